@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/doctor"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// errDoctorFailed is returned by the doctor command if a check failed, so it exits with a non-zero status.
+const errDoctorFailed = errs.ConstError("self-test found errors")
+
+var doctorJSON bool
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Run a self-test of the installation",
+	Long: `Run a self-test of the installation: database schema version, encryption key,
+data directory, inotify limits, clock, rclone backends and outbound network.
+
+The command exits with a non-zero status if a check failed. Use --json for
+machine-readable results, e.g. to attach them to a support request. Running
+servers return the same results at GET /api/admin/doctor.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cfg, err := config.Load(cfgFile)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		logger.InitLogger(logger.Environment(cfg.App.Environment), logger.LogLevel(cfg.Log.Level), cfg.Log.Levels)
+
+		report := doctor.Run(context.Background(), cfg, doctor.Options{})
+		out := cmd.OutOrStdout()
+		if doctorJSON {
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				return err
+			}
+		} else {
+			_, _ = fmt.Fprintf(out, "rclone-sync %s self-test\n", report.Version)
+			for _, c := range report.Checks {
+				_, _ = fmt.Fprintf(out, "  %-9s %-15s %s\n", "["+strings.ToUpper(string(c.Status))+"]", c.Name, c.Message)
+			}
+			_, _ = fmt.Fprintf(out, "Status: %s\n", report.Status)
+		}
+
+		if report.Status == doctor.StatusError {
+			return errDoctorFailed
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().StringVar(&cfgFile, "config", "", "config file (default is ./config.toml)")
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "print the results as JSON")
+}
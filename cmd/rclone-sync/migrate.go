@@ -0,0 +1,160 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	migrateSteps  int
+	migrateDryRun bool
+)
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Inspect and apply database migrations",
+	Long: `Inspect and apply the versioned database migrations.
+
+In production, the server refuses to migrate an existing database on startup
+unless database.allow_auto_migrate is set; use these commands instead.`,
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the current migration version and pending migrations",
+	Args:  cobra.NoArgs,
+	// Errors are reported as migration failures, not usage errors
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return withMigrationDB(func(cfg *config.Config, sqlDB *sql.DB) error {
+			status, err := db.GetMigrationStatus(sqlDB)
+			if err != nil {
+				return err
+			}
+			plan, err := db.PlanMigrations(sqlDB)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			_, _ = fmt.Fprintf(out, "Current version: %d\n", status.Version)
+			if status.Dirty {
+				_, _ = fmt.Fprintln(out, "Dirty: yes (a previous migration failed, manual repair required)")
+			}
+			_, _ = fmt.Fprintf(out, "Pending migrations: %d\n", len(plan))
+			for _, p := range plan {
+				_, _ = fmt.Fprintf(out, "  %d %s\n", p.Version, p.Name)
+			}
+			return nil
+		})
+	},
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:          "up",
+	Short:        "Apply all pending migrations",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return withMigrationDB(func(cfg *config.Config, sqlDB *sql.DB) error {
+			if migrateDryRun {
+				plan, err := db.PlanMigrations(sqlDB)
+				if err != nil {
+					return err
+				}
+				printMigrationPlan(cmd, plan)
+				return nil
+			}
+			return db.Migrate(sqlDB, cfg.App.Environment)
+		})
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:          "down",
+	Short:        "Roll back applied migrations",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return withMigrationDB(func(cfg *config.Config, sqlDB *sql.DB) error {
+			if migrateDryRun {
+				plan, err := db.PlanRollback(sqlDB, migrateSteps)
+				if err != nil {
+					return err
+				}
+				printMigrationPlan(cmd, plan)
+				return nil
+			}
+			return db.MigrateDown(sqlDB, cfg.App.Environment, migrateSteps)
+		})
+	},
+}
+
+var migrateDryRunCmd = &cobra.Command{
+	Use:          "dry-run",
+	Short:        "Print the SQL of pending migrations without applying them",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return withMigrationDB(func(cfg *config.Config, sqlDB *sql.DB) error {
+			plan, err := db.PlanMigrations(sqlDB)
+			if err != nil {
+				return err
+			}
+			printMigrationPlan(cmd, plan)
+			return nil
+		})
+	},
+}
+
+// withMigrationDB loads the configuration, opens the configured database and runs fn with it.
+func withMigrationDB(fn func(cfg *config.Config, sqlDB *sql.DB) error) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	logger.InitLogger(logger.Environment(cfg.App.Environment), logger.LogLevel(cfg.Log.Level), cfg.Log.Levels)
+
+	sqlDB, err := sql.Open("sqlite3", db.FileSDN(cfg.Database.Path))
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() {
+		if err := sqlDB.Close(); err != nil {
+			logger.Named("cmd.migrate").Warn("Failed to close database", zap.Error(err))
+		}
+	}()
+
+	return fn(cfg, sqlDB)
+}
+
+// printMigrationPlan prints the migrations that would be executed together with their SQL.
+func printMigrationPlan(cmd *cobra.Command, plan []db.PlannedMigration) {
+	out := cmd.OutOrStdout()
+	if len(plan) == 0 {
+		_, _ = fmt.Fprintln(out, "Nothing to do")
+		return
+	}
+	for _, p := range plan {
+		_, _ = fmt.Fprintf(out, "-- %d %s\n%s\n", p.Version, p.Name, p.SQL)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateStatusCmd, migrateUpCmd, migrateDownCmd, migrateDryRunCmd)
+
+	migrateCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is ./config.toml)")
+
+	migrateUpCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "print the SQL that would be executed without applying it")
+	migrateDownCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "print the SQL that would be executed without applying it")
+	migrateDownCmd.Flags().IntVar(&migrateSteps, "steps", 1, "number of migrations to roll back")
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -19,10 +20,16 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/config"
 	"github.com/xzzpig/rclone-sync/internal/core/crypto"
 	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/hooks"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/logship"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
 	"github.com/xzzpig/rclone-sync/internal/core/runner"
 	"github.com/xzzpig/rclone-sync/internal/core/scheduler"
 	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/core/tracing"
+	"github.com/xzzpig/rclone-sync/internal/core/version"
 	"github.com/xzzpig/rclone-sync/internal/core/watcher"
 	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
@@ -31,7 +38,10 @@ import (
 	"go.uber.org/zap"
 )
 
-var cfgFile string
+var (
+	cfgFile  string
+	seedDemo bool
+)
 
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
@@ -52,22 +62,54 @@ var serveCmd = &cobra.Command{
 
 		// 2. Initialize Logger with hierarchical level configuration
 		logger.InitLogger(logger.Environment(cfg.App.Environment), logger.LogLevel(cfg.Log.Level), cfg.Log.Levels)
+		if err := logger.InitFileOutput(logger.FileOptions{
+			Path:       cfg.Log.File.Path,
+			MaxSizeMB:  cfg.Log.File.MaxSize,
+			MaxBackups: cfg.Log.File.MaxBackups,
+			MaxAgeDays: cfg.Log.File.MaxAge,
+			Compress:   cfg.Log.File.Compress,
+		}); err != nil {
+			logger.Get().Fatal("Failed to open log file", zap.String("path", cfg.Log.File.Path), zap.Error(err))
+		}
+		defer func() { _ = logger.CloseFileOutput() }()
 		log := logger.Named("cmd.serve")
 		log.Info("Starting rclone-sync server...")
 		rclone.SetupLogLevel(cfg.Log.Level)
 
+		// Initialize tracing, spans are only recorded and exported if an endpoint is configured
+		if err := tracing.Init(context.Background(), tracing.Options{
+			Endpoint:    cfg.Tracing.Endpoint,
+			ServiceName: cfg.Tracing.ServiceName,
+			SampleRatio: cfg.Tracing.SampleRatio,
+		}); err != nil {
+			log.Fatal("Failed to initialize tracing", zap.String("endpoint", cfg.Tracing.Endpoint), zap.Error(err))
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := tracing.Shutdown(ctx); err != nil {
+				log.Warn("Failed to export remaining spans", zap.Error(err))
+			}
+		}()
+
 		// 3. Initialize i18n
 		if err := i18n.Init(); err != nil {
 			log.Fatal("Failed to initialize i18n", zap.Error(err))
 		}
+		i18n.SetDefaultLocale(cfg.App.Locale)
 		log.Info("i18n initialized successfully")
 
 		// 4. Initialize database with configured options
+		if err := ids.SetVersion(cfg.Database.UUIDVersion); err != nil {
+			log.Fatal("Invalid database UUID version", zap.Int("version", cfg.Database.UUIDVersion), zap.Error(err))
+		}
 		dbClient, err := db.InitDB(db.InitDBOptions{
-			DSN:           db.FileSDN(cfg.Database.Path),
-			MigrationMode: db.ParseMigrationMode(cfg.Database.MigrationMode),
-			EnableDebug:   logger.GetLevelForName("core.db.query") == zap.DebugLevel,
-			Environment:   cfg.App.Environment,
+			DSN:              db.FileSDN(cfg.Database.Path),
+			MigrationMode:    db.ParseMigrationMode(cfg.Database.MigrationMode),
+			EnableDebug:      logger.GetLevelForName("core.db.query") == zap.DebugLevel,
+			Environment:      cfg.App.Environment,
+			AllowAutoMigrate: cfg.Database.AllowAutoMigrate,
+			EnableTracing:    cfg.Tracing.Endpoint != "",
 		})
 		if err != nil {
 			log.Fatal("Failed to initialize database", zap.Error(err))
@@ -82,6 +124,7 @@ var serveCmd = &cobra.Command{
 
 		// 6. Initialize connection service and DBStorage
 		connSvc := services.NewConnectionService(dbClient, encryptor)
+		connSvc.SetConfigCacheTTL(cfg.App.Connection.ConfigCacheTTL)
 		dbStorage := rclone.NewDBStorage(connSvc)
 		dbStorage.Install()
 		log.Info("DBStorage installed - rclone will use database for configuration")
@@ -91,15 +134,103 @@ var serveCmd = &cobra.Command{
 		// 7. Initialize services
 		taskSvc := services.NewTaskService(dbClient)
 		jobSvc := services.NewJobService(dbClient)
+		jobSvc.SetFailureEscalationThreshold(cfg.App.Job.FailureEscalationThreshold)
+		jobSvc.SetAutoDisableThreshold(cfg.App.Job.AutoDisableThreshold)
+		// Ship job logs and status changes to syslog or Loki if a target is configured
+		if shipping := cfg.Log.Shipping; shipping.Target != "" {
+			labels, err := logship.NewLabels(shipping.Labels)
+			if err != nil {
+				log.Fatal("Invalid log shipping labels", zap.Error(err))
+			}
+			sink, err := logship.NewSink(shipping.Target, shipping.Address, labels)
+			if err != nil {
+				log.Fatal("Failed to initialize log shipping", zap.String("target", shipping.Target), zap.Error(err))
+			}
+			shipper := logship.NewShipper(dbClient, sink, logship.Options{
+				BatchSize:     shipping.BatchSize,
+				FlushInterval: shipping.FlushInterval,
+				BufferSize:    shipping.BufferSize,
+			})
+			jobSvc.SetJobLogSink(shipper)
+			shipper.Start()
+			defer shipper.Stop()
+		}
 		jobProgressBus := subscription.NewJobProgressBus()
 		transferProgressBus := subscription.NewTransferProgressBus()
+		logDeleteProgressBus := subscription.NewLogDeleteProgressBus()
 		syncEngine := rclone.NewSyncEngine(jobSvc, jobProgressBus, transferProgressBus, cfg.App.DataDir, cfg.App.Job.AutoDeleteEmptyJobs, cfg.App.Sync.Transfers)
+		syncEngine.SetLogBufferOptions(rclone.LogBufferOptions{
+			BatchSize:     cfg.App.Sync.LogBatchSize,
+			FlushInterval: cfg.App.Sync.LogFlushInterval,
+			BufferLimit:   cfg.App.Sync.LogBufferLimit,
+		})
+		syncEngine.SetHookOptions(hooks.Options{
+			AllowedCommands: cfg.App.Hooks.AllowedCommands,
+			Timeout:         cfg.App.Hooks.Timeout,
+			MaxOutput:       cfg.App.Hooks.MaxOutput,
+		})
+		syncEngine.SetDeleteAnomalyOptions(rclone.DeleteAnomalyOptions{
+			Factor: cfg.App.Sync.DeleteAnomalyFactor,
+			Min:    cfg.App.Sync.DeleteAnomalyMin,
+		})
+		syncEngine.SetConnectionResolver(connSvc)
+		// Fault injection exercises retries and recovery in integration tests and staging, never in production
+		if spec := os.Getenv(rclone.FaultInjectionEnv); spec != "" {
+			if logger.Environment(cfg.App.Environment) == logger.EnvironmentProduction {
+				log.Warn("Ignoring fault injection in production", zap.String("env", rclone.FaultInjectionEnv))
+			} else {
+				faults, err := rclone.ParseFaultOptions(spec)
+				if err != nil {
+					log.Fatal("Invalid fault injection", zap.String("env", rclone.FaultInjectionEnv), zap.Error(err))
+				}
+				syncEngine.SetFaultInjection(faults)
+			}
+		}
+		backupEngine := rclone.NewBackupEngine(syncEngine)
 		taskRunner := runner.NewRunner(syncEngine)
+		taskRunner.RegisterEngine(ports.BackupSyncEngine, backupEngine)
+		taskRunner.EnableStallDetection(jobProgressBus, jobSvc, runner.StallOptions{
+			Timeout:    cfg.App.Job.StallTimeout,
+			AutoCancel: cfg.App.Job.StallAutoCancel,
+		})
+		taskRunner.Start()
+		if cfg.App.MaintenanceMode {
+			taskRunner.SetMaintenance(true, false)
+			log.Warn("Starting in maintenance mode, mutations and job starts are rejected")
+		}
+
+		// Warm up the Fs cache of the tasks' remotes in the background, so the first jobs don't set up slow remotes one after another
+		if cfg.App.Connection.WarmupParallel > 0 {
+			warmupCtx, warmupCancel := context.WithCancel(context.Background())
+			defer warmupCancel()
+			go func() {
+				tasks, err := taskSvc.ListAllTasks(warmupCtx)
+				if err != nil {
+					log.Error("Failed to list tasks to warm up the Fs cache", zap.Error(err))
+					return
+				}
+				syncEngine.WarmUp(warmupCtx, tasks, cfg.App.Connection.WarmupParallel)
+			}()
+		}
 
-		// Reset any stuck jobs from previous crash/shutdown
-		if err := jobSvc.ResetStuckJobs(context.Background()); err != nil {
+		// Reset any stuck jobs from previous crash/shutdown, and resume the interrupted tasks that opted in
+		interruptedJobs, err := jobSvc.ResetStuckJobs(context.Background())
+		if err != nil {
 			log.Error("Failed to reset stuck jobs", zap.Error(err))
 		}
+		if len(interruptedJobs) > 0 && !cfg.App.MaintenanceMode {
+			if resumed := taskRunner.ResumeInterrupted(context.Background(), taskSvc, interruptedJobs); len(resumed) > 0 {
+				log.Info("Resumed tasks interrupted by a crash", zap.Int("count", len(resumed)))
+			}
+		}
+
+		// Create the demo data if requested, existing demo data is kept
+		if seedDemo {
+			demoSvc := services.NewDemoService(dbClient, connSvc)
+			if _, err := demoSvc.Seed(context.Background(), filepath.Join(syncEngine.DataDir(), services.DemoDirName)); err != nil {
+				log.Error("Failed to seed demo data", zap.Error(err))
+			}
+		}
 
 		// 8. Initialize and start scheduler
 		sched := scheduler.NewScheduler(taskSvc, taskRunner)
@@ -107,33 +238,86 @@ var serveCmd = &cobra.Command{
 		defer sched.Stop()
 
 		// 9. Initialize and start watcher
-		watch, err := watcher.NewWatcher(taskSvc, taskRunner)
+		watch, err := watcher.NewWatcher(taskSvc, taskRunner, cfg.App.Watcher.IgnorePatterns)
 		if err != nil {
 			log.Fatal("Failed to initialize watcher", zap.Error(err))
 		}
 		watch.Start()
 		defer watch.Stop()
 
-		// 10. Initialize and start log cleanup service
-		if cfg.App.Job.MaxLogsPerConnection > 0 && cfg.App.Job.CleanupSchedule != "" {
+		// 10. Initialize and start log cleanup service, which also applies the job retention of tasks
+		// and purges deleted tasks after their retention. It always runs, since tasks may set their own job retention.
+		if cfg.App.Job.CleanupSchedule != "" {
 			logCleanupSvc := services.NewLogCleanupService(dbClient, cfg.App.Job.MaxLogsPerConnection)
+			logCleanupSvc.SetDeletedTaskRetention(cfg.App.Task.DeletedRetention)
+			logCleanupSvc.SetJobRetention(cfg.App.Job.LogRetention, cfg.App.Job.RecordRetention)
 			if err := logCleanupSvc.Start(cfg.App.Job.CleanupSchedule); err != nil {
 				log.Fatal("Failed to start log cleanup service", zap.Error(err))
 			}
 			defer logCleanupSvc.Stop()
 		}
 
-		// 11. Setup router with dependencies
+		// 11. Initialize and start the daily usage sampling of connections for the usage forecast
+		if cfg.App.Usage.SampleSchedule != "" {
+			usageSvc := services.NewUsageService(dbClient, cfg.App.Usage.ForecastDays, cfg.App.Usage.WarningDays)
+			if err := usageSvc.Start(cfg.App.Usage.SampleSchedule, remoteUsage); err != nil {
+				log.Fatal("Failed to start usage sampling", zap.Error(err))
+			}
+			defer usageSvc.Stop()
+		}
+
+		// 12. Initialize and start the expiry check of connection credentials
+		if cfg.App.Credentials.CheckSchedule != "" {
+			credentialSvc := services.NewCredentialService(connSvc, cfg.App.Credentials.WarningDays)
+			if err := credentialSvc.Start(cfg.App.Credentials.CheckSchedule); err != nil {
+				log.Fatal("Failed to start credential expiry checks", zap.Error(err))
+			}
+			defer credentialSvc.Stop()
+		}
+
+		// 13. Initialize and start the decryption check of connection configs, reporting a wrong encryption key
+		// or corrupted configs before the jobs of the connections fail
+		integritySvc := services.NewIntegrityService(connSvc)
+		if cfg.App.Connection.IntegrityCheckSchedule != "" {
+			if err := integritySvc.Start(cfg.App.Connection.IntegrityCheckSchedule); err != nil {
+				log.Fatal("Failed to start config integrity checks", zap.Error(err))
+			}
+			defer integritySvc.Stop()
+		}
+
+		// 14. Initialize and start the opt-in check for newer releases
+		var updateSvc *services.UpdateService
+		if cfg.App.UpdateCheck.Enabled {
+			updateSvc = services.NewUpdateService(version.Get().Version, cfg.App.UpdateCheck.Repository, cfg.App.UpdateCheck.Interval)
+			updateSvc.Start()
+			defer updateSvc.Stop()
+		}
+
+		// 15. Initialize the database optimization and run it in the configured quiet hours
+		databaseSvc := services.NewDatabaseService(dbClient)
+		if cfg.Database.OptimizeSchedule != "" {
+			if err := databaseSvc.Start(cfg.Database.OptimizeSchedule, func() bool { return taskRunner.RunningCount() > 0 }); err != nil {
+				log.Fatal("Failed to start database optimization", zap.Error(err))
+			}
+			defer databaseSvc.Stop()
+		}
+
+		// 16. Setup router with dependencies
 		routerDeps := api.RouterDeps{
-			Client:              dbClient,
-			Config:              cfg,
-			SyncEngine:          syncEngine,
-			Runner:              taskRunner,
-			JobService:          jobSvc,
-			Watcher:             watch,
-			Scheduler:           sched,
-			JobProgressBus:      jobProgressBus,
-			TransferProgressBus: transferProgressBus,
+			Client:               dbClient,
+			Config:               cfg,
+			SyncEngine:           syncEngine,
+			BackupEngine:         backupEngine,
+			Runner:               taskRunner,
+			JobService:           jobSvc,
+			Watcher:              watch,
+			Scheduler:            sched,
+			JobProgressBus:       jobProgressBus,
+			TransferProgressBus:  transferProgressBus,
+			LogDeleteProgressBus: logDeleteProgressBus,
+			UpdateService:        updateSvc,
+			DatabaseService:      databaseSvc,
+			IntegrityService:     integritySvc,
 		}
 		r := api.SetupRouter(routerDeps)
 
@@ -173,6 +357,11 @@ var serveCmd = &cobra.Command{
 		// Stop the task runner (this waits for tasks to finish/cancel)
 		taskRunner.Stop()
 
+		// End the remaining subscriptions, no more progress is published once all tasks stopped
+		jobProgressBus.Close()
+		transferProgressBus.Close()
+		logDeleteProgressBus.Close()
+
 		log.Info("Server exiting")
 	},
 }
@@ -183,6 +372,7 @@ func init() {
 	config.BindFlags(serveCmd)
 
 	serveCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is ./config.toml)")
+	serveCmd.Flags().BoolVar(&seedDemo, "seed-demo", false, "create demo data (a local demo connection, a sample task and a completed job) on startup")
 
 	// Here you will define your flags and configuration settings.
 
@@ -194,3 +384,15 @@ func init() {
 	// is called directly, e.g.:
 	// serveCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
+
+// remoteUsage returns the quota of the remote of a connection for the usage sampling.
+func remoteUsage(ctx context.Context, connectionName string) (*services.UsageQuota, error) {
+	quota, err := rclone.GetRemoteQuota(ctx, connectionName)
+	if err != nil {
+		return nil, err
+	}
+	if quota.Used == nil {
+		return nil, fmt.Errorf("remote %s does not report its used space", connectionName) //nolint:err113
+	}
+	return &services.UsageQuota{Used: *quota.Used, Total: quota.Total, Free: quota.Free}, nil
+}
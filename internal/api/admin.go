@@ -0,0 +1,133 @@
+package api
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/doctor"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// defaultServerLogWindow is how far back server logs are returned without a since parameter.
+const defaultServerLogWindow = time.Hour
+
+// errNegativeSince is returned for a since duration pointing into the future.
+const errNegativeSince = errs.ConstError("since duration must not be negative")
+
+// adminLog returns a named logger for the api.admin package.
+func adminLog() *zap.Logger {
+	return logger.Named("api.admin")
+}
+
+// registerAdminRoutes registers the administration routes under /admin.
+// The self-test checks the current data directory returned by dataDir.
+func registerAdminRoutes(router *gin.RouterGroup, cfg *config.Config, dataDir func() string) {
+	group := router.Group("/admin")
+	{
+		group.GET("/logs", downloadServerLogs)
+		group.POST("/logs/rotate", rotateServerLogs)
+		group.GET("/doctor", runDoctor(cfg, dataDir))
+	}
+}
+
+// runDoctor returns the handler running the self-test of the installation, which returns the results of the
+// checks as JSON like the doctor command. Failed checks are part of the results, not an error of the request.
+func runDoctor(cfg *config.Config, dataDir func() string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := doctor.Run(c.Request.Context(), cfg, doctor.Options{DataDir: dataDir()})
+		adminLog().Info("Ran self-test",
+			zap.String("status", string(report.Status)),
+			zap.String("user", c.GetString(gin.AuthUserKey)),
+		)
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// downloadServerLogs streams the server log entries (JSON lines) written since the "since" query parameter,
+// which is an RFC 3339 timestamp or a duration back from now (e.g. "30m"), default one hour.
+// Rotated log files are included. The response is gzip compressed if the client accepts it.
+func downloadServerLogs(c *gin.Context) {
+	since, err := parseSince(c.Query("since"), time.Now())
+	if err != nil {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrInvalidSince).WithCause(err))
+		return
+	}
+	if !logger.FileOutputEnabled() {
+		_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrServerLogDisabled))
+		return
+	}
+
+	adminLog().Info("Downloading server logs",
+		zap.Time("since", since),
+		zap.String("user", c.GetString(gin.AuthUserKey)),
+	)
+
+	c.Header("Content-Type", "application/x-ndjson; charset=utf-8")
+	c.Header("Vary", "Accept-Encoding")
+
+	var out io.Writer = c.Writer
+	if acceptsGzip(c.GetHeader("Accept-Encoding")) {
+		c.Header("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(c.Writer)
+		defer gz.Close()
+		out = gz
+	}
+	c.Status(http.StatusOK)
+
+	w := bufio.NewWriter(out)
+	err = logger.ForEachFileLog(since, func(line []byte) error {
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+		return w.WriteByte('\n')
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		// The status has already been sent, so the truncated log is all the client gets
+		adminLog().Warn("Streaming server logs failed", zap.Error(err))
+	}
+}
+
+// rotateServerLogs starts a new server log file, keeping the current one as a rotated backup.
+func rotateServerLogs(c *gin.Context) {
+	if err := logger.RotateFile(); err != nil {
+		if errors.Is(err, logger.ErrFileOutputDisabled) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrServerLogDisabled))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrGeneric).WithCause(err))
+		return
+	}
+	adminLog().Info("Rotated server log file", zap.String("user", c.GetString(gin.AuthUserKey)))
+	c.Status(http.StatusNoContent)
+}
+
+// parseSince parses an RFC 3339 timestamp or a duration back from now. An empty value means defaultServerLogWindow.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return now.Add(-defaultServerLogWindow), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d < 0 {
+		return time.Time{}, errNegativeSince
+	}
+	return now.Add(-d), nil
+}
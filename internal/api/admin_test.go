@@ -0,0 +1,158 @@
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// setupAdminRoutes creates a router serving the administration routes of an installation that hasn't started yet.
+func setupAdminRoutes(t *testing.T) *gin.Engine {
+	t.Helper()
+	require.NoError(t, i18n.Init())
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(apicontext.LocaleMiddleware())
+	router.Use(apicontext.I18nErrorMiddleware())
+	cfg := &config.Config{}
+	cfg.Database.Path = filepath.Join(t.TempDir(), "missing.db")
+	// The data directory was relocated, so the config still names the old, removed one
+	cfg.App.DataDir = filepath.Join(t.TempDir(), "relocated")
+	dataDir := t.TempDir()
+	registerAdminRoutes(router.Group("/api"), cfg, func() string { return dataDir })
+	return router
+}
+
+func doAdminRequest(router *gin.Engine, method, target string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAdminRoutes_ServerLogs(t *testing.T) {
+	router := setupAdminRoutes(t)
+
+	t.Run("Disabled", func(t *testing.T) {
+		w := doAdminRequest(router, http.MethodGet, "/api/admin/logs", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrServerLogDisabled, errorCode(t, w))
+
+		w = doAdminRequest(router, http.MethodPost, "/api/admin/logs/rotate", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrServerLogDisabled, errorCode(t, w))
+	})
+
+	t.Run("InvalidSince", func(t *testing.T) {
+		w := doAdminRequest(router, http.MethodGet, "/api/admin/logs?since=yesterday", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, i18n.ErrInvalidSince, errorCode(t, w))
+	})
+
+	require.NoError(t, logger.InitFileOutput(logger.FileOptions{Path: filepath.Join(t.TempDir(), "server.log"), MaxSizeMB: 1}))
+	t.Cleanup(func() { _ = logger.CloseFileOutput() })
+	logger.Named("core.scheduler").Warn("Scheduler hiccup")
+
+	t.Run("Download", func(t *testing.T) {
+		w := doAdminRequest(router, http.MethodGet, "/api/admin/logs?since=5m", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson; charset=utf-8", w.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		require.NotEmpty(t, lines)
+		assert.Equal(t, "core.scheduler", gjson.Get(lines[0], "logger").String())
+		assert.Equal(t, "Scheduler hiccup", gjson.Get(lines[0], "msg").String())
+	})
+
+	t.Run("DownloadGzip", func(t *testing.T) {
+		w := doAdminRequest(router, http.MethodGet, "/api/admin/logs", http.Header{"Accept-Encoding": {"gzip"}})
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+
+		gz, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Scheduler hiccup")
+	})
+
+	t.Run("SinceFuture", func(t *testing.T) {
+		since := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		w := doAdminRequest(router, http.MethodGet, "/api/admin/logs?since="+since, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("Rotate", func(t *testing.T) {
+		w := doAdminRequest(router, http.MethodPost, "/api/admin/logs/rotate", nil)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = doAdminRequest(router, http.MethodGet, "/api/admin/logs", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Scheduler hiccup", "rotated files are included")
+	})
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Default", value: "", want: now.Add(-time.Hour)},
+		{name: "Duration", value: "30m", want: now.Add(-30 * time.Minute)},
+		{name: "Timestamp", value: "2026-10-17T08:30:00+02:00", want: time.Date(2026, 10, 17, 6, 30, 0, 0, time.UTC)},
+		{name: "NegativeDuration", value: "-1h", wantErr: true},
+		{name: "Invalid", value: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestAdminRoutes_Doctor(t *testing.T) {
+	router := setupAdminRoutes(t)
+
+	w := doAdminRequest(router, http.MethodGet, "/api/admin/doctor", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Equal(t, "warning", gjson.Get(body, "status").String())
+
+	checks := gjson.Get(body, "checks").Array()
+	require.Len(t, checks, 7)
+	assert.Equal(t, "database", checks[0].Get("name").String())
+	assert.Equal(t, "warning", checks[0].Get("status").String())
+	assert.Equal(t, "skipped", checks[1].Get("status").String(), "configs can't be checked without the database")
+	assert.Equal(t, "data_dir", checks[2].Get("name").String())
+	assert.Equal(t, "ok", checks[2].Get("status").String())
+	assert.True(t, checks[2].Get("durationMs").Exists())
+}
@@ -0,0 +1,309 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// archiveDirName is the directory below the data directory holding archives built in the background.
+const archiveDirName = "archives"
+
+// Status of an archive job.
+const (
+	archiveStatusRunning = "RUNNING"
+	archiveStatusSuccess = "SUCCESS"
+	archiveStatusFailed  = "FAILED"
+)
+
+// archiveJob is an archive of a remote directory built in the background.
+type archiveJob struct {
+	ID         uuid.UUID            `json:"id"`
+	Connection string               `json:"connection"`
+	Path       string               `json:"path"`
+	Format     rclone.ArchiveFormat `json:"format"`
+	Status     string               `json:"status"`
+	Files      int                  `json:"files"`
+	Size       int64                `json:"size"` // Total size of the archived files
+	Error      string               `json:"error,omitempty"`
+	CreatedAt  time.Time            `json:"createdAt"`
+	EndTime    *time.Time           `json:"endTime,omitempty"`
+	file       string
+}
+
+// archiveJobs keeps the archive jobs of the server. Jobs are kept in memory only: archives left
+// behind by a previous run are removed on the first job, and finished jobs expire after the retention.
+type archiveJobs struct {
+	dataDir   func() string
+	retention time.Duration
+	cleanup   sync.Once
+
+	mu   sync.Mutex
+	jobs map[uuid.UUID]*archiveJob
+}
+
+// newArchiveJobs creates the archive jobs of the server, keeping archives below the data directory returned by dataDir.
+func newArchiveJobs(cfg *config.Config, dataDir func() string) *archiveJobs {
+	return &archiveJobs{
+		dataDir:   dataDir,
+		retention: cfg.App.Archive.Retention,
+		jobs:      make(map[uuid.UUID]*archiveJob),
+	}
+}
+
+// dir returns the directory new archives are built in. It is resolved on every use, so archives
+// follow the data directory when it is relocated, while existing archives stay where they were built.
+func (a *archiveJobs) dir() string {
+	return filepath.Join(a.dataDir(), archiveDirName)
+}
+
+// start builds the archive of objects in the background and returns its job.
+func (a *archiveJobs) start(connection, remotePath string, format rclone.ArchiveFormat, objects []fs.Object, size int64) (*archiveJob, error) {
+	dir := a.dir()
+	a.cleanup.Do(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			filesLog().Warn("Failed to remove stale archives", zap.String("dir", dir), zap.Error(err))
+		}
+	})
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	a.expire(time.Now())
+
+	job := &archiveJob{
+		ID:         uuid.New(),
+		Connection: connection,
+		Path:       remotePath,
+		Format:     format,
+		Status:     archiveStatusRunning,
+		Files:      len(objects),
+		Size:       size,
+		CreatedAt:  time.Now(),
+	}
+	job.file = filepath.Join(dir, job.ID.String()+format.Extension())
+	result := job.snapshot()
+	a.mu.Lock()
+	a.jobs[job.ID] = job
+	a.mu.Unlock()
+
+	go a.build(job, objects)
+	return result, nil
+}
+
+// build writes the archive of a job to its file.
+func (a *archiveJobs) build(job *archiveJob, objects []fs.Object) {
+	log := filesLog().With(zap.Stringer("archive_id", job.ID), zap.String("connection", job.Connection), zap.String("path", job.Path))
+	err := writeArchiveFile(job.file, job.Format, objects)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := time.Now()
+	job.EndTime = &now
+	if err != nil {
+		log.Warn("Building remote directory archive failed", zap.Error(err))
+		job.Status = archiveStatusFailed
+		job.Error = err.Error()
+		return
+	}
+	log.Info("Built remote directory archive", zap.Int("files", job.Files), zap.Int64("size", job.Size))
+	job.Status = archiveStatusSuccess
+}
+
+// writeArchiveFile writes the archive to a temporary file first, so only complete archives are served.
+func writeArchiveFile(name string, format rclone.ArchiveFormat, objects []fs.Object) error {
+	tmp := name + ".part"
+	f, err := os.Create(tmp) //nolint:gosec // The name is built from the job ID
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = rclone.WriteArchive(context.Background(), w, format, objects)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// expire removes finished jobs older than the retention along with their archives.
+func (a *archiveJobs) expire(now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for id, job := range a.jobs {
+		if job.EndTime == nil || now.Sub(*job.EndTime) < a.retention {
+			continue
+		}
+		if err := os.Remove(job.file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			filesLog().Warn("Failed to remove expired archive", zap.String("file", job.file), zap.Error(err))
+		}
+		delete(a.jobs, id)
+	}
+}
+
+// get returns a copy of the job with the given ID.
+func (a *archiveJobs) get(id uuid.UUID) (*archiveJob, bool) {
+	a.expire(time.Now())
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	job, ok := a.jobs[id]
+	if !ok {
+		return nil, false
+	}
+	return job.snapshot(), true
+}
+
+// snapshot returns a copy of the job. Once the job is started, callers must hold the lock of the jobs.
+func (j *archiveJob) snapshot() *archiveJob {
+	c := *j
+	return &c
+}
+
+// fileName returns the name the archive is downloaded as, after the archived directory.
+func (j *archiveJob) fileName() string {
+	return archiveFileName(j.Path, j.Format)
+}
+
+// archiveFileName returns the download name of the archive of a remote directory.
+func archiveFileName(remotePath string, format rclone.ArchiveFormat) string {
+	name := path.Base(remotePath)
+	if name == "/" || name == "." {
+		name = "archive"
+	}
+	return name + format.Extension()
+}
+
+// archive streams a zip or tar.gz archive of the remote directory at the "path" query parameter.
+// Directories whose files total more than app.archive.stream_max_size are archived in the background
+// instead: the response is 202 Accepted with the archive job, see archiveStatus and archiveDownload.
+// Directories larger than app.archive.max_size are rejected.
+func (h *fileHandler) archive(c *gin.Context) {
+	maxSize := h.cfg.App.Archive.MaxSize
+	if maxSize <= 0 {
+		_ = c.Error(i18n.NewI18nError(i18n.ErrArchiveDisabled).WithStatus(http.StatusForbidden))
+		return
+	}
+	format, ok := rclone.ParseArchiveFormat(c.Query("format"))
+	if !ok {
+		_ = c.Error(i18n.NewI18nErrorWithData(i18n.ErrArchiveFormatInvalid, map[string]interface{}{"Format": c.Query("format")}).
+			WithStatus(http.StatusBadRequest))
+		return
+	}
+	conn, remotePath, ok := h.resolveTarget(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	f, err := rclone.GetFs(ctx, conn.Name, remotePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrorIsFile) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrPathNotExist).WithCause(err))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrConnectionFailed).WithCause(err))
+		return
+	}
+	objects, size, err := rclone.ListArchiveObjects(ctx, f)
+	if err != nil {
+		if errors.Is(err, fs.ErrorDirNotFound) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrPathNotExist).WithCause(err))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrConnectionFailed).WithCause(err))
+		return
+	}
+	if size > maxSize {
+		_ = c.Error(i18n.NewI18nErrorWithData(i18n.ErrArchiveTooLarge, map[string]interface{}{"Size": size, "Limit": maxSize}).
+			WithStatus(http.StatusRequestEntityTooLarge))
+		return
+	}
+
+	log := filesLog().With(
+		zap.String("connection", conn.Name),
+		zap.String("path", remotePath),
+		zap.String("format", string(format)),
+		zap.String("user", c.GetString(gin.AuthUserKey)),
+	)
+
+	if size > h.cfg.App.Archive.StreamMaxSize {
+		job, err := h.archives.start(conn.Name, remotePath, format, objects, size)
+		if err != nil {
+			_ = c.Error(i18n.ErrInternalI18n(i18n.ErrArchiveFailed).WithCause(err))
+			return
+		}
+		log.Info("Archiving remote directory in the background", zap.Stringer("archive_id", job.ID), zap.Int("files", job.Files), zap.Int64("size", size))
+		c.JSON(http.StatusAccepted, job)
+		return
+	}
+
+	log.Info("Streaming remote directory archive", zap.Int("files", len(objects)), zap.Int64("size", size))
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": archiveFileName(remotePath, format)}))
+	c.Status(http.StatusOK)
+	if err := rclone.WriteArchive(ctx, c.Writer, format, objects); err != nil {
+		// The response has started, so the client only sees a truncated archive
+		log.Warn("Streaming remote directory archive failed", zap.Error(err))
+		c.Abort()
+	}
+}
+
+// archiveStatus returns the archive job with the :id parameter.
+func (h *fileHandler) archiveStatus(c *gin.Context) {
+	job, ok := h.archiveJob(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// archiveDownload serves the archive of a successful archive job.
+func (h *fileHandler) archiveDownload(c *gin.Context) {
+	job, ok := h.archiveJob(c)
+	if !ok {
+		return
+	}
+	if job.Status != archiveStatusSuccess {
+		_ = c.Error(i18n.NewI18nError(i18n.ErrArchiveNotReady).WithStatus(http.StatusConflict))
+		return
+	}
+	c.Header("Content-Type", job.Format.ContentType())
+	c.FileAttachment(job.file, job.fileName())
+}
+
+// archiveJob loads the archive job from the :id parameter.
+// On failure the error is attached to the context and ok is false.
+func (h *fileHandler) archiveJob(c *gin.Context) (*archiveJob, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrInvalidIDFormat).WithCause(err))
+		return nil, false
+	}
+	job, ok := h.archives.get(id)
+	if !ok {
+		_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrArchiveNotFound))
+		return nil, false
+	}
+	return job, true
+}
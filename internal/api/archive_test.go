@@ -0,0 +1,189 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+func archiveConfig(t *testing.T, maxSize, streamMaxSize int64) *config.Config {
+	cfg := &config.Config{}
+	cfg.App.DataDir = t.TempDir()
+	cfg.App.Archive.MaxSize = maxSize
+	cfg.App.Archive.StreamMaxSize = streamMaxSize
+	cfg.App.Archive.Retention = time.Hour
+	return cfg
+}
+
+func doArchive(router *gin.Engine, connID uuid.UUID, remotePath, format string) *httptest.ResponseRecorder {
+	target := "/api/connections/" + connID.String() + "/archive?path=" + url.QueryEscape(remotePath) + "&format=" + url.QueryEscape(format)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+// archiveTestDir creates a directory with a file in the root and one in a subdirectory.
+func archiveTestDir(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("world!"), 0644))
+	return dir
+}
+
+// readZip returns the content of the files of a zip archive by name.
+func readZip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestFileRoutes_Archive(t *testing.T) {
+	dir := archiveTestDir(t)
+	expected := map[string]string{"a.txt": "hello", "sub/b.txt": "world!"}
+
+	t.Run("zip", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 1024, 1024))
+		w := doArchive(router, connID, dir, "")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "filename="+filepath.Base(dir)+".zip")
+		assert.Equal(t, expected, readZip(t, w.Body.Bytes()))
+	})
+
+	t.Run("tar.gz", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 1024, 1024))
+		w := doArchive(router, connID, dir, "tar.gz")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+
+		gr, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		tr := tar.NewReader(gr)
+		files := make(map[string]string)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[header.Name] = string(content)
+		}
+		assert.Equal(t, expected, files)
+	})
+
+	t.Run("background", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 1024, 4))
+		w := doArchive(router, connID, dir, "zip")
+		require.Equal(t, http.StatusAccepted, w.Code)
+		var job archiveJob
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+		assert.Equal(t, 2, job.Files)
+		assert.Equal(t, int64(11), job.Size)
+
+		status := func() string {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/archives/"+job.ID.String(), nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+			return job.Status
+		}
+		assert.Eventually(t, func() bool { return status() == archiveStatusSuccess }, 5*time.Second, 10*time.Millisecond)
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/archives/"+job.ID.String()+"/download", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Disposition"), filepath.Base(dir)+".zip")
+		assert.Equal(t, expected, readZip(t, w.Body.Bytes()))
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/archives/"+uuid.NewString(), nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrArchiveNotFound, errorCode(t, w))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 8, 4))
+		w := doArchive(router, connID, dir, "zip")
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Equal(t, i18n.ErrArchiveTooLarge, errorCode(t, w))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 0, 0))
+		w := doArchive(router, connID, dir, "zip")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, i18n.ErrArchiveDisabled, errorCode(t, w))
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 1024, 1024))
+		w := doArchive(router, connID, dir, "rar")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, i18n.ErrArchiveFormatInvalid, errorCode(t, w))
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		router, connID := setupFileRoutes(t, archiveConfig(t, 1024, 1024))
+		w := doArchive(router, connID, filepath.Join(dir, "missing"), "zip")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrPathNotExist, errorCode(t, w))
+	})
+}
+
+func TestArchiveJobs_RelocatedDataDir(t *testing.T) {
+	cfg := archiveConfig(t, 1024, 4)
+	dataDir := cfg.App.DataDir
+	jobs := newArchiveJobs(cfg, func() string { return dataDir })
+
+	build := func() string {
+		job, err := jobs.start("conn", "dir", "zip", nil, 0)
+		require.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			j, ok := jobs.get(job.ID)
+			return ok && j.Status == archiveStatusSuccess
+		}, 5*time.Second, 10*time.Millisecond)
+		j, _ := jobs.get(job.ID)
+		return j.file
+	}
+
+	first := build()
+	assert.Equal(t, filepath.Join(cfg.App.DataDir, archiveDirName), filepath.Dir(first))
+
+	// Archives built after relocating the data directory go to the new one
+	dataDir = t.TempDir()
+	second := build()
+	assert.Equal(t, filepath.Join(dataDir, archiveDirName), filepath.Dir(second))
+	assert.FileExists(t, first)
+	assert.FileExists(t, second)
+}
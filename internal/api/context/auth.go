@@ -2,12 +2,17 @@
 package context
 
 import (
+	"context"
 	"crypto/subtle"
 	"net/http"
+	"path"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
 	"go.uber.org/zap"
 )
 
@@ -16,21 +21,57 @@ func authLog() *zap.Logger {
 	return logger.Named("api.auth")
 }
 
+// ErrWebSocketUnauthorized is returned by AuthenticateConnectionParams if the connection_init payload
+// of a WebSocket holds no valid credentials.
+const ErrWebSocketUnauthorized = errs.ConstError("unauthorized")
+
+// graphQLPath is the path of the GraphQL endpoint, whose WebSocket connections may authenticate
+// with the connection_init payload instead of the upgrade request.
+const graphQLPath = "/api/graphql"
+
+// sharePathPrefix is the path prefix of the share links, which authenticate with their share token instead.
+const sharePathPrefix = "/api/share/"
+
+// restPathPrefix is the path prefix of the REST API, which also accepts the API tokens as bearer tokens.
+const restPathPrefix = "/api/v1/"
+
+// APITokenUser is the user recorded for the jobs started with an API token.
+const APITokenUser = "api-token"
+
+// pendingAuthKey is the request context key of the credential check deferred to the connection_init payload.
+type pendingAuthKey struct{}
+
 // BasicAuthMiddleware creates a gin middleware that validates HTTP Basic Auth credentials.
 // It uses constant-time comparison for password comparison to prevent timing attacks.
-// On successful authentication, the username is stored in the gin context using gin.AuthUserKey.
+// On successful authentication, the username is stored in the gin context using gin.AuthUserKey
+// and in the request context (see provenance.User). Share links pass without credentials.
 func BasicAuthMiddleware(username, password string) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// The holder of a share link has no credentials, the share routes check the token in the link
+		if isSharePath(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		// Requests to the REST API with a bearer token are authenticated by APITokenMiddleware
+		if isRESTPath(c.Request.URL.Path) && hasBearerToken(c.Request) {
+			c.Next()
+			return
+		}
+
+		// Browsers cannot set headers on WebSocket connects, so GraphQL subscriptions
+		// without an Authorization header authenticate with the connection_init payload
+		if isDeferredWebSocket(c.Request) {
+			check := func(user, pass string) bool { return credentialsMatch(user, pass, username, password) }
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), pendingAuthKey{}, check))
+			c.Next()
+			return
+		}
+
 		// Extract credentials from Authorization header
 		user, pass, hasAuth := c.Request.BasicAuth()
 
-		// Validate credentials using constant-time comparison
-		// This prevents timing attacks that could reveal password information
-		userMatch := subtle.ConstantTimeCompare([]byte(user), []byte(username)) == 1
-		passMatch := subtle.ConstantTimeCompare([]byte(pass), []byte(password)) == 1
-		authValid := hasAuth && userMatch && passMatch
-
-		if !authValid {
+		if !hasAuth || !credentialsMatch(user, pass, username, password) {
 			// Set WWW-Authenticate header to prompt browser for credentials
 			c.Header("WWW-Authenticate", `Basic realm="Login Required"`)
 
@@ -45,8 +86,10 @@ func BasicAuthMiddleware(username, password string) gin.HandlerFunc {
 			return
 		}
 
-		// Store authenticated username in context for downstream handlers
+		// Store authenticated username in context for downstream handlers,
+		// and in the request context so jobs started by the request record it
 		c.Set(gin.AuthUserKey, username)
+		c.Request = c.Request.WithContext(provenance.WithUser(c.Request.Context(), username))
 
 		c.Next()
 	}
@@ -64,3 +107,117 @@ func OptionalAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// APITokenMiddleware authenticates the requests of the REST API that carry an "Authorization: Bearer <token>"
+// header with the API tokens of cfg, on which the jobs they start record APITokenUser.
+// Requests without a bearer token are left to the authentication of the other routes.
+func APITokenMiddleware(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !hasBearerToken(c.Request) {
+			c.Next()
+			return
+		}
+
+		token := strings.TrimSpace(c.Request.Header.Get("Authorization")[len("Bearer "):])
+		if !apiTokenValid(token, cfg.Auth.APITokens) {
+			c.Header("WWW-Authenticate", `Bearer realm="rclone-sync"`)
+			authLog().Warn("api token authentication failed",
+				zap.String("ip", c.ClientIP()),
+				zap.String("path", c.Request.URL.Path),
+			)
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		c.Set(gin.AuthUserKey, APITokenUser)
+		c.Request = c.Request.WithContext(provenance.WithUser(c.Request.Context(), APITokenUser))
+		c.Next()
+	}
+}
+
+// AuthenticateConnectionParams validates the credentials in the connection_init payload of a GraphQL
+// WebSocket whose upgrade request had no Authorization header. The payload carries the header instead,
+// as in {"Authorization": "Basic <base64 of username:password>"}. On success the username is stored
+// in the returned context (see provenance.User); otherwise ErrWebSocketUnauthorized is returned.
+// Connections authenticated by the upgrade request, or served while authentication is disabled,
+// are accepted as they are.
+func AuthenticateConnectionParams(ctx context.Context, payload map[string]any) (context.Context, error) {
+	check, ok := ctx.Value(pendingAuthKey{}).(func(user, pass string) bool)
+	if !ok {
+		return ctx, nil
+	}
+
+	var authorization string
+	for _, key := range []string{"Authorization", "authorization"} {
+		if value, ok := payload[key].(string); ok && value != "" {
+			authorization = value
+			break
+		}
+	}
+	req := &http.Request{Header: http.Header{"Authorization": {authorization}}}
+	user, pass, hasAuth := req.BasicAuth()
+	if !hasAuth || !check(user, pass) {
+		authLog().Warn("websocket authentication failed",
+			zap.String("username", user),
+			zap.Bool("credentials", hasAuth),
+		)
+		return ctx, ErrWebSocketUnauthorized
+	}
+	return provenance.WithUser(ctx, user), nil
+}
+
+// credentialsMatch compares the credentials using constant-time comparison.
+// This prevents timing attacks that could reveal password information.
+func credentialsMatch(user, pass, username, password string) bool {
+	userMatch := subtle.ConstantTimeCompare([]byte(user), []byte(username)) == 1
+	passMatch := subtle.ConstantTimeCompare([]byte(pass), []byte(password)) == 1
+	return userMatch && passMatch
+}
+
+// apiTokenValid reports whether token is one of tokens, comparing each in constant time.
+func apiTokenValid(token string, tokens []string) bool {
+	valid := false
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			valid = true
+		}
+	}
+	return valid && token != ""
+}
+
+// hasBearerToken reports whether r has an Authorization header of the Bearer scheme.
+func hasBearerToken(r *http.Request) bool {
+	authorization := r.Header.Get("Authorization")
+	return len(authorization) > len("Bearer ") && strings.EqualFold(authorization[:len("Bearer ")], "Bearer ")
+}
+
+// isRESTPath reports whether p is a path of the REST API. Dot segments are resolved first,
+// so a path can't escape to other routes through the REST prefix.
+func isRESTPath(p string) bool {
+	return strings.HasPrefix(path.Clean(p), restPathPrefix)
+}
+
+// isDeferredWebSocket reports whether r is a WebSocket upgrade of the GraphQL endpoint without
+// an Authorization header, which authenticates with its connection_init payload instead.
+func isDeferredWebSocket(r *http.Request) bool {
+	return r.URL.Path == graphQLPath &&
+		r.Header.Get("Authorization") == "" &&
+		strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		headerContainsToken(r.Header.Get("Connection"), "upgrade")
+}
+
+// isSharePath reports whether p is the path of a share link. Dot segments are resolved first,
+// so a path can't escape to other routes through the share prefix.
+func isSharePath(p string) bool {
+	return strings.HasPrefix(path.Clean(p), sharePathPrefix)
+}
+
+// headerContainsToken reports whether the comma separated header value contains token.
+func headerContainsToken(value, token string) bool {
+	for _, t := range strings.Split(value, ",") {
+		if strings.EqualFold(strings.TrimSpace(t), token) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package context
 
 import (
+	stdcontext "context"
 	"encoding/base64"
 	"net/http"
 	"net/http/httptest"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
 )
 
 // basicAuthEncode encodes username and password for Basic Auth header
@@ -95,6 +98,11 @@ func TestBasicAuthMiddleware(t *testing.T) {
 			router.Use(BasicAuthMiddleware(tt.username, tt.password))
 			router.GET("/test", func(c *gin.Context) {
 				username := c.GetString(gin.AuthUserKey)
+				// The user is also available to services through the request context
+				if user := provenance.User(c.Request.Context()); user == nil || *user != username {
+					c.Status(http.StatusInternalServerError)
+					return
+				}
 				c.JSON(200, gin.H{"message": "success", "user": username})
 			})
 
@@ -174,3 +182,161 @@ func TestOptionalAuthMiddleware_AuthEnabled(t *testing.T) {
 	router.ServeHTTP(w2, req2)
 	assert.Equal(t, http.StatusOK, w2.Code)
 }
+
+func TestBasicAuthMiddleware_WebSocketDeferred(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(BasicAuthMiddleware("admin", "secret123"))
+	handler := func(c *gin.Context) {
+		_, err := AuthenticateConnectionParams(c.Request.Context(), nil)
+		c.JSON(http.StatusOK, gin.H{"pending": err != nil})
+	}
+	router.GET("/api/graphql", handler)
+	router.GET("/test", handler)
+
+	upgrade := func(path string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		req.Header.Set("Connection", "keep-alive, Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// GraphQL upgrades without an Authorization header defer to the connection_init payload
+	w := upgrade("/api/graphql")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"pending":true`)
+
+	// Upgrades of other paths still need the header
+	w = upgrade("/test")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// Plain requests to the GraphQL endpoint still need the header
+	req, _ := http.NewRequest("GET", "/api/graphql", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestBasicAuthMiddleware_ShareLinks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(BasicAuthMiddleware("admin", "secret123"))
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"user": provenance.User(c.Request.Context())})
+	}
+	router.GET("/api/share/:token", handler)
+	router.GET("/api/shares", handler)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Share links are checked by their token instead, without a user
+	w := get("/api/share/token")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"user":null`)
+
+	// Other paths still need credentials
+	assert.Equal(t, http.StatusUnauthorized, get("/api/shares").Code)
+	assert.Equal(t, http.StatusUnauthorized, get("/api/share/../graphql").Code)
+}
+
+func TestAuthenticateConnectionParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// pendingContext returns the request context of a GraphQL upgrade without an Authorization header
+	pendingContext := func(t *testing.T) stdcontext.Context {
+		var ctx stdcontext.Context
+		router := gin.New()
+		router.Use(BasicAuthMiddleware("admin", "secret123"))
+		router.GET("/api/graphql", func(c *gin.Context) { ctx = c.Request.Context() })
+		req, _ := http.NewRequest("GET", "/api/graphql", nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		require.NotNil(t, ctx)
+		return ctx
+	}
+
+	t.Run("valid credentials", func(t *testing.T) {
+		for _, key := range []string{"Authorization", "authorization"} {
+			ctx, err := AuthenticateConnectionParams(pendingContext(t), map[string]any{
+				key: "Basic " + basicAuthEncode("admin", "secret123"),
+			})
+			require.NoError(t, err)
+			user := provenance.User(ctx)
+			require.NotNil(t, user)
+			assert.Equal(t, "admin", *user)
+		}
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		for _, payload := range []map[string]any{
+			nil,
+			{"Authorization": "Basic " + basicAuthEncode("admin", "wrong")},
+			{"Authorization": "Bearer token"},
+			{"Authorization": 42},
+		} {
+			_, err := AuthenticateConnectionParams(pendingContext(t), payload)
+			assert.ErrorIs(t, err, ErrWebSocketUnauthorized)
+		}
+	})
+
+	t.Run("already authenticated", func(t *testing.T) {
+		ctx, err := AuthenticateConnectionParams(stdcontext.Background(), nil)
+		require.NoError(t, err)
+		assert.Nil(t, provenance.User(ctx))
+	})
+}
+
+func TestAPITokenMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.Auth.APITokens = []string{"home-assistant-token"}
+
+	router := gin.New()
+	router.Use(BasicAuthMiddleware("admin", "secret123"))
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"user": provenance.User(c.Request.Context())})
+	}
+	router.GET("/api/v1/tasks", APITokenMiddleware(cfg), handler)
+	router.GET("/api/graphql", handler)
+
+	get := func(path, authorization string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// A valid API token authenticates REST requests as APITokenUser
+	w := get("/api/v1/tasks", "Bearer home-assistant-token")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"user":"`+APITokenUser+`"`)
+
+	// Invalid tokens are rejected
+	w = get("/api/v1/tasks", "Bearer wrong-token")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, `Bearer realm="rclone-sync"`, w.Header().Get("WWW-Authenticate"))
+
+	// Basic Auth credentials still work on the REST API
+	w = get("/api/v1/tasks", "Basic "+basicAuthEncode("admin", "secret123"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"user":"admin"`)
+	assert.Equal(t, http.StatusUnauthorized, get("/api/v1/tasks", "").Code)
+
+	// API tokens aren't accepted by other routes
+	assert.Equal(t, http.StatusUnauthorized, get("/api/graphql", "Bearer home-assistant-token").Code)
+	assert.Equal(t, http.StatusUnauthorized, get("/api/v1/../graphql", "Bearer home-assistant-token").Code)
+}
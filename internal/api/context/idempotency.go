@@ -0,0 +1,21 @@
+package context
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+)
+
+// IdempotencyKeyHeader is the request header carrying the idempotency key of create and run mutations.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyKeyMiddleware stores the Idempotency-Key header of a request in its context,
+// where mutations without an idempotencyKey argument pick it up.
+func IdempotencyKeyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if key := c.GetHeader(IdempotencyKeyHeader); key != "" {
+			c.Request = c.Request.WithContext(services.WithIdempotencyKey(c.Request.Context(), key))
+		}
+		c.Next()
+	}
+}
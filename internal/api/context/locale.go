@@ -10,10 +10,13 @@ import (
 const GinContextKeyLocalizer = "localizer"
 
 // LocaleMiddleware parses Accept-Language header and stores Localizer in both contexts
+// Requests without Accept-Language use the configured default locale
 func LocaleMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		lang := c.GetHeader("Accept-Language")
-		locale := i18npkg.ParseLocale(lang)
+		locale := i18npkg.DefaultLocale()
+		if lang := c.GetHeader("Accept-Language"); lang != "" {
+			locale = i18npkg.ParseLocale(lang)
+		}
 		localizer := i18npkg.NewLocalizer(locale)
 
 		// 1. Store in Gin context (for handlers)
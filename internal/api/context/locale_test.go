@@ -72,6 +72,35 @@ func TestLocaleMiddleware(t *testing.T) {
 	}
 }
 
+func TestLocaleMiddleware_DefaultLocale(t *testing.T) {
+	i18npkg.Init()
+	i18npkg.SetDefaultLocale("zh-CN")
+	t.Cleanup(func() { i18npkg.SetDefaultLocale("") })
+
+	gin.SetMode(gin.TestMode)
+
+	t.Run("no header uses default locale", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request, _ = http.NewRequest("GET", "/test", nil)
+
+		LocaleMiddleware()(c)
+
+		locale, _ := c.Get("locale")
+		assert.Equal(t, "zh-CN", locale)
+	})
+
+	t.Run("header overrides default locale", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request, _ = http.NewRequest("GET", "/test", nil)
+		c.Request.Header.Set("Accept-Language", "en-US")
+
+		LocaleMiddleware()(c)
+
+		locale, _ := c.Get("locale")
+		assert.Equal(t, "en", locale)
+	})
+}
+
 func TestGetLocalizer(t *testing.T) {
 	i18npkg.Init()
 
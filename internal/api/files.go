@@ -0,0 +1,212 @@
+package api
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/rclone/rclone/lib/http/serve"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// filesLog returns a named logger for the api.files package.
+func filesLog() *zap.Logger {
+	return logger.Named("api.files")
+}
+
+// fileHandler serves and stores single remote files, and archives of remote directories, of a connection over plain HTTP.
+type fileHandler struct {
+	connService *services.ConnectionService
+	runner      ports.Runner
+	cfg         *config.Config
+	archives    *archiveJobs
+}
+
+// registerFileRoutes registers the remote file routes under /connections/:id and the archive job routes under /archives/:id.
+// Archives are built below the current data directory returned by dataDir.
+func registerFileRoutes(router *gin.RouterGroup, connService *services.ConnectionService, runner ports.Runner, cfg *config.Config, dataDir func() string) {
+	h := &fileHandler{connService: connService, runner: runner, cfg: cfg, archives: newArchiveJobs(cfg, dataDir)}
+	group := router.Group("/connections/:id")
+	{
+		group.GET("/download", h.download)
+		group.HEAD("/download", h.download)
+		group.PUT("/upload", h.upload)
+		group.GET("/archive", h.archive)
+	}
+	router.GET("/archives/:id", h.archiveStatus)
+	router.GET("/archives/:id/download", h.archiveDownload)
+}
+
+// download streams the remote file at the "path" query parameter.
+// Range requests are supported, so large files can be spot-checked partially.
+func (h *fileHandler) download(c *gin.Context) {
+	conn, remotePath, ok := h.resolveTarget(c)
+	if !ok {
+		return
+	}
+	serveRemoteFile(c, conn, remotePath, c.GetString(gin.AuthUserKey))
+}
+
+// serveRemoteFile streams the remote file at remotePath of the connection, logging who downloads it.
+func serveRemoteFile(c *gin.Context, conn *ent.Connection, remotePath, user string) {
+	dir, name := splitRemotePath(remotePath)
+	f, err := rclone.GetFs(c.Request.Context(), conn.Name, dir)
+	if err != nil {
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrConnectionFailed).WithCause(err))
+		return
+	}
+
+	obj, err := f.NewObject(c.Request.Context(), name)
+	if err != nil {
+		if errors.Is(err, fs.ErrorObjectNotFound) || errors.Is(err, fs.ErrorIsDir) || errors.Is(err, fs.ErrorNotAFile) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrPathNotExist).WithCause(err))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrConnectionFailed).WithCause(err))
+		return
+	}
+
+	filesLog().Info("Downloading remote file",
+		zap.String("connection", conn.Name),
+		zap.String("path", remotePath),
+		zap.String("user", user),
+	)
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	serve.Object(c.Writer, c.Request, obj)
+}
+
+// upload streams the request body to the remote file at the "path" query parameter, replacing any existing file.
+// Uploads are limited to app.upload.max_size bytes and, unless app.upload.require_auth is disabled,
+// only accepted while authentication is enabled, and refused in maintenance mode. Every upload attempt is audit logged.
+func (h *fileHandler) upload(c *gin.Context) {
+	if h.runner.IsMaintenance() {
+		_ = c.Error(i18n.NewI18nError(i18n.ErrMaintenanceMode).WithStatus(http.StatusServiceUnavailable))
+		return
+	}
+	maxSize := h.cfg.App.Upload.MaxSize
+	if maxSize <= 0 {
+		_ = c.Error(i18n.NewI18nError(i18n.ErrUploadDisabled).WithStatus(http.StatusForbidden))
+		return
+	}
+	if h.cfg.App.Upload.RequireAuth && !h.cfg.IsAuthEnabled() {
+		_ = c.Error(i18n.NewI18nError(i18n.ErrUploadRequiresAuth).WithStatus(http.StatusForbidden))
+		return
+	}
+
+	conn, remotePath, ok := h.resolveTarget(c)
+	if !ok {
+		return
+	}
+
+	log := filesLog().With(
+		zap.String("connection", conn.Name),
+		zap.String("path", remotePath),
+		zap.String("user", c.GetString(gin.AuthUserKey)),
+		zap.String("client_ip", c.ClientIP()),
+	)
+
+	tooLarge := func() {
+		log.Warn("Rejected remote file upload exceeding size limit", zap.Int64("max_size", maxSize))
+		_ = c.Error(i18n.NewI18nErrorWithData(i18n.ErrUploadTooLarge, map[string]interface{}{"Limit": maxSize}).
+			WithStatus(http.StatusRequestEntityTooLarge))
+	}
+	size := c.Request.ContentLength
+	if size > maxSize {
+		tooLarge()
+		return
+	}
+
+	dir, name := splitRemotePath(remotePath)
+	f, err := rclone.GetFs(c.Request.Context(), conn.Name, dir)
+	if err != nil {
+		log.Warn("Remote file upload failed", zap.Error(err))
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrConnectionFailed).WithCause(err))
+		return
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxSize)
+	var obj fs.Object
+	if size >= 0 {
+		obj, err = operations.RcatSize(c.Request.Context(), f, name, body, size, time.Now(), nil)
+	} else {
+		obj, err = operations.Rcat(c.Request.Context(), f, name, body, time.Now(), nil)
+	}
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			tooLarge()
+			return
+		}
+		log.Warn("Remote file upload failed", zap.Error(err))
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrConnectionFailed).WithCause(err))
+		return
+	}
+
+	log.Info("Uploaded remote file", zap.Int64("size", obj.Size()))
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"path":    remotePath,
+		"size":    obj.Size(),
+	})
+}
+
+// resolveTarget loads the connection from the :id parameter and normalizes the "path" query parameter.
+// On failure the error is attached to the context and ok is false.
+func (h *fileHandler) resolveTarget(c *gin.Context) (conn *ent.Connection, remotePath string, ok bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrInvalidIDFormat).WithCause(err))
+		return nil, "", false
+	}
+
+	remotePath = cleanRemotePath(c.Query("path"))
+	if remotePath == "" {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrMissingParameter))
+		return nil, "", false
+	}
+
+	conn, err = h.connService.GetConnectionByID(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrConnectionNotFound).WithCause(err))
+		return nil, "", false
+	}
+	return conn, remotePath, true
+}
+
+// cleanRemotePath normalizes a file path on the remote, using the same path syntax as task remote paths.
+// It returns an empty string when p does not name a file.
+func cleanRemotePath(p string) string {
+	if p == "" {
+		return ""
+	}
+	p = path.Clean(p)
+	if p == "." || p == "/" {
+		return ""
+	}
+	return p
+}
+
+// splitRemotePath splits a cleaned remote file path into the Fs root and the file name.
+func splitRemotePath(p string) (dir, name string) {
+	dir, name = path.Split(p)
+	if dir != "/" {
+		dir = strings.TrimSuffix(dir, "/")
+	}
+	return dir, name
+}
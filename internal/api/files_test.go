@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// fileRunner is a runner that only reports whether maintenance mode is enabled.
+type fileRunner struct {
+	ports.Runner
+	maintenance bool
+}
+
+func (r *fileRunner) IsMaintenance() bool { return r.maintenance }
+
+// setupFileRoutes creates a router serving the file routes and a local connection to test against.
+func setupFileRoutes(t *testing.T, cfg *config.Config) (*gin.Engine, uuid.UUID) {
+	t.Helper()
+	return setupFileRoutesWithRunner(t, cfg, &fileRunner{})
+}
+
+// setupFileRoutesWithRunner is setupFileRoutes with the runner reporting maintenance mode.
+func setupFileRoutesWithRunner(t *testing.T, cfg *config.Config, runner ports.Runner) (*gin.Engine, uuid.UUID) {
+	t.Helper()
+	require.NoError(t, i18n.Init())
+
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	t.Cleanup(func() { client.Close() })
+
+	encryptor, err := crypto.NewEncryptor("")
+	require.NoError(t, err)
+	connService := services.NewConnectionService(client, encryptor)
+	rclone.NewDBStorage(connService).Install()
+
+	conn, err := connService.CreateConnection(context.Background(), "files-"+uuid.NewString()[:8], "local", map[string]string{})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(apicontext.LocaleMiddleware())
+	router.Use(apicontext.I18nErrorMiddleware())
+	registerFileRoutes(router.Group("/api"), connService, runner, cfg, func() string { return cfg.App.DataDir })
+
+	return router, conn.ID
+}
+
+func doFileRequest(router *gin.Engine, method string, connID uuid.UUID, remotePath string, header http.Header) *httptest.ResponseRecorder {
+	target := "/api/connections/" + connID.String() + "/download?path=" + url.QueryEscape(remotePath)
+	req := httptest.NewRequest(method, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func errorCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body struct {
+		Code string `json:"code"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body.Code
+}
+
+func TestFileRoutes_Download(t *testing.T) {
+	router, connID := setupFileRoutes(t, &config.Config{})
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "report.txt")
+	require.NoError(t, os.WriteFile(file, []byte("hello remote world"), 0644))
+
+	t.Run("full file", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodGet, connID, file, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "hello remote world", w.Body.String())
+		assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `filename=report.txt`)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodGet, connID, file, http.Header{"Range": {"bytes=6-11"}})
+		require.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "remote", w.Body.String())
+		assert.Equal(t, "bytes 6-11/18", w.Header().Get("Content-Range"))
+	})
+
+	t.Run("head", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodHead, connID, file, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "18", w.Header().Get("Content-Length"))
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodGet, connID, filepath.Join(dir, "missing.txt"), nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrPathNotExist, errorCode(t, w))
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodGet, connID, dir, nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodGet, connID, "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, i18n.ErrMissingParameter, errorCode(t, w))
+	})
+
+	t.Run("unknown connection", func(t *testing.T) {
+		w := doFileRequest(router, http.MethodGet, uuid.New(), file, nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrConnectionNotFound, errorCode(t, w))
+	})
+}
+
+func doUpload(router *gin.Engine, connID uuid.UUID, remotePath string, body io.Reader, size int64) *httptest.ResponseRecorder {
+	target := "/api/connections/" + connID.String() + "/upload?path=" + url.QueryEscape(remotePath)
+	req := httptest.NewRequest(http.MethodPut, target, body)
+	req.ContentLength = size
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestFileRoutes_Upload(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.App.Upload.MaxSize = 16
+	runner := &fileRunner{}
+	router, connID := setupFileRoutesWithRunner(t, cfg, runner)
+	dir := t.TempDir()
+
+	t.Run("refused in maintenance mode", func(t *testing.T) {
+		runner.maintenance = true
+		defer func() { runner.maintenance = false }()
+
+		target := filepath.Join(dir, "maintenance.conf")
+		w := doUpload(router, connID, target, strings.NewReader("x"), 1)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, i18n.ErrMaintenanceMode, errorCode(t, w))
+		assert.NoFileExists(t, target)
+	})
+
+	t.Run("requires auth by default", func(t *testing.T) {
+		cfg.App.Upload.RequireAuth = true
+		defer func() { cfg.App.Upload.RequireAuth = false }()
+
+		w := doUpload(router, connID, filepath.Join(dir, "a.conf"), strings.NewReader("x"), 1)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, i18n.ErrUploadRequiresAuth, errorCode(t, w))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.App.Upload.MaxSize = 0
+		defer func() { cfg.App.Upload.MaxSize = 16 }()
+
+		w := doUpload(router, connID, filepath.Join(dir, "a.conf"), strings.NewReader("x"), 1)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, i18n.ErrUploadDisabled, errorCode(t, w))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		target := filepath.Join(dir, "sub", "app.conf")
+		w := doUpload(router, connID, target, strings.NewReader("key=value"), 9)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		data, err := os.ReadFile(target)
+		require.NoError(t, err)
+		assert.Equal(t, "key=value", string(data))
+	})
+
+	t.Run("unknown length", func(t *testing.T) {
+		target := filepath.Join(dir, "stream.conf")
+		w := doUpload(router, connID, target, strings.NewReader("streamed"), -1)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		data, err := os.ReadFile(target)
+		require.NoError(t, err)
+		assert.Equal(t, "streamed", string(data))
+	})
+
+	t.Run("declared size too large", func(t *testing.T) {
+		target := filepath.Join(dir, "big.conf")
+		w := doUpload(router, connID, target, strings.NewReader(strings.Repeat("x", 17)), 17)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Equal(t, i18n.ErrUploadTooLarge, errorCode(t, w))
+		assert.NoFileExists(t, target)
+	})
+
+	t.Run("streamed size too large", func(t *testing.T) {
+		target := filepath.Join(dir, "big-stream.conf")
+		w := doUpload(router, connID, target, strings.NewReader(strings.Repeat("x", 64)), -1)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Equal(t, i18n.ErrUploadTooLarge, errorCode(t, w))
+		assert.NoFileExists(t, target)
+	})
+}
+
+func TestCleanRemotePath(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"/", ""},
+		{".", ""},
+		{"a/b/../c.txt", "a/c.txt"},
+		{"/data/file.txt", "/data/file.txt"},
+		{"bucket/dir/", "bucket/dir"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, cleanRemotePath(tt.in), tt.in)
+	}
+}
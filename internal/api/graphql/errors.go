@@ -2,8 +2,10 @@ package graphql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime/debug"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -28,9 +30,36 @@ func errorsLog() *zap.Logger {
 }
 
 // ErrorPresenter translates I18nError to localized GraphQL errors.
+// ValidationError additionally lists every invalid field in the "fields" extension,
+// so that forms can highlight the offending inputs.
 func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
 	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
 
+	var validationErr *i18n.ValidationError
+	if errors.As(err, &validationErr) {
+		localizer := i18n.LocalizerFromContext(ctx)
+
+		fields := make([]map[string]any, len(validationErr.Fields))
+		messages := make([]string, len(validationErr.Fields))
+		for i, f := range validationErr.Fields {
+			messages[i] = f.Translate(localizer)
+			fields[i] = map[string]any{
+				"field":   f.Field,
+				"code":    f.MsgID,
+				"message": messages[i],
+			}
+		}
+
+		// Clients that only show the message still see what is wrong
+		gqlErr.Message = i18n.T(localizer, i18n.ErrValidationFailed) + ": " + strings.Join(messages, "; ")
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = make(map[string]any)
+		}
+		gqlErr.Extensions["code"] = i18n.ErrValidationFailed
+		gqlErr.Extensions["fields"] = fields
+		return gqlErr
+	}
+
 	// Check if it's an I18nError
 	if i18nErr, ok := i18n.IsI18nError(err); ok {
 		// Get localizer from context
@@ -100,6 +100,31 @@ func TestErrorPresenter_NilExtensions(t *testing.T) {
 	assert.Equal(t, "test.key", result.Extensions["code"])
 }
 
+func TestErrorPresenter_ValidationError(t *testing.T) {
+	err := i18n.Init()
+	require.NoError(t, err)
+
+	ctx := i18n.WithLocalizer(context.Background(), i18n.NewLocalizer("en"))
+
+	validationErr := i18n.NewValidationError()
+	validationErr.Add("name", i18n.ErrMissingParameter, nil)
+	validationErr.Add("options.transfers", i18n.ErrTransfersOutOfRange, map[string]interface{}{"Value": 100})
+
+	result := graphql.ErrorPresenter(ctx, validationErr.Err())
+
+	require.NotNil(t, result)
+	assert.Equal(t, "Validation failed: Missing required parameter; Transfers must be between 1 and 64, got 100", result.Message)
+	assert.Equal(t, i18n.ErrValidationFailed, result.Extensions["code"])
+
+	fields, ok := result.Extensions["fields"].([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "name", fields[0]["field"])
+	assert.Equal(t, i18n.ErrMissingParameter, fields[0]["code"])
+	assert.Equal(t, "options.transfers", fields[1]["field"])
+	assert.Equal(t, "Transfers must be between 1 and 64, got 100", fields[1]["message"])
+}
+
 func TestErrorPresenter_PreservesGQLErrorPath(t *testing.T) {
 	ctx := context.Background()
 
@@ -2,6 +2,7 @@
 package graphql
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/vektah/gqlparser/v2/ast"
 
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/resolver"
 )
@@ -44,6 +46,11 @@ func NewHandler(deps *resolver.Dependencies) *handler.Server {
 			WriteBufferSize: 1024,
 		},
 		KeepAlivePingInterval: 10 * time.Second,
+		// Authenticate before any subscription starts, see apicontext.AuthenticateConnectionParams
+		InitFunc: func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			ctx, err := apicontext.AuthenticateConnectionParams(ctx, payload)
+			return ctx, nil, err
+		},
 	})
 
 	// Enable query caching
@@ -57,6 +64,12 @@ func NewHandler(deps *resolver.Dependencies) *handler.Server {
 	// Add logging extension
 	srv.Use(NewLoggingExtension())
 
+	// Record spans of operations and resolvers
+	srv.Use(NewTracingExtension())
+
+	// Reject mutations while in maintenance mode
+	srv.Use(NewMaintenanceExtension(deps.Runner))
+
 	// Enable introspection (for development)
 	srv.Use(extension.Introspection{})
 
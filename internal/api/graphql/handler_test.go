@@ -9,12 +9,17 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/resolver"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
 	"github.com/xzzpig/rclone-sync/internal/core/crypto"
@@ -94,6 +99,9 @@ func (m *mockWatcher) Start()                          {}
 func (m *mockWatcher) Stop()                           {}
 func (m *mockWatcher) AddTask(task *ent.Task) error    { return nil }
 func (m *mockWatcher) RemoveTask(task *ent.Task) error { return nil }
+func (m *mockWatcher) WatchWarning(taskID uuid.UUID) *model.WatchWarning {
+	return nil
+}
 
 var _ ports.Watcher = (*mockWatcher)(nil)
 
@@ -104,6 +112,10 @@ func (m *mockScheduler) Start()                          {}
 func (m *mockScheduler) Stop()                           {}
 func (m *mockScheduler) AddTask(task *ent.Task) error    { return nil }
 func (m *mockScheduler) RemoveTask(task *ent.Task) error { return nil }
+func (m *mockScheduler) Pause()                          {}
+func (m *mockScheduler) Resume()                         {}
+func (m *mockScheduler) IsPaused() bool                  { return false }
+func (m *mockScheduler) ScheduledTaskCount() int         { return 0 }
 
 var _ ports.Scheduler = (*mockScheduler)(nil)
 
@@ -353,3 +365,36 @@ func TestHandler_PaginationQuery(t *testing.T) {
 	assert.False(t, data.Task.List.PageInfo.HasNextPage)
 	assert.False(t, data.Task.List.PageInfo.HasPreviousPage)
 }
+
+func TestHandler_Tracing(t *testing.T) {
+	original := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	router, _, cleanup := setupHandlerTest(t)
+	defer cleanup()
+
+	resp := executeGraphQL(t, router, GraphQLRequest{
+		Query:         `query ListTasks { task { list { totalCount } } }`,
+		OperationName: "ListTasks",
+	})
+	assert.Empty(t, resp.Errors)
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	operation, ok := spans["GraphQL query ListTasks"]
+	require.True(t, ok, "operation span is recorded")
+	root, ok := spans["Query.task"]
+	require.True(t, ok, "root resolver span is recorded")
+	list, ok := spans["TaskQuery.list"]
+	require.True(t, ok, "nested resolver span is recorded")
+	assert.Equal(t, operation.SpanContext().SpanID(), root.Parent().SpanID())
+	assert.Equal(t, root.SpanContext().SpanID(), list.Parent().SpanID())
+	assert.NotContains(t, spans, "TaskConnection.totalCount", "struct fields are not traced")
+
+	// All spans of the operation belong to the same trace
+	assert.Equal(t, operation.SpanContext().TraceID(), list.SpanContext().TraceID())
+}
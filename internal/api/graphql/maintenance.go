@@ -0,0 +1,51 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// maintenanceField is the root mutation field that stays available in maintenance mode,
+// so maintenance mode can be disabled again.
+const maintenanceField = "maintenance"
+
+// MaintenanceExtension rejects mutations while the runner is in maintenance mode.
+// Queries and subscriptions are not affected.
+type MaintenanceExtension struct {
+	Runner ports.Runner
+}
+
+// NewMaintenanceExtension creates a new maintenance extension.
+func NewMaintenanceExtension(runner ports.Runner) *MaintenanceExtension {
+	return &MaintenanceExtension{Runner: runner}
+}
+
+// ExtensionName returns the extension name.
+func (e *MaintenanceExtension) ExtensionName() string {
+	return "MaintenanceExtension"
+}
+
+// Validate validates the extension configuration.
+func (e *MaintenanceExtension) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptRootField rejects root mutation fields other than maintenance while maintenance mode is enabled.
+func (e *MaintenanceExtension) InterceptRootField(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	fc := graphql.GetRootFieldContext(ctx)
+	if fc != nil && fc.Object == "Mutation" && fc.Field.Name != maintenanceField &&
+		e.Runner != nil && e.Runner.IsMaintenance() {
+		graphql.AddError(ctx, i18n.NewI18nError(i18n.ErrMaintenanceMode))
+		return graphql.Null
+	}
+	return next(ctx)
+}
+
+var (
+	_ graphql.HandlerExtension     = (*MaintenanceExtension)(nil)
+	_ graphql.RootFieldInterceptor = (*MaintenanceExtension)(nil)
+)
@@ -33,3 +33,33 @@ func (LogAction) Values() []string {
 func (SyncDirection) Values() []string {
 	return toStrings(AllSyncDirection)
 }
+
+// Values returns all valid values for ConnectionType enum.
+func (ConnectionType) Values() []string {
+	return toStrings(AllConnectionType)
+}
+
+// Values returns all valid values for ConnectionHealthStatus enum.
+func (ConnectionHealthStatus) Values() []string {
+	return toStrings(AllConnectionHealthStatus)
+}
+
+// Values returns all valid values for TaskEventType enum.
+func (TaskEventType) Values() []string {
+	return toStrings(AllTaskEventType)
+}
+
+// Values returns all valid values for TransferErrorClass enum.
+func (TransferErrorClass) Values() []string {
+	return toStrings(AllTransferErrorClass)
+}
+
+// Values returns all valid values for JobEventType enum.
+func (JobEventType) Values() []string {
+	return toStrings(AllJobEventType)
+}
+
+// Values returns all valid values for ShareScope enum.
+func (ShareScope) Values() []string {
+	return toStrings(AllShareScope)
+}
@@ -22,6 +22,93 @@ type TestConnectionResult interface {
 	IsTestConnectionResult()
 }
 
+// 临时同步输入（参数与创建任务相同，但不保存任务，也不支持调度和实时同步）
+type AdhocSyncInput struct {
+	// 临时任务名称（显示在作业中），默认为 "Ad-hoc: <sourcePath>"
+	Name *string `json:"name,omitempty"`
+	// 本地源路径
+	SourcePath string `json:"sourcePath"`
+	// 关联连接 ID
+	ConnectionID uuid.UUID `json:"connectionId"`
+	// 远程目标路径
+	RemotePath string `json:"remotePath"`
+	// 同步方向
+	Direction SyncDirection `json:"direction"`
+	// 同步选项
+	Options *TaskSyncOptionsInput `json:"options,omitempty"`
+	// 同步引擎名称（必须是已注册的引擎，见 task.engines）
+	Engine *string `json:"engine,omitempty"`
+}
+
+// 快照恢复结果
+type BackupRestoreResult struct {
+	// 恢复的快照 ID
+	SnapshotID string `json:"snapshotId"`
+	// 恢复到的本地目录
+	TargetPath string `json:"targetPath"`
+	// 恢复的文件数
+	RestoredFiles int `json:"restoredFiles"`
+	// 恢复的字节数
+	RestoredBytes int64 `json:"restoredBytes"`
+	// 目标目录中已存在且大小和修改时间一致而跳过的文件数
+	SkippedFiles int `json:"skippedFiles"`
+}
+
+// 备份快照（备份任务的一次时间点备份）
+type BackupSnapshot struct {
+	// 快照 ID（UTC 时间戳，如 20261017T074405.123Z）
+	ID string `json:"id"`
+	// 备份时间
+	Time time.Time `json:"time"`
+	// 文件数
+	FileCount int `json:"fileCount"`
+	// 文件总大小（去重前）
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// 缓存变更命名空间
+type CacheMutation struct {
+	// 清除缓存的远程文件系统实例，返回清除的条目数
+	// 指定 connectionId 时仅清除该连接的缓存，否则清除全部缓存
+	Clear int `json:"clear"`
+}
+
+// 缓存查询命名空间
+type CacheQuery struct {
+	// 列出当前缓存的远程文件系统实例
+	Entries []*FsCacheEntry `json:"entries"`
+}
+
+// 连接解密配置缓存的统计（自启动以来累计）
+type ConfigCacheStats struct {
+	// 命中缓存的读取次数
+	Hits int64 `json:"hits"`
+	// 需要解密的读取次数
+	Misses int64 `json:"misses"`
+	// 命中率（0-1），没有读取时为 0
+	HitRate float64 `json:"hitRate"`
+	// 当前缓存的连接数
+	Entries int `json:"entries"`
+}
+
+// 无法解密配置的连接
+type ConfigIntegrityFailure struct {
+	// 连接
+	Connection *Connection `json:"connection"`
+	// 解密错误（通常为加密密钥错误或配置数据损坏）
+	Error string `json:"error"`
+}
+
+// 连接加密配置的完整性检查结果
+type ConfigIntegrityReport struct {
+	// 检查时间
+	CheckedAt time.Time `json:"checkedAt"`
+	// 检查的连接数
+	ConnectionCount int `json:"connectionCount"`
+	// 无法解密配置的连接（按名称排序）
+	Failures []*ConfigIntegrityFailure `json:"failures"`
+}
+
 // 远程存储连接
 type Connection struct {
 	// UUID 主键
@@ -29,13 +116,41 @@ type Connection struct {
 	// 连接名称（系统唯一）
 	Name string `json:"name"`
 	// 提供者类型（如 onedrive, s3, drive）
-	Type string `json:"type"`
+	Type ConnectionType `json:"type"`
 	// 配置参数（解密后，需要解密处理）
 	Config map[string]string `json:"config"`
-	// 加载状态（运行时状态）
+	// 加载状态（运行时状态，最近一次完整性检查无法解密配置时为 ERROR，见 system.integrity）
 	LoadStatus ConnectionLoadStatus `json:"loadStatus"`
 	// 加载错误信息（运行时状态，仅当 loadStatus 为 ERROR 时有值）
 	LoadError *string `json:"loadError,omitempty"`
+	// 健康状态（最近一次连接测试的结果，从未测试时为空）
+	HealthStatus *ConnectionHealthStatus `json:"healthStatus,omitempty"`
+	// 最近一次连接测试的时间
+	HealthCheckedAt *time.Time `json:"healthCheckedAt,omitempty"`
+	// 最近一次连接测试的错误信息（仅 UNHEALTHY 时有值）
+	HealthError *string `json:"healthError,omitempty"`
+	// 凭据过期时间（根据配置中的 OAuth 令牌计算，刷新令牌会过期的提供者如 onedrive 长期未使用时将过期，不会过期时为 null）
+	CredentialsExpireAt *time.Time `json:"credentialsExpireAt,omitempty"`
+	// 凭据是否将在 app.credentials.warning_days 天内过期（或已过期）
+	CredentialsExpiringSoon bool `json:"credentialsExpiringSoon"`
+	// 远程路径前缀（会拼接到此连接下所有任务的 remotePath 之前，为空表示不使用前缀）
+	BasePath *string `json:"basePath,omitempty"`
+	// 每个远程路径的 API 调用速率上限（每秒事务数），未设置时为 null
+	// 后端按远程路径分别限速，同步该连接不同路径的作业各自享有完整的速率，而不是共享同一上限
+	// 映射到后端的 pacer_min_sleep，仅支持可配置限速的提供者（如 drive、dropbox、webdav）
+	PathTpsLimit *float64 `json:"pathTpsLimit,omitempty"`
+	// 每个远程路径的 API 调用突发数（允许短时间内超出速率上限的事务数），映射到后端的 pacer_burst，未设置时为 null
+	PathTpsBurst *int `json:"pathTpsBurst,omitempty"`
+	// 显示名称（界面中代替连接名称显示，未设置时为 null）
+	DisplayName *string `json:"displayName,omitempty"`
+	// 显示颜色（#rrggbb 格式，未设置时为 null）
+	Color *string `json:"color,omitempty"`
+	// 图标名称（小写字母、数字和连字符，未设置时为 null）
+	Icon *string `json:"icon,omitempty"`
+	// 每个自然月的传输量上限（字节），超过后该连接的新作业将以 QUOTA_DEFERRED 状态推迟，未设置时为 null
+	MonthlyTransferCap *int64 `json:"monthlyTransferCap,omitempty"`
+	// 配置版本（每次修改名称、配置或远程路径前缀后递增）
+	ConfigVersion int `json:"configVersion"`
 	// 创建时间
 	CreatedAt time.Time `json:"createdAt"`
 	// 更新时间
@@ -44,6 +159,22 @@ type Connection struct {
 	Tasks *TaskConnection `json:"tasks"`
 	// 配额信息（调用 rclone about API）
 	Quota *ConnectionQuota `json:"quota,omitempty"`
+	// 用量预测（基于每日配额采样的增长速度估算剩余空间可用天数，采样少于两天时为 null）
+	Forecast *ConnectionForecast `json:"forecast,omitempty"`
+	// 本月传输量（本地时间的自然月）
+	TransferUsage *ConnectionTransferUsage `json:"transferUsage"`
+	// 最近几个自然月的传输量（按月份升序，不含没有传输的月份），最多 120 个月
+	TransferHistory []*ConnectionTransferUsage `json:"transferHistory"`
+}
+
+// 单项能力检测结果
+type ConnectionCapabilityResult struct {
+	// 检测的能力
+	Capability ConnectionCapability `json:"capability"`
+	// 检测状态
+	Status CapabilityStatus `json:"status"`
+	// 失败原因（仅 FAILED 时有值）
+	Error *string `json:"error,omitempty"`
 }
 
 // 连接分页连接
@@ -56,26 +187,76 @@ type ConnectionConnection struct {
 	PageInfo *OffsetPageInfo `json:"pageInfo"`
 }
 
+// 连接用量预测
+// 每天按 app.usage.sample_schedule 记录一次连接的配额，用最近 app.usage.forecast_days 天采样的线性增长速度估算
+type ConnectionForecast struct {
+	// 已使用空间的平均每日增长（字节/天），用量减少时为负数
+	DailyGrowth int64 `json:"dailyGrowth"`
+	// 最近一次采样的可用空间（字节），存储既不提供可用空间也不提供总空间时为 null
+	Free *int64 `json:"free,omitempty"`
+	// 按当前增长速度用满可用空间的天数，用量未增长或可用空间未知时为 null
+	DaysUntilFull *int `json:"daysUntilFull,omitempty"`
+	// 预计用满的日期
+	FullAt *time.Time `json:"fullAt,omitempty"`
+	// 预测所用的采样天数
+	SampleCount int `json:"sampleCount"`
+	// 预测所用的第一个采样的日期
+	Since time.Time `json:"since"`
+	// 剩余天数是否低于告警阈值 app.usage.warning_days
+	Warning bool `json:"warning"`
+}
+
 // 连接变更命名空间
 type ConnectionMutation struct {
 	// 创建连接（失败抛出 GraphQL error）
+	// idempotencyKey（或请求头 Idempotency-Key）相同的重复提交在 24 小时内返回首次的结果，不会重复创建连接
 	Create *Connection `json:"create"`
 	// 更新连接（失败抛出 GraphQL error）
 	Update *Connection `json:"update"`
 	// 删除连接（失败抛出 GraphQL error）
 	Delete *Connection `json:"delete"`
 	// 测试已保存的连接（测试失败是预期业务结果，用 union 表示）
+	// remotePath 可选，设置后会额外检测该路径的读写能力
 	Test TestConnectionResult `json:"test"`
 	// 测试未保存的连接配置（测试失败是预期业务结果，用 union 表示）
 	TestUnsaved TestConnectionResult `json:"testUnsaved"`
+	// 并发测试所有已保存的连接（并发数有上限），并更新各连接的健康状态
+	TestAll *ConnectionTestReport `json:"testAll"`
+	// 将任务迁移到另一个连接（如凭据需要在新的连接下重新创建时），无需重新创建任务
+	// 运行中的任务会被跳过；每个迁移的任务记录一条 TASK_UPDATED 任务事件
+	MigrateTasks *MigrateTasksReport `json:"migrateTasks"`
+}
+
+// 连接预设：针对特定服务（如 Cloudflare R2）预先填好的提供者配置
+type ConnectionPreset struct {
+	// 预设名称（如 cloudflare-r2）
+	Name string `json:"name"`
+	// 描述
+	Description string `json:"description"`
+	// 预设配置的提供者类型（如 s3）
+	Type string `json:"type"`
+	// 默认配置，可被连接配置覆盖
+	Defaults map[string]string `json:"defaults"`
+	// 固定配置，不可被连接配置覆盖
+	Fixed map[string]string `json:"fixed"`
+	// 未设置 endpoint 时根据 region 生成 endpoint 的模板，{region} 会被替换为 region
+	EndpointTemplate *string `json:"endpointTemplate,omitempty"`
+	// 应用预设后必须填写的配置项
+	Required []string `json:"required"`
 }
 
 // 连接查询命名空间
 type ConnectionQuery struct {
-	// 获取连接列表
+	// 获取连接列表，默认按创建时间倒序
 	List *ConnectionConnection `json:"list"`
 	// 获取单个连接
 	Get *Connection `json:"get,omitempty"`
+	// 导出连接为 rclone.conf 内容，未指定 ids 时导出全部连接
+	// 设置 password 时使用 rclone 配置加密格式导出，rclone 读取时需要输入该密码
+	Export string `json:"export"`
+	// 列出媒体类连接（如 Google Photos）的相册及其远程路径，先列出自己的相册，再列出共享相册
+	// 媒体类连接的目录是按相册、年份等组织的虚拟目录，任务应同步某个相册而非连接根目录
+	MediaAlbums []*MediaAlbum `json:"mediaAlbums"`
 }
 
 // 连接配额信息
@@ -102,22 +283,102 @@ type ConnectionTestFailure struct {
 
 func (ConnectionTestFailure) IsTestConnectionResult() {}
 
+// 批量连接测试报告
+type ConnectionTestReport struct {
+	// 测试的连接总数
+	Total int `json:"total"`
+	// 健康的连接数
+	Healthy int `json:"healthy"`
+	// 不健康的连接数
+	Unhealthy int `json:"unhealthy"`
+	// 各连接的测试结果（按连接名称排序）
+	Results []*ConnectionTestReportItem `json:"results"`
+}
+
+// 批量连接测试中单个连接的结果
+type ConnectionTestReportItem struct {
+	// 被测试的连接（包含更新后的健康状态）
+	Connection *Connection `json:"connection"`
+	// 测试结果
+	Result TestConnectionResult `json:"result"`
+}
+
 // 连接测试成功
 type ConnectionTestSuccess struct {
 	// 成功消息（已本地化）
 	Message string `json:"message"`
+	// 路径能力检测结果（未指定 remotePath 时为空列表）
+	Capabilities []*ConnectionCapabilityResult `json:"capabilities"`
 }
 
 func (ConnectionTestSuccess) IsTestConnectionResult() {}
 
+// 连接在一个自然月内的传输量
+type ConnectionTransferUsage struct {
+	// 月份（本地时间该月第一天的零点）
+	Month time.Time `json:"month"`
+	// 该月所有作业传输的字节数（分片作业计入其父作业）
+	Bytes int64 `json:"bytes"`
+	// 连接当前的每月传输量上限，未设置时为 null
+	Cap *int64 `json:"cap,omitempty"`
+	// 传输量是否已达上限
+	Exceeded bool `json:"exceeded"`
+}
+
+// 连接的 Fs 缓存预热进度
+type ConnectionWarmup struct {
+	// 连接
+	Connection *Connection `json:"connection"`
+	// 预热状态
+	State WarmupState `json:"state"`
+	// 需要预热的远程路径数（连接的已启用任务的远程路径，去重）
+	Paths int `json:"paths"`
+	// 已预热的远程路径数
+	ReadyPaths int `json:"readyPaths"`
+	// 第一个预热失败的错误
+	Error *string `json:"error,omitempty"`
+}
+
 // 创建连接输入
 type CreateConnectionInput struct {
 	// 连接名称
 	Name string `json:"name"`
 	// 提供者类型
-	Type string `json:"type"`
+	Type ConnectionType `json:"type"`
 	// 配置参数
 	Config map[string]string `json:"config"`
+	// 远程路径前缀（可选）
+	BasePath *string `json:"basePath,omitempty"`
+	// 每个远程路径的 API 调用速率上限（每秒事务数，可选）
+	PathTpsLimit *float64 `json:"pathTpsLimit,omitempty"`
+	// 每个远程路径的 API 调用突发数（可选）
+	PathTpsBurst *int `json:"pathTpsBurst,omitempty"`
+	// 每月传输量上限（字节，可选）
+	MonthlyTransferCap *int64 `json:"monthlyTransferCap,omitempty"`
+	// 显示名称（可选）
+	DisplayName *string `json:"displayName,omitempty"`
+	// 显示颜色（#rrggbb 格式，可选）
+	Color *string `json:"color,omitempty"`
+	// 图标名称（可选）
+	Icon *string `json:"icon,omitempty"`
+	// 连接预设名称（可选），会填入预设的配置并校验其必填项
+	Preset *string `json:"preset,omitempty"`
+}
+
+// 创建分享令牌输入
+type CreateShareTokenInput struct {
+	// 名称（例如分享对象）
+	Name string `json:"name"`
+	// 访问范围
+	Scope ShareScope `json:"scope"`
+	// 可访问的任务 ID（scope 为 TASK 时必填）
+	TaskID *uuid.UUID `json:"taskId,omitempty"`
+	// 文件所在的连接 ID（scope 为 DOWNLOAD 时必填）
+	ConnectionID *uuid.UUID `json:"connectionId,omitempty"`
+	// 可下载的远程文件路径（scope 为 DOWNLOAD 时必填）
+	Path *string `json:"path,omitempty"`
+	// 过期时间，必须在未来 90 天以内
+	ExpiresAt time.Time `json:"expiresAt"`
 }
 
 // 创建任务输入
@@ -132,12 +393,127 @@ type CreateTaskInput struct {
 	RemotePath string `json:"remotePath"`
 	// 同步方向
 	Direction SyncDirection `json:"direction"`
-	// Cron 调度表达式
+	// 调度：Cron 表达式或 RRULE（iCalendar）规则
 	Schedule *string `json:"schedule,omitempty"`
 	// 是否启用实时同步
 	Realtime *bool `json:"realtime,omitempty"`
 	// 同步选项
 	Options *TaskSyncOptionsInput `json:"options,omitempty"`
+	// 同步引擎名称（必须是已注册的引擎，见 task.engines）
+	Engine *string `json:"engine,omitempty"`
+	// 标签列表 - 首尾空白会被去除，空标签和重复标签会被忽略；更新时设置后替换原有标签
+	Tags []string `json:"tags,omitempty"`
+	// 创建后立即运行一次（手动触发），首个作业与任务一同创建并通过 Task.firstJob 返回；
+	// 任务无法运行时（如已开启维护模式）不会创建任务
+	RunImmediately *bool `json:"runImmediately,omitempty"`
+}
+
+// 新建的分享令牌
+type CreatedShareToken struct {
+	// 令牌信息
+	ShareToken *ShareToken `json:"shareToken"`
+	// 令牌明文，仅在创建时返回一次，服务端只保存其哈希
+	Token string `json:"token"`
+	// 分享链接（相对路径 /api/share/<token>）
+	URL string `json:"url"`
+}
+
+// 数据目录迁移结果
+type DataDirRelocation struct {
+	// 新的数据目录（绝对路径）
+	DataDir string `json:"dataDir"`
+	// 迁移的文件数
+	MovedFiles int `json:"movedFiles"`
+	// 迁移的字节数
+	MovedBytes int64 `json:"movedBytes"`
+	// 配置文件是否已更新
+	// 未使用配置文件或 app.data_dir 由环境变量指定时为 false，需手动修改配置，否则重启后将使用旧目录
+	ConfigUpdated bool `json:"configUpdated"`
+}
+
+// 数据库优化结果（VACUUM/ANALYZE）
+type DatabaseOptimization struct {
+	// 开始时间
+	StartedAt time.Time `json:"startedAt"`
+	// 耗时（毫秒）
+	DurationMs int64 `json:"durationMs"`
+	// 优化前的数据库大小（字节）
+	SizeBefore int64 `json:"sizeBefore"`
+	// 优化后的数据库大小（字节）
+	SizeAfter int64 `json:"sizeAfter"`
+	// 回收的空间（字节），数据库变大时为负数
+	ReclaimedBytes int64 `json:"reclaimedBytes"`
+}
+
+// 演示数据（用于在未配置真实远程的情况下体验界面）
+// 包含名为 demo 的本地连接、带过滤规则的示例任务及一个已完成的模拟作业，均位于数据目录的 demo 子目录下
+type DemoData struct {
+	// 演示连接（本地到本地）
+	Connection *Connection `json:"connection"`
+	// 示例任务
+	Task *Task `json:"task"`
+	// 示例任务的最新作业（作业被删除后为 null）
+	Job *Job `json:"job,omitempty"`
+}
+
+// 演示数据变更命名空间
+type DemoMutation struct {
+	// 创建演示数据，已存在时直接返回现有数据
+	// 已存在非演示数据的同名连接 demo 时报错
+	Seed *DemoData `json:"seed"`
+	// 删除演示数据（连接、任务、作业、日志及演示目录）
+	// 返回是否存在被删除的演示数据
+	Remove bool `json:"remove"`
+}
+
+// 演示数据查询命名空间
+type DemoQuery struct {
+	// 获取演示数据，未创建时为 null
+	Data *DemoData `json:"data,omitempty"`
+}
+
+// 重复文件
+type DuplicateFile struct {
+	// 文件路径（相对于扫描路径）
+	Path string `json:"path"`
+	// 文件大小（字节）
+	Size int64 `json:"size"`
+	// 修改时间
+	ModTime time.Time `json:"modTime"`
+	// 按清理规则是否保留（未指定清理规则时均为 true）
+	Keep bool `json:"keep"`
+	// 是否已被删除
+	Deleted bool `json:"deleted"`
+	// 删除失败原因
+	Error *string `json:"error,omitempty"`
+}
+
+// 一组内容相同的重复文件
+type DuplicateGroup struct {
+	// 匹配键（哈希值，或 大小/文件名）
+	Key string `json:"key"`
+	// 单个文件大小（字节）
+	Size int64 `json:"size"`
+	// 组内文件列表（保留的文件排在最前）
+	Files []*DuplicateFile `json:"files"`
+}
+
+// 重复文件扫描报告
+type DuplicateReport struct {
+	// 实际使用的匹配方式
+	Mode DuplicateMatchMode `json:"mode"`
+	// 使用的哈希类型（仅 HASH 模式）
+	HashType *string `json:"hashType,omitempty"`
+	// 扫描的文件总数
+	ScannedFiles int `json:"scannedFiles"`
+	// 重复文件组列表
+	Groups []*DuplicateGroup `json:"groups"`
+	// 多余的重复文件数（每组除一个以外的文件数之和）
+	DuplicateFiles int `json:"duplicateFiles"`
+	// 清理多余文件后可释放的字节数
+	ReclaimableBytes int64 `json:"reclaimableBytes"`
+	// 已删除的文件数（dryRun 时为 0）
+	DeletedFiles int `json:"deletedFiles"`
 }
 
 // 文件/目录条目
@@ -158,12 +534,95 @@ type FileQuery struct {
 	List []*FileEntry `json:"list"`
 }
 
+// 过滤规则建议 - 根据任务最近作业的文件日志，建议排除高变动、低价值的路径
+type FilterSuggestion struct {
+	// rclone filter 规则（如 "- node_modules/**"），可直接追加到任务的 filters 中
+	Rule string `json:"rule"`
+	// 类别
+	Category FilterSuggestionCategory `json:"category"`
+	// 匹配的不同路径数
+	Paths int `json:"paths"`
+	// 匹配路径被传输或删除的次数
+	Changes int `json:"changes"`
+	// 估计可节省的传输字节数（匹配路径被传输的字节数之和）
+	Bytes int64 `json:"bytes"`
+	// 匹配路径示例（按字母顺序，最多 5 条）
+	Examples []string `json:"examples"`
+}
+
+// 过滤规则建议的分析结果
+type FilterSuggestions struct {
+	// 分析的作业数
+	JobCount int `json:"jobCount"`
+	// 分析的作业中文件被传输或删除的总次数
+	Changes int `json:"changes"`
+	// 分析的作业传输的总字节数
+	Bytes int64 `json:"bytes"`
+	// 建议列表，按可节省的字节数降序；已在任务 filters 中的规则不会再建议
+	Suggestions []*FilterSuggestion `json:"suggestions"`
+}
+
+// 查找重复文件输入
+type FindDuplicatesInput struct {
+	// 要扫描的远程路径
+	Path string `json:"path"`
+	// 匹配方式（默认 HASH）
+	Mode *DuplicateMatchMode `json:"mode,omitempty"`
+	// 清理规则（为空时仅生成报告）
+	Keep *DuplicateKeepRule `json:"keep,omitempty"`
+	// 是否仅预览清理结果而不实际删除（默认 true）
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// 缓存的远程文件系统实例
+type FsCacheEntry struct {
+	// 所属连接 ID（连接已被删除时为空）
+	ConnectionID *uuid.UUID `json:"connectionId,omitempty"`
+	// 连接名称
+	ConnectionName string `json:"connectionName"`
+	// 远程路径
+	Path string `json:"path"`
+	// 创建时间
+	CreatedAt time.Time `json:"createdAt"`
+	// 最后使用时间
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	// 缓存存在时长（秒）
+	Age int `json:"age"`
+}
+
+// 任务组合并进度事件 - 汇总带有同一标签的任务的作业进度
+type GroupProgressEvent struct {
+	// 任务标签
+	Tag string `json:"tag"`
+	// 运行中（含等待确认）的作业数，为 0 时组内作业全部结束
+	RunningJobs int `json:"runningJobs"`
+	// 已传输文件数（各作业之和，下同）
+	FilesTransferred int `json:"filesTransferred"`
+	// 已传输字节数
+	BytesTransferred int64 `json:"bytesTransferred"`
+	// 总文件数
+	FilesTotal int `json:"filesTotal"`
+	// 总字节数
+	BytesTotal int64 `json:"bytesTotal"`
+	// 删除的文件数
+	FilesDeleted int `json:"filesDeleted"`
+	// 与目标比对过的文件数，包括无需传输的文件
+	FilesChecked int `json:"filesChecked"`
+	// 比对源和目标时列出的目录条目数
+	ListingsDone int `json:"listingsDone"`
+	// 错误数量
+	ErrorCount int `json:"errorCount"`
+	// 按任务细分的进度 - 每个任务最近一个作业的最新进度事件，按作业开始时间排序
+	// 已结束的作业保留在列表中并计入合计，直到该任务再次运行
+	Jobs []*JobProgressEvent `json:"jobs"`
+}
+
 // 导入连接输入
 type ImportConnectionInput struct {
 	// 连接名称
 	Name string `json:"name"`
 	// 提供者类型
-	Type string `json:"type"`
+	Type ConnectionType `json:"type"`
 	// 配置参数
 	Config map[string]string `json:"config"`
 }
@@ -208,6 +667,8 @@ func (ImportParseError) IsImportParseResult() {}
 type ImportParseInput struct {
 	// rclone 配置文件内容
 	Content string `json:"content"`
+	// rclone 配置密码，用于解密加密的配置文件（rclone config encryption），未加密的配置文件忽略此项
+	Password *string `json:"password,omitempty"`
 }
 
 // 导入解析成功
@@ -234,19 +695,116 @@ type Job struct {
 	FilesTransferred int `json:"filesTransferred"`
 	// 已传输字节数
 	BytesTransferred int64 `json:"bytesTransferred"`
+	// 已上传文件数（本地 -> 远程）
+	UploadedFiles int `json:"uploadedFiles"`
+	// 已上传字节数（本地 -> 远程）
+	UploadedBytes int64 `json:"uploadedBytes"`
+	// 已下载文件数（远程 -> 本地）
+	DownloadedFiles int `json:"downloadedFiles"`
+	// 已下载字节数（远程 -> 本地）
+	DownloadedBytes int64 `json:"downloadedBytes"`
 	// 删除的文件数
 	FilesDeleted int `json:"filesDeleted"`
+	// 与目标比对过的文件数，包括无需传输的文件
+	FilesChecked int `json:"filesChecked"`
+	// 比对源和目标时列出的目录条目数
+	ListingsDone int `json:"listingsDone"`
 	// 错误数量
 	ErrorCount int `json:"errorCount"`
 	// 错误信息
 	Errors *string `json:"errors,omitempty"`
+	// 用户备注（如 "远程服务当时宕机，可忽略"）
+	Note *string `json:"note,omitempty"`
+	// 是否已被用户确认（已确认的失败可视为已处理）
+	Acknowledged bool `json:"acknowledged"`
+	// 最近一次修改备注或确认状态的时间
+	AnnotatedAt *time.Time `json:"annotatedAt,omitempty"`
+	// 执行该作业的 OpenTelemetry trace ID（仅在启用追踪或由带 trace 的请求触发时有值）
+	TraceID *string `json:"traceId,omitempty"`
+	// 作业创建时所用连接的配置版本（对应 Connection.configVersion）
+	ConnectionConfigVersion *int `json:"connectionConfigVersion,omitempty"`
+	// 作业创建时任务的配置哈希（对应 Task.configHash）
+	TaskConfigHash *string `json:"taskConfigHash,omitempty"`
+	// 触发来源详情（如触发的 cron 表达式、文件事件、发起运行的用户），用于审计
+	TriggerDetail *JobTriggerDetail `json:"triggerDetail,omitempty"`
+	// 传输并发度序列（每次统计轮询时进行中的传输数），用于判断 transfers 设置是否被充分利用
+	// 作业结束后写入，运行中或没有传输的作业为 null；分片作业的序列记录在各子作业上
+	Concurrency *JobConcurrency `json:"concurrency,omitempty"`
+	// 作业实际使用的 rclone 配置快照（如 transfers、过滤规则、带宽限制、冲突策略、是否 resync），
+	// 用于对比失败与成功的运行之间的配置差异；作业开始传输前失败的作业为 null，分片作业的配置记录在父作业上
+	ConfigSnapshot *JobConfigSnapshot `json:"configSnapshot,omitempty"`
 	// 关联的任务（ent edge）
 	Task *Task `json:"task"`
+	// 父作业（仅分片和镜像子作业有值）
+	Parent *Job `json:"parent,omitempty"`
+	// 分片和镜像子作业列表
+	Children []*Job `json:"children"`
 	// 执行日志（分页查询）
 	Logs *JobLogConnection `json:"logs"`
 	// 运行时进度（仅 RUNNING 状态的 job 有值，其他状态返回 null）
 	Progress *JobProgressEvent `json:"progress,omitempty"`
-	TaskID   uuid.UUID         `json:"-"`
+	// 传输失败的文件（包括分片子作业中的失败文件），可通过 job.retryFailedFiles 单独重试
+	FailedFiles []*RetryQueueItem `json:"failedFiles"`
+	// 作业事件（如前置/后置钩子的执行结果），按发生时间排序
+	Events   []*JobEvent `json:"events"`
+	ParentID *uuid.UUID  `json:"-"`
+	TaskID   uuid.UUID   `json:"-"`
+}
+
+// 批量取消中单个作业的结果
+type JobCancellation struct {
+	// 作业（取消后的最新状态）
+	Job *Job `json:"job"`
+	// 取消结果
+	Outcome JobCancelOutcome `json:"outcome"`
+	// 取消失败的原因（outcome 为 FAILED 时）
+	Error *string `json:"error,omitempty"`
+}
+
+// 作业的传输并发度序列
+type JobConcurrency struct {
+	// 每个采样点覆盖的秒数（作业越长，相邻的采样点合并得越多，最多保留 120 个采样点）
+	Interval int `json:"interval"`
+	// 作业的并发传输数上限（transfers 设置）
+	Limit int `json:"limit"`
+	// 进行中的传输数峰值
+	Peak int `json:"peak"`
+	// 每个采样区间内进行中传输数的平均值，按时间顺序
+	Samples []float64 `json:"samples"`
+}
+
+// 作业运行时实际生效的配置，即任务选项经过默认值和回退（如 trackRenames 不受支持时被关闭）后的结果
+type JobConfigSnapshot struct {
+	// 同步方向
+	Direction SyncDirection `json:"direction"`
+	// 并发传输数（任务设置、全局默认值或内置默认值）
+	Transfers int `json:"transfers"`
+	// rclone 的带宽限制（--bwlimit 格式），不限速时为 null
+	BwLimit *string `json:"bwLimit,omitempty"`
+	// 实际应用的过滤规则，包括 windowsNames 跳过文件名时添加的规则
+	Filters []string `json:"filters"`
+	// 是否不删除目标端多余的文件
+	NoDelete bool `json:"noDelete"`
+	// 并行同步的分片数（1 表示未分片）
+	Shards int `json:"shards"`
+	// 最长运行时间（分钟），不限时为 null
+	MaxDurationMinutes *int `json:"maxDurationMinutes,omitempty"`
+	// 是否跟踪重命名
+	TrackRenames bool `json:"trackRenames"`
+	// 不兼容 Windows 的文件名的处理方式，未启用时为 null
+	WindowsNames *WindowsNameHandling `json:"windowsNames,omitempty"`
+	// 是否保留扩展元数据（权限、属主等）
+	PreserveMetadata bool `json:"preserveMetadata"`
+	// 是否跳过零字节文件
+	SkipZeroByteFiles bool `json:"skipZeroByteFiles"`
+	// 是否在目标端创建空目录
+	CreateEmptySrcDirs bool `json:"createEmptySrcDirs"`
+	// 删除确认阈值，未启用时为 null
+	ConfirmDeletesOver *int `json:"confirmDeletesOver,omitempty"`
+	// 冲突解决策略（仅双向同步有值）
+	ConflictResolution *ConflictResolution `json:"conflictResolution,omitempty"`
+	// 是否执行了 resync，即缺少上次的文件列表而重新建立基线（仅双向同步有值）
+	Resync *bool `json:"resync,omitempty"`
 }
 
 // 作业分页连接
@@ -259,6 +817,56 @@ type JobConnection struct {
 	PageInfo *OffsetPageInfo `json:"pageInfo"`
 }
 
+// 某一天开始的作业汇总，用于按天展示作业历史
+type JobDay struct {
+	// 日期（服务器本地时区当天零点）
+	Date time.Time `json:"date"`
+	// 作业数量
+	JobCount int `json:"jobCount"`
+	// 成功完成的作业数量
+	SuccessCount int `json:"successCount"`
+	// 已完成但有错误的作业数量
+	WarningCount int `json:"warningCount"`
+	// 失败的作业数量（包括超时）
+	FailedCount int `json:"failedCount"`
+	// 已取消的作业数量
+	CancelledCount int `json:"cancelledCount"`
+	// 等待执行或执行中的作业数量
+	RunningCount int `json:"runningCount"`
+	// 传输文件总数
+	FilesTransferred int `json:"filesTransferred"`
+	// 传输字节总数
+	BytesTransferred int64 `json:"bytesTransferred"`
+	// 错误总数
+	ErrorCount int `json:"errorCount"`
+	// 当天的作业 ID，按开始时间倒序
+	JobIds []uuid.UUID `json:"jobIds"`
+}
+
+// 作业事件（同步之外作为作业一部分执行的操作，如任务钩子）
+type JobEvent struct {
+	// UUID 主键
+	ID uuid.UUID `json:"id"`
+	// 事件类型
+	Type JobEventType `json:"type"`
+	// 执行的命令
+	Command string `json:"command"`
+	// 命令参数
+	Args []string `json:"args,omitempty"`
+	// 退出码（命令未能启动或因超时被终止时为 null）
+	ExitCode *int `json:"exitCode,omitempty"`
+	// 运行时长（毫秒）
+	DurationMs int64 `json:"durationMs"`
+	// 标准输出与标准错误的合并内容，超过 app.hooks.max_output 字节的部分被截断
+	Output string `json:"output"`
+	// 输出是否被截断
+	OutputTruncated bool `json:"outputTruncated"`
+	// 失败原因（成功时为 null）
+	Error *string `json:"error,omitempty"`
+	// 发生时间
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // 作业日志条目
 type JobLog struct {
 	// 自增主键
@@ -269,6 +877,8 @@ type JobLog struct {
 	Time time.Time `json:"time"`
 	// 文件路径
 	Path string `json:"path"`
+	// 重命名前的文件路径（仅 RENAME 日志）
+	PreviousPath *string `json:"previousPath,omitempty"`
 	// 操作类型
 	What LogAction `json:"what"`
 	// 文件大小（字节）
@@ -288,10 +898,30 @@ type JobLogConnection struct {
 	PageInfo *OffsetPageInfo `json:"pageInfo"`
 }
 
+// 作业变更命名空间
+type JobMutation struct {
+	// 为作业添加备注或确认标记（失败抛出 GraphQL error）
+	// note 为 null 时保持不变，为空字符串时清除备注；acknowledged 为 null 时保持不变
+	Annotate *Job `json:"annotate"`
+	// 仅重试作业中传输失败且尚未重试的文件（创建并启动一个 RETRY 作业，失败抛出 GraphQL error）
+	// idempotencyKey（或请求头 Idempotency-Key）相同的重复提交在 24 小时内返回首次的结果，不会重复启动作业
+	RetryFailedFiles *Job `json:"retryFailedFiles"`
+	// 确认等待中作业的删除并继续同步（作业不在 WAITING_CONFIRMATION 状态时抛出 GraphQL error）
+	Confirm *Job `json:"confirm"`
+	// 中止等待确认的作业，不进行同步，作业标记为 CANCELLED（作业不在 WAITING_CONFIRMATION 状态时抛出 GraphQL error）
+	Abort *Job `json:"abort"`
+	// 取消所有等待执行、执行中或等待确认的作业，可按任务和连接过滤，返回每个作业的结果
+	// 运行中的作业一起取消并等待其结束；没有对应运行（例如进程异常后残留）的作业直接标记为 CANCELLED
+	// 按连接过滤时也包括上传到该连接的镜像作业，取消镜像作业会一并取消其任务的本次运行
+	CancelAll []*JobCancellation `json:"cancelAll"`
+}
+
 // 作业进度事件
 type JobProgressEvent struct {
 	// 作业 ID
 	JobID uuid.UUID `json:"jobId"`
+	// 父作业 ID，仅镜像作业有值（其 taskId 为父作业的任务）
+	ParentID *uuid.UUID `json:"parentId,omitempty"`
 	// 关联的任务 ID
 	TaskID uuid.UUID `json:"taskId"`
 	// 关联的连接 ID
@@ -302,12 +932,24 @@ type JobProgressEvent struct {
 	FilesTransferred int `json:"filesTransferred"`
 	// 已传输字节数
 	BytesTransferred int64 `json:"bytesTransferred"`
+	// 已上传文件数（本地 -> 远程）
+	UploadedFiles int `json:"uploadedFiles"`
+	// 已上传字节数（本地 -> 远程）
+	UploadedBytes int64 `json:"uploadedBytes"`
+	// 已下载文件数（远程 -> 本地）
+	DownloadedFiles int `json:"downloadedFiles"`
+	// 已下载字节数（远程 -> 本地）
+	DownloadedBytes int64 `json:"downloadedBytes"`
 	// 总文件数（队列+已完成+进行中），会随扫描动态增加
 	FilesTotal int `json:"filesTotal"`
 	// 总字节数（队列大小+已传输+正在传输的总大小）
 	BytesTotal int64 `json:"bytesTotal"`
 	// 删除的文件数
 	FilesDeleted int `json:"filesDeleted"`
+	// 与目标比对过的文件数，包括无需传输的文件
+	FilesChecked int `json:"filesChecked"`
+	// 比对源和目标时列出的目录条目数
+	ListingsDone int `json:"listingsDone"`
 	// 错误数量
 	ErrorCount int `json:"errorCount"`
 	// 开始时间
@@ -320,18 +962,179 @@ type JobProgressEvent struct {
 type JobQuery struct {
 	// 获取作业列表
 	List *JobConnection `json:"list"`
+	// 按天分组的作业汇总（包括今天在内的最近 days 天，按日期升序，不包含没有作业的日期）
+	ByDay []*JobDay `json:"byDay"`
 	// 获取单个作业
 	Get *Job `json:"get,omitempty"`
 	// 获取作业进度
 	Progress *JobProgressEvent `json:"progress,omitempty"`
 }
 
+// 作业的触发来源详情，仅与触发方式相关的字段有值
+type JobTriggerDetail struct {
+	// 触发运行的 cron 表达式或 RRULE 规则（SCHEDULE）
+	Schedule *string `json:"schedule,omitempty"`
+	// 触发运行的一批文件事件的数量（REALTIME）
+	EventCount *int `json:"eventCount,omitempty"`
+	// 该批文件事件中的文件路径，相对于任务的源路径，最多记录 20 条（REALTIME）
+	EventPaths []string `json:"eventPaths,omitempty"`
+	// 发起运行的用户（MANUAL、RETRY，仅在启用认证时有值）
+	User *string `json:"user,omitempty"`
+	// 被重试失败文件的作业（RETRY）
+	SourceJobID *uuid.UUID `json:"sourceJobId,omitempty"`
+	// 超时后自动续跑的次数（由超时续跑启动的运行有值）
+	Continuation *int `json:"continuation,omitempty"`
+	// 因崩溃或异常退出被中断、由本次运行在启动时补跑的作业（仅启用 resumeAfterCrash 的任务）
+	ResumedJobID *uuid.UUID `json:"resumedJobId,omitempty"`
+	// 镜像子作业上传到的镜像连接（镜像子作业有值）
+	MirrorConnectionID *uuid.UUID `json:"mirrorConnectionId,omitempty"`
+}
+
+// 批量删除日志的过滤条件，未设置的条件匹配所有日志，至少需要设置一个条件
+type LogDeleteFilter struct {
+	// 仅删除该任务的日志
+	TaskID *uuid.UUID `json:"taskId,omitempty"`
+	// 仅删除该作业的日志
+	JobID *uuid.UUID `json:"jobId,omitempty"`
+	// 仅删除该级别的日志
+	Level *LogLevel `json:"level,omitempty"`
+	// 仅删除早于该时间的日志
+	Before *time.Time `json:"before,omitempty"`
+}
+
+// 批量删除日志的进度事件，每删除一批日志推送一次，删除结束（包括失败）时推送 done 为 true 的事件
+type LogDeleteProgressEvent struct {
+	// 删除操作的 ID
+	OperationID string `json:"operationId"`
+	// 已删除的日志数
+	Deleted int `json:"deleted"`
+	// 开始删除时匹配过滤条件的日志数
+	Total int `json:"total"`
+	// 删除是否已结束
+	Done bool `json:"done"`
+}
+
+// 批量删除日志的结果
+type LogDeleteResult struct {
+	// 删除操作的 ID，与 logDeleteProgress 订阅中的 operationId 对应
+	OperationID string `json:"operationId"`
+	// 开始删除时匹配过滤条件的日志数
+	Matched int `json:"matched"`
+	// 实际删除的日志数
+	Deleted int `json:"deleted"`
+}
+
+// 作业日志批量写入的统计（自启动以来累计，所有作业共享）
+type LogFlushStats struct {
+	// 成功的批量写入次数
+	Flushes int64 `json:"flushes"`
+	// 失败的批量写入次数
+	FailedFlushes int64 `json:"failedFlushes"`
+	// 已写入的日志条数
+	FlushedLogs int64 `json:"flushedLogs"`
+	// 因缓冲区溢出而丢弃的日志条数
+	DroppedLogs int64 `json:"droppedLogs"`
+	// 最近一次成功写入的日志条数
+	LastFlushSize int `json:"lastFlushSize"`
+	// 最近一次成功写入的耗时（毫秒）
+	LastFlushDurationMs int64 `json:"lastFlushDurationMs"`
+	// 最近一次成功写入的时间，尚未写入时为 null
+	LastFlushAt *time.Time `json:"lastFlushAt,omitempty"`
+}
+
+// 日志变更命名空间
+type LogMutation struct {
+	// 按条件分批删除日志（每批 1000 条），用于快速清理某个任务的大量历史日志，作为定期清理之外的补充
+	// operationId 用于通过 logDeleteProgress 订阅删除进度，未提供时自动生成；过滤条件全部为空时抛出 GraphQL error
+	Delete *LogDeleteResult `json:"delete"`
+}
+
 // 日志查询命名空间
 type LogQuery struct {
 	// 获取日志列表
 	List *JobLogConnection `json:"list"`
 }
 
+// 维护模式变更命名空间（维护期间仍可调用）
+type MaintenanceMutation struct {
+	// 进入维护模式
+	// cancelRunning 为 true 时取消所有正在运行的任务并等待其结束，否则等待其自然完成
+	Enable *MaintenanceStatus `json:"enable"`
+	// 退出维护模式
+	Disable *MaintenanceStatus `json:"disable"`
+	// 将数据目录（bisync 状态）迁移到新路径并更新配置文件
+	// 需先进入维护模式且没有正在运行的任务；所有文件复制并校验通过后才会切换到新目录并删除旧文件
+	// 新路径不能位于当前数据目录内或包含当前数据目录；存在演示数据时需先通过 demo.remove 删除，后台打包的临时压缩包留在旧目录直到过期
+	RelocateDataDir *DataDirRelocation `json:"relocateDataDir"`
+	// 重新计算派生字段（分片父作业的汇总统计）并报告不一致项，适用于从备份恢复数据库后
+	// 任务的最新作业为查询时实时计算，无需重建
+	// dryRun 为 true 时仅报告不修正
+	Reindex *ReindexReport `json:"reindex"`
+	// 立即优化数据库（VACUUM 回收已删除数据占用的空间，ANALYZE 更新查询统计信息）并报告回收的空间
+	// 优化期间数据库写入会被阻塞，因此有任务正在运行时拒绝执行；也可通过 database.optimize_schedule 在空闲时段定期执行
+	OptimizeDatabase *DatabaseOptimization `json:"optimizeDatabase"`
+}
+
+// 维护模式查询命名空间
+type MaintenanceQuery struct {
+	// 获取维护模式状态
+	Status *MaintenanceStatus `json:"status"`
+	// 获取自启动以来最近一次数据库优化的结果，未优化过时为 null
+	LastDatabaseOptimization *DatabaseOptimization `json:"lastDatabaseOptimization,omitempty"`
+}
+
+// 维护模式状态
+type MaintenanceStatus struct {
+	// 是否处于维护模式（维护期间所有变更和作业启动将被拒绝，查询和订阅不受影响）
+	Enabled bool `json:"enabled"`
+	// 当前正在运行的任务数量（为 0 时可安全执行数据库迁移）
+	RunningTaskCount int `json:"runningTaskCount"`
+	// 当前数据目录（存放 bisync 状态等数据）
+	DataDir string `json:"dataDir"`
+}
+
+// 媒体类连接（如 Google Photos）的相册
+type MediaAlbum struct {
+	// 相册标题
+	Title string `json:"title"`
+	// 相册的远程路径（如 album/旅行），可直接用作任务的 remotePath
+	RemotePath string `json:"remotePath"`
+	// 是否为共享相册（位于 shared-album/ 下）
+	Shared bool `json:"shared"`
+}
+
+// 迁移任务的选项
+type MigrateTasksOptions struct {
+	// 要迁移的任务 ID（须属于源连接），为空时迁移源连接的所有任务
+	TaskIds []uuid.UUID `json:"taskIds,omitempty"`
+	// 是否重置双向同步任务的同步状态
+	// 默认将上次的文件列表迁移到目标连接名下，下次运行不必 resync（任务的远程路径在两个连接下相同时才能找到）；
+	// 为 true 时删除，下次运行将执行 resync，适用于目标连接的数据与源连接不同时
+	ResetBisyncState *bool `json:"resetBisyncState,omitempty"`
+}
+
+// 任务迁移报告
+type MigrateTasksReport struct {
+	// 已迁移的任务数
+	Migrated int `json:"migrated"`
+	// 未迁移的任务数
+	Skipped int `json:"skipped"`
+	// 各任务的结果（按任务名称排序）
+	Items []*MigrateTasksReportItem `json:"items"`
+}
+
+// 任务迁移报告中单个任务的结果
+type MigrateTasksReportItem struct {
+	// 任务（已迁移时指向目标连接）
+	Task *Task `json:"task"`
+	// 是否已迁移
+	Migrated bool `json:"migrated"`
+	// 未迁移的原因（如任务正在运行，或任务的方向、镜像目标不适用于目标连接）
+	Error *string `json:"error,omitempty"`
+	// 迁移（或重置时删除）的双向同步状态文件数
+	StateFiles int `json:"stateFiles"`
+}
+
 type Mutation struct {
 }
 
@@ -415,14 +1218,176 @@ type ProviderQuery struct {
 	List []*Provider `json:"list"`
 	// 获取单个提供者的配置选项
 	Get *Provider `json:"get,omitempty"`
+	// 获取所有连接预设
+	Presets []*ConnectionPreset `json:"presets"`
 }
 
 type Query struct {
 }
 
+// 派生字段不一致项
+type ReindexDiscrepancy struct {
+	// 分片父作业 ID
+	JobID uuid.UUID `json:"jobId"`
+	// 字段名（数据库列名，如 files_transferred）
+	Field string `json:"field"`
+	// 数据库中存储的值
+	Stored int64 `json:"stored"`
+	// 由子作业重新计算得到的值
+	Computed int64 `json:"computed"`
+}
+
+// 派生字段重建结果
+type ReindexReport struct {
+	// 检查的已结束分片父作业数
+	CheckedJobs int `json:"checkedJobs"`
+	// 发现的不一致项
+	Discrepancies []*ReindexDiscrepancy `json:"discrepancies"`
+	// 已修正的作业数（dryRun 时为 0）
+	FixedJobs int `json:"fixedJobs"`
+}
+
+// 重试队列条目（作业中传输失败的单个文件）
+type RetryQueueItem struct {
+	// UUID 主键
+	ID uuid.UUID `json:"id"`
+	// 文件路径（相对于任务的源路径和远程路径）
+	Path string `json:"path"`
+	// 传输方向（UPLOAD 或 DOWNLOAD）
+	Direction SyncDirection `json:"direction"`
+	// 失败原因分类
+	ErrorClass TransferErrorClass `json:"errorClass"`
+	// 错误信息
+	Error string `json:"error"`
+	// 失败时间
+	CreatedAt time.Time `json:"createdAt"`
+	// 请求重试的时间（未请求时为 null）
+	RequestedAt *time.Time `json:"requestedAt,omitempty"`
+	// 重试该文件的作业 ID（尚未重试时为 null）
+	RetryJobID *uuid.UUID `json:"retryJobId,omitempty"`
+}
+
+// 调度器变更命名空间
+type SchedulerMutation struct {
+	// 暂停所有定时触发
+	Pause *SchedulerStatus `json:"pause"`
+	// 恢复所有定时触发
+	Resume *SchedulerStatus `json:"resume"`
+}
+
+// 调度器查询命名空间
+type SchedulerQuery struct {
+	// 获取调度器状态
+	Status *SchedulerStatus `json:"status"`
+}
+
+// 调度器状态
+type SchedulerStatus struct {
+	// 是否已暂停（暂停期间定时触发将被跳过，手动运行不受影响）
+	Paused bool `json:"paused"`
+	// 已注册的定时任务数量
+	ScheduledTaskCount int `json:"scheduledTaskCount"`
+}
+
+// 分享令牌（限时、限定范围的访问凭据，持有者无需登录即可通过 /api/share/<token> 访问）
+type ShareToken struct {
+	// UUID 主键
+	ID uuid.UUID `json:"id"`
+	// 名称（例如分享对象），仅用于管理
+	Name string `json:"name"`
+	// 访问范围
+	Scope ShareScope `json:"scope"`
+	// 可访问的任务（scope 为 TASK 时），任务被删除后令牌随之删除
+	Task *Task `json:"task,omitempty"`
+	// 可下载文件所在的连接（scope 为 DOWNLOAD 时）
+	Connection *Connection `json:"connection,omitempty"`
+	// 可下载的远程文件路径（scope 为 DOWNLOAD 时）
+	Path *string `json:"path,omitempty"`
+	// 过期时间
+	ExpiresAt time.Time `json:"expiresAt"`
+	// 是否已过期
+	Expired bool `json:"expired"`
+	// 最近一次使用时间
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// 使用次数
+	UseCount int `json:"useCount"`
+	// 创建时间
+	CreatedAt    time.Time  `json:"createdAt"`
+	ConnectionID *uuid.UUID `json:"-"`
+	TaskID       *uuid.UUID `json:"-"`
+}
+
+// 分享令牌分页连接
+type ShareTokenConnection struct {
+	// 令牌列表
+	Items []*ShareToken `json:"items"`
+	// 总数
+	TotalCount int `json:"totalCount"`
+	// 分页信息
+	PageInfo *OffsetPageInfo `json:"pageInfo"`
+}
+
+// 分享令牌变更命名空间
+type ShareTokenMutation struct {
+	// 创建分享令牌
+	Create *CreatedShareToken `json:"create"`
+	// 撤销（删除）分享令牌，链接立即失效
+	Revoke bool `json:"revoke"`
+}
+
+// 分享令牌查询命名空间
+type ShareTokenQuery struct {
+	// 分页获取分享令牌列表（按创建时间倒序，包含已过期的令牌）
+	List *ShareTokenConnection `json:"list"`
+}
+
 type Subscription struct {
 }
 
+// 临时同步变更命名空间
+type SyncMutation struct {
+	// 运行一次临时同步（创建并启动作业，失败抛出 GraphQL error）
+	// 作业关联到一个隐藏的临时任务（ephemeral 为 true），该任务不出现在任务列表中，也不会被调度或监听
+	// idempotencyKey（或请求头 Idempotency-Key）相同的重复提交在 24 小时内返回首次的结果，不会重复启动作业
+	RunAdhoc *Job `json:"runAdhoc"`
+}
+
+// 系统查询命名空间
+type SystemQuery struct {
+	// 获取版本信息及更新检查结果
+	Version *SystemVersion `json:"version"`
+	// 获取连接解密配置缓存的统计
+	ConfigCache *ConfigCacheStats `json:"configCache"`
+	// 获取作业日志批量写入的统计，可据此发现日志写入失败或被丢弃
+	LogFlush *LogFlushStats `json:"logFlush"`
+	// 获取连接加密配置的完整性检查结果（启动时及按 app.connection.integrity_check_schedule 定期检查）
+	// 尚未完成检查或 refresh 为 true 时立即检查
+	Integrity *ConfigIntegrityReport `json:"integrity"`
+	// 获取启动时 Fs 缓存的预热状态，界面可据此显示各连接是否就绪
+	WarmupStatus *WarmupStatus `json:"warmupStatus"`
+}
+
+// 版本信息
+type SystemVersion struct {
+	// 应用版本（如 v1.2.3，开发构建为 dev）
+	App string `json:"app"`
+	// 构建所用的 git 提交
+	Commit *string `json:"commit,omitempty"`
+	// 构建时间（RFC 3339）
+	BuildDate *string `json:"buildDate,omitempty"`
+	// 内嵌前端的版本（前端文件的哈希），未内嵌前端时为 null
+	// 与已加载页面的版本不同时，页面应刷新
+	UIVersion *string `json:"uiVersion,omitempty"`
+	// 是否有更新的版本（未启用 app.update_check 或尚未检查时为 false）
+	UpdateAvailable bool `json:"updateAvailable"`
+	// 最新发布的版本，尚未检查时为 null
+	LatestVersion *string `json:"latestVersion,omitempty"`
+	// 最新发布的页面地址
+	LatestReleaseURL *string `json:"latestReleaseUrl,omitempty"`
+	// 最近一次成功检查更新的时间
+	UpdateCheckedAt *time.Time `json:"updateCheckedAt,omitempty"`
+}
+
 // 同步任务
 type Task struct {
 	// UUID 主键
@@ -433,25 +1398,70 @@ type Task struct {
 	SourcePath string `json:"sourcePath"`
 	// 远程目标路径
 	RemotePath string `json:"remotePath"`
+	// 实际同步的远程路径（拼接连接的 basePath 后的结果）
+	ResolvedRemotePath string `json:"resolvedRemotePath"`
 	// 同步方向
 	Direction SyncDirection `json:"direction"`
-	// Cron 调度表达式
+	// 调度：Cron 表达式或 RRULE（iCalendar）规则
 	Schedule *string `json:"schedule,omitempty"`
 	// 是否启用实时同步
 	Realtime bool `json:"realtime"`
 	// 同步选项（JSON → 类型转换）
 	Options *TaskSyncOptions `json:"options,omitempty"`
+	// 执行任务的同步引擎名称（默认 rclone 镜像同步，backup 为带版本和去重的快照备份）
+	Engine string `json:"engine"`
+	// 标签列表 - 用于将任务分组，如通过 groupProgress 订阅一组任务的合并进度
+	Tags []string `json:"tags"`
 	// 创建时间
 	CreatedAt time.Time `json:"createdAt"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updatedAt"`
+	// 删除时间，未删除的任务为 null；已删除的任务在保留期内可通过 task.restore 恢复
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// 关联的远程连接（ent edge）
 	Connection *Connection `json:"connection"`
 	// 作业历史（分页查询）
 	Jobs *JobConnection `json:"jobs"`
 	// 最近一次作业（计算字段）
-	LatestJob    *Job      `json:"latestJob,omitempty"`
-	ConnectionID uuid.UUID `json:"-"`
+	LatestJob *Job `json:"latestJob,omitempty"`
+	// 因作业仍在运行而跳过的定时触发次数
+	SkippedRuns int `json:"skippedRuns"`
+	// 连续失败的运行次数（FAILED 或 FAILED_TIMEOUT），成功运行后清零，取消的运行不计入
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// 是否启用；已禁用的任务不会被定时调度或实时监听触发，仍可手动运行
+	Enabled bool `json:"enabled"`
+	// 自动禁用的原因（最后一次失败的错误），启用或由用户禁用的任务为 null
+	DisabledReason *string `json:"disabledReason,omitempty"`
+	// 是否为临时同步（sync.runAdhoc）创建的隐藏任务，此类任务不出现在任务列表中
+	Ephemeral bool `json:"ephemeral"`
+	// 任务事件（分页查询，按时间倒序）
+	Events *TaskEventConnection `json:"events"`
+	// 备份快照列表（按时间倒序），仅备份任务（engine 为 backup）有快照，其他任务返回空列表
+	Snapshots []*BackupSnapshot `json:"snapshots"`
+	// 任务生效配置的稳定哈希（源路径、连接及其 basePath、远程路径、方向、引擎和同步选项），名称和调度不计入
+	ConfigHash string `json:"configHash"`
+	// 自最近一次成功作业以来配置是否已变更（即存在尚未应用的配置修改），没有记录配置哈希的成功作业时为 null
+	ConfigChangedSinceLastRun *bool `json:"configChangedSinceLastRun,omitempty"`
+	// 本次更新修改的字段（仅在 task.update 的返回结果中有值，其他查询返回 null），可用于显示更新确认
+	Changes []*TaskChange `json:"changes,omitempty"`
+	// 创建时立即运行的首个作业（仅在设置了 runImmediately 的 task.create 返回结果中有值，其他查询及幂等重放返回 null）
+	FirstJob *Job `json:"firstJob,omitempty"`
+	// 实时监听的告警，未达到 inotify watch 上限或非实时任务时为 null
+	// 任务重新监听（如修改源路径或重启服务）后清除
+	WatchWarning *WatchWarning `json:"watchWarning,omitempty"`
+	// 任务在媒体类连接（如 Google Photos）上的限制，仅 DOWNLOAD 方向的任务有，其他任务返回空列表
+	MediaWarnings []MediaWarning `json:"mediaWarnings"`
+	ConnectionID  uuid.UUID      `json:"-"`
+}
+
+// 任务更新中一个字段的修改
+type TaskChange struct {
+	// 字段名（与 UpdateTaskInput 一致，同步选项为 options.<字段名>，如 options.transfers）
+	Field string `json:"field"`
+	// 修改前的值（字符串和数字按原样，列表和对象为 JSON），未设置时为 null
+	Old *string `json:"old,omitempty"`
+	// 修改后的值，格式同 old，清除时为 null
+	New *string `json:"new,omitempty"`
 }
 
 // 任务分页连接
@@ -464,24 +1474,136 @@ type TaskConnection struct {
 	PageInfo *OffsetPageInfo `json:"pageInfo"`
 }
 
+// 任务事件（作业之外发生的事情，如被跳过的定时触发）
+type TaskEvent struct {
+	// UUID 主键
+	ID uuid.UUID `json:"id"`
+	// 事件类型
+	Type TaskEventType `json:"type"`
+	// 事件说明
+	Message *string `json:"message,omitempty"`
+	// 发生时间
+	Time time.Time `json:"time"`
+}
+
+// 任务事件分页连接
+type TaskEventConnection struct {
+	// 事件列表
+	Items []*TaskEvent `json:"items"`
+	// 总数
+	TotalCount int `json:"totalCount"`
+	// 分页信息
+	PageInfo *OffsetPageInfo `json:"pageInfo"`
+}
+
+// 任务钩子 - 在同步前后执行的命令
+// 钩子在临时目录中运行，不继承服务的环境变量，仅获得 PATH 及 RCLONE_SYNC_TASK_ID、RCLONE_SYNC_TASK_NAME、
+// RCLONE_SYNC_JOB_ID、RCLONE_SYNC_SOURCE_PATH 等变量；超时（app.hooks.timeout）后被终止
+type TaskHook struct {
+	// 可执行文件的绝对路径
+	Command string `json:"command"`
+	// 命令参数
+	Args []string `json:"args,omitempty"`
+}
+
+// 任务钩子输入
+type TaskHookInput struct {
+	// 可执行文件的绝对路径
+	Command string `json:"command"`
+	// 命令参数
+	Args []string `json:"args,omitempty"`
+}
+
+// 任务的镜像目标 - 主同步成功后接收相同上传内容的另一个连接及路径
+type TaskMirror struct {
+	// 镜像目标的连接 ID
+	ConnectionID uuid.UUID `json:"connectionId"`
+	// 镜像目标连接上的远程路径，相对于连接的基础路径
+	RemotePath string `json:"remotePath"`
+}
+
+// 任务镜像目标输入
+type TaskMirrorInput struct {
+	// 镜像目标的连接 ID
+	ConnectionID uuid.UUID `json:"connectionId"`
+	// 镜像目标连接上的远程路径，不能与任务的远程路径或其他镜像目标相同
+	RemotePath string `json:"remotePath"`
+}
+
 // 任务变更命名空间
 type TaskMutation struct {
 	// 创建任务（失败抛出 GraphQL error）
+	// verifyRemotePath 为 true 时检查远程路径（拼接连接的 basePath 后）是否存在，不存在则返回字段校验错误；
+	// createRemotePath 为 true 时远程路径不存在会自动创建（隐含 verifyRemotePath）
+	// 创建中任一步骤失败（包括 runImmediately 的首次运行无法启动）时不会留下任务
+	// idempotencyKey（或请求头 Idempotency-Key）相同的重复提交在 24 小时内返回首次的结果，不会重复创建任务
 	Create *Task `json:"create"`
+	// 按目录批量创建任务：扫描 localRoot 的直接子目录，为每个子目录创建一个任务，
+	// 映射到 remoteRoot 下的同名目录。已存在相同源路径任务的子目录会被跳过。
+	// 返回新创建的任务列表（失败抛出 GraphQL error）
+	CreateFromDirectory []*Task `json:"createFromDirectory"`
 	// 更新任务（失败抛出 GraphQL error）
 	Update *Task `json:"update"`
 	// 删除任务（失败抛出 GraphQL error）
+	// 任务被软删除：停止调度和实时监听，不再出现在任务列表中，作业历史保留到配置的保留期 app.task.deleted_retention 结束后才被清除
 	Delete *Task `json:"delete"`
+	// 恢复保留期内已删除的任务，并重新启用其调度和实时监听（失败抛出 GraphQL error）
+	Restore *Task `json:"restore"`
 	// 运行任务（创建并启动作业，失败抛出 GraphQL error）
+	// idempotencyKey（或请求头 Idempotency-Key）相同的重复提交在 24 小时内返回首次的结果，不会重复启动作业
 	Run *Job `json:"run"`
+	// 将备份任务的快照恢复到本地目录 targetPath（失败抛出 GraphQL error）
+	// 目标目录中的同名文件会被覆盖，快照中不存在的文件保持不变；任务运行中时不能恢复
+	RestoreSnapshot *BackupRestoreResult `json:"restoreSnapshot"`
+}
+
+// 任务的附加同步路径 - 将另一个本地目录同步到任务远程路径下的子目录
+type TaskPath struct {
+	// 本地目录的绝对路径
+	SourcePath string `json:"sourcePath"`
+	// 相对于任务远程路径的子目录
+	RemoteSubpath string `json:"remoteSubpath"`
+}
+
+// 任务附加同步路径输入
+type TaskPathInput struct {
+	// 本地目录的绝对路径
+	SourcePath string `json:"sourcePath"`
+	// 相对于任务远程路径的子目录，不能为空、包含 ".." 或与其他附加路径重叠
+	RemoteSubpath string `json:"remoteSubpath"`
 }
 
 // 任务查询命名空间
 type TaskQuery struct {
-	// 获取任务列表
+	// 获取任务列表，默认按创建时间倒序
 	List *TaskConnection `json:"list"`
 	// 获取单个任务
 	Get *Task `json:"get,omitempty"`
+	// 按 ID 批量获取任务（最多 100 个），结果与 ids 顺序一致，不存在或已删除的任务对应 null
+	GetMany []*Task `json:"getMany"`
+	// 获取已删除（保留期内可恢复）的任务列表，按删除时间倒序
+	ListDeleted *TaskConnection `json:"listDeleted"`
+	// 已注册的同步引擎名称列表
+	Engines []string `json:"engines"`
+	// 获取任务最近 lastN 次运行（按开始时间升序，最多 100 次，分片子作业不计入）
+	// 比 job.list 更轻量，适合列表中为大量任务绘制迷你趋势图
+	RunHistory []*TaskRun `json:"runHistory"`
+	// 分析任务最近 lastN 次运行（最多 50 次）的文件日志，建议排除缓存、依赖目录、构建产物等高变动低价值路径的过滤规则
+	SuggestFilters *FilterSuggestions `json:"suggestFilters"`
+}
+
+// 任务运行记录（精简的作业信息，用于在任务列表中绘制迷你趋势图）
+type TaskRun struct {
+	// 作业 ID
+	JobID uuid.UUID `json:"jobId"`
+	// 作业状态
+	Status JobStatus `json:"status"`
+	// 开始时间
+	StartTime time.Time `json:"startTime"`
+	// 运行时长（毫秒），未结束时为 null
+	DurationMs *int64 `json:"durationMs,omitempty"`
+	// 传输的字节数
+	Bytes int64 `json:"bytes"`
 }
 
 // 任务同步选项
@@ -498,6 +1620,79 @@ type TaskSyncOptions struct {
 	// 并行传输数量 - 范围 1-64
 	// 为 null 时使用全局配置默认值
 	Transfers *int `json:"transfers,omitempty"`
+	// 分片并行数量 - 范围 1-16，仅单向同步有效
+	// 大于 1 时按顶层目录拆分为多个子作业并行执行，父作业汇总进度
+	Shards *int `json:"shards,omitempty"`
+	// 最长执行时间（分钟）- 超时后取消作业并标记为 FAILED_TIMEOUT，为空或 0 表示不限制
+	MaxDurationMinutes *int `json:"maxDurationMinutes,omitempty"`
+	// 超时后是否自动启动一次续传运行
+	ContinueOnTimeout *bool `json:"continueOnTimeout,omitempty"`
+	// 崩溃恢复 - 启用后，若服务重启前任务的作业因崩溃或异常退出被中断，启动时自动补跑一次
+	ResumeAfterCrash *bool `json:"resumeAfterCrash,omitempty"`
+	// 删除确认阈值 - 仅单向同步（非 noDelete）有效
+	// 一次运行将删除目标端超过 N 个文件时，作业暂停在 WAITING_CONFIRMATION 状态，
+	// 需通过 job.confirm 继续或 job.abort 中止；为空或 0 表示不需要确认
+	ConfirmDeletesOver *int `json:"confirmDeletesOver,omitempty"`
+	// 跟踪重命名 - 仅单向同步（非 noDelete）有效
+	// 启用后，源端重命名或移动的文件在目标端通过服务端移动完成，而不是重新上传
+	// 目标端不支持服务端移动/复制或两端没有共同哈希时忽略并在作业日志中给出警告
+	TrackRenames *bool `json:"trackRenames,omitempty"`
+	// Windows 文件名处理 - 用于同步到 Windows/SMB 等不接受 Windows 保留名称（如 aux.txt）和非法字符的远程端
+	// ENCODE 在目标端编码这些名称，SKIP 跳过这些文件；两种方式都跳过过长的路径
+	// 受影响的文件在作业日志中记录为 WARNING；为空时不做处理
+	WindowsNames *WindowsNameHandling `json:"windowsNames,omitempty"`
+	// 保留扩展元数据 - 除修改时间外，同时同步权限、属主等元数据（rclone --metadata），用于服务器迁移
+	// 仅两端后端均支持元数据时生效（如 local、s3；sftp 不支持写入元数据），目标端无法保存的元数据在传输前按文件记录为 WARNING 作业日志
+	PreserveMetadata *bool `json:"preserveMetadata,omitempty"`
+	// 实时监听忽略模式列表 - glob 语法，仅实时同步有效
+	// 不含 "/" 的模式匹配路径中的任意一级名称（如 "*.part"），含 "/" 的模式匹配相对源目录的完整路径
+	// 匹配的文件变更不会触发同步；为空时使用全局配置 app.watcher.ignore_patterns，设置后替换全局默认值
+	WatchIgnorePatterns []string `json:"watchIgnorePatterns,omitempty"`
+	// 实时监听排除目录列表 - 相对源目录的子目录路径（如 "cache"、"build/tmp"），仅实时同步有效
+	// 与忽略模式不同，这些目录及其下所有目录完全不被监听，不占用 inotify watch，也不产生事件
+	// 用于源目录中包含大量无需同步的文件的目录（如缓存目录）；不影响同步本身，需要时应另外配置过滤规则
+	WatchExcludeDirs []string `json:"watchExcludeDirs,omitempty"`
+	// 详细日志 - 启用后将检查、列举等操作记录为 DEBUG 级别的作业日志，用于排查单个任务的问题
+	// 会产生大量日志，排查完成后应关闭
+	VerboseLogging *bool `json:"verboseLogging,omitempty"`
+	// 跳过传输前的大小估算 - 仅单向同步（非分片）有效
+	// 默认在传输前列举两端并立即发布作业的总文件数和总字节数；对于列举代价很高的超大远程端可启用此项跳过
+	SkipSizing *bool `json:"skipSizing,omitempty"`
+	// 在目标端创建源端的空目录（单向和双向同步均有效）
+	// 未设置时单向同步创建空目录，双向同步不同步空目录
+	CreateEmptySrcDirs *bool `json:"createEmptySrcDirs,omitempty"`
+	// 跳过 0 字节文件（如临时文件），既不传输也不删除 - 单向和双向同步均有效
+	SkipZeroByteFiles *bool `json:"skipZeroByteFiles,omitempty"`
+	// 保留最近 N 个快照 - 仅备份任务（engine 为 backup）有效
+	// 保留规则均未设置时保留全部快照，否则删除不被任一规则保留的快照及不再被引用的数据
+	BackupKeepLast *int `json:"backupKeepLast,omitempty"`
+	// 保留最近 N 天中每天最新的一个快照 - 仅备份任务有效
+	BackupKeepDaily *int `json:"backupKeepDaily,omitempty"`
+	// 保留最近 N 周中每周最新的一个快照 - 仅备份任务有效
+	BackupKeepWeekly *int `json:"backupKeepWeekly,omitempty"`
+	// 保留最近 N 个月中每月最新的一个快照 - 仅备份任务有效
+	BackupKeepMonthly *int `json:"backupKeepMonthly,omitempty"`
+	// 前置钩子 - 同步开始前执行，失败时作业失败且不进行同步
+	// 命令必须是管理员在 app.hooks.allowed_commands 中允许的可执行文件
+	PreHook *TaskHook `json:"preHook,omitempty"`
+	// 后置钩子 - 同步结束后执行（作业被取消时不执行），通过环境变量 RCLONE_SYNC_STATUS 获取同步结果
+	PostHook *TaskHook `json:"postHook,omitempty"`
+	// 附加同步路径 - 仅单向同步（非分片）有效
+	// 任务的 sourcePath → remotePath 与各附加路径在同一作业中依次同步，统计信息合并；
+	// 主路径同步时排除各附加路径的 remoteSubpath，不会删除其中的文件
+	Paths []*TaskPath `json:"paths,omitempty"`
+	// 某个路径同步失败时是否停止同步其余路径（默认继续）
+	StopOnPathError *bool `json:"stopOnPathError,omitempty"`
+	// 镜像目标 - 仅上传任务有效
+	// 主同步成功后，依次将相同的内容（包括附加路径）同步到各镜像目标，每个目标作为主作业的子作业运行，
+	// 有独立的统计信息和日志；镜像失败时主作业状态为 SUCCESS_WITH_WARNINGS 并记录错误日志
+	Mirrors []*TaskMirror `json:"mirrors,omitempty"`
+	// 作业日志保留天数 - 超过该天数的逐文件作业日志被清理，作业记录本身保留
+	// 为空时使用全局配置 app.job.log_retention，0 表示永久保留
+	LogRetentionDays *int `json:"logRetentionDays,omitempty"`
+	// 作业记录保留天数 - 结束超过该天数的作业连同其日志被删除，任务最近一次作业始终保留
+	// 为空时使用全局配置 app.job.record_retention，0 表示永久保留
+	JobRetentionDays *int `json:"jobRetentionDays,omitempty"`
 }
 
 // 任务同步选项输入
@@ -510,14 +1705,70 @@ type TaskSyncOptionsInput struct {
 	NoDelete *bool `json:"noDelete,omitempty"`
 	// 并行传输数量 - 范围 1-64
 	Transfers *int `json:"transfers,omitempty"`
+	// 分片并行数量 - 范围 1-16，仅单向同步有效
+	// 大于 1 时，以 / 开头的过滤器规则会改写到各顶层目录下，其第一段路径须能单独匹配目录名（不能包含 ** 或跨目录的分组）
+	Shards *int `json:"shards,omitempty"`
+	// 最长执行时间（分钟）- 为空或 0 表示不限制
+	MaxDurationMinutes *int `json:"maxDurationMinutes,omitempty"`
+	// 超时后是否自动启动一次续传运行
+	ContinueOnTimeout *bool `json:"continueOnTimeout,omitempty"`
+	// 崩溃恢复 - 启用后，若服务重启前任务的作业因崩溃或异常退出被中断，启动时自动补跑一次
+	ResumeAfterCrash *bool `json:"resumeAfterCrash,omitempty"`
+	// 删除确认阈值 - 仅单向同步（非 noDelete）有效，不能为负数
+	ConfirmDeletesOver *int `json:"confirmDeletesOver,omitempty"`
+	// 跟踪重命名 - 仅单向同步（非 noDelete）有效
+	// 启用后，源端重命名或移动的文件在目标端通过服务端移动完成，而不是重新上传
+	// 目标端不支持服务端移动/复制或两端没有共同哈希时忽略并在作业日志中给出警告
+	TrackRenames *bool `json:"trackRenames,omitempty"`
+	// Windows 文件名处理 - ENCODE 编码保留名称和非法字符，SKIP 跳过这些文件；为空时不做处理
+	WindowsNames *WindowsNameHandling `json:"windowsNames,omitempty"`
+	// 保留扩展元数据 - 同时同步权限、属主等元数据，目标端无法保存的元数据记录为 WARNING 作业日志
+	PreserveMetadata *bool `json:"preserveMetadata,omitempty"`
+	// 实时监听忽略模式列表 - glob 语法，仅实时同步有效
+	// 为空时使用全局默认值，设置后替换全局默认值
+	WatchIgnorePatterns []string `json:"watchIgnorePatterns,omitempty"`
+	// 实时监听排除目录列表 - 相对源目录的子目录路径，这些目录完全不被监听，仅实时同步有效
+	WatchExcludeDirs []string `json:"watchExcludeDirs,omitempty"`
+	// 详细日志 - 将检查、列举等操作记录为 DEBUG 级别的作业日志
+	VerboseLogging *bool `json:"verboseLogging,omitempty"`
+	// 跳过传输前的大小估算 - 仅单向同步（非分片）有效
+	SkipSizing *bool `json:"skipSizing,omitempty"`
+	// 在目标端创建源端的空目录 - 未设置时单向同步创建，双向同步不创建
+	CreateEmptySrcDirs *bool `json:"createEmptySrcDirs,omitempty"`
+	// 跳过 0 字节文件
+	SkipZeroByteFiles *bool `json:"skipZeroByteFiles,omitempty"`
+	// 保留最近 N 个快照 - 仅备份任务有效，不能为负数
+	BackupKeepLast *int `json:"backupKeepLast,omitempty"`
+	// 保留最近 N 天中每天最新的一个快照 - 仅备份任务有效，不能为负数
+	BackupKeepDaily *int `json:"backupKeepDaily,omitempty"`
+	// 保留最近 N 周中每周最新的一个快照 - 仅备份任务有效，不能为负数
+	BackupKeepWeekly *int `json:"backupKeepWeekly,omitempty"`
+	// 保留最近 N 个月中每月最新的一个快照 - 仅备份任务有效，不能为负数
+	BackupKeepMonthly *int `json:"backupKeepMonthly,omitempty"`
+	// 前置钩子 - 命令必须在 app.hooks.allowed_commands 中
+	PreHook *TaskHookInput `json:"preHook,omitempty"`
+	// 后置钩子 - 命令必须在 app.hooks.allowed_commands 中
+	PostHook *TaskHookInput `json:"postHook,omitempty"`
+	// 附加同步路径 - 仅单向同步有效，不能与分片同时使用
+	Paths []*TaskPathInput `json:"paths,omitempty"`
+	// 某个路径同步失败时是否停止同步其余路径（默认继续）
+	StopOnPathError *bool `json:"stopOnPathError,omitempty"`
+	// 镜像目标 - 仅 rclone 引擎的上传任务有效
+	Mirrors []*TaskMirrorInput `json:"mirrors,omitempty"`
+	// 作业日志保留天数 - 为空时使用全局配置 app.job.log_retention，0 表示永久保留，不能为负数
+	LogRetentionDays *int `json:"logRetentionDays,omitempty"`
+	// 作业记录保留天数 - 为空时使用全局配置 app.job.record_retention，0 表示永久保留，不能为负数
+	JobRetentionDays *int `json:"jobRetentionDays,omitempty"`
 }
 
 // 测试连接输入（未保存的配置）
 type TestConnectionInput struct {
 	// 提供者类型
-	Type string `json:"type"`
+	Type ConnectionType `json:"type"`
 	// 配置参数
 	Config map[string]string `json:"config"`
+	// 可选的远程路径，设置后会在该路径下写入并删除一个探测对象以检测读写能力
+	RemotePath *string `json:"remotePath,omitempty"`
 }
 
 // 当前正在传输的文件项
@@ -528,6 +1779,11 @@ type TransferItem struct {
 	Size int64 `json:"size"`
 	// 已传输字节数
 	Bytes int64 `json:"bytes"`
+	// 当前上传的分块序号（从 1 开始）- 仅上传到分块上传的后端（如 s3、drive、onedrive）且文件需要分块时有值，
+	// 由已传输字节数和后端的分块大小（chunk_size）计算得出
+	Chunk *int `json:"chunk,omitempty"`
+	// 分块总数 - 与 chunk 同时有值
+	Chunks *int `json:"chunks,omitempty"`
 }
 
 // 传输进度事件 - 当前正在传输的文件列表
@@ -546,8 +1802,25 @@ type TransferProgressEvent struct {
 type UpdateConnectionInput struct {
 	// 连接名称
 	Name *string `json:"name,omitempty"`
-	// 配置参数
+	// 配置参数（不传时保持原配置）
 	Config map[string]string `json:"config,omitempty"`
+	// 远程路径前缀（传入空字符串表示清除；修改后双向同步任务会触发一次完整的 resync）
+	BasePath *string `json:"basePath,omitempty"`
+	// 每个远程路径的 API 调用速率上限（每秒事务数，传入 0 表示清除）
+	PathTpsLimit *float64 `json:"pathTpsLimit,omitempty"`
+	// 每个远程路径的 API 调用突发数（传入 0 表示清除）
+	PathTpsBurst *int `json:"pathTpsBurst,omitempty"`
+	// 每月传输量上限（字节，传入 0 表示清除）
+	MonthlyTransferCap *int64 `json:"monthlyTransferCap,omitempty"`
+	// 显示名称（传入空字符串表示清除）
+	// 仅修改显示名称、颜色和图标时不会递增配置版本，也不受运行中作业的限制
+	DisplayName *string `json:"displayName,omitempty"`
+	// 显示颜色（#rrggbb 格式，传入空字符串表示清除）
+	Color *string `json:"color,omitempty"`
+	// 图标名称（传入空字符串表示清除）
+	Icon *string `json:"icon,omitempty"`
+	// 期望的当前配置版本（可选），与实际版本不一致时拒绝修改，用于避免覆盖他人的并发修改
+	ExpectedConfigVersion *int `json:"expectedConfigVersion,omitempty"`
 }
 
 // 更新任务输入
@@ -562,12 +1835,111 @@ type UpdateTaskInput struct {
 	RemotePath *string `json:"remotePath,omitempty"`
 	// 同步方向
 	Direction *SyncDirection `json:"direction,omitempty"`
-	// Cron 调度表达式
+	// 调度：Cron 表达式或 RRULE（iCalendar）规则
 	Schedule *string `json:"schedule,omitempty"`
 	// 是否启用实时同步
 	Realtime *bool `json:"realtime,omitempty"`
 	// 同步选项
 	Options *TaskSyncOptionsInput `json:"options,omitempty"`
+	// 同步引擎名称（必须是已注册的引擎，见 task.engines）
+	Engine *string `json:"engine,omitempty"`
+	// 标签列表 - 首尾空白会被去除，空标签和重复标签会被忽略；更新时设置后替换原有标签
+	Tags []string `json:"tags,omitempty"`
+	// 是否启用，重新启用会清除 disabledReason 并重新开始统计非临时性错误的连续失败次数
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// 实用工具变更命名空间
+type UtilityMutation struct {
+	// 扫描连接下指定路径中的重复文件，可按清理规则删除多余文件
+	FindDuplicates *DuplicateReport `json:"findDuplicates"`
+}
+
+// 启动时 Fs 缓存的预热状态
+type WarmupStatus struct {
+	// 预热开始时间，未预热（app.connection.warmup_parallel 为 0）时为 null
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	// 预热完成时间，预热中为 null
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// 预热是否已完成（未预热时为 true）
+	Ready bool `json:"ready"`
+	// 各连接的预热进度（按名称排序）
+	Connections []*ConnectionWarmup `json:"connections"`
+}
+
+// 实时监听因达到系统 inotify watch 上限而未能监听部分目录的告警
+type WatchWarning struct {
+	// 第一个未能监听的目录（绝对路径），该目录及之后遍历到的目录中的变更不会触发同步
+	Path string `json:"path"`
+	// 当前的 inotify watch 上限（fs.inotify.max_user_watches），无法读取时为 null
+	Limit *int `json:"limit,omitempty"`
+	// 本服务已使用的 watch 数量（同一用户的其他进程也会占用该上限）
+	Used int `json:"used"`
+	// 建议用于提高上限的命令（需要 root 权限，持久化需写入 /etc/sysctl.conf）
+	Sysctl string `json:"sysctl"`
+	// 检测到的时间
+	DetectedAt time.Time `json:"detectedAt"`
+}
+
+// 能力检测状态
+type CapabilityStatus string
+
+const (
+	// 检测通过
+	CapabilityStatusOk CapabilityStatus = "OK"
+	// 检测失败（如权限不足、只读存储）
+	CapabilityStatusFailed CapabilityStatus = "FAILED"
+	// 因前置检测失败而跳过
+	CapabilityStatusSkipped CapabilityStatus = "SKIPPED"
+)
+
+var AllCapabilityStatus = []CapabilityStatus{
+	CapabilityStatusOk,
+	CapabilityStatusFailed,
+	CapabilityStatusSkipped,
+}
+
+func (e CapabilityStatus) IsValid() bool {
+	switch e {
+	case CapabilityStatusOk, CapabilityStatusFailed, CapabilityStatusSkipped:
+		return true
+	}
+	return false
+}
+
+func (e CapabilityStatus) String() string {
+	return string(e)
+}
+
+func (e *CapabilityStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CapabilityStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CapabilityStatus", str)
+	}
+	return nil
+}
+
+func (e CapabilityStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CapabilityStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CapabilityStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
 
 // 冲突解决策略（仅用于双向同步）
@@ -634,54 +2006,57 @@ func (e ConflictResolution) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// 连接加载状态
-type ConnectionLoadStatus string
+// 连接能力
+type ConnectionCapability string
 
 const (
-	// 已加载
-	ConnectionLoadStatusLoaded ConnectionLoadStatus = "LOADED"
-	// 加载中
-	ConnectionLoadStatusLoading ConnectionLoadStatus = "LOADING"
-	// 加载失败
-	ConnectionLoadStatusError ConnectionLoadStatus = "ERROR"
+	// 列出路径内容
+	ConnectionCapabilityList ConnectionCapability = "LIST"
+	// 在路径下写入对象
+	ConnectionCapabilityWrite ConnectionCapability = "WRITE"
+	// 读取写入的对象
+	ConnectionCapabilityRead ConnectionCapability = "READ"
+	// 删除路径下的对象
+	ConnectionCapabilityDelete ConnectionCapability = "DELETE"
 )
 
-var AllConnectionLoadStatus = []ConnectionLoadStatus{
-	ConnectionLoadStatusLoaded,
-	ConnectionLoadStatusLoading,
-	ConnectionLoadStatusError,
+var AllConnectionCapability = []ConnectionCapability{
+	ConnectionCapabilityList,
+	ConnectionCapabilityWrite,
+	ConnectionCapabilityRead,
+	ConnectionCapabilityDelete,
 }
 
-func (e ConnectionLoadStatus) IsValid() bool {
+func (e ConnectionCapability) IsValid() bool {
 	switch e {
-	case ConnectionLoadStatusLoaded, ConnectionLoadStatusLoading, ConnectionLoadStatusError:
+	case ConnectionCapabilityList, ConnectionCapabilityWrite, ConnectionCapabilityRead, ConnectionCapabilityDelete:
 		return true
 	}
 	return false
 }
 
-func (e ConnectionLoadStatus) String() string {
+func (e ConnectionCapability) String() string {
 	return string(e)
 }
 
-func (e *ConnectionLoadStatus) UnmarshalGQL(v any) error {
+func (e *ConnectionCapability) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ConnectionLoadStatus(str)
+	*e = ConnectionCapability(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ConnectionLoadStatus", str)
+		return fmt.Errorf("%s is not a valid ConnectionCapability", str)
 	}
 	return nil
 }
 
-func (e ConnectionLoadStatus) MarshalGQL(w io.Writer) {
+func (e ConnectionCapability) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ConnectionLoadStatus) UnmarshalJSON(b []byte) error {
+func (e *ConnectionCapability) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -689,66 +2064,1143 @@ func (e *ConnectionLoadStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ConnectionLoadStatus) MarshalJSON() ([]byte, error) {
+func (e ConnectionCapability) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// 作业执行状态
+// 连接健康状态（最近一次连接测试的结果）
+type ConnectionHealthStatus string
+
+const (
+	// 健康
+	ConnectionHealthStatusHealthy ConnectionHealthStatus = "HEALTHY"
+	// 不健康
+	ConnectionHealthStatusUnhealthy ConnectionHealthStatus = "UNHEALTHY"
+)
+
+var AllConnectionHealthStatus = []ConnectionHealthStatus{
+	ConnectionHealthStatusHealthy,
+	ConnectionHealthStatusUnhealthy,
+}
+
+func (e ConnectionHealthStatus) IsValid() bool {
+	switch e {
+	case ConnectionHealthStatusHealthy, ConnectionHealthStatusUnhealthy:
+		return true
+	}
+	return false
+}
+
+func (e ConnectionHealthStatus) String() string {
+	return string(e)
+}
+
+func (e *ConnectionHealthStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConnectionHealthStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectionHealthStatus", str)
+	}
+	return nil
+}
+
+func (e ConnectionHealthStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ConnectionHealthStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ConnectionHealthStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 连接加载状态
+type ConnectionLoadStatus string
+
+const (
+	// 已加载
+	ConnectionLoadStatusLoaded ConnectionLoadStatus = "LOADED"
+	// 加载中
+	ConnectionLoadStatusLoading ConnectionLoadStatus = "LOADING"
+	// 加载失败
+	ConnectionLoadStatusError ConnectionLoadStatus = "ERROR"
+)
+
+var AllConnectionLoadStatus = []ConnectionLoadStatus{
+	ConnectionLoadStatusLoaded,
+	ConnectionLoadStatusLoading,
+	ConnectionLoadStatusError,
+}
+
+func (e ConnectionLoadStatus) IsValid() bool {
+	switch e {
+	case ConnectionLoadStatusLoaded, ConnectionLoadStatusLoading, ConnectionLoadStatusError:
+		return true
+	}
+	return false
+}
+
+func (e ConnectionLoadStatus) String() string {
+	return string(e)
+}
+
+func (e *ConnectionLoadStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConnectionLoadStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectionLoadStatus", str)
+	}
+	return nil
+}
+
+func (e ConnectionLoadStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ConnectionLoadStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ConnectionLoadStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 连接列表的排序字段，值相同的连接按名称排序
+type ConnectionSortField string
+
+const (
+	// 名称
+	ConnectionSortFieldName ConnectionSortField = "NAME"
+	// 连接类型
+	ConnectionSortFieldType ConnectionSortField = "TYPE"
+	// 创建时间
+	ConnectionSortFieldCreatedAt ConnectionSortField = "CREATED_AT"
+	// 其任务最近一次作业的开始时间，没有作业的连接总排在最后
+	ConnectionSortFieldLastJobAt ConnectionSortField = "LAST_JOB_AT"
+	// 健康状态，升序时 HEALTHY 在前，从未测试的连接总排在最后
+	ConnectionSortFieldHealth ConnectionSortField = "HEALTH"
+)
+
+var AllConnectionSortField = []ConnectionSortField{
+	ConnectionSortFieldName,
+	ConnectionSortFieldType,
+	ConnectionSortFieldCreatedAt,
+	ConnectionSortFieldLastJobAt,
+	ConnectionSortFieldHealth,
+}
+
+func (e ConnectionSortField) IsValid() bool {
+	switch e {
+	case ConnectionSortFieldName, ConnectionSortFieldType, ConnectionSortFieldCreatedAt, ConnectionSortFieldLastJobAt, ConnectionSortFieldHealth:
+		return true
+	}
+	return false
+}
+
+func (e ConnectionSortField) String() string {
+	return string(e)
+}
+
+func (e *ConnectionSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConnectionSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectionSortField", str)
+	}
+	return nil
+}
+
+func (e ConnectionSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ConnectionSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ConnectionSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 连接类型 - 即 rclone 后端的配置类型，与编译进来的 rclone 后端一一对应
+// 未知的类型（如拼写错误的 "onedrve"）在创建连接时即被拒绝
+type ConnectionType string
+
+const (
+	// Alias for an existing remote
+	ConnectionTypeAlias ConnectionType = "alias"
+	// Read archives
+	ConnectionTypeArchive ConnectionType = "archive"
+	// Microsoft Azure Blob Storage
+	ConnectionTypeAzureblob ConnectionType = "azureblob"
+	// Microsoft Azure Files
+	ConnectionTypeAzurefiles ConnectionType = "azurefiles"
+	// Backblaze B2
+	ConnectionTypeB2 ConnectionType = "b2"
+	// Box
+	ConnectionTypeBox ConnectionType = "box"
+	// Cache a remote
+	ConnectionTypeCache ConnectionType = "cache"
+	// Transparently chunk/split large files
+	ConnectionTypeChunker ConnectionType = "chunker"
+	// Cloudinary
+	ConnectionTypeCloudinary ConnectionType = "cloudinary"
+	// Combine several remotes into one
+	ConnectionTypeCombine ConnectionType = "combine"
+	// Compress a remote
+	ConnectionTypeCompress ConnectionType = "compress"
+	// Encrypt/Decrypt a remote
+	ConnectionTypeCrypt ConnectionType = "crypt"
+	// DOI datasets
+	ConnectionTypeDoi ConnectionType = "doi"
+	// Google Drive
+	ConnectionTypeDrive ConnectionType = "drive"
+	// Dropbox
+	ConnectionTypeDropbox ConnectionType = "dropbox"
+	// 1Fichier
+	ConnectionTypeFichier ConnectionType = "fichier"
+	// Enterprise File Fabric
+	ConnectionTypeFilefabric ConnectionType = "filefabric"
+	// FileLu Cloud Storage
+	ConnectionTypeFilelu ConnectionType = "filelu"
+	// Files.com
+	ConnectionTypeFilescom ConnectionType = "filescom"
+	// FTP
+	ConnectionTypeFtp ConnectionType = "ftp"
+	// Google Cloud Storage (this is not Google Drive)
+	ConnectionTypeGcs ConnectionType = "gcs"
+	// Gofile
+	ConnectionTypeGofile ConnectionType = "gofile"
+	// Google Photos
+	ConnectionTypeGphotos ConnectionType = "gphotos"
+	// Better checksums for other remotes
+	ConnectionTypeHasher ConnectionType = "hasher"
+	// Hadoop distributed file system
+	ConnectionTypeHdfs ConnectionType = "hdfs"
+	// HiDrive
+	ConnectionTypeHidrive ConnectionType = "hidrive"
+	// HTTP
+	ConnectionTypeHTTP ConnectionType = "http"
+	// iCloud Drive
+	ConnectionTypeIclouddrive ConnectionType = "iclouddrive"
+	// ImageKit.io
+	ConnectionTypeImagekit ConnectionType = "imagekit"
+	// Internet Archive
+	ConnectionTypeInternetarchive ConnectionType = "internetarchive"
+	// Jottacloud
+	ConnectionTypeJottacloud ConnectionType = "jottacloud"
+	// Koofr, Digi Storage and other Koofr-compatible storage providers
+	ConnectionTypeKoofr ConnectionType = "koofr"
+	// Linkbox
+	ConnectionTypeLinkbox ConnectionType = "linkbox"
+	// Local Disk
+	ConnectionTypeLocal ConnectionType = "local"
+	// Mail.ru Cloud
+	ConnectionTypeMailru ConnectionType = "mailru"
+	// Mega
+	ConnectionTypeMega ConnectionType = "mega"
+	// In memory object storage system
+	ConnectionTypeMemory ConnectionType = "memory"
+	// Akamai NetStorage
+	ConnectionTypeNetstorage ConnectionType = "netstorage"
+	// Microsoft OneDrive
+	ConnectionTypeOnedrive ConnectionType = "onedrive"
+	// Oracle Cloud Infrastructure Object Storage
+	ConnectionTypeOos ConnectionType = "oos"
+	// OpenDrive
+	ConnectionTypeOpendrive ConnectionType = "opendrive"
+	// Pcloud
+	ConnectionTypePcloud ConnectionType = "pcloud"
+	// PikPak
+	ConnectionTypePikpak ConnectionType = "pikpak"
+	// Pixeldrain Filesystem
+	ConnectionTypePixeldrain ConnectionType = "pixeldrain"
+	// premiumize.me
+	ConnectionTypePremiumizeme ConnectionType = "premiumizeme"
+	// Proton Drive
+	ConnectionTypeProtondrive ConnectionType = "protondrive"
+	// Put.io
+	ConnectionTypePutio ConnectionType = "putio"
+	// QingCloud Object Storage
+	ConnectionTypeQingstor ConnectionType = "qingstor"
+	// Quatrix by Maytech
+	ConnectionTypeQuatrix ConnectionType = "quatrix"
+	// Amazon S3 Compliant Storage Providers
+	ConnectionTypeS3 ConnectionType = "s3"
+	// seafile
+	ConnectionTypeSeafile ConnectionType = "seafile"
+	// SSH/SFTP
+	ConnectionTypeSftp ConnectionType = "sftp"
+	// Citrix Sharefile
+	ConnectionTypeSharefile ConnectionType = "sharefile"
+	// Sia Decentralized Cloud
+	ConnectionTypeSia ConnectionType = "sia"
+	// SMB / CIFS
+	ConnectionTypeSmb ConnectionType = "smb"
+	// Storj Decentralized Cloud Storage
+	ConnectionTypeStorj ConnectionType = "storj"
+	// Sugarsync
+	ConnectionTypeSugarsync ConnectionType = "sugarsync"
+	// OpenStack Swift (Rackspace Cloud Files, Blomp Cloud Storage, Memset Memstore, OVH)
+	ConnectionTypeSwift ConnectionType = "swift"
+	// Storj Decentralized Cloud Storage
+	ConnectionTypeTardigrade ConnectionType = "tardigrade"
+	// Uloz.to
+	ConnectionTypeUlozto ConnectionType = "ulozto"
+	// Union merges the contents of several upstream fs
+	ConnectionTypeUnion ConnectionType = "union"
+	// Uptobox
+	ConnectionTypeUptobox ConnectionType = "uptobox"
+	// WebDAV
+	ConnectionTypeWebdav ConnectionType = "webdav"
+	// Yandex Disk
+	ConnectionTypeYandex ConnectionType = "yandex"
+	// Zoho
+	ConnectionTypeZoho ConnectionType = "zoho"
+)
+
+var AllConnectionType = []ConnectionType{
+	ConnectionTypeAlias,
+	ConnectionTypeArchive,
+	ConnectionTypeAzureblob,
+	ConnectionTypeAzurefiles,
+	ConnectionTypeB2,
+	ConnectionTypeBox,
+	ConnectionTypeCache,
+	ConnectionTypeChunker,
+	ConnectionTypeCloudinary,
+	ConnectionTypeCombine,
+	ConnectionTypeCompress,
+	ConnectionTypeCrypt,
+	ConnectionTypeDoi,
+	ConnectionTypeDrive,
+	ConnectionTypeDropbox,
+	ConnectionTypeFichier,
+	ConnectionTypeFilefabric,
+	ConnectionTypeFilelu,
+	ConnectionTypeFilescom,
+	ConnectionTypeFtp,
+	ConnectionTypeGcs,
+	ConnectionTypeGofile,
+	ConnectionTypeGphotos,
+	ConnectionTypeHasher,
+	ConnectionTypeHdfs,
+	ConnectionTypeHidrive,
+	ConnectionTypeHTTP,
+	ConnectionTypeIclouddrive,
+	ConnectionTypeImagekit,
+	ConnectionTypeInternetarchive,
+	ConnectionTypeJottacloud,
+	ConnectionTypeKoofr,
+	ConnectionTypeLinkbox,
+	ConnectionTypeLocal,
+	ConnectionTypeMailru,
+	ConnectionTypeMega,
+	ConnectionTypeMemory,
+	ConnectionTypeNetstorage,
+	ConnectionTypeOnedrive,
+	ConnectionTypeOos,
+	ConnectionTypeOpendrive,
+	ConnectionTypePcloud,
+	ConnectionTypePikpak,
+	ConnectionTypePixeldrain,
+	ConnectionTypePremiumizeme,
+	ConnectionTypeProtondrive,
+	ConnectionTypePutio,
+	ConnectionTypeQingstor,
+	ConnectionTypeQuatrix,
+	ConnectionTypeS3,
+	ConnectionTypeSeafile,
+	ConnectionTypeSftp,
+	ConnectionTypeSharefile,
+	ConnectionTypeSia,
+	ConnectionTypeSmb,
+	ConnectionTypeStorj,
+	ConnectionTypeSugarsync,
+	ConnectionTypeSwift,
+	ConnectionTypeTardigrade,
+	ConnectionTypeUlozto,
+	ConnectionTypeUnion,
+	ConnectionTypeUptobox,
+	ConnectionTypeWebdav,
+	ConnectionTypeYandex,
+	ConnectionTypeZoho,
+}
+
+func (e ConnectionType) IsValid() bool {
+	switch e {
+	case ConnectionTypeAlias, ConnectionTypeArchive, ConnectionTypeAzureblob, ConnectionTypeAzurefiles, ConnectionTypeB2, ConnectionTypeBox, ConnectionTypeCache, ConnectionTypeChunker, ConnectionTypeCloudinary, ConnectionTypeCombine, ConnectionTypeCompress, ConnectionTypeCrypt, ConnectionTypeDoi, ConnectionTypeDrive, ConnectionTypeDropbox, ConnectionTypeFichier, ConnectionTypeFilefabric, ConnectionTypeFilelu, ConnectionTypeFilescom, ConnectionTypeFtp, ConnectionTypeGcs, ConnectionTypeGofile, ConnectionTypeGphotos, ConnectionTypeHasher, ConnectionTypeHdfs, ConnectionTypeHidrive, ConnectionTypeHTTP, ConnectionTypeIclouddrive, ConnectionTypeImagekit, ConnectionTypeInternetarchive, ConnectionTypeJottacloud, ConnectionTypeKoofr, ConnectionTypeLinkbox, ConnectionTypeLocal, ConnectionTypeMailru, ConnectionTypeMega, ConnectionTypeMemory, ConnectionTypeNetstorage, ConnectionTypeOnedrive, ConnectionTypeOos, ConnectionTypeOpendrive, ConnectionTypePcloud, ConnectionTypePikpak, ConnectionTypePixeldrain, ConnectionTypePremiumizeme, ConnectionTypeProtondrive, ConnectionTypePutio, ConnectionTypeQingstor, ConnectionTypeQuatrix, ConnectionTypeS3, ConnectionTypeSeafile, ConnectionTypeSftp, ConnectionTypeSharefile, ConnectionTypeSia, ConnectionTypeSmb, ConnectionTypeStorj, ConnectionTypeSugarsync, ConnectionTypeSwift, ConnectionTypeTardigrade, ConnectionTypeUlozto, ConnectionTypeUnion, ConnectionTypeUptobox, ConnectionTypeWebdav, ConnectionTypeYandex, ConnectionTypeZoho:
+		return true
+	}
+	return false
+}
+
+func (e ConnectionType) String() string {
+	return string(e)
+}
+
+func (e *ConnectionType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConnectionType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectionType", str)
+	}
+	return nil
+}
+
+func (e ConnectionType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ConnectionType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ConnectionType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 重复文件清理规则 - 每组重复文件中保留哪一个
+type DuplicateKeepRule string
+
+const (
+	// 保留修改时间最新的文件
+	DuplicateKeepRuleNewest DuplicateKeepRule = "NEWEST"
+	// 保留路径最短的文件
+	DuplicateKeepRuleShortestPath DuplicateKeepRule = "SHORTEST_PATH"
+)
+
+var AllDuplicateKeepRule = []DuplicateKeepRule{
+	DuplicateKeepRuleNewest,
+	DuplicateKeepRuleShortestPath,
+}
+
+func (e DuplicateKeepRule) IsValid() bool {
+	switch e {
+	case DuplicateKeepRuleNewest, DuplicateKeepRuleShortestPath:
+		return true
+	}
+	return false
+}
+
+func (e DuplicateKeepRule) String() string {
+	return string(e)
+}
+
+func (e *DuplicateKeepRule) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DuplicateKeepRule(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DuplicateKeepRule", str)
+	}
+	return nil
+}
+
+func (e DuplicateKeepRule) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DuplicateKeepRule) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DuplicateKeepRule) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 重复文件匹配方式
+type DuplicateMatchMode string
+
+const (
+	// 按文件哈希匹配（仅对大小相同的文件计算哈希）
+	DuplicateMatchModeHash DuplicateMatchMode = "HASH"
+	// 按文件大小 + 文件名匹配（不读取文件内容，速度快）
+	DuplicateMatchModeSizeName DuplicateMatchMode = "SIZE_NAME"
+)
+
+var AllDuplicateMatchMode = []DuplicateMatchMode{
+	DuplicateMatchModeHash,
+	DuplicateMatchModeSizeName,
+}
+
+func (e DuplicateMatchMode) IsValid() bool {
+	switch e {
+	case DuplicateMatchModeHash, DuplicateMatchModeSizeName:
+		return true
+	}
+	return false
+}
+
+func (e DuplicateMatchMode) String() string {
+	return string(e)
+}
+
+func (e *DuplicateMatchMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DuplicateMatchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DuplicateMatchMode", str)
+	}
+	return nil
+}
+
+func (e DuplicateMatchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DuplicateMatchMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DuplicateMatchMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 过滤规则建议的类别
+type FilterSuggestionCategory string
+
+const (
+	// 依赖目录（如 node_modules）
+	FilterSuggestionCategoryDependencies FilterSuggestionCategory = "DEPENDENCIES"
+	// 缓存目录（如 .cache、__pycache__）
+	FilterSuggestionCategoryCache FilterSuggestionCategory = "CACHE"
+	// 构建产物（如 dist、target），仅在同一路径被多个作业重复传输时建议
+	FilterSuggestionCategoryBuildOutput FilterSuggestionCategory = "BUILD_OUTPUT"
+	// 版本控制目录（如 .git），仅在同一路径被多个作业重复传输时建议
+	FilterSuggestionCategoryVersionControl FilterSuggestionCategory = "VERSION_CONTROL"
+	// 临时文件（如 *.tmp、*.swp）
+	FilterSuggestionCategoryTemporary FilterSuggestionCategory = "TEMPORARY"
+	// 系统生成的文件（如 .DS_Store、Thumbs.db）
+	FilterSuggestionCategorySystem FilterSuggestionCategory = "SYSTEM"
+)
+
+var AllFilterSuggestionCategory = []FilterSuggestionCategory{
+	FilterSuggestionCategoryDependencies,
+	FilterSuggestionCategoryCache,
+	FilterSuggestionCategoryBuildOutput,
+	FilterSuggestionCategoryVersionControl,
+	FilterSuggestionCategoryTemporary,
+	FilterSuggestionCategorySystem,
+}
+
+func (e FilterSuggestionCategory) IsValid() bool {
+	switch e {
+	case FilterSuggestionCategoryDependencies, FilterSuggestionCategoryCache, FilterSuggestionCategoryBuildOutput, FilterSuggestionCategoryVersionControl, FilterSuggestionCategoryTemporary, FilterSuggestionCategorySystem:
+		return true
+	}
+	return false
+}
+
+func (e FilterSuggestionCategory) String() string {
+	return string(e)
+}
+
+func (e *FilterSuggestionCategory) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FilterSuggestionCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FilterSuggestionCategory", str)
+	}
+	return nil
+}
+
+func (e FilterSuggestionCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FilterSuggestionCategory) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FilterSuggestionCategory) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 批量取消作业时单个作业的结果
+type JobCancelOutcome string
+
+const (
+	// 作业已取消
+	JobCancelOutcomeCancelled JobCancelOutcome = "CANCELLED"
+	// 作业在取消生效前已结束，状态保持不变
+	JobCancelOutcomeAlreadyFinished JobCancelOutcome = "ALREADY_FINISHED"
+	// 取消失败（见 error）
+	JobCancelOutcomeFailed JobCancelOutcome = "FAILED"
+)
+
+var AllJobCancelOutcome = []JobCancelOutcome{
+	JobCancelOutcomeCancelled,
+	JobCancelOutcomeAlreadyFinished,
+	JobCancelOutcomeFailed,
+}
+
+func (e JobCancelOutcome) IsValid() bool {
+	switch e {
+	case JobCancelOutcomeCancelled, JobCancelOutcomeAlreadyFinished, JobCancelOutcomeFailed:
+		return true
+	}
+	return false
+}
+
+func (e JobCancelOutcome) String() string {
+	return string(e)
+}
+
+func (e *JobCancelOutcome) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobCancelOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobCancelOutcome", str)
+	}
+	return nil
+}
+
+func (e JobCancelOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobCancelOutcome) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobCancelOutcome) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 作业事件类型
+type JobEventType string
+
+const (
+	// 前置钩子执行结果
+	JobEventTypePreHook JobEventType = "PRE_HOOK"
+	// 后置钩子执行结果
+	JobEventTypePostHook JobEventType = "POST_HOOK"
+)
+
+var AllJobEventType = []JobEventType{
+	JobEventTypePreHook,
+	JobEventTypePostHook,
+}
+
+func (e JobEventType) IsValid() bool {
+	switch e {
+	case JobEventTypePreHook, JobEventTypePostHook:
+		return true
+	}
+	return false
+}
+
+func (e JobEventType) String() string {
+	return string(e)
+}
+
+func (e *JobEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobEventType", str)
+	}
+	return nil
+}
+
+func (e JobEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 作业执行状态
 type JobStatus string
 
 const (
-	// 等待执行
-	JobStatusPending JobStatus = "PENDING"
-	// 执行中
-	JobStatusRunning JobStatus = "RUNNING"
-	// 成功完成
-	JobStatusSuccess JobStatus = "SUCCESS"
-	// 执行失败
-	JobStatusFailed JobStatus = "FAILED"
-	// 已取消
-	JobStatusCancelled JobStatus = "CANCELLED"
+	// 等待执行
+	JobStatusPending JobStatus = "PENDING"
+	// 执行中
+	JobStatusRunning JobStatus = "RUNNING"
+	// 等待确认（将删除的文件数超过任务的 confirmDeletesOver，需调用 job.confirm 或 job.abort）
+	JobStatusWaitingConfirmation JobStatus = "WAITING_CONFIRMATION"
+	// 成功完成
+	JobStatusSuccess JobStatus = "SUCCESS"
+	// 已完成但有错误（部分文件失败，errorCount > 0）
+	JobStatusSuccessWithWarnings JobStatus = "SUCCESS_WITH_WARNINGS"
+	// 执行失败
+	JobStatusFailed JobStatus = "FAILED"
+	// 超过最长执行时间而失败
+	JobStatusFailedTimeout JobStatus = "FAILED_TIMEOUT"
+	// 已取消
+	JobStatusCancelled JobStatus = "CANCELLED"
+	// 因连接本月传输量已达上限（monthlyTransferCap）而推迟，未执行同步
+	JobStatusQuotaDeferred JobStatus = "QUOTA_DEFERRED"
+)
+
+var AllJobStatus = []JobStatus{
+	JobStatusPending,
+	JobStatusRunning,
+	JobStatusWaitingConfirmation,
+	JobStatusSuccess,
+	JobStatusSuccessWithWarnings,
+	JobStatusFailed,
+	JobStatusFailedTimeout,
+	JobStatusCancelled,
+	JobStatusQuotaDeferred,
+}
+
+func (e JobStatus) IsValid() bool {
+	switch e {
+	case JobStatusPending, JobStatusRunning, JobStatusWaitingConfirmation, JobStatusSuccess, JobStatusSuccessWithWarnings, JobStatusFailed, JobStatusFailedTimeout, JobStatusCancelled, JobStatusQuotaDeferred:
+		return true
+	}
+	return false
+}
+
+func (e JobStatus) String() string {
+	return string(e)
+}
+
+func (e *JobStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobStatus", str)
+	}
+	return nil
+}
+
+func (e JobStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 作业触发方式
+type JobTrigger string
+
+const (
+	// 手动触发
+	JobTriggerManual JobTrigger = "MANUAL"
+	// 定时触发
+	JobTriggerSchedule JobTrigger = "SCHEDULE"
+	// 实时触发（文件变更）
+	JobTriggerRealtime JobTrigger = "REALTIME"
+	// 重试失败文件（由 job.retryFailedFiles 触发，仅传输请求重试的文件）
+	JobTriggerRetry JobTrigger = "RETRY"
+)
+
+var AllJobTrigger = []JobTrigger{
+	JobTriggerManual,
+	JobTriggerSchedule,
+	JobTriggerRealtime,
+	JobTriggerRetry,
+}
+
+func (e JobTrigger) IsValid() bool {
+	switch e {
+	case JobTriggerManual, JobTriggerSchedule, JobTriggerRealtime, JobTriggerRetry:
+		return true
+	}
+	return false
+}
+
+func (e JobTrigger) String() string {
+	return string(e)
+}
+
+func (e *JobTrigger) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobTrigger(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobTrigger", str)
+	}
+	return nil
+}
+
+func (e JobTrigger) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *JobTrigger) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e JobTrigger) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 日志操作类型
+type LogAction string
+
+const (
+	// 上传文件
+	LogActionUpload LogAction = "UPLOAD"
+	// 下载文件
+	LogActionDownload LogAction = "DOWNLOAD"
+	// 删除文件
+	LogActionDelete LogAction = "DELETE"
+	// 移动文件
+	LogActionMove LogAction = "MOVE"
+	// 重命名文件（双向同步中检测到的重命名或移动，path 为新路径，previousPath 为原路径）
+	LogActionRename LogAction = "RENAME"
+	// 跳过文件（名称或路径与 Windows 不兼容，见 TaskSyncOptions.windowsNames）
+	LogActionSkip LogAction = "SKIP"
+	// 元数据未保留（目标端不支持部分元数据，见 TaskSyncOptions.preserveMetadata），path 为文件路径，冒号后列出未保留的元数据键
+	LogActionMetadata LogAction = "METADATA"
+	// 检查文件（比较、计算哈希）
+	LogActionCheck LogAction = "CHECK"
+	// 列举目录
+	LogActionList LogAction = "LIST"
+	// 错误
+	LogActionError LogAction = "ERROR"
+	// 未知操作
+	LogActionUnknown LogAction = "UNKNOWN"
+)
+
+var AllLogAction = []LogAction{
+	LogActionUpload,
+	LogActionDownload,
+	LogActionDelete,
+	LogActionMove,
+	LogActionRename,
+	LogActionSkip,
+	LogActionMetadata,
+	LogActionCheck,
+	LogActionList,
+	LogActionError,
+	LogActionUnknown,
+}
+
+func (e LogAction) IsValid() bool {
+	switch e {
+	case LogActionUpload, LogActionDownload, LogActionDelete, LogActionMove, LogActionRename, LogActionSkip, LogActionMetadata, LogActionCheck, LogActionList, LogActionError, LogActionUnknown:
+		return true
+	}
+	return false
+}
+
+func (e LogAction) String() string {
+	return string(e)
+}
+
+func (e *LogAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LogAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LogAction", str)
+	}
+	return nil
+}
+
+func (e LogAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LogAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LogAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 日志级别
+type LogLevel string
+
+const (
+	// 调试（仅在任务启用 verboseLogging 时记录）
+	LogLevelDebug   LogLevel = "DEBUG"
+	LogLevelInfo    LogLevel = "INFO"
+	LogLevelWarning LogLevel = "WARNING"
+	LogLevelError   LogLevel = "ERROR"
+)
+
+var AllLogLevel = []LogLevel{
+	LogLevelDebug,
+	LogLevelInfo,
+	LogLevelWarning,
+	LogLevelError,
+}
+
+func (e LogLevel) IsValid() bool {
+	switch e {
+	case LogLevelDebug, LogLevelInfo, LogLevelWarning, LogLevelError:
+		return true
+	}
+	return false
+}
+
+func (e LogLevel) String() string {
+	return string(e)
+}
+
+func (e *LogLevel) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LogLevel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LogLevel", str)
+	}
+	return nil
+}
+
+func (e LogLevel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LogLevel) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LogLevel) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 媒体类连接（如 Google Photos）上的任务的限制
+type MediaWarning string
+
+const (
+	// 下载的是后端重新编码的副本而非原始文件（图片可能被压缩并去除位置等 EXIF 信息，视频被转码）
+	MediaWarningDownloadsNotOriginals MediaWarning = "DOWNLOADS_NOT_ORIGINALS"
+	// 受后端 API 限制，只能列出和下载通过本服务（rclone）上传的媒体，其他媒体不会被同步
+	MediaWarningOnlyUploadedMedia MediaWarning = "ONLY_UPLOADED_MEDIA"
+)
+
+var AllMediaWarning = []MediaWarning{
+	MediaWarningDownloadsNotOriginals,
+	MediaWarningOnlyUploadedMedia,
+}
+
+func (e MediaWarning) IsValid() bool {
+	switch e {
+	case MediaWarningDownloadsNotOriginals, MediaWarningOnlyUploadedMedia:
+		return true
+	}
+	return false
+}
+
+func (e MediaWarning) String() string {
+	return string(e)
+}
+
+func (e *MediaWarning) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MediaWarning(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MediaWarning", str)
+	}
+	return nil
+}
+
+func (e MediaWarning) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MediaWarning) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MediaWarning) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 分享令牌的访问范围
+type ShareScope string
+
+const (
+	// 查看单个任务及其最近作业，并可运行该任务
+	ShareScopeTask ShareScope = "TASK"
+	// 下载单个远程文件
+	ShareScopeDownload ShareScope = "DOWNLOAD"
 )
 
-var AllJobStatus = []JobStatus{
-	JobStatusPending,
-	JobStatusRunning,
-	JobStatusSuccess,
-	JobStatusFailed,
-	JobStatusCancelled,
+var AllShareScope = []ShareScope{
+	ShareScopeTask,
+	ShareScopeDownload,
 }
 
-func (e JobStatus) IsValid() bool {
+func (e ShareScope) IsValid() bool {
 	switch e {
-	case JobStatusPending, JobStatusRunning, JobStatusSuccess, JobStatusFailed, JobStatusCancelled:
+	case ShareScopeTask, ShareScopeDownload:
 		return true
 	}
 	return false
 }
 
-func (e JobStatus) String() string {
+func (e ShareScope) String() string {
 	return string(e)
 }
 
-func (e *JobStatus) UnmarshalGQL(v any) error {
+func (e *ShareScope) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = JobStatus(str)
+	*e = ShareScope(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid JobStatus", str)
+		return fmt.Errorf("%s is not a valid ShareScope", str)
 	}
 	return nil
 }
 
-func (e JobStatus) MarshalGQL(w io.Writer) {
+func (e ShareScope) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *JobStatus) UnmarshalJSON(b []byte) error {
+func (e *ShareScope) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -756,60 +3208,57 @@ func (e *JobStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e JobStatus) MarshalJSON() ([]byte, error) {
+func (e ShareScope) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// 作业触发方式
-type JobTrigger string
+// 排序方向
+type SortDirection string
 
 const (
-	// 手动触发
-	JobTriggerManual JobTrigger = "MANUAL"
-	// 定时触发
-	JobTriggerSchedule JobTrigger = "SCHEDULE"
-	// 实时触发（文件变更）
-	JobTriggerRealtime JobTrigger = "REALTIME"
+	// 升序
+	SortDirectionAsc SortDirection = "ASC"
+	// 降序
+	SortDirectionDesc SortDirection = "DESC"
 )
 
-var AllJobTrigger = []JobTrigger{
-	JobTriggerManual,
-	JobTriggerSchedule,
-	JobTriggerRealtime,
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
 }
 
-func (e JobTrigger) IsValid() bool {
+func (e SortDirection) IsValid() bool {
 	switch e {
-	case JobTriggerManual, JobTriggerSchedule, JobTriggerRealtime:
+	case SortDirectionAsc, SortDirectionDesc:
 		return true
 	}
 	return false
 }
 
-func (e JobTrigger) String() string {
+func (e SortDirection) String() string {
 	return string(e)
 }
 
-func (e *JobTrigger) UnmarshalGQL(v any) error {
+func (e *SortDirection) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = JobTrigger(str)
+	*e = SortDirection(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid JobTrigger", str)
+		return fmt.Errorf("%s is not a valid SortDirection", str)
 	}
 	return nil
 }
 
-func (e JobTrigger) MarshalGQL(w io.Writer) {
+func (e SortDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *JobTrigger) UnmarshalJSON(b []byte) error {
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -817,69 +3266,60 @@ func (e *JobTrigger) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e JobTrigger) MarshalJSON() ([]byte, error) {
+func (e SortDirection) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// 日志操作类型
-type LogAction string
+// 同步方向
+type SyncDirection string
 
 const (
-	// 上传文件
-	LogActionUpload LogAction = "UPLOAD"
-	// 下载文件
-	LogActionDownload LogAction = "DOWNLOAD"
-	// 删除文件
-	LogActionDelete LogAction = "DELETE"
-	// 移动文件
-	LogActionMove LogAction = "MOVE"
-	// 错误
-	LogActionError LogAction = "ERROR"
-	// 未知操作
-	LogActionUnknown LogAction = "UNKNOWN"
+	// 本地上传到远程
+	SyncDirectionUpload SyncDirection = "UPLOAD"
+	// 远程下载到本地
+	SyncDirectionDownload SyncDirection = "DOWNLOAD"
+	// 双向同步（媒体类连接如 Google Photos 不支持）
+	SyncDirectionBidirectional SyncDirection = "BIDIRECTIONAL"
 )
 
-var AllLogAction = []LogAction{
-	LogActionUpload,
-	LogActionDownload,
-	LogActionDelete,
-	LogActionMove,
-	LogActionError,
-	LogActionUnknown,
+var AllSyncDirection = []SyncDirection{
+	SyncDirectionUpload,
+	SyncDirectionDownload,
+	SyncDirectionBidirectional,
 }
 
-func (e LogAction) IsValid() bool {
+func (e SyncDirection) IsValid() bool {
 	switch e {
-	case LogActionUpload, LogActionDownload, LogActionDelete, LogActionMove, LogActionError, LogActionUnknown:
+	case SyncDirectionUpload, SyncDirectionDownload, SyncDirectionBidirectional:
 		return true
 	}
 	return false
 }
 
-func (e LogAction) String() string {
+func (e SyncDirection) String() string {
 	return string(e)
 }
 
-func (e *LogAction) UnmarshalGQL(v any) error {
+func (e *SyncDirection) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = LogAction(str)
+	*e = SyncDirection(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid LogAction", str)
+		return fmt.Errorf("%s is not a valid SyncDirection", str)
 	}
 	return nil
 }
 
-func (e LogAction) MarshalGQL(w io.Writer) {
+func (e SyncDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *LogAction) UnmarshalJSON(b []byte) error {
+func (e *SyncDirection) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -887,57 +3327,75 @@ func (e *LogAction) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e LogAction) MarshalJSON() ([]byte, error) {
+func (e SyncDirection) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// 日志级别
-type LogLevel string
+// 任务事件类型
+type TaskEventType string
 
 const (
-	LogLevelInfo    LogLevel = "INFO"
-	LogLevelWarning LogLevel = "WARNING"
-	LogLevelError   LogLevel = "ERROR"
+	// 定时触发被跳过（该任务的作业仍在运行）
+	TaskEventTypeScheduleSkipped TaskEventType = "SCHEDULE_SKIPPED"
+	// 任务连续失败次数达到告警阈值 app.job.failure_escalation_threshold
+	TaskEventTypeConsecutiveFailures TaskEventType = "CONSECUTIVE_FAILURES"
+	// 作业因连接本月传输量已达上限而推迟
+	TaskEventTypeQuotaDeferred TaskEventType = "QUOTA_DEFERRED"
+	// 任务配置被修改（消息为修改的字段及其前后的值）
+	TaskEventTypeTaskUpdated TaskEventType = "TASK_UPDATED"
+	// 实时监听达到系统 inotify watch 上限，部分目录未被监听（消息包含上限、已用数量及建议的 sysctl 命令）
+	TaskEventTypeWatchLimit TaskEventType = "WATCH_LIMIT"
+	// 任务因连续多次非临时性错误（如认证失败、路径不存在）失败而被自动禁用（消息为禁用原因），见 app.job.auto_disable_threshold
+	TaskEventTypeTaskDisabled TaskEventType = "TASK_DISABLED"
+	// 一次运行将删除的文件数远超该任务通常的删除数（近期成功运行删除数的中位数），见 app.sync.delete_anomaly_factor；设置了 confirmDeletesOver 的任务会暂停等待确认
+	TaskEventTypeDeleteAnomaly TaskEventType = "DELETE_ANOMALY"
+	// 连接的加密配置无法解密（通常为加密密钥错误或配置数据损坏），该任务的作业将失败（消息为解密错误），见 system.integrity
+	TaskEventTypeConfigUnreadable TaskEventType = "CONFIG_UNREADABLE"
 )
 
-var AllLogLevel = []LogLevel{
-	LogLevelInfo,
-	LogLevelWarning,
-	LogLevelError,
+var AllTaskEventType = []TaskEventType{
+	TaskEventTypeScheduleSkipped,
+	TaskEventTypeConsecutiveFailures,
+	TaskEventTypeQuotaDeferred,
+	TaskEventTypeTaskUpdated,
+	TaskEventTypeWatchLimit,
+	TaskEventTypeTaskDisabled,
+	TaskEventTypeDeleteAnomaly,
+	TaskEventTypeConfigUnreadable,
 }
 
-func (e LogLevel) IsValid() bool {
+func (e TaskEventType) IsValid() bool {
 	switch e {
-	case LogLevelInfo, LogLevelWarning, LogLevelError:
+	case TaskEventTypeScheduleSkipped, TaskEventTypeConsecutiveFailures, TaskEventTypeQuotaDeferred, TaskEventTypeTaskUpdated, TaskEventTypeWatchLimit, TaskEventTypeTaskDisabled, TaskEventTypeDeleteAnomaly, TaskEventTypeConfigUnreadable:
 		return true
 	}
 	return false
 }
 
-func (e LogLevel) String() string {
+func (e TaskEventType) String() string {
 	return string(e)
 }
 
-func (e *LogLevel) UnmarshalGQL(v any) error {
+func (e *TaskEventType) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = LogLevel(str)
+	*e = TaskEventType(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid LogLevel", str)
+		return fmt.Errorf("%s is not a valid TaskEventType", str)
 	}
 	return nil
 }
 
-func (e LogLevel) MarshalGQL(w io.Writer) {
+func (e TaskEventType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *LogLevel) UnmarshalJSON(b []byte) error {
+func (e *TaskEventType) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -945,60 +3403,66 @@ func (e *LogLevel) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e LogLevel) MarshalJSON() ([]byte, error) {
+func (e TaskEventType) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// 同步方向
-type SyncDirection string
+// 任务列表的排序字段，值相同的任务按名称排序
+type TaskSortField string
 
 const (
-	// 本地上传到远程
-	SyncDirectionUpload SyncDirection = "UPLOAD"
-	// 远程下载到本地
-	SyncDirectionDownload SyncDirection = "DOWNLOAD"
-	// 双向同步
-	SyncDirectionBidirectional SyncDirection = "BIDIRECTIONAL"
+	// 名称
+	TaskSortFieldName TaskSortField = "NAME"
+	// 同步引擎
+	TaskSortFieldType TaskSortField = "TYPE"
+	// 创建时间
+	TaskSortFieldCreatedAt TaskSortField = "CREATED_AT"
+	// 最近一次作业的开始时间，没有作业的任务总排在最后
+	TaskSortFieldLastJobAt TaskSortField = "LAST_JOB_AT"
+	// 健康状况，升序时最健康的在前：先按是否被禁用，再按连续失败次数
+	TaskSortFieldHealth TaskSortField = "HEALTH"
 )
 
-var AllSyncDirection = []SyncDirection{
-	SyncDirectionUpload,
-	SyncDirectionDownload,
-	SyncDirectionBidirectional,
+var AllTaskSortField = []TaskSortField{
+	TaskSortFieldName,
+	TaskSortFieldType,
+	TaskSortFieldCreatedAt,
+	TaskSortFieldLastJobAt,
+	TaskSortFieldHealth,
 }
 
-func (e SyncDirection) IsValid() bool {
+func (e TaskSortField) IsValid() bool {
 	switch e {
-	case SyncDirectionUpload, SyncDirectionDownload, SyncDirectionBidirectional:
+	case TaskSortFieldName, TaskSortFieldType, TaskSortFieldCreatedAt, TaskSortFieldLastJobAt, TaskSortFieldHealth:
 		return true
 	}
 	return false
 }
 
-func (e SyncDirection) String() string {
+func (e TaskSortField) String() string {
 	return string(e)
 }
 
-func (e *SyncDirection) UnmarshalGQL(v any) error {
+func (e *TaskSortField) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SyncDirection(str)
+	*e = TaskSortField(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SyncDirection", str)
+		return fmt.Errorf("%s is not a valid TaskSortField", str)
 	}
 	return nil
 }
 
-func (e SyncDirection) MarshalGQL(w io.Writer) {
+func (e TaskSortField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *SyncDirection) UnmarshalJSON(b []byte) error {
+func (e *TaskSortField) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1006,7 +3470,203 @@ func (e *SyncDirection) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e SyncDirection) MarshalJSON() ([]byte, error) {
+func (e TaskSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 文件传输失败原因分类
+type TransferErrorClass string
+
+const (
+	// 文件或目录不存在（如传输期间被删除）
+	TransferErrorClassNotFound TransferErrorClass = "NOT_FOUND"
+	// 权限不足
+	TransferErrorClassPermissionDenied TransferErrorClass = "PERMISSION_DENIED"
+	// 存储空间或配额不足
+	TransferErrorClassNoSpace TransferErrorClass = "NO_SPACE"
+	// 请求被远程服务限流
+	TransferErrorClassRateLimited TransferErrorClass = "RATE_LIMITED"
+	// 网络错误（连接中断、超时等）
+	TransferErrorClassNetwork TransferErrorClass = "NETWORK"
+	// 传输后校验失败（大小或哈希不一致）
+	TransferErrorClassCorrupted TransferErrorClass = "CORRUPTED"
+	// 其他错误
+	TransferErrorClassOther TransferErrorClass = "OTHER"
+)
+
+var AllTransferErrorClass = []TransferErrorClass{
+	TransferErrorClassNotFound,
+	TransferErrorClassPermissionDenied,
+	TransferErrorClassNoSpace,
+	TransferErrorClassRateLimited,
+	TransferErrorClassNetwork,
+	TransferErrorClassCorrupted,
+	TransferErrorClassOther,
+}
+
+func (e TransferErrorClass) IsValid() bool {
+	switch e {
+	case TransferErrorClassNotFound, TransferErrorClassPermissionDenied, TransferErrorClassNoSpace, TransferErrorClassRateLimited, TransferErrorClassNetwork, TransferErrorClassCorrupted, TransferErrorClassOther:
+		return true
+	}
+	return false
+}
+
+func (e TransferErrorClass) String() string {
+	return string(e)
+}
+
+func (e *TransferErrorClass) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TransferErrorClass(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TransferErrorClass", str)
+	}
+	return nil
+}
+
+func (e TransferErrorClass) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TransferErrorClass) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TransferErrorClass) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// 连接 Fs 缓存的预热状态
+type WarmupState string
+
+const (
+	// 等待预热
+	WarmupStatePending WarmupState = "PENDING"
+	// 正在预热
+	WarmupStateWarming WarmupState = "WARMING"
+	// 所有远程路径已预热
+	WarmupStateReady WarmupState = "READY"
+	// 部分远程路径预热失败，这些路径在作业启动时重新创建
+	WarmupStateFailed WarmupState = "FAILED"
+)
+
+var AllWarmupState = []WarmupState{
+	WarmupStatePending,
+	WarmupStateWarming,
+	WarmupStateReady,
+	WarmupStateFailed,
+}
+
+func (e WarmupState) IsValid() bool {
+	switch e {
+	case WarmupStatePending, WarmupStateWarming, WarmupStateReady, WarmupStateFailed:
+		return true
+	}
+	return false
+}
+
+func (e WarmupState) String() string {
+	return string(e)
+}
+
+func (e *WarmupState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WarmupState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WarmupState", str)
+	}
+	return nil
+}
+
+func (e WarmupState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WarmupState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WarmupState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Windows 文件名处理方式
+type WindowsNameHandling string
+
+const (
+	// 编码 - 保留名称（如 aux.txt 变为 aux_.txt）、非法字符和末尾的空格/句点在目标端替换为等价的 Unicode 字符，仅单向同步有效
+	// 双向同步不支持编码，按 SKIP 处理并在作业日志中给出警告
+	WindowsNameHandlingEncode WindowsNameHandling = "ENCODE"
+	// 跳过 - 不同步名称与 Windows 不兼容的文件和目录
+	WindowsNameHandlingSkip WindowsNameHandling = "SKIP"
+)
+
+var AllWindowsNameHandling = []WindowsNameHandling{
+	WindowsNameHandlingEncode,
+	WindowsNameHandlingSkip,
+}
+
+func (e WindowsNameHandling) IsValid() bool {
+	switch e {
+	case WindowsNameHandlingEncode, WindowsNameHandlingSkip:
+		return true
+	}
+	return false
+}
+
+func (e WindowsNameHandling) String() string {
+	return string(e)
+}
+
+func (e *WindowsNameHandling) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WindowsNameHandling(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WindowsNameHandling", str)
+	}
+	return nil
+}
+
+func (e WindowsNameHandling) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WindowsNameHandling) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WindowsNameHandling) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -29,7 +29,7 @@ func (s *AtomicityTestSuite) TestConnectionMutation_CreateRollbackOnDuplicate()
 			connection {
 				create(input: {
 					name: "duplicate-test",
-					type: "local",
+					type: local,
 					config: {}
 				}) {
 					id
@@ -74,11 +74,11 @@ func (s *AtomicityTestSuite) TestTaskMutation_CreateWithInvalidConnection() {
 	nonExistentConnID := uuid.New()
 
 	createMutation := `
-		mutation($connId: ID!) {
+		mutation($connId: ID!, $sourcePath: String!) {
 			task {
 				create(input: {
 					name: "invalid-conn-task",
-					sourcePath: "/tmp/source",
+					sourcePath: $sourcePath,
 					connectionId: $connId,
 					remotePath: "/remote",
 					direction: UPLOAD
@@ -91,7 +91,8 @@ func (s *AtomicityTestSuite) TestTaskMutation_CreateWithInvalidConnection() {
 	`
 
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
-		"connId": nonExistentConnID.String(),
+		"connId":     nonExistentConnID.String(),
+		"sourcePath": s.Env.SourcePath(s.T(), "source"),
 	})
 
 	// Should fail due to invalid connection reference
@@ -129,7 +130,7 @@ func (s *AtomicityTestSuite) TestConnectionMutation_DeleteWithTasks() {
 			connection {
 				create(input: {
 					name: "conn-with-tasks-gql",
-					type: "local",
+					type: local,
 					config: {}
 				}) {
 					id
@@ -145,11 +146,11 @@ func (s *AtomicityTestSuite) TestConnectionMutation_DeleteWithTasks() {
 
 	// Create a task referencing this connection via GraphQL
 	createTaskMutation := `
-		mutation($connId: ID!) {
+		mutation($connId: ID!, $sourcePath: String!) {
 			task {
 				create(input: {
 					name: "task-blocking-delete",
-					sourcePath: "/tmp/source",
+					sourcePath: $sourcePath,
 					connectionId: $connId,
 					remotePath: "/remote",
 					direction: UPLOAD
@@ -161,7 +162,8 @@ func (s *AtomicityTestSuite) TestConnectionMutation_DeleteWithTasks() {
 	`
 
 	taskResp := s.Env.ExecuteGraphQLWithVars(s.T(), createTaskMutation, map[string]interface{}{
-		"connId": connID,
+		"connId":     connID,
+		"sourcePath": s.Env.SourcePath(s.T(), "source"),
 	})
 	require.Empty(s.T(), taskResp.Errors)
 	taskID := gjson.Get(string(taskResp.Data), "task.create.id").String()
@@ -233,7 +235,7 @@ func (s *AtomicityTestSuite) TestTaskMutation_UpdateWithInvalidConnection() {
 			connection {
 				create(input: {
 					name: "valid-conn-for-update-gql",
-					type: "local",
+					type: local,
 					config: {}
 				}) {
 					id
@@ -249,11 +251,11 @@ func (s *AtomicityTestSuite) TestTaskMutation_UpdateWithInvalidConnection() {
 
 	// Create a task via GraphQL
 	createTaskMutation := `
-		mutation($connId: ID!) {
+		mutation($connId: ID!, $sourcePath: String!) {
 			task {
 				create(input: {
 					name: "task-for-update-test-gql",
-					sourcePath: "/tmp/source",
+					sourcePath: $sourcePath,
 					connectionId: $connId,
 					remotePath: "/remote",
 					direction: UPLOAD
@@ -266,7 +268,8 @@ func (s *AtomicityTestSuite) TestTaskMutation_UpdateWithInvalidConnection() {
 	`
 
 	taskResp := s.Env.ExecuteGraphQLWithVars(s.T(), createTaskMutation, map[string]interface{}{
-		"connId": connID,
+		"connId":     connID,
+		"sourcePath": s.Env.SourcePath(s.T(), "source"),
 	})
 	require.Empty(s.T(), taskResp.Errors)
 	taskID := gjson.Get(string(taskResp.Data), "task.create.id").String()
@@ -313,12 +316,12 @@ func (s *AtomicityTestSuite) TestImportMutation_PartialFailure() {
 					connections: [
 						{
 							name: "new-import-conn",
-							type: "local",
+							type: local,
 							config: {}
 						},
 						{
 							name: "existing-for-import",
-							type: "local",
+							type: local,
 							config: {}
 						}
 					]
@@ -468,11 +471,11 @@ func (s *AtomicityTestSuite) TestTaskMutation_CreateValidationAtomicity() {
 
 	// Try to create task with empty name which might be caught by business logic
 	createTaskMutation := `
-		mutation($connId: ID!) {
+		mutation($connId: ID!, $sourcePath: String!) {
 			task {
 				create(input: {
 					name: "",
-					sourcePath: "/tmp/source",
+					sourcePath: $sourcePath,
 					connectionId: $connId,
 					remotePath: "/remote",
 					direction: UPLOAD
@@ -484,7 +487,8 @@ func (s *AtomicityTestSuite) TestTaskMutation_CreateValidationAtomicity() {
 	`
 
 	taskResp := s.Env.ExecuteGraphQLWithVars(s.T(), createTaskMutation, map[string]interface{}{
-		"connId": connID.String(),
+		"connId":     connID.String(),
+		"sourcePath": s.Env.SourcePath(s.T(), "source"),
 	})
 
 	// If validation catches empty name, it should fail
@@ -606,11 +610,11 @@ func (s *AtomicityTestSuite) TestTaskMutation_CreateAndDeleteCycle() {
 
 	// Create a task
 	createMutation := `
-		mutation($connId: ID!) {
+		mutation($connId: ID!, $sourcePath: String!) {
 			task {
 				create(input: {
 					name: "cycle-test-task",
-					sourcePath: "/tmp/source",
+					sourcePath: $sourcePath,
 					connectionId: $connId,
 					remotePath: "/remote",
 					direction: UPLOAD
@@ -623,7 +627,8 @@ func (s *AtomicityTestSuite) TestTaskMutation_CreateAndDeleteCycle() {
 	`
 
 	createResp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
-		"connId": connID.String(),
+		"connId":     connID.String(),
+		"sourcePath": s.Env.SourcePath(s.T(), "source"),
 	})
 	require.Empty(s.T(), createResp.Errors)
 	taskID := gjson.Get(string(createResp.Data), "task.create.id").String()
@@ -673,7 +678,7 @@ func (s *AtomicityTestSuite) TestConnectionMutation_CreateAndDeleteCycle() {
 			connection {
 				create(input: {
 					name: "cycle-test-conn",
-					type: "local",
+					type: local,
 					config: {}
 				}) {
 					id
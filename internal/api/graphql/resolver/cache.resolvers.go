@@ -0,0 +1,67 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// Clear is the resolver for the clear field.
+func (r *cacheMutationResolver) Clear(ctx context.Context, obj *model.CacheMutation, connectionID *uuid.UUID) (int, error) {
+	if connectionID == nil {
+		return rclone.ClearAllFsCache(), nil
+	}
+	conn, err := r.deps.ConnectionService.GetConnectionByID(ctx, *connectionID)
+	if err != nil {
+		return 0, err
+	}
+	return rclone.ClearFsCache(conn.Name), nil
+}
+
+// Entries is the resolver for the entries field.
+func (r *cacheQueryResolver) Entries(ctx context.Context, obj *model.CacheQuery) ([]*model.FsCacheEntry, error) {
+	conns, err := r.deps.ConnectionService.ListConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]uuid.UUID, len(conns))
+	for _, c := range conns {
+		ids[c.Name] = c.ID
+	}
+
+	now := time.Now()
+	entries := rclone.ListFsCache()
+	result := make([]*model.FsCacheEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, fsCacheEntryToModel(e, ids, now))
+	}
+	return result, nil
+}
+
+// Cache is the resolver for the cache field.
+func (r *mutationResolver) Cache(ctx context.Context) (*model.CacheMutation, error) {
+	return &model.CacheMutation{}, nil
+}
+
+// Cache is the resolver for the cache field.
+func (r *queryResolver) Cache(ctx context.Context) (*model.CacheQuery, error) {
+	return &model.CacheQuery{}, nil
+}
+
+// CacheMutation returns generated.CacheMutationResolver implementation.
+func (r *Resolver) CacheMutation() generated.CacheMutationResolver { return &cacheMutationResolver{r} }
+
+// CacheQuery returns generated.CacheQueryResolver implementation.
+func (r *Resolver) CacheQuery() generated.CacheQueryResolver { return &cacheQueryResolver{r} }
+
+type cacheMutationResolver struct{ *Resolver }
+type cacheQueryResolver struct{ *Resolver }
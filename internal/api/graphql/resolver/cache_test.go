@@ -0,0 +1,84 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// CacheResolverTestSuite tests CacheQuery and CacheMutation resolvers.
+type CacheResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestCacheResolverSuite(t *testing.T) {
+	suite.Run(t, new(CacheResolverTestSuite))
+}
+
+// TestCache_EntriesAndClear tests listing cached Fs instances and clearing them per connection.
+func (s *CacheResolverTestSuite) TestCache_EntriesAndClear() {
+	connID := s.Env.CreateTestConnection(s.T(), "cache-conn")
+	dir := s.T().TempDir()
+	_, err := rclone.GetFs(context.Background(), "cache-conn", dir)
+	require.NoError(s.T(), err)
+	s.T().Cleanup(func() { rclone.ClearFsCache("cache-conn") })
+
+	query := `
+		query {
+			cache {
+				entries {
+					connectionId
+					connectionName
+					path
+					createdAt
+					lastUsedAt
+					age
+				}
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+
+	entry := gjson.Get(string(resp.Data), `cache.entries.#(connectionName=="cache-conn")`)
+	require.True(s.T(), entry.Exists())
+	assert.Equal(s.T(), connID.String(), entry.Get("connectionId").String())
+	assert.Equal(s.T(), dir, entry.Get("path").String())
+	assert.GreaterOrEqual(s.T(), entry.Get("age").Int(), int64(0))
+
+	mutation := `
+		mutation($connectionId: ID) {
+			cache {
+				clear(connectionId: $connectionId)
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"connectionId": connID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), int64(1), gjson.Get(string(resp.Data), "cache.clear").Int())
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+	assert.False(s.T(), gjson.Get(string(resp.Data), `cache.entries.#(connectionName=="cache-conn")`).Exists())
+}
+
+// TestCache_ClearUnknownConnection tests that clearing the cache of a missing connection fails.
+func (s *CacheResolverTestSuite) TestCache_ClearUnknownConnection() {
+	mutation := `
+		mutation($connectionId: ID) {
+			cache {
+				clear(connectionId: $connectionId)
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"connectionId": uuid.New().String()})
+	assert.NotEmpty(s.T(), resp.Errors)
+}
@@ -80,6 +80,20 @@ func testPagination(env *TestEnv, t *testing.T, tc PaginationTestCase) {
 	assert.True(t, gjson.Get(data, tc.DataPath+".pageInfo.hasPreviousPage").Bool())
 }
 
+// validationFieldCodes returns the i18n code of each field of the single validation error of the response.
+func validationFieldCodes(t *testing.T, resp *GraphQLResponse) map[string]interface{} {
+	t.Helper()
+	require.Len(t, resp.Errors, 1)
+	fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+	require.True(t, ok)
+	codes := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		field := f.(map[string]interface{})
+		codes[field["field"].(string)] = field["code"]
+	}
+	return codes
+}
+
 // GetNotFoundTestCase represents a test case for get operations with non-existent IDs.
 type GetNotFoundTestCase struct {
 	Name        string
@@ -7,11 +7,15 @@ package resolver
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
 	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 	"go.uber.org/zap"
@@ -24,6 +28,10 @@ func (r *connectionResolver) Config(ctx context.Context, obj *model.Connection)
 
 // LoadStatus is the resolver for the loadStatus field.
 func (r *connectionResolver) LoadStatus(ctx context.Context, obj *model.Connection) (model.ConnectionLoadStatus, error) {
+	// A config that can't be decrypted fails every load of the connection
+	if r.deps.IntegrityService.Failure(obj.ID, obj.UpdatedAt) != nil {
+		return model.ConnectionLoadStatusError, nil
+	}
 	// Check if connection root is loaded in cache (using empty path for root)
 	if rclone.IsConnectionLoaded(obj.Name, "") {
 		return model.ConnectionLoadStatusLoaded, nil
@@ -36,12 +44,20 @@ func (r *connectionResolver) LoadStatus(ctx context.Context, obj *model.Connecti
 
 // LoadError is the resolver for the loadError field.
 func (r *connectionResolver) LoadError(ctx context.Context, obj *model.Connection) (*string, error) {
-	// Currently we don't persist load errors
-	// Return nil to indicate no error
+	if err := r.deps.IntegrityService.Failure(obj.ID, obj.UpdatedAt); err != nil {
+		msg := err.Error()
+		return &msg, nil
+	}
+	// Other load errors are not persisted
 	// TODO: Implement proper load error tracking
 	return nil, nil
 }
 
+// CredentialsExpiringSoon is the resolver for the credentialsExpiringSoon field.
+func (r *connectionResolver) CredentialsExpiringSoon(ctx context.Context, obj *model.Connection) (bool, error) {
+	return r.deps.CredentialService.ExpiringSoon(obj.CredentialsExpireAt), nil
+}
+
 // Tasks is the resolver for the tasks field.
 func (r *connectionResolver) Tasks(ctx context.Context, obj *model.Connection, pagination *model.PaginationInput) (*model.TaskConnection, error) {
 	// Default pagination values
@@ -106,13 +122,100 @@ func (r *connectionResolver) Quota(ctx context.Context, obj *model.Connection) (
 	}, nil
 }
 
-// Create is the resolver for the create field.
-func (r *connectionMutationResolver) Create(ctx context.Context, obj *model.ConnectionMutation, input model.CreateConnectionInput) (*model.Connection, error) {
-	entConn, err := r.deps.ConnectionService.CreateConnection(ctx, input.Name, input.Type, input.Config)
+// Forecast is the resolver for the forecast field.
+func (r *connectionResolver) Forecast(ctx context.Context, obj *model.Connection) (*model.ConnectionForecast, error) {
+	forecast, err := r.deps.UsageService.Forecast(ctx, obj.ID)
+	if err != nil || forecast == nil {
+		return nil, err
+	}
+
+	return &model.ConnectionForecast{
+		DailyGrowth:   forecast.DailyGrowth,
+		Free:          forecast.Free,
+		DaysUntilFull: forecast.DaysUntilFull,
+		FullAt:        forecast.FullAt,
+		SampleCount:   forecast.SampleCount,
+		Since:         forecast.Since,
+		Warning:       forecast.Warning,
+	}, nil
+}
+
+// TransferUsage is the resolver for the transferUsage field.
+func (r *connectionResolver) TransferUsage(ctx context.Context, obj *model.Connection) (*model.ConnectionTransferUsage, error) {
+	now := time.Now()
+	bytes, err := r.deps.JobService.MonthlyTransfer(ctx, obj.ID, now)
 	if err != nil {
 		return nil, err
 	}
-	return entConnectionToModel(entConn), nil
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return transferUsageToModel(month, bytes, obj.MonthlyTransferCap), nil
+}
+
+// TransferHistory is the resolver for the transferHistory field.
+func (r *connectionResolver) TransferHistory(ctx context.Context, obj *model.Connection, months *int) ([]*model.ConnectionTransferUsage, error) {
+	n := 12
+	if months != nil {
+		n = min(*months, maxTransferHistoryMonths)
+	}
+	if n <= 0 {
+		return []*model.ConnectionTransferUsage{}, nil
+	}
+
+	transfers, err := r.deps.JobService.ListMonthlyTransfers(ctx, obj.ID, n)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.ConnectionTransferUsage, len(transfers))
+	for i, t := range transfers {
+		result[i] = transferUsageToModel(t.Month, t.Bytes, obj.MonthlyTransferCap)
+	}
+	return result, nil
+}
+
+// Create is the resolver for the create field.
+func (r *connectionMutationResolver) Create(ctx context.Context, obj *model.ConnectionMutation, input model.CreateConnectionInput, idempotencyKey *string) (*model.Connection, error) {
+	return idempotent(ctx, r.Resolver, idempotencyKey, "connection.create", input, func(ctx context.Context) (*model.Connection, error) {
+		if err := r.validateCreateConnectionInput(ctx, input); err != nil {
+			return nil, err
+		}
+
+		config := input.Config
+		if input.Preset != nil {
+			if preset, ok := rclone.GetConnectionPreset(*input.Preset); ok {
+				config = preset.Apply(config)
+			}
+		}
+
+		entConn, err := r.deps.ConnectionService.CreateConnection(ctx, input.Name, input.Type, config)
+		if err != nil {
+			return nil, err
+		}
+		if input.BasePath != nil {
+			entConn, err = r.deps.ConnectionService.SetConnectionBasePath(ctx, entConn.ID, *input.BasePath)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if input.PathTpsLimit != nil || input.PathTpsBurst != nil {
+			entConn, err = r.deps.ConnectionService.SetConnectionPacing(ctx, entConn.ID, input.PathTpsLimit, input.PathTpsBurst)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if input.MonthlyTransferCap != nil {
+			entConn, err = r.deps.ConnectionService.SetConnectionTransferCap(ctx, entConn.ID, *input.MonthlyTransferCap)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if display := connectionDisplay(input.DisplayName, input.Color, input.Icon); !display.IsEmpty() {
+			entConn, err = r.deps.ConnectionService.SetConnectionDisplay(ctx, entConn.ID, display)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return entConnectionToModel(entConn), nil
+	}, func(c *model.Connection) uuid.UUID { return c.ID }, r.connectionByID)
 }
 
 // Update is the resolver for the update field.
@@ -122,28 +225,82 @@ func (r *connectionMutationResolver) Update(ctx context.Context, obj *model.Conn
 	if err != nil {
 		return nil, err
 	}
+	if err := r.validateUpdateConnectionInput(ctx, oldConn, input); err != nil {
+		return nil, err
+	}
+	display := connectionDisplay(input.DisplayName, input.Color, input.Icon)
+
+	// The transfer cap only decides whether new jobs are deferred, so it is changed even while tasks are running
+	if input.MonthlyTransferCap != nil {
+		if _, err := r.deps.ConnectionService.SetConnectionTransferCap(ctx, id, *input.MonthlyTransferCap); err != nil {
+			return nil, err
+		}
+	}
+
+	// The display name, color and icon don't affect syncs, so they are changed even while tasks are running
+	if input.Name == nil && input.Config == nil && input.BasePath == nil && input.PathTpsLimit == nil && input.PathTpsBurst == nil &&
+		input.ExpectedConfigVersion == nil {
+		entConn, err := r.deps.ConnectionService.SetConnectionDisplay(ctx, id, display)
+		if err != nil {
+			return nil, err
+		}
+		return entConnectionToModel(entConn), nil
+	}
+
 	oldName := oldConn.Name
+	renamed := input.Name != nil && *input.Name != oldName
 
-	err = r.deps.ConnectionService.UpdateConnection(ctx, id, input.Name, nil, input.Config)
+	// Running syncs would switch to the changed config (or remote name) mid-run, so refuse changes while any task is running.
+	// Jobs that start after this check are caught by ApplyConnectionUpdate within its transaction.
+	// Tasks mirroring to the connection upload to it with its config as well.
+	tasks, err := r.deps.TaskService.ListTasksByConnection(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	mirroring, err := r.deps.TaskService.ListTasksMirroringTo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range slices.Concat(tasks, mirroring) {
+		if r.deps.Runner.IsRunning(t.ID) {
+			return nil, i18n.NewI18nError(i18n.ErrConnectionUpdateRunning).WithStatus(409)
+		}
+	}
+
+	entConn, err := r.deps.ConnectionService.ApplyConnectionUpdate(ctx, id, services.ConnectionUpdate{
+		Name:            input.Name,
+		Config:          input.Config,
+		BasePath:        input.BasePath,
+		PathTPSLimit:    input.PathTpsLimit,
+		PathTPSBurst:    input.PathTpsBurst,
+		Display:         display,
+		ExpectedVersion: input.ExpectedConfigVersion,
+	})
+	switch {
+	case errors.Is(err, services.ErrConnectionInUse):
+		return nil, i18n.NewI18nError(i18n.ErrConnectionUpdateRunning).WithStatus(409).WithCause(err)
+	case errors.Is(err, services.ErrConnectionVersionConflict):
+		return nil, i18n.NewI18nError(i18n.ErrConnectionVersionConflict).WithStatus(409).WithCause(err)
+	case err != nil:
+		return nil, err
+	}
 
 	// Clear Fs cache for the old connection name to ensure stale cached Fs is removed.
 	// This is necessary because UpdateConnection may not go through storage.go's SetValue/DeleteSection
 	// which already calls cache.ClearConfig internally.
 	rclone.ClearFsCache(oldName)
 
-	// If name changed, also clear new name cache (defensive, though it shouldn't exist yet)
-	if input.Name != nil && *input.Name != oldName {
-		rclone.ClearFsCache(*input.Name)
+	// If name changed, move the bisync state to the new remote name so the next run doesn't force a resync.
+	// This also clears the Fs cache of both names.
+	if renamed {
+		if _, err := r.deps.SyncEngine.RenameRemoteState(ctx, oldName, *input.Name, tasks); err != nil {
+			logger.Named("api.graphql.resolver.connection").Warn("Failed to migrate bisync state for renamed connection",
+				zap.String("old_name", oldName),
+				zap.String("new_name", *input.Name),
+				zap.Error(err))
+		}
 	}
 
-	// Fetch updated connection
-	entConn, err := r.deps.ConnectionService.GetConnectionByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
 	return entConnectionToModel(entConn), nil
 }
 
@@ -157,7 +314,7 @@ func (r *connectionMutationResolver) Delete(ctx context.Context, obj *model.Conn
 	conn := entConnectionToModel(entConn)
 	connName := entConn.Name
 
-	// Check if connection has dependent tasks
+	// Check if connection has dependent tasks, including the tasks mirroring to it
 	taskCount, err := r.deps.ConnectionService.CountAssociatedTasks(ctx, id)
 	if err != nil {
 		return nil, err
@@ -180,7 +337,7 @@ func (r *connectionMutationResolver) Delete(ctx context.Context, obj *model.Conn
 }
 
 // Test is the resolver for the test field.
-func (r *connectionMutationResolver) Test(ctx context.Context, obj *model.ConnectionMutation, id uuid.UUID) (model.TestConnectionResult, error) {
+func (r *connectionMutationResolver) Test(ctx context.Context, obj *model.ConnectionMutation, id uuid.UUID, remotePath *string) (model.TestConnectionResult, error) {
 	// Get connection to get its type
 	entConn, err := r.deps.ConnectionService.GetConnectionByID(ctx, id)
 	if err != nil {
@@ -193,36 +350,56 @@ func (r *connectionMutationResolver) Test(ctx context.Context, obj *model.Connec
 		return nil, err
 	}
 
-	// Test the remote configuration
-	if err := rclone.TestRemote(ctx, entConn.Type, config); err != nil {
-		//nolint:nilerr // Returning test failure as union result, not as error
-		return &model.ConnectionTestFailure{
-			Error: err.Error(),
-		}, nil
-	}
-
-	return &model.ConnectionTestSuccess{
-		Message: "Connection test successful",
-	}, nil
+	result := testConnection(ctx, string(entConn.Type), config, remotePath)
+	recordConnectionHealth(ctx, r.deps.ConnectionService, entConn, result)
+	return result, nil
 }
 
 // TestUnsaved is the resolver for the testUnsaved field.
 func (r *connectionMutationResolver) TestUnsaved(ctx context.Context, obj *model.ConnectionMutation, input model.TestConnectionInput) (model.TestConnectionResult, error) {
-	// Test the remote configuration
-	if err := rclone.TestRemote(ctx, input.Type, input.Config); err != nil {
-		//nolint:nilerr // Returning test failure as union result, not as error
-		return &model.ConnectionTestFailure{
-			Error: err.Error(),
-		}, nil
+	return testConnection(ctx, string(input.Type), input.Config, input.RemotePath), nil
+}
+
+// TestAll is the resolver for the testAll field.
+func (r *connectionMutationResolver) TestAll(ctx context.Context, obj *model.ConnectionMutation) (*model.ConnectionTestReport, error) {
+	conns, err := r.deps.ConnectionService.ListConnections(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return testAllConnections(ctx, r.deps.ConnectionService, conns, connectionTestConcurrency), nil
+}
 
-	return &model.ConnectionTestSuccess{
-		Message: "Connection test successful",
-	}, nil
+// MigrateTasks is the resolver for the migrateTasks field.
+func (r *connectionMutationResolver) MigrateTasks(ctx context.Context, obj *model.ConnectionMutation, fromID uuid.UUID, toID uuid.UUID, options *model.MigrateTasksOptions) (*model.MigrateTasksReport, error) {
+	if fromID == toID {
+		return nil, i18n.NewI18nError(i18n.ErrMigrateSameConnection).WithStatus(400)
+	}
+	from, err := r.deps.ConnectionService.GetConnectionByID(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := r.deps.ConnectionService.GetConnectionByID(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := r.deps.TaskService.ListTasksByConnection(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	if options != nil && len(options.TaskIds) > 0 {
+		tasks, err = selectMigratedTasks(tasks, options.TaskIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	reset := options != nil && options.ResetBisyncState != nil && *options.ResetBisyncState
+
+	return r.migrateTasks(ctx, tasks, from, to, reset)
 }
 
 // List is the resolver for the list field.
-func (r *connectionQueryResolver) List(ctx context.Context, obj *model.ConnectionQuery, pagination *model.PaginationInput) (*model.ConnectionConnection, error) {
+func (r *connectionQueryResolver) List(ctx context.Context, obj *model.ConnectionQuery, pagination *model.PaginationInput, sortBy model.ConnectionSortField, sortDirection model.SortDirection) (*model.ConnectionConnection, error) {
 	// Default pagination values (0 means no limit, return all)
 	limit := 0
 	offset := 0
@@ -236,7 +413,7 @@ func (r *connectionQueryResolver) List(ctx context.Context, obj *model.Connectio
 	}
 
 	// Use ConnectionService to list connections (limit=0 returns all)
-	entConnections, totalCount, err := r.deps.ConnectionService.ListConnectionsPaginated(ctx, limit, offset)
+	entConnections, totalCount, err := r.deps.ConnectionService.ListConnectionsPaginated(ctx, limit, offset, sortBy, sortDirection)
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +450,70 @@ func (r *connectionQueryResolver) Get(ctx context.Context, obj *model.Connection
 	return entConnectionToModel(entConnection), nil
 }
 
+// Export is the resolver for the export field.
+func (r *connectionQueryResolver) Export(ctx context.Context, obj *model.ConnectionQuery, ids []uuid.UUID, password *string) (string, error) {
+	if ids == nil {
+		conns, err := r.deps.ConnectionService.ListConnections(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, conn := range conns {
+			ids = append(ids, conn.ID)
+		}
+	}
+
+	parsed := make([]rclone.ParsedConnection, len(ids))
+	for i, id := range ids {
+		conn, err := r.deps.ConnectionService.GetConnectionByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		config, err := r.deps.ConnectionService.GetConnectionConfigByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		parsed[i] = rclone.ParsedConnection{Name: conn.Name, Type: string(conn.Type), Config: config}
+	}
+	content, err := rclone.FormatRcloneConf(parsed)
+	if err != nil {
+		return "", err
+	}
+
+	if password == nil || *password == "" {
+		return content, nil
+	}
+	content, err = rclone.EncryptRcloneConf(content, *password)
+	if errors.Is(err, rclone.ErrConfigPasswordInvalid) {
+		return "", i18n.ErrBadRequestI18n(i18n.ErrConfigPasswordInvalid).WithCause(err)
+	}
+	return content, err
+}
+
+// MediaAlbums is the resolver for the mediaAlbums field.
+func (r *connectionQueryResolver) MediaAlbums(ctx context.Context, obj *model.ConnectionQuery, id uuid.UUID) ([]*model.MediaAlbum, error) {
+	conn, err := r.deps.ConnectionService.GetConnectionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !rclone.IsMediaBackend(string(conn.Type)) {
+		return nil, i18n.NewI18nError(i18n.ErrNotMediaConnection)
+	}
+
+	albums, err := rclone.ListMediaAlbums(ctx, conn.Name)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.MediaAlbum, len(albums))
+	for i, album := range albums {
+		result[i] = &model.MediaAlbum{
+			Title:      album.Title,
+			RemotePath: album.Path,
+			Shared:     album.Shared,
+		}
+	}
+	return result, nil
+}
+
 // Connection is the resolver for the connection field.
 func (r *mutationResolver) Connection(ctx context.Context) (*model.ConnectionMutation, error) {
 	return &model.ConnectionMutation{}, nil
@@ -2,14 +2,20 @@
 package resolver_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // ConnectionResolverTestSuite tests ConnectionQuery and ConnectionMutation resolvers.
@@ -91,6 +97,27 @@ func (s *ConnectionResolverTestSuite) TestConnectionQuery_ListWithPagination() {
 	}
 }
 
+// TestConnectionQuery_ListSorted tests ConnectionQuery.list with sortBy and sortDirection.
+func (s *ConnectionResolverTestSuite) TestConnectionQuery_ListSorted() {
+	for _, name := range []string{"beta", "alpha", "gamma"} {
+		s.Env.CreateTestConnection(s.T(), name)
+	}
+
+	query := `
+		query {
+			connection {
+				default: list { items { name } }
+				byName: list(sortBy: NAME, sortDirection: ASC) { items { name } }
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, nil)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), `["gamma","alpha","beta"]`, gjson.Get(data, "connection.default.items.#.name").Raw, "newest first by default")
+	assert.Equal(s.T(), `["alpha","beta","gamma"]`, gjson.Get(data, "connection.byName.items.#.name").Raw)
+}
+
 // TestConnectionQuery_Get tests ConnectionQuery.get resolver.
 func (s *ConnectionResolverTestSuite) TestConnectionQuery_Get() {
 	connID := s.Env.CreateTestConnection(s.T(), "my-connection")
@@ -130,6 +157,62 @@ func (s *ConnectionResolverTestSuite) TestConnectionQuery_GetNotFound() {
 	})
 }
 
+// TestConnectionQuery_Export tests ConnectionQuery.export.
+func (s *ConnectionResolverTestSuite) TestConnectionQuery_Export() {
+	firstID := s.Env.CreateTestConnection(s.T(), "export-first")
+	s.Env.CreateTestConnection(s.T(), "export-second")
+
+	query := `
+		query($ids: [ID!], $password: String) {
+			connection {
+				export(ids: $ids, password: $password)
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, nil)
+	require.Empty(s.T(), resp.Errors)
+	content := gjson.Get(string(resp.Data), "connection.export").String()
+	assert.Contains(s.T(), content, "[export-first]")
+	assert.Contains(s.T(), content, "[export-second]")
+	assert.Contains(s.T(), content, "type = local")
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"ids": []string{firstID.String()},
+	})
+	require.Empty(s.T(), resp.Errors)
+	content = gjson.Get(string(resp.Data), "connection.export").String()
+	assert.Contains(s.T(), content, "[export-first]")
+	assert.NotContains(s.T(), content, "[export-second]")
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"password": "   ",
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrConfigPasswordInvalid, resp.Errors[0].Extensions["code"])
+}
+
+// TestConnectionQuery_MediaAlbums tests ConnectionQuery.mediaAlbums.
+func (s *ConnectionResolverTestSuite) TestConnectionQuery_MediaAlbums() {
+	connID := s.Env.CreateTestConnection(s.T(), "not-media")
+
+	query := `
+		query($id: ID!) {
+			connection {
+				mediaAlbums(id: $id) { title remotePath shared }
+			}
+		}
+	`
+
+	// Only media connections have albums
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": connID.String()})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrNotMediaConnection, resp.Errors[0].Extensions["code"])
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": uuid.New().String()})
+	require.NotEmpty(s.T(), resp.Errors)
+}
+
 // TestConnectionMutation_Create tests ConnectionMutation.create resolver.
 func (s *ConnectionResolverTestSuite) TestConnectionMutation_Create() {
 	mutation := `
@@ -416,6 +499,67 @@ func (s *ConnectionResolverTestSuite) TestConnectionMutation_Test() {
 	assert.True(s.T(), successMsg.Exists() || errorMsg.Exists(), "Either success message or error should exist")
 }
 
+// TestConnectionMutation_TestAll tests ConnectionMutation.testAll resolver.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_TestAll() {
+	connA := s.Env.CreateTestConnection(s.T(), "conn-test-all-a")
+	connB := s.Env.CreateTestConnection(s.T(), "conn-test-all-b")
+
+	mutation := `
+		mutation {
+			connection {
+				testAll {
+					total
+					healthy
+					unhealthy
+					results {
+						connection { id name healthStatus healthCheckedAt healthError }
+						result { __typename }
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: mutation})
+	require.Empty(s.T(), resp.Errors)
+
+	report := gjson.Get(string(resp.Data), "connection.testAll")
+	total := int(report.Get("total").Int())
+	assert.GreaterOrEqual(s.T(), total, 2)
+	assert.Equal(s.T(), total, int(report.Get("healthy").Int()+report.Get("unhealthy").Int()))
+
+	results := report.Get("results").Array()
+	require.Len(s.T(), results, total)
+	seen := map[string]bool{}
+	for _, r := range results {
+		seen[r.Get("connection.id").String()] = true
+		healthy := r.Get("result.__typename").String() == "ConnectionTestSuccess"
+		if healthy {
+			assert.Equal(s.T(), "HEALTHY", r.Get("connection.healthStatus").String())
+			assert.False(s.T(), r.Get("connection.healthError").Exists() && r.Get("connection.healthError").Type != gjson.Null)
+		} else {
+			assert.Equal(s.T(), "UNHEALTHY", r.Get("connection.healthStatus").String())
+			assert.NotEmpty(s.T(), r.Get("connection.healthError").String())
+		}
+		assert.NotEmpty(s.T(), r.Get("connection.healthCheckedAt").String())
+	}
+	assert.True(s.T(), seen[connA.String()])
+	assert.True(s.T(), seen[connB.String()])
+
+	// The stored health status is visible on subsequent queries
+	query := `
+		query($id: ID!) {
+			connection {
+				get(id: $id) { healthStatus healthCheckedAt }
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": connA.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.NotEmpty(s.T(), gjson.Get(string(resp.Data), "connection.get.healthStatus").String())
+	assert.NotEmpty(s.T(), gjson.Get(string(resp.Data), "connection.get.healthCheckedAt").String())
+}
+
 // TestConnectionMutation_TestUnsaved tests ConnectionMutation.testUnsaved resolver.
 func (s *ConnectionResolverTestSuite) TestConnectionMutation_TestUnsaved() {
 	mutation := `
@@ -450,6 +594,45 @@ func (s *ConnectionResolverTestSuite) TestConnectionMutation_TestUnsaved() {
 	assert.True(s.T(), successMsg.Exists() || errorMsg.Exists(), "Either success message or error should exist")
 }
 
+// TestConnectionMutation_TestUnsaved_RemotePath tests the capability probe of testUnsaved.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_TestUnsaved_RemotePath() {
+	mutation := `
+		mutation($input: TestConnectionInput!) {
+			connection {
+				testUnsaved(input: $input) {
+					... on ConnectionTestSuccess {
+						message
+						capabilities {
+							capability
+							status
+							error
+						}
+					}
+					... on ConnectionTestFailure {
+						error
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"type":       "local",
+			"config":     map[string]interface{}{},
+			"remotePath": s.T().TempDir(),
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	capabilities := gjson.Get(data, "connection.testUnsaved.capabilities").Array()
+	require.Len(s.T(), capabilities, 4)
+	for _, c := range capabilities {
+		assert.Equal(s.T(), "OK", c.Get("status").String(), c.Get("capability").String())
+	}
+}
+
 // TestConnection_Quota tests Connection.quota field resolver.
 func (s *ConnectionResolverTestSuite) TestConnection_Quota() {
 	connID := s.Env.CreateTestConnection(s.T(), "conn-quota")
@@ -517,6 +700,54 @@ func (s *ConnectionResolverTestSuite) TestConnection_QuotaExtendedFields() {
 	// The important thing is that the query executes without error
 }
 
+// TestConnection_Forecast tests the usage forecast of a connection from its daily usage samples.
+func (s *ConnectionResolverTestSuite) TestConnection_Forecast() {
+	connID := s.Env.CreateTestConnection(s.T(), "conn-forecast")
+	ctx := context.Background()
+
+	query := `
+		query($id: ID!) {
+			connection {
+				get(id: $id) {
+					forecast {
+						dailyGrowth
+						free
+						daysUntilFull
+						sampleCount
+						warning
+					}
+				}
+			}
+		}
+	`
+
+	// No forecast without samples
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": connID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "null", gjson.Get(string(resp.Data), "connection.get.forecast").Raw)
+
+	// 1 GiB more used per day over the last three days, 3 GiB free on the latest day
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for i, used := range []int64{1 << 30, 2 << 30, 3 << 30} {
+		s.Env.Client.ConnectionUsage.Create().
+			SetConnectionID(connID).
+			SetDay(today.AddDate(0, 0, i-2)).
+			SetUsed(used).
+			SetFree(6<<30 - used).
+			SaveX(ctx)
+	}
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": connID.String()})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(1<<30), gjson.Get(data, "connection.get.forecast.dailyGrowth").Int())
+	assert.Equal(s.T(), int64(3<<30), gjson.Get(data, "connection.get.forecast.free").Int())
+	assert.Equal(s.T(), int64(3), gjson.Get(data, "connection.get.forecast.daysUntilFull").Int())
+	assert.Equal(s.T(), int64(3), gjson.Get(data, "connection.get.forecast.sampleCount").Int())
+	assert.False(s.T(), gjson.Get(data, "connection.get.forecast.warning").Bool(), "Warnings are disabled by default")
+}
+
 // TestConnection_DeleteWithTasks tests that deleting a connection with tasks fails.
 func (s *ConnectionResolverTestSuite) TestConnection_DeleteWithTasks() {
 	connID := s.Env.CreateTestConnection(s.T(), "conn-with-tasks")
@@ -539,6 +770,31 @@ func (s *ConnectionResolverTestSuite) TestConnection_DeleteWithTasks() {
 	require.NotEmpty(s.T(), resp.Errors)
 }
 
+// TestConnection_DeleteMirrorTarget tests that a connection used as the mirror of a task can't be deleted.
+func (s *ConnectionResolverTestSuite) TestConnection_DeleteMirrorTarget() {
+	connID := s.Env.CreateTestConnection(s.T(), "conn-primary")
+	mirrorID := s.Env.CreateTestConnection(s.T(), "conn-mirror-target")
+	_, err := s.Env.TaskService.CreateTask(context.Background(), "mirrored-task", s.T().TempDir(), connID, "/remote",
+		string(model.SyncDirectionUpload), "", false,
+		&model.TaskSyncOptions{Mirrors: []*model.TaskMirror{{ConnectionID: mirrorID, RemotePath: "/mirror"}}})
+	require.NoError(s.T(), err)
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!) {
+			connection {
+				delete(id: $id) {
+					id
+				}
+			}
+		}
+	`, map[string]interface{}{"id": mirrorID.String()})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrConnectionHasDependentTasks, resp.Errors[0].Extensions["code"])
+
+	_, err = s.Env.ConnectionService.GetConnectionByID(context.Background(), mirrorID)
+	assert.NoError(s.T(), err, "the mirror connection is kept")
+}
+
 // TestConnection_TasksWithPagination tests Connection.tasks field with pagination.
 func (s *ConnectionResolverTestSuite) TestConnection_TasksWithPagination() {
 	connID := s.Env.CreateTestConnection(s.T(), "conn-many-tasks")
@@ -668,6 +924,277 @@ func (s *ConnectionResolverTestSuite) TestConnectionMutation_CreateWithConfig()
 	assert.True(s.T(), config.Exists())
 }
 
+// TestConnectionMutation_CreateWithPreset tests that ConnectionMutation.create fills in and validates the config of a preset.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_CreateWithPreset() {
+	mutation := `
+		mutation($input: CreateConnectionInput!) {
+			connection {
+				create(input: $input) {
+					id
+				}
+			}
+		}
+	`
+	create := func(preset, providerType string, config map[string]interface{}) *GraphQLResponse {
+		return s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":   "preset-" + preset,
+				"type":   providerType,
+				"config": config,
+				"preset": preset,
+			},
+		})
+	}
+
+	resp := create("unknown", "s3", map[string]interface{}{})
+	assert.Equal(s.T(), map[string]interface{}{"preset": i18n.ErrPresetNotFound}, validationFieldCodes(s.T(), resp))
+
+	resp = create("wasabi", "local", map[string]interface{}{})
+	assert.Equal(s.T(), map[string]interface{}{"type": i18n.ErrPresetTypeMismatch}, validationFieldCodes(s.T(), resp))
+
+	resp = create("backblaze-b2-s3", "s3", map[string]interface{}{"access_key_id": "key"})
+	assert.Equal(s.T(), map[string]interface{}{
+		"config.region":            i18n.ErrMissingParameter,
+		"config.endpoint":          i18n.ErrMissingParameter,
+		"config.secret_access_key": i18n.ErrMissingParameter,
+	}, validationFieldCodes(s.T(), resp))
+
+	resp = create("backblaze-b2-s3", "s3", map[string]interface{}{
+		"region":            "us-west-004",
+		"access_key_id":     "key",
+		"secret_access_key": "secret",
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	connID, err := uuid.Parse(gjson.Get(string(resp.Data), "connection.create.id").String())
+	require.NoError(s.T(), err)
+	config, err := s.Env.Deps.ConnectionService.GetConnectionConfigByID(context.Background(), connID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Other", config["provider"])
+	assert.Equal(s.T(), "https://s3.us-west-004.backblazeb2.com", config["endpoint"])
+	assert.Equal(s.T(), "key", config["access_key_id"])
+}
+
+// TestConnectionMutation_Display tests setting, validating and clearing the display name, color and icon of a connection.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_Display() {
+	createMutation := `
+		mutation($input: CreateConnectionInput!) {
+			connection {
+				create(input: $input) {
+					id
+					displayName
+					color
+					icon
+					configVersion
+				}
+			}
+		}
+	`
+	create := func(name, color, icon string) *GraphQLResponse {
+		return s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":        name,
+				"type":        "local",
+				"config":      map[string]interface{}{},
+				"displayName": "  Work OneDrive ",
+				"color":       color,
+				"icon":        icon,
+			},
+		})
+	}
+
+	resp := create("display-invalid", "blue", "Cloud Icon")
+	assert.Equal(s.T(), map[string]interface{}{
+		"color": i18n.ErrColorInvalid,
+		"icon":  i18n.ErrIconInvalid,
+	}, validationFieldCodes(s.T(), resp))
+
+	resp = create("display-work", "#1E90FF", "onedrive")
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), "Work OneDrive", gjson.Get(data, "connection.create.displayName").String())
+	assert.Equal(s.T(), "#1e90ff", gjson.Get(data, "connection.create.color").String())
+	assert.Equal(s.T(), "onedrive", gjson.Get(data, "connection.create.icon").String())
+	id := gjson.Get(data, "connection.create.id").String()
+	version := gjson.Get(data, "connection.create.configVersion").Int()
+
+	// Changing only the display doesn't bump the config version, empty strings clear it
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!, $input: UpdateConnectionInput!) {
+			connection {
+				update(id: $id, input: $input) {
+					displayName
+					color
+					icon
+					configVersion
+				}
+			}
+		}
+	`, map[string]interface{}{
+		"id":    id,
+		"input": map[string]interface{}{"displayName": "Personal", "color": ""},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), "Personal", gjson.Get(data, "connection.update.displayName").String())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "connection.update.color").Type)
+	assert.Equal(s.T(), "onedrive", gjson.Get(data, "connection.update.icon").String())
+	assert.Equal(s.T(), version, gjson.Get(data, "connection.update.configVersion").Int())
+
+	// The display is included in list queries
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: `query { connection { list { items { name displayName icon } } } }`})
+	require.Empty(s.T(), resp.Errors)
+	for _, item := range gjson.Get(string(resp.Data), "connection.list.items").Array() {
+		if item.Get("name").String() == "display-work" {
+			assert.Equal(s.T(), "Personal", item.Get("displayName").String())
+			assert.Equal(s.T(), "onedrive", item.Get("icon").String())
+		}
+	}
+}
+
+// TestConnectionMutation_Pacing tests setting, validating and clearing the API pacing of a connection.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_Pacing() {
+	createMutation := `
+		mutation($input: CreateConnectionInput!) {
+			connection {
+				create(input: $input) {
+					id
+					pathTpsLimit
+					pathTpsBurst
+				}
+			}
+		}
+	`
+	create := func(name, providerType string, pathTpsLimit float64, pathTpsBurst int) *GraphQLResponse {
+		return s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":         name,
+				"type":         providerType,
+				"config":       map[string]interface{}{},
+				"pathTpsLimit": pathTpsLimit,
+				"pathTpsBurst": pathTpsBurst,
+			},
+		})
+	}
+
+	resp := create("paced-local", "local", 5, 10)
+	assert.Equal(s.T(), map[string]interface{}{
+		"pathTpsLimit": i18n.ErrPacingNotSupported,
+		"pathTpsBurst": i18n.ErrPacingNotSupported,
+	}, validationFieldCodes(s.T(), resp))
+
+	resp = create("paced-drive", "drive", -1, 10)
+	assert.Equal(s.T(), map[string]interface{}{"pathTpsLimit": i18n.ErrPacingNegative}, validationFieldCodes(s.T(), resp))
+
+	resp = create("paced-drive", "drive", 5, 10)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), 5.0, gjson.Get(data, "connection.create.pathTpsLimit").Float())
+	assert.Equal(s.T(), int64(10), gjson.Get(data, "connection.create.pathTpsBurst").Int())
+
+	// Zero clears the pacing
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!, $input: UpdateConnectionInput!) {
+			connection {
+				update(id: $id, input: $input) {
+					pathTpsLimit
+					pathTpsBurst
+				}
+			}
+		}
+	`, map[string]interface{}{
+		"id":    gjson.Get(data, "connection.create.id").String(),
+		"input": map[string]interface{}{"pathTpsBurst": 0},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), 5.0, gjson.Get(data, "connection.update.pathTpsLimit").Float())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "connection.update.pathTpsBurst").Type)
+}
+
+// TestConnectionMutation_TransferCap tests the monthly transfer cap of connections and Connection.transferUsage.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_TransferCap() {
+	createMutation := `
+		mutation($input: CreateConnectionInput!) {
+			connection {
+				create(input: $input) {
+					id
+					monthlyTransferCap
+				}
+			}
+		}
+	`
+	create := func(transferCap int64) *GraphQLResponse {
+		return s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":               "capped-local",
+				"type":               "local",
+				"config":             map[string]interface{}{},
+				"monthlyTransferCap": transferCap,
+			},
+		})
+	}
+
+	resp := create(-1)
+	assert.Equal(s.T(), map[string]interface{}{"monthlyTransferCap": i18n.ErrTransferCapNegative}, validationFieldCodes(s.T(), resp))
+
+	resp = create(1000)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(1000), gjson.Get(data, "connection.create.monthlyTransferCap").Int())
+	connID := gjson.Get(data, "connection.create.id").String()
+
+	// Transfers of the connection's jobs count against the cap
+	ctx := context.Background()
+	task := s.Env.CreateTestTask(s.T(), "capped-task", uuid.MustParse(connID))
+	j, err := s.Env.JobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.FinalizeJob(ctx, j.ID, ports.JobResult{Status: model.JobStatusSuccess, BytesTransferred: 1200})
+	require.NoError(s.T(), err)
+
+	usageQuery := `
+		query($id: ID!) {
+			connection {
+				get(id: $id) {
+					transferUsage { month bytes cap exceeded }
+					transferHistory { bytes }
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), usageQuery, map[string]interface{}{"id": connID})
+	require.Empty(s.T(), resp.Errors)
+	usage := gjson.Get(string(resp.Data), "connection.get.transferUsage")
+	assert.Equal(s.T(), int64(1200), usage.Get("bytes").Int())
+	assert.Equal(s.T(), int64(1000), usage.Get("cap").Int())
+	assert.True(s.T(), usage.Get("exceeded").Bool())
+	month, err := time.Parse(time.RFC3339, usage.Get("month").String())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, month.Day())
+	history := gjson.Get(string(resp.Data), "connection.get.transferHistory").Array()
+	require.Len(s.T(), history, 1)
+	assert.Equal(s.T(), int64(1200), history[0].Get("bytes").Int())
+
+	// Zero clears the cap
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!, $input: UpdateConnectionInput!) {
+			connection {
+				update(id: $id, input: $input) {
+					monthlyTransferCap
+					transferUsage { exceeded }
+				}
+			}
+		}
+	`, map[string]interface{}{
+		"id":    connID,
+		"input": map[string]interface{}{"monthlyTransferCap": 0},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "connection.update.monthlyTransferCap").Type)
+	assert.False(s.T(), gjson.Get(data, "connection.update.transferUsage.exceeded").Bool())
+}
+
 // TestConnectionMutation_UpdateConfig tests ConnectionMutation.update with config changes.
 func (s *ConnectionResolverTestSuite) TestConnectionMutation_UpdateConfig() {
 	connID := s.Env.CreateTestConnection(s.T(), "conn-to-update-config")
@@ -895,3 +1422,332 @@ func (s *ConnectionResolverTestSuite) TestConnectionMutation_CreateDuplicateName
 	})
 	require.NotEmpty(s.T(), resp.Errors, "Should fail with duplicate name")
 }
+
+// TestConnectionMutation_BasePath tests that the connection base path is prepended to its tasks' remote paths.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_BasePath() {
+	connID := s.Env.CreateTestConnection(s.T(), "base-path-conn")
+	task := s.Env.CreateTestTask(s.T(), "base-path-task", connID)
+
+	mutation := `
+		mutation($id: ID!, $input: UpdateConnectionInput!) {
+			connection {
+				update(id: $id, input: $input) {
+					basePath
+				}
+			}
+		}
+	`
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					remotePath
+					resolvedRemotePath
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":    connID.String(),
+		"input": map[string]interface{}{"basePath": "/backups-v2/"},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "/backups-v2", gjson.Get(string(resp.Data), "connection.update.basePath").String())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), "/remote", gjson.Get(data, "task.get.remotePath").String())
+	assert.Equal(s.T(), "/backups-v2/remote", gjson.Get(data, "task.get.resolvedRemotePath").String())
+
+	// An empty base path clears the prefix
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":    connID.String(),
+		"input": map[string]interface{}{"basePath": ""},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(string(resp.Data), "connection.update.basePath").Type)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "/remote", gjson.Get(string(resp.Data), "task.get.resolvedRemotePath").String())
+}
+
+// TestConnectionMutation_UpdateConfigVersion tests that connection updates are versioned and blocked while a job is running.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_UpdateConfigVersion() {
+	connID := s.Env.CreateTestConnection(s.T(), "versioned-conn")
+	task := s.Env.CreateTestTask(s.T(), "versioned-task", connID)
+
+	mutation := `
+		mutation($id: ID!, $input: UpdateConnectionInput!) {
+			connection {
+				update(id: $id, input: $input) {
+					configVersion
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":    connID.String(),
+		"input": map[string]interface{}{"config": map[string]interface{}{"type": "local"}, "expectedConfigVersion": 1},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), int64(2), gjson.Get(string(resp.Data), "connection.update.configVersion").Int())
+
+	// A stale version is rejected
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":    connID.String(),
+		"input": map[string]interface{}{"basePath": "/backups", "expectedConfigVersion": 1},
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+
+	// A running job keeps the config it started with
+	job, err := s.Env.JobService.CreateJob(context.Background(), task.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.UpdateJobStatus(context.Background(), job.ID, string(model.JobStatusRunning), "")
+	require.NoError(s.T(), err)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":    connID.String(),
+		"input": map[string]interface{}{"basePath": "/backups"},
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+
+	entConn, err := s.Env.ConnectionService.GetConnectionByID(context.Background(), connID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, entConn.ConfigVersion)
+	assert.Empty(s.T(), entConn.BasePath)
+}
+
+// TestConnectionMutation_CreateUnknownType tests that ConnectionMutation.create rejects types that are not compiled rclone backends.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_CreateUnknownType() {
+	mutation := `
+		mutation($input: CreateConnectionInput!) {
+			connection {
+				create(input: $input) {
+					id
+					type
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{"name": "typo", "type": "onedrve", "config": map[string]interface{}{}},
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Contains(s.T(), resp.Errors[0].Message, "ConnectionType")
+	exists, err := s.Env.ConnectionService.ConnectionNameExists(context.Background(), "typo")
+	require.NoError(s.T(), err)
+	assert.False(s.T(), exists, "no connection is created for an unknown type")
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{"name": "gcs", "type": "gcs", "config": map[string]interface{}{}},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "gcs", gjson.Get(string(resp.Data), "connection.create.type").String())
+}
+
+// TestConnectionMutation_CreateValidationFields tests that ConnectionMutation.create reports invalid fields.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_CreateValidationFields() {
+	s.Env.CreateTestConnection(s.T(), "taken-name")
+
+	mutation := `
+		mutation($input: CreateConnectionInput!) {
+			connection {
+				create(input: $input) {
+					id
+				}
+			}
+		}
+	`
+
+	tests := []struct {
+		name      string
+		input     map[string]interface{}
+		wantField string
+		wantCode  string
+	}{
+		{
+			name:      "duplicate name",
+			input:     map[string]interface{}{"name": "taken-name", "type": "local", "config": map[string]interface{}{}},
+			wantField: "name",
+			wantCode:  i18n.ErrAlreadyExists,
+		},
+		{
+			name:      "malformed name",
+			input:     map[string]interface{}{"name": "bad:name", "type": "local", "config": map[string]interface{}{}},
+			wantField: "name",
+			wantCode:  i18n.ErrConnectionNameInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": tt.input})
+			require.Len(s.T(), resp.Errors, 1)
+			assert.Equal(s.T(), i18n.ErrValidationFailed, resp.Errors[0].Extensions["code"])
+
+			fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+			require.True(s.T(), ok)
+			require.Len(s.T(), fields, 1)
+			field := fields[0].(map[string]interface{})
+			assert.Equal(s.T(), tt.wantField, field["field"])
+			assert.Equal(s.T(), tt.wantCode, field["code"])
+		})
+	}
+}
+
+// TestConnectionMutation_MigrateTasks tests moving tasks to another connection.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_MigrateTasks() {
+	fromID := s.Env.CreateTestConnection(s.T(), "migrate-from")
+	toID := s.Env.CreateTestConnection(s.T(), "migrate-to")
+	otherID := s.Env.CreateTestConnection(s.T(), "migrate-other")
+	taskB := s.Env.CreateTestTask(s.T(), "task-b", fromID)
+	taskA := s.Env.CreateTestTask(s.T(), "task-a", fromID)
+	kept := s.Env.CreateTestTask(s.T(), "task-kept", fromID)
+	other := s.Env.CreateTestTask(s.T(), "task-other", otherID)
+
+	mutation := `
+		mutation($fromId: ID!, $toId: ID!, $options: MigrateTasksOptions) {
+			connection {
+				migrateTasks(fromId: $fromId, toId: $toId, options: $options) {
+					migrated
+					skipped
+					items {
+						task { id name connection { id } }
+						migrated
+						error
+						stateFiles
+					}
+				}
+			}
+		}
+	`
+	migrate := func(from, to uuid.UUID, taskIDs ...uuid.UUID) *GraphQLResponse {
+		ids := make([]string, 0, len(taskIDs))
+		for _, id := range taskIDs {
+			ids = append(ids, id.String())
+		}
+		return s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+			"fromId":  from.String(),
+			"toId":    to.String(),
+			"options": map[string]interface{}{"taskIds": ids, "resetBisyncState": true},
+		})
+	}
+
+	// Tasks can't be migrated to their own connection
+	resp := migrate(fromID, fromID)
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrMigrateSameConnection, resp.Errors[0].Extensions["code"])
+
+	// Only tasks of the source connection can be selected
+	resp = migrate(fromID, toID, taskA.ID, other.ID)
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrMigrateTaskNotOnConnection, resp.Errors[0].Extensions["code"])
+
+	resp = migrate(fromID, toID, taskB.ID, taskA.ID)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(2), gjson.Get(data, "connection.migrateTasks.migrated").Int())
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "connection.migrateTasks.skipped").Int())
+	items := gjson.Get(data, "connection.migrateTasks.items").Array()
+	require.Len(s.T(), items, 2)
+	for i, name := range []string{"task-a", "task-b"} {
+		assert.Equal(s.T(), name, items[i].Get("task.name").String())
+		assert.Equal(s.T(), toID.String(), items[i].Get("task.connection.id").String())
+		assert.True(s.T(), items[i].Get("migrated").Bool())
+		assert.Equal(s.T(), gjson.Null, items[i].Get("error").Type)
+	}
+
+	ctx := context.Background()
+	moved, err := s.Env.Client.Task.Get(ctx, taskA.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), toID, moved.ConnectionID)
+	unselected, err := s.Env.Client.Task.Get(ctx, kept.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), fromID, unselected.ConnectionID)
+
+	// The migration is recorded in the task events
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					events { items { type message } }
+				}
+			}
+		}
+	`, map[string]interface{}{"id": taskA.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	events := gjson.Get(string(resp.Data), "task.get.events.items").Array()
+	require.Len(s.T(), events, 1)
+	assert.Equal(s.T(), "TASK_UPDATED", events[0].Get("type").String())
+	assert.Contains(s.T(), events[0].Get("message").String(), "connectionId: "+fromID.String()+" → "+toID.String())
+}
+
+// TestConnectionMutation_MigrateTasksInvalidOnTarget tests that tasks not valid on the target connection are skipped.
+func (s *ConnectionResolverTestSuite) TestConnectionMutation_MigrateTasksInvalidOnTarget() {
+	ctx := context.Background()
+	fromID := s.Env.CreateTestConnection(s.T(), "migrate-from")
+	mirrorID := s.Env.CreateTestConnection(s.T(), "migrate-mirror")
+	photos, err := s.Env.ConnectionService.CreateConnection(ctx, "photos", "gphotos", map[string]string{
+		"token": `{"access_token":"token"}`,
+	})
+	require.NoError(s.T(), err)
+
+	bisync, err := s.Env.TaskService.CreateTask(ctx, "task-bisync", "/tmp/source", fromID, "/remote", "BIDIRECTIONAL", "", false, nil)
+	require.NoError(s.T(), err)
+	upload := s.Env.CreateTestTask(s.T(), "task-upload", fromID)
+	mirrored, err := s.Env.TaskService.CreateTask(ctx, "task-mirrored", "/tmp/source", fromID, "/remote", "UPLOAD", "", false,
+		&model.TaskSyncOptions{Mirrors: []*model.TaskMirror{{ConnectionID: mirrorID, RemotePath: "/remote"}}})
+	require.NoError(s.T(), err)
+
+	mutation := `
+		mutation($fromId: ID!, $toId: ID!, $taskIds: [ID!]) {
+			connection {
+				migrateTasks(fromId: $fromId, toId: $toId, options: { taskIds: $taskIds }) {
+					migrated
+					skipped
+					items { task { name } migrated error }
+				}
+			}
+		}
+	`
+
+	// Media connections reject bidirectional tasks, but the upload task still moves
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"fromId":  fromID.String(),
+		"toId":    photos.ID.String(),
+		"taskIds": []string{bisync.ID.String(), upload.ID.String()},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "connection.migrateTasks.migrated").Int())
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "connection.migrateTasks.skipped").Int())
+	items := gjson.Get(data, "connection.migrateTasks.items").Array()
+	require.Len(s.T(), items, 2)
+	assert.Equal(s.T(), "task-bisync", items[0].Get("task.name").String())
+	assert.False(s.T(), items[0].Get("migrated").Bool())
+	assert.NotEmpty(s.T(), items[0].Get("error").String())
+	assert.Equal(s.T(), "task-upload", items[1].Get("task.name").String())
+	assert.True(s.T(), items[1].Get("migrated").Bool())
+
+	// On the connection of its mirror, the task would mirror onto its own remote path
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"fromId":  fromID.String(),
+		"toId":    mirrorID.String(),
+		"taskIds": []string{mirrored.ID.String()},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "connection.migrateTasks.migrated").Int())
+	assert.NotEmpty(s.T(), gjson.Get(data, "connection.migrateTasks.items.0.error").String())
+
+	for _, id := range []uuid.UUID{bisync.ID, mirrored.ID} {
+		unchanged, err := s.Env.Client.Task.Get(ctx, id)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), fromID, unchanged.ConnectionID)
+	}
+}
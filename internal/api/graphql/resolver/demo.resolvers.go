@@ -0,0 +1,64 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// Seed is the resolver for the seed field.
+func (r *demoMutationResolver) Seed(ctx context.Context, obj *model.DemoMutation) (*model.DemoData, error) {
+	data, err := r.deps.DemoService.Seed(ctx, demoDir(r.deps.SyncEngine))
+	if err != nil {
+		return nil, demoError(err)
+	}
+	return demoDataToModel(data), nil
+}
+
+// Remove is the resolver for the remove field.
+func (r *demoMutationResolver) Remove(ctx context.Context, obj *model.DemoMutation) (bool, error) {
+	removed, err := r.deps.DemoService.Remove(ctx, demoDir(r.deps.SyncEngine))
+	if err != nil {
+		return false, demoError(err)
+	}
+	if removed {
+		rclone.ClearFsCache(services.DemoConnectionName)
+	}
+	return removed, nil
+}
+
+// Data is the resolver for the data field.
+func (r *demoQueryResolver) Data(ctx context.Context, obj *model.DemoQuery) (*model.DemoData, error) {
+	data, err := r.deps.DemoService.Get(ctx)
+	if err != nil {
+		return nil, demoError(err)
+	}
+	return demoDataToModel(data), nil
+}
+
+// Demo is the resolver for the demo field.
+func (r *mutationResolver) Demo(ctx context.Context) (*model.DemoMutation, error) {
+	return &model.DemoMutation{}, nil
+}
+
+// Demo is the resolver for the demo field.
+func (r *queryResolver) Demo(ctx context.Context) (*model.DemoQuery, error) {
+	return &model.DemoQuery{}, nil
+}
+
+// DemoMutation returns generated.DemoMutationResolver implementation.
+func (r *Resolver) DemoMutation() generated.DemoMutationResolver { return &demoMutationResolver{r} }
+
+// DemoQuery returns generated.DemoQueryResolver implementation.
+func (r *Resolver) DemoQuery() generated.DemoQueryResolver { return &demoQueryResolver{r} }
+
+type demoMutationResolver struct{ *Resolver }
+type demoQueryResolver struct{ *Resolver }
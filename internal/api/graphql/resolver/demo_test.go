@@ -0,0 +1,95 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// DemoResolverTestSuite tests DemoQuery and DemoMutation resolvers.
+type DemoResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestDemoResolverSuite(t *testing.T) {
+	suite.Run(t, new(DemoResolverTestSuite))
+}
+
+const demoDataQuery = `
+	query {
+		demo {
+			data {
+				connection { name type }
+				task { name direction options { filters } }
+				job { status filesTransferred }
+			}
+		}
+	}
+`
+
+// TestDemoMutation_SeedRemove tests DemoMutation.seed and remove resolvers.
+func (s *DemoResolverTestSuite) TestDemoMutation_SeedRemove() {
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: demoDataQuery})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "null", gjson.Get(string(resp.Data), "demo.data").Raw)
+
+	seedMutation := `
+		mutation {
+			demo {
+				seed {
+					connection { name }
+					task { id name }
+					job { status }
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: seedMutation})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), services.DemoConnectionName, gjson.Get(data, "demo.seed.connection.name").String())
+	assert.Equal(s.T(), services.DemoTaskName, gjson.Get(data, "demo.seed.task.name").String())
+	assert.Equal(s.T(), "SUCCESS", gjson.Get(data, "demo.seed.job.status").String())
+	assert.DirExists(s.T(), filepath.Join(s.Env.Deps.SyncEngine.DataDir(), services.DemoDirName, "source"))
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: demoDataQuery})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), "local", gjson.Get(data, "demo.data.connection.type").String())
+	assert.Equal(s.T(), "UPLOAD", gjson.Get(data, "demo.data.task.direction").String())
+	assert.NotEmpty(s.T(), gjson.Get(data, "demo.data.task.options.filters").Array())
+	assert.Equal(s.T(), int64(4), gjson.Get(data, "demo.data.job.filesTransferred").Int())
+
+	removeMutation := `mutation { demo { remove } }`
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: removeMutation})
+	require.Empty(s.T(), resp.Errors)
+	assert.True(s.T(), gjson.Get(string(resp.Data), "demo.remove").Bool())
+	assert.NoDirExists(s.T(), filepath.Join(s.Env.Deps.SyncEngine.DataDir(), services.DemoDirName))
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: removeMutation})
+	require.Empty(s.T(), resp.Errors)
+	assert.False(s.T(), gjson.Get(string(resp.Data), "demo.remove").Bool())
+}
+
+// TestDemoMutation_SeedConflict tests that a user connection named like the demo connection is kept.
+func (s *DemoResolverTestSuite) TestDemoMutation_SeedConflict() {
+	_, err := s.Env.ConnectionService.CreateConnection(context.Background(), services.DemoConnectionName, "local", map[string]string{"type": "local"})
+	require.NoError(s.T(), err)
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: `mutation { demo { seed { task { id } } } }`})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrDemoConnectionConflict, resp.Errors[0].Extensions["code"])
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: `mutation { demo { remove } }`})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrDemoConnectionConflict, resp.Errors[0].Extensions["code"])
+}
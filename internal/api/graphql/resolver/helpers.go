@@ -3,20 +3,103 @@
 package resolver
 
 import (
+	"context"
+	"errors"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/core/version"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+	"go.uber.org/zap"
 )
 
+// connectionTestConcurrency bounds the number of connections tested at once by connection.testAll.
+const connectionTestConcurrency = 4
+
+// maxRunHistory bounds the number of runs returned by task.runHistory.
+const maxRunHistory = 100
+
+// logDeleteBatchSize is the number of logs deleted at once by log.delete.
+const logDeleteBatchSize = 1000
+
+// maxFilterSuggestionRuns bounds the number of runs analyzed by task.suggestFilters.
+const maxFilterSuggestionRuns = 50
+
+// maxTransferHistoryMonths bounds the number of months returned by connection.transferHistory.
+const maxTransferHistoryMonths = 120
+
+// maxGetManyTasks bounds the number of IDs of task.getMany.
+const maxGetManyTasks = 100
+
 // entConnectionToModel converts an ent Connection to a GraphQL model Connection.
 func entConnectionToModel(c *ent.Connection) *model.Connection {
-	return &model.Connection{
-		ID:        c.ID,
-		Name:      c.Name,
-		Type:      c.Type,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+	conn := &model.Connection{
+		ID:                  c.ID,
+		Name:                c.Name,
+		Type:                c.Type,
+		HealthStatus:        c.HealthStatus,
+		HealthCheckedAt:     c.HealthCheckedAt,
+		CredentialsExpireAt: c.CredentialsExpireAt,
+		PathTpsLimit:        c.PathTpsLimit,
+		PathTpsBurst:        c.PathTpsBurst,
+		MonthlyTransferCap:  c.MonthlyTransferCap,
+		ConfigVersion:       c.ConfigVersion,
+		CreatedAt:           c.CreatedAt,
+		UpdatedAt:           c.UpdatedAt,
+	}
+	if c.HealthError != "" {
+		conn.HealthError = &c.HealthError
+	}
+	if c.BasePath != "" {
+		conn.BasePath = &c.BasePath
+	}
+	if c.DisplayName != "" {
+		conn.DisplayName = &c.DisplayName
+	}
+	if c.Color != "" {
+		conn.Color = &c.Color
+	}
+	if c.Icon != "" {
+		conn.Icon = &c.Icon
+	}
+	return conn
+}
+
+// connectionDisplay returns the display name, color and icon of a connection input as a services.ConnectionDisplay.
+func connectionDisplay(displayName, color, icon *string) services.ConnectionDisplay {
+	return services.ConnectionDisplay{DisplayName: displayName, Color: color, Icon: icon}
+}
+
+// taskChangesToModel converts the changes of a task update to GraphQL model TaskChanges.
+func taskChangesToModel(changes []services.TaskChange) []*model.TaskChange {
+	result := make([]*model.TaskChange, len(changes))
+	for i, c := range changes {
+		result[i] = &model.TaskChange{Field: c.Field, Old: c.Old, New: c.New}
+	}
+	return result
+}
+
+// transferUsageToModel returns the transfer usage of a connection in a month against its monthly transfer cap.
+func transferUsageToModel(month time.Time, bytes int64, transferCap *int64) *model.ConnectionTransferUsage {
+	return &model.ConnectionTransferUsage{
+		Month:    month,
+		Bytes:    bytes,
+		Cap:      transferCap,
+		Exceeded: transferCap != nil && bytes >= *transferCap,
 	}
 }
 
@@ -28,16 +111,89 @@ func entTaskToModel(t *ent.Task) *model.Task {
 	}
 
 	return &model.Task{
+		ID:                  t.ID,
+		Name:                t.Name,
+		SourcePath:          t.SourcePath,
+		RemotePath:          t.RemotePath,
+		Direction:           t.Direction,
+		Schedule:            schedule,
+		Realtime:            t.Realtime,
+		Engine:              t.Engine,
+		Tags:                t.Tags,
+		SkippedRuns:         t.SkippedRuns,
+		ConsecutiveFailures: t.ConsecutiveFailures,
+		Enabled:             !t.Disabled,
+		DisabledReason:      t.DisabledReason,
+		Ephemeral:           t.Ephemeral,
+		CreatedAt:           t.CreatedAt,
+		UpdatedAt:           t.UpdatedAt,
+		DeletedAt:           t.DeletedAt,
+		ConnectionID:        t.ConnectionID, // FK for dataloader optimization
+	}
+}
+
+// taskConfigHash returns the hash of the task's effective configuration, see services.TaskConfigHash.
+func taskConfigHash(ctx context.Context, obj *model.Task) (string, error) {
+	loaders := dataloader.For(ctx)
+	entTask, err := loaders.TaskLoader.Load(ctx, obj.ID)
+	if err != nil {
+		return "", err
+	}
+	entConn, err := loaders.ConnectionLoader.Load(ctx, obj.ConnectionID)
+	if err != nil {
+		return "", err
+	}
+	return services.TaskConfigHash(entTask, entConn.BasePath), nil
+}
+
+// orphanedJobCancelReason is the error recorded for an active job without a run when job.cancelAll cancels it.
+const orphanedJobCancelReason = "Cancelled without a running execution"
+
+// cancellation reports the outcome of cancelling the job with the given ID once its run was stopped.
+// A job that is still active has no run that could finish it, so it is marked cancelled directly.
+func (r *Resolver) cancellation(ctx context.Context, jobID uuid.UUID) *model.JobCancellation {
+	j, err := r.deps.JobService.GetJob(ctx, jobID)
+	if err != nil {
+		errStr := err.Error()
+		return &model.JobCancellation{Job: &model.Job{ID: jobID}, Outcome: model.JobCancelOutcomeFailed, Error: &errStr}
+	}
+
+	switch j.Status {
+	case model.JobStatusCancelled:
+		return &model.JobCancellation{Job: entJobToModel(j), Outcome: model.JobCancelOutcomeCancelled}
+	case model.JobStatusPending, model.JobStatusRunning, model.JobStatusWaitingConfirmation:
+		cancelled, err := r.deps.JobService.UpdateJobStatus(ctx, jobID, string(model.JobStatusCancelled), orphanedJobCancelReason)
+		if err != nil {
+			errStr := err.Error()
+			return &model.JobCancellation{Job: entJobToModel(j), Outcome: model.JobCancelOutcomeFailed, Error: &errStr}
+		}
+		return &model.JobCancellation{Job: entJobToModel(cancelled), Outcome: model.JobCancelOutcomeCancelled}
+	default:
+		return &model.JobCancellation{Job: entJobToModel(j), Outcome: model.JobCancelOutcomeAlreadyFinished}
+	}
+}
+
+// shareURLPrefix is the path the share routes are served under, a share link is the prefix followed by the token.
+const shareURLPrefix = "/api/share/"
+
+// entShareTokenToModel converts an ent ShareToken to a GraphQL model ShareToken, expired as of now.
+func entShareTokenToModel(t *ent.ShareToken, now time.Time) *model.ShareToken {
+	var remotePath *string
+	if t.Path != "" {
+		remotePath = &t.Path
+	}
+	return &model.ShareToken{
 		ID:           t.ID,
 		Name:         t.Name,
-		SourcePath:   t.SourcePath,
-		RemotePath:   t.RemotePath,
-		Direction:    t.Direction,
-		Schedule:     schedule,
-		Realtime:     t.Realtime,
+		Scope:        t.Scope,
+		Path:         remotePath,
+		ExpiresAt:    t.ExpiresAt,
+		Expired:      !now.Before(t.ExpiresAt),
+		LastUsedAt:   t.LastUsedAt,
+		UseCount:     t.UseCount,
 		CreatedAt:    t.CreatedAt,
-		UpdatedAt:    t.UpdatedAt,
-		ConnectionID: t.ConnectionID, // FK for dataloader optimization
+		TaskID:       t.TaskID,
+		ConnectionID: t.ConnectionID,
 	}
 }
 
@@ -55,18 +211,257 @@ func entJobToModel(j *ent.Job) *model.Job {
 		endTime = &j.EndTime
 	}
 
+	var note *string
+	if j.Note != "" {
+		note = &j.Note
+	}
+
 	return &model.Job{
-		ID:               j.ID,
-		Status:           j.Status,
-		Trigger:          j.Trigger,
-		StartTime:        j.StartTime,
-		EndTime:          endTime,
-		FilesTransferred: j.FilesTransferred,
-		BytesTransferred: j.BytesTransferred,
-		FilesDeleted:     j.FilesDeleted,
-		ErrorCount:       j.ErrorCount,
-		Errors:           errStr,
-		TaskID:           j.TaskID, // FK for dataloader optimization
+		ID:                      j.ID,
+		Status:                  j.Status,
+		Trigger:                 j.Trigger,
+		StartTime:               j.StartTime,
+		EndTime:                 endTime,
+		FilesTransferred:        j.FilesTransferred,
+		BytesTransferred:        j.BytesTransferred,
+		UploadedFiles:           j.UploadedFiles,
+		UploadedBytes:           j.UploadedBytes,
+		DownloadedFiles:         j.DownloadedFiles,
+		DownloadedBytes:         j.DownloadedBytes,
+		FilesDeleted:            j.FilesDeleted,
+		FilesChecked:            j.FilesChecked,
+		ListingsDone:            j.ListingsDone,
+		ErrorCount:              j.ErrorCount,
+		Errors:                  errStr,
+		Note:                    note,
+		Acknowledged:            j.Acknowledged,
+		AnnotatedAt:             j.AnnotatedAt,
+		TraceID:                 j.TraceID,
+		ConnectionConfigVersion: j.ConnectionConfigVersion,
+		TaskConfigHash:          j.TaskConfigHash,
+		TriggerDetail:           j.TriggerDetail,
+		Concurrency:             j.Concurrency,
+		ConfigSnapshot:          j.ConfigSnapshot,
+		TaskID:                  j.TaskID,   // FK for dataloader optimization
+		ParentID:                j.ParentID, // FK for dataloader optimization
+	}
+}
+
+// entJobToTaskRun converts an ent Job to a GraphQL model TaskRun for run history charts.
+func entJobToTaskRun(j *ent.Job) *model.TaskRun {
+	run := &model.TaskRun{
+		JobID:     j.ID,
+		Status:    j.Status,
+		StartTime: j.StartTime,
+		Bytes:     j.BytesTransferred,
+	}
+	if !j.EndTime.IsZero() {
+		duration := j.EndTime.Sub(j.StartTime).Milliseconds()
+		run.DurationMs = &duration
+	}
+	return run
+}
+
+// entTaskEventToModel converts an ent TaskEvent to a GraphQL model TaskEvent.
+func entTaskEventToModel(e *ent.TaskEvent) *model.TaskEvent {
+	var message *string
+	if e.Message != "" {
+		message = &e.Message
+	}
+
+	return &model.TaskEvent{
+		ID:      e.ID,
+		Type:    e.Type,
+		Message: message,
+		Time:    e.Time,
+	}
+}
+
+// entRetryQueueToModel converts an ent RetryQueue to a GraphQL model RetryQueueItem.
+func entRetryQueueToModel(q *ent.RetryQueue) *model.RetryQueueItem {
+	return &model.RetryQueueItem{
+		ID:          q.ID,
+		Path:        q.Path,
+		Direction:   q.Direction,
+		ErrorClass:  q.ErrorClass,
+		Error:       q.Error,
+		CreatedAt:   q.CreatedAt,
+		RequestedAt: q.RequestedAt,
+		RetryJobID:  q.RetryJobID,
+	}
+}
+
+// idempotent runs a create or run mutation once per idempotency key, taken from the idempotencyKey
+// argument or else the Idempotency-Key header of the request. Without a key run is simply called.
+// A repeated submission returns the entity of the first run, loaded by the ID idOf returned for it, instead of calling run again.
+func idempotent[T any](ctx context.Context, r *Resolver, key *string, operation string, request any,
+	run func(ctx context.Context) (*T, error), idOf func(*T) uuid.UUID, load func(ctx context.Context, id uuid.UUID) (*T, error),
+) (*T, error) {
+	k := services.IdempotencyKeyFromContext(ctx)
+	if key != nil {
+		k = *key
+	}
+	if k == "" {
+		return run(ctx)
+	}
+
+	var result *T
+	id, replayed, err := r.deps.IdempotencyService.Do(ctx, k, operation, request, func(ctx context.Context) (uuid.UUID, error) {
+		var err error
+		if result, err = run(ctx); err != nil {
+			return uuid.Nil, err
+		}
+		return idOf(result), nil
+	})
+	switch {
+	case errors.Is(err, services.ErrIdempotencyKeyInvalid):
+		v := i18n.NewValidationError()
+		v.Add("idempotencyKey", i18n.ErrIdempotencyKeyInvalid, map[string]interface{}{"Max": services.MaxIdempotencyKeyLength})
+		return nil, v.Err()
+	case errors.Is(err, services.ErrIdempotencyKeyReused):
+		return nil, i18n.NewI18nError(i18n.ErrIdempotencyKeyReused).WithStatus(422).WithCause(err)
+	case errors.Is(err, services.ErrIdempotencyKeyInProgress):
+		return nil, i18n.NewI18nError(i18n.ErrIdempotencyKeyInProgress).WithStatus(409).WithCause(err)
+	case err != nil:
+		return nil, err
+	case replayed:
+		return load(ctx, id)
+	}
+	return result, nil
+}
+
+// completeTaskCreation applies the settings of input that TaskService.CreateTask doesn't take to a newly created
+// task and, with runImmediately, starts its first run. The job of the run is created before it starts and taken
+// over by the run, so it can be returned right away. It returns the task and its first job, nil if it wasn't run.
+func (r *Resolver) completeTaskCreation(ctx context.Context, entTask *ent.Task, input model.CreateTaskInput) (*ent.Task, *ent.Job, error) {
+	var err error
+	if input.Engine != nil {
+		if entTask, err = r.deps.TaskService.SetTaskEngine(ctx, entTask.ID, *input.Engine); err != nil {
+			return nil, nil, err
+		}
+	}
+	if input.Tags != nil {
+		if entTask, err = r.deps.TaskService.SetTaskTags(ctx, entTask.ID, input.Tags); err != nil {
+			return nil, nil, err
+		}
+	}
+	if input.RunImmediately == nil || !*input.RunImmediately {
+		return entTask, nil, nil
+	}
+
+	runTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, entTask.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{User: provenance.User(ctx)})
+	firstJob, err := r.deps.JobService.CreateJob(ctx, entTask.ID, model.JobTriggerManual)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := r.deps.Runner.StartTask(provenance.WithJob(ctx, &firstJob.ID), runTask, model.JobTriggerManual); err != nil {
+		return nil, nil, err
+	}
+	return entTask, firstJob, nil
+}
+
+// taskByID, jobByID and connectionByID load the entity an idempotent mutation returned on its first run.
+func (r *Resolver) taskByID(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	t, err := r.deps.TaskService.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return entTaskToModel(t), nil
+}
+
+func (r *Resolver) jobByID(ctx context.Context, id uuid.UUID) (*model.Job, error) {
+	j, err := r.deps.JobService.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return entJobToModel(j), nil
+}
+
+func (r *Resolver) connectionByID(ctx context.Context, id uuid.UUID) (*model.Connection, error) {
+	c, err := r.deps.ConnectionService.GetConnectionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return entConnectionToModel(c), nil
+}
+
+// decideJob confirms or aborts the deletions of a job waiting for confirmation with decide and returns the job.
+func (r *Resolver) decideJob(ctx context.Context, id uuid.UUID, decide func(context.Context, uuid.UUID) error) (*model.Job, error) {
+	if _, err := r.deps.JobService.GetJob(ctx, id); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, i18n.ErrNotFoundI18n(i18n.ErrJobNotFound).WithCause(err)
+		}
+		return nil, err
+	}
+	if err := decide(ctx, id); err != nil {
+		if errors.Is(err, rclone.ErrJobNotWaiting) {
+			return nil, i18n.NewI18nError(i18n.ErrJobNotWaiting).WithStatus(409).WithCause(err)
+		}
+		return nil, err
+	}
+	return r.jobByID(ctx, id)
+}
+
+// jobDayToModel converts a services.JobDay to a GraphQL model JobDay.
+func jobDayToModel(d *services.JobDay) *model.JobDay {
+	return &model.JobDay{
+		Date:             d.Day,
+		JobCount:         len(d.JobIDs),
+		SuccessCount:     d.StatusCounts[model.JobStatusSuccess],
+		WarningCount:     d.StatusCounts[model.JobStatusSuccessWithWarnings],
+		FailedCount:      d.StatusCounts[model.JobStatusFailed] + d.StatusCounts[model.JobStatusFailedTimeout],
+		CancelledCount:   d.StatusCounts[model.JobStatusCancelled],
+		RunningCount:     d.StatusCounts[model.JobStatusPending] + d.StatusCounts[model.JobStatusRunning] + d.StatusCounts[model.JobStatusWaitingConfirmation],
+		FilesTransferred: int(d.FilesTransferred),
+		BytesTransferred: d.BytesTransferred,
+		ErrorCount:       int(d.ErrorCount),
+		JobIds:           d.JobIDs,
+	}
+}
+
+// filterAnalysisToModel converts a services.FilterAnalysis to a GraphQL model FilterSuggestions.
+func filterAnalysisToModel(a *services.FilterAnalysis) *model.FilterSuggestions {
+	suggestions := make([]*model.FilterSuggestion, len(a.Suggestions))
+	for i, s := range a.Suggestions {
+		suggestions[i] = &model.FilterSuggestion{
+			Rule:     s.Rule,
+			Category: s.Category,
+			Paths:    s.Paths,
+			Changes:  s.Changes,
+			Bytes:    s.Bytes,
+			Examples: s.Examples,
+		}
+	}
+	return &model.FilterSuggestions{
+		JobCount:    a.JobCount,
+		Changes:     a.Changes,
+		Bytes:       a.Bytes,
+		Suggestions: suggestions,
+	}
+}
+
+// entJobEventToModel converts an ent JobEvent to a GraphQL model JobEvent.
+func entJobEventToModel(e *ent.JobEvent) *model.JobEvent {
+	var errMsg *string
+	if e.Error != "" {
+		errMsg = &e.Error
+	}
+
+	return &model.JobEvent{
+		ID:              e.ID,
+		Type:            e.Type,
+		Command:         e.Command,
+		Args:            e.Args,
+		ExitCode:        e.ExitCode,
+		DurationMs:      e.DurationMs,
+		Output:          e.Output,
+		OutputTruncated: e.OutputTruncated,
+		Error:           errMsg,
+		CreatedAt:       e.CreatedAt,
 	}
 }
 
@@ -91,16 +486,371 @@ func buildOptions(input *model.TaskSyncOptionsInput) *model.TaskSyncOptions {
 	}
 
 	options := &model.TaskSyncOptions{
-		ConflictResolution: input.ConflictResolution,
-		Filters:            input.Filters,
-		NoDelete:           input.NoDelete,
-		Transfers:          input.Transfers,
+		ConflictResolution:  input.ConflictResolution,
+		Filters:             input.Filters,
+		NoDelete:            input.NoDelete,
+		Transfers:           input.Transfers,
+		Shards:              input.Shards,
+		MaxDurationMinutes:  input.MaxDurationMinutes,
+		ContinueOnTimeout:   input.ContinueOnTimeout,
+		ResumeAfterCrash:    input.ResumeAfterCrash,
+		ConfirmDeletesOver:  input.ConfirmDeletesOver,
+		TrackRenames:        input.TrackRenames,
+		WindowsNames:        input.WindowsNames,
+		PreserveMetadata:    input.PreserveMetadata,
+		WatchIgnorePatterns: input.WatchIgnorePatterns,
+		WatchExcludeDirs:    input.WatchExcludeDirs,
+		VerboseLogging:      input.VerboseLogging,
+		SkipSizing:          input.SkipSizing,
+		CreateEmptySrcDirs:  input.CreateEmptySrcDirs,
+		SkipZeroByteFiles:   input.SkipZeroByteFiles,
+		BackupKeepLast:      input.BackupKeepLast,
+		BackupKeepDaily:     input.BackupKeepDaily,
+		BackupKeepWeekly:    input.BackupKeepWeekly,
+		BackupKeepMonthly:   input.BackupKeepMonthly,
+		PreHook:             buildHook(input.PreHook),
+		PostHook:            buildHook(input.PostHook),
+		Paths:               buildPaths(input.Paths),
+		StopOnPathError:     input.StopOnPathError,
+		Mirrors:             buildMirrors(input.Mirrors),
+		LogRetentionDays:    input.LogRetentionDays,
+		JobRetentionDays:    input.JobRetentionDays,
 	}
 
 	// Return nil if all fields are empty
-	if options.ConflictResolution == nil && len(options.Filters) == 0 && options.NoDelete == nil && options.Transfers == nil {
+	if options.ConflictResolution == nil && len(options.Filters) == 0 && options.NoDelete == nil && options.Transfers == nil && options.Shards == nil &&
+		options.MaxDurationMinutes == nil && options.ContinueOnTimeout == nil && options.ResumeAfterCrash == nil && options.ConfirmDeletesOver == nil &&
+		options.TrackRenames == nil && options.WindowsNames == nil && options.PreserveMetadata == nil && len(options.WatchIgnorePatterns) == 0 && len(options.WatchExcludeDirs) == 0 && options.VerboseLogging == nil && options.SkipSizing == nil &&
+		options.CreateEmptySrcDirs == nil && options.SkipZeroByteFiles == nil &&
+		options.BackupKeepLast == nil && options.BackupKeepDaily == nil && options.BackupKeepWeekly == nil && options.BackupKeepMonthly == nil &&
+		options.PreHook == nil && options.PostHook == nil && len(options.Paths) == 0 && options.StopOnPathError == nil &&
+		len(options.Mirrors) == 0 && options.LogRetentionDays == nil && options.JobRetentionDays == nil {
 		return nil
 	}
 
 	return options
 }
+
+// buildHook converts a TaskHookInput to the TaskHook stored in the task options.
+func buildHook(input *model.TaskHookInput) *model.TaskHook {
+	if input == nil {
+		return nil
+	}
+	return &model.TaskHook{Command: input.Command, Args: input.Args}
+}
+
+// buildPaths converts the additional path inputs of a task to the paths stored in the task options,
+// cleaning both paths so transfers can be matched to their path, see rclone.SyncPath.
+func buildPaths(input []*model.TaskPathInput) []*model.TaskPath {
+	if len(input) == 0 {
+		return nil
+	}
+	paths := make([]*model.TaskPath, len(input))
+	for i, p := range input {
+		paths[i] = &model.TaskPath{
+			SourcePath:    filepath.Clean(p.SourcePath),
+			RemoteSubpath: strings.Trim(path.Clean(p.RemoteSubpath), "/"),
+		}
+	}
+	return paths
+}
+
+// buildMirrors converts the mirror target inputs of a task to the mirrors stored in the task options.
+func buildMirrors(input []*model.TaskMirrorInput) []*model.TaskMirror {
+	if len(input) == 0 {
+		return nil
+	}
+	mirrors := make([]*model.TaskMirror, len(input))
+	for i, m := range input {
+		mirrors[i] = &model.TaskMirror{ConnectionID: m.ConnectionID, RemotePath: m.RemotePath}
+	}
+	return mirrors
+}
+
+// maintenanceStatus builds a GraphQL MaintenanceStatus from the runner state.
+func maintenanceStatus(r ports.Runner, e *rclone.SyncEngine) *model.MaintenanceStatus {
+	return &model.MaintenanceStatus{
+		Enabled:          r.IsMaintenance(),
+		RunningTaskCount: r.RunningCount(),
+		DataDir:          e.DataDir(),
+	}
+}
+
+// databaseOptimization converts a database optimization report to its GraphQL model, nil if o is nil.
+func databaseOptimization(o *services.DatabaseOptimization) *model.DatabaseOptimization {
+	if o == nil {
+		return nil
+	}
+	return &model.DatabaseOptimization{
+		StartedAt:      o.StartedAt,
+		DurationMs:     o.Duration.Milliseconds(),
+		SizeBefore:     o.SizeBefore,
+		SizeAfter:      o.SizeAfter,
+		ReclaimedBytes: o.Reclaimed(),
+	}
+}
+
+// schedulerStatus builds a GraphQL SchedulerStatus from the scheduler state.
+func schedulerStatus(s ports.Scheduler) *model.SchedulerStatus {
+	return &model.SchedulerStatus{
+		Paused:             s.IsPaused(),
+		ScheduledTaskCount: s.ScheduledTaskCount(),
+	}
+}
+
+// systemVersion builds a GraphQL SystemVersion from the build information and the UI version.
+// Unknown values are returned as null.
+func systemVersion(info version.Info, uiVersion string) *model.SystemVersion {
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	return &model.SystemVersion{
+		App:       info.Version,
+		Commit:    optional(info.Commit),
+		BuildDate: optional(info.BuildDate),
+		UIVersion: optional(uiVersion),
+	}
+}
+
+// testConnection tests a remote configuration and, if remotePath is set, probes its capabilities under that path.
+// Test failures are returned as ConnectionTestFailure union members, not as errors.
+func testConnection(ctx context.Context, providerType string, config map[string]string, remotePath *string) model.TestConnectionResult {
+	if err := rclone.TestRemote(ctx, providerType, config); err != nil {
+		return &model.ConnectionTestFailure{Error: err.Error()}
+	}
+
+	capabilities := []*model.ConnectionCapabilityResult{}
+	if remotePath != nil && *remotePath != "" {
+		var err error
+		capabilities, err = rclone.ProbeRemotePath(ctx, providerType, config, *remotePath)
+		if err != nil {
+			return &model.ConnectionTestFailure{Error: err.Error()}
+		}
+	}
+
+	return &model.ConnectionTestSuccess{
+		Message:      "Connection test successful",
+		Capabilities: capabilities,
+	}
+}
+
+// recordConnectionHealth stores the outcome of a connection test as the connection's health status.
+// Failing to store it is logged but does not fail the test itself; the original connection is returned then.
+func recordConnectionHealth(ctx context.Context, svc *services.ConnectionService, conn *ent.Connection, result model.TestConnectionResult) *ent.Connection {
+	status, errMsg := model.ConnectionHealthStatusHealthy, ""
+	if failure, ok := result.(*model.ConnectionTestFailure); ok {
+		status, errMsg = model.ConnectionHealthStatusUnhealthy, failure.Error
+	}
+	updated, err := svc.UpdateConnectionHealth(ctx, conn.ID, status, errMsg)
+	if err != nil {
+		logger.Named("api.graphql").Warn("Failed to record connection health",
+			zap.String("connection", conn.Name), zap.Error(err))
+		return conn
+	}
+	return updated
+}
+
+// selectMigratedTasks returns the tasks of the source connection of connection.migrateTasks with the given IDs,
+// or an error naming the first ID that isn't a task of the source connection.
+func selectMigratedTasks(tasks []*ent.Task, ids []uuid.UUID) ([]*ent.Task, error) {
+	byID := make(map[uuid.UUID]*ent.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	selected := make([]*ent.Task, 0, len(ids))
+	for _, id := range ids {
+		t, ok := byID[id]
+		if !ok {
+			return nil, i18n.NewI18nErrorWithData(i18n.ErrMigrateTaskNotOnConnection, map[string]interface{}{"Task": id}).WithStatus(400)
+		}
+		if !slices.Contains(selected, t) {
+			selected = append(selected, t)
+		}
+	}
+	return selected, nil
+}
+
+// migrateTasks moves the tasks to the connection to and migrates (or with reset removes) their bisync state,
+// recording the change of each task in its task events. Running tasks are skipped, since their jobs use the
+// old connection until they finish, and so are tasks that aren't valid on the target connection, e.g. bidirectional
+// tasks moved to a media connection. The report lists the tasks by name.
+func (r *connectionMutationResolver) migrateTasks(ctx context.Context, tasks []*ent.Task, from, to *ent.Connection, reset bool) (*model.MigrateTasksReport, error) {
+	log := logger.Named("api.graphql.resolver.connection")
+	slices.SortFunc(tasks, func(a, b *ent.Task) int { return strings.Compare(a.Name, b.Name) })
+
+	report := &model.MigrateTasksReport{Items: make([]*model.MigrateTasksReportItem, 0, len(tasks))}
+	for _, t := range tasks {
+		if r.deps.Runner.IsRunning(t.ID) {
+			msg := i18n.Ctx(ctx, i18n.ErrMigrateTaskRunning)
+			report.Items = append(report.Items, &model.MigrateTasksReportItem{Task: entTaskToModel(t), Error: &msg})
+			report.Skipped++
+			continue
+		}
+		var validationErr *i18n.ValidationError
+		if err := r.validateMigratedTask(ctx, t, to.ID); errors.As(err, &validationErr) {
+			msg := validationMessage(ctx, validationErr)
+			report.Items = append(report.Items, &model.MigrateTasksReportItem{Task: entTaskToModel(t), Error: &msg})
+			report.Skipped++
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		migrated, err := r.deps.TaskService.SetTaskConnection(ctx, t.ID, to.ID)
+		if err != nil {
+			return nil, err
+		}
+		// The migration is saved, so failing to record it in the task events doesn't fail the mutation
+		if err := r.deps.TaskService.RecordTaskChanges(ctx, t.ID, services.DiffTasks(t, migrated)); err != nil {
+			log.Warn("Failed to record task changes", zap.String("task_id", t.ID.String()), zap.Error(err))
+		}
+
+		// Without its state a bidirectional task resyncs, so failing to migrate it doesn't fail the mutation either
+		migrated.Edges.Connection = to
+		files, err := r.deps.SyncEngine.MigrateTaskState(ctx, migrated, from.Name, to.Name, reset)
+		if err != nil {
+			log.Warn("Failed to migrate bisync state of migrated task",
+				zap.String("task_id", t.ID.String()),
+				zap.String("from", from.Name),
+				zap.String("to", to.Name),
+				zap.Error(err))
+		}
+
+		report.Items = append(report.Items, &model.MigrateTasksReportItem{Task: entTaskToModel(migrated), Migrated: true, StateFiles: files})
+		report.Migrated++
+	}
+
+	log.Info("Migrated tasks to another connection",
+		zap.String("from", from.Name),
+		zap.String("to", to.Name),
+		zap.Int("migrated", report.Migrated),
+		zap.Int("skipped", report.Skipped),
+		zap.Bool("reset_bisync_state", reset))
+	return report, nil
+}
+
+// validationMessage translates the invalid fields of a validation error into a single message,
+// the same way the GraphQL error presenter does.
+func validationMessage(ctx context.Context, err *i18n.ValidationError) string {
+	localizer := i18n.LocalizerFromContext(ctx)
+	messages := make([]string, len(err.Fields))
+	for i, f := range err.Fields {
+		messages[i] = f.Translate(localizer)
+	}
+	return i18n.T(localizer, i18n.ErrValidationFailed) + ": " + strings.Join(messages, "; ")
+}
+
+// testAllConnections tests the given connections with at most concurrency tests in flight,
+// recording each connection's health, and aggregates the results in the order of conns.
+func testAllConnections(ctx context.Context, svc *services.ConnectionService, conns []*ent.Connection, concurrency int) *model.ConnectionTestReport {
+	items := make([]*model.ConnectionTestReportItem, len(conns))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, conn := range conns {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var result model.TestConnectionResult
+			config, err := svc.GetConnectionConfigByID(ctx, conn.ID)
+			if err != nil {
+				result = &model.ConnectionTestFailure{Error: err.Error()}
+			} else {
+				result = testConnection(ctx, string(conn.Type), config, nil)
+			}
+			items[i] = &model.ConnectionTestReportItem{
+				Connection: entConnectionToModel(recordConnectionHealth(ctx, svc, conn, result)),
+				Result:     result,
+			}
+		})
+	}
+	wg.Wait()
+
+	report := &model.ConnectionTestReport{Total: len(items), Results: items}
+	for _, item := range items {
+		if _, ok := item.Result.(*model.ConnectionTestFailure); ok {
+			report.Unhealthy++
+		} else {
+			report.Healthy++
+		}
+	}
+	return report
+}
+
+// duplicateOptions converts FindDuplicatesInput into rclone duplicate finder options, applying the schema defaults.
+func duplicateOptions(input model.FindDuplicatesInput) rclone.DuplicateOptions {
+	opts := rclone.DuplicateOptions{
+		Mode:   model.DuplicateMatchModeHash,
+		Keep:   input.Keep,
+		DryRun: true,
+	}
+	if input.Mode != nil {
+		opts.Mode = *input.Mode
+	}
+	if input.DryRun != nil {
+		opts.DryRun = *input.DryRun
+	}
+	return opts
+}
+
+// fsCacheEntryToModel converts an rclone Fs cache entry to a GraphQL model FsCacheEntry.
+// ids maps connection names to their IDs; entries of connections missing from it get no connectionId.
+func fsCacheEntryToModel(e rclone.FsCacheEntry, ids map[string]uuid.UUID, now time.Time) *model.FsCacheEntry {
+	entry := &model.FsCacheEntry{
+		ConnectionName: e.Remote,
+		Path:           e.Path,
+		CreatedAt:      e.CreatedAt,
+		LastUsedAt:     e.LastUsedAt,
+		Age:            int(now.Sub(e.CreatedAt).Seconds()),
+	}
+	if id, ok := ids[e.Remote]; ok {
+		entry.ConnectionID = &id
+	}
+	return entry
+}
+
+// demoDir returns the directory holding the files of the demo data.
+func demoDir(e *rclone.SyncEngine) string {
+	return filepath.Join(e.DataDir(), services.DemoDirName)
+}
+
+// demoDataToModel converts the demo data to a GraphQL model DemoData, nil if there is none.
+func demoDataToModel(data *services.DemoData) *model.DemoData {
+	if data == nil {
+		return nil
+	}
+	demo := &model.DemoData{
+		Connection: entConnectionToModel(data.Connection),
+		Task:       entTaskToModel(data.Task),
+	}
+	if data.Job != nil {
+		demo.Job = entJobToModel(data.Job)
+	}
+	return demo
+}
+
+// demoError converts a conflicting user connection named like the demo connection to an i18n error.
+func demoError(err error) error {
+	if errors.Is(err, errs.ErrAlreadyExists) {
+		return i18n.NewI18nErrorWithData(i18n.ErrDemoConnectionConflict, map[string]interface{}{
+			"Name": services.DemoConnectionName,
+		}).WithStatus(409)
+	}
+	return err
+}
+
+// configPasswordError returns the localized message of an error decrypting an encrypted rclone.conf.
+func configPasswordError(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(err, rclone.ErrConfigPasswordRequired):
+		return i18n.Ctx(ctx, i18n.ErrImportPasswordRequired)
+	case errors.Is(err, rclone.ErrConfigPasswordIncorrect):
+		return i18n.Ctx(ctx, i18n.ErrImportPasswordIncorrect)
+	case errors.Is(err, rclone.ErrConfigPasswordInvalid):
+		return i18n.Ctx(ctx, i18n.ErrConfigPasswordInvalid)
+	}
+	return err.Error()
+}
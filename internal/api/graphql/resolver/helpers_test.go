@@ -111,7 +111,7 @@ func (s *HelpersTestSuite) TestEntTaskToModelWithSchedule() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "scheduled-task",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -7,16 +7,32 @@ package resolver
 
 import (
 	"context"
+	"errors"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 )
 
 // Parse is the resolver for the parse field.
 func (r *importMutationResolver) Parse(ctx context.Context, obj *model.ImportMutation, input model.ImportParseInput) (model.ImportParseResult, error) {
+	// Decrypt rclone.conf content encrypted with a config password
+	var password string
+	if input.Password != nil {
+		password = *input.Password
+	}
+	content, err := rclone.DecryptRcloneConf(input.Content, password)
+	if err != nil {
+		//nolint:nilerr // Return decryption error as union result, not as GraphQL error
+		return &model.ImportParseError{
+			Error: configPasswordError(ctx, err),
+		}, nil
+	}
+
 	// Parse rclone.conf content
-	connections, err := rclone.ParseRcloneConf(input.Content)
+	connections, err := rclone.ParseRcloneConf(content)
 	if err != nil {
 		//nolint:nilerr // Return parse error as union result, not as GraphQL error
 		return &model.ImportParseError{
@@ -59,21 +75,30 @@ func (r *importMutationResolver) Execute(ctx context.Context, obj *model.ImportM
 		if err == nil && existing != nil {
 			// Connection exists
 			if input.Overwrite {
-				// Update existing connection
-				err := r.deps.ConnectionService.UpdateConnection(ctx, existing.ID, nil, &connInput.Type, connInput.Config)
+				// Update existing connection, unless a job using it is running
+				_, err := r.deps.ConnectionService.ApplyConnectionUpdate(ctx, existing.ID, services.ConnectionUpdate{
+					Type:   &connInput.Type,
+					Config: connInput.Config,
+				})
+				if errors.Is(err, services.ErrConnectionInUse) {
+					return nil, i18n.NewI18nError(i18n.ErrConnectionUpdateRunning).WithStatus(409).WithCause(err)
+				}
 				if err != nil {
 					return nil, err
 				}
+				// Drop cached Fs instances so the new config is used instead of stale credentials
+				rclone.ClearFsCache(existing.Name)
 				updatedCount++
 				// Add to result (refetch to get updated info)
 				updated, err := r.deps.ConnectionService.GetConnectionByName(ctx, connInput.Name)
 				if err == nil && updated != nil {
 					importedConns = append(importedConns, &model.Connection{
-						ID:        updated.ID,
-						Name:      updated.Name,
-						Type:      updated.Type,
-						CreatedAt: updated.CreatedAt,
-						UpdatedAt: updated.UpdatedAt,
+						ID:            updated.ID,
+						Name:          updated.Name,
+						Type:          updated.Type,
+						ConfigVersion: updated.ConfigVersion,
+						CreatedAt:     updated.CreatedAt,
+						UpdatedAt:     updated.UpdatedAt,
 					})
 				}
 			}
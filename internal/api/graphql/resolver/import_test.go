@@ -385,3 +385,58 @@ func (s *ImportResolverTestSuite) TestImportMutation_NamespaceExists() {
 		assert.NotContains(s.T(), err.Message, "Cannot query field")
 	}
 }
+
+// TestImportMutation_ParseEncryptedConfig tests parsing an rclone.conf exported with a config password.
+func (s *ImportResolverTestSuite) TestImportMutation_ParseEncryptedConfig() {
+	s.Env.CreateTestConnection(s.T(), "encrypted-conn")
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), `
+		query($password: String) {
+			connection {
+				export(password: $password)
+			}
+		}
+	`, map[string]interface{}{"password": "s3cret"})
+	require.Empty(s.T(), resp.Errors)
+	content := gjson.Get(string(resp.Data), "connection.export").String()
+	assert.Contains(s.T(), content, "RCLONE_ENCRYPT_V0:")
+	assert.NotContains(s.T(), content, "encrypted-conn")
+
+	mutation := `
+		mutation($input: ImportParseInput!) {
+			import {
+				parse(input: $input) {
+					... on ImportParseSuccess {
+						connections {
+							name
+							type
+						}
+					}
+					... on ImportParseError {
+						error
+					}
+				}
+			}
+		}
+	`
+	parse := func(password interface{}) string {
+		resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"content":  content,
+				"password": password,
+			},
+		})
+		require.Empty(s.T(), resp.Errors)
+		return string(resp.Data)
+	}
+
+	data := parse("s3cret")
+	assert.Equal(s.T(), "encrypted-conn", gjson.Get(data, "import.parse.connections.0.name").String())
+	assert.Equal(s.T(), "local", gjson.Get(data, "import.parse.connections.0.type").String())
+
+	// Missing and wrong passwords are parse errors
+	assert.NotEmpty(s.T(), gjson.Get(parse(nil), "import.parse.error").String())
+	data = parse("wrong")
+	assert.NotEmpty(s.T(), gjson.Get(data, "import.parse.error").String())
+	assert.False(s.T(), gjson.Get(data, "import.parse.connections").Exists())
+}
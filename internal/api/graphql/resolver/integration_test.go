@@ -129,7 +129,7 @@ func (s *IntegrationTestSuite) TestUserJourney_CompleteWorkflow() {
 		"id": task.ID.String(),
 		"input": map[string]interface{}{
 			"name":       "updated-sync-task",
-			"sourcePath": "/new/source/path",
+			"sourcePath": s.Env.SourcePath(s.T(), "new/source/path"),
 		},
 	})
 	require.Empty(s.T(), resp.Errors)
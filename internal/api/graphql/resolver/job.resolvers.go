@@ -7,13 +7,19 @@ package resolver
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // Task is the resolver for the task field.
@@ -28,6 +34,36 @@ func (r *jobResolver) Task(ctx context.Context, obj *model.Job) (*model.Task, er
 	return entTaskToModel(entTask), nil
 }
 
+// Parent is the resolver for the parent field.
+func (r *jobResolver) Parent(ctx context.Context, obj *model.Job) (*model.Job, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+
+	// Use dataloader with pre-resolved ParentID to avoid N+1 queries
+	loaders := dataloader.For(ctx)
+	entJob, err := loaders.JobLoader.Load(ctx, *obj.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entJobToModel(entJob), nil
+}
+
+// Children is the resolver for the children field.
+func (r *jobResolver) Children(ctx context.Context, obj *model.Job) ([]*model.Job, error) {
+	entJobs, err := r.deps.JobService.ListChildJobs(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]*model.Job, len(entJobs))
+	for i, j := range entJobs {
+		children[i] = entJobToModel(j)
+	}
+	return children, nil
+}
+
 // Logs is the resolver for the logs field.
 func (r *jobResolver) Logs(ctx context.Context, obj *model.Job, pagination *model.PaginationInput) (*model.JobLogConnection, error) {
 	// Default pagination values
@@ -76,6 +112,34 @@ func (r *jobResolver) Progress(ctx context.Context, obj *model.Job) (*model.JobP
 	return r.deps.SyncEngine.GetJobProgress(obj.ID), nil
 }
 
+// FailedFiles is the resolver for the failedFiles field.
+func (r *jobResolver) FailedFiles(ctx context.Context, obj *model.Job) ([]*model.RetryQueueItem, error) {
+	entItems, err := r.deps.JobService.ListRetryQueue(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.RetryQueueItem, len(entItems))
+	for i, q := range entItems {
+		items[i] = entRetryQueueToModel(q)
+	}
+	return items, nil
+}
+
+// Events is the resolver for the events field.
+func (r *jobResolver) Events(ctx context.Context, obj *model.Job) ([]*model.JobEvent, error) {
+	entEvents, err := r.deps.JobService.ListJobEvents(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*model.JobEvent, len(entEvents))
+	for i, e := range entEvents {
+		events[i] = entJobEventToModel(e)
+	}
+	return events, nil
+}
+
 // Job is the resolver for the job field.
 func (r *jobLogResolver) Job(ctx context.Context, obj *model.JobLog) (*model.Job, error) {
 	// Use dataloader with pre-resolved JobID to avoid N+1 queries
@@ -88,8 +152,94 @@ func (r *jobLogResolver) Job(ctx context.Context, obj *model.JobLog) (*model.Job
 	return entJobToModel(entJob), nil
 }
 
+// Annotate is the resolver for the annotate field.
+func (r *jobMutationResolver) Annotate(ctx context.Context, obj *model.JobMutation, id uuid.UUID, note *string, acknowledged *bool) (*model.Job, error) {
+	j, err := r.deps.JobService.AnnotateJob(ctx, id, note, acknowledged)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, i18n.ErrNotFoundI18n(i18n.ErrJobNotFound).WithCause(err)
+		}
+		return nil, err
+	}
+	return entJobToModel(j), nil
+}
+
+// RetryFailedFiles is the resolver for the retryFailedFiles field.
+func (r *jobMutationResolver) RetryFailedFiles(ctx context.Context, obj *model.JobMutation, jobID uuid.UUID, idempotencyKey *string) (*model.Job, error) {
+	return idempotent(ctx, r.Resolver, idempotencyKey, "job.retryFailedFiles", jobID, func(ctx context.Context) (*model.Job, error) {
+		j, err := r.deps.JobService.GetJob(ctx, jobID)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				return nil, i18n.ErrNotFoundI18n(i18n.ErrJobNotFound).WithCause(err)
+			}
+			return nil, err
+		}
+		// Starting the retry would cancel the running job
+		if r.deps.Runner.IsRunning(j.TaskID) {
+			return nil, i18n.NewI18nError(i18n.ErrRetryTaskRunning).WithStatus(409)
+		}
+
+		n, err := r.deps.JobService.RequestRetry(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, i18n.NewI18nError(i18n.ErrNoFailedFiles).WithStatus(400)
+		}
+
+		entTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, j.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		ctx = provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{User: provenance.User(ctx), SourceJobID: &jobID})
+		if err := r.deps.Runner.StartTask(ctx, entTask, model.JobTriggerRetry); err != nil {
+			return nil, err
+		}
+
+		// Get the retry job created for the task
+		entJob, err := r.deps.JobService.GetLastJobByTaskID(ctx, j.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		return entJobToModel(entJob), nil
+	}, func(j *model.Job) uuid.UUID { return j.ID }, r.jobByID)
+}
+
+// Confirm is the resolver for the confirm field.
+func (r *jobMutationResolver) Confirm(ctx context.Context, obj *model.JobMutation, id uuid.UUID) (*model.Job, error) {
+	return r.decideJob(ctx, id, r.deps.SyncEngine.ConfirmJob)
+}
+
+// Abort is the resolver for the abort field.
+func (r *jobMutationResolver) Abort(ctx context.Context, obj *model.JobMutation, id uuid.UUID) (*model.Job, error) {
+	return r.decideJob(ctx, id, r.deps.SyncEngine.AbortJob)
+}
+
+// CancelAll is the resolver for the cancelAll field.
+func (r *jobMutationResolver) CancelAll(ctx context.Context, obj *model.JobMutation, taskID *uuid.UUID, connectionID *uuid.UUID) ([]*model.JobCancellation, error) {
+	jobs, err := r.deps.JobService.ListActiveJobs(ctx, taskID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The runner cancels all matching runs together and waits for them to finish their jobs
+	taskIDs := make([]uuid.UUID, 0, len(jobs))
+	for _, j := range jobs {
+		if !slices.Contains(taskIDs, j.TaskID) {
+			taskIDs = append(taskIDs, j.TaskID)
+		}
+	}
+	r.deps.Runner.StopTasks(taskIDs)
+
+	results := make([]*model.JobCancellation, len(jobs))
+	for i, j := range jobs {
+		results[i] = r.cancellation(ctx, j.ID)
+	}
+	return results, nil
+}
+
 // List is the resolver for the list field.
-func (r *jobQueryResolver) List(ctx context.Context, obj *model.JobQuery, taskID *uuid.UUID, connectionID *uuid.UUID, pagination *model.PaginationInput) (*model.JobConnection, error) {
+func (r *jobQueryResolver) List(ctx context.Context, obj *model.JobQuery, taskID *uuid.UUID, connectionID *uuid.UUID, status *model.JobStatus, pagination *model.PaginationInput) (*model.JobConnection, error) {
 	// Default pagination values
 	limit := 20
 	offset := 0
@@ -102,14 +252,19 @@ func (r *jobQueryResolver) List(ctx context.Context, obj *model.JobQuery, taskID
 		}
 	}
 
+	var statusFilter string
+	if status != nil {
+		statusFilter = string(*status)
+	}
+
 	// Get total count
-	totalCount, err := r.deps.JobService.CountJobs(ctx, taskID, connectionID)
+	totalCount, err := r.deps.JobService.CountJobs(ctx, taskID, connectionID, statusFilter)
 	if err != nil {
 		return nil, err
 	}
 
 	// Fetch jobs
-	entJobs, err := r.deps.JobService.ListJobs(ctx, taskID, connectionID, limit, offset)
+	entJobs, err := r.deps.JobService.ListJobs(ctx, taskID, connectionID, statusFilter, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -136,12 +291,76 @@ func (r *jobQueryResolver) List(ctx context.Context, obj *model.JobQuery, taskID
 	}, nil
 }
 
+// ByDay is the resolver for the byDay field.
+func (r *jobQueryResolver) ByDay(ctx context.Context, obj *model.JobQuery, taskID *uuid.UUID, connectionID *uuid.UUID, days int) ([]*model.JobDay, error) {
+	if err := validateJobDays(days); err != nil {
+		return nil, err
+	}
+
+	jobDays, err := r.deps.JobService.ListJobDays(ctx, taskID, connectionID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.JobDay, len(jobDays))
+	for i, d := range jobDays {
+		result[i] = jobDayToModel(d)
+	}
+	return result, nil
+}
+
+// Get is the resolver for the get field.
+func (r *jobQueryResolver) Get(ctx context.Context, obj *model.JobQuery, id uuid.UUID) (*model.Job, error) {
+	j, err := r.deps.JobService.GetJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entJobToModel(j), nil
+}
+
 // Progress is the resolver for the progress field.
 func (r *jobQueryResolver) Progress(ctx context.Context, obj *model.JobQuery, id uuid.UUID) (*model.JobProgressEvent, error) {
 	// Get progress from SyncEngine - returns the cached JobProgressEvent directly
 	return r.deps.SyncEngine.GetJobProgress(id), nil
 }
 
+// Delete is the resolver for the delete field.
+func (r *logMutationResolver) Delete(ctx context.Context, obj *model.LogMutation, filter model.LogDeleteFilter, operationID *string) (*model.LogDeleteResult, error) {
+	if filter.TaskID == nil && filter.JobID == nil && filter.Level == nil && filter.Before == nil {
+		v := i18n.NewValidationError()
+		v.Add("filter", i18n.ErrLogDeleteFilterEmpty, nil)
+		return nil, v.Err()
+	}
+	id := uuid.NewString()
+	if operationID != nil && *operationID != "" {
+		id = *operationID
+	}
+
+	svcFilter := services.JobLogDeleteFilter{TaskID: filter.TaskID, JobID: filter.JobID, Before: filter.Before}
+	if filter.Level != nil {
+		svcFilter.Level = string(*filter.Level)
+	}
+	matched := 0
+	publish := func(deleted int, done bool) {
+		if r.deps.LogDeleteProgressBus != nil {
+			r.deps.LogDeleteProgressBus.Publish(&model.LogDeleteProgressEvent{OperationID: id, Deleted: deleted, Total: matched, Done: done})
+		}
+	}
+	deleted, err := r.deps.JobService.DeleteJobLogs(ctx, svcFilter, logDeleteBatchSize, func(deleted, total int) {
+		matched = total
+		publish(deleted, false)
+	})
+	// Subscribers are told the deletion ended even if it failed
+	publish(deleted, true)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LogDeleteResult{OperationID: id, Matched: matched, Deleted: deleted}, nil
+}
+
 // List is the resolver for the list field.
 func (r *logQueryResolver) List(ctx context.Context, obj *model.LogQuery, connectionID uuid.UUID, taskID *uuid.UUID, jobID *uuid.UUID, level *model.LogLevel, pagination *model.PaginationInput) (*model.JobLogConnection, error) {
 	// Default pagination values
@@ -196,6 +415,16 @@ func (r *logQueryResolver) List(ctx context.Context, obj *model.LogQuery, connec
 	}, nil
 }
 
+// Job is the resolver for the job field.
+func (r *mutationResolver) Job(ctx context.Context) (*model.JobMutation, error) {
+	return &model.JobMutation{}, nil
+}
+
+// Log is the resolver for the log field.
+func (r *mutationResolver) Log(ctx context.Context) (*model.LogMutation, error) {
+	return &model.LogMutation{}, nil
+}
+
 // Job is the resolver for the job field.
 func (r *queryResolver) Job(ctx context.Context) (*model.JobQuery, error) {
 	return &model.JobQuery{}, nil
@@ -299,19 +528,111 @@ func (r *subscriptionResolver) TransferProgress(ctx context.Context, connectionI
 	return out, nil
 }
 
+// GroupProgress is the resolver for the groupProgress field.
+func (r *subscriptionResolver) GroupProgress(ctx context.Context, tag string) (<-chan *model.GroupProgressEvent, error) {
+	if r.deps.JobProgressBus == nil {
+		// Fallback: return an empty channel that immediately closes
+		ch := make(chan *model.GroupProgressEvent)
+		close(ch)
+		return ch, nil
+	}
+
+	// The group is made of the tasks tagged when subscribing
+	taskIDs, err := r.deps.TaskService.ListTaskIDsByTag(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	group := subscription.NewGroupProgress(tag, taskIDs)
+	sub := r.deps.JobProgressBus.Subscribe(group.Filter())
+
+	out := make(chan *model.GroupProgressEvent)
+
+	go func() {
+		defer r.deps.JobProgressBus.Unsubscribe(sub.ID)
+		defer close(out)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-sub.Events:
+				if !ok {
+					return
+				}
+				select {
+				case out <- group.Add(event):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// LogDeleteProgress is the resolver for the logDeleteProgress field.
+func (r *subscriptionResolver) LogDeleteProgress(ctx context.Context, operationID string) (<-chan *model.LogDeleteProgressEvent, error) {
+	if r.deps.LogDeleteProgressBus == nil {
+		// Fallback: return an empty channel that immediately closes
+		ch := make(chan *model.LogDeleteProgressEvent)
+		close(ch)
+		return ch, nil
+	}
+
+	sub := r.deps.LogDeleteProgressBus.Subscribe(subscription.LogDeleteProgressFilter(operationID))
+
+	out := make(chan *model.LogDeleteProgressEvent)
+
+	go func() {
+		defer r.deps.LogDeleteProgressBus.Unsubscribe(sub.ID)
+		defer close(out)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-sub.Events:
+				if !ok {
+					return
+				}
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+				if event.Done {
+					// The deletion ended, there are no more events for the operation
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 // Job returns generated.JobResolver implementation.
 func (r *Resolver) Job() generated.JobResolver { return &jobResolver{r} }
 
 // JobLog returns generated.JobLogResolver implementation.
 func (r *Resolver) JobLog() generated.JobLogResolver { return &jobLogResolver{r} }
 
+// JobMutation returns generated.JobMutationResolver implementation.
+func (r *Resolver) JobMutation() generated.JobMutationResolver { return &jobMutationResolver{r} }
+
 // JobQuery returns generated.JobQueryResolver implementation.
 func (r *Resolver) JobQuery() generated.JobQueryResolver { return &jobQueryResolver{r} }
 
+// LogMutation returns generated.LogMutationResolver implementation.
+func (r *Resolver) LogMutation() generated.LogMutationResolver { return &logMutationResolver{r} }
+
 // LogQuery returns generated.LogQueryResolver implementation.
 func (r *Resolver) LogQuery() generated.LogQueryResolver { return &logQueryResolver{r} }
 
 type jobResolver struct{ *Resolver }
 type jobLogResolver struct{ *Resolver }
+type jobMutationResolver struct{ *Resolver }
 type jobQueryResolver struct{ *Resolver }
+type logMutationResolver struct{ *Resolver }
 type logQueryResolver struct{ *Resolver }
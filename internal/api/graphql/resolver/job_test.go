@@ -11,9 +11,15 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
-// JobResolverTestSuite tests JobQuery and LogQuery resolvers.
+// JobResolverTestSuite tests JobQuery, JobMutation and LogQuery resolvers.
 type JobResolverTestSuite struct {
 	ResolverTestSuite
 }
@@ -69,6 +75,230 @@ func (s *JobResolverTestSuite) TestJobQuery_List() {
 	assert.Equal(s.T(), 3, len(gjson.Get(data, "job.list.items").Array()))
 }
 
+// TestJobMutation_Annotate tests JobMutation.annotate resolver.
+func (s *JobResolverTestSuite) TestJobMutation_Annotate() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "test-task", connID)
+	jobID := s.createTestJob(task.ID)
+
+	mutation := `
+		mutation($id: ID!, $note: String, $acknowledged: Boolean) {
+			job {
+				annotate(id: $id, note: $note, acknowledged: $acknowledged) {
+					id
+					note
+					acknowledged
+					annotatedAt
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":           jobID.String(),
+		"note":         "remote was down, ignore",
+		"acknowledged": true,
+	})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), "remote was down, ignore", gjson.Get(data, "job.annotate.note").String())
+	assert.True(s.T(), gjson.Get(data, "job.annotate.acknowledged").Bool())
+	assert.NotEmpty(s.T(), gjson.Get(data, "job.annotate.annotatedAt").String())
+
+	// The annotation is queryable
+	query := `
+		query($id: ID!) {
+			job {
+				get(id: $id) {
+					note
+					acknowledged
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": jobID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "remote was down, ignore", gjson.Get(string(resp.Data), "job.get.note").String())
+	assert.True(s.T(), gjson.Get(string(resp.Data), "job.get.acknowledged").Bool())
+
+	// Unknown job
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"id":   uuid.NewString(),
+		"note": "x",
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrJobNotFound, resp.Errors[0].Extensions["code"])
+}
+
+// TestJobMutation_RetryFailedFiles tests Job.failedFiles and JobMutation.retryFailedFiles.
+func (s *JobResolverTestSuite) TestJobMutation_RetryFailedFiles() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "test-task", connID)
+	jobID := s.createTestJob(task.ID)
+	require.NoError(s.T(), s.Env.JobService.AddRetryQueueItems(ctx, jobID, []*ent.RetryQueue{
+		{TaskID: task.ID, Path: "docs/a.txt", Direction: model.SyncDirectionUpload, ErrorClass: model.TransferErrorClassRateLimited, Error: "429 Too Many Requests"},
+	}))
+
+	query := `
+		query($id: ID!) {
+			job {
+				get(id: $id) {
+					failedFiles {
+						path
+						direction
+						errorClass
+						error
+						requestedAt
+					}
+				}
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": jobID.String()})
+	require.Empty(s.T(), resp.Errors)
+	files := gjson.Get(string(resp.Data), "job.get.failedFiles")
+	require.Len(s.T(), files.Array(), 1)
+	assert.Equal(s.T(), "docs/a.txt", files.Get("0.path").String())
+	assert.Equal(s.T(), "UPLOAD", files.Get("0.direction").String())
+	assert.Equal(s.T(), "RATE_LIMITED", files.Get("0.errorClass").String())
+	assert.Equal(s.T(), "429 Too Many Requests", files.Get("0.error").String())
+	assert.Equal(s.T(), gjson.Null, files.Get("0.requestedAt").Type)
+
+	mutation := `
+		mutation($jobId: ID!) {
+			job {
+				retryFailedFiles(jobId: $jobId) {
+					id
+					trigger
+				}
+			}
+		}
+	`
+
+	// A job without failed files can't be retried
+	otherJobID := s.createTestJob(task.ID)
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"jobId": otherJobID.String()})
+	require.NotEmpty(s.T(), resp.Errors)
+
+	// Unknown job
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"jobId": uuid.NewString()})
+	require.NotEmpty(s.T(), resp.Errors)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"jobId": jobID.String()})
+	// The retry job is created asynchronously, see TestTaskMutation_Run
+	if len(resp.Errors) > 0 {
+		assert.Contains(s.T(), resp.Errors[0].Message, "not found")
+	} else {
+		assert.NotEmpty(s.T(), gjson.Get(string(resp.Data), "job.retryFailedFiles.id").String())
+	}
+	items, err := s.Env.JobService.ListRetryQueue(ctx, jobID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 1)
+	assert.NotNil(s.T(), items[0].RequestedAt)
+}
+
+// TestJobMutation_ConfirmAbort tests JobMutation.confirm and JobMutation.abort for jobs that aren't waiting for confirmation.
+// Waiting jobs are covered by TestSyncEngine_RunTask_ConfirmDeletes.
+func (s *JobResolverTestSuite) TestJobMutation_ConfirmAbort() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "test-task", connID)
+	jobID := s.createTestJob(task.ID)
+
+	for _, field := range []string{"confirm", "abort"} {
+		mutation := `
+			mutation($id: ID!) {
+				job {
+					` + field + `(id: $id) {
+						id
+						status
+					}
+				}
+			}
+		`
+
+		resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"id": jobID.String()})
+		require.NotEmpty(s.T(), resp.Errors, field)
+		assert.Contains(s.T(), resp.Errors[0].Message, "not waiting for confirmation", field)
+
+		resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"id": uuid.NewString()})
+		require.NotEmpty(s.T(), resp.Errors, field)
+		assert.Contains(s.T(), resp.Errors[0].Message, "not found", field)
+	}
+}
+
+// TestJobMutation_CancelAll tests JobMutation.cancelAll with jobs that have no running execution.
+func (s *JobResolverTestSuite) TestJobMutation_CancelAll() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	otherConnID := s.Env.CreateTestConnection(s.T(), "other-conn")
+	task1 := s.Env.CreateTestTask(s.T(), "task-1", connID)
+	task2 := s.Env.CreateTestTask(s.T(), "task-2", connID)
+	otherTask := s.Env.CreateTestTask(s.T(), "other-task", otherConnID)
+
+	running := s.createTestJob(task1.ID)
+	_, err := s.Env.JobService.UpdateJobStatus(ctx, running, string(model.JobStatusRunning), "")
+	require.NoError(s.T(), err)
+	pending := s.createTestJob(task2.ID)
+	finished := s.createTestJob(task1.ID)
+	_, err = s.Env.JobService.UpdateJobStatus(ctx, finished, string(model.JobStatusSuccess), "")
+	require.NoError(s.T(), err)
+	other := s.createTestJob(otherTask.ID)
+
+	// Mirror jobs of tasks on other connections are matched by the connection of their mirror
+	mirroredTask := s.Env.CreateTestTask(s.T(), "mirrored-task", otherConnID)
+	mirroredParent := s.createTestJob(mirroredTask.ID)
+	_, err = s.Env.JobService.UpdateJobStatus(ctx, mirroredParent, string(model.JobStatusRunning), "")
+	require.NoError(s.T(), err)
+	mirrorCtx := provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{MirrorConnectionID: &connID})
+	mirror, err := s.Env.JobService.CreateChildJob(mirrorCtx, mirroredParent, mirroredTask.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	shardCtx := provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{})
+	_, err = s.Env.JobService.CreateChildJob(shardCtx, mirroredParent, mirroredTask.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+
+	mutation := `
+		mutation($connectionId: ID) {
+			job {
+				cancelAll(connectionId: $connectionId) {
+					job { id status endTime }
+					outcome
+					error
+				}
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"connectionId": connID.String()})
+	require.Empty(s.T(), resp.Errors)
+	results := gjson.Get(string(resp.Data), "job.cancelAll").Array()
+	require.Len(s.T(), results, 3, "finished jobs and jobs of other connections are left alone")
+	cancelled := make([]string, len(results))
+	for i, r := range results {
+		cancelled[i] = r.Get("job.id").String()
+	}
+	assert.ElementsMatch(s.T(), []string{running.String(), pending.String(), mirror.ID.String()}, cancelled)
+	for _, r := range results {
+		assert.Equal(s.T(), "CANCELLED", r.Get("outcome").String())
+		assert.Equal(s.T(), "CANCELLED", r.Get("job.status").String())
+		assert.NotEmpty(s.T(), r.Get("job.endTime").String())
+	}
+
+	j, err := s.Env.JobService.GetJob(ctx, other)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.JobStatusPending, j.Status)
+	j, err = s.Env.JobService.GetJob(ctx, finished)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), model.JobStatusSuccess, j.Status)
+
+	// Without a filter every active job is cancelled
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, nil)
+	require.Empty(s.T(), resp.Errors)
+	results = gjson.Get(string(resp.Data), "job.cancelAll").Array()
+	require.Len(s.T(), results, 2, "child jobs end with their parent")
+	assert.ElementsMatch(s.T(), []string{other.String(), mirroredParent.String()},
+		[]string{results[0].Get("job.id").String(), results[1].Get("job.id").String()})
+}
+
 // TestJobQuery_ListWithTaskFilter tests JobQuery.list with taskId filter.
 func (s *JobResolverTestSuite) TestJobQuery_ListWithTaskFilter() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
@@ -117,6 +347,101 @@ func (s *JobResolverTestSuite) TestJobQuery_ListWithTaskFilter() {
 	assert.Equal(s.T(), 2, int(gjson.Get(data, "job.list.totalCount").Int()))
 }
 
+// TestJobQuery_ByDay tests JobQuery.byDay resolver.
+func (s *JobResolverTestSuite) TestJobQuery_ByDay() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "test-task", connID)
+
+	jobIDs := []uuid.UUID{s.createTestJob(task.ID), s.createTestJob(task.ID)}
+	_, err := s.Env.JobService.UpdateJobStatus(ctx, jobIDs[0], string(model.JobStatusFailed), "boom")
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.UpdateJobStats(ctx, jobIDs[1], 3, 300, 0, 0)
+	require.NoError(s.T(), err)
+
+	query := `
+		query($taskId: ID, $days: Int!) {
+			job {
+				byDay(taskId: $taskId, days: $days) {
+					date
+					jobCount
+					failedCount
+					runningCount
+					filesTransferred
+					bytesTransferred
+					jobIds
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"taskId": task.ID.String(),
+		"days":   7,
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	days := gjson.Get(string(resp.Data), "job.byDay").Array()
+	require.Len(s.T(), days, 1)
+	assert.Equal(s.T(), int64(2), days[0].Get("jobCount").Int())
+	assert.Equal(s.T(), int64(1), days[0].Get("failedCount").Int())
+	assert.Equal(s.T(), int64(1), days[0].Get("runningCount").Int(), "New jobs are pending")
+	assert.Equal(s.T(), int64(3), days[0].Get("filesTransferred").Int())
+	assert.Equal(s.T(), int64(300), days[0].Get("bytesTransferred").Int())
+	assert.Len(s.T(), days[0].Get("jobIds").Array(), 2)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"days": 0})
+	assert.Equal(s.T(), map[string]interface{}{"days": i18n.ErrDaysOutOfRange}, validationFieldCodes(s.T(), resp))
+}
+
+// TestJobQuery_ListWithStatusFilter tests JobQuery.list with status filter.
+func (s *JobResolverTestSuite) TestJobQuery_ListWithStatusFilter() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "test-task", connID)
+
+	s.createTestJob(task.ID)
+	for i := 0; i < 2; i++ {
+		jobID := s.createTestJob(task.ID)
+		_, err := s.Env.JobService.UpdateJobStatus(ctx, jobID, "SUCCESS_WITH_WARNINGS", "")
+		require.NoError(s.T(), err)
+	}
+
+	query := `
+		query($taskId: ID, $status: JobStatus) {
+			job {
+				list(taskId: $taskId, status: $status) {
+					items {
+						status
+						endTime
+					}
+					totalCount
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"taskId": task.ID.String(),
+		"status": "SUCCESS_WITH_WARNINGS",
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.Equal(s.T(), 2, int(gjson.Get(data, "job.list.totalCount").Int()))
+	for _, item := range gjson.Get(data, "job.list.items").Array() {
+		assert.Equal(s.T(), "SUCCESS_WITH_WARNINGS", item.Get("status").String())
+		assert.True(s.T(), item.Get("endTime").Exists() && item.Get("endTime").Type != gjson.Null)
+	}
+
+	// Without a status filter all jobs are listed
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"taskId": task.ID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), 3, int(gjson.Get(string(resp.Data), "job.list.totalCount").Int()))
+}
+
 // TestJobQuery_ListWithPagination tests JobQuery.list with pagination.
 func (s *JobResolverTestSuite) TestJobQuery_ListWithPagination() {
 	testCases := []struct {
@@ -190,6 +515,201 @@ func (s *JobResolverTestSuite) TestJob_Task() {
 	assert.Equal(s.T(), "my-task", items[0].Get("task.name").String())
 }
 
+// TestJob_TriggerDetail tests the Job.triggerDetail field.
+func (s *JobResolverTestSuite) TestJob_TriggerDetail() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "watched-task", connID)
+
+	eventCount := 3
+	ctx := provenance.WithTriggerDetail(context.Background(), &model.JobTriggerDetail{
+		EventCount: &eventCount,
+		EventPaths: []string{"docs/a.txt", "docs/b.txt"},
+	})
+	_, err := s.Env.JobService.CreateJob(ctx, task.ID, model.JobTriggerRealtime)
+	require.NoError(s.T(), err)
+	s.createTestJob(task.ID)
+
+	query := `
+		query($taskId: ID) {
+			job {
+				list(taskId: $taskId) {
+					items {
+						trigger
+						triggerDetail {
+							schedule
+							eventCount
+							eventPaths
+							user
+						}
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"taskId": task.ID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	items := gjson.Get(string(resp.Data), "job.list.items").Array()
+	require.Len(s.T(), items, 2)
+	var realtime, other gjson.Result
+	for _, item := range items {
+		if item.Get("trigger").String() == string(model.JobTriggerRealtime) {
+			realtime = item
+		} else {
+			other = item
+		}
+	}
+	assert.Equal(s.T(), int64(3), realtime.Get("triggerDetail.eventCount").Int())
+	assert.Equal(s.T(), `["docs/a.txt","docs/b.txt"]`, realtime.Get("triggerDetail.eventPaths").Raw)
+	assert.Equal(s.T(), gjson.Null, realtime.Get("triggerDetail.schedule").Type)
+	assert.Equal(s.T(), gjson.Null, other.Get("triggerDetail").Type, "jobs created without a detail have none")
+}
+
+// TestJob_ConfigSnapshot tests the Job.configSnapshot field.
+func (s *JobResolverTestSuite) TestJob_ConfigSnapshot() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "bisync-task", connID)
+	jobID := s.createTestJob(task.ID)
+
+	conflict := model.ConflictResolutionBoth
+	resync := true
+	_, err := s.Env.JobService.FinalizeJob(context.Background(), jobID, ports.JobResult{
+		Status: model.JobStatusSuccess,
+		ConfigSnapshot: &model.JobConfigSnapshot{
+			Direction:          model.SyncDirectionBidirectional,
+			Transfers:          8,
+			Filters:            []string{"- *.tmp"},
+			Shards:             1,
+			ConflictResolution: &conflict,
+			Resync:             &resync,
+		},
+	})
+	require.NoError(s.T(), err)
+
+	query := `
+		query($id: ID!) {
+			job {
+				get(id: $id) {
+					configSnapshot {
+						direction
+						transfers
+						bwLimit
+						filters
+						conflictResolution
+						resync
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"id": jobID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	snapshot := gjson.Get(string(resp.Data), "job.get.configSnapshot")
+	assert.Equal(s.T(), "BIDIRECTIONAL", snapshot.Get("direction").String())
+	assert.Equal(s.T(), int64(8), snapshot.Get("transfers").Int())
+	assert.Equal(s.T(), gjson.Null, snapshot.Get("bwLimit").Type)
+	assert.Equal(s.T(), `["- *.tmp"]`, snapshot.Get("filters").Raw)
+	assert.Equal(s.T(), "BOTH", snapshot.Get("conflictResolution").String())
+	assert.True(s.T(), snapshot.Get("resync").Bool())
+}
+
+// TestLogMutation_Delete tests LogMutation.delete resolver.
+func (s *JobResolverTestSuite) TestLogMutation_Delete() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	noisy := s.Env.CreateTestTask(s.T(), "noisy-task", connID)
+	quiet := s.Env.CreateTestTask(s.T(), "quiet-task", connID)
+	for _, taskID := range []uuid.UUID{noisy.ID, noisy.ID, quiet.ID} {
+		_, err := s.Env.JobService.AddJobLog(ctx, s.createTestJob(taskID), string(model.LogLevelInfo), string(model.LogActionUpload), "file.txt", 1)
+		require.NoError(s.T(), err)
+	}
+
+	mutation := `
+		mutation($filter: LogDeleteFilter!) {
+			log {
+				delete(filter: $filter) {
+					operationId
+					matched
+					deleted
+				}
+			}
+		}
+	`
+
+	// Deleting every log at once requires an explicit filter
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"filter": map[string]interface{}{},
+	})
+	assert.Equal(s.T(), map[string]interface{}{"filter": i18n.ErrLogDeleteFilterEmpty}, validationFieldCodes(s.T(), resp))
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"filter": map[string]interface{}{"taskId": noisy.ID.String(), "level": "INFO"},
+	})
+	require.Empty(s.T(), resp.Errors)
+	result := gjson.Get(string(resp.Data), "log.delete")
+	assert.NotEmpty(s.T(), result.Get("operationId").String(), "an operation ID is generated if none is given")
+	assert.Equal(s.T(), int64(2), result.Get("matched").Int())
+	assert.Equal(s.T(), int64(2), result.Get("deleted").Int())
+
+	count, err := s.Env.JobService.CountJobLogs(ctx, nil, &noisy.ID, nil, "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, count)
+	count, err = s.Env.JobService.CountJobLogs(ctx, nil, &quiet.ID, nil, "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, count)
+}
+
+// TestJob_ParentChildren tests Job.parent and Job.children field resolvers for sharded jobs.
+func (s *JobResolverTestSuite) TestJob_ParentChildren() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "sharded-task", connID)
+	parentID := s.createTestJob(task.ID)
+	child, err := s.Env.JobService.CreateChildJob(ctx, parentID, task.ID, "MANUAL")
+	require.NoError(s.T(), err)
+
+	query := `
+		query($taskId: ID) {
+			job {
+				list(taskId: $taskId) {
+					items {
+						id
+						parent { id }
+						children {
+							id
+							parent { id }
+						}
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"taskId": task.ID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	items := gjson.Get(data, "job.list.items").Array()
+	require.Len(s.T(), items, 1, "Child jobs should only be reachable through their parent")
+
+	assert.Equal(s.T(), parentID.String(), items[0].Get("id").String())
+	assert.False(s.T(), items[0].Get("parent.id").Exists())
+
+	children := items[0].Get("children").Array()
+	require.Len(s.T(), children, 1)
+	assert.Equal(s.T(), child.ID.String(), children[0].Get("id").String())
+	assert.Equal(s.T(), parentID.String(), children[0].Get("parent.id").String())
+}
+
 // TestJob_Logs tests Job.logs field resolver.
 func (s *JobResolverTestSuite) TestJob_Logs() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
@@ -516,6 +1036,41 @@ func (s *JobResolverTestSuite) TestJob_FilesDeletedAndErrorCount() {
 	assert.Equal(s.T(), int64(0), items[0].Get("errorCount").Int())
 }
 
+// TestJob_CheckCounters tests Job.filesChecked and Job.listingsDone fields.
+func (s *JobResolverTestSuite) TestJob_CheckCounters() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "test-task", connID)
+	jobID := s.createTestJob(task.ID)
+	_, err := s.Env.JobService.FinalizeJob(context.Background(), jobID, ports.JobResult{
+		Status:       model.JobStatusSuccess,
+		FilesChecked: 300000,
+		ListingsDone: 310000,
+	})
+	require.NoError(s.T(), err)
+
+	query := `
+		query($id: ID!) {
+			job {
+				get(id: $id) {
+					filesTransferred
+					filesChecked
+					listingsDone
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"id": jobID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	job := gjson.Get(string(resp.Data), "job.get")
+	assert.Equal(s.T(), int64(0), job.Get("filesTransferred").Int())
+	assert.Equal(s.T(), int64(300000), job.Get("filesChecked").Int())
+	assert.Equal(s.T(), int64(310000), job.Get("listingsDone").Int())
+}
+
 // TestLogQuery_EmptyJobId tests LogQuery.list with non-existent job.
 func (s *JobResolverTestSuite) TestLogQuery_EmptyJobId() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
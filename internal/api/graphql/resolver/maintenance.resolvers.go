@@ -0,0 +1,134 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"go.uber.org/zap"
+)
+
+// Enable is the resolver for the enable field.
+func (r *maintenanceMutationResolver) Enable(ctx context.Context, obj *model.MaintenanceMutation, cancelRunning *bool) (*model.MaintenanceStatus, error) {
+	r.deps.Runner.SetMaintenance(true, cancelRunning != nil && *cancelRunning)
+	return maintenanceStatus(r.deps.Runner, r.deps.SyncEngine), nil
+}
+
+// Disable is the resolver for the disable field.
+func (r *maintenanceMutationResolver) Disable(ctx context.Context, obj *model.MaintenanceMutation) (*model.MaintenanceStatus, error) {
+	r.deps.Runner.SetMaintenance(false, false)
+	return maintenanceStatus(r.deps.Runner, r.deps.SyncEngine), nil
+}
+
+// RelocateDataDir is the resolver for the relocateDataDir field.
+func (r *maintenanceMutationResolver) RelocateDataDir(ctx context.Context, obj *model.MaintenanceMutation, newPath string) (*model.DataDirRelocation, error) {
+	if !r.deps.Runner.IsMaintenance() || r.deps.Runner.RunningCount() > 0 {
+		return nil, i18n.NewI18nError(i18n.ErrRelocateNotInMaintenance).WithStatus(409)
+	}
+	// The demo task refers to the files of the demo data by their paths, so they can't be moved along
+	if _, err := os.Stat(demoDir(r.deps.SyncEngine)); err == nil {
+		return nil, i18n.NewI18nError(i18n.ErrRelocateDemoData).WithStatus(409)
+	}
+
+	relocation, err := r.deps.SyncEngine.RelocateDataDir(newPath)
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrRelocateFailed).WithCause(err)
+	}
+
+	configUpdated, err := config.PersistString("app.data_dir", relocation.DataDir)
+	if err != nil {
+		// The state already lives in the new directory; report it so the config can be fixed manually
+		logger.Named("api.graphql").Error("Failed to update data_dir in config file",
+			zap.String("data_dir", relocation.DataDir), zap.Error(err))
+	}
+
+	return &model.DataDirRelocation{
+		DataDir:       relocation.DataDir,
+		MovedFiles:    relocation.MovedFiles,
+		MovedBytes:    relocation.MovedBytes,
+		ConfigUpdated: configUpdated,
+	}, nil
+}
+
+// Reindex is the resolver for the reindex field.
+func (r *maintenanceMutationResolver) Reindex(ctx context.Context, obj *model.MaintenanceMutation, dryRun *bool) (*model.ReindexReport, error) {
+	report, err := r.deps.JobService.ReindexJobRollups(ctx, dryRun == nil || !*dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	discrepancies := make([]*model.ReindexDiscrepancy, len(report.Discrepancies))
+	for i, d := range report.Discrepancies {
+		discrepancies[i] = &model.ReindexDiscrepancy{
+			JobID:    d.JobID,
+			Field:    d.Field,
+			Stored:   d.Stored,
+			Computed: d.Computed,
+		}
+	}
+	return &model.ReindexReport{
+		CheckedJobs:   report.CheckedJobs,
+		Discrepancies: discrepancies,
+		FixedJobs:     report.FixedJobs,
+	}, nil
+}
+
+// OptimizeDatabase is the resolver for the optimizeDatabase field.
+func (r *maintenanceMutationResolver) OptimizeDatabase(ctx context.Context, obj *model.MaintenanceMutation) (*model.DatabaseOptimization, error) {
+	if r.deps.Runner.RunningCount() > 0 {
+		return nil, i18n.NewI18nError(i18n.ErrOptimizeTasksRunning).WithStatus(409)
+	}
+
+	report, err := r.deps.DatabaseService.Optimize(ctx)
+	if err != nil {
+		if errors.Is(err, services.ErrOptimizeRunning) {
+			return nil, i18n.NewI18nError(i18n.ErrOptimizeRunning).WithStatus(409)
+		}
+		return nil, i18n.NewI18nError(i18n.ErrOptimizeFailed).WithCause(err)
+	}
+	return databaseOptimization(report), nil
+}
+
+// Status is the resolver for the status field.
+func (r *maintenanceQueryResolver) Status(ctx context.Context, obj *model.MaintenanceQuery) (*model.MaintenanceStatus, error) {
+	return maintenanceStatus(r.deps.Runner, r.deps.SyncEngine), nil
+}
+
+// LastDatabaseOptimization is the resolver for the lastDatabaseOptimization field.
+func (r *maintenanceQueryResolver) LastDatabaseOptimization(ctx context.Context, obj *model.MaintenanceQuery) (*model.DatabaseOptimization, error) {
+	return databaseOptimization(r.deps.DatabaseService.LastOptimization()), nil
+}
+
+// Maintenance is the resolver for the maintenance field.
+func (r *mutationResolver) Maintenance(ctx context.Context) (*model.MaintenanceMutation, error) {
+	return &model.MaintenanceMutation{}, nil
+}
+
+// Maintenance is the resolver for the maintenance field.
+func (r *queryResolver) Maintenance(ctx context.Context) (*model.MaintenanceQuery, error) {
+	return &model.MaintenanceQuery{}, nil
+}
+
+// MaintenanceMutation returns generated.MaintenanceMutationResolver implementation.
+func (r *Resolver) MaintenanceMutation() generated.MaintenanceMutationResolver {
+	return &maintenanceMutationResolver{r}
+}
+
+// MaintenanceQuery returns generated.MaintenanceQueryResolver implementation.
+func (r *Resolver) MaintenanceQuery() generated.MaintenanceQueryResolver {
+	return &maintenanceQueryResolver{r}
+}
+
+type maintenanceMutationResolver struct{ *Resolver }
+type maintenanceQueryResolver struct{ *Resolver }
@@ -0,0 +1,263 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// MaintenanceResolverTestSuite tests MaintenanceQuery and MaintenanceMutation resolvers.
+type MaintenanceResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestMaintenanceResolverSuite(t *testing.T) {
+	suite.Run(t, new(MaintenanceResolverTestSuite))
+}
+
+// TestMaintenanceQuery_Status tests MaintenanceQuery.status resolver.
+func (s *MaintenanceResolverTestSuite) TestMaintenanceQuery_Status() {
+	query := `
+		query {
+			maintenance {
+				status {
+					enabled
+					runningTaskCount
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.False(s.T(), gjson.Get(data, "maintenance.status.enabled").Bool())
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "maintenance.status.runningTaskCount").Int())
+}
+
+// TestMaintenanceMutation_RejectsMutations tests that mutations are rejected in maintenance mode
+// while queries and the maintenance mutations keep working.
+func (s *MaintenanceResolverTestSuite) TestMaintenanceMutation_RejectsMutations() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "maintenance-task", connID)
+
+	enableMutation := `
+		mutation {
+			maintenance {
+				enable(cancelRunning: true) {
+					enabled
+				}
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: enableMutation})
+	require.Empty(s.T(), resp.Errors)
+	assert.True(s.T(), gjson.Get(string(resp.Data), "maintenance.enable.enabled").Bool())
+	assert.True(s.T(), s.Env.Runner.IsMaintenance())
+
+	// Mutations are rejected with the maintenance error code
+	runMutation := `
+		mutation($taskId: ID!) {
+			task {
+				run(taskId: $taskId) {
+					id
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), runMutation, map[string]interface{}{
+		"taskId": task.ID.String(),
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrMaintenanceMode, resp.Errors[0].Extensions["code"])
+	assert.False(s.T(), s.Env.Runner.IsRunning(task.ID))
+
+	// Queries still work
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					name
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"id": task.ID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "maintenance-task", gjson.Get(string(resp.Data), "task.get.name").String())
+
+	// Maintenance mode can still be disabled
+	disableMutation := `
+		mutation {
+			maintenance {
+				disable {
+					enabled
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: disableMutation})
+	require.Empty(s.T(), resp.Errors)
+	assert.False(s.T(), gjson.Get(string(resp.Data), "maintenance.disable.enabled").Bool())
+	assert.False(s.T(), s.Env.Runner.IsMaintenance())
+}
+
+// TestMaintenanceMutation_RelocateDataDir tests MaintenanceMutation.relocateDataDir resolver.
+func (s *MaintenanceResolverTestSuite) TestMaintenanceMutation_RelocateDataDir() {
+	newDataDir := filepath.Join(s.T().TempDir(), "relocated")
+	mutation := `
+		mutation($path: String!) {
+			maintenance {
+				relocateDataDir(newPath: $path) {
+					dataDir
+					movedFiles
+					configUpdated
+				}
+			}
+		}
+	`
+	vars := map[string]interface{}{"path": newDataDir}
+
+	// Refused outside maintenance mode
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars)
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrRelocateNotInMaintenance, resp.Errors[0].Extensions["code"])
+
+	s.Env.Runner.SetMaintenance(true, false)
+	defer s.Env.Runner.SetMaintenance(false, false)
+
+	// Refused while the demo data lives in the data directory
+	demoDir := filepath.Join(s.Env.Deps.SyncEngine.DataDir(), services.DemoDirName)
+	require.NoError(s.T(), os.MkdirAll(demoDir, 0o755))
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars)
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrRelocateDemoData, resp.Errors[0].Extensions["code"])
+	require.NoError(s.T(), os.RemoveAll(demoDir))
+
+	// Refused below the current data directory
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"path": filepath.Join(s.Env.Deps.SyncEngine.DataDir(), "nested"),
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	assert.Equal(s.T(), i18n.ErrRelocateFailed, resp.Errors[0].Extensions["code"])
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), newDataDir, gjson.Get(data, "maintenance.relocateDataDir.dataDir").String())
+	assert.False(s.T(), gjson.Get(data, "maintenance.relocateDataDir.configUpdated").Bool(), "tests run without a config file")
+
+	// The status reports the new location
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: `query { maintenance { status { dataDir } } }`})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), newDataDir, gjson.Get(string(resp.Data), "maintenance.status.dataDir").String())
+}
+
+// TestMaintenanceMutation_Reindex tests MaintenanceMutation.reindex resolver.
+func (s *MaintenanceResolverTestSuite) TestMaintenanceMutation_Reindex() {
+	ctx := context.Background()
+	connID := s.Env.CreateTestConnection(s.T(), "reindex-conn")
+	task := s.Env.CreateTestTask(s.T(), "reindex-task", connID)
+
+	parent, err := s.Env.JobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	child, err := s.Env.JobService.CreateChildJob(ctx, parent.ID, task.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.FinalizeJob(ctx, child.ID, ports.JobResult{Status: model.JobStatusSuccess, FilesTransferred: 3})
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.FinalizeJob(ctx, parent.ID, ports.JobResult{Status: model.JobStatusSuccess, FilesTransferred: 1})
+	require.NoError(s.T(), err)
+
+	mutation := `
+		mutation($dryRun: Boolean) {
+			maintenance {
+				reindex(dryRun: $dryRun) {
+					checkedJobs
+					fixedJobs
+					discrepancies {
+						jobId
+						field
+						stored
+						computed
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"dryRun": true})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "maintenance.reindex.checkedJobs").Int())
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "maintenance.reindex.fixedJobs").Int())
+	require.Equal(s.T(), int64(1), gjson.Get(data, "maintenance.reindex.discrepancies.#").Int())
+	assert.Equal(s.T(), parent.ID.String(), gjson.Get(data, "maintenance.reindex.discrepancies.0.jobId").String())
+	assert.Equal(s.T(), "files_transferred", gjson.Get(data, "maintenance.reindex.discrepancies.0.field").String())
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "maintenance.reindex.discrepancies.0.stored").Int())
+	assert.Equal(s.T(), int64(3), gjson.Get(data, "maintenance.reindex.discrepancies.0.computed").Int())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), int64(1), gjson.Get(string(resp.Data), "maintenance.reindex.fixedJobs").Int())
+
+	j, err := s.Env.JobService.GetJob(ctx, parent.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, j.FilesTransferred)
+}
+
+// TestMaintenanceMutation_OptimizeDatabase tests MaintenanceMutation.optimizeDatabase and MaintenanceQuery.lastDatabaseOptimization resolvers.
+func (s *MaintenanceResolverTestSuite) TestMaintenanceMutation_OptimizeDatabase() {
+	lastQuery := `
+		query {
+			maintenance {
+				lastDatabaseOptimization {
+					sizeAfter
+				}
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: lastQuery})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(string(resp.Data), "maintenance.lastDatabaseOptimization").Type)
+
+	mutation := `
+		mutation {
+			maintenance {
+				optimizeDatabase {
+					startedAt
+					durationMs
+					sizeBefore
+					sizeAfter
+					reclaimedBytes
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: mutation})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	sizeBefore := gjson.Get(data, "maintenance.optimizeDatabase.sizeBefore").Int()
+	sizeAfter := gjson.Get(data, "maintenance.optimizeDatabase.sizeAfter").Int()
+	assert.Positive(s.T(), sizeAfter)
+	assert.Equal(s.T(), sizeBefore-sizeAfter, gjson.Get(data, "maintenance.optimizeDatabase.reclaimedBytes").Int())
+	assert.NotEmpty(s.T(), gjson.Get(data, "maintenance.optimizeDatabase.startedAt").String())
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: lastQuery})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), sizeAfter, gjson.Get(string(resp.Data), "maintenance.lastDatabaseOptimization.sizeAfter").Int())
+}
@@ -8,6 +8,7 @@ package resolver
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
@@ -98,6 +99,33 @@ func (r *providerQueryResolver) Get(ctx context.Context, obj *model.ProviderQuer
 	}, nil
 }
 
+// Presets is the resolver for the presets field.
+func (r *providerQueryResolver) Presets(ctx context.Context, obj *model.ProviderQuery) ([]*model.ConnectionPreset, error) {
+	presets := rclone.ListConnectionPresets()
+	result := make([]*model.ConnectionPreset, 0, len(presets))
+	for _, p := range presets {
+		preset := &model.ConnectionPreset{
+			Name:        p.Name,
+			Description: p.Description,
+			Type:        p.Type,
+			Defaults:    maps.Clone(p.Defaults),
+			Fixed:       maps.Clone(p.Fixed),
+			Required:    p.Required,
+		}
+		if preset.Defaults == nil {
+			preset.Defaults = map[string]string{}
+		}
+		if preset.Fixed == nil {
+			preset.Fixed = map[string]string{}
+		}
+		if p.EndpointTemplate != "" {
+			preset.EndpointTemplate = &p.EndpointTemplate
+		}
+		result = append(result, preset)
+	}
+	return result, nil
+}
+
 // Provider is the resolver for the provider field.
 func (r *queryResolver) Provider(ctx context.Context) (*model.ProviderQuery, error) {
 	return &model.ProviderQuery{}, nil
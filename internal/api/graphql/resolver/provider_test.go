@@ -278,3 +278,41 @@ func (s *ProviderResolverTestSuite) TestProviderQuery_EmptyName() {
 	result := gjson.Get(data, "provider.get")
 	assert.True(s.T(), !result.Exists() || result.Type == gjson.Null, "provider.get should be null for empty name")
 }
+
+// TestProviderQuery_Presets tests ProviderQuery.presets resolver.
+func (s *ProviderResolverTestSuite) TestProviderQuery_Presets() {
+	query := `
+		query {
+			provider {
+				presets {
+					name
+					type
+					defaults
+					fixed
+					endpointTemplate
+					required
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	r2 := gjson.Get(data, `provider.presets.#(name=="cloudflare-r2")`)
+	require.True(s.T(), r2.Exists())
+	assert.Equal(s.T(), "s3", r2.Get("type").String())
+	assert.Equal(s.T(), "Cloudflare", r2.Get("fixed.provider").String())
+	assert.Equal(s.T(), "private", r2.Get("defaults.acl").String())
+	assert.Equal(s.T(), gjson.Null, r2.Get("endpointTemplate").Type)
+	assert.Equal(s.T(), `["endpoint","access_key_id","secret_access_key"]`, r2.Get("required").Raw)
+
+	wasabi := gjson.Get(data, `provider.presets.#(name=="wasabi")`)
+	assert.Equal(s.T(), "https://s3.{region}.wasabisys.com", wasabi.Get("endpointTemplate").String())
+
+	photos := gjson.Get(data, `provider.presets.#(name=="google-photos")`)
+	assert.Equal(s.T(), "gphotos", photos.Get("type").String())
+	assert.Equal(s.T(), `{}`, photos.Get("fixed").Raw)
+	assert.Equal(s.T(), `["token"]`, photos.Get("required").Raw)
+}
@@ -19,16 +19,26 @@ import (
 
 // Dependencies holds all dependencies required by resolvers.
 type Dependencies struct {
-	SyncEngine          *rclone.SyncEngine
-	Runner              ports.Runner
-	Watcher             ports.Watcher
-	Scheduler           ports.Scheduler
-	Encryptor           *crypto.Encryptor
-	JobProgressBus      *subscription.JobProgressBus
-	TransferProgressBus *subscription.TransferProgressBus
-	ConnectionService   *services.ConnectionService
-	TaskService         *services.TaskService
-	JobService          *services.JobService
+	SyncEngine           *rclone.SyncEngine
+	BackupEngine         *rclone.BackupEngine
+	Runner               ports.Runner
+	Watcher              ports.Watcher
+	Scheduler            ports.Scheduler
+	Encryptor            *crypto.Encryptor
+	JobProgressBus       *subscription.JobProgressBus
+	TransferProgressBus  *subscription.TransferProgressBus
+	LogDeleteProgressBus *subscription.LogDeleteProgressBus // nil disables the progress events of log.delete
+	ConnectionService    *services.ConnectionService
+	TaskService          *services.TaskService
+	JobService           *services.JobService
+	DemoService          *services.DemoService
+	UsageService         *services.UsageService
+	CredentialService    *services.CredentialService
+	IntegrityService     *services.IntegrityService
+	IdempotencyService   *services.IdempotencyService
+	ShareTokenService    *services.ShareTokenService
+	UpdateService        *services.UpdateService // nil if update checks are disabled
+	DatabaseService      *services.DatabaseService
 }
 
 // Resolver is the root resolver that holds all dependencies.
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -16,9 +18,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/resolver"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
 	"github.com/xzzpig/rclone-sync/internal/core/crypto"
@@ -42,6 +46,7 @@ type TestEnv struct {
 	JobService        *services.JobService
 	ConnectionService *services.ConnectionService
 	Runner            ports.Runner
+	LocalDir          string
 	Cleanup           func()
 }
 
@@ -80,7 +85,10 @@ func NewTestEnv(t *testing.T) *TestEnv {
 	storage.Install()
 
 	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, appDataDir, false, 0)
+	syncEngine.SetConnectionResolver(connectionService)
+	backupEngine := rclone.NewBackupEngine(syncEngine)
 	runnerInstance := runner.NewRunner(syncEngine)
+	runnerInstance.RegisterEngine(ports.BackupSyncEngine, backupEngine)
 
 	// Create mock watcher and scheduler for testing
 	mockWatcher := &mockWatcher{}
@@ -92,16 +100,25 @@ func NewTestEnv(t *testing.T) *TestEnv {
 
 	// Create dependencies
 	deps := &resolver.Dependencies{
-		SyncEngine:          syncEngine,
-		Runner:              runnerInstance,
-		JobService:          jobService,
-		Watcher:             mockWatcher,
-		Scheduler:           mockScheduler,
-		TaskService:         taskService,
-		ConnectionService:   connectionService,
-		Encryptor:           encryptor,
-		JobProgressBus:      jobProgressBus,
-		TransferProgressBus: transferProgressBus,
+		SyncEngine:           syncEngine,
+		BackupEngine:         backupEngine,
+		Runner:               runnerInstance,
+		JobService:           jobService,
+		Watcher:              mockWatcher,
+		Scheduler:            mockScheduler,
+		TaskService:          taskService,
+		ConnectionService:    connectionService,
+		DemoService:          services.NewDemoService(client, connectionService),
+		UsageService:         services.NewUsageService(client, 0, 0),
+		CredentialService:    services.NewCredentialService(connectionService, 0),
+		IntegrityService:     services.NewIntegrityService(connectionService),
+		IdempotencyService:   services.NewIdempotencyService(client),
+		ShareTokenService:    services.NewShareTokenService(client),
+		DatabaseService:      services.NewDatabaseService(client),
+		Encryptor:            encryptor,
+		JobProgressBus:       jobProgressBus,
+		TransferProgressBus:  transferProgressBus,
+		LogDeleteProgressBus: subscription.NewLogDeleteProgressBus(),
 	}
 
 	// Create GraphQL handler
@@ -113,6 +130,7 @@ func NewTestEnv(t *testing.T) *TestEnv {
 
 	// Add dataloader middleware
 	router.Use(dataloader.Middleware(client))
+	router.Use(apicontext.IdempotencyKeyMiddleware())
 
 	router.POST("/graphql", graphql.GinHandler(srv))
 
@@ -130,6 +148,7 @@ func NewTestEnv(t *testing.T) *TestEnv {
 		JobService:        jobService,
 		ConnectionService: connectionService,
 		Runner:            runnerInstance,
+		LocalDir:          t.TempDir(),
 		Cleanup:           cleanup,
 	}
 }
@@ -139,6 +158,8 @@ type GraphQLRequest struct {
 	Query         string                 `json:"query"`
 	OperationName string                 `json:"operationName,omitempty"`
 	Variables     map[string]interface{} `json:"variables,omitempty"`
+	// Headers are additional HTTP headers of the request
+	Headers map[string]string `json:"-"`
 }
 
 // GraphQLResponse represents a GraphQL response body.
@@ -164,6 +185,9 @@ func (e *TestEnv) ExecuteGraphQL(t *testing.T, req GraphQLRequest) *GraphQLRespo
 	httpReq, err := http.NewRequest(http.MethodPost, "/graphql", bytes.NewBuffer(body))
 	require.NoError(t, err)
 	httpReq.Header.Set("Content-Type", "application/json")
+	for k, v := range req.Headers {
+		httpReq.Header.Set(k, v)
+	}
 
 	w := httptest.NewRecorder()
 	e.Router.ServeHTTP(w, httpReq)
@@ -183,6 +207,15 @@ func (e *TestEnv) ExecuteGraphQLWithVars(t *testing.T, query string, vars map[st
 	})
 }
 
+// SourcePath returns an existing local directory under LocalDir for use as a task source path.
+// Calling it again with the same name returns the same directory.
+func (e *TestEnv) SourcePath(t *testing.T, name string) string {
+	t.Helper()
+	dir := filepath.Join(e.LocalDir, name)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	return dir
+}
+
 // CreateTestConnection creates a test connection and returns its ID.
 func (e *TestEnv) CreateTestConnection(t *testing.T, name string) uuid.UUID {
 	t.Helper()
@@ -212,20 +245,31 @@ func (e *TestEnv) CreateTestTask(t *testing.T, name string, connectionID uuid.UU
 }
 
 // mockWatcher is a mock implementation of ports.Watcher for testing.
-type mockWatcher struct{}
+type mockWatcher struct {
+	warnings map[uuid.UUID]*model.WatchWarning
+}
 
 func (m *mockWatcher) Start()                          {}
 func (m *mockWatcher) Stop()                           {}
 func (m *mockWatcher) AddTask(task *ent.Task) error    { return nil }
 func (m *mockWatcher) RemoveTask(task *ent.Task) error { return nil }
+func (m *mockWatcher) WatchWarning(taskID uuid.UUID) *model.WatchWarning {
+	return m.warnings[taskID]
+}
 
 // mockScheduler is a mock implementation of ports.Scheduler for testing.
-type mockScheduler struct{}
+type mockScheduler struct {
+	paused bool
+}
 
 func (m *mockScheduler) Start()                          {}
 func (m *mockScheduler) Stop()                           {}
 func (m *mockScheduler) AddTask(task *ent.Task) error    { return nil }
 func (m *mockScheduler) RemoveTask(task *ent.Task) error { return nil }
+func (m *mockScheduler) Pause()                          { m.paused = true }
+func (m *mockScheduler) Resume()                         { m.paused = false }
+func (m *mockScheduler) IsPaused() bool                  { return m.paused }
+func (m *mockScheduler) ScheduledTaskCount() int         { return 0 }
 
 // ResolverTestSuite is a base test suite for resolver tests.
 type ResolverTestSuite struct {
@@ -0,0 +1,53 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// Scheduler is the resolver for the scheduler field.
+func (r *mutationResolver) Scheduler(ctx context.Context) (*model.SchedulerMutation, error) {
+	return &model.SchedulerMutation{}, nil
+}
+
+// Scheduler is the resolver for the scheduler field.
+func (r *queryResolver) Scheduler(ctx context.Context) (*model.SchedulerQuery, error) {
+	return &model.SchedulerQuery{}, nil
+}
+
+// Pause is the resolver for the pause field.
+func (r *schedulerMutationResolver) Pause(ctx context.Context, obj *model.SchedulerMutation) (*model.SchedulerStatus, error) {
+	r.deps.Scheduler.Pause()
+	return schedulerStatus(r.deps.Scheduler), nil
+}
+
+// Resume is the resolver for the resume field.
+func (r *schedulerMutationResolver) Resume(ctx context.Context, obj *model.SchedulerMutation) (*model.SchedulerStatus, error) {
+	r.deps.Scheduler.Resume()
+	return schedulerStatus(r.deps.Scheduler), nil
+}
+
+// Status is the resolver for the status field.
+func (r *schedulerQueryResolver) Status(ctx context.Context, obj *model.SchedulerQuery) (*model.SchedulerStatus, error) {
+	return schedulerStatus(r.deps.Scheduler), nil
+}
+
+// SchedulerMutation returns generated.SchedulerMutationResolver implementation.
+func (r *Resolver) SchedulerMutation() generated.SchedulerMutationResolver {
+	return &schedulerMutationResolver{r}
+}
+
+// SchedulerQuery returns generated.SchedulerQueryResolver implementation.
+func (r *Resolver) SchedulerQuery() generated.SchedulerQueryResolver {
+	return &schedulerQueryResolver{r}
+}
+
+type schedulerMutationResolver struct{ *Resolver }
+type schedulerQueryResolver struct{ *Resolver }
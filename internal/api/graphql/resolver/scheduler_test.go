@@ -0,0 +1,74 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+)
+
+// SchedulerResolverTestSuite tests SchedulerQuery and SchedulerMutation resolvers.
+type SchedulerResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestSchedulerResolverSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerResolverTestSuite))
+}
+
+// TestSchedulerQuery_Status tests SchedulerQuery.status resolver.
+func (s *SchedulerResolverTestSuite) TestSchedulerQuery_Status() {
+	query := `
+		query {
+			scheduler {
+				status {
+					paused
+					scheduledTaskCount
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.False(s.T(), gjson.Get(data, "scheduler.status.paused").Bool())
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "scheduler.status.scheduledTaskCount").Int())
+}
+
+// TestSchedulerMutation_PauseResume tests SchedulerMutation.pause and resume resolvers.
+func (s *SchedulerResolverTestSuite) TestSchedulerMutation_PauseResume() {
+	pauseMutation := `
+		mutation {
+			scheduler {
+				pause {
+					paused
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: pauseMutation})
+	require.Empty(s.T(), resp.Errors)
+	assert.True(s.T(), gjson.Get(string(resp.Data), "scheduler.pause.paused").Bool())
+	assert.True(s.T(), s.Env.Deps.Scheduler.IsPaused())
+
+	resumeMutation := `
+		mutation {
+			scheduler {
+				resume {
+					paused
+				}
+			}
+		}
+	`
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: resumeMutation})
+	require.Empty(s.T(), resp.Errors)
+	assert.False(s.T(), gjson.Get(string(resp.Data), "scheduler.resume.paused").Bool())
+	assert.False(s.T(), s.Env.Deps.Scheduler.IsPaused())
+}
@@ -0,0 +1,145 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+)
+
+// ShareToken is the resolver for the shareToken field.
+func (r *mutationResolver) ShareToken(ctx context.Context) (*model.ShareTokenMutation, error) {
+	return &model.ShareTokenMutation{}, nil
+}
+
+// ShareToken is the resolver for the shareToken field.
+func (r *queryResolver) ShareToken(ctx context.Context) (*model.ShareTokenQuery, error) {
+	return &model.ShareTokenQuery{}, nil
+}
+
+// Task is the resolver for the task field.
+func (r *shareTokenResolver) Task(ctx context.Context, obj *model.ShareToken) (*model.Task, error) {
+	if obj.TaskID == nil {
+		return nil, nil
+	}
+	entTask, err := dataloader.For(ctx).TaskLoader.Load(ctx, *obj.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	return entTaskToModel(entTask), nil
+}
+
+// Connection is the resolver for the connection field.
+func (r *shareTokenResolver) Connection(ctx context.Context, obj *model.ShareToken) (*model.Connection, error) {
+	if obj.ConnectionID == nil {
+		return nil, nil
+	}
+	entConn, err := dataloader.For(ctx).ConnectionLoader.Load(ctx, *obj.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	return entConnectionToModel(entConn), nil
+}
+
+// Create is the resolver for the create field.
+func (r *shareTokenMutationResolver) Create(ctx context.Context, obj *model.ShareTokenMutation, input model.CreateShareTokenInput) (*model.CreatedShareToken, error) {
+	now := time.Now()
+	if err := r.validateCreateShareTokenInput(ctx, input, now); err != nil {
+		return nil, err
+	}
+
+	// Only the fields of the scope are kept, a token never grants more than its scope
+	spec := services.ShareTokenSpec{
+		Name:      input.Name,
+		Scope:     input.Scope,
+		ExpiresAt: input.ExpiresAt,
+	}
+	switch input.Scope {
+	case model.ShareScopeTask:
+		spec.TaskID = input.TaskID
+	case model.ShareScopeDownload:
+		spec.ConnectionID = input.ConnectionID
+		spec.Path = cleanSharePath(*input.Path)
+	}
+
+	entToken, token, err := r.deps.ShareTokenService.CreateShareToken(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return &model.CreatedShareToken{
+		ShareToken: entShareTokenToModel(entToken, now),
+		Token:      token,
+		URL:        shareURLPrefix + token,
+	}, nil
+}
+
+// Revoke is the resolver for the revoke field.
+func (r *shareTokenMutationResolver) Revoke(ctx context.Context, obj *model.ShareTokenMutation, id uuid.UUID) (bool, error) {
+	if err := r.deps.ShareTokenService.RevokeShareToken(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// List is the resolver for the list field.
+func (r *shareTokenQueryResolver) List(ctx context.Context, obj *model.ShareTokenQuery, pagination *model.PaginationInput) (*model.ShareTokenConnection, error) {
+	// Default pagination values
+	limit := 20
+	offset := 0
+	if pagination != nil {
+		if pagination.Limit != nil {
+			limit = *pagination.Limit
+		}
+		if pagination.Offset != nil {
+			offset = *pagination.Offset
+		}
+	}
+
+	entTokens, totalCount, err := r.deps.ShareTokenService.ListShareTokensPaginated(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]*model.ShareToken, len(entTokens))
+	for i, t := range entTokens {
+		items[i] = entShareTokenToModel(t, now)
+	}
+
+	return &model.ShareTokenConnection{
+		Items:      items,
+		TotalCount: totalCount,
+		PageInfo: &model.OffsetPageInfo{
+			Limit:           limit,
+			Offset:          offset,
+			HasNextPage:     offset+len(items) < totalCount,
+			HasPreviousPage: offset > 0,
+		},
+	}, nil
+}
+
+// ShareToken returns generated.ShareTokenResolver implementation.
+func (r *Resolver) ShareToken() generated.ShareTokenResolver { return &shareTokenResolver{r} }
+
+// ShareTokenMutation returns generated.ShareTokenMutationResolver implementation.
+func (r *Resolver) ShareTokenMutation() generated.ShareTokenMutationResolver {
+	return &shareTokenMutationResolver{r}
+}
+
+// ShareTokenQuery returns generated.ShareTokenQueryResolver implementation.
+func (r *Resolver) ShareTokenQuery() generated.ShareTokenQueryResolver {
+	return &shareTokenQueryResolver{r}
+}
+
+type shareTokenResolver struct{ *Resolver }
+type shareTokenMutationResolver struct{ *Resolver }
+type shareTokenQueryResolver struct{ *Resolver }
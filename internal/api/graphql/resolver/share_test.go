@@ -0,0 +1,142 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// ShareTokenResolverTestSuite tests ShareTokenQuery and ShareTokenMutation resolvers.
+type ShareTokenResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestShareTokenResolverSuite(t *testing.T) {
+	suite.Run(t, new(ShareTokenResolverTestSuite))
+}
+
+const createShareTokenMutation = `
+	mutation($input: CreateShareTokenInput!) {
+		shareToken {
+			create(input: $input) {
+				token
+				url
+				shareToken { id name scope path expired useCount task { name } connection { id } }
+			}
+		}
+	}
+`
+
+// TestShareTokenMutation_CreateListRevoke tests creating, listing and revoking share tokens.
+func (s *ShareTokenResolverTestSuite) TestShareTokenMutation_CreateListRevoke() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "shared-task", connID)
+	expiresAt := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createShareTokenMutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":      "colleague",
+			"scope":     "TASK",
+			"taskId":    task.ID.String(),
+			"path":      "ignored.txt",
+			"expiresAt": expiresAt,
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	token := gjson.Get(data, "shareToken.create.token").String()
+	require.NotEmpty(s.T(), token)
+	assert.Equal(s.T(), "/api/share/"+token, gjson.Get(data, "shareToken.create.url").String())
+	assert.Equal(s.T(), "shared-task", gjson.Get(data, "shareToken.create.shareToken.task.name").String())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "shareToken.create.shareToken.path").Type, "fields of other scopes are dropped")
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "shareToken.create.shareToken.connection").Type)
+	assert.False(s.T(), gjson.Get(data, "shareToken.create.shareToken.expired").Bool())
+	id := gjson.Get(data, "shareToken.create.shareToken.id").String()
+
+	listQuery := `
+		query {
+			shareToken {
+				list { totalCount items { id name scope useCount } }
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: listQuery})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "shareToken.list.totalCount").Int())
+	assert.Equal(s.T(), id, gjson.Get(data, "shareToken.list.items.0.id").String())
+	assert.Equal(s.T(), "TASK", gjson.Get(data, "shareToken.list.items.0.scope").String())
+
+	revokeMutation := `
+		mutation($id: ID!) {
+			shareToken { revoke(id: $id) }
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), revokeMutation, map[string]interface{}{"id": id})
+	require.Empty(s.T(), resp.Errors)
+	assert.True(s.T(), gjson.Get(string(resp.Data), "shareToken.revoke").Bool())
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: listQuery})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), int64(0), gjson.Get(string(resp.Data), "shareToken.list.totalCount").Int())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), revokeMutation, map[string]interface{}{"id": id})
+	require.NotEmpty(s.T(), resp.Errors)
+}
+
+// TestShareTokenMutation_CreateDownload tests creating a share token for a remote file.
+func (s *ShareTokenResolverTestSuite) TestShareTokenMutation_CreateDownload() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createShareTokenMutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "report",
+			"scope":        "DOWNLOAD",
+			"connectionId": connID.String(),
+			"path":         "reports//2026/report.pdf",
+			"expiresAt":    time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), "reports/2026/report.pdf", gjson.Get(data, "shareToken.create.shareToken.path").String())
+	assert.Equal(s.T(), connID.String(), gjson.Get(data, "shareToken.create.shareToken.connection.id").String())
+}
+
+// TestShareTokenMutation_CreateValidation tests the validation of CreateShareTokenInput.
+func (s *ShareTokenResolverTestSuite) TestShareTokenMutation_CreateValidation() {
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createShareTokenMutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":      " ",
+			"scope":     "DOWNLOAD",
+			"path":      "/",
+			"expiresAt": time.Now().AddDate(0, 0, 91).Format(time.RFC3339),
+		},
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	codes := validationFieldCodes(s.T(), resp)
+	assert.Equal(s.T(), i18n.ErrMissingParameter, codes["name"])
+	assert.Equal(s.T(), i18n.ErrShareExpiryInvalid, codes["expiresAt"])
+	assert.Equal(s.T(), i18n.ErrMissingParameter, codes["connectionId"])
+	assert.Equal(s.T(), i18n.ErrMissingParameter, codes["path"])
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), createShareTokenMutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":      "expired",
+			"scope":     "TASK",
+			"taskId":    "00000000-0000-0000-0000-000000000001",
+			"expiresAt": time.Now().Add(-time.Minute).Format(time.RFC3339),
+		},
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	codes = validationFieldCodes(s.T(), resp)
+	assert.Equal(s.T(), i18n.ErrTaskNotFound, codes["taskId"])
+	assert.Equal(s.T(), i18n.ErrShareExpiryInvalid, codes["expiresAt"])
+}
@@ -3,6 +3,7 @@ package resolver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/resolver"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
 )
 
 // SubscriptionResolverTestSuite tests Subscription resolvers.
@@ -1370,3 +1372,85 @@ func (s *SubscriptionResolverTestSuite) TestJobProgressBus_TaskAndConnectionFilt
 		// Expected
 	}
 }
+
+// TestSubscription_GroupProgress tests that GroupProgress combines the events of the tasks tagged with the group's tag.
+func (s *SubscriptionResolverTestSuite) TestSubscription_GroupProgress() {
+	res := NewResolverForTest(s.Env.Deps)
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	tagged := s.Env.CreateTestTask(s.T(), "tagged", connID)
+	_, err := s.Env.Deps.TaskService.SetTaskTags(context.Background(), tagged.ID, []string{"nightly"})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := res.Subscription().GroupProgress(ctx, "nightly")
+	s.Require().NoError(err)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		// Events of other tasks are not combined
+		s.Env.Deps.JobProgressBus.Publish(&model.JobProgressEvent{
+			TaskID: uuid.New(), JobID: uuid.New(), Status: model.JobStatusRunning, BytesTotal: 1000, StartTime: time.Now(),
+		})
+		s.Env.Deps.JobProgressBus.Publish(&model.JobProgressEvent{
+			TaskID: tagged.ID, JobID: uuid.New(), Status: model.JobStatusRunning, BytesTransferred: 10, BytesTotal: 40, StartTime: time.Now(),
+		})
+	}()
+
+	select {
+	case received := <-ch:
+		assert.Equal(s.T(), "nightly", received.Tag)
+		assert.Equal(s.T(), 1, received.RunningJobs)
+		assert.Equal(s.T(), int64(10), received.BytesTransferred)
+		assert.Equal(s.T(), int64(40), received.BytesTotal)
+		if assert.Len(s.T(), received.Jobs, 1) {
+			assert.Equal(s.T(), tagged.ID, received.Jobs[0].TaskID)
+		}
+	case <-time.After(time.Second):
+		s.T().Error("Timeout waiting for group progress event")
+	}
+}
+
+// TestSubscription_LogDeleteProgress tests that log.delete reports its progress to the subscribers of its operation.
+func (s *SubscriptionResolverTestSuite) TestSubscription_LogDeleteProgress() {
+	res := NewResolverForTest(s.Env.Deps)
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "noisy", connID)
+	job, err := s.Env.Deps.JobService.CreateJob(context.Background(), task.ID, model.JobTriggerManual)
+	s.Require().NoError(err)
+	logs := make([]*ent.JobLog, 1500)
+	for i := range logs {
+		logs[i] = &ent.JobLog{Level: model.LogLevelInfo, What: model.LogActionUpload, Path: fmt.Sprintf("file%d", i), Time: time.Now()}
+	}
+	s.Require().NoError(s.Env.Deps.JobService.AddJobLogsBatch(context.Background(), job.ID, logs))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := res.Subscription().LogDeleteProgress(ctx, "cleanup-1")
+	s.Require().NoError(err)
+	other, err := res.Subscription().LogDeleteProgress(ctx, "cleanup-2")
+	s.Require().NoError(err)
+
+	operationID := "cleanup-1"
+	result, err := res.LogMutation().Delete(ctx, &model.LogMutation{}, model.LogDeleteFilter{TaskID: &task.ID}, &operationID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), model.LogDeleteResult{OperationID: "cleanup-1", Matched: 1500, Deleted: 1500}, *result)
+
+	var events []model.LogDeleteProgressEvent
+	for event := range ch {
+		events = append(events, *event)
+	}
+	assert.Equal(s.T(), []model.LogDeleteProgressEvent{
+		{OperationID: "cleanup-1", Deleted: 1000, Total: 1500},
+		{OperationID: "cleanup-1", Deleted: 1500, Total: 1500},
+		{OperationID: "cleanup-1", Deleted: 1500, Total: 1500, Done: true},
+	}, events, "the subscription ends with the deletion")
+
+	select {
+	case event := <-other:
+		s.T().Errorf("Unexpected event of another operation: %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
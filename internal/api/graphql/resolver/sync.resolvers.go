@@ -0,0 +1,85 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+)
+
+// Sync is the resolver for the sync field.
+func (r *mutationResolver) Sync(ctx context.Context) (*model.SyncMutation, error) {
+	return &model.SyncMutation{}, nil
+}
+
+// RunAdhoc is the resolver for the runAdhoc field.
+func (r *syncMutationResolver) RunAdhoc(ctx context.Context, obj *model.SyncMutation, input model.AdhocSyncInput, idempotencyKey *string) (*model.Job, error) {
+	return idempotent(ctx, r.Resolver, idempotencyKey, "sync.runAdhoc", input, func(ctx context.Context) (*model.Job, error) {
+		name := "Ad-hoc: " + input.SourcePath
+		if input.Name != nil {
+			name = *input.Name
+		}
+		if err := r.validateCreateTaskInput(ctx, model.CreateTaskInput{
+			Name:         name,
+			SourcePath:   input.SourcePath,
+			ConnectionID: input.ConnectionID,
+			RemotePath:   input.RemotePath,
+			Direction:    input.Direction,
+			Options:      input.Options,
+			Engine:       input.Engine,
+		}); err != nil {
+			return nil, err
+		}
+
+		var options *model.TaskSyncOptions
+		if input.Options != nil {
+			options = buildOptions(input.Options)
+		}
+		engine := ""
+		if input.Engine != nil {
+			engine = *input.Engine
+		}
+
+		// The job needs a task, a hidden one is created for this run only
+		created, err := r.deps.TaskService.CreateEphemeralTask(ctx, services.TaskSpec{
+			Name:         name,
+			SourcePath:   input.SourcePath,
+			ConnectionID: input.ConnectionID,
+			RemotePath:   input.RemotePath,
+			Direction:    string(input.Direction),
+			Options:      options,
+		}, engine)
+		if err != nil {
+			return nil, err
+		}
+		entTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, created.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{User: provenance.User(ctx)})
+		if err := r.deps.Runner.StartTask(ctx, entTask, model.JobTriggerManual); err != nil {
+			return nil, err
+		}
+
+		entJob, err := r.deps.JobService.GetLastJobByTaskID(ctx, entTask.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return entJobToModel(entJob), nil
+	}, func(j *model.Job) uuid.UUID { return j.ID }, r.jobByID)
+}
+
+// SyncMutation returns generated.SyncMutationResolver implementation.
+func (r *Resolver) SyncMutation() generated.SyncMutationResolver { return &syncMutationResolver{r} }
+
+type syncMutationResolver struct{ *Resolver }
@@ -0,0 +1,113 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+)
+
+// SyncResolverTestSuite tests SyncMutation resolvers.
+type SyncResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestSyncResolverSuite(t *testing.T) {
+	suite.Run(t, new(SyncResolverTestSuite))
+}
+
+// TestSyncMutation_RunAdhoc tests that SyncMutation.runAdhoc runs a hidden task that stays out of task lists.
+func (s *SyncResolverTestSuite) TestSyncMutation_RunAdhoc() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	s.Env.CreateTestTask(s.T(), "saved-task", connID)
+
+	mutation := `
+		mutation($input: AdhocSyncInput!) {
+			sync {
+				runAdhoc(input: $input) {
+					id
+					trigger
+					task { name ephemeral }
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"sourcePath":   s.Env.SourcePath(s.T(), "adhoc"),
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "UPLOAD",
+		},
+	})
+
+	// As for task.run, the job may not be found yet when the runner creates it asynchronously
+	if len(resp.Errors) > 0 {
+		assert.Contains(s.T(), resp.Errors[0].Message, "not found")
+	} else {
+		data := string(resp.Data)
+		assert.NotEmpty(s.T(), gjson.Get(data, "sync.runAdhoc.id").String())
+		assert.Equal(s.T(), "MANUAL", gjson.Get(data, "sync.runAdhoc.trigger").String())
+		assert.True(s.T(), gjson.Get(data, "sync.runAdhoc.task.ephemeral").Bool())
+		assert.Contains(s.T(), gjson.Get(data, "sync.runAdhoc.task.name").String(), "Ad-hoc: ")
+	}
+
+	ephemeral, err := s.Env.Client.Task.Query().Where(task.Ephemeral(true)).All(context.Background())
+	require.NoError(s.T(), err)
+	require.Len(s.T(), ephemeral, 1)
+	assert.Equal(s.T(), "/remote", ephemeral[0].RemotePath)
+
+	query := `
+		query {
+			task {
+				list {
+					totalCount
+					items { name }
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "task.list.totalCount").Int())
+	assert.Equal(s.T(), "saved-task", gjson.Get(data, "task.list.items.0.name").String())
+}
+
+// TestSyncMutation_RunAdhocValidation tests that SyncMutation.runAdhoc validates its input like task.create.
+func (s *SyncResolverTestSuite) TestSyncMutation_RunAdhocValidation() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: AdhocSyncInput!) {
+			sync {
+				runAdhoc(input: $input) { id }
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"sourcePath":   "relative/path",
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "UPLOAD",
+			"engine":       "unknown",
+		},
+	})
+	require.NotEmpty(s.T(), resp.Errors)
+	codes := validationFieldCodes(s.T(), resp)
+	assert.Contains(s.T(), codes, "sourcePath")
+	assert.Contains(s.T(), codes, "engine")
+
+	count, err := s.Env.Client.Task.Query().Count(context.Background())
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), count, "no task is created for an invalid run")
+}
@@ -0,0 +1,115 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/version"
+	"github.com/xzzpig/rclone-sync/internal/ui"
+)
+
+// System is the resolver for the system field.
+func (r *queryResolver) System(ctx context.Context) (*model.SystemQuery, error) {
+	return &model.SystemQuery{}, nil
+}
+
+// Version is the resolver for the version field.
+func (r *systemQueryResolver) Version(ctx context.Context, obj *model.SystemQuery) (*model.SystemVersion, error) {
+	result := systemVersion(version.Get(), ui.Version())
+	// The update check is opt-in, without it no update is ever reported
+	if r.deps.UpdateService != nil {
+		if status := r.deps.UpdateService.Status(); status != nil {
+			result.UpdateAvailable = status.UpdateAvailable
+			result.LatestVersion = &status.LatestVersion
+			result.LatestReleaseURL = &status.ReleaseURL
+			result.UpdateCheckedAt = &status.CheckedAt
+		}
+	}
+	return result, nil
+}
+
+// ConfigCache is the resolver for the configCache field.
+func (r *systemQueryResolver) ConfigCache(ctx context.Context, obj *model.SystemQuery) (*model.ConfigCacheStats, error) {
+	m := r.deps.ConnectionService.ConfigCacheMetrics()
+	return &model.ConfigCacheStats{
+		Hits:    m.Hits,
+		Misses:  m.Misses,
+		HitRate: m.HitRate(),
+		Entries: m.Entries,
+	}, nil
+}
+
+// LogFlush is the resolver for the logFlush field.
+func (r *systemQueryResolver) LogFlush(ctx context.Context, obj *model.SystemQuery) (*model.LogFlushStats, error) {
+	m := r.deps.SyncEngine.GetLogFlushMetrics()
+	result := &model.LogFlushStats{
+		Flushes:             m.Flushes,
+		FailedFlushes:       m.FailedFlushes,
+		FlushedLogs:         m.FlushedLogs,
+		DroppedLogs:         m.DroppedLogs,
+		LastFlushSize:       m.LastFlushSize,
+		LastFlushDurationMs: m.LastFlushDuration.Milliseconds(),
+	}
+	if !m.LastFlushAt.IsZero() {
+		result.LastFlushAt = &m.LastFlushAt
+	}
+	return result, nil
+}
+
+// Integrity is the resolver for the integrity field.
+func (r *systemQueryResolver) Integrity(ctx context.Context, obj *model.SystemQuery, refresh *bool) (*model.ConfigIntegrityReport, error) {
+	report := r.deps.IntegrityService.Report()
+	if report == nil || (refresh != nil && *refresh) {
+		var err error
+		if report, err = r.deps.IntegrityService.CheckAll(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	failures := make([]*model.ConfigIntegrityFailure, len(report.Failures))
+	for i, f := range report.Failures {
+		failures[i] = &model.ConfigIntegrityFailure{
+			Connection: entConnectionToModel(f.Connection),
+			Error:      f.Err.Error(),
+		}
+	}
+	return &model.ConfigIntegrityReport{
+		CheckedAt:       report.CheckedAt,
+		ConnectionCount: report.Connections,
+		Failures:        failures,
+	}, nil
+}
+
+// WarmupStatus is the resolver for the warmupStatus field.
+func (r *systemQueryResolver) WarmupStatus(ctx context.Context, obj *model.SystemQuery) (*model.WarmupStatus, error) {
+	status := r.deps.SyncEngine.WarmupStatus()
+	connections := make([]*model.ConnectionWarmup, len(status.Connections))
+	for i, c := range status.Connections {
+		connections[i] = &model.ConnectionWarmup{
+			Connection: entConnectionToModel(c.Connection),
+			State:      c.State,
+			Paths:      c.Paths,
+			ReadyPaths: c.ReadyPaths,
+		}
+		if c.Error != "" {
+			connections[i].Error = &c.Error
+		}
+	}
+	return &model.WarmupStatus{
+		StartedAt:   status.StartedAt,
+		FinishedAt:  status.FinishedAt,
+		Ready:       status.StartedAt == nil || status.FinishedAt != nil,
+		Connections: connections,
+	}, nil
+}
+
+// SystemQuery returns generated.SystemQueryResolver implementation.
+func (r *Resolver) SystemQuery() generated.SystemQueryResolver { return &systemQueryResolver{r} }
+
+type systemQueryResolver struct{ *Resolver }
@@ -0,0 +1,234 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/version"
+)
+
+// SystemResolverTestSuite tests SystemQuery resolvers.
+type SystemResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestSystemResolverSuite(t *testing.T) {
+	suite.Run(t, new(SystemResolverTestSuite))
+}
+
+// TestSystemQuery_Version tests SystemQuery.version resolver without update checks.
+func (s *SystemResolverTestSuite) TestSystemQuery_Version() {
+	query := `
+		query {
+			system {
+				version {
+					app
+					commit
+					uiVersion
+					updateAvailable
+					latestVersion
+					updateCheckedAt
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.Equal(s.T(), version.Get().Version, gjson.Get(data, "system.version.app").String())
+	assert.NotEmpty(s.T(), gjson.Get(data, "system.version.app").String())
+	assert.False(s.T(), gjson.Get(data, "system.version.updateAvailable").Bool())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "system.version.latestVersion").Type)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "system.version.updateCheckedAt").Type)
+}
+
+// TestSystemQuery_ConfigCache tests SystemQuery.configCache resolver.
+func (s *SystemResolverTestSuite) TestSystemQuery_ConfigCache() {
+	connID := s.Env.CreateTestConnection(s.T(), "cache-test")
+	for range 3 {
+		_, err := s.Env.ConnectionService.GetConnectionConfigByID(s.T().Context(), connID)
+		require.NoError(s.T(), err)
+	}
+
+	query := `
+		query {
+			system {
+				configCache {
+					hits
+					misses
+					hitRate
+					entries
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(2), gjson.Get(data, "system.configCache.hits").Int())
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "system.configCache.misses").Int())
+	assert.InDelta(s.T(), 2.0/3, gjson.Get(data, "system.configCache.hitRate").Float(), 0.001)
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "system.configCache.entries").Int())
+}
+
+// TestSystemQuery_LogFlush tests SystemQuery.logFlush resolver after a job wrote its logs.
+func (s *SystemResolverTestSuite) TestSystemQuery_LogFlush() {
+	ctx := s.T().Context()
+	query := `
+		query {
+			system {
+				logFlush {
+					flushes
+					failedFlushes
+					flushedLogs
+					droppedLogs
+					lastFlushSize
+					lastFlushDurationMs
+					lastFlushAt
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "system.logFlush.flushes").Int())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "system.logFlush.lastFlushAt").Type)
+
+	connID := s.Env.CreateTestConnection(s.T(), "log-flush")
+	sourcePath := s.Env.SourcePath(s.T(), "log-flush-source")
+	require.NoError(s.T(), os.WriteFile(filepath.Join(sourcePath, "file.txt"), []byte("logged"), 0o644))
+	task, err := s.Env.TaskService.CreateTask(ctx, "log-flush", sourcePath, connID,
+		filepath.Join(s.Env.LocalDir, "log-flush-dest"), "UPLOAD", "", false, nil)
+	require.NoError(s.T(), err)
+	task, err = s.Env.TaskService.GetTaskWithConnection(ctx, task.ID)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.Env.Deps.SyncEngine.RunTask(ctx, task, model.JobTriggerManual))
+
+	resp = s.Env.ExecuteGraphQL(s.T(), GraphQLRequest{Query: query})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.GreaterOrEqual(s.T(), gjson.Get(data, "system.logFlush.flushes").Int(), int64(1))
+	assert.GreaterOrEqual(s.T(), gjson.Get(data, "system.logFlush.flushedLogs").Int(), int64(1))
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "system.logFlush.failedFlushes").Int())
+	assert.Equal(s.T(), int64(0), gjson.Get(data, "system.logFlush.droppedLogs").Int())
+	assert.Positive(s.T(), gjson.Get(data, "system.logFlush.lastFlushSize").Int())
+	assert.NotEmpty(s.T(), gjson.Get(data, "system.logFlush.lastFlushAt").String())
+}
+
+// TestSystemQuery_Integrity tests SystemQuery.integrity resolver and the load status of connections
+// whose config can't be decrypted.
+func (s *SystemResolverTestSuite) TestSystemQuery_Integrity() {
+	ctx := s.T().Context()
+	s.Env.CreateTestConnection(s.T(), "integrity-valid")
+	corruptedID := s.Env.CreateTestConnection(s.T(), "integrity-corrupted")
+
+	query := `
+		query($refresh: Boolean) {
+			system {
+				integrity(refresh: $refresh) {
+					checkedAt
+					connectionCount
+					failures {
+						connection {
+							name
+							loadStatus
+							loadError
+						}
+						error
+					}
+				}
+			}
+		}
+	`
+
+	// The first query checks, since no check completed yet
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, nil)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(2), gjson.Get(data, "system.integrity.connectionCount").Int())
+	assert.Empty(s.T(), gjson.Get(data, "system.integrity.failures").Array())
+	checkedAt := gjson.Get(data, "system.integrity.checkedAt").String()
+	assert.NotEmpty(s.T(), checkedAt)
+
+	require.NoError(s.T(), s.Env.Client.Connection.UpdateOneID(corruptedID).SetEncryptedConfig([]byte("corrupted")).Exec(ctx))
+
+	// The latest report is returned until refreshed
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, nil)
+	require.Empty(s.T(), resp.Errors)
+	assert.Empty(s.T(), gjson.Get(string(resp.Data), "system.integrity.failures").Array())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]any{"refresh": true})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	failures := gjson.Get(data, "system.integrity.failures").Array()
+	require.Len(s.T(), failures, 1)
+	assert.Equal(s.T(), "integrity-corrupted", failures[0].Get("connection.name").String())
+	assert.Equal(s.T(), "ERROR", failures[0].Get("connection.loadStatus").String())
+	assert.NotEmpty(s.T(), failures[0].Get("error").String())
+	assert.Equal(s.T(), failures[0].Get("error").String(), failures[0].Get("connection.loadError").String())
+}
+
+// TestSystemQuery_WarmupStatus tests SystemQuery.warmupStatus resolver.
+func (s *SystemResolverTestSuite) TestSystemQuery_WarmupStatus() {
+	connID := s.Env.CreateTestConnection(s.T(), "warmup-conn")
+	s.Env.CreateTestTask(s.T(), "warmup-task", connID)
+
+	query := `
+		query {
+			system {
+				warmupStatus {
+					startedAt
+					finishedAt
+					ready
+					connections {
+						connection {
+							name
+						}
+						state
+						paths
+						readyPaths
+						error
+					}
+				}
+			}
+		}
+	`
+
+	// Without a warm-up there is nothing to wait for
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, nil)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.True(s.T(), gjson.Get(data, "system.warmupStatus.ready").Bool())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "system.warmupStatus.startedAt").Type)
+	assert.Empty(s.T(), gjson.Get(data, "system.warmupStatus.connections").Array())
+
+	tasks, err := s.Env.TaskService.ListAllTasks(s.T().Context())
+	require.NoError(s.T(), err)
+	s.Env.Deps.SyncEngine.WarmUp(s.T().Context(), tasks, 2)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, nil)
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.True(s.T(), gjson.Get(data, "system.warmupStatus.ready").Bool())
+	assert.NotEmpty(s.T(), gjson.Get(data, "system.warmupStatus.finishedAt").String())
+	connections := gjson.Get(data, "system.warmupStatus.connections").Array()
+	require.Len(s.T(), connections, 1)
+	assert.Equal(s.T(), "warmup-conn", connections[0].Get("connection.name").String())
+	assert.Equal(s.T(), "READY", connections[0].Get("state").String(), connections[0].Get("error").String())
+	assert.Equal(s.T(), int64(1), connections[0].Get("paths").Int())
+	assert.Equal(s.T(), int64(1), connections[0].Get("readyPaths").Int())
+}
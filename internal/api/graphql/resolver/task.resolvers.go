@@ -7,12 +7,22 @@ package resolver
 
 import (
 	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
-	"github.com/xzzpig/rclone-sync/internal/utils"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+	"go.uber.org/zap"
 )
 
 // Task is the resolver for the task field.
@@ -25,10 +35,21 @@ func (r *queryResolver) Task(ctx context.Context) (*model.TaskQuery, error) {
 	return &model.TaskQuery{}, nil
 }
 
+// ResolvedRemotePath is the resolver for the resolvedRemotePath field.
+func (r *taskResolver) ResolvedRemotePath(ctx context.Context, obj *model.Task) (string, error) {
+	// Use dataloader to batch load the connection for its base path
+	entConn, err := dataloader.For(ctx).ConnectionLoader.Load(ctx, obj.ConnectionID)
+	if err != nil {
+		return "", err
+	}
+
+	return rclone.ResolveRemotePath(entConn.BasePath, obj.RemotePath), nil
+}
+
 // Options is the resolver for the options field.
 func (r *taskResolver) Options(ctx context.Context, obj *model.Task) (*model.TaskSyncOptions, error) {
-	// Fetch task from database to get options via TaskService
-	entTask, err := r.deps.TaskService.GetTask(ctx, obj.ID)
+	// Use dataloader to batch load the task, which also finds deleted tasks
+	entTask, err := dataloader.For(ctx).TaskLoader.Load(ctx, obj.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -100,59 +121,265 @@ func (r *taskResolver) LatestJob(ctx context.Context, obj *model.Task) (*model.J
 	return entJobToModel(entJob), nil
 }
 
+// Events is the resolver for the events field.
+func (r *taskResolver) Events(ctx context.Context, obj *model.Task, pagination *model.PaginationInput) (*model.TaskEventConnection, error) {
+	// Default pagination values
+	limit := 20
+	offset := 0
+	if pagination != nil {
+		if pagination.Limit != nil {
+			limit = *pagination.Limit
+		}
+		if pagination.Offset != nil {
+			offset = *pagination.Offset
+		}
+	}
+
+	entEvents, totalCount, err := r.deps.TaskService.ListTaskEventsPaginated(ctx, obj.ID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.TaskEvent, len(entEvents))
+	for i, e := range entEvents {
+		items[i] = entTaskEventToModel(e)
+	}
+
+	return &model.TaskEventConnection{
+		Items:      items,
+		TotalCount: totalCount,
+		PageInfo: &model.OffsetPageInfo{
+			Limit:           limit,
+			Offset:          offset,
+			HasNextPage:     offset+len(items) < totalCount,
+			HasPreviousPage: offset > 0,
+		},
+	}, nil
+}
+
+// Snapshots is the resolver for the snapshots field.
+func (r *taskResolver) Snapshots(ctx context.Context, obj *model.Task) ([]*model.BackupSnapshot, error) {
+	// Only backup tasks have a repository to list
+	if obj.Engine != ports.BackupSyncEngine || r.deps.BackupEngine == nil {
+		return []*model.BackupSnapshot{}, nil
+	}
+	entTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.deps.BackupEngine.ListSnapshots(ctx, entTask)
+}
+
+// ConfigHash is the resolver for the configHash field.
+func (r *taskResolver) ConfigHash(ctx context.Context, obj *model.Task) (string, error) {
+	return taskConfigHash(ctx, obj)
+}
+
+// ConfigChangedSinceLastRun is the resolver for the configChangedSinceLastRun field.
+func (r *taskResolver) ConfigChangedSinceLastRun(ctx context.Context, obj *model.Task) (*bool, error) {
+	hash, err := taskConfigHash(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	lastHash, err := r.deps.JobService.GetLastSuccessfulConfigHash(ctx, obj.ID)
+	if err != nil || lastHash == nil {
+		return nil, err
+	}
+	changed := *lastHash != hash
+	return &changed, nil
+}
+
+// WatchWarning is the resolver for the watchWarning field.
+func (r *taskResolver) WatchWarning(ctx context.Context, obj *model.Task) (*model.WatchWarning, error) {
+	if !obj.Realtime || r.deps.Watcher == nil {
+		return nil, nil
+	}
+	return r.deps.Watcher.WatchWarning(obj.ID), nil
+}
+
+// MediaWarnings is the resolver for the mediaWarnings field.
+func (r *taskResolver) MediaWarnings(ctx context.Context, obj *model.Task) ([]model.MediaWarning, error) {
+	warnings := []model.MediaWarning{}
+	if obj.Direction != model.SyncDirectionDownload {
+		return warnings, nil
+	}
+	entConn, err := dataloader.For(ctx).ConnectionLoader.Load(ctx, obj.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if rclone.IsMediaBackend(string(entConn.Type)) {
+		warnings = append(warnings, model.MediaWarningDownloadsNotOriginals, model.MediaWarningOnlyUploadedMedia)
+	}
+	return warnings, nil
+}
+
 // Create is the resolver for the create field.
-func (r *taskMutationResolver) Create(ctx context.Context, obj *model.TaskMutation, input model.CreateTaskInput) (*model.Task, error) {
-	// Validate cron schedule if provided
-	schedule := ""
-	if input.Schedule != nil {
-		schedule = *input.Schedule
-		if err := utils.ValidateCronSchedule(schedule); err != nil {
+func (r *taskMutationResolver) Create(ctx context.Context, obj *model.TaskMutation, input model.CreateTaskInput, verifyRemotePath *bool, createRemotePath *bool, idempotencyKey *string) (*model.Task, error) {
+	request := map[string]any{"input": input, "verifyRemotePath": verifyRemotePath, "createRemotePath": createRemotePath}
+	return idempotent(ctx, r.Resolver, idempotencyKey, "task.create", request, func(ctx context.Context) (*model.Task, error) {
+		if err := r.validateCreateTaskInput(ctx, input); err != nil {
+			return nil, err
+		}
+
+		// A typo in remotePath would otherwise create an unwanted directory or fail on the first run
+		mkdir := createRemotePath != nil && *createRemotePath
+		if mkdir || (verifyRemotePath != nil && *verifyRemotePath) {
+			if err := r.checkRemotePath(ctx, input.ConnectionID, input.RemotePath, mkdir); err != nil {
+				return nil, err
+			}
+		}
+
+		schedule := ""
+		if input.Schedule != nil {
+			schedule = *input.Schedule
+		}
+
+		// Build options from input
+		var options *model.TaskSyncOptions
+		if input.Options != nil {
+			options = buildOptions(input.Options)
+		}
+
+		// Default realtime to false if not provided
+		realtime := false
+		if input.Realtime != nil {
+			realtime = *input.Realtime
+		}
+
+		// Create task
+		entTask, err := r.deps.TaskService.CreateTask(
+			ctx,
+			input.Name,
+			input.SourcePath,
+			input.ConnectionID,
+			input.RemotePath,
+			string(input.Direction),
+			schedule,
+			realtime,
+			options,
+		)
+		if err != nil {
+			return nil, err
+		}
+		taskID := entTask.ID
+		entTask, firstJob, err := r.completeTaskCreation(ctx, entTask, input)
+		if err != nil {
+			// Don't leave a half created task behind
+			if discardErr := r.deps.TaskService.DiscardTask(ctx, taskID); discardErr != nil {
+				logger.Named("api.graphql.resolver.task").Warn("Failed to discard incomplete task",
+					zap.String("task_id", taskID.String()),
+					zap.Error(discardErr))
+			}
 			return nil, err
 		}
+
+		// If realtime sync is enabled, add to watcher
+		if realtime && r.deps.Watcher != nil {
+			// Log the error but don't fail the request
+			// The task was created successfully, watcher can be added later
+			_ = r.deps.Watcher.AddTask(entTask)
+		}
+
+		// If schedule is set, add to scheduler
+		if schedule != "" && r.deps.Scheduler != nil {
+			// Log but don't fail
+			_ = r.deps.Scheduler.AddTask(entTask)
+		}
+
+		result := entTaskToModel(entTask)
+		if firstJob != nil {
+			result.FirstJob = entJobToModel(firstJob)
+		}
+		return result, nil
+	}, func(t *model.Task) uuid.UUID { return t.ID }, r.taskByID)
+}
+
+// CreateFromDirectory is the resolver for the createFromDirectory field.
+func (r *taskMutationResolver) CreateFromDirectory(ctx context.Context, obj *model.TaskMutation, connectionID uuid.UUID, localRoot string, remoteRoot string, direction *model.SyncDirection, options *model.TaskSyncOptionsInput) ([]*model.Task, error) {
+	// Ensure the connection exists before scanning
+	if _, err := r.deps.ConnectionService.GetConnectionByID(ctx, connectionID); err != nil {
+		return nil, err
 	}
 
-	// Build options from input
-	var options *model.TaskSyncOptions
-	if input.Options != nil {
-		options = buildOptions(input.Options)
+	info, err := os.Stat(localRoot)
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrPathNotExist).WithCause(err)
+	}
+	if !info.IsDir() {
+		return nil, i18n.NewI18nError(i18n.ErrPathNotDirectory)
 	}
 
-	// Default realtime to false if not provided
-	realtime := false
-	if input.Realtime != nil {
-		realtime = *input.Realtime
+	entries, err := os.ReadDir(localRoot)
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrPathNotExist).WithCause(err)
 	}
 
-	// Create task
-	entTask, err := r.deps.TaskService.CreateTask(
-		ctx,
-		input.Name,
-		input.SourcePath,
-		input.ConnectionID,
-		input.RemotePath,
-		string(input.Direction),
-		schedule,
-		realtime,
-		options,
-	)
+	// Skip subdirectories that already have a task on this connection
+	existingTasks, err := r.deps.TaskService.ListTasksByConnection(ctx, connectionID)
 	if err != nil {
 		return nil, err
 	}
+	existingSources := make(map[string]bool, len(existingTasks))
+	for _, t := range existingTasks {
+		existingSources[filepath.Clean(t.SourcePath)] = true
+	}
 
-	// If realtime sync is enabled, add to watcher
-	if realtime && r.deps.Watcher != nil {
-		// Log the error but don't fail the request
-		// The task was created successfully, watcher can be added later
-		_ = r.deps.Watcher.AddTask(entTask)
+	syncDirection := model.SyncDirectionBidirectional
+	if direction != nil {
+		syncDirection = *direction
 	}
 
-	// If schedule is set, add to scheduler
-	if schedule != "" && r.deps.Scheduler != nil {
-		// Log but don't fail
-		_ = r.deps.Scheduler.AddTask(entTask)
+	var taskOptions *model.TaskSyncOptions
+	if options != nil {
+		taskOptions = buildOptions(options)
 	}
 
-	return entTaskToModel(entTask), nil
+	var specs []services.TaskSpec
+	for _, entry := range entries {
+		// Ignore hidden directories like .git
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		sourcePath := filepath.Join(localRoot, entry.Name())
+		if existingSources[sourcePath] {
+			continue
+		}
+
+		// Each task goes through the same checks as a single create, so media connections still reject BIDIRECTIONAL
+		remotePath := path.Join(remoteRoot, entry.Name())
+		if err := r.validateCreateTaskInput(ctx, model.CreateTaskInput{
+			Name:         entry.Name(),
+			SourcePath:   sourcePath,
+			ConnectionID: connectionID,
+			RemotePath:   remotePath,
+			Direction:    syncDirection,
+			Options:      options,
+		}); err != nil {
+			return nil, err
+		}
+
+		specs = append(specs, services.TaskSpec{
+			Name:         entry.Name(),
+			SourcePath:   sourcePath,
+			ConnectionID: connectionID,
+			RemotePath:   remotePath,
+			Direction:    string(syncDirection),
+			Options:      taskOptions,
+		})
+	}
+
+	entTasks, err := r.deps.TaskService.CreateTasks(ctx, specs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Task, len(entTasks))
+	for i, t := range entTasks {
+		result[i] = entTaskToModel(t)
+	}
+	return result, nil
 }
 
 // Update is the resolver for the update field.
@@ -163,6 +390,10 @@ func (r *taskMutationResolver) Update(ctx context.Context, obj *model.TaskMutati
 		return nil, err
 	}
 
+	if err := r.validateUpdateTaskInput(ctx, existingTask, input); err != nil {
+		return nil, err
+	}
+
 	// Use existing values if not provided in update
 	name := existingTask.Name
 	if input.Name != nil {
@@ -192,9 +423,6 @@ func (r *taskMutationResolver) Update(ctx context.Context, obj *model.TaskMutati
 	schedule := existingTask.Schedule
 	if input.Schedule != nil {
 		schedule = *input.Schedule
-		if err := utils.ValidateCronSchedule(schedule); err != nil {
-			return nil, err
-		}
 	}
 
 	realtime := existingTask.Realtime
@@ -221,6 +449,32 @@ func (r *taskMutationResolver) Update(ctx context.Context, obj *model.TaskMutati
 	if err != nil {
 		return nil, err
 	}
+	if input.Engine != nil {
+		updatedTask, err = r.deps.TaskService.SetTaskEngine(ctx, id, *input.Engine)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Tags != nil {
+		updatedTask, err = r.deps.TaskService.SetTaskTags(ctx, id, input.Tags)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Enabled != nil {
+		updatedTask, err = r.deps.TaskService.SetTaskEnabled(ctx, id, *input.Enabled)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The update is saved, so failing to record it in the task events doesn't fail the mutation
+	changes := services.DiffTasks(existingTask, updatedTask)
+	if err := r.deps.TaskService.RecordTaskChanges(ctx, id, changes); err != nil {
+		logger.Named("api.graphql.resolver.task").Warn("Failed to record task changes",
+			zap.String("task_id", id.String()),
+			zap.Error(err))
+	}
 
 	// Handle watcher updates based on realtime status changes
 	if r.deps.Watcher != nil {
@@ -232,9 +486,8 @@ func (r *taskMutationResolver) Update(ctx context.Context, obj *model.TaskMutati
 				// Realtime was disabled, remove from watcher
 				_ = r.deps.Watcher.RemoveTask(updatedTask)
 			}
-		} else if realtime && existingTask.SourcePath != updatedTask.SourcePath {
-			// Realtime is still enabled but source path changed, update watcher
-			_ = r.deps.Watcher.RemoveTask(existingTask)
+		} else if realtime {
+			// Realtime is still enabled, pick up source path and ignore pattern changes
 			_ = r.deps.Watcher.AddTask(updatedTask)
 		}
 	}
@@ -252,7 +505,9 @@ func (r *taskMutationResolver) Update(ctx context.Context, obj *model.TaskMutati
 		}
 	}
 
-	return entTaskToModel(updatedTask), nil
+	result := entTaskToModel(updatedTask)
+	result.Changes = taskChangesToModel(changes)
+	return result, nil
 }
 
 // Delete is the resolver for the delete field.
@@ -273,38 +528,90 @@ func (r *taskMutationResolver) Delete(ctx context.Context, obj *model.TaskMutati
 		_ = r.deps.Scheduler.RemoveTask(taskToDelete)
 	}
 
-	// Delete the task
-	if err := r.deps.TaskService.DeleteTask(ctx, id); err != nil {
+	// Soft delete the task, it can be restored until the retention worker purges it
+	deletedTask, err := r.deps.TaskService.DeleteTask(ctx, id)
+	if err != nil {
 		return nil, err
 	}
 
-	return entTaskToModel(taskToDelete), nil
+	return entTaskToModel(deletedTask), nil
 }
 
-// Run is the resolver for the run field.
-func (r *taskMutationResolver) Run(ctx context.Context, obj *model.TaskMutation, taskID uuid.UUID) (*model.Job, error) {
-	// Get task with connection
-	entTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, taskID)
+// Restore is the resolver for the restore field.
+func (r *taskMutationResolver) Restore(ctx context.Context, obj *model.TaskMutation, id uuid.UUID) (*model.Task, error) {
+	restoredTask, err := r.deps.TaskService.RestoreTask(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Start the task via runner
-	if err := r.deps.Runner.StartTask(entTask, model.JobTriggerManual); err != nil {
+	// Resume realtime sync and scheduling like for a new task
+	if restoredTask.Realtime && r.deps.Watcher != nil {
+		_ = r.deps.Watcher.AddTask(restoredTask)
+	}
+	if restoredTask.Schedule != "" && r.deps.Scheduler != nil {
+		_ = r.deps.Scheduler.AddTask(restoredTask)
+	}
+
+	return entTaskToModel(restoredTask), nil
+}
+
+// Run is the resolver for the run field.
+func (r *taskMutationResolver) Run(ctx context.Context, obj *model.TaskMutation, taskID uuid.UUID, idempotencyKey *string) (*model.Job, error) {
+	return idempotent(ctx, r.Resolver, idempotencyKey, "task.run", taskID, func(ctx context.Context) (*model.Job, error) {
+		// Get task with connection
+		entTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Start the task via runner, recording who started it
+		ctx = provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{User: provenance.User(ctx)})
+		if err := r.deps.Runner.StartTask(ctx, entTask, model.JobTriggerManual); err != nil {
+			return nil, err
+		}
+
+		// Get the latest job created for this task
+		entJob, err := r.deps.JobService.GetLastJobByTaskID(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		return entJobToModel(entJob), nil
+	}, func(j *model.Job) uuid.UUID { return j.ID }, r.jobByID)
+}
+
+// RestoreSnapshot is the resolver for the restoreSnapshot field.
+func (r *taskMutationResolver) RestoreSnapshot(ctx context.Context, obj *model.TaskMutation, taskID uuid.UUID, snapshotID string, targetPath string) (*model.BackupRestoreResult, error) {
+	v := i18n.NewValidationError()
+	validateRequired(v, "targetPath", targetPath)
+	if err := v.Err(); err != nil {
 		return nil, err
 	}
 
-	// Get the latest job created for this task
-	entJob, err := r.deps.JobService.GetLastJobByTaskID(ctx, taskID)
+	entTask, err := r.deps.TaskService.GetTaskWithConnection(ctx, taskID)
 	if err != nil {
 		return nil, err
 	}
+	if entTask.Engine != ports.BackupSyncEngine || r.deps.BackupEngine == nil {
+		return nil, i18n.NewI18nErrorWithData(i18n.ErrSnapshotNotFound, map[string]interface{}{"Snapshot": snapshotID}).WithStatus(404)
+	}
+	// A running backup may forget the snapshot while it is restored
+	if r.deps.Runner.IsRunning(taskID) {
+		return nil, i18n.NewI18nError(i18n.ErrRestoreTaskRunning).WithStatus(409)
+	}
 
-	return entJobToModel(entJob), nil
+	result, err := r.deps.BackupEngine.RestoreSnapshot(ctx, entTask, snapshotID, targetPath)
+	if err != nil {
+		if _, ok := i18n.IsI18nError(err); ok {
+			return nil, err
+		}
+		return nil, i18n.NewI18nError(i18n.ErrRestoreFailed).WithCause(err)
+	}
+	return result, nil
 }
 
 // List is the resolver for the list field.
-func (r *taskQueryResolver) List(ctx context.Context, obj *model.TaskQuery, pagination *model.PaginationInput) (*model.TaskConnection, error) {
+func (r *taskQueryResolver) List(ctx context.Context, obj *model.TaskQuery, pagination *model.PaginationInput, sortBy model.TaskSortField, sortDirection model.SortDirection) (*model.TaskConnection, error) {
 	// Default pagination values
 	limit := 20
 	offset := 0
@@ -318,7 +625,7 @@ func (r *taskQueryResolver) List(ctx context.Context, obj *model.TaskQuery, pagi
 	}
 
 	// Use TaskService to list tasks
-	entTasks, totalCount, err := r.deps.TaskService.ListTasksPaginated(ctx, limit, offset)
+	entTasks, totalCount, err := r.deps.TaskService.ListTasksPaginated(ctx, limit, offset, sortBy, sortDirection)
 	if err != nil {
 		return nil, err
 	}
@@ -355,6 +662,114 @@ func (r *taskQueryResolver) Get(ctx context.Context, obj *model.TaskQuery, id uu
 	return entTaskToModel(entTask), nil
 }
 
+// GetMany is the resolver for the getMany field.
+func (r *taskQueryResolver) GetMany(ctx context.Context, obj *model.TaskQuery, ids []uuid.UUID) ([]*model.Task, error) {
+	if len(ids) > maxGetManyTasks {
+		v := i18n.NewValidationError()
+		v.Add("ids", i18n.ErrTooManyIDs, map[string]interface{}{"Max": maxGetManyTasks, "Value": len(ids)})
+		return nil, v.Err()
+	}
+
+	entTasks, err := r.deps.TaskService.GetTasks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*model.Task, len(entTasks))
+	for i, t := range entTasks {
+		if t != nil {
+			tasks[i] = entTaskToModel(t)
+		}
+	}
+	return tasks, nil
+}
+
+// ListDeleted is the resolver for the listDeleted field.
+func (r *taskQueryResolver) ListDeleted(ctx context.Context, obj *model.TaskQuery, pagination *model.PaginationInput) (*model.TaskConnection, error) {
+	limit := 20
+	offset := 0
+	if pagination != nil {
+		if pagination.Limit != nil {
+			limit = *pagination.Limit
+		}
+		if pagination.Offset != nil {
+			offset = *pagination.Offset
+		}
+	}
+
+	entTasks, totalCount, err := r.deps.TaskService.ListDeletedTasksPaginated(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Task, len(entTasks))
+	for i, t := range entTasks {
+		items[i] = entTaskToModel(t)
+	}
+
+	return &model.TaskConnection{
+		Items:      items,
+		TotalCount: totalCount,
+		PageInfo: &model.OffsetPageInfo{
+			Limit:           limit,
+			Offset:          offset,
+			HasNextPage:     offset+len(items) < totalCount,
+			HasPreviousPage: offset > 0,
+		},
+	}, nil
+}
+
+// Engines is the resolver for the engines field.
+func (r *taskQueryResolver) Engines(ctx context.Context, obj *model.TaskQuery) ([]string, error) {
+	return r.deps.Runner.Engines(), nil
+}
+
+// RunHistory is the resolver for the runHistory field.
+func (r *taskQueryResolver) RunHistory(ctx context.Context, obj *model.TaskQuery, taskID uuid.UUID, lastN *int) ([]*model.TaskRun, error) {
+	n := 20
+	if lastN != nil {
+		n = min(*lastN, maxRunHistory)
+	}
+	if n <= 0 {
+		return []*model.TaskRun{}, nil
+	}
+
+	jobs, err := r.deps.JobService.ListRunHistory(ctx, taskID, n)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]*model.TaskRun, len(jobs))
+	for i, j := range jobs {
+		runs[i] = entJobToTaskRun(j)
+	}
+	return runs, nil
+}
+
+// SuggestFilters is the resolver for the suggestFilters field.
+func (r *taskQueryResolver) SuggestFilters(ctx context.Context, obj *model.TaskQuery, taskID uuid.UUID, lastN *int) (*model.FilterSuggestions, error) {
+	n := 20
+	if lastN != nil {
+		n = min(*lastN, maxFilterSuggestionRuns)
+	}
+
+	entTask, err := r.deps.TaskService.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	var existing []string
+	if entTask.Options != nil {
+		existing = entTask.Options.Filters
+	}
+	if n <= 0 {
+		return &model.FilterSuggestions{Suggestions: []*model.FilterSuggestion{}}, nil
+	}
+
+	analysis, err := r.deps.JobService.SuggestFilters(ctx, taskID, n, existing)
+	if err != nil {
+		return nil, err
+	}
+	return filterAnalysisToModel(analysis), nil
+}
+
 // Task returns generated.TaskResolver implementation.
 func (r *Resolver) Task() generated.TaskResolver { return &taskResolver{r} }
 
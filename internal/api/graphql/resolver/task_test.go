@@ -4,13 +4,21 @@ package resolver_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/tidwall/gjson"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/hooks"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // TaskResolverTestSuite tests TaskQuery and TaskMutation resolvers.
@@ -98,6 +106,37 @@ func (s *TaskResolverTestSuite) TestTaskQuery_ListWithPagination() {
 	}
 }
 
+// TestTaskQuery_ListSorted tests TaskQuery.list with sortBy and sortDirection.
+func (s *TaskResolverTestSuite) TestTaskQuery_ListSorted() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	for _, name := range []string{"beta", "alpha", "gamma"} {
+		s.Env.CreateTestTask(s.T(), name, connID)
+	}
+
+	query := `
+		query($sortBy: TaskSortField!, $sortDirection: SortDirection!) {
+			task {
+				list(pagination: { limit: 2 }, sortBy: $sortBy, sortDirection: $sortDirection) {
+					items { name }
+					totalCount
+				}
+			}
+		}
+	`
+	names := func(sortBy, direction string) []string {
+		resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"sortBy": sortBy, "sortDirection": direction})
+		require.Empty(s.T(), resp.Errors)
+		assert.Equal(s.T(), int64(3), gjson.Get(string(resp.Data), "task.list.totalCount").Int())
+		var names []string
+		for _, name := range gjson.Get(string(resp.Data), "task.list.items.#.name").Array() {
+			names = append(names, name.String())
+		}
+		return names
+	}
+	assert.Equal(s.T(), []string{"alpha", "beta"}, names("NAME", "ASC"))
+	assert.Equal(s.T(), []string{"gamma", "beta"}, names("NAME", "DESC"))
+}
+
 // TestTaskQuery_Get tests TaskQuery.get resolver.
 func (s *TaskResolverTestSuite) TestTaskQuery_Get() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
@@ -144,6 +183,48 @@ func (s *TaskResolverTestSuite) TestTaskQuery_GetNotFound() {
 	})
 }
 
+// TestTaskQuery_GetMany tests TaskQuery.getMany resolver.
+func (s *TaskResolverTestSuite) TestTaskQuery_GetMany() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	first := s.Env.CreateTestTask(s.T(), "first-task", connID)
+	second := s.Env.CreateTestTask(s.T(), "second-task", connID)
+	deleted := s.Env.CreateTestTask(s.T(), "deleted-task", connID)
+	_, err := s.Env.Deps.TaskService.DeleteTask(context.Background(), deleted.ID)
+	require.NoError(s.T(), err)
+
+	query := `
+		query($ids: [ID!]!) {
+			task {
+				getMany(ids: $ids) {
+					id
+					name
+				}
+			}
+		}
+	`
+
+	// Tasks are returned in the requested order, with null for missing and deleted tasks
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"ids": []string{second.ID.String(), uuid.New().String(), first.ID.String(), deleted.ID.String(), second.ID.String()},
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	tasks := gjson.Get(string(resp.Data), "task.getMany").Array()
+	require.Len(s.T(), tasks, 5)
+	assert.Equal(s.T(), "second-task", tasks[0].Get("name").String())
+	assert.Equal(s.T(), gjson.Null, tasks[1].Type)
+	assert.Equal(s.T(), "first-task", tasks[2].Get("name").String())
+	assert.Equal(s.T(), gjson.Null, tasks[3].Type)
+	assert.Equal(s.T(), second.ID.String(), tasks[4].Get("id").String())
+
+	ids := make([]string, 101)
+	for i := range ids {
+		ids[i] = uuid.New().String()
+	}
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"ids": ids})
+	assert.Equal(s.T(), map[string]interface{}{"ids": i18n.ErrTooManyIDs}, validationFieldCodes(s.T(), resp))
+}
+
 // TestTaskMutation_Create tests TaskMutation.create resolver.
 func (s *TaskResolverTestSuite) TestTaskMutation_Create() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
@@ -167,7 +248,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_Create() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "new-task",
-			"sourcePath":   "/local/path",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local/path"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote/path",
 			"direction":    "UPLOAD",
@@ -180,12 +261,117 @@ func (s *TaskResolverTestSuite) TestTaskMutation_Create() {
 	data := string(resp.Data)
 	assert.NotEmpty(s.T(), gjson.Get(data, "task.create.id").String())
 	assert.Equal(s.T(), "new-task", gjson.Get(data, "task.create.name").String())
-	assert.Equal(s.T(), "/local/path", gjson.Get(data, "task.create.sourcePath").String())
+	assert.Equal(s.T(), s.Env.SourcePath(s.T(), "local/path"), gjson.Get(data, "task.create.sourcePath").String())
 	assert.Equal(s.T(), "/remote/path", gjson.Get(data, "task.create.remotePath").String())
 	assert.Equal(s.T(), "UPLOAD", gjson.Get(data, "task.create.direction").String())
 	assert.Equal(s.T(), "0 * * * *", gjson.Get(data, "task.create.schedule").String())
 }
 
+// TestTaskMutation_CreateIdempotent tests that repeating TaskMutation.create with an idempotency key
+// returns the task created first instead of a duplicate.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateIdempotent() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!, $idempotencyKey: String) {
+			task {
+				create(input: $input, idempotencyKey: $idempotencyKey) {
+					id
+					name
+				}
+			}
+		}
+	`
+	input := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":         name,
+			"sourcePath":   s.Env.SourcePath(s.T(), name),
+			"connectionId": connID.String(),
+			"remotePath":   "/remote/" + name,
+			"direction":    "UPLOAD",
+		}
+	}
+	createdID := func(resp *GraphQLResponse) string {
+		require.Empty(s.T(), resp.Errors)
+		return gjson.Get(string(resp.Data), "task.create.id").String()
+	}
+
+	vars := map[string]interface{}{"input": input("argument"), "idempotencyKey": "retry-1"}
+	first := createdID(s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars))
+	assert.Equal(s.T(), first, createdID(s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars)))
+
+	// The key can't be reused for another task
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input("other"), "idempotencyKey": "retry-1"})
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrIdempotencyKeyReused, resp.Errors[0].Extensions["code"])
+
+	// The key can also be sent as header
+	req := GraphQLRequest{
+		Query:     mutation,
+		Variables: map[string]interface{}{"input": input("header")},
+		Headers:   map[string]string{"Idempotency-Key": "retry-2"},
+	}
+	second := createdID(s.Env.ExecuteGraphQL(s.T(), req))
+	assert.NotEqual(s.T(), first, second)
+	assert.Equal(s.T(), second, createdID(s.Env.ExecuteGraphQL(s.T(), req)))
+
+	tasks, err := s.Env.TaskService.ListAllTasks(context.Background())
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), tasks, 2)
+}
+
+// TestTaskMutation_CreateRunImmediately tests that TaskMutation.create with runImmediately starts the first run
+// and returns its job along with the task.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateRunImmediately() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!, $idempotencyKey: String) {
+			task {
+				create(input: $input, idempotencyKey: $idempotencyKey) {
+					id
+					firstJob {
+						id
+						trigger
+					}
+				}
+			}
+		}
+	`
+	vars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":           "run-immediately",
+			"sourcePath":     s.Env.SourcePath(s.T(), "run-immediately"),
+			"connectionId":   connID.String(),
+			"remotePath":     "/remote/run-immediately",
+			"direction":      "UPLOAD",
+			"runImmediately": true,
+		},
+		"idempotencyKey": "run-immediately",
+	}
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	taskID := uuid.MustParse(gjson.Get(data, "task.create.id").String())
+	firstJobID := gjson.Get(data, "task.create.firstJob.id").String()
+	require.NotEmpty(s.T(), firstJobID)
+	assert.Equal(s.T(), "MANUAL", gjson.Get(data, "task.create.firstJob.trigger").String())
+	require.Eventually(s.T(), func() bool { return !s.Env.Runner.IsRunning(taskID) }, 10*time.Second, 10*time.Millisecond)
+
+	// The run takes over the returned job instead of creating another one
+	jobs, err := s.Env.JobService.ListJobs(context.Background(), &taskID, nil, "", 10, 0)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), jobs, 1)
+	assert.Equal(s.T(), firstJobID, jobs[0].ID.String())
+
+	// Replays return the task only
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, vars)
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), taskID.String(), gjson.Get(string(resp.Data), "task.create.id").String())
+	assert.False(s.T(), gjson.Get(string(resp.Data), "task.create.firstJob.id").Exists())
+}
+
 // TestTaskMutation_CreateWithOptions tests TaskMutation.create with options.
 func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithOptions() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
@@ -207,7 +393,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithOptions() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "task-with-options",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "BIDIRECTIONAL",
@@ -240,7 +426,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_CreateInvalidSchedule() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "task-invalid-schedule",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -248,6 +434,134 @@ func (s *TaskResolverTestSuite) TestTaskMutation_CreateInvalidSchedule() {
 		},
 	})
 	require.NotEmpty(s.T(), resp.Errors)
+
+	// RRULE schedules are validated too
+	input := func(name, schedule string) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":         name,
+				"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+				"connectionId": connID.String(),
+				"remotePath":   "/remote",
+				"direction":    "UPLOAD",
+				"schedule":     schedule,
+			},
+		}
+	}
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, input("task-invalid-rrule", "RRULE:FREQ=WEEKLY;BYDAY=1SA"))
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrValidationFailed, resp.Errors[0].Extensions["code"])
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, input("task-rrule", "DTSTART:20260103T090000Z RRULE:FREQ=MONTHLY;BYDAY=1SA"))
+	require.Empty(s.T(), resp.Errors)
+}
+
+// TestTaskMutation_CreateFromDirectory tests TaskMutation.createFromDirectory resolver.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateFromDirectory() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	localRoot := s.T().TempDir()
+	for _, name := range []string{"project-a", "project-b", ".hidden", "existing"} {
+		require.NoError(s.T(), os.Mkdir(filepath.Join(localRoot, name), 0o755))
+	}
+	require.NoError(s.T(), os.WriteFile(filepath.Join(localRoot, "file.txt"), []byte("x"), 0o644))
+
+	// A task already covering one subdirectory should be skipped
+	_, err := s.Env.TaskService.CreateTask(context.Background(), "existing", filepath.Join(localRoot, "existing"),
+		connID, "/backup/existing", "UPLOAD", "", false, nil)
+	require.NoError(s.T(), err)
+
+	mutation := `
+		mutation($connectionId: ID!, $localRoot: String!, $remoteRoot: String!) {
+			task {
+				createFromDirectory(connectionId: $connectionId, localRoot: $localRoot, remoteRoot: $remoteRoot, direction: UPLOAD) {
+					name
+					sourcePath
+					remotePath
+					direction
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"connectionId": connID.String(),
+		"localRoot":    localRoot,
+		"remoteRoot":   "/backup",
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	tasks := gjson.Get(string(resp.Data), "task.createFromDirectory").Array()
+	require.Len(s.T(), tasks, 2)
+	assert.Equal(s.T(), "project-a", tasks[0].Get("name").String())
+	assert.Equal(s.T(), filepath.Join(localRoot, "project-a"), tasks[0].Get("sourcePath").String())
+	assert.Equal(s.T(), "/backup/project-a", tasks[0].Get("remotePath").String())
+	assert.Equal(s.T(), "UPLOAD", tasks[0].Get("direction").String())
+	assert.Equal(s.T(), "project-b", tasks[1].Get("name").String())
+}
+
+// TestTaskMutation_CreateFromDirectoryValidation tests that createFromDirectory validates each task like a single create.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateFromDirectoryValidation() {
+	photos, err := s.Env.ConnectionService.CreateConnection(context.Background(), "photos", "gphotos", map[string]string{
+		"token": `{"access_token":"token"}`,
+	})
+	require.NoError(s.T(), err)
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	localRoot := s.T().TempDir()
+	require.NoError(s.T(), os.Mkdir(filepath.Join(localRoot, "album"), 0o755))
+
+	mutation := `
+		mutation($connectionId: ID!, $localRoot: String!, $options: TaskSyncOptionsInput) {
+			task {
+				createFromDirectory(connectionId: $connectionId, localRoot: $localRoot, remoteRoot: "/backup", options: $options) {
+					id
+				}
+			}
+		}
+	`
+
+	// The default BIDIRECTIONAL direction is rejected on media connections
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"connectionId": photos.ID.String(),
+		"localRoot":    localRoot,
+	})
+	assert.Equal(s.T(), i18n.ErrMediaBidirectional, validationFieldCodes(s.T(), resp)["direction"])
+
+	// Invalid options are rejected too
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"connectionId": connID.String(),
+		"localRoot":    localRoot,
+		"options":      map[string]interface{}{"filters": []string{"not a rule"}},
+	})
+	assert.Equal(s.T(), i18n.ErrFilterRuleInvalid, validationFieldCodes(s.T(), resp)["options.filters.0"])
+
+	for _, id := range []uuid.UUID{photos.ID, connID} {
+		tasks, err := s.Env.TaskService.ListTasksByConnection(context.Background(), id)
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), tasks)
+	}
+}
+
+// TestTaskMutation_CreateFromDirectoryNotExist tests createFromDirectory with a missing local root.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateFromDirectoryNotExist() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($connectionId: ID!, $localRoot: String!) {
+			task {
+				createFromDirectory(connectionId: $connectionId, localRoot: $localRoot, remoteRoot: "/backup") {
+					id
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"connectionId": connID.String(),
+		"localRoot":    filepath.Join(s.T().TempDir(), "missing"),
+	})
+	require.NotEmpty(s.T(), resp.Errors)
 }
 
 // TestTaskMutation_Update tests TaskMutation.update resolver.
@@ -272,7 +586,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_Update() {
 		"id": task.ID.String(),
 		"input": map[string]interface{}{
 			"name":       "updated-name",
-			"sourcePath": "/new/source",
+			"sourcePath": s.Env.SourcePath(s.T(), "new/source"),
 		},
 	})
 	require.Empty(s.T(), resp.Errors)
@@ -280,7 +594,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_Update() {
 	data := string(resp.Data)
 	assert.Equal(s.T(), task.ID.String(), gjson.Get(data, "task.update.id").String())
 	assert.Equal(s.T(), "updated-name", gjson.Get(data, "task.update.name").String())
-	assert.Equal(s.T(), "/new/source", gjson.Get(data, "task.update.sourcePath").String())
+	assert.Equal(s.T(), s.Env.SourcePath(s.T(), "new/source"), gjson.Get(data, "task.update.sourcePath").String())
 	// remotePath should remain unchanged
 	assert.Equal(s.T(), "/remote", gjson.Get(data, "task.update.remotePath").String())
 }
@@ -306,6 +620,113 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateNotFound() {
 	require.NotEmpty(s.T(), resp.Errors)
 }
 
+// TestTaskMutation_UpdateChanges tests the changes returned by TaskMutation.update and recorded as task events.
+func (s *TaskResolverTestSuite) TestTaskMutation_UpdateChanges() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "original-name", connID)
+
+	mutation := `
+		mutation($id: ID!, $input: UpdateTaskInput!) {
+			task {
+				update(id: $id, input: $input) {
+					changes { field old new }
+				}
+			}
+		}
+	`
+	update := func(input map[string]interface{}) []gjson.Result {
+		resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"id": task.ID.String(), "input": input})
+		require.Empty(s.T(), resp.Errors)
+		changes := gjson.Get(string(resp.Data), "task.update.changes")
+		require.True(s.T(), changes.IsArray())
+		return changes.Array()
+	}
+
+	changes := update(map[string]interface{}{
+		"name":    "updated-name",
+		"options": map[string]interface{}{"transfers": 8},
+	})
+	require.Len(s.T(), changes, 2)
+	assert.Equal(s.T(), "name", changes[0].Get("field").String())
+	assert.Equal(s.T(), "original-name", changes[0].Get("old").String())
+	assert.Equal(s.T(), "updated-name", changes[0].Get("new").String())
+	assert.Equal(s.T(), "options.transfers", changes[1].Get("field").String())
+	assert.Equal(s.T(), gjson.Null, changes[1].Get("old").Type)
+	assert.Equal(s.T(), "8", changes[1].Get("new").String())
+
+	// Updates without changes return an empty list and aren't recorded
+	assert.Empty(s.T(), update(map[string]interface{}{
+		"name":    "updated-name",
+		"options": map[string]interface{}{"transfers": 8},
+	}))
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					changes { field }
+					events { items { type message } }
+				}
+			}
+		}
+	`, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "task.get.changes").Type, "changes are only returned by task.update")
+	events := gjson.Get(data, "task.get.events.items").Array()
+	require.Len(s.T(), events, 1)
+	assert.Equal(s.T(), "TASK_UPDATED", events[0].Get("type").String())
+	assert.Equal(s.T(), "name: original-name → updated-name\noptions.transfers: (unset) → 8", events[0].Get("message").String())
+}
+
+// TestTaskMutation_UpdateEnabled tests re-enabling a task that was disabled after repeated fatal failures.
+func (s *TaskResolverTestSuite) TestTaskMutation_UpdateEnabled() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "task-enabled", connID)
+	require.NoError(s.T(), s.Env.Client.Task.UpdateOneID(task.ID).
+		SetDisabled(true).
+		SetDisabledReason("disabled after 5 runs in a row failed with a non-transient error").
+		SetConsecutiveFatalFailures(5).
+		Exec(context.Background()))
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), `
+		query($id: ID!) {
+			task {
+				get(id: $id) { enabled disabledReason }
+			}
+		}
+	`, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.False(s.T(), gjson.Get(data, "task.get.enabled").Bool())
+	assert.Equal(s.T(), "disabled after 5 runs in a row failed with a non-transient error", gjson.Get(data, "task.get.disabledReason").String())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!, $input: UpdateTaskInput!) {
+			task {
+				update(id: $id, input: $input) {
+					enabled
+					disabledReason
+					changes { field old new }
+				}
+			}
+		}
+	`, map[string]interface{}{"id": task.ID.String(), "input": map[string]interface{}{"enabled": true}})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.True(s.T(), gjson.Get(data, "task.update.enabled").Bool())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "task.update.disabledReason").Type)
+	changes := gjson.Get(data, "task.update.changes").Array()
+	require.Len(s.T(), changes, 1)
+	assert.Equal(s.T(), "enabled", changes[0].Get("field").String())
+	assert.Equal(s.T(), "false", changes[0].Get("old").String())
+	assert.Equal(s.T(), "true", changes[0].Get("new").String())
+
+	updated, err := s.Env.Client.Task.Get(context.Background(), task.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, updated.ConsecutiveFatalFailures)
+}
+
 // TestTaskMutation_UpdateDirection tests TaskMutation.update with direction change.
 func (s *TaskResolverTestSuite) TestTaskMutation_UpdateDirection() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
@@ -443,7 +864,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateScheduleRemove() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "task-with-schedule",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -548,7 +969,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateRealtimeDisable() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "realtime-task",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -603,7 +1024,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateSourcePathWithRealtime()
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "realtime-source-task",
-			"sourcePath":   "/original/source",
+			"sourcePath":   s.Env.SourcePath(s.T(), "original/source"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -629,13 +1050,13 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateSourcePathWithRealtime()
 	resp = s.Env.ExecuteGraphQLWithVars(s.T(), updateMutation, map[string]interface{}{
 		"id": taskID,
 		"input": map[string]interface{}{
-			"sourcePath": "/new/source/path",
+			"sourcePath": s.Env.SourcePath(s.T(), "new/source/path"),
 		},
 	})
 	require.Empty(s.T(), resp.Errors)
 
 	data := string(resp.Data)
-	assert.Equal(s.T(), "/new/source/path", gjson.Get(data, "task.update.sourcePath").String())
+	assert.Equal(s.T(), s.Env.SourcePath(s.T(), "new/source/path"), gjson.Get(data, "task.update.sourcePath").String())
 	assert.True(s.T(), gjson.Get(data, "task.update.realtime").Bool())
 }
 
@@ -703,7 +1124,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateAllFields() {
 		"id": task.ID.String(),
 		"input": map[string]interface{}{
 			"name":         "completely-updated-task",
-			"sourcePath":   "/updated/source",
+			"sourcePath":   s.Env.SourcePath(s.T(), "updated/source"),
 			"remotePath":   "/updated/remote",
 			"connectionId": connID2.String(),
 			"direction":    "BIDIRECTIONAL",
@@ -719,7 +1140,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateAllFields() {
 	data := string(resp.Data)
 	assert.Equal(s.T(), task.ID.String(), gjson.Get(data, "task.update.id").String())
 	assert.Equal(s.T(), "completely-updated-task", gjson.Get(data, "task.update.name").String())
-	assert.Equal(s.T(), "/updated/source", gjson.Get(data, "task.update.sourcePath").String())
+	assert.Equal(s.T(), s.Env.SourcePath(s.T(), "updated/source"), gjson.Get(data, "task.update.sourcePath").String())
 	assert.Equal(s.T(), "/updated/remote", gjson.Get(data, "task.update.remotePath").String())
 	assert.Equal(s.T(), connID2.String(), gjson.Get(data, "task.update.connection.id").String())
 	assert.Equal(s.T(), "BIDIRECTIONAL", gjson.Get(data, "task.update.direction").String())
@@ -823,6 +1244,83 @@ func (s *TaskResolverTestSuite) TestTaskMutation_DeleteNotFound() {
 	require.NotEmpty(s.T(), resp.Errors)
 }
 
+// TestTaskMutation_Restore tests TaskQuery.listDeleted and TaskMutation.restore after a soft delete.
+func (s *TaskResolverTestSuite) TestTaskMutation_Restore() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "task-to-restore", connID)
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!) {
+			task {
+				delete(id: $id) {
+					deletedAt
+				}
+			}
+		}
+	`, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.NotEmpty(s.T(), gjson.Get(string(resp.Data), "task.delete.deletedAt").String())
+
+	listDeleted := `
+		query {
+			task {
+				listDeleted {
+					totalCount
+					items {
+						id
+						deletedAt
+						options {
+							transfers
+						}
+					}
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), listDeleted, nil)
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "task.listDeleted.totalCount").Int())
+	assert.Equal(s.T(), task.ID.String(), gjson.Get(data, "task.listDeleted.items.0.id").String())
+	assert.NotEmpty(s.T(), gjson.Get(data, "task.listDeleted.items.0.deletedAt").String())
+
+	restore := `
+		mutation($id: ID!) {
+			task {
+				restore(id: $id) {
+					id
+					deletedAt
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), restore, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), task.ID.String(), gjson.Get(data, "task.restore.id").String())
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "task.restore.deletedAt").Type)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					name
+				}
+			}
+		}
+	`, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "task-to-restore", gjson.Get(string(resp.Data), "task.get.name").String())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), listDeleted, nil)
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), int64(0), gjson.Get(string(resp.Data), "task.listDeleted.totalCount").Int())
+
+	// A task that is not deleted cannot be restored
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), restore, map[string]interface{}{"id": task.ID.String()})
+	require.NotEmpty(s.T(), resp.Errors)
+}
+
 // TestTask_Connection tests Task.connection field resolver.
 func (s *TaskResolverTestSuite) TestTask_Connection() {
 	connID := s.Env.CreateTestConnection(s.T(), "my-connection")
@@ -895,36 +1393,245 @@ func (s *TaskResolverTestSuite) TestTask_Jobs() {
 	assert.Equal(s.T(), 3, len(gjson.Get(data, "task.get.jobs.items").Array()))
 }
 
-// TestTask_LatestJob tests Task.latestJob field resolver.
-func (s *TaskResolverTestSuite) TestTask_LatestJob() {
+// TestTaskQuery_RunHistory tests TaskQuery.runHistory resolver.
+func (s *TaskResolverTestSuite) TestTaskQuery_RunHistory() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
-	task := s.Env.CreateTestTask(s.T(), "task-with-latest-job", connID)
+	task := s.Env.CreateTestTask(s.T(), "task-with-history", connID)
 
-	// Create jobs for the task
 	ctx := context.Background()
-	var lastJobID uuid.UUID
-	for i := 0; i < 3; i++ {
-		job, err := s.Env.JobService.CreateJob(ctx, task.ID, "MANUAL")
+	var jobIDs []string
+	for i := 1; i <= 3; i++ {
+		j, err := s.Env.JobService.CreateJob(ctx, task.ID, "MANUAL")
 		require.NoError(s.T(), err)
-		lastJobID = job.ID
+		jobIDs = append(jobIDs, j.ID.String())
+		if i < 3 {
+			_, err = s.Env.JobService.FinalizeJob(ctx, j.ID, ports.JobResult{
+				Status:           model.JobStatusSuccess,
+				BytesTransferred: int64(i * 100),
+			})
+			require.NoError(s.T(), err)
+		} else {
+			// Shard jobs are not runs of their own
+			_, err = s.Env.JobService.CreateChildJob(ctx, j.ID, task.ID, "MANUAL")
+			require.NoError(s.T(), err)
+		}
+		time.Sleep(time.Millisecond) // Ensure different start times
 	}
 
 	query := `
-		query($id: ID!) {
+		query($taskId: ID!, $lastN: Int) {
 			task {
-				get(id: $id) {
-					id
-					latestJob {
-						id
-						status
-					}
+				runHistory(taskId: $taskId, lastN: $lastN) {
+					jobId
+					status
+					durationMs
+					bytes
 				}
 			}
 		}
 	`
 
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
-		"id": task.ID.String(),
+		"taskId": task.ID.String(),
+		"lastN":  2,
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	runs := gjson.Get(string(resp.Data), "task.runHistory").Array()
+	require.Len(s.T(), runs, 2)
+	// Oldest first
+	assert.Equal(s.T(), jobIDs[1], runs[0].Get("jobId").String())
+	assert.Equal(s.T(), "SUCCESS", runs[0].Get("status").String())
+	assert.Equal(s.T(), int64(200), runs[0].Get("bytes").Int())
+	assert.Equal(s.T(), gjson.Number, runs[0].Get("durationMs").Type)
+	assert.Equal(s.T(), jobIDs[2], runs[1].Get("jobId").String())
+	assert.Equal(s.T(), "PENDING", runs[1].Get("status").String())
+	assert.Equal(s.T(), gjson.Null, runs[1].Get("durationMs").Type)
+
+	// The default covers all runs, and non-positive counts return none
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"taskId": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Len(s.T(), gjson.Get(string(resp.Data), "task.runHistory").Array(), 3)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"taskId": task.ID.String(), "lastN": 0})
+	require.Empty(s.T(), resp.Errors)
+	assert.Empty(s.T(), gjson.Get(string(resp.Data), "task.runHistory").Array())
+}
+
+// TestTaskQuery_WatchWarning tests that Task.watchWarning reports the watch limit warning of realtime tasks.
+func (s *TaskResolverTestSuite) TestTaskQuery_WatchWarning() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "partially-watched", connID)
+
+	limit := 8192
+	watcher := s.Env.Deps.Watcher.(*mockWatcher)
+	watcher.warnings = map[uuid.UUID]*model.WatchWarning{task.ID: {
+		Path:       "/tmp/source/deep",
+		Limit:      &limit,
+		Used:       8192,
+		Sysctl:     "sysctl -w fs.inotify.max_user_watches=524288",
+		DetectedAt: time.Now(),
+	}}
+	defer func() { watcher.warnings = nil }()
+
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					watchWarning { path limit used sysctl detectedAt }
+				}
+			}
+		}
+	`
+
+	// Only realtime tasks are watched
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(string(resp.Data), "task.get.watchWarning").Type)
+
+	require.NoError(s.T(), s.Env.Client.Task.UpdateOneID(task.ID).SetRealtime(true).Exec(context.Background()))
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+	warning := gjson.Get(string(resp.Data), "task.get.watchWarning")
+	assert.Equal(s.T(), "/tmp/source/deep", warning.Get("path").String())
+	assert.Equal(s.T(), int64(8192), warning.Get("limit").Int())
+	assert.Equal(s.T(), int64(8192), warning.Get("used").Int())
+	assert.Equal(s.T(), "sysctl -w fs.inotify.max_user_watches=524288", warning.Get("sysctl").String())
+	assert.NotEmpty(s.T(), warning.Get("detectedAt").String())
+}
+
+// TestTaskQuery_SuggestFilters tests TaskQuery.suggestFilters resolver.
+func (s *TaskResolverTestSuite) TestTaskQuery_SuggestFilters() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "task-with-churn", connID)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		j, err := s.Env.JobService.CreateJob(ctx, task.ID, "MANUAL")
+		require.NoError(s.T(), err)
+		_, err = s.Env.JobService.AddJobLog(ctx, j.ID, "INFO", "UPLOAD", "app/node_modules/lib/index.js", 100)
+		require.NoError(s.T(), err)
+		_, err = s.Env.JobService.AddJobLog(ctx, j.ID, "INFO", "UPLOAD", "docs/report.pdf", 1000)
+		require.NoError(s.T(), err)
+		// Errors are not changes
+		_, err = s.Env.JobService.AddJobLog(ctx, j.ID, "ERROR", "UPLOAD", "app/node_modules/lib/broken.js", 100)
+		require.NoError(s.T(), err)
+		time.Sleep(time.Millisecond) // Ensure different start times
+	}
+
+	query := `
+		query($taskId: ID!, $lastN: Int) {
+			task {
+				suggestFilters(taskId: $taskId, lastN: $lastN) {
+					jobCount
+					changes
+					bytes
+					suggestions { rule category paths changes bytes examples }
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"taskId": task.ID.String()})
+	require.Empty(s.T(), resp.Errors)
+
+	result := gjson.Get(string(resp.Data), "task.suggestFilters")
+	assert.Equal(s.T(), int64(2), result.Get("jobCount").Int())
+	assert.Equal(s.T(), int64(4), result.Get("changes").Int())
+	assert.Equal(s.T(), int64(2200), result.Get("bytes").Int())
+	suggestions := result.Get("suggestions").Array()
+	require.Len(s.T(), suggestions, 1)
+	assert.Equal(s.T(), "- node_modules/**", suggestions[0].Get("rule").String())
+	assert.Equal(s.T(), "DEPENDENCIES", suggestions[0].Get("category").String())
+	assert.Equal(s.T(), int64(1), suggestions[0].Get("paths").Int())
+	assert.Equal(s.T(), int64(2), suggestions[0].Get("changes").Int())
+	assert.Equal(s.T(), int64(200), suggestions[0].Get("bytes").Int())
+	assert.Equal(s.T(), "app/node_modules/lib/index.js", suggestions[0].Get("examples.0").String())
+
+	// Only the last run is analyzed with lastN 1
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"taskId": task.ID.String(), "lastN": 1})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), int64(1), gjson.Get(string(resp.Data), "task.suggestFilters.jobCount").Int())
+	assert.Equal(s.T(), int64(100), gjson.Get(string(resp.Data), "task.suggestFilters.suggestions.0.bytes").Int())
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"taskId": uuid.New().String()})
+	assert.NotEmpty(s.T(), resp.Errors)
+}
+
+// TestTask_SkippedRunsAndEvents tests Task.skippedRuns and Task.events field resolvers.
+func (s *TaskResolverTestSuite) TestTask_SkippedRunsAndEvents() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "task-with-events", connID)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		require.NoError(s.T(), s.Env.TaskService.RecordSkippedRun(ctx, task.ID, fmt.Sprintf("skipped %d", i)))
+	}
+
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					skippedRuns
+					events(pagination: { limit: 1 }) {
+						items {
+							type
+							message
+						}
+						totalCount
+						pageInfo {
+							hasNextPage
+						}
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"id": task.ID.String(),
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.Equal(s.T(), int64(2), gjson.Get(data, "task.get.skippedRuns").Int())
+	assert.Equal(s.T(), int64(2), gjson.Get(data, "task.get.events.totalCount").Int())
+	assert.True(s.T(), gjson.Get(data, "task.get.events.pageInfo.hasNextPage").Bool())
+	require.Len(s.T(), gjson.Get(data, "task.get.events.items").Array(), 1)
+	assert.Equal(s.T(), "SCHEDULE_SKIPPED", gjson.Get(data, "task.get.events.items.0.type").String())
+	assert.Equal(s.T(), "skipped 1", gjson.Get(data, "task.get.events.items.0.message").String())
+}
+
+// TestTask_LatestJob tests Task.latestJob field resolver.
+func (s *TaskResolverTestSuite) TestTask_LatestJob() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "task-with-latest-job", connID)
+
+	// Create jobs for the task
+	ctx := context.Background()
+	var lastJobID uuid.UUID
+	for i := 0; i < 3; i++ {
+		job, err := s.Env.JobService.CreateJob(ctx, task.ID, "MANUAL")
+		require.NoError(s.T(), err)
+		lastJobID = job.ID
+	}
+
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					id
+					latestJob {
+						id
+						status
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{
+		"id": task.ID.String(),
 	})
 	require.Empty(s.T(), resp.Errors)
 
@@ -960,6 +1667,73 @@ func (s *TaskResolverTestSuite) TestTask_LatestJobNone() {
 	assert.True(s.T(), latestJob.Type == gjson.Null || !latestJob.Exists(), "latestJob should be null when no jobs exist")
 }
 
+// TestTask_ConfigChangedSinceLastRun tests Task.configHash and Task.configChangedSinceLastRun.
+func (s *TaskResolverTestSuite) TestTask_ConfigChangedSinceLastRun() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	task := s.Env.CreateTestTask(s.T(), "task-config-drift", connID)
+	ctx := context.Background()
+
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					configHash
+					configChangedSinceLastRun
+					latestJob {
+						taskConfigHash
+					}
+				}
+			}
+		}
+	`
+	get := func() string {
+		resp := s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": task.ID.String()})
+		require.Empty(s.T(), resp.Errors)
+		return string(resp.Data)
+	}
+	update := func(input map[string]interface{}) {
+		resp := s.Env.ExecuteGraphQLWithVars(s.T(), `
+			mutation($id: ID!, $input: UpdateTaskInput!) {
+				task { update(id: $id, input: $input) { id } }
+			}
+		`, map[string]interface{}{"id": task.ID.String(), "input": input})
+		require.Empty(s.T(), resp.Errors)
+	}
+
+	// Unknown before the first successful run
+	data := get()
+	hash := gjson.Get(data, "task.get.configHash").String()
+	assert.Len(s.T(), hash, 64)
+	assert.Equal(s.T(), gjson.Null, gjson.Get(data, "task.get.configChangedSinceLastRun").Type)
+
+	job, err := s.Env.JobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.UpdateJobStatus(ctx, job.ID, string(model.JobStatusSuccess), "")
+	require.NoError(s.T(), err)
+	data = get()
+	assert.Equal(s.T(), hash, gjson.Get(data, "task.get.latestJob.taskConfigHash").String())
+	assert.False(s.T(), gjson.Get(data, "task.get.configChangedSinceLastRun").Bool())
+	assert.Equal(s.T(), gjson.False, gjson.Get(data, "task.get.configChangedSinceLastRun").Type)
+
+	// The schedule doesn't change what a run does
+	update(map[string]interface{}{"schedule": "0 */2 * * *"})
+	data = get()
+	assert.Equal(s.T(), hash, gjson.Get(data, "task.get.configHash").String())
+	assert.Equal(s.T(), gjson.False, gjson.Get(data, "task.get.configChangedSinceLastRun").Type)
+
+	update(map[string]interface{}{"options": map[string]interface{}{"conflictResolution": "REMOTE"}})
+	data = get()
+	assert.NotEqual(s.T(), hash, gjson.Get(data, "task.get.configHash").String())
+	assert.True(s.T(), gjson.Get(data, "task.get.configChangedSinceLastRun").Bool())
+
+	// Failed runs don't apply the changes
+	job, err = s.Env.JobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(s.T(), err)
+	_, err = s.Env.JobService.UpdateJobStatus(ctx, job.ID, string(model.JobStatusFailed), "boom")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), gjson.Get(get(), "task.get.configChangedSinceLastRun").Bool())
+}
+
 // TestTaskMutation_Run tests TaskMutation.run resolver.
 // Note: This test verifies that the run mutation starts task execution.
 // Due to the async nature of the runner (job creation happens in a goroutine),
@@ -1036,7 +1810,7 @@ func (s *TaskResolverTestSuite) TestTask_Options() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "task-with-options",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "BIDIRECTIONAL",
@@ -1094,7 +1868,7 @@ func (s *TaskResolverTestSuite) TestTask_AllDirections() {
 			resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 				"input": map[string]interface{}{
 					"name":         "task-" + direction,
-					"sourcePath":   "/local",
+					"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 					"connectionId": connID.String(),
 					"remotePath":   "/remote",
 					"direction":    direction,
@@ -1126,7 +1900,7 @@ func (s *TaskResolverTestSuite) TestTask_Realtime() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "realtime-task",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -1236,7 +2010,7 @@ func (s *TaskResolverTestSuite) TestTask_JobsEmpty() {
 	assert.Equal(s.T(), 0, len(gjson.Get(data, "task.get.jobs.items").Array()))
 }
 
-// TestTaskMutation_DeleteWithJobs tests that deleting a task with jobs succeeds (jobs are cascade deleted).
+// TestTaskMutation_DeleteWithJobs tests that deleting a task with jobs succeeds (jobs are kept until the task is purged).
 func (s *TaskResolverTestSuite) TestTaskMutation_DeleteWithJobs() {
 	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
 	task := s.Env.CreateTestTask(s.T(), "task-with-jobs-delete", connID)
@@ -1286,7 +2060,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_DeleteWithRealtime() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "realtime-task-delete",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -1332,7 +2106,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_DeleteWithSchedule() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "scheduled-task-delete",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -1405,7 +2179,7 @@ func (s *TaskResolverTestSuite) TestTask_AllFields() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "all-fields-task",
-			"sourcePath":   "/local/source",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local/source"),
 			"connectionId": connID.String(),
 			"remotePath":   "/remote/path",
 			"direction":    "BIDIRECTIONAL",
@@ -1468,7 +2242,7 @@ func (s *TaskResolverTestSuite) TestTask_AllFields() {
 	data := string(resp.Data)
 	assert.Equal(s.T(), taskID, gjson.Get(data, "task.get.id").String())
 	assert.Equal(s.T(), "all-fields-task", gjson.Get(data, "task.get.name").String())
-	assert.Equal(s.T(), "/local/source", gjson.Get(data, "task.get.sourcePath").String())
+	assert.Equal(s.T(), s.Env.SourcePath(s.T(), "local/source"), gjson.Get(data, "task.get.sourcePath").String())
 	assert.Equal(s.T(), "/remote/path", gjson.Get(data, "task.get.remotePath").String())
 	assert.Equal(s.T(), "BIDIRECTIONAL", gjson.Get(data, "task.get.direction").String())
 	assert.Equal(s.T(), "0 12 * * *", gjson.Get(data, "task.get.schedule").String())
@@ -1548,7 +2322,7 @@ func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithInvalidConnection() {
 	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
 		"input": map[string]interface{}{
 			"name":         "invalid-conn-task",
-			"sourcePath":   "/local",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
 			"connectionId": uuid.New().String(),
 			"remotePath":   "/remote",
 			"direction":    "UPLOAD",
@@ -1584,3 +2358,665 @@ func (s *TaskResolverTestSuite) TestTaskMutation_UpdateInvalidConnection() {
 	// Should fail because the connection doesn't exist
 	require.NotEmpty(s.T(), resp.Errors)
 }
+
+// TestTaskMutation_CreateValidationFields tests that TaskMutation.create reports every invalid field.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateValidationFields() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "validation-task",
+			"sourcePath":   filepath.Join(s.Env.LocalDir, "missing"),
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "UPLOAD",
+			"schedule":     "invalid-cron",
+			"options": map[string]interface{}{
+				"filters":          []interface{}{"- *.tmp", "*.log"},
+				"transfers":        100,
+				"logRetentionDays": -1,
+				"jobRetentionDays": -1,
+			},
+		},
+	})
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrValidationFailed, resp.Errors[0].Extensions["code"])
+
+	fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+	require.True(s.T(), ok)
+	codes := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		field := f.(map[string]interface{})
+		assert.NotEmpty(s.T(), field["message"])
+		codes[field["field"].(string)] = field["code"]
+	}
+	assert.Equal(s.T(), map[string]interface{}{
+		"sourcePath":               i18n.ErrPathNotExist,
+		"schedule":                 i18n.ErrInvalidSchedule,
+		"options.filters.1":        i18n.ErrFilterRuleInvalid,
+		"options.transfers":        i18n.ErrTransfersOutOfRange,
+		"options.logRetentionDays": i18n.ErrRetentionDaysNegative,
+		"options.jobRetentionDays": i18n.ErrRetentionDaysNegative,
+	}, codes)
+
+	// A download creates its local directory, so a missing source path is accepted
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "download-task",
+			"sourcePath":   filepath.Join(s.Env.LocalDir, "missing"),
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "DOWNLOAD",
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	// Sharded syncs reject anchored filter rules that can't be rebased onto each top-level directory
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "sharded-task",
+			"sourcePath":   s.Env.LocalDir,
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "UPLOAD",
+			"options": map[string]interface{}{
+				"filters": []interface{}{"- /photos/raw/**", "- /**.tmp"},
+				"shards":  4,
+			},
+		},
+	})
+	assert.Equal(s.T(), map[string]interface{}{
+		"options.filters.1": i18n.ErrFilterRuleNotShardable,
+	}, validationFieldCodes(s.T(), resp))
+}
+
+// TestTaskMutation_CreateWithWatchExcludeDirs tests that TaskMutation.create only accepts subdirectories as excluded watch directories.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithWatchExcludeDirs() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					options { watchExcludeDirs }
+				}
+			}
+		}
+	`
+	input := func(dirs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":         "task-with-excludes",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "UPLOAD",
+			"realtime":     true,
+			"options":      map[string]interface{}{"watchExcludeDirs": dirs},
+		}
+	}
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input("cache", "/abs", "../up", ".")})
+	assert.Equal(s.T(), map[string]interface{}{
+		"options.watchExcludeDirs.1": i18n.ErrWatchExcludeDirInvalid,
+		"options.watchExcludeDirs.2": i18n.ErrWatchExcludeDirInvalid,
+		"options.watchExcludeDirs.3": i18n.ErrWatchExcludeDirInvalid,
+	}, validationFieldCodes(s.T(), resp))
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input("cache", "build/tmp")})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), `["cache","build/tmp"]`, gjson.Get(string(resp.Data), "task.create.options.watchExcludeDirs").Raw)
+}
+
+// TestTaskMutation_Tags tests that tags are set on create and replaced on update.
+func (s *TaskResolverTestSuite) TestTaskMutation_Tags() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	createMutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) { id tags }
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), createMutation, map[string]interface{}{"input": map[string]interface{}{
+		"name":         "tagged-task",
+		"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+		"connectionId": connID.String(),
+		"remotePath":   "/remote",
+		"direction":    "UPLOAD",
+		"tags":         []interface{}{"nightly", " photos ", "nightly"},
+	}})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), `["nightly","photos"]`, gjson.Get(string(resp.Data), "task.create.tags").Raw)
+	taskID := gjson.Get(string(resp.Data), "task.create.id").String()
+
+	updateMutation := `
+		mutation($id: ID!, $input: UpdateTaskInput!) {
+			task {
+				update(id: $id, input: $input) { tags changes { field } }
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), updateMutation, map[string]interface{}{
+		"id":    taskID,
+		"input": map[string]interface{}{"tags": []interface{}{"weekly"}},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), `["weekly"]`, gjson.Get(string(resp.Data), "task.update.tags").Raw)
+	assert.Equal(s.T(), `["tags"]`, gjson.Get(string(resp.Data), "task.update.changes.#.field").Raw)
+
+	// Tags are kept if not set in the update
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), updateMutation, map[string]interface{}{
+		"id":    taskID,
+		"input": map[string]interface{}{"name": "renamed"},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), `["weekly"]`, gjson.Get(string(resp.Data), "task.update.tags").Raw)
+}
+
+// TestTaskMutation_CreateWithWindowsNames tests that the windowsNames option is stored and returned.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithWindowsNames() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					options { windowsNames }
+				}
+			}
+		}
+	`
+	input := map[string]interface{}{
+		"name":         "task-with-windows-names",
+		"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+		"connectionId": connID.String(),
+		"remotePath":   "/remote",
+		"direction":    "UPLOAD",
+		"options":      map[string]interface{}{"windowsNames": "ENCODE"},
+	}
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input})
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), "ENCODE", gjson.Get(string(resp.Data), "task.create.options.windowsNames").String())
+
+	input["options"] = map[string]interface{}{"windowsNames": "RENAME"}
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input})
+	assert.NotEmpty(s.T(), resp.Errors)
+}
+
+// TestTaskMutation_CreateWithPreserveMetadata tests that the preserveMetadata option is stored and returned.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithPreserveMetadata() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					options { preserveMetadata }
+				}
+			}
+		}
+	`
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "task-with-metadata",
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+			"connectionId": connID.String(),
+			"remotePath":   "/remote",
+			"direction":    "UPLOAD",
+			"options":      map[string]interface{}{"preserveMetadata": true},
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.True(s.T(), gjson.Get(string(resp.Data), "task.create.options.preserveMetadata").Bool())
+}
+
+// TestTaskMutation_CreateWithHooks tests that TaskMutation.create only accepts hook commands allowlisted by the admin.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithHooks() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					options {
+						preHook { command args }
+						postHook { command }
+					}
+				}
+			}
+		}
+	`
+	input := map[string]interface{}{
+		"name":         "task-with-hooks",
+		"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+		"connectionId": connID.String(),
+		"remotePath":   "/remote",
+		"direction":    "UPLOAD",
+		"options": map[string]interface{}{
+			"preHook":  map[string]interface{}{"command": "/usr/local/bin/mount-backup", "args": []interface{}{"--disk", "usb"}},
+			"postHook": map[string]interface{}{"command": "/usr/local/bin/notify"},
+		},
+	}
+	fieldCodes := func(resp *GraphQLResponse) map[string]interface{} {
+		require.Len(s.T(), resp.Errors, 1)
+		fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+		require.True(s.T(), ok)
+		codes := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			field := f.(map[string]interface{})
+			codes[field["field"].(string)] = field["code"]
+		}
+		return codes
+	}
+
+	// Hooks are disabled until executables are allowlisted
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input})
+	assert.Equal(s.T(), map[string]interface{}{
+		"options.preHook.command":  i18n.ErrHooksDisabled,
+		"options.postHook.command": i18n.ErrHooksDisabled,
+	}, fieldCodes(resp))
+
+	s.Env.Deps.SyncEngine.SetHookOptions(hooks.Options{AllowedCommands: []string{"/usr/local/bin/mount-backup"}})
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input})
+	assert.Equal(s.T(), map[string]interface{}{
+		"options.postHook.command": i18n.ErrHookNotAllowed,
+	}, fieldCodes(resp))
+
+	s.Env.Deps.SyncEngine.SetHookOptions(hooks.Options{AllowedCommands: []string{"/usr/local/bin/mount-backup", "/usr/local/bin/notify"}})
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{"input": input})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	assert.Equal(s.T(), "/usr/local/bin/mount-backup", gjson.Get(data, "task.create.options.preHook.command").String())
+	assert.Equal(s.T(), `["--disk","usb"]`, gjson.Get(data, "task.create.options.preHook.args").Raw)
+	assert.Equal(s.T(), "/usr/local/bin/notify", gjson.Get(data, "task.create.options.postHook.command").String())
+}
+
+// TestTaskMutation_CreateVerifyRemotePath tests the verifyRemotePath and createRemotePath flags of TaskMutation.create.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateVerifyRemotePath() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	remotePath := filepath.Join(s.Env.LocalDir, "remote", "typo")
+
+	mutation := `
+		mutation($input: CreateTaskInput!, $verify: Boolean, $create: Boolean) {
+			task {
+				create(input: $input, verifyRemotePath: $verify, createRemotePath: $create) {
+					id
+				}
+			}
+		}
+	`
+	input := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":         name,
+			"sourcePath":   s.Env.SourcePath(s.T(), "local"),
+			"connectionId": connID.String(),
+			"remotePath":   remotePath,
+			"direction":    "UPLOAD",
+		}
+	}
+
+	// A missing remote path is reported on the remotePath field
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input":  input("verify-task"),
+		"verify": true,
+	})
+	require.Len(s.T(), resp.Errors, 1)
+	fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+	require.True(s.T(), ok)
+	require.Len(s.T(), fields, 1)
+	assert.Equal(s.T(), "remotePath", fields[0].(map[string]interface{})["field"])
+	assert.Equal(s.T(), i18n.ErrRemotePathNotExist, fields[0].(map[string]interface{})["code"])
+	assert.NoDirExists(s.T(), remotePath)
+
+	// createRemotePath creates it instead
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input":  input("create-task"),
+		"create": true,
+	})
+	require.Empty(s.T(), resp.Errors)
+	assert.DirExists(s.T(), remotePath)
+
+	// Verification passes once the path exists
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input":  input("verify-task"),
+		"verify": true,
+	})
+	require.Empty(s.T(), resp.Errors)
+}
+
+// TestTaskMutation_BackupTask tests creating a backup task, listing its snapshots and restoring one.
+func (s *TaskResolverTestSuite) TestTaskMutation_BackupTask() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	sourcePath := s.Env.SourcePath(s.T(), "backup-source")
+	require.NoError(s.T(), os.WriteFile(filepath.Join(sourcePath, "file.txt"), []byte("backup"), 0o644))
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					engine
+					snapshots { id }
+				}
+			}
+		}
+	`
+
+	// Backups only upload and don't accept negative retention rules
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "invalid-backup",
+			"sourcePath":   sourcePath,
+			"connectionId": connID.String(),
+			"remotePath":   filepath.Join(s.Env.LocalDir, "repo"),
+			"direction":    "DOWNLOAD",
+			"engine":       ports.BackupSyncEngine,
+			"options":      map[string]interface{}{"backupKeepLast": -1},
+		},
+	})
+	require.Len(s.T(), resp.Errors, 1)
+	fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+	require.True(s.T(), ok)
+	codes := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		field := f.(map[string]interface{})
+		codes[field["field"].(string)] = field["code"]
+	}
+	assert.Equal(s.T(), map[string]interface{}{
+		"direction":              i18n.ErrBackupDirectionInvalid,
+		"options.backupKeepLast": i18n.ErrBackupRetentionNegative,
+	}, codes)
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":         "backup",
+			"sourcePath":   sourcePath,
+			"connectionId": connID.String(),
+			"remotePath":   filepath.Join(s.Env.LocalDir, "repo"),
+			"direction":    "UPLOAD",
+			"engine":       ports.BackupSyncEngine,
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), ports.BackupSyncEngine, gjson.Get(data, "task.create.engine").String())
+	assert.Empty(s.T(), gjson.Get(data, "task.create.snapshots").Array())
+	taskID := uuid.MustParse(gjson.Get(data, "task.create.id").String())
+
+	entTask, err := s.Env.TaskService.GetTaskWithConnection(context.Background(), taskID)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.Env.Deps.BackupEngine.RunTask(context.Background(), entTask, model.JobTriggerManual))
+
+	query := `
+		query($id: ID!) {
+			task {
+				get(id: $id) {
+					snapshots { id fileCount totalBytes }
+				}
+			}
+		}
+	`
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), query, map[string]interface{}{"id": taskID.String()})
+	require.Empty(s.T(), resp.Errors)
+	snapshots := gjson.Get(string(resp.Data), "task.get.snapshots").Array()
+	require.Len(s.T(), snapshots, 1)
+	assert.Equal(s.T(), int64(1), snapshots[0].Get("fileCount").Int())
+	assert.Equal(s.T(), int64(6), snapshots[0].Get("totalBytes").Int())
+
+	restore := `
+		mutation($taskId: ID!, $snapshotId: String!, $targetPath: String!) {
+			task {
+				restoreSnapshot(taskId: $taskId, snapshotId: $snapshotId, targetPath: $targetPath) {
+					snapshotId
+					restoredFiles
+					restoredBytes
+					skippedFiles
+				}
+			}
+		}
+	`
+	targetPath := filepath.Join(s.Env.LocalDir, "restored")
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), restore, map[string]interface{}{
+		"taskId":     taskID.String(),
+		"snapshotId": snapshots[0].Get("id").String(),
+		"targetPath": targetPath,
+	})
+	require.Empty(s.T(), resp.Errors)
+	data = string(resp.Data)
+	assert.Equal(s.T(), snapshots[0].Get("id").String(), gjson.Get(data, "task.restoreSnapshot.snapshotId").String())
+	assert.Equal(s.T(), int64(1), gjson.Get(data, "task.restoreSnapshot.restoredFiles").Int())
+	assert.Equal(s.T(), int64(6), gjson.Get(data, "task.restoreSnapshot.restoredBytes").Int())
+	content, err := os.ReadFile(filepath.Join(targetPath, "file.txt"))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "backup", string(content))
+
+	// Unknown snapshots are reported as not found
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), restore, map[string]interface{}{
+		"taskId":     taskID.String(),
+		"snapshotId": "20000101T000000.000Z",
+		"targetPath": targetPath,
+	})
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrSnapshotNotFound, resp.Errors[0].Extensions["code"])
+}
+
+// TestTaskMutation_CreateWithPaths tests creating a task with additional paths and their validation.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithPaths() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	sourcePath := s.Env.SourcePath(s.T(), "paths-source")
+	photosPath := s.Env.SourcePath(s.T(), "paths-photos")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					options {
+						paths {
+							sourcePath
+							remoteSubpath
+						}
+						stopOnPathError
+					}
+				}
+			}
+		}
+	`
+	create := func(direction string, paths []interface{}) *GraphQLResponse {
+		return s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":         "paths-task-" + direction,
+				"sourcePath":   sourcePath,
+				"connectionId": connID.String(),
+				"remotePath":   "/remote",
+				"direction":    direction,
+				"options":      map[string]interface{}{"paths": paths, "stopOnPathError": true},
+			},
+		})
+	}
+	fieldCodes := func(resp *GraphQLResponse) map[string]interface{} {
+		require.Len(s.T(), resp.Errors, 1)
+		fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+		require.True(s.T(), ok)
+		codes := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			field := f.(map[string]interface{})
+			codes[field["field"].(string)] = field["code"]
+		}
+		return codes
+	}
+
+	// Bidirectional tasks don't support additional paths
+	resp := create("BIDIRECTIONAL", []interface{}{map[string]interface{}{"sourcePath": photosPath, "remoteSubpath": "photos"}})
+	assert.Equal(s.T(), map[string]interface{}{"options.paths": i18n.ErrTaskPathsUnsupported}, fieldCodes(resp))
+
+	resp = create("UPLOAD", []interface{}{
+		map[string]interface{}{"sourcePath": "relative", "remoteSubpath": "photos"},
+		map[string]interface{}{"sourcePath": photosPath, "remoteSubpath": "../outside"},
+		map[string]interface{}{"sourcePath": photosPath, "remoteSubpath": "photos/2024"},
+	})
+	assert.Equal(s.T(), map[string]interface{}{
+		"options.paths.0.sourcePath":    i18n.ErrPathNotAbsolute,
+		"options.paths.1.remoteSubpath": i18n.ErrTaskPathSubpathInvalid,
+		"options.paths.2.remoteSubpath": i18n.ErrTaskPathSubpathOverlap,
+	}, fieldCodes(resp))
+
+	resp = create("UPLOAD", []interface{}{map[string]interface{}{"sourcePath": photosPath + "/", "remoteSubpath": "/media/photos/"}})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), photosPath, gjson.Get(data, "task.create.options.paths.0.sourcePath").String())
+	assert.Equal(s.T(), "media/photos", gjson.Get(data, "task.create.options.paths.0.remoteSubpath").String())
+	assert.True(s.T(), gjson.Get(data, "task.create.options.stopOnPathError").Bool())
+}
+
+// TestTaskMutation_CreateWithMirrors tests creating a task with mirror targets and their validation.
+func (s *TaskResolverTestSuite) TestTaskMutation_CreateWithMirrors() {
+	connID := s.Env.CreateTestConnection(s.T(), "test-conn")
+	mirrorID := s.Env.CreateTestConnection(s.T(), "mirror-conn")
+	sourcePath := s.Env.SourcePath(s.T(), "mirrors-source")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					options {
+						mirrors {
+							connectionId
+							remotePath
+						}
+					}
+				}
+			}
+		}
+	`
+	create := func(direction string, mirrors []interface{}) *GraphQLResponse {
+		return s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":         "mirrors-task-" + direction,
+				"sourcePath":   sourcePath,
+				"connectionId": connID.String(),
+				"remotePath":   "/backup",
+				"direction":    direction,
+				"options":      map[string]interface{}{"mirrors": mirrors},
+			},
+		})
+	}
+	fieldCodes := func(resp *GraphQLResponse) map[string]interface{} {
+		require.Len(s.T(), resp.Errors, 1)
+		fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+		require.True(s.T(), ok)
+		codes := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			field := f.(map[string]interface{})
+			codes[field["field"].(string)] = field["code"]
+		}
+		return codes
+	}
+
+	// Only upload tasks support mirrors
+	resp := create("DOWNLOAD", []interface{}{map[string]interface{}{"connectionId": mirrorID.String(), "remotePath": "backup"}})
+	assert.Equal(s.T(), map[string]interface{}{"options.mirrors": i18n.ErrTaskMirrorsUnsupported}, fieldCodes(resp))
+
+	resp = create("UPLOAD", []interface{}{
+		map[string]interface{}{"connectionId": uuid.New().String(), "remotePath": "backup"},
+		map[string]interface{}{"connectionId": connID.String(), "remotePath": "backup/"},
+		map[string]interface{}{"connectionId": mirrorID.String(), "remotePath": ""},
+	})
+	assert.Equal(s.T(), map[string]interface{}{
+		"options.mirrors.0.connectionId": i18n.ErrConnectionNotFound,
+		"options.mirrors.1.remotePath":   i18n.ErrTaskMirrorDuplicate,
+		"options.mirrors.2.remotePath":   i18n.ErrMissingParameter,
+	}, fieldCodes(resp))
+
+	resp = create("UPLOAD", []interface{}{
+		map[string]interface{}{"connectionId": mirrorID.String(), "remotePath": "backup"},
+		map[string]interface{}{"connectionId": connID.String(), "remotePath": "backup-copy"},
+	})
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), mirrorID.String(), gjson.Get(data, "task.create.options.mirrors.0.connectionId").String())
+	assert.Equal(s.T(), "backup-copy", gjson.Get(data, "task.create.options.mirrors.1.remotePath").String())
+}
+
+// TestTaskMutation_MediaConnection tests the validation and warnings of tasks on media-only connections.
+func (s *TaskResolverTestSuite) TestTaskMutation_MediaConnection() {
+	conn, err := s.Env.ConnectionService.CreateConnection(context.Background(), "photos", "gphotos", map[string]string{
+		"token": `{"access_token":"token"}`,
+	})
+	require.NoError(s.T(), err)
+	localID := s.Env.CreateTestConnection(s.T(), "test-conn")
+
+	mutation := `
+		mutation($input: CreateTaskInput!) {
+			task {
+				create(input: $input) {
+					id
+					mediaWarnings
+				}
+			}
+		}
+	`
+	input := func(name string, connectionID uuid.UUID, direction string) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":         name,
+				"sourcePath":   s.T().TempDir(),
+				"connectionId": connectionID.String(),
+				"remotePath":   "album/Holidays",
+				"direction":    direction,
+			},
+		}
+	}
+
+	// Media can't be deleted or modified, so bidirectional syncs are rejected
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, input("photos-bisync", conn.ID, "BIDIRECTIONAL"))
+	require.Len(s.T(), resp.Errors, 1)
+	fields, ok := resp.Errors[0].Extensions["fields"].([]interface{})
+	require.True(s.T(), ok)
+	require.Len(s.T(), fields, 1)
+	assert.Equal(s.T(), "direction", fields[0].(map[string]interface{})["field"])
+	assert.Equal(s.T(), i18n.ErrMediaBidirectional, fields[0].(map[string]interface{})["code"])
+
+	// Downloads warn about their limitations
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, input("photos-download", conn.ID, "DOWNLOAD"))
+	require.Empty(s.T(), resp.Errors)
+	data := string(resp.Data)
+	assert.Equal(s.T(), `["DOWNLOADS_NOT_ORIGINALS","ONLY_UPLOADED_MEDIA"]`, gjson.Get(data, "task.create.mediaWarnings").Raw)
+	taskID := gjson.Get(data, "task.create.id").String()
+
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), mutation, input("local-download", localID, "DOWNLOAD"))
+	require.Empty(s.T(), resp.Errors)
+	assert.Equal(s.T(), `[]`, gjson.Get(string(resp.Data), "task.create.mediaWarnings").Raw)
+
+	// Changing the direction of an existing task is validated too
+	resp = s.Env.ExecuteGraphQLWithVars(s.T(), `
+		mutation($id: ID!, $input: UpdateTaskInput!) {
+			task {
+				update(id: $id, input: $input) { id }
+			}
+		}
+	`, map[string]interface{}{
+		"id":    taskID,
+		"input": map[string]interface{}{"direction": "BIDIRECTIONAL"},
+	})
+	require.Len(s.T(), resp.Errors, 1)
+	assert.Equal(s.T(), i18n.ErrValidationFailed, resp.Errors[0].Extensions["code"])
+}
@@ -0,0 +1,47 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.85
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/generated"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// Utility is the resolver for the utility field.
+func (r *mutationResolver) Utility(ctx context.Context) (*model.UtilityMutation, error) {
+	return &model.UtilityMutation{}, nil
+}
+
+// FindDuplicates is the resolver for the findDuplicates field.
+func (r *utilityMutationResolver) FindDuplicates(ctx context.Context, obj *model.UtilityMutation, connectionID uuid.UUID, input model.FindDuplicatesInput) (*model.DuplicateReport, error) {
+	conn, err := r.deps.ConnectionService.GetConnectionByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := rclone.GetFs(ctx, conn.Name, input.Path)
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrPathNotExist).WithCause(err)
+	}
+
+	report, err := rclone.FindDuplicates(ctx, f, duplicateOptions(input))
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrFailedToListRemotes).WithCause(err)
+	}
+	return report, nil
+}
+
+// UtilityMutation returns generated.UtilityMutationResolver implementation.
+func (r *Resolver) UtilityMutation() generated.UtilityMutationResolver {
+	return &utilityMutationResolver{r}
+}
+
+type utilityMutationResolver struct{ *Resolver }
@@ -0,0 +1,78 @@
+// Package resolver provides GraphQL resolver tests.
+package resolver_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+)
+
+// UtilityResolverTestSuite tests UtilityMutation resolvers.
+type UtilityResolverTestSuite struct {
+	ResolverTestSuite
+}
+
+func TestUtilityResolverSuite(t *testing.T) {
+	suite.Run(t, new(UtilityResolverTestSuite))
+}
+
+// TestUtilityMutation_FindDuplicates tests UtilityMutation.findDuplicates resolver.
+func (s *UtilityResolverTestSuite) TestUtilityMutation_FindDuplicates() {
+	connID := s.Env.CreateTestConnection(s.T(), "dup-conn")
+
+	dir := s.T().TempDir()
+	for _, name := range []string{"a.jpg", "nested/a-copy.jpg"} {
+		p := filepath.Join(dir, name)
+		require.NoError(s.T(), os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(s.T(), os.WriteFile(p, []byte("duplicate"), 0644))
+	}
+	require.NoError(s.T(), os.WriteFile(filepath.Join(dir, "b.jpg"), []byte("unique"), 0644))
+
+	mutation := `
+		mutation($connectionId: ID!, $input: FindDuplicatesInput!) {
+			utility {
+				findDuplicates(connectionId: $connectionId, input: $input) {
+					mode
+					scannedFiles
+					duplicateFiles
+					reclaimableBytes
+					deletedFiles
+					groups {
+						size
+						files {
+							path
+							keep
+							deleted
+						}
+					}
+				}
+			}
+		}
+	`
+
+	resp := s.Env.ExecuteGraphQLWithVars(s.T(), mutation, map[string]interface{}{
+		"connectionId": connID.String(),
+		"input": map[string]interface{}{
+			"path": dir,
+			"keep": "SHORTEST_PATH",
+		},
+	})
+	require.Empty(s.T(), resp.Errors)
+
+	data := string(resp.Data)
+	report := gjson.Get(data, "utility.findDuplicates")
+	assert.Equal(s.T(), "HASH", report.Get("mode").String())
+	assert.Equal(s.T(), int64(3), report.Get("scannedFiles").Int())
+	assert.Equal(s.T(), int64(1), report.Get("duplicateFiles").Int())
+	assert.Equal(s.T(), int64(len("duplicate")), report.Get("reclaimableBytes").Int())
+	// dryRun defaults to true
+	assert.Equal(s.T(), int64(0), report.Get("deletedFiles").Int())
+	assert.Equal(s.T(), "a.jpg", report.Get("groups.0.files.0.path").String())
+	assert.False(s.T(), report.Get("groups.0.files.1.keep").Bool())
+	assert.FileExists(s.T(), filepath.Join(dir, "nested/a-copy.jpg"))
+}
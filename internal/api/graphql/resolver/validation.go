@@ -0,0 +1,616 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/hooks"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/scheduler"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// Valid ranges of the numeric task options.
+const (
+	minTransfers = 1
+	maxTransfers = 64
+	minShards    = 1
+	maxShards    = 16
+	minJobDays   = 1
+	maxJobDays   = 366
+)
+
+// validateCreateTaskInput checks every field of a CreateTaskInput and reports all invalid fields at once.
+func (r *Resolver) validateCreateTaskInput(ctx context.Context, input model.CreateTaskInput) error {
+	v := i18n.NewValidationError()
+
+	validateRequired(v, "name", input.Name)
+	validateSourcePath(v, input.SourcePath, input.Direction)
+	if err := r.validateConnectionExists(ctx, v, input.ConnectionID); err != nil {
+		return err
+	}
+	if input.Schedule != nil {
+		validateSchedule(v, *input.Schedule)
+	}
+	validateTaskOptions(v, input.Options)
+	r.validateHooks(v, input.Options)
+	r.validateEngine(v, input.Engine)
+	engine := ports.DefaultSyncEngine
+	if input.Engine != nil {
+		engine = *input.Engine
+	}
+	validateBackupDirection(v, engine, input.Direction)
+	if err := r.validateMediaDirection(ctx, v, input.ConnectionID, input.Direction); err != nil {
+		return err
+	}
+	validateTaskPaths(v, input.Options, input.Direction, engine)
+	if err := r.validateTaskMirrors(ctx, v, input.Options, input.Direction, engine, input.ConnectionID, input.RemotePath); err != nil {
+		return err
+	}
+
+	return v.Err()
+}
+
+// validateUpdateTaskInput checks the fields provided in an UpdateTaskInput against the existing task.
+func (r *Resolver) validateUpdateTaskInput(ctx context.Context, existing *ent.Task, input model.UpdateTaskInput) error {
+	v := i18n.NewValidationError()
+
+	if input.Name != nil {
+		validateRequired(v, "name", *input.Name)
+	}
+	direction := existing.Direction
+	if input.Direction != nil {
+		direction = *input.Direction
+	}
+	if input.SourcePath != nil {
+		validateSourcePath(v, *input.SourcePath, direction)
+	}
+	if input.ConnectionID != nil {
+		if err := r.validateConnectionExists(ctx, v, *input.ConnectionID); err != nil {
+			return err
+		}
+	}
+	if input.Schedule != nil {
+		validateSchedule(v, *input.Schedule)
+	}
+	validateTaskOptions(v, input.Options)
+	r.validateHooks(v, input.Options)
+	r.validateEngine(v, input.Engine)
+	engine := existing.Engine
+	if input.Engine != nil {
+		engine = *input.Engine
+	}
+	validateBackupDirection(v, engine, direction)
+	validateTaskPaths(v, input.Options, direction, engine)
+	connectionID, remotePath := existing.ConnectionID, existing.RemotePath
+	if input.ConnectionID != nil {
+		connectionID = *input.ConnectionID
+	}
+	if input.RemotePath != nil {
+		remotePath = *input.RemotePath
+	}
+	if err := r.validateMediaDirection(ctx, v, connectionID, direction); err != nil {
+		return err
+	}
+	if err := r.validateTaskMirrors(ctx, v, input.Options, direction, engine, connectionID, remotePath); err != nil {
+		return err
+	}
+
+	return v.Err()
+}
+
+// validateMigratedTask checks a task of connection.migrateTasks against the target connection,
+// since its direction and mirrors were only validated against the source connection.
+func (r *Resolver) validateMigratedTask(ctx context.Context, t *ent.Task, to uuid.UUID) error {
+	v := i18n.NewValidationError()
+
+	if err := r.validateMediaDirection(ctx, v, to, t.Direction); err != nil {
+		return err
+	}
+	if t.Options != nil && len(t.Options.Mirrors) > 0 {
+		mirrors := make([]*model.TaskMirrorInput, len(t.Options.Mirrors))
+		for i, m := range t.Options.Mirrors {
+			mirrors[i] = &model.TaskMirrorInput{ConnectionID: m.ConnectionID, RemotePath: m.RemotePath}
+		}
+		options := &model.TaskSyncOptionsInput{Mirrors: mirrors}
+		if err := r.validateTaskMirrors(ctx, v, options, t.Direction, t.Engine, to, t.RemotePath); err != nil {
+			return err
+		}
+	}
+
+	return v.Err()
+}
+
+// validateCreateConnectionInput checks every field of a CreateConnectionInput.
+func (r *Resolver) validateCreateConnectionInput(ctx context.Context, input model.CreateConnectionInput) error {
+	v := i18n.NewValidationError()
+
+	if err := r.validateConnectionName(ctx, v, input.Name); err != nil {
+		return err
+	}
+	if validateRequired(v, "type", string(input.Type)) {
+		if _, err := rclone.GetProviderOptions(string(input.Type)); err != nil {
+			v.Add("type", i18n.ErrProviderNotFound, nil)
+		}
+	}
+	if input.Preset != nil {
+		validateConnectionPreset(v, *input.Preset, string(input.Type), input.Config)
+	}
+	validateConnectionPacing(v, string(input.Type), input.PathTpsLimit, input.PathTpsBurst)
+	validateTransferCap(v, input.MonthlyTransferCap)
+	validateConnectionDisplay(v, input.DisplayName, input.Color, input.Icon)
+
+	return v.Err()
+}
+
+// validateUpdateConnectionInput checks the fields provided in an UpdateConnectionInput against the existing connection.
+func (r *Resolver) validateUpdateConnectionInput(ctx context.Context, existing *ent.Connection, input model.UpdateConnectionInput) error {
+	v := i18n.NewValidationError()
+
+	if input.Name != nil && *input.Name != existing.Name {
+		if err := r.validateConnectionName(ctx, v, *input.Name); err != nil {
+			return err
+		}
+	}
+	validateConnectionPacing(v, string(existing.Type), input.PathTpsLimit, input.PathTpsBurst)
+	validateTransferCap(v, input.MonthlyTransferCap)
+	validateConnectionDisplay(v, input.DisplayName, input.Color, input.Icon)
+
+	return v.Err()
+}
+
+// checkRemotePath verifies that the remote path of a task, resolved against the connection's base path,
+// exists on the connection. A missing path is created when mkdir is set, otherwise it is reported on the remotePath field.
+func (r *Resolver) checkRemotePath(ctx context.Context, connectionID uuid.UUID, remotePath string, mkdir bool) error {
+	conn, err := r.deps.ConnectionService.GetConnectionByID(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+	resolved := rclone.ResolveRemotePath(conn.BasePath, remotePath)
+
+	exists, err := rclone.RemoteDirExists(ctx, conn.Name, resolved)
+	if i18nErr, ok := i18n.IsI18nError(err); ok && i18nErr.MsgID == i18n.ErrPathNotDirectory {
+		v := i18n.NewValidationError()
+		v.Add("remotePath", i18n.ErrPathNotDirectory, nil)
+		return v.Err()
+	}
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	if mkdir {
+		return rclone.MakeRemoteDir(ctx, conn.Name, resolved)
+	}
+	v := i18n.NewValidationError()
+	v.Add("remotePath", i18n.ErrRemotePathNotExist, map[string]interface{}{"Path": resolved})
+	return v.Err()
+}
+
+// validateConnectionExists reports a task referencing a connection that does not exist.
+func (r *Resolver) validateConnectionExists(ctx context.Context, v *i18n.ValidationError, id uuid.UUID) error {
+	exists, err := r.deps.ConnectionService.ConnectionExists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		v.Add("connectionId", i18n.ErrConnectionNotFound, nil)
+	}
+	return nil
+}
+
+// validateMediaDirection reports a bidirectional task on a media backend, which can't delete or modify media.
+// A connection that does not exist is reported by validateConnectionExists.
+func (r *Resolver) validateMediaDirection(ctx context.Context, v *i18n.ValidationError, connectionID uuid.UUID, direction model.SyncDirection) error {
+	if direction != model.SyncDirectionBidirectional {
+		return nil
+	}
+	exists, err := r.deps.ConnectionService.ConnectionExists(ctx, connectionID)
+	if err != nil || !exists {
+		return err
+	}
+	conn, err := r.deps.ConnectionService.GetConnectionByID(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+	if rclone.IsMediaBackend(string(conn.Type)) {
+		v.Add("direction", i18n.ErrMediaBidirectional, map[string]interface{}{"Type": conn.Type})
+	}
+	return nil
+}
+
+// validateConnectionName reports an empty, malformed or already used connection name.
+func (r *Resolver) validateConnectionName(ctx context.Context, v *i18n.ValidationError, name string) error {
+	if !validateRequired(v, "name", name) {
+		return nil
+	}
+	if err := services.ValidateConnectionName(name); err != nil {
+		v.Add("name", i18n.ErrConnectionNameInvalid, map[string]interface{}{"Reason": err.Error()})
+		return nil
+	}
+	exists, err := r.deps.ConnectionService.ConnectionNameExists(ctx, name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		v.Add("name", i18n.ErrAlreadyExists, nil)
+	}
+	return nil
+}
+
+// validateEngine reports an engine that is not registered in the runner.
+func (r *Resolver) validateEngine(v *i18n.ValidationError, engine *string) {
+	if engine == nil {
+		return
+	}
+	if !slices.Contains(r.deps.Runner.Engines(), *engine) {
+		v.Add("engine", i18n.ErrEngineNotFound, map[string]interface{}{"Engine": *engine})
+	}
+}
+
+// validateHooks reports hook commands that are not allowlisted by the admin.
+func (r *Resolver) validateHooks(v *i18n.ValidationError, options *model.TaskSyncOptionsInput) {
+	if options == nil {
+		return
+	}
+	for _, hook := range []struct {
+		field string
+		input *model.TaskHookInput
+	}{
+		{"options.preHook.command", options.PreHook},
+		{"options.postHook.command", options.PostHook},
+	} {
+		if hook.input == nil {
+			continue
+		}
+		switch err := r.deps.SyncEngine.CheckHookCommand(hook.input.Command); {
+		case errors.Is(err, hooks.ErrHooksDisabled):
+			v.Add(hook.field, i18n.ErrHooksDisabled, nil)
+		case err != nil:
+			v.Add(hook.field, i18n.ErrHookNotAllowed, map[string]interface{}{"Command": hook.input.Command})
+		}
+	}
+}
+
+// validateConnectionPreset reports an unknown preset, a preset for another provider,
+// and required fields of the preset that are still empty once it is applied to config.
+func validateConnectionPreset(v *i18n.ValidationError, name, providerType string, config map[string]string) {
+	preset, ok := rclone.GetConnectionPreset(name)
+	if !ok {
+		v.Add("preset", i18n.ErrPresetNotFound, nil)
+		return
+	}
+	if preset.Type != providerType {
+		v.Add("type", i18n.ErrPresetTypeMismatch, map[string]interface{}{"Type": preset.Type})
+		return
+	}
+	for _, field := range preset.MissingFields(preset.Apply(config)) {
+		v.Add("config."+field, i18n.ErrMissingParameter, nil)
+	}
+}
+
+// maxDisplayNameLength is the maximum length of the display name of a connection, in characters.
+const maxDisplayNameLength = 64
+
+var (
+	colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	iconPattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+)
+
+// validateConnectionDisplay checks the display name, color and icon of a connection. Empty strings clear them.
+func validateConnectionDisplay(v *i18n.ValidationError, displayName, color, icon *string) {
+	if displayName != nil && utf8.RuneCountInString(strings.TrimSpace(*displayName)) > maxDisplayNameLength {
+		v.Add("displayName", i18n.ErrDisplayNameTooLong, map[string]interface{}{"Max": maxDisplayNameLength})
+	}
+	if color != nil && *color != "" && !colorPattern.MatchString(*color) {
+		v.Add("color", i18n.ErrColorInvalid, map[string]interface{}{"Color": *color})
+	}
+	if icon != nil && *icon != "" && !iconPattern.MatchString(*icon) {
+		v.Add("icon", i18n.ErrIconInvalid, map[string]interface{}{"Icon": *icon})
+	}
+}
+
+// validateConnectionPacing reports a negative API pacing, or one the provider doesn't pace its API calls with.
+// Zero clears the pacing and is always valid.
+func validateConnectionPacing(v *i18n.ValidationError, providerType string, pathTpsLimit *float64, pathTpsBurst *int) {
+	rate, burst := rclone.PacingSupport(providerType)
+	if pathTpsLimit != nil {
+		switch {
+		case *pathTpsLimit < 0:
+			v.Add("pathTpsLimit", i18n.ErrPacingNegative, nil)
+		case *pathTpsLimit > 0 && !rate:
+			v.Add("pathTpsLimit", i18n.ErrPacingNotSupported, map[string]interface{}{"Type": providerType})
+		}
+	}
+	if pathTpsBurst != nil {
+		switch {
+		case *pathTpsBurst < 0:
+			v.Add("pathTpsBurst", i18n.ErrPacingNegative, nil)
+		case *pathTpsBurst > 0 && !burst:
+			v.Add("pathTpsBurst", i18n.ErrPacingNotSupported, map[string]interface{}{"Type": providerType})
+		}
+	}
+}
+
+// validateTransferCap reports a negative monthly transfer cap. Zero clears the cap and is always valid.
+func validateTransferCap(v *i18n.ValidationError, transferCap *int64) {
+	if transferCap != nil && *transferCap < 0 {
+		v.Add("monthlyTransferCap", i18n.ErrTransferCapNegative, nil)
+	}
+}
+
+// maxShareTokenDays is how far ahead a share token may expire, in days.
+const maxShareTokenDays = 90
+
+// validateCreateShareTokenInput checks a CreateShareTokenInput: a TASK token needs an existing task,
+// a DOWNLOAD token an existing connection and a file path.
+func (r *Resolver) validateCreateShareTokenInput(ctx context.Context, input model.CreateShareTokenInput, now time.Time) error {
+	v := i18n.NewValidationError()
+
+	validateRequired(v, "name", input.Name)
+	if !input.ExpiresAt.After(now) || input.ExpiresAt.After(now.AddDate(0, 0, maxShareTokenDays)) {
+		v.Add("expiresAt", i18n.ErrShareExpiryInvalid, map[string]interface{}{"Max": maxShareTokenDays})
+	}
+
+	switch input.Scope {
+	case model.ShareScopeTask:
+		if input.TaskID == nil {
+			v.Add("taskId", i18n.ErrMissingParameter, nil)
+			break
+		}
+		if _, err := r.deps.TaskService.GetTask(ctx, *input.TaskID); err != nil {
+			if !errors.Is(err, errs.ErrNotFound) {
+				return err
+			}
+			v.Add("taskId", i18n.ErrTaskNotFound, nil)
+		}
+	case model.ShareScopeDownload:
+		if input.ConnectionID == nil {
+			v.Add("connectionId", i18n.ErrMissingParameter, nil)
+		} else if err := r.validateConnectionExists(ctx, v, *input.ConnectionID); err != nil {
+			return err
+		}
+		if input.Path == nil || cleanSharePath(*input.Path) == "" {
+			v.Add("path", i18n.ErrMissingParameter, nil)
+		}
+	}
+
+	return v.Err()
+}
+
+// cleanSharePath normalizes the remote file path of a share token like the file download route does,
+// it is empty if the path names no file.
+func cleanSharePath(p string) string {
+	if p == "" {
+		return ""
+	}
+	p = path.Clean(p)
+	if p == "." || p == "/" {
+		return ""
+	}
+	return p
+}
+
+// validateJobDays reports a number of days of job history outside the range that can be grouped by day.
+func validateJobDays(days int) error {
+	v := i18n.NewValidationError()
+	if days < minJobDays || days > maxJobDays {
+		v.Add("days", i18n.ErrDaysOutOfRange, map[string]interface{}{"Value": days})
+	}
+	return v.Err()
+}
+
+// validateBackupDirection reports a backup task that doesn't upload, since snapshots are taken of the local side.
+func validateBackupDirection(v *i18n.ValidationError, engine string, direction model.SyncDirection) {
+	if engine == ports.BackupSyncEngine && direction != model.SyncDirectionUpload {
+		v.Add("direction", i18n.ErrBackupDirectionInvalid, nil)
+	}
+}
+
+// validateRequired reports an empty value and returns whether the value is present.
+func validateRequired(v *i18n.ValidationError, field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		v.Add(field, i18n.ErrMissingParameter, nil)
+		return false
+	}
+	return true
+}
+
+// validateSourcePath checks the local path of a task.
+// Downloads create the local directory on their first run, every other direction needs it to exist.
+func validateSourcePath(v *i18n.ValidationError, sourcePath string, direction model.SyncDirection) {
+	if !validateRequired(v, "sourcePath", sourcePath) {
+		return
+	}
+	validateLocalDir(v, "sourcePath", sourcePath, direction)
+}
+
+// validateLocalDir checks a local directory synced in direction, reporting it on field.
+func validateLocalDir(v *i18n.ValidationError, field, dir string, direction model.SyncDirection) {
+	info, err := os.Stat(dir)
+	switch {
+	case err != nil && direction != model.SyncDirectionDownload:
+		v.Add(field, i18n.ErrPathNotExist, nil)
+	case err == nil && !info.IsDir():
+		v.Add(field, i18n.ErrPathNotDirectory, nil)
+	}
+}
+
+// validateTaskPaths checks the additional paths of a task, which are only synced by one-way tasks of the
+// default engine without shards. Remote subpaths must be relative paths below the task's remote path that
+// don't overlap, since the sync of one would delete the files of the other.
+func validateTaskPaths(v *i18n.ValidationError, options *model.TaskSyncOptionsInput, direction model.SyncDirection, engine string) {
+	if options == nil || len(options.Paths) == 0 {
+		return
+	}
+	if direction == model.SyncDirectionBidirectional || engine != ports.DefaultSyncEngine || (options.Shards != nil && *options.Shards > 1) {
+		v.Add("options.paths", i18n.ErrTaskPathsUnsupported, nil)
+		return
+	}
+
+	var subpaths []string
+	for i, p := range options.Paths {
+		field := fmt.Sprintf("options.paths.%d", i)
+		if !filepath.IsAbs(p.SourcePath) {
+			v.Add(field+".sourcePath", i18n.ErrPathNotAbsolute, nil)
+		} else {
+			validateLocalDir(v, field+".sourcePath", p.SourcePath, direction)
+		}
+
+		subpath := strings.Trim(p.RemoteSubpath, "/")
+		if path.Clean(subpath) == "." || slices.Contains(strings.Split(subpath, "/"), "..") {
+			v.Add(field+".remoteSubpath", i18n.ErrTaskPathSubpathInvalid, map[string]interface{}{"Path": p.RemoteSubpath})
+			continue
+		}
+		subpath = path.Clean(subpath)
+		for _, other := range subpaths {
+			if isWithinSubpath(subpath, other) || isWithinSubpath(other, subpath) {
+				v.Add(field+".remoteSubpath", i18n.ErrTaskPathSubpathOverlap, map[string]interface{}{"Path": subpath, "Other": other})
+				break
+			}
+		}
+		subpaths = append(subpaths, subpath)
+	}
+}
+
+// validateTaskMirrors checks the mirror targets of a task, which are only synced by upload tasks of the default
+// engine. Each mirror must be on an existing connection and differ from the task's own remote path (on the task's
+// connection connectionID) and the other mirrors, since syncing the same destination twice is pointless.
+func (r *Resolver) validateTaskMirrors(ctx context.Context, v *i18n.ValidationError, options *model.TaskSyncOptionsInput, direction model.SyncDirection, engine string, connectionID uuid.UUID, remotePath string) error {
+	if options == nil || len(options.Mirrors) == 0 {
+		return nil
+	}
+	if direction != model.SyncDirectionUpload || engine != ports.DefaultSyncEngine {
+		v.Add("options.mirrors", i18n.ErrTaskMirrorsUnsupported, nil)
+		return nil
+	}
+
+	type target struct {
+		connectionID uuid.UUID
+		remotePath   string
+	}
+	targets := []target{{connectionID, strings.Trim(path.Clean(remotePath), "/")}}
+	for i, m := range options.Mirrors {
+		field := fmt.Sprintf("options.mirrors.%d", i)
+		exists, err := r.deps.ConnectionService.ConnectionExists(ctx, m.ConnectionID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			v.Add(field+".connectionId", i18n.ErrConnectionNotFound, nil)
+		}
+		if !validateRequired(v, field+".remotePath", m.RemotePath) {
+			continue
+		}
+
+		t := target{m.ConnectionID, strings.Trim(path.Clean(m.RemotePath), "/")}
+		if slices.Contains(targets, t) {
+			v.Add(field+".remotePath", i18n.ErrTaskMirrorDuplicate, map[string]interface{}{"Path": m.RemotePath})
+			continue
+		}
+		targets = append(targets, t)
+	}
+	return nil
+}
+
+// isWithinSubpath reports whether the remote subpath p is root or below it.
+func isWithinSubpath(p, root string) bool {
+	return p == root || strings.HasPrefix(p, root+"/")
+}
+
+// validateSchedule checks a cron expression or RRULE schedule.
+func validateSchedule(v *i18n.ValidationError, schedule string) {
+	if err := scheduler.ValidateSchedule(schedule); err != nil {
+		v.Add("schedule", i18n.ErrInvalidSchedule, nil)
+	}
+}
+
+// validateTaskOptions checks the filter rules, ignore patterns, numeric ranges and retention rules of the task options.
+func validateTaskOptions(v *i18n.ValidationError, options *model.TaskSyncOptionsInput) {
+	if options == nil {
+		return
+	}
+
+	for i, rule := range options.Filters {
+		if err := rclone.ValidateFilterRule(rule); err != nil {
+			v.Add(fmt.Sprintf("options.filters.%d", i), i18n.ErrFilterRuleInvalid, map[string]interface{}{
+				"Index":  i + 1,
+				"Rule":   rule,
+				"Reason": err.Error(),
+			})
+		} else if options.Shards != nil && *options.Shards > 1 && rclone.ValidateShardFilterRule(rule) != nil {
+			v.Add(fmt.Sprintf("options.filters.%d", i), i18n.ErrFilterRuleNotShardable, map[string]interface{}{
+				"Index": i + 1,
+				"Rule":  rule,
+			})
+		}
+	}
+	for i, pattern := range options.WatchIgnorePatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			v.Add(fmt.Sprintf("options.watchIgnorePatterns.%d", i), i18n.ErrIgnorePatternInvalid, map[string]interface{}{
+				"Pattern": pattern,
+			})
+		}
+	}
+	for i, dir := range options.WatchExcludeDirs {
+		if clean := path.Clean(dir); dir == "" || path.IsAbs(dir) || clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+			v.Add(fmt.Sprintf("options.watchExcludeDirs.%d", i), i18n.ErrWatchExcludeDirInvalid, map[string]interface{}{
+				"Path": dir,
+			})
+		}
+	}
+
+	if t := options.Transfers; t != nil && (*t < minTransfers || *t > maxTransfers) {
+		v.Add("options.transfers", i18n.ErrTransfersOutOfRange, map[string]interface{}{"Value": *t})
+	}
+	if s := options.Shards; s != nil && (*s < minShards || *s > maxShards) {
+		v.Add("options.shards", i18n.ErrShardsOutOfRange, map[string]interface{}{"Value": *s})
+	}
+	if m := options.MaxDurationMinutes; m != nil && *m < 0 {
+		v.Add("options.maxDurationMinutes", i18n.ErrMaxDurationNegative, map[string]interface{}{"Value": *m})
+	}
+	if c := options.ConfirmDeletesOver; c != nil && *c < 0 {
+		v.Add("options.confirmDeletesOver", i18n.ErrConfirmDeletesNegative, map[string]interface{}{"Value": *c})
+	}
+	for _, rule := range []struct {
+		field string
+		keep  *int
+	}{
+		{"options.backupKeepLast", options.BackupKeepLast},
+		{"options.backupKeepDaily", options.BackupKeepDaily},
+		{"options.backupKeepWeekly", options.BackupKeepWeekly},
+		{"options.backupKeepMonthly", options.BackupKeepMonthly},
+	} {
+		if rule.keep != nil && *rule.keep < 0 {
+			v.Add(rule.field, i18n.ErrBackupRetentionNegative, map[string]interface{}{"Value": *rule.keep})
+		}
+	}
+	for _, rule := range []struct {
+		field string
+		days  *int
+	}{
+		{"options.logRetentionDays", options.LogRetentionDays},
+		{"options.jobRetentionDays", options.JobRetentionDays},
+	} {
+		if rule.days != nil && *rule.days < 0 {
+			v.Add(rule.field, i18n.ErrRetentionDaysNegative, map[string]interface{}{"Value": *rule.days})
+		}
+	}
+}
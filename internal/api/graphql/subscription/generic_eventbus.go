@@ -24,6 +24,7 @@ type GenericEventBus[T any] struct {
 	mu          sync.RWMutex
 	subscribers map[string]*GenericSubscriber[T]
 	bufferSize  int
+	closed      bool
 }
 
 // NewGenericEventBus creates a new generic event bus.
@@ -40,6 +41,7 @@ func NewGenericEventBus[T any](bufferSize int) *GenericEventBus[T] {
 
 // Subscribe creates a new subscription with an optional filter.
 // If filter is nil, all events are delivered to this subscriber.
+// After Close, the returned subscriber's Events channel is already closed.
 func (eb *GenericEventBus[T]) Subscribe(filter func(T) bool) *GenericSubscriber[T] {
 	eb.mu.Lock()
 	defer eb.mu.Unlock()
@@ -49,6 +51,10 @@ func (eb *GenericEventBus[T]) Subscribe(filter func(T) bool) *GenericSubscriber[
 		Filter: filter,
 		Events: make(chan T, eb.bufferSize),
 	}
+	if eb.closed {
+		close(sub.Events)
+		return sub
+	}
 	eb.subscribers[sub.ID] = sub
 	return sub
 }
@@ -68,10 +74,15 @@ func (eb *GenericEventBus[T]) Unsubscribe(subscriberID string) {
 // Publish sends an event to all matching subscribers.
 // Events are filtered by each subscriber's Filter function.
 // Non-blocking: if a subscriber's buffer is full, the event is dropped for that subscriber.
+// Events published after Close are dropped.
 func (eb *GenericEventBus[T]) Publish(event T) {
 	eb.mu.RLock()
 	defer eb.mu.RUnlock()
 
+	if eb.closed {
+		return
+	}
+
 	for _, sub := range eb.subscribers {
 		// Apply filter if present
 		if sub.Filter != nil && !sub.Filter(event) {
@@ -88,6 +99,37 @@ func (eb *GenericEventBus[T]) Publish(event T) {
 	}
 }
 
+// Close stops the bus from accepting events and closes the Events channels of all subscribers,
+// which still receive the events already buffered. Subscribers end on the closed channel, so
+// their goroutines don't outlive the server. Close is safe to call more than once.
+func (eb *GenericEventBus[T]) Close() {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	if eb.closed {
+		return
+	}
+	eb.closed = true
+	for id, sub := range eb.subscribers {
+		close(sub.Events)
+		delete(eb.subscribers, id)
+	}
+}
+
+// HasSubscribers reports whether a subscriber accepts events like probe according to its filter,
+// so publishers can skip building events nobody receives.
+func (eb *GenericEventBus[T]) HasSubscribers(probe T) bool {
+	eb.mu.RLock()
+	defer eb.mu.RUnlock()
+
+	for _, sub := range eb.subscribers {
+		if sub.Filter == nil || sub.Filter(probe) {
+			return true
+		}
+	}
+	return false
+}
+
 // SubscriberCount returns the number of active subscribers.
 func (eb *GenericEventBus[T]) SubscriberCount() int {
 	eb.mu.RLock()
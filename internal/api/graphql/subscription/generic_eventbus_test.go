@@ -168,6 +168,22 @@ func TestGenericEventBus_Publish(t *testing.T) {
 	})
 }
 
+func TestGenericEventBus_HasSubscribers(t *testing.T) {
+	bus := NewGenericEventBus[*TestEvent](10)
+	assert.False(t, bus.HasSubscribers(&TestEvent{Tag: "a"}))
+
+	sub := bus.Subscribe(func(e *TestEvent) bool { return e.Tag == "a" })
+	assert.True(t, bus.HasSubscribers(&TestEvent{Tag: "a"}))
+	assert.False(t, bus.HasSubscribers(&TestEvent{Tag: "b"}), "the filters of subscribers apply")
+
+	all := bus.Subscribe(nil)
+	assert.True(t, bus.HasSubscribers(&TestEvent{Tag: "b"}))
+
+	bus.Unsubscribe(sub.ID)
+	bus.Unsubscribe(all.ID)
+	assert.False(t, bus.HasSubscribers(&TestEvent{Tag: "a"}))
+}
+
 func TestGenericEventBus_Concurrent(t *testing.T) {
 	bus := NewGenericEventBus[*TestEvent](1000)
 
@@ -241,3 +257,71 @@ func TestGenericEventBus_Concurrent(t *testing.T) {
 		assert.LessOrEqual(t, count, totalExpected, "subscriber %d received too many events", i)
 	}
 }
+
+func TestGenericEventBus_Close(t *testing.T) {
+	bus := NewGenericEventBus[*TestEvent](10)
+	sub := bus.Subscribe(nil)
+	bus.Publish(&TestEvent{ID: 1})
+
+	bus.Close()
+	assert.Equal(t, 0, bus.SubscriberCount())
+
+	// Buffered events are still delivered before the channel reports closed
+	event, ok := <-sub.Events
+	require.True(t, ok)
+	assert.Equal(t, 1, event.ID)
+	_, ok = <-sub.Events
+	assert.False(t, ok)
+
+	// Publishing, unsubscribing and closing again after Close don't panic
+	assert.NotPanics(t, func() {
+		bus.Publish(&TestEvent{ID: 2})
+		bus.Unsubscribe(sub.ID)
+		bus.Close()
+	})
+
+	late := bus.Subscribe(nil)
+	_, ok = <-late.Events
+	assert.False(t, ok, "subscribing after Close returns a closed channel")
+	assert.Equal(t, 0, bus.SubscriberCount())
+}
+
+func TestGenericEventBus_CloseConcurrent(t *testing.T) {
+	bus := NewGenericEventBus[*TestEvent](1)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for p := 0; p < 5; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					bus.Publish(&TestEvent{ID: p})
+				}
+			}
+		}()
+	}
+
+	// Subscribers end once the bus is closed
+	var subsWg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		sub := bus.Subscribe(nil)
+		subsWg.Add(1)
+		go func() {
+			defer subsWg.Done()
+			defer bus.Unsubscribe(sub.ID)
+			for range sub.Events {
+			}
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	bus.Close()
+	subsWg.Wait()
+	close(stop)
+	wg.Wait()
+}
@@ -0,0 +1,85 @@
+package subscription
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// GroupProgress aggregates the JobProgressEvents of a group of tasks into GroupProgressEvents.
+// It keeps the latest event of the latest job of each task, so finished jobs keep counting
+// towards the totals until their task runs again. It is not safe for concurrent use.
+type GroupProgress struct {
+	tag    string
+	tasks  map[uuid.UUID]bool
+	latest map[uuid.UUID]*model.JobProgressEvent // By task ID
+}
+
+// NewGroupProgress creates a GroupProgress for the tasks of the group tag.
+func NewGroupProgress(tag string, taskIDs []uuid.UUID) *GroupProgress {
+	tasks := make(map[uuid.UUID]bool, len(taskIDs))
+	for _, id := range taskIDs {
+		tasks[id] = true
+	}
+	return &GroupProgress{
+		tag:    tag,
+		tasks:  tasks,
+		latest: make(map[uuid.UUID]*model.JobProgressEvent),
+	}
+}
+
+// Filter returns a filter function accepting the JobProgressEvents of the top-level jobs of the tasks of the group.
+// Unlike Add, it is safe to call from other goroutines.
+func (g *GroupProgress) Filter() func(*model.JobProgressEvent) bool {
+	return func(event *model.JobProgressEvent) bool {
+		return event.ParentID == nil && g.tasks[event.TaskID]
+	}
+}
+
+// Add records the event of a job of the group and returns the combined progress of the group.
+// Events of earlier jobs of a task than the one already recorded are ignored, as are events of child jobs
+// such as mirror jobs, which carry the task of their parent and would replace the progress of the parent.
+func (g *GroupProgress) Add(event *model.JobProgressEvent) *model.GroupProgressEvent {
+	if event.ParentID != nil {
+		return g.snapshot()
+	}
+	if prev, ok := g.latest[event.TaskID]; !ok || prev.JobID == event.JobID || !event.StartTime.Before(prev.StartTime) {
+		g.latest[event.TaskID] = event
+	}
+	return g.snapshot()
+}
+
+// snapshot returns the combined progress of the recorded jobs, ordered by start time.
+func (g *GroupProgress) snapshot() *model.GroupProgressEvent {
+	combined := &model.GroupProgressEvent{
+		Tag:  g.tag,
+		Jobs: make([]*model.JobProgressEvent, 0, len(g.latest)),
+	}
+	for _, event := range g.latest {
+		combined.Jobs = append(combined.Jobs, event)
+	}
+	slices.SortFunc(combined.Jobs, func(a, b *model.JobProgressEvent) int {
+		if c := a.StartTime.Compare(b.StartTime); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.TaskID[:], b.TaskID[:])
+	})
+
+	for _, event := range combined.Jobs {
+		switch event.Status {
+		case model.JobStatusPending, model.JobStatusRunning, model.JobStatusWaitingConfirmation:
+			combined.RunningJobs++
+		}
+		combined.FilesTransferred += event.FilesTransferred
+		combined.BytesTransferred += event.BytesTransferred
+		combined.FilesTotal += event.FilesTotal
+		combined.BytesTotal += event.BytesTotal
+		combined.FilesDeleted += event.FilesDeleted
+		combined.FilesChecked += event.FilesChecked
+		combined.ListingsDone += event.ListingsDone
+		combined.ErrorCount += event.ErrorCount
+	}
+	return combined
+}
@@ -0,0 +1,79 @@
+package subscription_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
+)
+
+// TestGroupProgress tests that GroupProgress combines the latest job of each task of the group.
+func TestGroupProgress(t *testing.T) {
+	task1, task2, other := uuid.New(), uuid.New(), uuid.New()
+	group := subscription.NewGroupProgress("nightly", []uuid.UUID{task1, task2})
+	start := time.Now()
+
+	filter := group.Filter()
+	assert.True(t, filter(&model.JobProgressEvent{TaskID: task1}))
+	assert.False(t, filter(&model.JobProgressEvent{TaskID: other}))
+
+	job1 := &model.JobProgressEvent{JobID: uuid.New(), TaskID: task1, Status: model.JobStatusRunning,
+		FilesTransferred: 1, BytesTransferred: 100, FilesTotal: 4, BytesTotal: 400, StartTime: start}
+	combined := group.Add(job1)
+	assert.Equal(t, "nightly", combined.Tag)
+	assert.Equal(t, 1, combined.RunningJobs)
+	assert.Equal(t, int64(400), combined.BytesTotal)
+
+	job2 := &model.JobProgressEvent{JobID: uuid.New(), TaskID: task2, Status: model.JobStatusRunning,
+		FilesTransferred: 2, BytesTransferred: 50, FilesTotal: 2, BytesTotal: 50, ErrorCount: 1, StartTime: start.Add(time.Second)}
+	combined = group.Add(job2)
+	assert.Equal(t, 2, combined.RunningJobs)
+	assert.Equal(t, 3, combined.FilesTransferred)
+	assert.Equal(t, int64(150), combined.BytesTransferred)
+	assert.Equal(t, 6, combined.FilesTotal)
+	assert.Equal(t, int64(450), combined.BytesTotal)
+	assert.Equal(t, 1, combined.ErrorCount)
+	assert.Equal(t, []*model.JobProgressEvent{job1, job2}, combined.Jobs)
+
+	// Finished jobs keep counting towards the totals
+	finished := *job2
+	finished.Status = model.JobStatusSuccess
+	combined = group.Add(&finished)
+	assert.Equal(t, 1, combined.RunningJobs)
+	assert.Equal(t, int64(450), combined.BytesTotal)
+
+	// A new job of a task replaces its previous job, late events of the previous job are ignored
+	rerun := &model.JobProgressEvent{JobID: uuid.New(), TaskID: task1, Status: model.JobStatusRunning,
+		BytesTotal: 10, StartTime: start.Add(2 * time.Second)}
+	group.Add(rerun)
+	late := *job1
+	late.Status = model.JobStatusSuccess
+	combined = group.Add(&late)
+	assert.Equal(t, 1, combined.RunningJobs)
+	assert.Equal(t, int64(60), combined.BytesTotal)
+	assert.Equal(t, []*model.JobProgressEvent{&finished, rerun}, combined.Jobs)
+}
+
+// TestGroupProgress_ChildJobs tests that the events of child jobs, which carry the task of their parent,
+// don't replace the progress of the parent.
+func TestGroupProgress_ChildJobs(t *testing.T) {
+	task := uuid.New()
+	group := subscription.NewGroupProgress("nightly", []uuid.UUID{task})
+	start := time.Now()
+
+	parent := &model.JobProgressEvent{JobID: uuid.New(), TaskID: task, Status: model.JobStatusRunning,
+		FilesTransferred: 3, BytesTransferred: 300, FilesTotal: 3, BytesTotal: 300, StartTime: start}
+	group.Add(parent)
+
+	child := &model.JobProgressEvent{JobID: uuid.New(), ParentID: &parent.JobID, TaskID: task, Status: model.JobStatusRunning,
+		FilesTransferred: 1, BytesTransferred: 10, FilesTotal: 5, BytesTotal: 500, StartTime: start.Add(time.Second)}
+	assert.False(t, group.Filter()(child))
+	combined := group.Add(child)
+	assert.Equal(t, []*model.JobProgressEvent{parent}, combined.Jobs)
+	assert.Equal(t, int64(300), combined.BytesTransferred)
+	assert.Equal(t, int64(300), combined.BytesTotal)
+}
@@ -0,0 +1,21 @@
+package subscription
+
+import (
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// LogDeleteProgressBus is a specialized event bus for LogDeleteProgressEvent.
+type LogDeleteProgressBus = GenericEventBus[*model.LogDeleteProgressEvent]
+
+// NewLogDeleteProgressBus creates a new LogDeleteProgressEvent event bus.
+// Uses a default buffer size of 100.
+func NewLogDeleteProgressBus() *LogDeleteProgressBus {
+	return NewGenericEventBus[*model.LogDeleteProgressEvent](100)
+}
+
+// LogDeleteProgressFilter creates a filter function accepting the events of the log deletion operationID.
+func LogDeleteProgressFilter(operationID string) func(*model.LogDeleteProgressEvent) bool {
+	return func(event *model.LogDeleteProgressEvent) bool {
+		return event.OperationID == operationID
+	}
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// SubscriptionsDisabledExtension rejects subscription operations, for servers with subscriptions disabled
+// by server.api.subscriptions. Without it subscriptions could still be sent over the HTTP transports.
+type SubscriptionsDisabledExtension struct{}
+
+// NewSubscriptionsDisabledExtension creates a new extension rejecting subscriptions.
+func NewSubscriptionsDisabledExtension() *SubscriptionsDisabledExtension {
+	return &SubscriptionsDisabledExtension{}
+}
+
+// ExtensionName returns the extension name.
+func (e *SubscriptionsDisabledExtension) ExtensionName() string {
+	return "SubscriptionsDisabledExtension"
+}
+
+// Validate validates the extension configuration.
+func (e *SubscriptionsDisabledExtension) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext rejects the operation before it is executed if it is a subscription.
+func (e *SubscriptionsDisabledExtension) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if rc.Operation != nil && rc.Operation.Operation == ast.Subscription {
+		return ErrorPresenter(ctx, i18n.NewI18nError(i18n.ErrSubscriptionsDisabled))
+	}
+	return nil
+}
+
+var (
+	_ graphql.HandlerExtension        = (*SubscriptionsDisabledExtension)(nil)
+	_ graphql.OperationContextMutator = (*SubscriptionsDisabledExtension)(nil)
+)
@@ -0,0 +1,78 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/xzzpig/rclone-sync/internal/core/tracing"
+)
+
+// TracingExtension records a span for every GraphQL operation and a child span for every resolver call.
+// Fields that are plain struct fields are not traced.
+type TracingExtension struct {
+	Tracer trace.Tracer
+}
+
+// NewTracingExtension creates a new tracing extension.
+func NewTracingExtension() *TracingExtension {
+	return &TracingExtension{Tracer: tracing.Tracer("api.graphql")}
+}
+
+// ExtensionName returns the extension name.
+func (e *TracingExtension) ExtensionName() string {
+	return "TracingExtension"
+}
+
+// Validate validates the extension configuration.
+func (e *TracingExtension) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse records the span of an operation.
+func (e *TracingExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	oc := graphql.GetOperationContext(ctx)
+	name := "GraphQL operation"
+	attrs := []attribute.KeyValue{semconv.GraphQLOperationName(oc.OperationName)}
+	if oc.Operation != nil {
+		name = "GraphQL " + string(oc.Operation.Operation)
+		attrs = append(attrs, semconv.GraphQLOperationTypeKey.String(string(oc.Operation.Operation)))
+	}
+	if oc.OperationName != "" {
+		name += " " + oc.OperationName
+	}
+
+	ctx, span := e.Tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.SetStatus(codes.Error, resp.Errors.Error())
+	}
+	return resp
+}
+
+// InterceptField records the span of a resolver call.
+func (e *TracingExtension) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := e.Tracer.Start(ctx, fc.Object+"."+fc.Field.Name, trace.WithAttributes(
+		attribute.String("graphql.field.path", fc.Path().String()),
+	))
+	res, err := next(ctx)
+	tracing.End(span, err)
+	return res, err
+}
+
+var (
+	_ graphql.HandlerExtension    = (*TracingExtension)(nil)
+	_ graphql.ResponseInterceptor = (*TracingExtension)(nil)
+	_ graphql.FieldInterceptor    = (*TracingExtension)(nil)
+)
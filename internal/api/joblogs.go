@@ -0,0 +1,134 @@
+package api
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// jobLogBatchSize is the number of job logs loaded from the database at a time while streaming.
+const jobLogBatchSize = 1000
+
+// jobLogTimeFormat is the timestamp format of plain text job logs.
+const jobLogTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// jobLogsLog returns a named logger for the api.joblogs package.
+func jobLogsLog() *zap.Logger {
+	return logger.Named("api.joblogs")
+}
+
+// jobLogHandler serves the logs of a job as plain text.
+type jobLogHandler struct {
+	jobService *services.JobService
+}
+
+// registerJobLogRoutes registers the job log routes under /jobs/:id.
+func registerJobLogRoutes(router *gin.RouterGroup, jobService *services.JobService) {
+	h := &jobLogHandler{jobService: jobService}
+	router.GET("/jobs/:id/logs.txt", h.download)
+}
+
+// download streams all logs of the job as plain text, one tab separated line per log with
+// timestamp, level, action, path ("previous -> new" for renames) and size, preceded by a header summarizing the job.
+// The response is gzip compressed if the client accepts it.
+func (h *jobLogHandler) download(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrInvalidIDFormat).WithCause(err))
+		return
+	}
+
+	job, err := h.jobService.GetJob(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrJobNotFound).WithCause(err))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return
+	}
+
+	c.Header("Content-Type", "text/plain; charset=utf-8")
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": "job-" + job.ID.String() + ".log.txt",
+	}))
+	c.Header("Vary", "Accept-Encoding")
+
+	var out io.Writer = c.Writer
+	if acceptsGzip(c.GetHeader("Accept-Encoding")) {
+		c.Header("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(c.Writer)
+		defer gz.Close()
+		out = gz
+	}
+	c.Status(http.StatusOK)
+
+	w := bufio.NewWriter(out)
+	writeJobLogHeader(w, job)
+	err = h.jobService.ForEachJobLog(c.Request.Context(), job.ID, jobLogBatchSize, func(l *ent.JobLog) error {
+		path := l.Path
+		if l.PreviousPath != nil {
+			path = *l.PreviousPath + " -> " + path
+		}
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n",
+			l.Time.UTC().Format(jobLogTimeFormat), l.Level, l.What, path, l.Size)
+		return err
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		// The status has already been sent, so the truncated log is all the client gets
+		jobLogsLog().Warn("Streaming job logs failed", zap.Stringer("job_id", job.ID), zap.Error(err))
+	}
+}
+
+// writeJobLogHeader writes the summary of a job as comment lines.
+func writeJobLogHeader(w io.Writer, job *ent.Job) {
+	fmt.Fprintf(w, "# Job: %s\n", job.ID)
+	fmt.Fprintf(w, "# Task: %s\n", job.TaskID)
+	fmt.Fprintf(w, "# Trigger: %s\n", job.Trigger)
+	fmt.Fprintf(w, "# Status: %s\n", job.Status)
+	fmt.Fprintf(w, "# Start: %s\n", job.StartTime.UTC().Format(jobLogTimeFormat))
+	if !job.EndTime.IsZero() {
+		fmt.Fprintf(w, "# End: %s (%s)\n", job.EndTime.UTC().Format(jobLogTimeFormat),
+			job.EndTime.Sub(job.StartTime).Round(time.Millisecond))
+	}
+	fmt.Fprintf(w, "# Files: %d, Bytes: %d, Deleted: %d, Errors: %d\n",
+		job.FilesTransferred, job.BytesTransferred, job.FilesDeleted, job.ErrorCount)
+	if job.Errors != "" {
+		for _, line := range strings.Split(strings.TrimRight(job.Errors, "\n"), "\n") {
+			fmt.Fprintf(w, "# Error: %s\n", line)
+		}
+	}
+	fmt.Fprintln(w, "# time\tlevel\taction\tpath\tsize")
+}
+
+// acceptsGzip reports whether an Accept-Encoding header value allows gzip content coding.
+func acceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		q, ok := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q=")
+		return !ok || strings.Trim(q, "0.") != ""
+	}
+	return false
+}
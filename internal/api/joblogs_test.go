@@ -0,0 +1,134 @@
+package api
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// setupJobLogRoutes creates a router serving the job log routes and a job with two logs to test against.
+func setupJobLogRoutes(t *testing.T) (*gin.Engine, uuid.UUID) {
+	t.Helper()
+	require.NoError(t, i18n.Init())
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	t.Cleanup(func() { client.Close() })
+
+	encryptor, err := crypto.NewEncryptor("")
+	require.NoError(t, err)
+	connService := services.NewConnectionService(client, encryptor)
+	taskService := services.NewTaskService(client)
+	jobService := services.NewJobService(client)
+
+	conn, err := connService.CreateConnection(ctx, "logs", "local", map[string]string{})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Logs", "/l", conn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	job, err := jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	logTime := time.Date(2026, 10, 17, 8, 30, 0, 0, time.UTC)
+	require.NoError(t, jobService.AddJobLogsBatch(ctx, job.ID, []*ent.JobLog{
+		{Level: model.LogLevelInfo, What: model.LogActionUpload, Path: "docs/report final.txt", Size: 1234, Time: logTime},
+		{Level: model.LogLevelError, What: model.LogActionError, Path: "broken.bin", Time: logTime.Add(time.Second)},
+	}))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(apicontext.LocaleMiddleware())
+	router.Use(apicontext.I18nErrorMiddleware())
+	registerJobLogRoutes(router.Group("/api"), jobService)
+
+	return router, job.ID
+}
+
+func doJobLogRequest(router *gin.Engine, jobID string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/jobs/"+jobID+"/logs.txt", nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestJobLogRoutes_Download(t *testing.T) {
+	router, jobID := setupJobLogRoutes(t)
+
+	t.Run("PlainText", func(t *testing.T) {
+		w := doJobLogRequest(router, jobID.String(), nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "job-"+jobID.String()+".log.txt")
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+
+		body := w.Body.String()
+		assert.Contains(t, body, "# Job: "+jobID.String()+"\n")
+		assert.Contains(t, body, "2026-10-17T08:30:00.000Z\tINFO\tUPLOAD\tdocs/report final.txt\t1234\n")
+		assert.True(t, strings.HasSuffix(body, "2026-10-17T08:30:01.000Z\tERROR\tERROR\tbroken.bin\t0\n"))
+	})
+
+	t.Run("Gzip", func(t *testing.T) {
+		plain := doJobLogRequest(router, jobID.String(), nil).Body.String()
+
+		w := doJobLogRequest(router, jobID.String(), http.Header{"Accept-Encoding": {"br, gzip;q=0.8"}})
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+
+		gz, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		assert.Equal(t, plain, string(body))
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		w := doJobLogRequest(router, "not-a-uuid", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, i18n.ErrInvalidIDFormat, errorCode(t, w))
+	})
+
+	t.Run("UnknownJob", func(t *testing.T) {
+		w := doJobLogRequest(router, uuid.NewString(), nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrJobNotFound, errorCode(t, w))
+	})
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected bool
+	}{
+		{"", false},
+		{"gzip", true},
+		{"deflate, GZIP", true},
+		{"gzip;q=0.5", true},
+		{"gzip; q=0", false},
+		{"gzip;q=0.000", false},
+		{"identity", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, acceptsGzip(tt.header), tt.header)
+	}
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// restOpenAPISpec is the OpenAPI description of the REST API, served at /v1/openapi.json.
+//
+//go:embed openapi_v1.json
+var restOpenAPISpec []byte
+
+// restLog returns a named logger for the api.rest package.
+func restLog() *zap.Logger {
+	return logger.Named("api.rest")
+}
+
+// restHandler serves the REST API, a small and stable subset of the GraphQL API for integration platforms
+// like Home Assistant and Node-RED: listing tasks, running them and polling the resulting jobs.
+// Its responses are described by openapi_v1.json, fields are only ever added to them.
+type restHandler struct {
+	tasks       *services.TaskService
+	jobs        *services.JobService
+	runner      ports.Runner
+	idempotency *services.IdempotencyService
+}
+
+// restTask is a task as returned by the REST API.
+type restTask struct {
+	ID         uuid.UUID           `json:"id"`
+	Name       string              `json:"name"`
+	Direction  model.SyncDirection `json:"direction"`
+	SourcePath string              `json:"sourcePath"`
+	Connection string              `json:"connection"`
+	RemotePath string              `json:"remotePath"`
+	Schedule   *string             `json:"schedule"`
+	Realtime   bool                `json:"realtime"`
+	Tags       []string            `json:"tags"`
+	Running    bool                `json:"running"`
+	LastJob    *restJob            `json:"lastJob"`
+}
+
+// restJob is a job as returned by the REST API.
+type restJob struct {
+	ID               uuid.UUID        `json:"id"`
+	TaskID           uuid.UUID        `json:"taskId"`
+	Status           model.JobStatus  `json:"status"`
+	Trigger          model.JobTrigger `json:"trigger"`
+	StartTime        time.Time        `json:"startTime"`
+	EndTime          *time.Time       `json:"endTime"`
+	FilesTransferred int              `json:"filesTransferred"`
+	BytesTransferred int64            `json:"bytesTransferred"`
+	FilesDeleted     int              `json:"filesDeleted"`
+	ErrorCount       int              `json:"errorCount"`
+	Error            *string          `json:"error"`
+}
+
+// registerRESTRoutes registers the REST API routes under /v1. Requests are authenticated by the
+// API tokens in addition to the credentials accepted by the other routes.
+func registerRESTRoutes(router *gin.RouterGroup, h *restHandler, auth gin.HandlerFunc) {
+	group := router.Group("/v1", auth)
+	{
+		group.GET("/openapi.json", h.openAPI)
+		group.GET("/tasks", h.listTasks)
+		group.POST("/tasks/:id/run", h.runTask)
+		group.GET("/jobs/:id", h.getJob)
+	}
+}
+
+// openAPI serves the OpenAPI description of the REST API.
+func (h *restHandler) openAPI(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", restOpenAPISpec)
+}
+
+// listTasks lists all tasks with their latest job, ordered by name.
+func (h *restHandler) listTasks(c *gin.Context) {
+	tasks, err := h.tasks.ListAllTasks(c.Request.Context())
+	if err != nil {
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return
+	}
+	slices.SortFunc(tasks, func(a, b *ent.Task) int { return strings.Compare(a.Name, b.Name) })
+
+	resp := make([]restTask, len(tasks))
+	for i, t := range tasks {
+		resp[i] = h.task(t)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// runTask starts the task, restarting it if it is running already, and returns the job of the new run.
+// Requests with the same Idempotency-Key header return the job of the first run instead of starting another,
+// like the task.run mutation of the GraphQL API.
+func (h *restHandler) runTask(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrInvalidIDFormat).WithCause(err))
+		return
+	}
+	t, err := h.tasks.GetTaskWithConnection(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrTaskNotFound).WithCause(err))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	var j *ent.Job
+	key := services.IdempotencyKeyFromContext(ctx)
+	if key == "" {
+		j, err = h.startTask(ctx, t)
+	} else {
+		var jobID uuid.UUID
+		var replayed bool
+		jobID, replayed, err = h.idempotency.Do(ctx, key, "task.run", t.ID, func(ctx context.Context) (uuid.UUID, error) {
+			if j, err = h.startTask(ctx, t); err != nil {
+				return uuid.Nil, err
+			}
+			return j.ID, nil
+		})
+		if err == nil && replayed {
+			j, err = h.jobs.GetJob(ctx, jobID)
+		}
+	}
+	if err != nil {
+		_ = c.Error(restRunError(err))
+		return
+	}
+	restLog().Info("Task started", zap.String("task", t.Name), zap.String("client_ip", c.ClientIP()))
+	c.JSON(http.StatusAccepted, newRESTJob(j))
+}
+
+// startTask starts a manual run of the task and returns its job. The job is created before the run starts
+// and taken over by it, so it is the job of this run even if another one starts right after.
+func (h *restHandler) startTask(ctx context.Context, t *ent.Task) (*ent.Job, error) {
+	ctx = provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{User: provenance.User(ctx)})
+	j, err := h.jobs.CreateJob(ctx, t.ID, model.JobTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.runner.StartTask(provenance.WithJob(ctx, &j.ID), t, model.JobTriggerManual); err != nil {
+		// The run never took the job over
+		if delErr := h.jobs.DeleteJob(context.WithoutCancel(ctx), j.ID); delErr != nil {
+			restLog().Warn("Failed to delete the job of a run that didn't start", zap.Stringer("job_id", j.ID), zap.Error(delErr))
+		}
+		return nil, err
+	}
+	return j, nil
+}
+
+// restRunError converts an error of runTask to the error returned to the client.
+func restRunError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrIdempotencyKeyInvalid):
+		return i18n.NewI18nErrorWithData(i18n.ErrIdempotencyKeyInvalid, map[string]interface{}{"Max": services.MaxIdempotencyKeyLength}).WithCause(err)
+	case errors.Is(err, services.ErrIdempotencyKeyReused):
+		return i18n.NewI18nError(i18n.ErrIdempotencyKeyReused).WithStatus(http.StatusUnprocessableEntity).WithCause(err)
+	case errors.Is(err, services.ErrIdempotencyKeyInProgress):
+		return i18n.NewI18nError(i18n.ErrIdempotencyKeyInProgress).WithStatus(http.StatusConflict).WithCause(err)
+	}
+	if _, ok := i18n.IsI18nError(err); ok {
+		return err
+	}
+	if errors.Is(err, errs.ErrSystem) {
+		return i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err)
+	}
+	return i18n.ErrInternalI18n(i18n.ErrSyncFailed).WithCause(err)
+}
+
+// getJob returns a job, e.g. to poll the job returned by runTask until it ends.
+func (h *restHandler) getJob(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		_ = c.Error(i18n.ErrBadRequestI18n(i18n.ErrInvalidIDFormat).WithCause(err))
+		return
+	}
+	j, err := h.jobs.GetJob(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrJobNotFound).WithCause(err))
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return
+	}
+	c.JSON(http.StatusOK, newRESTJob(j))
+}
+
+// task converts a task loaded with its connection and latest job.
+func (h *restHandler) task(t *ent.Task) restTask {
+	rt := restTask{
+		ID:         t.ID,
+		Name:       t.Name,
+		Direction:  t.Direction,
+		SourcePath: t.SourcePath,
+		RemotePath: t.RemotePath,
+		Realtime:   t.Realtime,
+		Tags:       t.Tags,
+		Running:    h.runner.IsRunning(t.ID),
+	}
+	if rt.Tags == nil {
+		rt.Tags = []string{}
+	}
+	if t.Schedule != "" {
+		rt.Schedule = &t.Schedule
+	}
+	if t.Edges.Connection != nil {
+		rt.Connection = t.Edges.Connection.Name
+	}
+	if len(t.Edges.Jobs) > 0 {
+		j := newRESTJob(t.Edges.Jobs[0])
+		rt.LastJob = &j
+	}
+	return rt
+}
+
+// newRESTJob converts a job.
+func newRESTJob(j *ent.Job) restJob {
+	rj := restJob{
+		ID:               j.ID,
+		TaskID:           j.TaskID,
+		Status:           j.Status,
+		Trigger:          j.Trigger,
+		StartTime:        j.StartTime,
+		FilesTransferred: j.FilesTransferred,
+		BytesTransferred: j.BytesTransferred,
+		FilesDeleted:     j.FilesDeleted,
+		ErrorCount:       j.ErrorCount,
+	}
+	if !j.EndTime.IsZero() {
+		rj.EndTime = &j.EndTime
+	}
+	if j.Errors != "" {
+		rj.Error = &j.Errors
+	}
+	return rj
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+func TestRESTRoutes(t *testing.T) {
+	require.NoError(t, i18n.Init())
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	t.Cleanup(func() { client.Close() })
+	encryptor, err := crypto.NewEncryptor("")
+	require.NoError(t, err)
+	connService := services.NewConnectionService(client, encryptor)
+	taskService := services.NewTaskService(client)
+	jobService := services.NewJobService(client)
+	runner := &shareRunner{jobs: jobService}
+
+	cfg := &config.Config{}
+	cfg.Auth.Username = "admin"
+	cfg.Auth.Password = "secret"
+	cfg.Auth.APITokens = []string{"home-assistant-token"}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(apicontext.LocaleMiddleware())
+	router.Use(apicontext.I18nErrorMiddleware())
+	router.Use(apicontext.IdempotencyKeyMiddleware())
+	registerRESTRoutes(router.Group("/api"), &restHandler{
+		tasks:       taskService,
+		jobs:        jobService,
+		runner:      runner,
+		idempotency: services.NewIdempotencyService(client),
+	}, apicontext.APITokenMiddleware(cfg))
+
+	conn, err := connService.CreateConnection(ctx, "rest-"+uuid.NewString()[:8], "local", map[string]string{})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Photos", t.TempDir(), conn.ID, "/photos", string(model.SyncDirectionUpload), "0 3 * * *", false, nil)
+	require.NoError(t, err)
+	docs, err := taskService.CreateTask(ctx, "Documents", t.TempDir(), conn.ID, "/docs", string(model.SyncDirectionDownload), "", false, nil)
+	require.NoError(t, err)
+
+	doWithKey := func(method, target, token, key string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, target, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if key != "" {
+			req.Header.Set(apicontext.IdempotencyKeyHeader, key)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+	do := func(method, target, token string) *httptest.ResponseRecorder {
+		return doWithKey(method, target, token, "")
+	}
+
+	t.Run("invalid token", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/v1/tasks", "wrong-token")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, `Bearer realm="rclone-sync"`, w.Header().Get("WWW-Authenticate"))
+	})
+
+	var jobID string
+	t.Run("run", func(t *testing.T) {
+		w := do(http.MethodPost, "/api/v1/tasks/"+task.ID.String()+"/run", "home-assistant-token")
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		assert.Equal(t, []string{apicontext.APITokenUser}, runner.started)
+
+		var job restJob
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+		assert.Equal(t, task.ID, job.TaskID)
+		assert.Equal(t, model.JobTriggerManual, job.Trigger)
+		assert.Nil(t, job.EndTime)
+		jobID = job.ID.String()
+
+		// The run took over the job created ahead of it
+		count, err := jobService.CountJobs(ctx, &task.ID, nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("list tasks", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/v1/tasks", "home-assistant-token")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var tasks []restTask
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tasks))
+		require.Len(t, tasks, 2)
+		assert.Equal(t, "Documents", tasks[0].Name)
+		assert.Nil(t, tasks[0].Schedule)
+		assert.Nil(t, tasks[0].LastJob)
+		assert.Equal(t, []string{}, tasks[0].Tags)
+
+		assert.Equal(t, "Photos", tasks[1].Name)
+		assert.Equal(t, conn.Name, tasks[1].Connection)
+		require.NotNil(t, tasks[1].Schedule)
+		assert.Equal(t, "0 3 * * *", *tasks[1].Schedule)
+		require.NotNil(t, tasks[1].LastJob)
+		assert.Equal(t, jobID, tasks[1].LastJob.ID.String())
+	})
+
+	t.Run("get job", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/v1/jobs/"+jobID, "home-assistant-token")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var job restJob
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+		assert.Equal(t, jobID, job.ID.String())
+
+		w = do(http.MethodGet, "/api/v1/jobs/"+uuid.NewString(), "home-assistant-token")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrJobNotFound, errorCode(t, w))
+
+		w = do(http.MethodGet, "/api/v1/jobs/not-a-uuid", "home-assistant-token")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, i18n.ErrInvalidIDFormat, errorCode(t, w))
+	})
+
+	t.Run("run with idempotency key", func(t *testing.T) {
+		w := doWithKey(http.MethodPost, "/api/v1/tasks/"+docs.ID.String()+"/run", "home-assistant-token", "nightly-docs")
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		var first restJob
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &first))
+		started := len(runner.started)
+
+		// A retry returns the job of the first run without starting another
+		w = doWithKey(http.MethodPost, "/api/v1/tasks/"+docs.ID.String()+"/run", "home-assistant-token", "nightly-docs")
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		var replayed restJob
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &replayed))
+		assert.Equal(t, first.ID, replayed.ID)
+		assert.Len(t, runner.started, started)
+
+		// The key can't be reused for another task
+		w = doWithKey(http.MethodPost, "/api/v1/tasks/"+task.ID.String()+"/run", "home-assistant-token", "nightly-docs")
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, i18n.ErrIdempotencyKeyReused, errorCode(t, w))
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		w := do(http.MethodPost, "/api/v1/tasks/"+uuid.NewString()+"/run", "home-assistant-token")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrTaskNotFound, errorCode(t, w))
+	})
+
+	t.Run("openapi", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/v1/openapi.json", "home-assistant-token")
+		require.Equal(t, http.StatusOK, w.Code)
+		var spec struct {
+			Paths map[string]map[string]any `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+
+		// Every registered route has to be described by the spec.
+		for _, route := range router.Routes() {
+			path, ok := strings.CutPrefix(route.Path, "/api/v1")
+			if !ok {
+				continue
+			}
+			if i := strings.Index(path, ":id"); i >= 0 {
+				path = path[:i] + "{id}" + path[i+len(":id"):]
+			}
+			require.Contains(t, spec.Paths, path)
+			assert.Contains(t, spec.Paths[path], strings.ToLower(route.Method), route.Method+" "+path)
+		}
+	})
+}
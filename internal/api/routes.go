@@ -4,6 +4,7 @@ package api
 import (
 	"fmt"
 
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/dataloader"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/resolver"
@@ -14,23 +15,30 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
 	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
 )
 
 // RouterDeps contains all dependencies required for setting up API routes.
 type RouterDeps struct {
-	Client              *ent.Client
-	Config              *config.Config
-	SyncEngine          *rclone.SyncEngine
-	Runner              ports.Runner
-	JobService          *services.JobService
-	Watcher             ports.Watcher
-	Scheduler           ports.Scheduler
-	JobProgressBus      *subscription.JobProgressBus
-	TransferProgressBus *subscription.TransferProgressBus
+	Client               *ent.Client
+	Config               *config.Config
+	SyncEngine           *rclone.SyncEngine
+	BackupEngine         *rclone.BackupEngine
+	Runner               ports.Runner
+	JobService           *services.JobService
+	Watcher              ports.Watcher
+	Scheduler            ports.Scheduler
+	JobProgressBus       *subscription.JobProgressBus
+	TransferProgressBus  *subscription.TransferProgressBus
+	LogDeleteProgressBus *subscription.LogDeleteProgressBus
+	UpdateService        *services.UpdateService
+	DatabaseService      *services.DatabaseService  // Created if nil
+	IntegrityService     *services.IntegrityService // Created if nil
 }
 
 // routesLog returns a named logger for the api.routes package.
@@ -50,33 +58,115 @@ func RegisterAPIRoutes(router *gin.RouterGroup, deps RouterDeps) error {
 	// Initialize services
 	taskService := services.NewTaskService(deps.Client)
 	connService := services.NewConnectionService(deps.Client, encryptor)
+	shareTokenService := services.NewShareTokenService(deps.Client)
+	databaseService := deps.DatabaseService
+	if databaseService == nil {
+		databaseService = services.NewDatabaseService(deps.Client)
+	}
+	idempotencyService := services.NewIdempotencyService(deps.Client)
+	integrityService := deps.IntegrityService
+	if integrityService == nil {
+		integrityService = services.NewIntegrityService(connService)
+	}
 
 	// GraphQL endpoint
-	gqlDeps := &resolver.Dependencies{
-		SyncEngine:          deps.SyncEngine,
-		Runner:              deps.Runner,
-		JobService:          deps.JobService,
-		Watcher:             deps.Watcher,
-		Scheduler:           deps.Scheduler,
-		TaskService:         taskService,
-		ConnectionService:   connService,
-		Encryptor:           encryptor,
-		JobProgressBus:      deps.JobProgressBus,
-		TransferProgressBus: deps.TransferProgressBus,
+	if deps.Config.Server.API.GraphQL {
+		registerGraphQLRoutes(router, deps, &resolver.Dependencies{
+			SyncEngine:           deps.SyncEngine,
+			BackupEngine:         deps.BackupEngine,
+			Runner:               deps.Runner,
+			JobService:           deps.JobService,
+			Watcher:              deps.Watcher,
+			Scheduler:            deps.Scheduler,
+			TaskService:          taskService,
+			ConnectionService:    connService,
+			DemoService:          services.NewDemoService(deps.Client, connService),
+			UsageService:         services.NewUsageService(deps.Client, deps.Config.App.Usage.ForecastDays, deps.Config.App.Usage.WarningDays),
+			CredentialService:    services.NewCredentialService(connService, deps.Config.App.Credentials.WarningDays),
+			IntegrityService:     integrityService,
+			IdempotencyService:   idempotencyService,
+			ShareTokenService:    shareTokenService,
+			UpdateService:        deps.UpdateService,
+			DatabaseService:      databaseService,
+			Encryptor:            encryptor,
+			JobProgressBus:       deps.JobProgressBus,
+			TransferProgressBus:  deps.TransferProgressBus,
+			LogDeleteProgressBus: deps.LogDeleteProgressBus,
+		})
+	} else {
+		router.Any("/graphql", disabledAPI(i18n.ErrGraphQLDisabled))
+	}
+
+	// Remote file endpoints
+	// The data directory can be relocated at runtime, so it is taken from the engine instead of the config
+	registerFileRoutes(router, connService, deps.Runner, deps.Config, deps.SyncEngine.DataDir)
+
+	// Job log endpoints
+	registerJobLogRoutes(router, deps.JobService)
+
+	// Administration endpoints
+	registerAdminRoutes(router, deps.Config, deps.SyncEngine.DataDir)
+
+	// REST API for integration platforms (also authenticated by the API tokens)
+	if deps.Config.Server.API.REST {
+		registerRESTRoutes(router, &restHandler{
+			tasks:       taskService,
+			jobs:        deps.JobService,
+			runner:      deps.Runner,
+			idempotency: idempotencyService,
+		}, apicontext.APITokenMiddleware(deps.Config))
+	} else {
+		router.Any("/v1/*path", disabledAPI(i18n.ErrRESTAPIDisabled))
 	}
+
+	// Share link endpoints (authenticated by the share token)
+	registerShareRoutes(router, &shareHandler{
+		shareTokens: shareTokenService,
+		tasks:       taskService,
+		connections: connService,
+		jobs:        deps.JobService,
+		runner:      deps.Runner,
+	})
+
+	return nil
+}
+
+// registerGraphQLRoutes registers the GraphQL endpoint under /graphql. With subscriptions disabled,
+// WebSocket upgrades are rejected and subscriptions sent over HTTP fail.
+func registerGraphQLRoutes(router *gin.RouterGroup, deps RouterDeps, gqlDeps *resolver.Dependencies) {
 	gqlHandler := graphql.NewHandler(gqlDeps)
+	if !deps.Config.Server.API.Subscriptions {
+		gqlHandler.Use(graphql.NewSubscriptionsDisabledExtension())
+	}
 
 	gqlGroup := router.Group("/graphql")
 	gqlGroup.Use(dataloader.Middleware(deps.Client))
 	{
 		gqlGroup.POST("", graphql.GinHandler(gqlHandler))
-		gqlGroup.GET("", graphql.GinHandler(gqlHandler)) // For WebSocket upgrade
+		gqlGroup.GET("", rejectWebSocket(deps.Config), graphql.GinHandler(gqlHandler)) // For WebSocket upgrade
 
 		// GraphiQL Playground (development only)
 		if deps.Config.App.Environment == "development" {
 			gqlGroup.GET("/playground", graphql.PlaygroundHandler("/api/graphql"))
 		}
 	}
+}
 
-	return nil
+// rejectWebSocket rejects WebSocket upgrades, which only serve subscriptions, while subscriptions are disabled.
+func rejectWebSocket(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !cfg.Server.API.Subscriptions && websocket.IsWebSocketUpgrade(c.Request) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrSubscriptionsDisabled))
+			c.Abort()
+		}
+	}
+}
+
+// disabledAPI returns a handler rejecting the requests to an API surface disabled by server.api with 404,
+// so no handler of the surface is reachable.
+func disabledAPI(msgID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_ = c.Error(i18n.ErrNotFoundI18n(msgID))
+		c.Abort()
+	}
 }
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+func TestRegisterAPIRoutes_DisabledSurfaces(t *testing.T) {
+	require.NoError(t, i18n.Init())
+
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	t.Cleanup(func() { client.Close() })
+
+	setup := func(t *testing.T, graphQL, subscriptions, rest bool) *gin.Engine {
+		t.Helper()
+		cfg := &config.Config{}
+		cfg.Server.API.GraphQL = graphQL
+		cfg.Server.API.Subscriptions = subscriptions
+		cfg.Server.API.REST = rest
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(apicontext.LocaleMiddleware())
+		router.Use(apicontext.I18nErrorMiddleware())
+		require.NoError(t, RegisterAPIRoutes(router.Group("/api"), RouterDeps{
+			Client:     client,
+			Config:     cfg,
+			JobService: services.NewJobService(client),
+		}))
+		return router
+	}
+	do := func(router *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	graphQL := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(`{"query":"`+query+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+	webSocket := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/graphql", nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		return req
+	}
+
+	t.Run("GraphQL disabled", func(t *testing.T) {
+		router := setup(t, false, true, true)
+		w := do(router, graphQL("{ __typename }"))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrGraphQLDisabled, errorCode(t, w))
+
+		w = do(router, httptest.NewRequest(http.MethodGet, "/api/v1/tasks", nil))
+		assert.NotEqual(t, http.StatusNotFound, w.Code, "the REST API is still served")
+	})
+
+	t.Run("Subscriptions disabled", func(t *testing.T) {
+		router := setup(t, true, false, true)
+		w := do(router, webSocket())
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrSubscriptionsDisabled, errorCode(t, w))
+
+		w = do(router, graphQL("subscription { jobProgress { jobId } }"))
+		assert.Contains(t, w.Body.String(), i18n.ErrSubscriptionsDisabled, "subscriptions over HTTP are rejected too")
+
+		w = do(router, graphQL("{ __typename }"))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "errors", "queries are still served")
+	})
+
+	t.Run("REST API disabled", func(t *testing.T) {
+		router := setup(t, true, true, false)
+		for _, target := range []string{"/api/v1/tasks", "/api/v1/openapi.json"} {
+			w := do(router, httptest.NewRequest(http.MethodGet, target, nil))
+			assert.Equal(t, http.StatusNotFound, w.Code, target)
+			assert.Equal(t, i18n.ErrRESTAPIDisabled, errorCode(t, w), target)
+		}
+
+		w := do(router, graphQL("{ __typename }"))
+		assert.Equal(t, http.StatusOK, w.Code, "GraphQL is still served")
+	})
+}
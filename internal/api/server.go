@@ -42,7 +42,7 @@ func SetupRouter(deps RouterDeps) *gin.Engine {
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"}, // Adjust for production
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "Accept-Language"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "Accept-Language", context.IdempotencyKeyHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -59,6 +59,8 @@ func SetupRouter(deps RouterDeps) *gin.Engine {
 
 	// API Group
 	apiGroup := r.Group("/api")
+	apiGroup.Use(context.TracingMiddleware())
+	apiGroup.Use(context.IdempotencyKeyMiddleware())
 	{
 		// Register routes here
 		if err := RegisterAPIRoutes(apiGroup, deps); err != nil {
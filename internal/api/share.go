@@ -0,0 +1,207 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// shareJobCount is the number of recent jobs returned for a shared task.
+const shareJobCount = 10
+
+// shareLog returns a named logger for the api.share package.
+func shareLog() *zap.Logger {
+	return logger.Named("api.share")
+}
+
+// shareHandler serves the share links, which grant the holder of a share token access to a single
+// task or remote file without the API credentials. The routes are exempt from authentication.
+type shareHandler struct {
+	shareTokens *services.ShareTokenService
+	tasks       *services.TaskService
+	connections *services.ConnectionService
+	jobs        *services.JobService
+	runner      ports.Runner
+}
+
+// registerShareRoutes registers the share link routes under /share/:token.
+func registerShareRoutes(router *gin.RouterGroup, h *shareHandler) {
+	group := router.Group("/share/:token")
+	{
+		group.GET("", h.info)
+		group.POST("/run", h.run)
+		group.GET("/download", h.download)
+		group.HEAD("/download", h.download)
+	}
+}
+
+// info describes what the share token grants access to: the task with its recent jobs,
+// or the name of the file that can be downloaded.
+func (h *shareHandler) info(c *gin.Context) {
+	st, ok := h.resolve(c, "")
+	if !ok {
+		return
+	}
+
+	resp := gin.H{
+		"name":      st.Name,
+		"scope":     st.Scope,
+		"expiresAt": st.ExpiresAt,
+	}
+	switch st.Scope {
+	case model.ShareScopeTask:
+		t, ok := h.task(c, st)
+		if !ok {
+			return
+		}
+		jobs, err := h.jobs.ListJobs(c.Request.Context(), &t.ID, nil, "", shareJobCount, 0)
+		if err != nil {
+			_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+			return
+		}
+		resp["task"] = gin.H{
+			"id":        t.ID,
+			"name":      t.Name,
+			"direction": t.Direction,
+			"running":   h.runner.IsRunning(t.ID),
+		}
+		resp["jobs"] = shareJobs(jobs)
+	case model.ShareScopeDownload:
+		_, name := splitRemotePath(st.Path)
+		resp["file"] = gin.H{"name": name}
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// run starts the shared task, recording the share token as the user who started it.
+func (h *shareHandler) run(c *gin.Context) {
+	st, ok := h.resolve(c, model.ShareScopeTask)
+	if !ok {
+		return
+	}
+	t, ok := h.task(c, st)
+	if !ok {
+		return
+	}
+
+	user := shareUser(st)
+	ctx := provenance.WithTriggerDetail(c.Request.Context(), &model.JobTriggerDetail{User: &user})
+	if err := h.runner.StartTask(ctx, t, model.JobTriggerManual); err != nil {
+		if _, ok := i18n.IsI18nError(err); ok {
+			_ = c.Error(err)
+			return
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrSyncFailed).WithCause(err))
+		return
+	}
+	shareLog().Info("Shared task started",
+		zap.String("task", t.Name),
+		zap.String("share_token", st.ID.String()),
+		zap.String("client_ip", c.ClientIP()),
+	)
+
+	j, err := h.jobs.GetLastJobByTaskID(ctx, t.ID)
+	if err != nil {
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"job":     shareJobs([]*ent.Job{j})[0],
+	})
+}
+
+// download streams the shared remote file. Range requests are supported.
+func (h *shareHandler) download(c *gin.Context) {
+	st, ok := h.resolve(c, model.ShareScopeDownload)
+	if !ok {
+		return
+	}
+	if st.ConnectionID == nil {
+		_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrShareTokenInvalid))
+		return
+	}
+	conn, err := h.connections.GetConnectionByID(c.Request.Context(), *st.ConnectionID)
+	if err != nil {
+		_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrConnectionNotFound).WithCause(err))
+		return
+	}
+	serveRemoteFile(c, conn, st.Path, shareUser(st))
+}
+
+// resolve loads the share token of the :token parameter and checks that it grants scope, any scope if empty.
+// On failure the error is attached to the context and ok is false.
+func (h *shareHandler) resolve(c *gin.Context, scope model.ShareScope) (st *ent.ShareToken, ok bool) {
+	st, err := h.shareTokens.ResolveShareToken(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		if errors.Is(err, services.ErrShareTokenInvalid) {
+			shareLog().Warn("Invalid share token", zap.String("client_ip", c.ClientIP()))
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrShareTokenInvalid))
+			return nil, false
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return nil, false
+	}
+	if scope != "" && st.Scope != scope {
+		_ = c.Error(i18n.NewI18nError(i18n.ErrShareScopeDenied).WithStatus(http.StatusForbidden))
+		return nil, false
+	}
+	return st, true
+}
+
+// task loads the task of a TASK share token with its connection. Deleted tasks are not found.
+func (h *shareHandler) task(c *gin.Context, st *ent.ShareToken) (*ent.Task, bool) {
+	if st.TaskID == nil {
+		_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrTaskNotFound))
+		return nil, false
+	}
+	t, err := h.tasks.GetTaskWithConnection(c.Request.Context(), *st.TaskID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			_ = c.Error(i18n.ErrNotFoundI18n(i18n.ErrTaskNotFound).WithCause(err))
+			return nil, false
+		}
+		_ = c.Error(i18n.ErrInternalI18n(i18n.ErrDatabaseError).WithCause(err))
+		return nil, false
+	}
+	return t, true
+}
+
+// shareUser is the user recorded for the jobs started and files downloaded through a share token.
+func shareUser(st *ent.ShareToken) string {
+	return "share:" + st.Name
+}
+
+// shareJobs returns the summary of jobs shown to the holder of a share token.
+func shareJobs(jobs []*ent.Job) []gin.H {
+	summaries := make([]gin.H, len(jobs))
+	for i, j := range jobs {
+		var endTime *time.Time
+		if !j.EndTime.IsZero() {
+			endTime = &j.EndTime
+		}
+		summaries[i] = gin.H{
+			"id":               j.ID,
+			"status":           j.Status,
+			"trigger":          j.Trigger,
+			"startTime":        j.StartTime,
+			"endTime":          endTime,
+			"filesTransferred": j.FilesTransferred,
+			"bytesTransferred": j.BytesTransferred,
+			"errorCount":       j.ErrorCount,
+		}
+	}
+	return summaries
+}
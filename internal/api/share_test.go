@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apicontext "github.com/xzzpig/rclone-sync/internal/api/context"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// shareRunner is a runner creating the job of a started task right away, recording who started it.
+type shareRunner struct {
+	ports.Runner
+	jobs    *services.JobService
+	started []string
+}
+
+func (r *shareRunner) StartTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
+	if detail := provenance.TriggerDetail(ctx); detail != nil && detail.User != nil {
+		r.started = append(r.started, *detail.User)
+	}
+	_, err := r.jobs.CreateJob(ctx, task.ID, trigger)
+	return err
+}
+
+func (r *shareRunner) IsRunning(uuid.UUID) bool { return false }
+
+func TestShareRoutes(t *testing.T) {
+	require.NoError(t, i18n.Init())
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	t.Cleanup(func() { client.Close() })
+	encryptor, err := crypto.NewEncryptor("")
+	require.NoError(t, err)
+	connService := services.NewConnectionService(client, encryptor)
+	rclone.NewDBStorage(connService).Install()
+	taskService := services.NewTaskService(client)
+	jobService := services.NewJobService(client)
+	shareTokens := services.NewShareTokenService(client)
+	runner := &shareRunner{jobs: jobService}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(apicontext.LocaleMiddleware())
+	router.Use(apicontext.I18nErrorMiddleware())
+	registerShareRoutes(router.Group("/api"), &shareHandler{
+		shareTokens: shareTokens,
+		tasks:       taskService,
+		connections: connService,
+		jobs:        jobService,
+		runner:      runner,
+	})
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "report.txt")
+	require.NoError(t, os.WriteFile(file, []byte("shared report"), 0644))
+
+	conn, err := connService.CreateConnection(ctx, "share-"+uuid.NewString()[:8], "local", map[string]string{})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Shared Task", dir, conn.ID, "/backup", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+	_, taskToken, err := shareTokens.CreateShareToken(ctx, services.ShareTokenSpec{
+		Name: "colleague", Scope: model.ShareScopeTask, TaskID: &task.ID, ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+	_, fileToken, err := shareTokens.CreateShareToken(ctx, services.ShareTokenSpec{
+		Name: "report", Scope: model.ShareScopeDownload, ConnectionID: &conn.ID, Path: file, ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	do := func(method, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+
+	t.Run("run", func(t *testing.T) {
+		w := do(http.MethodPost, "/api/share/"+taskToken+"/run")
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		assert.Equal(t, []string{"share:colleague"}, runner.started)
+
+		w = do(http.MethodGet, "/api/share/"+taskToken)
+		require.Equal(t, http.StatusOK, w.Code)
+		var info struct {
+			Scope string `json:"scope"`
+			Task  struct {
+				Name string `json:"name"`
+			} `json:"task"`
+			Jobs []struct {
+				Trigger string `json:"trigger"`
+			} `json:"jobs"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+		assert.Equal(t, "TASK", info.Scope)
+		assert.Equal(t, "Shared Task", info.Task.Name)
+		require.Len(t, info.Jobs, 1)
+		assert.Equal(t, "MANUAL", info.Jobs[0].Trigger)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/share/"+fileToken+"/download")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "shared report", w.Body.String())
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `filename=report.txt`)
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/share/"+taskToken+"/download")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, i18n.ErrShareScopeDenied, errorCode(t, w))
+
+		w = do(http.MethodPost, "/api/share/"+fileToken+"/run")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		w := do(http.MethodGet, "/api/share/unknown")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrShareTokenInvalid, errorCode(t, w))
+	})
+
+	t.Run("deleted task", func(t *testing.T) {
+		_, err := taskService.DeleteTask(ctx, task.ID)
+		require.NoError(t, err)
+		w := do(http.MethodPost, "/api/share/"+taskToken+"/run")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, i18n.ErrTaskNotFound, errorCode(t, w))
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
@@ -17,36 +18,138 @@ type Config struct {
 	Server struct {
 		Port int    `mapstructure:"port"`
 		Host string `mapstructure:"host"`
+		// API surfaces served under /api, disabled surfaces respond with 404
+		API struct {
+			GraphQL       bool `mapstructure:"graphql"`       // Serve the GraphQL API under /api/graphql, which the web interface uses, default: true
+			Subscriptions bool `mapstructure:"subscriptions"` // Serve GraphQL subscriptions (live progress), default: true
+			REST          bool `mapstructure:"rest"`          // Serve the REST API under /api/v1, default: true
+		} `mapstructure:"api"`
 	} `mapstructure:"server"`
 	Database struct {
-		Path          string `mapstructure:"path"`
-		MigrationMode string `mapstructure:"migration_mode"`
+		Path             string `mapstructure:"path"`
+		MigrationMode    string `mapstructure:"migration_mode"`
+		AllowAutoMigrate bool   `mapstructure:"allow_auto_migrate"` // Allow migrating an existing database at startup in production, default: false
+		UUIDVersion      int    `mapstructure:"uuid_version"`       // Version of generated entity IDs, 7 (time-ordered) or 4 (random), default: 7
+		OptimizeSchedule string `mapstructure:"optimize_schedule"`  // Cron schedule of VACUUM/ANALYZE in quiet hours, skipped while tasks run, empty disables it, default: "0 4 * * 0"
 	} `mapstructure:"database"`
 	Log struct {
 		Level  string    `mapstructure:"level"`
 		Levels LogLevels `mapstructure:"levels"`
+		File   struct {
+			Path       string `mapstructure:"path"`        // Also write server logs as JSON lines to this file, empty disables file logging
+			MaxSize    int    `mapstructure:"max_size"`    // Size in megabytes at which the log file is rotated, default: 100
+			MaxBackups int    `mapstructure:"max_backups"` // Number of rotated log files to keep, 0 keeps all, default: 5
+			MaxAge     int    `mapstructure:"max_age"`     // Days to keep rotated log files, 0 keeps them regardless of age, default: 30
+			Compress   bool   `mapstructure:"compress"`    // Gzip rotated log files, default: true
+		} `mapstructure:"file"`
+		Shipping struct {
+			Target  string            `mapstructure:"target"`  // Ship job logs and job status changes to "syslog" or "loki", empty disables shipping
+			Address string            `mapstructure:"address"` // Syslog server ("udp://host:514", "tcp://host:601") or Loki base URL ("http://loki:3100")
+			Labels  map[string]string `mapstructure:"labels"`  // Labels of the entries as templates over the entry (e.g. "{{.Task}}"), default: app, task and connection
+			// Number of entries sent at once, default: 100
+			BatchSize int `mapstructure:"batch_size"`
+			// Longest time entries are queued before being sent, default: 5s
+			FlushInterval time.Duration `mapstructure:"flush_interval"`
+			// Number of queued entries, further entries are dropped while the log store is slow or unreachable, default: 10000
+			BufferSize int `mapstructure:"buffer_size"`
+		} `mapstructure:"shipping"`
 	} `mapstructure:"log"`
+	Tracing struct {
+		Endpoint    string  `mapstructure:"endpoint"`     // OTLP/HTTP endpoint URL traces are exported to (e.g. "http://localhost:4318"), empty disables tracing
+		ServiceName string  `mapstructure:"service_name"` // Service name reported with the spans, default: "rclone-sync"
+		SampleRatio float64 `mapstructure:"sample_ratio"` // Fraction of new traces that are recorded (0-1), default: 1
+	} `mapstructure:"tracing"`
 	App struct {
-		DataDir     string `mapstructure:"data_dir"`
-		Environment string `mapstructure:"environment"`
-		Job         struct {
-			AutoDeleteEmptyJobs  bool   `mapstructure:"auto_delete_empty_jobs"`
-			MaxLogsPerConnection int    `mapstructure:"max_logs_per_connection"`
-			CleanupSchedule      string `mapstructure:"cleanup_schedule"`
+		DataDir         string `mapstructure:"data_dir"`
+		Environment     string `mapstructure:"environment"`
+		MaintenanceMode bool   `mapstructure:"maintenance_mode"` // Start in maintenance mode (mutations and job starts rejected)
+		Locale          string `mapstructure:"locale"`           // Default locale for job failure reasons and requests without Accept-Language, default: "en"
+		Job             struct {
+			AutoDeleteEmptyJobs  bool          `mapstructure:"auto_delete_empty_jobs"`
+			MaxLogsPerConnection int           `mapstructure:"max_logs_per_connection"`
+			CleanupSchedule      string        `mapstructure:"cleanup_schedule"`
+			LogRetention         time.Duration `mapstructure:"log_retention"`     // Delete job logs older than this, keeping the jobs, 0 keeps them, overridable per task, default: 0
+			RecordRetention      time.Duration `mapstructure:"record_retention"`  // Delete finished jobs (with their logs) that ended longer ago, 0 keeps them, overridable per task, default: 0
+			StallTimeout         time.Duration `mapstructure:"stall_timeout"`     // Warn about running jobs without progress for this long, 0 disables, default: 30m
+			StallAutoCancel      bool          `mapstructure:"stall_auto_cancel"` // Cancel stalled jobs instead of only warning, default: false
+			// Escalate a task (CONSECUTIVE_FAILURES event and error log) after this many failed runs in a row, 0 disables, default: 3
+			FailureEscalationThreshold int `mapstructure:"failure_escalation_threshold"`
+			// Disable a task (TASK_DISABLED event) after this many runs in a row failed with a non-transient error, 0 disables, default: 5
+			AutoDisableThreshold int `mapstructure:"auto_disable_threshold"`
 		} `mapstructure:"job"`
+		Task struct {
+			DeletedRetention time.Duration `mapstructure:"deleted_retention"` // Keep deleted tasks restorable for this long before purging them, 0 keeps them forever, default: 720h
+		} `mapstructure:"task"`
 		Sync struct {
-			Transfers int `mapstructure:"transfers"` // Default parallel transfers (1-64), default: 4
+			Transfers        int           `mapstructure:"transfers"`          // Default parallel transfers (1-64), default: 4
+			LogBatchSize     int           `mapstructure:"log_batch_size"`     // Job logs written per batch, default: 500
+			LogFlushInterval time.Duration `mapstructure:"log_flush_interval"` // Max time job logs stay buffered, default: 5s
+			LogBufferLimit   int           `mapstructure:"log_buffer_limit"`   // Max buffered job logs per job, default: 10000
+			// Flag one-way runs deleting more than this many times the files their task usually deletes (DELETE_ANOMALY event), 0 disables, default: 100
+			DeleteAnomalyFactor int `mapstructure:"delete_anomaly_factor"`
+			DeleteAnomalyMin    int `mapstructure:"delete_anomaly_min"` // Runs deleting at most this many files are never flagged, default: 100
 		} `mapstructure:"sync"`
+		Upload struct {
+			MaxSize     int64 `mapstructure:"max_size"`     // Max size in bytes of a file uploaded via the REST API, 0 disables uploads, default: 10 MiB
+			RequireAuth bool  `mapstructure:"require_auth"` // Reject uploads while authentication is disabled, default: true
+		} `mapstructure:"upload"`
+		Archive struct {
+			MaxSize       int64         `mapstructure:"max_size"`        // Max total size in bytes of the files of a directory archive, 0 disables archives, default: 4 GiB
+			StreamMaxSize int64         `mapstructure:"stream_max_size"` // Larger archives are built in the background instead of streamed, default: 256 MiB
+			Retention     time.Duration `mapstructure:"retention"`       // How long archives built in the background are kept, default: 24h
+		} `mapstructure:"archive"`
+		Watcher struct {
+			IgnorePatterns []string `mapstructure:"ignore_patterns"` // Glob patterns whose changes never trigger a realtime sync, overridable per task
+		} `mapstructure:"watcher"`
+		Hooks struct {
+			AllowedCommands []string      `mapstructure:"allowed_commands"` // Absolute paths of the executables task hooks may run, empty disables hooks
+			Timeout         time.Duration `mapstructure:"timeout"`          // Kill hooks running longer than this, default: 5m
+			MaxOutput       int           `mapstructure:"max_output"`       // Bytes of hook output stored in the job event, default: 65536
+		} `mapstructure:"hooks"`
+		Usage struct {
+			SampleSchedule string `mapstructure:"sample_schedule"` // Cron schedule of the daily quota samples of connections, empty disables sampling, default: "0 3 * * *"
+			ForecastDays   int    `mapstructure:"forecast_days"`   // Days of samples the usage forecast is based on, default: 30
+			WarningDays    int    `mapstructure:"warning_days"`    // Warn about connections forecast to run full within this many days, 0 disables, default: 0
+		} `mapstructure:"usage"`
+		Credentials struct {
+			CheckSchedule string `mapstructure:"check_schedule"` // Cron schedule of the expiry check of connection credentials, empty disables the check, default: "0 4 * * *"
+			WarningDays   int    `mapstructure:"warning_days"`   // Warn about connection credentials expiring within this many days, default: 14
+		} `mapstructure:"credentials"`
+		Connection struct {
+			ConfigCacheTTL         time.Duration `mapstructure:"config_cache_ttl"`         // How long decrypted connection configs are cached, 0 disables the cache, default: 30s
+			IntegrityCheckSchedule string        `mapstructure:"integrity_check_schedule"` // Cron schedule of the decryption check of connection configs, also run at startup, empty disables it, default: "30 4 * * *"
+			WarmupParallel         int           `mapstructure:"warmup_parallel"`          // Remote Fs of the enabled tasks created at a time when warming up the Fs cache at startup, 0 disables the warm-up, default: 4
+		} `mapstructure:"connection"`
+		UpdateCheck struct {
+			Enabled    bool          `mapstructure:"enabled"`    // Periodically check GitHub for a newer release, default: false
+			Interval   time.Duration `mapstructure:"interval"`   // Time between update checks, default: 24h
+			Repository string        `mapstructure:"repository"` // GitHub repository the releases are checked of, default: "xzzpig/rclone-sync"
+		} `mapstructure:"update_check"`
+		Doctor struct {
+			ProbeURLs []string      `mapstructure:"probe_urls"` // URLs requested by the self-test to check outbound network access and the clock, default: ["https://rclone.org"]
+			Timeout   time.Duration `mapstructure:"timeout"`    // Timeout of each probe request of the self-test, default: 10s
+		} `mapstructure:"doctor"`
 	} `mapstructure:"app"`
 	Security struct {
 		EncryptionKey string `mapstructure:"encryption_key"`
 	} `mapstructure:"security"`
 	Auth struct {
-		Username string `mapstructure:"username"`
-		Password string `mapstructure:"password"`
+		Username  string   `mapstructure:"username"`
+		Password  string   `mapstructure:"password"`
+		APITokens []string `mapstructure:"api_tokens"` // Bearer tokens accepted by the REST API under /api/v1 only
 	} `mapstructure:"auth"`
 }
 
+// DefaultWatcherIgnorePatterns are the default realtime watcher ignore patterns:
+// partial downloads, temporary files and editor swap/backup files.
+// Hidden files (e.g. ".DS_Store", Emacs ".#" lock files) are always ignored by the watcher.
+var DefaultWatcherIgnorePatterns = []string{
+	"*.part", "*.partial", "*.crdownload", "*.download",
+	"*.tmp", "*.temp",
+	"*.swp", "*.swo", "*.swx", "4913", "*~", "#*#",
+	"~$*",
+}
+
 // Load loads the application configuration from file and environment variables.
 // Returns the configuration and any error encountered.
 func Load(cfgFile string) (*Config, error) {
@@ -108,6 +211,9 @@ func (c *Config) IsAuthEnabled() bool {
 	return c.Auth.Username != "" && c.Auth.Password != ""
 }
 
+// minAPITokenLength is the minimum length of the API tokens, so they can't be guessed.
+const minAPITokenLength = 16
+
 // ValidateAuth checks if auth configuration is valid
 func (c *Config) ValidateAuth() error {
 	hasUsername := c.Auth.Username != ""
@@ -115,6 +221,11 @@ func (c *Config) ValidateAuth() error {
 	if hasUsername != hasPassword {
 		return errs.ConstError("username and password must both be set or both be empty")
 	}
+	for _, token := range c.Auth.APITokens {
+		if len(token) < minAPITokenLength {
+			return fmt.Errorf("api tokens must be at least %d characters long", minAPITokenLength) //nolint:err113
+		}
+	}
 	return nil
 }
 
@@ -125,15 +236,59 @@ func setDefaults() {
 	// 设置非零默认值
 	viper.SetDefault("server.port", 8080)
 	viper.SetDefault("server.host", "0.0.0.0")
+	viper.SetDefault("server.api.graphql", true)
+	viper.SetDefault("server.api.subscriptions", true)
+	viper.SetDefault("server.api.rest", true)
 	viper.SetDefault("database.path", "rclone-sync.db")
 	viper.SetDefault("database.migration_mode", "versioned")
+	viper.SetDefault("database.uuid_version", 7)
+	viper.SetDefault("database.optimize_schedule", "0 4 * * 0")
 	viper.SetDefault("log.level", "info")
+	viper.SetDefault("log.file.max_size", 100)
+	viper.SetDefault("log.file.max_backups", 5)
+	viper.SetDefault("log.file.max_age", 30)
+	viper.SetDefault("log.shipping.batch_size", 100)
+	viper.SetDefault("log.shipping.flush_interval", "5s")
+	viper.SetDefault("log.shipping.buffer_size", 10000)
+	viper.SetDefault("log.file.compress", true)
+	viper.SetDefault("tracing.service_name", "rclone-sync")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
 	viper.SetDefault("app.data_dir", "./app_data")
 	viper.SetDefault("app.environment", "production")
+	viper.SetDefault("app.locale", "en")
 	viper.SetDefault("app.job.auto_delete_empty_jobs", true)
 	viper.SetDefault("app.job.max_logs_per_connection", 1000)
 	viper.SetDefault("app.job.cleanup_schedule", "0 * * * *")
+	viper.SetDefault("app.job.stall_timeout", "30m")
+	viper.SetDefault("app.job.failure_escalation_threshold", 3)
+	viper.SetDefault("app.job.auto_disable_threshold", 5)
+	viper.SetDefault("app.task.deleted_retention", "720h")
 	viper.SetDefault("app.sync.transfers", 4)
+	viper.SetDefault("app.sync.log_batch_size", 500)
+	viper.SetDefault("app.sync.log_flush_interval", "5s")
+	viper.SetDefault("app.sync.log_buffer_limit", 10000)
+	viper.SetDefault("app.sync.delete_anomaly_factor", 100)
+	viper.SetDefault("app.sync.delete_anomaly_min", 100)
+	viper.SetDefault("app.upload.max_size", 10*1024*1024)
+	viper.SetDefault("app.upload.require_auth", true)
+	viper.SetDefault("app.archive.max_size", 4*1024*1024*1024)
+	viper.SetDefault("app.archive.stream_max_size", 256*1024*1024)
+	viper.SetDefault("app.archive.retention", "24h")
+	viper.SetDefault("app.watcher.ignore_patterns", DefaultWatcherIgnorePatterns)
+	viper.SetDefault("app.hooks.timeout", "5m")
+	viper.SetDefault("app.hooks.max_output", 65536)
+	viper.SetDefault("app.usage.sample_schedule", "0 3 * * *")
+	viper.SetDefault("app.usage.forecast_days", 30)
+	viper.SetDefault("app.credentials.check_schedule", "0 4 * * *")
+	viper.SetDefault("app.credentials.warning_days", 14)
+	viper.SetDefault("app.connection.config_cache_ttl", "30s")
+	viper.SetDefault("app.connection.integrity_check_schedule", "30 4 * * *")
+	viper.SetDefault("app.connection.warmup_parallel", 4)
+	viper.SetDefault("app.update_check.enabled", false)
+	viper.SetDefault("app.update_check.interval", "24h")
+	viper.SetDefault("app.update_check.repository", "xzzpig/rclone-sync")
+	viper.SetDefault("app.doctor.probe_urls", []string{"https://rclone.org"})
+	viper.SetDefault("app.doctor.timeout", "10s")
 }
 
 // registerConfigKeys 通过反射遍历结构体，为每个字段注册零值默认值
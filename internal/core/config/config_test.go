@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -103,6 +104,37 @@ level = "debug"
 	assert.Empty(t, cfg.Log.Levels)
 }
 
+func TestLoad_WithLogShipping(t *testing.T) {
+	// Reset viper before each test
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.toml")
+
+	configContent := `
+[log.shipping]
+target = "loki"
+address = "http://loki:3100"
+flush_interval = "1s"
+
+[log.shipping.labels]
+app = "rclone-sync"
+task = "{{.Task}}"
+`
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "loki", cfg.Log.Shipping.Target)
+	assert.Equal(t, "http://loki:3100", cfg.Log.Shipping.Address)
+	assert.Equal(t, time.Second, cfg.Log.Shipping.FlushInterval)
+	assert.Equal(t, 100, cfg.Log.Shipping.BatchSize)
+	assert.Equal(t, map[string]string{"app": "rclone-sync", "task": "{{.Task}}"}, cfg.Log.Shipping.Labels)
+	assert.Empty(t, cfg.Log.Levels)
+}
+
 func TestLoad_Defaults(t *testing.T) {
 	// Reset viper before each test
 	viper.Reset()
@@ -122,13 +154,55 @@ func TestLoad_Defaults(t *testing.T) {
 	assert.Equal(t, "0.0.0.0", cfg.Server.Host)
 	assert.Equal(t, "rclone-sync.db", cfg.Database.Path)
 	assert.Equal(t, "versioned", cfg.Database.MigrationMode)
+	assert.False(t, cfg.Database.AllowAutoMigrate)
+	assert.Equal(t, 7, cfg.Database.UUIDVersion)
+	assert.Equal(t, "0 4 * * 0", cfg.Database.OptimizeSchedule)
+	assert.Equal(t, 720*time.Hour, cfg.App.Task.DeletedRetention)
 	assert.Equal(t, "info", cfg.Log.Level)
+	assert.Empty(t, cfg.Log.File.Path)
+	assert.Equal(t, 100, cfg.Log.File.MaxSize)
+	assert.Equal(t, 5, cfg.Log.File.MaxBackups)
+	assert.Equal(t, 30, cfg.Log.File.MaxAge)
+	assert.True(t, cfg.Log.File.Compress)
+	assert.Empty(t, cfg.Log.Shipping.Target)
+	assert.Empty(t, cfg.Log.Shipping.Labels)
+	assert.Equal(t, 100, cfg.Log.Shipping.BatchSize)
+	assert.Equal(t, 5*time.Second, cfg.Log.Shipping.FlushInterval)
+	assert.Equal(t, 10000, cfg.Log.Shipping.BufferSize)
+	assert.Empty(t, cfg.Tracing.Endpoint)
+	assert.Equal(t, "rclone-sync", cfg.Tracing.ServiceName)
+	assert.Equal(t, 1.0, cfg.Tracing.SampleRatio)
 	assert.Equal(t, "./app_data", cfg.App.DataDir)
 	assert.Equal(t, true, cfg.App.Job.AutoDeleteEmptyJobs)
 	assert.Equal(t, 1000, cfg.App.Job.MaxLogsPerConnection)
 	assert.Equal(t, "0 * * * *", cfg.App.Job.CleanupSchedule)
+	assert.Equal(t, 30*time.Minute, cfg.App.Job.StallTimeout)
+	assert.False(t, cfg.App.Job.StallAutoCancel)
+	assert.Equal(t, 3, cfg.App.Job.FailureEscalationThreshold)
 	assert.Equal(t, 4, cfg.App.Sync.Transfers)
+	assert.Equal(t, 500, cfg.App.Sync.LogBatchSize)
+	assert.Equal(t, 5*time.Second, cfg.App.Sync.LogFlushInterval)
+	assert.Equal(t, 10000, cfg.App.Sync.LogBufferLimit)
+	assert.Equal(t, int64(10*1024*1024), cfg.App.Upload.MaxSize)
+	assert.True(t, cfg.App.Upload.RequireAuth)
+	assert.Equal(t, int64(4*1024*1024*1024), cfg.App.Archive.MaxSize)
+	assert.Equal(t, int64(256*1024*1024), cfg.App.Archive.StreamMaxSize)
+	assert.Equal(t, 24*time.Hour, cfg.App.Archive.Retention)
+	assert.Equal(t, DefaultWatcherIgnorePatterns, cfg.App.Watcher.IgnorePatterns)
+	assert.Empty(t, cfg.App.Hooks.AllowedCommands)
+	assert.Equal(t, 5*time.Minute, cfg.App.Hooks.Timeout)
+	assert.Equal(t, 65536, cfg.App.Hooks.MaxOutput)
+	assert.Equal(t, "0 3 * * *", cfg.App.Usage.SampleSchedule)
+	assert.Equal(t, 30, cfg.App.Usage.ForecastDays)
+	assert.Equal(t, 0, cfg.App.Usage.WarningDays)
+	assert.Equal(t, "0 4 * * *", cfg.App.Credentials.CheckSchedule)
+	assert.Equal(t, 14, cfg.App.Credentials.WarningDays)
+	assert.Equal(t, 30*time.Second, cfg.App.Connection.ConfigCacheTTL)
+	assert.False(t, cfg.App.UpdateCheck.Enabled)
+	assert.Equal(t, 24*time.Hour, cfg.App.UpdateCheck.Interval)
+	assert.Equal(t, "xzzpig/rclone-sync", cfg.App.UpdateCheck.Repository)
 	assert.Equal(t, "production", cfg.App.Environment)
+	assert.Equal(t, "en", cfg.App.Locale)
 }
 
 func TestLoad_ConfigFileNotFound(t *testing.T) {
@@ -212,6 +286,27 @@ cleanup_schedule = "*/30 * * * *"
 [app.sync]
 transfers = 8
 
+[app.watcher]
+ignore_patterns = ["*.bak", "cache/*"]
+
+[app.hooks]
+allowed_commands = ["/usr/local/bin/notify"]
+timeout = "30s"
+
+[app.usage]
+warning_days = 14
+
+[app.credentials]
+check_schedule = ""
+warning_days = 30
+
+[app.connection]
+config_cache_ttl = "0s"
+
+[app.update_check]
+enabled = true
+interval = "12h"
+
 [security]
 encryption_key = "secret-key"
 `
@@ -232,6 +327,15 @@ encryption_key = "secret-key"
 	assert.Equal(t, 500, cfg.App.Job.MaxLogsPerConnection)
 	assert.Equal(t, "*/30 * * * *", cfg.App.Job.CleanupSchedule)
 	assert.Equal(t, 8, cfg.App.Sync.Transfers)
+	assert.Equal(t, []string{"*.bak", "cache/*"}, cfg.App.Watcher.IgnorePatterns)
+	assert.Equal(t, []string{"/usr/local/bin/notify"}, cfg.App.Hooks.AllowedCommands)
+	assert.Equal(t, 30*time.Second, cfg.App.Hooks.Timeout)
+	assert.Equal(t, 14, cfg.App.Usage.WarningDays)
+	assert.Equal(t, "", cfg.App.Credentials.CheckSchedule)
+	assert.Equal(t, 30, cfg.App.Credentials.WarningDays)
+	assert.Equal(t, time.Duration(0), cfg.App.Connection.ConfigCacheTTL)
+	assert.True(t, cfg.App.UpdateCheck.Enabled)
+	assert.Equal(t, 12*time.Hour, cfg.App.UpdateCheck.Interval)
 	assert.Equal(t, "secret-key", cfg.Security.EncryptionKey)
 }
 
@@ -333,6 +437,28 @@ port = 8080
 	}
 }
 
+func TestAuthConfig_APITokens(t *testing.T) {
+	viper.Reset()
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+[auth]
+api_tokens = ["home-assistant-token", "node-red-token-01"]
+`), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"home-assistant-token", "node-red-token-01"}, cfg.Auth.APITokens)
+	assert.NoError(t, cfg.ValidateAuth())
+	assert.False(t, cfg.IsAuthEnabled())
+
+	cfg.Auth.APITokens = append(cfg.Auth.APITokens, "short")
+	err = cfg.ValidateAuth()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "api tokens must be at least 16 characters long")
+}
+
 func TestAuthConfig_EnvironmentVariables(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -380,3 +506,16 @@ password = "configpass"
 		})
 	}
 }
+
+func TestLoad_WatcherIgnorePatternsFromEnv(t *testing.T) {
+	viper.Reset()
+	t.Setenv("RCLONESYNC_APP_WATCHER_IGNORE_PATTERNS", "*.part,*.tmp")
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"*.part", "*.tmp"}, cfg.App.Watcher.IgnorePatterns)
+}
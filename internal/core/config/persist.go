@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// tomlTableHeader matches a standard table header line such as "[app]" or "[app.job]".
+var tomlTableHeader = regexp.MustCompile(`^\s*\[([^\[\]]+)\]\s*(#.*)?$`)
+
+// PersistString sets a string configuration value, e.g. "app.data_dir", in memory and in the
+// config file in use, keeping the rest of the file including comments untouched.
+//
+// It returns false without error if the value cannot take effect through the config file:
+// either no config file is used or the key is overridden by its environment variable.
+func PersistString(key, value string) (bool, error) {
+	viper.Set(key, value)
+
+	envKey := "RCLONESYNC_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+	if _, ok := os.LookupEnv(envKey); ok {
+		return false, nil
+	}
+
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		return false, nil
+	}
+	content, err := os.ReadFile(path) //nolint:gosec // path is the config file loaded at startup
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	idx := strings.LastIndex(key, ".")
+	updated := setTOMLString(string(content), key[:max(idx, 0)], key[idx+1:], value)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat config file: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".config-*.toml")
+	if err != nil {
+		return false, fmt.Errorf("failed to write config file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.WriteString(updated); err != nil {
+		_ = tmp.Close()
+		return false, fmt.Errorf("failed to write config file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return false, fmt.Errorf("failed to write config file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return false, fmt.Errorf("failed to write config file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return false, fmt.Errorf("failed to write config file: %w", err)
+	}
+	return true, nil
+}
+
+// setTOMLString sets key in table (empty for the root table) to a string value.
+// An existing assignment is replaced in place, otherwise the key is added at the start
+// of the table, creating the table at the end of the document if needed.
+func setTOMLString(content, table, key, value string) string {
+	lines := strings.Split(content, "\n")
+	assignment := key + " = " + strconv.Quote(value)
+	keyLine := regexp.MustCompile(`^(\s*)` + regexp.QuoteMeta(key) + `\s*=`)
+
+	current := ""
+	tableStart := -1
+	if table == "" {
+		tableStart = 0
+	}
+	for i, line := range lines {
+		if m := tomlTableHeader.FindStringSubmatch(line); m != nil {
+			current = strings.TrimSpace(m[1])
+			if current == table {
+				tableStart = i + 1
+			}
+			continue
+		}
+		if current == table {
+			if m := keyLine.FindStringSubmatch(line); m != nil {
+				lines[i] = m[1] + assignment
+				return strings.Join(lines, "\n")
+			}
+		}
+	}
+
+	if tableStart < 0 {
+		if !strings.HasSuffix(content, "\n") && content != "" {
+			content += "\n"
+		}
+		return content + "\n[" + table + "]\n" + assignment + "\n"
+	}
+	lines = append(lines[:tableStart], append([]string{assignment}, lines[tableStart:]...)...)
+	return strings.Join(lines, "\n")
+}
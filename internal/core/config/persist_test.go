@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTOMLString(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		table   string
+		key     string
+		want    string
+	}{
+		{
+			name:    "replaces existing value and keeps comments",
+			content: "[app]\n# Data storage directory\ndata_dir = \"./app_data\" # old\n\n[server]\nport = 8080\n",
+			table:   "app",
+			key:     "data_dir",
+			want:    "[app]\n# Data storage directory\ndata_dir = \"/data\"\n\n[server]\nport = 8080\n",
+		},
+		{
+			name:    "adds key to existing table",
+			content: "[app]\nenvironment = \"production\"\n",
+			table:   "app",
+			key:     "data_dir",
+			want:    "[app]\ndata_dir = \"/data\"\nenvironment = \"production\"\n",
+		},
+		{
+			name:    "does not touch same key in other table",
+			content: "[other]\ndata_dir = \"x\"\n[app]\n",
+			table:   "app",
+			key:     "data_dir",
+			want:    "[other]\ndata_dir = \"x\"\n[app]\ndata_dir = \"/data\"\n",
+		},
+		{
+			name:    "creates missing table",
+			content: "[server]\nport = 8080",
+			table:   "app",
+			key:     "data_dir",
+			want:    "[server]\nport = 8080\n\n[app]\ndata_dir = \"/data\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, setTOMLString(tt.content, tt.table, tt.key, "/data"))
+		})
+	}
+}
+
+func TestPersistString(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(cfgPath, []byte("[app]\n# keep me\ndata_dir = \"./old\"\n"), 0600))
+
+	_, err := Load(cfgPath)
+	require.NoError(t, err)
+
+	updated, err := PersistString("app.data_dir", "/new/data")
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, "/new/data", viper.GetString("app.data_dir"))
+
+	content, err := os.ReadFile(cfgPath)
+	require.NoError(t, err)
+	assert.Equal(t, "[app]\n# keep me\ndata_dir = \"/new/data\"\n", string(content))
+
+	info, err := os.Stat(cfgPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// Overridden by environment variable: the file is left alone
+	t.Setenv("RCLONESYNC_APP_DATA_DIR", "/env/data")
+	updated, err = PersistString("app.data_dir", "/other")
+	require.NoError(t, err)
+	assert.False(t, updated)
+	content, err = os.ReadFile(cfgPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "/new/data")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/mattn/go-sqlite3" // SQLite driver for database/sql
 	"go.uber.org/zap"
@@ -21,10 +22,12 @@ func log() *zap.Logger {
 
 // InitDBOptions contains options for database initialization.
 type InitDBOptions struct {
-	DSN           string        // SQLite DSN connection string (e.g., "file:data.db?cache=shared&_fk=1")
-	MigrationMode MigrationMode // Migration mode (versioned or auto)
-	EnableDebug   bool          // Enable SQL debug logging
-	Environment   string        // Application environment (for migrations)
+	DSN              string        // SQLite DSN connection string (e.g., "file:data.db?cache=shared&_fk=1")
+	MigrationMode    MigrationMode // Migration mode (versioned or auto)
+	EnableDebug      bool          // Enable SQL debug logging
+	Environment      string        // Application environment (for migrations)
+	AllowAutoMigrate bool          // Allow migrating an existing database at startup in production
+	EnableTracing    bool          // Record a tracing span for every statement and transaction
 }
 
 // InitDB initializes the database connection and runs migrations.
@@ -37,7 +40,10 @@ func InitDB(opts InitDBOptions) (*ent.Client, error) {
 	}
 
 	// Create ent driver
-	drv := entsql.OpenDB("sqlite3", sqlDB)
+	var drv dialect.Driver = entsql.OpenDB("sqlite3", sqlDB)
+	if opts.EnableTracing {
+		drv = newTracedDriver(drv)
+	}
 
 	// Create ent client with optional debug logging
 	options := []ent.Option{ent.Driver(drv)}
@@ -46,6 +52,13 @@ func InitDB(opts InitDBOptions) (*ent.Client, error) {
 	}
 	client := ent.NewClient(options...)
 
+	if err := checkStartupMigration(sqlDB, opts); err != nil {
+		if closeErr := client.Close(); closeErr != nil {
+			log().Warn("Failed to close client after migration check error", zap.Error(closeErr))
+		}
+		return nil, err
+	}
+
 	// Execute migrations based on mode
 	switch opts.MigrationMode {
 	case MigrationModeAuto:
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"os"
 	"path/filepath"
@@ -11,6 +12,9 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // TestFileSDN_WALMode tests that WAL journal mode is properly enabled
@@ -310,6 +314,39 @@ func TestCloseDB_NilClient(t *testing.T) {
 	CloseDB(nil)
 }
 
+// TestInitDB_WithTracing tests that statements and transactions are recorded as spans
+func TestInitDB_WithTracing(t *testing.T) {
+	original := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client, err := InitDB(InitDBOptions{
+		DSN:           InMemoryDSN(),
+		MigrationMode: MigrationModeAuto,
+		EnableTracing: true,
+	})
+	require.NoError(t, err)
+	defer CloseDB(client)
+
+	// Skip the spans of the migration
+	migrationSpans := len(recorder.Ended())
+	ctx := context.Background()
+	_, err = client.Task.Query().Count(ctx)
+	require.NoError(t, err)
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tx.Job.Query().Count(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	var names []string
+	for _, span := range recorder.Ended()[migrationSpans:] {
+		names = append(names, span.Name())
+	}
+	assert.Equal(t, []string{"db.query", "db.query", "db.tx"}, names)
+}
+
 // TestFileSDN_ConcurrentWrites tests that FileSDN configuration allows concurrent writes
 // from multiple goroutines using WAL mode and busy_timeout
 func TestFileSDN_ConcurrentWrites(t *testing.T) {
@@ -2,5 +2,5 @@ package db
 
 import "embed"
 
-//go:embed migrations/*.up.sql
+//go:embed migrations/*.sql
 var migrations embed.FS
@@ -1,3 +1,3 @@
 package db
 
-//go:generate go tool ent generate ./schema --target ../ent
+//go:generate go tool ent generate --feature sql/execquery ./schema --target ../ent
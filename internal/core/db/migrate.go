@@ -5,10 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"go.uber.org/zap"
 )
 
@@ -48,31 +52,39 @@ func (l *migrateLogger) Verbose() bool {
 	return l.environment == "development"
 }
 
-// Migrate executes database migrations from embedded SQL files.
-// The environment parameter is used for logging verbosity control.
-func Migrate(db *sql.DB, environment string) error {
-	// 1. Create migration source from embed.FS (specify migrations subdirectory)
-	source, err := iofs.New(migrations, "migrations")
+// ErrAutoMigrateNotAllowed is returned at startup in production when the database would be
+// migrated implicitly without database.allow_auto_migrate being set.
+const ErrAutoMigrateNotAllowed = errs.ConstError("automatic migration is not allowed in production")
+
+// newMigrate creates a golang-migrate instance reading the embedded migration files.
+// The returned source driver can be used to inspect the available migrations.
+func newMigrate(db *sql.DB) (*migrate.Migrate, source.Driver, error) {
+	src, err := iofs.New(migrations, "migrations")
 	if err != nil {
-		return fmt.Errorf("failed to create migration source: %w", err)
+		return nil, nil, fmt.Errorf("failed to create migration source: %w", err)
 	}
 
-	// 2. Create SQLite database driver
 	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{})
 	if err != nil {
-		return fmt.Errorf("failed to create database driver: %w", err)
+		return nil, nil, fmt.Errorf("failed to create database driver: %w", err)
 	}
 
-	// 3. Create migrate instance
-	m, err := migrate.NewWithInstance("iofs", source, "sqlite3", driver)
+	m, err := migrate.NewWithInstance("iofs", src, "sqlite3", driver)
 	if err != nil {
-		return fmt.Errorf("failed to create migrate instance: %w", err)
+		return nil, nil, fmt.Errorf("failed to create migrate instance: %w", err)
 	}
+	return m, src, nil
+}
 
-	// 4. Set logger
+// Migrate executes database migrations from embedded SQL files.
+// The environment parameter is used for logging verbosity control.
+func Migrate(db *sql.DB, environment string) error {
+	m, _, err := newMigrate(db)
+	if err != nil {
+		return err
+	}
 	m.Log = &migrateLogger{environment: environment}
 
-	// 5. Execute migrations
 	if err := m.Up(); err != nil {
 		if errors.Is(err, migrate.ErrNoChange) {
 			log().Info("No pending migrations")
@@ -85,6 +97,28 @@ func Migrate(db *sql.DB, environment string) error {
 	return nil
 }
 
+// MigrateDown rolls back the given number of applied migrations.
+func MigrateDown(db *sql.DB, environment string, steps int) error {
+	// Validate the rollback first: golang-migrate treats a missing down file as a no-op
+	// and would only lower the recorded version.
+	if _, err := PlanRollback(db, steps); err != nil {
+		return err
+	}
+
+	m, _, err := newMigrate(db)
+	if err != nil {
+		return err
+	}
+	m.Log = &migrateLogger{environment: environment}
+
+	if err := m.Steps(-steps); err != nil {
+		return fmt.Errorf("rollback failed: %w", err)
+	}
+
+	log().Info("Rollback completed successfully", zap.Int("steps", steps))
+	return nil
+}
+
 // MigrationStatus represents the current migration status.
 type MigrationStatus struct {
 	Version uint  // Current migration version
@@ -94,22 +128,9 @@ type MigrationStatus struct {
 
 // GetMigrationStatus returns the current migration status.
 func GetMigrationStatus(db *sql.DB) (*MigrationStatus, error) {
-	// Create migration source from embed.FS
-	source, err := iofs.New(migrations, "migrations")
+	m, _, err := newMigrate(db)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create migration source: %w", err)
-	}
-
-	// Create SQLite database driver
-	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create database driver: %w", err)
-	}
-
-	// Create migrate instance
-	m, err := migrate.NewWithInstance("iofs", source, "sqlite3", driver)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create migrate instance: %w", err)
+		return nil, err
 	}
 
 	version, dirty, err := m.Version()
@@ -126,22 +147,9 @@ func GetMigrationStatus(db *sql.DB) (*MigrationStatus, error) {
 
 // GetPendingMigrations returns the list of pending migration versions.
 func GetPendingMigrations(db *sql.DB) ([]uint, error) {
-	// Create migration source from embed.FS
-	source, err := iofs.New(migrations, "migrations")
-	if err != nil {
-		return nil, fmt.Errorf("failed to create migration source: %w", err)
-	}
-
-	// Create SQLite database driver
-	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create database driver: %w", err)
-	}
-
-	// Create migrate instance
-	m, err := migrate.NewWithInstance("iofs", source, "sqlite3", driver)
+	m, source, err := newMigrate(db)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create migrate instance: %w", err)
+		return nil, err
 	}
 
 	// Get current version
@@ -193,3 +201,116 @@ func LogMigrationStatus(db *sql.DB) {
 		zap.Int("pending_count", len(pending)),
 	)
 }
+
+// PlannedMigration is a migration that would be executed, together with its SQL.
+type PlannedMigration struct {
+	Version uint   // Migration version
+	Name    string // Migration name, e.g. "add_job_parent"
+	SQL     string // SQL statements that would be executed
+}
+
+// PlanMigrations returns the pending migrations with the SQL that `Migrate` would execute,
+// without changing the database.
+func PlanMigrations(db *sql.DB) ([]PlannedMigration, error) {
+	pending, err := GetPendingMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	_, src, err := newMigrate(db)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := make([]PlannedMigration, 0, len(pending))
+	for _, version := range pending {
+		p, err := readMigration(version, src.ReadUp)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, p)
+	}
+	return plan, nil
+}
+
+// PlanRollback returns the applied migrations, newest first, with the SQL that
+// `MigrateDown` would execute for the given number of steps, without changing the database.
+func PlanRollback(db *sql.DB, steps int) ([]PlannedMigration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("%w: steps must be positive", errs.ErrInvalidInput)
+	}
+
+	status, err := GetMigrationStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	_, src, err := newMigrate(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []PlannedMigration
+	for version := status.Version; version != 0 && len(plan) < steps; {
+		p, err := readMigration(version, src.ReadDown)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, p)
+
+		prev, err := src.Prev(version)
+		if err != nil {
+			break //nolint:nilerr // First migration reached, rolling back leaves an empty database
+		}
+		version = prev
+	}
+	if len(plan) < steps {
+		return nil, fmt.Errorf("%w: only %d migration(s) applied", errs.ErrInvalidInput, len(plan))
+	}
+	return plan, nil
+}
+
+// readMigration reads the SQL of a migration file using the given source read function.
+func readMigration(version uint, read func(uint) (io.ReadCloser, string, error)) (PlannedMigration, error) {
+	r, name, err := read(version)
+	if err != nil {
+		return PlannedMigration{}, fmt.Errorf("failed to read migration %d: %w", version, err)
+	}
+	defer r.Close()
+
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return PlannedMigration{}, fmt.Errorf("failed to read migration %d: %w", version, err)
+	}
+	return PlannedMigration{Version: version, Name: name, SQL: string(body)}, nil
+}
+
+// checkStartupMigration refuses implicit schema changes at startup in production unless
+// opts.AllowAutoMigrate is set. Initializing a fresh database is always allowed.
+func checkStartupMigration(db *sql.DB, opts InitDBOptions) error {
+	if opts.AllowAutoMigrate || opts.Environment != string(logger.EnvironmentProduction) {
+		return nil
+	}
+
+	if opts.MigrationMode == MigrationModeAuto {
+		return fmt.Errorf("%w: use versioned migration mode or set database.allow_auto_migrate", ErrAutoMigrateNotAllowed)
+	}
+
+	status, err := GetMigrationStatus(db)
+	if err != nil {
+		return err
+	}
+	if status.Version == 0 {
+		return nil
+	}
+
+	pending, err := GetPendingMigrations(db)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending migration(s), run `rclone-sync migrate up` or set database.allow_auto_migrate",
+			ErrAutoMigrateNotAllowed, len(pending))
+	}
+	return nil
+}
@@ -1,13 +1,22 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"os"
 	"testing"
+	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 )
 
@@ -172,3 +181,236 @@ func TestMigrationModeConstants(t *testing.T) {
 	assert.Equal(t, MigrationMode("versioned"), MigrationModeVersioned)
 	assert.Equal(t, MigrationMode("auto"), MigrationModeAuto)
 }
+
+func TestMigrateDown(t *testing.T) {
+	db, cleanup := createTestDB(t)
+	defer cleanup()
+
+	require.NoError(t, Migrate(db, "test"))
+	before, err := GetMigrationStatus(db)
+	require.NoError(t, err)
+
+	require.NoError(t, MigrateDown(db, "test", 1))
+
+	pending, err := GetPendingMigrations(db)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{before.Version}, pending)
+
+	// Rolling back more migrations than applied fails without changing the database
+	err = MigrateDown(db, "test", 100)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	pending, err = GetPendingMigrations(db)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	// Re-applying works after a rollback
+	require.NoError(t, Migrate(db, "test"))
+	after, err := GetMigrationStatus(db)
+	require.NoError(t, err)
+	assert.Equal(t, before.Version, after.Version)
+}
+
+func TestPlanMigrations(t *testing.T) {
+	db, cleanup := createTestDB(t)
+	defer cleanup()
+
+	plan, err := PlanMigrations(db)
+	require.NoError(t, err)
+	require.NotEmpty(t, plan)
+	assert.Equal(t, "initial", plan[0].Name)
+	assert.Contains(t, plan[0].SQL, "CREATE TABLE")
+
+	// Planning does not touch the database
+	status, err := GetMigrationStatus(db)
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), status.Version)
+
+	require.NoError(t, Migrate(db, "test"))
+	plan, err = PlanMigrations(db)
+	require.NoError(t, err)
+	assert.Empty(t, plan)
+}
+
+func TestPlanRollback(t *testing.T) {
+	db, cleanup := createTestDB(t)
+	defer cleanup()
+
+	require.NoError(t, Migrate(db, "test"))
+	status, err := GetMigrationStatus(db)
+	require.NoError(t, err)
+
+	plan, err := PlanRollback(db, 2)
+	require.NoError(t, err)
+	require.Len(t, plan, 2)
+	assert.Equal(t, status.Version, plan[0].Version)
+	assert.Greater(t, plan[0].Version, plan[1].Version)
+	assert.NotEmpty(t, plan[0].SQL)
+
+	_, err = PlanRollback(db, 0)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestCheckStartupMigration(t *testing.T) {
+	production := string(logger.EnvironmentProduction)
+
+	t.Run("fresh database is allowed in production", func(t *testing.T) {
+		db, cleanup := createTestDB(t)
+		defer cleanup()
+
+		err := checkStartupMigration(db, InitDBOptions{Environment: production})
+		assert.NoError(t, err)
+	})
+
+	t.Run("pending migrations are refused in production", func(t *testing.T) {
+		db, cleanup := createTestDB(t)
+		defer cleanup()
+		require.NoError(t, Migrate(db, "test"))
+		require.NoError(t, MigrateDown(db, "test", 1))
+
+		err := checkStartupMigration(db, InitDBOptions{Environment: production})
+		assert.ErrorIs(t, err, ErrAutoMigrateNotAllowed)
+
+		err = checkStartupMigration(db, InitDBOptions{Environment: production, AllowAutoMigrate: true})
+		assert.NoError(t, err)
+
+		err = checkStartupMigration(db, InitDBOptions{Environment: "development"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("up-to-date database is allowed in production", func(t *testing.T) {
+		db, cleanup := createTestDB(t)
+		defer cleanup()
+		require.NoError(t, Migrate(db, "test"))
+
+		err := checkStartupMigration(db, InitDBOptions{Environment: production})
+		assert.NoError(t, err)
+	})
+
+	t.Run("auto migration mode is refused in production", func(t *testing.T) {
+		db, cleanup := createTestDB(t)
+		defer cleanup()
+
+		err := checkStartupMigration(db, InitDBOptions{Environment: production, MigrationMode: MigrationModeAuto})
+		assert.ErrorIs(t, err, ErrAutoMigrateNotAllowed)
+	})
+}
+
+func TestMigrate_BackfillJobUUIDv7(t *testing.T) {
+	db, cleanup := createTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Create jobs with random IDs as before, then re-run the backfill migration
+	require.NoError(t, Migrate(db, "test"))
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+
+	conn, err := client.Connection.Create().
+		SetName("local").
+		SetType("local").
+		SetEncryptedConfig([]byte{}).
+		Save(ctx)
+	require.NoError(t, err)
+	tsk, err := client.Task.Create().
+		SetName("task").
+		SetSourcePath("/tmp/source").
+		SetRemotePath("/remote").
+		SetConnection(conn).
+		Save(ctx)
+	require.NoError(t, err)
+
+	start := time.Date(2026, 10, 17, 8, 30, 15, 123456789, time.UTC)
+	parent, err := client.Job.Create().
+		SetID(uuid.New()).
+		SetTask(tsk).
+		SetTrigger(model.JobTriggerManual).
+		SetStartTime(start).
+		Save(ctx)
+	require.NoError(t, err)
+	child, err := client.Job.Create().
+		SetID(uuid.New()).
+		SetTask(tsk).
+		SetParentID(parent.ID).
+		SetTrigger(model.JobTriggerManual).
+		SetStartTime(start.Add(time.Second)).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.JobLog.Create().
+		SetJobID(child.ID).
+		SetLevel(model.LogLevelInfo).
+		SetWhat(model.LogActionUpload).
+		SetPath("file.txt").
+		Save(ctx)
+	require.NoError(t, err)
+
+	m, _, err := newMigrate(db)
+	require.NoError(t, err)
+	// Migrate down to add_job_log_previous_path, the version before the backfill
+	require.NoError(t, m.Migrate(20261017091530))
+	require.NoError(t, Migrate(db, "test"))
+
+	jobs, err := client.Job.Query().Order(ent.Asc(job.FieldID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	for i, want := range []time.Time{start, start.Add(time.Second)} {
+		assert.Equal(t, uuid.Version(7), jobs[i].ID.Version())
+		sec, nsec := jobs[i].ID.Time().UnixTime()
+		assert.Equal(t, want.UnixMilli(), time.Unix(sec, nsec).UnixMilli(), "ID is derived from the start time")
+	}
+	assert.Equal(t, parent.ID[8:], jobs[0].ID[8:], "random bits of the previous ID are kept")
+	require.NotNil(t, jobs[1].ParentID)
+	assert.Equal(t, jobs[0].ID, *jobs[1].ParentID)
+
+	logs, err := client.JobLog.Query().Where(joblog.JobID(jobs[1].ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, logs)
+}
+
+func TestMigrate_NormalizeConnectionTypes(t *testing.T) {
+	db, cleanup := createTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, Migrate(db, "test"))
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+
+	// Types as they could be stored before connection types were validated
+	stored := map[string]string{
+		"upper":   " OneDrive ",
+		"gcs":     "google cloud storage",
+		"photos":  "google photos",
+		"oracle":  "oracleobjectstorage",
+		"valid":   "s3",
+		"unknown": "onedrve",
+	}
+	for name, connType := range stored {
+		_, err := client.Connection.Create().
+			SetName(name).
+			SetType(model.ConnectionTypeLocal).
+			SetEncryptedConfig([]byte{}).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = db.Exec("UPDATE `connections` SET `type` = ? WHERE `name` = ?", connType, name)
+		require.NoError(t, err)
+	}
+
+	m, _, err := newMigrate(db)
+	require.NoError(t, err)
+	// Migrate down to add_share_tokens, the version before the normalization
+	require.NoError(t, m.Migrate(20261018001245))
+	require.NoError(t, Migrate(db, "test"))
+
+	conns, err := client.Connection.Query().All(ctx)
+	require.NoError(t, err)
+	types := make(map[string]model.ConnectionType, len(conns))
+	for _, conn := range conns {
+		types[conn.Name] = conn.Type
+	}
+	assert.Equal(t, map[string]model.ConnectionType{
+		"upper":   model.ConnectionTypeOnedrive,
+		"gcs":     model.ConnectionTypeGcs,
+		"photos":  model.ConnectionTypeGphotos,
+		"oracle":  model.ConnectionTypeOos,
+		"valid":   model.ConnectionTypeS3,
+		"unknown": "onedrve",
+	}, types, "unknown types are kept, they can't be mapped to a backend")
+}
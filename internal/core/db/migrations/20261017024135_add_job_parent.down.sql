@@ -0,0 +1,4 @@
+-- reverse: create index "job_parent_id" to table: "jobs"
+DROP INDEX `job_parent_id`;
+-- reverse: add column "parent_id" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `parent_id`;
@@ -0,0 +1,4 @@
+-- add column "parent_id" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `parent_id` uuid NULL CONSTRAINT `jobs_jobs_children` REFERENCES `jobs` (`id`) ON DELETE CASCADE;
+-- create index "job_parent_id" to table: "jobs"
+CREATE INDEX `job_parent_id` ON `jobs` (`parent_id`);
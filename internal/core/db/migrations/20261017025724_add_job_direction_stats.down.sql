@@ -0,0 +1,8 @@
+-- reverse: add column "downloaded_bytes" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `downloaded_bytes`;
+-- reverse: add column "downloaded_files" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `downloaded_files`;
+-- reverse: add column "uploaded_bytes" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `uploaded_bytes`;
+-- reverse: add column "uploaded_files" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `uploaded_files`;
@@ -0,0 +1,8 @@
+-- add column "uploaded_files" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `uploaded_files` integer NOT NULL DEFAULT (0);
+-- add column "uploaded_bytes" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `uploaded_bytes` integer NOT NULL DEFAULT (0);
+-- add column "downloaded_files" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `downloaded_files` integer NOT NULL DEFAULT (0);
+-- add column "downloaded_bytes" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `downloaded_bytes` integer NOT NULL DEFAULT (0);
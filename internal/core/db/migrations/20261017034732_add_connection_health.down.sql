@@ -0,0 +1,6 @@
+-- reverse: add column "health_error" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `health_error`;
+-- reverse: add column "health_checked_at" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `health_checked_at`;
+-- reverse: add column "health_status" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `health_status`;
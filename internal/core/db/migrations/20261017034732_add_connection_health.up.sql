@@ -0,0 +1,6 @@
+-- add column "health_status" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `health_status` text NULL;
+-- add column "health_checked_at" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `health_checked_at` datetime NULL;
+-- add column "health_error" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `health_error` text NULL;
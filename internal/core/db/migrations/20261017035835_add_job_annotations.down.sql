@@ -0,0 +1,6 @@
+-- reverse: add column "annotated_at" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `annotated_at`;
+-- reverse: add column "acknowledged" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `acknowledged`;
+-- reverse: add column "note" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `note`;
@@ -0,0 +1,6 @@
+-- add column "note" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `note` text NULL;
+-- add column "acknowledged" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `acknowledged` bool NOT NULL DEFAULT (false);
+-- add column "annotated_at" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `annotated_at` datetime NULL;
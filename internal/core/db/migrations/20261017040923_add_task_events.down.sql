@@ -0,0 +1,6 @@
+-- reverse: create index "taskevent_task_id_time" to table: "task_events"
+DROP INDEX `taskevent_task_id_time`;
+-- reverse: create "task_events" table
+DROP TABLE `task_events`;
+-- reverse: add column "skipped_runs" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `skipped_runs`;
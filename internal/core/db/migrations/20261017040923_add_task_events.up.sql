@@ -0,0 +1,6 @@
+-- add column "skipped_runs" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `skipped_runs` integer NOT NULL DEFAULT (0);
+-- create "task_events" table
+CREATE TABLE `task_events` (`id` uuid NOT NULL, `type` text NOT NULL, `message` text NULL, `time` datetime NOT NULL, `task_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `task_events_tasks_events` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE);
+-- create index "taskevent_task_id_time" to table: "task_events"
+CREATE INDEX `taskevent_task_id_time` ON `task_events` (`task_id`, `time`);
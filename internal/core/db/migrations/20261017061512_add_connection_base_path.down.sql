@@ -0,0 +1,2 @@
+-- reverse: add column "base_path" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `base_path`;
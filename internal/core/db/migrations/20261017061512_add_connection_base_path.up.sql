@@ -0,0 +1,2 @@
+-- add column "base_path" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `base_path` text NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "engine" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `engine`;
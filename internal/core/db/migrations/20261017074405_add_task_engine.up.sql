@@ -0,0 +1,2 @@
+-- add column "engine" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `engine` text NOT NULL DEFAULT ('rclone');
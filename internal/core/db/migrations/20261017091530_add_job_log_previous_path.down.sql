@@ -0,0 +1,2 @@
+-- reverse: add column "previous_path" to table: "job_logs"
+ALTER TABLE `job_logs` DROP COLUMN `previous_path`;
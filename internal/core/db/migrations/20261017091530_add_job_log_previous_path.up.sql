@@ -0,0 +1,2 @@
+-- add column "previous_path" to table: "job_logs"
+ALTER TABLE `job_logs` ADD COLUMN `previous_path` text NULL;
@@ -0,0 +1,3 @@
+-- reverse: backfill time-ordered job IDs
+-- the time-ordered IDs are kept, they are valid job IDs regardless of the configured UUID version
+SELECT 1;
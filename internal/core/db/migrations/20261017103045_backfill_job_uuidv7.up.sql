@@ -0,0 +1,12 @@
+-- defer foreign key checks to the end of the migration, jobs and their references are updated separately
+PRAGMA defer_foreign_keys = ON;
+-- map existing random job IDs to time-ordered (version 7) UUIDs: the start time in milliseconds
+-- replaces the leading 48 bits, the random bits of the previous ID are kept
+CREATE TEMPORARY TABLE `job_id_map` (`old_id` uuid NOT NULL, `new_id` uuid NOT NULL, PRIMARY KEY (`old_id`));
+INSERT INTO `job_id_map` (`old_id`, `new_id`) SELECT `id`, substr(`ts`, 1, 8) || '-' || substr(`ts`, 9, 4) || '-7' || substr(`id`, 16, 3) || '-' || substr(`id`, 20, 4) || '-' || substr(`id`, 25, 12) FROM (SELECT `id`, printf('%012x', CAST(round((julianday(`start_time`) - 2440587.5) * 86400000) AS integer)) AS `ts` FROM `jobs` WHERE substr(`id`, 15, 1) <> '7');
+-- modify "jobs" table
+UPDATE `jobs` SET `id` = (SELECT `new_id` FROM `job_id_map` WHERE `old_id` = `jobs`.`id`) WHERE `id` IN (SELECT `old_id` FROM `job_id_map`);
+UPDATE `jobs` SET `parent_id` = (SELECT `new_id` FROM `job_id_map` WHERE `old_id` = `jobs`.`parent_id`) WHERE `parent_id` IN (SELECT `old_id` FROM `job_id_map`);
+-- modify "job_logs" table
+UPDATE `job_logs` SET `job_id` = (SELECT `new_id` FROM `job_id_map` WHERE `old_id` = `job_logs`.`job_id`) WHERE `job_id` IN (SELECT `old_id` FROM `job_id_map`);
+DROP TABLE `job_id_map`;
@@ -0,0 +1,6 @@
+-- purge soft deleted tasks, they would otherwise become active again
+DELETE FROM `tasks` WHERE `deleted_at` IS NOT NULL;
+-- reverse: create index "task_deleted_at" to table: "tasks"
+DROP INDEX `task_deleted_at`;
+-- reverse: add column "deleted_at" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `deleted_at`;
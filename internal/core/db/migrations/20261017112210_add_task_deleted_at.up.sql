@@ -0,0 +1,4 @@
+-- add column "deleted_at" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `deleted_at` datetime NULL;
+-- create index "task_deleted_at" to table: "tasks"
+CREATE INDEX `task_deleted_at` ON `tasks` (`deleted_at`);
@@ -0,0 +1,2 @@
+-- reverse: add column "consecutive_failures" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `consecutive_failures`;
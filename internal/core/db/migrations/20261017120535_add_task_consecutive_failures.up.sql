@@ -0,0 +1,2 @@
+-- add column "consecutive_failures" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `consecutive_failures` integer NOT NULL DEFAULT (0);
@@ -0,0 +1,2 @@
+-- reverse: add column "trace_id" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `trace_id`;
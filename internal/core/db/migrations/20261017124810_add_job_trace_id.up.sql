@@ -0,0 +1,2 @@
+-- add column "trace_id" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `trace_id` text NULL;
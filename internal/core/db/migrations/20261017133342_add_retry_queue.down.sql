@@ -0,0 +1,6 @@
+-- reverse: create index "retryqueue_task_id_retry_job_id" to table: "retry_queues"
+DROP INDEX `retryqueue_task_id_retry_job_id`;
+-- reverse: create index "retryqueue_job_id" to table: "retry_queues"
+DROP INDEX `retryqueue_job_id`;
+-- reverse: create "retry_queues" table
+DROP TABLE `retry_queues`;
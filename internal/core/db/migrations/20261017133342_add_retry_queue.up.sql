@@ -0,0 +1,6 @@
+-- create "retry_queues" table
+CREATE TABLE `retry_queues` (`id` uuid NOT NULL, `task_id` uuid NOT NULL, `path` text NOT NULL, `direction` text NOT NULL, `error_class` text NOT NULL, `error` text NOT NULL, `created_at` datetime NOT NULL, `requested_at` datetime NULL, `retry_job_id` uuid NULL, `job_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `retry_queues_jobs_retry_queue` FOREIGN KEY (`job_id`) REFERENCES `jobs` (`id`) ON DELETE CASCADE);
+-- create index "retryqueue_job_id" to table: "retry_queues"
+CREATE INDEX `retryqueue_job_id` ON `retry_queues` (`job_id`);
+-- create index "retryqueue_task_id_retry_job_id" to table: "retry_queues"
+CREATE INDEX `retryqueue_task_id_retry_job_id` ON `retry_queues` (`task_id`, `retry_job_id`);
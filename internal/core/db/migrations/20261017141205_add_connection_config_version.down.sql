@@ -0,0 +1,4 @@
+-- reverse: add column "connection_config_version" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `connection_config_version`;
+-- reverse: add column "config_version" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `config_version`;
@@ -0,0 +1,4 @@
+-- add column "config_version" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `config_version` integer NOT NULL DEFAULT (1);
+-- add column "connection_config_version" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `connection_config_version` integer NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "trigger_detail" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `trigger_detail`;
@@ -0,0 +1,2 @@
+-- add column "trigger_detail" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `trigger_detail` json NULL;
@@ -0,0 +1,4 @@
+-- reverse: create index "jobevent_job_id" to table: "job_events"
+DROP INDEX `jobevent_job_id`;
+-- reverse: create "job_events" table
+DROP TABLE `job_events`;
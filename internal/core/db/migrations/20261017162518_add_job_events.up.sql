@@ -0,0 +1,4 @@
+-- create "job_events" table
+CREATE TABLE `job_events` (`id` uuid NOT NULL, `type` text NOT NULL, `command` text NOT NULL, `args` json NULL, `exit_code` integer NULL, `duration_ms` integer NOT NULL DEFAULT 0, `output` text NOT NULL DEFAULT '', `output_truncated` bool NOT NULL DEFAULT false, `error` text NULL, `created_at` datetime NOT NULL, `job_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `job_events_jobs_events` FOREIGN KEY (`job_id`) REFERENCES `jobs` (`id`) ON DELETE CASCADE);
+-- create index "jobevent_job_id" to table: "job_events"
+CREATE INDEX `jobevent_job_id` ON `job_events` (`job_id`);
@@ -0,0 +1,4 @@
+-- reverse: create index "connectionusage_connection_id_day" to table: "connection_usages"
+DROP INDEX `connectionusage_connection_id_day`;
+-- reverse: create "connection_usages" table
+DROP TABLE `connection_usages`;
@@ -0,0 +1,4 @@
+-- create "connection_usages" table
+CREATE TABLE `connection_usages` (`id` uuid NOT NULL, `day` datetime NOT NULL, `used` integer NOT NULL, `total` integer NULL, `free` integer NULL, `connection_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `connection_usages_connections_usage` FOREIGN KEY (`connection_id`) REFERENCES `connections` (`id`) ON DELETE CASCADE);
+-- create index "connectionusage_connection_id_day" to table: "connection_usages"
+CREATE UNIQUE INDEX `connectionusage_connection_id_day` ON `connection_usages` (`connection_id`, `day`);
@@ -0,0 +1,6 @@
+-- reverse: create index "idempotencykey_created_at" to table: "idempotency_keys"
+DROP INDEX `idempotencykey_created_at`;
+-- reverse: create index "idempotencykey_key_operation" to table: "idempotency_keys"
+DROP INDEX `idempotencykey_key_operation`;
+-- reverse: create "idempotency_keys" table
+DROP TABLE `idempotency_keys`;
@@ -0,0 +1,6 @@
+-- create "idempotency_keys" table
+CREATE TABLE `idempotency_keys` (`id` uuid NOT NULL, `key` text NOT NULL, `operation` text NOT NULL, `request_hash` text NOT NULL, `result_id` uuid NULL, `created_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create index "idempotencykey_key_operation" to table: "idempotency_keys"
+CREATE UNIQUE INDEX `idempotencykey_key_operation` ON `idempotency_keys` (`key`, `operation`);
+-- create index "idempotencykey_created_at" to table: "idempotency_keys"
+CREATE INDEX `idempotencykey_created_at` ON `idempotency_keys` (`created_at`);
@@ -0,0 +1,4 @@
+-- reverse: add column "tps_burst" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `tps_burst`;
+-- reverse: add column "tps_limit" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `tps_limit`;
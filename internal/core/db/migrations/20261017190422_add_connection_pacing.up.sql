@@ -0,0 +1,4 @@
+-- add column "tps_limit" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `tps_limit` real NULL;
+-- add column "tps_burst" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `tps_burst` integer NULL;
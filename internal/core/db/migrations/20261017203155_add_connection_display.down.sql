@@ -0,0 +1,6 @@
+-- reverse: add column "icon" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `icon`;
+-- reverse: add column "color" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `color`;
+-- reverse: add column "display_name" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `display_name`;
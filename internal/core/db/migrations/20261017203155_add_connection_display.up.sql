@@ -0,0 +1,6 @@
+-- add column "display_name" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `display_name` text NULL;
+-- add column "color" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `color` text NULL;
+-- add column "icon" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `icon` text NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "task_config_hash" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `task_config_hash`;
@@ -0,0 +1,2 @@
+-- add column "task_config_hash" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `task_config_hash` text NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "credentials_expire_at" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `credentials_expire_at`;
@@ -0,0 +1,2 @@
+-- add column "credentials_expire_at" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `credentials_expire_at` datetime NULL;
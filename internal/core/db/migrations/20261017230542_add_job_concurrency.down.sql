@@ -0,0 +1,2 @@
+-- reverse: add column "concurrency" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `concurrency`;
@@ -0,0 +1,2 @@
+-- add column "concurrency" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `concurrency` json NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "ephemeral" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `ephemeral`;
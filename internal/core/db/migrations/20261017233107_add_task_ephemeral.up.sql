@@ -0,0 +1,2 @@
+-- add column "ephemeral" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `ephemeral` bool NOT NULL DEFAULT (false);
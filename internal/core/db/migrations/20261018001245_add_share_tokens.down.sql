@@ -0,0 +1,6 @@
+-- reverse: create index "sharetoken_created_at" to table: "share_tokens"
+DROP INDEX `sharetoken_created_at`;
+-- reverse: create index "sharetoken_token_hash" to table: "share_tokens"
+DROP INDEX `sharetoken_token_hash`;
+-- reverse: create "share_tokens" table
+DROP TABLE `share_tokens`;
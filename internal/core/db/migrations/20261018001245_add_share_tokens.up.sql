@@ -0,0 +1,6 @@
+-- create "share_tokens" table
+CREATE TABLE `share_tokens` (`id` uuid NOT NULL, `name` text NOT NULL, `token_hash` text NOT NULL, `scope` text NOT NULL, `path` text NULL, `expires_at` datetime NOT NULL, `last_used_at` datetime NULL, `use_count` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `connection_id` uuid NULL, `task_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `share_tokens_connections_share_tokens` FOREIGN KEY (`connection_id`) REFERENCES `connections` (`id`) ON DELETE CASCADE, CONSTRAINT `share_tokens_tasks_share_tokens` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE);
+-- create index "sharetoken_token_hash" to table: "share_tokens"
+CREATE UNIQUE INDEX `sharetoken_token_hash` ON `share_tokens` (`token_hash`);
+-- create index "sharetoken_created_at" to table: "share_tokens"
+CREATE INDEX `sharetoken_created_at` ON `share_tokens` (`created_at`);
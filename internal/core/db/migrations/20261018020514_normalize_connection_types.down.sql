@@ -0,0 +1,3 @@
+-- reverse: normalize connection types
+-- the normalized types are kept, rclone resolves them to the same backends
+SELECT 1;
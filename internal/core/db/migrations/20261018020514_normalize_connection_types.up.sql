@@ -0,0 +1,6 @@
+-- normalize connection types to the config types of the rclone backends, which are lower case
+UPDATE `connections` SET `type` = lower(trim(`type`)) WHERE `type` <> lower(trim(`type`));
+-- backends whose name differs from their config type are stored by config type
+UPDATE `connections` SET `type` = 'gcs' WHERE `type` = 'google cloud storage';
+UPDATE `connections` SET `type` = 'gphotos' WHERE `type` = 'google photos';
+UPDATE `connections` SET `type` = 'oos' WHERE `type` = 'oracleobjectstorage';
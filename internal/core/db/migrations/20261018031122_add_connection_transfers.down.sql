@@ -0,0 +1,6 @@
+-- reverse: create index "connectiontransfer_connection_id_month" to table: "connection_transfers"
+DROP INDEX `connectiontransfer_connection_id_month`;
+-- reverse: create "connection_transfers" table
+DROP TABLE `connection_transfers`;
+-- reverse: add column "monthly_transfer_cap" to table: "connections"
+ALTER TABLE `connections` DROP COLUMN `monthly_transfer_cap`;
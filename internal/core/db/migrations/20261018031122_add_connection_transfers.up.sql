@@ -0,0 +1,6 @@
+-- add column "monthly_transfer_cap" to table: "connections"
+ALTER TABLE `connections` ADD COLUMN `monthly_transfer_cap` integer NULL;
+-- create "connection_transfers" table
+CREATE TABLE `connection_transfers` (`id` uuid NOT NULL, `month` datetime NOT NULL, `bytes` integer NOT NULL DEFAULT 0, `connection_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `connection_transfers_connections_transfers` FOREIGN KEY (`connection_id`) REFERENCES `connections` (`id`) ON DELETE CASCADE);
+-- create index "connectiontransfer_connection_id_month" to table: "connection_transfers"
+CREATE UNIQUE INDEX `connectiontransfer_connection_id_month` ON `connection_transfers` (`connection_id`, `month`);
@@ -0,0 +1,2 @@
+-- reverse: add column "tags" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `tags`;
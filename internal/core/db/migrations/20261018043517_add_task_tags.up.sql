@@ -0,0 +1,2 @@
+-- add column "tags" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `tags` json NULL;
@@ -0,0 +1,2 @@
+-- reverse: add column "config_snapshot" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `config_snapshot`;
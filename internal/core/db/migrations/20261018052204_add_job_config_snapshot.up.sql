@@ -0,0 +1,2 @@
+-- add column "config_snapshot" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `config_snapshot` json NULL;
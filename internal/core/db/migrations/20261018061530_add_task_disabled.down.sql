@@ -0,0 +1,6 @@
+-- reverse: add column "disabled_reason" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `disabled_reason`;
+-- reverse: add column "disabled" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `disabled`;
+-- reverse: add column "consecutive_fatal_failures" to table: "tasks"
+ALTER TABLE `tasks` DROP COLUMN `consecutive_fatal_failures`;
@@ -0,0 +1,6 @@
+-- add column "consecutive_fatal_failures" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `consecutive_fatal_failures` integer NOT NULL DEFAULT (0);
+-- add column "disabled" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `disabled` bool NOT NULL DEFAULT (false);
+-- add column "disabled_reason" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `disabled_reason` text NULL;
@@ -0,0 +1,4 @@
+-- reverse: add column "listings_done" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `listings_done`;
+-- reverse: add column "files_checked" to table: "jobs"
+ALTER TABLE `jobs` DROP COLUMN `files_checked`;
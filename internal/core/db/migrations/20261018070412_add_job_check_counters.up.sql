@@ -0,0 +1,4 @@
+-- add column "files_checked" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `files_checked` integer NOT NULL DEFAULT (0);
+-- add column "listings_done" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `listings_done` integer NOT NULL DEFAULT (0);
@@ -0,0 +1,8 @@
+-- reverse: create index "connection_health_status" to table: "connections"
+DROP INDEX `connection_health_status`;
+-- reverse: create index "task_disabled_consecutive_failures" to table: "tasks"
+DROP INDEX `task_disabled_consecutive_failures`;
+-- reverse: create index "task_engine" to table: "tasks"
+DROP INDEX `task_engine`;
+-- reverse: create index "task_name" to table: "tasks"
+DROP INDEX `task_name`;
@@ -0,0 +1,8 @@
+-- create index "task_name" to table: "tasks"
+CREATE INDEX `task_name` ON `tasks` (`name`);
+-- create index "task_engine" to table: "tasks"
+CREATE INDEX `task_engine` ON `tasks` (`engine`);
+-- create index "task_disabled_consecutive_failures" to table: "tasks"
+CREATE INDEX `task_disabled_consecutive_failures` ON `tasks` (`disabled`, `consecutive_failures`);
+-- create index "connection_health_status" to table: "connections"
+CREATE INDEX `connection_health_status` ON `connections` (`health_status`);
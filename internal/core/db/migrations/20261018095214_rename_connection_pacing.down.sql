@@ -0,0 +1,4 @@
+-- reverse: rename a column from "tps_burst" to "path_tps_burst"
+ALTER TABLE `connections` RENAME COLUMN `path_tps_burst` TO `tps_burst`;
+-- reverse: rename a column from "tps_limit" to "path_tps_limit"
+ALTER TABLE `connections` RENAME COLUMN `path_tps_limit` TO `tps_limit`;
@@ -0,0 +1,4 @@
+-- rename a column from "tps_limit" to "path_tps_limit"
+ALTER TABLE `connections` RENAME COLUMN `tps_limit` TO `path_tps_limit`;
+-- rename a column from "tps_burst" to "path_tps_burst"
+ALTER TABLE `connections` RENAME COLUMN `tps_burst` TO `path_tps_burst`;
@@ -10,6 +10,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
 )
 
 // Connection holds the schema definition for the Connection entity.
@@ -21,16 +23,58 @@ type Connection struct {
 func (Connection) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New),
+			Default(ids.New),
 		field.String("name").
 			NotEmpty().
 			Unique().
 			Comment("Remote name, must be unique across the system"),
-		field.String("type").
-			NotEmpty().
-			Comment("Provider type, e.g., onedrive, s3, drive, local"),
+		field.Enum("type").
+			GoType(model.ConnectionType("")).
+			Comment("Provider type, the config type of a compiled rclone backend, e.g., onedrive, s3, drive, local"),
 		field.Bytes("encrypted_config").
 			Comment("AES-GCM encrypted configuration JSON"),
+		field.Enum("health_status").
+			GoType(model.ConnectionHealthStatus("")).
+			Optional().
+			Nillable().
+			Comment("Result of the last connection test"),
+		field.Time("health_checked_at").
+			Optional().
+			Nillable(),
+		field.Text("health_error").
+			Optional().
+			Comment("Error message of the last failed connection test"),
+		field.String("base_path").
+			Optional().
+			Comment("Remote path prefix prepended to the remote path of every task of the connection"),
+		field.Float("path_tps_limit").
+			Optional().
+			Nillable().
+			Comment("Maximum API transactions per second of each remote path of the connection, mapped to the pacer of the backend"),
+		field.Int("path_tps_burst").
+			Optional().
+			Nillable().
+			Comment("Number of API transactions allowed in a burst above path_tps_limit"),
+		field.String("display_name").
+			Optional().
+			Comment("Name shown in the UI instead of the connection name"),
+		field.String("color").
+			Optional().
+			Comment("Color of the connection in the UI, as #rrggbb"),
+		field.String("icon").
+			Optional().
+			Comment("Name of the icon of the connection in the UI"),
+		field.Time("credentials_expire_at").
+			Optional().
+			Nillable().
+			Comment("Time the credentials stored in the config lapse unless refreshed, parsed from its OAuth token"),
+		field.Int64("monthly_transfer_cap").
+			Optional().
+			Nillable().
+			Comment("Maximum bytes transferred by the jobs of the connection per calendar month, further jobs are deferred"),
+		field.Int("config_version").
+			Default(1).
+			Comment("Incremented by every user edit of the name, config or base path"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -46,6 +90,7 @@ func (Connection) Indexes() []ent.Index {
 		index.Fields("name").Unique(),
 		index.Fields("type"),
 		index.Fields("created_at"),
+		index.Fields("health_status"), // Sort field of connection.list
 	}
 }
 
@@ -54,5 +99,11 @@ func (Connection) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("tasks", Task.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("usage", ConnectionUsage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("share_tokens", ShareToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transfers", ConnectionTransfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
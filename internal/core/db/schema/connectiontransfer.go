@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ConnectionTransfer holds the schema definition for the ConnectionTransfer entity.
+// A connection transfer is the number of bytes transferred by the jobs of a connection in a calendar month,
+// kept apart from the jobs so that deleting jobs doesn't reset the usage counted against a monthly cap.
+type ConnectionTransfer struct {
+	ent.Schema
+}
+
+// Fields of the ConnectionTransfer.
+func (ConnectionTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.UUID("connection_id", uuid.UUID{}),
+		field.Time("month").
+			Comment("Start of the local calendar month the bytes were transferred in"),
+		field.Int64("bytes").
+			Default(0),
+	}
+}
+
+// Indexes of the ConnectionTransfer.
+func (ConnectionTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connection_id", "month").
+			Unique(),
+	}
+}
+
+// Edges of the ConnectionTransfer.
+func (ConnectionTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("connection", Connection.Type).
+			Ref("transfers").
+			Unique().
+			Required().
+			Field("connection_id"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ConnectionUsage holds the schema definition for the ConnectionUsage entity.
+// A connection usage is the daily sample of the quota of a connection, used to forecast when it runs full.
+type ConnectionUsage struct {
+	ent.Schema
+}
+
+// Fields of the ConnectionUsage.
+func (ConnectionUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.UUID("connection_id", uuid.UUID{}),
+		field.Time("day").
+			Comment("Start of the local day the sample was taken on"),
+		field.Int64("used"),
+		field.Int64("total").
+			Optional().
+			Nillable(),
+		field.Int64("free").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the ConnectionUsage.
+func (ConnectionUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connection_id", "day").
+			Unique(),
+	}
+}
+
+// Edges of the ConnectionUsage.
+func (ConnectionUsage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("connection", Connection.Type).
+			Ref("usage").
+			Unique().
+			Required().
+			Field("connection_id"),
+	}
+}
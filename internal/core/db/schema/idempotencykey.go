@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// An idempotency key records the result of a create or run mutation, so that a repeated
+// submission with the same key returns it instead of creating a duplicate.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.String("key").
+			NotEmpty(),
+		field.String("operation").
+			NotEmpty().
+			Comment("Mutation the key was used for, e.g. task.create"),
+		field.String("request_hash").
+			Comment("SHA-256 of the arguments of the mutation, a key can't be reused with other arguments"),
+		field.UUID("result_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the entity the mutation created or started, nil while it runs"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "operation").
+			Unique(),
+		index.Fields("created_at"),
+	}
+}
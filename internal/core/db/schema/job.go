@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -22,8 +23,11 @@ type Job struct {
 func (Job) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New),
+			Default(ids.New),
 		field.UUID("task_id", uuid.UUID{}),
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 		field.Enum("status").
 			GoType(model.JobStatus("")).
 			Default(string(model.JobStatusPending)),
@@ -37,12 +41,60 @@ func (Job) Fields() []ent.Field {
 			Default(0),
 		field.Int64("bytes_transferred").
 			Default(0),
+		field.Int("uploaded_files").
+			Default(0),
+		field.Int64("uploaded_bytes").
+			Default(0),
+		field.Int("downloaded_files").
+			Default(0),
+		field.Int64("downloaded_bytes").
+			Default(0),
 		field.Int("files_deleted").
 			Default(0),
+		field.Int("files_checked").
+			Default(0).
+			Comment("Files compared with the destination, including files that didn't need a transfer"),
+		field.Int("listings_done").
+			Default(0).
+			Comment("Directory entries listed while comparing the source and destination"),
 		field.Int("error_count").
 			Default(0),
 		field.Text("errors").
 			Optional(),
+		field.Text("note").
+			Optional().
+			Comment("Free-text note attached by a user"),
+		field.Bool("acknowledged").
+			Default(false).
+			Comment("Whether a user has acknowledged the job, e.g. a failure that can be ignored"),
+		field.Time("annotated_at").
+			Optional().
+			Nillable(),
+		field.String("trace_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("OpenTelemetry trace ID of the run, set if the job was created within a trace"),
+		field.Int("connection_config_version").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Config version of the task's connection when the job was created"),
+		field.JSON("trigger_detail", &model.JobTriggerDetail{}).
+			Optional().
+			Immutable().
+			Comment("What triggered the job beyond its trigger type, e.g. the schedule or the user"),
+		field.String("task_config_hash").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Hash of the task's effective configuration when the job was created, see services.TaskConfigHash"),
+		field.JSON("concurrency", &model.JobConcurrency{}).
+			Optional().
+			Comment("Downsampled number of transfers in progress over the run of the job, written when it ends"),
+		field.JSON("config_snapshot", &model.JobConfigSnapshot{}).
+			Optional().
+			Comment("Effective sync configuration of the run, e.g. transfers and filters, written when it ends"),
 	}
 }
 
@@ -52,6 +104,7 @@ func (Job) Indexes() []ent.Index {
 		index.Fields("task_id"),
 		index.Fields("task_id", "start_time"),
 		index.Fields("status"),
+		index.Fields("parent_id"),
 	}
 }
 
@@ -65,5 +118,14 @@ func (Job) Edges() []ent.Edge {
 			Field("task_id"),
 		edge.To("logs", JobLog.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("retry_queue", RetryQueue.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("events", JobEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("children", Job.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Unique().
+			Field("parent_id"),
 	}
 }
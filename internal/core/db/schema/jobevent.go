@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// JobEvent holds the schema definition for the JobEvent entity.
+// Job events record things that ran as part of a job besides the sync itself, e.g. the result of a task hook.
+type JobEvent struct {
+	ent.Schema
+}
+
+// Fields of the JobEvent.
+func (JobEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.UUID("job_id", uuid.UUID{}),
+		field.Enum("type").
+			GoType(model.JobEventType("")),
+		field.Text("command"),
+		field.JSON("args", []string{}).
+			Optional(),
+		field.Int("exit_code").
+			Optional().
+			Nillable().
+			Comment("Exit code of the command, nil if it did not start or was killed"),
+		field.Int64("duration_ms").
+			Default(0),
+		field.Text("output").
+			Default("").
+			Comment("Combined stdout and stderr of the command, capped at app.hooks.max_output bytes"),
+		field.Bool("output_truncated").
+			Default(false),
+		field.Text("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the JobEvent.
+func (JobEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id"),
+	}
+}
+
+// Edges of the JobEvent.
+func (JobEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job", Job.Type).
+			Ref("events").
+			Unique().
+			Required().
+			Field("job_id"),
+	}
+}
@@ -27,6 +27,10 @@ func (JobLog) Fields() []ent.Field {
 			Default(time.Now),
 		field.String("path").
 			Optional(),
+		field.String("previous_path").
+			Optional().
+			Nillable().
+			Comment("Previous path of a renamed file, only set for RENAME logs"),
 		field.Enum("what").
 			GoType(model.LogAction("")).
 			Default(string(model.LogActionUnknown)),
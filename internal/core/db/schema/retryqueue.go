@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RetryQueue holds the schema definition for the RetryQueue entity.
+// A retry queue item is a file whose transfer failed within a job, so it can be retried
+// without re-running the whole task.
+type RetryQueue struct {
+	ent.Schema
+}
+
+// Fields of the RetryQueue.
+func (RetryQueue) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.UUID("job_id", uuid.UUID{}),
+		field.UUID("task_id", uuid.UUID{}),
+		field.Text("path").
+			Comment("Path of the file relative to the task's source and remote path"),
+		field.Enum("direction").
+			GoType(model.SyncDirection("")),
+		field.Enum("error_class").
+			GoType(model.TransferErrorClass("")),
+		field.Text("error"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("requested_at").
+			Optional().
+			Nillable().
+			Comment("When a retry of the file was requested"),
+		field.UUID("retry_job_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Job that retried the file"),
+	}
+}
+
+// Indexes of the RetryQueue.
+func (RetryQueue) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id"),
+		index.Fields("task_id", "retry_job_id"),
+	}
+}
+
+// Edges of the RetryQueue.
+func (RetryQueue) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job", Job.Type).
+			Ref("retry_queue").
+			Unique().
+			Required().
+			Field("job_id"),
+	}
+}
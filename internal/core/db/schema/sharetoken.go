@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShareToken holds the schema definition for the ShareToken entity.
+// A share token grants time-limited access to a single task or remote file without the API credentials.
+type ShareToken struct {
+	ent.Schema
+}
+
+// Fields of the ShareToken.
+func (ShareToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.String("name").
+			NotEmpty(),
+		field.String("token_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 of the token, the token itself is only returned when it is created"),
+		field.Enum("scope").
+			GoType(model.ShareScope("")),
+		field.UUID("task_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Task that can be read and run, set for the TASK scope"),
+		field.UUID("connection_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Connection of the file that can be downloaded, set for the DOWNLOAD scope"),
+		field.String("path").
+			Optional().
+			Comment("Remote file that can be downloaded, set for the DOWNLOAD scope"),
+		field.Time("expires_at"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Int("use_count").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the ShareToken.
+func (ShareToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash").
+			Unique(),
+		index.Fields("created_at"),
+	}
+}
+
+// Edges of the ShareToken.
+func (ShareToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("share_tokens").
+			Unique().
+			Field("task_id"),
+		edge.From("connection", Connection.Type).
+			Ref("share_tokens").
+			Unique().
+			Field("connection_id"),
+	}
+}
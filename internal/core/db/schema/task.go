@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -22,7 +23,7 @@ type Task struct {
 func (Task) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New),
+			Default(ids.New),
 		field.String("name").
 			NotEmpty(),
 		field.String("source_path").
@@ -40,11 +41,42 @@ func (Task) Fields() []ent.Field {
 			Default(false),
 		field.JSON("options", &model.TaskSyncOptions{}).
 			Optional(),
+		field.JSON("tags", []string{}).
+			Optional().
+			Comment("Tags grouping the task with others, e.g. to follow the progress of all tasks of a group"),
+		field.String("engine").
+			NotEmpty().
+			Default("rclone").
+			Comment("Name of the sync engine registered in the runner that executes the task"),
+		field.Int("skipped_runs").
+			Default(0).
+			Comment("Number of scheduled runs skipped because a job for the task was still running"),
+		field.Int("consecutive_failures").
+			Default(0).
+			Comment("Number of failed runs in a row, reset by a successful run"),
+		field.Int("consecutive_fatal_failures").
+			Default(0).
+			Comment("Number of runs in a row that failed with a non-transient error, e.g. an authentication failure or a missing path"),
+		field.Bool("disabled").
+			Default(false).
+			Comment("Disabled tasks aren't run by their schedule or realtime watcher, only on demand"),
+		field.String("disabled_reason").
+			Optional().
+			Nillable().
+			Comment("Why the task was disabled automatically, nil if it is enabled or was disabled by a user"),
+		field.Bool("ephemeral").
+			Default(false).
+			Immutable().
+			Comment("Hidden task created for a single ad-hoc run, excluded from task lists, schedules and watchers"),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Time the task was soft deleted, deleted tasks are kept until purged by the retention worker"),
 	}
 }
 
@@ -53,6 +85,11 @@ func (Task) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("connection_id"),
 		index.Fields("created_at"),
+		index.Fields("deleted_at"),
+		// Sort fields of task.list
+		index.Fields("name"),
+		index.Fields("engine"),
+		index.Fields("disabled", "consecutive_failures"),
 	}
 }
 
@@ -61,6 +98,10 @@ func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("jobs", Job.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("events", TaskEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("share_tokens", ShareToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("connection", Connection.Type).
 			Ref("tasks").
 			Unique().
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TaskEvent holds the schema definition for the TaskEvent entity.
+// Task events record things that happened to a task outside of a job, e.g. a skipped scheduled run.
+type TaskEvent struct {
+	ent.Schema
+}
+
+// Fields of the TaskEvent.
+func (TaskEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New),
+		field.UUID("task_id", uuid.UUID{}),
+		field.Enum("type").
+			GoType(model.TaskEventType("")),
+		field.Text("message").
+			Optional(),
+		field.Time("time").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the TaskEvent.
+func (TaskEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "time"),
+	}
+}
+
+// Edges of the TaskEvent.
+func (TaskEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("events").
+			Unique().
+			Required().
+			Field("task_id"),
+	}
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/tracing"
+)
+
+// errExecQueryUnsupported is returned for raw statements if the wrapped driver doesn't run them.
+const errExecQueryUnsupported = errs.ConstError("driver does not support raw statements")
+
+// tracedDriver records a span for every statement and transaction executed through the wrapped driver,
+// so slow queries and time spent waiting for the database lock show up in traces.
+type tracedDriver struct {
+	dialect.Driver
+	tracer trace.Tracer
+}
+
+// newTracedDriver wraps drv to record spans with the core.db tracer.
+func newTracedDriver(drv dialect.Driver) dialect.Driver {
+	return &tracedDriver{Driver: drv, tracer: tracing.Tracer("core.db")}
+}
+
+// startStatement starts the span of a single statement.
+func startStatement(ctx context.Context, tracer trace.Tracer, name, query string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemNameSQLite, semconv.DBQueryText(query)),
+	)
+}
+
+// Exec executes a statement that returns no rows.
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, d.tracer, "db.exec", query)
+	err := d.Driver.Exec(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// Query executes a statement that returns rows.
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, d.tracer, "db.query", query)
+	err := d.Driver.Query(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// execQuerier is implemented by drivers that run raw statements, see ent.Client.ExecContext and ent.Client.QueryContext.
+type execQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// ExecContext executes a raw statement that returns no rows.
+func (d *tracedDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	eq, ok := d.Driver.(execQuerier)
+	if !ok {
+		return nil, errExecQueryUnsupported
+	}
+	ctx, span := startStatement(ctx, d.tracer, "db.exec", query)
+	res, err := eq.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return res, err
+}
+
+// QueryContext executes a raw statement that returns rows.
+func (d *tracedDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	eq, ok := d.Driver.(execQuerier)
+	if !ok {
+		return nil, errExecQueryUnsupported
+	}
+	ctx, span := startStatement(ctx, d.tracer, "db.query", query)
+	rows, err := eq.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
+}
+
+// Tx starts a transaction whose span lasts until it is committed or rolled back.
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	ctx, span := d.tracer.Start(ctx, "db.tx",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemNameSQLite),
+	)
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, tracer: d.tracer, span: span}, nil
+}
+
+// tracedTx records a span for every statement of a transaction and ends the transaction span on commit or rollback.
+type tracedTx struct {
+	dialect.Tx
+	tracer trace.Tracer
+	span   trace.Span
+}
+
+// Exec executes a statement that returns no rows within the transaction.
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, t.tracer, "db.exec", query)
+	err := t.Tx.Exec(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// Query executes a statement that returns rows within the transaction.
+func (t *tracedTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, t.tracer, "db.query", query)
+	err := t.Tx.Query(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// Commit commits the transaction and ends its span.
+func (t *tracedTx) Commit() error {
+	err := t.Tx.Commit()
+	tracing.End(t.span, err)
+	return err
+}
+
+// Rollback rolls back the transaction and ends its span.
+func (t *tracedTx) Rollback() error {
+	err := t.Tx.Rollback()
+	tracing.End(t.span, err)
+	return err
+}
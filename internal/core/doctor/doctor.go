@@ -0,0 +1,431 @@
+// Package doctor runs the startup self-test of an installation: it checks the database, the encryption key,
+// the data directory, the inotify limits, the clock, the rclone backends and the outbound network, and
+// returns machine-readable results to speed up support triage.
+package doctor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/rclone/rclone/fs"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/core/version"
+	"github.com/xzzpig/rclone-sync/internal/core/watcher"
+)
+
+// Names of the checks, in the order they run.
+const (
+	CheckDatabase      = "database"
+	CheckEncryptionKey = "encryption_key"
+	CheckDataDir       = "data_dir"
+	CheckInotify       = "inotify"
+	CheckNetwork       = "network"
+	CheckTimeSync      = "time_sync"
+	CheckBackends      = "backends"
+)
+
+const (
+	// clockSkewWarning is the clock offset reported as a warning, since schedules and logs drift.
+	clockSkewWarning = time.Minute
+	// clockSkewError is the clock offset reported as an error, since signed requests (e.g. S3) are rejected.
+	clockSkewError = 15 * time.Minute
+)
+
+// Status is the result of a check, or of the whole self-test.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusWarning Status = "warning"
+	StatusError   Status = "error"
+	StatusSkipped Status = "skipped" // The check doesn't apply or depends on a failed check
+)
+
+// severity orders statuses by how bad they are.
+func (s Status) severity() int {
+	switch s {
+	case StatusError:
+		return 2
+	case StatusWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Result is the result of a single check.
+type Result struct {
+	Name     string         `json:"name"`
+	Status   Status         `json:"status"`
+	Message  string         `json:"message"`
+	Details  map[string]any `json:"details,omitempty"`
+	Duration int64          `json:"durationMs"` // Milliseconds the check took
+}
+
+// Report is the result of a self-test.
+type Report struct {
+	// Status is the worst status of the checks.
+	Status    Status    `json:"status"`
+	Version   string    `json:"version"`
+	CheckedAt time.Time `json:"checkedAt"`
+	Checks    []Result  `json:"checks"`
+}
+
+// Check returns the result of the check with the given name, or nil if it didn't run.
+func (r *Report) Check(name string) *Result {
+	for i := range r.Checks {
+		if r.Checks[i].Name == name {
+			return &r.Checks[i]
+		}
+	}
+	return nil
+}
+
+// Options tunes a self-test.
+type Options struct {
+	// HTTPClient sends the probe requests, nil uses a client with the configured probe timeout.
+	HTTPClient *http.Client
+	// DataDir is the data directory in use, empty uses the configured one. A running server passes its
+	// current one, since relocating the data directory doesn't update the loaded config.
+	DataDir string
+}
+
+// doctor holds the state shared by the checks of a self-test.
+type doctor struct {
+	cfg        *config.Config
+	httpClient *http.Client
+	logger     *zap.Logger
+	dataDir    string
+
+	client *ent.Client // Nil if the database doesn't exist
+	dbOK   bool        // Whether the schema of the database is usable by the checks reading it
+
+	probeDates []time.Time // Server times of the successful network probes, for the clock check
+	probedAt   []time.Time // Local times the probe responses were received at
+}
+
+// Run runs all checks against the installation configured by cfg. The database is opened read-only in the
+// sense that no migration is applied, so the self-test can run next to the server or before its first start.
+// Failures are reported in the results, the self-test itself doesn't fail.
+func Run(ctx context.Context, cfg *config.Config, opts Options) *Report {
+	d := &doctor{
+		cfg:        cfg,
+		httpClient: opts.HTTPClient,
+		logger:     logger.Named("core.doctor"),
+		dataDir:    opts.DataDir,
+	}
+	if d.httpClient == nil {
+		d.httpClient = &http.Client{Timeout: cfg.App.Doctor.Timeout}
+	}
+	if d.dataDir == "" {
+		d.dataDir = cfg.App.DataDir
+	}
+	defer d.close()
+
+	report := &Report{Status: StatusOK, Version: version.Get().Version, CheckedAt: time.Now()}
+	checks := []struct {
+		name string
+		run  func(ctx context.Context) Result
+	}{
+		{CheckDatabase, d.checkDatabase},
+		{CheckEncryptionKey, d.checkEncryptionKey},
+		{CheckDataDir, d.checkDataDir},
+		{CheckInotify, d.checkInotify},
+		{CheckNetwork, d.checkNetwork},
+		{CheckTimeSync, d.checkTimeSync},
+		{CheckBackends, d.checkBackends},
+	}
+	for _, c := range checks {
+		start := time.Now()
+		result := c.run(ctx)
+		result.Name = c.name
+		result.Duration = time.Since(start).Milliseconds()
+		if result.Status.severity() > report.Status.severity() {
+			report.Status = result.Status
+		}
+		report.Checks = append(report.Checks, result)
+	}
+
+	d.logger.Info("Self-test finished", zap.String("status", string(report.Status)))
+	return report
+}
+
+// close closes the database opened by checkDatabase.
+func (d *doctor) close() {
+	if d.client != nil {
+		if err := d.client.Close(); err != nil {
+			d.logger.Warn("Failed to close database", zap.Error(err))
+		}
+	}
+}
+
+// skippedWithoutDatabase returns the result of a check that can't run without the database.
+func (d *doctor) skippedWithoutDatabase() Result {
+	return Result{Status: StatusSkipped, Message: "database not available"}
+}
+
+// checkDatabase checks that the database can be opened and reports its migration version.
+// Pending migrations are a warning, since the server applies them on startup (or refuses to in production
+// without database.allow_auto_migrate), a dirty version left by a failed migration is an error.
+func (d *doctor) checkDatabase(ctx context.Context) Result {
+	path := d.cfg.Database.Path
+	details := map[string]any{"path": path}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Result{Status: StatusWarning, Message: "database does not exist yet, it is created on the first start", Details: details}
+		}
+		return Result{Status: StatusError, Message: err.Error(), Details: details}
+	}
+
+	sqlDB, err := sql.Open("sqlite3", db.FileSDN(path))
+	if err != nil {
+		return Result{Status: StatusError, Message: err.Error(), Details: details}
+	}
+	d.client = ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", sqlDB)))
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return Result{Status: StatusError, Message: fmt.Sprintf("failed to open database: %v", err), Details: details}
+	}
+
+	mode := db.ParseMigrationMode(d.cfg.Database.MigrationMode)
+	details["migrationMode"] = string(mode)
+	if mode == db.MigrationModeAuto {
+		d.dbOK = true
+		return Result{Status: StatusOK, Message: "schema is migrated automatically (auto migration mode)", Details: details}
+	}
+
+	status, err := db.GetMigrationStatus(sqlDB)
+	if err != nil {
+		return Result{Status: StatusError, Message: err.Error(), Details: details}
+	}
+	pending, err := db.GetPendingMigrations(sqlDB)
+	if err != nil {
+		return Result{Status: StatusError, Message: err.Error(), Details: details}
+	}
+	details["version"] = status.Version
+	details["pending"] = len(pending)
+
+	switch {
+	case status.Dirty:
+		return Result{Status: StatusError, Message: fmt.Sprintf("schema version %d is dirty: a previous migration failed, manual repair required", status.Version), Details: details}
+	case status.Version == 0:
+		return Result{Status: StatusWarning, Message: "schema not created yet, it is created on the first start", Details: details}
+	case len(pending) > 0:
+		d.dbOK = true
+		return Result{Status: StatusWarning, Message: fmt.Sprintf("schema version %d, %d migrations pending (see the migrate command)", status.Version, len(pending)), Details: details}
+	}
+	d.dbOK = true
+	return Result{Status: StatusOK, Message: fmt.Sprintf("schema version %d, up to date", status.Version), Details: details}
+}
+
+// checkEncryptionKey test-decrypts the config of every connection, so a wrong security.encryption_key
+// is reported before the jobs of the connections fail. Without a key configs are stored unencrypted.
+func (d *doctor) checkEncryptionKey(ctx context.Context) Result {
+	encryptor, err := crypto.NewEncryptor(d.cfg.Security.EncryptionKey)
+	if err != nil {
+		return Result{Status: StatusError, Message: err.Error()}
+	}
+	if !d.dbOK {
+		return d.skippedWithoutDatabase()
+	}
+
+	checked, failures, err := services.NewConnectionService(d.client, encryptor).VerifyConfigs(ctx)
+	if err != nil {
+		return Result{Status: StatusError, Message: err.Error()}
+	}
+	details := map[string]any{"connections": checked}
+	if len(failures) > 0 {
+		names := make([]string, len(failures))
+		for i, f := range failures {
+			names[i] = f.Connection.Name
+		}
+		details["failed"] = names
+		return Result{
+			Status:  StatusError,
+			Message: fmt.Sprintf("configs of %d of %d connections can't be decrypted, the encryption key is wrong or the configs are corrupted: %s", len(failures), checked, strings.Join(names, ", ")),
+			Details: details,
+		}
+	}
+	if d.cfg.Security.EncryptionKey == "" {
+		return Result{Status: StatusWarning, Message: "no encryption key set, connection configs are stored unencrypted", Details: details}
+	}
+	return Result{Status: StatusOK, Message: fmt.Sprintf("configs of all %d connections can be decrypted", checked), Details: details}
+}
+
+// checkDataDir checks that a file can be written to the data directory, which holds the bisync state.
+func (d *doctor) checkDataDir(_ context.Context) Result {
+	dir := d.dataDir
+	details := map[string]any{"path": dir}
+	info, err := os.Stat(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Result{Status: StatusWarning, Message: "data directory does not exist yet, it is created when needed", Details: details}
+		}
+		return Result{Status: StatusError, Message: err.Error(), Details: details}
+	}
+	if !info.IsDir() {
+		return Result{Status: StatusError, Message: "data directory is not a directory", Details: details}
+	}
+
+	f, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		return Result{Status: StatusError, Message: fmt.Sprintf("data directory is not writable: %v", err), Details: details}
+	}
+	_, err = f.WriteString("rclone-sync")
+	err = errors.Join(err, f.Close(), os.Remove(f.Name()))
+	if err != nil {
+		return Result{Status: StatusError, Message: fmt.Sprintf("data directory is not writable: %v", err), Details: details}
+	}
+	return Result{Status: StatusOK, Message: "data directory is writable", Details: details}
+}
+
+// watchLimit returns the inotify watch limit, replaced by tests.
+var watchLimit = watcher.WatchLimit
+
+// checkInotify checks the inotify watch limit realtime tasks watch their source trees with.
+// A low limit is only a warning if there are realtime tasks.
+func (d *doctor) checkInotify(ctx context.Context) Result {
+	limit := watchLimit()
+	if limit == 0 {
+		return Result{Status: StatusSkipped, Message: "inotify watch limit not available on this platform"}
+	}
+	details := map[string]any{"maxUserWatches": limit}
+	if limit >= watcher.MinSuggestedWatchLimit {
+		return Result{Status: StatusOK, Message: fmt.Sprintf("inotify watch limit is %d", limit), Details: details}
+	}
+
+	realtime := 0
+	if d.dbOK {
+		var err error
+		realtime, err = d.client.Task.Query().Where(task.Realtime(true), task.DeletedAtIsNil()).Count(ctx)
+		if err != nil {
+			return Result{Status: StatusError, Message: err.Error(), Details: details}
+		}
+	}
+	details["realtimeTasks"] = realtime
+	sysctl := (&watcher.WatchLimitError{Limit: limit}).Sysctl()
+	details["sysctl"] = sysctl
+	if realtime == 0 {
+		return Result{Status: StatusOK, Message: fmt.Sprintf("inotify watch limit is %d, raise it before watching large trees: %s", limit, sysctl), Details: details}
+	}
+	return Result{Status: StatusWarning, Message: fmt.Sprintf("inotify watch limit is %d, realtime tasks may not watch large trees completely: %s", limit, sysctl), Details: details}
+}
+
+// checkNetwork requests the configured probe URLs. Any response, whatever its status, proves the URL is
+// reachable. Unreachable URLs are an error if none is reachable and a warning otherwise.
+func (d *doctor) checkNetwork(ctx context.Context) Result {
+	urls := d.cfg.App.Doctor.ProbeURLs
+	if len(urls) == 0 {
+		return Result{Status: StatusSkipped, Message: "no probe URLs configured"}
+	}
+
+	var unreachable []string
+	probes := make(map[string]string, len(urls))
+	for _, url := range urls {
+		if err := d.probe(ctx, url); err != nil {
+			unreachable = append(unreachable, url)
+			probes[url] = err.Error()
+			continue
+		}
+		probes[url] = "ok"
+	}
+	details := map[string]any{"probes": probes}
+
+	switch {
+	case len(unreachable) == len(urls):
+		return Result{Status: StatusError, Message: "no probe URL is reachable: " + strings.Join(unreachable, ", "), Details: details}
+	case len(unreachable) > 0:
+		return Result{Status: StatusWarning, Message: "some probe URLs are unreachable: " + strings.Join(unreachable, ", "), Details: details}
+	}
+	return Result{Status: StatusOK, Message: fmt.Sprintf("all %d probe URLs are reachable", len(urls)), Details: details}
+}
+
+// probe sends a HEAD request to url and records the server time of the response for checkTimeSync.
+func (d *doctor) probe(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		d.probeDates = append(d.probeDates, date)
+		d.probedAt = append(d.probedAt, time.Now())
+	}
+	return nil
+}
+
+// checkTimeSync compares the local clock with the Date headers of the network probes. The headers have a
+// resolution of a second, which is plenty to find clocks that aren't synchronized at all.
+func (d *doctor) checkTimeSync(_ context.Context) Result {
+	if len(d.probeDates) == 0 {
+		return Result{Status: StatusSkipped, Message: "no probe response with a Date header to compare the clock with"}
+	}
+
+	offsets := make([]time.Duration, len(d.probeDates))
+	for i, date := range d.probeDates {
+		offsets[i] = d.probedAt[i].Sub(date)
+	}
+	// The median offset is robust against a single server with a wrong clock
+	slices.Sort(offsets)
+	offset := offsets[len(offsets)/2]
+	details := map[string]any{"offsetSeconds": offset.Round(time.Second).Seconds()}
+
+	abs := offset.Abs()
+	switch {
+	case abs >= clockSkewError:
+		return Result{Status: StatusError, Message: fmt.Sprintf("clock is off by %s, signed requests to remotes like S3 are rejected", offset.Round(time.Second)), Details: details}
+	case abs >= clockSkewWarning:
+		return Result{Status: StatusWarning, Message: fmt.Sprintf("clock is off by %s, check time synchronization (NTP)", offset.Round(time.Second)), Details: details}
+	}
+	return Result{Status: StatusOK, Message: "clock is synchronized", Details: details}
+}
+
+// checkBackends checks that the rclone backend of every connection type is compiled in.
+func (d *doctor) checkBackends(ctx context.Context) Result {
+	details := map[string]any{"registered": len(fs.Registry)}
+	if len(fs.Registry) == 0 {
+		return Result{Status: StatusError, Message: "no rclone backends are compiled in", Details: details}
+	}
+	if !d.dbOK {
+		return Result{Status: StatusOK, Message: fmt.Sprintf("%d rclone backends are compiled in", len(fs.Registry)), Details: details}
+	}
+
+	types, err := d.client.Connection.Query().Unique(true).Select(connection.FieldType).Strings(ctx)
+	if err != nil {
+		return Result{Status: StatusError, Message: err.Error(), Details: details}
+	}
+	var missing []string
+	for _, t := range types {
+		if _, err := fs.Find(t); err != nil {
+			missing = append(missing, t)
+		}
+	}
+	slices.Sort(missing)
+	details["connectionTypes"] = len(types)
+	if len(missing) > 0 {
+		details["missing"] = missing
+		return Result{Status: StatusError, Message: "backends of connection types not available: " + strings.Join(missing, ", "), Details: details}
+	}
+	return Result{Status: StatusOK, Message: fmt.Sprintf("%d rclone backends are compiled in, including those of all %d connection types", len(fs.Registry), len(types)), Details: details}
+}
@@ -0,0 +1,168 @@
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/rclone/rclone/backend/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/config"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+)
+
+// setupInstallation creates a migrated database with a connection encrypted with key and returns
+// the config of the installation.
+func setupInstallation(t *testing.T, key string) *config.Config {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Database.Path = filepath.Join(t.TempDir(), "app.db")
+	cfg.App.DataDir = t.TempDir()
+	cfg.App.Doctor.Timeout = 5 * time.Second
+
+	client, err := db.InitDB(db.InitDBOptions{
+		DSN:           db.FileSDN(cfg.Database.Path),
+		MigrationMode: db.MigrationModeVersioned,
+		Environment:   "test",
+	})
+	require.NoError(t, err)
+	defer db.CloseDB(client)
+
+	encryptor, err := crypto.NewEncryptor(key)
+	require.NoError(t, err)
+	_, err = services.NewConnectionService(client, encryptor).CreateConnection(context.Background(), "local", model.ConnectionTypeLocal, map[string]string{})
+	require.NoError(t, err)
+
+	_, err = client.Task.Create().
+		SetName("realtime").
+		SetSourcePath(t.TempDir()).
+		SetRemotePath("backup").
+		SetConnectionID(client.Connection.Query().OnlyIDX(context.Background())).
+		SetRealtime(true).
+		Save(context.Background())
+	require.NoError(t, err)
+	return cfg
+}
+
+// stubWatchLimit makes the inotify check read the given limit.
+func stubWatchLimit(t *testing.T, limit int) {
+	original := watchLimit
+	t.Cleanup(func() { watchLimit = original })
+	watchLimit = func() int { return limit }
+}
+
+// probeServer returns a server answering with a Date header off by offset.
+func probeServer(t *testing.T, offset time.Duration) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Date", time.Now().Add(-offset).UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRun(t *testing.T) {
+	const key = "doctor-key"
+	cfg := setupInstallation(t, key)
+	cfg.Security.EncryptionKey = key
+	cfg.App.Doctor.ProbeURLs = []string{probeServer(t, 0).URL}
+	stubWatchLimit(t, 8192)
+
+	report := Run(context.Background(), cfg, Options{})
+	names := make([]string, len(report.Checks))
+	for i, c := range report.Checks {
+		names[i] = c.Name
+	}
+	assert.Equal(t, []string{CheckDatabase, CheckEncryptionKey, CheckDataDir, CheckInotify, CheckNetwork, CheckTimeSync, CheckBackends}, names)
+
+	assert.Equal(t, StatusOK, report.Check(CheckDatabase).Status, report.Check(CheckDatabase).Message)
+	assert.Equal(t, StatusOK, report.Check(CheckEncryptionKey).Status)
+	assert.Equal(t, StatusOK, report.Check(CheckDataDir).Status)
+	assert.Equal(t, StatusOK, report.Check(CheckNetwork).Status, "any response proves the URL is reachable")
+	assert.Equal(t, StatusOK, report.Check(CheckTimeSync).Status)
+	assert.Equal(t, StatusOK, report.Check(CheckBackends).Status)
+
+	// A low watch limit is a warning since there is a realtime task
+	inotify := report.Check(CheckInotify)
+	assert.Equal(t, StatusWarning, inotify.Status)
+	assert.Equal(t, 1, inotify.Details["realtimeTasks"])
+	assert.Contains(t, inotify.Message, "sysctl -w fs.inotify.max_user_watches=524288")
+	assert.Equal(t, StatusWarning, report.Status)
+
+	// Results are machine-readable
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "warning", decoded["status"])
+	assert.Len(t, decoded["checks"], 7)
+}
+
+func TestRun_Failures(t *testing.T) {
+	cfg := setupInstallation(t, "doctor-key")
+	cfg.Security.EncryptionKey = "wrong-key"
+	cfg.App.Doctor.ProbeURLs = []string{probeServer(t, time.Hour).URL, "http://127.0.0.1:1"}
+	stubWatchLimit(t, 0)
+
+	// A data directory that is a file can't hold the bisync state
+	cfg.App.DataDir = filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(cfg.App.DataDir, nil, 0644))
+
+	report := Run(context.Background(), cfg, Options{})
+	assert.Equal(t, StatusError, report.Status)
+
+	encryption := report.Check(CheckEncryptionKey)
+	assert.Equal(t, StatusError, encryption.Status)
+	assert.Equal(t, []string{"local"}, encryption.Details["failed"])
+	assert.Equal(t, StatusError, report.Check(CheckDataDir).Status)
+	assert.Equal(t, StatusSkipped, report.Check(CheckInotify).Status)
+	assert.Equal(t, StatusWarning, report.Check(CheckNetwork).Status)
+
+	timeSync := report.Check(CheckTimeSync)
+	assert.Equal(t, StatusError, timeSync.Status)
+	assert.InDelta(t, 3600, timeSync.Details["offsetSeconds"], 2)
+}
+
+func TestRun_NotStarted(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Path = filepath.Join(t.TempDir(), "missing.db")
+	cfg.App.DataDir = filepath.Join(t.TempDir(), "missing")
+	stubWatchLimit(t, 1048576)
+
+	report := Run(context.Background(), cfg, Options{})
+	assert.Equal(t, StatusWarning, report.Status)
+	assert.Equal(t, StatusWarning, report.Check(CheckDatabase).Status)
+	assert.Equal(t, StatusSkipped, report.Check(CheckEncryptionKey).Status)
+	assert.Equal(t, StatusWarning, report.Check(CheckDataDir).Status)
+	assert.Equal(t, StatusOK, report.Check(CheckInotify).Status)
+	assert.Equal(t, StatusSkipped, report.Check(CheckNetwork).Status)
+	assert.Equal(t, StatusSkipped, report.Check(CheckTimeSync).Status)
+	assert.Equal(t, StatusOK, report.Check(CheckBackends).Status)
+
+	_, err := os.Stat(cfg.Database.Path)
+	assert.ErrorIs(t, err, os.ErrNotExist, "the self-test doesn't create the database")
+}
+
+func TestRun_DataDirOption(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Path = filepath.Join(t.TempDir(), "missing.db")
+	cfg.App.DataDir = filepath.Join(t.TempDir(), "missing")
+	stubWatchLimit(t, 0)
+
+	// The data directory in use is checked instead of the configured one
+	dataDir := t.TempDir()
+	report := Run(context.Background(), cfg, Options{DataDir: dataDir})
+	check := report.Check(CheckDataDir)
+	assert.Equal(t, StatusOK, check.Status)
+	assert.Equal(t, dataDir, check.Details["path"])
+}
@@ -17,9 +17,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/idempotencykey"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -29,12 +38,26 @@ type Client struct {
 	Schema *migrate.Schema
 	// Connection is the client for interacting with the Connection builders.
 	Connection *ConnectionClient
+	// ConnectionTransfer is the client for interacting with the ConnectionTransfer builders.
+	ConnectionTransfer *ConnectionTransferClient
+	// ConnectionUsage is the client for interacting with the ConnectionUsage builders.
+	ConnectionUsage *ConnectionUsageClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Job is the client for interacting with the Job builders.
 	Job *JobClient
+	// JobEvent is the client for interacting with the JobEvent builders.
+	JobEvent *JobEventClient
 	// JobLog is the client for interacting with the JobLog builders.
 	JobLog *JobLogClient
+	// RetryQueue is the client for interacting with the RetryQueue builders.
+	RetryQueue *RetryQueueClient
+	// ShareToken is the client for interacting with the ShareToken builders.
+	ShareToken *ShareTokenClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskEvent is the client for interacting with the TaskEvent builders.
+	TaskEvent *TaskEventClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -47,9 +70,16 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Connection = NewConnectionClient(c.config)
+	c.ConnectionTransfer = NewConnectionTransferClient(c.config)
+	c.ConnectionUsage = NewConnectionUsageClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
 	c.Job = NewJobClient(c.config)
+	c.JobEvent = NewJobEventClient(c.config)
 	c.JobLog = NewJobLogClient(c.config)
+	c.RetryQueue = NewRetryQueueClient(c.config)
+	c.ShareToken = NewShareTokenClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskEvent = NewTaskEventClient(c.config)
 }
 
 type (
@@ -140,12 +170,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Connection: NewConnectionClient(cfg),
-		Job:        NewJobClient(cfg),
-		JobLog:     NewJobLogClient(cfg),
-		Task:       NewTaskClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Connection:         NewConnectionClient(cfg),
+		ConnectionTransfer: NewConnectionTransferClient(cfg),
+		ConnectionUsage:    NewConnectionUsageClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		Job:                NewJobClient(cfg),
+		JobEvent:           NewJobEventClient(cfg),
+		JobLog:             NewJobLogClient(cfg),
+		RetryQueue:         NewRetryQueueClient(cfg),
+		ShareToken:         NewShareTokenClient(cfg),
+		Task:               NewTaskClient(cfg),
+		TaskEvent:          NewTaskEventClient(cfg),
 	}, nil
 }
 
@@ -163,12 +200,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Connection: NewConnectionClient(cfg),
-		Job:        NewJobClient(cfg),
-		JobLog:     NewJobLogClient(cfg),
-		Task:       NewTaskClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Connection:         NewConnectionClient(cfg),
+		ConnectionTransfer: NewConnectionTransferClient(cfg),
+		ConnectionUsage:    NewConnectionUsageClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		Job:                NewJobClient(cfg),
+		JobEvent:           NewJobEventClient(cfg),
+		JobLog:             NewJobLogClient(cfg),
+		RetryQueue:         NewRetryQueueClient(cfg),
+		ShareToken:         NewShareTokenClient(cfg),
+		Task:               NewTaskClient(cfg),
+		TaskEvent:          NewTaskEventClient(cfg),
 	}, nil
 }
 
@@ -197,19 +241,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Connection.Use(hooks...)
-	c.Job.Use(hooks...)
-	c.JobLog.Use(hooks...)
-	c.Task.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Connection, c.ConnectionTransfer, c.ConnectionUsage, c.IdempotencyKey, c.Job,
+		c.JobEvent, c.JobLog, c.RetryQueue, c.ShareToken, c.Task, c.TaskEvent,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Connection.Intercept(interceptors...)
-	c.Job.Intercept(interceptors...)
-	c.JobLog.Intercept(interceptors...)
-	c.Task.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Connection, c.ConnectionTransfer, c.ConnectionUsage, c.IdempotencyKey, c.Job,
+		c.JobEvent, c.JobLog, c.RetryQueue, c.ShareToken, c.Task, c.TaskEvent,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -217,12 +265,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ConnectionMutation:
 		return c.Connection.mutate(ctx, m)
+	case *ConnectionTransferMutation:
+		return c.ConnectionTransfer.mutate(ctx, m)
+	case *ConnectionUsageMutation:
+		return c.ConnectionUsage.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
 	case *JobMutation:
 		return c.Job.mutate(ctx, m)
+	case *JobEventMutation:
+		return c.JobEvent.mutate(ctx, m)
 	case *JobLogMutation:
 		return c.JobLog.mutate(ctx, m)
+	case *RetryQueueMutation:
+		return c.RetryQueue.mutate(ctx, m)
+	case *ShareTokenMutation:
+		return c.ShareToken.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskEventMutation:
+		return c.TaskEvent.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -352,6 +414,54 @@ func (c *ConnectionClient) QueryTasks(_m *Connection) *TaskQuery {
 	return query
 }
 
+// QueryUsage queries the usage edge of a Connection.
+func (c *ConnectionClient) QueryUsage(_m *Connection) *ConnectionUsageQuery {
+	query := (&ConnectionUsageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, id),
+			sqlgraph.To(connectionusage.Table, connectionusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.UsageTable, connection.UsageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareTokens queries the share_tokens edge of a Connection.
+func (c *ConnectionClient) QueryShareTokens(_m *Connection) *ShareTokenQuery {
+	query := (&ShareTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, id),
+			sqlgraph.To(sharetoken.Table, sharetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.ShareTokensTable, connection.ShareTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransfers queries the transfers edge of a Connection.
+func (c *ConnectionClient) QueryTransfers(_m *Connection) *ConnectionTransferQuery {
+	query := (&ConnectionTransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, id),
+			sqlgraph.To(connectiontransfer.Table, connectiontransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.TransfersTable, connection.TransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ConnectionClient) Hooks() []Hook {
 	return c.hooks.Connection
@@ -377,107 +487,107 @@ func (c *ConnectionClient) mutate(ctx context.Context, m *ConnectionMutation) (V
 	}
 }
 
-// JobClient is a client for the Job schema.
-type JobClient struct {
+// ConnectionTransferClient is a client for the ConnectionTransfer schema.
+type ConnectionTransferClient struct {
 	config
 }
 
-// NewJobClient returns a client for the Job from the given config.
-func NewJobClient(c config) *JobClient {
-	return &JobClient{config: c}
+// NewConnectionTransferClient returns a client for the ConnectionTransfer from the given config.
+func NewConnectionTransferClient(c config) *ConnectionTransferClient {
+	return &ConnectionTransferClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
-func (c *JobClient) Use(hooks ...Hook) {
-	c.hooks.Job = append(c.hooks.Job, hooks...)
+// A call to `Use(f, g, h)` equals to `connectiontransfer.Hooks(f(g(h())))`.
+func (c *ConnectionTransferClient) Use(hooks ...Hook) {
+	c.hooks.ConnectionTransfer = append(c.hooks.ConnectionTransfer, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
-func (c *JobClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Job = append(c.inters.Job, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `connectiontransfer.Intercept(f(g(h())))`.
+func (c *ConnectionTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConnectionTransfer = append(c.inters.ConnectionTransfer, interceptors...)
 }
 
-// Create returns a builder for creating a Job entity.
-func (c *JobClient) Create() *JobCreate {
-	mutation := newJobMutation(c.config, OpCreate)
-	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConnectionTransfer entity.
+func (c *ConnectionTransferClient) Create() *ConnectionTransferCreate {
+	mutation := newConnectionTransferMutation(c.config, OpCreate)
+	return &ConnectionTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Job entities.
-func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
-	return &JobCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConnectionTransfer entities.
+func (c *ConnectionTransferClient) CreateBulk(builders ...*ConnectionTransferCreate) *ConnectionTransferCreateBulk {
+	return &ConnectionTransferCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+func (c *ConnectionTransferClient) MapCreateBulk(slice any, setFunc func(*ConnectionTransferCreate, int)) *ConnectionTransferCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConnectionTransferCreateBulk{err: fmt.Errorf("calling to ConnectionTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*JobCreate, rv.Len())
+	builders := make([]*ConnectionTransferCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &JobCreateBulk{config: c.config, builders: builders}
+	return &ConnectionTransferCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Job.
-func (c *JobClient) Update() *JobUpdate {
-	mutation := newJobMutation(c.config, OpUpdate)
-	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConnectionTransfer.
+func (c *ConnectionTransferClient) Update() *ConnectionTransferUpdate {
+	mutation := newConnectionTransferMutation(c.config, OpUpdate)
+	return &ConnectionTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
-	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
-	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConnectionTransferClient) UpdateOne(_m *ConnectionTransfer) *ConnectionTransferUpdateOne {
+	mutation := newConnectionTransferMutation(c.config, OpUpdateOne, withConnectionTransfer(_m))
+	return &ConnectionTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
-	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
-	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConnectionTransferClient) UpdateOneID(id uuid.UUID) *ConnectionTransferUpdateOne {
+	mutation := newConnectionTransferMutation(c.config, OpUpdateOne, withConnectionTransferID(id))
+	return &ConnectionTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Job.
-func (c *JobClient) Delete() *JobDelete {
-	mutation := newJobMutation(c.config, OpDelete)
-	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConnectionTransfer.
+func (c *ConnectionTransferClient) Delete() *ConnectionTransferDelete {
+	mutation := newConnectionTransferMutation(c.config, OpDelete)
+	return &ConnectionTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+func (c *ConnectionTransferClient) DeleteOne(_m *ConnectionTransfer) *ConnectionTransferDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
-	builder := c.Delete().Where(job.ID(id))
+func (c *ConnectionTransferClient) DeleteOneID(id uuid.UUID) *ConnectionTransferDeleteOne {
+	builder := c.Delete().Where(connectiontransfer.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &JobDeleteOne{builder}
+	return &ConnectionTransferDeleteOne{builder}
 }
 
-// Query returns a query builder for Job.
-func (c *JobClient) Query() *JobQuery {
-	return &JobQuery{
+// Query returns a query builder for ConnectionTransfer.
+func (c *ConnectionTransferClient) Query() *ConnectionTransferQuery {
+	return &ConnectionTransferQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeJob},
+		ctx:    &QueryContext{Type: TypeConnectionTransfer},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Job entity by its id.
-func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
-	return c.Query().Where(job.ID(id)).Only(ctx)
+// Get returns a ConnectionTransfer entity by its id.
+func (c *ConnectionTransferClient) Get(ctx context.Context, id uuid.UUID) (*ConnectionTransfer, error) {
+	return c.Query().Where(connectiontransfer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+func (c *ConnectionTransferClient) GetX(ctx context.Context, id uuid.UUID) *ConnectionTransfer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -485,31 +595,15 @@ func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
 	return obj
 }
 
-// QueryTask queries the task edge of a Job.
-func (c *JobClient) QueryTask(_m *Job) *TaskQuery {
-	query := (&TaskClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(job.Table, job.FieldID, id),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, job.TaskTable, job.TaskColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLogs queries the logs edge of a Job.
-func (c *JobClient) QueryLogs(_m *Job) *JobLogQuery {
-	query := (&JobLogClient{config: c.config}).Query()
+// QueryConnection queries the connection edge of a ConnectionTransfer.
+func (c *ConnectionTransferClient) QueryConnection(_m *ConnectionTransfer) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(job.Table, job.FieldID, id),
-			sqlgraph.To(joblog.Table, joblog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, job.LogsTable, job.LogsColumn),
+			sqlgraph.From(connectiontransfer.Table, connectiontransfer.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, connectiontransfer.ConnectionTable, connectiontransfer.ConnectionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -518,131 +612,131 @@ func (c *JobClient) QueryLogs(_m *Job) *JobLogQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *JobClient) Hooks() []Hook {
-	return c.hooks.Job
+func (c *ConnectionTransferClient) Hooks() []Hook {
+	return c.hooks.ConnectionTransfer
 }
 
 // Interceptors returns the client interceptors.
-func (c *JobClient) Interceptors() []Interceptor {
-	return c.inters.Job
+func (c *ConnectionTransferClient) Interceptors() []Interceptor {
+	return c.inters.ConnectionTransfer
 }
 
-func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+func (c *ConnectionTransferClient) mutate(ctx context.Context, m *ConnectionTransferMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectionTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectionTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectionTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConnectionTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConnectionTransfer mutation op: %q", m.Op())
 	}
 }
 
-// JobLogClient is a client for the JobLog schema.
-type JobLogClient struct {
+// ConnectionUsageClient is a client for the ConnectionUsage schema.
+type ConnectionUsageClient struct {
 	config
 }
 
-// NewJobLogClient returns a client for the JobLog from the given config.
-func NewJobLogClient(c config) *JobLogClient {
-	return &JobLogClient{config: c}
+// NewConnectionUsageClient returns a client for the ConnectionUsage from the given config.
+func NewConnectionUsageClient(c config) *ConnectionUsageClient {
+	return &ConnectionUsageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `joblog.Hooks(f(g(h())))`.
-func (c *JobLogClient) Use(hooks ...Hook) {
-	c.hooks.JobLog = append(c.hooks.JobLog, hooks...)
+// A call to `Use(f, g, h)` equals to `connectionusage.Hooks(f(g(h())))`.
+func (c *ConnectionUsageClient) Use(hooks ...Hook) {
+	c.hooks.ConnectionUsage = append(c.hooks.ConnectionUsage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `joblog.Intercept(f(g(h())))`.
-func (c *JobLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.JobLog = append(c.inters.JobLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `connectionusage.Intercept(f(g(h())))`.
+func (c *ConnectionUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConnectionUsage = append(c.inters.ConnectionUsage, interceptors...)
 }
 
-// Create returns a builder for creating a JobLog entity.
-func (c *JobLogClient) Create() *JobLogCreate {
-	mutation := newJobLogMutation(c.config, OpCreate)
-	return &JobLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConnectionUsage entity.
+func (c *ConnectionUsageClient) Create() *ConnectionUsageCreate {
+	mutation := newConnectionUsageMutation(c.config, OpCreate)
+	return &ConnectionUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of JobLog entities.
-func (c *JobLogClient) CreateBulk(builders ...*JobLogCreate) *JobLogCreateBulk {
-	return &JobLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConnectionUsage entities.
+func (c *ConnectionUsageClient) CreateBulk(builders ...*ConnectionUsageCreate) *ConnectionUsageCreateBulk {
+	return &ConnectionUsageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *JobLogClient) MapCreateBulk(slice any, setFunc func(*JobLogCreate, int)) *JobLogCreateBulk {
+func (c *ConnectionUsageClient) MapCreateBulk(slice any, setFunc func(*ConnectionUsageCreate, int)) *ConnectionUsageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &JobLogCreateBulk{err: fmt.Errorf("calling to JobLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConnectionUsageCreateBulk{err: fmt.Errorf("calling to ConnectionUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*JobLogCreate, rv.Len())
+	builders := make([]*ConnectionUsageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &JobLogCreateBulk{config: c.config, builders: builders}
+	return &ConnectionUsageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for JobLog.
-func (c *JobLogClient) Update() *JobLogUpdate {
-	mutation := newJobLogMutation(c.config, OpUpdate)
-	return &JobLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConnectionUsage.
+func (c *ConnectionUsageClient) Update() *ConnectionUsageUpdate {
+	mutation := newConnectionUsageMutation(c.config, OpUpdate)
+	return &ConnectionUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *JobLogClient) UpdateOne(_m *JobLog) *JobLogUpdateOne {
-	mutation := newJobLogMutation(c.config, OpUpdateOne, withJobLog(_m))
-	return &JobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConnectionUsageClient) UpdateOne(_m *ConnectionUsage) *ConnectionUsageUpdateOne {
+	mutation := newConnectionUsageMutation(c.config, OpUpdateOne, withConnectionUsage(_m))
+	return &ConnectionUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *JobLogClient) UpdateOneID(id int) *JobLogUpdateOne {
-	mutation := newJobLogMutation(c.config, OpUpdateOne, withJobLogID(id))
-	return &JobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConnectionUsageClient) UpdateOneID(id uuid.UUID) *ConnectionUsageUpdateOne {
+	mutation := newConnectionUsageMutation(c.config, OpUpdateOne, withConnectionUsageID(id))
+	return &ConnectionUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for JobLog.
-func (c *JobLogClient) Delete() *JobLogDelete {
-	mutation := newJobLogMutation(c.config, OpDelete)
-	return &JobLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConnectionUsage.
+func (c *ConnectionUsageClient) Delete() *ConnectionUsageDelete {
+	mutation := newConnectionUsageMutation(c.config, OpDelete)
+	return &ConnectionUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *JobLogClient) DeleteOne(_m *JobLog) *JobLogDeleteOne {
+func (c *ConnectionUsageClient) DeleteOne(_m *ConnectionUsage) *ConnectionUsageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *JobLogClient) DeleteOneID(id int) *JobLogDeleteOne {
-	builder := c.Delete().Where(joblog.ID(id))
+func (c *ConnectionUsageClient) DeleteOneID(id uuid.UUID) *ConnectionUsageDeleteOne {
+	builder := c.Delete().Where(connectionusage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &JobLogDeleteOne{builder}
+	return &ConnectionUsageDeleteOne{builder}
 }
 
-// Query returns a query builder for JobLog.
-func (c *JobLogClient) Query() *JobLogQuery {
-	return &JobLogQuery{
+// Query returns a query builder for ConnectionUsage.
+func (c *ConnectionUsageClient) Query() *ConnectionUsageQuery {
+	return &ConnectionUsageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeJobLog},
+		ctx:    &QueryContext{Type: TypeConnectionUsage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a JobLog entity by its id.
-func (c *JobLogClient) Get(ctx context.Context, id int) (*JobLog, error) {
-	return c.Query().Where(joblog.ID(id)).Only(ctx)
+// Get returns a ConnectionUsage entity by its id.
+func (c *ConnectionUsageClient) Get(ctx context.Context, id uuid.UUID) (*ConnectionUsage, error) {
+	return c.Query().Where(connectionusage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *JobLogClient) GetX(ctx context.Context, id int) *JobLog {
+func (c *ConnectionUsageClient) GetX(ctx context.Context, id uuid.UUID) *ConnectionUsage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -650,15 +744,15 @@ func (c *JobLogClient) GetX(ctx context.Context, id int) *JobLog {
 	return obj
 }
 
-// QueryJob queries the job edge of a JobLog.
-func (c *JobLogClient) QueryJob(_m *JobLog) *JobQuery {
-	query := (&JobClient{config: c.config}).Query()
+// QueryConnection queries the connection edge of a ConnectionUsage.
+func (c *ConnectionUsageClient) QueryConnection(_m *ConnectionUsage) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(joblog.Table, joblog.FieldID, id),
-			sqlgraph.To(job.Table, job.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, joblog.JobTable, joblog.JobColumn),
+			sqlgraph.From(connectionusage.Table, connectionusage.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, connectionusage.ConnectionTable, connectionusage.ConnectionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -667,131 +761,131 @@ func (c *JobLogClient) QueryJob(_m *JobLog) *JobQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *JobLogClient) Hooks() []Hook {
-	return c.hooks.JobLog
+func (c *ConnectionUsageClient) Hooks() []Hook {
+	return c.hooks.ConnectionUsage
 }
 
 // Interceptors returns the client interceptors.
-func (c *JobLogClient) Interceptors() []Interceptor {
-	return c.inters.JobLog
+func (c *ConnectionUsageClient) Interceptors() []Interceptor {
+	return c.inters.ConnectionUsage
 }
 
-func (c *JobLogClient) mutate(ctx context.Context, m *JobLogMutation) (Value, error) {
+func (c *ConnectionUsageClient) mutate(ctx context.Context, m *ConnectionUsageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&JobLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectionUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&JobLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectionUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&JobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectionUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&JobLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConnectionUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown JobLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConnectionUsage mutation op: %q", m.Op())
 	}
 }
 
-// TaskClient is a client for the Task schema.
-type TaskClient struct {
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
 	config
 }
 
-// NewTaskClient returns a client for the Task from the given config.
-func NewTaskClient(c config) *TaskClient {
-	return &TaskClient{config: c}
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
-func (c *TaskClient) Use(hooks ...Hook) {
-	c.hooks.Task = append(c.hooks.Task, hooks...)
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
-func (c *TaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Task = append(c.inters.Task, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
 }
 
-// Create returns a builder for creating a Task entity.
-func (c *TaskClient) Create() *TaskCreate {
-	mutation := newTaskMutation(c.config, OpCreate)
-	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Task entities.
-func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
-	return &TaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskCreate, rv.Len())
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskCreateBulk{config: c.config, builders: builders}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Task.
-func (c *TaskClient) Update() *TaskUpdate {
-	mutation := newTaskMutation(c.config, OpUpdate)
-	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskClient) UpdateOne(_m *Task) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(_m))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskClient) UpdateOneID(id uuid.UUID) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Task.
-func (c *TaskClient) Delete() *TaskDelete {
-	mutation := newTaskMutation(c.config, OpDelete)
-	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskClient) DeleteOne(_m *Task) *TaskDeleteOne {
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskClient) DeleteOneID(id uuid.UUID) *TaskDeleteOne {
-	builder := c.Delete().Where(task.ID(id))
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskDeleteOne{builder}
+	return &IdempotencyKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Task.
-func (c *TaskClient) Query() *TaskQuery {
-	return &TaskQuery{
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTask},
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Task entity by its id.
-func (c *TaskClient) Get(ctx context.Context, id uuid.UUID) (*Task, error) {
-	return c.Query().Where(task.ID(id)).Only(ctx)
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskClient) GetX(ctx context.Context, id uuid.UUID) *Task {
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -799,31 +893,1037 @@ func (c *TaskClient) GetX(ctx context.Context, id uuid.UUID) *Task {
 	return obj
 }
 
-// QueryJobs queries the jobs edge of a Task.
-func (c *TaskClient) QueryJobs(_m *Task) *JobQuery {
-	query := (&JobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(job.Table, job.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, task.JobsTable, task.JobsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
 }
 
-// QueryConnection queries the connection edge of a Task.
-func (c *TaskClient) QueryConnection(_m *Task) *ConnectionQuery {
-	query := (&ConnectionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(connection.Table, connection.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, task.ConnectionTable, task.ConnectionColumn),
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a Job.
+func (c *JobClient) QueryTask(_m *Job) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.TaskTable, job.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLogs queries the logs edge of a Job.
+func (c *JobClient) QueryLogs(_m *Job) *JobLogQuery {
+	query := (&JobLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(joblog.Table, joblog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, job.LogsTable, job.LogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRetryQueue queries the retry_queue edge of a Job.
+func (c *JobClient) QueryRetryQueue(_m *Job) *RetryQueueQuery {
+	query := (&RetryQueueClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(retryqueue.Table, retryqueue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, job.RetryQueueTable, job.RetryQueueColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvents queries the events edge of a Job.
+func (c *JobClient) QueryEvents(_m *Job) *JobEventQuery {
+	query := (&JobEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(jobevent.Table, jobevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, job.EventsTable, job.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Job.
+func (c *JobClient) QueryParent(_m *Job) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.ParentTable, job.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Job.
+func (c *JobClient) QueryChildren(_m *Job) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, job.ChildrenTable, job.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// JobEventClient is a client for the JobEvent schema.
+type JobEventClient struct {
+	config
+}
+
+// NewJobEventClient returns a client for the JobEvent from the given config.
+func NewJobEventClient(c config) *JobEventClient {
+	return &JobEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobevent.Hooks(f(g(h())))`.
+func (c *JobEventClient) Use(hooks ...Hook) {
+	c.hooks.JobEvent = append(c.hooks.JobEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobevent.Intercept(f(g(h())))`.
+func (c *JobEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobEvent = append(c.inters.JobEvent, interceptors...)
+}
+
+// Create returns a builder for creating a JobEvent entity.
+func (c *JobEventClient) Create() *JobEventCreate {
+	mutation := newJobEventMutation(c.config, OpCreate)
+	return &JobEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobEvent entities.
+func (c *JobEventClient) CreateBulk(builders ...*JobEventCreate) *JobEventCreateBulk {
+	return &JobEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobEventClient) MapCreateBulk(slice any, setFunc func(*JobEventCreate, int)) *JobEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobEventCreateBulk{err: fmt.Errorf("calling to JobEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobEvent.
+func (c *JobEventClient) Update() *JobEventUpdate {
+	mutation := newJobEventMutation(c.config, OpUpdate)
+	return &JobEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobEventClient) UpdateOne(_m *JobEvent) *JobEventUpdateOne {
+	mutation := newJobEventMutation(c.config, OpUpdateOne, withJobEvent(_m))
+	return &JobEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobEventClient) UpdateOneID(id uuid.UUID) *JobEventUpdateOne {
+	mutation := newJobEventMutation(c.config, OpUpdateOne, withJobEventID(id))
+	return &JobEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobEvent.
+func (c *JobEventClient) Delete() *JobEventDelete {
+	mutation := newJobEventMutation(c.config, OpDelete)
+	return &JobEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobEventClient) DeleteOne(_m *JobEvent) *JobEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobEventClient) DeleteOneID(id uuid.UUID) *JobEventDeleteOne {
+	builder := c.Delete().Where(jobevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobEventDeleteOne{builder}
+}
+
+// Query returns a query builder for JobEvent.
+func (c *JobEventClient) Query() *JobEventQuery {
+	return &JobEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobEvent entity by its id.
+func (c *JobEventClient) Get(ctx context.Context, id uuid.UUID) (*JobEvent, error) {
+	return c.Query().Where(jobevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobEventClient) GetX(ctx context.Context, id uuid.UUID) *JobEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJob queries the job edge of a JobEvent.
+func (c *JobEventClient) QueryJob(_m *JobEvent) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobevent.Table, jobevent.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, jobevent.JobTable, jobevent.JobColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobEventClient) Hooks() []Hook {
+	return c.hooks.JobEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobEventClient) Interceptors() []Interceptor {
+	return c.inters.JobEvent
+}
+
+func (c *JobEventClient) mutate(ctx context.Context, m *JobEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobEvent mutation op: %q", m.Op())
+	}
+}
+
+// JobLogClient is a client for the JobLog schema.
+type JobLogClient struct {
+	config
+}
+
+// NewJobLogClient returns a client for the JobLog from the given config.
+func NewJobLogClient(c config) *JobLogClient {
+	return &JobLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `joblog.Hooks(f(g(h())))`.
+func (c *JobLogClient) Use(hooks ...Hook) {
+	c.hooks.JobLog = append(c.hooks.JobLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `joblog.Intercept(f(g(h())))`.
+func (c *JobLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobLog = append(c.inters.JobLog, interceptors...)
+}
+
+// Create returns a builder for creating a JobLog entity.
+func (c *JobLogClient) Create() *JobLogCreate {
+	mutation := newJobLogMutation(c.config, OpCreate)
+	return &JobLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobLog entities.
+func (c *JobLogClient) CreateBulk(builders ...*JobLogCreate) *JobLogCreateBulk {
+	return &JobLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobLogClient) MapCreateBulk(slice any, setFunc func(*JobLogCreate, int)) *JobLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobLogCreateBulk{err: fmt.Errorf("calling to JobLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobLog.
+func (c *JobLogClient) Update() *JobLogUpdate {
+	mutation := newJobLogMutation(c.config, OpUpdate)
+	return &JobLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobLogClient) UpdateOne(_m *JobLog) *JobLogUpdateOne {
+	mutation := newJobLogMutation(c.config, OpUpdateOne, withJobLog(_m))
+	return &JobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobLogClient) UpdateOneID(id int) *JobLogUpdateOne {
+	mutation := newJobLogMutation(c.config, OpUpdateOne, withJobLogID(id))
+	return &JobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobLog.
+func (c *JobLogClient) Delete() *JobLogDelete {
+	mutation := newJobLogMutation(c.config, OpDelete)
+	return &JobLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobLogClient) DeleteOne(_m *JobLog) *JobLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobLogClient) DeleteOneID(id int) *JobLogDeleteOne {
+	builder := c.Delete().Where(joblog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobLogDeleteOne{builder}
+}
+
+// Query returns a query builder for JobLog.
+func (c *JobLogClient) Query() *JobLogQuery {
+	return &JobLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobLog entity by its id.
+func (c *JobLogClient) Get(ctx context.Context, id int) (*JobLog, error) {
+	return c.Query().Where(joblog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobLogClient) GetX(ctx context.Context, id int) *JobLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJob queries the job edge of a JobLog.
+func (c *JobLogClient) QueryJob(_m *JobLog) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(joblog.Table, joblog.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, joblog.JobTable, joblog.JobColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobLogClient) Hooks() []Hook {
+	return c.hooks.JobLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobLogClient) Interceptors() []Interceptor {
+	return c.inters.JobLog
+}
+
+func (c *JobLogClient) mutate(ctx context.Context, m *JobLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobLog mutation op: %q", m.Op())
+	}
+}
+
+// RetryQueueClient is a client for the RetryQueue schema.
+type RetryQueueClient struct {
+	config
+}
+
+// NewRetryQueueClient returns a client for the RetryQueue from the given config.
+func NewRetryQueueClient(c config) *RetryQueueClient {
+	return &RetryQueueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `retryqueue.Hooks(f(g(h())))`.
+func (c *RetryQueueClient) Use(hooks ...Hook) {
+	c.hooks.RetryQueue = append(c.hooks.RetryQueue, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `retryqueue.Intercept(f(g(h())))`.
+func (c *RetryQueueClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RetryQueue = append(c.inters.RetryQueue, interceptors...)
+}
+
+// Create returns a builder for creating a RetryQueue entity.
+func (c *RetryQueueClient) Create() *RetryQueueCreate {
+	mutation := newRetryQueueMutation(c.config, OpCreate)
+	return &RetryQueueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RetryQueue entities.
+func (c *RetryQueueClient) CreateBulk(builders ...*RetryQueueCreate) *RetryQueueCreateBulk {
+	return &RetryQueueCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RetryQueueClient) MapCreateBulk(slice any, setFunc func(*RetryQueueCreate, int)) *RetryQueueCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RetryQueueCreateBulk{err: fmt.Errorf("calling to RetryQueueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RetryQueueCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RetryQueueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RetryQueue.
+func (c *RetryQueueClient) Update() *RetryQueueUpdate {
+	mutation := newRetryQueueMutation(c.config, OpUpdate)
+	return &RetryQueueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RetryQueueClient) UpdateOne(_m *RetryQueue) *RetryQueueUpdateOne {
+	mutation := newRetryQueueMutation(c.config, OpUpdateOne, withRetryQueue(_m))
+	return &RetryQueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RetryQueueClient) UpdateOneID(id uuid.UUID) *RetryQueueUpdateOne {
+	mutation := newRetryQueueMutation(c.config, OpUpdateOne, withRetryQueueID(id))
+	return &RetryQueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RetryQueue.
+func (c *RetryQueueClient) Delete() *RetryQueueDelete {
+	mutation := newRetryQueueMutation(c.config, OpDelete)
+	return &RetryQueueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RetryQueueClient) DeleteOne(_m *RetryQueue) *RetryQueueDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RetryQueueClient) DeleteOneID(id uuid.UUID) *RetryQueueDeleteOne {
+	builder := c.Delete().Where(retryqueue.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RetryQueueDeleteOne{builder}
+}
+
+// Query returns a query builder for RetryQueue.
+func (c *RetryQueueClient) Query() *RetryQueueQuery {
+	return &RetryQueueQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRetryQueue},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RetryQueue entity by its id.
+func (c *RetryQueueClient) Get(ctx context.Context, id uuid.UUID) (*RetryQueue, error) {
+	return c.Query().Where(retryqueue.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RetryQueueClient) GetX(ctx context.Context, id uuid.UUID) *RetryQueue {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJob queries the job edge of a RetryQueue.
+func (c *RetryQueueClient) QueryJob(_m *RetryQueue) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(retryqueue.Table, retryqueue.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, retryqueue.JobTable, retryqueue.JobColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RetryQueueClient) Hooks() []Hook {
+	return c.hooks.RetryQueue
+}
+
+// Interceptors returns the client interceptors.
+func (c *RetryQueueClient) Interceptors() []Interceptor {
+	return c.inters.RetryQueue
+}
+
+func (c *RetryQueueClient) mutate(ctx context.Context, m *RetryQueueMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RetryQueueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RetryQueueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RetryQueueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RetryQueueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RetryQueue mutation op: %q", m.Op())
+	}
+}
+
+// ShareTokenClient is a client for the ShareToken schema.
+type ShareTokenClient struct {
+	config
+}
+
+// NewShareTokenClient returns a client for the ShareToken from the given config.
+func NewShareTokenClient(c config) *ShareTokenClient {
+	return &ShareTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharetoken.Hooks(f(g(h())))`.
+func (c *ShareTokenClient) Use(hooks ...Hook) {
+	c.hooks.ShareToken = append(c.hooks.ShareToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharetoken.Intercept(f(g(h())))`.
+func (c *ShareTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareToken = append(c.inters.ShareToken, interceptors...)
+}
+
+// Create returns a builder for creating a ShareToken entity.
+func (c *ShareTokenClient) Create() *ShareTokenCreate {
+	mutation := newShareTokenMutation(c.config, OpCreate)
+	return &ShareTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareToken entities.
+func (c *ShareTokenClient) CreateBulk(builders ...*ShareTokenCreate) *ShareTokenCreateBulk {
+	return &ShareTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareTokenClient) MapCreateBulk(slice any, setFunc func(*ShareTokenCreate, int)) *ShareTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareTokenCreateBulk{err: fmt.Errorf("calling to ShareTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareToken.
+func (c *ShareTokenClient) Update() *ShareTokenUpdate {
+	mutation := newShareTokenMutation(c.config, OpUpdate)
+	return &ShareTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareTokenClient) UpdateOne(_m *ShareToken) *ShareTokenUpdateOne {
+	mutation := newShareTokenMutation(c.config, OpUpdateOne, withShareToken(_m))
+	return &ShareTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareTokenClient) UpdateOneID(id uuid.UUID) *ShareTokenUpdateOne {
+	mutation := newShareTokenMutation(c.config, OpUpdateOne, withShareTokenID(id))
+	return &ShareTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareToken.
+func (c *ShareTokenClient) Delete() *ShareTokenDelete {
+	mutation := newShareTokenMutation(c.config, OpDelete)
+	return &ShareTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareTokenClient) DeleteOne(_m *ShareToken) *ShareTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareTokenClient) DeleteOneID(id uuid.UUID) *ShareTokenDeleteOne {
+	builder := c.Delete().Where(sharetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareToken.
+func (c *ShareTokenClient) Query() *ShareTokenQuery {
+	return &ShareTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShareToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShareToken entity by its id.
+func (c *ShareTokenClient) Get(ctx context.Context, id uuid.UUID) (*ShareToken, error) {
+	return c.Query().Where(sharetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareTokenClient) GetX(ctx context.Context, id uuid.UUID) *ShareToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a ShareToken.
+func (c *ShareTokenClient) QueryTask(_m *ShareToken) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharetoken.Table, sharetoken.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharetoken.TaskTable, sharetoken.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConnection queries the connection edge of a ShareToken.
+func (c *ShareTokenClient) QueryConnection(_m *ShareToken) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharetoken.Table, sharetoken.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharetoken.ConnectionTable, sharetoken.ConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareTokenClient) Hooks() []Hook {
+	return c.hooks.ShareToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareTokenClient) Interceptors() []Interceptor {
+	return c.inters.ShareToken
+}
+
+func (c *ShareTokenClient) mutate(ctx context.Context, m *ShareTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShareToken mutation op: %q", m.Op())
+	}
+}
+
+// TaskClient is a client for the Task schema.
+type TaskClient struct {
+	config
+}
+
+// NewTaskClient returns a client for the Task from the given config.
+func NewTaskClient(c config) *TaskClient {
+	return &TaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
+func (c *TaskClient) Use(hooks ...Hook) {
+	c.hooks.Task = append(c.hooks.Task, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
+// Create returns a builder for creating a Task entity.
+func (c *TaskClient) Create() *TaskCreate {
+	mutation := newTaskMutation(c.config, OpCreate)
+	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Task entities.
+func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Task.
+func (c *TaskClient) Update() *TaskUpdate {
+	mutation := newTaskMutation(c.config, OpUpdate)
+	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskClient) UpdateOne(_m *Task) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(_m))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskClient) UpdateOneID(id uuid.UUID) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Task.
+func (c *TaskClient) Delete() *TaskDelete {
+	mutation := newTaskMutation(c.config, OpDelete)
+	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskClient) DeleteOne(_m *Task) *TaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskClient) DeleteOneID(id uuid.UUID) *TaskDeleteOne {
+	builder := c.Delete().Where(task.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskDeleteOne{builder}
+}
+
+// Query returns a query builder for Task.
+func (c *TaskClient) Query() *TaskQuery {
+	return &TaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Task entity by its id.
+func (c *TaskClient) Get(ctx context.Context, id uuid.UUID) (*Task, error) {
+	return c.Query().Where(task.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskClient) GetX(ctx context.Context, id uuid.UUID) *Task {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryJobs queries the jobs edge of a Task.
+func (c *TaskClient) QueryJobs(_m *Task) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.JobsTable, task.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvents queries the events edge of a Task.
+func (c *TaskClient) QueryEvents(_m *Task) *TaskEventQuery {
+	query := (&TaskEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskevent.Table, taskevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.EventsTable, task.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareTokens queries the share_tokens edge of a Task.
+func (c *TaskClient) QueryShareTokens(_m *Task) *ShareTokenQuery {
+	query := (&ShareTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(sharetoken.Table, sharetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ShareTokensTable, task.ShareTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConnection queries the connection edge of a Task.
+func (c *TaskClient) QueryConnection(_m *Task) *ConnectionQuery {
+	query := (&ConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(connection.Table, connection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.ConnectionTable, task.ConnectionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -856,12 +1956,187 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// TaskEventClient is a client for the TaskEvent schema.
+type TaskEventClient struct {
+	config
+}
+
+// NewTaskEventClient returns a client for the TaskEvent from the given config.
+func NewTaskEventClient(c config) *TaskEventClient {
+	return &TaskEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskevent.Hooks(f(g(h())))`.
+func (c *TaskEventClient) Use(hooks ...Hook) {
+	c.hooks.TaskEvent = append(c.hooks.TaskEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskevent.Intercept(f(g(h())))`.
+func (c *TaskEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskEvent = append(c.inters.TaskEvent, interceptors...)
+}
+
+// Create returns a builder for creating a TaskEvent entity.
+func (c *TaskEventClient) Create() *TaskEventCreate {
+	mutation := newTaskEventMutation(c.config, OpCreate)
+	return &TaskEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskEvent entities.
+func (c *TaskEventClient) CreateBulk(builders ...*TaskEventCreate) *TaskEventCreateBulk {
+	return &TaskEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskEventClient) MapCreateBulk(slice any, setFunc func(*TaskEventCreate, int)) *TaskEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskEventCreateBulk{err: fmt.Errorf("calling to TaskEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskEvent.
+func (c *TaskEventClient) Update() *TaskEventUpdate {
+	mutation := newTaskEventMutation(c.config, OpUpdate)
+	return &TaskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskEventClient) UpdateOne(_m *TaskEvent) *TaskEventUpdateOne {
+	mutation := newTaskEventMutation(c.config, OpUpdateOne, withTaskEvent(_m))
+	return &TaskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskEventClient) UpdateOneID(id uuid.UUID) *TaskEventUpdateOne {
+	mutation := newTaskEventMutation(c.config, OpUpdateOne, withTaskEventID(id))
+	return &TaskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskEvent.
+func (c *TaskEventClient) Delete() *TaskEventDelete {
+	mutation := newTaskEventMutation(c.config, OpDelete)
+	return &TaskEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskEventClient) DeleteOne(_m *TaskEvent) *TaskEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskEventClient) DeleteOneID(id uuid.UUID) *TaskEventDeleteOne {
+	builder := c.Delete().Where(taskevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskEventDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskEvent.
+func (c *TaskEventClient) Query() *TaskEventQuery {
+	return &TaskEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskEvent entity by its id.
+func (c *TaskEventClient) Get(ctx context.Context, id uuid.UUID) (*TaskEvent, error) {
+	return c.Query().Where(taskevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskEventClient) GetX(ctx context.Context, id uuid.UUID) *TaskEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskEvent.
+func (c *TaskEventClient) QueryTask(_m *TaskEvent) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskevent.Table, taskevent.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskevent.TaskTable, taskevent.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskEventClient) Hooks() []Hook {
+	return c.hooks.TaskEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskEventClient) Interceptors() []Interceptor {
+	return c.inters.TaskEvent
+}
+
+func (c *TaskEventClient) mutate(ctx context.Context, m *TaskEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskEvent mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Connection, Job, JobLog, Task []ent.Hook
+		Connection, ConnectionTransfer, ConnectionUsage, IdempotencyKey, Job, JobEvent,
+		JobLog, RetryQueue, ShareToken, Task, TaskEvent []ent.Hook
 	}
 	inters struct {
-		Connection, Job, JobLog, Task []ent.Interceptor
+		Connection, ConnectionTransfer, ConnectionUsage, IdempotencyKey, Job, JobEvent,
+		JobLog, RetryQueue, ShareToken, Task, TaskEvent []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
 )
 
@@ -20,10 +21,34 @@ type Connection struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Remote name, must be unique across the system
 	Name string `json:"name,omitempty"`
-	// Provider type, e.g., onedrive, s3, drive, local
-	Type string `json:"type,omitempty"`
+	// Provider type, the config type of a compiled rclone backend, e.g., onedrive, s3, drive, local
+	Type model.ConnectionType `json:"type,omitempty"`
 	// AES-GCM encrypted configuration JSON
 	EncryptedConfig []byte `json:"encrypted_config,omitempty"`
+	// Result of the last connection test
+	HealthStatus *model.ConnectionHealthStatus `json:"health_status,omitempty"`
+	// HealthCheckedAt holds the value of the "health_checked_at" field.
+	HealthCheckedAt *time.Time `json:"health_checked_at,omitempty"`
+	// Error message of the last failed connection test
+	HealthError string `json:"health_error,omitempty"`
+	// Remote path prefix prepended to the remote path of every task of the connection
+	BasePath string `json:"base_path,omitempty"`
+	// Maximum API transactions per second of each remote path of the connection, mapped to the pacer of the backend
+	PathTpsLimit *float64 `json:"path_tps_limit,omitempty"`
+	// Number of API transactions allowed in a burst above path_tps_limit
+	PathTpsBurst *int `json:"path_tps_burst,omitempty"`
+	// Name shown in the UI instead of the connection name
+	DisplayName string `json:"display_name,omitempty"`
+	// Color of the connection in the UI, as #rrggbb
+	Color string `json:"color,omitempty"`
+	// Name of the icon of the connection in the UI
+	Icon string `json:"icon,omitempty"`
+	// Time the credentials stored in the config lapse unless refreshed, parsed from its OAuth token
+	CredentialsExpireAt *time.Time `json:"credentials_expire_at,omitempty"`
+	// Maximum bytes transferred by the jobs of the connection per calendar month, further jobs are deferred
+	MonthlyTransferCap *int64 `json:"monthly_transfer_cap,omitempty"`
+	// Incremented by every user edit of the name, config or base path
+	ConfigVersion int `json:"config_version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -38,9 +63,15 @@ type Connection struct {
 type ConnectionEdges struct {
 	// Tasks holds the value of the tasks edge.
 	Tasks []*Task `json:"tasks,omitempty"`
+	// Usage holds the value of the usage edge.
+	Usage []*ConnectionUsage `json:"usage,omitempty"`
+	// ShareTokens holds the value of the share_tokens edge.
+	ShareTokens []*ShareToken `json:"share_tokens,omitempty"`
+	// Transfers holds the value of the transfers edge.
+	Transfers []*ConnectionTransfer `json:"transfers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // TasksOrErr returns the Tasks value or an error if the edge
@@ -52,6 +83,33 @@ func (e ConnectionEdges) TasksOrErr() ([]*Task, error) {
 	return nil, &NotLoadedError{edge: "tasks"}
 }
 
+// UsageOrErr returns the Usage value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConnectionEdges) UsageOrErr() ([]*ConnectionUsage, error) {
+	if e.loadedTypes[1] {
+		return e.Usage, nil
+	}
+	return nil, &NotLoadedError{edge: "usage"}
+}
+
+// ShareTokensOrErr returns the ShareTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConnectionEdges) ShareTokensOrErr() ([]*ShareToken, error) {
+	if e.loadedTypes[2] {
+		return e.ShareTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "share_tokens"}
+}
+
+// TransfersOrErr returns the Transfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConnectionEdges) TransfersOrErr() ([]*ConnectionTransfer, error) {
+	if e.loadedTypes[3] {
+		return e.Transfers, nil
+	}
+	return nil, &NotLoadedError{edge: "transfers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Connection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -59,9 +117,13 @@ func (*Connection) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case connection.FieldEncryptedConfig:
 			values[i] = new([]byte)
-		case connection.FieldName, connection.FieldType:
+		case connection.FieldPathTpsLimit:
+			values[i] = new(sql.NullFloat64)
+		case connection.FieldPathTpsBurst, connection.FieldMonthlyTransferCap, connection.FieldConfigVersion:
+			values[i] = new(sql.NullInt64)
+		case connection.FieldName, connection.FieldType, connection.FieldHealthStatus, connection.FieldHealthError, connection.FieldBasePath, connection.FieldDisplayName, connection.FieldColor, connection.FieldIcon:
 			values[i] = new(sql.NullString)
-		case connection.FieldCreatedAt, connection.FieldUpdatedAt:
+		case connection.FieldHealthCheckedAt, connection.FieldCredentialsExpireAt, connection.FieldCreatedAt, connection.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case connection.FieldID:
 			values[i] = new(uuid.UUID)
@@ -96,7 +158,7 @@ func (_m *Connection) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
 			} else if value.Valid {
-				_m.Type = value.String
+				_m.Type = model.ConnectionType(value.String)
 			}
 		case connection.FieldEncryptedConfig:
 			if value, ok := values[i].(*[]byte); !ok {
@@ -104,6 +166,84 @@ func (_m *Connection) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.EncryptedConfig = *value
 			}
+		case connection.FieldHealthStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health_status", values[i])
+			} else if value.Valid {
+				_m.HealthStatus = new(model.ConnectionHealthStatus)
+				*_m.HealthStatus = model.ConnectionHealthStatus(value.String)
+			}
+		case connection.FieldHealthCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field health_checked_at", values[i])
+			} else if value.Valid {
+				_m.HealthCheckedAt = new(time.Time)
+				*_m.HealthCheckedAt = value.Time
+			}
+		case connection.FieldHealthError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health_error", values[i])
+			} else if value.Valid {
+				_m.HealthError = value.String
+			}
+		case connection.FieldBasePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_path", values[i])
+			} else if value.Valid {
+				_m.BasePath = value.String
+			}
+		case connection.FieldPathTpsLimit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field path_tps_limit", values[i])
+			} else if value.Valid {
+				_m.PathTpsLimit = new(float64)
+				*_m.PathTpsLimit = value.Float64
+			}
+		case connection.FieldPathTpsBurst:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field path_tps_burst", values[i])
+			} else if value.Valid {
+				_m.PathTpsBurst = new(int)
+				*_m.PathTpsBurst = int(value.Int64)
+			}
+		case connection.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case connection.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				_m.Color = value.String
+			}
+		case connection.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = value.String
+			}
+		case connection.FieldCredentialsExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field credentials_expire_at", values[i])
+			} else if value.Valid {
+				_m.CredentialsExpireAt = new(time.Time)
+				*_m.CredentialsExpireAt = value.Time
+			}
+		case connection.FieldMonthlyTransferCap:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_transfer_cap", values[i])
+			} else if value.Valid {
+				_m.MonthlyTransferCap = new(int64)
+				*_m.MonthlyTransferCap = value.Int64
+			}
+		case connection.FieldConfigVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field config_version", values[i])
+			} else if value.Valid {
+				_m.ConfigVersion = int(value.Int64)
+			}
 		case connection.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -134,6 +274,21 @@ func (_m *Connection) QueryTasks() *TaskQuery {
 	return NewConnectionClient(_m.config).QueryTasks(_m)
 }
 
+// QueryUsage queries the "usage" edge of the Connection entity.
+func (_m *Connection) QueryUsage() *ConnectionUsageQuery {
+	return NewConnectionClient(_m.config).QueryUsage(_m)
+}
+
+// QueryShareTokens queries the "share_tokens" edge of the Connection entity.
+func (_m *Connection) QueryShareTokens() *ShareTokenQuery {
+	return NewConnectionClient(_m.config).QueryShareTokens(_m)
+}
+
+// QueryTransfers queries the "transfers" edge of the Connection entity.
+func (_m *Connection) QueryTransfers() *ConnectionTransferQuery {
+	return NewConnectionClient(_m.config).QueryTransfers(_m)
+}
+
 // Update returns a builder for updating this Connection.
 // Note that you need to call Connection.Unwrap() before calling this method if this Connection
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -161,11 +316,59 @@ func (_m *Connection) String() string {
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
 	builder.WriteString("type=")
-	builder.WriteString(_m.Type)
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
 	builder.WriteString(", ")
 	builder.WriteString("encrypted_config=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EncryptedConfig))
 	builder.WriteString(", ")
+	if v := _m.HealthStatus; v != nil {
+		builder.WriteString("health_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HealthCheckedAt; v != nil {
+		builder.WriteString("health_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("health_error=")
+	builder.WriteString(_m.HealthError)
+	builder.WriteString(", ")
+	builder.WriteString("base_path=")
+	builder.WriteString(_m.BasePath)
+	builder.WriteString(", ")
+	if v := _m.PathTpsLimit; v != nil {
+		builder.WriteString("path_tps_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PathTpsBurst; v != nil {
+		builder.WriteString("path_tps_burst=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(_m.Color)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
+	if v := _m.CredentialsExpireAt; v != nil {
+		builder.WriteString("credentials_expire_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.MonthlyTransferCap; v != nil {
+		builder.WriteString("monthly_transfer_cap=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("config_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConfigVersion))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
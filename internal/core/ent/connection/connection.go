@@ -3,11 +3,13 @@
 package connection
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 )
 
 const (
@@ -21,12 +23,42 @@ const (
 	FieldType = "type"
 	// FieldEncryptedConfig holds the string denoting the encrypted_config field in the database.
 	FieldEncryptedConfig = "encrypted_config"
+	// FieldHealthStatus holds the string denoting the health_status field in the database.
+	FieldHealthStatus = "health_status"
+	// FieldHealthCheckedAt holds the string denoting the health_checked_at field in the database.
+	FieldHealthCheckedAt = "health_checked_at"
+	// FieldHealthError holds the string denoting the health_error field in the database.
+	FieldHealthError = "health_error"
+	// FieldBasePath holds the string denoting the base_path field in the database.
+	FieldBasePath = "base_path"
+	// FieldPathTpsLimit holds the string denoting the path_tps_limit field in the database.
+	FieldPathTpsLimit = "path_tps_limit"
+	// FieldPathTpsBurst holds the string denoting the path_tps_burst field in the database.
+	FieldPathTpsBurst = "path_tps_burst"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// FieldIcon holds the string denoting the icon field in the database.
+	FieldIcon = "icon"
+	// FieldCredentialsExpireAt holds the string denoting the credentials_expire_at field in the database.
+	FieldCredentialsExpireAt = "credentials_expire_at"
+	// FieldMonthlyTransferCap holds the string denoting the monthly_transfer_cap field in the database.
+	FieldMonthlyTransferCap = "monthly_transfer_cap"
+	// FieldConfigVersion holds the string denoting the config_version field in the database.
+	FieldConfigVersion = "config_version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeTasks holds the string denoting the tasks edge name in mutations.
 	EdgeTasks = "tasks"
+	// EdgeUsage holds the string denoting the usage edge name in mutations.
+	EdgeUsage = "usage"
+	// EdgeShareTokens holds the string denoting the share_tokens edge name in mutations.
+	EdgeShareTokens = "share_tokens"
+	// EdgeTransfers holds the string denoting the transfers edge name in mutations.
+	EdgeTransfers = "transfers"
 	// Table holds the table name of the connection in the database.
 	Table = "connections"
 	// TasksTable is the table that holds the tasks relation/edge.
@@ -36,6 +68,27 @@ const (
 	TasksInverseTable = "tasks"
 	// TasksColumn is the table column denoting the tasks relation/edge.
 	TasksColumn = "connection_id"
+	// UsageTable is the table that holds the usage relation/edge.
+	UsageTable = "connection_usages"
+	// UsageInverseTable is the table name for the ConnectionUsage entity.
+	// It exists in this package in order to avoid circular dependency with the "connectionusage" package.
+	UsageInverseTable = "connection_usages"
+	// UsageColumn is the table column denoting the usage relation/edge.
+	UsageColumn = "connection_id"
+	// ShareTokensTable is the table that holds the share_tokens relation/edge.
+	ShareTokensTable = "share_tokens"
+	// ShareTokensInverseTable is the table name for the ShareToken entity.
+	// It exists in this package in order to avoid circular dependency with the "sharetoken" package.
+	ShareTokensInverseTable = "share_tokens"
+	// ShareTokensColumn is the table column denoting the share_tokens relation/edge.
+	ShareTokensColumn = "connection_id"
+	// TransfersTable is the table that holds the transfers relation/edge.
+	TransfersTable = "connection_transfers"
+	// TransfersInverseTable is the table name for the ConnectionTransfer entity.
+	// It exists in this package in order to avoid circular dependency with the "connectiontransfer" package.
+	TransfersInverseTable = "connection_transfers"
+	// TransfersColumn is the table column denoting the transfers relation/edge.
+	TransfersColumn = "connection_id"
 )
 
 // Columns holds all SQL columns for connection fields.
@@ -44,6 +97,18 @@ var Columns = []string{
 	FieldName,
 	FieldType,
 	FieldEncryptedConfig,
+	FieldHealthStatus,
+	FieldHealthCheckedAt,
+	FieldHealthError,
+	FieldBasePath,
+	FieldPathTpsLimit,
+	FieldPathTpsBurst,
+	FieldDisplayName,
+	FieldColor,
+	FieldIcon,
+	FieldCredentialsExpireAt,
+	FieldMonthlyTransferCap,
+	FieldConfigVersion,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -61,8 +126,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
-	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
-	TypeValidator func(string) error
+	// DefaultConfigVersion holds the default value on creation for the "config_version" field.
+	DefaultConfigVersion int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -73,6 +138,26 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type model.ConnectionType) error {
+	switch _type.String() {
+	case "alias", "archive", "azureblob", "azurefiles", "b2", "box", "cache", "chunker", "cloudinary", "combine", "compress", "crypt", "doi", "drive", "dropbox", "fichier", "filefabric", "filelu", "filescom", "ftp", "gcs", "gofile", "gphotos", "hasher", "hdfs", "hidrive", "http", "iclouddrive", "imagekit", "internetarchive", "jottacloud", "koofr", "linkbox", "local", "mailru", "mega", "memory", "netstorage", "onedrive", "oos", "opendrive", "pcloud", "pikpak", "pixeldrain", "premiumizeme", "protondrive", "putio", "qingstor", "quatrix", "s3", "seafile", "sftp", "sharefile", "sia", "smb", "storj", "sugarsync", "swift", "tardigrade", "ulozto", "union", "uptobox", "webdav", "yandex", "zoho":
+		return nil
+	default:
+		return fmt.Errorf("connection: invalid enum value for type field: %q", _type)
+	}
+}
+
+// HealthStatusValidator is a validator for the "health_status" field enum values. It is called by the builders before save.
+func HealthStatusValidator(hs model.ConnectionHealthStatus) error {
+	switch hs.String() {
+	case "HEALTHY", "UNHEALTHY":
+		return nil
+	default:
+		return fmt.Errorf("connection: invalid enum value for health_status field: %q", hs)
+	}
+}
+
 // OrderOption defines the ordering options for the Connection queries.
 type OrderOption func(*sql.Selector)
 
@@ -91,6 +176,66 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByHealthStatus orders the results by the health_status field.
+func ByHealthStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthStatus, opts...).ToFunc()
+}
+
+// ByHealthCheckedAt orders the results by the health_checked_at field.
+func ByHealthCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthCheckedAt, opts...).ToFunc()
+}
+
+// ByHealthError orders the results by the health_error field.
+func ByHealthError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthError, opts...).ToFunc()
+}
+
+// ByBasePath orders the results by the base_path field.
+func ByBasePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBasePath, opts...).ToFunc()
+}
+
+// ByPathTpsLimit orders the results by the path_tps_limit field.
+func ByPathTpsLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPathTpsLimit, opts...).ToFunc()
+}
+
+// ByPathTpsBurst orders the results by the path_tps_burst field.
+func ByPathTpsBurst(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPathTpsBurst, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByColor orders the results by the color field.
+func ByColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColor, opts...).ToFunc()
+}
+
+// ByIcon orders the results by the icon field.
+func ByIcon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIcon, opts...).ToFunc()
+}
+
+// ByCredentialsExpireAt orders the results by the credentials_expire_at field.
+func ByCredentialsExpireAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredentialsExpireAt, opts...).ToFunc()
+}
+
+// ByMonthlyTransferCap orders the results by the monthly_transfer_cap field.
+func ByMonthlyTransferCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyTransferCap, opts...).ToFunc()
+}
+
+// ByConfigVersion orders the results by the config_version field.
+func ByConfigVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfigVersion, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -114,6 +259,48 @@ func ByTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByUsageCount orders the results by usage count.
+func ByUsageCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsageStep(), opts...)
+	}
+}
+
+// ByUsage orders the results by usage terms.
+func ByUsage(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsageStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareTokensCount orders the results by share_tokens count.
+func ByShareTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareTokensStep(), opts...)
+	}
+}
+
+// ByShareTokens orders the results by share_tokens terms.
+func ByShareTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransfersCount orders the results by transfers count.
+func ByTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransfersStep(), opts...)
+	}
+}
+
+// ByTransfers orders the results by transfers terms.
+func ByTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTasksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -121,3 +308,24 @@ func newTasksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
 	)
 }
+func newUsageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsageTable, UsageColumn),
+	)
+}
+func newShareTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareTokensTable, ShareTokensColumn),
+	)
+}
+func newTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransfersTable, TransfersColumn),
+	)
+}
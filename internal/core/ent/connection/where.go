@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
 )
 
@@ -61,16 +62,66 @@ func Name(v string) predicate.Connection {
 	return predicate.Connection(sql.FieldEQ(FieldName, v))
 }
 
-// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
-func Type(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldEQ(FieldType, v))
-}
-
 // EncryptedConfig applies equality check predicate on the "encrypted_config" field. It's identical to EncryptedConfigEQ.
 func EncryptedConfig(v []byte) predicate.Connection {
 	return predicate.Connection(sql.FieldEQ(FieldEncryptedConfig, v))
 }
 
+// HealthCheckedAt applies equality check predicate on the "health_checked_at" field. It's identical to HealthCheckedAtEQ.
+func HealthCheckedAt(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthError applies equality check predicate on the "health_error" field. It's identical to HealthErrorEQ.
+func HealthError(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldHealthError, v))
+}
+
+// BasePath applies equality check predicate on the "base_path" field. It's identical to BasePathEQ.
+func BasePath(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldBasePath, v))
+}
+
+// PathTpsLimit applies equality check predicate on the "path_tps_limit" field. It's identical to PathTpsLimitEQ.
+func PathTpsLimit(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldPathTpsLimit, v))
+}
+
+// PathTpsBurst applies equality check predicate on the "path_tps_burst" field. It's identical to PathTpsBurstEQ.
+func PathTpsBurst(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldPathTpsBurst, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// Color applies equality check predicate on the "color" field. It's identical to ColorEQ.
+func Color(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldColor, v))
+}
+
+// Icon applies equality check predicate on the "icon" field. It's identical to IconEQ.
+func Icon(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldIcon, v))
+}
+
+// CredentialsExpireAt applies equality check predicate on the "credentials_expire_at" field. It's identical to CredentialsExpireAtEQ.
+func CredentialsExpireAt(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldCredentialsExpireAt, v))
+}
+
+// MonthlyTransferCap applies equality check predicate on the "monthly_transfer_cap" field. It's identical to MonthlyTransferCapEQ.
+func MonthlyTransferCap(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldMonthlyTransferCap, v))
+}
+
+// ConfigVersion applies equality check predicate on the "config_version" field. It's identical to ConfigVersionEQ.
+func ConfigVersion(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldConfigVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Connection {
 	return predicate.Connection(sql.FieldEQ(FieldCreatedAt, v))
@@ -147,68 +198,33 @@ func NameContainsFold(v string) predicate.Connection {
 }
 
 // TypeEQ applies the EQ predicate on the "type" field.
-func TypeEQ(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldEQ(FieldType, v))
+func TypeEQ(v model.ConnectionType) predicate.Connection {
+	vc := v
+	return predicate.Connection(sql.FieldEQ(FieldType, vc))
 }
 
 // TypeNEQ applies the NEQ predicate on the "type" field.
-func TypeNEQ(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldNEQ(FieldType, v))
+func TypeNEQ(v model.ConnectionType) predicate.Connection {
+	vc := v
+	return predicate.Connection(sql.FieldNEQ(FieldType, vc))
 }
 
 // TypeIn applies the In predicate on the "type" field.
-func TypeIn(vs ...string) predicate.Connection {
-	return predicate.Connection(sql.FieldIn(FieldType, vs...))
+func TypeIn(vs ...model.ConnectionType) predicate.Connection {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Connection(sql.FieldIn(FieldType, v...))
 }
 
 // TypeNotIn applies the NotIn predicate on the "type" field.
-func TypeNotIn(vs ...string) predicate.Connection {
-	return predicate.Connection(sql.FieldNotIn(FieldType, vs...))
-}
-
-// TypeGT applies the GT predicate on the "type" field.
-func TypeGT(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldGT(FieldType, v))
-}
-
-// TypeGTE applies the GTE predicate on the "type" field.
-func TypeGTE(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldGTE(FieldType, v))
-}
-
-// TypeLT applies the LT predicate on the "type" field.
-func TypeLT(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldLT(FieldType, v))
-}
-
-// TypeLTE applies the LTE predicate on the "type" field.
-func TypeLTE(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldLTE(FieldType, v))
-}
-
-// TypeContains applies the Contains predicate on the "type" field.
-func TypeContains(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldContains(FieldType, v))
-}
-
-// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
-func TypeHasPrefix(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldHasPrefix(FieldType, v))
-}
-
-// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
-func TypeHasSuffix(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldHasSuffix(FieldType, v))
-}
-
-// TypeEqualFold applies the EqualFold predicate on the "type" field.
-func TypeEqualFold(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldEqualFold(FieldType, v))
-}
-
-// TypeContainsFold applies the ContainsFold predicate on the "type" field.
-func TypeContainsFold(v string) predicate.Connection {
-	return predicate.Connection(sql.FieldContainsFold(FieldType, v))
+func TypeNotIn(vs ...model.ConnectionType) predicate.Connection {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Connection(sql.FieldNotIn(FieldType, v...))
 }
 
 // EncryptedConfigEQ applies the EQ predicate on the "encrypted_config" field.
@@ -251,6 +267,711 @@ func EncryptedConfigLTE(v []byte) predicate.Connection {
 	return predicate.Connection(sql.FieldLTE(FieldEncryptedConfig, v))
 }
 
+// HealthStatusEQ applies the EQ predicate on the "health_status" field.
+func HealthStatusEQ(v model.ConnectionHealthStatus) predicate.Connection {
+	vc := v
+	return predicate.Connection(sql.FieldEQ(FieldHealthStatus, vc))
+}
+
+// HealthStatusNEQ applies the NEQ predicate on the "health_status" field.
+func HealthStatusNEQ(v model.ConnectionHealthStatus) predicate.Connection {
+	vc := v
+	return predicate.Connection(sql.FieldNEQ(FieldHealthStatus, vc))
+}
+
+// HealthStatusIn applies the In predicate on the "health_status" field.
+func HealthStatusIn(vs ...model.ConnectionHealthStatus) predicate.Connection {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Connection(sql.FieldIn(FieldHealthStatus, v...))
+}
+
+// HealthStatusNotIn applies the NotIn predicate on the "health_status" field.
+func HealthStatusNotIn(vs ...model.ConnectionHealthStatus) predicate.Connection {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Connection(sql.FieldNotIn(FieldHealthStatus, v...))
+}
+
+// HealthStatusIsNil applies the IsNil predicate on the "health_status" field.
+func HealthStatusIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldHealthStatus))
+}
+
+// HealthStatusNotNil applies the NotNil predicate on the "health_status" field.
+func HealthStatusNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldHealthStatus))
+}
+
+// HealthCheckedAtEQ applies the EQ predicate on the "health_checked_at" field.
+func HealthCheckedAtEQ(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtNEQ applies the NEQ predicate on the "health_checked_at" field.
+func HealthCheckedAtNEQ(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtIn applies the In predicate on the "health_checked_at" field.
+func HealthCheckedAtIn(vs ...time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldHealthCheckedAt, vs...))
+}
+
+// HealthCheckedAtNotIn applies the NotIn predicate on the "health_checked_at" field.
+func HealthCheckedAtNotIn(vs ...time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldHealthCheckedAt, vs...))
+}
+
+// HealthCheckedAtGT applies the GT predicate on the "health_checked_at" field.
+func HealthCheckedAtGT(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtGTE applies the GTE predicate on the "health_checked_at" field.
+func HealthCheckedAtGTE(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtLT applies the LT predicate on the "health_checked_at" field.
+func HealthCheckedAtLT(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtLTE applies the LTE predicate on the "health_checked_at" field.
+func HealthCheckedAtLTE(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtIsNil applies the IsNil predicate on the "health_checked_at" field.
+func HealthCheckedAtIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldHealthCheckedAt))
+}
+
+// HealthCheckedAtNotNil applies the NotNil predicate on the "health_checked_at" field.
+func HealthCheckedAtNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldHealthCheckedAt))
+}
+
+// HealthErrorEQ applies the EQ predicate on the "health_error" field.
+func HealthErrorEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldHealthError, v))
+}
+
+// HealthErrorNEQ applies the NEQ predicate on the "health_error" field.
+func HealthErrorNEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldHealthError, v))
+}
+
+// HealthErrorIn applies the In predicate on the "health_error" field.
+func HealthErrorIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldHealthError, vs...))
+}
+
+// HealthErrorNotIn applies the NotIn predicate on the "health_error" field.
+func HealthErrorNotIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldHealthError, vs...))
+}
+
+// HealthErrorGT applies the GT predicate on the "health_error" field.
+func HealthErrorGT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldHealthError, v))
+}
+
+// HealthErrorGTE applies the GTE predicate on the "health_error" field.
+func HealthErrorGTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldHealthError, v))
+}
+
+// HealthErrorLT applies the LT predicate on the "health_error" field.
+func HealthErrorLT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldHealthError, v))
+}
+
+// HealthErrorLTE applies the LTE predicate on the "health_error" field.
+func HealthErrorLTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldHealthError, v))
+}
+
+// HealthErrorContains applies the Contains predicate on the "health_error" field.
+func HealthErrorContains(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContains(FieldHealthError, v))
+}
+
+// HealthErrorHasPrefix applies the HasPrefix predicate on the "health_error" field.
+func HealthErrorHasPrefix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasPrefix(FieldHealthError, v))
+}
+
+// HealthErrorHasSuffix applies the HasSuffix predicate on the "health_error" field.
+func HealthErrorHasSuffix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasSuffix(FieldHealthError, v))
+}
+
+// HealthErrorIsNil applies the IsNil predicate on the "health_error" field.
+func HealthErrorIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldHealthError))
+}
+
+// HealthErrorNotNil applies the NotNil predicate on the "health_error" field.
+func HealthErrorNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldHealthError))
+}
+
+// HealthErrorEqualFold applies the EqualFold predicate on the "health_error" field.
+func HealthErrorEqualFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEqualFold(FieldHealthError, v))
+}
+
+// HealthErrorContainsFold applies the ContainsFold predicate on the "health_error" field.
+func HealthErrorContainsFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContainsFold(FieldHealthError, v))
+}
+
+// BasePathEQ applies the EQ predicate on the "base_path" field.
+func BasePathEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldBasePath, v))
+}
+
+// BasePathNEQ applies the NEQ predicate on the "base_path" field.
+func BasePathNEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldBasePath, v))
+}
+
+// BasePathIn applies the In predicate on the "base_path" field.
+func BasePathIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldBasePath, vs...))
+}
+
+// BasePathNotIn applies the NotIn predicate on the "base_path" field.
+func BasePathNotIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldBasePath, vs...))
+}
+
+// BasePathGT applies the GT predicate on the "base_path" field.
+func BasePathGT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldBasePath, v))
+}
+
+// BasePathGTE applies the GTE predicate on the "base_path" field.
+func BasePathGTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldBasePath, v))
+}
+
+// BasePathLT applies the LT predicate on the "base_path" field.
+func BasePathLT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldBasePath, v))
+}
+
+// BasePathLTE applies the LTE predicate on the "base_path" field.
+func BasePathLTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldBasePath, v))
+}
+
+// BasePathContains applies the Contains predicate on the "base_path" field.
+func BasePathContains(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContains(FieldBasePath, v))
+}
+
+// BasePathHasPrefix applies the HasPrefix predicate on the "base_path" field.
+func BasePathHasPrefix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasPrefix(FieldBasePath, v))
+}
+
+// BasePathHasSuffix applies the HasSuffix predicate on the "base_path" field.
+func BasePathHasSuffix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasSuffix(FieldBasePath, v))
+}
+
+// BasePathIsNil applies the IsNil predicate on the "base_path" field.
+func BasePathIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldBasePath))
+}
+
+// BasePathNotNil applies the NotNil predicate on the "base_path" field.
+func BasePathNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldBasePath))
+}
+
+// BasePathEqualFold applies the EqualFold predicate on the "base_path" field.
+func BasePathEqualFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEqualFold(FieldBasePath, v))
+}
+
+// BasePathContainsFold applies the ContainsFold predicate on the "base_path" field.
+func BasePathContainsFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContainsFold(FieldBasePath, v))
+}
+
+// PathTpsLimitEQ applies the EQ predicate on the "path_tps_limit" field.
+func PathTpsLimitEQ(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldPathTpsLimit, v))
+}
+
+// PathTpsLimitNEQ applies the NEQ predicate on the "path_tps_limit" field.
+func PathTpsLimitNEQ(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldPathTpsLimit, v))
+}
+
+// PathTpsLimitIn applies the In predicate on the "path_tps_limit" field.
+func PathTpsLimitIn(vs ...float64) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldPathTpsLimit, vs...))
+}
+
+// PathTpsLimitNotIn applies the NotIn predicate on the "path_tps_limit" field.
+func PathTpsLimitNotIn(vs ...float64) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldPathTpsLimit, vs...))
+}
+
+// PathTpsLimitGT applies the GT predicate on the "path_tps_limit" field.
+func PathTpsLimitGT(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldPathTpsLimit, v))
+}
+
+// PathTpsLimitGTE applies the GTE predicate on the "path_tps_limit" field.
+func PathTpsLimitGTE(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldPathTpsLimit, v))
+}
+
+// PathTpsLimitLT applies the LT predicate on the "path_tps_limit" field.
+func PathTpsLimitLT(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldPathTpsLimit, v))
+}
+
+// PathTpsLimitLTE applies the LTE predicate on the "path_tps_limit" field.
+func PathTpsLimitLTE(v float64) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldPathTpsLimit, v))
+}
+
+// PathTpsLimitIsNil applies the IsNil predicate on the "path_tps_limit" field.
+func PathTpsLimitIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldPathTpsLimit))
+}
+
+// PathTpsLimitNotNil applies the NotNil predicate on the "path_tps_limit" field.
+func PathTpsLimitNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldPathTpsLimit))
+}
+
+// PathTpsBurstEQ applies the EQ predicate on the "path_tps_burst" field.
+func PathTpsBurstEQ(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldPathTpsBurst, v))
+}
+
+// PathTpsBurstNEQ applies the NEQ predicate on the "path_tps_burst" field.
+func PathTpsBurstNEQ(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldPathTpsBurst, v))
+}
+
+// PathTpsBurstIn applies the In predicate on the "path_tps_burst" field.
+func PathTpsBurstIn(vs ...int) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldPathTpsBurst, vs...))
+}
+
+// PathTpsBurstNotIn applies the NotIn predicate on the "path_tps_burst" field.
+func PathTpsBurstNotIn(vs ...int) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldPathTpsBurst, vs...))
+}
+
+// PathTpsBurstGT applies the GT predicate on the "path_tps_burst" field.
+func PathTpsBurstGT(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldPathTpsBurst, v))
+}
+
+// PathTpsBurstGTE applies the GTE predicate on the "path_tps_burst" field.
+func PathTpsBurstGTE(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldPathTpsBurst, v))
+}
+
+// PathTpsBurstLT applies the LT predicate on the "path_tps_burst" field.
+func PathTpsBurstLT(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldPathTpsBurst, v))
+}
+
+// PathTpsBurstLTE applies the LTE predicate on the "path_tps_burst" field.
+func PathTpsBurstLTE(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldPathTpsBurst, v))
+}
+
+// PathTpsBurstIsNil applies the IsNil predicate on the "path_tps_burst" field.
+func PathTpsBurstIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldPathTpsBurst))
+}
+
+// PathTpsBurstNotNil applies the NotNil predicate on the "path_tps_burst" field.
+func PathTpsBurstNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldPathTpsBurst))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// ColorEQ applies the EQ predicate on the "color" field.
+func ColorEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldColor, v))
+}
+
+// ColorNEQ applies the NEQ predicate on the "color" field.
+func ColorNEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldColor, v))
+}
+
+// ColorIn applies the In predicate on the "color" field.
+func ColorIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldColor, vs...))
+}
+
+// ColorNotIn applies the NotIn predicate on the "color" field.
+func ColorNotIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldColor, vs...))
+}
+
+// ColorGT applies the GT predicate on the "color" field.
+func ColorGT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldColor, v))
+}
+
+// ColorGTE applies the GTE predicate on the "color" field.
+func ColorGTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldColor, v))
+}
+
+// ColorLT applies the LT predicate on the "color" field.
+func ColorLT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldColor, v))
+}
+
+// ColorLTE applies the LTE predicate on the "color" field.
+func ColorLTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldColor, v))
+}
+
+// ColorContains applies the Contains predicate on the "color" field.
+func ColorContains(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContains(FieldColor, v))
+}
+
+// ColorHasPrefix applies the HasPrefix predicate on the "color" field.
+func ColorHasPrefix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasPrefix(FieldColor, v))
+}
+
+// ColorHasSuffix applies the HasSuffix predicate on the "color" field.
+func ColorHasSuffix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasSuffix(FieldColor, v))
+}
+
+// ColorIsNil applies the IsNil predicate on the "color" field.
+func ColorIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldColor))
+}
+
+// ColorNotNil applies the NotNil predicate on the "color" field.
+func ColorNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldColor))
+}
+
+// ColorEqualFold applies the EqualFold predicate on the "color" field.
+func ColorEqualFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEqualFold(FieldColor, v))
+}
+
+// ColorContainsFold applies the ContainsFold predicate on the "color" field.
+func ColorContainsFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContainsFold(FieldColor, v))
+}
+
+// IconEQ applies the EQ predicate on the "icon" field.
+func IconEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldIcon, v))
+}
+
+// IconNEQ applies the NEQ predicate on the "icon" field.
+func IconNEQ(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldIcon, v))
+}
+
+// IconIn applies the In predicate on the "icon" field.
+func IconIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldIcon, vs...))
+}
+
+// IconNotIn applies the NotIn predicate on the "icon" field.
+func IconNotIn(vs ...string) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldIcon, vs...))
+}
+
+// IconGT applies the GT predicate on the "icon" field.
+func IconGT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldIcon, v))
+}
+
+// IconGTE applies the GTE predicate on the "icon" field.
+func IconGTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldIcon, v))
+}
+
+// IconLT applies the LT predicate on the "icon" field.
+func IconLT(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldIcon, v))
+}
+
+// IconLTE applies the LTE predicate on the "icon" field.
+func IconLTE(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldIcon, v))
+}
+
+// IconContains applies the Contains predicate on the "icon" field.
+func IconContains(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContains(FieldIcon, v))
+}
+
+// IconHasPrefix applies the HasPrefix predicate on the "icon" field.
+func IconHasPrefix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasPrefix(FieldIcon, v))
+}
+
+// IconHasSuffix applies the HasSuffix predicate on the "icon" field.
+func IconHasSuffix(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldHasSuffix(FieldIcon, v))
+}
+
+// IconIsNil applies the IsNil predicate on the "icon" field.
+func IconIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldIcon))
+}
+
+// IconNotNil applies the NotNil predicate on the "icon" field.
+func IconNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldIcon))
+}
+
+// IconEqualFold applies the EqualFold predicate on the "icon" field.
+func IconEqualFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldEqualFold(FieldIcon, v))
+}
+
+// IconContainsFold applies the ContainsFold predicate on the "icon" field.
+func IconContainsFold(v string) predicate.Connection {
+	return predicate.Connection(sql.FieldContainsFold(FieldIcon, v))
+}
+
+// CredentialsExpireAtEQ applies the EQ predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtEQ(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldCredentialsExpireAt, v))
+}
+
+// CredentialsExpireAtNEQ applies the NEQ predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtNEQ(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldCredentialsExpireAt, v))
+}
+
+// CredentialsExpireAtIn applies the In predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtIn(vs ...time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldCredentialsExpireAt, vs...))
+}
+
+// CredentialsExpireAtNotIn applies the NotIn predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtNotIn(vs ...time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldCredentialsExpireAt, vs...))
+}
+
+// CredentialsExpireAtGT applies the GT predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtGT(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldCredentialsExpireAt, v))
+}
+
+// CredentialsExpireAtGTE applies the GTE predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtGTE(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldCredentialsExpireAt, v))
+}
+
+// CredentialsExpireAtLT applies the LT predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtLT(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldCredentialsExpireAt, v))
+}
+
+// CredentialsExpireAtLTE applies the LTE predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtLTE(v time.Time) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldCredentialsExpireAt, v))
+}
+
+// CredentialsExpireAtIsNil applies the IsNil predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldCredentialsExpireAt))
+}
+
+// CredentialsExpireAtNotNil applies the NotNil predicate on the "credentials_expire_at" field.
+func CredentialsExpireAtNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldCredentialsExpireAt))
+}
+
+// MonthlyTransferCapEQ applies the EQ predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapEQ(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldMonthlyTransferCap, v))
+}
+
+// MonthlyTransferCapNEQ applies the NEQ predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapNEQ(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldMonthlyTransferCap, v))
+}
+
+// MonthlyTransferCapIn applies the In predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapIn(vs ...int64) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldMonthlyTransferCap, vs...))
+}
+
+// MonthlyTransferCapNotIn applies the NotIn predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapNotIn(vs ...int64) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldMonthlyTransferCap, vs...))
+}
+
+// MonthlyTransferCapGT applies the GT predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapGT(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldMonthlyTransferCap, v))
+}
+
+// MonthlyTransferCapGTE applies the GTE predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapGTE(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldMonthlyTransferCap, v))
+}
+
+// MonthlyTransferCapLT applies the LT predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapLT(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldMonthlyTransferCap, v))
+}
+
+// MonthlyTransferCapLTE applies the LTE predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapLTE(v int64) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldMonthlyTransferCap, v))
+}
+
+// MonthlyTransferCapIsNil applies the IsNil predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapIsNil() predicate.Connection {
+	return predicate.Connection(sql.FieldIsNull(FieldMonthlyTransferCap))
+}
+
+// MonthlyTransferCapNotNil applies the NotNil predicate on the "monthly_transfer_cap" field.
+func MonthlyTransferCapNotNil() predicate.Connection {
+	return predicate.Connection(sql.FieldNotNull(FieldMonthlyTransferCap))
+}
+
+// ConfigVersionEQ applies the EQ predicate on the "config_version" field.
+func ConfigVersionEQ(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldEQ(FieldConfigVersion, v))
+}
+
+// ConfigVersionNEQ applies the NEQ predicate on the "config_version" field.
+func ConfigVersionNEQ(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldNEQ(FieldConfigVersion, v))
+}
+
+// ConfigVersionIn applies the In predicate on the "config_version" field.
+func ConfigVersionIn(vs ...int) predicate.Connection {
+	return predicate.Connection(sql.FieldIn(FieldConfigVersion, vs...))
+}
+
+// ConfigVersionNotIn applies the NotIn predicate on the "config_version" field.
+func ConfigVersionNotIn(vs ...int) predicate.Connection {
+	return predicate.Connection(sql.FieldNotIn(FieldConfigVersion, vs...))
+}
+
+// ConfigVersionGT applies the GT predicate on the "config_version" field.
+func ConfigVersionGT(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldGT(FieldConfigVersion, v))
+}
+
+// ConfigVersionGTE applies the GTE predicate on the "config_version" field.
+func ConfigVersionGTE(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldGTE(FieldConfigVersion, v))
+}
+
+// ConfigVersionLT applies the LT predicate on the "config_version" field.
+func ConfigVersionLT(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldLT(FieldConfigVersion, v))
+}
+
+// ConfigVersionLTE applies the LTE predicate on the "config_version" field.
+func ConfigVersionLTE(v int) predicate.Connection {
+	return predicate.Connection(sql.FieldLTE(FieldConfigVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Connection {
 	return predicate.Connection(sql.FieldEQ(FieldCreatedAt, v))
@@ -354,6 +1075,75 @@ func HasTasksWith(preds ...predicate.Task) predicate.Connection {
 	})
 }
 
+// HasUsage applies the HasEdge predicate on the "usage" edge.
+func HasUsage() predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsageTable, UsageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsageWith applies the HasEdge predicate on the "usage" edge with a given conditions (other predicates).
+func HasUsageWith(preds ...predicate.ConnectionUsage) predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := newUsageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareTokens applies the HasEdge predicate on the "share_tokens" edge.
+func HasShareTokens() predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareTokensTable, ShareTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareTokensWith applies the HasEdge predicate on the "share_tokens" edge with a given conditions (other predicates).
+func HasShareTokensWith(preds ...predicate.ShareToken) predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := newShareTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfers applies the HasEdge predicate on the "transfers" edge.
+func HasTransfers() predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransfersTable, TransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransfersWith applies the HasEdge predicate on the "transfers" edge with a given conditions (other predicates).
+func HasTransfersWith(preds ...predicate.ConnectionTransfer) predicate.Connection {
+	return predicate.Connection(func(s *sql.Selector) {
+		step := newTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Connection) predicate.Connection {
 	return predicate.Connection(sql.AndPredicates(predicates...))
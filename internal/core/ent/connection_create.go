@@ -11,7 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 )
 
@@ -29,7 +33,7 @@ func (_c *ConnectionCreate) SetName(v string) *ConnectionCreate {
 }
 
 // SetType sets the "type" field.
-func (_c *ConnectionCreate) SetType(v string) *ConnectionCreate {
+func (_c *ConnectionCreate) SetType(v model.ConnectionType) *ConnectionCreate {
 	_c.mutation.SetType(v)
 	return _c
 }
@@ -40,6 +44,174 @@ func (_c *ConnectionCreate) SetEncryptedConfig(v []byte) *ConnectionCreate {
 	return _c
 }
 
+// SetHealthStatus sets the "health_status" field.
+func (_c *ConnectionCreate) SetHealthStatus(v model.ConnectionHealthStatus) *ConnectionCreate {
+	_c.mutation.SetHealthStatus(v)
+	return _c
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableHealthStatus(v *model.ConnectionHealthStatus) *ConnectionCreate {
+	if v != nil {
+		_c.SetHealthStatus(*v)
+	}
+	return _c
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_c *ConnectionCreate) SetHealthCheckedAt(v time.Time) *ConnectionCreate {
+	_c.mutation.SetHealthCheckedAt(v)
+	return _c
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableHealthCheckedAt(v *time.Time) *ConnectionCreate {
+	if v != nil {
+		_c.SetHealthCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetHealthError sets the "health_error" field.
+func (_c *ConnectionCreate) SetHealthError(v string) *ConnectionCreate {
+	_c.mutation.SetHealthError(v)
+	return _c
+}
+
+// SetNillableHealthError sets the "health_error" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableHealthError(v *string) *ConnectionCreate {
+	if v != nil {
+		_c.SetHealthError(*v)
+	}
+	return _c
+}
+
+// SetBasePath sets the "base_path" field.
+func (_c *ConnectionCreate) SetBasePath(v string) *ConnectionCreate {
+	_c.mutation.SetBasePath(v)
+	return _c
+}
+
+// SetNillableBasePath sets the "base_path" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableBasePath(v *string) *ConnectionCreate {
+	if v != nil {
+		_c.SetBasePath(*v)
+	}
+	return _c
+}
+
+// SetPathTpsLimit sets the "path_tps_limit" field.
+func (_c *ConnectionCreate) SetPathTpsLimit(v float64) *ConnectionCreate {
+	_c.mutation.SetPathTpsLimit(v)
+	return _c
+}
+
+// SetNillablePathTpsLimit sets the "path_tps_limit" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillablePathTpsLimit(v *float64) *ConnectionCreate {
+	if v != nil {
+		_c.SetPathTpsLimit(*v)
+	}
+	return _c
+}
+
+// SetPathTpsBurst sets the "path_tps_burst" field.
+func (_c *ConnectionCreate) SetPathTpsBurst(v int) *ConnectionCreate {
+	_c.mutation.SetPathTpsBurst(v)
+	return _c
+}
+
+// SetNillablePathTpsBurst sets the "path_tps_burst" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillablePathTpsBurst(v *int) *ConnectionCreate {
+	if v != nil {
+		_c.SetPathTpsBurst(*v)
+	}
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *ConnectionCreate) SetDisplayName(v string) *ConnectionCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableDisplayName(v *string) *ConnectionCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetColor sets the "color" field.
+func (_c *ConnectionCreate) SetColor(v string) *ConnectionCreate {
+	_c.mutation.SetColor(v)
+	return _c
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableColor(v *string) *ConnectionCreate {
+	if v != nil {
+		_c.SetColor(*v)
+	}
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *ConnectionCreate) SetIcon(v string) *ConnectionCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableIcon(v *string) *ConnectionCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
+// SetCredentialsExpireAt sets the "credentials_expire_at" field.
+func (_c *ConnectionCreate) SetCredentialsExpireAt(v time.Time) *ConnectionCreate {
+	_c.mutation.SetCredentialsExpireAt(v)
+	return _c
+}
+
+// SetNillableCredentialsExpireAt sets the "credentials_expire_at" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableCredentialsExpireAt(v *time.Time) *ConnectionCreate {
+	if v != nil {
+		_c.SetCredentialsExpireAt(*v)
+	}
+	return _c
+}
+
+// SetMonthlyTransferCap sets the "monthly_transfer_cap" field.
+func (_c *ConnectionCreate) SetMonthlyTransferCap(v int64) *ConnectionCreate {
+	_c.mutation.SetMonthlyTransferCap(v)
+	return _c
+}
+
+// SetNillableMonthlyTransferCap sets the "monthly_transfer_cap" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableMonthlyTransferCap(v *int64) *ConnectionCreate {
+	if v != nil {
+		_c.SetMonthlyTransferCap(*v)
+	}
+	return _c
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (_c *ConnectionCreate) SetConfigVersion(v int) *ConnectionCreate {
+	_c.mutation.SetConfigVersion(v)
+	return _c
+}
+
+// SetNillableConfigVersion sets the "config_version" field if the given value is not nil.
+func (_c *ConnectionCreate) SetNillableConfigVersion(v *int) *ConnectionCreate {
+	if v != nil {
+		_c.SetConfigVersion(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ConnectionCreate) SetCreatedAt(v time.Time) *ConnectionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -97,6 +269,51 @@ func (_c *ConnectionCreate) AddTasks(v ...*Task) *ConnectionCreate {
 	return _c.AddTaskIDs(ids...)
 }
 
+// AddUsageIDs adds the "usage" edge to the ConnectionUsage entity by IDs.
+func (_c *ConnectionCreate) AddUsageIDs(ids ...uuid.UUID) *ConnectionCreate {
+	_c.mutation.AddUsageIDs(ids...)
+	return _c
+}
+
+// AddUsage adds the "usage" edges to the ConnectionUsage entity.
+func (_c *ConnectionCreate) AddUsage(v ...*ConnectionUsage) *ConnectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUsageIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (_c *ConnectionCreate) AddShareTokenIDs(ids ...uuid.UUID) *ConnectionCreate {
+	_c.mutation.AddShareTokenIDs(ids...)
+	return _c
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (_c *ConnectionCreate) AddShareTokens(v ...*ShareToken) *ConnectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareTokenIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the ConnectionTransfer entity by IDs.
+func (_c *ConnectionCreate) AddTransferIDs(ids ...uuid.UUID) *ConnectionCreate {
+	_c.mutation.AddTransferIDs(ids...)
+	return _c
+}
+
+// AddTransfers adds the "transfers" edges to the ConnectionTransfer entity.
+func (_c *ConnectionCreate) AddTransfers(v ...*ConnectionTransfer) *ConnectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransferIDs(ids...)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_c *ConnectionCreate) Mutation() *ConnectionMutation {
 	return _c.mutation
@@ -132,6 +349,10 @@ func (_c *ConnectionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ConnectionCreate) defaults() {
+	if _, ok := _c.mutation.ConfigVersion(); !ok {
+		v := connection.DefaultConfigVersion
+		_c.mutation.SetConfigVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := connection.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -167,6 +388,14 @@ func (_c *ConnectionCreate) check() error {
 	if _, ok := _c.mutation.EncryptedConfig(); !ok {
 		return &ValidationError{Name: "encrypted_config", err: errors.New(`ent: missing required field "Connection.encrypted_config"`)}
 	}
+	if v, ok := _c.mutation.HealthStatus(); ok {
+		if err := connection.HealthStatusValidator(v); err != nil {
+			return &ValidationError{Name: "health_status", err: fmt.Errorf(`ent: validator failed for field "Connection.health_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConfigVersion(); !ok {
+		return &ValidationError{Name: "config_version", err: errors.New(`ent: missing required field "Connection.config_version"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Connection.created_at"`)}
 	}
@@ -213,13 +442,61 @@ func (_c *ConnectionCreate) createSpec() (*Connection, *sqlgraph.CreateSpec) {
 		_node.Name = value
 	}
 	if value, ok := _c.mutation.GetType(); ok {
-		_spec.SetField(connection.FieldType, field.TypeString, value)
+		_spec.SetField(connection.FieldType, field.TypeEnum, value)
 		_node.Type = value
 	}
 	if value, ok := _c.mutation.EncryptedConfig(); ok {
 		_spec.SetField(connection.FieldEncryptedConfig, field.TypeBytes, value)
 		_node.EncryptedConfig = value
 	}
+	if value, ok := _c.mutation.HealthStatus(); ok {
+		_spec.SetField(connection.FieldHealthStatus, field.TypeEnum, value)
+		_node.HealthStatus = &value
+	}
+	if value, ok := _c.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(connection.FieldHealthCheckedAt, field.TypeTime, value)
+		_node.HealthCheckedAt = &value
+	}
+	if value, ok := _c.mutation.HealthError(); ok {
+		_spec.SetField(connection.FieldHealthError, field.TypeString, value)
+		_node.HealthError = value
+	}
+	if value, ok := _c.mutation.BasePath(); ok {
+		_spec.SetField(connection.FieldBasePath, field.TypeString, value)
+		_node.BasePath = value
+	}
+	if value, ok := _c.mutation.PathTpsLimit(); ok {
+		_spec.SetField(connection.FieldPathTpsLimit, field.TypeFloat64, value)
+		_node.PathTpsLimit = &value
+	}
+	if value, ok := _c.mutation.PathTpsBurst(); ok {
+		_spec.SetField(connection.FieldPathTpsBurst, field.TypeInt, value)
+		_node.PathTpsBurst = &value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(connection.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.Color(); ok {
+		_spec.SetField(connection.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(connection.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
+	if value, ok := _c.mutation.CredentialsExpireAt(); ok {
+		_spec.SetField(connection.FieldCredentialsExpireAt, field.TypeTime, value)
+		_node.CredentialsExpireAt = &value
+	}
+	if value, ok := _c.mutation.MonthlyTransferCap(); ok {
+		_spec.SetField(connection.FieldMonthlyTransferCap, field.TypeInt64, value)
+		_node.MonthlyTransferCap = &value
+	}
+	if value, ok := _c.mutation.ConfigVersion(); ok {
+		_spec.SetField(connection.FieldConfigVersion, field.TypeInt, value)
+		_node.ConfigVersion = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(connection.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -244,6 +521,54 @@ func (_c *ConnectionCreate) createSpec() (*Connection, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,18 +14,24 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 )
 
 // ConnectionQuery is the builder for querying Connection entities.
 type ConnectionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []connection.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Connection
-	withTasks  *TaskQuery
+	ctx             *QueryContext
+	order           []connection.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Connection
+	withTasks       *TaskQuery
+	withUsage       *ConnectionUsageQuery
+	withShareTokens *ShareTokenQuery
+	withTransfers   *ConnectionTransferQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +90,72 @@ func (_q *ConnectionQuery) QueryTasks() *TaskQuery {
 	return query
 }
 
+// QueryUsage chains the current query on the "usage" edge.
+func (_q *ConnectionQuery) QueryUsage() *ConnectionUsageQuery {
+	query := (&ConnectionUsageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, selector),
+			sqlgraph.To(connectionusage.Table, connectionusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.UsageTable, connection.UsageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareTokens chains the current query on the "share_tokens" edge.
+func (_q *ConnectionQuery) QueryShareTokens() *ShareTokenQuery {
+	query := (&ShareTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, selector),
+			sqlgraph.To(sharetoken.Table, sharetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.ShareTokensTable, connection.ShareTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfers chains the current query on the "transfers" edge.
+func (_q *ConnectionQuery) QueryTransfers() *ConnectionTransferQuery {
+	query := (&ConnectionTransferClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connection.Table, connection.FieldID, selector),
+			sqlgraph.To(connectiontransfer.Table, connectiontransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connection.TransfersTable, connection.TransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Connection entity from the query.
 // Returns a *NotFoundError when no Connection was found.
 func (_q *ConnectionQuery) First(ctx context.Context) (*Connection, error) {
@@ -271,12 +343,15 @@ func (_q *ConnectionQuery) Clone() *ConnectionQuery {
 		return nil
 	}
 	return &ConnectionQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]connection.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Connection{}, _q.predicates...),
-		withTasks:  _q.withTasks.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]connection.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Connection{}, _q.predicates...),
+		withTasks:       _q.withTasks.Clone(),
+		withUsage:       _q.withUsage.Clone(),
+		withShareTokens: _q.withShareTokens.Clone(),
+		withTransfers:   _q.withTransfers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +369,39 @@ func (_q *ConnectionQuery) WithTasks(opts ...func(*TaskQuery)) *ConnectionQuery
 	return _q
 }
 
+// WithUsage tells the query-builder to eager-load the nodes that are connected to
+// the "usage" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ConnectionQuery) WithUsage(opts ...func(*ConnectionUsageQuery)) *ConnectionQuery {
+	query := (&ConnectionUsageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUsage = query
+	return _q
+}
+
+// WithShareTokens tells the query-builder to eager-load the nodes that are connected to
+// the "share_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ConnectionQuery) WithShareTokens(opts ...func(*ShareTokenQuery)) *ConnectionQuery {
+	query := (&ShareTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShareTokens = query
+	return _q
+}
+
+// WithTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ConnectionQuery) WithTransfers(opts ...func(*ConnectionTransferQuery)) *ConnectionQuery {
+	query := (&ConnectionTransferClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransfers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +480,11 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 	var (
 		nodes       = []*Connection{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			_q.withTasks != nil,
+			_q.withUsage != nil,
+			_q.withShareTokens != nil,
+			_q.withTransfers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -401,6 +512,27 @@ func (_q *ConnectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 			return nil, err
 		}
 	}
+	if query := _q.withUsage; query != nil {
+		if err := _q.loadUsage(ctx, query, nodes,
+			func(n *Connection) { n.Edges.Usage = []*ConnectionUsage{} },
+			func(n *Connection, e *ConnectionUsage) { n.Edges.Usage = append(n.Edges.Usage, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShareTokens; query != nil {
+		if err := _q.loadShareTokens(ctx, query, nodes,
+			func(n *Connection) { n.Edges.ShareTokens = []*ShareToken{} },
+			func(n *Connection, e *ShareToken) { n.Edges.ShareTokens = append(n.Edges.ShareTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransfers; query != nil {
+		if err := _q.loadTransfers(ctx, query, nodes,
+			func(n *Connection) { n.Edges.Transfers = []*ConnectionTransfer{} },
+			func(n *Connection, e *ConnectionTransfer) { n.Edges.Transfers = append(n.Edges.Transfers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,6 +566,99 @@ func (_q *ConnectionQuery) loadTasks(ctx context.Context, query *TaskQuery, node
 	}
 	return nil
 }
+func (_q *ConnectionQuery) loadUsage(ctx context.Context, query *ConnectionUsageQuery, nodes []*Connection, init func(*Connection), assign func(*Connection, *ConnectionUsage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Connection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(connectionusage.FieldConnectionID)
+	}
+	query.Where(predicate.ConnectionUsage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connection.UsageColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ConnectionQuery) loadShareTokens(ctx context.Context, query *ShareTokenQuery, nodes []*Connection, init func(*Connection), assign func(*Connection, *ShareToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Connection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sharetoken.FieldConnectionID)
+	}
+	query.Where(predicate.ShareToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connection.ShareTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "connection_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connection_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ConnectionQuery) loadTransfers(ctx context.Context, query *ConnectionTransferQuery, nodes []*Connection, init func(*Connection), assign func(*Connection, *ConnectionTransfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Connection)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(connectiontransfer.FieldConnectionID)
+	}
+	query.Where(predicate.ConnectionTransfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connection.TransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connection_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ConnectionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,8 +12,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 )
 
@@ -45,13 +49,13 @@ func (_u *ConnectionUpdate) SetNillableName(v *string) *ConnectionUpdate {
 }
 
 // SetType sets the "type" field.
-func (_u *ConnectionUpdate) SetType(v string) *ConnectionUpdate {
+func (_u *ConnectionUpdate) SetType(v model.ConnectionType) *ConnectionUpdate {
 	_u.mutation.SetType(v)
 	return _u
 }
 
 // SetNillableType sets the "type" field if the given value is not nil.
-func (_u *ConnectionUpdate) SetNillableType(v *string) *ConnectionUpdate {
+func (_u *ConnectionUpdate) SetNillableType(v *model.ConnectionType) *ConnectionUpdate {
 	if v != nil {
 		_u.SetType(*v)
 	}
@@ -64,6 +68,268 @@ func (_u *ConnectionUpdate) SetEncryptedConfig(v []byte) *ConnectionUpdate {
 	return _u
 }
 
+// SetHealthStatus sets the "health_status" field.
+func (_u *ConnectionUpdate) SetHealthStatus(v model.ConnectionHealthStatus) *ConnectionUpdate {
+	_u.mutation.SetHealthStatus(v)
+	return _u
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableHealthStatus(v *model.ConnectionHealthStatus) *ConnectionUpdate {
+	if v != nil {
+		_u.SetHealthStatus(*v)
+	}
+	return _u
+}
+
+// ClearHealthStatus clears the value of the "health_status" field.
+func (_u *ConnectionUpdate) ClearHealthStatus() *ConnectionUpdate {
+	_u.mutation.ClearHealthStatus()
+	return _u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_u *ConnectionUpdate) SetHealthCheckedAt(v time.Time) *ConnectionUpdate {
+	_u.mutation.SetHealthCheckedAt(v)
+	return _u
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableHealthCheckedAt(v *time.Time) *ConnectionUpdate {
+	if v != nil {
+		_u.SetHealthCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (_u *ConnectionUpdate) ClearHealthCheckedAt() *ConnectionUpdate {
+	_u.mutation.ClearHealthCheckedAt()
+	return _u
+}
+
+// SetHealthError sets the "health_error" field.
+func (_u *ConnectionUpdate) SetHealthError(v string) *ConnectionUpdate {
+	_u.mutation.SetHealthError(v)
+	return _u
+}
+
+// SetNillableHealthError sets the "health_error" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableHealthError(v *string) *ConnectionUpdate {
+	if v != nil {
+		_u.SetHealthError(*v)
+	}
+	return _u
+}
+
+// ClearHealthError clears the value of the "health_error" field.
+func (_u *ConnectionUpdate) ClearHealthError() *ConnectionUpdate {
+	_u.mutation.ClearHealthError()
+	return _u
+}
+
+// SetBasePath sets the "base_path" field.
+func (_u *ConnectionUpdate) SetBasePath(v string) *ConnectionUpdate {
+	_u.mutation.SetBasePath(v)
+	return _u
+}
+
+// SetNillableBasePath sets the "base_path" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableBasePath(v *string) *ConnectionUpdate {
+	if v != nil {
+		_u.SetBasePath(*v)
+	}
+	return _u
+}
+
+// ClearBasePath clears the value of the "base_path" field.
+func (_u *ConnectionUpdate) ClearBasePath() *ConnectionUpdate {
+	_u.mutation.ClearBasePath()
+	return _u
+}
+
+// SetPathTpsLimit sets the "path_tps_limit" field.
+func (_u *ConnectionUpdate) SetPathTpsLimit(v float64) *ConnectionUpdate {
+	_u.mutation.ResetPathTpsLimit()
+	_u.mutation.SetPathTpsLimit(v)
+	return _u
+}
+
+// SetNillablePathTpsLimit sets the "path_tps_limit" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillablePathTpsLimit(v *float64) *ConnectionUpdate {
+	if v != nil {
+		_u.SetPathTpsLimit(*v)
+	}
+	return _u
+}
+
+// AddPathTpsLimit adds value to the "path_tps_limit" field.
+func (_u *ConnectionUpdate) AddPathTpsLimit(v float64) *ConnectionUpdate {
+	_u.mutation.AddPathTpsLimit(v)
+	return _u
+}
+
+// ClearPathTpsLimit clears the value of the "path_tps_limit" field.
+func (_u *ConnectionUpdate) ClearPathTpsLimit() *ConnectionUpdate {
+	_u.mutation.ClearPathTpsLimit()
+	return _u
+}
+
+// SetPathTpsBurst sets the "path_tps_burst" field.
+func (_u *ConnectionUpdate) SetPathTpsBurst(v int) *ConnectionUpdate {
+	_u.mutation.ResetPathTpsBurst()
+	_u.mutation.SetPathTpsBurst(v)
+	return _u
+}
+
+// SetNillablePathTpsBurst sets the "path_tps_burst" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillablePathTpsBurst(v *int) *ConnectionUpdate {
+	if v != nil {
+		_u.SetPathTpsBurst(*v)
+	}
+	return _u
+}
+
+// AddPathTpsBurst adds value to the "path_tps_burst" field.
+func (_u *ConnectionUpdate) AddPathTpsBurst(v int) *ConnectionUpdate {
+	_u.mutation.AddPathTpsBurst(v)
+	return _u
+}
+
+// ClearPathTpsBurst clears the value of the "path_tps_burst" field.
+func (_u *ConnectionUpdate) ClearPathTpsBurst() *ConnectionUpdate {
+	_u.mutation.ClearPathTpsBurst()
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *ConnectionUpdate) SetDisplayName(v string) *ConnectionUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableDisplayName(v *string) *ConnectionUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *ConnectionUpdate) ClearDisplayName() *ConnectionUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *ConnectionUpdate) SetColor(v string) *ConnectionUpdate {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableColor(v *string) *ConnectionUpdate {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// ClearColor clears the value of the "color" field.
+func (_u *ConnectionUpdate) ClearColor() *ConnectionUpdate {
+	_u.mutation.ClearColor()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *ConnectionUpdate) SetIcon(v string) *ConnectionUpdate {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableIcon(v *string) *ConnectionUpdate {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *ConnectionUpdate) ClearIcon() *ConnectionUpdate {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
+// SetCredentialsExpireAt sets the "credentials_expire_at" field.
+func (_u *ConnectionUpdate) SetCredentialsExpireAt(v time.Time) *ConnectionUpdate {
+	_u.mutation.SetCredentialsExpireAt(v)
+	return _u
+}
+
+// SetNillableCredentialsExpireAt sets the "credentials_expire_at" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableCredentialsExpireAt(v *time.Time) *ConnectionUpdate {
+	if v != nil {
+		_u.SetCredentialsExpireAt(*v)
+	}
+	return _u
+}
+
+// ClearCredentialsExpireAt clears the value of the "credentials_expire_at" field.
+func (_u *ConnectionUpdate) ClearCredentialsExpireAt() *ConnectionUpdate {
+	_u.mutation.ClearCredentialsExpireAt()
+	return _u
+}
+
+// SetMonthlyTransferCap sets the "monthly_transfer_cap" field.
+func (_u *ConnectionUpdate) SetMonthlyTransferCap(v int64) *ConnectionUpdate {
+	_u.mutation.ResetMonthlyTransferCap()
+	_u.mutation.SetMonthlyTransferCap(v)
+	return _u
+}
+
+// SetNillableMonthlyTransferCap sets the "monthly_transfer_cap" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableMonthlyTransferCap(v *int64) *ConnectionUpdate {
+	if v != nil {
+		_u.SetMonthlyTransferCap(*v)
+	}
+	return _u
+}
+
+// AddMonthlyTransferCap adds value to the "monthly_transfer_cap" field.
+func (_u *ConnectionUpdate) AddMonthlyTransferCap(v int64) *ConnectionUpdate {
+	_u.mutation.AddMonthlyTransferCap(v)
+	return _u
+}
+
+// ClearMonthlyTransferCap clears the value of the "monthly_transfer_cap" field.
+func (_u *ConnectionUpdate) ClearMonthlyTransferCap() *ConnectionUpdate {
+	_u.mutation.ClearMonthlyTransferCap()
+	return _u
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (_u *ConnectionUpdate) SetConfigVersion(v int) *ConnectionUpdate {
+	_u.mutation.ResetConfigVersion()
+	_u.mutation.SetConfigVersion(v)
+	return _u
+}
+
+// SetNillableConfigVersion sets the "config_version" field if the given value is not nil.
+func (_u *ConnectionUpdate) SetNillableConfigVersion(v *int) *ConnectionUpdate {
+	if v != nil {
+		_u.SetConfigVersion(*v)
+	}
+	return _u
+}
+
+// AddConfigVersion adds value to the "config_version" field.
+func (_u *ConnectionUpdate) AddConfigVersion(v int) *ConnectionUpdate {
+	_u.mutation.AddConfigVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ConnectionUpdate) SetUpdatedAt(v time.Time) *ConnectionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -85,6 +351,51 @@ func (_u *ConnectionUpdate) AddTasks(v ...*Task) *ConnectionUpdate {
 	return _u.AddTaskIDs(ids...)
 }
 
+// AddUsageIDs adds the "usage" edge to the ConnectionUsage entity by IDs.
+func (_u *ConnectionUpdate) AddUsageIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.AddUsageIDs(ids...)
+	return _u
+}
+
+// AddUsage adds the "usage" edges to the ConnectionUsage entity.
+func (_u *ConnectionUpdate) AddUsage(v ...*ConnectionUsage) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUsageIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (_u *ConnectionUpdate) AddShareTokenIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.AddShareTokenIDs(ids...)
+	return _u
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (_u *ConnectionUpdate) AddShareTokens(v ...*ShareToken) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareTokenIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the ConnectionTransfer entity by IDs.
+func (_u *ConnectionUpdate) AddTransferIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.AddTransferIDs(ids...)
+	return _u
+}
+
+// AddTransfers adds the "transfers" edges to the ConnectionTransfer entity.
+func (_u *ConnectionUpdate) AddTransfers(v ...*ConnectionTransfer) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransferIDs(ids...)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_u *ConnectionUpdate) Mutation() *ConnectionMutation {
 	return _u.mutation
@@ -111,6 +422,69 @@ func (_u *ConnectionUpdate) RemoveTasks(v ...*Task) *ConnectionUpdate {
 	return _u.RemoveTaskIDs(ids...)
 }
 
+// ClearUsage clears all "usage" edges to the ConnectionUsage entity.
+func (_u *ConnectionUpdate) ClearUsage() *ConnectionUpdate {
+	_u.mutation.ClearUsage()
+	return _u
+}
+
+// RemoveUsageIDs removes the "usage" edge to ConnectionUsage entities by IDs.
+func (_u *ConnectionUpdate) RemoveUsageIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.RemoveUsageIDs(ids...)
+	return _u
+}
+
+// RemoveUsage removes "usage" edges to ConnectionUsage entities.
+func (_u *ConnectionUpdate) RemoveUsage(v ...*ConnectionUsage) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageIDs(ids...)
+}
+
+// ClearShareTokens clears all "share_tokens" edges to the ShareToken entity.
+func (_u *ConnectionUpdate) ClearShareTokens() *ConnectionUpdate {
+	_u.mutation.ClearShareTokens()
+	return _u
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to ShareToken entities by IDs.
+func (_u *ConnectionUpdate) RemoveShareTokenIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.RemoveShareTokenIDs(ids...)
+	return _u
+}
+
+// RemoveShareTokens removes "share_tokens" edges to ShareToken entities.
+func (_u *ConnectionUpdate) RemoveShareTokens(v ...*ShareToken) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareTokenIDs(ids...)
+}
+
+// ClearTransfers clears all "transfers" edges to the ConnectionTransfer entity.
+func (_u *ConnectionUpdate) ClearTransfers() *ConnectionUpdate {
+	_u.mutation.ClearTransfers()
+	return _u
+}
+
+// RemoveTransferIDs removes the "transfers" edge to ConnectionTransfer entities by IDs.
+func (_u *ConnectionUpdate) RemoveTransferIDs(ids ...uuid.UUID) *ConnectionUpdate {
+	_u.mutation.RemoveTransferIDs(ids...)
+	return _u
+}
+
+// RemoveTransfers removes "transfers" edges to ConnectionTransfer entities.
+func (_u *ConnectionUpdate) RemoveTransfers(v ...*ConnectionTransfer) *ConnectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransferIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ConnectionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -159,6 +533,11 @@ func (_u *ConnectionUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Connection.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.HealthStatus(); ok {
+		if err := connection.HealthStatusValidator(v); err != nil {
+			return &ValidationError{Name: "health_status", err: fmt.Errorf(`ent: validator failed for field "Connection.health_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -178,11 +557,92 @@ func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		_spec.SetField(connection.FieldName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.GetType(); ok {
-		_spec.SetField(connection.FieldType, field.TypeString, value)
+		_spec.SetField(connection.FieldType, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.EncryptedConfig(); ok {
 		_spec.SetField(connection.FieldEncryptedConfig, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.HealthStatus(); ok {
+		_spec.SetField(connection.FieldHealthStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.HealthStatusCleared() {
+		_spec.ClearField(connection.FieldHealthStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(connection.FieldHealthCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HealthCheckedAtCleared() {
+		_spec.ClearField(connection.FieldHealthCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HealthError(); ok {
+		_spec.SetField(connection.FieldHealthError, field.TypeString, value)
+	}
+	if _u.mutation.HealthErrorCleared() {
+		_spec.ClearField(connection.FieldHealthError, field.TypeString)
+	}
+	if value, ok := _u.mutation.BasePath(); ok {
+		_spec.SetField(connection.FieldBasePath, field.TypeString, value)
+	}
+	if _u.mutation.BasePathCleared() {
+		_spec.ClearField(connection.FieldBasePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathTpsLimit(); ok {
+		_spec.SetField(connection.FieldPathTpsLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPathTpsLimit(); ok {
+		_spec.AddField(connection.FieldPathTpsLimit, field.TypeFloat64, value)
+	}
+	if _u.mutation.PathTpsLimitCleared() {
+		_spec.ClearField(connection.FieldPathTpsLimit, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PathTpsBurst(); ok {
+		_spec.SetField(connection.FieldPathTpsBurst, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPathTpsBurst(); ok {
+		_spec.AddField(connection.FieldPathTpsBurst, field.TypeInt, value)
+	}
+	if _u.mutation.PathTpsBurstCleared() {
+		_spec.ClearField(connection.FieldPathTpsBurst, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(connection.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(connection.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(connection.FieldColor, field.TypeString, value)
+	}
+	if _u.mutation.ColorCleared() {
+		_spec.ClearField(connection.FieldColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(connection.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(connection.FieldIcon, field.TypeString)
+	}
+	if value, ok := _u.mutation.CredentialsExpireAt(); ok {
+		_spec.SetField(connection.FieldCredentialsExpireAt, field.TypeTime, value)
+	}
+	if _u.mutation.CredentialsExpireAtCleared() {
+		_spec.ClearField(connection.FieldCredentialsExpireAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MonthlyTransferCap(); ok {
+		_spec.SetField(connection.FieldMonthlyTransferCap, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyTransferCap(); ok {
+		_spec.AddField(connection.FieldMonthlyTransferCap, field.TypeInt64, value)
+	}
+	if _u.mutation.MonthlyTransferCapCleared() {
+		_spec.ClearField(connection.FieldMonthlyTransferCap, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ConfigVersion(); ok {
+		_spec.SetField(connection.FieldConfigVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfigVersion(); ok {
+		_spec.AddField(connection.FieldConfigVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(connection.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -231,57 +691,454 @@ func (_u *ConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{connection.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if _u.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsageIDs(); len(nodes) > 0 && !_u.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareTokensIDs(); len(nodes) > 0 && !_u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransfersIDs(); len(nodes) > 0 && !_u.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConnectionUpdateOne is the builder for updating a single Connection entity.
+type ConnectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConnectionMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ConnectionUpdateOne) SetName(v string) *ConnectionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableName(v *string) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ConnectionUpdateOne) SetType(v model.ConnectionType) *ConnectionUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableType(v *model.ConnectionType) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetEncryptedConfig sets the "encrypted_config" field.
+func (_u *ConnectionUpdateOne) SetEncryptedConfig(v []byte) *ConnectionUpdateOne {
+	_u.mutation.SetEncryptedConfig(v)
+	return _u
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_u *ConnectionUpdateOne) SetHealthStatus(v model.ConnectionHealthStatus) *ConnectionUpdateOne {
+	_u.mutation.SetHealthStatus(v)
+	return _u
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableHealthStatus(v *model.ConnectionHealthStatus) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetHealthStatus(*v)
+	}
+	return _u
+}
+
+// ClearHealthStatus clears the value of the "health_status" field.
+func (_u *ConnectionUpdateOne) ClearHealthStatus() *ConnectionUpdateOne {
+	_u.mutation.ClearHealthStatus()
+	return _u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_u *ConnectionUpdateOne) SetHealthCheckedAt(v time.Time) *ConnectionUpdateOne {
+	_u.mutation.SetHealthCheckedAt(v)
+	return _u
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableHealthCheckedAt(v *time.Time) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetHealthCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (_u *ConnectionUpdateOne) ClearHealthCheckedAt() *ConnectionUpdateOne {
+	_u.mutation.ClearHealthCheckedAt()
+	return _u
+}
+
+// SetHealthError sets the "health_error" field.
+func (_u *ConnectionUpdateOne) SetHealthError(v string) *ConnectionUpdateOne {
+	_u.mutation.SetHealthError(v)
+	return _u
+}
+
+// SetNillableHealthError sets the "health_error" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableHealthError(v *string) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetHealthError(*v)
+	}
+	return _u
+}
+
+// ClearHealthError clears the value of the "health_error" field.
+func (_u *ConnectionUpdateOne) ClearHealthError() *ConnectionUpdateOne {
+	_u.mutation.ClearHealthError()
+	return _u
+}
+
+// SetBasePath sets the "base_path" field.
+func (_u *ConnectionUpdateOne) SetBasePath(v string) *ConnectionUpdateOne {
+	_u.mutation.SetBasePath(v)
+	return _u
+}
+
+// SetNillableBasePath sets the "base_path" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableBasePath(v *string) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetBasePath(*v)
+	}
+	return _u
+}
+
+// ClearBasePath clears the value of the "base_path" field.
+func (_u *ConnectionUpdateOne) ClearBasePath() *ConnectionUpdateOne {
+	_u.mutation.ClearBasePath()
+	return _u
+}
+
+// SetPathTpsLimit sets the "path_tps_limit" field.
+func (_u *ConnectionUpdateOne) SetPathTpsLimit(v float64) *ConnectionUpdateOne {
+	_u.mutation.ResetPathTpsLimit()
+	_u.mutation.SetPathTpsLimit(v)
+	return _u
+}
+
+// SetNillablePathTpsLimit sets the "path_tps_limit" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillablePathTpsLimit(v *float64) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetPathTpsLimit(*v)
+	}
+	return _u
+}
+
+// AddPathTpsLimit adds value to the "path_tps_limit" field.
+func (_u *ConnectionUpdateOne) AddPathTpsLimit(v float64) *ConnectionUpdateOne {
+	_u.mutation.AddPathTpsLimit(v)
+	return _u
+}
+
+// ClearPathTpsLimit clears the value of the "path_tps_limit" field.
+func (_u *ConnectionUpdateOne) ClearPathTpsLimit() *ConnectionUpdateOne {
+	_u.mutation.ClearPathTpsLimit()
+	return _u
+}
+
+// SetPathTpsBurst sets the "path_tps_burst" field.
+func (_u *ConnectionUpdateOne) SetPathTpsBurst(v int) *ConnectionUpdateOne {
+	_u.mutation.ResetPathTpsBurst()
+	_u.mutation.SetPathTpsBurst(v)
+	return _u
+}
+
+// SetNillablePathTpsBurst sets the "path_tps_burst" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillablePathTpsBurst(v *int) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetPathTpsBurst(*v)
+	}
+	return _u
+}
+
+// AddPathTpsBurst adds value to the "path_tps_burst" field.
+func (_u *ConnectionUpdateOne) AddPathTpsBurst(v int) *ConnectionUpdateOne {
+	_u.mutation.AddPathTpsBurst(v)
+	return _u
+}
+
+// ClearPathTpsBurst clears the value of the "path_tps_burst" field.
+func (_u *ConnectionUpdateOne) ClearPathTpsBurst() *ConnectionUpdateOne {
+	_u.mutation.ClearPathTpsBurst()
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *ConnectionUpdateOne) SetDisplayName(v string) *ConnectionUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableDisplayName(v *string) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *ConnectionUpdateOne) ClearDisplayName() *ConnectionUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *ConnectionUpdateOne) SetColor(v string) *ConnectionUpdateOne {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableColor(v *string) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// ClearColor clears the value of the "color" field.
+func (_u *ConnectionUpdateOne) ClearColor() *ConnectionUpdateOne {
+	_u.mutation.ClearColor()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *ConnectionUpdateOne) SetIcon(v string) *ConnectionUpdateOne {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableIcon(v *string) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetIcon(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// ConnectionUpdateOne is the builder for updating a single Connection entity.
-type ConnectionUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ConnectionMutation
+// ClearIcon clears the value of the "icon" field.
+func (_u *ConnectionUpdateOne) ClearIcon() *ConnectionUpdateOne {
+	_u.mutation.ClearIcon()
+	return _u
 }
 
-// SetName sets the "name" field.
-func (_u *ConnectionUpdateOne) SetName(v string) *ConnectionUpdateOne {
-	_u.mutation.SetName(v)
+// SetCredentialsExpireAt sets the "credentials_expire_at" field.
+func (_u *ConnectionUpdateOne) SetCredentialsExpireAt(v time.Time) *ConnectionUpdateOne {
+	_u.mutation.SetCredentialsExpireAt(v)
 	return _u
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *ConnectionUpdateOne) SetNillableName(v *string) *ConnectionUpdateOne {
+// SetNillableCredentialsExpireAt sets the "credentials_expire_at" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableCredentialsExpireAt(v *time.Time) *ConnectionUpdateOne {
 	if v != nil {
-		_u.SetName(*v)
+		_u.SetCredentialsExpireAt(*v)
 	}
 	return _u
 }
 
-// SetType sets the "type" field.
-func (_u *ConnectionUpdateOne) SetType(v string) *ConnectionUpdateOne {
-	_u.mutation.SetType(v)
+// ClearCredentialsExpireAt clears the value of the "credentials_expire_at" field.
+func (_u *ConnectionUpdateOne) ClearCredentialsExpireAt() *ConnectionUpdateOne {
+	_u.mutation.ClearCredentialsExpireAt()
 	return _u
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (_u *ConnectionUpdateOne) SetNillableType(v *string) *ConnectionUpdateOne {
+// SetMonthlyTransferCap sets the "monthly_transfer_cap" field.
+func (_u *ConnectionUpdateOne) SetMonthlyTransferCap(v int64) *ConnectionUpdateOne {
+	_u.mutation.ResetMonthlyTransferCap()
+	_u.mutation.SetMonthlyTransferCap(v)
+	return _u
+}
+
+// SetNillableMonthlyTransferCap sets the "monthly_transfer_cap" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableMonthlyTransferCap(v *int64) *ConnectionUpdateOne {
 	if v != nil {
-		_u.SetType(*v)
+		_u.SetMonthlyTransferCap(*v)
 	}
 	return _u
 }
 
-// SetEncryptedConfig sets the "encrypted_config" field.
-func (_u *ConnectionUpdateOne) SetEncryptedConfig(v []byte) *ConnectionUpdateOne {
-	_u.mutation.SetEncryptedConfig(v)
+// AddMonthlyTransferCap adds value to the "monthly_transfer_cap" field.
+func (_u *ConnectionUpdateOne) AddMonthlyTransferCap(v int64) *ConnectionUpdateOne {
+	_u.mutation.AddMonthlyTransferCap(v)
+	return _u
+}
+
+// ClearMonthlyTransferCap clears the value of the "monthly_transfer_cap" field.
+func (_u *ConnectionUpdateOne) ClearMonthlyTransferCap() *ConnectionUpdateOne {
+	_u.mutation.ClearMonthlyTransferCap()
+	return _u
+}
+
+// SetConfigVersion sets the "config_version" field.
+func (_u *ConnectionUpdateOne) SetConfigVersion(v int) *ConnectionUpdateOne {
+	_u.mutation.ResetConfigVersion()
+	_u.mutation.SetConfigVersion(v)
+	return _u
+}
+
+// SetNillableConfigVersion sets the "config_version" field if the given value is not nil.
+func (_u *ConnectionUpdateOne) SetNillableConfigVersion(v *int) *ConnectionUpdateOne {
+	if v != nil {
+		_u.SetConfigVersion(*v)
+	}
+	return _u
+}
+
+// AddConfigVersion adds value to the "config_version" field.
+func (_u *ConnectionUpdateOne) AddConfigVersion(v int) *ConnectionUpdateOne {
+	_u.mutation.AddConfigVersion(v)
 	return _u
 }
 
@@ -306,6 +1163,51 @@ func (_u *ConnectionUpdateOne) AddTasks(v ...*Task) *ConnectionUpdateOne {
 	return _u.AddTaskIDs(ids...)
 }
 
+// AddUsageIDs adds the "usage" edge to the ConnectionUsage entity by IDs.
+func (_u *ConnectionUpdateOne) AddUsageIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.AddUsageIDs(ids...)
+	return _u
+}
+
+// AddUsage adds the "usage" edges to the ConnectionUsage entity.
+func (_u *ConnectionUpdateOne) AddUsage(v ...*ConnectionUsage) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUsageIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (_u *ConnectionUpdateOne) AddShareTokenIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.AddShareTokenIDs(ids...)
+	return _u
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (_u *ConnectionUpdateOne) AddShareTokens(v ...*ShareToken) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareTokenIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the ConnectionTransfer entity by IDs.
+func (_u *ConnectionUpdateOne) AddTransferIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.AddTransferIDs(ids...)
+	return _u
+}
+
+// AddTransfers adds the "transfers" edges to the ConnectionTransfer entity.
+func (_u *ConnectionUpdateOne) AddTransfers(v ...*ConnectionTransfer) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransferIDs(ids...)
+}
+
 // Mutation returns the ConnectionMutation object of the builder.
 func (_u *ConnectionUpdateOne) Mutation() *ConnectionMutation {
 	return _u.mutation
@@ -332,6 +1234,69 @@ func (_u *ConnectionUpdateOne) RemoveTasks(v ...*Task) *ConnectionUpdateOne {
 	return _u.RemoveTaskIDs(ids...)
 }
 
+// ClearUsage clears all "usage" edges to the ConnectionUsage entity.
+func (_u *ConnectionUpdateOne) ClearUsage() *ConnectionUpdateOne {
+	_u.mutation.ClearUsage()
+	return _u
+}
+
+// RemoveUsageIDs removes the "usage" edge to ConnectionUsage entities by IDs.
+func (_u *ConnectionUpdateOne) RemoveUsageIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.RemoveUsageIDs(ids...)
+	return _u
+}
+
+// RemoveUsage removes "usage" edges to ConnectionUsage entities.
+func (_u *ConnectionUpdateOne) RemoveUsage(v ...*ConnectionUsage) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageIDs(ids...)
+}
+
+// ClearShareTokens clears all "share_tokens" edges to the ShareToken entity.
+func (_u *ConnectionUpdateOne) ClearShareTokens() *ConnectionUpdateOne {
+	_u.mutation.ClearShareTokens()
+	return _u
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to ShareToken entities by IDs.
+func (_u *ConnectionUpdateOne) RemoveShareTokenIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.RemoveShareTokenIDs(ids...)
+	return _u
+}
+
+// RemoveShareTokens removes "share_tokens" edges to ShareToken entities.
+func (_u *ConnectionUpdateOne) RemoveShareTokens(v ...*ShareToken) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareTokenIDs(ids...)
+}
+
+// ClearTransfers clears all "transfers" edges to the ConnectionTransfer entity.
+func (_u *ConnectionUpdateOne) ClearTransfers() *ConnectionUpdateOne {
+	_u.mutation.ClearTransfers()
+	return _u
+}
+
+// RemoveTransferIDs removes the "transfers" edge to ConnectionTransfer entities by IDs.
+func (_u *ConnectionUpdateOne) RemoveTransferIDs(ids ...uuid.UUID) *ConnectionUpdateOne {
+	_u.mutation.RemoveTransferIDs(ids...)
+	return _u
+}
+
+// RemoveTransfers removes "transfers" edges to ConnectionTransfer entities.
+func (_u *ConnectionUpdateOne) RemoveTransfers(v ...*ConnectionTransfer) *ConnectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransferIDs(ids...)
+}
+
 // Where appends a list predicates to the ConnectionUpdate builder.
 func (_u *ConnectionUpdateOne) Where(ps ...predicate.Connection) *ConnectionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -393,6 +1358,11 @@ func (_u *ConnectionUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Connection.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.HealthStatus(); ok {
+		if err := connection.HealthStatusValidator(v); err != nil {
+			return &ValidationError{Name: "health_status", err: fmt.Errorf(`ent: validator failed for field "Connection.health_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -429,11 +1399,92 @@ func (_u *ConnectionUpdateOne) sqlSave(ctx context.Context) (_node *Connection,
 		_spec.SetField(connection.FieldName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.GetType(); ok {
-		_spec.SetField(connection.FieldType, field.TypeString, value)
+		_spec.SetField(connection.FieldType, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.EncryptedConfig(); ok {
 		_spec.SetField(connection.FieldEncryptedConfig, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.HealthStatus(); ok {
+		_spec.SetField(connection.FieldHealthStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.HealthStatusCleared() {
+		_spec.ClearField(connection.FieldHealthStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(connection.FieldHealthCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HealthCheckedAtCleared() {
+		_spec.ClearField(connection.FieldHealthCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HealthError(); ok {
+		_spec.SetField(connection.FieldHealthError, field.TypeString, value)
+	}
+	if _u.mutation.HealthErrorCleared() {
+		_spec.ClearField(connection.FieldHealthError, field.TypeString)
+	}
+	if value, ok := _u.mutation.BasePath(); ok {
+		_spec.SetField(connection.FieldBasePath, field.TypeString, value)
+	}
+	if _u.mutation.BasePathCleared() {
+		_spec.ClearField(connection.FieldBasePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathTpsLimit(); ok {
+		_spec.SetField(connection.FieldPathTpsLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPathTpsLimit(); ok {
+		_spec.AddField(connection.FieldPathTpsLimit, field.TypeFloat64, value)
+	}
+	if _u.mutation.PathTpsLimitCleared() {
+		_spec.ClearField(connection.FieldPathTpsLimit, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PathTpsBurst(); ok {
+		_spec.SetField(connection.FieldPathTpsBurst, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPathTpsBurst(); ok {
+		_spec.AddField(connection.FieldPathTpsBurst, field.TypeInt, value)
+	}
+	if _u.mutation.PathTpsBurstCleared() {
+		_spec.ClearField(connection.FieldPathTpsBurst, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(connection.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(connection.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(connection.FieldColor, field.TypeString, value)
+	}
+	if _u.mutation.ColorCleared() {
+		_spec.ClearField(connection.FieldColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(connection.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(connection.FieldIcon, field.TypeString)
+	}
+	if value, ok := _u.mutation.CredentialsExpireAt(); ok {
+		_spec.SetField(connection.FieldCredentialsExpireAt, field.TypeTime, value)
+	}
+	if _u.mutation.CredentialsExpireAtCleared() {
+		_spec.ClearField(connection.FieldCredentialsExpireAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MonthlyTransferCap(); ok {
+		_spec.SetField(connection.FieldMonthlyTransferCap, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyTransferCap(); ok {
+		_spec.AddField(connection.FieldMonthlyTransferCap, field.TypeInt64, value)
+	}
+	if _u.mutation.MonthlyTransferCapCleared() {
+		_spec.ClearField(connection.FieldMonthlyTransferCap, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ConfigVersion(); ok {
+		_spec.SetField(connection.FieldConfigVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfigVersion(); ok {
+		_spec.AddField(connection.FieldConfigVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(connection.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -482,6 +1533,141 @@ func (_u *ConnectionUpdateOne) sqlSave(ctx context.Context) (_node *Connection,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsageIDs(); len(nodes) > 0 && !_u.mutation.UsageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.UsageTable,
+			Columns: []string{connection.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareTokensIDs(); len(nodes) > 0 && !_u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.ShareTokensTable,
+			Columns: []string{connection.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransfersIDs(); len(nodes) > 0 && !_u.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connection.TransfersTable,
+			Columns: []string{connection.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Connection{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+)
+
+// ConnectionTransfer is the model entity for the ConnectionTransfer schema.
+type ConnectionTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ConnectionID holds the value of the "connection_id" field.
+	ConnectionID uuid.UUID `json:"connection_id,omitempty"`
+	// Start of the local calendar month the bytes were transferred in
+	Month time.Time `json:"month,omitempty"`
+	// Bytes holds the value of the "bytes" field.
+	Bytes int64 `json:"bytes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConnectionTransferQuery when eager-loading is set.
+	Edges        ConnectionTransferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConnectionTransferEdges holds the relations/edges for other nodes in the graph.
+type ConnectionTransferEdges struct {
+	// Connection holds the value of the connection edge.
+	Connection *Connection `json:"connection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConnectionOrErr returns the Connection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConnectionTransferEdges) ConnectionOrErr() (*Connection, error) {
+	if e.Connection != nil {
+		return e.Connection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: connection.Label}
+	}
+	return nil, &NotLoadedError{edge: "connection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConnectionTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case connectiontransfer.FieldBytes:
+			values[i] = new(sql.NullInt64)
+		case connectiontransfer.FieldMonth:
+			values[i] = new(sql.NullTime)
+		case connectiontransfer.FieldID, connectiontransfer.FieldConnectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConnectionTransfer fields.
+func (_m *ConnectionTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case connectiontransfer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case connectiontransfer.FieldConnectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value != nil {
+				_m.ConnectionID = *value
+			}
+		case connectiontransfer.FieldMonth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field month", values[i])
+			} else if value.Valid {
+				_m.Month = value.Time
+			}
+		case connectiontransfer.FieldBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes", values[i])
+			} else if value.Valid {
+				_m.Bytes = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConnectionTransfer.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConnectionTransfer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConnection queries the "connection" edge of the ConnectionTransfer entity.
+func (_m *ConnectionTransfer) QueryConnection() *ConnectionQuery {
+	return NewConnectionTransferClient(_m.config).QueryConnection(_m)
+}
+
+// Update returns a builder for updating this ConnectionTransfer.
+// Note that you need to call ConnectionTransfer.Unwrap() before calling this method if this ConnectionTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConnectionTransfer) Update() *ConnectionTransferUpdateOne {
+	return NewConnectionTransferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConnectionTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConnectionTransfer) Unwrap() *ConnectionTransfer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConnectionTransfer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConnectionTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConnectionTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("connection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConnectionID))
+	builder.WriteString(", ")
+	builder.WriteString("month=")
+	builder.WriteString(_m.Month.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bytes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConnectionTransfers is a parsable slice of ConnectionTransfer.
+type ConnectionTransfers []*ConnectionTransfer
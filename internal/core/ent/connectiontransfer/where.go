@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package connectiontransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldMonth, v))
+}
+
+// Bytes applies equality check predicate on the "bytes" field. It's identical to BytesEQ.
+func Bytes(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldBytes, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...uuid.UUID) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v time.Time) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldLTE(FieldMonth, v))
+}
+
+// BytesEQ applies the EQ predicate on the "bytes" field.
+func BytesEQ(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldEQ(FieldBytes, v))
+}
+
+// BytesNEQ applies the NEQ predicate on the "bytes" field.
+func BytesNEQ(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNEQ(FieldBytes, v))
+}
+
+// BytesIn applies the In predicate on the "bytes" field.
+func BytesIn(vs ...int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldIn(FieldBytes, vs...))
+}
+
+// BytesNotIn applies the NotIn predicate on the "bytes" field.
+func BytesNotIn(vs ...int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldNotIn(FieldBytes, vs...))
+}
+
+// BytesGT applies the GT predicate on the "bytes" field.
+func BytesGT(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldGT(FieldBytes, v))
+}
+
+// BytesGTE applies the GTE predicate on the "bytes" field.
+func BytesGTE(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldGTE(FieldBytes, v))
+}
+
+// BytesLT applies the LT predicate on the "bytes" field.
+func BytesLT(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldLT(FieldBytes, v))
+}
+
+// BytesLTE applies the LTE predicate on the "bytes" field.
+func BytesLTE(v int64) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.FieldLTE(FieldBytes, v))
+}
+
+// HasConnection applies the HasEdge predicate on the "connection" edge.
+func HasConnection() predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConnectionTable, ConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectionWith applies the HasEdge predicate on the "connection" edge with a given conditions (other predicates).
+func HasConnectionWith(preds ...predicate.Connection) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(func(s *sql.Selector) {
+		step := newConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConnectionTransfer) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConnectionTransfer) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConnectionTransfer) predicate.ConnectionTransfer {
+	return predicate.ConnectionTransfer(sql.NotPredicates(p))
+}
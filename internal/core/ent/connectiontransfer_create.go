@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+)
+
+// ConnectionTransferCreate is the builder for creating a ConnectionTransfer entity.
+type ConnectionTransferCreate struct {
+	config
+	mutation *ConnectionTransferMutation
+	hooks    []Hook
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *ConnectionTransferCreate) SetConnectionID(v uuid.UUID) *ConnectionTransferCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetMonth sets the "month" field.
+func (_c *ConnectionTransferCreate) SetMonth(v time.Time) *ConnectionTransferCreate {
+	_c.mutation.SetMonth(v)
+	return _c
+}
+
+// SetBytes sets the "bytes" field.
+func (_c *ConnectionTransferCreate) SetBytes(v int64) *ConnectionTransferCreate {
+	_c.mutation.SetBytes(v)
+	return _c
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_c *ConnectionTransferCreate) SetNillableBytes(v *int64) *ConnectionTransferCreate {
+	if v != nil {
+		_c.SetBytes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConnectionTransferCreate) SetID(v uuid.UUID) *ConnectionTransferCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ConnectionTransferCreate) SetNillableID(v *uuid.UUID) *ConnectionTransferCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_c *ConnectionTransferCreate) SetConnection(v *Connection) *ConnectionTransferCreate {
+	return _c.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ConnectionTransferMutation object of the builder.
+func (_c *ConnectionTransferCreate) Mutation() *ConnectionTransferMutation {
+	return _c.mutation
+}
+
+// Save creates the ConnectionTransfer in the database.
+func (_c *ConnectionTransferCreate) Save(ctx context.Context) (*ConnectionTransfer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConnectionTransferCreate) SaveX(ctx context.Context) *ConnectionTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConnectionTransferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConnectionTransferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConnectionTransferCreate) defaults() {
+	if _, ok := _c.mutation.Bytes(); !ok {
+		v := connectiontransfer.DefaultBytes
+		_c.mutation.SetBytes(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := connectiontransfer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConnectionTransferCreate) check() error {
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`ent: missing required field "ConnectionTransfer.connection_id"`)}
+	}
+	if _, ok := _c.mutation.Month(); !ok {
+		return &ValidationError{Name: "month", err: errors.New(`ent: missing required field "ConnectionTransfer.month"`)}
+	}
+	if _, ok := _c.mutation.Bytes(); !ok {
+		return &ValidationError{Name: "bytes", err: errors.New(`ent: missing required field "ConnectionTransfer.bytes"`)}
+	}
+	if len(_c.mutation.ConnectionIDs()) == 0 {
+		return &ValidationError{Name: "connection", err: errors.New(`ent: missing required edge "ConnectionTransfer.connection"`)}
+	}
+	return nil
+}
+
+func (_c *ConnectionTransferCreate) sqlSave(ctx context.Context) (*ConnectionTransfer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConnectionTransferCreate) createSpec() (*ConnectionTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConnectionTransfer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(connectiontransfer.Table, sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Month(); ok {
+		_spec.SetField(connectiontransfer.FieldMonth, field.TypeTime, value)
+		_node.Month = value
+	}
+	if value, ok := _c.mutation.Bytes(); ok {
+		_spec.SetField(connectiontransfer.FieldBytes, field.TypeInt64, value)
+		_node.Bytes = value
+	}
+	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectiontransfer.ConnectionTable,
+			Columns: []string{connectiontransfer.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConnectionTransferCreateBulk is the builder for creating many ConnectionTransfer entities in bulk.
+type ConnectionTransferCreateBulk struct {
+	config
+	err      error
+	builders []*ConnectionTransferCreate
+}
+
+// Save creates the ConnectionTransfer entities in the database.
+func (_c *ConnectionTransferCreateBulk) Save(ctx context.Context) ([]*ConnectionTransfer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConnectionTransfer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConnectionTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConnectionTransferCreateBulk) SaveX(ctx context.Context) []*ConnectionTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConnectionTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConnectionTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ConnectionTransferDelete is the builder for deleting a ConnectionTransfer entity.
+type ConnectionTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConnectionTransferMutation
+}
+
+// Where appends a list predicates to the ConnectionTransferDelete builder.
+func (_d *ConnectionTransferDelete) Where(ps ...predicate.ConnectionTransfer) *ConnectionTransferDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConnectionTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConnectionTransferDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConnectionTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(connectiontransfer.Table, sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConnectionTransferDeleteOne is the builder for deleting a single ConnectionTransfer entity.
+type ConnectionTransferDeleteOne struct {
+	_d *ConnectionTransferDelete
+}
+
+// Where appends a list predicates to the ConnectionTransferDelete builder.
+func (_d *ConnectionTransferDeleteOne) Where(ps ...predicate.ConnectionTransfer) *ConnectionTransferDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConnectionTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{connectiontransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConnectionTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
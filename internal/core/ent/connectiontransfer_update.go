@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ConnectionTransferUpdate is the builder for updating ConnectionTransfer entities.
+type ConnectionTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConnectionTransferMutation
+}
+
+// Where appends a list predicates to the ConnectionTransferUpdate builder.
+func (_u *ConnectionTransferUpdate) Where(ps ...predicate.ConnectionTransfer) *ConnectionTransferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ConnectionTransferUpdate) SetConnectionID(v uuid.UUID) *ConnectionTransferUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ConnectionTransferUpdate) SetNillableConnectionID(v *uuid.UUID) *ConnectionTransferUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *ConnectionTransferUpdate) SetMonth(v time.Time) *ConnectionTransferUpdate {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *ConnectionTransferUpdate) SetNillableMonth(v *time.Time) *ConnectionTransferUpdate {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *ConnectionTransferUpdate) SetBytes(v int64) *ConnectionTransferUpdate {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *ConnectionTransferUpdate) SetNillableBytes(v *int64) *ConnectionTransferUpdate {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *ConnectionTransferUpdate) AddBytes(v int64) *ConnectionTransferUpdate {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ConnectionTransferUpdate) SetConnection(v *Connection) *ConnectionTransferUpdate {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ConnectionTransferMutation object of the builder.
+func (_u *ConnectionTransferUpdate) Mutation() *ConnectionTransferMutation {
+	return _u.mutation
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ConnectionTransferUpdate) ClearConnection() *ConnectionTransferUpdate {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConnectionTransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConnectionTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConnectionTransferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConnectionTransferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConnectionTransferUpdate) check() error {
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConnectionTransfer.connection"`)
+	}
+	return nil
+}
+
+func (_u *ConnectionTransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(connectiontransfer.Table, connectiontransfer.Columns, sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(connectiontransfer.FieldMonth, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(connectiontransfer.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(connectiontransfer.FieldBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectiontransfer.ConnectionTable,
+			Columns: []string{connectiontransfer.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectiontransfer.ConnectionTable,
+			Columns: []string{connectiontransfer.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectiontransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConnectionTransferUpdateOne is the builder for updating a single ConnectionTransfer entity.
+type ConnectionTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConnectionTransferMutation
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ConnectionTransferUpdateOne) SetConnectionID(v uuid.UUID) *ConnectionTransferUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ConnectionTransferUpdateOne) SetNillableConnectionID(v *uuid.UUID) *ConnectionTransferUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *ConnectionTransferUpdateOne) SetMonth(v time.Time) *ConnectionTransferUpdateOne {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *ConnectionTransferUpdateOne) SetNillableMonth(v *time.Time) *ConnectionTransferUpdateOne {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *ConnectionTransferUpdateOne) SetBytes(v int64) *ConnectionTransferUpdateOne {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *ConnectionTransferUpdateOne) SetNillableBytes(v *int64) *ConnectionTransferUpdateOne {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *ConnectionTransferUpdateOne) AddBytes(v int64) *ConnectionTransferUpdateOne {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ConnectionTransferUpdateOne) SetConnection(v *Connection) *ConnectionTransferUpdateOne {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ConnectionTransferMutation object of the builder.
+func (_u *ConnectionTransferUpdateOne) Mutation() *ConnectionTransferMutation {
+	return _u.mutation
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ConnectionTransferUpdateOne) ClearConnection() *ConnectionTransferUpdateOne {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Where appends a list predicates to the ConnectionTransferUpdate builder.
+func (_u *ConnectionTransferUpdateOne) Where(ps ...predicate.ConnectionTransfer) *ConnectionTransferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConnectionTransferUpdateOne) Select(field string, fields ...string) *ConnectionTransferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConnectionTransfer entity.
+func (_u *ConnectionTransferUpdateOne) Save(ctx context.Context) (*ConnectionTransfer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConnectionTransferUpdateOne) SaveX(ctx context.Context) *ConnectionTransfer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConnectionTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConnectionTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConnectionTransferUpdateOne) check() error {
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConnectionTransfer.connection"`)
+	}
+	return nil
+}
+
+func (_u *ConnectionTransferUpdateOne) sqlSave(ctx context.Context) (_node *ConnectionTransfer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(connectiontransfer.Table, connectiontransfer.Columns, sqlgraph.NewFieldSpec(connectiontransfer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConnectionTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, connectiontransfer.FieldID)
+		for _, f := range fields {
+			if !connectiontransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != connectiontransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(connectiontransfer.FieldMonth, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(connectiontransfer.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(connectiontransfer.FieldBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectiontransfer.ConnectionTable,
+			Columns: []string{connectiontransfer.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectiontransfer.ConnectionTable,
+			Columns: []string{connectiontransfer.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConnectionTransfer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectiontransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
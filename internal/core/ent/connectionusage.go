@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+)
+
+// ConnectionUsage is the model entity for the ConnectionUsage schema.
+type ConnectionUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ConnectionID holds the value of the "connection_id" field.
+	ConnectionID uuid.UUID `json:"connection_id,omitempty"`
+	// Start of the local day the sample was taken on
+	Day time.Time `json:"day,omitempty"`
+	// Used holds the value of the "used" field.
+	Used int64 `json:"used,omitempty"`
+	// Total holds the value of the "total" field.
+	Total *int64 `json:"total,omitempty"`
+	// Free holds the value of the "free" field.
+	Free *int64 `json:"free,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConnectionUsageQuery when eager-loading is set.
+	Edges        ConnectionUsageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConnectionUsageEdges holds the relations/edges for other nodes in the graph.
+type ConnectionUsageEdges struct {
+	// Connection holds the value of the connection edge.
+	Connection *Connection `json:"connection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConnectionOrErr returns the Connection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConnectionUsageEdges) ConnectionOrErr() (*Connection, error) {
+	if e.Connection != nil {
+		return e.Connection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: connection.Label}
+	}
+	return nil, &NotLoadedError{edge: "connection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConnectionUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case connectionusage.FieldUsed, connectionusage.FieldTotal, connectionusage.FieldFree:
+			values[i] = new(sql.NullInt64)
+		case connectionusage.FieldDay:
+			values[i] = new(sql.NullTime)
+		case connectionusage.FieldID, connectionusage.FieldConnectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConnectionUsage fields.
+func (_m *ConnectionUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case connectionusage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case connectionusage.FieldConnectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_id", values[i])
+			} else if value != nil {
+				_m.ConnectionID = *value
+			}
+		case connectionusage.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = value.Time
+			}
+		case connectionusage.FieldUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used", values[i])
+			} else if value.Valid {
+				_m.Used = value.Int64
+			}
+		case connectionusage.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = new(int64)
+				*_m.Total = value.Int64
+			}
+		case connectionusage.FieldFree:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field free", values[i])
+			} else if value.Valid {
+				_m.Free = new(int64)
+				*_m.Free = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConnectionUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConnectionUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConnection queries the "connection" edge of the ConnectionUsage entity.
+func (_m *ConnectionUsage) QueryConnection() *ConnectionQuery {
+	return NewConnectionUsageClient(_m.config).QueryConnection(_m)
+}
+
+// Update returns a builder for updating this ConnectionUsage.
+// Note that you need to call ConnectionUsage.Unwrap() before calling this method if this ConnectionUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConnectionUsage) Update() *ConnectionUsageUpdateOne {
+	return NewConnectionUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConnectionUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConnectionUsage) Unwrap() *ConnectionUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConnectionUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConnectionUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConnectionUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("connection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConnectionID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(_m.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Used))
+	builder.WriteString(", ")
+	if v := _m.Total; v != nil {
+		builder.WriteString("total=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Free; v != nil {
+		builder.WriteString("free=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConnectionUsages is a parsable slice of ConnectionUsage.
+type ConnectionUsages []*ConnectionUsage
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package connectionusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLTE(FieldID, id))
+}
+
+// ConnectionID applies equality check predicate on the "connection_id" field. It's identical to ConnectionIDEQ.
+func ConnectionID(v uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// Used applies equality check predicate on the "used" field. It's identical to UsedEQ.
+func Used(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldUsed, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldTotal, v))
+}
+
+// Free applies equality check predicate on the "free" field. It's identical to FreeEQ.
+func Free(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldFree, v))
+}
+
+// ConnectionIDEQ applies the EQ predicate on the "connection_id" field.
+func ConnectionIDEQ(v uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDNEQ applies the NEQ predicate on the "connection_id" field.
+func ConnectionIDNEQ(v uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNEQ(FieldConnectionID, v))
+}
+
+// ConnectionIDIn applies the In predicate on the "connection_id" field.
+func ConnectionIDIn(vs ...uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIn(FieldConnectionID, vs...))
+}
+
+// ConnectionIDNotIn applies the NotIn predicate on the "connection_id" field.
+func ConnectionIDNotIn(vs ...uuid.UUID) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotIn(FieldConnectionID, vs...))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLTE(FieldDay, v))
+}
+
+// UsedEQ applies the EQ predicate on the "used" field.
+func UsedEQ(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldUsed, v))
+}
+
+// UsedNEQ applies the NEQ predicate on the "used" field.
+func UsedNEQ(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNEQ(FieldUsed, v))
+}
+
+// UsedIn applies the In predicate on the "used" field.
+func UsedIn(vs ...int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIn(FieldUsed, vs...))
+}
+
+// UsedNotIn applies the NotIn predicate on the "used" field.
+func UsedNotIn(vs ...int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotIn(FieldUsed, vs...))
+}
+
+// UsedGT applies the GT predicate on the "used" field.
+func UsedGT(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGT(FieldUsed, v))
+}
+
+// UsedGTE applies the GTE predicate on the "used" field.
+func UsedGTE(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGTE(FieldUsed, v))
+}
+
+// UsedLT applies the LT predicate on the "used" field.
+func UsedLT(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLT(FieldUsed, v))
+}
+
+// UsedLTE applies the LTE predicate on the "used" field.
+func UsedLTE(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLTE(FieldUsed, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLTE(FieldTotal, v))
+}
+
+// TotalIsNil applies the IsNil predicate on the "total" field.
+func TotalIsNil() predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIsNull(FieldTotal))
+}
+
+// TotalNotNil applies the NotNil predicate on the "total" field.
+func TotalNotNil() predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotNull(FieldTotal))
+}
+
+// FreeEQ applies the EQ predicate on the "free" field.
+func FreeEQ(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldEQ(FieldFree, v))
+}
+
+// FreeNEQ applies the NEQ predicate on the "free" field.
+func FreeNEQ(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNEQ(FieldFree, v))
+}
+
+// FreeIn applies the In predicate on the "free" field.
+func FreeIn(vs ...int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIn(FieldFree, vs...))
+}
+
+// FreeNotIn applies the NotIn predicate on the "free" field.
+func FreeNotIn(vs ...int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotIn(FieldFree, vs...))
+}
+
+// FreeGT applies the GT predicate on the "free" field.
+func FreeGT(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGT(FieldFree, v))
+}
+
+// FreeGTE applies the GTE predicate on the "free" field.
+func FreeGTE(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldGTE(FieldFree, v))
+}
+
+// FreeLT applies the LT predicate on the "free" field.
+func FreeLT(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLT(FieldFree, v))
+}
+
+// FreeLTE applies the LTE predicate on the "free" field.
+func FreeLTE(v int64) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldLTE(FieldFree, v))
+}
+
+// FreeIsNil applies the IsNil predicate on the "free" field.
+func FreeIsNil() predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldIsNull(FieldFree))
+}
+
+// FreeNotNil applies the NotNil predicate on the "free" field.
+func FreeNotNil() predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.FieldNotNull(FieldFree))
+}
+
+// HasConnection applies the HasEdge predicate on the "connection" edge.
+func HasConnection() predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConnectionTable, ConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectionWith applies the HasEdge predicate on the "connection" edge with a given conditions (other predicates).
+func HasConnectionWith(preds ...predicate.Connection) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(func(s *sql.Selector) {
+		step := newConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConnectionUsage) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConnectionUsage) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConnectionUsage) predicate.ConnectionUsage {
+	return predicate.ConnectionUsage(sql.NotPredicates(p))
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+)
+
+// ConnectionUsageCreate is the builder for creating a ConnectionUsage entity.
+type ConnectionUsageCreate struct {
+	config
+	mutation *ConnectionUsageMutation
+	hooks    []Hook
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *ConnectionUsageCreate) SetConnectionID(v uuid.UUID) *ConnectionUsageCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *ConnectionUsageCreate) SetDay(v time.Time) *ConnectionUsageCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetUsed sets the "used" field.
+func (_c *ConnectionUsageCreate) SetUsed(v int64) *ConnectionUsageCreate {
+	_c.mutation.SetUsed(v)
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *ConnectionUsageCreate) SetTotal(v int64) *ConnectionUsageCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *ConnectionUsageCreate) SetNillableTotal(v *int64) *ConnectionUsageCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetFree sets the "free" field.
+func (_c *ConnectionUsageCreate) SetFree(v int64) *ConnectionUsageCreate {
+	_c.mutation.SetFree(v)
+	return _c
+}
+
+// SetNillableFree sets the "free" field if the given value is not nil.
+func (_c *ConnectionUsageCreate) SetNillableFree(v *int64) *ConnectionUsageCreate {
+	if v != nil {
+		_c.SetFree(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConnectionUsageCreate) SetID(v uuid.UUID) *ConnectionUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ConnectionUsageCreate) SetNillableID(v *uuid.UUID) *ConnectionUsageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_c *ConnectionUsageCreate) SetConnection(v *Connection) *ConnectionUsageCreate {
+	return _c.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ConnectionUsageMutation object of the builder.
+func (_c *ConnectionUsageCreate) Mutation() *ConnectionUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the ConnectionUsage in the database.
+func (_c *ConnectionUsageCreate) Save(ctx context.Context) (*ConnectionUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConnectionUsageCreate) SaveX(ctx context.Context) *ConnectionUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConnectionUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConnectionUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConnectionUsageCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := connectionusage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConnectionUsageCreate) check() error {
+	if _, ok := _c.mutation.ConnectionID(); !ok {
+		return &ValidationError{Name: "connection_id", err: errors.New(`ent: missing required field "ConnectionUsage.connection_id"`)}
+	}
+	if _, ok := _c.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "ConnectionUsage.day"`)}
+	}
+	if _, ok := _c.mutation.Used(); !ok {
+		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "ConnectionUsage.used"`)}
+	}
+	if len(_c.mutation.ConnectionIDs()) == 0 {
+		return &ValidationError{Name: "connection", err: errors.New(`ent: missing required edge "ConnectionUsage.connection"`)}
+	}
+	return nil
+}
+
+func (_c *ConnectionUsageCreate) sqlSave(ctx context.Context) (*ConnectionUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConnectionUsageCreate) createSpec() (*ConnectionUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConnectionUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(connectionusage.Table, sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(connectionusage.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := _c.mutation.Used(); ok {
+		_spec.SetField(connectionusage.FieldUsed, field.TypeInt64, value)
+		_node.Used = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(connectionusage.FieldTotal, field.TypeInt64, value)
+		_node.Total = &value
+	}
+	if value, ok := _c.mutation.Free(); ok {
+		_spec.SetField(connectionusage.FieldFree, field.TypeInt64, value)
+		_node.Free = &value
+	}
+	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectionusage.ConnectionTable,
+			Columns: []string{connectionusage.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConnectionUsageCreateBulk is the builder for creating many ConnectionUsage entities in bulk.
+type ConnectionUsageCreateBulk struct {
+	config
+	err      error
+	builders []*ConnectionUsageCreate
+}
+
+// Save creates the ConnectionUsage entities in the database.
+func (_c *ConnectionUsageCreateBulk) Save(ctx context.Context) ([]*ConnectionUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConnectionUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConnectionUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConnectionUsageCreateBulk) SaveX(ctx context.Context) []*ConnectionUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConnectionUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConnectionUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
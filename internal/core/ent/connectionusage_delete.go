@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ConnectionUsageDelete is the builder for deleting a ConnectionUsage entity.
+type ConnectionUsageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConnectionUsageMutation
+}
+
+// Where appends a list predicates to the ConnectionUsageDelete builder.
+func (_d *ConnectionUsageDelete) Where(ps ...predicate.ConnectionUsage) *ConnectionUsageDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConnectionUsageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConnectionUsageDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConnectionUsageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(connectionusage.Table, sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConnectionUsageDeleteOne is the builder for deleting a single ConnectionUsage entity.
+type ConnectionUsageDeleteOne struct {
+	_d *ConnectionUsageDelete
+}
+
+// Where appends a list predicates to the ConnectionUsageDelete builder.
+func (_d *ConnectionUsageDeleteOne) Where(ps ...predicate.ConnectionUsage) *ConnectionUsageDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConnectionUsageDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{connectionusage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConnectionUsageDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
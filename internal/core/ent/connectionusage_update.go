@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ConnectionUsageUpdate is the builder for updating ConnectionUsage entities.
+type ConnectionUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConnectionUsageMutation
+}
+
+// Where appends a list predicates to the ConnectionUsageUpdate builder.
+func (_u *ConnectionUsageUpdate) Where(ps ...predicate.ConnectionUsage) *ConnectionUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ConnectionUsageUpdate) SetConnectionID(v uuid.UUID) *ConnectionUsageUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ConnectionUsageUpdate) SetNillableConnectionID(v *uuid.UUID) *ConnectionUsageUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *ConnectionUsageUpdate) SetDay(v time.Time) *ConnectionUsageUpdate {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *ConnectionUsageUpdate) SetNillableDay(v *time.Time) *ConnectionUsageUpdate {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// SetUsed sets the "used" field.
+func (_u *ConnectionUsageUpdate) SetUsed(v int64) *ConnectionUsageUpdate {
+	_u.mutation.ResetUsed()
+	_u.mutation.SetUsed(v)
+	return _u
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_u *ConnectionUsageUpdate) SetNillableUsed(v *int64) *ConnectionUsageUpdate {
+	if v != nil {
+		_u.SetUsed(*v)
+	}
+	return _u
+}
+
+// AddUsed adds value to the "used" field.
+func (_u *ConnectionUsageUpdate) AddUsed(v int64) *ConnectionUsageUpdate {
+	_u.mutation.AddUsed(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *ConnectionUsageUpdate) SetTotal(v int64) *ConnectionUsageUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *ConnectionUsageUpdate) SetNillableTotal(v *int64) *ConnectionUsageUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *ConnectionUsageUpdate) AddTotal(v int64) *ConnectionUsageUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// ClearTotal clears the value of the "total" field.
+func (_u *ConnectionUsageUpdate) ClearTotal() *ConnectionUsageUpdate {
+	_u.mutation.ClearTotal()
+	return _u
+}
+
+// SetFree sets the "free" field.
+func (_u *ConnectionUsageUpdate) SetFree(v int64) *ConnectionUsageUpdate {
+	_u.mutation.ResetFree()
+	_u.mutation.SetFree(v)
+	return _u
+}
+
+// SetNillableFree sets the "free" field if the given value is not nil.
+func (_u *ConnectionUsageUpdate) SetNillableFree(v *int64) *ConnectionUsageUpdate {
+	if v != nil {
+		_u.SetFree(*v)
+	}
+	return _u
+}
+
+// AddFree adds value to the "free" field.
+func (_u *ConnectionUsageUpdate) AddFree(v int64) *ConnectionUsageUpdate {
+	_u.mutation.AddFree(v)
+	return _u
+}
+
+// ClearFree clears the value of the "free" field.
+func (_u *ConnectionUsageUpdate) ClearFree() *ConnectionUsageUpdate {
+	_u.mutation.ClearFree()
+	return _u
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ConnectionUsageUpdate) SetConnection(v *Connection) *ConnectionUsageUpdate {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ConnectionUsageMutation object of the builder.
+func (_u *ConnectionUsageUpdate) Mutation() *ConnectionUsageMutation {
+	return _u.mutation
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ConnectionUsageUpdate) ClearConnection() *ConnectionUsageUpdate {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConnectionUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConnectionUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConnectionUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConnectionUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConnectionUsageUpdate) check() error {
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConnectionUsage.connection"`)
+	}
+	return nil
+}
+
+func (_u *ConnectionUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(connectionusage.Table, connectionusage.Columns, sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(connectionusage.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Used(); ok {
+		_spec.SetField(connectionusage.FieldUsed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsed(); ok {
+		_spec.AddField(connectionusage.FieldUsed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(connectionusage.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(connectionusage.FieldTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.TotalCleared() {
+		_spec.ClearField(connectionusage.FieldTotal, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Free(); ok {
+		_spec.SetField(connectionusage.FieldFree, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFree(); ok {
+		_spec.AddField(connectionusage.FieldFree, field.TypeInt64, value)
+	}
+	if _u.mutation.FreeCleared() {
+		_spec.ClearField(connectionusage.FieldFree, field.TypeInt64)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectionusage.ConnectionTable,
+			Columns: []string{connectionusage.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectionusage.ConnectionTable,
+			Columns: []string{connectionusage.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectionusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConnectionUsageUpdateOne is the builder for updating a single ConnectionUsage entity.
+type ConnectionUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConnectionUsageMutation
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ConnectionUsageUpdateOne) SetConnectionID(v uuid.UUID) *ConnectionUsageUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ConnectionUsageUpdateOne) SetNillableConnectionID(v *uuid.UUID) *ConnectionUsageUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *ConnectionUsageUpdateOne) SetDay(v time.Time) *ConnectionUsageUpdateOne {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *ConnectionUsageUpdateOne) SetNillableDay(v *time.Time) *ConnectionUsageUpdateOne {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// SetUsed sets the "used" field.
+func (_u *ConnectionUsageUpdateOne) SetUsed(v int64) *ConnectionUsageUpdateOne {
+	_u.mutation.ResetUsed()
+	_u.mutation.SetUsed(v)
+	return _u
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_u *ConnectionUsageUpdateOne) SetNillableUsed(v *int64) *ConnectionUsageUpdateOne {
+	if v != nil {
+		_u.SetUsed(*v)
+	}
+	return _u
+}
+
+// AddUsed adds value to the "used" field.
+func (_u *ConnectionUsageUpdateOne) AddUsed(v int64) *ConnectionUsageUpdateOne {
+	_u.mutation.AddUsed(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *ConnectionUsageUpdateOne) SetTotal(v int64) *ConnectionUsageUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *ConnectionUsageUpdateOne) SetNillableTotal(v *int64) *ConnectionUsageUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *ConnectionUsageUpdateOne) AddTotal(v int64) *ConnectionUsageUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// ClearTotal clears the value of the "total" field.
+func (_u *ConnectionUsageUpdateOne) ClearTotal() *ConnectionUsageUpdateOne {
+	_u.mutation.ClearTotal()
+	return _u
+}
+
+// SetFree sets the "free" field.
+func (_u *ConnectionUsageUpdateOne) SetFree(v int64) *ConnectionUsageUpdateOne {
+	_u.mutation.ResetFree()
+	_u.mutation.SetFree(v)
+	return _u
+}
+
+// SetNillableFree sets the "free" field if the given value is not nil.
+func (_u *ConnectionUsageUpdateOne) SetNillableFree(v *int64) *ConnectionUsageUpdateOne {
+	if v != nil {
+		_u.SetFree(*v)
+	}
+	return _u
+}
+
+// AddFree adds value to the "free" field.
+func (_u *ConnectionUsageUpdateOne) AddFree(v int64) *ConnectionUsageUpdateOne {
+	_u.mutation.AddFree(v)
+	return _u
+}
+
+// ClearFree clears the value of the "free" field.
+func (_u *ConnectionUsageUpdateOne) ClearFree() *ConnectionUsageUpdateOne {
+	_u.mutation.ClearFree()
+	return _u
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ConnectionUsageUpdateOne) SetConnection(v *Connection) *ConnectionUsageUpdateOne {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ConnectionUsageMutation object of the builder.
+func (_u *ConnectionUsageUpdateOne) Mutation() *ConnectionUsageMutation {
+	return _u.mutation
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ConnectionUsageUpdateOne) ClearConnection() *ConnectionUsageUpdateOne {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Where appends a list predicates to the ConnectionUsageUpdate builder.
+func (_u *ConnectionUsageUpdateOne) Where(ps ...predicate.ConnectionUsage) *ConnectionUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConnectionUsageUpdateOne) Select(field string, fields ...string) *ConnectionUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConnectionUsage entity.
+func (_u *ConnectionUsageUpdateOne) Save(ctx context.Context) (*ConnectionUsage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConnectionUsageUpdateOne) SaveX(ctx context.Context) *ConnectionUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConnectionUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConnectionUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConnectionUsageUpdateOne) check() error {
+	if _u.mutation.ConnectionCleared() && len(_u.mutation.ConnectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConnectionUsage.connection"`)
+	}
+	return nil
+}
+
+func (_u *ConnectionUsageUpdateOne) sqlSave(ctx context.Context) (_node *ConnectionUsage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(connectionusage.Table, connectionusage.Columns, sqlgraph.NewFieldSpec(connectionusage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConnectionUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, connectionusage.FieldID)
+		for _, f := range fields {
+			if !connectionusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != connectionusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(connectionusage.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Used(); ok {
+		_spec.SetField(connectionusage.FieldUsed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsed(); ok {
+		_spec.AddField(connectionusage.FieldUsed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(connectionusage.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(connectionusage.FieldTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.TotalCleared() {
+		_spec.ClearField(connectionusage.FieldTotal, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Free(); ok {
+		_spec.SetField(connectionusage.FieldFree, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFree(); ok {
+		_spec.AddField(connectionusage.FieldFree, field.TypeInt64, value)
+	}
+	if _u.mutation.FreeCleared() {
+		_spec.ClearField(connectionusage.FieldFree, field.TypeInt64)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectionusage.ConnectionTable,
+			Columns: []string{connectionusage.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   connectionusage.ConnectionTable,
+			Columns: []string{connectionusage.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConnectionUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectionusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,9 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/idempotencykey"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			connection.Table: connection.ValidColumn,
-			job.Table:        job.ValidColumn,
-			joblog.Table:     joblog.ValidColumn,
-			task.Table:       task.ValidColumn,
+			connection.Table:         connection.ValidColumn,
+			connectiontransfer.Table: connectiontransfer.ValidColumn,
+			connectionusage.Table:    connectionusage.ValidColumn,
+			idempotencykey.Table:     idempotencykey.ValidColumn,
+			job.Table:                job.ValidColumn,
+			jobevent.Table:           jobevent.ValidColumn,
+			joblog.Table:             joblog.ValidColumn,
+			retryqueue.Table:         retryqueue.ValidColumn,
+			sharetoken.Table:         sharetoken.ValidColumn,
+			task.Table:               task.ValidColumn,
+			taskevent.Table:          taskevent.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
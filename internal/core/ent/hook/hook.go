@@ -21,6 +21,42 @@ func (f ConnectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConnectionMutation", m)
 }
 
+// The ConnectionTransferFunc type is an adapter to allow the use of ordinary
+// function as ConnectionTransfer mutator.
+type ConnectionTransferFunc func(context.Context, *ent.ConnectionTransferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConnectionTransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConnectionTransferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConnectionTransferMutation", m)
+}
+
+// The ConnectionUsageFunc type is an adapter to allow the use of ordinary
+// function as ConnectionUsage mutator.
+type ConnectionUsageFunc func(context.Context, *ent.ConnectionUsageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConnectionUsageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConnectionUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConnectionUsageMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+}
+
 // The JobFunc type is an adapter to allow the use of ordinary
 // function as Job mutator.
 type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
@@ -33,6 +69,18 @@ func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
 }
 
+// The JobEventFunc type is an adapter to allow the use of ordinary
+// function as JobEvent mutator.
+type JobEventFunc func(context.Context, *ent.JobEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobEventMutation", m)
+}
+
 // The JobLogFunc type is an adapter to allow the use of ordinary
 // function as JobLog mutator.
 type JobLogFunc func(context.Context, *ent.JobLogMutation) (ent.Value, error)
@@ -45,6 +93,30 @@ func (f JobLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobLogMutation", m)
 }
 
+// The RetryQueueFunc type is an adapter to allow the use of ordinary
+// function as RetryQueue mutator.
+type RetryQueueFunc func(context.Context, *ent.RetryQueueMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RetryQueueFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RetryQueueMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RetryQueueMutation", m)
+}
+
+// The ShareTokenFunc type is an adapter to allow the use of ordinary
+// function as ShareToken mutator.
+type ShareTokenFunc func(context.Context, *ent.ShareTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareTokenMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
@@ -57,6 +129,18 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The TaskEventFunc type is an adapter to allow the use of ordinary
+// function as TaskEvent mutator.
+type TaskEventFunc func(context.Context, *ent.TaskEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskEventMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
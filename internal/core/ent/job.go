@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,6 +23,8 @@ type Job struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// TaskID holds the value of the "task_id" field.
 	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status model.JobStatus `json:"status,omitempty"`
 	// Trigger holds the value of the "trigger" field.
@@ -34,12 +37,42 @@ type Job struct {
 	FilesTransferred int `json:"files_transferred,omitempty"`
 	// BytesTransferred holds the value of the "bytes_transferred" field.
 	BytesTransferred int64 `json:"bytes_transferred,omitempty"`
+	// UploadedFiles holds the value of the "uploaded_files" field.
+	UploadedFiles int `json:"uploaded_files,omitempty"`
+	// UploadedBytes holds the value of the "uploaded_bytes" field.
+	UploadedBytes int64 `json:"uploaded_bytes,omitempty"`
+	// DownloadedFiles holds the value of the "downloaded_files" field.
+	DownloadedFiles int `json:"downloaded_files,omitempty"`
+	// DownloadedBytes holds the value of the "downloaded_bytes" field.
+	DownloadedBytes int64 `json:"downloaded_bytes,omitempty"`
 	// FilesDeleted holds the value of the "files_deleted" field.
 	FilesDeleted int `json:"files_deleted,omitempty"`
+	// Files compared with the destination, including files that didn't need a transfer
+	FilesChecked int `json:"files_checked,omitempty"`
+	// Directory entries listed while comparing the source and destination
+	ListingsDone int `json:"listings_done,omitempty"`
 	// ErrorCount holds the value of the "error_count" field.
 	ErrorCount int `json:"error_count,omitempty"`
 	// Errors holds the value of the "errors" field.
 	Errors string `json:"errors,omitempty"`
+	// Free-text note attached by a user
+	Note string `json:"note,omitempty"`
+	// Whether a user has acknowledged the job, e.g. a failure that can be ignored
+	Acknowledged bool `json:"acknowledged,omitempty"`
+	// AnnotatedAt holds the value of the "annotated_at" field.
+	AnnotatedAt *time.Time `json:"annotated_at,omitempty"`
+	// OpenTelemetry trace ID of the run, set if the job was created within a trace
+	TraceID *string `json:"trace_id,omitempty"`
+	// Config version of the task's connection when the job was created
+	ConnectionConfigVersion *int `json:"connection_config_version,omitempty"`
+	// What triggered the job beyond its trigger type, e.g. the schedule or the user
+	TriggerDetail *model.JobTriggerDetail `json:"trigger_detail,omitempty"`
+	// Hash of the task's effective configuration when the job was created, see services.TaskConfigHash
+	TaskConfigHash *string `json:"task_config_hash,omitempty"`
+	// Downsampled number of transfers in progress over the run of the job, written when it ends
+	Concurrency *model.JobConcurrency `json:"concurrency,omitempty"`
+	// Effective sync configuration of the run, e.g. transfers and filters, written when it ends
+	ConfigSnapshot *model.JobConfigSnapshot `json:"config_snapshot,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the JobQuery when eager-loading is set.
 	Edges        JobEdges `json:"edges"`
@@ -52,9 +85,17 @@ type JobEdges struct {
 	Task *Task `json:"task,omitempty"`
 	// Logs holds the value of the logs edge.
 	Logs []*JobLog `json:"logs,omitempty"`
+	// RetryQueue holds the value of the retry_queue edge.
+	RetryQueue []*RetryQueue `json:"retry_queue,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*JobEvent `json:"events,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Job `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Job `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // TaskOrErr returns the Task value or an error if the edge
@@ -77,16 +118,60 @@ func (e JobEdges) LogsOrErr() ([]*JobLog, error) {
 	return nil, &NotLoadedError{edge: "logs"}
 }
 
+// RetryQueueOrErr returns the RetryQueue value or an error if the edge
+// was not loaded in eager-loading.
+func (e JobEdges) RetryQueueOrErr() ([]*RetryQueue, error) {
+	if e.loadedTypes[2] {
+		return e.RetryQueue, nil
+	}
+	return nil, &NotLoadedError{edge: "retry_queue"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e JobEdges) EventsOrErr() ([]*JobEvent, error) {
+	if e.loadedTypes[3] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobEdges) ParentOrErr() (*Job, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: job.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e JobEdges) ChildrenOrErr() ([]*Job, error) {
+	if e.loadedTypes[5] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Job) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case job.FieldFilesTransferred, job.FieldBytesTransferred, job.FieldFilesDeleted, job.FieldErrorCount:
+		case job.FieldParentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case job.FieldTriggerDetail, job.FieldConcurrency, job.FieldConfigSnapshot:
+			values[i] = new([]byte)
+		case job.FieldAcknowledged:
+			values[i] = new(sql.NullBool)
+		case job.FieldFilesTransferred, job.FieldBytesTransferred, job.FieldUploadedFiles, job.FieldUploadedBytes, job.FieldDownloadedFiles, job.FieldDownloadedBytes, job.FieldFilesDeleted, job.FieldFilesChecked, job.FieldListingsDone, job.FieldErrorCount, job.FieldConnectionConfigVersion:
 			values[i] = new(sql.NullInt64)
-		case job.FieldStatus, job.FieldTrigger, job.FieldErrors:
+		case job.FieldStatus, job.FieldTrigger, job.FieldErrors, job.FieldNote, job.FieldTraceID, job.FieldTaskConfigHash:
 			values[i] = new(sql.NullString)
-		case job.FieldStartTime, job.FieldEndTime:
+		case job.FieldStartTime, job.FieldEndTime, job.FieldAnnotatedAt:
 			values[i] = new(sql.NullTime)
 		case job.FieldID, job.FieldTaskID:
 			values[i] = new(uuid.UUID)
@@ -117,6 +202,13 @@ func (_m *Job) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.TaskID = *value
 			}
+		case job.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(uuid.UUID)
+				*_m.ParentID = *value.S.(*uuid.UUID)
+			}
 		case job.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -153,12 +245,48 @@ func (_m *Job) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.BytesTransferred = value.Int64
 			}
+		case job.FieldUploadedFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uploaded_files", values[i])
+			} else if value.Valid {
+				_m.UploadedFiles = int(value.Int64)
+			}
+		case job.FieldUploadedBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uploaded_bytes", values[i])
+			} else if value.Valid {
+				_m.UploadedBytes = value.Int64
+			}
+		case job.FieldDownloadedFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloaded_files", values[i])
+			} else if value.Valid {
+				_m.DownloadedFiles = int(value.Int64)
+			}
+		case job.FieldDownloadedBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloaded_bytes", values[i])
+			} else if value.Valid {
+				_m.DownloadedBytes = value.Int64
+			}
 		case job.FieldFilesDeleted:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field files_deleted", values[i])
 			} else if value.Valid {
 				_m.FilesDeleted = int(value.Int64)
 			}
+		case job.FieldFilesChecked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field files_checked", values[i])
+			} else if value.Valid {
+				_m.FilesChecked = int(value.Int64)
+			}
+		case job.FieldListingsDone:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field listings_done", values[i])
+			} else if value.Valid {
+				_m.ListingsDone = int(value.Int64)
+			}
 		case job.FieldErrorCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field error_count", values[i])
@@ -171,6 +299,70 @@ func (_m *Job) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Errors = value.String
 			}
+		case job.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case job.FieldAcknowledged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged", values[i])
+			} else if value.Valid {
+				_m.Acknowledged = value.Bool
+			}
+		case job.FieldAnnotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field annotated_at", values[i])
+			} else if value.Valid {
+				_m.AnnotatedAt = new(time.Time)
+				*_m.AnnotatedAt = value.Time
+			}
+		case job.FieldTraceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_id", values[i])
+			} else if value.Valid {
+				_m.TraceID = new(string)
+				*_m.TraceID = value.String
+			}
+		case job.FieldConnectionConfigVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field connection_config_version", values[i])
+			} else if value.Valid {
+				_m.ConnectionConfigVersion = new(int)
+				*_m.ConnectionConfigVersion = int(value.Int64)
+			}
+		case job.FieldTriggerDetail:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_detail", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TriggerDetail); err != nil {
+					return fmt.Errorf("unmarshal field trigger_detail: %w", err)
+				}
+			}
+		case job.FieldTaskConfigHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_config_hash", values[i])
+			} else if value.Valid {
+				_m.TaskConfigHash = new(string)
+				*_m.TaskConfigHash = value.String
+			}
+		case job.FieldConcurrency:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrency", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Concurrency); err != nil {
+					return fmt.Errorf("unmarshal field concurrency: %w", err)
+				}
+			}
+		case job.FieldConfigSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field config_snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ConfigSnapshot); err != nil {
+					return fmt.Errorf("unmarshal field config_snapshot: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -194,6 +386,26 @@ func (_m *Job) QueryLogs() *JobLogQuery {
 	return NewJobClient(_m.config).QueryLogs(_m)
 }
 
+// QueryRetryQueue queries the "retry_queue" edge of the Job entity.
+func (_m *Job) QueryRetryQueue() *RetryQueueQuery {
+	return NewJobClient(_m.config).QueryRetryQueue(_m)
+}
+
+// QueryEvents queries the "events" edge of the Job entity.
+func (_m *Job) QueryEvents() *JobEventQuery {
+	return NewJobClient(_m.config).QueryEvents(_m)
+}
+
+// QueryParent queries the "parent" edge of the Job entity.
+func (_m *Job) QueryParent() *JobQuery {
+	return NewJobClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Job entity.
+func (_m *Job) QueryChildren() *JobQuery {
+	return NewJobClient(_m.config).QueryChildren(_m)
+}
+
 // Update returns a builder for updating this Job.
 // Note that you need to call Job.Unwrap() before calling this method if this Job
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -220,6 +432,11 @@ func (_m *Job) String() string {
 	builder.WriteString("task_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
 	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
@@ -238,14 +455,67 @@ func (_m *Job) String() string {
 	builder.WriteString("bytes_transferred=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BytesTransferred))
 	builder.WriteString(", ")
+	builder.WriteString("uploaded_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("uploaded_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadedBytes))
+	builder.WriteString(", ")
+	builder.WriteString("downloaded_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("downloaded_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadedBytes))
+	builder.WriteString(", ")
 	builder.WriteString("files_deleted=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FilesDeleted))
 	builder.WriteString(", ")
+	builder.WriteString("files_checked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FilesChecked))
+	builder.WriteString(", ")
+	builder.WriteString("listings_done=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingsDone))
+	builder.WriteString(", ")
 	builder.WriteString("error_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ErrorCount))
 	builder.WriteString(", ")
 	builder.WriteString("errors=")
 	builder.WriteString(_m.Errors)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("acknowledged=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Acknowledged))
+	builder.WriteString(", ")
+	if v := _m.AnnotatedAt; v != nil {
+		builder.WriteString("annotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TraceID; v != nil {
+		builder.WriteString("trace_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ConnectionConfigVersion; v != nil {
+		builder.WriteString("connection_config_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trigger_detail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TriggerDetail))
+	builder.WriteString(", ")
+	if v := _m.TaskConfigHash; v != nil {
+		builder.WriteString("task_config_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("concurrency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Concurrency))
+	builder.WriteString(", ")
+	builder.WriteString("config_snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConfigSnapshot))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,6 +19,8 @@ const (
 	FieldID = "id"
 	// FieldTaskID holds the string denoting the task_id field in the database.
 	FieldTaskID = "task_id"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldTrigger holds the string denoting the trigger field in the database.
@@ -31,16 +33,54 @@ const (
 	FieldFilesTransferred = "files_transferred"
 	// FieldBytesTransferred holds the string denoting the bytes_transferred field in the database.
 	FieldBytesTransferred = "bytes_transferred"
+	// FieldUploadedFiles holds the string denoting the uploaded_files field in the database.
+	FieldUploadedFiles = "uploaded_files"
+	// FieldUploadedBytes holds the string denoting the uploaded_bytes field in the database.
+	FieldUploadedBytes = "uploaded_bytes"
+	// FieldDownloadedFiles holds the string denoting the downloaded_files field in the database.
+	FieldDownloadedFiles = "downloaded_files"
+	// FieldDownloadedBytes holds the string denoting the downloaded_bytes field in the database.
+	FieldDownloadedBytes = "downloaded_bytes"
 	// FieldFilesDeleted holds the string denoting the files_deleted field in the database.
 	FieldFilesDeleted = "files_deleted"
+	// FieldFilesChecked holds the string denoting the files_checked field in the database.
+	FieldFilesChecked = "files_checked"
+	// FieldListingsDone holds the string denoting the listings_done field in the database.
+	FieldListingsDone = "listings_done"
 	// FieldErrorCount holds the string denoting the error_count field in the database.
 	FieldErrorCount = "error_count"
 	// FieldErrors holds the string denoting the errors field in the database.
 	FieldErrors = "errors"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldAcknowledged holds the string denoting the acknowledged field in the database.
+	FieldAcknowledged = "acknowledged"
+	// FieldAnnotatedAt holds the string denoting the annotated_at field in the database.
+	FieldAnnotatedAt = "annotated_at"
+	// FieldTraceID holds the string denoting the trace_id field in the database.
+	FieldTraceID = "trace_id"
+	// FieldConnectionConfigVersion holds the string denoting the connection_config_version field in the database.
+	FieldConnectionConfigVersion = "connection_config_version"
+	// FieldTriggerDetail holds the string denoting the trigger_detail field in the database.
+	FieldTriggerDetail = "trigger_detail"
+	// FieldTaskConfigHash holds the string denoting the task_config_hash field in the database.
+	FieldTaskConfigHash = "task_config_hash"
+	// FieldConcurrency holds the string denoting the concurrency field in the database.
+	FieldConcurrency = "concurrency"
+	// FieldConfigSnapshot holds the string denoting the config_snapshot field in the database.
+	FieldConfigSnapshot = "config_snapshot"
 	// EdgeTask holds the string denoting the task edge name in mutations.
 	EdgeTask = "task"
 	// EdgeLogs holds the string denoting the logs edge name in mutations.
 	EdgeLogs = "logs"
+	// EdgeRetryQueue holds the string denoting the retry_queue edge name in mutations.
+	EdgeRetryQueue = "retry_queue"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the job in the database.
 	Table = "jobs"
 	// TaskTable is the table that holds the task relation/edge.
@@ -57,21 +97,59 @@ const (
 	LogsInverseTable = "job_logs"
 	// LogsColumn is the table column denoting the logs relation/edge.
 	LogsColumn = "job_id"
+	// RetryQueueTable is the table that holds the retry_queue relation/edge.
+	RetryQueueTable = "retry_queues"
+	// RetryQueueInverseTable is the table name for the RetryQueue entity.
+	// It exists in this package in order to avoid circular dependency with the "retryqueue" package.
+	RetryQueueInverseTable = "retry_queues"
+	// RetryQueueColumn is the table column denoting the retry_queue relation/edge.
+	RetryQueueColumn = "job_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "job_events"
+	// EventsInverseTable is the table name for the JobEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "jobevent" package.
+	EventsInverseTable = "job_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "job_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "jobs"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "jobs"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
 )
 
 // Columns holds all SQL columns for job fields.
 var Columns = []string{
 	FieldID,
 	FieldTaskID,
+	FieldParentID,
 	FieldStatus,
 	FieldTrigger,
 	FieldStartTime,
 	FieldEndTime,
 	FieldFilesTransferred,
 	FieldBytesTransferred,
+	FieldUploadedFiles,
+	FieldUploadedBytes,
+	FieldDownloadedFiles,
+	FieldDownloadedBytes,
 	FieldFilesDeleted,
+	FieldFilesChecked,
+	FieldListingsDone,
 	FieldErrorCount,
 	FieldErrors,
+	FieldNote,
+	FieldAcknowledged,
+	FieldAnnotatedAt,
+	FieldTraceID,
+	FieldConnectionConfigVersion,
+	FieldTriggerDetail,
+	FieldTaskConfigHash,
+	FieldConcurrency,
+	FieldConfigSnapshot,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -91,10 +169,24 @@ var (
 	DefaultFilesTransferred int
 	// DefaultBytesTransferred holds the default value on creation for the "bytes_transferred" field.
 	DefaultBytesTransferred int64
+	// DefaultUploadedFiles holds the default value on creation for the "uploaded_files" field.
+	DefaultUploadedFiles int
+	// DefaultUploadedBytes holds the default value on creation for the "uploaded_bytes" field.
+	DefaultUploadedBytes int64
+	// DefaultDownloadedFiles holds the default value on creation for the "downloaded_files" field.
+	DefaultDownloadedFiles int
+	// DefaultDownloadedBytes holds the default value on creation for the "downloaded_bytes" field.
+	DefaultDownloadedBytes int64
 	// DefaultFilesDeleted holds the default value on creation for the "files_deleted" field.
 	DefaultFilesDeleted int
+	// DefaultFilesChecked holds the default value on creation for the "files_checked" field.
+	DefaultFilesChecked int
+	// DefaultListingsDone holds the default value on creation for the "listings_done" field.
+	DefaultListingsDone int
 	// DefaultErrorCount holds the default value on creation for the "error_count" field.
 	DefaultErrorCount int
+	// DefaultAcknowledged holds the default value on creation for the "acknowledged" field.
+	DefaultAcknowledged bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -104,7 +196,7 @@ const DefaultStatus model.JobStatus = "PENDING"
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s model.JobStatus) error {
 	switch s.String() {
-	case "PENDING", "RUNNING", "SUCCESS", "FAILED", "CANCELLED":
+	case "PENDING", "RUNNING", "WAITING_CONFIRMATION", "SUCCESS", "SUCCESS_WITH_WARNINGS", "FAILED", "FAILED_TIMEOUT", "CANCELLED", "QUOTA_DEFERRED":
 		return nil
 	default:
 		return fmt.Errorf("job: invalid enum value for status field: %q", s)
@@ -114,7 +206,7 @@ func StatusValidator(s model.JobStatus) error {
 // TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
 func TriggerValidator(t model.JobTrigger) error {
 	switch t.String() {
-	case "MANUAL", "SCHEDULE", "REALTIME":
+	case "MANUAL", "SCHEDULE", "REALTIME", "RETRY":
 		return nil
 	default:
 		return fmt.Errorf("job: invalid enum value for trigger field: %q", t)
@@ -134,6 +226,11 @@ func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
 }
 
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -164,11 +261,41 @@ func ByBytesTransferred(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBytesTransferred, opts...).ToFunc()
 }
 
+// ByUploadedFiles orders the results by the uploaded_files field.
+func ByUploadedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadedFiles, opts...).ToFunc()
+}
+
+// ByUploadedBytes orders the results by the uploaded_bytes field.
+func ByUploadedBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadedBytes, opts...).ToFunc()
+}
+
+// ByDownloadedFiles orders the results by the downloaded_files field.
+func ByDownloadedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadedFiles, opts...).ToFunc()
+}
+
+// ByDownloadedBytes orders the results by the downloaded_bytes field.
+func ByDownloadedBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadedBytes, opts...).ToFunc()
+}
+
 // ByFilesDeleted orders the results by the files_deleted field.
 func ByFilesDeleted(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFilesDeleted, opts...).ToFunc()
 }
 
+// ByFilesChecked orders the results by the files_checked field.
+func ByFilesChecked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilesChecked, opts...).ToFunc()
+}
+
+// ByListingsDone orders the results by the listings_done field.
+func ByListingsDone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListingsDone, opts...).ToFunc()
+}
+
 // ByErrorCount orders the results by the error_count field.
 func ByErrorCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorCount, opts...).ToFunc()
@@ -179,6 +306,36 @@ func ByErrors(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrors, opts...).ToFunc()
 }
 
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByAcknowledged orders the results by the acknowledged field.
+func ByAcknowledged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcknowledged, opts...).ToFunc()
+}
+
+// ByAnnotatedAt orders the results by the annotated_at field.
+func ByAnnotatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnotatedAt, opts...).ToFunc()
+}
+
+// ByTraceID orders the results by the trace_id field.
+func ByTraceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTraceID, opts...).ToFunc()
+}
+
+// ByConnectionConfigVersion orders the results by the connection_config_version field.
+func ByConnectionConfigVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectionConfigVersion, opts...).ToFunc()
+}
+
+// ByTaskConfigHash orders the results by the task_config_hash field.
+func ByTaskConfigHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskConfigHash, opts...).ToFunc()
+}
+
 // ByTaskField orders the results by task field.
 func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -199,6 +356,55 @@ func ByLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRetryQueueCount orders the results by retry_queue count.
+func ByRetryQueueCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRetryQueueStep(), opts...)
+	}
+}
+
+// ByRetryQueue orders the results by retry_queue terms.
+func ByRetryQueue(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRetryQueueStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTaskStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -213,3 +419,31 @@ func newLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LogsTable, LogsColumn),
 	)
 }
+func newRetryQueueStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RetryQueueInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RetryQueueTable, RetryQueueColumn),
+	)
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
@@ -62,6 +62,11 @@ func TaskID(v uuid.UUID) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldTaskID, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldParentID, v))
+}
+
 // StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
 func StartTime(v time.Time) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldStartTime, v))
@@ -82,11 +87,41 @@ func BytesTransferred(v int64) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldBytesTransferred, v))
 }
 
+// UploadedFiles applies equality check predicate on the "uploaded_files" field. It's identical to UploadedFilesEQ.
+func UploadedFiles(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUploadedFiles, v))
+}
+
+// UploadedBytes applies equality check predicate on the "uploaded_bytes" field. It's identical to UploadedBytesEQ.
+func UploadedBytes(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUploadedBytes, v))
+}
+
+// DownloadedFiles applies equality check predicate on the "downloaded_files" field. It's identical to DownloadedFilesEQ.
+func DownloadedFiles(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDownloadedFiles, v))
+}
+
+// DownloadedBytes applies equality check predicate on the "downloaded_bytes" field. It's identical to DownloadedBytesEQ.
+func DownloadedBytes(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDownloadedBytes, v))
+}
+
 // FilesDeleted applies equality check predicate on the "files_deleted" field. It's identical to FilesDeletedEQ.
 func FilesDeleted(v int) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldFilesDeleted, v))
 }
 
+// FilesChecked applies equality check predicate on the "files_checked" field. It's identical to FilesCheckedEQ.
+func FilesChecked(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldFilesChecked, v))
+}
+
+// ListingsDone applies equality check predicate on the "listings_done" field. It's identical to ListingsDoneEQ.
+func ListingsDone(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldListingsDone, v))
+}
+
 // ErrorCount applies equality check predicate on the "error_count" field. It's identical to ErrorCountEQ.
 func ErrorCount(v int) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldErrorCount, v))
@@ -97,6 +132,36 @@ func Errors(v string) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldErrors, v))
 }
 
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldNote, v))
+}
+
+// Acknowledged applies equality check predicate on the "acknowledged" field. It's identical to AcknowledgedEQ.
+func Acknowledged(v bool) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAcknowledged, v))
+}
+
+// AnnotatedAt applies equality check predicate on the "annotated_at" field. It's identical to AnnotatedAtEQ.
+func AnnotatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAnnotatedAt, v))
+}
+
+// TraceID applies equality check predicate on the "trace_id" field. It's identical to TraceIDEQ.
+func TraceID(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTraceID, v))
+}
+
+// ConnectionConfigVersion applies equality check predicate on the "connection_config_version" field. It's identical to ConnectionConfigVersionEQ.
+func ConnectionConfigVersion(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldConnectionConfigVersion, v))
+}
+
+// TaskConfigHash applies equality check predicate on the "task_config_hash" field. It's identical to TaskConfigHashEQ.
+func TaskConfigHash(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTaskConfigHash, v))
+}
+
 // TaskIDEQ applies the EQ predicate on the "task_id" field.
 func TaskIDEQ(v uuid.UUID) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldTaskID, v))
@@ -117,6 +182,36 @@ func TaskIDNotIn(vs ...uuid.UUID) predicate.Job {
 	return predicate.Job(sql.FieldNotIn(FieldTaskID, vs...))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldParentID))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v model.JobStatus) predicate.Job {
 	vc := v
@@ -347,6 +442,166 @@ func BytesTransferredLTE(v int64) predicate.Job {
 	return predicate.Job(sql.FieldLTE(FieldBytesTransferred, v))
 }
 
+// UploadedFilesEQ applies the EQ predicate on the "uploaded_files" field.
+func UploadedFilesEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUploadedFiles, v))
+}
+
+// UploadedFilesNEQ applies the NEQ predicate on the "uploaded_files" field.
+func UploadedFilesNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUploadedFiles, v))
+}
+
+// UploadedFilesIn applies the In predicate on the "uploaded_files" field.
+func UploadedFilesIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUploadedFiles, vs...))
+}
+
+// UploadedFilesNotIn applies the NotIn predicate on the "uploaded_files" field.
+func UploadedFilesNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUploadedFiles, vs...))
+}
+
+// UploadedFilesGT applies the GT predicate on the "uploaded_files" field.
+func UploadedFilesGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUploadedFiles, v))
+}
+
+// UploadedFilesGTE applies the GTE predicate on the "uploaded_files" field.
+func UploadedFilesGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUploadedFiles, v))
+}
+
+// UploadedFilesLT applies the LT predicate on the "uploaded_files" field.
+func UploadedFilesLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUploadedFiles, v))
+}
+
+// UploadedFilesLTE applies the LTE predicate on the "uploaded_files" field.
+func UploadedFilesLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUploadedFiles, v))
+}
+
+// UploadedBytesEQ applies the EQ predicate on the "uploaded_bytes" field.
+func UploadedBytesEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUploadedBytes, v))
+}
+
+// UploadedBytesNEQ applies the NEQ predicate on the "uploaded_bytes" field.
+func UploadedBytesNEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUploadedBytes, v))
+}
+
+// UploadedBytesIn applies the In predicate on the "uploaded_bytes" field.
+func UploadedBytesIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUploadedBytes, vs...))
+}
+
+// UploadedBytesNotIn applies the NotIn predicate on the "uploaded_bytes" field.
+func UploadedBytesNotIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUploadedBytes, vs...))
+}
+
+// UploadedBytesGT applies the GT predicate on the "uploaded_bytes" field.
+func UploadedBytesGT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUploadedBytes, v))
+}
+
+// UploadedBytesGTE applies the GTE predicate on the "uploaded_bytes" field.
+func UploadedBytesGTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUploadedBytes, v))
+}
+
+// UploadedBytesLT applies the LT predicate on the "uploaded_bytes" field.
+func UploadedBytesLT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUploadedBytes, v))
+}
+
+// UploadedBytesLTE applies the LTE predicate on the "uploaded_bytes" field.
+func UploadedBytesLTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUploadedBytes, v))
+}
+
+// DownloadedFilesEQ applies the EQ predicate on the "downloaded_files" field.
+func DownloadedFilesEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDownloadedFiles, v))
+}
+
+// DownloadedFilesNEQ applies the NEQ predicate on the "downloaded_files" field.
+func DownloadedFilesNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldDownloadedFiles, v))
+}
+
+// DownloadedFilesIn applies the In predicate on the "downloaded_files" field.
+func DownloadedFilesIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldDownloadedFiles, vs...))
+}
+
+// DownloadedFilesNotIn applies the NotIn predicate on the "downloaded_files" field.
+func DownloadedFilesNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldDownloadedFiles, vs...))
+}
+
+// DownloadedFilesGT applies the GT predicate on the "downloaded_files" field.
+func DownloadedFilesGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldDownloadedFiles, v))
+}
+
+// DownloadedFilesGTE applies the GTE predicate on the "downloaded_files" field.
+func DownloadedFilesGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldDownloadedFiles, v))
+}
+
+// DownloadedFilesLT applies the LT predicate on the "downloaded_files" field.
+func DownloadedFilesLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldDownloadedFiles, v))
+}
+
+// DownloadedFilesLTE applies the LTE predicate on the "downloaded_files" field.
+func DownloadedFilesLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldDownloadedFiles, v))
+}
+
+// DownloadedBytesEQ applies the EQ predicate on the "downloaded_bytes" field.
+func DownloadedBytesEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDownloadedBytes, v))
+}
+
+// DownloadedBytesNEQ applies the NEQ predicate on the "downloaded_bytes" field.
+func DownloadedBytesNEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldDownloadedBytes, v))
+}
+
+// DownloadedBytesIn applies the In predicate on the "downloaded_bytes" field.
+func DownloadedBytesIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldDownloadedBytes, vs...))
+}
+
+// DownloadedBytesNotIn applies the NotIn predicate on the "downloaded_bytes" field.
+func DownloadedBytesNotIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldDownloadedBytes, vs...))
+}
+
+// DownloadedBytesGT applies the GT predicate on the "downloaded_bytes" field.
+func DownloadedBytesGT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldDownloadedBytes, v))
+}
+
+// DownloadedBytesGTE applies the GTE predicate on the "downloaded_bytes" field.
+func DownloadedBytesGTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldDownloadedBytes, v))
+}
+
+// DownloadedBytesLT applies the LT predicate on the "downloaded_bytes" field.
+func DownloadedBytesLT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldDownloadedBytes, v))
+}
+
+// DownloadedBytesLTE applies the LTE predicate on the "downloaded_bytes" field.
+func DownloadedBytesLTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldDownloadedBytes, v))
+}
+
 // FilesDeletedEQ applies the EQ predicate on the "files_deleted" field.
 func FilesDeletedEQ(v int) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldFilesDeleted, v))
@@ -387,6 +642,86 @@ func FilesDeletedLTE(v int) predicate.Job {
 	return predicate.Job(sql.FieldLTE(FieldFilesDeleted, v))
 }
 
+// FilesCheckedEQ applies the EQ predicate on the "files_checked" field.
+func FilesCheckedEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldFilesChecked, v))
+}
+
+// FilesCheckedNEQ applies the NEQ predicate on the "files_checked" field.
+func FilesCheckedNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldFilesChecked, v))
+}
+
+// FilesCheckedIn applies the In predicate on the "files_checked" field.
+func FilesCheckedIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldFilesChecked, vs...))
+}
+
+// FilesCheckedNotIn applies the NotIn predicate on the "files_checked" field.
+func FilesCheckedNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldFilesChecked, vs...))
+}
+
+// FilesCheckedGT applies the GT predicate on the "files_checked" field.
+func FilesCheckedGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldFilesChecked, v))
+}
+
+// FilesCheckedGTE applies the GTE predicate on the "files_checked" field.
+func FilesCheckedGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldFilesChecked, v))
+}
+
+// FilesCheckedLT applies the LT predicate on the "files_checked" field.
+func FilesCheckedLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldFilesChecked, v))
+}
+
+// FilesCheckedLTE applies the LTE predicate on the "files_checked" field.
+func FilesCheckedLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldFilesChecked, v))
+}
+
+// ListingsDoneEQ applies the EQ predicate on the "listings_done" field.
+func ListingsDoneEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldListingsDone, v))
+}
+
+// ListingsDoneNEQ applies the NEQ predicate on the "listings_done" field.
+func ListingsDoneNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldListingsDone, v))
+}
+
+// ListingsDoneIn applies the In predicate on the "listings_done" field.
+func ListingsDoneIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldListingsDone, vs...))
+}
+
+// ListingsDoneNotIn applies the NotIn predicate on the "listings_done" field.
+func ListingsDoneNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldListingsDone, vs...))
+}
+
+// ListingsDoneGT applies the GT predicate on the "listings_done" field.
+func ListingsDoneGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldListingsDone, v))
+}
+
+// ListingsDoneGTE applies the GTE predicate on the "listings_done" field.
+func ListingsDoneGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldListingsDone, v))
+}
+
+// ListingsDoneLT applies the LT predicate on the "listings_done" field.
+func ListingsDoneLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldListingsDone, v))
+}
+
+// ListingsDoneLTE applies the LTE predicate on the "listings_done" field.
+func ListingsDoneLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldListingsDone, v))
+}
+
 // ErrorCountEQ applies the EQ predicate on the "error_count" field.
 func ErrorCountEQ(v int) predicate.Job {
 	return predicate.Job(sql.FieldEQ(FieldErrorCount, v))
@@ -502,6 +837,371 @@ func ErrorsContainsFold(v string) predicate.Job {
 	return predicate.Job(sql.FieldContainsFold(FieldErrors, v))
 }
 
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldNote, v))
+}
+
+// AcknowledgedEQ applies the EQ predicate on the "acknowledged" field.
+func AcknowledgedEQ(v bool) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAcknowledged, v))
+}
+
+// AcknowledgedNEQ applies the NEQ predicate on the "acknowledged" field.
+func AcknowledgedNEQ(v bool) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAcknowledged, v))
+}
+
+// AnnotatedAtEQ applies the EQ predicate on the "annotated_at" field.
+func AnnotatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAnnotatedAt, v))
+}
+
+// AnnotatedAtNEQ applies the NEQ predicate on the "annotated_at" field.
+func AnnotatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAnnotatedAt, v))
+}
+
+// AnnotatedAtIn applies the In predicate on the "annotated_at" field.
+func AnnotatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldAnnotatedAt, vs...))
+}
+
+// AnnotatedAtNotIn applies the NotIn predicate on the "annotated_at" field.
+func AnnotatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldAnnotatedAt, vs...))
+}
+
+// AnnotatedAtGT applies the GT predicate on the "annotated_at" field.
+func AnnotatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldAnnotatedAt, v))
+}
+
+// AnnotatedAtGTE applies the GTE predicate on the "annotated_at" field.
+func AnnotatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldAnnotatedAt, v))
+}
+
+// AnnotatedAtLT applies the LT predicate on the "annotated_at" field.
+func AnnotatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldAnnotatedAt, v))
+}
+
+// AnnotatedAtLTE applies the LTE predicate on the "annotated_at" field.
+func AnnotatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldAnnotatedAt, v))
+}
+
+// AnnotatedAtIsNil applies the IsNil predicate on the "annotated_at" field.
+func AnnotatedAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldAnnotatedAt))
+}
+
+// AnnotatedAtNotNil applies the NotNil predicate on the "annotated_at" field.
+func AnnotatedAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldAnnotatedAt))
+}
+
+// TraceIDEQ applies the EQ predicate on the "trace_id" field.
+func TraceIDEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTraceID, v))
+}
+
+// TraceIDNEQ applies the NEQ predicate on the "trace_id" field.
+func TraceIDNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldTraceID, v))
+}
+
+// TraceIDIn applies the In predicate on the "trace_id" field.
+func TraceIDIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldTraceID, vs...))
+}
+
+// TraceIDNotIn applies the NotIn predicate on the "trace_id" field.
+func TraceIDNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldTraceID, vs...))
+}
+
+// TraceIDGT applies the GT predicate on the "trace_id" field.
+func TraceIDGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldTraceID, v))
+}
+
+// TraceIDGTE applies the GTE predicate on the "trace_id" field.
+func TraceIDGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldTraceID, v))
+}
+
+// TraceIDLT applies the LT predicate on the "trace_id" field.
+func TraceIDLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldTraceID, v))
+}
+
+// TraceIDLTE applies the LTE predicate on the "trace_id" field.
+func TraceIDLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldTraceID, v))
+}
+
+// TraceIDContains applies the Contains predicate on the "trace_id" field.
+func TraceIDContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldTraceID, v))
+}
+
+// TraceIDHasPrefix applies the HasPrefix predicate on the "trace_id" field.
+func TraceIDHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldTraceID, v))
+}
+
+// TraceIDHasSuffix applies the HasSuffix predicate on the "trace_id" field.
+func TraceIDHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldTraceID, v))
+}
+
+// TraceIDIsNil applies the IsNil predicate on the "trace_id" field.
+func TraceIDIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldTraceID))
+}
+
+// TraceIDNotNil applies the NotNil predicate on the "trace_id" field.
+func TraceIDNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldTraceID))
+}
+
+// TraceIDEqualFold applies the EqualFold predicate on the "trace_id" field.
+func TraceIDEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldTraceID, v))
+}
+
+// TraceIDContainsFold applies the ContainsFold predicate on the "trace_id" field.
+func TraceIDContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldTraceID, v))
+}
+
+// ConnectionConfigVersionEQ applies the EQ predicate on the "connection_config_version" field.
+func ConnectionConfigVersionEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldConnectionConfigVersion, v))
+}
+
+// ConnectionConfigVersionNEQ applies the NEQ predicate on the "connection_config_version" field.
+func ConnectionConfigVersionNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldConnectionConfigVersion, v))
+}
+
+// ConnectionConfigVersionIn applies the In predicate on the "connection_config_version" field.
+func ConnectionConfigVersionIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldConnectionConfigVersion, vs...))
+}
+
+// ConnectionConfigVersionNotIn applies the NotIn predicate on the "connection_config_version" field.
+func ConnectionConfigVersionNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldConnectionConfigVersion, vs...))
+}
+
+// ConnectionConfigVersionGT applies the GT predicate on the "connection_config_version" field.
+func ConnectionConfigVersionGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldConnectionConfigVersion, v))
+}
+
+// ConnectionConfigVersionGTE applies the GTE predicate on the "connection_config_version" field.
+func ConnectionConfigVersionGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldConnectionConfigVersion, v))
+}
+
+// ConnectionConfigVersionLT applies the LT predicate on the "connection_config_version" field.
+func ConnectionConfigVersionLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldConnectionConfigVersion, v))
+}
+
+// ConnectionConfigVersionLTE applies the LTE predicate on the "connection_config_version" field.
+func ConnectionConfigVersionLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldConnectionConfigVersion, v))
+}
+
+// ConnectionConfigVersionIsNil applies the IsNil predicate on the "connection_config_version" field.
+func ConnectionConfigVersionIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldConnectionConfigVersion))
+}
+
+// ConnectionConfigVersionNotNil applies the NotNil predicate on the "connection_config_version" field.
+func ConnectionConfigVersionNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldConnectionConfigVersion))
+}
+
+// TriggerDetailIsNil applies the IsNil predicate on the "trigger_detail" field.
+func TriggerDetailIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldTriggerDetail))
+}
+
+// TriggerDetailNotNil applies the NotNil predicate on the "trigger_detail" field.
+func TriggerDetailNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldTriggerDetail))
+}
+
+// TaskConfigHashEQ applies the EQ predicate on the "task_config_hash" field.
+func TaskConfigHashEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashNEQ applies the NEQ predicate on the "task_config_hash" field.
+func TaskConfigHashNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashIn applies the In predicate on the "task_config_hash" field.
+func TaskConfigHashIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldTaskConfigHash, vs...))
+}
+
+// TaskConfigHashNotIn applies the NotIn predicate on the "task_config_hash" field.
+func TaskConfigHashNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldTaskConfigHash, vs...))
+}
+
+// TaskConfigHashGT applies the GT predicate on the "task_config_hash" field.
+func TaskConfigHashGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashGTE applies the GTE predicate on the "task_config_hash" field.
+func TaskConfigHashGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashLT applies the LT predicate on the "task_config_hash" field.
+func TaskConfigHashLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashLTE applies the LTE predicate on the "task_config_hash" field.
+func TaskConfigHashLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashContains applies the Contains predicate on the "task_config_hash" field.
+func TaskConfigHashContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashHasPrefix applies the HasPrefix predicate on the "task_config_hash" field.
+func TaskConfigHashHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashHasSuffix applies the HasSuffix predicate on the "task_config_hash" field.
+func TaskConfigHashHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashIsNil applies the IsNil predicate on the "task_config_hash" field.
+func TaskConfigHashIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldTaskConfigHash))
+}
+
+// TaskConfigHashNotNil applies the NotNil predicate on the "task_config_hash" field.
+func TaskConfigHashNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldTaskConfigHash))
+}
+
+// TaskConfigHashEqualFold applies the EqualFold predicate on the "task_config_hash" field.
+func TaskConfigHashEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldTaskConfigHash, v))
+}
+
+// TaskConfigHashContainsFold applies the ContainsFold predicate on the "task_config_hash" field.
+func TaskConfigHashContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldTaskConfigHash, v))
+}
+
+// ConcurrencyIsNil applies the IsNil predicate on the "concurrency" field.
+func ConcurrencyIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldConcurrency))
+}
+
+// ConcurrencyNotNil applies the NotNil predicate on the "concurrency" field.
+func ConcurrencyNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldConcurrency))
+}
+
+// ConfigSnapshotIsNil applies the IsNil predicate on the "config_snapshot" field.
+func ConfigSnapshotIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldConfigSnapshot))
+}
+
+// ConfigSnapshotNotNil applies the NotNil predicate on the "config_snapshot" field.
+func ConfigSnapshotNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldConfigSnapshot))
+}
+
 // HasTask applies the HasEdge predicate on the "task" edge.
 func HasTask() predicate.Job {
 	return predicate.Job(func(s *sql.Selector) {
@@ -548,6 +1248,98 @@ func HasLogsWith(preds ...predicate.JobLog) predicate.Job {
 	})
 }
 
+// HasRetryQueue applies the HasEdge predicate on the "retry_queue" edge.
+func HasRetryQueue() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RetryQueueTable, RetryQueueColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRetryQueueWith applies the HasEdge predicate on the "retry_queue" edge with a given conditions (other predicates).
+func HasRetryQueueWith(preds ...predicate.RetryQueue) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newRetryQueueStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.JobEvent) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Job) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Job) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Job) predicate.Job {
 	return predicate.Job(sql.AndPredicates(predicates...))
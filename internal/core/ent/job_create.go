@@ -13,7 +13,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 )
 
@@ -30,6 +32,20 @@ func (_c *JobCreate) SetTaskID(v uuid.UUID) *JobCreate {
 	return _c
 }
 
+// SetParentID sets the "parent_id" field.
+func (_c *JobCreate) SetParentID(v uuid.UUID) *JobCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *JobCreate) SetNillableParentID(v *uuid.UUID) *JobCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *JobCreate) SetStatus(v model.JobStatus) *JobCreate {
 	_c.mutation.SetStatus(v)
@@ -106,6 +122,62 @@ func (_c *JobCreate) SetNillableBytesTransferred(v *int64) *JobCreate {
 	return _c
 }
 
+// SetUploadedFiles sets the "uploaded_files" field.
+func (_c *JobCreate) SetUploadedFiles(v int) *JobCreate {
+	_c.mutation.SetUploadedFiles(v)
+	return _c
+}
+
+// SetNillableUploadedFiles sets the "uploaded_files" field if the given value is not nil.
+func (_c *JobCreate) SetNillableUploadedFiles(v *int) *JobCreate {
+	if v != nil {
+		_c.SetUploadedFiles(*v)
+	}
+	return _c
+}
+
+// SetUploadedBytes sets the "uploaded_bytes" field.
+func (_c *JobCreate) SetUploadedBytes(v int64) *JobCreate {
+	_c.mutation.SetUploadedBytes(v)
+	return _c
+}
+
+// SetNillableUploadedBytes sets the "uploaded_bytes" field if the given value is not nil.
+func (_c *JobCreate) SetNillableUploadedBytes(v *int64) *JobCreate {
+	if v != nil {
+		_c.SetUploadedBytes(*v)
+	}
+	return _c
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (_c *JobCreate) SetDownloadedFiles(v int) *JobCreate {
+	_c.mutation.SetDownloadedFiles(v)
+	return _c
+}
+
+// SetNillableDownloadedFiles sets the "downloaded_files" field if the given value is not nil.
+func (_c *JobCreate) SetNillableDownloadedFiles(v *int) *JobCreate {
+	if v != nil {
+		_c.SetDownloadedFiles(*v)
+	}
+	return _c
+}
+
+// SetDownloadedBytes sets the "downloaded_bytes" field.
+func (_c *JobCreate) SetDownloadedBytes(v int64) *JobCreate {
+	_c.mutation.SetDownloadedBytes(v)
+	return _c
+}
+
+// SetNillableDownloadedBytes sets the "downloaded_bytes" field if the given value is not nil.
+func (_c *JobCreate) SetNillableDownloadedBytes(v *int64) *JobCreate {
+	if v != nil {
+		_c.SetDownloadedBytes(*v)
+	}
+	return _c
+}
+
 // SetFilesDeleted sets the "files_deleted" field.
 func (_c *JobCreate) SetFilesDeleted(v int) *JobCreate {
 	_c.mutation.SetFilesDeleted(v)
@@ -120,6 +192,34 @@ func (_c *JobCreate) SetNillableFilesDeleted(v *int) *JobCreate {
 	return _c
 }
 
+// SetFilesChecked sets the "files_checked" field.
+func (_c *JobCreate) SetFilesChecked(v int) *JobCreate {
+	_c.mutation.SetFilesChecked(v)
+	return _c
+}
+
+// SetNillableFilesChecked sets the "files_checked" field if the given value is not nil.
+func (_c *JobCreate) SetNillableFilesChecked(v *int) *JobCreate {
+	if v != nil {
+		_c.SetFilesChecked(*v)
+	}
+	return _c
+}
+
+// SetListingsDone sets the "listings_done" field.
+func (_c *JobCreate) SetListingsDone(v int) *JobCreate {
+	_c.mutation.SetListingsDone(v)
+	return _c
+}
+
+// SetNillableListingsDone sets the "listings_done" field if the given value is not nil.
+func (_c *JobCreate) SetNillableListingsDone(v *int) *JobCreate {
+	if v != nil {
+		_c.SetListingsDone(*v)
+	}
+	return _c
+}
+
 // SetErrorCount sets the "error_count" field.
 func (_c *JobCreate) SetErrorCount(v int) *JobCreate {
 	_c.mutation.SetErrorCount(v)
@@ -148,6 +248,108 @@ func (_c *JobCreate) SetNillableErrors(v *string) *JobCreate {
 	return _c
 }
 
+// SetNote sets the "note" field.
+func (_c *JobCreate) SetNote(v string) *JobCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *JobCreate) SetNillableNote(v *string) *JobCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetAcknowledged sets the "acknowledged" field.
+func (_c *JobCreate) SetAcknowledged(v bool) *JobCreate {
+	_c.mutation.SetAcknowledged(v)
+	return _c
+}
+
+// SetNillableAcknowledged sets the "acknowledged" field if the given value is not nil.
+func (_c *JobCreate) SetNillableAcknowledged(v *bool) *JobCreate {
+	if v != nil {
+		_c.SetAcknowledged(*v)
+	}
+	return _c
+}
+
+// SetAnnotatedAt sets the "annotated_at" field.
+func (_c *JobCreate) SetAnnotatedAt(v time.Time) *JobCreate {
+	_c.mutation.SetAnnotatedAt(v)
+	return _c
+}
+
+// SetNillableAnnotatedAt sets the "annotated_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableAnnotatedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetAnnotatedAt(*v)
+	}
+	return _c
+}
+
+// SetTraceID sets the "trace_id" field.
+func (_c *JobCreate) SetTraceID(v string) *JobCreate {
+	_c.mutation.SetTraceID(v)
+	return _c
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (_c *JobCreate) SetNillableTraceID(v *string) *JobCreate {
+	if v != nil {
+		_c.SetTraceID(*v)
+	}
+	return _c
+}
+
+// SetConnectionConfigVersion sets the "connection_config_version" field.
+func (_c *JobCreate) SetConnectionConfigVersion(v int) *JobCreate {
+	_c.mutation.SetConnectionConfigVersion(v)
+	return _c
+}
+
+// SetNillableConnectionConfigVersion sets the "connection_config_version" field if the given value is not nil.
+func (_c *JobCreate) SetNillableConnectionConfigVersion(v *int) *JobCreate {
+	if v != nil {
+		_c.SetConnectionConfigVersion(*v)
+	}
+	return _c
+}
+
+// SetTriggerDetail sets the "trigger_detail" field.
+func (_c *JobCreate) SetTriggerDetail(v *model.JobTriggerDetail) *JobCreate {
+	_c.mutation.SetTriggerDetail(v)
+	return _c
+}
+
+// SetTaskConfigHash sets the "task_config_hash" field.
+func (_c *JobCreate) SetTaskConfigHash(v string) *JobCreate {
+	_c.mutation.SetTaskConfigHash(v)
+	return _c
+}
+
+// SetNillableTaskConfigHash sets the "task_config_hash" field if the given value is not nil.
+func (_c *JobCreate) SetNillableTaskConfigHash(v *string) *JobCreate {
+	if v != nil {
+		_c.SetTaskConfigHash(*v)
+	}
+	return _c
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (_c *JobCreate) SetConcurrency(v *model.JobConcurrency) *JobCreate {
+	_c.mutation.SetConcurrency(v)
+	return _c
+}
+
+// SetConfigSnapshot sets the "config_snapshot" field.
+func (_c *JobCreate) SetConfigSnapshot(v *model.JobConfigSnapshot) *JobCreate {
+	_c.mutation.SetConfigSnapshot(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *JobCreate) SetID(v uuid.UUID) *JobCreate {
 	_c.mutation.SetID(v)
@@ -182,6 +384,56 @@ func (_c *JobCreate) AddLogs(v ...*JobLog) *JobCreate {
 	return _c.AddLogIDs(ids...)
 }
 
+// AddRetryQueueIDs adds the "retry_queue" edge to the RetryQueue entity by IDs.
+func (_c *JobCreate) AddRetryQueueIDs(ids ...uuid.UUID) *JobCreate {
+	_c.mutation.AddRetryQueueIDs(ids...)
+	return _c
+}
+
+// AddRetryQueue adds the "retry_queue" edges to the RetryQueue entity.
+func (_c *JobCreate) AddRetryQueue(v ...*RetryQueue) *JobCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRetryQueueIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the JobEvent entity by IDs.
+func (_c *JobCreate) AddEventIDs(ids ...uuid.UUID) *JobCreate {
+	_c.mutation.AddEventIDs(ids...)
+	return _c
+}
+
+// AddEvents adds the "events" edges to the JobEvent entity.
+func (_c *JobCreate) AddEvents(v ...*JobEvent) *JobCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Job entity.
+func (_c *JobCreate) SetParent(v *Job) *JobCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Job entity by IDs.
+func (_c *JobCreate) AddChildIDs(ids ...uuid.UUID) *JobCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Job entity.
+func (_c *JobCreate) AddChildren(v ...*Job) *JobCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (_c *JobCreate) Mutation() *JobMutation {
 	return _c.mutation
@@ -233,14 +485,42 @@ func (_c *JobCreate) defaults() {
 		v := job.DefaultBytesTransferred
 		_c.mutation.SetBytesTransferred(v)
 	}
+	if _, ok := _c.mutation.UploadedFiles(); !ok {
+		v := job.DefaultUploadedFiles
+		_c.mutation.SetUploadedFiles(v)
+	}
+	if _, ok := _c.mutation.UploadedBytes(); !ok {
+		v := job.DefaultUploadedBytes
+		_c.mutation.SetUploadedBytes(v)
+	}
+	if _, ok := _c.mutation.DownloadedFiles(); !ok {
+		v := job.DefaultDownloadedFiles
+		_c.mutation.SetDownloadedFiles(v)
+	}
+	if _, ok := _c.mutation.DownloadedBytes(); !ok {
+		v := job.DefaultDownloadedBytes
+		_c.mutation.SetDownloadedBytes(v)
+	}
 	if _, ok := _c.mutation.FilesDeleted(); !ok {
 		v := job.DefaultFilesDeleted
 		_c.mutation.SetFilesDeleted(v)
 	}
+	if _, ok := _c.mutation.FilesChecked(); !ok {
+		v := job.DefaultFilesChecked
+		_c.mutation.SetFilesChecked(v)
+	}
+	if _, ok := _c.mutation.ListingsDone(); !ok {
+		v := job.DefaultListingsDone
+		_c.mutation.SetListingsDone(v)
+	}
 	if _, ok := _c.mutation.ErrorCount(); !ok {
 		v := job.DefaultErrorCount
 		_c.mutation.SetErrorCount(v)
 	}
+	if _, ok := _c.mutation.Acknowledged(); !ok {
+		v := job.DefaultAcknowledged
+		_c.mutation.SetAcknowledged(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := job.DefaultID()
 		_c.mutation.SetID(v)
@@ -277,12 +557,33 @@ func (_c *JobCreate) check() error {
 	if _, ok := _c.mutation.BytesTransferred(); !ok {
 		return &ValidationError{Name: "bytes_transferred", err: errors.New(`ent: missing required field "Job.bytes_transferred"`)}
 	}
+	if _, ok := _c.mutation.UploadedFiles(); !ok {
+		return &ValidationError{Name: "uploaded_files", err: errors.New(`ent: missing required field "Job.uploaded_files"`)}
+	}
+	if _, ok := _c.mutation.UploadedBytes(); !ok {
+		return &ValidationError{Name: "uploaded_bytes", err: errors.New(`ent: missing required field "Job.uploaded_bytes"`)}
+	}
+	if _, ok := _c.mutation.DownloadedFiles(); !ok {
+		return &ValidationError{Name: "downloaded_files", err: errors.New(`ent: missing required field "Job.downloaded_files"`)}
+	}
+	if _, ok := _c.mutation.DownloadedBytes(); !ok {
+		return &ValidationError{Name: "downloaded_bytes", err: errors.New(`ent: missing required field "Job.downloaded_bytes"`)}
+	}
 	if _, ok := _c.mutation.FilesDeleted(); !ok {
 		return &ValidationError{Name: "files_deleted", err: errors.New(`ent: missing required field "Job.files_deleted"`)}
 	}
+	if _, ok := _c.mutation.FilesChecked(); !ok {
+		return &ValidationError{Name: "files_checked", err: errors.New(`ent: missing required field "Job.files_checked"`)}
+	}
+	if _, ok := _c.mutation.ListingsDone(); !ok {
+		return &ValidationError{Name: "listings_done", err: errors.New(`ent: missing required field "Job.listings_done"`)}
+	}
 	if _, ok := _c.mutation.ErrorCount(); !ok {
 		return &ValidationError{Name: "error_count", err: errors.New(`ent: missing required field "Job.error_count"`)}
 	}
+	if _, ok := _c.mutation.Acknowledged(); !ok {
+		return &ValidationError{Name: "acknowledged", err: errors.New(`ent: missing required field "Job.acknowledged"`)}
+	}
 	if len(_c.mutation.TaskIDs()) == 0 {
 		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "Job.task"`)}
 	}
@@ -345,10 +646,34 @@ func (_c *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldBytesTransferred, field.TypeInt64, value)
 		_node.BytesTransferred = value
 	}
+	if value, ok := _c.mutation.UploadedFiles(); ok {
+		_spec.SetField(job.FieldUploadedFiles, field.TypeInt, value)
+		_node.UploadedFiles = value
+	}
+	if value, ok := _c.mutation.UploadedBytes(); ok {
+		_spec.SetField(job.FieldUploadedBytes, field.TypeInt64, value)
+		_node.UploadedBytes = value
+	}
+	if value, ok := _c.mutation.DownloadedFiles(); ok {
+		_spec.SetField(job.FieldDownloadedFiles, field.TypeInt, value)
+		_node.DownloadedFiles = value
+	}
+	if value, ok := _c.mutation.DownloadedBytes(); ok {
+		_spec.SetField(job.FieldDownloadedBytes, field.TypeInt64, value)
+		_node.DownloadedBytes = value
+	}
 	if value, ok := _c.mutation.FilesDeleted(); ok {
 		_spec.SetField(job.FieldFilesDeleted, field.TypeInt, value)
 		_node.FilesDeleted = value
 	}
+	if value, ok := _c.mutation.FilesChecked(); ok {
+		_spec.SetField(job.FieldFilesChecked, field.TypeInt, value)
+		_node.FilesChecked = value
+	}
+	if value, ok := _c.mutation.ListingsDone(); ok {
+		_spec.SetField(job.FieldListingsDone, field.TypeInt, value)
+		_node.ListingsDone = value
+	}
 	if value, ok := _c.mutation.ErrorCount(); ok {
 		_spec.SetField(job.FieldErrorCount, field.TypeInt, value)
 		_node.ErrorCount = value
@@ -357,6 +682,42 @@ func (_c *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldErrors, field.TypeString, value)
 		_node.Errors = value
 	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(job.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Acknowledged(); ok {
+		_spec.SetField(job.FieldAcknowledged, field.TypeBool, value)
+		_node.Acknowledged = value
+	}
+	if value, ok := _c.mutation.AnnotatedAt(); ok {
+		_spec.SetField(job.FieldAnnotatedAt, field.TypeTime, value)
+		_node.AnnotatedAt = &value
+	}
+	if value, ok := _c.mutation.TraceID(); ok {
+		_spec.SetField(job.FieldTraceID, field.TypeString, value)
+		_node.TraceID = &value
+	}
+	if value, ok := _c.mutation.ConnectionConfigVersion(); ok {
+		_spec.SetField(job.FieldConnectionConfigVersion, field.TypeInt, value)
+		_node.ConnectionConfigVersion = &value
+	}
+	if value, ok := _c.mutation.TriggerDetail(); ok {
+		_spec.SetField(job.FieldTriggerDetail, field.TypeJSON, value)
+		_node.TriggerDetail = value
+	}
+	if value, ok := _c.mutation.TaskConfigHash(); ok {
+		_spec.SetField(job.FieldTaskConfigHash, field.TypeString, value)
+		_node.TaskConfigHash = &value
+	}
+	if value, ok := _c.mutation.Concurrency(); ok {
+		_spec.SetField(job.FieldConcurrency, field.TypeJSON, value)
+		_node.Concurrency = value
+	}
+	if value, ok := _c.mutation.ConfigSnapshot(); ok {
+		_spec.SetField(job.FieldConfigSnapshot, field.TypeJSON, value)
+		_node.ConfigSnapshot = value
+	}
 	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -390,6 +751,71 @@ func (_c *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RetryQueueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.ParentTable,
+			Columns: []string{job.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,8 +14,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 )
 
@@ -46,6 +48,26 @@ func (_u *JobUpdate) SetNillableTaskID(v *uuid.UUID) *JobUpdate {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *JobUpdate) SetParentID(v uuid.UUID) *JobUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableParentID(v *uuid.UUID) *JobUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *JobUpdate) ClearParentID() *JobUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *JobUpdate) SetStatus(v model.JobStatus) *JobUpdate {
 	_u.mutation.SetStatus(v)
@@ -150,6 +172,90 @@ func (_u *JobUpdate) AddBytesTransferred(v int64) *JobUpdate {
 	return _u
 }
 
+// SetUploadedFiles sets the "uploaded_files" field.
+func (_u *JobUpdate) SetUploadedFiles(v int) *JobUpdate {
+	_u.mutation.ResetUploadedFiles()
+	_u.mutation.SetUploadedFiles(v)
+	return _u
+}
+
+// SetNillableUploadedFiles sets the "uploaded_files" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableUploadedFiles(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetUploadedFiles(*v)
+	}
+	return _u
+}
+
+// AddUploadedFiles adds value to the "uploaded_files" field.
+func (_u *JobUpdate) AddUploadedFiles(v int) *JobUpdate {
+	_u.mutation.AddUploadedFiles(v)
+	return _u
+}
+
+// SetUploadedBytes sets the "uploaded_bytes" field.
+func (_u *JobUpdate) SetUploadedBytes(v int64) *JobUpdate {
+	_u.mutation.ResetUploadedBytes()
+	_u.mutation.SetUploadedBytes(v)
+	return _u
+}
+
+// SetNillableUploadedBytes sets the "uploaded_bytes" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableUploadedBytes(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetUploadedBytes(*v)
+	}
+	return _u
+}
+
+// AddUploadedBytes adds value to the "uploaded_bytes" field.
+func (_u *JobUpdate) AddUploadedBytes(v int64) *JobUpdate {
+	_u.mutation.AddUploadedBytes(v)
+	return _u
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (_u *JobUpdate) SetDownloadedFiles(v int) *JobUpdate {
+	_u.mutation.ResetDownloadedFiles()
+	_u.mutation.SetDownloadedFiles(v)
+	return _u
+}
+
+// SetNillableDownloadedFiles sets the "downloaded_files" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableDownloadedFiles(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetDownloadedFiles(*v)
+	}
+	return _u
+}
+
+// AddDownloadedFiles adds value to the "downloaded_files" field.
+func (_u *JobUpdate) AddDownloadedFiles(v int) *JobUpdate {
+	_u.mutation.AddDownloadedFiles(v)
+	return _u
+}
+
+// SetDownloadedBytes sets the "downloaded_bytes" field.
+func (_u *JobUpdate) SetDownloadedBytes(v int64) *JobUpdate {
+	_u.mutation.ResetDownloadedBytes()
+	_u.mutation.SetDownloadedBytes(v)
+	return _u
+}
+
+// SetNillableDownloadedBytes sets the "downloaded_bytes" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableDownloadedBytes(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetDownloadedBytes(*v)
+	}
+	return _u
+}
+
+// AddDownloadedBytes adds value to the "downloaded_bytes" field.
+func (_u *JobUpdate) AddDownloadedBytes(v int64) *JobUpdate {
+	_u.mutation.AddDownloadedBytes(v)
+	return _u
+}
+
 // SetFilesDeleted sets the "files_deleted" field.
 func (_u *JobUpdate) SetFilesDeleted(v int) *JobUpdate {
 	_u.mutation.ResetFilesDeleted()
@@ -171,6 +277,48 @@ func (_u *JobUpdate) AddFilesDeleted(v int) *JobUpdate {
 	return _u
 }
 
+// SetFilesChecked sets the "files_checked" field.
+func (_u *JobUpdate) SetFilesChecked(v int) *JobUpdate {
+	_u.mutation.ResetFilesChecked()
+	_u.mutation.SetFilesChecked(v)
+	return _u
+}
+
+// SetNillableFilesChecked sets the "files_checked" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableFilesChecked(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetFilesChecked(*v)
+	}
+	return _u
+}
+
+// AddFilesChecked adds value to the "files_checked" field.
+func (_u *JobUpdate) AddFilesChecked(v int) *JobUpdate {
+	_u.mutation.AddFilesChecked(v)
+	return _u
+}
+
+// SetListingsDone sets the "listings_done" field.
+func (_u *JobUpdate) SetListingsDone(v int) *JobUpdate {
+	_u.mutation.ResetListingsDone()
+	_u.mutation.SetListingsDone(v)
+	return _u
+}
+
+// SetNillableListingsDone sets the "listings_done" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableListingsDone(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetListingsDone(*v)
+	}
+	return _u
+}
+
+// AddListingsDone adds value to the "listings_done" field.
+func (_u *JobUpdate) AddListingsDone(v int) *JobUpdate {
+	_u.mutation.AddListingsDone(v)
+	return _u
+}
+
 // SetErrorCount sets the "error_count" field.
 func (_u *JobUpdate) SetErrorCount(v int) *JobUpdate {
 	_u.mutation.ResetErrorCount()
@@ -212,6 +360,84 @@ func (_u *JobUpdate) ClearErrors() *JobUpdate {
 	return _u
 }
 
+// SetNote sets the "note" field.
+func (_u *JobUpdate) SetNote(v string) *JobUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableNote(v *string) *JobUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *JobUpdate) ClearNote() *JobUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetAcknowledged sets the "acknowledged" field.
+func (_u *JobUpdate) SetAcknowledged(v bool) *JobUpdate {
+	_u.mutation.SetAcknowledged(v)
+	return _u
+}
+
+// SetNillableAcknowledged sets the "acknowledged" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableAcknowledged(v *bool) *JobUpdate {
+	if v != nil {
+		_u.SetAcknowledged(*v)
+	}
+	return _u
+}
+
+// SetAnnotatedAt sets the "annotated_at" field.
+func (_u *JobUpdate) SetAnnotatedAt(v time.Time) *JobUpdate {
+	_u.mutation.SetAnnotatedAt(v)
+	return _u
+}
+
+// SetNillableAnnotatedAt sets the "annotated_at" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableAnnotatedAt(v *time.Time) *JobUpdate {
+	if v != nil {
+		_u.SetAnnotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAnnotatedAt clears the value of the "annotated_at" field.
+func (_u *JobUpdate) ClearAnnotatedAt() *JobUpdate {
+	_u.mutation.ClearAnnotatedAt()
+	return _u
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (_u *JobUpdate) SetConcurrency(v *model.JobConcurrency) *JobUpdate {
+	_u.mutation.SetConcurrency(v)
+	return _u
+}
+
+// ClearConcurrency clears the value of the "concurrency" field.
+func (_u *JobUpdate) ClearConcurrency() *JobUpdate {
+	_u.mutation.ClearConcurrency()
+	return _u
+}
+
+// SetConfigSnapshot sets the "config_snapshot" field.
+func (_u *JobUpdate) SetConfigSnapshot(v *model.JobConfigSnapshot) *JobUpdate {
+	_u.mutation.SetConfigSnapshot(v)
+	return _u
+}
+
+// ClearConfigSnapshot clears the value of the "config_snapshot" field.
+func (_u *JobUpdate) ClearConfigSnapshot() *JobUpdate {
+	_u.mutation.ClearConfigSnapshot()
+	return _u
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_u *JobUpdate) SetTask(v *Task) *JobUpdate {
 	return _u.SetTaskID(v.ID)
@@ -232,6 +458,56 @@ func (_u *JobUpdate) AddLogs(v ...*JobLog) *JobUpdate {
 	return _u.AddLogIDs(ids...)
 }
 
+// AddRetryQueueIDs adds the "retry_queue" edge to the RetryQueue entity by IDs.
+func (_u *JobUpdate) AddRetryQueueIDs(ids ...uuid.UUID) *JobUpdate {
+	_u.mutation.AddRetryQueueIDs(ids...)
+	return _u
+}
+
+// AddRetryQueue adds the "retry_queue" edges to the RetryQueue entity.
+func (_u *JobUpdate) AddRetryQueue(v ...*RetryQueue) *JobUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRetryQueueIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the JobEvent entity by IDs.
+func (_u *JobUpdate) AddEventIDs(ids ...uuid.UUID) *JobUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the JobEvent entity.
+func (_u *JobUpdate) AddEvents(v ...*JobEvent) *JobUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Job entity.
+func (_u *JobUpdate) SetParent(v *Job) *JobUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Job entity by IDs.
+func (_u *JobUpdate) AddChildIDs(ids ...uuid.UUID) *JobUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Job entity.
+func (_u *JobUpdate) AddChildren(v ...*Job) *JobUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (_u *JobUpdate) Mutation() *JobMutation {
 	return _u.mutation
@@ -264,6 +540,75 @@ func (_u *JobUpdate) RemoveLogs(v ...*JobLog) *JobUpdate {
 	return _u.RemoveLogIDs(ids...)
 }
 
+// ClearRetryQueue clears all "retry_queue" edges to the RetryQueue entity.
+func (_u *JobUpdate) ClearRetryQueue() *JobUpdate {
+	_u.mutation.ClearRetryQueue()
+	return _u
+}
+
+// RemoveRetryQueueIDs removes the "retry_queue" edge to RetryQueue entities by IDs.
+func (_u *JobUpdate) RemoveRetryQueueIDs(ids ...uuid.UUID) *JobUpdate {
+	_u.mutation.RemoveRetryQueueIDs(ids...)
+	return _u
+}
+
+// RemoveRetryQueue removes "retry_queue" edges to RetryQueue entities.
+func (_u *JobUpdate) RemoveRetryQueue(v ...*RetryQueue) *JobUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRetryQueueIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the JobEvent entity.
+func (_u *JobUpdate) ClearEvents() *JobUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to JobEvent entities by IDs.
+func (_u *JobUpdate) RemoveEventIDs(ids ...uuid.UUID) *JobUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to JobEvent entities.
+func (_u *JobUpdate) RemoveEvents(v ...*JobEvent) *JobUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Job entity.
+func (_u *JobUpdate) ClearParent() *JobUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Job entity.
+func (_u *JobUpdate) ClearChildren() *JobUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Job entities by IDs.
+func (_u *JobUpdate) RemoveChildIDs(ids ...uuid.UUID) *JobUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Job entities.
+func (_u *JobUpdate) RemoveChildren(v ...*Job) *JobUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *JobUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -348,12 +693,48 @@ func (_u *JobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedBytesTransferred(); ok {
 		_spec.AddField(job.FieldBytesTransferred, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.UploadedFiles(); ok {
+		_spec.SetField(job.FieldUploadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUploadedFiles(); ok {
+		_spec.AddField(job.FieldUploadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UploadedBytes(); ok {
+		_spec.SetField(job.FieldUploadedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadedBytes(); ok {
+		_spec.AddField(job.FieldUploadedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DownloadedFiles(); ok {
+		_spec.SetField(job.FieldDownloadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadedFiles(); ok {
+		_spec.AddField(job.FieldDownloadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DownloadedBytes(); ok {
+		_spec.SetField(job.FieldDownloadedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadedBytes(); ok {
+		_spec.AddField(job.FieldDownloadedBytes, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.FilesDeleted(); ok {
 		_spec.SetField(job.FieldFilesDeleted, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedFilesDeleted(); ok {
 		_spec.AddField(job.FieldFilesDeleted, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.FilesChecked(); ok {
+		_spec.SetField(job.FieldFilesChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFilesChecked(); ok {
+		_spec.AddField(job.FieldFilesChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ListingsDone(); ok {
+		_spec.SetField(job.FieldListingsDone, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedListingsDone(); ok {
+		_spec.AddField(job.FieldListingsDone, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.ErrorCount(); ok {
 		_spec.SetField(job.FieldErrorCount, field.TypeInt, value)
 	}
@@ -366,6 +747,45 @@ func (_u *JobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ErrorsCleared() {
 		_spec.ClearField(job.FieldErrors, field.TypeString)
 	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(job.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(job.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Acknowledged(); ok {
+		_spec.SetField(job.FieldAcknowledged, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AnnotatedAt(); ok {
+		_spec.SetField(job.FieldAnnotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AnnotatedAtCleared() {
+		_spec.ClearField(job.FieldAnnotatedAt, field.TypeTime)
+	}
+	if _u.mutation.TraceIDCleared() {
+		_spec.ClearField(job.FieldTraceID, field.TypeString)
+	}
+	if _u.mutation.ConnectionConfigVersionCleared() {
+		_spec.ClearField(job.FieldConnectionConfigVersion, field.TypeInt)
+	}
+	if _u.mutation.TriggerDetailCleared() {
+		_spec.ClearField(job.FieldTriggerDetail, field.TypeJSON)
+	}
+	if _u.mutation.TaskConfigHashCleared() {
+		_spec.ClearField(job.FieldTaskConfigHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Concurrency(); ok {
+		_spec.SetField(job.FieldConcurrency, field.TypeJSON, value)
+	}
+	if _u.mutation.ConcurrencyCleared() {
+		_spec.ClearField(job.FieldConcurrency, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ConfigSnapshot(); ok {
+		_spec.SetField(job.FieldConfigSnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.ConfigSnapshotCleared() {
+		_spec.ClearField(job.FieldConfigSnapshot, field.TypeJSON)
+	}
 	if _u.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -440,41 +860,225 @@ func (_u *JobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{job.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.RetryQueueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// JobUpdateOne is the builder for updating a single Job entity.
-type JobUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *JobMutation
-}
-
-// SetTaskID sets the "task_id" field.
-func (_u *JobUpdateOne) SetTaskID(v uuid.UUID) *JobUpdateOne {
-	_u.mutation.SetTaskID(v)
-	return _u
-}
-
-// SetNillableTaskID sets the "task_id" field if the given value is not nil.
-func (_u *JobUpdateOne) SetNillableTaskID(v *uuid.UUID) *JobUpdateOne {
-	if v != nil {
-		_u.SetTaskID(*v)
+	if nodes := _u.mutation.RemovedRetryQueueIDs(); len(nodes) > 0 && !_u.mutation.RetryQueueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetStatus sets the "status" field.
+	if nodes := _u.mutation.RetryQueueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.ParentTable,
+			Columns: []string{job.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.ParentTable,
+			Columns: []string{job.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *JobUpdateOne) SetTaskID(v uuid.UUID) *JobUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableTaskID(v *uuid.UUID) *JobUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *JobUpdateOne) SetParentID(v uuid.UUID) *JobUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableParentID(v *uuid.UUID) *JobUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *JobUpdateOne) ClearParentID() *JobUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
 func (_u *JobUpdateOne) SetStatus(v model.JobStatus) *JobUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
@@ -578,6 +1182,90 @@ func (_u *JobUpdateOne) AddBytesTransferred(v int64) *JobUpdateOne {
 	return _u
 }
 
+// SetUploadedFiles sets the "uploaded_files" field.
+func (_u *JobUpdateOne) SetUploadedFiles(v int) *JobUpdateOne {
+	_u.mutation.ResetUploadedFiles()
+	_u.mutation.SetUploadedFiles(v)
+	return _u
+}
+
+// SetNillableUploadedFiles sets the "uploaded_files" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableUploadedFiles(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetUploadedFiles(*v)
+	}
+	return _u
+}
+
+// AddUploadedFiles adds value to the "uploaded_files" field.
+func (_u *JobUpdateOne) AddUploadedFiles(v int) *JobUpdateOne {
+	_u.mutation.AddUploadedFiles(v)
+	return _u
+}
+
+// SetUploadedBytes sets the "uploaded_bytes" field.
+func (_u *JobUpdateOne) SetUploadedBytes(v int64) *JobUpdateOne {
+	_u.mutation.ResetUploadedBytes()
+	_u.mutation.SetUploadedBytes(v)
+	return _u
+}
+
+// SetNillableUploadedBytes sets the "uploaded_bytes" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableUploadedBytes(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetUploadedBytes(*v)
+	}
+	return _u
+}
+
+// AddUploadedBytes adds value to the "uploaded_bytes" field.
+func (_u *JobUpdateOne) AddUploadedBytes(v int64) *JobUpdateOne {
+	_u.mutation.AddUploadedBytes(v)
+	return _u
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (_u *JobUpdateOne) SetDownloadedFiles(v int) *JobUpdateOne {
+	_u.mutation.ResetDownloadedFiles()
+	_u.mutation.SetDownloadedFiles(v)
+	return _u
+}
+
+// SetNillableDownloadedFiles sets the "downloaded_files" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableDownloadedFiles(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetDownloadedFiles(*v)
+	}
+	return _u
+}
+
+// AddDownloadedFiles adds value to the "downloaded_files" field.
+func (_u *JobUpdateOne) AddDownloadedFiles(v int) *JobUpdateOne {
+	_u.mutation.AddDownloadedFiles(v)
+	return _u
+}
+
+// SetDownloadedBytes sets the "downloaded_bytes" field.
+func (_u *JobUpdateOne) SetDownloadedBytes(v int64) *JobUpdateOne {
+	_u.mutation.ResetDownloadedBytes()
+	_u.mutation.SetDownloadedBytes(v)
+	return _u
+}
+
+// SetNillableDownloadedBytes sets the "downloaded_bytes" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableDownloadedBytes(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetDownloadedBytes(*v)
+	}
+	return _u
+}
+
+// AddDownloadedBytes adds value to the "downloaded_bytes" field.
+func (_u *JobUpdateOne) AddDownloadedBytes(v int64) *JobUpdateOne {
+	_u.mutation.AddDownloadedBytes(v)
+	return _u
+}
+
 // SetFilesDeleted sets the "files_deleted" field.
 func (_u *JobUpdateOne) SetFilesDeleted(v int) *JobUpdateOne {
 	_u.mutation.ResetFilesDeleted()
@@ -599,6 +1287,48 @@ func (_u *JobUpdateOne) AddFilesDeleted(v int) *JobUpdateOne {
 	return _u
 }
 
+// SetFilesChecked sets the "files_checked" field.
+func (_u *JobUpdateOne) SetFilesChecked(v int) *JobUpdateOne {
+	_u.mutation.ResetFilesChecked()
+	_u.mutation.SetFilesChecked(v)
+	return _u
+}
+
+// SetNillableFilesChecked sets the "files_checked" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableFilesChecked(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetFilesChecked(*v)
+	}
+	return _u
+}
+
+// AddFilesChecked adds value to the "files_checked" field.
+func (_u *JobUpdateOne) AddFilesChecked(v int) *JobUpdateOne {
+	_u.mutation.AddFilesChecked(v)
+	return _u
+}
+
+// SetListingsDone sets the "listings_done" field.
+func (_u *JobUpdateOne) SetListingsDone(v int) *JobUpdateOne {
+	_u.mutation.ResetListingsDone()
+	_u.mutation.SetListingsDone(v)
+	return _u
+}
+
+// SetNillableListingsDone sets the "listings_done" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableListingsDone(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetListingsDone(*v)
+	}
+	return _u
+}
+
+// AddListingsDone adds value to the "listings_done" field.
+func (_u *JobUpdateOne) AddListingsDone(v int) *JobUpdateOne {
+	_u.mutation.AddListingsDone(v)
+	return _u
+}
+
 // SetErrorCount sets the "error_count" field.
 func (_u *JobUpdateOne) SetErrorCount(v int) *JobUpdateOne {
 	_u.mutation.ResetErrorCount()
@@ -640,6 +1370,84 @@ func (_u *JobUpdateOne) ClearErrors() *JobUpdateOne {
 	return _u
 }
 
+// SetNote sets the "note" field.
+func (_u *JobUpdateOne) SetNote(v string) *JobUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableNote(v *string) *JobUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *JobUpdateOne) ClearNote() *JobUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetAcknowledged sets the "acknowledged" field.
+func (_u *JobUpdateOne) SetAcknowledged(v bool) *JobUpdateOne {
+	_u.mutation.SetAcknowledged(v)
+	return _u
+}
+
+// SetNillableAcknowledged sets the "acknowledged" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableAcknowledged(v *bool) *JobUpdateOne {
+	if v != nil {
+		_u.SetAcknowledged(*v)
+	}
+	return _u
+}
+
+// SetAnnotatedAt sets the "annotated_at" field.
+func (_u *JobUpdateOne) SetAnnotatedAt(v time.Time) *JobUpdateOne {
+	_u.mutation.SetAnnotatedAt(v)
+	return _u
+}
+
+// SetNillableAnnotatedAt sets the "annotated_at" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableAnnotatedAt(v *time.Time) *JobUpdateOne {
+	if v != nil {
+		_u.SetAnnotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAnnotatedAt clears the value of the "annotated_at" field.
+func (_u *JobUpdateOne) ClearAnnotatedAt() *JobUpdateOne {
+	_u.mutation.ClearAnnotatedAt()
+	return _u
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (_u *JobUpdateOne) SetConcurrency(v *model.JobConcurrency) *JobUpdateOne {
+	_u.mutation.SetConcurrency(v)
+	return _u
+}
+
+// ClearConcurrency clears the value of the "concurrency" field.
+func (_u *JobUpdateOne) ClearConcurrency() *JobUpdateOne {
+	_u.mutation.ClearConcurrency()
+	return _u
+}
+
+// SetConfigSnapshot sets the "config_snapshot" field.
+func (_u *JobUpdateOne) SetConfigSnapshot(v *model.JobConfigSnapshot) *JobUpdateOne {
+	_u.mutation.SetConfigSnapshot(v)
+	return _u
+}
+
+// ClearConfigSnapshot clears the value of the "config_snapshot" field.
+func (_u *JobUpdateOne) ClearConfigSnapshot() *JobUpdateOne {
+	_u.mutation.ClearConfigSnapshot()
+	return _u
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_u *JobUpdateOne) SetTask(v *Task) *JobUpdateOne {
 	return _u.SetTaskID(v.ID)
@@ -660,6 +1468,56 @@ func (_u *JobUpdateOne) AddLogs(v ...*JobLog) *JobUpdateOne {
 	return _u.AddLogIDs(ids...)
 }
 
+// AddRetryQueueIDs adds the "retry_queue" edge to the RetryQueue entity by IDs.
+func (_u *JobUpdateOne) AddRetryQueueIDs(ids ...uuid.UUID) *JobUpdateOne {
+	_u.mutation.AddRetryQueueIDs(ids...)
+	return _u
+}
+
+// AddRetryQueue adds the "retry_queue" edges to the RetryQueue entity.
+func (_u *JobUpdateOne) AddRetryQueue(v ...*RetryQueue) *JobUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRetryQueueIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the JobEvent entity by IDs.
+func (_u *JobUpdateOne) AddEventIDs(ids ...uuid.UUID) *JobUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the JobEvent entity.
+func (_u *JobUpdateOne) AddEvents(v ...*JobEvent) *JobUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Job entity.
+func (_u *JobUpdateOne) SetParent(v *Job) *JobUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Job entity by IDs.
+func (_u *JobUpdateOne) AddChildIDs(ids ...uuid.UUID) *JobUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Job entity.
+func (_u *JobUpdateOne) AddChildren(v ...*Job) *JobUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the JobMutation object of the builder.
 func (_u *JobUpdateOne) Mutation() *JobMutation {
 	return _u.mutation
@@ -692,6 +1550,75 @@ func (_u *JobUpdateOne) RemoveLogs(v ...*JobLog) *JobUpdateOne {
 	return _u.RemoveLogIDs(ids...)
 }
 
+// ClearRetryQueue clears all "retry_queue" edges to the RetryQueue entity.
+func (_u *JobUpdateOne) ClearRetryQueue() *JobUpdateOne {
+	_u.mutation.ClearRetryQueue()
+	return _u
+}
+
+// RemoveRetryQueueIDs removes the "retry_queue" edge to RetryQueue entities by IDs.
+func (_u *JobUpdateOne) RemoveRetryQueueIDs(ids ...uuid.UUID) *JobUpdateOne {
+	_u.mutation.RemoveRetryQueueIDs(ids...)
+	return _u
+}
+
+// RemoveRetryQueue removes "retry_queue" edges to RetryQueue entities.
+func (_u *JobUpdateOne) RemoveRetryQueue(v ...*RetryQueue) *JobUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRetryQueueIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the JobEvent entity.
+func (_u *JobUpdateOne) ClearEvents() *JobUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to JobEvent entities by IDs.
+func (_u *JobUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *JobUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to JobEvent entities.
+func (_u *JobUpdateOne) RemoveEvents(v ...*JobEvent) *JobUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Job entity.
+func (_u *JobUpdateOne) ClearParent() *JobUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Job entity.
+func (_u *JobUpdateOne) ClearChildren() *JobUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Job entities by IDs.
+func (_u *JobUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *JobUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Job entities.
+func (_u *JobUpdateOne) RemoveChildren(v ...*Job) *JobUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the JobUpdate builder.
 func (_u *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
 	_u.mutation.Where(ps...)
@@ -806,12 +1733,48 @@ func (_u *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 	if value, ok := _u.mutation.AddedBytesTransferred(); ok {
 		_spec.AddField(job.FieldBytesTransferred, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.UploadedFiles(); ok {
+		_spec.SetField(job.FieldUploadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUploadedFiles(); ok {
+		_spec.AddField(job.FieldUploadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UploadedBytes(); ok {
+		_spec.SetField(job.FieldUploadedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUploadedBytes(); ok {
+		_spec.AddField(job.FieldUploadedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DownloadedFiles(); ok {
+		_spec.SetField(job.FieldDownloadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadedFiles(); ok {
+		_spec.AddField(job.FieldDownloadedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DownloadedBytes(); ok {
+		_spec.SetField(job.FieldDownloadedBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadedBytes(); ok {
+		_spec.AddField(job.FieldDownloadedBytes, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.FilesDeleted(); ok {
 		_spec.SetField(job.FieldFilesDeleted, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedFilesDeleted(); ok {
 		_spec.AddField(job.FieldFilesDeleted, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.FilesChecked(); ok {
+		_spec.SetField(job.FieldFilesChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFilesChecked(); ok {
+		_spec.AddField(job.FieldFilesChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ListingsDone(); ok {
+		_spec.SetField(job.FieldListingsDone, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedListingsDone(); ok {
+		_spec.AddField(job.FieldListingsDone, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.ErrorCount(); ok {
 		_spec.SetField(job.FieldErrorCount, field.TypeInt, value)
 	}
@@ -824,6 +1787,45 @@ func (_u *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 	if _u.mutation.ErrorsCleared() {
 		_spec.ClearField(job.FieldErrors, field.TypeString)
 	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(job.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(job.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Acknowledged(); ok {
+		_spec.SetField(job.FieldAcknowledged, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AnnotatedAt(); ok {
+		_spec.SetField(job.FieldAnnotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AnnotatedAtCleared() {
+		_spec.ClearField(job.FieldAnnotatedAt, field.TypeTime)
+	}
+	if _u.mutation.TraceIDCleared() {
+		_spec.ClearField(job.FieldTraceID, field.TypeString)
+	}
+	if _u.mutation.ConnectionConfigVersionCleared() {
+		_spec.ClearField(job.FieldConnectionConfigVersion, field.TypeInt)
+	}
+	if _u.mutation.TriggerDetailCleared() {
+		_spec.ClearField(job.FieldTriggerDetail, field.TypeJSON)
+	}
+	if _u.mutation.TaskConfigHashCleared() {
+		_spec.ClearField(job.FieldTaskConfigHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Concurrency(); ok {
+		_spec.SetField(job.FieldConcurrency, field.TypeJSON, value)
+	}
+	if _u.mutation.ConcurrencyCleared() {
+		_spec.ClearField(job.FieldConcurrency, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ConfigSnapshot(); ok {
+		_spec.SetField(job.FieldConfigSnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.ConfigSnapshotCleared() {
+		_spec.ClearField(job.FieldConfigSnapshot, field.TypeJSON)
+	}
 	if _u.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -898,6 +1900,170 @@ func (_u *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RetryQueueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRetryQueueIDs(); len(nodes) > 0 && !_u.mutation.RetryQueueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RetryQueueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.RetryQueueTable,
+			Columns: []string{job.RetryQueueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.EventsTable,
+			Columns: []string{job.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.ParentTable,
+			Columns: []string{job.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.ParentTable,
+			Columns: []string{job.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   job.ChildrenTable,
+			Columns: []string{job.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Job{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
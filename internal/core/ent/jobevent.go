@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
+)
+
+// JobEvent is the model entity for the JobEvent schema.
+type JobEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID uuid.UUID `json:"job_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type model.JobEventType `json:"type,omitempty"`
+	// Command holds the value of the "command" field.
+	Command string `json:"command,omitempty"`
+	// Args holds the value of the "args" field.
+	Args []string `json:"args,omitempty"`
+	// Exit code of the command, nil if it did not start or was killed
+	ExitCode *int `json:"exit_code,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// Combined stdout and stderr of the command, capped at app.hooks.max_output bytes
+	Output string `json:"output,omitempty"`
+	// OutputTruncated holds the value of the "output_truncated" field.
+	OutputTruncated bool `json:"output_truncated,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JobEventQuery when eager-loading is set.
+	Edges        JobEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JobEventEdges holds the relations/edges for other nodes in the graph.
+type JobEventEdges struct {
+	// Job holds the value of the job edge.
+	Job *Job `json:"job,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobOrErr returns the Job value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobEventEdges) JobOrErr() (*Job, error) {
+	if e.Job != nil {
+		return e.Job, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: job.Label}
+	}
+	return nil, &NotLoadedError{edge: "job"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobevent.FieldArgs:
+			values[i] = new([]byte)
+		case jobevent.FieldOutputTruncated:
+			values[i] = new(sql.NullBool)
+		case jobevent.FieldExitCode, jobevent.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case jobevent.FieldType, jobevent.FieldCommand, jobevent.FieldOutput, jobevent.FieldError:
+			values[i] = new(sql.NullString)
+		case jobevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case jobevent.FieldID, jobevent.FieldJobID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobEvent fields.
+func (_m *JobEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case jobevent.FieldJobID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value != nil {
+				_m.JobID = *value
+			}
+		case jobevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = model.JobEventType(value.String)
+			}
+		case jobevent.FieldCommand:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field command", values[i])
+			} else if value.Valid {
+				_m.Command = value.String
+			}
+		case jobevent.FieldArgs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field args", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Args); err != nil {
+					return fmt.Errorf("unmarshal field args: %w", err)
+				}
+			}
+		case jobevent.FieldExitCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_code", values[i])
+			} else if value.Valid {
+				_m.ExitCode = new(int)
+				*_m.ExitCode = int(value.Int64)
+			}
+		case jobevent.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = value.Int64
+			}
+		case jobevent.FieldOutput:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field output", values[i])
+			} else if value.Valid {
+				_m.Output = value.String
+			}
+		case jobevent.FieldOutputTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field output_truncated", values[i])
+			} else if value.Valid {
+				_m.OutputTruncated = value.Bool
+			}
+		case jobevent.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case jobevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *JobEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryJob queries the "job" edge of the JobEvent entity.
+func (_m *JobEvent) QueryJob() *JobQuery {
+	return NewJobEventClient(_m.config).QueryJob(_m)
+}
+
+// Update returns a builder for updating this JobEvent.
+// Note that you need to call JobEvent.Unwrap() before calling this method if this JobEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *JobEvent) Update() *JobEventUpdateOne {
+	return NewJobEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the JobEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *JobEvent) Unwrap() *JobEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *JobEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("command=")
+	builder.WriteString(_m.Command)
+	builder.WriteString(", ")
+	builder.WriteString("args=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Args))
+	builder.WriteString(", ")
+	if v := _m.ExitCode; v != nil {
+		builder.WriteString("exit_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("output=")
+	builder.WriteString(_m.Output)
+	builder.WriteString(", ")
+	builder.WriteString("output_truncated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutputTruncated))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobEvents is a parsable slice of JobEvent.
+type JobEvents []*JobEvent
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+const (
+	// Label holds the string label denoting the jobevent type in the database.
+	Label = "job_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldCommand holds the string denoting the command field in the database.
+	FieldCommand = "command"
+	// FieldArgs holds the string denoting the args field in the database.
+	FieldArgs = "args"
+	// FieldExitCode holds the string denoting the exit_code field in the database.
+	FieldExitCode = "exit_code"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldOutput holds the string denoting the output field in the database.
+	FieldOutput = "output"
+	// FieldOutputTruncated holds the string denoting the output_truncated field in the database.
+	FieldOutputTruncated = "output_truncated"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeJob holds the string denoting the job edge name in mutations.
+	EdgeJob = "job"
+	// Table holds the table name of the jobevent in the database.
+	Table = "job_events"
+	// JobTable is the table that holds the job relation/edge.
+	JobTable = "job_events"
+	// JobInverseTable is the table name for the Job entity.
+	// It exists in this package in order to avoid circular dependency with the "job" package.
+	JobInverseTable = "jobs"
+	// JobColumn is the table column denoting the job relation/edge.
+	JobColumn = "job_id"
+)
+
+// Columns holds all SQL columns for jobevent fields.
+var Columns = []string{
+	FieldID,
+	FieldJobID,
+	FieldType,
+	FieldCommand,
+	FieldArgs,
+	FieldExitCode,
+	FieldDurationMs,
+	FieldOutput,
+	FieldOutputTruncated,
+	FieldError,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDurationMs holds the default value on creation for the "duration_ms" field.
+	DefaultDurationMs int64
+	// DefaultOutput holds the default value on creation for the "output" field.
+	DefaultOutput string
+	// DefaultOutputTruncated holds the default value on creation for the "output_truncated" field.
+	DefaultOutputTruncated bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type model.JobEventType) error {
+	switch _type.String() {
+	case "PRE_HOOK", "POST_HOOK":
+		return nil
+	default:
+		return fmt.Errorf("jobevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the JobEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByCommand orders the results by the command field.
+func ByCommand(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommand, opts...).ToFunc()
+}
+
+// ByExitCode orders the results by the exit_code field.
+func ByExitCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitCode, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByOutput orders the results by the output field.
+func ByOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutput, opts...).ToFunc()
+}
+
+// ByOutputTruncated orders the results by the output_truncated field.
+func ByOutputTruncated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputTruncated, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByJobField orders the results by job field.
+func ByJobField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newJobStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JobTable, JobColumn),
+	)
+}
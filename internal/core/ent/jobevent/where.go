@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldID, id))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldJobID, v))
+}
+
+// Command applies equality check predicate on the "command" field. It's identical to CommandEQ.
+func Command(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldCommand, v))
+}
+
+// ExitCode applies equality check predicate on the "exit_code" field. It's identical to ExitCodeEQ.
+func ExitCode(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldExitCode, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// Output applies equality check predicate on the "output" field. It's identical to OutputEQ.
+func Output(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldOutput, v))
+}
+
+// OutputTruncated applies equality check predicate on the "output_truncated" field. It's identical to OutputTruncatedEQ.
+func OutputTruncated(v bool) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldOutputTruncated, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...uuid.UUID) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v model.JobEventType) predicate.JobEvent {
+	vc := v
+	return predicate.JobEvent(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v model.JobEventType) predicate.JobEvent {
+	vc := v
+	return predicate.JobEvent(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...model.JobEventType) predicate.JobEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JobEvent(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...model.JobEventType) predicate.JobEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JobEvent(sql.FieldNotIn(FieldType, v...))
+}
+
+// CommandEQ applies the EQ predicate on the "command" field.
+func CommandEQ(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldCommand, v))
+}
+
+// CommandNEQ applies the NEQ predicate on the "command" field.
+func CommandNEQ(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldCommand, v))
+}
+
+// CommandIn applies the In predicate on the "command" field.
+func CommandIn(vs ...string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldCommand, vs...))
+}
+
+// CommandNotIn applies the NotIn predicate on the "command" field.
+func CommandNotIn(vs ...string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldCommand, vs...))
+}
+
+// CommandGT applies the GT predicate on the "command" field.
+func CommandGT(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldCommand, v))
+}
+
+// CommandGTE applies the GTE predicate on the "command" field.
+func CommandGTE(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldCommand, v))
+}
+
+// CommandLT applies the LT predicate on the "command" field.
+func CommandLT(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldCommand, v))
+}
+
+// CommandLTE applies the LTE predicate on the "command" field.
+func CommandLTE(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldCommand, v))
+}
+
+// CommandContains applies the Contains predicate on the "command" field.
+func CommandContains(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldContains(FieldCommand, v))
+}
+
+// CommandHasPrefix applies the HasPrefix predicate on the "command" field.
+func CommandHasPrefix(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldHasPrefix(FieldCommand, v))
+}
+
+// CommandHasSuffix applies the HasSuffix predicate on the "command" field.
+func CommandHasSuffix(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldHasSuffix(FieldCommand, v))
+}
+
+// CommandEqualFold applies the EqualFold predicate on the "command" field.
+func CommandEqualFold(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEqualFold(FieldCommand, v))
+}
+
+// CommandContainsFold applies the ContainsFold predicate on the "command" field.
+func CommandContainsFold(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldContainsFold(FieldCommand, v))
+}
+
+// ArgsIsNil applies the IsNil predicate on the "args" field.
+func ArgsIsNil() predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIsNull(FieldArgs))
+}
+
+// ArgsNotNil applies the NotNil predicate on the "args" field.
+func ArgsNotNil() predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotNull(FieldArgs))
+}
+
+// ExitCodeEQ applies the EQ predicate on the "exit_code" field.
+func ExitCodeEQ(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldExitCode, v))
+}
+
+// ExitCodeNEQ applies the NEQ predicate on the "exit_code" field.
+func ExitCodeNEQ(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldExitCode, v))
+}
+
+// ExitCodeIn applies the In predicate on the "exit_code" field.
+func ExitCodeIn(vs ...int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldExitCode, vs...))
+}
+
+// ExitCodeNotIn applies the NotIn predicate on the "exit_code" field.
+func ExitCodeNotIn(vs ...int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldExitCode, vs...))
+}
+
+// ExitCodeGT applies the GT predicate on the "exit_code" field.
+func ExitCodeGT(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldExitCode, v))
+}
+
+// ExitCodeGTE applies the GTE predicate on the "exit_code" field.
+func ExitCodeGTE(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldExitCode, v))
+}
+
+// ExitCodeLT applies the LT predicate on the "exit_code" field.
+func ExitCodeLT(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldExitCode, v))
+}
+
+// ExitCodeLTE applies the LTE predicate on the "exit_code" field.
+func ExitCodeLTE(v int) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldExitCode, v))
+}
+
+// ExitCodeIsNil applies the IsNil predicate on the "exit_code" field.
+func ExitCodeIsNil() predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIsNull(FieldExitCode))
+}
+
+// ExitCodeNotNil applies the NotNil predicate on the "exit_code" field.
+func ExitCodeNotNil() predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotNull(FieldExitCode))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// OutputEQ applies the EQ predicate on the "output" field.
+func OutputEQ(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldOutput, v))
+}
+
+// OutputNEQ applies the NEQ predicate on the "output" field.
+func OutputNEQ(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldOutput, v))
+}
+
+// OutputIn applies the In predicate on the "output" field.
+func OutputIn(vs ...string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldOutput, vs...))
+}
+
+// OutputNotIn applies the NotIn predicate on the "output" field.
+func OutputNotIn(vs ...string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldOutput, vs...))
+}
+
+// OutputGT applies the GT predicate on the "output" field.
+func OutputGT(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldOutput, v))
+}
+
+// OutputGTE applies the GTE predicate on the "output" field.
+func OutputGTE(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldOutput, v))
+}
+
+// OutputLT applies the LT predicate on the "output" field.
+func OutputLT(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldOutput, v))
+}
+
+// OutputLTE applies the LTE predicate on the "output" field.
+func OutputLTE(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldOutput, v))
+}
+
+// OutputContains applies the Contains predicate on the "output" field.
+func OutputContains(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldContains(FieldOutput, v))
+}
+
+// OutputHasPrefix applies the HasPrefix predicate on the "output" field.
+func OutputHasPrefix(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldHasPrefix(FieldOutput, v))
+}
+
+// OutputHasSuffix applies the HasSuffix predicate on the "output" field.
+func OutputHasSuffix(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldHasSuffix(FieldOutput, v))
+}
+
+// OutputEqualFold applies the EqualFold predicate on the "output" field.
+func OutputEqualFold(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEqualFold(FieldOutput, v))
+}
+
+// OutputContainsFold applies the ContainsFold predicate on the "output" field.
+func OutputContainsFold(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldContainsFold(FieldOutput, v))
+}
+
+// OutputTruncatedEQ applies the EQ predicate on the "output_truncated" field.
+func OutputTruncatedEQ(v bool) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldOutputTruncated, v))
+}
+
+// OutputTruncatedNEQ applies the NEQ predicate on the "output_truncated" field.
+func OutputTruncatedNEQ(v bool) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldOutputTruncated, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.JobEvent {
+	return predicate.JobEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasJob applies the HasEdge predicate on the "job" edge.
+func HasJob() predicate.JobEvent {
+	return predicate.JobEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, JobTable, JobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobWith applies the HasEdge predicate on the "job" edge with a given conditions (other predicates).
+func HasJobWith(preds ...predicate.Job) predicate.JobEvent {
+	return predicate.JobEvent(func(s *sql.Selector) {
+		step := newJobStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JobEvent) predicate.JobEvent {
+	return predicate.JobEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JobEvent) predicate.JobEvent {
+	return predicate.JobEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JobEvent) predicate.JobEvent {
+	return predicate.JobEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
+)
+
+// JobEventCreate is the builder for creating a JobEvent entity.
+type JobEventCreate struct {
+	config
+	mutation *JobEventMutation
+	hooks    []Hook
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *JobEventCreate) SetJobID(v uuid.UUID) *JobEventCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *JobEventCreate) SetType(v model.JobEventType) *JobEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetCommand sets the "command" field.
+func (_c *JobEventCreate) SetCommand(v string) *JobEventCreate {
+	_c.mutation.SetCommand(v)
+	return _c
+}
+
+// SetArgs sets the "args" field.
+func (_c *JobEventCreate) SetArgs(v []string) *JobEventCreate {
+	_c.mutation.SetArgs(v)
+	return _c
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_c *JobEventCreate) SetExitCode(v int) *JobEventCreate {
+	_c.mutation.SetExitCode(v)
+	return _c
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableExitCode(v *int) *JobEventCreate {
+	if v != nil {
+		_c.SetExitCode(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *JobEventCreate) SetDurationMs(v int64) *JobEventCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableDurationMs(v *int64) *JobEventCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetOutput sets the "output" field.
+func (_c *JobEventCreate) SetOutput(v string) *JobEventCreate {
+	_c.mutation.SetOutput(v)
+	return _c
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableOutput(v *string) *JobEventCreate {
+	if v != nil {
+		_c.SetOutput(*v)
+	}
+	return _c
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (_c *JobEventCreate) SetOutputTruncated(v bool) *JobEventCreate {
+	_c.mutation.SetOutputTruncated(v)
+	return _c
+}
+
+// SetNillableOutputTruncated sets the "output_truncated" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableOutputTruncated(v *bool) *JobEventCreate {
+	if v != nil {
+		_c.SetOutputTruncated(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *JobEventCreate) SetError(v string) *JobEventCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableError(v *string) *JobEventCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JobEventCreate) SetCreatedAt(v time.Time) *JobEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableCreatedAt(v *time.Time) *JobEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JobEventCreate) SetID(v uuid.UUID) *JobEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *JobEventCreate) SetNillableID(v *uuid.UUID) *JobEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (_c *JobEventCreate) SetJob(v *Job) *JobEventCreate {
+	return _c.SetJobID(v.ID)
+}
+
+// Mutation returns the JobEventMutation object of the builder.
+func (_c *JobEventCreate) Mutation() *JobEventMutation {
+	return _c.mutation
+}
+
+// Save creates the JobEvent in the database.
+func (_c *JobEventCreate) Save(ctx context.Context) (*JobEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobEventCreate) SaveX(ctx context.Context) *JobEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobEventCreate) defaults() {
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := jobevent.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.Output(); !ok {
+		v := jobevent.DefaultOutput
+		_c.mutation.SetOutput(v)
+	}
+	if _, ok := _c.mutation.OutputTruncated(); !ok {
+		v := jobevent.DefaultOutputTruncated
+		_c.mutation.SetOutputTruncated(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := jobevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := jobevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobEventCreate) check() error {
+	if _, ok := _c.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "JobEvent.job_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "JobEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := jobevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "JobEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Command(); !ok {
+		return &ValidationError{Name: "command", err: errors.New(`ent: missing required field "JobEvent.command"`)}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "JobEvent.duration_ms"`)}
+	}
+	if _, ok := _c.mutation.Output(); !ok {
+		return &ValidationError{Name: "output", err: errors.New(`ent: missing required field "JobEvent.output"`)}
+	}
+	if _, ok := _c.mutation.OutputTruncated(); !ok {
+		return &ValidationError{Name: "output_truncated", err: errors.New(`ent: missing required field "JobEvent.output_truncated"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JobEvent.created_at"`)}
+	}
+	if len(_c.mutation.JobIDs()) == 0 {
+		return &ValidationError{Name: "job", err: errors.New(`ent: missing required edge "JobEvent.job"`)}
+	}
+	return nil
+}
+
+func (_c *JobEventCreate) sqlSave(ctx context.Context) (*JobEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobEventCreate) createSpec() (*JobEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(jobevent.Table, sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(jobevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Command(); ok {
+		_spec.SetField(jobevent.FieldCommand, field.TypeString, value)
+		_node.Command = value
+	}
+	if value, ok := _c.mutation.Args(); ok {
+		_spec.SetField(jobevent.FieldArgs, field.TypeJSON, value)
+		_node.Args = value
+	}
+	if value, ok := _c.mutation.ExitCode(); ok {
+		_spec.SetField(jobevent.FieldExitCode, field.TypeInt, value)
+		_node.ExitCode = &value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(jobevent.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.Output(); ok {
+		_spec.SetField(jobevent.FieldOutput, field.TypeString, value)
+		_node.Output = value
+	}
+	if value, ok := _c.mutation.OutputTruncated(); ok {
+		_spec.SetField(jobevent.FieldOutputTruncated, field.TypeBool, value)
+		_node.OutputTruncated = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(jobevent.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(jobevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobevent.JobTable,
+			Columns: []string{jobevent.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JobID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JobEventCreateBulk is the builder for creating many JobEvent entities in bulk.
+type JobEventCreateBulk struct {
+	config
+	err      error
+	builders []*JobEventCreate
+}
+
+// Save creates the JobEvent entities in the database.
+func (_c *JobEventCreateBulk) Save(ctx context.Context) ([]*JobEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JobEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobEventCreateBulk) SaveX(ctx context.Context) []*JobEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
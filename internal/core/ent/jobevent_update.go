@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// JobEventUpdate is the builder for updating JobEvent entities.
+type JobEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobEventMutation
+}
+
+// Where appends a list predicates to the JobEventUpdate builder.
+func (_u *JobEventUpdate) Where(ps ...predicate.JobEvent) *JobEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *JobEventUpdate) SetJobID(v uuid.UUID) *JobEventUpdate {
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableJobID(v *uuid.UUID) *JobEventUpdate {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *JobEventUpdate) SetType(v model.JobEventType) *JobEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableType(v *model.JobEventType) *JobEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetCommand sets the "command" field.
+func (_u *JobEventUpdate) SetCommand(v string) *JobEventUpdate {
+	_u.mutation.SetCommand(v)
+	return _u
+}
+
+// SetNillableCommand sets the "command" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableCommand(v *string) *JobEventUpdate {
+	if v != nil {
+		_u.SetCommand(*v)
+	}
+	return _u
+}
+
+// SetArgs sets the "args" field.
+func (_u *JobEventUpdate) SetArgs(v []string) *JobEventUpdate {
+	_u.mutation.SetArgs(v)
+	return _u
+}
+
+// AppendArgs appends value to the "args" field.
+func (_u *JobEventUpdate) AppendArgs(v []string) *JobEventUpdate {
+	_u.mutation.AppendArgs(v)
+	return _u
+}
+
+// ClearArgs clears the value of the "args" field.
+func (_u *JobEventUpdate) ClearArgs() *JobEventUpdate {
+	_u.mutation.ClearArgs()
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *JobEventUpdate) SetExitCode(v int) *JobEventUpdate {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableExitCode(v *int) *JobEventUpdate {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *JobEventUpdate) AddExitCode(v int) *JobEventUpdate {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *JobEventUpdate) ClearExitCode() *JobEventUpdate {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *JobEventUpdate) SetDurationMs(v int64) *JobEventUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableDurationMs(v *int64) *JobEventUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *JobEventUpdate) AddDurationMs(v int64) *JobEventUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetOutput sets the "output" field.
+func (_u *JobEventUpdate) SetOutput(v string) *JobEventUpdate {
+	_u.mutation.SetOutput(v)
+	return _u
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableOutput(v *string) *JobEventUpdate {
+	if v != nil {
+		_u.SetOutput(*v)
+	}
+	return _u
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (_u *JobEventUpdate) SetOutputTruncated(v bool) *JobEventUpdate {
+	_u.mutation.SetOutputTruncated(v)
+	return _u
+}
+
+// SetNillableOutputTruncated sets the "output_truncated" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableOutputTruncated(v *bool) *JobEventUpdate {
+	if v != nil {
+		_u.SetOutputTruncated(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobEventUpdate) SetError(v string) *JobEventUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableError(v *string) *JobEventUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobEventUpdate) ClearError() *JobEventUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *JobEventUpdate) SetCreatedAt(v time.Time) *JobEventUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *JobEventUpdate) SetNillableCreatedAt(v *time.Time) *JobEventUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (_u *JobEventUpdate) SetJob(v *Job) *JobEventUpdate {
+	return _u.SetJobID(v.ID)
+}
+
+// Mutation returns the JobEventMutation object of the builder.
+func (_u *JobEventUpdate) Mutation() *JobEventMutation {
+	return _u.mutation
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (_u *JobEventUpdate) ClearJob() *JobEventUpdate {
+	_u.mutation.ClearJob()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobEventUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := jobevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "JobEvent.type": %w`, err)}
+		}
+	}
+	if _u.mutation.JobCleared() && len(_u.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JobEvent.job"`)
+	}
+	return nil
+}
+
+func (_u *JobEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobevent.Table, jobevent.Columns, sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(jobevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Command(); ok {
+		_spec.SetField(jobevent.FieldCommand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Args(); ok {
+		_spec.SetField(jobevent.FieldArgs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedArgs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, jobevent.FieldArgs, value)
+		})
+	}
+	if _u.mutation.ArgsCleared() {
+		_spec.ClearField(jobevent.FieldArgs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(jobevent.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(jobevent.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.ExitCodeCleared() {
+		_spec.ClearField(jobevent.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(jobevent.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobevent.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Output(); ok {
+		_spec.SetField(jobevent.FieldOutput, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OutputTruncated(); ok {
+		_spec.SetField(jobevent.FieldOutputTruncated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(jobevent.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(jobevent.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(jobevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobevent.JobTable,
+			Columns: []string{jobevent.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobevent.JobTable,
+			Columns: []string{jobevent.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobEventUpdateOne is the builder for updating a single JobEvent entity.
+type JobEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobEventMutation
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *JobEventUpdateOne) SetJobID(v uuid.UUID) *JobEventUpdateOne {
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableJobID(v *uuid.UUID) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *JobEventUpdateOne) SetType(v model.JobEventType) *JobEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableType(v *model.JobEventType) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetCommand sets the "command" field.
+func (_u *JobEventUpdateOne) SetCommand(v string) *JobEventUpdateOne {
+	_u.mutation.SetCommand(v)
+	return _u
+}
+
+// SetNillableCommand sets the "command" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableCommand(v *string) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetCommand(*v)
+	}
+	return _u
+}
+
+// SetArgs sets the "args" field.
+func (_u *JobEventUpdateOne) SetArgs(v []string) *JobEventUpdateOne {
+	_u.mutation.SetArgs(v)
+	return _u
+}
+
+// AppendArgs appends value to the "args" field.
+func (_u *JobEventUpdateOne) AppendArgs(v []string) *JobEventUpdateOne {
+	_u.mutation.AppendArgs(v)
+	return _u
+}
+
+// ClearArgs clears the value of the "args" field.
+func (_u *JobEventUpdateOne) ClearArgs() *JobEventUpdateOne {
+	_u.mutation.ClearArgs()
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *JobEventUpdateOne) SetExitCode(v int) *JobEventUpdateOne {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableExitCode(v *int) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *JobEventUpdateOne) AddExitCode(v int) *JobEventUpdateOne {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *JobEventUpdateOne) ClearExitCode() *JobEventUpdateOne {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *JobEventUpdateOne) SetDurationMs(v int64) *JobEventUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableDurationMs(v *int64) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *JobEventUpdateOne) AddDurationMs(v int64) *JobEventUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetOutput sets the "output" field.
+func (_u *JobEventUpdateOne) SetOutput(v string) *JobEventUpdateOne {
+	_u.mutation.SetOutput(v)
+	return _u
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableOutput(v *string) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetOutput(*v)
+	}
+	return _u
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (_u *JobEventUpdateOne) SetOutputTruncated(v bool) *JobEventUpdateOne {
+	_u.mutation.SetOutputTruncated(v)
+	return _u
+}
+
+// SetNillableOutputTruncated sets the "output_truncated" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableOutputTruncated(v *bool) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetOutputTruncated(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobEventUpdateOne) SetError(v string) *JobEventUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableError(v *string) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobEventUpdateOne) ClearError() *JobEventUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *JobEventUpdateOne) SetCreatedAt(v time.Time) *JobEventUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *JobEventUpdateOne) SetNillableCreatedAt(v *time.Time) *JobEventUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (_u *JobEventUpdateOne) SetJob(v *Job) *JobEventUpdateOne {
+	return _u.SetJobID(v.ID)
+}
+
+// Mutation returns the JobEventMutation object of the builder.
+func (_u *JobEventUpdateOne) Mutation() *JobEventMutation {
+	return _u.mutation
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (_u *JobEventUpdateOne) ClearJob() *JobEventUpdateOne {
+	_u.mutation.ClearJob()
+	return _u
+}
+
+// Where appends a list predicates to the JobEventUpdate builder.
+func (_u *JobEventUpdateOne) Where(ps ...predicate.JobEvent) *JobEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobEventUpdateOne) Select(field string, fields ...string) *JobEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JobEvent entity.
+func (_u *JobEventUpdateOne) Save(ctx context.Context) (*JobEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobEventUpdateOne) SaveX(ctx context.Context) *JobEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobEventUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := jobevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "JobEvent.type": %w`, err)}
+		}
+	}
+	if _u.mutation.JobCleared() && len(_u.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JobEvent.job"`)
+	}
+	return nil
+}
+
+func (_u *JobEventUpdateOne) sqlSave(ctx context.Context) (_node *JobEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobevent.Table, jobevent.Columns, sqlgraph.NewFieldSpec(jobevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobevent.FieldID)
+		for _, f := range fields {
+			if !jobevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(jobevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Command(); ok {
+		_spec.SetField(jobevent.FieldCommand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Args(); ok {
+		_spec.SetField(jobevent.FieldArgs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedArgs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, jobevent.FieldArgs, value)
+		})
+	}
+	if _u.mutation.ArgsCleared() {
+		_spec.ClearField(jobevent.FieldArgs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(jobevent.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(jobevent.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.ExitCodeCleared() {
+		_spec.ClearField(jobevent.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(jobevent.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobevent.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Output(); ok {
+		_spec.SetField(jobevent.FieldOutput, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OutputTruncated(); ok {
+		_spec.SetField(jobevent.FieldOutputTruncated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(jobevent.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(jobevent.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(jobevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobevent.JobTable,
+			Columns: []string{jobevent.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobevent.JobTable,
+			Columns: []string{jobevent.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &JobEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -28,6 +28,8 @@ type JobLog struct {
 	Time time.Time `json:"time,omitempty"`
 	// Path holds the value of the "path" field.
 	Path string `json:"path,omitempty"`
+	// Previous path of a renamed file, only set for RENAME logs
+	PreviousPath *string `json:"previous_path,omitempty"`
 	// What holds the value of the "what" field.
 	What model.LogAction `json:"what,omitempty"`
 	// Size holds the value of the "size" field.
@@ -65,7 +67,7 @@ func (*JobLog) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case joblog.FieldID, joblog.FieldSize:
 			values[i] = new(sql.NullInt64)
-		case joblog.FieldLevel, joblog.FieldPath, joblog.FieldWhat:
+		case joblog.FieldLevel, joblog.FieldPath, joblog.FieldPreviousPath, joblog.FieldWhat:
 			values[i] = new(sql.NullString)
 		case joblog.FieldTime:
 			values[i] = new(sql.NullTime)
@@ -116,6 +118,13 @@ func (_m *JobLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Path = value.String
 			}
+		case joblog.FieldPreviousPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_path", values[i])
+			} else if value.Valid {
+				_m.PreviousPath = new(string)
+				*_m.PreviousPath = value.String
+			}
 		case joblog.FieldWhat:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field what", values[i])
@@ -181,6 +190,11 @@ func (_m *JobLog) String() string {
 	builder.WriteString("path=")
 	builder.WriteString(_m.Path)
 	builder.WriteString(", ")
+	if v := _m.PreviousPath; v != nil {
+		builder.WriteString("previous_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("what=")
 	builder.WriteString(fmt.Sprintf("%v", _m.What))
 	builder.WriteString(", ")
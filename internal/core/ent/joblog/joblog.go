@@ -24,6 +24,8 @@ const (
 	FieldTime = "time"
 	// FieldPath holds the string denoting the path field in the database.
 	FieldPath = "path"
+	// FieldPreviousPath holds the string denoting the previous_path field in the database.
+	FieldPreviousPath = "previous_path"
 	// FieldWhat holds the string denoting the what field in the database.
 	FieldWhat = "what"
 	// FieldSize holds the string denoting the size field in the database.
@@ -48,6 +50,7 @@ var Columns = []string{
 	FieldLevel,
 	FieldTime,
 	FieldPath,
+	FieldPreviousPath,
 	FieldWhat,
 	FieldSize,
 }
@@ -70,7 +73,7 @@ var (
 // LevelValidator is a validator for the "level" field enum values. It is called by the builders before save.
 func LevelValidator(l model.LogLevel) error {
 	switch l.String() {
-	case "INFO", "WARNING", "ERROR":
+	case "DEBUG", "INFO", "WARNING", "ERROR":
 		return nil
 	default:
 		return fmt.Errorf("joblog: invalid enum value for level field: %q", l)
@@ -82,7 +85,7 @@ const DefaultWhat model.LogAction = "UNKNOWN"
 // WhatValidator is a validator for the "what" field enum values. It is called by the builders before save.
 func WhatValidator(w model.LogAction) error {
 	switch w.String() {
-	case "UPLOAD", "DOWNLOAD", "DELETE", "MOVE", "ERROR", "UNKNOWN":
+	case "UPLOAD", "DOWNLOAD", "DELETE", "MOVE", "RENAME", "SKIP", "METADATA", "CHECK", "LIST", "ERROR", "UNKNOWN":
 		return nil
 	default:
 		return fmt.Errorf("joblog: invalid enum value for what field: %q", w)
@@ -117,6 +120,11 @@ func ByPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPath, opts...).ToFunc()
 }
 
+// ByPreviousPath orders the results by the previous_path field.
+func ByPreviousPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousPath, opts...).ToFunc()
+}
+
 // ByWhat orders the results by the what field.
 func ByWhat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWhat, opts...).ToFunc()
@@ -72,6 +72,11 @@ func Path(v string) predicate.JobLog {
 	return predicate.JobLog(sql.FieldEQ(FieldPath, v))
 }
 
+// PreviousPath applies equality check predicate on the "previous_path" field. It's identical to PreviousPathEQ.
+func PreviousPath(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldEQ(FieldPreviousPath, v))
+}
+
 // Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
 func Size(v int64) predicate.JobLog {
 	return predicate.JobLog(sql.FieldEQ(FieldSize, v))
@@ -242,6 +247,81 @@ func PathContainsFold(v string) predicate.JobLog {
 	return predicate.JobLog(sql.FieldContainsFold(FieldPath, v))
 }
 
+// PreviousPathEQ applies the EQ predicate on the "previous_path" field.
+func PreviousPathEQ(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldEQ(FieldPreviousPath, v))
+}
+
+// PreviousPathNEQ applies the NEQ predicate on the "previous_path" field.
+func PreviousPathNEQ(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldNEQ(FieldPreviousPath, v))
+}
+
+// PreviousPathIn applies the In predicate on the "previous_path" field.
+func PreviousPathIn(vs ...string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldIn(FieldPreviousPath, vs...))
+}
+
+// PreviousPathNotIn applies the NotIn predicate on the "previous_path" field.
+func PreviousPathNotIn(vs ...string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldNotIn(FieldPreviousPath, vs...))
+}
+
+// PreviousPathGT applies the GT predicate on the "previous_path" field.
+func PreviousPathGT(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldGT(FieldPreviousPath, v))
+}
+
+// PreviousPathGTE applies the GTE predicate on the "previous_path" field.
+func PreviousPathGTE(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldGTE(FieldPreviousPath, v))
+}
+
+// PreviousPathLT applies the LT predicate on the "previous_path" field.
+func PreviousPathLT(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldLT(FieldPreviousPath, v))
+}
+
+// PreviousPathLTE applies the LTE predicate on the "previous_path" field.
+func PreviousPathLTE(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldLTE(FieldPreviousPath, v))
+}
+
+// PreviousPathContains applies the Contains predicate on the "previous_path" field.
+func PreviousPathContains(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldContains(FieldPreviousPath, v))
+}
+
+// PreviousPathHasPrefix applies the HasPrefix predicate on the "previous_path" field.
+func PreviousPathHasPrefix(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldHasPrefix(FieldPreviousPath, v))
+}
+
+// PreviousPathHasSuffix applies the HasSuffix predicate on the "previous_path" field.
+func PreviousPathHasSuffix(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldHasSuffix(FieldPreviousPath, v))
+}
+
+// PreviousPathIsNil applies the IsNil predicate on the "previous_path" field.
+func PreviousPathIsNil() predicate.JobLog {
+	return predicate.JobLog(sql.FieldIsNull(FieldPreviousPath))
+}
+
+// PreviousPathNotNil applies the NotNil predicate on the "previous_path" field.
+func PreviousPathNotNil() predicate.JobLog {
+	return predicate.JobLog(sql.FieldNotNull(FieldPreviousPath))
+}
+
+// PreviousPathEqualFold applies the EqualFold predicate on the "previous_path" field.
+func PreviousPathEqualFold(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldEqualFold(FieldPreviousPath, v))
+}
+
+// PreviousPathContainsFold applies the ContainsFold predicate on the "previous_path" field.
+func PreviousPathContainsFold(v string) predicate.JobLog {
+	return predicate.JobLog(sql.FieldContainsFold(FieldPreviousPath, v))
+}
+
 // WhatEQ applies the EQ predicate on the "what" field.
 func WhatEQ(v model.LogAction) predicate.JobLog {
 	vc := v
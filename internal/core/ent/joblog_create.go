@@ -63,6 +63,20 @@ func (_c *JobLogCreate) SetNillablePath(v *string) *JobLogCreate {
 	return _c
 }
 
+// SetPreviousPath sets the "previous_path" field.
+func (_c *JobLogCreate) SetPreviousPath(v string) *JobLogCreate {
+	_c.mutation.SetPreviousPath(v)
+	return _c
+}
+
+// SetNillablePreviousPath sets the "previous_path" field if the given value is not nil.
+func (_c *JobLogCreate) SetNillablePreviousPath(v *string) *JobLogCreate {
+	if v != nil {
+		_c.SetPreviousPath(*v)
+	}
+	return _c
+}
+
 // SetWhat sets the "what" field.
 func (_c *JobLogCreate) SetWhat(v model.LogAction) *JobLogCreate {
 	_c.mutation.SetWhat(v)
@@ -206,6 +220,10 @@ func (_c *JobLogCreate) createSpec() (*JobLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(joblog.FieldPath, field.TypeString, value)
 		_node.Path = value
 	}
+	if value, ok := _c.mutation.PreviousPath(); ok {
+		_spec.SetField(joblog.FieldPreviousPath, field.TypeString, value)
+		_node.PreviousPath = &value
+	}
 	if value, ok := _c.mutation.What(); ok {
 		_spec.SetField(joblog.FieldWhat, field.TypeEnum, value)
 		_node.What = value
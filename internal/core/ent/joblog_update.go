@@ -93,6 +93,26 @@ func (_u *JobLogUpdate) ClearPath() *JobLogUpdate {
 	return _u
 }
 
+// SetPreviousPath sets the "previous_path" field.
+func (_u *JobLogUpdate) SetPreviousPath(v string) *JobLogUpdate {
+	_u.mutation.SetPreviousPath(v)
+	return _u
+}
+
+// SetNillablePreviousPath sets the "previous_path" field if the given value is not nil.
+func (_u *JobLogUpdate) SetNillablePreviousPath(v *string) *JobLogUpdate {
+	if v != nil {
+		_u.SetPreviousPath(*v)
+	}
+	return _u
+}
+
+// ClearPreviousPath clears the value of the "previous_path" field.
+func (_u *JobLogUpdate) ClearPreviousPath() *JobLogUpdate {
+	_u.mutation.ClearPreviousPath()
+	return _u
+}
+
 // SetWhat sets the "what" field.
 func (_u *JobLogUpdate) SetWhat(v model.LogAction) *JobLogUpdate {
 	_u.mutation.SetWhat(v)
@@ -219,6 +239,12 @@ func (_u *JobLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PathCleared() {
 		_spec.ClearField(joblog.FieldPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.PreviousPath(); ok {
+		_spec.SetField(joblog.FieldPreviousPath, field.TypeString, value)
+	}
+	if _u.mutation.PreviousPathCleared() {
+		_spec.ClearField(joblog.FieldPreviousPath, field.TypeString)
+	}
 	if value, ok := _u.mutation.What(); ok {
 		_spec.SetField(joblog.FieldWhat, field.TypeEnum, value)
 	}
@@ -342,6 +368,26 @@ func (_u *JobLogUpdateOne) ClearPath() *JobLogUpdateOne {
 	return _u
 }
 
+// SetPreviousPath sets the "previous_path" field.
+func (_u *JobLogUpdateOne) SetPreviousPath(v string) *JobLogUpdateOne {
+	_u.mutation.SetPreviousPath(v)
+	return _u
+}
+
+// SetNillablePreviousPath sets the "previous_path" field if the given value is not nil.
+func (_u *JobLogUpdateOne) SetNillablePreviousPath(v *string) *JobLogUpdateOne {
+	if v != nil {
+		_u.SetPreviousPath(*v)
+	}
+	return _u
+}
+
+// ClearPreviousPath clears the value of the "previous_path" field.
+func (_u *JobLogUpdateOne) ClearPreviousPath() *JobLogUpdateOne {
+	_u.mutation.ClearPreviousPath()
+	return _u
+}
+
 // SetWhat sets the "what" field.
 func (_u *JobLogUpdateOne) SetWhat(v model.LogAction) *JobLogUpdateOne {
 	_u.mutation.SetWhat(v)
@@ -498,6 +544,12 @@ func (_u *JobLogUpdateOne) sqlSave(ctx context.Context) (_node *JobLog, err erro
 	if _u.mutation.PathCleared() {
 		_spec.ClearField(joblog.FieldPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.PreviousPath(); ok {
+		_spec.SetField(joblog.FieldPreviousPath, field.TypeString, value)
+	}
+	if _u.mutation.PreviousPathCleared() {
+		_spec.ClearField(joblog.FieldPreviousPath, field.TypeString)
+	}
 	if value, ok := _u.mutation.What(); ok {
 		_spec.SetField(joblog.FieldWhat, field.TypeEnum, value)
 	}
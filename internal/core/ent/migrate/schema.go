@@ -12,8 +12,20 @@ var (
 	ConnectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString, Unique: true},
-		{Name: "type", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"alias", "archive", "azureblob", "azurefiles", "b2", "box", "cache", "chunker", "cloudinary", "combine", "compress", "crypt", "doi", "drive", "dropbox", "fichier", "filefabric", "filelu", "filescom", "ftp", "gcs", "gofile", "gphotos", "hasher", "hdfs", "hidrive", "http", "iclouddrive", "imagekit", "internetarchive", "jottacloud", "koofr", "linkbox", "local", "mailru", "mega", "memory", "netstorage", "onedrive", "oos", "opendrive", "pcloud", "pikpak", "pixeldrain", "premiumizeme", "protondrive", "putio", "qingstor", "quatrix", "s3", "seafile", "sftp", "sharefile", "sia", "smb", "storj", "sugarsync", "swift", "tardigrade", "ulozto", "union", "uptobox", "webdav", "yandex", "zoho"}},
 		{Name: "encrypted_config", Type: field.TypeBytes},
+		{Name: "health_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"HEALTHY", "UNHEALTHY"}},
+		{Name: "health_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "health_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "base_path", Type: field.TypeString, Nullable: true},
+		{Name: "path_tps_limit", Type: field.TypeFloat64, Nullable: true},
+		{Name: "path_tps_burst", Type: field.TypeInt, Nullable: true},
+		{Name: "display_name", Type: field.TypeString, Nullable: true},
+		{Name: "color", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "credentials_expire_at", Type: field.TypeTime, Nullable: true},
+		{Name: "monthly_transfer_cap", Type: field.TypeInt64, Nullable: true},
+		{Name: "config_version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -36,22 +48,128 @@ var (
 			{
 				Name:    "connection_created_at",
 				Unique:  false,
+				Columns: []*schema.Column{ConnectionsColumns[16]},
+			},
+			{
+				Name:    "connection_health_status",
+				Unique:  false,
 				Columns: []*schema.Column{ConnectionsColumns[4]},
 			},
 		},
 	}
+	// ConnectionTransfersColumns holds the columns for the "connection_transfers" table.
+	ConnectionTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "month", Type: field.TypeTime},
+		{Name: "bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "connection_id", Type: field.TypeUUID},
+	}
+	// ConnectionTransfersTable holds the schema information for the "connection_transfers" table.
+	ConnectionTransfersTable = &schema.Table{
+		Name:       "connection_transfers",
+		Columns:    ConnectionTransfersColumns,
+		PrimaryKey: []*schema.Column{ConnectionTransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "connection_transfers_connections_transfers",
+				Columns:    []*schema.Column{ConnectionTransfersColumns[3]},
+				RefColumns: []*schema.Column{ConnectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "connectiontransfer_connection_id_month",
+				Unique:  true,
+				Columns: []*schema.Column{ConnectionTransfersColumns[3], ConnectionTransfersColumns[1]},
+			},
+		},
+	}
+	// ConnectionUsagesColumns holds the columns for the "connection_usages" table.
+	ConnectionUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "used", Type: field.TypeInt64},
+		{Name: "total", Type: field.TypeInt64, Nullable: true},
+		{Name: "free", Type: field.TypeInt64, Nullable: true},
+		{Name: "connection_id", Type: field.TypeUUID},
+	}
+	// ConnectionUsagesTable holds the schema information for the "connection_usages" table.
+	ConnectionUsagesTable = &schema.Table{
+		Name:       "connection_usages",
+		Columns:    ConnectionUsagesColumns,
+		PrimaryKey: []*schema.Column{ConnectionUsagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "connection_usages_connections_usage",
+				Columns:    []*schema.Column{ConnectionUsagesColumns[5]},
+				RefColumns: []*schema.Column{ConnectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "connectionusage_connection_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{ConnectionUsagesColumns[5], ConnectionUsagesColumns[1]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "result_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_key_operation",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+			{
+				Name:    "idempotencykey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[5]},
+			},
+		},
+	}
 	// JobsColumns holds the columns for the "jobs" table.
 	JobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "RUNNING", "SUCCESS", "FAILED", "CANCELLED"}, Default: "PENDING"},
-		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"MANUAL", "SCHEDULE", "REALTIME"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "RUNNING", "WAITING_CONFIRMATION", "SUCCESS", "SUCCESS_WITH_WARNINGS", "FAILED", "FAILED_TIMEOUT", "CANCELLED", "QUOTA_DEFERRED"}, Default: "PENDING"},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"MANUAL", "SCHEDULE", "REALTIME", "RETRY"}},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime, Nullable: true},
 		{Name: "files_transferred", Type: field.TypeInt, Default: 0},
 		{Name: "bytes_transferred", Type: field.TypeInt64, Default: 0},
+		{Name: "uploaded_files", Type: field.TypeInt, Default: 0},
+		{Name: "uploaded_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "downloaded_files", Type: field.TypeInt, Default: 0},
+		{Name: "downloaded_bytes", Type: field.TypeInt64, Default: 0},
 		{Name: "files_deleted", Type: field.TypeInt, Default: 0},
+		{Name: "files_checked", Type: field.TypeInt, Default: 0},
+		{Name: "listings_done", Type: field.TypeInt, Default: 0},
 		{Name: "error_count", Type: field.TypeInt, Default: 0},
 		{Name: "errors", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "acknowledged", Type: field.TypeBool, Default: false},
+		{Name: "annotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trace_id", Type: field.TypeString, Nullable: true},
+		{Name: "connection_config_version", Type: field.TypeInt, Nullable: true},
+		{Name: "trigger_detail", Type: field.TypeJSON, Nullable: true},
+		{Name: "task_config_hash", Type: field.TypeString, Nullable: true},
+		{Name: "concurrency", Type: field.TypeJSON, Nullable: true},
+		{Name: "config_snapshot", Type: field.TypeJSON, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "task_id", Type: field.TypeUUID},
 	}
 	// JobsTable holds the schema information for the "jobs" table.
@@ -60,9 +178,15 @@ var (
 		Columns:    JobsColumns,
 		PrimaryKey: []*schema.Column{JobsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_jobs_children",
+				Columns:    []*schema.Column{JobsColumns[25]},
+				RefColumns: []*schema.Column{JobsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "jobs_tasks_jobs",
-				Columns:    []*schema.Column{JobsColumns[10]},
+				Columns:    []*schema.Column{JobsColumns[26]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -71,27 +195,68 @@ var (
 			{
 				Name:    "job_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{JobsColumns[10]},
+				Columns: []*schema.Column{JobsColumns[26]},
 			},
 			{
 				Name:    "job_task_id_start_time",
 				Unique:  false,
-				Columns: []*schema.Column{JobsColumns[10], JobsColumns[3]},
+				Columns: []*schema.Column{JobsColumns[26], JobsColumns[3]},
 			},
 			{
 				Name:    "job_status",
 				Unique:  false,
 				Columns: []*schema.Column{JobsColumns[1]},
 			},
+			{
+				Name:    "job_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[25]},
+			},
+		},
+	}
+	// JobEventsColumns holds the columns for the "job_events" table.
+	JobEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"PRE_HOOK", "POST_HOOK"}},
+		{Name: "command", Type: field.TypeString, Size: 2147483647},
+		{Name: "args", Type: field.TypeJSON, Nullable: true},
+		{Name: "exit_code", Type: field.TypeInt, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "output", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "output_truncated", Type: field.TypeBool, Default: false},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "job_id", Type: field.TypeUUID},
+	}
+	// JobEventsTable holds the schema information for the "job_events" table.
+	JobEventsTable = &schema.Table{
+		Name:       "job_events",
+		Columns:    JobEventsColumns,
+		PrimaryKey: []*schema.Column{JobEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "job_events_jobs_events",
+				Columns:    []*schema.Column{JobEventsColumns[10]},
+				RefColumns: []*schema.Column{JobsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobevent_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobEventsColumns[10]},
+			},
 		},
 	}
 	// JobLogsColumns holds the columns for the "job_logs" table.
 	JobLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "level", Type: field.TypeEnum, Enums: []string{"INFO", "WARNING", "ERROR"}},
+		{Name: "level", Type: field.TypeEnum, Enums: []string{"DEBUG", "INFO", "WARNING", "ERROR"}},
 		{Name: "time", Type: field.TypeTime},
 		{Name: "path", Type: field.TypeString, Nullable: true},
-		{Name: "what", Type: field.TypeEnum, Enums: []string{"UPLOAD", "DOWNLOAD", "DELETE", "MOVE", "ERROR", "UNKNOWN"}, Default: "UNKNOWN"},
+		{Name: "previous_path", Type: field.TypeString, Nullable: true},
+		{Name: "what", Type: field.TypeEnum, Enums: []string{"UPLOAD", "DOWNLOAD", "DELETE", "MOVE", "RENAME", "SKIP", "METADATA", "CHECK", "LIST", "ERROR", "UNKNOWN"}, Default: "UNKNOWN"},
 		{Name: "size", Type: field.TypeInt64, Nullable: true},
 		{Name: "job_id", Type: field.TypeUUID},
 	}
@@ -103,7 +268,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "job_logs_jobs_logs",
-				Columns:    []*schema.Column{JobLogsColumns[6]},
+				Columns:    []*schema.Column{JobLogsColumns[7]},
 				RefColumns: []*schema.Column{JobsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -112,12 +277,97 @@ var (
 			{
 				Name:    "joblog_job_id",
 				Unique:  false,
-				Columns: []*schema.Column{JobLogsColumns[6]},
+				Columns: []*schema.Column{JobLogsColumns[7]},
 			},
 			{
 				Name:    "joblog_job_id_time",
 				Unique:  false,
-				Columns: []*schema.Column{JobLogsColumns[6], JobLogsColumns[2]},
+				Columns: []*schema.Column{JobLogsColumns[7], JobLogsColumns[2]},
+			},
+		},
+	}
+	// RetryQueuesColumns holds the columns for the "retry_queues" table.
+	RetryQueuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "task_id", Type: field.TypeUUID},
+		{Name: "path", Type: field.TypeString, Size: 2147483647},
+		{Name: "direction", Type: field.TypeEnum, Enums: []string{"UPLOAD", "DOWNLOAD", "BIDIRECTIONAL"}},
+		{Name: "error_class", Type: field.TypeEnum, Enums: []string{"NOT_FOUND", "PERMISSION_DENIED", "NO_SPACE", "RATE_LIMITED", "NETWORK", "CORRUPTED", "OTHER"}},
+		{Name: "error", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "retry_job_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "job_id", Type: field.TypeUUID},
+	}
+	// RetryQueuesTable holds the schema information for the "retry_queues" table.
+	RetryQueuesTable = &schema.Table{
+		Name:       "retry_queues",
+		Columns:    RetryQueuesColumns,
+		PrimaryKey: []*schema.Column{RetryQueuesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "retry_queues_jobs_retry_queue",
+				Columns:    []*schema.Column{RetryQueuesColumns[9]},
+				RefColumns: []*schema.Column{JobsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "retryqueue_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{RetryQueuesColumns[9]},
+			},
+			{
+				Name:    "retryqueue_task_id_retry_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{RetryQueuesColumns[1], RetryQueuesColumns[8]},
+			},
+		},
+	}
+	// ShareTokensColumns holds the columns for the "share_tokens" table.
+	ShareTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"TASK", "DOWNLOAD"}},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "use_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "connection_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ShareTokensTable holds the schema information for the "share_tokens" table.
+	ShareTokensTable = &schema.Table{
+		Name:       "share_tokens",
+		Columns:    ShareTokensColumns,
+		PrimaryKey: []*schema.Column{ShareTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_tokens_connections_share_tokens",
+				Columns:    []*schema.Column{ShareTokensColumns[9]},
+				RefColumns: []*schema.Column{ConnectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "share_tokens_tasks_share_tokens",
+				Columns:    []*schema.Column{ShareTokensColumns[10]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharetoken_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{ShareTokensColumns[2]},
+			},
+			{
+				Name:    "sharetoken_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShareTokensColumns[8]},
 			},
 		},
 	}
@@ -131,8 +381,17 @@ var (
 		{Name: "schedule", Type: field.TypeString, Nullable: true},
 		{Name: "realtime", Type: field.TypeBool, Default: false},
 		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "engine", Type: field.TypeString, Default: "rclone"},
+		{Name: "skipped_runs", Type: field.TypeInt, Default: 0},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "consecutive_fatal_failures", Type: field.TypeInt, Default: 0},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "disabled_reason", Type: field.TypeString, Nullable: true},
+		{Name: "ephemeral", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "connection_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
@@ -143,7 +402,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_connections_tasks",
-				Columns:    []*schema.Column{TasksColumns[10]},
+				Columns:    []*schema.Column{TasksColumns[19]},
 				RefColumns: []*schema.Column{ConnectionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -152,26 +411,90 @@ var (
 			{
 				Name:    "task_connection_id",
 				Unique:  false,
-				Columns: []*schema.Column{TasksColumns[10]},
+				Columns: []*schema.Column{TasksColumns[19]},
 			},
 			{
 				Name:    "task_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TasksColumns[8]},
+				Columns: []*schema.Column{TasksColumns[16]},
+			},
+			{
+				Name:    "task_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[18]},
+			},
+			{
+				Name:    "task_name",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[1]},
+			},
+			{
+				Name:    "task_engine",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[9]},
+			},
+			{
+				Name:    "task_disabled_consecutive_failures",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[13], TasksColumns[11]},
+			},
+		},
+	}
+	// TaskEventsColumns holds the columns for the "task_events" table.
+	TaskEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"SCHEDULE_SKIPPED", "CONSECUTIVE_FAILURES", "QUOTA_DEFERRED", "TASK_UPDATED", "WATCH_LIMIT", "TASK_DISABLED", "DELETE_ANOMALY", "CONFIG_UNREADABLE"}},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeUUID},
+	}
+	// TaskEventsTable holds the schema information for the "task_events" table.
+	TaskEventsTable = &schema.Table{
+		Name:       "task_events",
+		Columns:    TaskEventsColumns,
+		PrimaryKey: []*schema.Column{TaskEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_events_tasks_events",
+				Columns:    []*schema.Column{TaskEventsColumns[4]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskevent_task_id_time",
+				Unique:  false,
+				Columns: []*schema.Column{TaskEventsColumns[4], TaskEventsColumns[3]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ConnectionsTable,
+		ConnectionTransfersTable,
+		ConnectionUsagesTable,
+		IdempotencyKeysTable,
 		JobsTable,
+		JobEventsTable,
 		JobLogsTable,
+		RetryQueuesTable,
+		ShareTokensTable,
 		TasksTable,
+		TaskEventsTable,
 	}
 )
 
 func init() {
-	JobsTable.ForeignKeys[0].RefTable = TasksTable
+	ConnectionTransfersTable.ForeignKeys[0].RefTable = ConnectionsTable
+	ConnectionUsagesTable.ForeignKeys[0].RefTable = ConnectionsTable
+	JobsTable.ForeignKeys[0].RefTable = JobsTable
+	JobsTable.ForeignKeys[1].RefTable = TasksTable
+	JobEventsTable.ForeignKeys[0].RefTable = JobsTable
 	JobLogsTable.ForeignKeys[0].RefTable = JobsTable
+	RetryQueuesTable.ForeignKeys[0].RefTable = JobsTable
+	ShareTokensTable.ForeignKeys[0].RefTable = ConnectionsTable
+	ShareTokensTable.ForeignKeys[1].RefTable = TasksTable
 	TasksTable.ForeignKeys[0].RefTable = ConnectionsTable
+	TaskEventsTable.ForeignKeys[0].RefTable = TasksTable
 }
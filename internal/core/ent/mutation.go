@@ -14,10 +14,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/idempotencykey"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 )
 
 const (
@@ -29,30 +36,62 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeConnection = "Connection"
-	TypeJob        = "Job"
-	TypeJobLog     = "JobLog"
-	TypeTask       = "Task"
+	TypeConnection         = "Connection"
+	TypeConnectionTransfer = "ConnectionTransfer"
+	TypeConnectionUsage    = "ConnectionUsage"
+	TypeIdempotencyKey     = "IdempotencyKey"
+	TypeJob                = "Job"
+	TypeJobEvent           = "JobEvent"
+	TypeJobLog             = "JobLog"
+	TypeRetryQueue         = "RetryQueue"
+	TypeShareToken         = "ShareToken"
+	TypeTask               = "Task"
+	TypeTaskEvent          = "TaskEvent"
 )
 
 // ConnectionMutation represents an operation that mutates the Connection nodes in the graph.
 type ConnectionMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	_type            *string
-	encrypted_config *[]byte
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	tasks            map[uuid.UUID]struct{}
-	removedtasks     map[uuid.UUID]struct{}
-	clearedtasks     bool
-	done             bool
-	oldValue         func(context.Context) (*Connection, error)
-	predicates       []predicate.Connection
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	name                    *string
+	_type                   *model.ConnectionType
+	encrypted_config        *[]byte
+	health_status           *model.ConnectionHealthStatus
+	health_checked_at       *time.Time
+	health_error            *string
+	base_path               *string
+	path_tps_limit          *float64
+	addpath_tps_limit       *float64
+	path_tps_burst          *int
+	addpath_tps_burst       *int
+	display_name            *string
+	color                   *string
+	icon                    *string
+	credentials_expire_at   *time.Time
+	monthly_transfer_cap    *int64
+	addmonthly_transfer_cap *int64
+	config_version          *int
+	addconfig_version       *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	tasks                   map[uuid.UUID]struct{}
+	removedtasks            map[uuid.UUID]struct{}
+	clearedtasks            bool
+	usage                   map[uuid.UUID]struct{}
+	removedusage            map[uuid.UUID]struct{}
+	clearedusage            bool
+	share_tokens            map[uuid.UUID]struct{}
+	removedshare_tokens     map[uuid.UUID]struct{}
+	clearedshare_tokens     bool
+	transfers               map[uuid.UUID]struct{}
+	removedtransfers        map[uuid.UUID]struct{}
+	clearedtransfers        bool
+	done                    bool
+	oldValue                func(context.Context) (*Connection, error)
+	predicates              []predicate.Connection
 }
 
 var _ ent.Mutation = (*ConnectionMutation)(nil)
@@ -196,12 +235,12 @@ func (m *ConnectionMutation) ResetName() {
 }
 
 // SetType sets the "type" field.
-func (m *ConnectionMutation) SetType(s string) {
-	m._type = &s
+func (m *ConnectionMutation) SetType(mt model.ConnectionType) {
+	m._type = &mt
 }
 
 // GetType returns the value of the "type" field in the mutation.
-func (m *ConnectionMutation) GetType() (r string, exists bool) {
+func (m *ConnectionMutation) GetType() (r model.ConnectionType, exists bool) {
 	v := m._type
 	if v == nil {
 		return
@@ -212,7 +251,7 @@ func (m *ConnectionMutation) GetType() (r string, exists bool) {
 // OldType returns the old "type" field's value of the Connection entity.
 // If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *ConnectionMutation) OldType(ctx context.Context) (v model.ConnectionType, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
@@ -267,1105 +306,961 @@ func (m *ConnectionMutation) ResetEncryptedConfig() {
 	m.encrypted_config = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ConnectionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetHealthStatus sets the "health_status" field.
+func (m *ConnectionMutation) SetHealthStatus(mhs model.ConnectionHealthStatus) {
+	m.health_status = &mhs
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConnectionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// HealthStatus returns the value of the "health_status" field in the mutation.
+func (m *ConnectionMutation) HealthStatus() (r model.ConnectionHealthStatus, exists bool) {
+	v := m.health_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Connection entity.
+// OldHealthStatus returns the old "health_status" field's value of the Connection entity.
 // If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConnectionMutation) OldHealthStatus(ctx context.Context) (v *model.ConnectionHealthStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldHealthStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldHealthStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldHealthStatus: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.HealthStatus, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConnectionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearHealthStatus clears the value of the "health_status" field.
+func (m *ConnectionMutation) ClearHealthStatus() {
+	m.health_status = nil
+	m.clearedFields[connection.FieldHealthStatus] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConnectionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// HealthStatusCleared returns if the "health_status" field was cleared in this mutation.
+func (m *ConnectionMutation) HealthStatusCleared() bool {
+	_, ok := m.clearedFields[connection.FieldHealthStatus]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConnectionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetHealthStatus resets all changes to the "health_status" field.
+func (m *ConnectionMutation) ResetHealthStatus() {
+	m.health_status = nil
+	delete(m.clearedFields, connection.FieldHealthStatus)
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (m *ConnectionMutation) SetHealthCheckedAt(t time.Time) {
+	m.health_checked_at = &t
+}
+
+// HealthCheckedAt returns the value of the "health_checked_at" field in the mutation.
+func (m *ConnectionMutation) HealthCheckedAt() (r time.Time, exists bool) {
+	v := m.health_checked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Connection entity.
+// OldHealthCheckedAt returns the old "health_checked_at" field's value of the Connection entity.
 // If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConnectionMutation) OldHealthCheckedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldHealthCheckedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldHealthCheckedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldHealthCheckedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.HealthCheckedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConnectionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (m *ConnectionMutation) ClearHealthCheckedAt() {
+	m.health_checked_at = nil
+	m.clearedFields[connection.FieldHealthCheckedAt] = struct{}{}
 }
 
-// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
-func (m *ConnectionMutation) AddTaskIDs(ids ...uuid.UUID) {
-	if m.tasks == nil {
-		m.tasks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
-	}
+// HealthCheckedAtCleared returns if the "health_checked_at" field was cleared in this mutation.
+func (m *ConnectionMutation) HealthCheckedAtCleared() bool {
+	_, ok := m.clearedFields[connection.FieldHealthCheckedAt]
+	return ok
 }
 
-// ClearTasks clears the "tasks" edge to the Task entity.
-func (m *ConnectionMutation) ClearTasks() {
-	m.clearedtasks = true
+// ResetHealthCheckedAt resets all changes to the "health_checked_at" field.
+func (m *ConnectionMutation) ResetHealthCheckedAt() {
+	m.health_checked_at = nil
+	delete(m.clearedFields, connection.FieldHealthCheckedAt)
 }
 
-// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
-func (m *ConnectionMutation) TasksCleared() bool {
-	return m.clearedtasks
+// SetHealthError sets the "health_error" field.
+func (m *ConnectionMutation) SetHealthError(s string) {
+	m.health_error = &s
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
-func (m *ConnectionMutation) RemoveTaskIDs(ids ...uuid.UUID) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+// HealthError returns the value of the "health_error" field in the mutation.
+func (m *ConnectionMutation) HealthError() (r string, exists bool) {
+	v := m.health_error
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
-func (m *ConnectionMutation) RemovedTasksIDs() (ids []uuid.UUID) {
-	for id := range m.removedtasks {
-		ids = append(ids, id)
+// OldHealthError returns the old "health_error" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldHealthError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHealthError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHealthError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHealthError: %w", err)
+	}
+	return oldValue.HealthError, nil
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *ConnectionMutation) TasksIDs() (ids []uuid.UUID) {
-	for id := range m.tasks {
-		ids = append(ids, id)
-	}
-	return
+// ClearHealthError clears the value of the "health_error" field.
+func (m *ConnectionMutation) ClearHealthError() {
+	m.health_error = nil
+	m.clearedFields[connection.FieldHealthError] = struct{}{}
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *ConnectionMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// HealthErrorCleared returns if the "health_error" field was cleared in this mutation.
+func (m *ConnectionMutation) HealthErrorCleared() bool {
+	_, ok := m.clearedFields[connection.FieldHealthError]
+	return ok
 }
 
-// Where appends a list predicates to the ConnectionMutation builder.
-func (m *ConnectionMutation) Where(ps ...predicate.Connection) {
-	m.predicates = append(m.predicates, ps...)
+// ResetHealthError resets all changes to the "health_error" field.
+func (m *ConnectionMutation) ResetHealthError() {
+	m.health_error = nil
+	delete(m.clearedFields, connection.FieldHealthError)
 }
 
-// WhereP appends storage-level predicates to the ConnectionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConnectionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Connection, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetBasePath sets the "base_path" field.
+func (m *ConnectionMutation) SetBasePath(s string) {
+	m.base_path = &s
+}
+
+// BasePath returns the value of the "base_path" field in the mutation.
+func (m *ConnectionMutation) BasePath() (r string, exists bool) {
+	v := m.base_path
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ConnectionMutation) Op() Op {
-	return m.op
+// OldBasePath returns the old "base_path" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldBasePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBasePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBasePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBasePath: %w", err)
+	}
+	return oldValue.BasePath, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ConnectionMutation) SetOp(op Op) {
-	m.op = op
+// ClearBasePath clears the value of the "base_path" field.
+func (m *ConnectionMutation) ClearBasePath() {
+	m.base_path = nil
+	m.clearedFields[connection.FieldBasePath] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Connection).
-func (m *ConnectionMutation) Type() string {
-	return m.typ
+// BasePathCleared returns if the "base_path" field was cleared in this mutation.
+func (m *ConnectionMutation) BasePathCleared() bool {
+	_, ok := m.clearedFields[connection.FieldBasePath]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ConnectionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, connection.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, connection.FieldType)
+// ResetBasePath resets all changes to the "base_path" field.
+func (m *ConnectionMutation) ResetBasePath() {
+	m.base_path = nil
+	delete(m.clearedFields, connection.FieldBasePath)
+}
+
+// SetPathTpsLimit sets the "path_tps_limit" field.
+func (m *ConnectionMutation) SetPathTpsLimit(f float64) {
+	m.path_tps_limit = &f
+	m.addpath_tps_limit = nil
+}
+
+// PathTpsLimit returns the value of the "path_tps_limit" field in the mutation.
+func (m *ConnectionMutation) PathTpsLimit() (r float64, exists bool) {
+	v := m.path_tps_limit
+	if v == nil {
+		return
 	}
-	if m.encrypted_config != nil {
-		fields = append(fields, connection.FieldEncryptedConfig)
+	return *v, true
+}
+
+// OldPathTpsLimit returns the old "path_tps_limit" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldPathTpsLimit(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPathTpsLimit is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, connection.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPathTpsLimit requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, connection.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPathTpsLimit: %w", err)
 	}
-	return fields
+	return oldValue.PathTpsLimit, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ConnectionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case connection.FieldName:
-		return m.Name()
-	case connection.FieldType:
-		return m.GetType()
-	case connection.FieldEncryptedConfig:
-		return m.EncryptedConfig()
-	case connection.FieldCreatedAt:
-		return m.CreatedAt()
-	case connection.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddPathTpsLimit adds f to the "path_tps_limit" field.
+func (m *ConnectionMutation) AddPathTpsLimit(f float64) {
+	if m.addpath_tps_limit != nil {
+		*m.addpath_tps_limit += f
+	} else {
+		m.addpath_tps_limit = &f
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ConnectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case connection.FieldName:
-		return m.OldName(ctx)
-	case connection.FieldType:
-		return m.OldType(ctx)
-	case connection.FieldEncryptedConfig:
-		return m.OldEncryptedConfig(ctx)
-	case connection.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case connection.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedPathTpsLimit returns the value that was added to the "path_tps_limit" field in this mutation.
+func (m *ConnectionMutation) AddedPathTpsLimit() (r float64, exists bool) {
+	v := m.addpath_tps_limit
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Connection field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConnectionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case connection.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case connection.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case connection.FieldEncryptedConfig:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEncryptedConfig(v)
-		return nil
-	case connection.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case connection.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Connection field %s", name)
+// ClearPathTpsLimit clears the value of the "path_tps_limit" field.
+func (m *ConnectionMutation) ClearPathTpsLimit() {
+	m.path_tps_limit = nil
+	m.addpath_tps_limit = nil
+	m.clearedFields[connection.FieldPathTpsLimit] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ConnectionMutation) AddedFields() []string {
-	return nil
+// PathTpsLimitCleared returns if the "path_tps_limit" field was cleared in this mutation.
+func (m *ConnectionMutation) PathTpsLimitCleared() bool {
+	_, ok := m.clearedFields[connection.FieldPathTpsLimit]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ConnectionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetPathTpsLimit resets all changes to the "path_tps_limit" field.
+func (m *ConnectionMutation) ResetPathTpsLimit() {
+	m.path_tps_limit = nil
+	m.addpath_tps_limit = nil
+	delete(m.clearedFields, connection.FieldPathTpsLimit)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConnectionMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Connection numeric field %s", name)
+// SetPathTpsBurst sets the "path_tps_burst" field.
+func (m *ConnectionMutation) SetPathTpsBurst(i int) {
+	m.path_tps_burst = &i
+	m.addpath_tps_burst = nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ConnectionMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ConnectionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ConnectionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Connection nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ConnectionMutation) ResetField(name string) error {
-	switch name {
-	case connection.FieldName:
-		m.ResetName()
-		return nil
-	case connection.FieldType:
-		m.ResetType()
-		return nil
-	case connection.FieldEncryptedConfig:
-		m.ResetEncryptedConfig()
-		return nil
-	case connection.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case connection.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// PathTpsBurst returns the value of the "path_tps_burst" field in the mutation.
+func (m *ConnectionMutation) PathTpsBurst() (r int, exists bool) {
+	v := m.path_tps_burst
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Connection field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConnectionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.tasks != nil {
-		edges = append(edges, connection.EdgeTasks)
+// OldPathTpsBurst returns the old "path_tps_burst" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldPathTpsBurst(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPathTpsBurst is only allowed on UpdateOne operations")
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ConnectionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case connection.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
-			ids = append(ids, id)
-		}
-		return ids
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPathTpsBurst requires an ID field in the mutation")
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConnectionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedtasks != nil {
-		edges = append(edges, connection.EdgeTasks)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPathTpsBurst: %w", err)
 	}
-	return edges
+	return oldValue.PathTpsBurst, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ConnectionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case connection.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
-			ids = append(ids, id)
-		}
-		return ids
+// AddPathTpsBurst adds i to the "path_tps_burst" field.
+func (m *ConnectionMutation) AddPathTpsBurst(i int) {
+	if m.addpath_tps_burst != nil {
+		*m.addpath_tps_burst += i
+	} else {
+		m.addpath_tps_burst = &i
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConnectionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtasks {
-		edges = append(edges, connection.EdgeTasks)
+// AddedPathTpsBurst returns the value that was added to the "path_tps_burst" field in this mutation.
+func (m *ConnectionMutation) AddedPathTpsBurst() (r int, exists bool) {
+	v := m.addpath_tps_burst
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ConnectionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case connection.EdgeTasks:
-		return m.clearedtasks
-	}
-	return false
+// ClearPathTpsBurst clears the value of the "path_tps_burst" field.
+func (m *ConnectionMutation) ClearPathTpsBurst() {
+	m.path_tps_burst = nil
+	m.addpath_tps_burst = nil
+	m.clearedFields[connection.FieldPathTpsBurst] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ConnectionMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Connection unique edge %s", name)
+// PathTpsBurstCleared returns if the "path_tps_burst" field was cleared in this mutation.
+func (m *ConnectionMutation) PathTpsBurstCleared() bool {
+	_, ok := m.clearedFields[connection.FieldPathTpsBurst]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ConnectionMutation) ResetEdge(name string) error {
-	switch name {
-	case connection.EdgeTasks:
-		m.ResetTasks()
-		return nil
-	}
-	return fmt.Errorf("unknown Connection edge %s", name)
+// ResetPathTpsBurst resets all changes to the "path_tps_burst" field.
+func (m *ConnectionMutation) ResetPathTpsBurst() {
+	m.path_tps_burst = nil
+	m.addpath_tps_burst = nil
+	delete(m.clearedFields, connection.FieldPathTpsBurst)
 }
 
-// JobMutation represents an operation that mutates the Job nodes in the graph.
-type JobMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	status               *model.JobStatus
-	trigger              *model.JobTrigger
-	start_time           *time.Time
-	end_time             *time.Time
-	files_transferred    *int
-	addfiles_transferred *int
-	bytes_transferred    *int64
-	addbytes_transferred *int64
-	files_deleted        *int
-	addfiles_deleted     *int
-	error_count          *int
-	adderror_count       *int
-	errors               *string
-	clearedFields        map[string]struct{}
-	task                 *uuid.UUID
-	clearedtask          bool
-	logs                 map[int]struct{}
-	removedlogs          map[int]struct{}
-	clearedlogs          bool
-	done                 bool
-	oldValue             func(context.Context) (*Job, error)
-	predicates           []predicate.Job
+// SetDisplayName sets the "display_name" field.
+func (m *ConnectionMutation) SetDisplayName(s string) {
+	m.display_name = &s
 }
 
-var _ ent.Mutation = (*JobMutation)(nil)
-
-// jobOption allows management of the mutation configuration using functional options.
-type jobOption func(*JobMutation)
-
-// newJobMutation creates new mutation for the Job entity.
-func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
-	m := &JobMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeJob,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *ConnectionMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withJobID sets the ID field of the mutation.
-func withJobID(id uuid.UUID) jobOption {
-	return func(m *JobMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Job
-		)
-		m.oldValue = func(ctx context.Context) (*Job, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Job.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldDisplayName returns the old "display_name" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConnectionMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
-}
-
-// withJob sets the old Job of the mutation.
-func withJob(node *Job) jobOption {
-	return func(m *JobMutation) {
-		m.oldValue = func(context.Context) (*Job, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m JobMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.DisplayName, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Job entities.
-func (m *JobMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *ConnectionMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[connection.FieldDisplayName] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *JobMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *ConnectionMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[connection.FieldDisplayName]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *JobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *ConnectionMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, connection.FieldDisplayName)
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *JobMutation) SetTaskID(u uuid.UUID) {
-	m.task = &u
+// SetColor sets the "color" field.
+func (m *ConnectionMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *JobMutation) TaskID() (r uuid.UUID, exists bool) {
-	v := m.task
+// Color returns the value of the "color" field in the mutation.
+func (m *ConnectionMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConnectionMutation) OldColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return oldValue.TaskID, nil
+	return oldValue.Color, nil
 }
 
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *JobMutation) ResetTaskID() {
-	m.task = nil
+// ClearColor clears the value of the "color" field.
+func (m *ConnectionMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[connection.FieldColor] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *JobMutation) SetStatus(ms model.JobStatus) {
-	m.status = &ms
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *ConnectionMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[connection.FieldColor]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *JobMutation) Status() (r model.JobStatus, exists bool) {
-	v := m.status
+// ResetColor resets all changes to the "color" field.
+func (m *ConnectionMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, connection.FieldColor)
+}
+
+// SetIcon sets the "icon" field.
+func (m *ConnectionMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *ConnectionMutation) Icon() (r string, exists bool) {
+	v := m.icon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldIcon returns the old "icon" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldStatus(ctx context.Context) (v model.JobStatus, err error) {
+func (m *ConnectionMutation) OldIcon(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Icon, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *JobMutation) ResetStatus() {
-	m.status = nil
+// ClearIcon clears the value of the "icon" field.
+func (m *ConnectionMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[connection.FieldIcon] = struct{}{}
 }
 
-// SetTrigger sets the "trigger" field.
-func (m *JobMutation) SetTrigger(mt model.JobTrigger) {
-	m.trigger = &mt
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *ConnectionMutation) IconCleared() bool {
+	_, ok := m.clearedFields[connection.FieldIcon]
+	return ok
 }
 
-// Trigger returns the value of the "trigger" field in the mutation.
-func (m *JobMutation) Trigger() (r model.JobTrigger, exists bool) {
-	v := m.trigger
+// ResetIcon resets all changes to the "icon" field.
+func (m *ConnectionMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, connection.FieldIcon)
+}
+
+// SetCredentialsExpireAt sets the "credentials_expire_at" field.
+func (m *ConnectionMutation) SetCredentialsExpireAt(t time.Time) {
+	m.credentials_expire_at = &t
+}
+
+// CredentialsExpireAt returns the value of the "credentials_expire_at" field in the mutation.
+func (m *ConnectionMutation) CredentialsExpireAt() (r time.Time, exists bool) {
+	v := m.credentials_expire_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrigger returns the old "trigger" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialsExpireAt returns the old "credentials_expire_at" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldTrigger(ctx context.Context) (v model.JobTrigger, err error) {
+func (m *ConnectionMutation) OldCredentialsExpireAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialsExpireAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrigger requires an ID field in the mutation")
+		return v, errors.New("OldCredentialsExpireAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialsExpireAt: %w", err)
 	}
-	return oldValue.Trigger, nil
+	return oldValue.CredentialsExpireAt, nil
 }
 
-// ResetTrigger resets all changes to the "trigger" field.
-func (m *JobMutation) ResetTrigger() {
-	m.trigger = nil
+// ClearCredentialsExpireAt clears the value of the "credentials_expire_at" field.
+func (m *ConnectionMutation) ClearCredentialsExpireAt() {
+	m.credentials_expire_at = nil
+	m.clearedFields[connection.FieldCredentialsExpireAt] = struct{}{}
 }
 
-// SetStartTime sets the "start_time" field.
-func (m *JobMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
+// CredentialsExpireAtCleared returns if the "credentials_expire_at" field was cleared in this mutation.
+func (m *ConnectionMutation) CredentialsExpireAtCleared() bool {
+	_, ok := m.clearedFields[connection.FieldCredentialsExpireAt]
+	return ok
 }
 
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *JobMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
+// ResetCredentialsExpireAt resets all changes to the "credentials_expire_at" field.
+func (m *ConnectionMutation) ResetCredentialsExpireAt() {
+	m.credentials_expire_at = nil
+	delete(m.clearedFields, connection.FieldCredentialsExpireAt)
+}
+
+// SetMonthlyTransferCap sets the "monthly_transfer_cap" field.
+func (m *ConnectionMutation) SetMonthlyTransferCap(i int64) {
+	m.monthly_transfer_cap = &i
+	m.addmonthly_transfer_cap = nil
+}
+
+// MonthlyTransferCap returns the value of the "monthly_transfer_cap" field in the mutation.
+func (m *ConnectionMutation) MonthlyTransferCap() (r int64, exists bool) {
+	v := m.monthly_transfer_cap
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "start_time" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldMonthlyTransferCap returns the old "monthly_transfer_cap" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+func (m *ConnectionMutation) OldMonthlyTransferCap(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonthlyTransferCap is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
+		return v, errors.New("OldMonthlyTransferCap requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonthlyTransferCap: %w", err)
 	}
-	return oldValue.StartTime, nil
-}
-
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *JobMutation) ResetStartTime() {
-	m.start_time = nil
+	return oldValue.MonthlyTransferCap, nil
 }
 
-// SetEndTime sets the "end_time" field.
-func (m *JobMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
+// AddMonthlyTransferCap adds i to the "monthly_transfer_cap" field.
+func (m *ConnectionMutation) AddMonthlyTransferCap(i int64) {
+	if m.addmonthly_transfer_cap != nil {
+		*m.addmonthly_transfer_cap += i
+	} else {
+		m.addmonthly_transfer_cap = &i
+	}
 }
 
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *JobMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
+// AddedMonthlyTransferCap returns the value that was added to the "monthly_transfer_cap" field in this mutation.
+func (m *ConnectionMutation) AddedMonthlyTransferCap() (r int64, exists bool) {
+	v := m.addmonthly_transfer_cap
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndTime returns the old "end_time" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
-	}
-	return oldValue.EndTime, nil
-}
-
-// ClearEndTime clears the value of the "end_time" field.
-func (m *JobMutation) ClearEndTime() {
-	m.end_time = nil
-	m.clearedFields[job.FieldEndTime] = struct{}{}
+// ClearMonthlyTransferCap clears the value of the "monthly_transfer_cap" field.
+func (m *ConnectionMutation) ClearMonthlyTransferCap() {
+	m.monthly_transfer_cap = nil
+	m.addmonthly_transfer_cap = nil
+	m.clearedFields[connection.FieldMonthlyTransferCap] = struct{}{}
 }
 
-// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
-func (m *JobMutation) EndTimeCleared() bool {
-	_, ok := m.clearedFields[job.FieldEndTime]
+// MonthlyTransferCapCleared returns if the "monthly_transfer_cap" field was cleared in this mutation.
+func (m *ConnectionMutation) MonthlyTransferCapCleared() bool {
+	_, ok := m.clearedFields[connection.FieldMonthlyTransferCap]
 	return ok
 }
 
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *JobMutation) ResetEndTime() {
-	m.end_time = nil
-	delete(m.clearedFields, job.FieldEndTime)
+// ResetMonthlyTransferCap resets all changes to the "monthly_transfer_cap" field.
+func (m *ConnectionMutation) ResetMonthlyTransferCap() {
+	m.monthly_transfer_cap = nil
+	m.addmonthly_transfer_cap = nil
+	delete(m.clearedFields, connection.FieldMonthlyTransferCap)
 }
 
-// SetFilesTransferred sets the "files_transferred" field.
-func (m *JobMutation) SetFilesTransferred(i int) {
-	m.files_transferred = &i
-	m.addfiles_transferred = nil
+// SetConfigVersion sets the "config_version" field.
+func (m *ConnectionMutation) SetConfigVersion(i int) {
+	m.config_version = &i
+	m.addconfig_version = nil
 }
 
-// FilesTransferred returns the value of the "files_transferred" field in the mutation.
-func (m *JobMutation) FilesTransferred() (r int, exists bool) {
-	v := m.files_transferred
+// ConfigVersion returns the value of the "config_version" field in the mutation.
+func (m *ConnectionMutation) ConfigVersion() (r int, exists bool) {
+	v := m.config_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilesTransferred returns the old "files_transferred" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldConfigVersion returns the old "config_version" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldFilesTransferred(ctx context.Context) (v int, err error) {
+func (m *ConnectionMutation) OldConfigVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilesTransferred is only allowed on UpdateOne operations")
+		return v, errors.New("OldConfigVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilesTransferred requires an ID field in the mutation")
+		return v, errors.New("OldConfigVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilesTransferred: %w", err)
+		return v, fmt.Errorf("querying old value for OldConfigVersion: %w", err)
 	}
-	return oldValue.FilesTransferred, nil
+	return oldValue.ConfigVersion, nil
 }
 
-// AddFilesTransferred adds i to the "files_transferred" field.
-func (m *JobMutation) AddFilesTransferred(i int) {
-	if m.addfiles_transferred != nil {
-		*m.addfiles_transferred += i
+// AddConfigVersion adds i to the "config_version" field.
+func (m *ConnectionMutation) AddConfigVersion(i int) {
+	if m.addconfig_version != nil {
+		*m.addconfig_version += i
 	} else {
-		m.addfiles_transferred = &i
+		m.addconfig_version = &i
 	}
 }
 
-// AddedFilesTransferred returns the value that was added to the "files_transferred" field in this mutation.
-func (m *JobMutation) AddedFilesTransferred() (r int, exists bool) {
-	v := m.addfiles_transferred
+// AddedConfigVersion returns the value that was added to the "config_version" field in this mutation.
+func (m *ConnectionMutation) AddedConfigVersion() (r int, exists bool) {
+	v := m.addconfig_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFilesTransferred resets all changes to the "files_transferred" field.
-func (m *JobMutation) ResetFilesTransferred() {
-	m.files_transferred = nil
-	m.addfiles_transferred = nil
+// ResetConfigVersion resets all changes to the "config_version" field.
+func (m *ConnectionMutation) ResetConfigVersion() {
+	m.config_version = nil
+	m.addconfig_version = nil
 }
 
-// SetBytesTransferred sets the "bytes_transferred" field.
-func (m *JobMutation) SetBytesTransferred(i int64) {
-	m.bytes_transferred = &i
-	m.addbytes_transferred = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *ConnectionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// BytesTransferred returns the value of the "bytes_transferred" field in the mutation.
-func (m *JobMutation) BytesTransferred() (r int64, exists bool) {
-	v := m.bytes_transferred
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConnectionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBytesTransferred returns the old "bytes_transferred" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldBytesTransferred(ctx context.Context) (v int64, err error) {
+func (m *ConnectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBytesTransferred is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBytesTransferred requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBytesTransferred: %w", err)
-	}
-	return oldValue.BytesTransferred, nil
-}
-
-// AddBytesTransferred adds i to the "bytes_transferred" field.
-func (m *JobMutation) AddBytesTransferred(i int64) {
-	if m.addbytes_transferred != nil {
-		*m.addbytes_transferred += i
-	} else {
-		m.addbytes_transferred = &i
-	}
-}
-
-// AddedBytesTransferred returns the value that was added to the "bytes_transferred" field in this mutation.
-func (m *JobMutation) AddedBytesTransferred() (r int64, exists bool) {
-	v := m.addbytes_transferred
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetBytesTransferred resets all changes to the "bytes_transferred" field.
-func (m *JobMutation) ResetBytesTransferred() {
-	m.bytes_transferred = nil
-	m.addbytes_transferred = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConnectionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFilesDeleted sets the "files_deleted" field.
-func (m *JobMutation) SetFilesDeleted(i int) {
-	m.files_deleted = &i
-	m.addfiles_deleted = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConnectionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// FilesDeleted returns the value of the "files_deleted" field in the mutation.
-func (m *JobMutation) FilesDeleted() (r int, exists bool) {
-	v := m.files_deleted
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConnectionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilesDeleted returns the old "files_deleted" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Connection entity.
+// If the Connection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldFilesDeleted(ctx context.Context) (v int, err error) {
+func (m *ConnectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilesDeleted is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilesDeleted requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilesDeleted: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.FilesDeleted, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddFilesDeleted adds i to the "files_deleted" field.
-func (m *JobMutation) AddFilesDeleted(i int) {
-	if m.addfiles_deleted != nil {
-		*m.addfiles_deleted += i
-	} else {
-		m.addfiles_deleted = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConnectionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedFilesDeleted returns the value that was added to the "files_deleted" field in this mutation.
-func (m *JobMutation) AddedFilesDeleted() (r int, exists bool) {
-	v := m.addfiles_deleted
-	if v == nil {
-		return
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *ConnectionMutation) AddTaskIDs(ids ...uuid.UUID) {
+	if m.tasks == nil {
+		m.tasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetFilesDeleted resets all changes to the "files_deleted" field.
-func (m *JobMutation) ResetFilesDeleted() {
-	m.files_deleted = nil
-	m.addfiles_deleted = nil
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *ConnectionMutation) ClearTasks() {
+	m.clearedtasks = true
 }
 
-// SetErrorCount sets the "error_count" field.
-func (m *JobMutation) SetErrorCount(i int) {
-	m.error_count = &i
-	m.adderror_count = nil
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *ConnectionMutation) TasksCleared() bool {
+	return m.clearedtasks
 }
 
-// ErrorCount returns the value of the "error_count" field in the mutation.
-func (m *JobMutation) ErrorCount() (r int, exists bool) {
-	v := m.error_count
-	if v == nil {
-		return
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *ConnectionMutation) RemoveTaskIDs(ids ...uuid.UUID) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldErrorCount returns the old "error_count" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldErrorCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCount requires an ID field in the mutation")
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *ConnectionMutation) RemovedTasksIDs() (ids []uuid.UUID) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCount: %w", err)
-	}
-	return oldValue.ErrorCount, nil
+	return
 }
 
-// AddErrorCount adds i to the "error_count" field.
-func (m *JobMutation) AddErrorCount(i int) {
-	if m.adderror_count != nil {
-		*m.adderror_count += i
-	} else {
-		m.adderror_count = &i
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *ConnectionMutation) TasksIDs() (ids []uuid.UUID) {
+	for id := range m.tasks {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedErrorCount returns the value that was added to the "error_count" field in this mutation.
-func (m *JobMutation) AddedErrorCount() (r int, exists bool) {
-	v := m.adderror_count
-	if v == nil {
-		return
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *ConnectionMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// AddUsageIDs adds the "usage" edge to the ConnectionUsage entity by ids.
+func (m *ConnectionMutation) AddUsageIDs(ids ...uuid.UUID) {
+	if m.usage == nil {
+		m.usage = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.usage[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetErrorCount resets all changes to the "error_count" field.
-func (m *JobMutation) ResetErrorCount() {
-	m.error_count = nil
-	m.adderror_count = nil
+// ClearUsage clears the "usage" edge to the ConnectionUsage entity.
+func (m *ConnectionMutation) ClearUsage() {
+	m.clearedusage = true
 }
 
-// SetErrors sets the "errors" field.
-func (m *JobMutation) SetErrors(s string) {
-	m.errors = &s
+// UsageCleared reports if the "usage" edge to the ConnectionUsage entity was cleared.
+func (m *ConnectionMutation) UsageCleared() bool {
+	return m.clearedusage
 }
 
-// Errors returns the value of the "errors" field in the mutation.
-func (m *JobMutation) Errors() (r string, exists bool) {
-	v := m.errors
-	if v == nil {
-		return
+// RemoveUsageIDs removes the "usage" edge to the ConnectionUsage entity by IDs.
+func (m *ConnectionMutation) RemoveUsageIDs(ids ...uuid.UUID) {
+	if m.removedusage == nil {
+		m.removedusage = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.usage, ids[i])
+		m.removedusage[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldErrors returns the old "errors" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldErrors(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrors requires an ID field in the mutation")
+// RemovedUsage returns the removed IDs of the "usage" edge to the ConnectionUsage entity.
+func (m *ConnectionMutation) RemovedUsageIDs() (ids []uuid.UUID) {
+	for id := range m.removedusage {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	return
+}
+
+// UsageIDs returns the "usage" edge IDs in the mutation.
+func (m *ConnectionMutation) UsageIDs() (ids []uuid.UUID) {
+	for id := range m.usage {
+		ids = append(ids, id)
 	}
-	return oldValue.Errors, nil
+	return
 }
 
-// ClearErrors clears the value of the "errors" field.
-func (m *JobMutation) ClearErrors() {
-	m.errors = nil
-	m.clearedFields[job.FieldErrors] = struct{}{}
+// ResetUsage resets all changes to the "usage" edge.
+func (m *ConnectionMutation) ResetUsage() {
+	m.usage = nil
+	m.clearedusage = false
+	m.removedusage = nil
 }
 
-// ErrorsCleared returns if the "errors" field was cleared in this mutation.
-func (m *JobMutation) ErrorsCleared() bool {
-	_, ok := m.clearedFields[job.FieldErrors]
-	return ok
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by ids.
+func (m *ConnectionMutation) AddShareTokenIDs(ids ...uuid.UUID) {
+	if m.share_tokens == nil {
+		m.share_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.share_tokens[ids[i]] = struct{}{}
+	}
 }
 
-// ResetErrors resets all changes to the "errors" field.
-func (m *JobMutation) ResetErrors() {
-	m.errors = nil
-	delete(m.clearedFields, job.FieldErrors)
+// ClearShareTokens clears the "share_tokens" edge to the ShareToken entity.
+func (m *ConnectionMutation) ClearShareTokens() {
+	m.clearedshare_tokens = true
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *JobMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[job.FieldTaskID] = struct{}{}
+// ShareTokensCleared reports if the "share_tokens" edge to the ShareToken entity was cleared.
+func (m *ConnectionMutation) ShareTokensCleared() bool {
+	return m.clearedshare_tokens
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *JobMutation) TaskCleared() bool {
-	return m.clearedtask
+// RemoveShareTokenIDs removes the "share_tokens" edge to the ShareToken entity by IDs.
+func (m *ConnectionMutation) RemoveShareTokenIDs(ids ...uuid.UUID) {
+	if m.removedshare_tokens == nil {
+		m.removedshare_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.share_tokens, ids[i])
+		m.removedshare_tokens[ids[i]] = struct{}{}
+	}
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *JobMutation) TaskIDs() (ids []uuid.UUID) {
-	if id := m.task; id != nil {
-		ids = append(ids, *id)
+// RemovedShareTokens returns the removed IDs of the "share_tokens" edge to the ShareToken entity.
+func (m *ConnectionMutation) RemovedShareTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedshare_tokens {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *JobMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ShareTokensIDs returns the "share_tokens" edge IDs in the mutation.
+func (m *ConnectionMutation) ShareTokensIDs() (ids []uuid.UUID) {
+	for id := range m.share_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddLogIDs adds the "logs" edge to the JobLog entity by ids.
-func (m *JobMutation) AddLogIDs(ids ...int) {
-	if m.logs == nil {
-		m.logs = make(map[int]struct{})
+// ResetShareTokens resets all changes to the "share_tokens" edge.
+func (m *ConnectionMutation) ResetShareTokens() {
+	m.share_tokens = nil
+	m.clearedshare_tokens = false
+	m.removedshare_tokens = nil
+}
+
+// AddTransferIDs adds the "transfers" edge to the ConnectionTransfer entity by ids.
+func (m *ConnectionMutation) AddTransferIDs(ids ...uuid.UUID) {
+	if m.transfers == nil {
+		m.transfers = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.logs[ids[i]] = struct{}{}
+		m.transfers[ids[i]] = struct{}{}
 	}
 }
 
-// ClearLogs clears the "logs" edge to the JobLog entity.
-func (m *JobMutation) ClearLogs() {
-	m.clearedlogs = true
+// ClearTransfers clears the "transfers" edge to the ConnectionTransfer entity.
+func (m *ConnectionMutation) ClearTransfers() {
+	m.clearedtransfers = true
 }
 
-// LogsCleared reports if the "logs" edge to the JobLog entity was cleared.
-func (m *JobMutation) LogsCleared() bool {
-	return m.clearedlogs
+// TransfersCleared reports if the "transfers" edge to the ConnectionTransfer entity was cleared.
+func (m *ConnectionMutation) TransfersCleared() bool {
+	return m.clearedtransfers
 }
 
-// RemoveLogIDs removes the "logs" edge to the JobLog entity by IDs.
-func (m *JobMutation) RemoveLogIDs(ids ...int) {
-	if m.removedlogs == nil {
-		m.removedlogs = make(map[int]struct{})
+// RemoveTransferIDs removes the "transfers" edge to the ConnectionTransfer entity by IDs.
+func (m *ConnectionMutation) RemoveTransferIDs(ids ...uuid.UUID) {
+	if m.removedtransfers == nil {
+		m.removedtransfers = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.logs, ids[i])
-		m.removedlogs[ids[i]] = struct{}{}
+		delete(m.transfers, ids[i])
+		m.removedtransfers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedLogs returns the removed IDs of the "logs" edge to the JobLog entity.
-func (m *JobMutation) RemovedLogsIDs() (ids []int) {
-	for id := range m.removedlogs {
+// RemovedTransfers returns the removed IDs of the "transfers" edge to the ConnectionTransfer entity.
+func (m *ConnectionMutation) RemovedTransfersIDs() (ids []uuid.UUID) {
+	for id := range m.removedtransfers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// LogsIDs returns the "logs" edge IDs in the mutation.
-func (m *JobMutation) LogsIDs() (ids []int) {
-	for id := range m.logs {
+// TransfersIDs returns the "transfers" edge IDs in the mutation.
+func (m *ConnectionMutation) TransfersIDs() (ids []uuid.UUID) {
+	for id := range m.transfers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLogs resets all changes to the "logs" edge.
-func (m *JobMutation) ResetLogs() {
-	m.logs = nil
-	m.clearedlogs = false
-	m.removedlogs = nil
+// ResetTransfers resets all changes to the "transfers" edge.
+func (m *ConnectionMutation) ResetTransfers() {
+	m.transfers = nil
+	m.clearedtransfers = false
+	m.removedtransfers = nil
 }
 
-// Where appends a list predicates to the JobMutation builder.
-func (m *JobMutation) Where(ps ...predicate.Job) {
+// Where appends a list predicates to the ConnectionMutation builder.
+func (m *ConnectionMutation) Where(ps ...predicate.Connection) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConnectionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Job, len(ps))
+func (m *ConnectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Connection, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1373,54 +1268,75 @@ func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JobMutation) Op() Op {
+func (m *ConnectionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JobMutation) SetOp(op Op) {
+func (m *ConnectionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Job).
-func (m *JobMutation) Type() string {
+// Type returns the node type of this mutation (Connection).
+func (m *ConnectionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JobMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.task != nil {
-		fields = append(fields, job.FieldTaskID)
+func (m *ConnectionMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.name != nil {
+		fields = append(fields, connection.FieldName)
 	}
-	if m.status != nil {
-		fields = append(fields, job.FieldStatus)
+	if m._type != nil {
+		fields = append(fields, connection.FieldType)
 	}
-	if m.trigger != nil {
-		fields = append(fields, job.FieldTrigger)
+	if m.encrypted_config != nil {
+		fields = append(fields, connection.FieldEncryptedConfig)
 	}
-	if m.start_time != nil {
-		fields = append(fields, job.FieldStartTime)
+	if m.health_status != nil {
+		fields = append(fields, connection.FieldHealthStatus)
 	}
-	if m.end_time != nil {
-		fields = append(fields, job.FieldEndTime)
+	if m.health_checked_at != nil {
+		fields = append(fields, connection.FieldHealthCheckedAt)
 	}
-	if m.files_transferred != nil {
-		fields = append(fields, job.FieldFilesTransferred)
+	if m.health_error != nil {
+		fields = append(fields, connection.FieldHealthError)
 	}
-	if m.bytes_transferred != nil {
-		fields = append(fields, job.FieldBytesTransferred)
+	if m.base_path != nil {
+		fields = append(fields, connection.FieldBasePath)
 	}
-	if m.files_deleted != nil {
-		fields = append(fields, job.FieldFilesDeleted)
+	if m.path_tps_limit != nil {
+		fields = append(fields, connection.FieldPathTpsLimit)
 	}
-	if m.error_count != nil {
-		fields = append(fields, job.FieldErrorCount)
+	if m.path_tps_burst != nil {
+		fields = append(fields, connection.FieldPathTpsBurst)
 	}
-	if m.errors != nil {
-		fields = append(fields, job.FieldErrors)
+	if m.display_name != nil {
+		fields = append(fields, connection.FieldDisplayName)
+	}
+	if m.color != nil {
+		fields = append(fields, connection.FieldColor)
+	}
+	if m.icon != nil {
+		fields = append(fields, connection.FieldIcon)
+	}
+	if m.credentials_expire_at != nil {
+		fields = append(fields, connection.FieldCredentialsExpireAt)
+	}
+	if m.monthly_transfer_cap != nil {
+		fields = append(fields, connection.FieldMonthlyTransferCap)
+	}
+	if m.config_version != nil {
+		fields = append(fields, connection.FieldConfigVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, connection.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, connection.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1428,28 +1344,42 @@ func (m *JobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JobMutation) Field(name string) (ent.Value, bool) {
+func (m *ConnectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldTaskID:
-		return m.TaskID()
-	case job.FieldStatus:
-		return m.Status()
-	case job.FieldTrigger:
-		return m.Trigger()
-	case job.FieldStartTime:
-		return m.StartTime()
-	case job.FieldEndTime:
-		return m.EndTime()
-	case job.FieldFilesTransferred:
-		return m.FilesTransferred()
-	case job.FieldBytesTransferred:
-		return m.BytesTransferred()
-	case job.FieldFilesDeleted:
-		return m.FilesDeleted()
-	case job.FieldErrorCount:
-		return m.ErrorCount()
-	case job.FieldErrors:
-		return m.Errors()
+	case connection.FieldName:
+		return m.Name()
+	case connection.FieldType:
+		return m.GetType()
+	case connection.FieldEncryptedConfig:
+		return m.EncryptedConfig()
+	case connection.FieldHealthStatus:
+		return m.HealthStatus()
+	case connection.FieldHealthCheckedAt:
+		return m.HealthCheckedAt()
+	case connection.FieldHealthError:
+		return m.HealthError()
+	case connection.FieldBasePath:
+		return m.BasePath()
+	case connection.FieldPathTpsLimit:
+		return m.PathTpsLimit()
+	case connection.FieldPathTpsBurst:
+		return m.PathTpsBurst()
+	case connection.FieldDisplayName:
+		return m.DisplayName()
+	case connection.FieldColor:
+		return m.Color()
+	case connection.FieldIcon:
+		return m.Icon()
+	case connection.FieldCredentialsExpireAt:
+		return m.CredentialsExpireAt()
+	case connection.FieldMonthlyTransferCap:
+		return m.MonthlyTransferCap()
+	case connection.FieldConfigVersion:
+		return m.ConfigVersion()
+	case connection.FieldCreatedAt:
+		return m.CreatedAt()
+	case connection.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1457,126 +1387,189 @@ func (m *JobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConnectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case job.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case job.FieldStatus:
-		return m.OldStatus(ctx)
-	case job.FieldTrigger:
-		return m.OldTrigger(ctx)
-	case job.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case job.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case job.FieldFilesTransferred:
-		return m.OldFilesTransferred(ctx)
-	case job.FieldBytesTransferred:
-		return m.OldBytesTransferred(ctx)
-	case job.FieldFilesDeleted:
-		return m.OldFilesDeleted(ctx)
-	case job.FieldErrorCount:
-		return m.OldErrorCount(ctx)
-	case job.FieldErrors:
-		return m.OldErrors(ctx)
+	case connection.FieldName:
+		return m.OldName(ctx)
+	case connection.FieldType:
+		return m.OldType(ctx)
+	case connection.FieldEncryptedConfig:
+		return m.OldEncryptedConfig(ctx)
+	case connection.FieldHealthStatus:
+		return m.OldHealthStatus(ctx)
+	case connection.FieldHealthCheckedAt:
+		return m.OldHealthCheckedAt(ctx)
+	case connection.FieldHealthError:
+		return m.OldHealthError(ctx)
+	case connection.FieldBasePath:
+		return m.OldBasePath(ctx)
+	case connection.FieldPathTpsLimit:
+		return m.OldPathTpsLimit(ctx)
+	case connection.FieldPathTpsBurst:
+		return m.OldPathTpsBurst(ctx)
+	case connection.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case connection.FieldColor:
+		return m.OldColor(ctx)
+	case connection.FieldIcon:
+		return m.OldIcon(ctx)
+	case connection.FieldCredentialsExpireAt:
+		return m.OldCredentialsExpireAt(ctx)
+	case connection.FieldMonthlyTransferCap:
+		return m.OldMonthlyTransferCap(ctx)
+	case connection.FieldConfigVersion:
+		return m.OldConfigVersion(ctx)
+	case connection.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case connection.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Job field %s", name)
+	return nil, fmt.Errorf("unknown Connection field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) SetField(name string, value ent.Value) error {
+func (m *ConnectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldTaskID:
-		v, ok := value.(uuid.UUID)
+	case connection.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTaskID(v)
+		m.SetName(v)
 		return nil
-	case job.FieldStatus:
-		v, ok := value.(model.JobStatus)
+	case connection.FieldType:
+		v, ok := value.(model.ConnectionType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetType(v)
 		return nil
-	case job.FieldTrigger:
-		v, ok := value.(model.JobTrigger)
+	case connection.FieldEncryptedConfig:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrigger(v)
+		m.SetEncryptedConfig(v)
 		return nil
-	case job.FieldStartTime:
-		v, ok := value.(time.Time)
+	case connection.FieldHealthStatus:
+		v, ok := value.(model.ConnectionHealthStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartTime(v)
+		m.SetHealthStatus(v)
 		return nil
-	case job.FieldEndTime:
+	case connection.FieldHealthCheckedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndTime(v)
+		m.SetHealthCheckedAt(v)
 		return nil
-	case job.FieldFilesTransferred:
+	case connection.FieldHealthError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHealthError(v)
+		return nil
+	case connection.FieldBasePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBasePath(v)
+		return nil
+	case connection.FieldPathTpsLimit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPathTpsLimit(v)
+		return nil
+	case connection.FieldPathTpsBurst:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilesTransferred(v)
+		m.SetPathTpsBurst(v)
 		return nil
-	case job.FieldBytesTransferred:
+	case connection.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case connection.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case connection.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case connection.FieldCredentialsExpireAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialsExpireAt(v)
+		return nil
+	case connection.FieldMonthlyTransferCap:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBytesTransferred(v)
+		m.SetMonthlyTransferCap(v)
 		return nil
-	case job.FieldFilesDeleted:
+	case connection.FieldConfigVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilesDeleted(v)
+		m.SetConfigVersion(v)
 		return nil
-	case job.FieldErrorCount:
-		v, ok := value.(int)
+	case connection.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorCount(v)
+		m.SetCreatedAt(v)
 		return nil
-	case job.FieldErrors:
-		v, ok := value.(string)
+	case connection.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrors(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown Connection field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JobMutation) AddedFields() []string {
+func (m *ConnectionMutation) AddedFields() []string {
 	var fields []string
-	if m.addfiles_transferred != nil {
-		fields = append(fields, job.FieldFilesTransferred)
+	if m.addpath_tps_limit != nil {
+		fields = append(fields, connection.FieldPathTpsLimit)
 	}
-	if m.addbytes_transferred != nil {
-		fields = append(fields, job.FieldBytesTransferred)
+	if m.addpath_tps_burst != nil {
+		fields = append(fields, connection.FieldPathTpsBurst)
 	}
-	if m.addfiles_deleted != nil {
-		fields = append(fields, job.FieldFilesDeleted)
+	if m.addmonthly_transfer_cap != nil {
+		fields = append(fields, connection.FieldMonthlyTransferCap)
 	}
-	if m.adderror_count != nil {
-		fields = append(fields, job.FieldErrorCount)
+	if m.addconfig_version != nil {
+		fields = append(fields, connection.FieldConfigVersion)
 	}
 	return fields
 }
@@ -1584,16 +1577,16 @@ func (m *JobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConnectionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldFilesTransferred:
-		return m.AddedFilesTransferred()
-	case job.FieldBytesTransferred:
-		return m.AddedBytesTransferred()
-	case job.FieldFilesDeleted:
-		return m.AddedFilesDeleted()
-	case job.FieldErrorCount:
-		return m.AddedErrorCount()
+	case connection.FieldPathTpsLimit:
+		return m.AddedPathTpsLimit()
+	case connection.FieldPathTpsBurst:
+		return m.AddedPathTpsBurst()
+	case connection.FieldMonthlyTransferCap:
+		return m.AddedMonthlyTransferCap()
+	case connection.FieldConfigVersion:
+		return m.AddedConfigVersion()
 	}
 	return nil, false
 }
@@ -1601,135 +1594,230 @@ func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) AddField(name string, value ent.Value) error {
+func (m *ConnectionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldFilesTransferred:
-		v, ok := value.(int)
+	case connection.FieldPathTpsLimit:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFilesTransferred(v)
+		m.AddPathTpsLimit(v)
 		return nil
-	case job.FieldBytesTransferred:
-		v, ok := value.(int64)
+	case connection.FieldPathTpsBurst:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBytesTransferred(v)
+		m.AddPathTpsBurst(v)
 		return nil
-	case job.FieldFilesDeleted:
-		v, ok := value.(int)
+	case connection.FieldMonthlyTransferCap:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFilesDeleted(v)
+		m.AddMonthlyTransferCap(v)
 		return nil
-	case job.FieldErrorCount:
+	case connection.FieldConfigVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddErrorCount(v)
+		m.AddConfigVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job numeric field %s", name)
+	return fmt.Errorf("unknown Connection numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JobMutation) ClearedFields() []string {
+func (m *ConnectionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(job.FieldEndTime) {
-		fields = append(fields, job.FieldEndTime)
+	if m.FieldCleared(connection.FieldHealthStatus) {
+		fields = append(fields, connection.FieldHealthStatus)
 	}
-	if m.FieldCleared(job.FieldErrors) {
-		fields = append(fields, job.FieldErrors)
+	if m.FieldCleared(connection.FieldHealthCheckedAt) {
+		fields = append(fields, connection.FieldHealthCheckedAt)
+	}
+	if m.FieldCleared(connection.FieldHealthError) {
+		fields = append(fields, connection.FieldHealthError)
+	}
+	if m.FieldCleared(connection.FieldBasePath) {
+		fields = append(fields, connection.FieldBasePath)
+	}
+	if m.FieldCleared(connection.FieldPathTpsLimit) {
+		fields = append(fields, connection.FieldPathTpsLimit)
+	}
+	if m.FieldCleared(connection.FieldPathTpsBurst) {
+		fields = append(fields, connection.FieldPathTpsBurst)
+	}
+	if m.FieldCleared(connection.FieldDisplayName) {
+		fields = append(fields, connection.FieldDisplayName)
+	}
+	if m.FieldCleared(connection.FieldColor) {
+		fields = append(fields, connection.FieldColor)
+	}
+	if m.FieldCleared(connection.FieldIcon) {
+		fields = append(fields, connection.FieldIcon)
+	}
+	if m.FieldCleared(connection.FieldCredentialsExpireAt) {
+		fields = append(fields, connection.FieldCredentialsExpireAt)
+	}
+	if m.FieldCleared(connection.FieldMonthlyTransferCap) {
+		fields = append(fields, connection.FieldMonthlyTransferCap)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JobMutation) FieldCleared(name string) bool {
+func (m *ConnectionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JobMutation) ClearField(name string) error {
+func (m *ConnectionMutation) ClearField(name string) error {
 	switch name {
-	case job.FieldEndTime:
-		m.ClearEndTime()
+	case connection.FieldHealthStatus:
+		m.ClearHealthStatus()
 		return nil
-	case job.FieldErrors:
-		m.ClearErrors()
+	case connection.FieldHealthCheckedAt:
+		m.ClearHealthCheckedAt()
+		return nil
+	case connection.FieldHealthError:
+		m.ClearHealthError()
+		return nil
+	case connection.FieldBasePath:
+		m.ClearBasePath()
+		return nil
+	case connection.FieldPathTpsLimit:
+		m.ClearPathTpsLimit()
+		return nil
+	case connection.FieldPathTpsBurst:
+		m.ClearPathTpsBurst()
+		return nil
+	case connection.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	case connection.FieldColor:
+		m.ClearColor()
+		return nil
+	case connection.FieldIcon:
+		m.ClearIcon()
+		return nil
+	case connection.FieldCredentialsExpireAt:
+		m.ClearCredentialsExpireAt()
+		return nil
+	case connection.FieldMonthlyTransferCap:
+		m.ClearMonthlyTransferCap()
 		return nil
 	}
-	return fmt.Errorf("unknown Job nullable field %s", name)
+	return fmt.Errorf("unknown Connection nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JobMutation) ResetField(name string) error {
+func (m *ConnectionMutation) ResetField(name string) error {
 	switch name {
-	case job.FieldTaskID:
-		m.ResetTaskID()
+	case connection.FieldName:
+		m.ResetName()
 		return nil
-	case job.FieldStatus:
-		m.ResetStatus()
+	case connection.FieldType:
+		m.ResetType()
 		return nil
-	case job.FieldTrigger:
-		m.ResetTrigger()
+	case connection.FieldEncryptedConfig:
+		m.ResetEncryptedConfig()
 		return nil
-	case job.FieldStartTime:
-		m.ResetStartTime()
+	case connection.FieldHealthStatus:
+		m.ResetHealthStatus()
 		return nil
-	case job.FieldEndTime:
-		m.ResetEndTime()
+	case connection.FieldHealthCheckedAt:
+		m.ResetHealthCheckedAt()
 		return nil
-	case job.FieldFilesTransferred:
-		m.ResetFilesTransferred()
+	case connection.FieldHealthError:
+		m.ResetHealthError()
 		return nil
-	case job.FieldBytesTransferred:
-		m.ResetBytesTransferred()
+	case connection.FieldBasePath:
+		m.ResetBasePath()
 		return nil
-	case job.FieldFilesDeleted:
-		m.ResetFilesDeleted()
+	case connection.FieldPathTpsLimit:
+		m.ResetPathTpsLimit()
 		return nil
-	case job.FieldErrorCount:
-		m.ResetErrorCount()
+	case connection.FieldPathTpsBurst:
+		m.ResetPathTpsBurst()
 		return nil
-	case job.FieldErrors:
-		m.ResetErrors()
+	case connection.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case connection.FieldColor:
+		m.ResetColor()
+		return nil
+	case connection.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case connection.FieldCredentialsExpireAt:
+		m.ResetCredentialsExpireAt()
+		return nil
+	case connection.FieldMonthlyTransferCap:
+		m.ResetMonthlyTransferCap()
+		return nil
+	case connection.FieldConfigVersion:
+		m.ResetConfigVersion()
+		return nil
+	case connection.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case connection.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown Connection field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.task != nil {
-		edges = append(edges, job.EdgeTask)
+func (m *ConnectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tasks != nil {
+		edges = append(edges, connection.EdgeTasks)
 	}
-	if m.logs != nil {
-		edges = append(edges, job.EdgeLogs)
+	if m.usage != nil {
+		edges = append(edges, connection.EdgeUsage)
+	}
+	if m.share_tokens != nil {
+		edges = append(edges, connection.EdgeShareTokens)
+	}
+	if m.transfers != nil {
+		edges = append(edges, connection.EdgeTransfers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JobMutation) AddedIDs(name string) []ent.Value {
+func (m *ConnectionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case job.EdgeTask:
-		if id := m.task; id != nil {
-			return []ent.Value{*id}
+	case connection.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
 		}
-	case job.EdgeLogs:
-		ids := make([]ent.Value, 0, len(m.logs))
-		for id := range m.logs {
+		return ids
+	case connection.EdgeUsage:
+		ids := make([]ent.Value, 0, len(m.usage))
+		for id := range m.usage {
+			ids = append(ids, id)
+		}
+		return ids
+	case connection.EdgeShareTokens:
+		ids := make([]ent.Value, 0, len(m.share_tokens))
+		for id := range m.share_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case connection.EdgeTransfers:
+		ids := make([]ent.Value, 0, len(m.transfers))
+		for id := range m.transfers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1738,21 +1826,48 @@ func (m *JobMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedlogs != nil {
-		edges = append(edges, job.EdgeLogs)
+func (m *ConnectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtasks != nil {
+		edges = append(edges, connection.EdgeTasks)
+	}
+	if m.removedusage != nil {
+		edges = append(edges, connection.EdgeUsage)
+	}
+	if m.removedshare_tokens != nil {
+		edges = append(edges, connection.EdgeShareTokens)
+	}
+	if m.removedtransfers != nil {
+		edges = append(edges, connection.EdgeTransfers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConnectionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case job.EdgeLogs:
-		ids := make([]ent.Value, 0, len(m.removedlogs))
-		for id := range m.removedlogs {
+	case connection.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case connection.EdgeUsage:
+		ids := make([]ent.Value, 0, len(m.removedusage))
+		for id := range m.removedusage {
+			ids = append(ids, id)
+		}
+		return ids
+	case connection.EdgeShareTokens:
+		ids := make([]ent.Value, 0, len(m.removedshare_tokens))
+		for id := range m.removedshare_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case connection.EdgeTransfers:
+		ids := make([]ent.Value, 0, len(m.removedtransfers))
+		for id := range m.removedtransfers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1761,85 +1876,95 @@ func (m *JobMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtask {
-		edges = append(edges, job.EdgeTask)
+func (m *ConnectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtasks {
+		edges = append(edges, connection.EdgeTasks)
 	}
-	if m.clearedlogs {
-		edges = append(edges, job.EdgeLogs)
+	if m.clearedusage {
+		edges = append(edges, connection.EdgeUsage)
+	}
+	if m.clearedshare_tokens {
+		edges = append(edges, connection.EdgeShareTokens)
+	}
+	if m.clearedtransfers {
+		edges = append(edges, connection.EdgeTransfers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JobMutation) EdgeCleared(name string) bool {
+func (m *ConnectionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case job.EdgeTask:
-		return m.clearedtask
-	case job.EdgeLogs:
-		return m.clearedlogs
+	case connection.EdgeTasks:
+		return m.clearedtasks
+	case connection.EdgeUsage:
+		return m.clearedusage
+	case connection.EdgeShareTokens:
+		return m.clearedshare_tokens
+	case connection.EdgeTransfers:
+		return m.clearedtransfers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JobMutation) ClearEdge(name string) error {
+func (m *ConnectionMutation) ClearEdge(name string) error {
 	switch name {
-	case job.EdgeTask:
-		m.ClearTask()
-		return nil
 	}
-	return fmt.Errorf("unknown Job unique edge %s", name)
+	return fmt.Errorf("unknown Connection unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JobMutation) ResetEdge(name string) error {
+func (m *ConnectionMutation) ResetEdge(name string) error {
 	switch name {
-	case job.EdgeTask:
-		m.ResetTask()
+	case connection.EdgeTasks:
+		m.ResetTasks()
 		return nil
-	case job.EdgeLogs:
-		m.ResetLogs()
+	case connection.EdgeUsage:
+		m.ResetUsage()
+		return nil
+	case connection.EdgeShareTokens:
+		m.ResetShareTokens()
+		return nil
+	case connection.EdgeTransfers:
+		m.ResetTransfers()
 		return nil
 	}
-	return fmt.Errorf("unknown Job edge %s", name)
+	return fmt.Errorf("unknown Connection edge %s", name)
 }
 
-// JobLogMutation represents an operation that mutates the JobLog nodes in the graph.
-type JobLogMutation struct {
+// ConnectionTransferMutation represents an operation that mutates the ConnectionTransfer nodes in the graph.
+type ConnectionTransferMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	level         *model.LogLevel
-	time          *time.Time
-	_path         *string
-	what          *model.LogAction
-	size          *int64
-	addsize       *int64
-	clearedFields map[string]struct{}
-	job           *uuid.UUID
-	clearedjob    bool
-	done          bool
-	oldValue      func(context.Context) (*JobLog, error)
-	predicates    []predicate.JobLog
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	month             *time.Time
+	bytes             *int64
+	addbytes          *int64
+	clearedFields     map[string]struct{}
+	connection        *uuid.UUID
+	clearedconnection bool
+	done              bool
+	oldValue          func(context.Context) (*ConnectionTransfer, error)
+	predicates        []predicate.ConnectionTransfer
 }
 
-var _ ent.Mutation = (*JobLogMutation)(nil)
+var _ ent.Mutation = (*ConnectionTransferMutation)(nil)
 
-// joblogOption allows management of the mutation configuration using functional options.
-type joblogOption func(*JobLogMutation)
+// connectiontransferOption allows management of the mutation configuration using functional options.
+type connectiontransferOption func(*ConnectionTransferMutation)
 
-// newJobLogMutation creates new mutation for the JobLog entity.
-func newJobLogMutation(c config, op Op, opts ...joblogOption) *JobLogMutation {
-	m := &JobLogMutation{
+// newConnectionTransferMutation creates new mutation for the ConnectionTransfer entity.
+func newConnectionTransferMutation(c config, op Op, opts ...connectiontransferOption) *ConnectionTransferMutation {
+	m := &ConnectionTransferMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJobLog,
+		typ:           TypeConnectionTransfer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1848,20 +1973,20 @@ func newJobLogMutation(c config, op Op, opts ...joblogOption) *JobLogMutation {
 	return m
 }
 
-// withJobLogID sets the ID field of the mutation.
-func withJobLogID(id int) joblogOption {
-	return func(m *JobLogMutation) {
+// withConnectionTransferID sets the ID field of the mutation.
+func withConnectionTransferID(id uuid.UUID) connectiontransferOption {
+	return func(m *ConnectionTransferMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *JobLog
+			value *ConnectionTransfer
 		)
-		m.oldValue = func(ctx context.Context) (*JobLog, error) {
+		m.oldValue = func(ctx context.Context) (*ConnectionTransfer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().JobLog.Get(ctx, id)
+					value, err = m.Client().ConnectionTransfer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1870,10 +1995,10 @@ func withJobLogID(id int) joblogOption {
 	}
 }
 
-// withJobLog sets the old JobLog of the mutation.
-func withJobLog(node *JobLog) joblogOption {
-	return func(m *JobLogMutation) {
-		m.oldValue = func(context.Context) (*JobLog, error) {
+// withConnectionTransfer sets the old ConnectionTransfer of the mutation.
+func withConnectionTransfer(node *ConnectionTransfer) connectiontransferOption {
+	return func(m *ConnectionTransferMutation) {
+		m.oldValue = func(context.Context) (*ConnectionTransfer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1882,7 +2007,7 @@ func withJobLog(node *JobLog) joblogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobLogMutation) Client() *Client {
+func (m ConnectionTransferMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1890,7 +2015,7 @@ func (m JobLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JobLogMutation) Tx() (*Tx, error) {
+func (m ConnectionTransferMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1899,9 +2024,15 @@ func (m JobLogMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConnectionTransfer entities.
+func (m *ConnectionTransferMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JobLogMutation) ID() (id int, exists bool) {
+func (m *ConnectionTransferMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1912,384 +2043,234 @@ func (m *JobLogMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JobLogMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConnectionTransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().JobLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConnectionTransfer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetJobID sets the "job_id" field.
-func (m *JobLogMutation) SetJobID(u uuid.UUID) {
-	m.job = &u
+// SetConnectionID sets the "connection_id" field.
+func (m *ConnectionTransferMutation) SetConnectionID(u uuid.UUID) {
+	m.connection = &u
 }
 
-// JobID returns the value of the "job_id" field in the mutation.
-func (m *JobLogMutation) JobID() (r uuid.UUID, exists bool) {
-	v := m.job
+// ConnectionID returns the value of the "connection_id" field in the mutation.
+func (m *ConnectionTransferMutation) ConnectionID() (r uuid.UUID, exists bool) {
+	v := m.connection
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobID returns the old "job_id" field's value of the JobLog entity.
-// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectionID returns the old "connection_id" field's value of the ConnectionTransfer entity.
+// If the ConnectionTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobLogMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConnectionTransferMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobID requires an ID field in the mutation")
+		return v, errors.New("OldConnectionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
 	}
-	return oldValue.JobID, nil
+	return oldValue.ConnectionID, nil
 }
 
-// ResetJobID resets all changes to the "job_id" field.
-func (m *JobLogMutation) ResetJobID() {
-	m.job = nil
+// ResetConnectionID resets all changes to the "connection_id" field.
+func (m *ConnectionTransferMutation) ResetConnectionID() {
+	m.connection = nil
 }
 
-// SetLevel sets the "level" field.
-func (m *JobLogMutation) SetLevel(ml model.LogLevel) {
-	m.level = &ml
+// SetMonth sets the "month" field.
+func (m *ConnectionTransferMutation) SetMonth(t time.Time) {
+	m.month = &t
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *JobLogMutation) Level() (r model.LogLevel, exists bool) {
-	v := m.level
+// Month returns the value of the "month" field in the mutation.
+func (m *ConnectionTransferMutation) Month() (r time.Time, exists bool) {
+	v := m.month
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the JobLog entity.
-// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// OldMonth returns the old "month" field's value of the ConnectionTransfer entity.
+// If the ConnectionTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobLogMutation) OldLevel(ctx context.Context) (v model.LogLevel, err error) {
+func (m *ConnectionTransferMutation) OldMonth(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldMonth requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
 	}
-	return oldValue.Level, nil
+	return oldValue.Month, nil
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *JobLogMutation) ResetLevel() {
-	m.level = nil
+// ResetMonth resets all changes to the "month" field.
+func (m *ConnectionTransferMutation) ResetMonth() {
+	m.month = nil
 }
 
-// SetTime sets the "time" field.
-func (m *JobLogMutation) SetTime(t time.Time) {
-	m.time = &t
+// SetBytes sets the "bytes" field.
+func (m *ConnectionTransferMutation) SetBytes(i int64) {
+	m.bytes = &i
+	m.addbytes = nil
 }
 
-// Time returns the value of the "time" field in the mutation.
-func (m *JobLogMutation) Time() (r time.Time, exists bool) {
-	v := m.time
+// Bytes returns the value of the "bytes" field in the mutation.
+func (m *ConnectionTransferMutation) Bytes() (r int64, exists bool) {
+	v := m.bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTime returns the old "time" field's value of the JobLog entity.
-// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// OldBytes returns the old "bytes" field's value of the ConnectionTransfer entity.
+// If the ConnectionTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobLogMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+func (m *ConnectionTransferMutation) OldBytes(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTime requires an ID field in the mutation")
+		return v, errors.New("OldBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldBytes: %w", err)
 	}
-	return oldValue.Time, nil
-}
-
-// ResetTime resets all changes to the "time" field.
-func (m *JobLogMutation) ResetTime() {
-	m.time = nil
+	return oldValue.Bytes, nil
 }
 
-// SetPath sets the "path" field.
-func (m *JobLogMutation) SetPath(s string) {
-	m._path = &s
+// AddBytes adds i to the "bytes" field.
+func (m *ConnectionTransferMutation) AddBytes(i int64) {
+	if m.addbytes != nil {
+		*m.addbytes += i
+	} else {
+		m.addbytes = &i
+	}
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *JobLogMutation) Path() (r string, exists bool) {
-	v := m._path
+// AddedBytes returns the value that was added to the "bytes" field in this mutation.
+func (m *ConnectionTransferMutation) AddedBytes() (r int64, exists bool) {
+	v := m.addbytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the JobLog entity.
-// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobLogMutation) OldPath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
-	}
-	return oldValue.Path, nil
+// ResetBytes resets all changes to the "bytes" field.
+func (m *ConnectionTransferMutation) ResetBytes() {
+	m.bytes = nil
+	m.addbytes = nil
 }
 
-// ClearPath clears the value of the "path" field.
-func (m *JobLogMutation) ClearPath() {
-	m._path = nil
-	m.clearedFields[joblog.FieldPath] = struct{}{}
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (m *ConnectionTransferMutation) ClearConnection() {
+	m.clearedconnection = true
+	m.clearedFields[connectiontransfer.FieldConnectionID] = struct{}{}
 }
 
-// PathCleared returns if the "path" field was cleared in this mutation.
-func (m *JobLogMutation) PathCleared() bool {
-	_, ok := m.clearedFields[joblog.FieldPath]
-	return ok
+// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
+func (m *ConnectionTransferMutation) ConnectionCleared() bool {
+	return m.clearedconnection
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *JobLogMutation) ResetPath() {
-	m._path = nil
-	delete(m.clearedFields, joblog.FieldPath)
+// ConnectionIDs returns the "connection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConnectionID instead. It exists only for internal usage by the builders.
+func (m *ConnectionTransferMutation) ConnectionIDs() (ids []uuid.UUID) {
+	if id := m.connection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetWhat sets the "what" field.
-func (m *JobLogMutation) SetWhat(ma model.LogAction) {
-	m.what = &ma
+// ResetConnection resets all changes to the "connection" edge.
+func (m *ConnectionTransferMutation) ResetConnection() {
+	m.connection = nil
+	m.clearedconnection = false
 }
 
-// What returns the value of the "what" field in the mutation.
-func (m *JobLogMutation) What() (r model.LogAction, exists bool) {
-	v := m.what
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the ConnectionTransferMutation builder.
+func (m *ConnectionTransferMutation) Where(ps ...predicate.ConnectionTransfer) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldWhat returns the old "what" field's value of the JobLog entity.
-// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobLogMutation) OldWhat(ctx context.Context) (v model.LogAction, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWhat is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWhat requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWhat: %w", err)
+// WhereP appends storage-level predicates to the ConnectionTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConnectionTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConnectionTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.What, nil
+	m.Where(p...)
 }
 
-// ResetWhat resets all changes to the "what" field.
-func (m *JobLogMutation) ResetWhat() {
-	m.what = nil
+// Op returns the operation name.
+func (m *ConnectionTransferMutation) Op() Op {
+	return m.op
 }
 
-// SetSize sets the "size" field.
-func (m *JobLogMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetOp allows setting the mutation operation.
+func (m *ConnectionTransferMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *JobLogMutation) Size() (r int64, exists bool) {
-	v := m.size
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (ConnectionTransfer).
+func (m *ConnectionTransferMutation) Type() string {
+	return m.typ
 }
 
-// OldSize returns the old "size" field's value of the JobLog entity.
-// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobLogMutation) OldSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConnectionTransferMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.connection != nil {
+		fields = append(fields, connectiontransfer.FieldConnectionID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+	if m.month != nil {
+		fields = append(fields, connectiontransfer.FieldMonth)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	if m.bytes != nil {
+		fields = append(fields, connectiontransfer.FieldBytes)
 	}
-	return oldValue.Size, nil
-}
-
-// AddSize adds i to the "size" field.
-func (m *JobLogMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
-}
-
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *JobLogMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearSize clears the value of the "size" field.
-func (m *JobLogMutation) ClearSize() {
-	m.size = nil
-	m.addsize = nil
-	m.clearedFields[joblog.FieldSize] = struct{}{}
-}
-
-// SizeCleared returns if the "size" field was cleared in this mutation.
-func (m *JobLogMutation) SizeCleared() bool {
-	_, ok := m.clearedFields[joblog.FieldSize]
-	return ok
-}
-
-// ResetSize resets all changes to the "size" field.
-func (m *JobLogMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
-	delete(m.clearedFields, joblog.FieldSize)
-}
-
-// ClearJob clears the "job" edge to the Job entity.
-func (m *JobLogMutation) ClearJob() {
-	m.clearedjob = true
-	m.clearedFields[joblog.FieldJobID] = struct{}{}
-}
-
-// JobCleared reports if the "job" edge to the Job entity was cleared.
-func (m *JobLogMutation) JobCleared() bool {
-	return m.clearedjob
-}
-
-// JobIDs returns the "job" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// JobID instead. It exists only for internal usage by the builders.
-func (m *JobLogMutation) JobIDs() (ids []uuid.UUID) {
-	if id := m.job; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetJob resets all changes to the "job" edge.
-func (m *JobLogMutation) ResetJob() {
-	m.job = nil
-	m.clearedjob = false
-}
-
-// Where appends a list predicates to the JobLogMutation builder.
-func (m *JobLogMutation) Where(ps ...predicate.JobLog) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the JobLogMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.JobLog, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *JobLogMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *JobLogMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (JobLog).
-func (m *JobLogMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *JobLogMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.job != nil {
-		fields = append(fields, joblog.FieldJobID)
-	}
-	if m.level != nil {
-		fields = append(fields, joblog.FieldLevel)
-	}
-	if m.time != nil {
-		fields = append(fields, joblog.FieldTime)
-	}
-	if m._path != nil {
-		fields = append(fields, joblog.FieldPath)
-	}
-	if m.what != nil {
-		fields = append(fields, joblog.FieldWhat)
-	}
-	if m.size != nil {
-		fields = append(fields, joblog.FieldSize)
-	}
-	return fields
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JobLogMutation) Field(name string) (ent.Value, bool) {
+func (m *ConnectionTransferMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case joblog.FieldJobID:
-		return m.JobID()
-	case joblog.FieldLevel:
-		return m.Level()
-	case joblog.FieldTime:
-		return m.Time()
-	case joblog.FieldPath:
-		return m.Path()
-	case joblog.FieldWhat:
-		return m.What()
-	case joblog.FieldSize:
-		return m.Size()
+	case connectiontransfer.FieldConnectionID:
+		return m.ConnectionID()
+	case connectiontransfer.FieldMonth:
+		return m.Month()
+	case connectiontransfer.FieldBytes:
+		return m.Bytes()
 	}
 	return nil, false
 }
@@ -2297,81 +2278,54 @@ func (m *JobLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JobLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConnectionTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case joblog.FieldJobID:
-		return m.OldJobID(ctx)
-	case joblog.FieldLevel:
-		return m.OldLevel(ctx)
-	case joblog.FieldTime:
-		return m.OldTime(ctx)
-	case joblog.FieldPath:
-		return m.OldPath(ctx)
-	case joblog.FieldWhat:
-		return m.OldWhat(ctx)
-	case joblog.FieldSize:
-		return m.OldSize(ctx)
+	case connectiontransfer.FieldConnectionID:
+		return m.OldConnectionID(ctx)
+	case connectiontransfer.FieldMonth:
+		return m.OldMonth(ctx)
+	case connectiontransfer.FieldBytes:
+		return m.OldBytes(ctx)
 	}
-	return nil, fmt.Errorf("unknown JobLog field %s", name)
+	return nil, fmt.Errorf("unknown ConnectionTransfer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobLogMutation) SetField(name string, value ent.Value) error {
+func (m *ConnectionTransferMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case joblog.FieldJobID:
+	case connectiontransfer.FieldConnectionID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJobID(v)
-		return nil
-	case joblog.FieldLevel:
-		v, ok := value.(model.LogLevel)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevel(v)
+		m.SetConnectionID(v)
 		return nil
-	case joblog.FieldTime:
+	case connectiontransfer.FieldMonth:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTime(v)
-		return nil
-	case joblog.FieldPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPath(v)
-		return nil
-	case joblog.FieldWhat:
-		v, ok := value.(model.LogAction)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWhat(v)
+		m.SetMonth(v)
 		return nil
-	case joblog.FieldSize:
+	case connectiontransfer.FieldBytes:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown JobLog field %s", name)
+	return fmt.Errorf("unknown ConnectionTransfer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JobLogMutation) AddedFields() []string {
+func (m *ConnectionTransferMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, joblog.FieldSize)
+	if m.addbytes != nil {
+		fields = append(fields, connectiontransfer.FieldBytes)
 	}
 	return fields
 }
@@ -2379,10 +2333,10 @@ func (m *JobLogMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JobLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConnectionTransferMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case joblog.FieldSize:
-		return m.AddedSize()
+	case connectiontransfer.FieldBytes:
+		return m.AddedBytes()
 	}
 	return nil, false
 }
@@ -2390,94 +2344,70 @@ func (m *JobLogMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobLogMutation) AddField(name string, value ent.Value) error {
+func (m *ConnectionTransferMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case joblog.FieldSize:
+	case connectiontransfer.FieldBytes:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown JobLog numeric field %s", name)
+	return fmt.Errorf("unknown ConnectionTransfer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JobLogMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(joblog.FieldPath) {
-		fields = append(fields, joblog.FieldPath)
-	}
-	if m.FieldCleared(joblog.FieldSize) {
-		fields = append(fields, joblog.FieldSize)
-	}
-	return fields
+func (m *ConnectionTransferMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JobLogMutation) FieldCleared(name string) bool {
+func (m *ConnectionTransferMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JobLogMutation) ClearField(name string) error {
-	switch name {
-	case joblog.FieldPath:
-		m.ClearPath()
-		return nil
-	case joblog.FieldSize:
-		m.ClearSize()
-		return nil
-	}
-	return fmt.Errorf("unknown JobLog nullable field %s", name)
+func (m *ConnectionTransferMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConnectionTransfer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JobLogMutation) ResetField(name string) error {
+func (m *ConnectionTransferMutation) ResetField(name string) error {
 	switch name {
-	case joblog.FieldJobID:
-		m.ResetJobID()
-		return nil
-	case joblog.FieldLevel:
-		m.ResetLevel()
-		return nil
-	case joblog.FieldTime:
-		m.ResetTime()
-		return nil
-	case joblog.FieldPath:
-		m.ResetPath()
+	case connectiontransfer.FieldConnectionID:
+		m.ResetConnectionID()
 		return nil
-	case joblog.FieldWhat:
-		m.ResetWhat()
+	case connectiontransfer.FieldMonth:
+		m.ResetMonth()
 		return nil
-	case joblog.FieldSize:
-		m.ResetSize()
+	case connectiontransfer.FieldBytes:
+		m.ResetBytes()
 		return nil
 	}
-	return fmt.Errorf("unknown JobLog field %s", name)
+	return fmt.Errorf("unknown ConnectionTransfer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobLogMutation) AddedEdges() []string {
+func (m *ConnectionTransferMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.job != nil {
-		edges = append(edges, joblog.EdgeJob)
+	if m.connection != nil {
+		edges = append(edges, connectiontransfer.EdgeConnection)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JobLogMutation) AddedIDs(name string) []ent.Value {
+func (m *ConnectionTransferMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case joblog.EdgeJob:
-		if id := m.job; id != nil {
+	case connectiontransfer.EdgeConnection:
+		if id := m.connection; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2485,95 +2415,90 @@ func (m *JobLogMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobLogMutation) RemovedEdges() []string {
+func (m *ConnectionTransferMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JobLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConnectionTransferMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobLogMutation) ClearedEdges() []string {
+func (m *ConnectionTransferMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedjob {
-		edges = append(edges, joblog.EdgeJob)
+	if m.clearedconnection {
+		edges = append(edges, connectiontransfer.EdgeConnection)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JobLogMutation) EdgeCleared(name string) bool {
+func (m *ConnectionTransferMutation) EdgeCleared(name string) bool {
 	switch name {
-	case joblog.EdgeJob:
-		return m.clearedjob
+	case connectiontransfer.EdgeConnection:
+		return m.clearedconnection
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JobLogMutation) ClearEdge(name string) error {
+func (m *ConnectionTransferMutation) ClearEdge(name string) error {
 	switch name {
-	case joblog.EdgeJob:
-		m.ClearJob()
+	case connectiontransfer.EdgeConnection:
+		m.ClearConnection()
 		return nil
 	}
-	return fmt.Errorf("unknown JobLog unique edge %s", name)
+	return fmt.Errorf("unknown ConnectionTransfer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JobLogMutation) ResetEdge(name string) error {
+func (m *ConnectionTransferMutation) ResetEdge(name string) error {
 	switch name {
-	case joblog.EdgeJob:
-		m.ResetJob()
+	case connectiontransfer.EdgeConnection:
+		m.ResetConnection()
 		return nil
 	}
-	return fmt.Errorf("unknown JobLog edge %s", name)
+	return fmt.Errorf("unknown ConnectionTransfer edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// ConnectionUsageMutation represents an operation that mutates the ConnectionUsage nodes in the graph.
+type ConnectionUsageMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *uuid.UUID
-	name              *string
-	source_path       *string
-	remote_path       *string
-	direction         *model.SyncDirection
-	schedule          *string
-	realtime          *bool
-	options           **model.TaskSyncOptions
-	created_at        *time.Time
-	updated_at        *time.Time
+	day               *time.Time
+	used              *int64
+	addused           *int64
+	total             *int64
+	addtotal          *int64
+	free              *int64
+	addfree           *int64
 	clearedFields     map[string]struct{}
-	jobs              map[uuid.UUID]struct{}
-	removedjobs       map[uuid.UUID]struct{}
-	clearedjobs       bool
 	connection        *uuid.UUID
 	clearedconnection bool
 	done              bool
-	oldValue          func(context.Context) (*Task, error)
-	predicates        []predicate.Task
+	oldValue          func(context.Context) (*ConnectionUsage, error)
+	predicates        []predicate.ConnectionUsage
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+var _ ent.Mutation = (*ConnectionUsageMutation)(nil)
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// connectionusageOption allows management of the mutation configuration using functional options.
+type connectionusageOption func(*ConnectionUsageMutation)
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+// newConnectionUsageMutation creates new mutation for the ConnectionUsage entity.
+func newConnectionUsageMutation(c config, op Op, opts ...connectionusageOption) *ConnectionUsageMutation {
+	m := &ConnectionUsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeConnectionUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2582,20 +2507,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id uuid.UUID) taskOption {
-	return func(m *TaskMutation) {
+// withConnectionUsageID sets the ID field of the mutation.
+func withConnectionUsageID(id uuid.UUID) connectionusageOption {
+	return func(m *ConnectionUsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *ConnectionUsage
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*ConnectionUsage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().ConnectionUsage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2604,10 +2529,10 @@ func withTaskID(id uuid.UUID) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withConnectionUsage sets the old ConnectionUsage of the mutation.
+func withConnectionUsage(node *ConnectionUsage) connectionusageOption {
+	return func(m *ConnectionUsageMutation) {
+		m.oldValue = func(context.Context) (*ConnectionUsage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2616,7 +2541,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m ConnectionUsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2624,7 +2549,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m ConnectionUsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2634,14 +2559,14 @@ func (m TaskMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Task entities.
-func (m *TaskMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConnectionUsage entities.
+func (m *ConnectionUsageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConnectionUsageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2652,7 +2577,7 @@ func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConnectionUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2661,501 +2586,9770 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConnectionUsage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TaskMutation) SetName(s string) {
-	m.name = &s
+// SetConnectionID sets the "connection_id" field.
+func (m *ConnectionUsageMutation) SetConnectionID(u uuid.UUID) {
+	m.connection = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TaskMutation) Name() (r string, exists bool) {
-	v := m.name
+// ConnectionID returns the value of the "connection_id" field in the mutation.
+func (m *ConnectionUsageMutation) ConnectionID() (r uuid.UUID, exists bool) {
+	v := m.connection
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldConnectionID returns the old "connection_id" field's value of the ConnectionUsage entity.
+// If the ConnectionUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ConnectionUsageMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldConnectionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ConnectionID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TaskMutation) ResetName() {
-	m.name = nil
+// ResetConnectionID resets all changes to the "connection_id" field.
+func (m *ConnectionUsageMutation) ResetConnectionID() {
+	m.connection = nil
 }
 
-// SetSourcePath sets the "source_path" field.
-func (m *TaskMutation) SetSourcePath(s string) {
-	m.source_path = &s
+// SetDay sets the "day" field.
+func (m *ConnectionUsageMutation) SetDay(t time.Time) {
+	m.day = &t
 }
 
-// SourcePath returns the value of the "source_path" field in the mutation.
-func (m *TaskMutation) SourcePath() (r string, exists bool) {
-	v := m.source_path
+// Day returns the value of the "day" field in the mutation.
+func (m *ConnectionUsageMutation) Day() (r time.Time, exists bool) {
+	v := m.day
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourcePath returns the old "source_path" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldDay returns the old "day" field's value of the ConnectionUsage entity.
+// If the ConnectionUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldSourcePath(ctx context.Context) (v string, err error) {
+func (m *ConnectionUsageMutation) OldDay(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourcePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourcePath requires an ID field in the mutation")
+		return v, errors.New("OldDay requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourcePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
 	}
-	return oldValue.SourcePath, nil
+	return oldValue.Day, nil
 }
 
-// ResetSourcePath resets all changes to the "source_path" field.
-func (m *TaskMutation) ResetSourcePath() {
-	m.source_path = nil
+// ResetDay resets all changes to the "day" field.
+func (m *ConnectionUsageMutation) ResetDay() {
+	m.day = nil
 }
 
-// SetConnectionID sets the "connection_id" field.
-func (m *TaskMutation) SetConnectionID(u uuid.UUID) {
-	m.connection = &u
+// SetUsed sets the "used" field.
+func (m *ConnectionUsageMutation) SetUsed(i int64) {
+	m.used = &i
+	m.addused = nil
 }
 
-// ConnectionID returns the value of the "connection_id" field in the mutation.
-func (m *TaskMutation) ConnectionID() (r uuid.UUID, exists bool) {
-	v := m.connection
+// Used returns the value of the "used" field in the mutation.
+func (m *ConnectionUsageMutation) Used() (r int64, exists bool) {
+	v := m.used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectionID returns the old "connection_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUsed returns the old "used" field's value of the ConnectionUsage entity.
+// If the ConnectionUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConnectionUsageMutation) OldUsed(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectionID requires an ID field in the mutation")
+		return v, errors.New("OldUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
 	}
-	return oldValue.ConnectionID, nil
+	return oldValue.Used, nil
 }
 
-// ClearConnectionID clears the value of the "connection_id" field.
-func (m *TaskMutation) ClearConnectionID() {
-	m.connection = nil
-	m.clearedFields[task.FieldConnectionID] = struct{}{}
+// AddUsed adds i to the "used" field.
+func (m *ConnectionUsageMutation) AddUsed(i int64) {
+	if m.addused != nil {
+		*m.addused += i
+	} else {
+		m.addused = &i
+	}
 }
 
-// ConnectionIDCleared returns if the "connection_id" field was cleared in this mutation.
-func (m *TaskMutation) ConnectionIDCleared() bool {
-	_, ok := m.clearedFields[task.FieldConnectionID]
-	return ok
+// AddedUsed returns the value that was added to the "used" field in this mutation.
+func (m *ConnectionUsageMutation) AddedUsed() (r int64, exists bool) {
+	v := m.addused
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetConnectionID resets all changes to the "connection_id" field.
-func (m *TaskMutation) ResetConnectionID() {
-	m.connection = nil
-	delete(m.clearedFields, task.FieldConnectionID)
+// ResetUsed resets all changes to the "used" field.
+func (m *ConnectionUsageMutation) ResetUsed() {
+	m.used = nil
+	m.addused = nil
 }
 
-// SetRemotePath sets the "remote_path" field.
-func (m *TaskMutation) SetRemotePath(s string) {
-	m.remote_path = &s
+// SetTotal sets the "total" field.
+func (m *ConnectionUsageMutation) SetTotal(i int64) {
+	m.total = &i
+	m.addtotal = nil
 }
 
-// RemotePath returns the value of the "remote_path" field in the mutation.
-func (m *TaskMutation) RemotePath() (r string, exists bool) {
-	v := m.remote_path
+// Total returns the value of the "total" field in the mutation.
+func (m *ConnectionUsageMutation) Total() (r int64, exists bool) {
+	v := m.total
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemotePath returns the old "remote_path" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTotal returns the old "total" field's value of the ConnectionUsage entity.
+// If the ConnectionUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldRemotePath(ctx context.Context) (v string, err error) {
+func (m *ConnectionUsageMutation) OldTotal(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemotePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemotePath requires an ID field in the mutation")
+		return v, errors.New("OldTotal requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemotePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
 	}
-	return oldValue.RemotePath, nil
+	return oldValue.Total, nil
 }
 
-// ResetRemotePath resets all changes to the "remote_path" field.
-func (m *TaskMutation) ResetRemotePath() {
-	m.remote_path = nil
+// AddTotal adds i to the "total" field.
+func (m *ConnectionUsageMutation) AddTotal(i int64) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
 }
 
-// SetDirection sets the "direction" field.
-func (m *TaskMutation) SetDirection(md model.SyncDirection) {
-	m.direction = &md
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *ConnectionUsageMutation) AddedTotal() (r int64, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Direction returns the value of the "direction" field in the mutation.
-func (m *TaskMutation) Direction() (r model.SyncDirection, exists bool) {
-	v := m.direction
+// ClearTotal clears the value of the "total" field.
+func (m *ConnectionUsageMutation) ClearTotal() {
+	m.total = nil
+	m.addtotal = nil
+	m.clearedFields[connectionusage.FieldTotal] = struct{}{}
+}
+
+// TotalCleared returns if the "total" field was cleared in this mutation.
+func (m *ConnectionUsageMutation) TotalCleared() bool {
+	_, ok := m.clearedFields[connectionusage.FieldTotal]
+	return ok
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *ConnectionUsageMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+	delete(m.clearedFields, connectionusage.FieldTotal)
+}
+
+// SetFree sets the "free" field.
+func (m *ConnectionUsageMutation) SetFree(i int64) {
+	m.free = &i
+	m.addfree = nil
+}
+
+// Free returns the value of the "free" field in the mutation.
+func (m *ConnectionUsageMutation) Free() (r int64, exists bool) {
+	v := m.free
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDirection returns the old "direction" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldFree returns the old "free" field's value of the ConnectionUsage entity.
+// If the ConnectionUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDirection(ctx context.Context) (v model.SyncDirection, err error) {
+func (m *ConnectionUsageMutation) OldFree(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDirection is only allowed on UpdateOne operations")
+		return v, errors.New("OldFree is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDirection requires an ID field in the mutation")
+		return v, errors.New("OldFree requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDirection: %w", err)
+		return v, fmt.Errorf("querying old value for OldFree: %w", err)
 	}
-	return oldValue.Direction, nil
-}
-
-// ResetDirection resets all changes to the "direction" field.
-func (m *TaskMutation) ResetDirection() {
-	m.direction = nil
+	return oldValue.Free, nil
 }
 
-// SetSchedule sets the "schedule" field.
-func (m *TaskMutation) SetSchedule(s string) {
-	m.schedule = &s
+// AddFree adds i to the "free" field.
+func (m *ConnectionUsageMutation) AddFree(i int64) {
+	if m.addfree != nil {
+		*m.addfree += i
+	} else {
+		m.addfree = &i
+	}
 }
 
-// Schedule returns the value of the "schedule" field in the mutation.
-func (m *TaskMutation) Schedule() (r string, exists bool) {
-	v := m.schedule
+// AddedFree returns the value that was added to the "free" field in this mutation.
+func (m *ConnectionUsageMutation) AddedFree() (r int64, exists bool) {
+	v := m.addfree
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchedule returns the old "schedule" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldSchedule(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+// ClearFree clears the value of the "free" field.
+func (m *ConnectionUsageMutation) ClearFree() {
+	m.free = nil
+	m.addfree = nil
+	m.clearedFields[connectionusage.FieldFree] = struct{}{}
+}
+
+// FreeCleared returns if the "free" field was cleared in this mutation.
+func (m *ConnectionUsageMutation) FreeCleared() bool {
+	_, ok := m.clearedFields[connectionusage.FieldFree]
+	return ok
+}
+
+// ResetFree resets all changes to the "free" field.
+func (m *ConnectionUsageMutation) ResetFree() {
+	m.free = nil
+	m.addfree = nil
+	delete(m.clearedFields, connectionusage.FieldFree)
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (m *ConnectionUsageMutation) ClearConnection() {
+	m.clearedconnection = true
+	m.clearedFields[connectionusage.FieldConnectionID] = struct{}{}
+}
+
+// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
+func (m *ConnectionUsageMutation) ConnectionCleared() bool {
+	return m.clearedconnection
+}
+
+// ConnectionIDs returns the "connection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConnectionID instead. It exists only for internal usage by the builders.
+func (m *ConnectionUsageMutation) ConnectionIDs() (ids []uuid.UUID) {
+	if id := m.connection; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	return
+}
+
+// ResetConnection resets all changes to the "connection" edge.
+func (m *ConnectionUsageMutation) ResetConnection() {
+	m.connection = nil
+	m.clearedconnection = false
+}
+
+// Where appends a list predicates to the ConnectionUsageMutation builder.
+func (m *ConnectionUsageMutation) Where(ps ...predicate.ConnectionUsage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConnectionUsageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConnectionUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConnectionUsage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConnectionUsageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConnectionUsageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConnectionUsage).
+func (m *ConnectionUsageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConnectionUsageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.connection != nil {
+		fields = append(fields, connectionusage.FieldConnectionID)
+	}
+	if m.day != nil {
+		fields = append(fields, connectionusage.FieldDay)
+	}
+	if m.used != nil {
+		fields = append(fields, connectionusage.FieldUsed)
+	}
+	if m.total != nil {
+		fields = append(fields, connectionusage.FieldTotal)
+	}
+	if m.free != nil {
+		fields = append(fields, connectionusage.FieldFree)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConnectionUsageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case connectionusage.FieldConnectionID:
+		return m.ConnectionID()
+	case connectionusage.FieldDay:
+		return m.Day()
+	case connectionusage.FieldUsed:
+		return m.Used()
+	case connectionusage.FieldTotal:
+		return m.Total()
+	case connectionusage.FieldFree:
+		return m.Free()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConnectionUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case connectionusage.FieldConnectionID:
+		return m.OldConnectionID(ctx)
+	case connectionusage.FieldDay:
+		return m.OldDay(ctx)
+	case connectionusage.FieldUsed:
+		return m.OldUsed(ctx)
+	case connectionusage.FieldTotal:
+		return m.OldTotal(ctx)
+	case connectionusage.FieldFree:
+		return m.OldFree(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConnectionUsage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectionUsageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case connectionusage.FieldConnectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectionID(v)
+		return nil
+	case connectionusage.FieldDay:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case connectionusage.FieldUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsed(v)
+		return nil
+	case connectionusage.FieldTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case connectionusage.FieldFree:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFree(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionUsage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConnectionUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addused != nil {
+		fields = append(fields, connectionusage.FieldUsed)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, connectionusage.FieldTotal)
+	}
+	if m.addfree != nil {
+		fields = append(fields, connectionusage.FieldFree)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConnectionUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case connectionusage.FieldUsed:
+		return m.AddedUsed()
+	case connectionusage.FieldTotal:
+		return m.AddedTotal()
+	case connectionusage.FieldFree:
+		return m.AddedFree()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectionUsageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case connectionusage.FieldUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUsed(v)
+		return nil
+	case connectionusage.FieldTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case connectionusage.FieldFree:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFree(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionUsage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConnectionUsageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(connectionusage.FieldTotal) {
+		fields = append(fields, connectionusage.FieldTotal)
+	}
+	if m.FieldCleared(connectionusage.FieldFree) {
+		fields = append(fields, connectionusage.FieldFree)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConnectionUsageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConnectionUsageMutation) ClearField(name string) error {
+	switch name {
+	case connectionusage.FieldTotal:
+		m.ClearTotal()
+		return nil
+	case connectionusage.FieldFree:
+		m.ClearFree()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionUsage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConnectionUsageMutation) ResetField(name string) error {
+	switch name {
+	case connectionusage.FieldConnectionID:
+		m.ResetConnectionID()
+		return nil
+	case connectionusage.FieldDay:
+		m.ResetDay()
+		return nil
+	case connectionusage.FieldUsed:
+		m.ResetUsed()
+		return nil
+	case connectionusage.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case connectionusage.FieldFree:
+		m.ResetFree()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionUsage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConnectionUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.connection != nil {
+		edges = append(edges, connectionusage.EdgeConnection)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConnectionUsageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case connectionusage.EdgeConnection:
+		if id := m.connection; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConnectionUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConnectionUsageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConnectionUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedconnection {
+		edges = append(edges, connectionusage.EdgeConnection)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConnectionUsageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case connectionusage.EdgeConnection:
+		return m.clearedconnection
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConnectionUsageMutation) ClearEdge(name string) error {
+	switch name {
+	case connectionusage.EdgeConnection:
+		m.ClearConnection()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionUsage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConnectionUsageMutation) ResetEdge(name string) error {
+	switch name {
+	case connectionusage.EdgeConnection:
+		m.ResetConnection()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectionUsage edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	key           *string
+	operation     *string
+	request_hash  *string
+	result_id     *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyKey, error)
+	predicates    []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *IdempotencyKeyMutation) SetOperation(s string) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *IdempotencyKeyMutation) Operation() (r string, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldOperation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *IdempotencyKeyMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetResultID sets the "result_id" field.
+func (m *IdempotencyKeyMutation) SetResultID(u uuid.UUID) {
+	m.result_id = &u
+}
+
+// ResultID returns the value of the "result_id" field in the mutation.
+func (m *IdempotencyKeyMutation) ResultID() (r uuid.UUID, exists bool) {
+	v := m.result_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultID returns the old "result_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResultID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultID: %w", err)
+	}
+	return oldValue.ResultID, nil
+}
+
+// ClearResultID clears the value of the "result_id" field.
+func (m *IdempotencyKeyMutation) ClearResultID() {
+	m.result_id = nil
+	m.clearedFields[idempotencykey.FieldResultID] = struct{}{}
+}
+
+// ResultIDCleared returns if the "result_id" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResultIDCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResultID]
+	return ok
+}
+
+// ResetResultID resets all changes to the "result_id" field.
+func (m *IdempotencyKeyMutation) ResetResultID() {
+	m.result_id = nil
+	delete(m.clearedFields, idempotencykey.FieldResultID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.operation != nil {
+		fields = append(fields, idempotencykey.FieldOperation)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.result_id != nil {
+		fields = append(fields, idempotencykey.FieldResultID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldOperation:
+		return m.Operation()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldResultID:
+		return m.ResultID()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldOperation:
+		return m.OldOperation(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldResultID:
+		return m.OldResultID(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldResultID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultID(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldResultID) {
+		fields = append(fields, idempotencykey.FieldResultID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldResultID:
+		m.ClearResultID()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldResultID:
+		m.ResetResultID()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	status                       *model.JobStatus
+	trigger                      *model.JobTrigger
+	start_time                   *time.Time
+	end_time                     *time.Time
+	files_transferred            *int
+	addfiles_transferred         *int
+	bytes_transferred            *int64
+	addbytes_transferred         *int64
+	uploaded_files               *int
+	adduploaded_files            *int
+	uploaded_bytes               *int64
+	adduploaded_bytes            *int64
+	downloaded_files             *int
+	adddownloaded_files          *int
+	downloaded_bytes             *int64
+	adddownloaded_bytes          *int64
+	files_deleted                *int
+	addfiles_deleted             *int
+	files_checked                *int
+	addfiles_checked             *int
+	listings_done                *int
+	addlistings_done             *int
+	error_count                  *int
+	adderror_count               *int
+	errors                       *string
+	note                         *string
+	acknowledged                 *bool
+	annotated_at                 *time.Time
+	trace_id                     *string
+	connection_config_version    *int
+	addconnection_config_version *int
+	trigger_detail               **model.JobTriggerDetail
+	task_config_hash             *string
+	concurrency                  **model.JobConcurrency
+	config_snapshot              **model.JobConfigSnapshot
+	clearedFields                map[string]struct{}
+	task                         *uuid.UUID
+	clearedtask                  bool
+	logs                         map[int]struct{}
+	removedlogs                  map[int]struct{}
+	clearedlogs                  bool
+	retry_queue                  map[uuid.UUID]struct{}
+	removedretry_queue           map[uuid.UUID]struct{}
+	clearedretry_queue           bool
+	events                       map[uuid.UUID]struct{}
+	removedevents                map[uuid.UUID]struct{}
+	clearedevents                bool
+	parent                       *uuid.UUID
+	clearedparent                bool
+	children                     map[uuid.UUID]struct{}
+	removedchildren              map[uuid.UUID]struct{}
+	clearedchildren              bool
+	done                         bool
+	oldValue                     func(context.Context) (*Job, error)
+	predicates                   []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id uuid.UUID) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *JobMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *JobMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *JobMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *JobMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *JobMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *JobMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[job.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *JobMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *JobMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, job.FieldParentID)
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(ms model.JobStatus) {
+	m.status = &ms
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r model.JobStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v model.JobStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *JobMutation) SetTrigger(mt model.JobTrigger) {
+	m.trigger = &mt
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *JobMutation) Trigger() (r model.JobTrigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTrigger(ctx context.Context) (v model.JobTrigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *JobMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *JobMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *JobMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *JobMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *JobMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *JobMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *JobMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[job.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *JobMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[job.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *JobMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, job.FieldEndTime)
+}
+
+// SetFilesTransferred sets the "files_transferred" field.
+func (m *JobMutation) SetFilesTransferred(i int) {
+	m.files_transferred = &i
+	m.addfiles_transferred = nil
+}
+
+// FilesTransferred returns the value of the "files_transferred" field in the mutation.
+func (m *JobMutation) FilesTransferred() (r int, exists bool) {
+	v := m.files_transferred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilesTransferred returns the old "files_transferred" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFilesTransferred(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesTransferred is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesTransferred requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesTransferred: %w", err)
+	}
+	return oldValue.FilesTransferred, nil
+}
+
+// AddFilesTransferred adds i to the "files_transferred" field.
+func (m *JobMutation) AddFilesTransferred(i int) {
+	if m.addfiles_transferred != nil {
+		*m.addfiles_transferred += i
+	} else {
+		m.addfiles_transferred = &i
+	}
+}
+
+// AddedFilesTransferred returns the value that was added to the "files_transferred" field in this mutation.
+func (m *JobMutation) AddedFilesTransferred() (r int, exists bool) {
+	v := m.addfiles_transferred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFilesTransferred resets all changes to the "files_transferred" field.
+func (m *JobMutation) ResetFilesTransferred() {
+	m.files_transferred = nil
+	m.addfiles_transferred = nil
+}
+
+// SetBytesTransferred sets the "bytes_transferred" field.
+func (m *JobMutation) SetBytesTransferred(i int64) {
+	m.bytes_transferred = &i
+	m.addbytes_transferred = nil
+}
+
+// BytesTransferred returns the value of the "bytes_transferred" field in the mutation.
+func (m *JobMutation) BytesTransferred() (r int64, exists bool) {
+	v := m.bytes_transferred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBytesTransferred returns the old "bytes_transferred" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldBytesTransferred(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBytesTransferred is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBytesTransferred requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBytesTransferred: %w", err)
+	}
+	return oldValue.BytesTransferred, nil
+}
+
+// AddBytesTransferred adds i to the "bytes_transferred" field.
+func (m *JobMutation) AddBytesTransferred(i int64) {
+	if m.addbytes_transferred != nil {
+		*m.addbytes_transferred += i
+	} else {
+		m.addbytes_transferred = &i
+	}
+}
+
+// AddedBytesTransferred returns the value that was added to the "bytes_transferred" field in this mutation.
+func (m *JobMutation) AddedBytesTransferred() (r int64, exists bool) {
+	v := m.addbytes_transferred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBytesTransferred resets all changes to the "bytes_transferred" field.
+func (m *JobMutation) ResetBytesTransferred() {
+	m.bytes_transferred = nil
+	m.addbytes_transferred = nil
+}
+
+// SetUploadedFiles sets the "uploaded_files" field.
+func (m *JobMutation) SetUploadedFiles(i int) {
+	m.uploaded_files = &i
+	m.adduploaded_files = nil
+}
+
+// UploadedFiles returns the value of the "uploaded_files" field in the mutation.
+func (m *JobMutation) UploadedFiles() (r int, exists bool) {
+	v := m.uploaded_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadedFiles returns the old "uploaded_files" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUploadedFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedFiles: %w", err)
+	}
+	return oldValue.UploadedFiles, nil
+}
+
+// AddUploadedFiles adds i to the "uploaded_files" field.
+func (m *JobMutation) AddUploadedFiles(i int) {
+	if m.adduploaded_files != nil {
+		*m.adduploaded_files += i
+	} else {
+		m.adduploaded_files = &i
+	}
+}
+
+// AddedUploadedFiles returns the value that was added to the "uploaded_files" field in this mutation.
+func (m *JobMutation) AddedUploadedFiles() (r int, exists bool) {
+	v := m.adduploaded_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUploadedFiles resets all changes to the "uploaded_files" field.
+func (m *JobMutation) ResetUploadedFiles() {
+	m.uploaded_files = nil
+	m.adduploaded_files = nil
+}
+
+// SetUploadedBytes sets the "uploaded_bytes" field.
+func (m *JobMutation) SetUploadedBytes(i int64) {
+	m.uploaded_bytes = &i
+	m.adduploaded_bytes = nil
+}
+
+// UploadedBytes returns the value of the "uploaded_bytes" field in the mutation.
+func (m *JobMutation) UploadedBytes() (r int64, exists bool) {
+	v := m.uploaded_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadedBytes returns the old "uploaded_bytes" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUploadedBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedBytes: %w", err)
+	}
+	return oldValue.UploadedBytes, nil
+}
+
+// AddUploadedBytes adds i to the "uploaded_bytes" field.
+func (m *JobMutation) AddUploadedBytes(i int64) {
+	if m.adduploaded_bytes != nil {
+		*m.adduploaded_bytes += i
+	} else {
+		m.adduploaded_bytes = &i
+	}
+}
+
+// AddedUploadedBytes returns the value that was added to the "uploaded_bytes" field in this mutation.
+func (m *JobMutation) AddedUploadedBytes() (r int64, exists bool) {
+	v := m.adduploaded_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUploadedBytes resets all changes to the "uploaded_bytes" field.
+func (m *JobMutation) ResetUploadedBytes() {
+	m.uploaded_bytes = nil
+	m.adduploaded_bytes = nil
+}
+
+// SetDownloadedFiles sets the "downloaded_files" field.
+func (m *JobMutation) SetDownloadedFiles(i int) {
+	m.downloaded_files = &i
+	m.adddownloaded_files = nil
+}
+
+// DownloadedFiles returns the value of the "downloaded_files" field in the mutation.
+func (m *JobMutation) DownloadedFiles() (r int, exists bool) {
+	v := m.downloaded_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadedFiles returns the old "downloaded_files" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldDownloadedFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadedFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadedFiles: %w", err)
+	}
+	return oldValue.DownloadedFiles, nil
+}
+
+// AddDownloadedFiles adds i to the "downloaded_files" field.
+func (m *JobMutation) AddDownloadedFiles(i int) {
+	if m.adddownloaded_files != nil {
+		*m.adddownloaded_files += i
+	} else {
+		m.adddownloaded_files = &i
+	}
+}
+
+// AddedDownloadedFiles returns the value that was added to the "downloaded_files" field in this mutation.
+func (m *JobMutation) AddedDownloadedFiles() (r int, exists bool) {
+	v := m.adddownloaded_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloadedFiles resets all changes to the "downloaded_files" field.
+func (m *JobMutation) ResetDownloadedFiles() {
+	m.downloaded_files = nil
+	m.adddownloaded_files = nil
+}
+
+// SetDownloadedBytes sets the "downloaded_bytes" field.
+func (m *JobMutation) SetDownloadedBytes(i int64) {
+	m.downloaded_bytes = &i
+	m.adddownloaded_bytes = nil
+}
+
+// DownloadedBytes returns the value of the "downloaded_bytes" field in the mutation.
+func (m *JobMutation) DownloadedBytes() (r int64, exists bool) {
+	v := m.downloaded_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadedBytes returns the old "downloaded_bytes" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldDownloadedBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadedBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadedBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadedBytes: %w", err)
+	}
+	return oldValue.DownloadedBytes, nil
+}
+
+// AddDownloadedBytes adds i to the "downloaded_bytes" field.
+func (m *JobMutation) AddDownloadedBytes(i int64) {
+	if m.adddownloaded_bytes != nil {
+		*m.adddownloaded_bytes += i
+	} else {
+		m.adddownloaded_bytes = &i
+	}
+}
+
+// AddedDownloadedBytes returns the value that was added to the "downloaded_bytes" field in this mutation.
+func (m *JobMutation) AddedDownloadedBytes() (r int64, exists bool) {
+	v := m.adddownloaded_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloadedBytes resets all changes to the "downloaded_bytes" field.
+func (m *JobMutation) ResetDownloadedBytes() {
+	m.downloaded_bytes = nil
+	m.adddownloaded_bytes = nil
+}
+
+// SetFilesDeleted sets the "files_deleted" field.
+func (m *JobMutation) SetFilesDeleted(i int) {
+	m.files_deleted = &i
+	m.addfiles_deleted = nil
+}
+
+// FilesDeleted returns the value of the "files_deleted" field in the mutation.
+func (m *JobMutation) FilesDeleted() (r int, exists bool) {
+	v := m.files_deleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilesDeleted returns the old "files_deleted" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFilesDeleted(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesDeleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesDeleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesDeleted: %w", err)
+	}
+	return oldValue.FilesDeleted, nil
+}
+
+// AddFilesDeleted adds i to the "files_deleted" field.
+func (m *JobMutation) AddFilesDeleted(i int) {
+	if m.addfiles_deleted != nil {
+		*m.addfiles_deleted += i
+	} else {
+		m.addfiles_deleted = &i
+	}
+}
+
+// AddedFilesDeleted returns the value that was added to the "files_deleted" field in this mutation.
+func (m *JobMutation) AddedFilesDeleted() (r int, exists bool) {
+	v := m.addfiles_deleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFilesDeleted resets all changes to the "files_deleted" field.
+func (m *JobMutation) ResetFilesDeleted() {
+	m.files_deleted = nil
+	m.addfiles_deleted = nil
+}
+
+// SetFilesChecked sets the "files_checked" field.
+func (m *JobMutation) SetFilesChecked(i int) {
+	m.files_checked = &i
+	m.addfiles_checked = nil
+}
+
+// FilesChecked returns the value of the "files_checked" field in the mutation.
+func (m *JobMutation) FilesChecked() (r int, exists bool) {
+	v := m.files_checked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilesChecked returns the old "files_checked" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFilesChecked(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesChecked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesChecked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesChecked: %w", err)
+	}
+	return oldValue.FilesChecked, nil
+}
+
+// AddFilesChecked adds i to the "files_checked" field.
+func (m *JobMutation) AddFilesChecked(i int) {
+	if m.addfiles_checked != nil {
+		*m.addfiles_checked += i
+	} else {
+		m.addfiles_checked = &i
+	}
+}
+
+// AddedFilesChecked returns the value that was added to the "files_checked" field in this mutation.
+func (m *JobMutation) AddedFilesChecked() (r int, exists bool) {
+	v := m.addfiles_checked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFilesChecked resets all changes to the "files_checked" field.
+func (m *JobMutation) ResetFilesChecked() {
+	m.files_checked = nil
+	m.addfiles_checked = nil
+}
+
+// SetListingsDone sets the "listings_done" field.
+func (m *JobMutation) SetListingsDone(i int) {
+	m.listings_done = &i
+	m.addlistings_done = nil
+}
+
+// ListingsDone returns the value of the "listings_done" field in the mutation.
+func (m *JobMutation) ListingsDone() (r int, exists bool) {
+	v := m.listings_done
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingsDone returns the old "listings_done" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldListingsDone(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingsDone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingsDone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingsDone: %w", err)
+	}
+	return oldValue.ListingsDone, nil
+}
+
+// AddListingsDone adds i to the "listings_done" field.
+func (m *JobMutation) AddListingsDone(i int) {
+	if m.addlistings_done != nil {
+		*m.addlistings_done += i
+	} else {
+		m.addlistings_done = &i
+	}
+}
+
+// AddedListingsDone returns the value that was added to the "listings_done" field in this mutation.
+func (m *JobMutation) AddedListingsDone() (r int, exists bool) {
+	v := m.addlistings_done
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetListingsDone resets all changes to the "listings_done" field.
+func (m *JobMutation) ResetListingsDone() {
+	m.listings_done = nil
+	m.addlistings_done = nil
+}
+
+// SetErrorCount sets the "error_count" field.
+func (m *JobMutation) SetErrorCount(i int) {
+	m.error_count = &i
+	m.adderror_count = nil
+}
+
+// ErrorCount returns the value of the "error_count" field in the mutation.
+func (m *JobMutation) ErrorCount() (r int, exists bool) {
+	v := m.error_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCount returns the old "error_count" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldErrorCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCount: %w", err)
+	}
+	return oldValue.ErrorCount, nil
+}
+
+// AddErrorCount adds i to the "error_count" field.
+func (m *JobMutation) AddErrorCount(i int) {
+	if m.adderror_count != nil {
+		*m.adderror_count += i
+	} else {
+		m.adderror_count = &i
+	}
+}
+
+// AddedErrorCount returns the value that was added to the "error_count" field in this mutation.
+func (m *JobMutation) AddedErrorCount() (r int, exists bool) {
+	v := m.adderror_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetErrorCount resets all changes to the "error_count" field.
+func (m *JobMutation) ResetErrorCount() {
+	m.error_count = nil
+	m.adderror_count = nil
+}
+
+// SetErrors sets the "errors" field.
+func (m *JobMutation) SetErrors(s string) {
+	m.errors = &s
+}
+
+// Errors returns the value of the "errors" field in the mutation.
+func (m *JobMutation) Errors() (r string, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrors returns the old "errors" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldErrors(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	}
+	return oldValue.Errors, nil
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (m *JobMutation) ClearErrors() {
+	m.errors = nil
+	m.clearedFields[job.FieldErrors] = struct{}{}
+}
+
+// ErrorsCleared returns if the "errors" field was cleared in this mutation.
+func (m *JobMutation) ErrorsCleared() bool {
+	_, ok := m.clearedFields[job.FieldErrors]
+	return ok
+}
+
+// ResetErrors resets all changes to the "errors" field.
+func (m *JobMutation) ResetErrors() {
+	m.errors = nil
+	delete(m.clearedFields, job.FieldErrors)
+}
+
+// SetNote sets the "note" field.
+func (m *JobMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *JobMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *JobMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[job.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *JobMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[job.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *JobMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, job.FieldNote)
+}
+
+// SetAcknowledged sets the "acknowledged" field.
+func (m *JobMutation) SetAcknowledged(b bool) {
+	m.acknowledged = &b
+}
+
+// Acknowledged returns the value of the "acknowledged" field in the mutation.
+func (m *JobMutation) Acknowledged() (r bool, exists bool) {
+	v := m.acknowledged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledged returns the old "acknowledged" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAcknowledged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledged: %w", err)
+	}
+	return oldValue.Acknowledged, nil
+}
+
+// ResetAcknowledged resets all changes to the "acknowledged" field.
+func (m *JobMutation) ResetAcknowledged() {
+	m.acknowledged = nil
+}
+
+// SetAnnotatedAt sets the "annotated_at" field.
+func (m *JobMutation) SetAnnotatedAt(t time.Time) {
+	m.annotated_at = &t
+}
+
+// AnnotatedAt returns the value of the "annotated_at" field in the mutation.
+func (m *JobMutation) AnnotatedAt() (r time.Time, exists bool) {
+	v := m.annotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnotatedAt returns the old "annotated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAnnotatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnotatedAt: %w", err)
+	}
+	return oldValue.AnnotatedAt, nil
+}
+
+// ClearAnnotatedAt clears the value of the "annotated_at" field.
+func (m *JobMutation) ClearAnnotatedAt() {
+	m.annotated_at = nil
+	m.clearedFields[job.FieldAnnotatedAt] = struct{}{}
+}
+
+// AnnotatedAtCleared returns if the "annotated_at" field was cleared in this mutation.
+func (m *JobMutation) AnnotatedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldAnnotatedAt]
+	return ok
+}
+
+// ResetAnnotatedAt resets all changes to the "annotated_at" field.
+func (m *JobMutation) ResetAnnotatedAt() {
+	m.annotated_at = nil
+	delete(m.clearedFields, job.FieldAnnotatedAt)
+}
+
+// SetTraceID sets the "trace_id" field.
+func (m *JobMutation) SetTraceID(s string) {
+	m.trace_id = &s
+}
+
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *JobMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceID returns the old "trace_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTraceID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
+	}
+	return oldValue.TraceID, nil
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (m *JobMutation) ClearTraceID() {
+	m.trace_id = nil
+	m.clearedFields[job.FieldTraceID] = struct{}{}
+}
+
+// TraceIDCleared returns if the "trace_id" field was cleared in this mutation.
+func (m *JobMutation) TraceIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldTraceID]
+	return ok
+}
+
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *JobMutation) ResetTraceID() {
+	m.trace_id = nil
+	delete(m.clearedFields, job.FieldTraceID)
+}
+
+// SetConnectionConfigVersion sets the "connection_config_version" field.
+func (m *JobMutation) SetConnectionConfigVersion(i int) {
+	m.connection_config_version = &i
+	m.addconnection_config_version = nil
+}
+
+// ConnectionConfigVersion returns the value of the "connection_config_version" field in the mutation.
+func (m *JobMutation) ConnectionConfigVersion() (r int, exists bool) {
+	v := m.connection_config_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectionConfigVersion returns the old "connection_config_version" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldConnectionConfigVersion(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectionConfigVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectionConfigVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectionConfigVersion: %w", err)
+	}
+	return oldValue.ConnectionConfigVersion, nil
+}
+
+// AddConnectionConfigVersion adds i to the "connection_config_version" field.
+func (m *JobMutation) AddConnectionConfigVersion(i int) {
+	if m.addconnection_config_version != nil {
+		*m.addconnection_config_version += i
+	} else {
+		m.addconnection_config_version = &i
+	}
+}
+
+// AddedConnectionConfigVersion returns the value that was added to the "connection_config_version" field in this mutation.
+func (m *JobMutation) AddedConnectionConfigVersion() (r int, exists bool) {
+	v := m.addconnection_config_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearConnectionConfigVersion clears the value of the "connection_config_version" field.
+func (m *JobMutation) ClearConnectionConfigVersion() {
+	m.connection_config_version = nil
+	m.addconnection_config_version = nil
+	m.clearedFields[job.FieldConnectionConfigVersion] = struct{}{}
+}
+
+// ConnectionConfigVersionCleared returns if the "connection_config_version" field was cleared in this mutation.
+func (m *JobMutation) ConnectionConfigVersionCleared() bool {
+	_, ok := m.clearedFields[job.FieldConnectionConfigVersion]
+	return ok
+}
+
+// ResetConnectionConfigVersion resets all changes to the "connection_config_version" field.
+func (m *JobMutation) ResetConnectionConfigVersion() {
+	m.connection_config_version = nil
+	m.addconnection_config_version = nil
+	delete(m.clearedFields, job.FieldConnectionConfigVersion)
+}
+
+// SetTriggerDetail sets the "trigger_detail" field.
+func (m *JobMutation) SetTriggerDetail(mtd *model.JobTriggerDetail) {
+	m.trigger_detail = &mtd
+}
+
+// TriggerDetail returns the value of the "trigger_detail" field in the mutation.
+func (m *JobMutation) TriggerDetail() (r *model.JobTriggerDetail, exists bool) {
+	v := m.trigger_detail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggerDetail returns the old "trigger_detail" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTriggerDetail(ctx context.Context) (v *model.JobTriggerDetail, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerDetail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerDetail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerDetail: %w", err)
+	}
+	return oldValue.TriggerDetail, nil
+}
+
+// ClearTriggerDetail clears the value of the "trigger_detail" field.
+func (m *JobMutation) ClearTriggerDetail() {
+	m.trigger_detail = nil
+	m.clearedFields[job.FieldTriggerDetail] = struct{}{}
+}
+
+// TriggerDetailCleared returns if the "trigger_detail" field was cleared in this mutation.
+func (m *JobMutation) TriggerDetailCleared() bool {
+	_, ok := m.clearedFields[job.FieldTriggerDetail]
+	return ok
+}
+
+// ResetTriggerDetail resets all changes to the "trigger_detail" field.
+func (m *JobMutation) ResetTriggerDetail() {
+	m.trigger_detail = nil
+	delete(m.clearedFields, job.FieldTriggerDetail)
+}
+
+// SetTaskConfigHash sets the "task_config_hash" field.
+func (m *JobMutation) SetTaskConfigHash(s string) {
+	m.task_config_hash = &s
+}
+
+// TaskConfigHash returns the value of the "task_config_hash" field in the mutation.
+func (m *JobMutation) TaskConfigHash() (r string, exists bool) {
+	v := m.task_config_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskConfigHash returns the old "task_config_hash" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTaskConfigHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskConfigHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskConfigHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskConfigHash: %w", err)
+	}
+	return oldValue.TaskConfigHash, nil
+}
+
+// ClearTaskConfigHash clears the value of the "task_config_hash" field.
+func (m *JobMutation) ClearTaskConfigHash() {
+	m.task_config_hash = nil
+	m.clearedFields[job.FieldTaskConfigHash] = struct{}{}
+}
+
+// TaskConfigHashCleared returns if the "task_config_hash" field was cleared in this mutation.
+func (m *JobMutation) TaskConfigHashCleared() bool {
+	_, ok := m.clearedFields[job.FieldTaskConfigHash]
+	return ok
+}
+
+// ResetTaskConfigHash resets all changes to the "task_config_hash" field.
+func (m *JobMutation) ResetTaskConfigHash() {
+	m.task_config_hash = nil
+	delete(m.clearedFields, job.FieldTaskConfigHash)
+}
+
+// SetConcurrency sets the "concurrency" field.
+func (m *JobMutation) SetConcurrency(mc *model.JobConcurrency) {
+	m.concurrency = &mc
+}
+
+// Concurrency returns the value of the "concurrency" field in the mutation.
+func (m *JobMutation) Concurrency() (r *model.JobConcurrency, exists bool) {
+	v := m.concurrency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrency returns the old "concurrency" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldConcurrency(ctx context.Context) (v *model.JobConcurrency, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrency: %w", err)
+	}
+	return oldValue.Concurrency, nil
+}
+
+// ClearConcurrency clears the value of the "concurrency" field.
+func (m *JobMutation) ClearConcurrency() {
+	m.concurrency = nil
+	m.clearedFields[job.FieldConcurrency] = struct{}{}
+}
+
+// ConcurrencyCleared returns if the "concurrency" field was cleared in this mutation.
+func (m *JobMutation) ConcurrencyCleared() bool {
+	_, ok := m.clearedFields[job.FieldConcurrency]
+	return ok
+}
+
+// ResetConcurrency resets all changes to the "concurrency" field.
+func (m *JobMutation) ResetConcurrency() {
+	m.concurrency = nil
+	delete(m.clearedFields, job.FieldConcurrency)
+}
+
+// SetConfigSnapshot sets the "config_snapshot" field.
+func (m *JobMutation) SetConfigSnapshot(mcs *model.JobConfigSnapshot) {
+	m.config_snapshot = &mcs
+}
+
+// ConfigSnapshot returns the value of the "config_snapshot" field in the mutation.
+func (m *JobMutation) ConfigSnapshot() (r *model.JobConfigSnapshot, exists bool) {
+	v := m.config_snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfigSnapshot returns the old "config_snapshot" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldConfigSnapshot(ctx context.Context) (v *model.JobConfigSnapshot, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfigSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfigSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfigSnapshot: %w", err)
+	}
+	return oldValue.ConfigSnapshot, nil
+}
+
+// ClearConfigSnapshot clears the value of the "config_snapshot" field.
+func (m *JobMutation) ClearConfigSnapshot() {
+	m.config_snapshot = nil
+	m.clearedFields[job.FieldConfigSnapshot] = struct{}{}
+}
+
+// ConfigSnapshotCleared returns if the "config_snapshot" field was cleared in this mutation.
+func (m *JobMutation) ConfigSnapshotCleared() bool {
+	_, ok := m.clearedFields[job.FieldConfigSnapshot]
+	return ok
+}
+
+// ResetConfigSnapshot resets all changes to the "config_snapshot" field.
+func (m *JobMutation) ResetConfigSnapshot() {
+	m.config_snapshot = nil
+	delete(m.clearedFields, job.FieldConfigSnapshot)
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *JobMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[job.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *JobMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *JobMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// AddLogIDs adds the "logs" edge to the JobLog entity by ids.
+func (m *JobMutation) AddLogIDs(ids ...int) {
+	if m.logs == nil {
+		m.logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLogs clears the "logs" edge to the JobLog entity.
+func (m *JobMutation) ClearLogs() {
+	m.clearedlogs = true
+}
+
+// LogsCleared reports if the "logs" edge to the JobLog entity was cleared.
+func (m *JobMutation) LogsCleared() bool {
+	return m.clearedlogs
+}
+
+// RemoveLogIDs removes the "logs" edge to the JobLog entity by IDs.
+func (m *JobMutation) RemoveLogIDs(ids ...int) {
+	if m.removedlogs == nil {
+		m.removedlogs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.logs, ids[i])
+		m.removedlogs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLogs returns the removed IDs of the "logs" edge to the JobLog entity.
+func (m *JobMutation) RemovedLogsIDs() (ids []int) {
+	for id := range m.removedlogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LogsIDs returns the "logs" edge IDs in the mutation.
+func (m *JobMutation) LogsIDs() (ids []int) {
+	for id := range m.logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLogs resets all changes to the "logs" edge.
+func (m *JobMutation) ResetLogs() {
+	m.logs = nil
+	m.clearedlogs = false
+	m.removedlogs = nil
+}
+
+// AddRetryQueueIDs adds the "retry_queue" edge to the RetryQueue entity by ids.
+func (m *JobMutation) AddRetryQueueIDs(ids ...uuid.UUID) {
+	if m.retry_queue == nil {
+		m.retry_queue = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.retry_queue[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRetryQueue clears the "retry_queue" edge to the RetryQueue entity.
+func (m *JobMutation) ClearRetryQueue() {
+	m.clearedretry_queue = true
+}
+
+// RetryQueueCleared reports if the "retry_queue" edge to the RetryQueue entity was cleared.
+func (m *JobMutation) RetryQueueCleared() bool {
+	return m.clearedretry_queue
+}
+
+// RemoveRetryQueueIDs removes the "retry_queue" edge to the RetryQueue entity by IDs.
+func (m *JobMutation) RemoveRetryQueueIDs(ids ...uuid.UUID) {
+	if m.removedretry_queue == nil {
+		m.removedretry_queue = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.retry_queue, ids[i])
+		m.removedretry_queue[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRetryQueue returns the removed IDs of the "retry_queue" edge to the RetryQueue entity.
+func (m *JobMutation) RemovedRetryQueueIDs() (ids []uuid.UUID) {
+	for id := range m.removedretry_queue {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RetryQueueIDs returns the "retry_queue" edge IDs in the mutation.
+func (m *JobMutation) RetryQueueIDs() (ids []uuid.UUID) {
+	for id := range m.retry_queue {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRetryQueue resets all changes to the "retry_queue" edge.
+func (m *JobMutation) ResetRetryQueue() {
+	m.retry_queue = nil
+	m.clearedretry_queue = false
+	m.removedretry_queue = nil
+}
+
+// AddEventIDs adds the "events" edge to the JobEvent entity by ids.
+func (m *JobMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the JobEvent entity.
+func (m *JobMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the JobEvent entity was cleared.
+func (m *JobMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the JobEvent entity by IDs.
+func (m *JobMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the JobEvent entity.
+func (m *JobMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *JobMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *JobMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// ClearParent clears the "parent" edge to the Job entity.
+func (m *JobMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[job.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Job entity was cleared.
+func (m *JobMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *JobMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Job entity by ids.
+func (m *JobMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Job entity.
+func (m *JobMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Job entity was cleared.
+func (m *JobMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Job entity by IDs.
+func (m *JobMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Job entity.
+func (m *JobMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *JobMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *JobMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.task != nil {
+		fields = append(fields, job.FieldTaskID)
+	}
+	if m.parent != nil {
+		fields = append(fields, job.FieldParentID)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.trigger != nil {
+		fields = append(fields, job.FieldTrigger)
+	}
+	if m.start_time != nil {
+		fields = append(fields, job.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, job.FieldEndTime)
+	}
+	if m.files_transferred != nil {
+		fields = append(fields, job.FieldFilesTransferred)
+	}
+	if m.bytes_transferred != nil {
+		fields = append(fields, job.FieldBytesTransferred)
+	}
+	if m.uploaded_files != nil {
+		fields = append(fields, job.FieldUploadedFiles)
+	}
+	if m.uploaded_bytes != nil {
+		fields = append(fields, job.FieldUploadedBytes)
+	}
+	if m.downloaded_files != nil {
+		fields = append(fields, job.FieldDownloadedFiles)
+	}
+	if m.downloaded_bytes != nil {
+		fields = append(fields, job.FieldDownloadedBytes)
+	}
+	if m.files_deleted != nil {
+		fields = append(fields, job.FieldFilesDeleted)
+	}
+	if m.files_checked != nil {
+		fields = append(fields, job.FieldFilesChecked)
+	}
+	if m.listings_done != nil {
+		fields = append(fields, job.FieldListingsDone)
+	}
+	if m.error_count != nil {
+		fields = append(fields, job.FieldErrorCount)
+	}
+	if m.errors != nil {
+		fields = append(fields, job.FieldErrors)
+	}
+	if m.note != nil {
+		fields = append(fields, job.FieldNote)
+	}
+	if m.acknowledged != nil {
+		fields = append(fields, job.FieldAcknowledged)
+	}
+	if m.annotated_at != nil {
+		fields = append(fields, job.FieldAnnotatedAt)
+	}
+	if m.trace_id != nil {
+		fields = append(fields, job.FieldTraceID)
+	}
+	if m.connection_config_version != nil {
+		fields = append(fields, job.FieldConnectionConfigVersion)
+	}
+	if m.trigger_detail != nil {
+		fields = append(fields, job.FieldTriggerDetail)
+	}
+	if m.task_config_hash != nil {
+		fields = append(fields, job.FieldTaskConfigHash)
+	}
+	if m.concurrency != nil {
+		fields = append(fields, job.FieldConcurrency)
+	}
+	if m.config_snapshot != nil {
+		fields = append(fields, job.FieldConfigSnapshot)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldTaskID:
+		return m.TaskID()
+	case job.FieldParentID:
+		return m.ParentID()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldTrigger:
+		return m.Trigger()
+	case job.FieldStartTime:
+		return m.StartTime()
+	case job.FieldEndTime:
+		return m.EndTime()
+	case job.FieldFilesTransferred:
+		return m.FilesTransferred()
+	case job.FieldBytesTransferred:
+		return m.BytesTransferred()
+	case job.FieldUploadedFiles:
+		return m.UploadedFiles()
+	case job.FieldUploadedBytes:
+		return m.UploadedBytes()
+	case job.FieldDownloadedFiles:
+		return m.DownloadedFiles()
+	case job.FieldDownloadedBytes:
+		return m.DownloadedBytes()
+	case job.FieldFilesDeleted:
+		return m.FilesDeleted()
+	case job.FieldFilesChecked:
+		return m.FilesChecked()
+	case job.FieldListingsDone:
+		return m.ListingsDone()
+	case job.FieldErrorCount:
+		return m.ErrorCount()
+	case job.FieldErrors:
+		return m.Errors()
+	case job.FieldNote:
+		return m.Note()
+	case job.FieldAcknowledged:
+		return m.Acknowledged()
+	case job.FieldAnnotatedAt:
+		return m.AnnotatedAt()
+	case job.FieldTraceID:
+		return m.TraceID()
+	case job.FieldConnectionConfigVersion:
+		return m.ConnectionConfigVersion()
+	case job.FieldTriggerDetail:
+		return m.TriggerDetail()
+	case job.FieldTaskConfigHash:
+		return m.TaskConfigHash()
+	case job.FieldConcurrency:
+		return m.Concurrency()
+	case job.FieldConfigSnapshot:
+		return m.ConfigSnapshot()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case job.FieldParentID:
+		return m.OldParentID(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case job.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case job.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case job.FieldFilesTransferred:
+		return m.OldFilesTransferred(ctx)
+	case job.FieldBytesTransferred:
+		return m.OldBytesTransferred(ctx)
+	case job.FieldUploadedFiles:
+		return m.OldUploadedFiles(ctx)
+	case job.FieldUploadedBytes:
+		return m.OldUploadedBytes(ctx)
+	case job.FieldDownloadedFiles:
+		return m.OldDownloadedFiles(ctx)
+	case job.FieldDownloadedBytes:
+		return m.OldDownloadedBytes(ctx)
+	case job.FieldFilesDeleted:
+		return m.OldFilesDeleted(ctx)
+	case job.FieldFilesChecked:
+		return m.OldFilesChecked(ctx)
+	case job.FieldListingsDone:
+		return m.OldListingsDone(ctx)
+	case job.FieldErrorCount:
+		return m.OldErrorCount(ctx)
+	case job.FieldErrors:
+		return m.OldErrors(ctx)
+	case job.FieldNote:
+		return m.OldNote(ctx)
+	case job.FieldAcknowledged:
+		return m.OldAcknowledged(ctx)
+	case job.FieldAnnotatedAt:
+		return m.OldAnnotatedAt(ctx)
+	case job.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case job.FieldConnectionConfigVersion:
+		return m.OldConnectionConfigVersion(ctx)
+	case job.FieldTriggerDetail:
+		return m.OldTriggerDetail(ctx)
+	case job.FieldTaskConfigHash:
+		return m.OldTaskConfigHash(ctx)
+	case job.FieldConcurrency:
+		return m.OldConcurrency(ctx)
+	case job.FieldConfigSnapshot:
+		return m.OldConfigSnapshot(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case job.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(model.JobStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldTrigger:
+		v, ok := value.(model.JobTrigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case job.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case job.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case job.FieldFilesTransferred:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesTransferred(v)
+		return nil
+	case job.FieldBytesTransferred:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytesTransferred(v)
+		return nil
+	case job.FieldUploadedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedFiles(v)
+		return nil
+	case job.FieldUploadedBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedBytes(v)
+		return nil
+	case job.FieldDownloadedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadedFiles(v)
+		return nil
+	case job.FieldDownloadedBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadedBytes(v)
+		return nil
+	case job.FieldFilesDeleted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesDeleted(v)
+		return nil
+	case job.FieldFilesChecked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesChecked(v)
+		return nil
+	case job.FieldListingsDone:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingsDone(v)
+		return nil
+	case job.FieldErrorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCount(v)
+		return nil
+	case job.FieldErrors:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	case job.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case job.FieldAcknowledged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledged(v)
+		return nil
+	case job.FieldAnnotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnotatedAt(v)
+		return nil
+	case job.FieldTraceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceID(v)
+		return nil
+	case job.FieldConnectionConfigVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectionConfigVersion(v)
+		return nil
+	case job.FieldTriggerDetail:
+		v, ok := value.(*model.JobTriggerDetail)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerDetail(v)
+		return nil
+	case job.FieldTaskConfigHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskConfigHash(v)
+		return nil
+	case job.FieldConcurrency:
+		v, ok := value.(*model.JobConcurrency)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrency(v)
+		return nil
+	case job.FieldConfigSnapshot:
+		v, ok := value.(*model.JobConfigSnapshot)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfigSnapshot(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addfiles_transferred != nil {
+		fields = append(fields, job.FieldFilesTransferred)
+	}
+	if m.addbytes_transferred != nil {
+		fields = append(fields, job.FieldBytesTransferred)
+	}
+	if m.adduploaded_files != nil {
+		fields = append(fields, job.FieldUploadedFiles)
+	}
+	if m.adduploaded_bytes != nil {
+		fields = append(fields, job.FieldUploadedBytes)
+	}
+	if m.adddownloaded_files != nil {
+		fields = append(fields, job.FieldDownloadedFiles)
+	}
+	if m.adddownloaded_bytes != nil {
+		fields = append(fields, job.FieldDownloadedBytes)
+	}
+	if m.addfiles_deleted != nil {
+		fields = append(fields, job.FieldFilesDeleted)
+	}
+	if m.addfiles_checked != nil {
+		fields = append(fields, job.FieldFilesChecked)
+	}
+	if m.addlistings_done != nil {
+		fields = append(fields, job.FieldListingsDone)
+	}
+	if m.adderror_count != nil {
+		fields = append(fields, job.FieldErrorCount)
+	}
+	if m.addconnection_config_version != nil {
+		fields = append(fields, job.FieldConnectionConfigVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldFilesTransferred:
+		return m.AddedFilesTransferred()
+	case job.FieldBytesTransferred:
+		return m.AddedBytesTransferred()
+	case job.FieldUploadedFiles:
+		return m.AddedUploadedFiles()
+	case job.FieldUploadedBytes:
+		return m.AddedUploadedBytes()
+	case job.FieldDownloadedFiles:
+		return m.AddedDownloadedFiles()
+	case job.FieldDownloadedBytes:
+		return m.AddedDownloadedBytes()
+	case job.FieldFilesDeleted:
+		return m.AddedFilesDeleted()
+	case job.FieldFilesChecked:
+		return m.AddedFilesChecked()
+	case job.FieldListingsDone:
+		return m.AddedListingsDone()
+	case job.FieldErrorCount:
+		return m.AddedErrorCount()
+	case job.FieldConnectionConfigVersion:
+		return m.AddedConnectionConfigVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldFilesTransferred:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFilesTransferred(v)
+		return nil
+	case job.FieldBytesTransferred:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBytesTransferred(v)
+		return nil
+	case job.FieldUploadedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUploadedFiles(v)
+		return nil
+	case job.FieldUploadedBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUploadedBytes(v)
+		return nil
+	case job.FieldDownloadedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloadedFiles(v)
+		return nil
+	case job.FieldDownloadedBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloadedBytes(v)
+		return nil
+	case job.FieldFilesDeleted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFilesDeleted(v)
+		return nil
+	case job.FieldFilesChecked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFilesChecked(v)
+		return nil
+	case job.FieldListingsDone:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddListingsDone(v)
+		return nil
+	case job.FieldErrorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrorCount(v)
+		return nil
+	case job.FieldConnectionConfigVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConnectionConfigVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldParentID) {
+		fields = append(fields, job.FieldParentID)
+	}
+	if m.FieldCleared(job.FieldEndTime) {
+		fields = append(fields, job.FieldEndTime)
+	}
+	if m.FieldCleared(job.FieldErrors) {
+		fields = append(fields, job.FieldErrors)
+	}
+	if m.FieldCleared(job.FieldNote) {
+		fields = append(fields, job.FieldNote)
+	}
+	if m.FieldCleared(job.FieldAnnotatedAt) {
+		fields = append(fields, job.FieldAnnotatedAt)
+	}
+	if m.FieldCleared(job.FieldTraceID) {
+		fields = append(fields, job.FieldTraceID)
+	}
+	if m.FieldCleared(job.FieldConnectionConfigVersion) {
+		fields = append(fields, job.FieldConnectionConfigVersion)
+	}
+	if m.FieldCleared(job.FieldTriggerDetail) {
+		fields = append(fields, job.FieldTriggerDetail)
+	}
+	if m.FieldCleared(job.FieldTaskConfigHash) {
+		fields = append(fields, job.FieldTaskConfigHash)
+	}
+	if m.FieldCleared(job.FieldConcurrency) {
+		fields = append(fields, job.FieldConcurrency)
+	}
+	if m.FieldCleared(job.FieldConfigSnapshot) {
+		fields = append(fields, job.FieldConfigSnapshot)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case job.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case job.FieldErrors:
+		m.ClearErrors()
+		return nil
+	case job.FieldNote:
+		m.ClearNote()
+		return nil
+	case job.FieldAnnotatedAt:
+		m.ClearAnnotatedAt()
+		return nil
+	case job.FieldTraceID:
+		m.ClearTraceID()
+		return nil
+	case job.FieldConnectionConfigVersion:
+		m.ClearConnectionConfigVersion()
+		return nil
+	case job.FieldTriggerDetail:
+		m.ClearTriggerDetail()
+		return nil
+	case job.FieldTaskConfigHash:
+		m.ClearTaskConfigHash()
+		return nil
+	case job.FieldConcurrency:
+		m.ClearConcurrency()
+		return nil
+	case job.FieldConfigSnapshot:
+		m.ClearConfigSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case job.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case job.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case job.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case job.FieldFilesTransferred:
+		m.ResetFilesTransferred()
+		return nil
+	case job.FieldBytesTransferred:
+		m.ResetBytesTransferred()
+		return nil
+	case job.FieldUploadedFiles:
+		m.ResetUploadedFiles()
+		return nil
+	case job.FieldUploadedBytes:
+		m.ResetUploadedBytes()
+		return nil
+	case job.FieldDownloadedFiles:
+		m.ResetDownloadedFiles()
+		return nil
+	case job.FieldDownloadedBytes:
+		m.ResetDownloadedBytes()
+		return nil
+	case job.FieldFilesDeleted:
+		m.ResetFilesDeleted()
+		return nil
+	case job.FieldFilesChecked:
+		m.ResetFilesChecked()
+		return nil
+	case job.FieldListingsDone:
+		m.ResetListingsDone()
+		return nil
+	case job.FieldErrorCount:
+		m.ResetErrorCount()
+		return nil
+	case job.FieldErrors:
+		m.ResetErrors()
+		return nil
+	case job.FieldNote:
+		m.ResetNote()
+		return nil
+	case job.FieldAcknowledged:
+		m.ResetAcknowledged()
+		return nil
+	case job.FieldAnnotatedAt:
+		m.ResetAnnotatedAt()
+		return nil
+	case job.FieldTraceID:
+		m.ResetTraceID()
+		return nil
+	case job.FieldConnectionConfigVersion:
+		m.ResetConnectionConfigVersion()
+		return nil
+	case job.FieldTriggerDetail:
+		m.ResetTriggerDetail()
+		return nil
+	case job.FieldTaskConfigHash:
+		m.ResetTaskConfigHash()
+		return nil
+	case job.FieldConcurrency:
+		m.ResetConcurrency()
+		return nil
+	case job.FieldConfigSnapshot:
+		m.ResetConfigSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.task != nil {
+		edges = append(edges, job.EdgeTask)
+	}
+	if m.logs != nil {
+		edges = append(edges, job.EdgeLogs)
+	}
+	if m.retry_queue != nil {
+		edges = append(edges, job.EdgeRetryQueue)
+	}
+	if m.events != nil {
+		edges = append(edges, job.EdgeEvents)
+	}
+	if m.parent != nil {
+		edges = append(edges, job.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, job.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case job.EdgeLogs:
+		ids := make([]ent.Value, 0, len(m.logs))
+		for id := range m.logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case job.EdgeRetryQueue:
+		ids := make([]ent.Value, 0, len(m.retry_queue))
+		for id := range m.retry_queue {
+			ids = append(ids, id)
+		}
+		return ids
+	case job.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case job.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case job.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedlogs != nil {
+		edges = append(edges, job.EdgeLogs)
+	}
+	if m.removedretry_queue != nil {
+		edges = append(edges, job.EdgeRetryQueue)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, job.EdgeEvents)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, job.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeLogs:
+		ids := make([]ent.Value, 0, len(m.removedlogs))
+		for id := range m.removedlogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case job.EdgeRetryQueue:
+		ids := make([]ent.Value, 0, len(m.removedretry_queue))
+		for id := range m.removedretry_queue {
+			ids = append(ids, id)
+		}
+		return ids
+	case job.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case job.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedtask {
+		edges = append(edges, job.EdgeTask)
+	}
+	if m.clearedlogs {
+		edges = append(edges, job.EdgeLogs)
+	}
+	if m.clearedretry_queue {
+		edges = append(edges, job.EdgeRetryQueue)
+	}
+	if m.clearedevents {
+		edges = append(edges, job.EdgeEvents)
+	}
+	if m.clearedparent {
+		edges = append(edges, job.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, job.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case job.EdgeTask:
+		return m.clearedtask
+	case job.EdgeLogs:
+		return m.clearedlogs
+	case job.EdgeRetryQueue:
+		return m.clearedretry_queue
+	case job.EdgeEvents:
+		return m.clearedevents
+	case job.EdgeParent:
+		return m.clearedparent
+	case job.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	switch name {
+	case job.EdgeTask:
+		m.ClearTask()
+		return nil
+	case job.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	switch name {
+	case job.EdgeTask:
+		m.ResetTask()
+		return nil
+	case job.EdgeLogs:
+		m.ResetLogs()
+		return nil
+	case job.EdgeRetryQueue:
+		m.ResetRetryQueue()
+		return nil
+	case job.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case job.EdgeParent:
+		m.ResetParent()
+		return nil
+	case job.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// JobEventMutation represents an operation that mutates the JobEvent nodes in the graph.
+type JobEventMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	_type            *model.JobEventType
+	command          *string
+	args             *[]string
+	appendargs       []string
+	exit_code        *int
+	addexit_code     *int
+	duration_ms      *int64
+	addduration_ms   *int64
+	output           *string
+	output_truncated *bool
+	error            *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	job              *uuid.UUID
+	clearedjob       bool
+	done             bool
+	oldValue         func(context.Context) (*JobEvent, error)
+	predicates       []predicate.JobEvent
+}
+
+var _ ent.Mutation = (*JobEventMutation)(nil)
+
+// jobeventOption allows management of the mutation configuration using functional options.
+type jobeventOption func(*JobEventMutation)
+
+// newJobEventMutation creates new mutation for the JobEvent entity.
+func newJobEventMutation(c config, op Op, opts ...jobeventOption) *JobEventMutation {
+	m := &JobEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobEventID sets the ID field of the mutation.
+func withJobEventID(id uuid.UUID) jobeventOption {
+	return func(m *JobEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobEvent
+		)
+		m.oldValue = func(ctx context.Context) (*JobEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobEvent sets the old JobEvent of the mutation.
+func withJobEvent(node *JobEvent) jobeventOption {
+	return func(m *JobEventMutation) {
+		m.oldValue = func(context.Context) (*JobEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JobEvent entities.
+func (m *JobEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobID sets the "job_id" field.
+func (m *JobEventMutation) SetJobID(u uuid.UUID) {
+	m.job = &u
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *JobEventMutation) JobID() (r uuid.UUID, exists bool) {
+	v := m.job
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *JobEventMutation) ResetJobID() {
+	m.job = nil
+}
+
+// SetType sets the "type" field.
+func (m *JobEventMutation) SetType(met model.JobEventType) {
+	m._type = &met
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *JobEventMutation) GetType() (r model.JobEventType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldType(ctx context.Context) (v model.JobEventType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *JobEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCommand sets the "command" field.
+func (m *JobEventMutation) SetCommand(s string) {
+	m.command = &s
+}
+
+// Command returns the value of the "command" field in the mutation.
+func (m *JobEventMutation) Command() (r string, exists bool) {
+	v := m.command
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommand returns the old "command" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldCommand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommand: %w", err)
+	}
+	return oldValue.Command, nil
+}
+
+// ResetCommand resets all changes to the "command" field.
+func (m *JobEventMutation) ResetCommand() {
+	m.command = nil
+}
+
+// SetArgs sets the "args" field.
+func (m *JobEventMutation) SetArgs(s []string) {
+	m.args = &s
+	m.appendargs = nil
+}
+
+// Args returns the value of the "args" field in the mutation.
+func (m *JobEventMutation) Args() (r []string, exists bool) {
+	v := m.args
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArgs returns the old "args" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldArgs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArgs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArgs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArgs: %w", err)
+	}
+	return oldValue.Args, nil
+}
+
+// AppendArgs adds s to the "args" field.
+func (m *JobEventMutation) AppendArgs(s []string) {
+	m.appendargs = append(m.appendargs, s...)
+}
+
+// AppendedArgs returns the list of values that were appended to the "args" field in this mutation.
+func (m *JobEventMutation) AppendedArgs() ([]string, bool) {
+	if len(m.appendargs) == 0 {
+		return nil, false
+	}
+	return m.appendargs, true
+}
+
+// ClearArgs clears the value of the "args" field.
+func (m *JobEventMutation) ClearArgs() {
+	m.args = nil
+	m.appendargs = nil
+	m.clearedFields[jobevent.FieldArgs] = struct{}{}
+}
+
+// ArgsCleared returns if the "args" field was cleared in this mutation.
+func (m *JobEventMutation) ArgsCleared() bool {
+	_, ok := m.clearedFields[jobevent.FieldArgs]
+	return ok
+}
+
+// ResetArgs resets all changes to the "args" field.
+func (m *JobEventMutation) ResetArgs() {
+	m.args = nil
+	m.appendargs = nil
+	delete(m.clearedFields, jobevent.FieldArgs)
+}
+
+// SetExitCode sets the "exit_code" field.
+func (m *JobEventMutation) SetExitCode(i int) {
+	m.exit_code = &i
+	m.addexit_code = nil
+}
+
+// ExitCode returns the value of the "exit_code" field in the mutation.
+func (m *JobEventMutation) ExitCode() (r int, exists bool) {
+	v := m.exit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitCode returns the old "exit_code" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldExitCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitCode: %w", err)
+	}
+	return oldValue.ExitCode, nil
+}
+
+// AddExitCode adds i to the "exit_code" field.
+func (m *JobEventMutation) AddExitCode(i int) {
+	if m.addexit_code != nil {
+		*m.addexit_code += i
+	} else {
+		m.addexit_code = &i
+	}
+}
+
+// AddedExitCode returns the value that was added to the "exit_code" field in this mutation.
+func (m *JobEventMutation) AddedExitCode() (r int, exists bool) {
+	v := m.addexit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (m *JobEventMutation) ClearExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	m.clearedFields[jobevent.FieldExitCode] = struct{}{}
+}
+
+// ExitCodeCleared returns if the "exit_code" field was cleared in this mutation.
+func (m *JobEventMutation) ExitCodeCleared() bool {
+	_, ok := m.clearedFields[jobevent.FieldExitCode]
+	return ok
+}
+
+// ResetExitCode resets all changes to the "exit_code" field.
+func (m *JobEventMutation) ResetExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	delete(m.clearedFields, jobevent.FieldExitCode)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *JobEventMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *JobEventMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *JobEventMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *JobEventMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *JobEventMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetOutput sets the "output" field.
+func (m *JobEventMutation) SetOutput(s string) {
+	m.output = &s
+}
+
+// Output returns the value of the "output" field in the mutation.
+func (m *JobEventMutation) Output() (r string, exists bool) {
+	v := m.output
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutput returns the old "output" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldOutput(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutput: %w", err)
+	}
+	return oldValue.Output, nil
+}
+
+// ResetOutput resets all changes to the "output" field.
+func (m *JobEventMutation) ResetOutput() {
+	m.output = nil
+}
+
+// SetOutputTruncated sets the "output_truncated" field.
+func (m *JobEventMutation) SetOutputTruncated(b bool) {
+	m.output_truncated = &b
+}
+
+// OutputTruncated returns the value of the "output_truncated" field in the mutation.
+func (m *JobEventMutation) OutputTruncated() (r bool, exists bool) {
+	v := m.output_truncated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTruncated returns the old "output_truncated" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldOutputTruncated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTruncated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTruncated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTruncated: %w", err)
+	}
+	return oldValue.OutputTruncated, nil
+}
+
+// ResetOutputTruncated resets all changes to the "output_truncated" field.
+func (m *JobEventMutation) ResetOutputTruncated() {
+	m.output_truncated = nil
+}
+
+// SetError sets the "error" field.
+func (m *JobEventMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobEventMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobEventMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobevent.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobEventMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobevent.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobEventMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobevent.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the JobEvent entity.
+// If the JobEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (m *JobEventMutation) ClearJob() {
+	m.clearedjob = true
+	m.clearedFields[jobevent.FieldJobID] = struct{}{}
+}
+
+// JobCleared reports if the "job" edge to the Job entity was cleared.
+func (m *JobEventMutation) JobCleared() bool {
+	return m.clearedjob
+}
+
+// JobIDs returns the "job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JobID instead. It exists only for internal usage by the builders.
+func (m *JobEventMutation) JobIDs() (ids []uuid.UUID) {
+	if id := m.job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJob resets all changes to the "job" edge.
+func (m *JobEventMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
+}
+
+// Where appends a list predicates to the JobEventMutation builder.
+func (m *JobEventMutation) Where(ps ...predicate.JobEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobEvent).
+func (m *JobEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobEventMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.job != nil {
+		fields = append(fields, jobevent.FieldJobID)
+	}
+	if m._type != nil {
+		fields = append(fields, jobevent.FieldType)
+	}
+	if m.command != nil {
+		fields = append(fields, jobevent.FieldCommand)
+	}
+	if m.args != nil {
+		fields = append(fields, jobevent.FieldArgs)
+	}
+	if m.exit_code != nil {
+		fields = append(fields, jobevent.FieldExitCode)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, jobevent.FieldDurationMs)
+	}
+	if m.output != nil {
+		fields = append(fields, jobevent.FieldOutput)
+	}
+	if m.output_truncated != nil {
+		fields = append(fields, jobevent.FieldOutputTruncated)
+	}
+	if m.error != nil {
+		fields = append(fields, jobevent.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, jobevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobevent.FieldJobID:
+		return m.JobID()
+	case jobevent.FieldType:
+		return m.GetType()
+	case jobevent.FieldCommand:
+		return m.Command()
+	case jobevent.FieldArgs:
+		return m.Args()
+	case jobevent.FieldExitCode:
+		return m.ExitCode()
+	case jobevent.FieldDurationMs:
+		return m.DurationMs()
+	case jobevent.FieldOutput:
+		return m.Output()
+	case jobevent.FieldOutputTruncated:
+		return m.OutputTruncated()
+	case jobevent.FieldError:
+		return m.Error()
+	case jobevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobevent.FieldJobID:
+		return m.OldJobID(ctx)
+	case jobevent.FieldType:
+		return m.OldType(ctx)
+	case jobevent.FieldCommand:
+		return m.OldCommand(ctx)
+	case jobevent.FieldArgs:
+		return m.OldArgs(ctx)
+	case jobevent.FieldExitCode:
+		return m.OldExitCode(ctx)
+	case jobevent.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case jobevent.FieldOutput:
+		return m.OldOutput(ctx)
+	case jobevent.FieldOutputTruncated:
+		return m.OldOutputTruncated(ctx)
+	case jobevent.FieldError:
+		return m.OldError(ctx)
+	case jobevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobevent.FieldJobID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case jobevent.FieldType:
+		v, ok := value.(model.JobEventType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case jobevent.FieldCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommand(v)
+		return nil
+	case jobevent.FieldArgs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArgs(v)
+		return nil
+	case jobevent.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitCode(v)
+		return nil
+	case jobevent.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case jobevent.FieldOutput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutput(v)
+		return nil
+	case jobevent.FieldOutputTruncated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputTruncated(v)
+		return nil
+	case jobevent.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case jobevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addexit_code != nil {
+		fields = append(fields, jobevent.FieldExitCode)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, jobevent.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobevent.FieldExitCode:
+		return m.AddedExitCode()
+	case jobevent.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobevent.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitCode(v)
+		return nil
+	case jobevent.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobevent.FieldArgs) {
+		fields = append(fields, jobevent.FieldArgs)
+	}
+	if m.FieldCleared(jobevent.FieldExitCode) {
+		fields = append(fields, jobevent.FieldExitCode)
+	}
+	if m.FieldCleared(jobevent.FieldError) {
+		fields = append(fields, jobevent.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobEventMutation) ClearField(name string) error {
+	switch name {
+	case jobevent.FieldArgs:
+		m.ClearArgs()
+		return nil
+	case jobevent.FieldExitCode:
+		m.ClearExitCode()
+		return nil
+	case jobevent.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown JobEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobEventMutation) ResetField(name string) error {
+	switch name {
+	case jobevent.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case jobevent.FieldType:
+		m.ResetType()
+		return nil
+	case jobevent.FieldCommand:
+		m.ResetCommand()
+		return nil
+	case jobevent.FieldArgs:
+		m.ResetArgs()
+		return nil
+	case jobevent.FieldExitCode:
+		m.ResetExitCode()
+		return nil
+	case jobevent.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case jobevent.FieldOutput:
+		m.ResetOutput()
+		return nil
+	case jobevent.FieldOutputTruncated:
+		m.ResetOutputTruncated()
+		return nil
+	case jobevent.FieldError:
+		m.ResetError()
+		return nil
+	case jobevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JobEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job != nil {
+		edges = append(edges, jobevent.EdgeJob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case jobevent.EdgeJob:
+		if id := m.job; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob {
+		edges = append(edges, jobevent.EdgeJob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case jobevent.EdgeJob:
+		return m.clearedjob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobEventMutation) ClearEdge(name string) error {
+	switch name {
+	case jobevent.EdgeJob:
+		m.ClearJob()
+		return nil
+	}
+	return fmt.Errorf("unknown JobEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobEventMutation) ResetEdge(name string) error {
+	switch name {
+	case jobevent.EdgeJob:
+		m.ResetJob()
+		return nil
+	}
+	return fmt.Errorf("unknown JobEvent edge %s", name)
+}
+
+// JobLogMutation represents an operation that mutates the JobLog nodes in the graph.
+type JobLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	level         *model.LogLevel
+	time          *time.Time
+	_path         *string
+	previous_path *string
+	what          *model.LogAction
+	size          *int64
+	addsize       *int64
+	clearedFields map[string]struct{}
+	job           *uuid.UUID
+	clearedjob    bool
+	done          bool
+	oldValue      func(context.Context) (*JobLog, error)
+	predicates    []predicate.JobLog
+}
+
+var _ ent.Mutation = (*JobLogMutation)(nil)
+
+// joblogOption allows management of the mutation configuration using functional options.
+type joblogOption func(*JobLogMutation)
+
+// newJobLogMutation creates new mutation for the JobLog entity.
+func newJobLogMutation(c config, op Op, opts ...joblogOption) *JobLogMutation {
+	m := &JobLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobLogID sets the ID field of the mutation.
+func withJobLogID(id int) joblogOption {
+	return func(m *JobLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobLog
+		)
+		m.oldValue = func(ctx context.Context) (*JobLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobLog sets the old JobLog of the mutation.
+func withJobLog(node *JobLog) joblogOption {
+	return func(m *JobLogMutation) {
+		m.oldValue = func(context.Context) (*JobLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobID sets the "job_id" field.
+func (m *JobLogMutation) SetJobID(u uuid.UUID) {
+	m.job = &u
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *JobLogMutation) JobID() (r uuid.UUID, exists bool) {
+	v := m.job
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *JobLogMutation) ResetJobID() {
+	m.job = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *JobLogMutation) SetLevel(ml model.LogLevel) {
+	m.level = &ml
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *JobLogMutation) Level() (r model.LogLevel, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldLevel(ctx context.Context) (v model.LogLevel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *JobLogMutation) ResetLevel() {
+	m.level = nil
+}
+
+// SetTime sets the "time" field.
+func (m *JobLogMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *JobLogMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *JobLogMutation) ResetTime() {
+	m.time = nil
+}
+
+// SetPath sets the "path" field.
+func (m *JobLogMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *JobLogMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *JobLogMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[joblog.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *JobLogMutation) PathCleared() bool {
+	_, ok := m.clearedFields[joblog.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *JobLogMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, joblog.FieldPath)
+}
+
+// SetPreviousPath sets the "previous_path" field.
+func (m *JobLogMutation) SetPreviousPath(s string) {
+	m.previous_path = &s
+}
+
+// PreviousPath returns the value of the "previous_path" field in the mutation.
+func (m *JobLogMutation) PreviousPath() (r string, exists bool) {
+	v := m.previous_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousPath returns the old "previous_path" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldPreviousPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousPath: %w", err)
+	}
+	return oldValue.PreviousPath, nil
+}
+
+// ClearPreviousPath clears the value of the "previous_path" field.
+func (m *JobLogMutation) ClearPreviousPath() {
+	m.previous_path = nil
+	m.clearedFields[joblog.FieldPreviousPath] = struct{}{}
+}
+
+// PreviousPathCleared returns if the "previous_path" field was cleared in this mutation.
+func (m *JobLogMutation) PreviousPathCleared() bool {
+	_, ok := m.clearedFields[joblog.FieldPreviousPath]
+	return ok
+}
+
+// ResetPreviousPath resets all changes to the "previous_path" field.
+func (m *JobLogMutation) ResetPreviousPath() {
+	m.previous_path = nil
+	delete(m.clearedFields, joblog.FieldPreviousPath)
+}
+
+// SetWhat sets the "what" field.
+func (m *JobLogMutation) SetWhat(ma model.LogAction) {
+	m.what = &ma
+}
+
+// What returns the value of the "what" field in the mutation.
+func (m *JobLogMutation) What() (r model.LogAction, exists bool) {
+	v := m.what
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWhat returns the old "what" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldWhat(ctx context.Context) (v model.LogAction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWhat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWhat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWhat: %w", err)
+	}
+	return oldValue.What, nil
+}
+
+// ResetWhat resets all changes to the "what" field.
+func (m *JobLogMutation) ResetWhat() {
+	m.what = nil
+}
+
+// SetSize sets the "size" field.
+func (m *JobLogMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *JobLogMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the JobLog entity.
+// If the JobLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobLogMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *JobLogMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *JobLogMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSize clears the value of the "size" field.
+func (m *JobLogMutation) ClearSize() {
+	m.size = nil
+	m.addsize = nil
+	m.clearedFields[joblog.FieldSize] = struct{}{}
+}
+
+// SizeCleared returns if the "size" field was cleared in this mutation.
+func (m *JobLogMutation) SizeCleared() bool {
+	_, ok := m.clearedFields[joblog.FieldSize]
+	return ok
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *JobLogMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+	delete(m.clearedFields, joblog.FieldSize)
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (m *JobLogMutation) ClearJob() {
+	m.clearedjob = true
+	m.clearedFields[joblog.FieldJobID] = struct{}{}
+}
+
+// JobCleared reports if the "job" edge to the Job entity was cleared.
+func (m *JobLogMutation) JobCleared() bool {
+	return m.clearedjob
+}
+
+// JobIDs returns the "job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JobID instead. It exists only for internal usage by the builders.
+func (m *JobLogMutation) JobIDs() (ids []uuid.UUID) {
+	if id := m.job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJob resets all changes to the "job" edge.
+func (m *JobLogMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
+}
+
+// Where appends a list predicates to the JobLogMutation builder.
+func (m *JobLogMutation) Where(ps ...predicate.JobLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobLog).
+func (m *JobLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.job != nil {
+		fields = append(fields, joblog.FieldJobID)
+	}
+	if m.level != nil {
+		fields = append(fields, joblog.FieldLevel)
+	}
+	if m.time != nil {
+		fields = append(fields, joblog.FieldTime)
+	}
+	if m._path != nil {
+		fields = append(fields, joblog.FieldPath)
+	}
+	if m.previous_path != nil {
+		fields = append(fields, joblog.FieldPreviousPath)
+	}
+	if m.what != nil {
+		fields = append(fields, joblog.FieldWhat)
+	}
+	if m.size != nil {
+		fields = append(fields, joblog.FieldSize)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case joblog.FieldJobID:
+		return m.JobID()
+	case joblog.FieldLevel:
+		return m.Level()
+	case joblog.FieldTime:
+		return m.Time()
+	case joblog.FieldPath:
+		return m.Path()
+	case joblog.FieldPreviousPath:
+		return m.PreviousPath()
+	case joblog.FieldWhat:
+		return m.What()
+	case joblog.FieldSize:
+		return m.Size()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case joblog.FieldJobID:
+		return m.OldJobID(ctx)
+	case joblog.FieldLevel:
+		return m.OldLevel(ctx)
+	case joblog.FieldTime:
+		return m.OldTime(ctx)
+	case joblog.FieldPath:
+		return m.OldPath(ctx)
+	case joblog.FieldPreviousPath:
+		return m.OldPreviousPath(ctx)
+	case joblog.FieldWhat:
+		return m.OldWhat(ctx)
+	case joblog.FieldSize:
+		return m.OldSize(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case joblog.FieldJobID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case joblog.FieldLevel:
+		v, ok := value.(model.LogLevel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case joblog.FieldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case joblog.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case joblog.FieldPreviousPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousPath(v)
+		return nil
+	case joblog.FieldWhat:
+		v, ok := value.(model.LogAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWhat(v)
+		return nil
+	case joblog.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, joblog.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case joblog.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case joblog.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(joblog.FieldPath) {
+		fields = append(fields, joblog.FieldPath)
+	}
+	if m.FieldCleared(joblog.FieldPreviousPath) {
+		fields = append(fields, joblog.FieldPreviousPath)
+	}
+	if m.FieldCleared(joblog.FieldSize) {
+		fields = append(fields, joblog.FieldSize)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobLogMutation) ClearField(name string) error {
+	switch name {
+	case joblog.FieldPath:
+		m.ClearPath()
+		return nil
+	case joblog.FieldPreviousPath:
+		m.ClearPreviousPath()
+		return nil
+	case joblog.FieldSize:
+		m.ClearSize()
+		return nil
+	}
+	return fmt.Errorf("unknown JobLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobLogMutation) ResetField(name string) error {
+	switch name {
+	case joblog.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case joblog.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case joblog.FieldTime:
+		m.ResetTime()
+		return nil
+	case joblog.FieldPath:
+		m.ResetPath()
+		return nil
+	case joblog.FieldPreviousPath:
+		m.ResetPreviousPath()
+		return nil
+	case joblog.FieldWhat:
+		m.ResetWhat()
+		return nil
+	case joblog.FieldSize:
+		m.ResetSize()
+		return nil
+	}
+	return fmt.Errorf("unknown JobLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job != nil {
+		edges = append(edges, joblog.EdgeJob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case joblog.EdgeJob:
+		if id := m.job; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob {
+		edges = append(edges, joblog.EdgeJob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case joblog.EdgeJob:
+		return m.clearedjob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobLogMutation) ClearEdge(name string) error {
+	switch name {
+	case joblog.EdgeJob:
+		m.ClearJob()
+		return nil
+	}
+	return fmt.Errorf("unknown JobLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobLogMutation) ResetEdge(name string) error {
+	switch name {
+	case joblog.EdgeJob:
+		m.ResetJob()
+		return nil
+	}
+	return fmt.Errorf("unknown JobLog edge %s", name)
+}
+
+// RetryQueueMutation represents an operation that mutates the RetryQueue nodes in the graph.
+type RetryQueueMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	task_id       *uuid.UUID
+	_path         *string
+	direction     *model.SyncDirection
+	error_class   *model.TransferErrorClass
+	error         *string
+	created_at    *time.Time
+	requested_at  *time.Time
+	retry_job_id  *uuid.UUID
+	clearedFields map[string]struct{}
+	job           *uuid.UUID
+	clearedjob    bool
+	done          bool
+	oldValue      func(context.Context) (*RetryQueue, error)
+	predicates    []predicate.RetryQueue
+}
+
+var _ ent.Mutation = (*RetryQueueMutation)(nil)
+
+// retryqueueOption allows management of the mutation configuration using functional options.
+type retryqueueOption func(*RetryQueueMutation)
+
+// newRetryQueueMutation creates new mutation for the RetryQueue entity.
+func newRetryQueueMutation(c config, op Op, opts ...retryqueueOption) *RetryQueueMutation {
+	m := &RetryQueueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRetryQueue,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRetryQueueID sets the ID field of the mutation.
+func withRetryQueueID(id uuid.UUID) retryqueueOption {
+	return func(m *RetryQueueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RetryQueue
+		)
+		m.oldValue = func(ctx context.Context) (*RetryQueue, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RetryQueue.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRetryQueue sets the old RetryQueue of the mutation.
+func withRetryQueue(node *RetryQueue) retryqueueOption {
+	return func(m *RetryQueueMutation) {
+		m.oldValue = func(context.Context) (*RetryQueue, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RetryQueueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RetryQueueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RetryQueue entities.
+func (m *RetryQueueMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RetryQueueMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RetryQueueMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RetryQueue.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobID sets the "job_id" field.
+func (m *RetryQueueMutation) SetJobID(u uuid.UUID) {
+	m.job = &u
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *RetryQueueMutation) JobID() (r uuid.UUID, exists bool) {
+	v := m.job
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *RetryQueueMutation) ResetJobID() {
+	m.job = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *RetryQueueMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *RetryQueueMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *RetryQueueMutation) ResetTaskID() {
+	m.task_id = nil
+}
+
+// SetPath sets the "path" field.
+func (m *RetryQueueMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *RetryQueueMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *RetryQueueMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetDirection sets the "direction" field.
+func (m *RetryQueueMutation) SetDirection(md model.SyncDirection) {
+	m.direction = &md
+}
+
+// Direction returns the value of the "direction" field in the mutation.
+func (m *RetryQueueMutation) Direction() (r model.SyncDirection, exists bool) {
+	v := m.direction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirection returns the old "direction" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldDirection(ctx context.Context) (v model.SyncDirection, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirection: %w", err)
+	}
+	return oldValue.Direction, nil
+}
+
+// ResetDirection resets all changes to the "direction" field.
+func (m *RetryQueueMutation) ResetDirection() {
+	m.direction = nil
+}
+
+// SetErrorClass sets the "error_class" field.
+func (m *RetryQueueMutation) SetErrorClass(mec model.TransferErrorClass) {
+	m.error_class = &mec
+}
+
+// ErrorClass returns the value of the "error_class" field in the mutation.
+func (m *RetryQueueMutation) ErrorClass() (r model.TransferErrorClass, exists bool) {
+	v := m.error_class
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorClass returns the old "error_class" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldErrorClass(ctx context.Context) (v model.TransferErrorClass, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorClass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorClass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorClass: %w", err)
+	}
+	return oldValue.ErrorClass, nil
+}
+
+// ResetErrorClass resets all changes to the "error_class" field.
+func (m *RetryQueueMutation) ResetErrorClass() {
+	m.error_class = nil
+}
+
+// SetError sets the "error" field.
+func (m *RetryQueueMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *RetryQueueMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *RetryQueueMutation) ResetError() {
+	m.error = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RetryQueueMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RetryQueueMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RetryQueueMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (m *RetryQueueMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
+}
+
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *RetryQueueMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldRequestedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ClearRequestedAt clears the value of the "requested_at" field.
+func (m *RetryQueueMutation) ClearRequestedAt() {
+	m.requested_at = nil
+	m.clearedFields[retryqueue.FieldRequestedAt] = struct{}{}
+}
+
+// RequestedAtCleared returns if the "requested_at" field was cleared in this mutation.
+func (m *RetryQueueMutation) RequestedAtCleared() bool {
+	_, ok := m.clearedFields[retryqueue.FieldRequestedAt]
+	return ok
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *RetryQueueMutation) ResetRequestedAt() {
+	m.requested_at = nil
+	delete(m.clearedFields, retryqueue.FieldRequestedAt)
+}
+
+// SetRetryJobID sets the "retry_job_id" field.
+func (m *RetryQueueMutation) SetRetryJobID(u uuid.UUID) {
+	m.retry_job_id = &u
+}
+
+// RetryJobID returns the value of the "retry_job_id" field in the mutation.
+func (m *RetryQueueMutation) RetryJobID() (r uuid.UUID, exists bool) {
+	v := m.retry_job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryJobID returns the old "retry_job_id" field's value of the RetryQueue entity.
+// If the RetryQueue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetryQueueMutation) OldRetryJobID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryJobID: %w", err)
+	}
+	return oldValue.RetryJobID, nil
+}
+
+// ClearRetryJobID clears the value of the "retry_job_id" field.
+func (m *RetryQueueMutation) ClearRetryJobID() {
+	m.retry_job_id = nil
+	m.clearedFields[retryqueue.FieldRetryJobID] = struct{}{}
+}
+
+// RetryJobIDCleared returns if the "retry_job_id" field was cleared in this mutation.
+func (m *RetryQueueMutation) RetryJobIDCleared() bool {
+	_, ok := m.clearedFields[retryqueue.FieldRetryJobID]
+	return ok
+}
+
+// ResetRetryJobID resets all changes to the "retry_job_id" field.
+func (m *RetryQueueMutation) ResetRetryJobID() {
+	m.retry_job_id = nil
+	delete(m.clearedFields, retryqueue.FieldRetryJobID)
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (m *RetryQueueMutation) ClearJob() {
+	m.clearedjob = true
+	m.clearedFields[retryqueue.FieldJobID] = struct{}{}
+}
+
+// JobCleared reports if the "job" edge to the Job entity was cleared.
+func (m *RetryQueueMutation) JobCleared() bool {
+	return m.clearedjob
+}
+
+// JobIDs returns the "job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JobID instead. It exists only for internal usage by the builders.
+func (m *RetryQueueMutation) JobIDs() (ids []uuid.UUID) {
+	if id := m.job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJob resets all changes to the "job" edge.
+func (m *RetryQueueMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
+}
+
+// Where appends a list predicates to the RetryQueueMutation builder.
+func (m *RetryQueueMutation) Where(ps ...predicate.RetryQueue) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RetryQueueMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RetryQueueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RetryQueue, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RetryQueueMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RetryQueueMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RetryQueue).
+func (m *RetryQueueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RetryQueueMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.job != nil {
+		fields = append(fields, retryqueue.FieldJobID)
+	}
+	if m.task_id != nil {
+		fields = append(fields, retryqueue.FieldTaskID)
+	}
+	if m._path != nil {
+		fields = append(fields, retryqueue.FieldPath)
+	}
+	if m.direction != nil {
+		fields = append(fields, retryqueue.FieldDirection)
+	}
+	if m.error_class != nil {
+		fields = append(fields, retryqueue.FieldErrorClass)
+	}
+	if m.error != nil {
+		fields = append(fields, retryqueue.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, retryqueue.FieldCreatedAt)
+	}
+	if m.requested_at != nil {
+		fields = append(fields, retryqueue.FieldRequestedAt)
+	}
+	if m.retry_job_id != nil {
+		fields = append(fields, retryqueue.FieldRetryJobID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RetryQueueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case retryqueue.FieldJobID:
+		return m.JobID()
+	case retryqueue.FieldTaskID:
+		return m.TaskID()
+	case retryqueue.FieldPath:
+		return m.Path()
+	case retryqueue.FieldDirection:
+		return m.Direction()
+	case retryqueue.FieldErrorClass:
+		return m.ErrorClass()
+	case retryqueue.FieldError:
+		return m.Error()
+	case retryqueue.FieldCreatedAt:
+		return m.CreatedAt()
+	case retryqueue.FieldRequestedAt:
+		return m.RequestedAt()
+	case retryqueue.FieldRetryJobID:
+		return m.RetryJobID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RetryQueueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case retryqueue.FieldJobID:
+		return m.OldJobID(ctx)
+	case retryqueue.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case retryqueue.FieldPath:
+		return m.OldPath(ctx)
+	case retryqueue.FieldDirection:
+		return m.OldDirection(ctx)
+	case retryqueue.FieldErrorClass:
+		return m.OldErrorClass(ctx)
+	case retryqueue.FieldError:
+		return m.OldError(ctx)
+	case retryqueue.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case retryqueue.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case retryqueue.FieldRetryJobID:
+		return m.OldRetryJobID(ctx)
+	}
+	return nil, fmt.Errorf("unknown RetryQueue field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetryQueueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case retryqueue.FieldJobID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case retryqueue.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case retryqueue.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case retryqueue.FieldDirection:
+		v, ok := value.(model.SyncDirection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirection(v)
+		return nil
+	case retryqueue.FieldErrorClass:
+		v, ok := value.(model.TransferErrorClass)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorClass(v)
+		return nil
+	case retryqueue.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case retryqueue.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case retryqueue.FieldRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedAt(v)
+		return nil
+	case retryqueue.FieldRetryJobID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryJobID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RetryQueue field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RetryQueueMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RetryQueueMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetryQueueMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RetryQueue numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RetryQueueMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(retryqueue.FieldRequestedAt) {
+		fields = append(fields, retryqueue.FieldRequestedAt)
+	}
+	if m.FieldCleared(retryqueue.FieldRetryJobID) {
+		fields = append(fields, retryqueue.FieldRetryJobID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RetryQueueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RetryQueueMutation) ClearField(name string) error {
+	switch name {
+	case retryqueue.FieldRequestedAt:
+		m.ClearRequestedAt()
+		return nil
+	case retryqueue.FieldRetryJobID:
+		m.ClearRetryJobID()
+		return nil
+	}
+	return fmt.Errorf("unknown RetryQueue nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RetryQueueMutation) ResetField(name string) error {
+	switch name {
+	case retryqueue.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case retryqueue.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case retryqueue.FieldPath:
+		m.ResetPath()
+		return nil
+	case retryqueue.FieldDirection:
+		m.ResetDirection()
+		return nil
+	case retryqueue.FieldErrorClass:
+		m.ResetErrorClass()
+		return nil
+	case retryqueue.FieldError:
+		m.ResetError()
+		return nil
+	case retryqueue.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case retryqueue.FieldRequestedAt:
+		m.ResetRequestedAt()
+		return nil
+	case retryqueue.FieldRetryJobID:
+		m.ResetRetryJobID()
+		return nil
+	}
+	return fmt.Errorf("unknown RetryQueue field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RetryQueueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job != nil {
+		edges = append(edges, retryqueue.EdgeJob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RetryQueueMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case retryqueue.EdgeJob:
+		if id := m.job; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RetryQueueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RetryQueueMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RetryQueueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob {
+		edges = append(edges, retryqueue.EdgeJob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RetryQueueMutation) EdgeCleared(name string) bool {
+	switch name {
+	case retryqueue.EdgeJob:
+		return m.clearedjob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RetryQueueMutation) ClearEdge(name string) error {
+	switch name {
+	case retryqueue.EdgeJob:
+		m.ClearJob()
+		return nil
+	}
+	return fmt.Errorf("unknown RetryQueue unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RetryQueueMutation) ResetEdge(name string) error {
+	switch name {
+	case retryqueue.EdgeJob:
+		m.ResetJob()
+		return nil
+	}
+	return fmt.Errorf("unknown RetryQueue edge %s", name)
+}
+
+// ShareTokenMutation represents an operation that mutates the ShareToken nodes in the graph.
+type ShareTokenMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	name              *string
+	token_hash        *string
+	scope             *model.ShareScope
+	_path             *string
+	expires_at        *time.Time
+	last_used_at      *time.Time
+	use_count         *int
+	adduse_count      *int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	task              *uuid.UUID
+	clearedtask       bool
+	connection        *uuid.UUID
+	clearedconnection bool
+	done              bool
+	oldValue          func(context.Context) (*ShareToken, error)
+	predicates        []predicate.ShareToken
+}
+
+var _ ent.Mutation = (*ShareTokenMutation)(nil)
+
+// sharetokenOption allows management of the mutation configuration using functional options.
+type sharetokenOption func(*ShareTokenMutation)
+
+// newShareTokenMutation creates new mutation for the ShareToken entity.
+func newShareTokenMutation(c config, op Op, opts ...sharetokenOption) *ShareTokenMutation {
+	m := &ShareTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareTokenID sets the ID field of the mutation.
+func withShareTokenID(id uuid.UUID) sharetokenOption {
+	return func(m *ShareTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareToken
+		)
+		m.oldValue = func(ctx context.Context) (*ShareToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareToken sets the old ShareToken of the mutation.
+func withShareToken(node *ShareToken) sharetokenOption {
+	return func(m *ShareTokenMutation) {
+		m.oldValue = func(context.Context) (*ShareToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ShareToken entities.
+func (m *ShareTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ShareTokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ShareTokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ShareTokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *ShareTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *ShareTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *ShareTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *ShareTokenMutation) SetScope(ms model.ShareScope) {
+	m.scope = &ms
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ShareTokenMutation) Scope() (r model.ShareScope, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldScope(ctx context.Context) (v model.ShareScope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *ShareTokenMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ShareTokenMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ShareTokenMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldTaskID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ShareTokenMutation) ClearTaskID() {
+	m.task = nil
+	m.clearedFields[sharetoken.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ShareTokenMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[sharetoken.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ShareTokenMutation) ResetTaskID() {
+	m.task = nil
+	delete(m.clearedFields, sharetoken.FieldTaskID)
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (m *ShareTokenMutation) SetConnectionID(u uuid.UUID) {
+	m.connection = &u
+}
+
+// ConnectionID returns the value of the "connection_id" field in the mutation.
+func (m *ShareTokenMutation) ConnectionID() (r uuid.UUID, exists bool) {
+	v := m.connection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectionID returns the old "connection_id" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldConnectionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
+	}
+	return oldValue.ConnectionID, nil
+}
+
+// ClearConnectionID clears the value of the "connection_id" field.
+func (m *ShareTokenMutation) ClearConnectionID() {
+	m.connection = nil
+	m.clearedFields[sharetoken.FieldConnectionID] = struct{}{}
+}
+
+// ConnectionIDCleared returns if the "connection_id" field was cleared in this mutation.
+func (m *ShareTokenMutation) ConnectionIDCleared() bool {
+	_, ok := m.clearedFields[sharetoken.FieldConnectionID]
+	return ok
+}
+
+// ResetConnectionID resets all changes to the "connection_id" field.
+func (m *ShareTokenMutation) ResetConnectionID() {
+	m.connection = nil
+	delete(m.clearedFields, sharetoken.FieldConnectionID)
+}
+
+// SetPath sets the "path" field.
+func (m *ShareTokenMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *ShareTokenMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *ShareTokenMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[sharetoken.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *ShareTokenMutation) PathCleared() bool {
+	_, ok := m.clearedFields[sharetoken.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *ShareTokenMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, sharetoken.FieldPath)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ShareTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ShareTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ShareTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ShareTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ShareTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ShareTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[sharetoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ShareTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[sharetoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ShareTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, sharetoken.FieldLastUsedAt)
+}
+
+// SetUseCount sets the "use_count" field.
+func (m *ShareTokenMutation) SetUseCount(i int) {
+	m.use_count = &i
+	m.adduse_count = nil
+}
+
+// UseCount returns the value of the "use_count" field in the mutation.
+func (m *ShareTokenMutation) UseCount() (r int, exists bool) {
+	v := m.use_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUseCount returns the old "use_count" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldUseCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUseCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUseCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUseCount: %w", err)
+	}
+	return oldValue.UseCount, nil
+}
+
+// AddUseCount adds i to the "use_count" field.
+func (m *ShareTokenMutation) AddUseCount(i int) {
+	if m.adduse_count != nil {
+		*m.adduse_count += i
+	} else {
+		m.adduse_count = &i
+	}
+}
+
+// AddedUseCount returns the value that was added to the "use_count" field in this mutation.
+func (m *ShareTokenMutation) AddedUseCount() (r int, exists bool) {
+	v := m.adduse_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUseCount resets all changes to the "use_count" field.
+func (m *ShareTokenMutation) ResetUseCount() {
+	m.use_count = nil
+	m.adduse_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShareTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShareTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ShareToken entity.
+// If the ShareToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShareTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ShareTokenMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[sharetoken.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ShareTokenMutation) TaskCleared() bool {
+	return m.TaskIDCleared() || m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ShareTokenMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *ShareTokenMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (m *ShareTokenMutation) ClearConnection() {
+	m.clearedconnection = true
+	m.clearedFields[sharetoken.FieldConnectionID] = struct{}{}
+}
+
+// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
+func (m *ShareTokenMutation) ConnectionCleared() bool {
+	return m.ConnectionIDCleared() || m.clearedconnection
+}
+
+// ConnectionIDs returns the "connection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConnectionID instead. It exists only for internal usage by the builders.
+func (m *ShareTokenMutation) ConnectionIDs() (ids []uuid.UUID) {
+	if id := m.connection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConnection resets all changes to the "connection" edge.
+func (m *ShareTokenMutation) ResetConnection() {
+	m.connection = nil
+	m.clearedconnection = false
+}
+
+// Where appends a list predicates to the ShareTokenMutation builder.
+func (m *ShareTokenMutation) Where(ps ...predicate.ShareToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareToken).
+func (m *ShareTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareTokenMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, sharetoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, sharetoken.FieldTokenHash)
+	}
+	if m.scope != nil {
+		fields = append(fields, sharetoken.FieldScope)
+	}
+	if m.task != nil {
+		fields = append(fields, sharetoken.FieldTaskID)
+	}
+	if m.connection != nil {
+		fields = append(fields, sharetoken.FieldConnectionID)
+	}
+	if m._path != nil {
+		fields = append(fields, sharetoken.FieldPath)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, sharetoken.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, sharetoken.FieldLastUsedAt)
+	}
+	if m.use_count != nil {
+		fields = append(fields, sharetoken.FieldUseCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sharetoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharetoken.FieldName:
+		return m.Name()
+	case sharetoken.FieldTokenHash:
+		return m.TokenHash()
+	case sharetoken.FieldScope:
+		return m.Scope()
+	case sharetoken.FieldTaskID:
+		return m.TaskID()
+	case sharetoken.FieldConnectionID:
+		return m.ConnectionID()
+	case sharetoken.FieldPath:
+		return m.Path()
+	case sharetoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case sharetoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case sharetoken.FieldUseCount:
+		return m.UseCount()
+	case sharetoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharetoken.FieldName:
+		return m.OldName(ctx)
+	case sharetoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case sharetoken.FieldScope:
+		return m.OldScope(ctx)
+	case sharetoken.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case sharetoken.FieldConnectionID:
+		return m.OldConnectionID(ctx)
+	case sharetoken.FieldPath:
+		return m.OldPath(ctx)
+	case sharetoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case sharetoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case sharetoken.FieldUseCount:
+		return m.OldUseCount(ctx)
+	case sharetoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharetoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sharetoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case sharetoken.FieldScope:
+		v, ok := value.(model.ShareScope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case sharetoken.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case sharetoken.FieldConnectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectionID(v)
+		return nil
+	case sharetoken.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case sharetoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case sharetoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case sharetoken.FieldUseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUseCount(v)
+		return nil
+	case sharetoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adduse_count != nil {
+		fields = append(fields, sharetoken.FieldUseCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sharetoken.FieldUseCount:
+		return m.AddedUseCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sharetoken.FieldUseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUseCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sharetoken.FieldTaskID) {
+		fields = append(fields, sharetoken.FieldTaskID)
+	}
+	if m.FieldCleared(sharetoken.FieldConnectionID) {
+		fields = append(fields, sharetoken.FieldConnectionID)
+	}
+	if m.FieldCleared(sharetoken.FieldPath) {
+		fields = append(fields, sharetoken.FieldPath)
+	}
+	if m.FieldCleared(sharetoken.FieldLastUsedAt) {
+		fields = append(fields, sharetoken.FieldLastUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareTokenMutation) ClearField(name string) error {
+	switch name {
+	case sharetoken.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case sharetoken.FieldConnectionID:
+		m.ClearConnectionID()
+		return nil
+	case sharetoken.FieldPath:
+		m.ClearPath()
+		return nil
+	case sharetoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareTokenMutation) ResetField(name string) error {
+	switch name {
+	case sharetoken.FieldName:
+		m.ResetName()
+		return nil
+	case sharetoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case sharetoken.FieldScope:
+		m.ResetScope()
+		return nil
+	case sharetoken.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case sharetoken.FieldConnectionID:
+		m.ResetConnectionID()
+		return nil
+	case sharetoken.FieldPath:
+		m.ResetPath()
+		return nil
+	case sharetoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case sharetoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case sharetoken.FieldUseCount:
+		m.ResetUseCount()
+		return nil
+	case sharetoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, sharetoken.EdgeTask)
+	}
+	if m.connection != nil {
+		edges = append(edges, sharetoken.EdgeConnection)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sharetoken.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case sharetoken.EdgeConnection:
+		if id := m.connection; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, sharetoken.EdgeTask)
+	}
+	if m.clearedconnection {
+		edges = append(edges, sharetoken.EdgeConnection)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sharetoken.EdgeTask:
+		return m.clearedtask
+	case sharetoken.EdgeConnection:
+		return m.clearedconnection
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case sharetoken.EdgeTask:
+		m.ClearTask()
+		return nil
+	case sharetoken.EdgeConnection:
+		m.ClearConnection()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case sharetoken.EdgeTask:
+		m.ResetTask()
+		return nil
+	case sharetoken.EdgeConnection:
+		m.ResetConnection()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareToken edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	name                          *string
+	source_path                   *string
+	remote_path                   *string
+	direction                     *model.SyncDirection
+	schedule                      *string
+	realtime                      *bool
+	options                       **model.TaskSyncOptions
+	tags                          *[]string
+	appendtags                    []string
+	engine                        *string
+	skipped_runs                  *int
+	addskipped_runs               *int
+	consecutive_failures          *int
+	addconsecutive_failures       *int
+	consecutive_fatal_failures    *int
+	addconsecutive_fatal_failures *int
+	disabled                      *bool
+	disabled_reason               *string
+	ephemeral                     *bool
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	deleted_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	jobs                          map[uuid.UUID]struct{}
+	removedjobs                   map[uuid.UUID]struct{}
+	clearedjobs                   bool
+	events                        map[uuid.UUID]struct{}
+	removedevents                 map[uuid.UUID]struct{}
+	clearedevents                 bool
+	share_tokens                  map[uuid.UUID]struct{}
+	removedshare_tokens           map[uuid.UUID]struct{}
+	clearedshare_tokens           bool
+	connection                    *uuid.UUID
+	clearedconnection             bool
+	done                          bool
+	oldValue                      func(context.Context) (*Task, error)
+	predicates                    []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id uuid.UUID) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Task entities.
+func (m *TaskMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TaskMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TaskMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TaskMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSourcePath sets the "source_path" field.
+func (m *TaskMutation) SetSourcePath(s string) {
+	m.source_path = &s
+}
+
+// SourcePath returns the value of the "source_path" field in the mutation.
+func (m *TaskMutation) SourcePath() (r string, exists bool) {
+	v := m.source_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourcePath returns the old "source_path" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSourcePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourcePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourcePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourcePath: %w", err)
+	}
+	return oldValue.SourcePath, nil
+}
+
+// ResetSourcePath resets all changes to the "source_path" field.
+func (m *TaskMutation) ResetSourcePath() {
+	m.source_path = nil
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (m *TaskMutation) SetConnectionID(u uuid.UUID) {
+	m.connection = &u
+}
+
+// ConnectionID returns the value of the "connection_id" field in the mutation.
+func (m *TaskMutation) ConnectionID() (r uuid.UUID, exists bool) {
+	v := m.connection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectionID returns the old "connection_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldConnectionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectionID: %w", err)
+	}
+	return oldValue.ConnectionID, nil
+}
+
+// ClearConnectionID clears the value of the "connection_id" field.
+func (m *TaskMutation) ClearConnectionID() {
+	m.connection = nil
+	m.clearedFields[task.FieldConnectionID] = struct{}{}
+}
+
+// ConnectionIDCleared returns if the "connection_id" field was cleared in this mutation.
+func (m *TaskMutation) ConnectionIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldConnectionID]
+	return ok
+}
+
+// ResetConnectionID resets all changes to the "connection_id" field.
+func (m *TaskMutation) ResetConnectionID() {
+	m.connection = nil
+	delete(m.clearedFields, task.FieldConnectionID)
+}
+
+// SetRemotePath sets the "remote_path" field.
+func (m *TaskMutation) SetRemotePath(s string) {
+	m.remote_path = &s
+}
+
+// RemotePath returns the value of the "remote_path" field in the mutation.
+func (m *TaskMutation) RemotePath() (r string, exists bool) {
+	v := m.remote_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemotePath returns the old "remote_path" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRemotePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemotePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemotePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemotePath: %w", err)
+	}
+	return oldValue.RemotePath, nil
+}
+
+// ResetRemotePath resets all changes to the "remote_path" field.
+func (m *TaskMutation) ResetRemotePath() {
+	m.remote_path = nil
+}
+
+// SetDirection sets the "direction" field.
+func (m *TaskMutation) SetDirection(md model.SyncDirection) {
+	m.direction = &md
+}
+
+// Direction returns the value of the "direction" field in the mutation.
+func (m *TaskMutation) Direction() (r model.SyncDirection, exists bool) {
+	v := m.direction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirection returns the old "direction" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDirection(ctx context.Context) (v model.SyncDirection, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirection: %w", err)
+	}
+	return oldValue.Direction, nil
+}
+
+// ResetDirection resets all changes to the "direction" field.
+func (m *TaskMutation) ResetDirection() {
+	m.direction = nil
+}
+
+// SetSchedule sets the "schedule" field.
+func (m *TaskMutation) SetSchedule(s string) {
+	m.schedule = &s
+}
+
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *TaskMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchedule returns the old "schedule" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSchedule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	}
+	return oldValue.Schedule, nil
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (m *TaskMutation) ClearSchedule() {
+	m.schedule = nil
+	m.clearedFields[task.FieldSchedule] = struct{}{}
+}
+
+// ScheduleCleared returns if the "schedule" field was cleared in this mutation.
+func (m *TaskMutation) ScheduleCleared() bool {
+	_, ok := m.clearedFields[task.FieldSchedule]
+	return ok
+}
+
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *TaskMutation) ResetSchedule() {
+	m.schedule = nil
+	delete(m.clearedFields, task.FieldSchedule)
+}
+
+// SetRealtime sets the "realtime" field.
+func (m *TaskMutation) SetRealtime(b bool) {
+	m.realtime = &b
+}
+
+// Realtime returns the value of the "realtime" field in the mutation.
+func (m *TaskMutation) Realtime() (r bool, exists bool) {
+	v := m.realtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealtime returns the old "realtime" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRealtime(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealtime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealtime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealtime: %w", err)
+	}
+	return oldValue.Realtime, nil
+}
+
+// ResetRealtime resets all changes to the "realtime" field.
+func (m *TaskMutation) ResetRealtime() {
+	m.realtime = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *TaskMutation) SetOptions(mso *model.TaskSyncOptions) {
+	m.options = &mso
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *TaskMutation) Options() (r *model.TaskSyncOptions, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOptions(ctx context.Context) (v *model.TaskSyncOptions, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *TaskMutation) ClearOptions() {
+	m.options = nil
+	m.clearedFields[task.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *TaskMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[task.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *TaskMutation) ResetOptions() {
+	m.options = nil
+	delete(m.clearedFields, task.FieldOptions)
+}
+
+// SetTags sets the "tags" field.
+func (m *TaskMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *TaskMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *TaskMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *TaskMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *TaskMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[task.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *TaskMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[task.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *TaskMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, task.FieldTags)
+}
+
+// SetEngine sets the "engine" field.
+func (m *TaskMutation) SetEngine(s string) {
+	m.engine = &s
+}
+
+// Engine returns the value of the "engine" field in the mutation.
+func (m *TaskMutation) Engine() (r string, exists bool) {
+	v := m.engine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEngine returns the old "engine" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldEngine(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEngine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEngine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEngine: %w", err)
+	}
+	return oldValue.Engine, nil
+}
+
+// ResetEngine resets all changes to the "engine" field.
+func (m *TaskMutation) ResetEngine() {
+	m.engine = nil
+}
+
+// SetSkippedRuns sets the "skipped_runs" field.
+func (m *TaskMutation) SetSkippedRuns(i int) {
+	m.skipped_runs = &i
+	m.addskipped_runs = nil
+}
+
+// SkippedRuns returns the value of the "skipped_runs" field in the mutation.
+func (m *TaskMutation) SkippedRuns() (r int, exists bool) {
+	v := m.skipped_runs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkippedRuns returns the old "skipped_runs" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSkippedRuns(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkippedRuns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkippedRuns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkippedRuns: %w", err)
+	}
+	return oldValue.SkippedRuns, nil
+}
+
+// AddSkippedRuns adds i to the "skipped_runs" field.
+func (m *TaskMutation) AddSkippedRuns(i int) {
+	if m.addskipped_runs != nil {
+		*m.addskipped_runs += i
+	} else {
+		m.addskipped_runs = &i
+	}
+}
+
+// AddedSkippedRuns returns the value that was added to the "skipped_runs" field in this mutation.
+func (m *TaskMutation) AddedSkippedRuns() (r int, exists bool) {
+	v := m.addskipped_runs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSkippedRuns resets all changes to the "skipped_runs" field.
+func (m *TaskMutation) ResetSkippedRuns() {
+	m.skipped_runs = nil
+	m.addskipped_runs = nil
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *TaskMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *TaskMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *TaskMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
+}
+
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *TaskMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *TaskMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
+}
+
+// SetConsecutiveFatalFailures sets the "consecutive_fatal_failures" field.
+func (m *TaskMutation) SetConsecutiveFatalFailures(i int) {
+	m.consecutive_fatal_failures = &i
+	m.addconsecutive_fatal_failures = nil
+}
+
+// ConsecutiveFatalFailures returns the value of the "consecutive_fatal_failures" field in the mutation.
+func (m *TaskMutation) ConsecutiveFatalFailures() (r int, exists bool) {
+	v := m.consecutive_fatal_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFatalFailures returns the old "consecutive_fatal_failures" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldConsecutiveFatalFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFatalFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFatalFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFatalFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFatalFailures, nil
+}
+
+// AddConsecutiveFatalFailures adds i to the "consecutive_fatal_failures" field.
+func (m *TaskMutation) AddConsecutiveFatalFailures(i int) {
+	if m.addconsecutive_fatal_failures != nil {
+		*m.addconsecutive_fatal_failures += i
+	} else {
+		m.addconsecutive_fatal_failures = &i
+	}
+}
+
+// AddedConsecutiveFatalFailures returns the value that was added to the "consecutive_fatal_failures" field in this mutation.
+func (m *TaskMutation) AddedConsecutiveFatalFailures() (r int, exists bool) {
+	v := m.addconsecutive_fatal_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFatalFailures resets all changes to the "consecutive_fatal_failures" field.
+func (m *TaskMutation) ResetConsecutiveFatalFailures() {
+	m.consecutive_fatal_failures = nil
+	m.addconsecutive_fatal_failures = nil
+}
+
+// SetDisabled sets the "disabled" field.
+func (m *TaskMutation) SetDisabled(b bool) {
+	m.disabled = &b
+}
+
+// Disabled returns the value of the "disabled" field in the mutation.
+func (m *TaskMutation) Disabled() (r bool, exists bool) {
+	v := m.disabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisabled returns the old "disabled" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
+	}
+	return oldValue.Disabled, nil
+}
+
+// ResetDisabled resets all changes to the "disabled" field.
+func (m *TaskMutation) ResetDisabled() {
+	m.disabled = nil
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (m *TaskMutation) SetDisabledReason(s string) {
+	m.disabled_reason = &s
+}
+
+// DisabledReason returns the value of the "disabled_reason" field in the mutation.
+func (m *TaskMutation) DisabledReason() (r string, exists bool) {
+	v := m.disabled_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisabledReason returns the old "disabled_reason" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDisabledReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabledReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabledReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabledReason: %w", err)
+	}
+	return oldValue.DisabledReason, nil
+}
+
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (m *TaskMutation) ClearDisabledReason() {
+	m.disabled_reason = nil
+	m.clearedFields[task.FieldDisabledReason] = struct{}{}
+}
+
+// DisabledReasonCleared returns if the "disabled_reason" field was cleared in this mutation.
+func (m *TaskMutation) DisabledReasonCleared() bool {
+	_, ok := m.clearedFields[task.FieldDisabledReason]
+	return ok
+}
+
+// ResetDisabledReason resets all changes to the "disabled_reason" field.
+func (m *TaskMutation) ResetDisabledReason() {
+	m.disabled_reason = nil
+	delete(m.clearedFields, task.FieldDisabledReason)
+}
+
+// SetEphemeral sets the "ephemeral" field.
+func (m *TaskMutation) SetEphemeral(b bool) {
+	m.ephemeral = &b
+}
+
+// Ephemeral returns the value of the "ephemeral" field in the mutation.
+func (m *TaskMutation) Ephemeral() (r bool, exists bool) {
+	v := m.ephemeral
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEphemeral returns the old "ephemeral" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldEphemeral(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEphemeral is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEphemeral requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEphemeral: %w", err)
+	}
+	return oldValue.Ephemeral, nil
+}
+
+// ResetEphemeral resets all changes to the "ephemeral" field.
+func (m *TaskMutation) ResetEphemeral() {
+	m.ephemeral = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TaskMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TaskMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TaskMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[task.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TaskMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TaskMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, task.FieldDeletedAt)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by ids.
+func (m *TaskMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the Job entity.
+func (m *TaskMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
+func (m *TaskMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
+func (m *TaskMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
+func (m *TaskMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *TaskMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *TaskMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// AddEventIDs adds the "events" edge to the TaskEvent entity by ids.
+func (m *TaskMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the TaskEvent entity.
+func (m *TaskMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the TaskEvent entity was cleared.
+func (m *TaskMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the TaskEvent entity by IDs.
+func (m *TaskMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the TaskEvent entity.
+func (m *TaskMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *TaskMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *TaskMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by ids.
+func (m *TaskMutation) AddShareTokenIDs(ids ...uuid.UUID) {
+	if m.share_tokens == nil {
+		m.share_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.share_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareTokens clears the "share_tokens" edge to the ShareToken entity.
+func (m *TaskMutation) ClearShareTokens() {
+	m.clearedshare_tokens = true
+}
+
+// ShareTokensCleared reports if the "share_tokens" edge to the ShareToken entity was cleared.
+func (m *TaskMutation) ShareTokensCleared() bool {
+	return m.clearedshare_tokens
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to the ShareToken entity by IDs.
+func (m *TaskMutation) RemoveShareTokenIDs(ids ...uuid.UUID) {
+	if m.removedshare_tokens == nil {
+		m.removedshare_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.share_tokens, ids[i])
+		m.removedshare_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareTokens returns the removed IDs of the "share_tokens" edge to the ShareToken entity.
+func (m *TaskMutation) RemovedShareTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedshare_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareTokensIDs returns the "share_tokens" edge IDs in the mutation.
+func (m *TaskMutation) ShareTokensIDs() (ids []uuid.UUID) {
+	for id := range m.share_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareTokens resets all changes to the "share_tokens" edge.
+func (m *TaskMutation) ResetShareTokens() {
+	m.share_tokens = nil
+	m.clearedshare_tokens = false
+	m.removedshare_tokens = nil
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (m *TaskMutation) ClearConnection() {
+	m.clearedconnection = true
+	m.clearedFields[task.FieldConnectionID] = struct{}{}
+}
+
+// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
+func (m *TaskMutation) ConnectionCleared() bool {
+	return m.ConnectionIDCleared() || m.clearedconnection
+}
+
+// ConnectionIDs returns the "connection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConnectionID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) ConnectionIDs() (ids []uuid.UUID) {
+	if id := m.connection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConnection resets all changes to the "connection" edge.
+func (m *TaskMutation) ResetConnection() {
+	m.connection = nil
+	m.clearedconnection = false
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.name != nil {
+		fields = append(fields, task.FieldName)
+	}
+	if m.source_path != nil {
+		fields = append(fields, task.FieldSourcePath)
+	}
+	if m.connection != nil {
+		fields = append(fields, task.FieldConnectionID)
+	}
+	if m.remote_path != nil {
+		fields = append(fields, task.FieldRemotePath)
+	}
+	if m.direction != nil {
+		fields = append(fields, task.FieldDirection)
+	}
+	if m.schedule != nil {
+		fields = append(fields, task.FieldSchedule)
+	}
+	if m.realtime != nil {
+		fields = append(fields, task.FieldRealtime)
+	}
+	if m.options != nil {
+		fields = append(fields, task.FieldOptions)
+	}
+	if m.tags != nil {
+		fields = append(fields, task.FieldTags)
+	}
+	if m.engine != nil {
+		fields = append(fields, task.FieldEngine)
+	}
+	if m.skipped_runs != nil {
+		fields = append(fields, task.FieldSkippedRuns)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, task.FieldConsecutiveFailures)
+	}
+	if m.consecutive_fatal_failures != nil {
+		fields = append(fields, task.FieldConsecutiveFatalFailures)
+	}
+	if m.disabled != nil {
+		fields = append(fields, task.FieldDisabled)
+	}
+	if m.disabled_reason != nil {
+		fields = append(fields, task.FieldDisabledReason)
+	}
+	if m.ephemeral != nil {
+		fields = append(fields, task.FieldEphemeral)
+	}
+	if m.created_at != nil {
+		fields = append(fields, task.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, task.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, task.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldName:
+		return m.Name()
+	case task.FieldSourcePath:
+		return m.SourcePath()
+	case task.FieldConnectionID:
+		return m.ConnectionID()
+	case task.FieldRemotePath:
+		return m.RemotePath()
+	case task.FieldDirection:
+		return m.Direction()
+	case task.FieldSchedule:
+		return m.Schedule()
+	case task.FieldRealtime:
+		return m.Realtime()
+	case task.FieldOptions:
+		return m.Options()
+	case task.FieldTags:
+		return m.Tags()
+	case task.FieldEngine:
+		return m.Engine()
+	case task.FieldSkippedRuns:
+		return m.SkippedRuns()
+	case task.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case task.FieldConsecutiveFatalFailures:
+		return m.ConsecutiveFatalFailures()
+	case task.FieldDisabled:
+		return m.Disabled()
+	case task.FieldDisabledReason:
+		return m.DisabledReason()
+	case task.FieldEphemeral:
+		return m.Ephemeral()
+	case task.FieldCreatedAt:
+		return m.CreatedAt()
+	case task.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case task.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case task.FieldName:
+		return m.OldName(ctx)
+	case task.FieldSourcePath:
+		return m.OldSourcePath(ctx)
+	case task.FieldConnectionID:
+		return m.OldConnectionID(ctx)
+	case task.FieldRemotePath:
+		return m.OldRemotePath(ctx)
+	case task.FieldDirection:
+		return m.OldDirection(ctx)
+	case task.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case task.FieldRealtime:
+		return m.OldRealtime(ctx)
+	case task.FieldOptions:
+		return m.OldOptions(ctx)
+	case task.FieldTags:
+		return m.OldTags(ctx)
+	case task.FieldEngine:
+		return m.OldEngine(ctx)
+	case task.FieldSkippedRuns:
+		return m.OldSkippedRuns(ctx)
+	case task.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case task.FieldConsecutiveFatalFailures:
+		return m.OldConsecutiveFatalFailures(ctx)
+	case task.FieldDisabled:
+		return m.OldDisabled(ctx)
+	case task.FieldDisabledReason:
+		return m.OldDisabledReason(ctx)
+	case task.FieldEphemeral:
+		return m.OldEphemeral(ctx)
+	case task.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case task.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case task.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Task field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case task.FieldSourcePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourcePath(v)
+		return nil
+	case task.FieldConnectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectionID(v)
+		return nil
+	case task.FieldRemotePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemotePath(v)
+		return nil
+	case task.FieldDirection:
+		v, ok := value.(model.SyncDirection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirection(v)
+		return nil
+	case task.FieldSchedule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedule(v)
+		return nil
+	case task.FieldRealtime:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRealtime(v)
+		return nil
+	case task.FieldOptions:
+		v, ok := value.(*model.TaskSyncOptions)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case task.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case task.FieldEngine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngine(v)
+		return nil
+	case task.FieldSkippedRuns:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkippedRuns(v)
+		return nil
+	case task.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case task.FieldConsecutiveFatalFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFatalFailures(v)
+		return nil
+	case task.FieldDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabled(v)
+		return nil
+	case task.FieldDisabledReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabledReason(v)
+		return nil
+	case task.FieldEphemeral:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEphemeral(v)
+		return nil
+	case task.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case task.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case task.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addskipped_runs != nil {
+		fields = append(fields, task.FieldSkippedRuns)
+	}
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, task.FieldConsecutiveFailures)
+	}
+	if m.addconsecutive_fatal_failures != nil {
+		fields = append(fields, task.FieldConsecutiveFatalFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldSkippedRuns:
+		return m.AddedSkippedRuns()
+	case task.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	case task.FieldConsecutiveFatalFailures:
+		return m.AddedConsecutiveFatalFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldSkippedRuns:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkippedRuns(v)
+		return nil
+	case task.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	case task.FieldConsecutiveFatalFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFatalFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldConnectionID) {
+		fields = append(fields, task.FieldConnectionID)
+	}
+	if m.FieldCleared(task.FieldSchedule) {
+		fields = append(fields, task.FieldSchedule)
+	}
+	if m.FieldCleared(task.FieldOptions) {
+		fields = append(fields, task.FieldOptions)
+	}
+	if m.FieldCleared(task.FieldTags) {
+		fields = append(fields, task.FieldTags)
+	}
+	if m.FieldCleared(task.FieldDisabledReason) {
+		fields = append(fields, task.FieldDisabledReason)
+	}
+	if m.FieldCleared(task.FieldDeletedAt) {
+		fields = append(fields, task.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldConnectionID:
+		m.ClearConnectionID()
+		return nil
+	case task.FieldSchedule:
+		m.ClearSchedule()
+		return nil
+	case task.FieldOptions:
+		m.ClearOptions()
+		return nil
+	case task.FieldTags:
+		m.ClearTags()
+		return nil
+	case task.FieldDisabledReason:
+		m.ClearDisabledReason()
+		return nil
+	case task.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case task.FieldName:
+		m.ResetName()
+		return nil
+	case task.FieldSourcePath:
+		m.ResetSourcePath()
+		return nil
+	case task.FieldConnectionID:
+		m.ResetConnectionID()
+		return nil
+	case task.FieldRemotePath:
+		m.ResetRemotePath()
+		return nil
+	case task.FieldDirection:
+		m.ResetDirection()
+		return nil
+	case task.FieldSchedule:
+		m.ResetSchedule()
+		return nil
+	case task.FieldRealtime:
+		m.ResetRealtime()
+		return nil
+	case task.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case task.FieldTags:
+		m.ResetTags()
+		return nil
+	case task.FieldEngine:
+		m.ResetEngine()
+		return nil
+	case task.FieldSkippedRuns:
+		m.ResetSkippedRuns()
+		return nil
+	case task.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case task.FieldConsecutiveFatalFailures:
+		m.ResetConsecutiveFatalFailures()
+		return nil
+	case task.FieldDisabled:
+		m.ResetDisabled()
+		return nil
+	case task.FieldDisabledReason:
+		m.ResetDisabledReason()
+		return nil
+	case task.FieldEphemeral:
+		m.ResetEphemeral()
+		return nil
+	case task.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case task.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case task.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.jobs != nil {
+		edges = append(edges, task.EdgeJobs)
+	}
+	if m.events != nil {
+		edges = append(edges, task.EdgeEvents)
+	}
+	if m.share_tokens != nil {
+		edges = append(edges, task.EdgeShareTokens)
+	}
+	if m.connection != nil {
+		edges = append(edges, task.EdgeConnection)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeShareTokens:
+		ids := make([]ent.Value, 0, len(m.share_tokens))
+		for id := range m.share_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeConnection:
+		if id := m.connection; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedjobs != nil {
+		edges = append(edges, task.EdgeJobs)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, task.EdgeEvents)
+	}
+	if m.removedshare_tokens != nil {
+		edges = append(edges, task.EdgeShareTokens)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeShareTokens:
+		ids := make([]ent.Value, 0, len(m.removedshare_tokens))
+		for id := range m.removedshare_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedjobs {
+		edges = append(edges, task.EdgeJobs)
+	}
+	if m.clearedevents {
+		edges = append(edges, task.EdgeEvents)
+	}
+	if m.clearedshare_tokens {
+		edges = append(edges, task.EdgeShareTokens)
+	}
+	if m.clearedconnection {
+		edges = append(edges, task.EdgeConnection)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case task.EdgeJobs:
+		return m.clearedjobs
+	case task.EdgeEvents:
+		return m.clearedevents
+	case task.EdgeShareTokens:
+		return m.clearedshare_tokens
+	case task.EdgeConnection:
+		return m.clearedconnection
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	switch name {
+	case task.EdgeConnection:
+		m.ClearConnection()
+		return nil
+	}
+	return fmt.Errorf("unknown Task unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	switch name {
+	case task.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	case task.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case task.EdgeShareTokens:
+		m.ResetShareTokens()
+		return nil
+	case task.EdgeConnection:
+		m.ResetConnection()
+		return nil
+	}
+	return fmt.Errorf("unknown Task edge %s", name)
+}
+
+// TaskEventMutation represents an operation that mutates the TaskEvent nodes in the graph.
+type TaskEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	_type         *model.TaskEventType
+	message       *string
+	time          *time.Time
+	clearedFields map[string]struct{}
+	task          *uuid.UUID
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*TaskEvent, error)
+	predicates    []predicate.TaskEvent
+}
+
+var _ ent.Mutation = (*TaskEventMutation)(nil)
+
+// taskeventOption allows management of the mutation configuration using functional options.
+type taskeventOption func(*TaskEventMutation)
+
+// newTaskEventMutation creates new mutation for the TaskEvent entity.
+func newTaskEventMutation(c config, op Op, opts ...taskeventOption) *TaskEventMutation {
+	m := &TaskEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskEventID sets the ID field of the mutation.
+func withTaskEventID(id uuid.UUID) taskeventOption {
+	return func(m *TaskEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskEvent
+		)
+		m.oldValue = func(ctx context.Context) (*TaskEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Schedule, nil
 }
 
-// ClearSchedule clears the value of the "schedule" field.
-func (m *TaskMutation) ClearSchedule() {
-	m.schedule = nil
-	m.clearedFields[task.FieldSchedule] = struct{}{}
+// withTaskEvent sets the old TaskEvent of the mutation.
+func withTaskEvent(node *TaskEvent) taskeventOption {
+	return func(m *TaskEventMutation) {
+		m.oldValue = func(context.Context) (*TaskEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ScheduleCleared returns if the "schedule" field was cleared in this mutation.
-func (m *TaskMutation) ScheduleCleared() bool {
-	_, ok := m.clearedFields[task.FieldSchedule]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetSchedule resets all changes to the "schedule" field.
-func (m *TaskMutation) ResetSchedule() {
-	m.schedule = nil
-	delete(m.clearedFields, task.FieldSchedule)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetRealtime sets the "realtime" field.
-func (m *TaskMutation) SetRealtime(b bool) {
-	m.realtime = &b
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskEvent entities.
+func (m *TaskEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// Realtime returns the value of the "realtime" field in the mutation.
-func (m *TaskMutation) Realtime() (r bool, exists bool) {
-	v := m.realtime
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldRealtime returns the old "realtime" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldRealtime(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRealtime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRealtime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRealtime: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Realtime, nil
-}
-
-// ResetRealtime resets all changes to the "realtime" field.
-func (m *TaskMutation) ResetRealtime() {
-	m.realtime = nil
 }
 
-// SetOptions sets the "options" field.
-func (m *TaskMutation) SetOptions(mso *model.TaskSyncOptions) {
-	m.options = &mso
+// SetTaskID sets the "task_id" field.
+func (m *TaskEventMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
 }
 
-// Options returns the value of the "options" field in the mutation.
-func (m *TaskMutation) Options() (r *model.TaskSyncOptions, exists bool) {
-	v := m.options
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TaskEventMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOptions returns the old "options" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the TaskEvent entity.
+// If the TaskEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOptions(ctx context.Context) (v *model.TaskSyncOptions, err error) {
+func (m *TaskEventMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptions requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Options, nil
-}
-
-// ClearOptions clears the value of the "options" field.
-func (m *TaskMutation) ClearOptions() {
-	m.options = nil
-	m.clearedFields[task.FieldOptions] = struct{}{}
-}
-
-// OptionsCleared returns if the "options" field was cleared in this mutation.
-func (m *TaskMutation) OptionsCleared() bool {
-	_, ok := m.clearedFields[task.FieldOptions]
-	return ok
+	return oldValue.TaskID, nil
 }
 
-// ResetOptions resets all changes to the "options" field.
-func (m *TaskMutation) ResetOptions() {
-	m.options = nil
-	delete(m.clearedFields, task.FieldOptions)
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TaskEventMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetType sets the "type" field.
+func (m *TaskEventMutation) SetType(met model.TaskEventType) {
+	m._type = &met
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// GetType returns the value of the "type" field in the mutation.
+func (m *TaskEventMutation) GetType() (r model.TaskEventType, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the TaskEvent entity.
+// If the TaskEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TaskEventMutation) OldType(ctx context.Context) (v model.TaskEventType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Type, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetType resets all changes to the "type" field.
+func (m *TaskEventMutation) ResetType() {
+	m._type = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TaskMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetMessage sets the "message" field.
+func (m *TaskEventMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Message returns the value of the "message" field in the mutation.
+func (m *TaskEventMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the TaskEvent entity.
+// If the TaskEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TaskEventMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Message, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TaskMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearMessage clears the value of the "message" field.
+func (m *TaskEventMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[taskevent.FieldMessage] = struct{}{}
 }
 
-// AddJobIDs adds the "jobs" edge to the Job entity by ids.
-func (m *TaskMutation) AddJobIDs(ids ...uuid.UUID) {
-	if m.jobs == nil {
-		m.jobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.jobs[ids[i]] = struct{}{}
-	}
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *TaskEventMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[taskevent.FieldMessage]
+	return ok
 }
 
-// ClearJobs clears the "jobs" edge to the Job entity.
-func (m *TaskMutation) ClearJobs() {
-	m.clearedjobs = true
+// ResetMessage resets all changes to the "message" field.
+func (m *TaskEventMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, taskevent.FieldMessage)
 }
 
-// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
-func (m *TaskMutation) JobsCleared() bool {
-	return m.clearedjobs
+// SetTime sets the "time" field.
+func (m *TaskEventMutation) SetTime(t time.Time) {
+	m.time = &t
 }
 
-// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
-func (m *TaskMutation) RemoveJobIDs(ids ...uuid.UUID) {
-	if m.removedjobs == nil {
-		m.removedjobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.jobs, ids[i])
-		m.removedjobs[ids[i]] = struct{}{}
+// Time returns the value of the "time" field in the mutation.
+func (m *TaskEventMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
-func (m *TaskMutation) RemovedJobsIDs() (ids []uuid.UUID) {
-	for id := range m.removedjobs {
-		ids = append(ids, id)
+// OldTime returns the old "time" field's value of the TaskEvent entity.
+// If the TaskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskEventMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// JobsIDs returns the "jobs" edge IDs in the mutation.
-func (m *TaskMutation) JobsIDs() (ids []uuid.UUID) {
-	for id := range m.jobs {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
 }
 
-// ResetJobs resets all changes to the "jobs" edge.
-func (m *TaskMutation) ResetJobs() {
-	m.jobs = nil
-	m.clearedjobs = false
-	m.removedjobs = nil
+// ResetTime resets all changes to the "time" field.
+func (m *TaskEventMutation) ResetTime() {
+	m.time = nil
 }
 
-// ClearConnection clears the "connection" edge to the Connection entity.
-func (m *TaskMutation) ClearConnection() {
-	m.clearedconnection = true
-	m.clearedFields[task.FieldConnectionID] = struct{}{}
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskEventMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[taskevent.FieldTaskID] = struct{}{}
 }
 
-// ConnectionCleared reports if the "connection" edge to the Connection entity was cleared.
-func (m *TaskMutation) ConnectionCleared() bool {
-	return m.ConnectionIDCleared() || m.clearedconnection
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskEventMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// ConnectionIDs returns the "connection" edge IDs in the mutation.
+// TaskIDs returns the "task" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ConnectionID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) ConnectionIDs() (ids []uuid.UUID) {
-	if id := m.connection; id != nil {
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskEventMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetConnection resets all changes to the "connection" edge.
-func (m *TaskMutation) ResetConnection() {
-	m.connection = nil
-	m.clearedconnection = false
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskEventMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the TaskEventMutation builder.
+func (m *TaskEventMutation) Where(ps ...predicate.TaskEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TaskEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *TaskEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3163,54 +12357,36 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *TaskEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *TaskEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (TaskEvent).
+func (m *TaskEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.name != nil {
-		fields = append(fields, task.FieldName)
-	}
-	if m.source_path != nil {
-		fields = append(fields, task.FieldSourcePath)
-	}
-	if m.connection != nil {
-		fields = append(fields, task.FieldConnectionID)
-	}
-	if m.remote_path != nil {
-		fields = append(fields, task.FieldRemotePath)
-	}
-	if m.direction != nil {
-		fields = append(fields, task.FieldDirection)
-	}
-	if m.schedule != nil {
-		fields = append(fields, task.FieldSchedule)
-	}
-	if m.realtime != nil {
-		fields = append(fields, task.FieldRealtime)
+func (m *TaskEventMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.task != nil {
+		fields = append(fields, taskevent.FieldTaskID)
 	}
-	if m.options != nil {
-		fields = append(fields, task.FieldOptions)
+	if m._type != nil {
+		fields = append(fields, taskevent.FieldType)
 	}
-	if m.created_at != nil {
-		fields = append(fields, task.FieldCreatedAt)
+	if m.message != nil {
+		fields = append(fields, taskevent.FieldMessage)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, task.FieldUpdatedAt)
+	if m.time != nil {
+		fields = append(fields, taskevent.FieldTime)
 	}
 	return fields
 }
@@ -3218,28 +12394,16 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldName:
-		return m.Name()
-	case task.FieldSourcePath:
-		return m.SourcePath()
-	case task.FieldConnectionID:
-		return m.ConnectionID()
-	case task.FieldRemotePath:
-		return m.RemotePath()
-	case task.FieldDirection:
-		return m.Direction()
-	case task.FieldSchedule:
-		return m.Schedule()
-	case task.FieldRealtime:
-		return m.Realtime()
-	case task.FieldOptions:
-		return m.Options()
-	case task.FieldCreatedAt:
-		return m.CreatedAt()
-	case task.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case taskevent.FieldTaskID:
+		return m.TaskID()
+	case taskevent.FieldType:
+		return m.GetType()
+	case taskevent.FieldMessage:
+		return m.Message()
+	case taskevent.FieldTime:
+		return m.Time()
 	}
 	return nil, false
 }
@@ -3247,235 +12411,142 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case task.FieldName:
-		return m.OldName(ctx)
-	case task.FieldSourcePath:
-		return m.OldSourcePath(ctx)
-	case task.FieldConnectionID:
-		return m.OldConnectionID(ctx)
-	case task.FieldRemotePath:
-		return m.OldRemotePath(ctx)
-	case task.FieldDirection:
-		return m.OldDirection(ctx)
-	case task.FieldSchedule:
-		return m.OldSchedule(ctx)
-	case task.FieldRealtime:
-		return m.OldRealtime(ctx)
-	case task.FieldOptions:
-		return m.OldOptions(ctx)
-	case task.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case task.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case taskevent.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case taskevent.FieldType:
+		return m.OldType(ctx)
+	case taskevent.FieldMessage:
+		return m.OldMessage(ctx)
+	case taskevent.FieldTime:
+		return m.OldTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown TaskEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *TaskEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case task.FieldSourcePath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourcePath(v)
-		return nil
-	case task.FieldConnectionID:
+	case taskevent.FieldTaskID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectionID(v)
-		return nil
-	case task.FieldRemotePath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRemotePath(v)
+		m.SetTaskID(v)
 		return nil
-	case task.FieldDirection:
-		v, ok := value.(model.SyncDirection)
+	case taskevent.FieldType:
+		v, ok := value.(model.TaskEventType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDirection(v)
+		m.SetType(v)
 		return nil
-	case task.FieldSchedule:
+	case taskevent.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSchedule(v)
-		return nil
-	case task.FieldRealtime:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRealtime(v)
-		return nil
-	case task.FieldOptions:
-		v, ok := value.(*model.TaskSyncOptions)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOptions(v)
-		return nil
-	case task.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+		m.SetMessage(v)
 		return nil
-	case task.FieldUpdatedAt:
+	case taskevent.FieldTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown TaskEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
+func (m *TaskEventMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *TaskEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown TaskEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *TaskEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldConnectionID) {
-		fields = append(fields, task.FieldConnectionID)
-	}
-	if m.FieldCleared(task.FieldSchedule) {
-		fields = append(fields, task.FieldSchedule)
-	}
-	if m.FieldCleared(task.FieldOptions) {
-		fields = append(fields, task.FieldOptions)
+	if m.FieldCleared(taskevent.FieldMessage) {
+		fields = append(fields, taskevent.FieldMessage)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *TaskEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *TaskEventMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldConnectionID:
-		m.ClearConnectionID()
-		return nil
-	case task.FieldSchedule:
-		m.ClearSchedule()
-		return nil
-	case task.FieldOptions:
-		m.ClearOptions()
+	case taskevent.FieldMessage:
+		m.ClearMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown TaskEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *TaskEventMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldName:
-		m.ResetName()
-		return nil
-	case task.FieldSourcePath:
-		m.ResetSourcePath()
-		return nil
-	case task.FieldConnectionID:
-		m.ResetConnectionID()
-		return nil
-	case task.FieldRemotePath:
-		m.ResetRemotePath()
-		return nil
-	case task.FieldDirection:
-		m.ResetDirection()
-		return nil
-	case task.FieldSchedule:
-		m.ResetSchedule()
-		return nil
-	case task.FieldRealtime:
-		m.ResetRealtime()
+	case taskevent.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case task.FieldOptions:
-		m.ResetOptions()
+	case taskevent.FieldType:
+		m.ResetType()
 		return nil
-	case task.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case taskevent.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case task.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case taskevent.FieldTime:
+		m.ResetTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown TaskEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.jobs != nil {
-		edges = append(edges, task.EdgeJobs)
-	}
-	if m.connection != nil {
-		edges = append(edges, task.EdgeConnection)
+func (m *TaskEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, taskevent.EdgeTask)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.jobs))
-		for id := range m.jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case task.EdgeConnection:
-		if id := m.connection; id != nil {
+	case taskevent.EdgeTask:
+		if id := m.task; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3483,73 +12554,54 @@ func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedjobs != nil {
-		edges = append(edges, task.EdgeJobs)
-	}
+func (m *TaskEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case task.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.removedjobs))
-		for id := range m.removedjobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TaskEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedjobs {
-		edges = append(edges, task.EdgeJobs)
-	}
-	if m.clearedconnection {
-		edges = append(edges, task.EdgeConnection)
+func (m *TaskEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, taskevent.EdgeTask)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *TaskEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeJobs:
-		return m.clearedjobs
-	case task.EdgeConnection:
-		return m.clearedconnection
+	case taskevent.EdgeTask:
+		return m.clearedtask
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *TaskEventMutation) ClearEdge(name string) error {
 	switch name {
-	case task.EdgeConnection:
-		m.ClearConnection()
+	case taskevent.EdgeTask:
+		m.ClearTask()
 		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown TaskEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *TaskEventMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeJobs:
-		m.ResetJobs()
-		return nil
-	case task.EdgeConnection:
-		m.ResetConnection()
+	case taskevent.EdgeTask:
+		m.ResetTask()
 		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown TaskEvent edge %s", name)
 }
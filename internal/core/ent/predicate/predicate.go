@@ -9,11 +9,32 @@ import (
 // Connection is the predicate function for connection builders.
 type Connection func(*sql.Selector)
 
+// ConnectionTransfer is the predicate function for connectiontransfer builders.
+type ConnectionTransfer func(*sql.Selector)
+
+// ConnectionUsage is the predicate function for connectionusage builders.
+type ConnectionUsage func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
 // Job is the predicate function for job builders.
 type Job func(*sql.Selector)
 
+// JobEvent is the predicate function for jobevent builders.
+type JobEvent func(*sql.Selector)
+
 // JobLog is the predicate function for joblog builders.
 type JobLog func(*sql.Selector)
 
+// RetryQueue is the predicate function for retryqueue builders.
+type RetryQueue func(*sql.Selector)
+
+// ShareToken is the predicate function for sharetoken builders.
+type ShareToken func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
+
+// TaskEvent is the predicate function for taskevent builders.
+type TaskEvent func(*sql.Selector)
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+)
+
+// RetryQueue is the model entity for the RetryQueue schema.
+type RetryQueue struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID uuid.UUID `json:"job_id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// Path of the file relative to the task's source and remote path
+	Path string `json:"path,omitempty"`
+	// Direction holds the value of the "direction" field.
+	Direction model.SyncDirection `json:"direction,omitempty"`
+	// ErrorClass holds the value of the "error_class" field.
+	ErrorClass model.TransferErrorClass `json:"error_class,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When a retry of the file was requested
+	RequestedAt *time.Time `json:"requested_at,omitempty"`
+	// Job that retried the file
+	RetryJobID *uuid.UUID `json:"retry_job_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RetryQueueQuery when eager-loading is set.
+	Edges        RetryQueueEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RetryQueueEdges holds the relations/edges for other nodes in the graph.
+type RetryQueueEdges struct {
+	// Job holds the value of the job edge.
+	Job *Job `json:"job,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobOrErr returns the Job value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RetryQueueEdges) JobOrErr() (*Job, error) {
+	if e.Job != nil {
+		return e.Job, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: job.Label}
+	}
+	return nil, &NotLoadedError{edge: "job"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RetryQueue) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case retryqueue.FieldRetryJobID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case retryqueue.FieldPath, retryqueue.FieldDirection, retryqueue.FieldErrorClass, retryqueue.FieldError:
+			values[i] = new(sql.NullString)
+		case retryqueue.FieldCreatedAt, retryqueue.FieldRequestedAt:
+			values[i] = new(sql.NullTime)
+		case retryqueue.FieldID, retryqueue.FieldJobID, retryqueue.FieldTaskID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RetryQueue fields.
+func (_m *RetryQueue) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case retryqueue.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case retryqueue.FieldJobID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value != nil {
+				_m.JobID = *value
+			}
+		case retryqueue.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				_m.TaskID = *value
+			}
+		case retryqueue.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case retryqueue.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				_m.Direction = model.SyncDirection(value.String)
+			}
+		case retryqueue.FieldErrorClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_class", values[i])
+			} else if value.Valid {
+				_m.ErrorClass = model.TransferErrorClass(value.String)
+			}
+		case retryqueue.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case retryqueue.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case retryqueue.FieldRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_at", values[i])
+			} else if value.Valid {
+				_m.RequestedAt = new(time.Time)
+				*_m.RequestedAt = value.Time
+			}
+		case retryqueue.FieldRetryJobID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_job_id", values[i])
+			} else if value.Valid {
+				_m.RetryJobID = new(uuid.UUID)
+				*_m.RetryJobID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RetryQueue.
+// This includes values selected through modifiers, order, etc.
+func (_m *RetryQueue) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryJob queries the "job" edge of the RetryQueue entity.
+func (_m *RetryQueue) QueryJob() *JobQuery {
+	return NewRetryQueueClient(_m.config).QueryJob(_m)
+}
+
+// Update returns a builder for updating this RetryQueue.
+// Note that you need to call RetryQueue.Unwrap() before calling this method if this RetryQueue
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RetryQueue) Update() *RetryQueueUpdateOne {
+	return NewRetryQueueClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RetryQueue entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RetryQueue) Unwrap() *RetryQueue {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RetryQueue is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RetryQueue) String() string {
+	var builder strings.Builder
+	builder.WriteString("RetryQueue(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("direction=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Direction))
+	builder.WriteString(", ")
+	builder.WriteString("error_class=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ErrorClass))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RequestedAt; v != nil {
+		builder.WriteString("requested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RetryJobID; v != nil {
+		builder.WriteString("retry_job_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RetryQueues is a parsable slice of RetryQueue.
+type RetryQueues []*RetryQueue
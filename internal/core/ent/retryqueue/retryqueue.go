@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retryqueue
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+const (
+	// Label holds the string label denoting the retryqueue type in the database.
+	Label = "retry_queue"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldDirection holds the string denoting the direction field in the database.
+	FieldDirection = "direction"
+	// FieldErrorClass holds the string denoting the error_class field in the database.
+	FieldErrorClass = "error_class"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRequestedAt holds the string denoting the requested_at field in the database.
+	FieldRequestedAt = "requested_at"
+	// FieldRetryJobID holds the string denoting the retry_job_id field in the database.
+	FieldRetryJobID = "retry_job_id"
+	// EdgeJob holds the string denoting the job edge name in mutations.
+	EdgeJob = "job"
+	// Table holds the table name of the retryqueue in the database.
+	Table = "retry_queues"
+	// JobTable is the table that holds the job relation/edge.
+	JobTable = "retry_queues"
+	// JobInverseTable is the table name for the Job entity.
+	// It exists in this package in order to avoid circular dependency with the "job" package.
+	JobInverseTable = "jobs"
+	// JobColumn is the table column denoting the job relation/edge.
+	JobColumn = "job_id"
+)
+
+// Columns holds all SQL columns for retryqueue fields.
+var Columns = []string{
+	FieldID,
+	FieldJobID,
+	FieldTaskID,
+	FieldPath,
+	FieldDirection,
+	FieldErrorClass,
+	FieldError,
+	FieldCreatedAt,
+	FieldRequestedAt,
+	FieldRetryJobID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// DirectionValidator is a validator for the "direction" field enum values. It is called by the builders before save.
+func DirectionValidator(d model.SyncDirection) error {
+	switch d.String() {
+	case "UPLOAD", "DOWNLOAD", "BIDIRECTIONAL":
+		return nil
+	default:
+		return fmt.Errorf("retryqueue: invalid enum value for direction field: %q", d)
+	}
+}
+
+// ErrorClassValidator is a validator for the "error_class" field enum values. It is called by the builders before save.
+func ErrorClassValidator(ec model.TransferErrorClass) error {
+	switch ec.String() {
+	case "NOT_FOUND", "PERMISSION_DENIED", "NO_SPACE", "RATE_LIMITED", "NETWORK", "CORRUPTED", "OTHER":
+		return nil
+	default:
+		return fmt.Errorf("retryqueue: invalid enum value for error_class field: %q", ec)
+	}
+}
+
+// OrderOption defines the ordering options for the RetryQueue queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByDirection orders the results by the direction field.
+func ByDirection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirection, opts...).ToFunc()
+}
+
+// ByErrorClass orders the results by the error_class field.
+func ByErrorClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorClass, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRequestedAt orders the results by the requested_at field.
+func ByRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedAt, opts...).ToFunc()
+}
+
+// ByRetryJobID orders the results by the retry_job_id field.
+func ByRetryJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryJobID, opts...).ToFunc()
+}
+
+// ByJobField orders the results by job field.
+func ByJobField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newJobStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JobTable, JobColumn),
+	)
+}
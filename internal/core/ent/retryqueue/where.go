@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retryqueue
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldID, id))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldJobID, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldTaskID, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldPath, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RequestedAt applies equality check predicate on the "requested_at" field. It's identical to RequestedAtEQ.
+func RequestedAt(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldRequestedAt, v))
+}
+
+// RetryJobID applies equality check predicate on the "retry_job_id" field. It's identical to RetryJobIDEQ.
+func RetryJobID(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldRetryJobID, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldTaskID, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldContainsFold(FieldPath, v))
+}
+
+// DirectionEQ applies the EQ predicate on the "direction" field.
+func DirectionEQ(v model.SyncDirection) predicate.RetryQueue {
+	vc := v
+	return predicate.RetryQueue(sql.FieldEQ(FieldDirection, vc))
+}
+
+// DirectionNEQ applies the NEQ predicate on the "direction" field.
+func DirectionNEQ(v model.SyncDirection) predicate.RetryQueue {
+	vc := v
+	return predicate.RetryQueue(sql.FieldNEQ(FieldDirection, vc))
+}
+
+// DirectionIn applies the In predicate on the "direction" field.
+func DirectionIn(vs ...model.SyncDirection) predicate.RetryQueue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RetryQueue(sql.FieldIn(FieldDirection, v...))
+}
+
+// DirectionNotIn applies the NotIn predicate on the "direction" field.
+func DirectionNotIn(vs ...model.SyncDirection) predicate.RetryQueue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RetryQueue(sql.FieldNotIn(FieldDirection, v...))
+}
+
+// ErrorClassEQ applies the EQ predicate on the "error_class" field.
+func ErrorClassEQ(v model.TransferErrorClass) predicate.RetryQueue {
+	vc := v
+	return predicate.RetryQueue(sql.FieldEQ(FieldErrorClass, vc))
+}
+
+// ErrorClassNEQ applies the NEQ predicate on the "error_class" field.
+func ErrorClassNEQ(v model.TransferErrorClass) predicate.RetryQueue {
+	vc := v
+	return predicate.RetryQueue(sql.FieldNEQ(FieldErrorClass, vc))
+}
+
+// ErrorClassIn applies the In predicate on the "error_class" field.
+func ErrorClassIn(vs ...model.TransferErrorClass) predicate.RetryQueue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RetryQueue(sql.FieldIn(FieldErrorClass, v...))
+}
+
+// ErrorClassNotIn applies the NotIn predicate on the "error_class" field.
+func ErrorClassNotIn(vs ...model.TransferErrorClass) predicate.RetryQueue {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RetryQueue(sql.FieldNotIn(FieldErrorClass, v...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RequestedAtEQ applies the EQ predicate on the "requested_at" field.
+func RequestedAtEQ(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldRequestedAt, v))
+}
+
+// RequestedAtNEQ applies the NEQ predicate on the "requested_at" field.
+func RequestedAtNEQ(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldRequestedAt, v))
+}
+
+// RequestedAtIn applies the In predicate on the "requested_at" field.
+func RequestedAtIn(vs ...time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldRequestedAt, vs...))
+}
+
+// RequestedAtNotIn applies the NotIn predicate on the "requested_at" field.
+func RequestedAtNotIn(vs ...time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldRequestedAt, vs...))
+}
+
+// RequestedAtGT applies the GT predicate on the "requested_at" field.
+func RequestedAtGT(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldRequestedAt, v))
+}
+
+// RequestedAtGTE applies the GTE predicate on the "requested_at" field.
+func RequestedAtGTE(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldRequestedAt, v))
+}
+
+// RequestedAtLT applies the LT predicate on the "requested_at" field.
+func RequestedAtLT(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldRequestedAt, v))
+}
+
+// RequestedAtLTE applies the LTE predicate on the "requested_at" field.
+func RequestedAtLTE(v time.Time) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldRequestedAt, v))
+}
+
+// RequestedAtIsNil applies the IsNil predicate on the "requested_at" field.
+func RequestedAtIsNil() predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIsNull(FieldRequestedAt))
+}
+
+// RequestedAtNotNil applies the NotNil predicate on the "requested_at" field.
+func RequestedAtNotNil() predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotNull(FieldRequestedAt))
+}
+
+// RetryJobIDEQ applies the EQ predicate on the "retry_job_id" field.
+func RetryJobIDEQ(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldEQ(FieldRetryJobID, v))
+}
+
+// RetryJobIDNEQ applies the NEQ predicate on the "retry_job_id" field.
+func RetryJobIDNEQ(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNEQ(FieldRetryJobID, v))
+}
+
+// RetryJobIDIn applies the In predicate on the "retry_job_id" field.
+func RetryJobIDIn(vs ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIn(FieldRetryJobID, vs...))
+}
+
+// RetryJobIDNotIn applies the NotIn predicate on the "retry_job_id" field.
+func RetryJobIDNotIn(vs ...uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotIn(FieldRetryJobID, vs...))
+}
+
+// RetryJobIDGT applies the GT predicate on the "retry_job_id" field.
+func RetryJobIDGT(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGT(FieldRetryJobID, v))
+}
+
+// RetryJobIDGTE applies the GTE predicate on the "retry_job_id" field.
+func RetryJobIDGTE(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldGTE(FieldRetryJobID, v))
+}
+
+// RetryJobIDLT applies the LT predicate on the "retry_job_id" field.
+func RetryJobIDLT(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLT(FieldRetryJobID, v))
+}
+
+// RetryJobIDLTE applies the LTE predicate on the "retry_job_id" field.
+func RetryJobIDLTE(v uuid.UUID) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldLTE(FieldRetryJobID, v))
+}
+
+// RetryJobIDIsNil applies the IsNil predicate on the "retry_job_id" field.
+func RetryJobIDIsNil() predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldIsNull(FieldRetryJobID))
+}
+
+// RetryJobIDNotNil applies the NotNil predicate on the "retry_job_id" field.
+func RetryJobIDNotNil() predicate.RetryQueue {
+	return predicate.RetryQueue(sql.FieldNotNull(FieldRetryJobID))
+}
+
+// HasJob applies the HasEdge predicate on the "job" edge.
+func HasJob() predicate.RetryQueue {
+	return predicate.RetryQueue(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, JobTable, JobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobWith applies the HasEdge predicate on the "job" edge with a given conditions (other predicates).
+func HasJobWith(preds ...predicate.Job) predicate.RetryQueue {
+	return predicate.RetryQueue(func(s *sql.Selector) {
+		step := newJobStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RetryQueue) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RetryQueue) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RetryQueue) predicate.RetryQueue {
+	return predicate.RetryQueue(sql.NotPredicates(p))
+}
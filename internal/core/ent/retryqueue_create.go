@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+)
+
+// RetryQueueCreate is the builder for creating a RetryQueue entity.
+type RetryQueueCreate struct {
+	config
+	mutation *RetryQueueMutation
+	hooks    []Hook
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *RetryQueueCreate) SetJobID(v uuid.UUID) *RetryQueueCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *RetryQueueCreate) SetTaskID(v uuid.UUID) *RetryQueueCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *RetryQueueCreate) SetPath(v string) *RetryQueueCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetDirection sets the "direction" field.
+func (_c *RetryQueueCreate) SetDirection(v model.SyncDirection) *RetryQueueCreate {
+	_c.mutation.SetDirection(v)
+	return _c
+}
+
+// SetErrorClass sets the "error_class" field.
+func (_c *RetryQueueCreate) SetErrorClass(v model.TransferErrorClass) *RetryQueueCreate {
+	_c.mutation.SetErrorClass(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *RetryQueueCreate) SetError(v string) *RetryQueueCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RetryQueueCreate) SetCreatedAt(v time.Time) *RetryQueueCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RetryQueueCreate) SetNillableCreatedAt(v *time.Time) *RetryQueueCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (_c *RetryQueueCreate) SetRequestedAt(v time.Time) *RetryQueueCreate {
+	_c.mutation.SetRequestedAt(v)
+	return _c
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (_c *RetryQueueCreate) SetNillableRequestedAt(v *time.Time) *RetryQueueCreate {
+	if v != nil {
+		_c.SetRequestedAt(*v)
+	}
+	return _c
+}
+
+// SetRetryJobID sets the "retry_job_id" field.
+func (_c *RetryQueueCreate) SetRetryJobID(v uuid.UUID) *RetryQueueCreate {
+	_c.mutation.SetRetryJobID(v)
+	return _c
+}
+
+// SetNillableRetryJobID sets the "retry_job_id" field if the given value is not nil.
+func (_c *RetryQueueCreate) SetNillableRetryJobID(v *uuid.UUID) *RetryQueueCreate {
+	if v != nil {
+		_c.SetRetryJobID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RetryQueueCreate) SetID(v uuid.UUID) *RetryQueueCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RetryQueueCreate) SetNillableID(v *uuid.UUID) *RetryQueueCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (_c *RetryQueueCreate) SetJob(v *Job) *RetryQueueCreate {
+	return _c.SetJobID(v.ID)
+}
+
+// Mutation returns the RetryQueueMutation object of the builder.
+func (_c *RetryQueueCreate) Mutation() *RetryQueueMutation {
+	return _c.mutation
+}
+
+// Save creates the RetryQueue in the database.
+func (_c *RetryQueueCreate) Save(ctx context.Context) (*RetryQueue, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RetryQueueCreate) SaveX(ctx context.Context) *RetryQueue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RetryQueueCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RetryQueueCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RetryQueueCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := retryqueue.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := retryqueue.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RetryQueueCreate) check() error {
+	if _, ok := _c.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "RetryQueue.job_id"`)}
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "RetryQueue.task_id"`)}
+	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "RetryQueue.path"`)}
+	}
+	if _, ok := _c.mutation.Direction(); !ok {
+		return &ValidationError{Name: "direction", err: errors.New(`ent: missing required field "RetryQueue.direction"`)}
+	}
+	if v, ok := _c.mutation.Direction(); ok {
+		if err := retryqueue.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "RetryQueue.direction": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ErrorClass(); !ok {
+		return &ValidationError{Name: "error_class", err: errors.New(`ent: missing required field "RetryQueue.error_class"`)}
+	}
+	if v, ok := _c.mutation.ErrorClass(); ok {
+		if err := retryqueue.ErrorClassValidator(v); err != nil {
+			return &ValidationError{Name: "error_class", err: fmt.Errorf(`ent: validator failed for field "RetryQueue.error_class": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "RetryQueue.error"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RetryQueue.created_at"`)}
+	}
+	if len(_c.mutation.JobIDs()) == 0 {
+		return &ValidationError{Name: "job", err: errors.New(`ent: missing required edge "RetryQueue.job"`)}
+	}
+	return nil
+}
+
+func (_c *RetryQueueCreate) sqlSave(ctx context.Context) (*RetryQueue, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RetryQueueCreate) createSpec() (*RetryQueue, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RetryQueue{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(retryqueue.Table, sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(retryqueue.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(retryqueue.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Direction(); ok {
+		_spec.SetField(retryqueue.FieldDirection, field.TypeEnum, value)
+		_node.Direction = value
+	}
+	if value, ok := _c.mutation.ErrorClass(); ok {
+		_spec.SetField(retryqueue.FieldErrorClass, field.TypeEnum, value)
+		_node.ErrorClass = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(retryqueue.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(retryqueue.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.RequestedAt(); ok {
+		_spec.SetField(retryqueue.FieldRequestedAt, field.TypeTime, value)
+		_node.RequestedAt = &value
+	}
+	if value, ok := _c.mutation.RetryJobID(); ok {
+		_spec.SetField(retryqueue.FieldRetryJobID, field.TypeUUID, value)
+		_node.RetryJobID = &value
+	}
+	if nodes := _c.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retryqueue.JobTable,
+			Columns: []string{retryqueue.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JobID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RetryQueueCreateBulk is the builder for creating many RetryQueue entities in bulk.
+type RetryQueueCreateBulk struct {
+	config
+	err      error
+	builders []*RetryQueueCreate
+}
+
+// Save creates the RetryQueue entities in the database.
+func (_c *RetryQueueCreateBulk) Save(ctx context.Context) ([]*RetryQueue, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RetryQueue, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RetryQueueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RetryQueueCreateBulk) SaveX(ctx context.Context) []*RetryQueue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RetryQueueCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RetryQueueCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
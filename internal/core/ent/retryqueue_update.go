@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+)
+
+// RetryQueueUpdate is the builder for updating RetryQueue entities.
+type RetryQueueUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RetryQueueMutation
+}
+
+// Where appends a list predicates to the RetryQueueUpdate builder.
+func (_u *RetryQueueUpdate) Where(ps ...predicate.RetryQueue) *RetryQueueUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *RetryQueueUpdate) SetJobID(v uuid.UUID) *RetryQueueUpdate {
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableJobID(v *uuid.UUID) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *RetryQueueUpdate) SetTaskID(v uuid.UUID) *RetryQueueUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableTaskID(v *uuid.UUID) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *RetryQueueUpdate) SetPath(v string) *RetryQueueUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillablePath(v *string) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetDirection sets the "direction" field.
+func (_u *RetryQueueUpdate) SetDirection(v model.SyncDirection) *RetryQueueUpdate {
+	_u.mutation.SetDirection(v)
+	return _u
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableDirection(v *model.SyncDirection) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetDirection(*v)
+	}
+	return _u
+}
+
+// SetErrorClass sets the "error_class" field.
+func (_u *RetryQueueUpdate) SetErrorClass(v model.TransferErrorClass) *RetryQueueUpdate {
+	_u.mutation.SetErrorClass(v)
+	return _u
+}
+
+// SetNillableErrorClass sets the "error_class" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableErrorClass(v *model.TransferErrorClass) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetErrorClass(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *RetryQueueUpdate) SetError(v string) *RetryQueueUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableError(v *string) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RetryQueueUpdate) SetCreatedAt(v time.Time) *RetryQueueUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableCreatedAt(v *time.Time) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (_u *RetryQueueUpdate) SetRequestedAt(v time.Time) *RetryQueueUpdate {
+	_u.mutation.SetRequestedAt(v)
+	return _u
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableRequestedAt(v *time.Time) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearRequestedAt clears the value of the "requested_at" field.
+func (_u *RetryQueueUpdate) ClearRequestedAt() *RetryQueueUpdate {
+	_u.mutation.ClearRequestedAt()
+	return _u
+}
+
+// SetRetryJobID sets the "retry_job_id" field.
+func (_u *RetryQueueUpdate) SetRetryJobID(v uuid.UUID) *RetryQueueUpdate {
+	_u.mutation.SetRetryJobID(v)
+	return _u
+}
+
+// SetNillableRetryJobID sets the "retry_job_id" field if the given value is not nil.
+func (_u *RetryQueueUpdate) SetNillableRetryJobID(v *uuid.UUID) *RetryQueueUpdate {
+	if v != nil {
+		_u.SetRetryJobID(*v)
+	}
+	return _u
+}
+
+// ClearRetryJobID clears the value of the "retry_job_id" field.
+func (_u *RetryQueueUpdate) ClearRetryJobID() *RetryQueueUpdate {
+	_u.mutation.ClearRetryJobID()
+	return _u
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (_u *RetryQueueUpdate) SetJob(v *Job) *RetryQueueUpdate {
+	return _u.SetJobID(v.ID)
+}
+
+// Mutation returns the RetryQueueMutation object of the builder.
+func (_u *RetryQueueUpdate) Mutation() *RetryQueueMutation {
+	return _u.mutation
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (_u *RetryQueueUpdate) ClearJob() *RetryQueueUpdate {
+	_u.mutation.ClearJob()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RetryQueueUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RetryQueueUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RetryQueueUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RetryQueueUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RetryQueueUpdate) check() error {
+	if v, ok := _u.mutation.Direction(); ok {
+		if err := retryqueue.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "RetryQueue.direction": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorClass(); ok {
+		if err := retryqueue.ErrorClassValidator(v); err != nil {
+			return &ValidationError{Name: "error_class", err: fmt.Errorf(`ent: validator failed for field "RetryQueue.error_class": %w`, err)}
+		}
+	}
+	if _u.mutation.JobCleared() && len(_u.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RetryQueue.job"`)
+	}
+	return nil
+}
+
+func (_u *RetryQueueUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retryqueue.Table, retryqueue.Columns, sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(retryqueue.FieldTaskID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(retryqueue.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Direction(); ok {
+		_spec.SetField(retryqueue.FieldDirection, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ErrorClass(); ok {
+		_spec.SetField(retryqueue.FieldErrorClass, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(retryqueue.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(retryqueue.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RequestedAt(); ok {
+		_spec.SetField(retryqueue.FieldRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RequestedAtCleared() {
+		_spec.ClearField(retryqueue.FieldRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RetryJobID(); ok {
+		_spec.SetField(retryqueue.FieldRetryJobID, field.TypeUUID, value)
+	}
+	if _u.mutation.RetryJobIDCleared() {
+		_spec.ClearField(retryqueue.FieldRetryJobID, field.TypeUUID)
+	}
+	if _u.mutation.JobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retryqueue.JobTable,
+			Columns: []string{retryqueue.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retryqueue.JobTable,
+			Columns: []string{retryqueue.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retryqueue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RetryQueueUpdateOne is the builder for updating a single RetryQueue entity.
+type RetryQueueUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RetryQueueMutation
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *RetryQueueUpdateOne) SetJobID(v uuid.UUID) *RetryQueueUpdateOne {
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableJobID(v *uuid.UUID) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *RetryQueueUpdateOne) SetTaskID(v uuid.UUID) *RetryQueueUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableTaskID(v *uuid.UUID) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *RetryQueueUpdateOne) SetPath(v string) *RetryQueueUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillablePath(v *string) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetDirection sets the "direction" field.
+func (_u *RetryQueueUpdateOne) SetDirection(v model.SyncDirection) *RetryQueueUpdateOne {
+	_u.mutation.SetDirection(v)
+	return _u
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableDirection(v *model.SyncDirection) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetDirection(*v)
+	}
+	return _u
+}
+
+// SetErrorClass sets the "error_class" field.
+func (_u *RetryQueueUpdateOne) SetErrorClass(v model.TransferErrorClass) *RetryQueueUpdateOne {
+	_u.mutation.SetErrorClass(v)
+	return _u
+}
+
+// SetNillableErrorClass sets the "error_class" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableErrorClass(v *model.TransferErrorClass) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetErrorClass(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *RetryQueueUpdateOne) SetError(v string) *RetryQueueUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableError(v *string) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RetryQueueUpdateOne) SetCreatedAt(v time.Time) *RetryQueueUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableCreatedAt(v *time.Time) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (_u *RetryQueueUpdateOne) SetRequestedAt(v time.Time) *RetryQueueUpdateOne {
+	_u.mutation.SetRequestedAt(v)
+	return _u
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableRequestedAt(v *time.Time) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearRequestedAt clears the value of the "requested_at" field.
+func (_u *RetryQueueUpdateOne) ClearRequestedAt() *RetryQueueUpdateOne {
+	_u.mutation.ClearRequestedAt()
+	return _u
+}
+
+// SetRetryJobID sets the "retry_job_id" field.
+func (_u *RetryQueueUpdateOne) SetRetryJobID(v uuid.UUID) *RetryQueueUpdateOne {
+	_u.mutation.SetRetryJobID(v)
+	return _u
+}
+
+// SetNillableRetryJobID sets the "retry_job_id" field if the given value is not nil.
+func (_u *RetryQueueUpdateOne) SetNillableRetryJobID(v *uuid.UUID) *RetryQueueUpdateOne {
+	if v != nil {
+		_u.SetRetryJobID(*v)
+	}
+	return _u
+}
+
+// ClearRetryJobID clears the value of the "retry_job_id" field.
+func (_u *RetryQueueUpdateOne) ClearRetryJobID() *RetryQueueUpdateOne {
+	_u.mutation.ClearRetryJobID()
+	return _u
+}
+
+// SetJob sets the "job" edge to the Job entity.
+func (_u *RetryQueueUpdateOne) SetJob(v *Job) *RetryQueueUpdateOne {
+	return _u.SetJobID(v.ID)
+}
+
+// Mutation returns the RetryQueueMutation object of the builder.
+func (_u *RetryQueueUpdateOne) Mutation() *RetryQueueMutation {
+	return _u.mutation
+}
+
+// ClearJob clears the "job" edge to the Job entity.
+func (_u *RetryQueueUpdateOne) ClearJob() *RetryQueueUpdateOne {
+	_u.mutation.ClearJob()
+	return _u
+}
+
+// Where appends a list predicates to the RetryQueueUpdate builder.
+func (_u *RetryQueueUpdateOne) Where(ps ...predicate.RetryQueue) *RetryQueueUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RetryQueueUpdateOne) Select(field string, fields ...string) *RetryQueueUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RetryQueue entity.
+func (_u *RetryQueueUpdateOne) Save(ctx context.Context) (*RetryQueue, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RetryQueueUpdateOne) SaveX(ctx context.Context) *RetryQueue {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RetryQueueUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RetryQueueUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RetryQueueUpdateOne) check() error {
+	if v, ok := _u.mutation.Direction(); ok {
+		if err := retryqueue.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "RetryQueue.direction": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorClass(); ok {
+		if err := retryqueue.ErrorClassValidator(v); err != nil {
+			return &ValidationError{Name: "error_class", err: fmt.Errorf(`ent: validator failed for field "RetryQueue.error_class": %w`, err)}
+		}
+	}
+	if _u.mutation.JobCleared() && len(_u.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RetryQueue.job"`)
+	}
+	return nil
+}
+
+func (_u *RetryQueueUpdateOne) sqlSave(ctx context.Context) (_node *RetryQueue, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retryqueue.Table, retryqueue.Columns, sqlgraph.NewFieldSpec(retryqueue.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RetryQueue.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retryqueue.FieldID)
+		for _, f := range fields {
+			if !retryqueue.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != retryqueue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(retryqueue.FieldTaskID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(retryqueue.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Direction(); ok {
+		_spec.SetField(retryqueue.FieldDirection, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ErrorClass(); ok {
+		_spec.SetField(retryqueue.FieldErrorClass, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(retryqueue.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(retryqueue.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RequestedAt(); ok {
+		_spec.SetField(retryqueue.FieldRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RequestedAtCleared() {
+		_spec.ClearField(retryqueue.FieldRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RetryJobID(); ok {
+		_spec.SetField(retryqueue.FieldRetryJobID, field.TypeUUID, value)
+	}
+	if _u.mutation.RetryJobIDCleared() {
+		_spec.ClearField(retryqueue.FieldRetryJobID, field.TypeUUID)
+	}
+	if _u.mutation.JobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retryqueue.JobTable,
+			Columns: []string{retryqueue.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retryqueue.JobTable,
+			Columns: []string{retryqueue.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RetryQueue{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retryqueue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
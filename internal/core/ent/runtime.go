@@ -8,9 +8,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/core/db/schema"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/idempotencykey"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -23,16 +30,16 @@ func init() {
 	connectionDescName := connectionFields[1].Descriptor()
 	// connection.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	connection.NameValidator = connectionDescName.Validators[0].(func(string) error)
-	// connectionDescType is the schema descriptor for type field.
-	connectionDescType := connectionFields[2].Descriptor()
-	// connection.TypeValidator is a validator for the "type" field. It is called by the builders before save.
-	connection.TypeValidator = connectionDescType.Validators[0].(func(string) error)
+	// connectionDescConfigVersion is the schema descriptor for config_version field.
+	connectionDescConfigVersion := connectionFields[15].Descriptor()
+	// connection.DefaultConfigVersion holds the default value on creation for the config_version field.
+	connection.DefaultConfigVersion = connectionDescConfigVersion.Default.(int)
 	// connectionDescCreatedAt is the schema descriptor for created_at field.
-	connectionDescCreatedAt := connectionFields[4].Descriptor()
+	connectionDescCreatedAt := connectionFields[16].Descriptor()
 	// connection.DefaultCreatedAt holds the default value on creation for the created_at field.
 	connection.DefaultCreatedAt = connectionDescCreatedAt.Default.(func() time.Time)
 	// connectionDescUpdatedAt is the schema descriptor for updated_at field.
-	connectionDescUpdatedAt := connectionFields[5].Descriptor()
+	connectionDescUpdatedAt := connectionFields[17].Descriptor()
 	// connection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	connection.DefaultUpdatedAt = connectionDescUpdatedAt.Default.(func() time.Time)
 	// connection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -41,38 +48,154 @@ func init() {
 	connectionDescID := connectionFields[0].Descriptor()
 	// connection.DefaultID holds the default value on creation for the id field.
 	connection.DefaultID = connectionDescID.Default.(func() uuid.UUID)
+	connectiontransferFields := schema.ConnectionTransfer{}.Fields()
+	_ = connectiontransferFields
+	// connectiontransferDescBytes is the schema descriptor for bytes field.
+	connectiontransferDescBytes := connectiontransferFields[3].Descriptor()
+	// connectiontransfer.DefaultBytes holds the default value on creation for the bytes field.
+	connectiontransfer.DefaultBytes = connectiontransferDescBytes.Default.(int64)
+	// connectiontransferDescID is the schema descriptor for id field.
+	connectiontransferDescID := connectiontransferFields[0].Descriptor()
+	// connectiontransfer.DefaultID holds the default value on creation for the id field.
+	connectiontransfer.DefaultID = connectiontransferDescID.Default.(func() uuid.UUID)
+	connectionusageFields := schema.ConnectionUsage{}.Fields()
+	_ = connectionusageFields
+	// connectionusageDescID is the schema descriptor for id field.
+	connectionusageDescID := connectionusageFields[0].Descriptor()
+	// connectionusage.DefaultID holds the default value on creation for the id field.
+	connectionusage.DefaultID = connectionusageDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescOperation is the schema descriptor for operation field.
+	idempotencykeyDescOperation := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	idempotencykey.OperationValidator = idempotencykeyDescOperation.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[5].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
 	jobFields := schema.Job{}.Fields()
 	_ = jobFields
 	// jobDescStartTime is the schema descriptor for start_time field.
-	jobDescStartTime := jobFields[4].Descriptor()
+	jobDescStartTime := jobFields[5].Descriptor()
 	// job.DefaultStartTime holds the default value on creation for the start_time field.
 	job.DefaultStartTime = jobDescStartTime.Default.(func() time.Time)
 	// jobDescFilesTransferred is the schema descriptor for files_transferred field.
-	jobDescFilesTransferred := jobFields[6].Descriptor()
+	jobDescFilesTransferred := jobFields[7].Descriptor()
 	// job.DefaultFilesTransferred holds the default value on creation for the files_transferred field.
 	job.DefaultFilesTransferred = jobDescFilesTransferred.Default.(int)
 	// jobDescBytesTransferred is the schema descriptor for bytes_transferred field.
-	jobDescBytesTransferred := jobFields[7].Descriptor()
+	jobDescBytesTransferred := jobFields[8].Descriptor()
 	// job.DefaultBytesTransferred holds the default value on creation for the bytes_transferred field.
 	job.DefaultBytesTransferred = jobDescBytesTransferred.Default.(int64)
+	// jobDescUploadedFiles is the schema descriptor for uploaded_files field.
+	jobDescUploadedFiles := jobFields[9].Descriptor()
+	// job.DefaultUploadedFiles holds the default value on creation for the uploaded_files field.
+	job.DefaultUploadedFiles = jobDescUploadedFiles.Default.(int)
+	// jobDescUploadedBytes is the schema descriptor for uploaded_bytes field.
+	jobDescUploadedBytes := jobFields[10].Descriptor()
+	// job.DefaultUploadedBytes holds the default value on creation for the uploaded_bytes field.
+	job.DefaultUploadedBytes = jobDescUploadedBytes.Default.(int64)
+	// jobDescDownloadedFiles is the schema descriptor for downloaded_files field.
+	jobDescDownloadedFiles := jobFields[11].Descriptor()
+	// job.DefaultDownloadedFiles holds the default value on creation for the downloaded_files field.
+	job.DefaultDownloadedFiles = jobDescDownloadedFiles.Default.(int)
+	// jobDescDownloadedBytes is the schema descriptor for downloaded_bytes field.
+	jobDescDownloadedBytes := jobFields[12].Descriptor()
+	// job.DefaultDownloadedBytes holds the default value on creation for the downloaded_bytes field.
+	job.DefaultDownloadedBytes = jobDescDownloadedBytes.Default.(int64)
 	// jobDescFilesDeleted is the schema descriptor for files_deleted field.
-	jobDescFilesDeleted := jobFields[8].Descriptor()
+	jobDescFilesDeleted := jobFields[13].Descriptor()
 	// job.DefaultFilesDeleted holds the default value on creation for the files_deleted field.
 	job.DefaultFilesDeleted = jobDescFilesDeleted.Default.(int)
+	// jobDescFilesChecked is the schema descriptor for files_checked field.
+	jobDescFilesChecked := jobFields[14].Descriptor()
+	// job.DefaultFilesChecked holds the default value on creation for the files_checked field.
+	job.DefaultFilesChecked = jobDescFilesChecked.Default.(int)
+	// jobDescListingsDone is the schema descriptor for listings_done field.
+	jobDescListingsDone := jobFields[15].Descriptor()
+	// job.DefaultListingsDone holds the default value on creation for the listings_done field.
+	job.DefaultListingsDone = jobDescListingsDone.Default.(int)
 	// jobDescErrorCount is the schema descriptor for error_count field.
-	jobDescErrorCount := jobFields[9].Descriptor()
+	jobDescErrorCount := jobFields[16].Descriptor()
 	// job.DefaultErrorCount holds the default value on creation for the error_count field.
 	job.DefaultErrorCount = jobDescErrorCount.Default.(int)
+	// jobDescAcknowledged is the schema descriptor for acknowledged field.
+	jobDescAcknowledged := jobFields[19].Descriptor()
+	// job.DefaultAcknowledged holds the default value on creation for the acknowledged field.
+	job.DefaultAcknowledged = jobDescAcknowledged.Default.(bool)
 	// jobDescID is the schema descriptor for id field.
 	jobDescID := jobFields[0].Descriptor()
 	// job.DefaultID holds the default value on creation for the id field.
 	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
+	jobeventFields := schema.JobEvent{}.Fields()
+	_ = jobeventFields
+	// jobeventDescDurationMs is the schema descriptor for duration_ms field.
+	jobeventDescDurationMs := jobeventFields[6].Descriptor()
+	// jobevent.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	jobevent.DefaultDurationMs = jobeventDescDurationMs.Default.(int64)
+	// jobeventDescOutput is the schema descriptor for output field.
+	jobeventDescOutput := jobeventFields[7].Descriptor()
+	// jobevent.DefaultOutput holds the default value on creation for the output field.
+	jobevent.DefaultOutput = jobeventDescOutput.Default.(string)
+	// jobeventDescOutputTruncated is the schema descriptor for output_truncated field.
+	jobeventDescOutputTruncated := jobeventFields[8].Descriptor()
+	// jobevent.DefaultOutputTruncated holds the default value on creation for the output_truncated field.
+	jobevent.DefaultOutputTruncated = jobeventDescOutputTruncated.Default.(bool)
+	// jobeventDescCreatedAt is the schema descriptor for created_at field.
+	jobeventDescCreatedAt := jobeventFields[10].Descriptor()
+	// jobevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	jobevent.DefaultCreatedAt = jobeventDescCreatedAt.Default.(func() time.Time)
+	// jobeventDescID is the schema descriptor for id field.
+	jobeventDescID := jobeventFields[0].Descriptor()
+	// jobevent.DefaultID holds the default value on creation for the id field.
+	jobevent.DefaultID = jobeventDescID.Default.(func() uuid.UUID)
 	joblogFields := schema.JobLog{}.Fields()
 	_ = joblogFields
 	// joblogDescTime is the schema descriptor for time field.
 	joblogDescTime := joblogFields[2].Descriptor()
 	// joblog.DefaultTime holds the default value on creation for the time field.
 	joblog.DefaultTime = joblogDescTime.Default.(func() time.Time)
+	retryqueueFields := schema.RetryQueue{}.Fields()
+	_ = retryqueueFields
+	// retryqueueDescCreatedAt is the schema descriptor for created_at field.
+	retryqueueDescCreatedAt := retryqueueFields[7].Descriptor()
+	// retryqueue.DefaultCreatedAt holds the default value on creation for the created_at field.
+	retryqueue.DefaultCreatedAt = retryqueueDescCreatedAt.Default.(func() time.Time)
+	// retryqueueDescID is the schema descriptor for id field.
+	retryqueueDescID := retryqueueFields[0].Descriptor()
+	// retryqueue.DefaultID holds the default value on creation for the id field.
+	retryqueue.DefaultID = retryqueueDescID.Default.(func() uuid.UUID)
+	sharetokenFields := schema.ShareToken{}.Fields()
+	_ = sharetokenFields
+	// sharetokenDescName is the schema descriptor for name field.
+	sharetokenDescName := sharetokenFields[1].Descriptor()
+	// sharetoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sharetoken.NameValidator = sharetokenDescName.Validators[0].(func(string) error)
+	// sharetokenDescTokenHash is the schema descriptor for token_hash field.
+	sharetokenDescTokenHash := sharetokenFields[2].Descriptor()
+	// sharetoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	sharetoken.TokenHashValidator = sharetokenDescTokenHash.Validators[0].(func(string) error)
+	// sharetokenDescUseCount is the schema descriptor for use_count field.
+	sharetokenDescUseCount := sharetokenFields[9].Descriptor()
+	// sharetoken.DefaultUseCount holds the default value on creation for the use_count field.
+	sharetoken.DefaultUseCount = sharetokenDescUseCount.Default.(int)
+	// sharetokenDescCreatedAt is the schema descriptor for created_at field.
+	sharetokenDescCreatedAt := sharetokenFields[10].Descriptor()
+	// sharetoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharetoken.DefaultCreatedAt = sharetokenDescCreatedAt.Default.(func() time.Time)
+	// sharetokenDescID is the schema descriptor for id field.
+	sharetokenDescID := sharetokenFields[0].Descriptor()
+	// sharetoken.DefaultID holds the default value on creation for the id field.
+	sharetoken.DefaultID = sharetokenDescID.Default.(func() uuid.UUID)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescName is the schema descriptor for name field.
@@ -91,12 +214,38 @@ func init() {
 	taskDescRealtime := taskFields[7].Descriptor()
 	// task.DefaultRealtime holds the default value on creation for the realtime field.
 	task.DefaultRealtime = taskDescRealtime.Default.(bool)
+	// taskDescEngine is the schema descriptor for engine field.
+	taskDescEngine := taskFields[10].Descriptor()
+	// task.DefaultEngine holds the default value on creation for the engine field.
+	task.DefaultEngine = taskDescEngine.Default.(string)
+	// task.EngineValidator is a validator for the "engine" field. It is called by the builders before save.
+	task.EngineValidator = taskDescEngine.Validators[0].(func(string) error)
+	// taskDescSkippedRuns is the schema descriptor for skipped_runs field.
+	taskDescSkippedRuns := taskFields[11].Descriptor()
+	// task.DefaultSkippedRuns holds the default value on creation for the skipped_runs field.
+	task.DefaultSkippedRuns = taskDescSkippedRuns.Default.(int)
+	// taskDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	taskDescConsecutiveFailures := taskFields[12].Descriptor()
+	// task.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	task.DefaultConsecutiveFailures = taskDescConsecutiveFailures.Default.(int)
+	// taskDescConsecutiveFatalFailures is the schema descriptor for consecutive_fatal_failures field.
+	taskDescConsecutiveFatalFailures := taskFields[13].Descriptor()
+	// task.DefaultConsecutiveFatalFailures holds the default value on creation for the consecutive_fatal_failures field.
+	task.DefaultConsecutiveFatalFailures = taskDescConsecutiveFatalFailures.Default.(int)
+	// taskDescDisabled is the schema descriptor for disabled field.
+	taskDescDisabled := taskFields[14].Descriptor()
+	// task.DefaultDisabled holds the default value on creation for the disabled field.
+	task.DefaultDisabled = taskDescDisabled.Default.(bool)
+	// taskDescEphemeral is the schema descriptor for ephemeral field.
+	taskDescEphemeral := taskFields[16].Descriptor()
+	// task.DefaultEphemeral holds the default value on creation for the ephemeral field.
+	task.DefaultEphemeral = taskDescEphemeral.Default.(bool)
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[9].Descriptor()
+	taskDescCreatedAt := taskFields[17].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
 	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[10].Descriptor()
+	taskDescUpdatedAt := taskFields[18].Descriptor()
 	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -105,4 +254,14 @@ func init() {
 	taskDescID := taskFields[0].Descriptor()
 	// task.DefaultID holds the default value on creation for the id field.
 	task.DefaultID = taskDescID.Default.(func() uuid.UUID)
+	taskeventFields := schema.TaskEvent{}.Fields()
+	_ = taskeventFields
+	// taskeventDescTime is the schema descriptor for time field.
+	taskeventDescTime := taskeventFields[4].Descriptor()
+	// taskevent.DefaultTime holds the default value on creation for the time field.
+	taskevent.DefaultTime = taskeventDescTime.Default.(func() time.Time)
+	// taskeventDescID is the schema descriptor for id field.
+	taskeventDescID := taskeventFields[0].Descriptor()
+	// taskevent.DefaultID holds the default value on creation for the id field.
+	taskevent.DefaultID = taskeventDescID.Default.(func() uuid.UUID)
 }
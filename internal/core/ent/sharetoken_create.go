@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+)
+
+// ShareTokenCreate is the builder for creating a ShareToken entity.
+type ShareTokenCreate struct {
+	config
+	mutation *ShareTokenMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *ShareTokenCreate) SetName(v string) *ShareTokenCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *ShareTokenCreate) SetTokenHash(v string) *ShareTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ShareTokenCreate) SetScope(v model.ShareScope) *ShareTokenCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *ShareTokenCreate) SetTaskID(v uuid.UUID) *ShareTokenCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillableTaskID(v *uuid.UUID) *ShareTokenCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_c *ShareTokenCreate) SetConnectionID(v uuid.UUID) *ShareTokenCreate {
+	_c.mutation.SetConnectionID(v)
+	return _c
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillableConnectionID(v *uuid.UUID) *ShareTokenCreate {
+	if v != nil {
+		_c.SetConnectionID(*v)
+	}
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *ShareTokenCreate) SetPath(v string) *ShareTokenCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillablePath(v *string) *ShareTokenCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ShareTokenCreate) SetExpiresAt(v time.Time) *ShareTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *ShareTokenCreate) SetLastUsedAt(v time.Time) *ShareTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillableLastUsedAt(v *time.Time) *ShareTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetUseCount sets the "use_count" field.
+func (_c *ShareTokenCreate) SetUseCount(v int) *ShareTokenCreate {
+	_c.mutation.SetUseCount(v)
+	return _c
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillableUseCount(v *int) *ShareTokenCreate {
+	if v != nil {
+		_c.SetUseCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ShareTokenCreate) SetCreatedAt(v time.Time) *ShareTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillableCreatedAt(v *time.Time) *ShareTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ShareTokenCreate) SetID(v uuid.UUID) *ShareTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ShareTokenCreate) SetNillableID(v *uuid.UUID) *ShareTokenCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *ShareTokenCreate) SetTask(v *Task) *ShareTokenCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_c *ShareTokenCreate) SetConnection(v *Connection) *ShareTokenCreate {
+	return _c.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ShareTokenMutation object of the builder.
+func (_c *ShareTokenCreate) Mutation() *ShareTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the ShareToken in the database.
+func (_c *ShareTokenCreate) Save(ctx context.Context) (*ShareToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShareTokenCreate) SaveX(ctx context.Context) *ShareToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShareTokenCreate) defaults() {
+	if _, ok := _c.mutation.UseCount(); !ok {
+		v := sharetoken.DefaultUseCount
+		_c.mutation.SetUseCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sharetoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := sharetoken.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShareTokenCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ShareToken.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := sharetoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ShareToken.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ShareToken.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := sharetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ShareToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ShareToken.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := sharetoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ShareToken.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ShareToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.UseCount(); !ok {
+		return &ValidationError{Name: "use_count", err: errors.New(`ent: missing required field "ShareToken.use_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareToken.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ShareTokenCreate) sqlSave(ctx context.Context) (*ShareToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShareTokenCreate) createSpec() (*ShareToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sharetoken.Table, sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(sharetoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(sharetoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(sharetoken.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(sharetoken.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharetoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(sharetoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.UseCount(); ok {
+		_spec.SetField(sharetoken.FieldUseCount, field.TypeInt, value)
+		_node.UseCount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sharetoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.TaskTable,
+			Columns: []string{sharetoken.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ConnectionTable,
+			Columns: []string{sharetoken.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareTokenCreateBulk is the builder for creating many ShareToken entities in bulk.
+type ShareTokenCreateBulk struct {
+	config
+	err      error
+	builders []*ShareTokenCreate
+}
+
+// Save creates the ShareToken entities in the database.
+func (_c *ShareTokenCreateBulk) Save(ctx context.Context) ([]*ShareToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShareToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShareTokenCreateBulk) SaveX(ctx context.Context) []*ShareToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+)
+
+// ShareTokenUpdate is the builder for updating ShareToken entities.
+type ShareTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareTokenMutation
+}
+
+// Where appends a list predicates to the ShareTokenUpdate builder.
+func (_u *ShareTokenUpdate) Where(ps ...predicate.ShareToken) *ShareTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ShareTokenUpdate) SetName(v string) *ShareTokenUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableName(v *string) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *ShareTokenUpdate) SetTokenHash(v string) *ShareTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableTokenHash(v *string) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ShareTokenUpdate) SetScope(v model.ShareScope) *ShareTokenUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableScope(v *model.ShareScope) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *ShareTokenUpdate) SetTaskID(v uuid.UUID) *ShareTokenUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableTaskID(v *uuid.UUID) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *ShareTokenUpdate) ClearTaskID() *ShareTokenUpdate {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ShareTokenUpdate) SetConnectionID(v uuid.UUID) *ShareTokenUpdate {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableConnectionID(v *uuid.UUID) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// ClearConnectionID clears the value of the "connection_id" field.
+func (_u *ShareTokenUpdate) ClearConnectionID() *ShareTokenUpdate {
+	_u.mutation.ClearConnectionID()
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *ShareTokenUpdate) SetPath(v string) *ShareTokenUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillablePath(v *string) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *ShareTokenUpdate) ClearPath() *ShareTokenUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ShareTokenUpdate) SetExpiresAt(v time.Time) *ShareTokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableExpiresAt(v *time.Time) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ShareTokenUpdate) SetLastUsedAt(v time.Time) *ShareTokenUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableLastUsedAt(v *time.Time) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ShareTokenUpdate) ClearLastUsedAt() *ShareTokenUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUseCount sets the "use_count" field.
+func (_u *ShareTokenUpdate) SetUseCount(v int) *ShareTokenUpdate {
+	_u.mutation.ResetUseCount()
+	_u.mutation.SetUseCount(v)
+	return _u
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableUseCount(v *int) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetUseCount(*v)
+	}
+	return _u
+}
+
+// AddUseCount adds value to the "use_count" field.
+func (_u *ShareTokenUpdate) AddUseCount(v int) *ShareTokenUpdate {
+	_u.mutation.AddUseCount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ShareTokenUpdate) SetCreatedAt(v time.Time) *ShareTokenUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ShareTokenUpdate) SetNillableCreatedAt(v *time.Time) *ShareTokenUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *ShareTokenUpdate) SetTask(v *Task) *ShareTokenUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ShareTokenUpdate) SetConnection(v *Connection) *ShareTokenUpdate {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ShareTokenMutation object of the builder.
+func (_u *ShareTokenUpdate) Mutation() *ShareTokenMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *ShareTokenUpdate) ClearTask() *ShareTokenUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ShareTokenUpdate) ClearConnection() *ShareTokenUpdate {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShareTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShareTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShareTokenUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sharetoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ShareToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := sharetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ShareToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := sharetoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ShareToken.scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ShareTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharetoken.Table, sharetoken.Columns, sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sharetoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(sharetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(sharetoken.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(sharetoken.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(sharetoken.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharetoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(sharetoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(sharetoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UseCount(); ok {
+		_spec.SetField(sharetoken.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUseCount(); ok {
+		_spec.AddField(sharetoken.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(sharetoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.TaskTable,
+			Columns: []string{sharetoken.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.TaskTable,
+			Columns: []string{sharetoken.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ConnectionTable,
+			Columns: []string{sharetoken.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ConnectionTable,
+			Columns: []string{sharetoken.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShareTokenUpdateOne is the builder for updating a single ShareToken entity.
+type ShareTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareTokenMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ShareTokenUpdateOne) SetName(v string) *ShareTokenUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableName(v *string) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *ShareTokenUpdateOne) SetTokenHash(v string) *ShareTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableTokenHash(v *string) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ShareTokenUpdateOne) SetScope(v model.ShareScope) *ShareTokenUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableScope(v *model.ShareScope) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *ShareTokenUpdateOne) SetTaskID(v uuid.UUID) *ShareTokenUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableTaskID(v *uuid.UUID) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *ShareTokenUpdateOne) ClearTaskID() *ShareTokenUpdateOne {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetConnectionID sets the "connection_id" field.
+func (_u *ShareTokenUpdateOne) SetConnectionID(v uuid.UUID) *ShareTokenUpdateOne {
+	_u.mutation.SetConnectionID(v)
+	return _u
+}
+
+// SetNillableConnectionID sets the "connection_id" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableConnectionID(v *uuid.UUID) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetConnectionID(*v)
+	}
+	return _u
+}
+
+// ClearConnectionID clears the value of the "connection_id" field.
+func (_u *ShareTokenUpdateOne) ClearConnectionID() *ShareTokenUpdateOne {
+	_u.mutation.ClearConnectionID()
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *ShareTokenUpdateOne) SetPath(v string) *ShareTokenUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillablePath(v *string) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *ShareTokenUpdateOne) ClearPath() *ShareTokenUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ShareTokenUpdateOne) SetExpiresAt(v time.Time) *ShareTokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableExpiresAt(v *time.Time) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ShareTokenUpdateOne) SetLastUsedAt(v time.Time) *ShareTokenUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableLastUsedAt(v *time.Time) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ShareTokenUpdateOne) ClearLastUsedAt() *ShareTokenUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUseCount sets the "use_count" field.
+func (_u *ShareTokenUpdateOne) SetUseCount(v int) *ShareTokenUpdateOne {
+	_u.mutation.ResetUseCount()
+	_u.mutation.SetUseCount(v)
+	return _u
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableUseCount(v *int) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetUseCount(*v)
+	}
+	return _u
+}
+
+// AddUseCount adds value to the "use_count" field.
+func (_u *ShareTokenUpdateOne) AddUseCount(v int) *ShareTokenUpdateOne {
+	_u.mutation.AddUseCount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ShareTokenUpdateOne) SetCreatedAt(v time.Time) *ShareTokenUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ShareTokenUpdateOne) SetNillableCreatedAt(v *time.Time) *ShareTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *ShareTokenUpdateOne) SetTask(v *Task) *ShareTokenUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetConnection sets the "connection" edge to the Connection entity.
+func (_u *ShareTokenUpdateOne) SetConnection(v *Connection) *ShareTokenUpdateOne {
+	return _u.SetConnectionID(v.ID)
+}
+
+// Mutation returns the ShareTokenMutation object of the builder.
+func (_u *ShareTokenUpdateOne) Mutation() *ShareTokenMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *ShareTokenUpdateOne) ClearTask() *ShareTokenUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearConnection clears the "connection" edge to the Connection entity.
+func (_u *ShareTokenUpdateOne) ClearConnection() *ShareTokenUpdateOne {
+	_u.mutation.ClearConnection()
+	return _u
+}
+
+// Where appends a list predicates to the ShareTokenUpdate builder.
+func (_u *ShareTokenUpdateOne) Where(ps ...predicate.ShareToken) *ShareTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShareTokenUpdateOne) Select(field string, fields ...string) *ShareTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShareToken entity.
+func (_u *ShareTokenUpdateOne) Save(ctx context.Context) (*ShareToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareTokenUpdateOne) SaveX(ctx context.Context) *ShareToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShareTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShareTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sharetoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ShareToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := sharetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ShareToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := sharetoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ShareToken.scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ShareTokenUpdateOne) sqlSave(ctx context.Context) (_node *ShareToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharetoken.Table, sharetoken.Columns, sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharetoken.FieldID)
+		for _, f := range fields {
+			if !sharetoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharetoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sharetoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(sharetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(sharetoken.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(sharetoken.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(sharetoken.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharetoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(sharetoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(sharetoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UseCount(); ok {
+		_spec.SetField(sharetoken.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUseCount(); ok {
+		_spec.AddField(sharetoken.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(sharetoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.TaskTable,
+			Columns: []string{sharetoken.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.TaskTable,
+			Columns: []string{sharetoken.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ConnectionTable,
+			Columns: []string{sharetoken.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ConnectionTable,
+			Columns: []string{sharetoken.ConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -37,10 +37,28 @@ type Task struct {
 	Realtime bool `json:"realtime,omitempty"`
 	// Options holds the value of the "options" field.
 	Options *model.TaskSyncOptions `json:"options,omitempty"`
+	// Tags grouping the task with others, e.g. to follow the progress of all tasks of a group
+	Tags []string `json:"tags,omitempty"`
+	// Name of the sync engine registered in the runner that executes the task
+	Engine string `json:"engine,omitempty"`
+	// Number of scheduled runs skipped because a job for the task was still running
+	SkippedRuns int `json:"skipped_runs,omitempty"`
+	// Number of failed runs in a row, reset by a successful run
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// Number of runs in a row that failed with a non-transient error, e.g. an authentication failure or a missing path
+	ConsecutiveFatalFailures int `json:"consecutive_fatal_failures,omitempty"`
+	// Disabled tasks aren't run by their schedule or realtime watcher, only on demand
+	Disabled bool `json:"disabled,omitempty"`
+	// Why the task was disabled automatically, nil if it is enabled or was disabled by a user
+	DisabledReason *string `json:"disabled_reason,omitempty"`
+	// Hidden task created for a single ad-hoc run, excluded from task lists, schedules and watchers
+	Ephemeral bool `json:"ephemeral,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Time the task was soft deleted, deleted tasks are kept until purged by the retention worker
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -51,11 +69,15 @@ type Task struct {
 type TaskEdges struct {
 	// Jobs holds the value of the jobs edge.
 	Jobs []*Job `json:"jobs,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*TaskEvent `json:"events,omitempty"`
+	// ShareTokens holds the value of the share_tokens edge.
+	ShareTokens []*ShareToken `json:"share_tokens,omitempty"`
 	// Connection holds the value of the connection edge.
 	Connection *Connection `json:"connection,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // JobsOrErr returns the Jobs value or an error if the edge
@@ -67,12 +89,30 @@ func (e TaskEdges) JobsOrErr() ([]*Job, error) {
 	return nil, &NotLoadedError{edge: "jobs"}
 }
 
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) EventsOrErr() ([]*TaskEvent, error) {
+	if e.loadedTypes[1] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// ShareTokensOrErr returns the ShareTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ShareTokensOrErr() ([]*ShareToken, error) {
+	if e.loadedTypes[2] {
+		return e.ShareTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "share_tokens"}
+}
+
 // ConnectionOrErr returns the Connection value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e TaskEdges) ConnectionOrErr() (*Connection, error) {
 	if e.Connection != nil {
 		return e.Connection, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: connection.Label}
 	}
 	return nil, &NotLoadedError{edge: "connection"}
@@ -83,13 +123,15 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldOptions:
+		case task.FieldOptions, task.FieldTags:
 			values[i] = new([]byte)
-		case task.FieldRealtime:
+		case task.FieldRealtime, task.FieldDisabled, task.FieldEphemeral:
 			values[i] = new(sql.NullBool)
-		case task.FieldName, task.FieldSourcePath, task.FieldRemotePath, task.FieldDirection, task.FieldSchedule:
+		case task.FieldSkippedRuns, task.FieldConsecutiveFailures, task.FieldConsecutiveFatalFailures:
+			values[i] = new(sql.NullInt64)
+		case task.FieldName, task.FieldSourcePath, task.FieldRemotePath, task.FieldDirection, task.FieldSchedule, task.FieldEngine, task.FieldDisabledReason:
 			values[i] = new(sql.NullString)
-		case task.FieldCreatedAt, task.FieldUpdatedAt:
+		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case task.FieldID, task.FieldConnectionID:
 			values[i] = new(uuid.UUID)
@@ -164,6 +206,57 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field options: %w", err)
 				}
 			}
+		case task.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case task.FieldEngine:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine", values[i])
+			} else if value.Valid {
+				_m.Engine = value.String
+			}
+		case task.FieldSkippedRuns:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_runs", values[i])
+			} else if value.Valid {
+				_m.SkippedRuns = int(value.Int64)
+			}
+		case task.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case task.FieldConsecutiveFatalFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_fatal_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFatalFailures = int(value.Int64)
+			}
+		case task.FieldDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled", values[i])
+			} else if value.Valid {
+				_m.Disabled = value.Bool
+			}
+		case task.FieldDisabledReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_reason", values[i])
+			} else if value.Valid {
+				_m.DisabledReason = new(string)
+				*_m.DisabledReason = value.String
+			}
+		case task.FieldEphemeral:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ephemeral", values[i])
+			} else if value.Valid {
+				_m.Ephemeral = value.Bool
+			}
 		case task.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -176,6 +269,13 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case task.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -194,6 +294,16 @@ func (_m *Task) QueryJobs() *JobQuery {
 	return NewTaskClient(_m.config).QueryJobs(_m)
 }
 
+// QueryEvents queries the "events" edge of the Task entity.
+func (_m *Task) QueryEvents() *TaskEventQuery {
+	return NewTaskClient(_m.config).QueryEvents(_m)
+}
+
+// QueryShareTokens queries the "share_tokens" edge of the Task entity.
+func (_m *Task) QueryShareTokens() *ShareTokenQuery {
+	return NewTaskClient(_m.config).QueryShareTokens(_m)
+}
+
 // QueryConnection queries the "connection" edge of the Task entity.
 func (_m *Task) QueryConnection() *ConnectionQuery {
 	return NewTaskClient(_m.config).QueryConnection(_m)
@@ -246,11 +356,42 @@ func (_m *Task) String() string {
 	builder.WriteString("options=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Options))
 	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("engine=")
+	builder.WriteString(_m.Engine)
+	builder.WriteString(", ")
+	builder.WriteString("skipped_runs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkippedRuns))
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_fatal_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFatalFailures))
+	builder.WriteString(", ")
+	builder.WriteString("disabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Disabled))
+	builder.WriteString(", ")
+	if v := _m.DisabledReason; v != nil {
+		builder.WriteString("disabled_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ephemeral=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ephemeral))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -33,12 +33,34 @@ const (
 	FieldRealtime = "realtime"
 	// FieldOptions holds the string denoting the options field in the database.
 	FieldOptions = "options"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldEngine holds the string denoting the engine field in the database.
+	FieldEngine = "engine"
+	// FieldSkippedRuns holds the string denoting the skipped_runs field in the database.
+	FieldSkippedRuns = "skipped_runs"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldConsecutiveFatalFailures holds the string denoting the consecutive_fatal_failures field in the database.
+	FieldConsecutiveFatalFailures = "consecutive_fatal_failures"
+	// FieldDisabled holds the string denoting the disabled field in the database.
+	FieldDisabled = "disabled"
+	// FieldDisabledReason holds the string denoting the disabled_reason field in the database.
+	FieldDisabledReason = "disabled_reason"
+	// FieldEphemeral holds the string denoting the ephemeral field in the database.
+	FieldEphemeral = "ephemeral"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeJobs holds the string denoting the jobs edge name in mutations.
 	EdgeJobs = "jobs"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeShareTokens holds the string denoting the share_tokens edge name in mutations.
+	EdgeShareTokens = "share_tokens"
 	// EdgeConnection holds the string denoting the connection edge name in mutations.
 	EdgeConnection = "connection"
 	// Table holds the table name of the task in the database.
@@ -50,6 +72,20 @@ const (
 	JobsInverseTable = "jobs"
 	// JobsColumn is the table column denoting the jobs relation/edge.
 	JobsColumn = "task_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "task_events"
+	// EventsInverseTable is the table name for the TaskEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "taskevent" package.
+	EventsInverseTable = "task_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "task_id"
+	// ShareTokensTable is the table that holds the share_tokens relation/edge.
+	ShareTokensTable = "share_tokens"
+	// ShareTokensInverseTable is the table name for the ShareToken entity.
+	// It exists in this package in order to avoid circular dependency with the "sharetoken" package.
+	ShareTokensInverseTable = "share_tokens"
+	// ShareTokensColumn is the table column denoting the share_tokens relation/edge.
+	ShareTokensColumn = "task_id"
 	// ConnectionTable is the table that holds the connection relation/edge.
 	ConnectionTable = "tasks"
 	// ConnectionInverseTable is the table name for the Connection entity.
@@ -70,8 +106,17 @@ var Columns = []string{
 	FieldSchedule,
 	FieldRealtime,
 	FieldOptions,
+	FieldTags,
+	FieldEngine,
+	FieldSkippedRuns,
+	FieldConsecutiveFailures,
+	FieldConsecutiveFatalFailures,
+	FieldDisabled,
+	FieldDisabledReason,
+	FieldEphemeral,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -93,6 +138,20 @@ var (
 	RemotePathValidator func(string) error
 	// DefaultRealtime holds the default value on creation for the "realtime" field.
 	DefaultRealtime bool
+	// DefaultEngine holds the default value on creation for the "engine" field.
+	DefaultEngine string
+	// EngineValidator is a validator for the "engine" field. It is called by the builders before save.
+	EngineValidator func(string) error
+	// DefaultSkippedRuns holds the default value on creation for the "skipped_runs" field.
+	DefaultSkippedRuns int
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// DefaultConsecutiveFatalFailures holds the default value on creation for the "consecutive_fatal_failures" field.
+	DefaultConsecutiveFatalFailures int
+	// DefaultDisabled holds the default value on creation for the "disabled" field.
+	DefaultDisabled bool
+	// DefaultEphemeral holds the default value on creation for the "ephemeral" field.
+	DefaultEphemeral bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -158,6 +217,41 @@ func ByRealtime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRealtime, opts...).ToFunc()
 }
 
+// ByEngine orders the results by the engine field.
+func ByEngine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEngine, opts...).ToFunc()
+}
+
+// BySkippedRuns orders the results by the skipped_runs field.
+func BySkippedRuns(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkippedRuns, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByConsecutiveFatalFailures orders the results by the consecutive_fatal_failures field.
+func ByConsecutiveFatalFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFatalFailures, opts...).ToFunc()
+}
+
+// ByDisabled orders the results by the disabled field.
+func ByDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabled, opts...).ToFunc()
+}
+
+// ByDisabledReason orders the results by the disabled_reason field.
+func ByDisabledReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledReason, opts...).ToFunc()
+}
+
+// ByEphemeral orders the results by the ephemeral field.
+func ByEphemeral(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEphemeral, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -168,6 +262,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByJobsCount orders the results by jobs count.
 func ByJobsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -182,6 +281,34 @@ func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareTokensCount orders the results by share_tokens count.
+func ByShareTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareTokensStep(), opts...)
+	}
+}
+
+// ByShareTokens orders the results by share_tokens terms.
+func ByShareTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByConnectionField orders the results by connection field.
 func ByConnectionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -195,6 +322,20 @@ func newJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
 	)
 }
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
+func newShareTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareTokensTable, ShareTokensColumn),
+	)
+}
 func newConnectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
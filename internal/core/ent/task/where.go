@@ -87,6 +87,41 @@ func Realtime(v bool) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldRealtime, v))
 }
 
+// Engine applies equality check predicate on the "engine" field. It's identical to EngineEQ.
+func Engine(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEngine, v))
+}
+
+// SkippedRuns applies equality check predicate on the "skipped_runs" field. It's identical to SkippedRunsEQ.
+func SkippedRuns(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSkippedRuns, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFatalFailures applies equality check predicate on the "consecutive_fatal_failures" field. It's identical to ConsecutiveFatalFailuresEQ.
+func ConsecutiveFatalFailures(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldConsecutiveFatalFailures, v))
+}
+
+// Disabled applies equality check predicate on the "disabled" field. It's identical to DisabledEQ.
+func Disabled(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDisabled, v))
+}
+
+// DisabledReason applies equality check predicate on the "disabled_reason" field. It's identical to DisabledReasonEQ.
+func DisabledReason(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDisabledReason, v))
+}
+
+// Ephemeral applies equality check predicate on the "ephemeral" field. It's identical to EphemeralEQ.
+func Ephemeral(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEphemeral, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -97,6 +132,11 @@ func UpdatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldName, v))
@@ -447,6 +487,296 @@ func OptionsNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldOptions))
 }
 
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldTags))
+}
+
+// EngineEQ applies the EQ predicate on the "engine" field.
+func EngineEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEngine, v))
+}
+
+// EngineNEQ applies the NEQ predicate on the "engine" field.
+func EngineNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldEngine, v))
+}
+
+// EngineIn applies the In predicate on the "engine" field.
+func EngineIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldEngine, vs...))
+}
+
+// EngineNotIn applies the NotIn predicate on the "engine" field.
+func EngineNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldEngine, vs...))
+}
+
+// EngineGT applies the GT predicate on the "engine" field.
+func EngineGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldEngine, v))
+}
+
+// EngineGTE applies the GTE predicate on the "engine" field.
+func EngineGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldEngine, v))
+}
+
+// EngineLT applies the LT predicate on the "engine" field.
+func EngineLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldEngine, v))
+}
+
+// EngineLTE applies the LTE predicate on the "engine" field.
+func EngineLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldEngine, v))
+}
+
+// EngineContains applies the Contains predicate on the "engine" field.
+func EngineContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldEngine, v))
+}
+
+// EngineHasPrefix applies the HasPrefix predicate on the "engine" field.
+func EngineHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldEngine, v))
+}
+
+// EngineHasSuffix applies the HasSuffix predicate on the "engine" field.
+func EngineHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldEngine, v))
+}
+
+// EngineEqualFold applies the EqualFold predicate on the "engine" field.
+func EngineEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldEngine, v))
+}
+
+// EngineContainsFold applies the ContainsFold predicate on the "engine" field.
+func EngineContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldEngine, v))
+}
+
+// SkippedRunsEQ applies the EQ predicate on the "skipped_runs" field.
+func SkippedRunsEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSkippedRuns, v))
+}
+
+// SkippedRunsNEQ applies the NEQ predicate on the "skipped_runs" field.
+func SkippedRunsNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldSkippedRuns, v))
+}
+
+// SkippedRunsIn applies the In predicate on the "skipped_runs" field.
+func SkippedRunsIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldSkippedRuns, vs...))
+}
+
+// SkippedRunsNotIn applies the NotIn predicate on the "skipped_runs" field.
+func SkippedRunsNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldSkippedRuns, vs...))
+}
+
+// SkippedRunsGT applies the GT predicate on the "skipped_runs" field.
+func SkippedRunsGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldSkippedRuns, v))
+}
+
+// SkippedRunsGTE applies the GTE predicate on the "skipped_runs" field.
+func SkippedRunsGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldSkippedRuns, v))
+}
+
+// SkippedRunsLT applies the LT predicate on the "skipped_runs" field.
+func SkippedRunsLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldSkippedRuns, v))
+}
+
+// SkippedRunsLTE applies the LTE predicate on the "skipped_runs" field.
+func SkippedRunsLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldSkippedRuns, v))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFatalFailuresEQ applies the EQ predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldConsecutiveFatalFailures, v))
+}
+
+// ConsecutiveFatalFailuresNEQ applies the NEQ predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldConsecutiveFatalFailures, v))
+}
+
+// ConsecutiveFatalFailuresIn applies the In predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldConsecutiveFatalFailures, vs...))
+}
+
+// ConsecutiveFatalFailuresNotIn applies the NotIn predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldConsecutiveFatalFailures, vs...))
+}
+
+// ConsecutiveFatalFailuresGT applies the GT predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldConsecutiveFatalFailures, v))
+}
+
+// ConsecutiveFatalFailuresGTE applies the GTE predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldConsecutiveFatalFailures, v))
+}
+
+// ConsecutiveFatalFailuresLT applies the LT predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldConsecutiveFatalFailures, v))
+}
+
+// ConsecutiveFatalFailuresLTE applies the LTE predicate on the "consecutive_fatal_failures" field.
+func ConsecutiveFatalFailuresLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldConsecutiveFatalFailures, v))
+}
+
+// DisabledEQ applies the EQ predicate on the "disabled" field.
+func DisabledEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDisabled, v))
+}
+
+// DisabledNEQ applies the NEQ predicate on the "disabled" field.
+func DisabledNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDisabled, v))
+}
+
+// DisabledReasonEQ applies the EQ predicate on the "disabled_reason" field.
+func DisabledReasonEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDisabledReason, v))
+}
+
+// DisabledReasonNEQ applies the NEQ predicate on the "disabled_reason" field.
+func DisabledReasonNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDisabledReason, v))
+}
+
+// DisabledReasonIn applies the In predicate on the "disabled_reason" field.
+func DisabledReasonIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldDisabledReason, vs...))
+}
+
+// DisabledReasonNotIn applies the NotIn predicate on the "disabled_reason" field.
+func DisabledReasonNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldDisabledReason, vs...))
+}
+
+// DisabledReasonGT applies the GT predicate on the "disabled_reason" field.
+func DisabledReasonGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldDisabledReason, v))
+}
+
+// DisabledReasonGTE applies the GTE predicate on the "disabled_reason" field.
+func DisabledReasonGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldDisabledReason, v))
+}
+
+// DisabledReasonLT applies the LT predicate on the "disabled_reason" field.
+func DisabledReasonLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldDisabledReason, v))
+}
+
+// DisabledReasonLTE applies the LTE predicate on the "disabled_reason" field.
+func DisabledReasonLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldDisabledReason, v))
+}
+
+// DisabledReasonContains applies the Contains predicate on the "disabled_reason" field.
+func DisabledReasonContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldDisabledReason, v))
+}
+
+// DisabledReasonHasPrefix applies the HasPrefix predicate on the "disabled_reason" field.
+func DisabledReasonHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldDisabledReason, v))
+}
+
+// DisabledReasonHasSuffix applies the HasSuffix predicate on the "disabled_reason" field.
+func DisabledReasonHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldDisabledReason, v))
+}
+
+// DisabledReasonIsNil applies the IsNil predicate on the "disabled_reason" field.
+func DisabledReasonIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldDisabledReason))
+}
+
+// DisabledReasonNotNil applies the NotNil predicate on the "disabled_reason" field.
+func DisabledReasonNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldDisabledReason))
+}
+
+// DisabledReasonEqualFold applies the EqualFold predicate on the "disabled_reason" field.
+func DisabledReasonEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldDisabledReason, v))
+}
+
+// DisabledReasonContainsFold applies the ContainsFold predicate on the "disabled_reason" field.
+func DisabledReasonContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldDisabledReason, v))
+}
+
+// EphemeralEQ applies the EQ predicate on the "ephemeral" field.
+func EphemeralEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEphemeral, v))
+}
+
+// EphemeralNEQ applies the NEQ predicate on the "ephemeral" field.
+func EphemeralNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldEphemeral, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -527,6 +857,56 @@ func UpdatedAtLTE(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasJobs applies the HasEdge predicate on the "jobs" edge.
 func HasJobs() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -550,6 +930,52 @@ func HasJobsWith(preds ...predicate.Job) predicate.Task {
 	})
 }
 
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.TaskEvent) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareTokens applies the HasEdge predicate on the "share_tokens" edge.
+func HasShareTokens() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareTokensTable, ShareTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareTokensWith applies the HasEdge predicate on the "share_tokens" edge with a given conditions (other predicates).
+func HasShareTokensWith(preds ...predicate.ShareToken) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newShareTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasConnection applies the HasEdge predicate on the "connection" edge.
 func HasConnection() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -14,7 +14,9 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 )
 
 // TaskCreate is the builder for creating a Task entity.
@@ -104,6 +106,110 @@ func (_c *TaskCreate) SetOptions(v *model.TaskSyncOptions) *TaskCreate {
 	return _c
 }
 
+// SetTags sets the "tags" field.
+func (_c *TaskCreate) SetTags(v []string) *TaskCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetEngine sets the "engine" field.
+func (_c *TaskCreate) SetEngine(v string) *TaskCreate {
+	_c.mutation.SetEngine(v)
+	return _c
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableEngine(v *string) *TaskCreate {
+	if v != nil {
+		_c.SetEngine(*v)
+	}
+	return _c
+}
+
+// SetSkippedRuns sets the "skipped_runs" field.
+func (_c *TaskCreate) SetSkippedRuns(v int) *TaskCreate {
+	_c.mutation.SetSkippedRuns(v)
+	return _c
+}
+
+// SetNillableSkippedRuns sets the "skipped_runs" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableSkippedRuns(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetSkippedRuns(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *TaskCreate) SetConsecutiveFailures(v int) *TaskCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableConsecutiveFailures(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFatalFailures sets the "consecutive_fatal_failures" field.
+func (_c *TaskCreate) SetConsecutiveFatalFailures(v int) *TaskCreate {
+	_c.mutation.SetConsecutiveFatalFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFatalFailures sets the "consecutive_fatal_failures" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableConsecutiveFatalFailures(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetConsecutiveFatalFailures(*v)
+	}
+	return _c
+}
+
+// SetDisabled sets the "disabled" field.
+func (_c *TaskCreate) SetDisabled(v bool) *TaskCreate {
+	_c.mutation.SetDisabled(v)
+	return _c
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableDisabled(v *bool) *TaskCreate {
+	if v != nil {
+		_c.SetDisabled(*v)
+	}
+	return _c
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (_c *TaskCreate) SetDisabledReason(v string) *TaskCreate {
+	_c.mutation.SetDisabledReason(v)
+	return _c
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableDisabledReason(v *string) *TaskCreate {
+	if v != nil {
+		_c.SetDisabledReason(*v)
+	}
+	return _c
+}
+
+// SetEphemeral sets the "ephemeral" field.
+func (_c *TaskCreate) SetEphemeral(v bool) *TaskCreate {
+	_c.mutation.SetEphemeral(v)
+	return _c
+}
+
+// SetNillableEphemeral sets the "ephemeral" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableEphemeral(v *bool) *TaskCreate {
+	if v != nil {
+		_c.SetEphemeral(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TaskCreate) SetCreatedAt(v time.Time) *TaskCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -132,6 +238,20 @@ func (_c *TaskCreate) SetNillableUpdatedAt(v *time.Time) *TaskCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskCreate) SetDeletedAt(v time.Time) *TaskCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableDeletedAt(v *time.Time) *TaskCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TaskCreate) SetID(v uuid.UUID) *TaskCreate {
 	_c.mutation.SetID(v)
@@ -161,6 +281,36 @@ func (_c *TaskCreate) AddJobs(v ...*Job) *TaskCreate {
 	return _c.AddJobIDs(ids...)
 }
 
+// AddEventIDs adds the "events" edge to the TaskEvent entity by IDs.
+func (_c *TaskCreate) AddEventIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddEventIDs(ids...)
+	return _c
+}
+
+// AddEvents adds the "events" edges to the TaskEvent entity.
+func (_c *TaskCreate) AddEvents(v ...*TaskEvent) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (_c *TaskCreate) AddShareTokenIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddShareTokenIDs(ids...)
+	return _c
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (_c *TaskCreate) AddShareTokens(v ...*ShareToken) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareTokenIDs(ids...)
+}
+
 // SetConnection sets the "connection" edge to the Connection entity.
 func (_c *TaskCreate) SetConnection(v *Connection) *TaskCreate {
 	return _c.SetConnectionID(v.ID)
@@ -209,6 +359,30 @@ func (_c *TaskCreate) defaults() {
 		v := task.DefaultRealtime
 		_c.mutation.SetRealtime(v)
 	}
+	if _, ok := _c.mutation.Engine(); !ok {
+		v := task.DefaultEngine
+		_c.mutation.SetEngine(v)
+	}
+	if _, ok := _c.mutation.SkippedRuns(); !ok {
+		v := task.DefaultSkippedRuns
+		_c.mutation.SetSkippedRuns(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := task.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFatalFailures(); !ok {
+		v := task.DefaultConsecutiveFatalFailures
+		_c.mutation.SetConsecutiveFatalFailures(v)
+	}
+	if _, ok := _c.mutation.Disabled(); !ok {
+		v := task.DefaultDisabled
+		_c.mutation.SetDisabled(v)
+	}
+	if _, ok := _c.mutation.Ephemeral(); !ok {
+		v := task.DefaultEphemeral
+		_c.mutation.SetEphemeral(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := task.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -260,6 +434,29 @@ func (_c *TaskCreate) check() error {
 	if _, ok := _c.mutation.Realtime(); !ok {
 		return &ValidationError{Name: "realtime", err: errors.New(`ent: missing required field "Task.realtime"`)}
 	}
+	if _, ok := _c.mutation.Engine(); !ok {
+		return &ValidationError{Name: "engine", err: errors.New(`ent: missing required field "Task.engine"`)}
+	}
+	if v, ok := _c.mutation.Engine(); ok {
+		if err := task.EngineValidator(v); err != nil {
+			return &ValidationError{Name: "engine", err: fmt.Errorf(`ent: validator failed for field "Task.engine": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SkippedRuns(); !ok {
+		return &ValidationError{Name: "skipped_runs", err: errors.New(`ent: missing required field "Task.skipped_runs"`)}
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "Task.consecutive_failures"`)}
+	}
+	if _, ok := _c.mutation.ConsecutiveFatalFailures(); !ok {
+		return &ValidationError{Name: "consecutive_fatal_failures", err: errors.New(`ent: missing required field "Task.consecutive_fatal_failures"`)}
+	}
+	if _, ok := _c.mutation.Disabled(); !ok {
+		return &ValidationError{Name: "disabled", err: errors.New(`ent: missing required field "Task.disabled"`)}
+	}
+	if _, ok := _c.mutation.Ephemeral(); !ok {
+		return &ValidationError{Name: "ephemeral", err: errors.New(`ent: missing required field "Task.ephemeral"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
 	}
@@ -329,6 +526,38 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldOptions, field.TypeJSON, value)
 		_node.Options = value
 	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(task.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Engine(); ok {
+		_spec.SetField(task.FieldEngine, field.TypeString, value)
+		_node.Engine = value
+	}
+	if value, ok := _c.mutation.SkippedRuns(); ok {
+		_spec.SetField(task.FieldSkippedRuns, field.TypeInt, value)
+		_node.SkippedRuns = value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(task.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.ConsecutiveFatalFailures(); ok {
+		_spec.SetField(task.FieldConsecutiveFatalFailures, field.TypeInt, value)
+		_node.ConsecutiveFatalFailures = value
+	}
+	if value, ok := _c.mutation.Disabled(); ok {
+		_spec.SetField(task.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = value
+	}
+	if value, ok := _c.mutation.DisabledReason(); ok {
+		_spec.SetField(task.FieldDisabledReason, field.TypeString, value)
+		_node.DisabledReason = &value
+	}
+	if value, ok := _c.mutation.Ephemeral(); ok {
+		_spec.SetField(task.FieldEphemeral, field.TypeBool, value)
+		_node.Ephemeral = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -337,6 +566,10 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.JobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -353,6 +586,38 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ConnectionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -16,18 +16,22 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 )
 
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx            *QueryContext
-	order          []task.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Task
-	withJobs       *JobQuery
-	withConnection *ConnectionQuery
+	ctx             *QueryContext
+	order           []task.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Task
+	withJobs        *JobQuery
+	withEvents      *TaskEventQuery
+	withShareTokens *ShareTokenQuery
+	withConnection  *ConnectionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +90,50 @@ func (_q *TaskQuery) QueryJobs() *JobQuery {
 	return query
 }
 
+// QueryEvents chains the current query on the "events" edge.
+func (_q *TaskQuery) QueryEvents() *TaskEventQuery {
+	query := (&TaskEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskevent.Table, taskevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.EventsTable, task.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareTokens chains the current query on the "share_tokens" edge.
+func (_q *TaskQuery) QueryShareTokens() *ShareTokenQuery {
+	query := (&ShareTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(sharetoken.Table, sharetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ShareTokensTable, task.ShareTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryConnection chains the current query on the "connection" edge.
 func (_q *TaskQuery) QueryConnection() *ConnectionQuery {
 	query := (&ConnectionClient{config: _q.config}).Query()
@@ -295,13 +343,15 @@ func (_q *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]task.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Task{}, _q.predicates...),
-		withJobs:       _q.withJobs.Clone(),
-		withConnection: _q.withConnection.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]task.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Task{}, _q.predicates...),
+		withJobs:        _q.withJobs.Clone(),
+		withEvents:      _q.withEvents.Clone(),
+		withShareTokens: _q.withShareTokens.Clone(),
+		withConnection:  _q.withConnection.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -319,6 +369,28 @@ func (_q *TaskQuery) WithJobs(opts ...func(*JobQuery)) *TaskQuery {
 	return _q
 }
 
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithEvents(opts ...func(*TaskEventQuery)) *TaskQuery {
+	query := (&TaskEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEvents = query
+	return _q
+}
+
+// WithShareTokens tells the query-builder to eager-load the nodes that are connected to
+// the "share_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithShareTokens(opts ...func(*ShareTokenQuery)) *TaskQuery {
+	query := (&ShareTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShareTokens = query
+	return _q
+}
+
 // WithConnection tells the query-builder to eager-load the nodes that are connected to
 // the "connection" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TaskQuery) WithConnection(opts ...func(*ConnectionQuery)) *TaskQuery {
@@ -408,8 +480,10 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withJobs != nil,
+			_q.withEvents != nil,
+			_q.withShareTokens != nil,
 			_q.withConnection != nil,
 		}
 	)
@@ -438,6 +512,20 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
+	if query := _q.withEvents; query != nil {
+		if err := _q.loadEvents(ctx, query, nodes,
+			func(n *Task) { n.Edges.Events = []*TaskEvent{} },
+			func(n *Task, e *TaskEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShareTokens; query != nil {
+		if err := _q.loadShareTokens(ctx, query, nodes,
+			func(n *Task) { n.Edges.ShareTokens = []*ShareToken{} },
+			func(n *Task, e *ShareToken) { n.Edges.ShareTokens = append(n.Edges.ShareTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withConnection; query != nil {
 		if err := _q.loadConnection(ctx, query, nodes, nil,
 			func(n *Task, e *Connection) { n.Edges.Connection = e }); err != nil {
@@ -477,6 +565,69 @@ func (_q *TaskQuery) loadJobs(ctx context.Context, query *JobQuery, nodes []*Tas
 	}
 	return nil
 }
+func (_q *TaskQuery) loadEvents(ctx context.Context, query *TaskEventQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskevent.FieldTaskID)
+	}
+	query.Where(predicate.TaskEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadShareTokens(ctx context.Context, query *ShareTokenQuery, nodes []*Task, init func(*Task), assign func(*Task, *ShareToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sharetoken.FieldTaskID)
+	}
+	query.Where(predicate.ShareToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ShareTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *TaskQuery) loadConnection(ctx context.Context, query *ConnectionQuery, nodes []*Task, init func(*Task), assign func(*Task, *Connection)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Task)
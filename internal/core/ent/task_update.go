@@ -10,13 +10,16 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 )
 
 // TaskUpdate is the builder for updating Task entities.
@@ -154,6 +157,135 @@ func (_u *TaskUpdate) ClearOptions() *TaskUpdate {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *TaskUpdate) SetTags(v []string) *TaskUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *TaskUpdate) AppendTags(v []string) *TaskUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *TaskUpdate) ClearTags() *TaskUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetEngine sets the "engine" field.
+func (_u *TaskUpdate) SetEngine(v string) *TaskUpdate {
+	_u.mutation.SetEngine(v)
+	return _u
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableEngine(v *string) *TaskUpdate {
+	if v != nil {
+		_u.SetEngine(*v)
+	}
+	return _u
+}
+
+// SetSkippedRuns sets the "skipped_runs" field.
+func (_u *TaskUpdate) SetSkippedRuns(v int) *TaskUpdate {
+	_u.mutation.ResetSkippedRuns()
+	_u.mutation.SetSkippedRuns(v)
+	return _u
+}
+
+// SetNillableSkippedRuns sets the "skipped_runs" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableSkippedRuns(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetSkippedRuns(*v)
+	}
+	return _u
+}
+
+// AddSkippedRuns adds value to the "skipped_runs" field.
+func (_u *TaskUpdate) AddSkippedRuns(v int) *TaskUpdate {
+	_u.mutation.AddSkippedRuns(v)
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *TaskUpdate) SetConsecutiveFailures(v int) *TaskUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableConsecutiveFailures(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *TaskUpdate) AddConsecutiveFailures(v int) *TaskUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetConsecutiveFatalFailures sets the "consecutive_fatal_failures" field.
+func (_u *TaskUpdate) SetConsecutiveFatalFailures(v int) *TaskUpdate {
+	_u.mutation.ResetConsecutiveFatalFailures()
+	_u.mutation.SetConsecutiveFatalFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFatalFailures sets the "consecutive_fatal_failures" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableConsecutiveFatalFailures(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetConsecutiveFatalFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFatalFailures adds value to the "consecutive_fatal_failures" field.
+func (_u *TaskUpdate) AddConsecutiveFatalFailures(v int) *TaskUpdate {
+	_u.mutation.AddConsecutiveFatalFailures(v)
+	return _u
+}
+
+// SetDisabled sets the "disabled" field.
+func (_u *TaskUpdate) SetDisabled(v bool) *TaskUpdate {
+	_u.mutation.SetDisabled(v)
+	return _u
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableDisabled(v *bool) *TaskUpdate {
+	if v != nil {
+		_u.SetDisabled(*v)
+	}
+	return _u
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (_u *TaskUpdate) SetDisabledReason(v string) *TaskUpdate {
+	_u.mutation.SetDisabledReason(v)
+	return _u
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableDisabledReason(v *string) *TaskUpdate {
+	if v != nil {
+		_u.SetDisabledReason(*v)
+	}
+	return _u
+}
+
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (_u *TaskUpdate) ClearDisabledReason() *TaskUpdate {
+	_u.mutation.ClearDisabledReason()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TaskUpdate) SetCreatedAt(v time.Time) *TaskUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -174,6 +306,26 @@ func (_u *TaskUpdate) SetUpdatedAt(v time.Time) *TaskUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskUpdate) SetDeletedAt(v time.Time) *TaskUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableDeletedAt(v *time.Time) *TaskUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskUpdate) ClearDeletedAt() *TaskUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // AddJobIDs adds the "jobs" edge to the Job entity by IDs.
 func (_u *TaskUpdate) AddJobIDs(ids ...uuid.UUID) *TaskUpdate {
 	_u.mutation.AddJobIDs(ids...)
@@ -189,6 +341,36 @@ func (_u *TaskUpdate) AddJobs(v ...*Job) *TaskUpdate {
 	return _u.AddJobIDs(ids...)
 }
 
+// AddEventIDs adds the "events" edge to the TaskEvent entity by IDs.
+func (_u *TaskUpdate) AddEventIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the TaskEvent entity.
+func (_u *TaskUpdate) AddEvents(v ...*TaskEvent) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (_u *TaskUpdate) AddShareTokenIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddShareTokenIDs(ids...)
+	return _u
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (_u *TaskUpdate) AddShareTokens(v ...*ShareToken) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareTokenIDs(ids...)
+}
+
 // SetConnection sets the "connection" edge to the Connection entity.
 func (_u *TaskUpdate) SetConnection(v *Connection) *TaskUpdate {
 	return _u.SetConnectionID(v.ID)
@@ -220,6 +402,48 @@ func (_u *TaskUpdate) RemoveJobs(v ...*Job) *TaskUpdate {
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearEvents clears all "events" edges to the TaskEvent entity.
+func (_u *TaskUpdate) ClearEvents() *TaskUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to TaskEvent entities by IDs.
+func (_u *TaskUpdate) RemoveEventIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to TaskEvent entities.
+func (_u *TaskUpdate) RemoveEvents(v ...*TaskEvent) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearShareTokens clears all "share_tokens" edges to the ShareToken entity.
+func (_u *TaskUpdate) ClearShareTokens() *TaskUpdate {
+	_u.mutation.ClearShareTokens()
+	return _u
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to ShareToken entities by IDs.
+func (_u *TaskUpdate) RemoveShareTokenIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveShareTokenIDs(ids...)
+	return _u
+}
+
+// RemoveShareTokens removes "share_tokens" edges to ShareToken entities.
+func (_u *TaskUpdate) RemoveShareTokens(v ...*ShareToken) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareTokenIDs(ids...)
+}
+
 // ClearConnection clears the "connection" edge to the Connection entity.
 func (_u *TaskUpdate) ClearConnection() *TaskUpdate {
 	_u.mutation.ClearConnection()
@@ -284,6 +508,11 @@ func (_u *TaskUpdate) check() error {
 			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "Task.direction": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Engine(); ok {
+		if err := task.EngineValidator(v); err != nil {
+			return &ValidationError{Name: "engine", err: fmt.Errorf(`ent: validator failed for field "Task.engine": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -326,12 +555,59 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.OptionsCleared() {
 		_spec.ClearField(task.FieldOptions, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(task.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(task.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Engine(); ok {
+		_spec.SetField(task.FieldEngine, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkippedRuns(); ok {
+		_spec.SetField(task.FieldSkippedRuns, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedRuns(); ok {
+		_spec.AddField(task.FieldSkippedRuns, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(task.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(task.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFatalFailures(); ok {
+		_spec.SetField(task.FieldConsecutiveFatalFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFatalFailures(); ok {
+		_spec.AddField(task.FieldConsecutiveFatalFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Disabled(); ok {
+		_spec.SetField(task.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DisabledReason(); ok {
+		_spec.SetField(task.FieldDisabledReason, field.TypeString, value)
+	}
+	if _u.mutation.DisabledReasonCleared() {
+		_spec.ClearField(task.FieldDisabledReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -377,6 +653,96 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareTokensIDs(); len(nodes) > 0 && !_u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ConnectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -548,6 +914,135 @@ func (_u *TaskUpdateOne) ClearOptions() *TaskUpdateOne {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *TaskUpdateOne) SetTags(v []string) *TaskUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *TaskUpdateOne) AppendTags(v []string) *TaskUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *TaskUpdateOne) ClearTags() *TaskUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetEngine sets the "engine" field.
+func (_u *TaskUpdateOne) SetEngine(v string) *TaskUpdateOne {
+	_u.mutation.SetEngine(v)
+	return _u
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableEngine(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetEngine(*v)
+	}
+	return _u
+}
+
+// SetSkippedRuns sets the "skipped_runs" field.
+func (_u *TaskUpdateOne) SetSkippedRuns(v int) *TaskUpdateOne {
+	_u.mutation.ResetSkippedRuns()
+	_u.mutation.SetSkippedRuns(v)
+	return _u
+}
+
+// SetNillableSkippedRuns sets the "skipped_runs" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableSkippedRuns(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetSkippedRuns(*v)
+	}
+	return _u
+}
+
+// AddSkippedRuns adds value to the "skipped_runs" field.
+func (_u *TaskUpdateOne) AddSkippedRuns(v int) *TaskUpdateOne {
+	_u.mutation.AddSkippedRuns(v)
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *TaskUpdateOne) SetConsecutiveFailures(v int) *TaskUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableConsecutiveFailures(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *TaskUpdateOne) AddConsecutiveFailures(v int) *TaskUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetConsecutiveFatalFailures sets the "consecutive_fatal_failures" field.
+func (_u *TaskUpdateOne) SetConsecutiveFatalFailures(v int) *TaskUpdateOne {
+	_u.mutation.ResetConsecutiveFatalFailures()
+	_u.mutation.SetConsecutiveFatalFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFatalFailures sets the "consecutive_fatal_failures" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableConsecutiveFatalFailures(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFatalFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFatalFailures adds value to the "consecutive_fatal_failures" field.
+func (_u *TaskUpdateOne) AddConsecutiveFatalFailures(v int) *TaskUpdateOne {
+	_u.mutation.AddConsecutiveFatalFailures(v)
+	return _u
+}
+
+// SetDisabled sets the "disabled" field.
+func (_u *TaskUpdateOne) SetDisabled(v bool) *TaskUpdateOne {
+	_u.mutation.SetDisabled(v)
+	return _u
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDisabled(v *bool) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDisabled(*v)
+	}
+	return _u
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (_u *TaskUpdateOne) SetDisabledReason(v string) *TaskUpdateOne {
+	_u.mutation.SetDisabledReason(v)
+	return _u
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDisabledReason(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDisabledReason(*v)
+	}
+	return _u
+}
+
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (_u *TaskUpdateOne) ClearDisabledReason() *TaskUpdateOne {
+	_u.mutation.ClearDisabledReason()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TaskUpdateOne) SetCreatedAt(v time.Time) *TaskUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -568,6 +1063,26 @@ func (_u *TaskUpdateOne) SetUpdatedAt(v time.Time) *TaskUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskUpdateOne) SetDeletedAt(v time.Time) *TaskUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskUpdateOne) ClearDeletedAt() *TaskUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // AddJobIDs adds the "jobs" edge to the Job entity by IDs.
 func (_u *TaskUpdateOne) AddJobIDs(ids ...uuid.UUID) *TaskUpdateOne {
 	_u.mutation.AddJobIDs(ids...)
@@ -583,6 +1098,36 @@ func (_u *TaskUpdateOne) AddJobs(v ...*Job) *TaskUpdateOne {
 	return _u.AddJobIDs(ids...)
 }
 
+// AddEventIDs adds the "events" edge to the TaskEvent entity by IDs.
+func (_u *TaskUpdateOne) AddEventIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the TaskEvent entity.
+func (_u *TaskUpdateOne) AddEvents(v ...*TaskEvent) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (_u *TaskUpdateOne) AddShareTokenIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddShareTokenIDs(ids...)
+	return _u
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (_u *TaskUpdateOne) AddShareTokens(v ...*ShareToken) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareTokenIDs(ids...)
+}
+
 // SetConnection sets the "connection" edge to the Connection entity.
 func (_u *TaskUpdateOne) SetConnection(v *Connection) *TaskUpdateOne {
 	return _u.SetConnectionID(v.ID)
@@ -614,6 +1159,48 @@ func (_u *TaskUpdateOne) RemoveJobs(v ...*Job) *TaskUpdateOne {
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearEvents clears all "events" edges to the TaskEvent entity.
+func (_u *TaskUpdateOne) ClearEvents() *TaskUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to TaskEvent entities by IDs.
+func (_u *TaskUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to TaskEvent entities.
+func (_u *TaskUpdateOne) RemoveEvents(v ...*TaskEvent) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearShareTokens clears all "share_tokens" edges to the ShareToken entity.
+func (_u *TaskUpdateOne) ClearShareTokens() *TaskUpdateOne {
+	_u.mutation.ClearShareTokens()
+	return _u
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to ShareToken entities by IDs.
+func (_u *TaskUpdateOne) RemoveShareTokenIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveShareTokenIDs(ids...)
+	return _u
+}
+
+// RemoveShareTokens removes "share_tokens" edges to ShareToken entities.
+func (_u *TaskUpdateOne) RemoveShareTokens(v ...*ShareToken) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareTokenIDs(ids...)
+}
+
 // ClearConnection clears the "connection" edge to the Connection entity.
 func (_u *TaskUpdateOne) ClearConnection() *TaskUpdateOne {
 	_u.mutation.ClearConnection()
@@ -691,6 +1278,11 @@ func (_u *TaskUpdateOne) check() error {
 			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "Task.direction": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Engine(); ok {
+		if err := task.EngineValidator(v); err != nil {
+			return &ValidationError{Name: "engine", err: fmt.Errorf(`ent: validator failed for field "Task.engine": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -750,12 +1342,59 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if _u.mutation.OptionsCleared() {
 		_spec.ClearField(task.FieldOptions, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(task.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(task.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Engine(); ok {
+		_spec.SetField(task.FieldEngine, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkippedRuns(); ok {
+		_spec.SetField(task.FieldSkippedRuns, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedRuns(); ok {
+		_spec.AddField(task.FieldSkippedRuns, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(task.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(task.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFatalFailures(); ok {
+		_spec.SetField(task.FieldConsecutiveFatalFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFatalFailures(); ok {
+		_spec.AddField(task.FieldConsecutiveFatalFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Disabled(); ok {
+		_spec.SetField(task.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DisabledReason(); ok {
+		_spec.SetField(task.FieldDisabledReason, field.TypeString, value)
+	}
+	if _u.mutation.DisabledReasonCleared() {
+		_spec.ClearField(task.FieldDisabledReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.JobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -801,6 +1440,96 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.EventsTable,
+			Columns: []string{task.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShareTokensIDs(); len(nodes) > 0 && !_u.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ShareTokensTable,
+			Columns: []string{task.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ConnectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
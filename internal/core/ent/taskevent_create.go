@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
+)
+
+// TaskEventCreate is the builder for creating a TaskEvent entity.
+type TaskEventCreate struct {
+	config
+	mutation *TaskEventMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *TaskEventCreate) SetTaskID(v uuid.UUID) *TaskEventCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *TaskEventCreate) SetType(v model.TaskEventType) *TaskEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *TaskEventCreate) SetMessage(v string) *TaskEventCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *TaskEventCreate) SetNillableMessage(v *string) *TaskEventCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetTime sets the "time" field.
+func (_c *TaskEventCreate) SetTime(v time.Time) *TaskEventCreate {
+	_c.mutation.SetTime(v)
+	return _c
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_c *TaskEventCreate) SetNillableTime(v *time.Time) *TaskEventCreate {
+	if v != nil {
+		_c.SetTime(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskEventCreate) SetID(v uuid.UUID) *TaskEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TaskEventCreate) SetNillableID(v *uuid.UUID) *TaskEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskEventCreate) SetTask(v *Task) *TaskEventCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskEventMutation object of the builder.
+func (_c *TaskEventCreate) Mutation() *TaskEventMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskEvent in the database.
+func (_c *TaskEventCreate) Save(ctx context.Context) (*TaskEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskEventCreate) SaveX(ctx context.Context) *TaskEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskEventCreate) defaults() {
+	if _, ok := _c.mutation.Time(); !ok {
+		v := taskevent.DefaultTime()
+		_c.mutation.SetTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := taskevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskEventCreate) check() error {
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskEvent.task_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TaskEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := taskevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New(`ent: missing required field "TaskEvent.time"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskEvent.task"`)}
+	}
+	return nil
+}
+
+func (_c *TaskEventCreate) sqlSave(ctx context.Context) (*TaskEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskEventCreate) createSpec() (*TaskEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskevent.Table, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(taskevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(taskevent.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Time(); ok {
+		_spec.SetField(taskevent.FieldTime, field.TypeTime, value)
+		_node.Time = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskevent.TaskTable,
+			Columns: []string{taskevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskEventCreateBulk is the builder for creating many TaskEvent entities in bulk.
+type TaskEventCreateBulk struct {
+	config
+	err      error
+	builders []*TaskEventCreate
+}
+
+// Save creates the TaskEvent entities in the database.
+func (_c *TaskEventCreateBulk) Save(ctx context.Context) ([]*TaskEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskEventCreateBulk) SaveX(ctx context.Context) []*TaskEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
+)
+
+// TaskEventUpdate is the builder for updating TaskEvent entities.
+type TaskEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskEventMutation
+}
+
+// Where appends a list predicates to the TaskEventUpdate builder.
+func (_u *TaskEventUpdate) Where(ps ...predicate.TaskEvent) *TaskEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskEventUpdate) SetTaskID(v uuid.UUID) *TaskEventUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskEventUpdate) SetNillableTaskID(v *uuid.UUID) *TaskEventUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *TaskEventUpdate) SetType(v model.TaskEventType) *TaskEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *TaskEventUpdate) SetNillableType(v *model.TaskEventType) *TaskEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *TaskEventUpdate) SetMessage(v string) *TaskEventUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *TaskEventUpdate) SetNillableMessage(v *string) *TaskEventUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *TaskEventUpdate) ClearMessage() *TaskEventUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetTime sets the "time" field.
+func (_u *TaskEventUpdate) SetTime(v time.Time) *TaskEventUpdate {
+	_u.mutation.SetTime(v)
+	return _u
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_u *TaskEventUpdate) SetNillableTime(v *time.Time) *TaskEventUpdate {
+	if v != nil {
+		_u.SetTime(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskEventUpdate) SetTask(v *Task) *TaskEventUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskEventMutation object of the builder.
+func (_u *TaskEventUpdate) Mutation() *TaskEventMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskEventUpdate) ClearTask() *TaskEventUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskEventUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := taskevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskEvent.type": %w`, err)}
+		}
+	}
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskEvent.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskevent.Table, taskevent.Columns, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(taskevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(taskevent.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(taskevent.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Time(); ok {
+		_spec.SetField(taskevent.FieldTime, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskevent.TaskTable,
+			Columns: []string{taskevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskevent.TaskTable,
+			Columns: []string{taskevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskEventUpdateOne is the builder for updating a single TaskEvent entity.
+type TaskEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskEventMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskEventUpdateOne) SetTaskID(v uuid.UUID) *TaskEventUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskEventUpdateOne) SetNillableTaskID(v *uuid.UUID) *TaskEventUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *TaskEventUpdateOne) SetType(v model.TaskEventType) *TaskEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *TaskEventUpdateOne) SetNillableType(v *model.TaskEventType) *TaskEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *TaskEventUpdateOne) SetMessage(v string) *TaskEventUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *TaskEventUpdateOne) SetNillableMessage(v *string) *TaskEventUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *TaskEventUpdateOne) ClearMessage() *TaskEventUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetTime sets the "time" field.
+func (_u *TaskEventUpdateOne) SetTime(v time.Time) *TaskEventUpdateOne {
+	_u.mutation.SetTime(v)
+	return _u
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_u *TaskEventUpdateOne) SetNillableTime(v *time.Time) *TaskEventUpdateOne {
+	if v != nil {
+		_u.SetTime(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskEventUpdateOne) SetTask(v *Task) *TaskEventUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskEventMutation object of the builder.
+func (_u *TaskEventUpdateOne) Mutation() *TaskEventMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskEventUpdateOne) ClearTask() *TaskEventUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Where appends a list predicates to the TaskEventUpdate builder.
+func (_u *TaskEventUpdateOne) Where(ps ...predicate.TaskEvent) *TaskEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskEventUpdateOne) Select(field string, fields ...string) *TaskEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskEvent entity.
+func (_u *TaskEventUpdateOne) Save(ctx context.Context) (*TaskEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskEventUpdateOne) SaveX(ctx context.Context) *TaskEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskEventUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := taskevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskEvent.type": %w`, err)}
+		}
+	}
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskEvent.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskEventUpdateOne) sqlSave(ctx context.Context) (_node *TaskEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskevent.Table, taskevent.Columns, sqlgraph.NewFieldSpec(taskevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskevent.FieldID)
+		for _, f := range fields {
+			if !taskevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(taskevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(taskevent.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(taskevent.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Time(); ok {
+		_spec.SetField(taskevent.FieldTime, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskevent.TaskTable,
+			Columns: []string{taskevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskevent.TaskTable,
+			Columns: []string{taskevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
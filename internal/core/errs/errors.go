@@ -30,4 +30,7 @@ const (
 
 	// ErrValidation is returned when validation of input data fails.
 	ErrValidation = ConstError("validation error")
+
+	// ErrTimeout is returned when an operation exceeds its maximum duration.
+	ErrTimeout = ConstError("timeout")
 )
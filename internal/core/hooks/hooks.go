@@ -0,0 +1,183 @@
+// Package hooks runs the pre and post hook commands of tasks. Only executables allowlisted by the
+// admin can be run, and they run without the environment of the server, with a timeout and capped output.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+const (
+	// DefaultTimeout is the time a hook may run if no timeout is configured.
+	DefaultTimeout = 5 * time.Minute
+	// DefaultMaxOutput is the number of output bytes kept of a hook if no limit is configured.
+	DefaultMaxOutput = 64 * 1024
+	// waitDelay is the time the output of a killed hook is still read, e.g. from child processes keeping it open.
+	waitDelay = time.Second
+)
+
+const (
+	// ErrHooksDisabled is returned for a hook while no executables are allowlisted.
+	ErrHooksDisabled = errs.ConstError("hooks are disabled, no executables are allowlisted")
+	// ErrCommandNotAllowed is returned for a hook whose command is not an allowlisted executable.
+	ErrCommandNotAllowed = errs.ConstError("hook command is not an allowlisted executable")
+	// ErrTimeout is returned for a hook that was killed after exceeding its timeout.
+	ErrTimeout = errs.ConstError("hook timed out")
+)
+
+// Options configures running hooks.
+type Options struct {
+	// AllowedCommands are the absolute paths of the executables hooks may run, hooks are disabled if empty.
+	AllowedCommands []string
+	// Timeout is the time after which a hook is killed, DefaultTimeout if not positive.
+	Timeout time.Duration
+	// MaxOutput is the number of bytes of the combined stdout and stderr kept of a hook, DefaultMaxOutput if not positive.
+	MaxOutput int
+}
+
+// Result is the outcome of a hook run.
+type Result struct {
+	// ExitCode is the exit code of the command, nil if it did not start or was killed.
+	ExitCode *int
+	// Duration is the time the command ran.
+	Duration time.Duration
+	// Output is the combined stdout and stderr of the command, cut off after Options.MaxOutput bytes.
+	Output string
+	// OutputTruncated reports whether output was cut off.
+	OutputTruncated bool
+	// Err is the reason the hook failed, nil if it exited with code 0.
+	Err error
+}
+
+// Executor runs hook commands under the restrictions of its options.
+type Executor struct {
+	allowed   []string
+	timeout   time.Duration
+	maxOutput int
+}
+
+// NewExecutor creates an Executor. Allowlisted commands that are not absolute paths are ignored,
+// since they would be resolved against the PATH of each run.
+func NewExecutor(opts Options) *Executor {
+	e := &Executor{timeout: opts.Timeout, maxOutput: opts.MaxOutput}
+	if e.timeout <= 0 {
+		e.timeout = DefaultTimeout
+	}
+	if e.maxOutput <= 0 {
+		e.maxOutput = DefaultMaxOutput
+	}
+	for _, command := range opts.AllowedCommands {
+		if filepath.IsAbs(command) {
+			e.allowed = append(e.allowed, filepath.Clean(command))
+		}
+	}
+	return e
+}
+
+// Enabled reports whether any executable is allowlisted.
+func (e *Executor) Enabled() bool {
+	return e != nil && len(e.allowed) > 0
+}
+
+// Check returns ErrHooksDisabled or ErrCommandNotAllowed if command may not be run.
+// The command must be given exactly as allowlisted, apart from redundant path elements.
+func (e *Executor) Check(command string) error {
+	if !e.Enabled() {
+		return ErrHooksDisabled
+	}
+	if !filepath.IsAbs(command) || !slices.Contains(e.allowed, filepath.Clean(command)) {
+		return ErrCommandNotAllowed
+	}
+	return nil
+}
+
+// Run runs command with args in the temp directory and waits for it to exit. The command gets
+// only a minimal base environment (see baseEnv) plus env, nothing is inherited from the server.
+// It is killed with its child processes when ctx is cancelled or the timeout is exceeded.
+func (e *Executor) Run(ctx context.Context, command string, args []string, env map[string]string) *Result {
+	if err := e.Check(command); err != nil {
+		return &Result{Err: err}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	output := &cappedBuffer{limit: e.maxOutput}
+	cmd := exec.CommandContext(ctx, filepath.Clean(command), args...)
+	cmd.Dir = os.TempDir()
+	cmd.Env = buildEnv(env)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	cmd.WaitDelay = waitDelay
+	killProcessGroup(cmd)
+
+	start := time.Now()
+	err := cmd.Run()
+	result := &Result{
+		Duration:        time.Since(start),
+		Output:          output.String(),
+		OutputTruncated: output.truncated,
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Err = ErrTimeout
+	case ctx.Err() != nil:
+		result.Err = ctx.Err()
+	case errors.As(err, &exitErr):
+		code := exitErr.ExitCode()
+		result.ExitCode = &code
+		result.Err = err
+	case err != nil:
+		result.Err = err
+	default:
+		code := 0
+		result.ExitCode = &code
+	}
+	return result
+}
+
+// buildEnv returns the environment of a hook: the base environment overridden by env, sorted by name.
+func buildEnv(env map[string]string) []string {
+	vars := baseEnv()
+	maps.Copy(vars, env)
+	list := make([]string, 0, len(vars))
+	for _, name := range slices.Sorted(maps.Keys(vars)) {
+		list = append(list, name+"="+vars[name])
+	}
+	return list
+}
+
+// cappedBuffer keeps the first limit bytes written to it and discards the rest,
+// so a hook writing a lot of output is neither blocked nor kept in memory.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write implements io.Writer, it never fails.
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.buf.Len(); len(p) > room {
+		b.buf.Write(p[:max(room, 0)])
+		b.truncated = true
+		return len(p), nil
+	}
+	b.buf.Write(p)
+	return len(p), nil
+}
+
+// String returns the kept output.
+func (b *cappedBuffer) String() string {
+	return b.buf.String()
+}
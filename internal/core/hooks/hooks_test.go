@@ -0,0 +1,105 @@
+//go:build unix
+
+package hooks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeScript writes an executable shell script to a temp directory and returns its path.
+func writeScript(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "hook.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o700))
+	return path
+}
+
+func TestExecutor_Check(t *testing.T) {
+	assert.ErrorIs(t, NewExecutor(Options{}).Check("/bin/true"), ErrHooksDisabled)
+	assert.False(t, NewExecutor(Options{AllowedCommands: []string{"true"}}).Enabled(), "relative commands are ignored")
+
+	e := NewExecutor(Options{AllowedCommands: []string{"/usr/local/bin/notify", "/opt/hooks/../hooks/backup.sh"}})
+	assert.True(t, e.Enabled())
+	assert.NoError(t, e.Check("/usr/local/bin/notify"))
+	assert.NoError(t, e.Check("/opt/hooks/backup.sh"))
+	assert.NoError(t, e.Check("/usr/local/bin//notify"))
+	assert.ErrorIs(t, e.Check("notify"), ErrCommandNotAllowed)
+	assert.ErrorIs(t, e.Check("/usr/bin/notify"), ErrCommandNotAllowed)
+	assert.ErrorIs(t, e.Check("/usr/local/bin/notify2"), ErrCommandNotAllowed)
+}
+
+func TestExecutor_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		script := writeScript(t, `echo "$1 $RCLONE_SYNC_TASK_NAME"; echo err >&2`)
+		e := NewExecutor(Options{AllowedCommands: []string{script}})
+
+		result := e.Run(context.Background(), script, []string{"hello"}, map[string]string{"RCLONE_SYNC_TASK_NAME": "photos"})
+		require.NoError(t, result.Err)
+		require.NotNil(t, result.ExitCode)
+		assert.Equal(t, 0, *result.ExitCode)
+		assert.Equal(t, "hello photos\nerr\n", result.Output)
+		assert.False(t, result.OutputTruncated)
+	})
+
+	t.Run("RestrictedEnvironment", func(t *testing.T) {
+		t.Setenv("RCLONESYNC_SECURITY_ENCRYPTION_KEY", "secret")
+		script := writeScript(t, `env`)
+		e := NewExecutor(Options{AllowedCommands: []string{script}})
+
+		result := e.Run(context.Background(), script, nil, nil)
+		require.NoError(t, result.Err)
+		assert.NotContains(t, result.Output, "secret")
+		assert.Contains(t, result.Output, "PATH=")
+	})
+
+	t.Run("ExitCode", func(t *testing.T) {
+		script := writeScript(t, `echo failing; exit 3`)
+		e := NewExecutor(Options{AllowedCommands: []string{script}})
+
+		result := e.Run(context.Background(), script, nil, nil)
+		require.Error(t, result.Err)
+		require.NotNil(t, result.ExitCode)
+		assert.Equal(t, 3, *result.ExitCode)
+		assert.Equal(t, "failing\n", result.Output)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		// The child process keeps the output open, it must be killed with the hook
+		script := writeScript(t, `sleep 30 & sleep 30`)
+		e := NewExecutor(Options{AllowedCommands: []string{script}, Timeout: 200 * time.Millisecond})
+
+		start := time.Now()
+		result := e.Run(context.Background(), script, nil, nil)
+		assert.ErrorIs(t, result.Err, ErrTimeout)
+		assert.Nil(t, result.ExitCode)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("OutputCapped", func(t *testing.T) {
+		script := writeScript(t, `i=0; while [ $i -lt 100 ]; do echo 0123456789; i=$((i+1)); done`)
+		e := NewExecutor(Options{AllowedCommands: []string{script}, MaxOutput: 25})
+
+		result := e.Run(context.Background(), script, nil, nil)
+		require.NoError(t, result.Err)
+		assert.Len(t, result.Output, 25)
+		assert.True(t, strings.HasPrefix(result.Output, "0123456789\n0123456789\n"))
+		assert.True(t, result.OutputTruncated)
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		script := writeScript(t, `exit 0`)
+		e := NewExecutor(Options{AllowedCommands: []string{"/usr/local/bin/other"}})
+
+		result := e.Run(context.Background(), script, nil, nil)
+		assert.ErrorIs(t, result.Err, ErrCommandNotAllowed)
+		assert.Nil(t, result.ExitCode)
+	})
+}
@@ -0,0 +1,25 @@
+//go:build !unix
+
+package hooks
+
+import (
+	"os"
+	"os/exec"
+)
+
+// baseEnv returns the variables every hook gets: the system directories Windows programs need to start.
+func baseEnv() map[string]string {
+	env := map[string]string{}
+	for _, name := range []string{"SystemRoot", "SystemDrive", "WINDIR"} {
+		if value := os.Getenv(name); value != "" {
+			env[name] = value
+		}
+	}
+	if root := os.Getenv("SystemRoot"); root != "" {
+		env["PATH"] = root + `\system32;` + root
+	}
+	return env
+}
+
+// killProcessGroup keeps the default cancellation, which kills only the hook process itself.
+func killProcessGroup(_ *exec.Cmd) {}
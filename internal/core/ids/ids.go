@@ -0,0 +1,47 @@
+// Package ids generates the UUIDs used as entity identifiers.
+package ids
+
+import (
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+const (
+	// VersionRandom generates random (version 4) UUIDs.
+	VersionRandom = 4
+	// VersionTimeOrdered generates time-ordered (version 7) UUIDs, whose order follows the creation time.
+	VersionTimeOrdered = 7
+)
+
+// ErrUnsupportedVersion is returned when configuring a UUID version other than 4 or 7.
+const ErrUnsupportedVersion = errs.ConstError("unsupported UUID version, must be 4 or 7")
+
+var version atomic.Int32
+
+func init() {
+	version.Store(VersionTimeOrdered)
+}
+
+// SetVersion sets the version of the UUIDs generated by New.
+func SetVersion(v int) error {
+	if v != VersionRandom && v != VersionTimeOrdered {
+		return ErrUnsupportedVersion
+	}
+	version.Store(int32(v))
+	return nil
+}
+
+// Version returns the version of the UUIDs generated by New.
+func Version() int {
+	return int(version.Load())
+}
+
+// New returns a new entity identifier of the configured version, time-ordered (version 7) by default.
+func New() uuid.UUID {
+	if version.Load() == VersionRandom {
+		return uuid.New()
+	}
+	return uuid.Must(uuid.NewV7())
+}
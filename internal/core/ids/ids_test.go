@@ -0,0 +1,34 @@
+package ids
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Cleanup(func() { _ = SetVersion(VersionTimeOrdered) })
+
+	t.Run("TimeOrderedByDefault", func(t *testing.T) {
+		assert.Equal(t, VersionTimeOrdered, Version())
+		prev := New()
+		assert.Equal(t, uuid.Version(7), prev.Version())
+		for range 100 {
+			id := New()
+			assert.Greater(t, id.String(), prev.String(), "IDs sort by creation")
+			prev = id
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		require.NoError(t, SetVersion(VersionRandom))
+		assert.Equal(t, uuid.Version(4), New().Version())
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		assert.ErrorIs(t, SetVersion(1), ErrUnsupportedVersion)
+		assert.Equal(t, VersionRandom, Version(), "version is unchanged")
+	})
+}
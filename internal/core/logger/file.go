@@ -0,0 +1,219 @@
+// Package logger provides logging utilities for the application.
+package logger
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// fileTimeKey is the JSON key of the entry timestamp in the log file.
+const fileTimeKey = "ts"
+
+// lumberjackBackupTimeFormat is the timestamp format lumberjack uses in the names of rotated files.
+const lumberjackBackupTimeFormat = "2006-01-02T15-04-05.000"
+
+// ErrFileOutputDisabled is returned when reading or rotating the log file while file logging is not configured.
+const ErrFileOutputDisabled = errs.ConstError("file logging is not configured")
+
+// fileWriter is the rotating log file writer, nil if file logging is disabled.
+// fileBase is the global logger before file output was added, restored by CloseFileOutput.
+var (
+	fileWriter   *lumberjack.Logger
+	fileBase     *zap.Logger
+	fileWriterMu sync.RWMutex
+)
+
+// FileOptions configures writing logs to a rotated file.
+type FileOptions struct {
+	// Path of the log file, file logging is disabled if empty.
+	Path string
+	// MaxSizeMB is the size in megabytes at which the file is rotated.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to keep, 0 keeps all.
+	MaxBackups int
+	// MaxAgeDays is the number of days to keep rotated files, 0 keeps them regardless of age.
+	MaxAgeDays int
+	// Compress gzips rotated files.
+	Compress bool
+}
+
+// InitFileOutput additionally writes all logs of the global logger as JSON lines to a rotated file.
+// It must be called after InitLogger and before named loggers are created. It does nothing if opts.Path is empty.
+func InitFileOutput(opts FileOptions) error {
+	if opts.Path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0755); err != nil {
+		return err
+	}
+
+	w := &lumberjack.Logger{
+		Filename:   opts.Path,
+		MaxSize:    opts.MaxSizeMB,
+		MaxBackups: opts.MaxBackups,
+		MaxAge:     opts.MaxAgeDays,
+		Compress:   opts.Compress,
+	}
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.TimeKey = fileTimeKey
+	encoderCfg.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+
+	base := Get()
+	// 文件核心使用与全局 logger 相同的级别，Named logger 的层级级别由 levelFilterCore 决定
+	fileCore := zapcore.NewCore(zapcore.NewJSONEncoder(encoderCfg), zapcore.AddSync(w), base.Core())
+	logger = base.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, fileCore)
+	}))
+
+	fileWriterMu.Lock()
+	fileWriter = w
+	fileBase = base
+	fileWriterMu.Unlock()
+
+	redirectLogs()
+	return nil
+}
+
+// CloseFileOutput stops writing logs to the file and closes it. It does nothing if file logging is disabled.
+func CloseFileOutput() error {
+	fileWriterMu.Lock()
+	defer fileWriterMu.Unlock()
+	if fileWriter == nil {
+		return nil
+	}
+
+	logger = fileBase
+	redirectLogs()
+	err := fileWriter.Close()
+	fileWriter, fileBase = nil, nil
+	return err
+}
+
+// FileOutputEnabled reports whether logs are written to a file.
+func FileOutputEnabled() bool {
+	fileWriterMu.RLock()
+	defer fileWriterMu.RUnlock()
+	return fileWriter != nil
+}
+
+// RotateFile closes the current log file, renames it with a timestamp and starts a new one.
+func RotateFile() error {
+	fileWriterMu.RLock()
+	defer fileWriterMu.RUnlock()
+	if fileWriter == nil {
+		return ErrFileOutputDisabled
+	}
+	return fileWriter.Rotate()
+}
+
+// ForEachFileLog calls fn with every JSON line of the log file and its rotated backups, oldest first,
+// whose timestamp is not before since. Rotated files last written before since are not read.
+func ForEachFileLog(since time.Time, fn func(line []byte) error) error {
+	fileWriterMu.RLock()
+	w := fileWriter
+	fileWriterMu.RUnlock()
+	if w == nil {
+		return ErrFileOutputDisabled
+	}
+
+	files, err := logFiles(w.Filename, since)
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		if err := forEachLineInFile(name, since, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logFiles returns the rotated backups of the log file at path that may contain entries since the given time,
+// oldest first, followed by the log file itself.
+func logFiles(path string, since time.Time) ([]string, error) {
+	ext := filepath.Ext(path)
+	prefix := strings.TrimSuffix(filepath.Base(path), ext) + "-"
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []string
+	for _, e := range entries {
+		name := e.Name()
+		stamp, ok := strings.CutPrefix(strings.TrimSuffix(name, ".gz"), prefix)
+		if e.IsDir() || !ok || !strings.HasSuffix(stamp, ext) {
+			continue
+		}
+		// The backup timestamp is the rotation time, i.e. the time of the last entry in the backup
+		rotated, err := time.Parse(lumberjackBackupTimeFormat, strings.TrimSuffix(stamp, ext))
+		if err != nil || rotated.Before(since) {
+			continue
+		}
+		backups = append(backups, filepath.Join(filepath.Dir(path), name))
+	}
+	// The timestamps in the names sort chronologically
+	slices.Sort(backups)
+	return append(backups, path), nil
+}
+
+// forEachLineInFile calls fn with every line of the (optionally gzipped) log file whose timestamp is not before since.
+// A missing file is skipped, it may have been removed by rotation in the meantime.
+func forEachLineInFile(name string, since time.Time, fn func(line []byte) error) error {
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 || lineTime(line).Before(since) {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// lineTime returns the timestamp of a JSON log line, or the zero time if it has none.
+func lineTime(line []byte) time.Time {
+	var entry struct {
+		Time time.Time `json:"ts"`
+	}
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return time.Time{}
+	}
+	return entry.Time
+}
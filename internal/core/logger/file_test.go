@@ -0,0 +1,76 @@
+// Package logger provides logging utilities for the application.
+package logger
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// readFileLogs returns the messages of all log file entries since the given time.
+func readFileLogs(t *testing.T, since time.Time) []string {
+	t.Helper()
+	var msgs []string
+	require.NoError(t, ForEachFileLog(since, func(line []byte) error {
+		msgs = append(msgs, gjson.GetBytes(line, "msg").String())
+		return nil
+	}))
+	return msgs
+}
+
+func TestFileOutput(t *testing.T) {
+	originalLogger := Get()
+	logger = zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(io.Discard), zapcore.InfoLevel))
+	InitLevelConfig(map[string]string{"test.verbose": "debug"}, zapcore.InfoLevel)
+	t.Cleanup(func() {
+		require.NoError(t, CloseFileOutput())
+		logger = originalLogger
+		redirectLogs()
+		InitLevelConfig(nil, zapcore.InfoLevel)
+	})
+
+	assert.False(t, FileOutputEnabled())
+	assert.ErrorIs(t, ForEachFileLog(time.Time{}, func([]byte) error { return nil }), ErrFileOutputDisabled)
+	assert.ErrorIs(t, RotateFile(), ErrFileOutputDisabled)
+
+	path := filepath.Join(t.TempDir(), "logs", "server.log")
+	require.NoError(t, InitFileOutput(FileOptions{Path: path, MaxSizeMB: 1, MaxBackups: 2}))
+	assert.True(t, FileOutputEnabled())
+
+	Named("test").Info("first")
+	Named("test").Debug("hidden by the global level")
+	Named("test.verbose").Debug("shown by the hierarchical level")
+	assert.Equal(t, []string{"first", "shown by the hierarchical level"}, readFileLogs(t, time.Time{}))
+
+	t.Run("Rotate", func(t *testing.T) {
+		require.NoError(t, RotateFile())
+		// Rotated file names have millisecond precision
+		time.Sleep(10 * time.Millisecond)
+		rotated := time.Now()
+		Named("test").Info("second")
+
+		backups, err := filepath.Glob(filepath.Join(filepath.Dir(path), "server-*.log"))
+		require.NoError(t, err)
+		assert.Len(t, backups, 1)
+		assert.Equal(t, []string{"first", "shown by the hierarchical level", "second"}, readFileLogs(t, time.Time{}))
+		assert.Equal(t, []string{"second"}, readFileLogs(t, rotated))
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		require.NoError(t, CloseFileOutput())
+		assert.False(t, FileOutputEnabled())
+		Named("test").Info("not written")
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "not written")
+	})
+}
@@ -107,6 +107,11 @@ func InitLogger(environment Environment, logLevel LogLevel, levels map[string]st
 	// 初始化层级日志级别配置
 	InitLevelConfig(levels, zapLevel)
 
+	redirectLogs()
+}
+
+// redirectLogs redirects the standard library log and slog (used by rclone) to the global logger.
+func redirectLogs() {
 	// Redirect standard log to zap
 	zap.RedirectStdLog(logger)
 
@@ -0,0 +1,372 @@
+// Package logship ships job logs and job status changes as structured entries to a central log store,
+// syslog or Loki, so the sync activity of many servers can be aggregated without reading their databases.
+// Entries are queued and sent in batches in the background; entries are dropped rather than slowing down jobs
+// when the queue is full or the log store is unreachable.
+package logship
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+)
+
+const (
+	// DefaultBatchSize is the number of entries sent at once if no batch size is configured.
+	DefaultBatchSize = 100
+	// DefaultFlushInterval is the time entries are queued at most if no interval is configured.
+	DefaultFlushInterval = 5 * time.Second
+	// DefaultBufferSize is the number of queued entries if no buffer size is configured.
+	DefaultBufferSize = 10000
+	// sendTimeout bounds sending a batch, including resolving the task and connection of its jobs.
+	sendTimeout = 10 * time.Second
+	// maxCachedJobs bounds the task and connection names cached by job ID.
+	maxCachedJobs = 1000
+)
+
+// ErrUnknownTarget is returned by NewSink for a target other than syslog and loki.
+const ErrUnknownTarget = errs.ConstError("unknown log shipping target, expected syslog or loki")
+
+// DefaultLabels are the labels of the entries if none are configured.
+var DefaultLabels = map[string]string{
+	"app":        "rclone-sync",
+	"task":       "{{.Task}}",
+	"connection": "{{.Connection}}",
+}
+
+// EntryType is the kind of a shipped entry.
+type EntryType string
+
+const (
+	// EntryTypeLog is a job log, such as a transferred or deleted file.
+	EntryTypeLog EntryType = "log"
+	// EntryTypeStatus is a status change of a job, such as its start or end.
+	EntryTypeStatus EntryType = "status"
+)
+
+// Entry is a job log or job status change as shipped to the log store, serialized as a JSON line.
+type Entry struct {
+	Time         time.Time `json:"time"`
+	Type         EntryType `json:"type"`
+	Level        string    `json:"level"` // info, warning or error
+	JobID        uuid.UUID `json:"jobId"`
+	TaskID       uuid.UUID `json:"taskId"`
+	Task         string    `json:"task,omitempty"`
+	ConnectionID uuid.UUID `json:"connectionId"`
+	Connection   string    `json:"connection,omitempty"`
+	// Fields of job logs
+	Action       string `json:"action,omitempty"`
+	Path         string `json:"path,omitempty"`
+	PreviousPath string `json:"previousPath,omitempty"`
+	Size         int64  `json:"size,omitempty"`
+	// Fields of job status changes
+	Status  string `json:"status,omitempty"`
+	Trigger string `json:"trigger,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Sink sends batches of entries to a log store.
+type Sink interface {
+	Send(ctx context.Context, entries []Entry) error
+}
+
+// Labels renders the labels of entries, such as the Loki stream labels or the syslog structured data.
+// Label values are text/template templates executed with the Entry, e.g. "{{.Task}}".
+type Labels struct {
+	names     []string
+	templates map[string]*template.Template
+}
+
+// NewLabels parses the label templates, DefaultLabels if labels is empty.
+func NewLabels(labels map[string]string) (*Labels, error) {
+	if len(labels) == 0 {
+		labels = DefaultLabels
+	}
+	l := &Labels{templates: make(map[string]*template.Template, len(labels))}
+	for name, value := range labels {
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log shipping label %q: %w", name, err)
+		}
+		l.names = append(l.names, name)
+		l.templates[name] = tmpl
+	}
+	sort.Strings(l.names)
+	return l, nil
+}
+
+// Render returns the labels of e. Labels rendering to an empty value are left out.
+func (l *Labels) Render(e *Entry) map[string]string {
+	labels := make(map[string]string, len(l.names))
+	var buf bytes.Buffer
+	for _, name := range l.names {
+		buf.Reset()
+		if err := l.templates[name].Execute(&buf, e); err != nil || buf.Len() == 0 {
+			continue
+		}
+		labels[name] = buf.String()
+	}
+	return labels
+}
+
+// NewSink creates the sink of target: "syslog" with an address like "udp://host:514" or "tcp://host:601",
+// or "loki" with the base URL of Loki, e.g. "http://loki:3100".
+func NewSink(target, address string, labels *Labels) (Sink, error) {
+	u, err := url.Parse(address)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid log shipping address %q", address) //nolint:err113
+	}
+	switch target {
+	case "syslog":
+		return NewSyslogSink(u.Scheme, u.Host, labels)
+	case "loki":
+		return NewLokiSink(address, labels), nil
+	}
+	return nil, ErrUnknownTarget
+}
+
+// Options configures a Shipper.
+type Options struct {
+	// BatchSize is the number of entries sent at once, DefaultBatchSize if not positive.
+	BatchSize int
+	// FlushInterval is the time entries are queued at most, DefaultFlushInterval if not positive.
+	FlushInterval time.Duration
+	// BufferSize is the number of queued entries, DefaultBufferSize if not positive. Further entries are dropped.
+	BufferSize int
+}
+
+// jobNames are the task and connection of a job.
+type jobNames struct {
+	taskID, connectionID uuid.UUID
+	task, connection     string
+}
+
+// Shipper queues the job logs and status changes recorded by the JobService and sends them to a Sink in the background.
+type Shipper struct {
+	client *ent.Client
+	sink   Sink
+	opts   Options
+	logger *zap.Logger
+
+	queue   chan Entry
+	dropped atomic.Int64
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+
+	names map[uuid.UUID]jobNames // Only used by the run goroutine
+}
+
+// NewShipper creates a Shipper sending to sink. The task and connection of entries are looked up with client.
+func NewShipper(client *ent.Client, sink Sink, opts Options) *Shipper {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultFlushInterval
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = DefaultBufferSize
+	}
+	return &Shipper{
+		client: client,
+		sink:   sink,
+		opts:   opts,
+		logger: logger.Named("core.logship"),
+		queue:  make(chan Entry, opts.BufferSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+		names:  make(map[uuid.UUID]jobNames),
+	}
+}
+
+// Start starts sending queued entries in the background.
+func (s *Shipper) Start() {
+	s.logger.Info("Starting job log shipping", zap.Int("batch_size", s.opts.BatchSize), zap.Duration("flush_interval", s.opts.FlushInterval))
+	go s.run()
+}
+
+// Stop sends the queued entries and stops the shipper.
+func (s *Shipper) Stop() {
+	s.once.Do(func() {
+		s.logger.Info("Stopping job log shipping")
+		close(s.stop)
+		<-s.done
+	})
+}
+
+// JobLogs queues the logs of a job.
+func (s *Shipper) JobLogs(jobID uuid.UUID, logs []*ent.JobLog) {
+	for _, l := range logs {
+		e := Entry{
+			Time:   l.Time,
+			Type:   EntryTypeLog,
+			Level:  logLevel(l.Level),
+			JobID:  jobID,
+			Action: string(l.What),
+			Path:   l.Path,
+			Size:   l.Size,
+		}
+		if l.PreviousPath != nil {
+			e.PreviousPath = *l.PreviousPath
+		}
+		if e.Time.IsZero() {
+			e.Time = time.Now()
+		}
+		s.enqueue(e)
+	}
+}
+
+// JobStatus queues the current status of a job.
+func (s *Shipper) JobStatus(j *ent.Job) {
+	e := Entry{
+		Time:    time.Now(),
+		Type:    EntryTypeStatus,
+		Level:   statusLevel(j.Status),
+		JobID:   j.ID,
+		TaskID:  j.TaskID,
+		Status:  string(j.Status),
+		Trigger: string(j.Trigger),
+		Error:   j.Errors,
+	}
+	s.enqueue(e)
+}
+
+// Dropped returns the number of entries dropped since the start, because the queue was full or sending failed.
+func (s *Shipper) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+func (s *Shipper) enqueue(e Entry) {
+	select {
+	case s.queue <- e:
+	default:
+		if s.dropped.Add(1) == 1 {
+			s.logger.Warn("Job log shipping queue is full, dropping entries", zap.Int("buffer_size", s.opts.BufferSize))
+		}
+	}
+}
+
+func (s *Shipper) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Entry, 0, s.opts.BatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			s.send(batch)
+			batch = batch[:0]
+		}
+	}
+	for {
+		select {
+		case e := <-s.queue:
+			batch = append(batch, e)
+			if len(batch) >= s.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stop:
+			for {
+				select {
+				case e := <-s.queue:
+					batch = append(batch, e)
+					if len(batch) >= s.opts.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// send resolves the task and connection of the entries and sends them.
+func (s *Shipper) send(batch []Entry) {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	for i := range batch {
+		s.resolve(ctx, &batch[i])
+	}
+	if err := s.sink.Send(ctx, batch); err != nil {
+		s.dropped.Add(int64(len(batch)))
+		s.logger.Warn("Failed to ship job logs", zap.Int("entries", len(batch)), zap.Error(err))
+	}
+}
+
+// resolve fills in the task and connection of an entry, cached by job until its final status is shipped.
+func (s *Shipper) resolve(ctx context.Context, e *Entry) {
+	names, ok := s.names[e.JobID]
+	if !ok {
+		j, err := s.client.Job.Query().
+			Where(job.ID(e.JobID)).
+			WithTask(func(q *ent.TaskQuery) { q.WithConnection() }).
+			Only(ctx)
+		if err == nil && j.Edges.Task != nil {
+			names = jobNames{taskID: j.TaskID, task: j.Edges.Task.Name}
+			if conn := j.Edges.Task.Edges.Connection; conn != nil {
+				names.connectionID, names.connection = conn.ID, conn.Name
+			}
+			if len(s.names) >= maxCachedJobs {
+				clear(s.names)
+			}
+			s.names[e.JobID] = names
+		}
+	}
+	e.TaskID, e.Task = names.taskID, names.task
+	e.ConnectionID, e.Connection = names.connectionID, names.connection
+	if e.Type == EntryTypeStatus && isFinal(model.JobStatus(e.Status)) {
+		delete(s.names, e.JobID)
+	}
+}
+
+// logLevel maps the level of a job log to the level of an entry.
+func logLevel(level model.LogLevel) string {
+	switch level {
+	case model.LogLevelError:
+		return "error"
+	case model.LogLevelWarning:
+		return "warning"
+	}
+	return "info"
+}
+
+// statusLevel maps the status of a job to the level of an entry.
+func statusLevel(status model.JobStatus) string {
+	switch status {
+	case model.JobStatusFailed, model.JobStatusFailedTimeout:
+		return "error"
+	case model.JobStatusSuccessWithWarnings, model.JobStatusCancelled, model.JobStatusQuotaDeferred:
+		return "warning"
+	}
+	return "info"
+}
+
+// isFinal reports whether a job with the status has ended.
+func isFinal(status model.JobStatus) bool {
+	switch status {
+	case model.JobStatusSuccess, model.JobStatusSuccessWithWarnings, model.JobStatusFailed,
+		model.JobStatusFailedTimeout, model.JobStatusCancelled, model.JobStatusQuotaDeferred:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,251 @@
+package logship
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/services"
+)
+
+// recordingSink records the entries it is sent.
+type recordingSink struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+func (r *recordingSink) Send(_ context.Context, entries []Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entries...)
+	return nil
+}
+
+func (r *recordingSink) all() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Entry(nil), r.entries...)
+}
+
+func TestLabels_Render(t *testing.T) {
+	labels, err := NewLabels(nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "rclone-sync", "task": "Photos", "connection": "gdrive"},
+		labels.Render(&Entry{Task: "Photos", Connection: "gdrive"}))
+	assert.Equal(t, map[string]string{"app": "rclone-sync"}, labels.Render(&Entry{}), "empty labels are left out")
+
+	labels, err = NewLabels(map[string]string{"job": "{{.Type}}-{{.Level}}"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"job": "status-error"}, labels.Render(&Entry{Type: EntryTypeStatus, Level: "error"}))
+
+	_, err = NewLabels(map[string]string{"task": "{{.Task"})
+	assert.Error(t, err)
+}
+
+func TestNewSink(t *testing.T) {
+	labels, err := NewLabels(nil)
+	require.NoError(t, err)
+
+	sink, err := NewSink("loki", "http://loki:3100/", labels)
+	require.NoError(t, err)
+	assert.Equal(t, "http://loki:3100/loki/api/v1/push", sink.(*LokiSink).url)
+
+	sink, err = NewSink("syslog", "tcp://syslog:601", labels)
+	require.NoError(t, err)
+	assert.Equal(t, "tcp", sink.(*SyslogSink).network)
+
+	_, err = NewSink("syslog", "unix://syslog:601", labels)
+	assert.Error(t, err)
+	_, err = NewSink("syslog", "syslog:514", labels)
+	assert.Error(t, err)
+	_, err = NewSink("kafka", "http://kafka:9092", labels)
+	assert.ErrorIs(t, err, ErrUnknownTarget)
+}
+
+func TestLokiSink_Send(t *testing.T) {
+	var push lokiPush
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, lokiPushPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&push))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	labels, err := NewLabels(map[string]string{"task": "{{.Task}}"})
+	require.NoError(t, err)
+	sink := NewLokiSink(server.URL, labels)
+	now := time.Now()
+	require.NoError(t, sink.Send(context.Background(), []Entry{
+		{Time: now, Type: EntryTypeLog, Task: "a", Path: "one.txt"},
+		{Time: now, Type: EntryTypeLog, Task: "b", Path: "two.txt"},
+		{Time: now, Type: EntryTypeStatus, Task: "a", Status: "SUCCESS"},
+	}))
+
+	require.Len(t, push.Streams, 2, "one stream per set of labels")
+	assert.Equal(t, map[string]string{"task": "a"}, push.Streams[0].Stream)
+	require.Len(t, push.Streams[0].Values, 2)
+	assert.Equal(t, strconv.FormatInt(now.UnixNano(), 10), push.Streams[0].Values[0][0])
+	var entry Entry
+	require.NoError(t, json.Unmarshal([]byte(push.Streams[0].Values[0][1]), &entry))
+	assert.Equal(t, "one.txt", entry.Path)
+	assert.Equal(t, map[string]string{"task": "b"}, push.Streams[1].Stream)
+
+	t.Run("error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "entry too far behind", http.StatusBadRequest)
+		}))
+		defer server.Close()
+		err := NewLokiSink(server.URL, labels).Send(context.Background(), []Entry{{Time: now}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "entry too far behind")
+	})
+}
+
+func TestSyslogSink_Send(t *testing.T) {
+	labels, err := NewLabels(map[string]string{"task": "{{.Task}}", "app": "rclone-sync"})
+	require.NoError(t, err)
+	time1 := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	t.Run("udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		sink, err := NewSyslogSink("udp", conn.LocalAddr().String(), labels)
+		require.NoError(t, err)
+		require.NoError(t, sink.Send(context.Background(), []Entry{
+			{Time: time1, Type: EntryTypeStatus, Level: "error", Task: `a "quoted" [task]`, Status: "FAILED"},
+		}))
+
+		buf := make([]byte, 4096)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		msg := string(buf[:n])
+		prefix := "<131>1 2026-10-17T12:00:00Z " + sink.hostname + ` rclone-sync - status [labels@32473 app="rclone-sync" task="a \"quoted\" [task\]"] `
+		require.True(t, strings.HasPrefix(msg, prefix), msg)
+		var entry Entry
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(msg, prefix)), &entry))
+		assert.Equal(t, "FAILED", entry.Status)
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+		received := make(chan string, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			data, _ := io.ReadAll(conn)
+			received <- string(data)
+		}()
+
+		sink, err := NewSyslogSink("tcp", listener.Addr().String(), labels)
+		require.NoError(t, err)
+		entries := []Entry{
+			{Time: time1, Type: EntryTypeLog, Level: "info", Path: "a.txt"},
+			{Time: time1, Type: EntryTypeLog, Level: "warning", Path: "b.txt"},
+		}
+		require.NoError(t, sink.Send(context.Background(), entries))
+
+		data := <-received
+		for _, e := range entries {
+			msg, err := sink.format(&e)
+			require.NoError(t, err)
+			frame := strconv.Itoa(len(msg)) + " " + msg
+			require.True(t, strings.HasPrefix(data, frame), "messages are framed by octet counting")
+			data = strings.TrimPrefix(data, frame)
+		}
+		assert.Empty(t, data)
+	})
+}
+
+func TestShipper(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	conn, err := services.NewConnectionService(client, encryptor).CreateConnection(ctx, "ship-conn", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	task, err := services.NewTaskService(client).CreateTask(ctx, "Ship Task", "/l", conn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	sink := &recordingSink{}
+	shipper := NewShipper(client, sink, Options{BatchSize: 2, FlushInterval: time.Hour})
+	jobService := services.NewJobService(client)
+	jobService.SetJobLogSink(shipper)
+	shipper.Start()
+
+	j, err := jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	_, err = jobService.UpdateJobStatus(ctx, j.ID, string(model.JobStatusRunning), "")
+	require.NoError(t, err)
+	_, err = jobService.AddJobLog(ctx, j.ID, string(model.LogLevelInfo), string(model.LogActionUpload), "a.txt", 42)
+	require.NoError(t, err)
+	_, err = jobService.FinalizeJob(ctx, j.ID, ports.JobResult{
+		Status: model.JobStatusFailed,
+		Error:  "boom",
+		Logs:   []*ent.JobLog{{Level: model.LogLevelError, What: model.LogActionError, Path: "b.txt"}},
+	})
+	require.NoError(t, err)
+	shipper.Stop()
+
+	entries := sink.all()
+	require.Len(t, entries, 5)
+	for _, e := range entries {
+		assert.Equal(t, j.ID, e.JobID)
+		assert.Equal(t, task.ID, e.TaskID)
+		assert.Equal(t, "Ship Task", e.Task)
+		assert.Equal(t, conn.ID, e.ConnectionID)
+		assert.Equal(t, "ship-conn", e.Connection)
+	}
+	assert.Equal(t, Entry{Type: EntryTypeStatus, Level: "info", Status: "PENDING", Trigger: "MANUAL"}, stripEntry(entries[0]))
+	assert.Equal(t, "RUNNING", entries[1].Status)
+	assert.Equal(t, Entry{Type: EntryTypeLog, Level: "info", Action: "UPLOAD", Path: "a.txt", Size: 42}, stripEntry(entries[2]))
+	assert.Equal(t, Entry{Type: EntryTypeLog, Level: "error", Action: "ERROR", Path: "b.txt"}, stripEntry(entries[3]))
+	assert.Equal(t, Entry{Type: EntryTypeStatus, Level: "error", Status: "FAILED", Trigger: "MANUAL", Error: "boom"}, stripEntry(entries[4]))
+	assert.Empty(t, shipper.names, "the names of ended jobs aren't cached")
+	assert.Zero(t, shipper.Dropped())
+}
+
+func TestShipper_DropsWhenFull(t *testing.T) {
+	shipper := NewShipper(nil, &recordingSink{}, Options{BufferSize: 1})
+	shipper.JobStatus(&ent.Job{ID: uuid.New()})
+	shipper.JobStatus(&ent.Job{ID: uuid.New()})
+	shipper.JobLogs(uuid.New(), []*ent.JobLog{{}, {}})
+	assert.Equal(t, int64(3), shipper.Dropped())
+}
+
+// stripEntry clears the fields of e that differ between runs.
+func stripEntry(e Entry) Entry {
+	e.Time = time.Time{}
+	e.JobID, e.TaskID, e.ConnectionID = uuid.Nil, uuid.Nil, uuid.Nil
+	e.Task, e.Connection = "", ""
+	return e
+}
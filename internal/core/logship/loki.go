@@ -0,0 +1,97 @@
+package logship
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// lokiPushPath is the path of the push API below the base URL of Loki.
+const lokiPushPath = "/loki/api/v1/push"
+
+// LokiSink sends entries to the push API of Loki, one stream per distinct set of labels.
+type LokiSink struct {
+	url    string
+	labels *Labels
+	client *http.Client
+}
+
+// NewLokiSink creates a sink pushing to the Loki at baseURL.
+func NewLokiSink(baseURL string, labels *Labels) *LokiSink {
+	return &LokiSink{
+		url:    strings.TrimSuffix(baseURL, "/") + lokiPushPath,
+		labels: labels,
+		client: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPush struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+// Send pushes the entries as JSON lines.
+func (l *LokiSink) Send(ctx context.Context, entries []Entry) error {
+	push := lokiPush{}
+	streams := make(map[string]*lokiStream)
+	for i := range entries {
+		e := &entries[i]
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		labels := l.labels.Render(e)
+		key := streamKey(labels)
+		stream, ok := streams[key]
+		if !ok {
+			stream = &lokiStream{Stream: labels}
+			streams[key] = stream
+			push.Streams = append(push.Streams, stream)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(e.Time.UnixNano(), 10), string(line)})
+	}
+
+	body, err := json.Marshal(push)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("loki push failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg))) //nolint:err113
+	}
+	return nil
+}
+
+// streamKey identifies a set of labels.
+func streamKey(labels map[string]string) string {
+	var b strings.Builder
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString(name + "=" + strconv.Quote(labels[name]) + ",")
+	}
+	return b.String()
+}
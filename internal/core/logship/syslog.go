@@ -0,0 +1,112 @@
+package logship
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// syslogFacility is the local0 facility.
+	syslogFacility = 16
+	// syslogAppName is the APP-NAME of the syslog messages.
+	syslogAppName = "rclone-sync"
+	// syslogSDID is the ID of the structured data element holding the labels, below the reserved example enterprise number.
+	syslogSDID = "labels@32473"
+)
+
+// SyslogSink sends entries as RFC 5424 messages to a syslog server, the labels as structured data.
+// Over TCP messages are framed by octet counting (RFC 6587).
+type SyslogSink struct {
+	network  string
+	address  string
+	hostname string
+	labels   *Labels
+}
+
+// NewSyslogSink creates a sink sending to the syslog server at address over network, "udp" or "tcp".
+func NewSyslogSink(network, address string, labels *Labels) (*SyslogSink, error) {
+	if network != "udp" && network != "tcp" {
+		return nil, fmt.Errorf("unsupported syslog network %q, expected udp or tcp", network) //nolint:err113
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &SyslogSink{network: network, address: address, hostname: hostname, labels: labels}, nil
+}
+
+// Send sends one message per entry, the entry as JSON message.
+func (s *SyslogSink) Send(ctx context.Context, entries []Entry) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	for i := range entries {
+		msg, err := s.format(&entries[i])
+		if err != nil {
+			return err
+		}
+		if s.network == "tcp" {
+			msg = strconv.Itoa(len(msg)) + " " + msg
+		}
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// format returns the RFC 5424 message of an entry.
+func (s *SyslogSink) format(e *Entry) (string, error) {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>1 %s %s %s - %s ",
+		syslogFacility*8+syslogSeverity(e.Level), e.Time.UTC().Format(time.RFC3339Nano), s.hostname, syslogAppName, e.Type)
+
+	labels := s.labels.Render(e)
+	if len(labels) == 0 {
+		b.WriteString("-")
+	} else {
+		b.WriteString("[" + syslogSDID)
+		for _, name := range s.labels.names {
+			if value, ok := labels[name]; ok {
+				b.WriteString(" " + name + "=\"" + escapeSDValue(value) + "\"")
+			}
+		}
+		b.WriteString("]")
+	}
+	b.WriteString(" ")
+	b.Write(line)
+	return b.String(), nil
+}
+
+// syslogSeverity maps the level of an entry to the syslog severity.
+func syslogSeverity(level string) int {
+	switch level {
+	case "error":
+		return 3
+	case "warning":
+		return 4
+	}
+	return 6
+}
+
+// escapeSDValue escapes the characters RFC 5424 doesn't allow in structured data values.
+func escapeSDValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -5,6 +5,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
@@ -15,12 +16,26 @@ import (
 type Runner interface {
 	Start()
 	Stop()
-	StartTask(task *ent.Task, trigger model.JobTrigger) error
+	StartTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error
 	StopTask(taskID uuid.UUID) error
+	StopTasks(taskIDs []uuid.UUID) []uuid.UUID
 	IsRunning(taskID uuid.UUID) bool
+	SetMaintenance(enabled bool, cancelRunning bool)
+	IsMaintenance() bool
+	RunningCount() int
+	Engines() []string
 }
 
+// Names of the built-in sync engines.
+const (
+	// DefaultSyncEngine is the name of the engine that runs tasks without an explicit engine.
+	DefaultSyncEngine = "rclone"
+	// BackupSyncEngine is the name of the engine that keeps versioned snapshots of the source instead of mirroring it.
+	BackupSyncEngine = "backup"
+)
+
 // SyncEngine executes the actual sync operation for a task.
+// Engines are registered in the runner by name and selected per task through its engine field.
 type SyncEngine interface {
 	RunTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error
 }
@@ -31,6 +46,8 @@ type Watcher interface {
 	Stop()
 	AddTask(task *ent.Task) error
 	RemoveTask(task *ent.Task) error
+	// WatchWarning returns the warning of a realtime task that is only partially watched, nil if there is none.
+	WatchWarning(taskID uuid.UUID) *model.WatchWarning
 }
 
 // Scheduler defines the interface for scheduled task operations.
@@ -39,6 +56,10 @@ type Scheduler interface {
 	Stop()
 	AddTask(task *ent.Task) error
 	RemoveTask(task *ent.Task) error
+	Pause()
+	Resume()
+	IsPaused() bool
+	ScheduledTaskCount() int
 }
 
 // TaskService provides CRUD operations for tasks.
@@ -46,34 +67,82 @@ type TaskService interface {
 	GetTask(ctx context.Context, id uuid.UUID) (*ent.Task, error)
 	GetTaskWithConnection(ctx context.Context, id uuid.UUID) (*ent.Task, error)
 	ListAllTasks(ctx context.Context) ([]*ent.Task, error)
+	RecordSkippedRun(ctx context.Context, taskID uuid.UUID, message string) error
+	RecordWatchLimitReached(ctx context.Context, taskID uuid.UUID, message string) error
 	// Add other methods as needed for testing
 }
 
+// JobResult describes the final outcome of a job, applied atomically by JobService.FinalizeJob.
+type JobResult struct {
+	Status model.JobStatus
+	Error  string
+	// Fatal marks a failure with a non-transient error, e.g. an authentication failure or a missing path,
+	// which fails every run until the configuration is fixed.
+	Fatal            bool
+	FilesTransferred int64
+	BytesTransferred int64
+	FilesDeleted     int64
+	ErrorCount       int64
+	// FilesChecked and ListingsDone count the files compared with the destination and the directory entries
+	// listed, the work of runs that transferred little.
+	FilesChecked int64
+	ListingsDone int64
+	// Per-direction breakdown of the transferred files and bytes
+	UploadedFiles   int64
+	UploadedBytes   int64
+	DownloadedFiles int64
+	DownloadedBytes int64
+	// Concurrency is the number of transfers in progress over the run of the job, nil if there were none.
+	Concurrency *model.JobConcurrency
+	// ConfigSnapshot is the effective sync configuration of the run, nil if the run didn't get to resolve it.
+	ConfigSnapshot *model.JobConfigSnapshot
+	// Logs are additional log entries persisted together with the result (e.g. the sync error).
+	Logs []*ent.JobLog
+	// DeleteJob removes the job and its logs instead of storing the result (e.g. empty jobs).
+	DeleteJob bool
+	// Renames are files renamed during the job, whose DELETE and transfer logs are merged into RENAME logs.
+	Renames []LogRename
+}
+
+// LogRename is a file rename detected in a job that was applied as a deletion of PreviousPath and a transfer of Path.
+type LogRename struct {
+	PreviousPath string
+	Path         string
+}
+
 // JobService defines the interface for job management operations.
 type JobService interface {
 	CreateJob(ctx context.Context, taskID uuid.UUID, trigger model.JobTrigger) (*ent.Job, error)
+	CreateChildJob(ctx context.Context, parentID, taskID uuid.UUID, trigger model.JobTrigger) (*ent.Job, error)
 	UpdateJobStatus(ctx context.Context, jobID uuid.UUID, status string, errStr string) (*ent.Job, error)
 	UpdateJobStats(ctx context.Context, jobID uuid.UUID, files, bytes, filesDeleted, errorCount int64) (*ent.Job, error)
 	AddJobLog(ctx context.Context, jobID uuid.UUID, level, what, path string, size int64) (*ent.JobLog, error)
 	AddJobLogsBatch(ctx context.Context, jobID uuid.UUID, logs []*ent.JobLog) error
 	GetJob(ctx context.Context, jobID uuid.UUID) (*ent.Job, error)
 	GetLastJobByTaskID(ctx context.Context, taskID uuid.UUID) (*ent.Job, error)
-	ListJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, limit, offset int) ([]*ent.Job, error)
-	CountJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID) (int, error)
+	ListJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, status string, limit, offset int) ([]*ent.Job, error)
+	CountJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, status string) (int, error)
 	GetJobWithLogs(ctx context.Context, jobID uuid.UUID) (*ent.Job, error)
 	ListJobLogs(ctx context.Context, connectionID *uuid.UUID, taskID *uuid.UUID, jobID *uuid.UUID, level string, limit, offset int) ([]*ent.JobLog, error)
 	CountJobLogs(ctx context.Context, connectionID *uuid.UUID, taskID *uuid.UUID, jobID *uuid.UUID, level string) (int, error)
 	DeleteJob(ctx context.Context, jobID uuid.UUID) error
+	FinalizeJob(ctx context.Context, jobID uuid.UUID, result JobResult) (*ent.Job, error)
+	AddRetryQueueItems(ctx context.Context, jobID uuid.UUID, items []*ent.RetryQueue) error
+	ClaimRetryQueue(ctx context.Context, taskID, jobID uuid.UUID) ([]*ent.RetryQueue, error)
+	AddJobEvent(ctx context.Context, jobID uuid.UUID, event *ent.JobEvent) (*ent.JobEvent, error)
+	MonthlyTransfer(ctx context.Context, connectionID uuid.UUID, t time.Time) (int64, error)
+	TypicalDeletes(ctx context.Context, taskID uuid.UUID, n int) (int64, int, error)
+	RecordDeleteAnomaly(ctx context.Context, taskID uuid.UUID, message string) error
 }
 
 // ConnectionService defines the interface for connection management operations.
 type ConnectionService interface {
-	CreateConnection(ctx context.Context, name, providerType string, config map[string]string) (*ent.Connection, error)
+	CreateConnection(ctx context.Context, name string, providerType model.ConnectionType, config map[string]string) (*ent.Connection, error)
 	ListConnections(ctx context.Context) ([]*ent.Connection, error)
 	ListConnectionNames(ctx context.Context) ([]string, error)
 	GetConnectionByName(ctx context.Context, name string) (*ent.Connection, error)
 	GetConnectionConfig(ctx context.Context, name string) (map[string]string, error)
-	UpdateConnection(ctx context.Context, id uuid.UUID, name, connType *string, config map[string]string) error
+	UpdateConnection(ctx context.Context, id uuid.UUID, name *string, connType *model.ConnectionType, config map[string]string) error
 	DeleteConnectionByName(ctx context.Context, name string) error
 	HasAssociatedTasks(ctx context.Context, connectionID uuid.UUID) (bool, error)
 }
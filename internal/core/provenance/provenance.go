@@ -0,0 +1,62 @@
+// Package provenance carries what triggered a job run through contexts, from the
+// scheduler, watcher or API request that started it down to the job it creates.
+package provenance
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+type (
+	triggerDetailKey struct{}
+	userKey          struct{}
+	jobKey           struct{}
+)
+
+// WithTriggerDetail returns a context carrying the trigger detail of the run it starts.
+// It returns ctx unchanged if detail is nil.
+func WithTriggerDetail(ctx context.Context, detail *model.JobTriggerDetail) context.Context {
+	if detail == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, triggerDetailKey{}, detail)
+}
+
+// TriggerDetail returns the trigger detail carried by the context, or nil if it has none.
+func TriggerDetail(ctx context.Context) *model.JobTriggerDetail {
+	detail, _ := ctx.Value(triggerDetailKey{}).(*model.JobTriggerDetail)
+	return detail
+}
+
+// WithUser returns a context carrying the name of the authenticated user of a request.
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// User returns the name of the authenticated user carried by the context, or nil if it has none.
+func User(ctx context.Context) *string {
+	if user, _ := ctx.Value(userKey{}).(string); user != "" {
+		return &user
+	}
+	return nil
+}
+
+// WithJob returns a context carrying the ID of a job created ahead of the run it starts, e.g. together
+// with its task, which the run takes over instead of creating a job. It returns ctx unchanged if jobID is nil.
+func WithJob(ctx context.Context, jobID *uuid.UUID) context.Context {
+	if jobID == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, jobKey{}, *jobID)
+}
+
+// Job returns the ID of the job created ahead of the run carried by the context, or nil if it has none.
+func Job(ctx context.Context) *uuid.UUID {
+	if jobID, ok := ctx.Value(jobKey{}).(uuid.UUID); ok {
+		return &jobID
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package provenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+func TestTriggerDetail(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, TriggerDetail(ctx))
+	assert.Equal(t, ctx, WithTriggerDetail(ctx, nil), "a nil detail leaves the context unchanged")
+
+	schedule := "*/5 * * * *"
+	detail := &model.JobTriggerDetail{Schedule: &schedule}
+	assert.Same(t, detail, TriggerDetail(WithTriggerDetail(ctx, detail)))
+}
+
+func TestUser(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, User(ctx))
+	assert.Nil(t, User(WithUser(ctx, "")))
+
+	user := User(WithUser(ctx, "admin"))
+	require.NotNil(t, user)
+	assert.Equal(t, "admin", *user)
+}
+
+func TestJob(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, Job(ctx))
+	assert.Equal(t, ctx, WithJob(ctx, nil), "a nil job leaves the context unchanged")
+
+	jobID := uuid.New()
+	got := Job(WithJob(ctx, &jobID))
+	require.NotNil(t, got)
+	assert.Equal(t, jobID, *got)
+}
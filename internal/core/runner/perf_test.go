@@ -15,6 +15,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/runner"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // MockSyncEngineForPerf is a performance-optimized mock for SyncEngine
@@ -30,6 +31,7 @@ func (m *MockSyncEngineForPerf) RunTask(ctx context.Context, task *ent.Task, tri
 func setupPerfTest(t testing.TB) {
 	t.Helper()
 	logger.InitLogger(logger.EnvironmentDevelopment, logger.LogLevelDebug, nil)
+	_ = i18n.Init()
 }
 
 func BenchmarkRunner_StartTask_Concurrent(b *testing.B) {
@@ -55,7 +57,7 @@ func BenchmarkRunner_StartTask_Concurrent(b *testing.B) {
 			}
 
 			// Start task
-			err := r.StartTask(task, "manual")
+			err := r.StartTask(context.Background(), task, "manual")
 			require.NoError(b, err)
 		}
 	})
@@ -80,7 +82,7 @@ func BenchmarkRunner_StopTask_Concurrent(b *testing.B) {
 			ID: uuid.New(),
 		}
 
-		err := r.StartTask(task, "manual")
+		err := r.StartTask(context.Background(), task, "manual")
 		require.NoError(b, err)
 		tasks = append(tasks, task)
 	}
@@ -117,7 +119,7 @@ func BenchmarkRunner_IsRunning_Concurrent(b *testing.B) {
 		ID: uuid.New(),
 	}
 
-	err := r.StartTask(task, "manual")
+	err := r.StartTask(context.Background(), task, "manual")
 	require.NoError(b, err)
 
 	b.ResetTimer()
@@ -149,7 +151,7 @@ func BenchmarkRunner_MixedOperations_Concurrent(b *testing.B) {
 			ID: uuid.New(),
 		}
 
-		err := r.StartTask(task, "manual")
+		err := r.StartTask(context.Background(), task, "manual")
 		require.NoError(b, err)
 		tasks = append(tasks, task)
 	}
@@ -166,7 +168,7 @@ func BenchmarkRunner_MixedOperations_Concurrent(b *testing.B) {
 				task := &ent.Task{
 					ID: uuid.New(),
 				}
-				r.StartTask(task, "manual")
+				r.StartTask(context.Background(), task, "manual")
 			case 1:
 				// Check if task is running
 				taskIdx := b.N % len(tasks)
@@ -214,7 +216,7 @@ func TestRunner_HighConcurrency(t *testing.T) {
 				ID: uuid.New(),
 			}
 
-			err := r.StartTask(task, "manual")
+			err := r.StartTask(context.Background(), task, "manual")
 			results <- err
 		}(i)
 	}
@@ -265,7 +267,7 @@ func TestRunner_MemoryUsage(t *testing.T) {
 			ID: uuid.New(),
 		}
 
-		err := r.StartTask(task, "manual")
+		err := r.StartTask(context.Background(), task, "manual")
 		require.NoError(t, err)
 	}
 
@@ -3,16 +3,50 @@ package runner
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/tracing"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+// maxTimeoutContinuations limits how many continuation runs are started in a row for a task
+// whose runs keep timing out, so a permanently stuck remote doesn't keep a task running forever.
+const maxTimeoutContinuations = 3
+
+// maxStallCheckInterval bounds how often the stall watchdog checks running tasks.
+const maxStallCheckInterval = time.Minute
+
+// StallOptions configures detection of runs that stopped making progress.
+type StallOptions struct {
+	// Timeout is how long a run may go without any progress before it is considered stalled. Zero disables detection.
+	Timeout time.Duration
+	// AutoCancel cancels stalled runs, freeing their connection slot, instead of only warning about them.
+	AutoCancel bool
+}
+
+// stallState tracks the progress of a single run for the stall watchdog.
+type stallState struct {
+	jobID        uuid.UUID
+	lastProgress time.Time
+	warned       bool
+	waiting      bool // The job waits for confirmation of its deletions and can't make progress
+}
+
 type runInfo struct {
 	cancel context.CancelFunc
 	runID  uuid.UUID
@@ -21,29 +55,104 @@ type runInfo struct {
 
 // Runner manages the execution of sync tasks.
 type Runner struct {
-	syncEngine ports.SyncEngine
-	logger     *zap.Logger
-	mu         sync.Mutex
-	running    map[uuid.UUID]runInfo
-	wg         sync.WaitGroup
+	// engines holds the registered sync engines by name, see RegisterEngine
+	engines map[string]ports.SyncEngine
+	logger  *zap.Logger
+	tracer  trace.Tracer
+	mu      sync.Mutex
+	running map[uuid.UUID]runInfo
+	wg      sync.WaitGroup
+	// maintenance rejects new task executions while set
+	maintenance bool
+	// stopped prevents continuation runs from being started during shutdown
+	stopped bool
+	// continuations counts consecutive timeout continuation runs per task
+	continuations map[uuid.UUID]int
+
+	// stall detection, see EnableStallDetection
+	stallOpts      StallOptions
+	jobProgressBus *subscription.JobProgressBus
+	jobService     ports.JobService
+	stalls         map[uuid.UUID]*stallState
+	stopWatchdog   chan struct{}
+	watchdogDone   chan struct{}
 }
 
 // NewRunner creates a new Runner instance.
+// syncEngine is registered as ports.DefaultSyncEngine, further engines can be added with RegisterEngine.
 func NewRunner(syncEngine ports.SyncEngine) *Runner {
 	return &Runner{
-		syncEngine: syncEngine,
-		logger:     logger.Named("core.runner"),
-		running:    make(map[uuid.UUID]runInfo),
+		engines:       map[string]ports.SyncEngine{ports.DefaultSyncEngine: syncEngine},
+		logger:        logger.Named("core.runner"),
+		tracer:        tracing.Tracer("core.runner"),
+		running:       make(map[uuid.UUID]runInfo),
+		continuations: make(map[uuid.UUID]int),
+		stalls:        make(map[uuid.UUID]*stallState),
 	}
 }
 
-// Start initializes the runner (no-op currently, reserved for future use).
-func (r *Runner) Start() {}
+// RegisterEngine makes engine available to tasks whose engine field is name.
+// Registering an existing name replaces its engine. It must be called before Start.
+func (r *Runner) RegisterEngine(name string, engine ports.SyncEngine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.engines[name] = engine
+}
+
+// Engines returns the names of the registered engines in sorted order.
+func (r *Runner) Engines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.engines))
+	for name := range r.engines {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// engineFor returns the engine that runs task, falling back to the default engine for tasks without one.
+// The caller must hold r.mu.
+func (r *Runner) engineFor(task *ent.Task) (ports.SyncEngine, error) {
+	name := task.Engine
+	if name == "" {
+		name = ports.DefaultSyncEngine
+	}
+	engine, ok := r.engines[name]
+	if !ok {
+		return nil, i18n.NewI18nErrorWithData(i18n.ErrEngineNotFound, map[string]interface{}{"Engine": name})
+	}
+	return engine, nil
+}
+
+// EnableStallDetection makes the runner watch running tasks for progress using the job progress events
+// published by the sync engine. A run without progress for opts.Timeout gets a warning in its job log and,
+// with opts.AutoCancel, is cancelled. It must be called before Start.
+func (r *Runner) EnableStallDetection(bus *subscription.JobProgressBus, jobService ports.JobService, opts StallOptions) {
+	r.jobProgressBus = bus
+	r.jobService = jobService
+	r.stallOpts = opts
+}
+
+// Start starts the stall watchdog if stall detection is enabled.
+func (r *Runner) Start() {
+	if r.jobProgressBus == nil || r.stallOpts.Timeout <= 0 {
+		return
+	}
+	r.stopWatchdog = make(chan struct{})
+	r.watchdogDone = make(chan struct{})
+	sub := r.jobProgressBus.Subscribe(nil)
+	go r.watchStalls(sub)
+	r.logger.Info("Stall detection enabled",
+		zap.Duration("timeout", r.stallOpts.Timeout),
+		zap.Bool("auto_cancel", r.stallOpts.AutoCancel))
+}
 
 // Stop cancels all running tasks and waits for them to finish.
 func (r *Runner) Stop() {
 	r.logger.Info("Stopping runner, cancelling all tasks...")
 	r.mu.Lock()
+	r.stopped = true
 	for id, info := range r.running {
 		r.logger.Info("Cancelling task", zap.Stringer("task_id", id))
 		info.cancel()
@@ -51,6 +160,12 @@ func (r *Runner) Stop() {
 	// We don't clear r.running here immediately, let the goroutines cleanup
 	r.mu.Unlock()
 
+	if r.stopWatchdog != nil {
+		close(r.stopWatchdog)
+		<-r.watchdogDone
+		r.stopWatchdog = nil
+	}
+
 	r.logger.Info("Waiting for running tasks to finish...")
 	r.wg.Wait()
 	r.logger.Info("Runner stopped")
@@ -59,11 +174,36 @@ func (r *Runner) Stop() {
 // StartTask starts a task execution asynchronously.
 // For Realtime triggers, it skips if the task is already running to avoid interrupting ongoing syncs.
 // For Manual and Scheduled triggers, it cancels any existing execution before starting a new one.
-func (r *Runner) StartTask(task *ent.Task, trigger model.JobTrigger) error {
+// Schedule and Realtime triggers of disabled tasks are skipped.
+// The execution continues the trace of ctx, but is not cancelled with it.
+func (r *Runner) StartTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
 	taskID := task.ID
 	runID := uuid.New()
 
+	// Disabled tasks are only run on demand
+	if task.Disabled && (trigger == model.JobTriggerSchedule || trigger == model.JobTriggerRealtime) {
+		r.logger.Info("Task is disabled, skipping automatic run",
+			zap.Stringer("task_id", taskID),
+			zap.Stringer("trigger", trigger))
+		return nil
+	}
+
 	r.mu.Lock()
+	if r.maintenance {
+		r.mu.Unlock()
+		r.logger.Info("Maintenance mode enabled, rejecting task execution",
+			zap.Stringer("task_id", taskID),
+			zap.Stringer("trigger", trigger))
+		return i18n.NewI18nError(i18n.ErrMaintenanceMode)
+	}
+	engine, err := r.engineFor(task)
+	if err != nil {
+		r.mu.Unlock()
+		r.logger.Warn("No engine registered for task, rejecting task execution",
+			zap.Stringer("task_id", taskID),
+			zap.String("engine", task.Engine))
+		return err
+	}
 	// Check if task is already running
 	if info, ok := r.running[taskID]; ok {
 		// For Realtime triggers, skip if task is already running
@@ -85,39 +225,226 @@ func (r *Runner) StartTask(task *ent.Task, trigger model.JobTrigger) error {
 		delete(r.running, taskID)
 	}
 
-	// Create new context for this run
-	ctx, cancel := context.WithCancel(context.Background())
+	// Create new context for this run, keeping what triggered it and the job created ahead of it
+	detail := provenance.TriggerDetail(ctx)
+	ctx, cancel := context.WithCancel(provenance.WithJob(provenance.WithTriggerDetail(tracing.Detach(ctx), detail), provenance.Job(ctx)))
 	done := make(chan struct{})
 	r.running[taskID] = runInfo{
 		cancel: cancel,
 		runID:  runID,
 		done:   done,
 	}
+	r.stalls[taskID] = &stallState{lastProgress: time.Now()}
 	r.mu.Unlock()
 
 	// Run asynchronously
 	r.wg.Go(func() {
+		timedOut := false
 		defer func() {
 			close(done)
 			r.mu.Lock()
 			// Only delete if it's still the SAME execution
 			if info, ok := r.running[taskID]; ok && info.runID == runID {
 				delete(r.running, taskID)
+				delete(r.stalls, taskID)
 			}
 			r.mu.Unlock()
+			// Start the continuation only after this run is removed, so StartTask doesn't wait for itself
+			r.handleTimeout(task, trigger, detail, timedOut)
 		}()
 
+		ctx, span := r.tracer.Start(ctx, "Runner.RunTask", trace.WithAttributes(
+			attribute.String("task.id", taskID.String()),
+			attribute.String("task.engine", task.Engine),
+			attribute.Stringer("job.trigger", trigger),
+		))
+		defer span.End()
+
 		r.logger.Info("Starting task execution", zap.Stringer("task_id", taskID), zap.Stringer("run_id", runID), zap.Stringer("trigger", trigger))
 		// The error is already handled and logged within RunTask (e.g., job status updated).
 		// We don't need to log it again here.
-		err := r.syncEngine.RunTask(ctx, task, trigger)
+		err := engine.RunTask(ctx, task, trigger)
+		tracing.RecordError(span, err)
 		if err != nil {
 			r.logger.Error("Task execution failed", zap.Stringer("task_id", taskID), zap.Stringer("run_id", runID), zap.Error(err))
 		}
+		timedOut = errors.Is(err, errs.ErrTimeout)
 	})
 	return nil
 }
 
+// handleTimeout starts a continuation run after a run exceeded the task's max duration,
+// if the task has continueOnTimeout enabled. Consecutive continuations are limited to maxTimeoutContinuations.
+// The continuation keeps the trigger detail of the timed out run, with its continuation count set.
+func (r *Runner) handleTimeout(task *ent.Task, trigger model.JobTrigger, detail *model.JobTriggerDetail, timedOut bool) {
+	r.mu.Lock()
+	if !timedOut || task.Options == nil || task.Options.ContinueOnTimeout == nil || !*task.Options.ContinueOnTimeout {
+		delete(r.continuations, task.ID)
+		r.mu.Unlock()
+		return
+	}
+	if r.stopped {
+		r.mu.Unlock()
+		return
+	}
+	if r.continuations[task.ID] >= maxTimeoutContinuations {
+		delete(r.continuations, task.ID)
+		r.mu.Unlock()
+		r.logger.Warn("Task keeps timing out, not starting another continuation run",
+			zap.Stringer("task_id", task.ID),
+			zap.Int("continuations", maxTimeoutContinuations))
+		return
+	}
+	r.continuations[task.ID]++
+	attempt := r.continuations[task.ID]
+	r.mu.Unlock()
+
+	r.logger.Info("Starting continuation run after timeout", zap.Stringer("task_id", task.ID), zap.Int("attempt", attempt))
+	continued := model.JobTriggerDetail{}
+	if detail != nil {
+		continued = *detail
+	}
+	continued.Continuation = &attempt
+	// A continuation starts a new trace, the run it continues has already ended
+	if err := r.StartTask(provenance.WithTriggerDetail(context.Background(), &continued), task, trigger); err != nil {
+		r.logger.Warn("Failed to start continuation run", zap.Stringer("task_id", task.ID), zap.Error(err))
+	}
+}
+
+// ResumeInterrupted starts a catch-up run for the tasks of jobs interrupted by a crash or unexpected shutdown,
+// as returned by JobService.ResetStuckJobs, if the task has resumeAfterCrash enabled. Deleted and ephemeral tasks
+// are not resumed. The catch-up run keeps the trigger and trigger detail of the interrupted job, with the job recorded
+// as resumed; a failed file retry is resumed as a manual run. It returns the IDs of the tasks that were started.
+func (r *Runner) ResumeInterrupted(ctx context.Context, taskService ports.TaskService, jobs []*ent.Job) []uuid.UUID {
+	var started []uuid.UUID
+	for _, j := range jobs {
+		// Shard jobs are resumed through their parent
+		if j.ParentID != nil || slices.Contains(started, j.TaskID) {
+			continue
+		}
+		task, err := taskService.GetTaskWithConnection(ctx, j.TaskID)
+		if err != nil {
+			if !errors.Is(err, errs.ErrNotFound) {
+				r.logger.Warn("Failed to load interrupted task", zap.Stringer("task_id", j.TaskID), zap.Error(err))
+			}
+			continue
+		}
+		if task.Ephemeral || task.Options == nil || task.Options.ResumeAfterCrash == nil || !*task.Options.ResumeAfterCrash {
+			continue
+		}
+
+		resumed := model.JobTriggerDetail{}
+		if j.TriggerDetail != nil {
+			resumed = *j.TriggerDetail
+		}
+		resumed.Continuation = nil
+		resumed.ResumedJobID = &j.ID
+		trigger := j.Trigger
+		if trigger == model.JobTriggerRetry {
+			trigger = model.JobTriggerManual
+			resumed.SourceJobID = nil
+		}
+
+		r.logger.Info("Resuming task interrupted by a crash",
+			zap.Stringer("task_id", task.ID),
+			zap.Stringer("job_id", j.ID),
+			zap.String("trigger", string(trigger)))
+		if err := r.StartTask(provenance.WithTriggerDetail(ctx, &resumed), task, trigger); err != nil {
+			r.logger.Warn("Failed to resume interrupted task", zap.Stringer("task_id", task.ID), zap.Error(err))
+			continue
+		}
+		started = append(started, task.ID)
+	}
+	return started
+}
+
+// watchStalls records progress of running tasks from sub and periodically checks them for stalls
+// until the runner is stopped.
+func (r *Runner) watchStalls(sub *subscription.JobProgressSubscriber) {
+	defer close(r.watchdogDone)
+	defer r.jobProgressBus.Unsubscribe(sub.ID)
+
+	ticker := time.NewTicker(min(r.stallOpts.Timeout/4, maxStallCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopWatchdog:
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+			r.recordProgress(event)
+		case <-ticker.C:
+			r.checkStalls(time.Now())
+		}
+	}
+}
+
+// recordProgress resets the stall timer of the event's task.
+// The sync engine only publishes an event when the job's stats changed, so every event is progress.
+// Runs waiting for confirmation are not watched until they continue.
+func (r *Runner) recordProgress(event *model.JobProgressEvent) {
+	if event.Status != model.JobStatusRunning && event.Status != model.JobStatusWaitingConfirmation {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if st, ok := r.stalls[event.TaskID]; ok {
+		st.jobID = event.JobID
+		st.lastProgress = time.Now()
+		st.warned = false
+		st.waiting = event.Status == model.JobStatusWaitingConfirmation
+	}
+}
+
+// checkStalls warns about, and with AutoCancel cancels, runs without progress since before now minus the stall timeout.
+// Each stall is reported once until the run makes progress again.
+func (r *Runner) checkStalls(now time.Time) {
+	type stalled struct {
+		taskID uuid.UUID
+		jobID  uuid.UUID
+		since  time.Time
+		cancel context.CancelFunc
+	}
+
+	var found []stalled
+	r.mu.Lock()
+	for taskID, st := range r.stalls {
+		info, ok := r.running[taskID]
+		if !ok || st.warned || st.waiting || now.Sub(st.lastProgress) < r.stallOpts.Timeout {
+			continue
+		}
+		st.warned = true
+		found = append(found, stalled{taskID: taskID, jobID: st.jobID, since: st.lastProgress, cancel: info.cancel})
+	}
+	r.mu.Unlock()
+
+	for _, s := range found {
+		r.logger.Warn("Task made no progress, it may be stuck",
+			zap.Stringer("task_id", s.taskID),
+			zap.Stringer("job_id", s.jobID),
+			zap.Duration("idle", now.Sub(s.since)),
+			zap.Bool("auto_cancel", r.stallOpts.AutoCancel))
+
+		if r.jobService != nil && s.jobID != uuid.Nil {
+			msg := fmt.Sprintf("no progress for %s, the job may be stuck", r.stallOpts.Timeout)
+			if r.stallOpts.AutoCancel {
+				msg = fmt.Sprintf("no progress for %s, cancelling stuck job", r.stallOpts.Timeout)
+			}
+			if _, err := r.jobService.AddJobLog(context.Background(), s.jobID,
+				string(model.LogLevelWarning), string(model.LogActionUnknown), msg, 0); err != nil {
+				r.logger.Error("Failed to add stall warning to job log", zap.Stringer("job_id", s.jobID), zap.Error(err))
+			}
+		}
+
+		if r.stallOpts.AutoCancel {
+			s.cancel()
+		}
+	}
+}
+
 // StopTask cancels a running task.
 func (r *Runner) StopTask(taskID uuid.UUID) error {
 	r.mu.Lock()
@@ -128,10 +455,45 @@ func (r *Runner) StopTask(taskID uuid.UUID) error {
 		info.cancel()
 		<-info.done
 		delete(r.running, taskID)
+		delete(r.stalls, taskID)
 	}
 	return nil
 }
 
+// StopTasks cancels the running tasks among taskIDs and waits for all of them to finish.
+// The tasks are cancelled together, so a slow task doesn't hold up cancelling the others.
+// It returns the IDs of the tasks that were running.
+func (r *Runner) StopTasks(taskIDs []uuid.UUID) []uuid.UUID {
+	r.mu.Lock()
+	var stopped []uuid.UUID
+	var pending []runInfo
+	for _, id := range taskIDs {
+		info, ok := r.running[id]
+		if !ok {
+			continue
+		}
+		r.logger.Info("Stopping task", zap.Stringer("task_id", id))
+		info.cancel()
+		stopped = append(stopped, id)
+		pending = append(pending, info)
+	}
+	r.mu.Unlock()
+
+	// Wait outside the lock, then remove the runs like StopTask does, unless a new run replaced them meanwhile
+	for _, info := range pending {
+		<-info.done
+	}
+	r.mu.Lock()
+	for i, id := range stopped {
+		if info, ok := r.running[id]; ok && info.runID == pending[i].runID {
+			delete(r.running, id)
+			delete(r.stalls, id)
+		}
+	}
+	r.mu.Unlock()
+	return stopped
+}
+
 // IsRunning checks if a task is currently running.
 func (r *Runner) IsRunning(taskID uuid.UUID) bool {
 	r.mu.Lock()
@@ -140,4 +502,42 @@ func (r *Runner) IsRunning(taskID uuid.UUID) bool {
 	return ok
 }
 
+// SetMaintenance enables or disables maintenance mode.
+// While enabled, StartTask rejects all new executions. Running tasks are left to finish
+// unless cancelRunning is true, in which case they are cancelled and waited for.
+func (r *Runner) SetMaintenance(enabled bool, cancelRunning bool) {
+	r.mu.Lock()
+	r.maintenance = enabled
+	var pending []runInfo
+	if enabled && cancelRunning {
+		for id, info := range r.running {
+			r.logger.Info("Cancelling task for maintenance", zap.Stringer("task_id", id))
+			info.cancel()
+			pending = append(pending, info)
+		}
+	}
+	r.mu.Unlock()
+
+	r.logger.Info("Maintenance mode changed", zap.Bool("enabled", enabled), zap.Bool("cancel_running", cancelRunning))
+
+	// Wait outside the lock; the task goroutines remove themselves from r.running
+	for _, info := range pending {
+		<-info.done
+	}
+}
+
+// IsMaintenance reports whether maintenance mode is enabled.
+func (r *Runner) IsMaintenance() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.maintenance
+}
+
+// RunningCount returns the number of tasks currently running.
+func (r *Runner) RunningCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.running)
+}
+
 var _ ports.Runner = (*Runner)(nil)
@@ -20,6 +20,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/runner"
 	"github.com/xzzpig/rclone-sync/internal/core/services"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 	"github.com/xzzpig/rclone-sync/internal/rclone/testutil"
 )
@@ -61,6 +62,7 @@ func setupIntegrationTest(t *testing.T, opts ...setupOption) *testContext {
 
 	// Initialize logger
 	logger.InitLogger(logger.EnvironmentDevelopment, logger.LogLevelDebug, nil)
+	_ = i18n.Init()
 
 	// Use in-memory sqlite for testing with db.InitDB
 	dsn := db.InMemoryDSN()
@@ -148,12 +150,12 @@ func createSlowTask(t *testing.T, tc *testContext, name, sourceDir, destDir stri
 	t.Helper()
 	ctx := context.Background()
 
-	// Create slowfs connection
+	// Create slowfs connection, slowfs is registered by the tests only and is reached through an alias of an on-the-fly remote
 	conn, err := tc.connService.GetConnectionByName(ctx, "slowlocal")
 	if err != nil {
-		conn, err = tc.connService.CreateConnection(ctx, "slowlocal", "slowfs", map[string]string{
-			"type":   "slowfs",
-			"remote": "/",
+		conn, err = tc.connService.CreateConnection(ctx, "slowlocal", model.ConnectionTypeAlias, map[string]string{
+			"type":   "alias",
+			"remote": ":slowfs,remote=/:",
 		})
 		require.NoError(t, err)
 	}
@@ -210,7 +212,7 @@ func TestRunner_Integration_BasicSyncFlow(t *testing.T) {
 	task := createTestTask(t, tc, "BasicSyncTest", sourceDir, destDir)
 
 	// Start task via Runner
-	err = tc.runner.StartTask(task, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 	require.NoError(t, err)
 	assert.True(t, tc.runner.IsRunning(task.ID), "Task should be running after StartTask")
 
@@ -226,7 +228,7 @@ func TestRunner_Integration_BasicSyncFlow(t *testing.T) {
 	assert.Equal(t, "hello world", string(content))
 
 	// Verify job record
-	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1, "Should have one job")
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs[0].Status), "Job should be successful")
@@ -253,13 +255,13 @@ func TestRunner_Integration_MultipleTasks(t *testing.T) {
 	task2 := createTestTask(t, tc, "MultiTask2", sourceDir2, destDir2)
 
 	// Start first task and wait for completion
-	err = tc.runner.StartTask(task1, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task1, model.JobTriggerManual)
 	require.NoError(t, err)
 	completed1 := waitForTaskCompletion(t, tc.runner, task1, 10*time.Second)
 	assert.True(t, completed1, "Task1 should complete")
 
 	// Start second task and wait for completion
-	err = tc.runner.StartTask(task2, model.JobTriggerSchedule)
+	err = tc.runner.StartTask(context.Background(), task2, model.JobTriggerSchedule)
 	require.NoError(t, err)
 	completed2 := waitForTaskCompletion(t, tc.runner, task2, 10*time.Second)
 	assert.True(t, completed2, "Task2 should complete")
@@ -274,12 +276,12 @@ func TestRunner_Integration_MultipleTasks(t *testing.T) {
 	assert.Equal(t, "task2", string(content2))
 
 	// Verify job records
-	jobs1, err := tc.jobService.ListJobs(context.Background(), &task1.ID, nil, 10, 0)
+	jobs1, err := tc.jobService.ListJobs(context.Background(), &task1.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs1, 1)
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs1[0].Status))
 
-	jobs2, err := tc.jobService.ListJobs(context.Background(), &task2.ID, nil, 10, 0)
+	jobs2, err := tc.jobService.ListJobs(context.Background(), &task2.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs2, 1)
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs2[0].Status))
@@ -310,7 +312,7 @@ func TestRunner_Cancel_StopRunningTaskMarksCancelled(t *testing.T) {
 
 	// Start task
 	t.Log("Starting task...")
-	err = tc.runner.StartTask(task, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 	require.NoError(t, err)
 
 	// Wait for the task to start
@@ -326,7 +328,7 @@ func TestRunner_Cancel_StopRunningTaskMarksCancelled(t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 
 	// Check that the job is running
-	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	require.Len(t, jobs, 1)
 	jobID := jobs[0].ID
@@ -341,7 +343,7 @@ func TestRunner_Cancel_StopRunningTaskMarksCancelled(t *testing.T) {
 	time.Sleep(500 * time.Millisecond)
 
 	// Check job status
-	jobs, err = tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+	jobs, err = tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	require.Len(t, jobs, 1)
 	assert.Equal(t, jobID, jobs[0].ID)
@@ -371,9 +373,9 @@ func TestRunner_Integration_StopCancelsAllTasks(t *testing.T) {
 	task2 := createTestTask(t, tc, "StopAllTask2", sourceDir2, destDir2)
 
 	// Start both tasks
-	err = tc.runner.StartTask(task1, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task1, model.JobTriggerManual)
 	require.NoError(t, err)
-	err = tc.runner.StartTask(task2, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task2, model.JobTriggerManual)
 	require.NoError(t, err)
 
 	// Give them a moment to start
@@ -414,7 +416,7 @@ func TestRunner_Integration_TaskExecutionError(t *testing.T) {
 	task := createTestTask(t, tc, "ErrorTest", sourceDir, destDir)
 
 	// Start task
-	err := tc.runner.StartTask(task, model.JobTriggerManual)
+	err := tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 	require.NoError(t, err)
 
 	// Wait for completion (should fail quickly)
@@ -426,7 +428,7 @@ func TestRunner_Integration_TaskExecutionError(t *testing.T) {
 	time.Sleep(200 * time.Millisecond)
 
 	// Verify job has failed status
-	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1, "Should have one job")
 	assert.Equal(t, string(model.JobStatusFailed), string(jobs[0].Status), "Job should have failed status")
@@ -455,7 +457,7 @@ func TestRunner_Integration_ConcurrentStartStop(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			_ = tc.runner.StartTask(task, model.JobTriggerManual)
+			_ = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 		}()
 	}
 
@@ -479,7 +481,7 @@ func TestRunner_Integration_ConcurrentStartStop(t *testing.T) {
 	_ = tc.runner.IsRunning(task.ID)
 
 	// Verify jobs were created (count may vary due to race conditions)
-	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 100, 0)
+	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 100, 0)
 	require.NoError(t, err)
 	t.Logf("Created %d jobs during concurrent operations", len(jobs))
 	assert.NotEmpty(t, jobs, "Should have created at least one job")
@@ -508,7 +510,7 @@ func TestRunner_Integration_DifferentTriggerTypes(t *testing.T) {
 			task := createTestTask(t, tc, "TriggerTest_"+string(trigger), sourceDir, destDir)
 
 			// Start task with specific trigger
-			err = tc.runner.StartTask(task, trigger)
+			err = tc.runner.StartTask(context.Background(), task, trigger)
 			require.NoError(t, err)
 
 			// Wait for completion
@@ -516,7 +518,7 @@ func TestRunner_Integration_DifferentTriggerTypes(t *testing.T) {
 			assert.True(t, completed, "Task should complete")
 
 			// Verify trigger type is recorded correctly
-			jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+			jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 			require.NoError(t, err)
 			assert.Len(t, jobs, 1)
 			assert.Equal(t, trigger, jobs[0].Trigger, "Trigger type should match")
@@ -538,7 +540,7 @@ func TestRunner_Integration_StopNonExistentTask(t *testing.T) {
 	task := createTestTask(t, tc, "NonExistentStopTest", sourceDir, destDir)
 
 	// Start and wait for completion
-	err = tc.runner.StartTask(task, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 	require.NoError(t, err)
 
 	completed := waitForTaskCompletion(t, tc.runner, task, 10*time.Second)
@@ -606,7 +608,7 @@ func TestRunner_Integration_TriggerBehaviorWhenRunning(t *testing.T) {
 			task := createSlowTask(t, tc, "TriggerTest_"+tt.name, sourceDir, destDir)
 
 			// Start first task with Manual trigger
-			err = tc.runner.StartTask(task, model.JobTriggerManual)
+			err = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 			require.NoError(t, err)
 
 			// Wait for the first task to start
@@ -618,19 +620,19 @@ func TestRunner_Integration_TriggerBehaviorWhenRunning(t *testing.T) {
 			time.Sleep(100 * time.Millisecond)
 
 			// Check first job is running
-			jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+			jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 			require.NoError(t, err)
 			require.Len(t, jobs, 1)
 			firstJobID := jobs[0].ID
 			assert.Equal(t, string(model.JobStatusRunning), string(jobs[0].Status))
 
 			// Trigger with second trigger type
-			err = tc.runner.StartTask(task, tt.secondTrigger)
+			err = tc.runner.StartTask(context.Background(), task, tt.secondTrigger)
 			require.NoError(t, err)
 			time.Sleep(500 * time.Millisecond)
 
 			// Check job statuses
-			jobs, err = tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+			jobs, err = tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 			require.NoError(t, err)
 
 			var firstJob *ent.Job
@@ -662,7 +664,7 @@ func TestRunner_Integration_TriggerBehaviorWhenRunning(t *testing.T) {
 
 			// Final check - should have at least one successful job
 			time.Sleep(200 * time.Millisecond)
-			jobs, err = tc.jobService.ListJobs(context.Background(), &task.ID, nil, 10, 0)
+			jobs, err = tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 10, 0)
 			require.NoError(t, err)
 			hasSuccess := false
 			for _, j := range jobs {
@@ -691,21 +693,21 @@ func TestRunner_Integration_RapidStartStop(t *testing.T) {
 
 	// Rapidly start and stop the task multiple times
 	for i := 0; i < 5; i++ {
-		err = tc.runner.StartTask(task, model.JobTriggerManual)
+		err = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 		assert.NoError(t, err)
 		err = tc.runner.StopTask(task.ID)
 		assert.NoError(t, err)
 	}
 
 	// Final start and let it complete
-	err = tc.runner.StartTask(task, model.JobTriggerManual)
+	err = tc.runner.StartTask(context.Background(), task, model.JobTriggerManual)
 	require.NoError(t, err)
 
 	completed := waitForTaskCompletion(t, tc.runner, task, 10*time.Second)
 	assert.True(t, completed, "Final task should complete")
 
 	// Verify jobs were created
-	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, 100, 0)
+	jobs, err := tc.jobService.ListJobs(context.Background(), &task.ID, nil, "", 100, 0)
 	require.NoError(t, err)
 	assert.NotEmpty(t, jobs, "Should have created jobs")
 	t.Logf("Created %d jobs during rapid start/stop sequence", len(jobs))
@@ -2,16 +2,24 @@ package runner_test
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
 	"github.com/xzzpig/rclone-sync/internal/core/runner"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // MockSyncEngine is a mock implementation of the SyncEngine interface.
@@ -33,6 +41,7 @@ func (m *MockSyncEngine) RunTask(ctx context.Context, task *ent.Task, trigger mo
 
 func setupTest() {
 	logger.InitLogger(logger.EnvironmentDevelopment, logger.LogLevelDebug, nil)
+	_ = i18n.Init()
 }
 
 func TestRunner_StartAndStopTask(t *testing.T) {
@@ -56,7 +65,7 @@ func TestRunner_StartAndStopTask(t *testing.T) {
 	})
 
 	// Start the task
-	err := r.StartTask(task, trigger)
+	err := r.StartTask(context.Background(), task, trigger)
 	assert.NoError(t, err)
 	assert.True(t, r.IsRunning(task.ID))
 
@@ -113,7 +122,7 @@ func TestRunner_Concurrency_StartTaskTwiceCancelsFirst(t *testing.T) {
 	}).Once()
 
 	// Start the task for the first time
-	err := r.StartTask(task, trigger1)
+	err := r.StartTask(context.Background(), task, trigger1)
 	assert.NoError(t, err)
 	assert.True(t, r.IsRunning(task.ID))
 	// Wait for the first goroutine to start
@@ -125,7 +134,7 @@ func TestRunner_Concurrency_StartTaskTwiceCancelsFirst(t *testing.T) {
 	}
 
 	// Start the task for the second time, which should cancel the first run
-	err = r.StartTask(task, trigger2)
+	err = r.StartTask(context.Background(), task, trigger2)
 	assert.NoError(t, err)
 	assert.True(t, r.IsRunning(task.ID))
 	// Wait for the second goroutine to start
@@ -168,6 +177,38 @@ func TestRunner_Concurrency_StartTaskTwiceCancelsFirst(t *testing.T) {
 	mockEngine.AssertExpectations(t)
 }
 
+func TestRunner_StopTasks(t *testing.T) {
+	setupTest()
+	mockEngine := new(MockSyncEngine)
+	r := runner.NewRunner(mockEngine)
+	trigger := model.JobTriggerManual
+
+	// Each task runs until it is cancelled
+	tasks := []*ent.Task{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}
+	for _, task := range tasks {
+		started := make(chan struct{})
+		mockEngine.On("RunTask", mock.Anything, task, trigger).Return(nil).Run(func(args mock.Arguments) {
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+		})
+		require.NoError(t, r.StartTask(context.Background(), task, trigger))
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("task goroutine failed to start within timeout")
+		}
+	}
+
+	stopped := r.StopTasks([]uuid.UUID{tasks[0].ID, tasks[1].ID, uuid.New()})
+	assert.ElementsMatch(t, []uuid.UUID{tasks[0].ID, tasks[1].ID}, stopped, "only running tasks are reported")
+	assert.False(t, r.IsRunning(tasks[0].ID))
+	assert.False(t, r.IsRunning(tasks[1].ID))
+	assert.True(t, r.IsRunning(tasks[2].ID), "other tasks keep running")
+
+	r.Stop()
+	assert.False(t, r.IsRunning(tasks[2].ID))
+}
+
 func TestRunner_Stop_CancelsAllTasks(t *testing.T) {
 	setupTest()
 	mockEngine := new(MockSyncEngine)
@@ -193,9 +234,9 @@ func TestRunner_Stop_CancelsAllTasks(t *testing.T) {
 	})
 
 	// Start tasks
-	err := r.StartTask(task1, trigger)
+	err := r.StartTask(context.Background(), task1, trigger)
 	assert.NoError(t, err)
-	err = r.StartTask(task2, trigger)
+	err = r.StartTask(context.Background(), task2, trigger)
 	assert.NoError(t, err)
 
 	// Wait for both goroutines to start and contexts to be captured
@@ -251,3 +292,418 @@ func TestRunner_Stop_CancelsAllTasks(t *testing.T) {
 
 	mockEngine.AssertExpectations(t)
 }
+
+func TestRunner_Maintenance(t *testing.T) {
+	setupTest()
+	mockEngine := new(MockSyncEngine)
+	r := runner.NewRunner(mockEngine)
+
+	task := &ent.Task{ID: uuid.New()}
+	trigger := model.JobTriggerManual
+
+	var taskCtx context.Context
+	started := make(chan struct{})
+	mockEngine.On("RunTask", mock.Anything, task, trigger).Return(nil).Run(func(args mock.Arguments) {
+		taskCtx = args.Get(0).(context.Context)
+		close(started)
+	}).Once()
+
+	assert.NoError(t, r.StartTask(context.Background(), task, trigger))
+	select {
+	case <-started:
+	case <-time.After(1 * time.Second):
+		t.Fatal("goroutine failed to start within timeout")
+	}
+	assert.Equal(t, 1, r.RunningCount())
+
+	// Enabling maintenance with cancelRunning cancels and waits for running tasks
+	r.SetMaintenance(true, true)
+	assert.True(t, r.IsMaintenance())
+	select {
+	case <-taskCtx.Done():
+	default:
+		t.Error("Task context should be canceled")
+	}
+	assert.Eventually(t, func() bool { return r.RunningCount() == 0 }, time.Second, 10*time.Millisecond)
+
+	// New executions are rejected while in maintenance mode
+	err := r.StartTask(context.Background(), task, trigger)
+	assert.Error(t, err)
+	assert.False(t, r.IsRunning(task.ID))
+
+	// Disabling maintenance allows executions again
+	mockEngine.On("RunTask", mock.Anything, task, trigger).Return(nil).Once()
+	r.SetMaintenance(false, false)
+	assert.False(t, r.IsMaintenance())
+	assert.NoError(t, r.StartTask(context.Background(), task, trigger))
+	r.Stop()
+	mockEngine.AssertExpectations(t)
+}
+
+func TestRunner_DisabledTask(t *testing.T) {
+	setupTest()
+	mockEngine := new(MockSyncEngine)
+	r := runner.NewRunner(mockEngine)
+
+	task := &ent.Task{ID: uuid.New(), Disabled: true}
+
+	// Schedules and watchers don't run disabled tasks
+	assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerSchedule))
+	assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerRealtime))
+	assert.False(t, r.IsRunning(task.ID))
+
+	// Manual runs still do, e.g. to check a fixed configuration
+	started := make(chan struct{})
+	mockEngine.On("RunTask", mock.Anything, task, model.JobTriggerManual).Return(nil).Run(func(args mock.Arguments) {
+		close(started)
+	}).Once()
+	assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerManual))
+	select {
+	case <-started:
+	case <-time.After(1 * time.Second):
+		t.Fatal("manual run of the disabled task was not started")
+	}
+
+	r.Stop()
+	mockEngine.AssertExpectations(t)
+}
+
+func TestRunner_Engines(t *testing.T) {
+	setupTest()
+	defaultEngine := new(MockSyncEngine)
+	mockEngine := new(MockSyncEngine)
+	r := runner.NewRunner(defaultEngine)
+	r.RegisterEngine("mock", mockEngine)
+
+	assert.Equal(t, []string{"mock", ports.DefaultSyncEngine}, r.Engines())
+
+	trigger := model.JobTriggerManual
+
+	// Tasks are dispatched to the engine named by their engine field
+	mockTask := &ent.Task{ID: uuid.New(), Engine: "mock"}
+	mockStarted := make(chan struct{})
+	mockEngine.On("RunTask", mock.Anything, mockTask, trigger).Return(nil).Run(func(args mock.Arguments) {
+		close(mockStarted)
+	}).Once()
+	assert.NoError(t, r.StartTask(context.Background(), mockTask, trigger))
+	select {
+	case <-mockStarted:
+	case <-time.After(1 * time.Second):
+		t.Fatal("mock engine was not called")
+	}
+
+	// Tasks without an engine use the default engine
+	defaultTask := &ent.Task{ID: uuid.New()}
+	defaultStarted := make(chan struct{})
+	defaultEngine.On("RunTask", mock.Anything, defaultTask, trigger).Return(nil).Run(func(args mock.Arguments) {
+		close(defaultStarted)
+	}).Once()
+	assert.NoError(t, r.StartTask(context.Background(), defaultTask, trigger))
+	select {
+	case <-defaultStarted:
+	case <-time.After(1 * time.Second):
+		t.Fatal("default engine was not called")
+	}
+
+	// Tasks with an unknown engine are rejected
+	unknownTask := &ent.Task{ID: uuid.New(), Engine: "unknown"}
+	err := r.StartTask(context.Background(), unknownTask, trigger)
+	assert.Error(t, err)
+	assert.False(t, r.IsRunning(unknownTask.ID))
+
+	r.Stop()
+	mockEngine.AssertExpectations(t)
+	defaultEngine.AssertExpectations(t)
+}
+
+func TestRunner_ContinueOnTimeout(t *testing.T) {
+	setupTest()
+
+	t.Run("continuation runs are limited", func(t *testing.T) {
+		mockEngine := new(MockSyncEngine)
+		r := runner.NewRunner(mockEngine)
+
+		continueOnTimeout := true
+		task := &ent.Task{ID: uuid.New(), Options: &model.TaskSyncOptions{ContinueOnTimeout: &continueOnTimeout}}
+		trigger := model.JobTriggerSchedule
+
+		// The initial run plus three continuations, all timing out
+		var runs atomic.Int32
+		mockEngine.On("RunTask", mock.Anything, task, trigger).Return(errs.ErrTimeout).Run(func(mock.Arguments) {
+			runs.Add(1)
+		}).Times(4)
+
+		assert.NoError(t, r.StartTask(context.Background(), task, trigger))
+		assert.Eventually(t, func() bool {
+			return runs.Load() == 4 && !r.IsRunning(task.ID)
+		}, 3*time.Second, 20*time.Millisecond)
+
+		// No further continuation is started
+		time.Sleep(300 * time.Millisecond)
+		assert.False(t, r.IsRunning(task.ID))
+		r.Stop()
+		mockEngine.AssertExpectations(t)
+	})
+
+	t.Run("continuation keeps the trigger detail", func(t *testing.T) {
+		mockEngine := new(MockSyncEngine)
+		r := runner.NewRunner(mockEngine)
+
+		continueOnTimeout := true
+		task := &ent.Task{ID: uuid.New(), Options: &model.TaskSyncOptions{ContinueOnTimeout: &continueOnTimeout}}
+		trigger := model.JobTriggerSchedule
+		schedule := "0 * * * *"
+
+		details := make(chan *model.JobTriggerDetail, 2)
+		record := func(args mock.Arguments) {
+			details <- provenance.TriggerDetail(args.Get(0).(context.Context))
+		}
+		mockEngine.On("RunTask", mock.Anything, task, trigger).Return(errs.ErrTimeout).Run(record).Once()
+		mockEngine.On("RunTask", mock.Anything, task, trigger).Return(nil).Run(record).Once()
+
+		ctx := provenance.WithTriggerDetail(context.Background(), &model.JobTriggerDetail{Schedule: &schedule})
+		assert.NoError(t, r.StartTask(ctx, task, trigger))
+
+		first, continued := <-details, <-details
+		require.NotNil(t, first)
+		assert.Equal(t, schedule, *first.Schedule)
+		assert.Nil(t, first.Continuation)
+		require.NotNil(t, continued)
+		assert.Equal(t, schedule, *continued.Schedule)
+		require.NotNil(t, continued.Continuation)
+		assert.Equal(t, 1, *continued.Continuation)
+
+		assert.Eventually(t, func() bool { return !r.IsRunning(task.ID) }, time.Second, 20*time.Millisecond)
+		r.Stop()
+		mockEngine.AssertExpectations(t)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		mockEngine := new(MockSyncEngine)
+		r := runner.NewRunner(mockEngine)
+
+		task := &ent.Task{ID: uuid.New()}
+		trigger := model.JobTriggerSchedule
+		mockEngine.On("RunTask", mock.Anything, task, trigger).Return(errs.ErrTimeout).Once()
+
+		assert.NoError(t, r.StartTask(context.Background(), task, trigger))
+		assert.Eventually(t, func() bool { return !r.IsRunning(task.ID) }, time.Second, 20*time.Millisecond)
+		time.Sleep(300 * time.Millisecond)
+		assert.False(t, r.IsRunning(task.ID))
+		r.Stop()
+		mockEngine.AssertExpectations(t)
+	})
+}
+
+// blockingSyncEngine runs until its context is cancelled, publishing a progress event for every value sent on progress.
+type blockingSyncEngine struct {
+	bus      *subscription.JobProgressBus
+	jobID    uuid.UUID
+	progress chan int64
+}
+
+func (e *blockingSyncEngine) RunTask(ctx context.Context, task *ent.Task, _ model.JobTrigger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case bytes := <-e.progress:
+			e.bus.Publish(&model.JobProgressEvent{
+				JobID:            e.jobID,
+				TaskID:           task.ID,
+				Status:           model.JobStatusRunning,
+				BytesTransferred: bytes,
+			})
+		}
+	}
+}
+
+// stallJobService records the job logs added by the stall watchdog.
+type stallJobService struct {
+	ports.JobService
+	mu   sync.Mutex
+	logs []string
+}
+
+func (s *stallJobService) AddJobLog(_ context.Context, _ uuid.UUID, level, _, path string, _ int64) (*ent.JobLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logs = append(s.logs, level+": "+path)
+	return &ent.JobLog{}, nil
+}
+
+func (s *stallJobService) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.logs)
+}
+
+func TestRunner_StallDetection(t *testing.T) {
+	setupTest()
+
+	newRunner := func(autoCancel bool) (*runner.Runner, *blockingSyncEngine, *stallJobService) {
+		bus := subscription.NewJobProgressBus()
+		engine := &blockingSyncEngine{bus: bus, jobID: uuid.New(), progress: make(chan int64)}
+		jobService := &stallJobService{}
+		r := runner.NewRunner(engine)
+		r.EnableStallDetection(bus, jobService, runner.StallOptions{Timeout: 200 * time.Millisecond, AutoCancel: autoCancel})
+		r.Start()
+		return r, engine, jobService
+	}
+
+	t.Run("warns once about a stalled run", func(t *testing.T) {
+		r, engine, jobService := newRunner(false)
+		task := &ent.Task{ID: uuid.New()}
+
+		assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerManual))
+		engine.progress <- 1
+
+		assert.Eventually(t, func() bool { return jobService.count() == 1 }, 2*time.Second, 10*time.Millisecond)
+		assert.Contains(t, jobService.logs[0], string(model.LogLevelWarning))
+		assert.Contains(t, jobService.logs[0], "may be stuck")
+
+		// The stall is reported only once and the run keeps going
+		time.Sleep(400 * time.Millisecond)
+		assert.Equal(t, 1, jobService.count())
+		assert.True(t, r.IsRunning(task.ID))
+
+		// Progress re-arms the watchdog
+		engine.progress <- 2
+		assert.Eventually(t, func() bool { return jobService.count() == 2 }, 2*time.Second, 10*time.Millisecond)
+		assert.True(t, r.IsRunning(task.ID))
+
+		r.Stop()
+	})
+
+	t.Run("progress keeps the run alive", func(t *testing.T) {
+		r, engine, jobService := newRunner(true)
+		task := &ent.Task{ID: uuid.New()}
+
+		assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerManual))
+		for i := range 8 {
+			engine.progress <- int64(i + 1)
+			time.Sleep(50 * time.Millisecond)
+		}
+		assert.Equal(t, 0, jobService.count())
+		assert.True(t, r.IsRunning(task.ID))
+
+		r.Stop()
+	})
+
+	t.Run("runs waiting for confirmation are not stalled", func(t *testing.T) {
+		r, engine, jobService := newRunner(true)
+		task := &ent.Task{ID: uuid.New()}
+
+		assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerManual))
+		engine.bus.Publish(&model.JobProgressEvent{JobID: engine.jobID, TaskID: task.ID, Status: model.JobStatusWaitingConfirmation})
+
+		time.Sleep(400 * time.Millisecond)
+		assert.Equal(t, 0, jobService.count())
+		assert.True(t, r.IsRunning(task.ID))
+
+		// The watchdog is re-armed once the run continues
+		engine.progress <- 1
+		assert.Eventually(t, func() bool { return !r.IsRunning(task.ID) }, 2*time.Second, 10*time.Millisecond)
+
+		r.Stop()
+	})
+
+	t.Run("auto cancel stops a stalled run", func(t *testing.T) {
+		r, engine, jobService := newRunner(true)
+		task := &ent.Task{ID: uuid.New()}
+
+		assert.NoError(t, r.StartTask(context.Background(), task, model.JobTriggerManual))
+		engine.progress <- 1
+
+		assert.Eventually(t, func() bool { return !r.IsRunning(task.ID) }, 2*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 1, jobService.count())
+		assert.Contains(t, jobService.logs[0], "cancelling")
+
+		r.Stop()
+	})
+}
+
+// fakeTaskService serves tasks from a map for the runner tests.
+type fakeTaskService struct {
+	tasks map[uuid.UUID]*ent.Task
+}
+
+func (f *fakeTaskService) GetTask(_ context.Context, id uuid.UUID) (*ent.Task, error) {
+	if t, ok := f.tasks[id]; ok {
+		return t, nil
+	}
+	return nil, errs.ErrNotFound
+}
+
+func (f *fakeTaskService) GetTaskWithConnection(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
+	return f.GetTask(ctx, id)
+}
+
+func (f *fakeTaskService) ListAllTasks(context.Context) ([]*ent.Task, error) {
+	return nil, nil
+}
+
+func (f *fakeTaskService) RecordSkippedRun(context.Context, uuid.UUID, string) error {
+	return nil
+}
+
+func (f *fakeTaskService) RecordWatchLimitReached(context.Context, uuid.UUID, string) error {
+	return nil
+}
+
+func TestRunner_ResumeInterrupted(t *testing.T) {
+	setupTest()
+	mockEngine := new(MockSyncEngine)
+	r := runner.NewRunner(mockEngine)
+
+	enabled, disabled := true, false
+	schedule := "0 * * * *"
+	resumable := &ent.Task{ID: uuid.New(), Options: &model.TaskSyncOptions{ResumeAfterCrash: &enabled}}
+	retried := &ent.Task{ID: uuid.New(), Options: &model.TaskSyncOptions{ResumeAfterCrash: &enabled}}
+	optedOut := &ent.Task{ID: uuid.New(), Options: &model.TaskSyncOptions{ResumeAfterCrash: &disabled}}
+	noOptions := &ent.Task{ID: uuid.New()}
+	ephemeral := &ent.Task{ID: uuid.New(), Ephemeral: true, Options: &model.TaskSyncOptions{ResumeAfterCrash: &enabled}}
+	taskSvc := &fakeTaskService{tasks: map[uuid.UUID]*ent.Task{}}
+	for _, task := range []*ent.Task{resumable, retried, optedOut, noOptions, ephemeral} {
+		taskSvc.tasks[task.ID] = task
+	}
+
+	parentID := uuid.New()
+	sourceJobID := uuid.New()
+	jobs := []*ent.Job{
+		{ID: parentID, TaskID: resumable.ID, Trigger: model.JobTriggerSchedule, TriggerDetail: &model.JobTriggerDetail{Schedule: &schedule}},
+		{ID: uuid.New(), TaskID: resumable.ID, ParentID: &parentID, Trigger: model.JobTriggerSchedule},
+		{ID: uuid.New(), TaskID: retried.ID, Trigger: model.JobTriggerRetry, TriggerDetail: &model.JobTriggerDetail{SourceJobID: &sourceJobID}},
+		{ID: uuid.New(), TaskID: optedOut.ID, Trigger: model.JobTriggerManual},
+		{ID: uuid.New(), TaskID: noOptions.ID, Trigger: model.JobTriggerManual},
+		{ID: uuid.New(), TaskID: ephemeral.ID, Trigger: model.JobTriggerManual},
+		{ID: uuid.New(), TaskID: uuid.New(), Trigger: model.JobTriggerManual}, // deleted task
+	}
+
+	details := make(chan *model.JobTriggerDetail, 2)
+	record := func(args mock.Arguments) {
+		details <- provenance.TriggerDetail(args.Get(0).(context.Context))
+	}
+	mockEngine.On("RunTask", mock.Anything, resumable, model.JobTriggerSchedule).Return(nil).Run(record).Once()
+	mockEngine.On("RunTask", mock.Anything, retried, model.JobTriggerManual).Return(nil).Run(record).Once()
+
+	started := r.ResumeInterrupted(context.Background(), taskSvc, jobs)
+	assert.ElementsMatch(t, []uuid.UUID{resumable.ID, retried.ID}, started)
+
+	for range 2 {
+		detail := <-details
+		require.NotNil(t, detail)
+		require.NotNil(t, detail.ResumedJobID)
+		if *detail.ResumedJobID == parentID {
+			require.NotNil(t, detail.Schedule, "the trigger detail of the interrupted job is kept")
+			assert.Equal(t, schedule, *detail.Schedule)
+		} else {
+			assert.Equal(t, jobs[2].ID, *detail.ResumedJobID)
+			assert.Nil(t, detail.SourceJobID, "a retry is resumed as a full run")
+		}
+	}
+
+	assert.Eventually(t, func() bool { return r.RunningCount() == 0 }, time.Second, 20*time.Millisecond)
+	r.Stop()
+	mockEngine.AssertExpectations(t)
+}
@@ -0,0 +1,230 @@
+package scheduler
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// icsFetchTimeout bounds a download of an X-EXCLUDE-ICS calendar.
+	icsFetchTimeout = 30 * time.Second
+	// icsRefreshInterval is how often X-EXCLUDE-ICS calendars are downloaded again.
+	icsRefreshInterval = 24 * time.Hour
+	// icsRetryInterval is how long to wait before downloading an X-EXCLUDE-ICS calendar again after a failure.
+	icsRetryInterval = 5 * time.Minute
+	// icsMaxSize bounds the size of an X-EXCLUDE-ICS calendar.
+	icsMaxSize = 10 << 20
+	// icsMaxEventDays bounds the days a single calendar event excludes.
+	icsMaxEventDays = 366
+)
+
+var icsHTTPClient = &http.Client{Timeout: icsFetchTimeout}
+
+// icsCalendar is the iCalendar file of an X-EXCLUDE-ICS property, e.g. of public holidays. The days with
+// events in it are excluded from the schedule. It is only ever downloaded in the background, so looking up
+// a day never waits for the network: until the first download succeeded no day is excluded. Failed downloads
+// are retried after icsRetryInterval, successful ones refreshed after icsRefreshInterval; if a download fails,
+// the days of the last successful one are kept.
+type icsCalendar struct {
+	url    string
+	loc    *time.Location
+	logger *zap.Logger
+
+	mu         sync.Mutex
+	days       map[string]struct{}
+	fetchedAt  time.Time // of the last successful download
+	failedAt   time.Time // of the last failed download, zero if it succeeded
+	refreshing bool
+}
+
+// newICSCalendar creates a calendar for the URL, whose all-day events are dates in loc.
+// Nothing is downloaded until load or contains is called.
+func newICSCalendar(url string, loc *time.Location) *icsCalendar {
+	return &icsCalendar{url: url, loc: loc, logger: logger.Named("core.scheduler")}
+}
+
+// load starts downloading the calendar in the background if it is due, see icsCalendar.
+func (c *icsCalendar) load() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loadLocked()
+}
+
+func (c *icsCalendar) loadLocked() {
+	if c.refreshing {
+		return
+	}
+	now := time.Now()
+	if !c.failedAt.IsZero() && now.Sub(c.failedAt) < icsRetryInterval {
+		return
+	}
+	if c.failedAt.IsZero() && !c.fetchedAt.IsZero() && now.Sub(c.fetchedAt) < icsRefreshInterval {
+		return
+	}
+	c.refreshing = true
+	go c.refresh()
+}
+
+// contains reports whether an event of the calendar covers the day of t. It only reads the days of the
+// last successful download and never blocks on one.
+func (c *icsCalendar) contains(t time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loadLocked()
+	_, ok := c.days[t.In(c.loc).Format(icalDate)]
+	return ok
+}
+
+// refresh downloads the calendar again.
+func (c *icsCalendar) refresh() {
+	days, err := c.fetch()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshing = false
+	if err != nil {
+		c.failedAt = time.Now()
+		c.logger.Warn("Failed to fetch calendar excluded from schedule", zap.String("url", c.url), zap.Error(err))
+		return
+	}
+	c.days = days
+	c.fetchedAt = time.Now()
+	c.failedAt = time.Time{}
+	c.logger.Debug("Fetched calendar excluded from schedule", zap.String("url", c.url), zap.Int("days", len(days)))
+}
+
+func (c *icsCalendar) fetch() (map[string]struct{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), icsFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := icsHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return parseICSDays(io.LimitReader(resp.Body, icsMaxSize), c.loc)
+}
+
+// parseICSDays returns the days (formatted as iCalendar dates) covered by the events of an iCalendar file.
+// All-day events cover their dates, timed events the days in loc they overlap. Recurring events only
+// cover the days of their first occurrence.
+func parseICSDays(r io.Reader, loc *time.Location) (map[string]struct{}, error) {
+	days := make(map[string]struct{})
+	calendar, inEvent := false, false
+	var start, end string
+
+	// Content lines are folded by starting the continuation lines with a space or tab
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), icsMaxSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		head, value, _ := strings.Cut(line, ":")
+		name, _, _ := strings.Cut(head, ";")
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VCALENDAR") {
+				calendar = true
+			} else if strings.EqualFold(value, "VEVENT") {
+				inEvent, start, end = true, "", ""
+			}
+		case "DTSTART":
+			if inEvent {
+				start = line
+			}
+		case "DTEND":
+			if inEvent {
+				end = line
+			}
+		case "END":
+			if !inEvent || !strings.EqualFold(value, "VEVENT") {
+				continue
+			}
+			inEvent = false
+			if err := addICSEventDays(days, start, end, loc); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !calendar {
+		return nil, errors.New("not an iCalendar file")
+	}
+	return days, nil
+}
+
+// addICSEventDays adds the days covered by an event with the DTSTART and DTEND content lines to days.
+func addICSEventDays(days map[string]struct{}, startLine, endLine string, loc *time.Location) error {
+	if startLine == "" {
+		return nil
+	}
+	start, allDay, err := parseICSEventTime(startLine, loc)
+	if err != nil {
+		return fmt.Errorf("invalid event DTSTART: %w", err)
+	}
+
+	// The end of all-day events is exclusive, events without one last a day (or an instant)
+	last := start
+	if endLine != "" {
+		end, _, err := parseICSEventTime(endLine, loc)
+		if err != nil {
+			return fmt.Errorf("invalid event DTEND: %w", err)
+		}
+		if allDay || end.Equal(time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)) {
+			end = end.AddDate(0, 0, -1)
+		}
+		if end.After(last) {
+			last = end
+		}
+	}
+
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	for i := 0; !day.After(last) && i < icsMaxEventDays; i++ {
+		days[day.Format(icalDate)] = struct{}{}
+		day = day.AddDate(0, 0, 1)
+	}
+	return nil
+}
+
+// parseICSEventTime parses a DTSTART or DTEND content line of an event into loc, reporting whether it is a date.
+func parseICSEventTime(line string, loc *time.Location) (time.Time, bool, error) {
+	head, value, _ := strings.Cut(line, ":")
+	_, params, _ := strings.Cut(head, ";")
+	allDay := len(value) == len(icalDate)
+	if allDay {
+		t, err := parseICalTime(value, loc)
+		return t, true, err
+	}
+	tz, err := tzidParam(params, loc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	t, err := parseICalTime(value, tz)
+	return t.In(loc), false, err
+}
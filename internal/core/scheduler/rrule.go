@@ -0,0 +1,598 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// rruleProperties are the iCalendar properties a schedule may start with to be read as an RRULE schedule.
+var rruleProperties = []string{"DTSTART", "RRULE", "EXDATE", "X-EXCLUDE-ICS"}
+
+// rruleHorizonYears bounds how far the next occurrence of an RRULE is searched for, so rules that never
+// match again, like the 30th of February, don't loop forever.
+const rruleHorizonYears = 100
+
+// isRRuleSchedule reports whether the schedule is an RRULE schedule rather than a cron expression.
+func isRRuleSchedule(schedule string) bool {
+	upper := strings.ToUpper(strings.TrimSpace(schedule))
+	for _, p := range rruleProperties {
+		if strings.HasPrefix(upper, p+":") || strings.HasPrefix(upper, p+";") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseSchedule parses the schedule of a task: a standard 5-field cron expression or descriptor, or an
+// RRULE schedule, i.e. iCalendar (RFC 5545) properties separated by whitespace or new lines:
+//
+//	DTSTART;TZID=Europe/Berlin:20260103T090000
+//	RRULE:FREQ=MONTHLY;BYDAY=1SA
+//	EXDATE:20261226
+//	X-EXCLUDE-ICS:https://example.com/holidays.ics
+//
+// RRULE is required and supports FREQ (HOURLY to YEARLY), INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY,
+// BYDAY, BYHOUR, BYMINUTE, BYSETPOS and WKST. DTSTART anchors the rule and defaults to midnight of
+// 1970-01-01 in the server's time zone. EXDATE skips occurrences, a date without a time skips the whole day.
+// X-EXCLUDE-ICS skips the days with events in an iCalendar file, e.g. public holidays. The file is downloaded
+// in the background when Next is first called, no day is excluded until it is loaded.
+func ParseSchedule(schedule string) (cron.Schedule, error) {
+	if isRRuleSchedule(schedule) {
+		return parseRRule(schedule)
+	}
+	return cron.ParseStandard(schedule)
+}
+
+// ValidateSchedule validates the schedule of a task, see ParseSchedule. An empty schedule is valid.
+// The calendars of X-EXCLUDE-ICS are not fetched.
+func ValidateSchedule(schedule string) error {
+	if schedule == "" {
+		return nil
+	}
+	_, err := ParseSchedule(schedule)
+	return err
+}
+
+// rruleFreq is the FREQ of an RRULE, the unit of its periods.
+type rruleFreq int
+
+const (
+	freqHourly rruleFreq = iota
+	freqDaily
+	freqWeekly
+	freqMonthly
+	freqYearly
+)
+
+var rruleFreqs = map[string]rruleFreq{
+	"HOURLY":  freqHourly,
+	"DAILY":   freqDaily,
+	"WEEKLY":  freqWeekly,
+	"MONTHLY": freqMonthly,
+	"YEARLY":  freqYearly,
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// rruleWeekday is a BYDAY value: a weekday, with n set for the nth (or, if negative, nth last) one
+// of the month or year.
+type rruleWeekday struct {
+	weekday time.Weekday
+	n       int
+}
+
+// rruleSchedule is a cron.Schedule firing on the occurrences of an RRULE.
+type rruleSchedule struct {
+	freq       rruleFreq
+	interval   int
+	count      int
+	until      time.Time
+	byMonth    []int
+	byMonthDay []int
+	byDay      []rruleWeekday
+	byHour     []int
+	byMinute   []int
+	bySetPos   []int
+	wkst       time.Weekday
+
+	dtstart  time.Time
+	loc      *time.Location
+	exTimes  []time.Time
+	exDays   map[string]struct{}
+	holidays *icsCalendar
+}
+
+// parseRRule parses an RRULE schedule, see ParseSchedule.
+func parseRRule(schedule string) (*rruleSchedule, error) {
+	var rrule, holidays string
+	var exdates []string
+	r := &rruleSchedule{loc: time.Local, exDays: map[string]struct{}{}}
+	for _, property := range strings.Fields(schedule) {
+		head, value, ok := strings.Cut(property, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid property %q", property)
+		}
+		name, params, _ := strings.Cut(head, ";")
+		switch strings.ToUpper(name) {
+		case "DTSTART":
+			if !r.dtstart.IsZero() {
+				return nil, errors.New("duplicate DTSTART")
+			}
+			loc, err := tzidParam(params, time.Local)
+			if err != nil {
+				return nil, err
+			}
+			if strings.HasSuffix(value, "Z") {
+				loc = time.UTC // Rules starting at a UTC time are evaluated in UTC
+			}
+			if r.dtstart, err = parseICalTime(value, loc); err != nil {
+				return nil, fmt.Errorf("invalid DTSTART: %w", err)
+			}
+			r.loc = loc
+		case "RRULE":
+			if rrule != "" {
+				return nil, errors.New("duplicate RRULE")
+			}
+			rrule = value
+		case "EXDATE":
+			exdates = append(exdates, property)
+		case "X-EXCLUDE-ICS":
+			if holidays != "" {
+				return nil, errors.New("duplicate X-EXCLUDE-ICS")
+			}
+			u, err := url.Parse(value)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, fmt.Errorf("invalid X-EXCLUDE-ICS URL %q", value)
+			}
+			holidays = value
+		default:
+			return nil, fmt.Errorf("unsupported property %s", name)
+		}
+	}
+	if rrule == "" {
+		return nil, errors.New("missing RRULE")
+	}
+	if r.dtstart.IsZero() {
+		r.dtstart = time.Date(1970, time.January, 1, 0, 0, 0, 0, r.loc)
+	}
+	if err := r.parseRule(rrule); err != nil {
+		return nil, err
+	}
+
+	// EXDATE values default to the time zone of DTSTART
+	for _, property := range exdates {
+		head, values, _ := strings.Cut(property, ":")
+		_, params, _ := strings.Cut(head, ";")
+		loc, err := tzidParam(params, r.loc)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range strings.Split(values, ",") {
+			t, err := parseICalTime(value, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EXDATE: %w", err)
+			}
+			if len(value) == len(icalDate) {
+				r.exDays[value] = struct{}{}
+			} else {
+				r.exTimes = append(r.exTimes, t)
+			}
+		}
+	}
+	if holidays != "" {
+		r.holidays = newICSCalendar(holidays, r.loc)
+	}
+	return r, nil
+}
+
+// parseRule parses the value of the RRULE property.
+func (r *rruleSchedule) parseRule(rule string) error {
+	r.interval = 1
+	r.wkst = time.Monday
+	seen := make(map[string]bool)
+	hasFreq := false
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(key)
+		if !ok || value == "" {
+			return fmt.Errorf("invalid RRULE part %q", part)
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate RRULE part %s", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.freq, hasFreq = rruleFreqs[strings.ToUpper(value)]
+			if !hasFreq {
+				return fmt.Errorf("unsupported FREQ %s", value)
+			}
+		case "INTERVAL":
+			r.interval, err = parseRRuleInt(value, 1, math.MaxInt32)
+		case "COUNT":
+			r.count, err = parseRRuleInt(value, 1, math.MaxInt32)
+		case "UNTIL":
+			r.until, err = parseICalTime(value, r.loc)
+		case "BYMONTH":
+			r.byMonth, err = parseRRuleInts(value, 1, 12, false)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRuleInts(value, -31, 31, true)
+		case "BYHOUR":
+			r.byHour, err = parseRRuleInts(value, 0, 23, false)
+		case "BYMINUTE":
+			r.byMinute, err = parseRRuleInts(value, 0, 59, false)
+		case "BYSETPOS":
+			r.bySetPos, err = parseRRuleInts(value, -366, 366, true)
+		case "BYDAY":
+			r.byDay, err = parseRRuleWeekdays(value)
+		case "WKST":
+			var ok bool
+			if r.wkst, ok = rruleWeekdays[strings.ToUpper(value)]; !ok {
+				err = fmt.Errorf("invalid weekday %q", value)
+			}
+		default:
+			return fmt.Errorf("unsupported RRULE part %s", key)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+
+	switch {
+	case !hasFreq:
+		return errors.New("missing FREQ in RRULE")
+	case r.count > 0 && !r.until.IsZero():
+		return errors.New("COUNT and UNTIL can't be used together")
+	case r.freq == freqWeekly && len(r.byMonthDay) > 0:
+		return errors.New("BYMONTHDAY can't be used with FREQ=WEEKLY")
+	case len(r.bySetPos) > 0 && len(r.byMonth)+len(r.byMonthDay)+len(r.byDay)+len(r.byHour)+len(r.byMinute) == 0:
+		return errors.New("BYSETPOS requires another BYxxx part")
+	}
+	if r.freq < freqMonthly {
+		for _, d := range r.byDay {
+			if d.n != 0 {
+				return errors.New("numbered BYDAY values require FREQ=MONTHLY or FREQ=YEARLY")
+			}
+		}
+	}
+	return nil
+}
+
+// Next returns the first occurrence of the rule after t, or the zero time if there is none.
+func (r *rruleSchedule) Next(t time.Time) time.Time {
+	t = t.In(r.loc)
+
+	from := t
+	if from.Before(r.dtstart) {
+		from = r.dtstart
+	}
+	horizon := from.AddDate(rruleHorizonYears, 0, 0)
+	if !r.until.IsZero() && r.until.Before(horizon) {
+		horizon = r.until
+	}
+
+	// COUNT is counted from DTSTART, otherwise the search starts at the first period due after t
+	p := r.periodStart(r.dtstart)
+	if r.count == 0 {
+		p = r.periodStart(from)
+		if rem := r.periodIndex(p) % r.interval; rem != 0 {
+			p = r.advance(p, r.interval-rem)
+		}
+	}
+
+	n := 0
+	for ; !p.After(horizon); p = r.advance(p, r.interval) {
+		for _, o := range r.expand(p) {
+			n++
+			if r.count > 0 && n > r.count {
+				return time.Time{}
+			}
+			if o.After(t) && !r.excluded(o) {
+				return o
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// loadCalendar starts downloading the X-EXCLUDE-ICS calendar in the background, so its days are excluded
+// as soon as possible. Next doesn't wait for it: until it is loaded, no day is excluded by it.
+func (r *rruleSchedule) loadCalendar() {
+	if r.holidays != nil {
+		r.holidays.load()
+	}
+}
+
+// excludedDay reports whether the X-EXCLUDE-ICS calendar excludes the day of t. It catches runs on days of a
+// calendar that was only loaded after Next returned them.
+func (r *rruleSchedule) excludedDay(t time.Time) bool {
+	return r.holidays != nil && r.holidays.contains(t)
+}
+
+// excluded reports whether the occurrence is skipped by EXDATE or X-EXCLUDE-ICS.
+func (r *rruleSchedule) excluded(o time.Time) bool {
+	if _, ok := r.exDays[o.Format(icalDate)]; ok {
+		return true
+	}
+	for _, ex := range r.exTimes {
+		if ex.Equal(o) {
+			return true
+		}
+	}
+	return r.holidays != nil && r.holidays.contains(o)
+}
+
+// periodStart returns the start of the period (year, month, week, day or hour) containing t.
+func (r *rruleSchedule) periodStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	switch r.freq {
+	case freqYearly:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, r.loc)
+	case freqMonthly:
+		return time.Date(y, m, 1, 0, 0, 0, 0, r.loc)
+	case freqWeekly:
+		return time.Date(y, m, d-(int(t.Weekday()-r.wkst)+7)%7, 0, 0, 0, 0, r.loc)
+	case freqDaily:
+		return time.Date(y, m, d, 0, 0, 0, 0, r.loc)
+	default:
+		return time.Date(y, m, d, t.Hour(), 0, 0, 0, r.loc)
+	}
+}
+
+// advance returns the start of the nth period after the period starting at p.
+func (r *rruleSchedule) advance(p time.Time, n int) time.Time {
+	y, m, d := p.Date()
+	switch r.freq {
+	case freqYearly:
+		return time.Date(y+n, m, d, 0, 0, 0, 0, r.loc)
+	case freqMonthly:
+		return time.Date(y, m+time.Month(n), d, 0, 0, 0, 0, r.loc)
+	case freqWeekly:
+		return time.Date(y, m, d+7*n, 0, 0, 0, 0, r.loc)
+	case freqDaily:
+		return time.Date(y, m, d+n, 0, 0, 0, 0, r.loc)
+	default:
+		return time.Date(y, m, d, p.Hour()+n, 0, 0, 0, r.loc)
+	}
+}
+
+// periodIndex returns the number of periods from the period of DTSTART to the period starting at p,
+// which occurs if it is a multiple of INTERVAL.
+func (r *rruleSchedule) periodIndex(p time.Time) int {
+	start := r.periodStart(r.dtstart)
+	switch r.freq {
+	case freqYearly:
+		return p.Year() - start.Year()
+	case freqMonthly:
+		return (p.Year()-start.Year())*12 + int(p.Month()-start.Month())
+	case freqWeekly:
+		return (civilDay(p) - civilDay(start)) / 7
+	case freqDaily:
+		return civilDay(p) - civilDay(start)
+	default:
+		return (civilDay(p)-civilDay(start))*24 + p.Hour() - start.Hour()
+	}
+}
+
+// expand returns the occurrences of the rule in the period starting at p in chronological order.
+func (r *rruleSchedule) expand(p time.Time) []time.Time {
+	var days []time.Time
+	switch r.freq {
+	case freqYearly:
+		for d := p; d.Year() == p.Year(); d = d.AddDate(0, 0, 1) {
+			days = append(days, d)
+		}
+	case freqMonthly:
+		for d := p; d.Month() == p.Month(); d = d.AddDate(0, 0, 1) {
+			days = append(days, d)
+		}
+	case freqWeekly:
+		for i := range 7 {
+			days = append(days, p.AddDate(0, 0, i))
+		}
+	default:
+		days = append(days, p)
+	}
+	days = slices.DeleteFunc(days, func(d time.Time) bool { return !r.matchesDay(d) })
+
+	hours, minutes := r.byHour, r.byMinute
+	if len(hours) == 0 {
+		hours = []int{r.dtstart.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{r.dtstart.Minute()}
+	}
+	if r.freq == freqHourly {
+		if len(r.byHour) > 0 && !slices.Contains(r.byHour, p.Hour()) {
+			return nil
+		}
+		hours = []int{p.Hour()}
+	}
+
+	var set []time.Time
+	for _, d := range days {
+		for _, h := range hours {
+			for _, m := range minutes {
+				set = append(set, time.Date(d.Year(), d.Month(), d.Day(), h, m, r.dtstart.Second(), 0, r.loc))
+			}
+		}
+	}
+	if len(r.bySetPos) > 0 {
+		var selected []time.Time
+		for _, pos := range r.bySetPos {
+			if pos > 0 && pos <= len(set) {
+				selected = append(selected, set[pos-1])
+			} else if pos < 0 && -pos <= len(set) {
+				selected = append(selected, set[len(set)+pos])
+			}
+		}
+		slices.SortFunc(selected, func(a, b time.Time) int { return a.Compare(b) })
+		set = slices.CompactFunc(selected, time.Time.Equal)
+	}
+	return slices.DeleteFunc(set, func(o time.Time) bool {
+		return o.Before(r.dtstart) || (!r.until.IsZero() && o.After(r.until))
+	})
+}
+
+// matchesDay reports whether the day matches the BYMONTH, BYMONTHDAY and BYDAY parts of the rule.
+// Without BYMONTHDAY and BYDAY, weekly rules repeat the weekday of DTSTART, and monthly and yearly
+// rules its day of the month.
+func (r *rruleSchedule) matchesDay(d time.Time) bool {
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, int(d.Month())) {
+		return false
+	}
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		switch r.freq {
+		case freqWeekly:
+			return d.Weekday() == r.dtstart.Weekday()
+		case freqMonthly, freqYearly:
+			if d.Day() != r.dtstart.Day() {
+				return false
+			}
+			return r.freq == freqMonthly || len(r.byMonth) > 0 || d.Month() == r.dtstart.Month()
+		}
+		return true
+	}
+
+	monthDays := daysIn(d.Year(), d.Month())
+	if len(r.byMonthDay) > 0 && !slices.ContainsFunc(r.byMonthDay, func(md int) bool {
+		return md == d.Day() || md == d.Day()-monthDays-1
+	}) {
+		return false
+	}
+	if len(r.byDay) > 0 && !slices.ContainsFunc(r.byDay, func(wd rruleWeekday) bool {
+		if wd.weekday != d.Weekday() {
+			return false
+		}
+		if wd.n == 0 {
+			return true
+		}
+		// Numbered weekdays count within the month, or the year for yearly rules without BYMONTH
+		day, last := d.Day(), monthDays
+		if r.freq == freqYearly && len(r.byMonth) == 0 {
+			day, last = d.YearDay(), time.Date(d.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+		}
+		if wd.n > 0 {
+			return (day-1)/7+1 == wd.n
+		}
+		return (last-day)/7+1 == -wd.n
+	}) {
+		return false
+	}
+	return true
+}
+
+// daysIn returns the number of days of the month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// civilDay returns the number of days from 1970-01-01 to the calendar day of t, ignoring its time zone offset.
+func civilDay(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+const (
+	icalDate        = "20060102"
+	icalDateTime    = "20060102T150405"
+	icalDateTimeUTC = "20060102T150405Z"
+)
+
+// parseICalTime parses an iCalendar DATE or DATE-TIME value. Values without a trailing Z are in loc.
+func parseICalTime(value string, loc *time.Location) (time.Time, error) {
+	switch len(value) {
+	case len(icalDate):
+		return time.ParseInLocation(icalDate, value, loc)
+	case len(icalDateTimeUTC):
+		t, err := time.Parse(icalDateTimeUTC, value)
+		return t.In(loc), err
+	default:
+		return time.ParseInLocation(icalDateTime, value, loc)
+	}
+}
+
+// tzidParam returns the time zone of the TZID parameter among the property parameters, or def without one.
+func tzidParam(params string, def *time.Location) (*time.Location, error) {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(param, "=")
+		if strings.EqualFold(key, "TZID") {
+			loc, err := time.LoadLocation(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid TZID %q: %w", value, err)
+			}
+			return loc, nil
+		}
+	}
+	return def, nil
+}
+
+// parseRRuleInt parses an integer in [minValue, maxValue].
+func parseRRuleInt(value string, minValue, maxValue int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < minValue || n > maxValue {
+		return 0, fmt.Errorf("%q is not an integer between %d and %d", value, minValue, maxValue)
+	}
+	return n, nil
+}
+
+// parseRRuleInts parses a sorted list of comma separated integers in [minValue, maxValue], excluding 0 if nonZero.
+func parseRRuleInts(value string, minValue, maxValue int, nonZero bool) ([]int, error) {
+	var values []int
+	for _, s := range strings.Split(value, ",") {
+		n, err := parseRRuleInt(s, minValue, maxValue)
+		if err == nil && nonZero && n == 0 {
+			err = fmt.Errorf("%q must not be 0", s)
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+	}
+	slices.Sort(values)
+	return slices.Compact(values), nil
+}
+
+// parseRRuleWeekdays parses a BYDAY value, e.g. "MO,TU" or "1SA,-1FR".
+func parseRRuleWeekdays(value string) ([]rruleWeekday, error) {
+	var weekdays []rruleWeekday
+	for _, s := range strings.Split(value, ",") {
+		if len(s) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", s)
+		}
+		weekday, ok := rruleWeekdays[strings.ToUpper(s[len(s)-2:])]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", s)
+		}
+		wd := rruleWeekday{weekday: weekday}
+		if prefix := s[:len(s)-2]; prefix != "" {
+			n, err := parseRRuleInt(strings.TrimPrefix(prefix, "+"), -53, 53)
+			if err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid weekday %q", s)
+			}
+			wd.n = n
+		}
+		weekdays = append(weekdays, wd)
+	}
+	return weekdays, nil
+}
+
+var _ cron.Schedule = (*rruleSchedule)(nil)
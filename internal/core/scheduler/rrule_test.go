@@ -0,0 +1,204 @@
+package scheduler_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/core/scheduler"
+)
+
+func TestParseSchedule_RRule(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) // A Saturday
+
+	tests := []struct {
+		name     string
+		schedule string
+		after    time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "first Saturday of the month",
+			schedule: "DTSTART:20260103T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=1SA",
+			after:    now,
+			want: []time.Time{
+				time.Date(2026, 11, 7, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 5, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "weekdays in a time zone with an excluded day",
+			schedule: "DTSTART;TZID=Europe/Berlin:20260105T080000 RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR EXDATE:20261019",
+			after:    now,
+			want: []time.Time{
+				time.Date(2026, 10, 20, 8, 0, 0, 0, berlin),
+				time.Date(2026, 10, 21, 8, 0, 0, 0, berlin),
+			},
+		},
+		{
+			name:     "last weekday of the month",
+			schedule: "DTSTART:20260101T220000Z RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			after:    now,
+			want: []time.Time{
+				time.Date(2026, 10, 30, 22, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 30, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "every other week",
+			schedule: "DTSTART:20260105T000000Z RRULE:FREQ=WEEKLY;INTERVAL=2",
+			after:    now,
+			want: []time.Time{
+				time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 9, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "last day of February",
+			schedule: "DTSTART:20200101T030000Z RRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1",
+			after:    now,
+			want: []time.Time{
+				time.Date(2027, 2, 28, 3, 0, 0, 0, time.UTC),
+				time.Date(2028, 2, 29, 3, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "every 6 hours at half past",
+			schedule: "DTSTART:20260101T000000Z RRULE:FREQ=HOURLY;INTERVAL=6;BYMINUTE=30",
+			after:    now,
+			want: []time.Time{
+				time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC),
+				time.Date(2026, 10, 17, 18, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "twice a day on listed hours",
+			schedule: "DTSTART:20260101T000000Z RRULE:FREQ=DAILY;BYHOUR=8,20;BYMINUTE=15",
+			after:    now,
+			want: []time.Time{
+				time.Date(2026, 10, 17, 20, 15, 0, 0, time.UTC),
+				time.Date(2026, 10, 18, 8, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "COUNT ends the rule",
+			schedule: "DTSTART:20261015T090000Z RRULE:FREQ=DAILY;COUNT=3",
+			after:    time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC),
+			want:     []time.Time{time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), {}},
+		},
+		{
+			name:     "UNTIL ends the rule",
+			schedule: "DTSTART:20261015T090000Z RRULE:FREQ=DAILY;UNTIL=20261018T090000Z",
+			after:    now,
+			want:     []time.Time{time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), {}},
+		},
+		{
+			name:     "rule starting in the future",
+			schedule: "DTSTART:20270101T000000Z RRULE:FREQ=MONTHLY",
+			after:    now,
+			want:     []time.Time{time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "rule never matching",
+			schedule: "RRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			after:    now,
+			want:     []time.Time{{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := scheduler.ParseSchedule(tt.schedule)
+			require.NoError(t, err)
+			next := tt.after
+			for _, want := range tt.want {
+				next = sched.Next(next)
+				if want.IsZero() {
+					assert.True(t, next.IsZero(), "unexpected occurrence %s", next)
+					return
+				}
+				assert.True(t, want.Equal(next), "want %s, got %s", want, next)
+			}
+		})
+	}
+}
+
+func TestParseSchedule_ExcludeICS(t *testing.T) {
+	setupTest(t)
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		<-release
+		fmt.Fprint(w, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"+
+			"BEGIN:VEVENT\r\nSUMMARY:Holiday\r\nDTSTART;VALUE=DATE:20261019\r\nDTEND;VALUE=DATE:20261021\r\nEND:VEVENT\r\n"+
+			"BEGIN:VEVENT\r\nSUMMARY:Folded\r\n summary\r\nDTSTART:20261022T100000Z\r\nDTEND:20261022T110000Z\r\nEND:VEVENT\r\n"+
+			"END:VCALENDAR\r\n")
+	}))
+	defer server.Close()
+
+	sched, err := scheduler.ParseSchedule("DTSTART:20260105T080000Z RRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR X-EXCLUDE-ICS:" + server.URL)
+	require.NoError(t, err)
+	assert.Zero(t, requests.Load(), "the calendar is fetched when first needed")
+
+	// Next doesn't wait for the download, no day is excluded until it is loaded
+	saturday := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), sched.Next(saturday))
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		return sched.Next(saturday).Equal(time.Date(2026, 10, 21, 8, 0, 0, 0, time.UTC))
+	}, 5*time.Second, 10*time.Millisecond, "Monday and Tuesday are holidays")
+	next := sched.Next(time.Date(2026, 10, 21, 8, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2026, 10, 23, 8, 0, 0, 0, time.UTC), next, "Thursday has a timed event")
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Without the calendar, no day is excluded
+	unreachable, err := scheduler.ParseSchedule("DTSTART:20260105T080000Z RRULE:FREQ=DAILY X-EXCLUDE-ICS:" + server.URL + "/missing")
+	require.NoError(t, err)
+	server.Close()
+	assert.Equal(t, time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC), unreachable.Next(saturday))
+}
+
+func TestValidateSchedule(t *testing.T) {
+	for _, schedule := range []string{
+		"",
+		"0 */6 * * *",
+		"@daily",
+		"RRULE:FREQ=DAILY",
+		"rrule:freq=weekly;byday=mo,fr",
+		"DTSTART;TZID=Asia/Shanghai:20260101T093000\nRRULE:FREQ=YEARLY;BYMONTH=1;BYDAY=-1FR\nEXDATE;TZID=Asia/Shanghai:20270129T093000,20280128",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=1,15 X-EXCLUDE-ICS:https://example.com/holidays.ics",
+	} {
+		assert.NoError(t, scheduler.ValidateSchedule(schedule), schedule)
+	}
+
+	for _, schedule := range []string{
+		"invalid cron",
+		"DTSTART:20260101T000000Z",
+		"RRULE:INTERVAL=2",
+		"RRULE:FREQ=SECONDLY",
+		"RRULE:FREQ=DAILY;BYWEEKNO=1",
+		"RRULE:FREQ=DAILY;INTERVAL=0",
+		"RRULE:FREQ=DAILY;COUNT=2;UNTIL=20270101",
+		"RRULE:FREQ=WEEKLY;BYDAY=1MO",
+		"RRULE:FREQ=WEEKLY;BYMONTHDAY=1",
+		"RRULE:FREQ=MONTHLY;BYDAY=XX",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=0",
+		"RRULE:FREQ=MONTHLY;BYSETPOS=1",
+		"RRULE:FREQ=DAILY RRULE:FREQ=WEEKLY",
+		"DTSTART;TZID=Nowhere/City:20260101T000000 RRULE:FREQ=DAILY",
+		"RRULE:FREQ=DAILY EXDATE:2026-01-01",
+		"RRULE:FREQ=DAILY X-EXCLUDE-ICS:ftp://example.com/holidays.ics",
+		"RRULE:FREQ=DAILY SUMMARY:Backup",
+	} {
+		assert.Error(t, scheduler.ValidateSchedule(schedule), schedule)
+	}
+}
@@ -1,15 +1,18 @@
-// Package scheduler provides cron-based task scheduling for the application.
+// Package scheduler provides cron and RRULE based task scheduling for the application.
 package scheduler
 
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +25,7 @@ type Scheduler struct {
 	mu      sync.Mutex
 	jobMap  map[string]cron.EntryID // Maps task ID to cron EntryID
 	running bool
+	paused  bool
 }
 
 // NewScheduler creates a new Scheduler instance.
@@ -62,6 +66,43 @@ func (s *Scheduler) Stop() {
 	s.running = false
 }
 
+// Pause suspends all scheduled triggers without removing them from the cron.
+// Manual runs through the runner are not affected.
+func (s *Scheduler) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.paused {
+		return
+	}
+	s.logger.Info("Pausing scheduler")
+	s.paused = true
+}
+
+// Resume re-enables scheduled triggers after a Pause.
+func (s *Scheduler) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.paused {
+		return
+	}
+	s.logger.Info("Resuming scheduler")
+	s.paused = false
+}
+
+// IsPaused reports whether scheduled triggers are currently suspended.
+func (s *Scheduler) IsPaused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.paused
+}
+
+// ScheduledTaskCount returns the number of tasks registered in the cron.
+func (s *Scheduler) ScheduledTaskCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.jobMap)
+}
+
 func (s *Scheduler) loadScheduledTasks() {
 	s.logger.Info("Loading scheduled tasks from database")
 	tasks, err := s.taskSvc.ListAllTasks(context.Background())
@@ -105,16 +146,49 @@ func (s *Scheduler) RemoveTask(task *ent.Task) error {
 
 func (s *Scheduler) addJob(task *ent.Task) error {
 	taskIDStr := task.ID.String()
-	taskID := task.ID     // Capture uuid.UUID directly for use in closure
-	taskName := task.Name // For logging
+	taskID := task.ID         // Capture uuid.UUID directly for use in closure
+	taskName := task.Name     // For logging
+	schedule := task.Schedule // Recorded as the trigger detail of the jobs it starts
 
 	s.removeJob(taskIDStr) // Remove existing job if any, to handle updates
 
-	entryID, err := s.cron.AddFunc(task.Schedule, func() {
+	// RRULE schedules are parsed here, cron expressions by the cron, honoring its parser options
+	var rule *rruleSchedule
+	if isRRuleSchedule(task.Schedule) {
+		var err error
+		if rule, err = parseRRule(task.Schedule); err != nil {
+			return err
+		}
+		rule.loadCalendar()
+	}
+
+	job := cron.FuncJob(func() {
+		if s.IsPaused() {
+			s.logger.Info("Scheduler is paused, skipping scheduled task", zap.String("task_name", taskName), zap.String("task_id", taskIDStr))
+			return
+		}
+		if rule != nil && rule.excludedDay(time.Now()) {
+			s.logger.Info("Day is excluded from the schedule, skipping scheduled task", zap.String("task_name", taskName), zap.String("task_id", taskIDStr))
+			return
+		}
+
+		ctx := context.Background()
+
+		// Coalesce triggers that fire while the previous run is still going,
+		// instead of cancelling it and piling up runs behind a slow job
+		if s.runner.IsRunning(taskID) {
+			s.logger.Info("Task is still running, skipping scheduled run", zap.String("task_name", taskName), zap.String("task_id", taskIDStr))
+			if err := s.taskSvc.RecordSkippedRun(ctx, taskID, i18n.Ctx(ctx, i18n.StatusScheduleSkipped)); err != nil {
+				s.logger.Error("Failed to record skipped scheduled run",
+					zap.String("task_id", taskIDStr),
+					zap.Error(err))
+			}
+			return
+		}
+
 		s.logger.Info("Running scheduled task", zap.String("task_name", taskName), zap.String("task_id", taskIDStr))
 
 		// Reload task from database to get the latest configuration
-		ctx := context.Background()
 		currentTask, err := s.taskSvc.GetTaskWithConnection(ctx, taskID)
 		if err != nil {
 			s.logger.Error("Failed to get task for scheduled run",
@@ -123,11 +197,18 @@ func (s *Scheduler) addJob(task *ent.Task) error {
 			return
 		}
 
-		_ = s.runner.StartTask(currentTask, model.JobTriggerSchedule)
+		ctx = provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{Schedule: &schedule})
+		_ = s.runner.StartTask(ctx, currentTask, model.JobTriggerSchedule)
 	})
 
-	if err != nil {
-		return err
+	var entryID cron.EntryID
+	if rule != nil {
+		entryID = s.cron.Schedule(rule, job)
+	} else {
+		var err error
+		if entryID, err = s.cron.AddJob(task.Schedule, job); err != nil {
+			return err
+		}
 	}
 
 	s.jobMap[taskIDStr] = entryID
@@ -2,6 +2,9 @@ package scheduler_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -9,20 +12,26 @@ import (
 	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
 	"github.com/xzzpig/rclone-sync/internal/core/scheduler"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // MockRunner is a mock for the Runner interface
 type MockRunner struct {
 	mock.Mock
+	// detail is the trigger detail of the last StartTask call
+	detail atomic.Pointer[model.JobTriggerDetail]
 }
 
 func (m *MockRunner) Start() { m.Called() }
 func (m *MockRunner) Stop()  { m.Called() }
-func (m *MockRunner) StartTask(task *ent.Task, trigger model.JobTrigger) error {
+func (m *MockRunner) StartTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
+	m.detail.Store(provenance.TriggerDetail(ctx))
 	args := m.Called(task, string(trigger))
 	return args.Error(0)
 }
@@ -30,10 +39,31 @@ func (m *MockRunner) StopTask(taskID uuid.UUID) error {
 	args := m.Called(taskID)
 	return args.Error(0)
 }
+func (m *MockRunner) StopTasks(taskIDs []uuid.UUID) []uuid.UUID {
+	args := m.Called(taskIDs)
+	stopped, _ := args.Get(0).([]uuid.UUID)
+	return stopped
+}
 func (m *MockRunner) IsRunning(taskID uuid.UUID) bool {
 	args := m.Called(taskID)
 	return args.Bool(0)
 }
+func (m *MockRunner) SetMaintenance(enabled bool, cancelRunning bool) {
+	m.Called(enabled, cancelRunning)
+}
+func (m *MockRunner) IsMaintenance() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+func (m *MockRunner) RunningCount() int {
+	args := m.Called()
+	return args.Int(0)
+}
+
+func (m *MockRunner) Engines() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
 
 // MockTaskService is a mock for the TaskService interface
 type MockTaskService struct {
@@ -55,9 +85,20 @@ func (m *MockTaskService) ListAllTasks(ctx context.Context) ([]*ent.Task, error)
 	return args.Get(0).([]*ent.Task), args.Error(1)
 }
 
+func (m *MockTaskService) RecordSkippedRun(ctx context.Context, taskID uuid.UUID, message string) error {
+	args := m.Called(ctx, taskID, message)
+	return args.Error(0)
+}
+
+func (m *MockTaskService) RecordWatchLimitReached(ctx context.Context, taskID uuid.UUID, message string) error {
+	args := m.Called(ctx, taskID, message)
+	return args.Error(0)
+}
+
 func setupTest(t *testing.T) {
 	t.Helper()
 	logger.InitLogger(logger.EnvironmentDevelopment, logger.LogLevelDebug, nil)
+	require.NoError(t, i18n.Init())
 }
 
 func TestScheduler_Start_LoadsScheduledTasks(t *testing.T) {
@@ -72,6 +113,7 @@ func TestScheduler_Start_LoadsScheduledTasks(t *testing.T) {
 	mockTaskSvc.On("ListAllTasks", mock.Anything).Return(tasks, nil)
 	// When cron triggers, scheduler will reload the task from DB using GetTaskWithConnection
 	mockTaskSvc.On("GetTaskWithConnection", mock.Anything, task1.ID).Return(task1, nil)
+	mockRunner.On("IsRunning", task1.ID).Return(false)
 	// We expect StartTask to be called for the scheduled task.
 	// We use a WaitGroup or channel to handle the async nature of cron.
 	startedChan := make(chan bool, 1)
@@ -90,6 +132,10 @@ func TestScheduler_Start_LoadsScheduledTasks(t *testing.T) {
 	case <-time.After(1500 * time.Millisecond): // Cron ticks every second
 		t.Fatal("timed out waiting for scheduled task to start")
 	}
+	// The job records the schedule that fired
+	detail := mockRunner.detail.Load()
+	require.NotNil(t, detail)
+	assert.Equal(t, task1.Schedule, *detail.Schedule)
 
 	mockTaskSvc.AssertExpectations(t)
 	mockRunner.AssertExpectations(t)
@@ -115,6 +161,7 @@ func TestScheduler_AddTask_And_RemoveTask(t *testing.T) {
 
 	// When cron triggers, scheduler will reload the task from DB using GetTaskWithConnection
 	mockTaskSvc.On("GetTaskWithConnection", mock.Anything, task.ID).Return(task, nil)
+	mockRunner.On("IsRunning", task.ID).Return(false)
 	// Expect it to run
 	startedChan := make(chan bool, 1)
 	mockRunner.On("StartTask", task, string(model.JobTriggerSchedule)).Return(nil).Run(func(args mock.Arguments) {
@@ -141,6 +188,50 @@ func TestScheduler_AddTask_And_RemoveTask(t *testing.T) {
 	mockTaskSvc.AssertExpectations(t)
 }
 
+func TestScheduler_AddTask_DoesNotWaitForCalendar(t *testing.T) {
+	setupTest(t)
+	mockTaskSvc := new(MockTaskService)
+	mockRunner := new(MockRunner)
+	mockTaskSvc.On("ListAllTasks", mock.Anything).Return([]*ent.Task{}, nil).Once()
+
+	// The calendar server doesn't answer until the test ends
+	fetched := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case fetched <- struct{}{}:
+		default:
+		}
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	s := scheduler.NewScheduler(mockTaskSvc, mockRunner)
+	s.Start()
+	defer s.Stop()
+
+	task := &ent.Task{ID: uuid.New(), Name: "Holiday Task", Schedule: "RRULE:FREQ=DAILY;BYHOUR=3 X-EXCLUDE-ICS:" + server.URL}
+	done := make(chan error, 1)
+	go func() { done <- s.AddTask(task) }()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("AddTask waited for the calendar download")
+	}
+	assert.Equal(t, 1, s.ScheduledTaskCount())
+
+	// The calendar is downloaded in the background
+	select {
+	case <-fetched:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the calendar download")
+	}
+	require.NoError(t, s.RemoveTask(task))
+	assert.Zero(t, s.ScheduledTaskCount())
+}
+
 func TestScheduler_StartStopIdempotency(t *testing.T) {
 	setupTest(t)
 	mockTaskSvc := new(MockTaskService)
@@ -170,3 +261,76 @@ func TestScheduler_StartStopIdempotency(t *testing.T) {
 
 	s.Stop() // Final cleanup
 }
+
+func TestScheduler_PauseResume(t *testing.T) {
+	setupTest(t)
+	mockTaskSvc := new(MockTaskService)
+	mockRunner := new(MockRunner)
+
+	task := &ent.Task{ID: uuid.New(), Name: "Paused Task", Schedule: "* * * * * *"}
+
+	mockTaskSvc.On("ListAllTasks", mock.Anything).Return([]*ent.Task{task}, nil).Once()
+	mockTaskSvc.On("GetTaskWithConnection", mock.Anything, task.ID).Return(task, nil)
+	mockRunner.On("IsRunning", task.ID).Return(false)
+	startedChan := make(chan bool, 1)
+	mockRunner.On("StartTask", task, string(model.JobTriggerSchedule)).Return(nil).Run(func(args mock.Arguments) {
+		select {
+		case startedChan <- true:
+		default:
+		}
+	})
+
+	s := scheduler.NewScheduler(mockTaskSvc, mockRunner, cron.WithSeconds())
+	s.Pause()
+	assert.True(t, s.IsPaused())
+	s.Start()
+	defer s.Stop()
+
+	// The task stays registered while paused, but must not be triggered.
+	assert.Equal(t, 1, s.ScheduledTaskCount())
+	time.Sleep(1500 * time.Millisecond)
+	mockRunner.AssertNotCalled(t, "StartTask", task, string(model.JobTriggerSchedule))
+
+	s.Resume()
+	assert.False(t, s.IsPaused())
+
+	select {
+	case <-startedChan:
+		// Success
+	case <-time.After(1500 * time.Millisecond):
+		t.Fatal("timed out waiting for resumed task to start")
+	}
+}
+
+func TestScheduler_SkipsWhileRunning(t *testing.T) {
+	setupTest(t)
+	mockTaskSvc := new(MockTaskService)
+	mockRunner := new(MockRunner)
+
+	task := &ent.Task{ID: uuid.New(), Name: "Slow Task", Schedule: "* * * * * *"}
+
+	mockTaskSvc.On("ListAllTasks", mock.Anything).Return([]*ent.Task{task}, nil).Once()
+	mockRunner.On("IsRunning", task.ID).Return(true)
+	skippedChan := make(chan string, 1)
+	mockTaskSvc.On("RecordSkippedRun", mock.Anything, task.ID, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		select {
+		case skippedChan <- args.String(2):
+		default:
+		}
+	})
+
+	s := scheduler.NewScheduler(mockTaskSvc, mockRunner, cron.WithSeconds())
+	s.Start()
+	defer s.Stop()
+
+	select {
+	case msg := <-skippedChan:
+		assert.NotEmpty(t, msg)
+	case <-time.After(1500 * time.Millisecond):
+		t.Fatal("timed out waiting for the skipped run to be recorded")
+	}
+
+	// The running job is neither cancelled nor restarted
+	mockRunner.AssertNotCalled(t, "StartTask", mock.Anything, mock.Anything)
+	mockTaskSvc.AssertNotCalled(t, "GetTaskWithConnection", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+// DefaultConfigCacheTTL 是解密配置缓存的默认有效期
+const DefaultConfigCacheTTL = 30 * time.Second
+
+// ConfigCacheMetrics 是解密配置缓存的累计统计
+type ConfigCacheMetrics struct {
+	Hits    int64 // 命中缓存的读取次数
+	Misses  int64 // 需要解密的读取次数
+	Entries int   // 当前缓存的连接数
+}
+
+// HitRate 返回命中率（0-1），没有读取时为 0
+func (m ConfigCacheMetrics) HitRate() float64 {
+	if total := m.Hits + m.Misses; total > 0 {
+		return float64(m.Hits) / float64(total)
+	}
+	return 0
+}
+
+// configCache 缓存连接的解密配置，避免每次作业启动时 rclone 逐项读取配置都要解密
+// 缓存项以连接的 updatedAt 为版本，连接被修改后自动失效；并发读取安全
+type configCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration // 0 表示禁用缓存
+	entries map[uuid.UUID]configCacheEntry
+	metrics ConfigCacheMetrics
+	now     func() time.Time
+}
+
+type configCacheEntry struct {
+	updatedAt time.Time
+	expiresAt time.Time
+	config    map[string]string
+}
+
+func newConfigCache(ttl time.Duration) *configCache {
+	return &configCache{
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]configCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// get 返回连接的解密配置副本，缓存未命中时调用 decrypt 解密并缓存结果
+// 解密在锁外进行，并发的未命中可能重复解密，但不会阻塞其他连接的读取
+func (c *configCache) get(conn *ent.Connection, decrypt func() (map[string]string, error)) (map[string]string, error) {
+	c.mu.Lock()
+	if c.ttl <= 0 {
+		c.metrics.Misses++
+		c.mu.Unlock()
+		return decrypt()
+	}
+	now := c.now()
+	if entry, ok := c.entries[conn.ID]; ok && entry.updatedAt.Equal(conn.UpdatedAt) && now.Before(entry.expiresAt) {
+		c.metrics.Hits++
+		c.mu.Unlock()
+		return maps.Clone(entry.config), nil
+	}
+	c.metrics.Misses++
+	c.mu.Unlock()
+
+	config, err := decrypt()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// 清理过期项，避免已删除的连接一直占用内存
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+	c.entries[conn.ID] = configCacheEntry{updatedAt: conn.UpdatedAt, expiresAt: now.Add(c.ttl), config: maps.Clone(config)}
+	return config, nil
+}
+
+// invalidate 移除连接的缓存项
+func (c *configCache) invalidate(id uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, id)
+}
+
+// setTTL 修改缓存有效期并清空缓存
+func (c *configCache) setTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+	clear(c.entries)
+}
+
+// snapshot 返回当前的统计
+func (c *configCache) snapshot() ConfigCacheMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m := c.metrics
+	m.Entries = len(c.entries)
+	return m
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionService_ConfigCache(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	ctx := context.Background()
+
+	conn, err := service.CreateConnection(ctx, "cached", "local", map[string]string{"type": "local", "nounc": "true"})
+	require.NoError(t, err)
+
+	config, err := service.GetConnectionConfig(ctx, "cached")
+	require.NoError(t, err)
+	assert.Equal(t, "true", config["nounc"])
+	assert.Equal(t, ConfigCacheMetrics{Misses: 1, Entries: 1}, service.ConfigCacheMetrics())
+
+	// Callers may modify the returned config without affecting the cache
+	config["nounc"] = "false"
+	config, err = service.GetConnectionConfigByID(ctx, conn.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "true", config["nounc"])
+	assert.Equal(t, ConfigCacheMetrics{Hits: 1, Misses: 1, Entries: 1}, service.ConfigCacheMetrics())
+	assert.InDelta(t, 0.5, service.ConfigCacheMetrics().HitRate(), 0.001)
+
+	// Updates invalidate the cached config
+	require.NoError(t, service.UpdateConnection(ctx, conn.ID, nil, nil, map[string]string{"type": "local", "nounc": "false"}))
+	config, err = service.GetConnectionConfig(ctx, "cached")
+	require.NoError(t, err)
+	assert.Equal(t, "false", config["nounc"])
+	assert.Equal(t, int64(2), service.ConfigCacheMetrics().Misses)
+
+	// Deletes drop the cached config
+	require.NoError(t, service.DeleteConnectionByID(ctx, conn.ID))
+	assert.Equal(t, 0, service.ConfigCacheMetrics().Entries)
+}
+
+func TestConnectionService_ConfigCache_Expiry(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	ctx := context.Background()
+	now := time.Now()
+	service.configs.now = func() time.Time { return now }
+
+	_, err := service.CreateConnection(ctx, "cached", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err = service.GetConnectionConfig(ctx, "cached")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, ConfigCacheMetrics{Hits: 1, Misses: 1, Entries: 1}, service.ConfigCacheMetrics())
+
+	now = now.Add(DefaultConfigCacheTTL)
+	_, err = service.GetConnectionConfig(ctx, "cached")
+	require.NoError(t, err)
+	assert.Equal(t, ConfigCacheMetrics{Hits: 1, Misses: 2, Entries: 1}, service.ConfigCacheMetrics())
+}
+
+func TestConnectionService_ConfigCache_Disabled(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	service.SetConfigCacheTTL(0)
+	ctx := context.Background()
+
+	_, err := service.CreateConnection(ctx, "uncached", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err = service.GetConnectionConfig(ctx, "uncached")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, ConfigCacheMetrics{Misses: 2}, service.ConfigCacheMetrics())
+	assert.Zero(t, service.ConfigCacheMetrics().HitRate())
+}
@@ -4,12 +4,19 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 	"github.com/rclone/rclone/fs/fspath"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/crypto"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
 )
@@ -17,23 +24,42 @@ import (
 const (
 	errNameEmpty          = errs.ConstError("name cannot be empty")
 	errTypeEmpty          = errs.ConstError("type cannot be empty")
+	errTypeUnknown        = errs.ConstError("type is not a known rclone backend")
 	errConnectionNotFound = errs.ConstError("connection not found")
+
+	// ErrConnectionInUse 表示连接正被运行中的作业使用，此时不允许修改
+	ErrConnectionInUse = errs.ConstError("connection is used by a running job")
+	// ErrConnectionVersionConflict 表示连接在读取后已被其他修改更新（配置版本不一致）
+	ErrConnectionVersionConflict = errs.ConstError("connection config version conflict")
 )
 
 // ConnectionService 处理云存储连接的业务逻辑
 type ConnectionService struct {
 	client    *ent.Client
 	encryptor *crypto.Encryptor
+	configs   *configCache
 }
 
 // NewConnectionService 创建新的 ConnectionService 实例
+// 解密后的配置缓存 DefaultConfigCacheTTL，可通过 SetConfigCacheTTL 修改
 func NewConnectionService(client *ent.Client, encryptor *crypto.Encryptor) *ConnectionService {
 	return &ConnectionService{
 		client:    client,
 		encryptor: encryptor,
+		configs:   newConfigCache(DefaultConfigCacheTTL),
 	}
 }
 
+// SetConfigCacheTTL 设置解密配置缓存的有效期，0 表示禁用缓存
+func (s *ConnectionService) SetConfigCacheTTL(ttl time.Duration) {
+	s.configs.setTTL(max(ttl, 0))
+}
+
+// ConfigCacheMetrics 返回解密配置缓存的累计统计
+func (s *ConnectionService) ConfigCacheMetrics() ConfigCacheMetrics {
+	return s.configs.snapshot()
+}
+
 // ValidateConnectionName 验证连接名称
 // 使用 rclone 官方的 fspath.CheckConfigName 验证规则
 func ValidateConnectionName(name string) error {
@@ -48,7 +74,7 @@ func ValidateConnectionName(name string) error {
 }
 
 // CreateConnection 创建新的云存储连接
-func (s *ConnectionService) CreateConnection(ctx context.Context, name, connType string, config map[string]string) (*ent.Connection, error) {
+func (s *ConnectionService) CreateConnection(ctx context.Context, name string, connType model.ConnectionType, config map[string]string) (*ent.Connection, error) {
 	// 验证名称
 	if err := ValidateConnectionName(name); err != nil {
 		return nil, err
@@ -58,6 +84,9 @@ func (s *ConnectionService) CreateConnection(ctx context.Context, name, connType
 	if connType == "" {
 		return nil, errTypeEmpty
 	}
+	if !connType.IsValid() {
+		return nil, fmt.Errorf("%w: %s", errTypeUnknown, connType)
+	}
 
 	// 检查名称是否已存在
 	exists, err := s.client.Connection.
@@ -74,7 +103,7 @@ func (s *ConnectionService) CreateConnection(ctx context.Context, name, connType
 	if config == nil {
 		config = make(map[string]string)
 	}
-	config["type"] = connType
+	config["type"] = string(connType)
 
 	// 加密配置
 	encryptedConfig, err := s.encryptor.EncryptConfig(config)
@@ -88,6 +117,7 @@ func (s *ConnectionService) CreateConnection(ctx context.Context, name, connType
 		SetName(name).
 		SetType(connType).
 		SetEncryptedConfig(encryptedConfig).
+		SetNillableCredentialsExpireAt(CredentialsExpireAt(connType, config)).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection: %w", err)
@@ -123,6 +153,24 @@ func (s *ConnectionService) GetConnectionByID(ctx context.Context, id uuid.UUID)
 	return conn, nil
 }
 
+// ConnectionExists 检查指定 ID 的连接是否存在
+func (s *ConnectionService) ConnectionExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	exists, err := s.client.Connection.Query().Where(connection.ID(id)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check connection existence: %w", err)
+	}
+	return exists, nil
+}
+
+// ConnectionNameExists 检查指定名称的连接是否存在
+func (s *ConnectionService) ConnectionNameExists(ctx context.Context, name string) (bool, error) {
+	exists, err := s.client.Connection.Query().Where(connection.Name(name)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check connection existence: %w", err)
+	}
+	return exists, nil
+}
+
 // ListConnections 列出所有连接
 func (s *ConnectionService) ListConnections(ctx context.Context) ([]*ent.Connection, error) {
 	conns, err := s.client.Connection.
@@ -135,11 +183,11 @@ func (s *ConnectionService) ListConnections(ctx context.Context) ([]*ent.Connect
 	return conns, nil
 }
 
-// ListConnectionsPaginated 分页列出连接
+// ListConnectionsPaginated 分页列出连接，按 sortBy 和 direction 排序
 // 当 limit <= 0 时，返回全部连接（不分页）
-func (s *ConnectionService) ListConnectionsPaginated(ctx context.Context, limit, offset int) ([]*ent.Connection, int, error) {
+func (s *ConnectionService) ListConnectionsPaginated(ctx context.Context, limit, offset int, sortBy model.ConnectionSortField, direction model.SortDirection) ([]*ent.Connection, int, error) {
 	query := s.client.Connection.Query().
-		Order(ent.Desc(connection.FieldCreatedAt))
+		Order(connectionOrder(sortBy, direction)...)
 
 	// Get total count
 	totalCount, err := query.Clone().Count(ctx)
@@ -160,7 +208,38 @@ func (s *ConnectionService) ListConnectionsPaginated(ctx context.Context, limit,
 	return conns, totalCount, nil
 }
 
-// CountAssociatedTasks 返回连接关联的任务数量
+// connectionOrder 返回按 sortBy 和 direction 排序连接列表的排序条件（见 model.ConnectionSortField），值相同的连接按名称排序
+func connectionOrder(sortBy model.ConnectionSortField, direction model.SortDirection) []connection.OrderOption {
+	opts := sortTermOptions(direction)
+	var order connection.OrderOption
+	switch sortBy {
+	case model.ConnectionSortFieldName:
+		order = connection.ByName(opts...)
+	case model.ConnectionSortFieldType:
+		order = connection.ByType(opts...)
+	case model.ConnectionSortFieldLastJobAt:
+		order = func(s *sql.Selector) {
+			// 子查询: SELECT MAX(jobs.start_time) FROM jobs JOIN tasks ON jobs.task_id = tasks.id
+			// WHERE tasks.connection_id = connections.id AND jobs.parent_id IS NULL
+			jobs, tasks := sql.Table(job.Table), sql.Table(task.Table)
+			orderBySubquery(s, sql.Select(sql.Max(jobs.C(job.FieldStartTime))).
+				From(jobs).
+				Join(tasks).On(jobs.C(job.TaskColumn), tasks.C(task.FieldID)).
+				Where(sql.And(
+					sql.ColumnsEQ(tasks.C(task.FieldConnectionID), s.C(connection.FieldID)),
+					sql.IsNull(jobs.C(job.FieldParentID)),
+				)), direction)
+		}
+	case model.ConnectionSortFieldHealth:
+		order = connection.ByHealthStatus(opts...)
+	default:
+		order = connection.ByCreatedAt(opts...)
+	}
+	return []connection.OrderOption{order, connection.ByName()}
+}
+
+// CountAssociatedTasks 返回连接关联的任务数量（不含已删除的任务和临时任务，它们随连接一起被清除）
+// 将该连接用作镜像目标（options.mirrors）的任务同样计入
 func (s *ConnectionService) CountAssociatedTasks(ctx context.Context, connectionID uuid.UUID) (int, error) {
 	conn, err := s.client.Connection.Get(ctx, connectionID)
 	if err != nil {
@@ -170,10 +249,20 @@ func (s *ConnectionService) CountAssociatedTasks(ctx context.Context, connection
 		return 0, fmt.Errorf("failed to get connection: %w", err)
 	}
 
-	count, err := conn.QueryTasks().Count(ctx)
+	count, err := conn.QueryTasks().Where(task.DeletedAtIsNil(), task.Ephemeral(false)).Count(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count tasks: %w", err)
 	}
+	mirroring, err := tasksMirroringTo(ctx, s.client, connectionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list mirroring tasks: %w", err)
+	}
+	for _, t := range mirroring {
+		// 同时直接使用该连接的任务已计入
+		if t.ConnectionID != connectionID {
+			count++
+		}
+	}
 
 	return count, nil
 }
@@ -203,11 +292,11 @@ func (s *ConnectionService) GetConnectionConfig(ctx context.Context, name string
 		return nil, err
 	}
 
-	config, err := s.encryptor.DecryptConfig(conn.EncryptedConfig)
+	config, err := s.decryptConfig(conn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt config: %w", err)
+		return nil, err
 	}
-	config["type"] = conn.Type
+	config["type"] = string(conn.Type)
 
 	return config, nil
 }
@@ -219,16 +308,24 @@ func (s *ConnectionService) GetConnectionConfigByID(ctx context.Context, id uuid
 		return nil, err
 	}
 
-	config, err := s.encryptor.DecryptConfig(conn.EncryptedConfig)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt config: %w", err)
-	}
+	return s.decryptConfig(conn)
+}
 
-	return config, nil
+// decryptConfig 返回连接的解密配置，优先使用缓存；返回的 map 可由调用方修改
+func (s *ConnectionService) decryptConfig(conn *ent.Connection) (map[string]string, error) {
+	return s.configs.get(conn, func() (map[string]string, error) {
+		config, err := s.encryptor.DecryptConfig(conn.EncryptedConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt config: %w", err)
+		}
+		return config, nil
+	})
 }
 
 // UpdateConnection 更新连接配置（基于 ID）
-func (s *ConnectionService) UpdateConnection(ctx context.Context, id uuid.UUID, name, connType *string, config map[string]string) error {
+// 这是底层写入，供 rclone 配置存储在运行中写回令牌等使用：不检查运行中的作业，也不递增配置版本。
+// 用户发起的修改应使用 ApplyConnectionUpdate
+func (s *ConnectionService) UpdateConnection(ctx context.Context, id uuid.UUID, name *string, connType *model.ConnectionType, config map[string]string) error {
 	// 根据 ID 查询连接
 	conn, err := s.client.Connection.Get(ctx, id)
 	if err != nil {
@@ -268,7 +365,7 @@ func (s *ConnectionService) UpdateConnection(ctx context.Context, id uuid.UUID,
 	if config == nil {
 		config = make(map[string]string)
 	}
-	config["type"] = conn.Type
+	config["type"] = string(conn.Type)
 
 	// 加密并更新配置（config 是必需的）
 	encryptedConfig, err := s.encryptor.EncryptConfig(config)
@@ -276,9 +373,11 @@ func (s *ConnectionService) UpdateConnection(ctx context.Context, id uuid.UUID,
 		return fmt.Errorf("failed to encrypt config: %w", err)
 	}
 	update = update.SetEncryptedConfig(encryptedConfig)
+	setCredentialsExpiry(update.Mutation(), conn.Type, config)
 
 	// 保存更新
 	_, err = update.Save(ctx)
+	s.configs.invalidate(id)
 	if err != nil {
 		return fmt.Errorf("failed to update connection: %w", err)
 	}
@@ -286,6 +385,377 @@ func (s *ConnectionService) UpdateConnection(ctx context.Context, id uuid.UUID,
 	return nil
 }
 
+// ConnectionUpdate 描述用户对连接的一次修改，由 ApplyConnectionUpdate 在单个事务中应用
+// nil 字段保持不变
+type ConnectionUpdate struct {
+	Name         *string
+	Type         *model.ConnectionType
+	Config       map[string]string
+	BasePath     *string  // 空字符串表示清除
+	PathTPSLimit *float64 // 0 表示清除
+	PathTPSBurst *int     // 0 表示清除
+	Display      ConnectionDisplay
+	// ExpectedVersion 非 nil 时要求连接的当前配置版本与之相同，否则返回 ErrConnectionVersionConflict
+	ExpectedVersion *int
+}
+
+// ApplyConnectionUpdate 在单个事务中应用用户对连接的修改，并递增连接的配置版本
+// 若有使用该连接的作业正在运行，返回 ErrConnectionInUse，这样运行中的作业始终使用其开始时的配置版本
+func (s *ConnectionService) ApplyConnectionUpdate(ctx context.Context, id uuid.UUID, upd ConnectionUpdate) (*ent.Connection, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	conn, err := tx.Connection.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	if upd.ExpectedVersion != nil && *upd.ExpectedVersion != conn.ConfigVersion {
+		return nil, ErrConnectionVersionConflict
+	}
+
+	// 在同一事务中检查运行中的作业，避免与新作业的创建交错
+	// 上传到该连接的镜像作业同样使用其配置
+	running, err := tx.Job.Query().
+		Where(
+			job.StatusIn(model.JobStatusRunning, model.JobStatusWaitingConfirmation),
+			job.Or(
+				job.HasTaskWith(task.ConnectionID(id)),
+				job.And(job.ParentIDNotNil(), func(sel *sql.Selector) {
+					sel.Where(sqljson.ValueEQ(job.FieldTriggerDetail, id.String(), sqljson.Path("mirrorConnectionId")))
+				}),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check running jobs: %w", err)
+	}
+	if running {
+		return nil, ErrConnectionInUse
+	}
+
+	update := tx.Connection.UpdateOne(conn).AddConfigVersion(1)
+
+	if upd.Name != nil && *upd.Name != conn.Name {
+		if err := ValidateConnectionName(*upd.Name); err != nil {
+			return nil, err
+		}
+		exists, err := tx.Connection.Query().Where(connection.Name(*upd.Name)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check new name existence: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("connection with name '%s' already exists", *upd.Name) //nolint:err113
+		}
+		update.SetName(*upd.Name)
+	}
+
+	connType := conn.Type
+	if upd.Type != nil {
+		connType = *upd.Type
+		update.SetType(connType)
+	}
+
+	if upd.Config != nil {
+		config := make(map[string]string, len(upd.Config)+1)
+		for k, v := range upd.Config {
+			config[k] = v
+		}
+		config["type"] = string(connType)
+		encryptedConfig, err := s.encryptor.EncryptConfig(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt config: %w", err)
+		}
+		update.SetEncryptedConfig(encryptedConfig)
+		setCredentialsExpiry(update.Mutation(), connType, config)
+	}
+
+	if upd.BasePath != nil {
+		if basePath := normalizeBasePath(*upd.BasePath); basePath != "" {
+			update.SetBasePath(basePath)
+		} else {
+			update.ClearBasePath()
+		}
+	}
+
+	setConnectionPacing(update.Mutation(), upd.PathTPSLimit, upd.PathTPSBurst)
+	setConnectionDisplay(update.Mutation(), upd.Display)
+
+	conn, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update connection: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit connection update: %w", err)
+	}
+	s.configs.invalidate(id)
+	return conn, nil
+}
+
+// UpdateConnectionHealth 记录连接测试结果（健康状态、测试时间和错误信息）
+// 健康检查不属于用户修改，因此保留原有的 updated_at
+func (s *ConnectionService) UpdateConnectionHealth(ctx context.Context, id uuid.UUID, status model.ConnectionHealthStatus, errMsg string) (*ent.Connection, error) {
+	conn, err := s.client.Connection.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	update := s.client.Connection.UpdateOne(conn).
+		SetHealthStatus(status).
+		SetHealthCheckedAt(time.Now()).
+		SetUpdatedAt(conn.UpdatedAt)
+	if errMsg != "" {
+		update = update.SetHealthError(errMsg)
+	} else {
+		update = update.ClearHealthError()
+	}
+
+	conn, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update connection health: %w", err)
+	}
+	return conn, nil
+}
+
+// ConfigIntegrityFailure 无法解密配置的连接及解密错误
+type ConfigIntegrityFailure struct {
+	Connection *ent.Connection
+	Err        error
+}
+
+// VerifyConfigs 不经缓存尝试解密所有连接的加密配置，返回检查的连接数及无法解密（密钥错误或数据损坏）的连接
+func (s *ConnectionService) VerifyConfigs(ctx context.Context) (int, []ConfigIntegrityFailure, error) {
+	connections, err := s.client.Connection.Query().Order(ent.Asc(connection.FieldName)).All(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+
+	var failures []ConfigIntegrityFailure
+	for _, conn := range connections {
+		if _, err := s.encryptor.DecryptConfig(conn.EncryptedConfig); err != nil {
+			failures = append(failures, ConfigIntegrityFailure{Connection: conn, Err: err})
+		}
+	}
+	return len(connections), failures, nil
+}
+
+// RecordConfigUnreadable 为连接的所有任务记录 CONFIG_UNREADABLE 事件，提示这些任务的作业将因配置无法解密而失败
+// 返回记录的任务数
+func (s *ConnectionService) RecordConfigUnreadable(ctx context.Context, connectionID uuid.UUID, message string) (int, error) {
+	taskIDs, err := s.client.Task.Query().Where(task.ConnectionID(connectionID)).IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tasks of connection: %w", err)
+	}
+	if len(taskIDs) == 0 {
+		return 0, nil
+	}
+
+	builders := make([]*ent.TaskEventCreate, len(taskIDs))
+	for i, id := range taskIDs {
+		builders[i] = s.client.TaskEvent.Create().
+			SetTaskID(id).
+			SetType(model.TaskEventTypeConfigUnreadable).
+			SetMessage(message)
+	}
+	if err := s.client.TaskEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to record task events: %w", err)
+	}
+	return len(taskIDs), nil
+}
+
+// RefreshCredentialsExpiry 根据所有连接的配置更新其凭据过期时间，并返回所有连接
+// 用于补全跟踪过期时间之前创建的连接；凭据检查不属于用户修改，因此保留原有的 updated_at
+func (s *ConnectionService) RefreshCredentialsExpiry(ctx context.Context) ([]*ent.Connection, error) {
+	connections, err := s.client.Connection.Query().Order(ent.Asc(connection.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+
+	for i, conn := range connections {
+		config, err := s.encryptor.DecryptConfig(conn.EncryptedConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt config of connection %s: %w", conn.Name, err)
+		}
+		expireAt := CredentialsExpireAt(conn.Type, config)
+		if timesEqual(expireAt, conn.CredentialsExpireAt) {
+			continue
+		}
+		update := s.client.Connection.UpdateOne(conn).SetUpdatedAt(conn.UpdatedAt)
+		setCredentialsExpiry(update.Mutation(), conn.Type, config)
+		if connections[i], err = update.Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update credentials expiry of connection %s: %w", conn.Name, err)
+		}
+	}
+	return connections, nil
+}
+
+// setCredentialsExpiry 根据连接配置设置或清除凭据过期时间
+func setCredentialsExpiry(m *ent.ConnectionMutation, connType model.ConnectionType, config map[string]string) {
+	if expireAt := CredentialsExpireAt(connType, config); expireAt != nil {
+		m.SetCredentialsExpireAt(*expireAt)
+	} else {
+		m.ClearCredentialsExpireAt()
+	}
+}
+
+// timesEqual 判断两个可为空的时间是否相同
+func timesEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// SetConnectionBasePath 设置连接的远程路径前缀，空字符串表示清除
+// 前缀会被拼接到该连接下所有任务的 remotePath 之前；保留前导 "/"，因为部分后端（如 sftp）据此区分绝对路径
+func (s *ConnectionService) SetConnectionBasePath(ctx context.Context, id uuid.UUID, basePath string) (*ent.Connection, error) {
+	conn, err := s.client.Connection.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	basePath = normalizeBasePath(basePath)
+	update := s.client.Connection.UpdateOne(conn)
+	if basePath != "" {
+		update = update.SetBasePath(basePath)
+	} else {
+		update = update.ClearBasePath()
+	}
+
+	conn, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update connection base path: %w", err)
+	}
+	return conn, nil
+}
+
+// SetConnectionPacing 设置连接每个远程路径的 API 调用速率（每秒事务数）和突发数，nil 表示不修改，0 表示清除
+// 后端按远程路径分别限速，同步不同路径的作业各自享有完整的速率
+func (s *ConnectionService) SetConnectionPacing(ctx context.Context, id uuid.UUID, pathTpsLimit *float64, pathTpsBurst *int) (*ent.Connection, error) {
+	update := s.client.Connection.UpdateOneID(id)
+	setConnectionPacing(update.Mutation(), pathTpsLimit, pathTpsBurst)
+
+	conn, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to update connection pacing: %w", err)
+	}
+	return conn, nil
+}
+
+// SetConnectionTransferCap 设置连接每个自然月的传输量上限（字节），0 表示清除
+// 上限不影响运行中的同步，只决定新作业是否推迟，因此不检查运行中的作业，也不递增配置版本
+func (s *ConnectionService) SetConnectionTransferCap(ctx context.Context, id uuid.UUID, transferCap int64) (*ent.Connection, error) {
+	update := s.client.Connection.UpdateOneID(id)
+	if transferCap > 0 {
+		update.SetMonthlyTransferCap(transferCap)
+	} else {
+		update.ClearMonthlyTransferCap()
+	}
+
+	conn, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to update connection transfer cap: %w", err)
+	}
+	return conn, nil
+}
+
+// ConnectionDisplay 描述连接在界面中的显示信息，nil 字段保持不变，空字符串表示清除
+type ConnectionDisplay struct {
+	DisplayName *string
+	Color       *string // #rrggbb
+	Icon        *string
+}
+
+// IsEmpty 判断是否没有要修改的显示信息
+func (d ConnectionDisplay) IsEmpty() bool {
+	return d.DisplayName == nil && d.Color == nil && d.Icon == nil
+}
+
+// SetConnectionDisplay 设置连接的显示名称、颜色和图标
+// 显示信息不影响同步，因此不检查运行中的作业，也不递增配置版本
+func (s *ConnectionService) SetConnectionDisplay(ctx context.Context, id uuid.UUID, display ConnectionDisplay) (*ent.Connection, error) {
+	update := s.client.Connection.UpdateOneID(id)
+	setConnectionDisplay(update.Mutation(), display)
+
+	conn, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to update connection display: %w", err)
+	}
+	return conn, nil
+}
+
+// setConnectionDisplay 将显示信息写入连接的变更，颜色统一为小写
+func setConnectionDisplay(m *ent.ConnectionMutation, display ConnectionDisplay) {
+	if display.DisplayName != nil {
+		if name := strings.TrimSpace(*display.DisplayName); name != "" {
+			m.SetDisplayName(name)
+		} else {
+			m.ClearDisplayName()
+		}
+	}
+	if display.Color != nil {
+		if *display.Color != "" {
+			m.SetColor(strings.ToLower(*display.Color))
+		} else {
+			m.ClearColor()
+		}
+	}
+	if display.Icon != nil {
+		if *display.Icon != "" {
+			m.SetIcon(*display.Icon)
+		} else {
+			m.ClearIcon()
+		}
+	}
+}
+
+// setConnectionPacing 将 API 调用速率和突发数写入连接的变更，nil 表示不修改，0 表示清除
+func setConnectionPacing(m *ent.ConnectionMutation, pathTpsLimit *float64, pathTpsBurst *int) {
+	switch {
+	case pathTpsLimit == nil:
+	case *pathTpsLimit > 0:
+		m.SetPathTpsLimit(*pathTpsLimit)
+	default:
+		m.ClearPathTpsLimit()
+	}
+	switch {
+	case pathTpsBurst == nil:
+	case *pathTpsBurst > 0:
+		m.SetPathTpsBurst(*pathTpsBurst)
+	default:
+		m.ClearPathTpsBurst()
+	}
+}
+
+// normalizeBasePath 去除远程路径前缀首尾的空白和末尾的 "/"（"/" 本身除外）
+func normalizeBasePath(basePath string) string {
+	basePath = strings.TrimSpace(basePath)
+	if basePath != "/" {
+		basePath = strings.TrimRight(basePath, "/")
+	}
+	return basePath
+}
+
 // DeleteConnectionByName 根据名称删除连接（级联删除关联的任务）
 func (s *ConnectionService) DeleteConnectionByName(ctx context.Context, name string) error {
 	conn, err := s.GetConnectionByName(ctx, name)
@@ -295,6 +765,7 @@ func (s *ConnectionService) DeleteConnectionByName(ctx context.Context, name str
 
 	// Ent 会自动处理级联删除（schema 中已定义）
 	err = s.client.Connection.DeleteOne(conn).Exec(ctx)
+	s.configs.invalidate(conn.ID)
 	if err != nil {
 		return fmt.Errorf("failed to delete connection: %w", err)
 	}
@@ -311,6 +782,7 @@ func (s *ConnectionService) DeleteConnectionByID(ctx context.Context, id uuid.UU
 
 	// Ent 会自动处理级联删除（schema 中已定义）
 	err = s.client.Connection.DeleteOne(conn).Exec(ctx)
+	s.configs.invalidate(conn.ID)
 	if err != nil {
 		return fmt.Errorf("failed to delete connection: %w", err)
 	}
@@ -318,21 +790,12 @@ func (s *ConnectionService) DeleteConnectionByID(ctx context.Context, id uuid.UU
 	return nil
 }
 
-// HasAssociatedTasks 检查连接是否有关联的任务
+// HasAssociatedTasks 检查连接是否有关联的任务（不含已删除的任务和临时任务），见 CountAssociatedTasks
 func (s *ConnectionService) HasAssociatedTasks(ctx context.Context, connectionID uuid.UUID) (bool, error) {
-	conn, err := s.client.Connection.Get(ctx, connectionID)
+	count, err := s.CountAssociatedTasks(ctx, connectionID)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return false, errConnectionNotFound
-		}
-		return false, fmt.Errorf("failed to get connection: %w", err)
+		return false, err
 	}
-
-	count, err := conn.QueryTasks().Count(ctx)
-	if err != nil {
-		return false, fmt.Errorf("failed to count tasks: %w", err)
-	}
-
 	return count > 0, nil
 }
 
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
 	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
 )
 
 // setupTestDB creates a test database and returns the client
@@ -50,7 +52,7 @@ func TestConnectionService_CreateConnection(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, conn)
 	assert.Equal(t, "my-onedrive", conn.Name)
-	assert.Equal(t, "onedrive", conn.Type)
+	assert.Equal(t, model.ConnectionTypeOnedrive, conn.Type)
 	assert.NotEmpty(t, conn.ID)
 	assert.NotEmpty(t, conn.EncryptedConfig)
 	assert.NotZero(t, conn.CreatedAt)
@@ -61,7 +63,7 @@ func TestConnectionService_CreateConnection(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, conn.ID, retrieved.ID)
 	assert.Equal(t, "my-onedrive", retrieved.Name)
-	assert.Equal(t, "onedrive", retrieved.Type)
+	assert.Equal(t, model.ConnectionTypeOnedrive, retrieved.Type)
 
 	// Verify config is encrypted (can be decrypted)
 	decryptedConfig, err := encryptor.DecryptConfig(retrieved.EncryptedConfig)
@@ -222,7 +224,7 @@ func TestConnectionService_ListConnections(t *testing.T) {
 
 	// Verify connections are returned (order may vary)
 	names := make(map[string]bool)
-	types := make(map[string]string)
+	types := make(map[string]model.ConnectionType)
 	for _, conn := range connections {
 		names[conn.Name] = true
 		types[conn.Name] = conn.Type
@@ -235,9 +237,9 @@ func TestConnectionService_ListConnections(t *testing.T) {
 	assert.True(t, names["my-s3"])
 	assert.True(t, names["my-onedrive"])
 	assert.True(t, names["my-dropbox"])
-	assert.Equal(t, "s3", types["my-s3"])
-	assert.Equal(t, "onedrive", types["my-onedrive"])
-	assert.Equal(t, "dropbox", types["my-dropbox"])
+	assert.Equal(t, model.ConnectionTypeS3, types["my-s3"])
+	assert.Equal(t, model.ConnectionTypeOnedrive, types["my-onedrive"])
+	assert.Equal(t, model.ConnectionTypeDropbox, types["my-dropbox"])
 
 	// Verify IDs match
 	assert.Contains(t, []string{conn1.ID.String(), conn2.ID.String(), conn3.ID.String()}, connections[0].ID.String())
@@ -307,7 +309,7 @@ func TestConnectionService_GetConnectionByName(t *testing.T) {
 	assert.NotNil(t, conn)
 	assert.Equal(t, created.ID, conn.ID)
 	assert.Equal(t, "test-connection", conn.Name)
-	assert.Equal(t, "onedrive", conn.Type)
+	assert.Equal(t, model.ConnectionTypeOnedrive, conn.Type)
 	assert.NotEmpty(t, conn.EncryptedConfig)
 	assert.NotZero(t, conn.CreatedAt)
 	assert.NotZero(t, conn.UpdatedAt)
@@ -388,7 +390,7 @@ func TestConnectionService_UpdateConnection(t *testing.T) {
 	assert.NotNil(t, updated)
 	assert.Equal(t, conn.ID, updated.ID)
 	assert.Equal(t, "my-s3", updated.Name)
-	assert.Equal(t, "s3", updated.Type) // Type should not change
+	assert.Equal(t, model.ConnectionTypeS3, updated.Type) // Type should not change
 	assert.NotEmpty(t, updated.EncryptedConfig)
 
 	// UpdatedAt should change
@@ -757,7 +759,7 @@ func TestConnectionService_GetConnectionByID(t *testing.T) {
 		assert.NotNil(t, conn)
 		assert.Equal(t, created.ID, conn.ID)
 		assert.Equal(t, "test-by-id", conn.Name)
-		assert.Equal(t, "s3", conn.Type)
+		assert.Equal(t, model.ConnectionTypeS3, conn.Type)
 		assert.NotEmpty(t, conn.EncryptedConfig)
 	})
 
@@ -958,7 +960,7 @@ func TestConnectionService_UpdateConnection_TypeChange(t *testing.T) {
 	require.NoError(t, err)
 
 	// Update type
-	newType := "onedrive"
+	newType := model.ConnectionTypeOnedrive
 	updatedConfig := map[string]string{
 		"type": "onedrive",
 	}
@@ -969,7 +971,7 @@ func TestConnectionService_UpdateConnection_TypeChange(t *testing.T) {
 	// Verify type changed
 	updated, err := service.GetConnectionByID(ctx, conn.ID)
 	require.NoError(t, err)
-	assert.Equal(t, "onedrive", updated.Type)
+	assert.Equal(t, model.ConnectionTypeOnedrive, updated.Type)
 }
 
 // Test ValidateConnectionName directly
@@ -1029,7 +1031,7 @@ func TestConnectionService_ListConnectionsPaginated(t *testing.T) {
 	ctx := context.Background()
 
 	// Initially empty
-	conns, total, err := service.ListConnectionsPaginated(ctx, 10, 0)
+	conns, total, err := service.ListConnectionsPaginated(ctx, 10, 0, model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc)
 	require.NoError(t, err)
 	assert.Empty(t, conns)
 	assert.Equal(t, 0, total)
@@ -1042,41 +1044,89 @@ func TestConnectionService_ListConnectionsPaginated(t *testing.T) {
 	}
 
 	t.Run("FirstPage", func(t *testing.T) {
-		conns, total, err := service.ListConnectionsPaginated(ctx, 2, 0)
+		conns, total, err := service.ListConnectionsPaginated(ctx, 2, 0, model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, conns, 2)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("SecondPage", func(t *testing.T) {
-		conns, total, err := service.ListConnectionsPaginated(ctx, 2, 2)
+		conns, total, err := service.ListConnectionsPaginated(ctx, 2, 2, model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, conns, 2)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("LastPage", func(t *testing.T) {
-		conns, total, err := service.ListConnectionsPaginated(ctx, 2, 4)
+		conns, total, err := service.ListConnectionsPaginated(ctx, 2, 4, model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, conns, 1)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("OffsetBeyondTotal", func(t *testing.T) {
-		conns, total, err := service.ListConnectionsPaginated(ctx, 10, 100)
+		conns, total, err := service.ListConnectionsPaginated(ctx, 10, 100, model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Empty(t, conns)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("LargeLimit", func(t *testing.T) {
-		conns, total, err := service.ListConnectionsPaginated(ctx, 100, 0)
+		conns, total, err := service.ListConnectionsPaginated(ctx, 100, 0, model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, conns, 5)
 		assert.Equal(t, 5, total)
 	})
 }
 
+func TestConnectionService_ListConnectionsPaginated_Sorted(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	taskService := NewTaskService(client)
+	jobService := NewJobService(client)
+	ctx := context.Background()
+
+	create := func(name string, typ model.ConnectionType) *ent.Connection {
+		conn, err := service.CreateConnection(ctx, name, typ, map[string]string{"type": string(typ)})
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond) // Ensure different creation times
+		return conn
+	}
+	beta, alpha, gamma := create("beta", "local"), create("alpha", "memory"), create("gamma", "local")
+	_, err := service.UpdateConnectionHealth(ctx, alpha.ID, model.ConnectionHealthStatusUnhealthy, "boom")
+	require.NoError(t, err)
+	_, err = service.UpdateConnectionHealth(ctx, beta.ID, model.ConnectionHealthStatusHealthy, "")
+	require.NoError(t, err)
+
+	// A task of gamma ran last, one of beta before it and alpha has none
+	for _, conn := range []*ent.Connection{beta, gamma} {
+		task, err := taskService.CreateTask(ctx, "task-"+conn.Name, "/src", conn.ID, "/dst", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		_, err = jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+	}
+
+	names := func(sortBy model.ConnectionSortField, direction model.SortDirection) []string {
+		conns, total, err := service.ListConnectionsPaginated(ctx, 0, 0, sortBy, direction)
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		var names []string
+		for _, conn := range conns {
+			names = append(names, conn.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, names(model.ConnectionSortFieldName, model.SortDirectionAsc))
+	assert.Equal(t, []string{"gamma", "alpha", "beta"}, names(model.ConnectionSortFieldCreatedAt, model.SortDirectionDesc))
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, names(model.ConnectionSortFieldType, model.SortDirectionDesc), "by type, then by name")
+	assert.Equal(t, []string{"beta", "alpha", "gamma"}, names(model.ConnectionSortFieldHealth, model.SortDirectionAsc), "untested connections are last")
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, names(model.ConnectionSortFieldHealth, model.SortDirectionDesc), "untested connections are last")
+	assert.Equal(t, []string{"gamma", "beta", "alpha"}, names(model.ConnectionSortFieldLastJobAt, model.SortDirectionDesc), "connections without jobs are last")
+}
+
 // Tests for CountAssociatedTasks
 func TestConnectionService_CountAssociatedTasks(t *testing.T) {
 	client := setupTestDB(t)
@@ -1121,6 +1171,30 @@ func TestConnectionService_CountAssociatedTasks(t *testing.T) {
 		assert.Equal(t, 3, count)
 	})
 
+	t.Run("MirrorTasks", func(t *testing.T) {
+		mirrorConn, err := connService.CreateConnection(ctx, "count-tasks-mirror", "local", config)
+		require.NoError(t, err)
+		mirrors := &model.TaskSyncOptions{Mirrors: []*model.TaskMirror{{ConnectionID: mirrorConn.ID, RemotePath: "/mirror"}}}
+		_, err = taskService.CreateTask(ctx, "mirrored", "/src4", conn.ID, "/dst4", string(model.SyncDirectionUpload), "", false, mirrors)
+		require.NoError(t, err)
+
+		// A connection only used as a mirror still has a dependent task
+		count, err := connService.CountAssociatedTasks(ctx, mirrorConn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		hasTasks, err := connService.HasAssociatedTasks(ctx, mirrorConn.ID)
+		require.NoError(t, err)
+		assert.True(t, hasTasks)
+
+		// A task mirroring to its own connection is only counted once
+		self := &model.TaskSyncOptions{Mirrors: []*model.TaskMirror{{ConnectionID: mirrorConn.ID, RemotePath: "/copy"}}}
+		_, err = taskService.CreateTask(ctx, "self-mirrored", "/src5", mirrorConn.ID, "/dst5", string(model.SyncDirectionUpload), "", false, self)
+		require.NoError(t, err)
+		count, err = connService.CountAssociatedTasks(ctx, mirrorConn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
 	t.Run("NotFound", func(t *testing.T) {
 		_, err := connService.CountAssociatedTasks(ctx, uuid.New())
 		assert.Error(t, err)
@@ -1143,3 +1217,218 @@ func TestConnectionService_CreateConnection_EmptyType(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "type cannot be empty")
 }
+
+func TestConnectionService_UpdateConnectionHealth(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	ctx := context.Background()
+
+	conn, err := service.CreateConnection(ctx, "health-conn", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	assert.Nil(t, conn.HealthStatus)
+
+	t.Run("records failure", func(t *testing.T) {
+		updated, err := service.UpdateConnectionHealth(ctx, conn.ID, model.ConnectionHealthStatusUnhealthy, "dial tcp: timeout")
+		require.NoError(t, err)
+		require.NotNil(t, updated.HealthStatus)
+		assert.Equal(t, model.ConnectionHealthStatusUnhealthy, *updated.HealthStatus)
+		assert.Equal(t, "dial tcp: timeout", updated.HealthError)
+		assert.NotNil(t, updated.HealthCheckedAt)
+		// Health checks are not user edits
+		assert.True(t, conn.UpdatedAt.Equal(updated.UpdatedAt))
+	})
+
+	t.Run("success clears error", func(t *testing.T) {
+		updated, err := service.UpdateConnectionHealth(ctx, conn.ID, model.ConnectionHealthStatusHealthy, "")
+		require.NoError(t, err)
+		assert.Equal(t, model.ConnectionHealthStatusHealthy, *updated.HealthStatus)
+		assert.Empty(t, updated.HealthError)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := service.UpdateConnectionHealth(ctx, uuid.New(), model.ConnectionHealthStatusHealthy, "")
+		assert.ErrorIs(t, err, errConnectionNotFound)
+	})
+}
+
+func TestConnectionService_SetConnectionBasePath(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	ctx := context.Background()
+
+	conn, err := service.CreateConnection(ctx, "base-path-conn", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	assert.Empty(t, conn.BasePath)
+
+	t.Run("sets and normalizes", func(t *testing.T) {
+		updated, err := service.SetConnectionBasePath(ctx, conn.ID, " /backups-v2/ ")
+		require.NoError(t, err)
+		assert.Equal(t, "/backups-v2", updated.BasePath)
+	})
+
+	t.Run("keeps root", func(t *testing.T) {
+		updated, err := service.SetConnectionBasePath(ctx, conn.ID, "/")
+		require.NoError(t, err)
+		assert.Equal(t, "/", updated.BasePath)
+	})
+
+	t.Run("empty clears", func(t *testing.T) {
+		updated, err := service.SetConnectionBasePath(ctx, conn.ID, "")
+		require.NoError(t, err)
+		assert.Empty(t, updated.BasePath)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := service.SetConnectionBasePath(ctx, uuid.New(), "/backups")
+		assert.ErrorIs(t, err, errConnectionNotFound)
+	})
+}
+
+func TestConnectionService_ApplyConnectionUpdate(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	taskService := NewTaskService(client)
+	jobService := NewJobService(client)
+	ctx := context.Background()
+
+	conn, err := service.CreateConnection(ctx, "apply-conn", "local", map[string]string{"type": "local", "key": "v1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, conn.ConfigVersion)
+
+	t.Run("applies all fields and bumps the version", func(t *testing.T) {
+		name, basePath := "apply-conn-renamed", " /backups/ "
+		updated, err := service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{
+			Name:            &name,
+			Config:          map[string]string{"key": "v2"},
+			BasePath:        &basePath,
+			ExpectedVersion: &conn.ConfigVersion,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, name, updated.Name)
+		assert.Equal(t, "/backups", updated.BasePath)
+		assert.Equal(t, 2, updated.ConfigVersion)
+
+		config, err := service.GetConnectionConfigByID(ctx, conn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"type": "local", "key": "v2"}, config)
+	})
+
+	t.Run("nil config keeps the config", func(t *testing.T) {
+		basePath := ""
+		updated, err := service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{BasePath: &basePath})
+		require.NoError(t, err)
+		assert.Empty(t, updated.BasePath)
+		assert.Equal(t, 3, updated.ConfigVersion)
+
+		config, err := service.GetConnectionConfigByID(ctx, conn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "v2", config["key"])
+	})
+
+	t.Run("version conflict", func(t *testing.T) {
+		stale := 1
+		_, err := service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{Config: map[string]string{"key": "v3"}, ExpectedVersion: &stale})
+		assert.ErrorIs(t, err, ErrConnectionVersionConflict)
+	})
+
+	t.Run("running job blocks the update", func(t *testing.T) {
+		task, err := taskService.CreateTask(ctx, "apply-task", "/src", conn.ID, "/dst", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		job, err := jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		require.NotNil(t, job.ConnectionConfigVersion)
+		assert.Equal(t, 3, *job.ConnectionConfigVersion)
+		_, err = jobService.UpdateJobStatus(ctx, job.ID, string(model.JobStatusRunning), "")
+		require.NoError(t, err)
+
+		_, err = service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{Config: map[string]string{"key": "v3"}})
+		assert.ErrorIs(t, err, ErrConnectionInUse)
+		current, err := service.GetConnectionByID(ctx, conn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, current.ConfigVersion, "a blocked update does not bump the version")
+
+		_, err = jobService.UpdateJobStatus(ctx, job.ID, string(model.JobStatusSuccess), "")
+		require.NoError(t, err)
+		updated, err := service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{Config: map[string]string{"key": "v3"}})
+		require.NoError(t, err)
+		assert.Equal(t, 4, updated.ConfigVersion)
+	})
+
+	t.Run("running mirror job blocks the update", func(t *testing.T) {
+		other, err := service.CreateConnection(ctx, "apply-conn-primary", "local", map[string]string{"type": "local"})
+		require.NoError(t, err)
+		task, err := taskService.CreateTask(ctx, "apply-mirrored-task", "/src", other.ID, "/dst", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		parent, err := jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		mirrorCtx := provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{MirrorConnectionID: &conn.ID})
+		mirror, err := jobService.CreateChildJob(mirrorCtx, parent.ID, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		_, err = jobService.UpdateJobStatus(ctx, mirror.ID, string(model.JobStatusRunning), "")
+		require.NoError(t, err)
+
+		_, err = service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{Config: map[string]string{"key": "v4"}})
+		assert.ErrorIs(t, err, ErrConnectionInUse)
+
+		_, err = jobService.UpdateJobStatus(ctx, mirror.ID, string(model.JobStatusSuccess), "")
+		require.NoError(t, err)
+		_, err = service.ApplyConnectionUpdate(ctx, conn.ID, ConnectionUpdate{Config: map[string]string{"key": "v4"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := service.ApplyConnectionUpdate(ctx, uuid.New(), ConnectionUpdate{})
+		assert.ErrorIs(t, err, errConnectionNotFound)
+	})
+}
+
+func TestConnectionService_ConnectionExists(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	service := NewConnectionService(client, setupTestEncryptor(t))
+	ctx := context.Background()
+
+	conn, err := service.CreateConnection(ctx, "exists-conn", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	exists, err := service.ConnectionExists(ctx, conn.ID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = service.ConnectionExists(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	exists, err = service.ConnectionNameExists(ctx, "exists-conn")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = service.ConnectionNameExists(ctx, "other-conn")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+// Test CreateConnection with a type that is not a compiled rclone backend
+func TestConnectionService_CreateConnection_UnknownType(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	encryptor := setupTestEncryptor(t)
+	service := NewConnectionService(client, encryptor)
+
+	ctx := context.Background()
+
+	_, err := service.CreateConnection(ctx, "typo", "onedrve", map[string]string{})
+	require.ErrorIs(t, err, errTypeUnknown)
+
+	exists, err := service.ConnectionNameExists(ctx, "typo")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
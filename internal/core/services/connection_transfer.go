@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectiontransfer"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+// MonthlyTransfer returns the bytes transferred by the jobs of a connection in the calendar month of t,
+// including the mirror jobs of other tasks uploading to it.
+func (s *JobService) MonthlyTransfer(ctx context.Context, connectionID uuid.UUID, t time.Time) (int64, error) {
+	transfer, err := s.client.ConnectionTransfer.Query().
+		Where(connectiontransfer.ConnectionID(connectionID), connectiontransfer.Month(startOfMonth(t))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Join(errs.ErrSystem, err)
+	}
+	return transfer.Bytes, nil
+}
+
+// ListMonthlyTransfers returns the transfers of a connection in the last months calendar months, including
+// the current one, oldest first. Months without transfers are left out.
+func (s *JobService) ListMonthlyTransfers(ctx context.Context, connectionID uuid.UUID, months int) ([]*ent.ConnectionTransfer, error) {
+	since := startOfMonth(time.Now()).AddDate(0, 1-months, 0)
+	transfers, err := s.client.ConnectionTransfer.Query().
+		Where(connectiontransfer.ConnectionID(connectionID), connectiontransfer.MonthGTE(since)).
+		Order(ent.Asc(connectiontransfer.FieldMonth)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return transfers, nil
+}
+
+// recordTransferTx adds the bytes transferred by a job to the current month of the connection it transferred to:
+// the connection of its task for top-level jobs, the connection of the mirror for mirror jobs. Shard jobs are
+// counted through their parent.
+func recordTransferTx(ctx context.Context, client *ent.Client, j *ent.Job, bytes int64) error {
+	if bytes <= 0 {
+		return nil
+	}
+	var connectionID uuid.UUID
+	switch {
+	case j.ParentID == nil:
+		t, err := client.Task.Get(ctx, j.TaskID)
+		if err != nil {
+			return err
+		}
+		connectionID = t.ConnectionID
+	case j.TriggerDetail != nil && j.TriggerDetail.MirrorConnectionID != nil:
+		connectionID = *j.TriggerDetail.MirrorConnectionID
+	default:
+		return nil
+	}
+
+	month := startOfMonth(time.Now())
+	existing, err := client.ConnectionTransfer.Query().
+		Where(connectiontransfer.ConnectionID(connectionID), connectiontransfer.Month(month)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return client.ConnectionTransfer.Create().
+			SetConnectionID(connectionID).
+			SetMonth(month).
+			SetBytes(bytes).
+			Exec(ctx)
+	case err != nil:
+		return err
+	}
+	return existing.Update().AddBytes(bytes).Exec(ctx)
+}
+
+// recordQuotaDeferredTx records a QUOTA_DEFERRED task event for a run that was deferred since the
+// connection of the task reached its monthly transfer cap.
+func recordQuotaDeferredTx(ctx context.Context, client *ent.Client, taskID uuid.UUID, reason string) error {
+	return client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeQuotaDeferred).
+		SetMessage(reason).
+		Exec(ctx)
+}
+
+// startOfMonth returns the start of the calendar month of t in its location.
+func startOfMonth(t time.Time) time.Time {
+	year, month, _ := t.Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+}
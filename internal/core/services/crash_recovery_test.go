@@ -154,7 +154,7 @@ func TestCrashRecovery_ResetStuckJobs(t *testing.T) {
 
 	// 4. Execute Recovery Logic
 	// This is the method called in serve.go on startup
-	err = jobSvc.ResetStuckJobs(ctx)
+	_, err = jobSvc.ResetStuckJobs(ctx)
 	assert.NoError(t, err)
 
 	// 5. Verify Results
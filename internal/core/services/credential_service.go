@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+// DefaultCredentialWarningDays is the number of days ahead of their expiry credentials are warned about if none is configured.
+const DefaultCredentialWarningDays = 14
+
+// refreshTokenLifetimes are the times the refresh tokens of backends lapse after their last use.
+// The refresh tokens of other backends don't lapse as long as the app is authorized.
+var refreshTokenLifetimes = map[model.ConnectionType]time.Duration{
+	model.ConnectionTypeOnedrive: 90 * 24 * time.Hour,
+	model.ConnectionTypeBox:      60 * 24 * time.Hour,
+}
+
+// oauthToken is the part of the OAuth token rclone stores as JSON in the "token" option of a remote.
+type oauthToken struct {
+	RefreshToken string    `json:"refresh_token"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// CredentialsExpireAt returns the time the credentials of a connection config lapse unless refreshed,
+// nil if they don't lapse or the config has no OAuth token with an expiry.
+// Refreshing the access token renews the refresh token, so refresh tokens that lapse expire their lifetime
+// after the access token expiry. Without a refresh token, the credentials expire with the access token.
+func CredentialsExpireAt(connType model.ConnectionType, config map[string]string) *time.Time {
+	var token oauthToken
+	if err := json.Unmarshal([]byte(config["token"]), &token); err != nil || token.Expiry.IsZero() {
+		return nil
+	}
+	if token.RefreshToken == "" {
+		return &token.Expiry
+	}
+	lifetime, ok := refreshTokenLifetimes[connType]
+	if !ok {
+		return nil
+	}
+	expireAt := token.Expiry.Add(lifetime)
+	return &expireAt
+}
+
+// CredentialService checks the credentials of connections and warns ahead of their expiry.
+type CredentialService struct {
+	connections *ConnectionService
+	logger      *zap.Logger
+	warningDays int
+	cron        *cron.Cron
+	now         func() time.Time
+}
+
+// NewCredentialService creates a new CredentialService instance that warns about credentials
+// expiring within warningDays days (DefaultCredentialWarningDays if not positive).
+func NewCredentialService(connections *ConnectionService, warningDays int) *CredentialService {
+	if warningDays <= 0 {
+		warningDays = DefaultCredentialWarningDays
+	}
+	return &CredentialService{
+		connections: connections,
+		logger:      logger.Named("service.credential"),
+		warningDays: warningDays,
+		now:         time.Now,
+	}
+}
+
+// Start checks the credentials of all connections now and then with the given cron schedule.
+func (s *CredentialService) Start(schedule string) error {
+	s.logger.Info("Starting credential expiry checks",
+		zap.String("schedule", schedule),
+		zap.Int("warning_days", s.warningDays))
+
+	s.cron = cron.New()
+	if _, err := s.cron.AddFunc(schedule, s.check); err != nil {
+		return err
+	}
+	s.cron.Start()
+	// Also fills in the expiry of connections created before it was tracked
+	go s.check()
+	return nil
+}
+
+// Stop stops checking.
+func (s *CredentialService) Stop() {
+	if s.cron != nil {
+		s.logger.Info("Stopping credential expiry checks")
+		s.cron.Stop()
+		s.cron = nil
+	}
+}
+
+// check runs CheckAll, logging its error.
+func (s *CredentialService) check() {
+	if _, err := s.CheckAll(context.Background()); err != nil {
+		s.logger.Error("Credential expiry check failed", zap.Error(err))
+	}
+}
+
+// ExpiringSoon reports whether credentials expiring at expireAt expire within the warning threshold or already expired.
+func (s *CredentialService) ExpiringSoon(expireAt *time.Time) bool {
+	return expireAt != nil && expireAt.Before(s.now().AddDate(0, 0, s.warningDays))
+}
+
+// CheckAll updates the credential expiry of every connection from its config and logs a warning
+// for each connection whose credentials expire within the warning threshold.
+// It returns the connections warned about.
+func (s *CredentialService) CheckAll(ctx context.Context) ([]*ent.Connection, error) {
+	connections, err := s.connections.RefreshCredentialsExpiry(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	var expiring []*ent.Connection
+	for _, conn := range connections {
+		if !s.ExpiringSoon(conn.CredentialsExpireAt) {
+			continue
+		}
+		expiring = append(expiring, conn)
+		if conn.CredentialsExpireAt.Before(s.now()) {
+			s.logger.Error("Connection credentials expired",
+				zap.String("connection", conn.Name),
+				zap.Time("expired_at", *conn.CredentialsExpireAt))
+			continue
+		}
+		s.logger.Warn("Connection credentials expire soon",
+			zap.String("connection", conn.Name),
+			zap.Time("expire_at", *conn.CredentialsExpireAt))
+	}
+
+	s.logger.Info("Credential expiry check completed", zap.Int("connections", len(connections)), zap.Int("expiring", len(expiring)))
+	return expiring, nil
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+)
+
+// oauthTokenConfig returns the "token" option of an rclone OAuth remote expiring at expiry.
+func oauthTokenConfig(expiry time.Time, refreshToken string) string {
+	return `{"access_token":"access","token_type":"Bearer","refresh_token":"` + refreshToken + `","expiry":"` + expiry.Format(time.RFC3339Nano) + `"}`
+}
+
+func TestCredentialsExpireAt(t *testing.T) {
+	expiry := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	expireAt := CredentialsExpireAt("onedrive", map[string]string{"token": oauthTokenConfig(expiry, "refresh")})
+	require.NotNil(t, expireAt)
+	assert.Equal(t, expiry.AddDate(0, 0, 90), expireAt.UTC(), "onedrive refresh tokens lapse after 90 days")
+
+	assert.Nil(t, CredentialsExpireAt("drive", map[string]string{"token": oauthTokenConfig(expiry, "refresh")}),
+		"drive refresh tokens don't lapse")
+
+	expireAt = CredentialsExpireAt("drive", map[string]string{"token": oauthTokenConfig(expiry, "")})
+	require.NotNil(t, expireAt)
+	assert.Equal(t, expiry, expireAt.UTC(), "without a refresh token the access token expiry is the credentials expiry")
+
+	assert.Nil(t, CredentialsExpireAt("s3", map[string]string{"access_key_id": "key"}))
+	assert.Nil(t, CredentialsExpireAt("onedrive", map[string]string{"token": "not json"}))
+	assert.Nil(t, CredentialsExpireAt("onedrive", map[string]string{"token": `{"refresh_token":"refresh"}`}))
+}
+
+func TestCredentialService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	service := NewCredentialService(connService, 14)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	// Refreshed 80 days ago, lapses in 10 days
+	expiring, err := connService.CreateConnection(ctx, "expiring", "onedrive", map[string]string{"token": oauthTokenConfig(now.AddDate(0, 0, -80), "refresh")})
+	require.NoError(t, err)
+	require.NotNil(t, expiring.CredentialsExpireAt)
+	assert.True(t, service.ExpiringSoon(expiring.CredentialsExpireAt))
+
+	fresh, err := connService.CreateConnection(ctx, "fresh", "onedrive", map[string]string{"token": oauthTokenConfig(now, "refresh")})
+	require.NoError(t, err)
+	assert.False(t, service.ExpiringSoon(fresh.CredentialsExpireAt))
+
+	local, err := connService.CreateConnection(ctx, "local", "local", nil)
+	require.NoError(t, err)
+	assert.Nil(t, local.CredentialsExpireAt)
+	assert.False(t, service.ExpiringSoon(local.CredentialsExpireAt))
+
+	t.Run("TokenRefresh", func(t *testing.T) {
+		// rclone writes the refreshed token back through UpdateConnection
+		require.NoError(t, connService.UpdateConnection(ctx, fresh.ID, nil, nil, map[string]string{"token": oauthTokenConfig(now.AddDate(0, 0, 1), "refresh")}))
+		conn, err := connService.GetConnectionByID(ctx, fresh.ID)
+		require.NoError(t, err)
+		assert.WithinDuration(t, now.AddDate(0, 0, 91), *conn.CredentialsExpireAt, time.Second)
+
+		updated, err := connService.ApplyConnectionUpdate(ctx, fresh.ID, ConnectionUpdate{Config: map[string]string{"token": oauthTokenConfig(now, "refresh")}})
+		require.NoError(t, err)
+		assert.WithinDuration(t, now.AddDate(0, 0, 90), *updated.CredentialsExpireAt, time.Second)
+	})
+
+	t.Run("CheckAll", func(t *testing.T) {
+		// Connections created before the expiry was tracked are filled in, keeping their updated_at
+		_, err := client.Connection.UpdateOneID(expiring.ID).ClearCredentialsExpireAt().SetUpdatedAt(expiring.UpdatedAt).Save(ctx)
+		require.NoError(t, err)
+
+		warned, err := service.CheckAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, warned, 1)
+		assert.Equal(t, expiring.ID, warned[0].ID)
+
+		conn, err := connService.GetConnectionByID(ctx, expiring.ID)
+		require.NoError(t, err)
+		require.NotNil(t, conn.CredentialsExpireAt)
+		assert.True(t, conn.CredentialsExpireAt.Equal(*expiring.CredentialsExpireAt))
+		assert.True(t, conn.UpdatedAt.Equal(expiring.UpdatedAt))
+
+		// Expired credentials are reported as well
+		service.now = func() time.Time { return now.AddDate(0, 0, 100) }
+		warned, err = service.CheckAll(ctx)
+		require.NoError(t, err)
+		assert.Len(t, warned, 2)
+	})
+}
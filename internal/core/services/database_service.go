@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+// ErrOptimizeRunning is returned by Optimize while another optimization is running.
+const ErrOptimizeRunning = errs.ConstError("database optimization is already running")
+
+// DatabaseOptimization is the report of a database optimization.
+type DatabaseOptimization struct {
+	// StartedAt is the time the optimization started at.
+	StartedAt time.Time
+	// Duration is the time the optimization took.
+	Duration time.Duration
+	// SizeBefore is the size of the database in bytes before the optimization.
+	SizeBefore int64
+	// SizeAfter is the size of the database in bytes after the optimization.
+	SizeAfter int64
+}
+
+// Reclaimed returns the number of bytes the optimization reclaimed, negative if the database grew.
+func (o *DatabaseOptimization) Reclaimed() int64 {
+	return o.SizeBefore - o.SizeAfter
+}
+
+// DatabaseService runs database maintenance: VACUUM rebuilds the SQLite database file to reclaim the space
+// of deleted rows (such as cleaned up job logs), and ANALYZE refreshes the statistics of the query planner.
+type DatabaseService struct {
+	client *ent.Client
+	logger *zap.Logger
+	cron   *cron.Cron
+
+	running sync.Mutex // Held while an optimization runs
+
+	mu   sync.RWMutex
+	last *DatabaseOptimization
+}
+
+// NewDatabaseService creates a new DatabaseService instance.
+func NewDatabaseService(client *ent.Client) *DatabaseService {
+	return &DatabaseService{
+		client: client,
+		logger: logger.Named("service.database"),
+	}
+}
+
+// Start optimizes the database with the given cron schedule, which should fall into quiet hours
+// since VACUUM blocks writes while it runs. Scheduled runs are skipped while busy reports true.
+func (s *DatabaseService) Start(schedule string, busy func() bool) error {
+	s.logger.Info("Starting database optimization", zap.String("schedule", schedule))
+
+	s.cron = cron.New()
+	if _, err := s.cron.AddFunc(schedule, func() {
+		if busy != nil && busy() {
+			s.logger.Info("Skipping database optimization while tasks are running")
+			return
+		}
+		if _, err := s.Optimize(context.Background()); err != nil {
+			s.logger.Error("Database optimization failed", zap.Error(err))
+		}
+	}); err != nil {
+		return err
+	}
+	s.cron.Start()
+	return nil
+}
+
+// Stop stops the scheduled optimizations.
+func (s *DatabaseService) Stop() {
+	if s.cron != nil {
+		s.logger.Info("Stopping database optimization")
+		s.cron.Stop()
+		s.cron = nil
+	}
+}
+
+// Optimize runs VACUUM and ANALYZE, truncates the write-ahead log and reports the space reclaimed.
+// It returns ErrOptimizeRunning if another optimization is running.
+func (s *DatabaseService) Optimize(ctx context.Context) (*DatabaseOptimization, error) {
+	if !s.running.TryLock() {
+		return nil, ErrOptimizeRunning
+	}
+	defer s.running.Unlock()
+
+	report := &DatabaseOptimization{StartedAt: time.Now()}
+	size, err := s.size(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.SizeBefore = size
+
+	for _, stmt := range []string{"VACUUM", "ANALYZE", "PRAGMA wal_checkpoint(TRUNCATE)"} {
+		if _, err := s.client.ExecContext(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	if report.SizeAfter, err = s.size(ctx); err != nil {
+		return nil, err
+	}
+	report.Duration = time.Since(report.StartedAt)
+
+	s.logger.Info("Database optimized",
+		zap.Int64("size_before", report.SizeBefore),
+		zap.Int64("size_after", report.SizeAfter),
+		zap.Int64("reclaimed", report.Reclaimed()),
+		zap.Duration("duration", report.Duration))
+
+	s.mu.Lock()
+	s.last = report
+	s.mu.Unlock()
+	return report, nil
+}
+
+// LastOptimization returns the report of the last optimization since startup, nil if there was none.
+func (s *DatabaseService) LastOptimization() *DatabaseOptimization {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.last
+}
+
+// size returns the size of the database in bytes.
+func (s *DatabaseService) size(ctx context.Context) (int64, error) {
+	rows, err := s.client.QueryContext(ctx, "SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var size int64
+	if rows.Next() {
+		if err := rows.Scan(&size); err != nil {
+			return 0, err
+		}
+	}
+	return size, rows.Err()
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+)
+
+func TestDatabaseService_Optimize(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	// Fill the database with logs and delete them again, leaving free pages behind
+	conn, err := createTestConnService(t, client).CreateConnection(ctx, "optimize-conn-"+uuid.NewString(), "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	task, err := NewTaskService(client).CreateTask(ctx, "Optimize Task", "/l", conn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	jobService := NewJobService(client)
+	job, err := jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		_, err := jobService.AddJobLog(ctx, job.ID, string(model.LogLevelInfo), string(model.LogActionUpload), strings.Repeat("x", 1000), 0)
+		require.NoError(t, err)
+	}
+	_, err = client.JobLog.Delete().Exec(ctx)
+	require.NoError(t, err)
+
+	svc := NewDatabaseService(client)
+	assert.Nil(t, svc.LastOptimization())
+
+	report, err := svc.Optimize(ctx)
+	require.NoError(t, err)
+	assert.Positive(t, report.SizeAfter)
+	assert.Positive(t, report.Reclaimed(), "the space of the deleted logs is reclaimed")
+	assert.Equal(t, report, svc.LastOptimization())
+
+	t.Run("already running", func(t *testing.T) {
+		svc.running.Lock()
+		defer svc.running.Unlock()
+		_, err := svc.Optimize(ctx)
+		assert.ErrorIs(t, err, ErrOptimizeRunning)
+	})
+}
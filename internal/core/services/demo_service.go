@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connection"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+const (
+	// DemoConnectionName is the name of the local connection created for the demo data.
+	DemoConnectionName = "demo"
+	// DemoTaskName is the name of the sample task created for the demo data.
+	DemoTaskName = "Demo: Documents"
+	// DemoDirName is the directory below the data directory holding the files of the demo data.
+	DemoDirName = "demo"
+
+	// demoDescription is stored as the description of the demo connection. It marks the
+	// connection as demo data, so a user connection that happens to be named "demo" is never touched.
+	demoDescription = "Demo data created by rclone-sync. Remove it with the demo.remove mutation."
+)
+
+// demoFilters are the filter rules of the sample task.
+var demoFilters = []string{"- *.tmp", "- .cache/**"}
+
+// demoFile is a sample file of the demo source directory.
+type demoFile struct {
+	path    string
+	content string
+	// excluded files are skipped by demoFilters and show the filters in action
+	excluded bool
+}
+
+// demoFiles are the sample files of the demo source directory.
+var demoFiles = []demoFile{
+	{path: "README.md", content: "# Demo\n\nThis directory was created by rclone-sync to demonstrate a local-to-local sync task.\n"},
+	{path: "docs/getting-started.md", content: "1. Create a connection to your remote.\n2. Create a task that syncs a local directory with it.\n3. Run the task manually, on a schedule or in realtime.\n"},
+	{path: "docs/filters.md", content: "The demo task excludes temporary files (*.tmp) and the .cache directory.\n"},
+	{path: "notes/todo.txt", content: "- Configure a real remote\n- Remove the demo data\n"},
+	{path: "notes/draft.tmp", content: "Excluded by the *.tmp filter rule.\n", excluded: true},
+	{path: ".cache/thumbnails/index.db", content: "Excluded by the .cache/** filter rule.\n", excluded: true},
+}
+
+// errDemoConnectionConflict is returned when a connection named DemoConnectionName exists that is not demo data.
+const errDemoConnectionConflict = errs.ConstError("a connection named \"" + DemoConnectionName + "\" exists that is not demo data")
+
+// DemoData is the demo data: a local connection, a sample task and its fake completed job.
+type DemoData struct {
+	Connection *ent.Connection
+	Task       *ent.Task
+	// Job is the latest job of the sample task, nil if all jobs have been deleted.
+	Job *ent.Job
+}
+
+// DemoService creates and removes demo data, so the UI can be evaluated without configuring a real remote.
+type DemoService struct {
+	client      *ent.Client
+	logger      *zap.Logger
+	connService *ConnectionService
+	taskService *TaskService
+	jobService  *JobService
+}
+
+// NewDemoService creates a new DemoService instance.
+func NewDemoService(client *ent.Client, connService *ConnectionService) *DemoService {
+	return &DemoService{
+		client:      client,
+		logger:      logger.Named("service.demo"),
+		connService: connService,
+		taskService: NewTaskService(client),
+		jobService:  NewJobService(client),
+	}
+}
+
+// Get returns the demo data, or nil if it hasn't been seeded.
+func (s *DemoService) Get(ctx context.Context) (*DemoData, error) {
+	conn, err := s.demoConnection(ctx)
+	if err != nil || conn == nil {
+		return nil, err
+	}
+
+	tasks, err := conn.QueryTasks().Where(task.DeletedAtIsNil()).All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	data := &DemoData{Connection: conn}
+	for _, t := range tasks {
+		if t.Name == DemoTaskName {
+			data.Task = t
+		}
+	}
+	if data.Task == nil {
+		// The sample task was deleted, the remaining demo data is incomplete
+		return nil, nil
+	}
+
+	data.Job, err = data.Task.QueryJobs().Order(ent.Desc(job.FieldStartTime)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return data, nil
+}
+
+// Seed creates the demo data below dir: a local connection, a sample upload task with filter rules
+// from dir/source to dir/target, and a completed job with logs. Seeding is idempotent, existing
+// demo data is returned as it is.
+func (s *DemoService) Seed(ctx context.Context, dir string) (*DemoData, error) {
+	if data, err := s.Get(ctx); err != nil || data != nil {
+		return data, err
+	}
+	if _, err := s.Remove(ctx, dir); err != nil {
+		return nil, err
+	}
+
+	sourceDir := filepath.Join(dir, "source")
+	targetDir := filepath.Join(dir, "target")
+	if err := writeDemoFiles(sourceDir, targetDir); err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	conn, err := s.connService.CreateConnection(ctx, DemoConnectionName, "local", map[string]string{
+		"type":        "local",
+		"description": demoDescription,
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.seedTask(ctx, conn, sourceDir, targetDir)
+	if err != nil {
+		// Leave no half-seeded demo data behind
+		if _, rerr := s.Remove(ctx, dir); rerr != nil {
+			s.logger.Error("Failed to remove incomplete demo data", zap.Error(rerr))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Seeded demo data",
+		zap.String("connection", conn.Name),
+		zap.String("task_id", data.Task.ID.String()),
+		zap.String("dir", dir))
+	return data, nil
+}
+
+// seedTask creates the sample task of the demo connection and its completed job.
+func (s *DemoService) seedTask(ctx context.Context, conn *ent.Connection, sourceDir, targetDir string) (*DemoData, error) {
+	t, err := s.taskService.CreateTask(ctx, DemoTaskName, sourceDir, conn.ID, targetDir,
+		string(model.SyncDirectionUpload), "", false, &model.TaskSyncOptions{Filters: demoFilters})
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := s.jobService.CreateJob(ctx, t.ID, model.JobTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+	result := ports.JobResult{Status: model.JobStatusSuccess}
+	now := time.Now()
+	for _, f := range demoFiles {
+		if f.excluded {
+			continue
+		}
+		size := int64(len(f.content))
+		result.Logs = append(result.Logs, &ent.JobLog{
+			Level: model.LogLevelInfo,
+			What:  model.LogActionUpload,
+			Path:  f.path,
+			Size:  size,
+			Time:  now,
+		})
+		result.FilesTransferred++
+		result.BytesTransferred += size
+	}
+	result.UploadedFiles = result.FilesTransferred
+	result.UploadedBytes = result.BytesTransferred
+	j, err = s.jobService.FinalizeJob(ctx, j.ID, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DemoData{Connection: conn, Task: t, Job: j}, nil
+}
+
+// Remove deletes the demo connection together with its tasks, jobs and logs, and the demo directory dir.
+// It reports whether there was a demo connection to remove.
+func (s *DemoService) Remove(ctx context.Context, dir string) (bool, error) {
+	conn, err := s.demoConnection(ctx)
+	if err != nil {
+		return false, err
+	}
+	if conn != nil {
+		// Tasks, jobs and logs are deleted by the cascading foreign keys
+		if err := s.client.Connection.DeleteOne(conn).Exec(ctx); err != nil {
+			return false, errors.Join(errs.ErrSystem, err)
+		}
+		s.logger.Info("Removed demo data", zap.String("connection", conn.Name))
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return false, errors.Join(errs.ErrSystem, err)
+	}
+	return conn != nil, nil
+}
+
+// demoConnection returns the demo connection, or nil if there is none.
+func (s *DemoService) demoConnection(ctx context.Context) (*ent.Connection, error) {
+	conn, err := s.client.Connection.Query().Where(connection.Name(DemoConnectionName)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	config, err := s.connService.GetConnectionConfigByID(ctx, conn.ID)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	if config["description"] != demoDescription {
+		return nil, errors.Join(errs.ErrAlreadyExists, errDemoConnectionConflict)
+	}
+	return conn, nil
+}
+
+// writeDemoFiles writes all sample files to sourceDir and the transferred ones to targetDir,
+// matching the state after the fake job.
+func writeDemoFiles(sourceDir, targetDir string) error {
+	write := func(dir string, f demoFile) error {
+		path := filepath.Join(dir, filepath.FromSlash(f.path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(f.content), 0644); err != nil {
+			return fmt.Errorf("failed to write demo file %s: %w", path, err)
+		}
+		return nil
+	}
+	for _, f := range demoFiles {
+		if err := write(sourceDir, f); err != nil {
+			return err
+		}
+		if f.excluded {
+			continue
+		}
+		if err := write(targetDir, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+func TestDemoService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	jobService := NewJobService(client)
+	service := NewDemoService(client, connService)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "demo")
+
+	data, err := service.Get(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, data, "nothing is seeded yet")
+
+	t.Run("Seed", func(t *testing.T) {
+		data, err := service.Seed(ctx, dir)
+		require.NoError(t, err)
+		assert.Equal(t, DemoConnectionName, data.Connection.Name)
+		assert.Equal(t, model.ConnectionTypeLocal, data.Connection.Type)
+		assert.Equal(t, DemoTaskName, data.Task.Name)
+		assert.Equal(t, model.SyncDirectionUpload, data.Task.Direction)
+		assert.Equal(t, demoFilters, data.Task.Options.Filters)
+
+		require.NotNil(t, data.Job)
+		assert.Equal(t, model.JobStatusSuccess, data.Job.Status)
+		assert.Equal(t, 4, data.Job.FilesTransferred)
+		logs, total, err := jobService.ListJobLogsByJobPaginated(ctx, data.Job.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, "README.md", logs[0].Path)
+
+		// Excluded files only exist in the source directory
+		assert.FileExists(t, filepath.Join(dir, "source", "notes", "draft.tmp"))
+		assert.FileExists(t, filepath.Join(dir, "target", "notes", "todo.txt"))
+		assert.NoFileExists(t, filepath.Join(dir, "target", "notes", "draft.tmp"))
+	})
+
+	t.Run("SeedIsIdempotent", func(t *testing.T) {
+		first, err := service.Get(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, first)
+
+		second, err := service.Seed(ctx, dir)
+		require.NoError(t, err)
+		assert.Equal(t, first.Task.ID, second.Task.ID)
+		assert.Equal(t, first.Job.ID, second.Job.ID)
+	})
+
+	t.Run("DeletedTask", func(t *testing.T) {
+		data, err := service.Get(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, data)
+		_, err = NewTaskService(client).DeleteTask(ctx, data.Task.ID)
+		require.NoError(t, err)
+
+		data, err = service.Get(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, data, "a deleted sample task leaves the demo data incomplete")
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		removed, err := service.Remove(ctx, dir)
+		require.NoError(t, err)
+		assert.True(t, removed)
+		assert.NoDirExists(t, dir)
+
+		count, err := client.Task.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count, "the sample task is deleted with the demo connection")
+		count, err = client.Job.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		removed, err = service.Remove(ctx, dir)
+		require.NoError(t, err)
+		assert.False(t, removed)
+	})
+
+	t.Run("UserConnectionNamedDemo", func(t *testing.T) {
+		_, err := connService.CreateConnection(ctx, DemoConnectionName, "local", map[string]string{"type": "local"})
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+
+		_, err = service.Seed(ctx, dir)
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+		_, err = service.Remove(ctx, dir)
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+
+		_, err = connService.GetConnectionByName(ctx, DemoConnectionName)
+		assert.NoError(t, err, "a user connection is never removed")
+		assert.DirExists(t, dir)
+	})
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+// maxFilterSuggestionExamples bounds the example paths of a filter suggestion.
+const maxFilterSuggestionExamples = 5
+
+// filterCandidate is an exclusion filter that can be suggested for paths of little value to sync.
+type filterCandidate struct {
+	category model.FilterSuggestionCategory
+	// dir matches paths within a directory of this name at any level, glob matches file names
+	dir  string
+	glob string
+	// churnOnly candidates are only suggested if matching paths changed in more than one job,
+	// their names are also used for directories that are worth syncing
+	churnOnly bool
+}
+
+// rule returns the rclone filter rule excluding the paths matched by c.
+func (c filterCandidate) rule() string {
+	if c.dir != "" {
+		return "- " + c.dir + "/**"
+	}
+	return "- " + c.glob
+}
+
+// matches reports whether the file at p, relative to the synced root, is matched by c.
+func (c filterCandidate) matches(p string) bool {
+	segments := strings.Split(p, "/")
+	if c.dir != "" {
+		return slices.Contains(segments[:len(segments)-1], c.dir)
+	}
+	ok, _ := path.Match(c.glob, segments[len(segments)-1])
+	return ok
+}
+
+// filterCandidates are the exclusion filters suggested by SuggestFilters, in the order they are matched.
+var filterCandidates = []filterCandidate{
+	{category: model.FilterSuggestionCategoryDependencies, dir: "node_modules"},
+	{category: model.FilterSuggestionCategoryDependencies, dir: "bower_components"},
+	{category: model.FilterSuggestionCategoryDependencies, dir: ".venv"},
+	{category: model.FilterSuggestionCategoryDependencies, dir: "Pods", churnOnly: true},
+	{category: model.FilterSuggestionCategoryCache, dir: ".cache"},
+	{category: model.FilterSuggestionCategoryCache, dir: "__pycache__"},
+	{category: model.FilterSuggestionCategoryCache, dir: ".pytest_cache"},
+	{category: model.FilterSuggestionCategoryCache, dir: ".mypy_cache"},
+	{category: model.FilterSuggestionCategoryCache, dir: ".gradle"},
+	{category: model.FilterSuggestionCategoryCache, dir: ".sass-cache"},
+	{category: model.FilterSuggestionCategoryCache, dir: ".parcel-cache"},
+	{category: model.FilterSuggestionCategoryCache, dir: ".turbo"},
+	{category: model.FilterSuggestionCategoryBuildOutput, dir: ".next"},
+	{category: model.FilterSuggestionCategoryBuildOutput, dir: ".nuxt"},
+	{category: model.FilterSuggestionCategoryBuildOutput, dir: "build", churnOnly: true},
+	{category: model.FilterSuggestionCategoryBuildOutput, dir: "dist", churnOnly: true},
+	{category: model.FilterSuggestionCategoryBuildOutput, dir: "target", churnOnly: true},
+	{category: model.FilterSuggestionCategoryBuildOutput, dir: "obj", churnOnly: true},
+	{category: model.FilterSuggestionCategoryVersionControl, dir: ".git", churnOnly: true},
+	{category: model.FilterSuggestionCategoryVersionControl, dir: ".svn", churnOnly: true},
+	{category: model.FilterSuggestionCategoryVersionControl, dir: ".hg", churnOnly: true},
+	{category: model.FilterSuggestionCategoryTemporary, glob: "*.tmp"},
+	{category: model.FilterSuggestionCategoryTemporary, glob: "*.swp"},
+	{category: model.FilterSuggestionCategoryTemporary, glob: "*.pyc"},
+	{category: model.FilterSuggestionCategoryTemporary, glob: "~$*"},
+	{category: model.FilterSuggestionCategoryTemporary, glob: "*.crdownload"},
+	{category: model.FilterSuggestionCategoryTemporary, glob: "*.part"},
+	{category: model.FilterSuggestionCategorySystem, glob: ".DS_Store"},
+	{category: model.FilterSuggestionCategorySystem, glob: "Thumbs.db"},
+	{category: model.FilterSuggestionCategorySystem, glob: "desktop.ini"},
+}
+
+// FilterSuggestion is an exclusion filter suggested for paths that were synced in the analyzed jobs.
+type FilterSuggestion struct {
+	// Rule is the rclone filter rule, which can be added to the filters of the task as is.
+	Rule     string
+	Category model.FilterSuggestionCategory
+	// Paths is the number of distinct paths matched, Changes the number of times they were transferred or deleted.
+	Paths   int
+	Changes int
+	// Bytes is the size of the matched transfers, which would have been saved with the rule.
+	Bytes int64
+	// Examples are some of the matched paths, sorted.
+	Examples []string
+}
+
+// FilterAnalysis is the result of SuggestFilters.
+type FilterAnalysis struct {
+	// JobCount is the number of jobs analyzed.
+	JobCount int
+	// Changes and Bytes are the totals of the analyzed jobs, to put the savings of the suggestions into perspective.
+	Changes int
+	Bytes   int64
+	// Suggestions are ordered by the bytes they save, then by their changes.
+	Suggestions []*FilterSuggestion
+}
+
+// SuggestFilters inspects the file logs of the last n runs of a task and suggests exclusion filters for
+// high-churn, low-value paths such as caches, dependencies and build output, with the transfers they would have saved.
+// Rules already in existing, the filters of the task, are not suggested again.
+func (s *JobService) SuggestFilters(ctx context.Context, taskID uuid.UUID, n int, existing []string) (*FilterAnalysis, error) {
+	runs, err := s.client.Job.Query().
+		Where(job.TaskID(taskID), job.ParentIDIsNil()).
+		Order(ent.Desc(job.FieldStartTime)).
+		Limit(n).
+		IDs(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	if len(runs) == 0 {
+		return &FilterAnalysis{}, nil
+	}
+
+	// Shard jobs are counted as part of their run
+	jobs, err := s.client.Job.Query().
+		Where(job.Or(job.IDIn(runs...), job.ParentIDIn(runs...))).
+		Select(job.FieldParentID).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	runOf := make(map[uuid.UUID]uuid.UUID, len(jobs))
+	jobIDs := make([]uuid.UUID, len(jobs))
+	for i, j := range jobs {
+		jobIDs[i] = j.ID
+		runOf[j.ID] = j.ID
+		if j.ParentID != nil {
+			runOf[j.ID] = *j.ParentID
+		}
+	}
+
+	logs, err := s.client.JobLog.Query().
+		Where(
+			joblog.JobIDIn(jobIDs...),
+			joblog.LevelEQ(model.LogLevelInfo),
+			joblog.WhatIn(model.LogActionUpload, model.LogActionDownload, model.LogActionDelete, model.LogActionMove, model.LogActionRename),
+		).
+		Select(joblog.FieldJobID, joblog.FieldWhat, joblog.FieldPath, joblog.FieldSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	for _, l := range logs {
+		l.JobID = runOf[l.JobID]
+	}
+
+	analysis := suggestFilters(logs, existing)
+	analysis.JobCount = len(runs)
+	return analysis, nil
+}
+
+// suggestFilters matches the file logs of jobs against filterCandidates.
+func suggestFilters(logs []*ent.JobLog, existing []string) *FilterAnalysis {
+	type candidateMatch struct {
+		suggestion *FilterSuggestion
+		// runs are the jobs each matched path changed in
+		runs map[string]map[uuid.UUID]bool
+	}
+
+	applied := make(map[string]bool, len(existing))
+	for _, f := range existing {
+		applied[normalizeFilterRule(f)] = true
+	}
+
+	analysis := &FilterAnalysis{}
+	matches := make(map[int]*candidateMatch)
+	for _, l := range logs {
+		analysis.Changes++
+		transferred := l.What == model.LogActionUpload || l.What == model.LogActionDownload
+		if transferred {
+			analysis.Bytes += l.Size
+		}
+		for i, c := range filterCandidates {
+			if applied[c.rule()] || !c.matches(l.Path) {
+				continue
+			}
+			m := matches[i]
+			if m == nil {
+				m = &candidateMatch{
+					suggestion: &FilterSuggestion{Rule: c.rule(), Category: c.category},
+					runs:       make(map[string]map[uuid.UUID]bool),
+				}
+				matches[i] = m
+			}
+			m.suggestion.Changes++
+			if transferred {
+				m.suggestion.Bytes += l.Size
+			}
+			if m.runs[l.Path] == nil {
+				m.runs[l.Path] = make(map[uuid.UUID]bool)
+			}
+			m.runs[l.Path][l.JobID] = true
+			// A path is attributed to the first candidate matching it
+			break
+		}
+	}
+
+	for i, m := range matches {
+		churned := false
+		paths := make([]string, 0, len(m.runs))
+		for p, runs := range m.runs {
+			paths = append(paths, p)
+			churned = churned || len(runs) > 1
+		}
+		if filterCandidates[i].churnOnly && !churned {
+			continue
+		}
+		slices.Sort(paths)
+		m.suggestion.Paths = len(paths)
+		m.suggestion.Examples = paths[:min(len(paths), maxFilterSuggestionExamples)]
+		analysis.Suggestions = append(analysis.Suggestions, m.suggestion)
+	}
+	slices.SortFunc(analysis.Suggestions, func(a, b *FilterSuggestion) int {
+		if a.Bytes != b.Bytes {
+			return cmp.Compare(b.Bytes, a.Bytes)
+		}
+		if a.Changes != b.Changes {
+			return b.Changes - a.Changes
+		}
+		return strings.Compare(a.Rule, b.Rule)
+	})
+	return analysis
+}
+
+// normalizeFilterRule returns a filter rule with the whitespace between its prefix and pattern normalized.
+func normalizeFilterRule(rule string) string {
+	rule = strings.TrimSpace(rule)
+	if len(rule) > 1 && (rule[0] == '-' || rule[0] == '+') {
+		return rule[:1] + " " + strings.TrimSpace(rule[1:])
+	}
+	return rule
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestSuggestFilters(t *testing.T) {
+	run1, run2 := uuid.New(), uuid.New()
+	logFor := func(jobID uuid.UUID, what model.LogAction, path string, size int64) *ent.JobLog {
+		return &ent.JobLog{JobID: jobID, What: what, Path: path, Size: size}
+	}
+	logs := []*ent.JobLog{
+		logFor(run1, model.LogActionUpload, "web/node_modules/a/index.js", 100),
+		logFor(run1, model.LogActionUpload, "web/node_modules/b/index.js", 200),
+		logFor(run2, model.LogActionDelete, "web/node_modules/a/index.js", 0),
+		logFor(run1, model.LogActionUpload, "photos/.DS_Store", 10),
+		logFor(run1, model.LogActionUpload, "docs/report.pdf", 1000),
+		// build changed in a single run only, dist in both
+		logFor(run1, model.LogActionUpload, "app/build/out.bin", 5000),
+		logFor(run1, model.LogActionUpload, "app/dist/main.js", 50),
+		logFor(run2, model.LogActionUpload, "app/dist/main.js", 50),
+		// Already excluded by the task
+		logFor(run2, model.LogActionUpload, "lib/__pycache__/x.pyc", 30),
+	}
+
+	analysis := suggestFilters(logs, []string{"-   __pycache__/**"})
+	assert.Equal(t, len(logs), analysis.Changes)
+	assert.Equal(t, int64(6440), analysis.Bytes)
+
+	require.Len(t, analysis.Suggestions, 4)
+	nodeModules := analysis.Suggestions[0]
+	assert.Equal(t, "- node_modules/**", nodeModules.Rule)
+	assert.Equal(t, model.FilterSuggestionCategoryDependencies, nodeModules.Category)
+	assert.Equal(t, 2, nodeModules.Paths)
+	assert.Equal(t, 3, nodeModules.Changes)
+	assert.Equal(t, int64(300), nodeModules.Bytes)
+	assert.Equal(t, []string{"web/node_modules/a/index.js", "web/node_modules/b/index.js"}, nodeModules.Examples)
+
+	assert.Equal(t, "- dist/**", analysis.Suggestions[1].Rule)
+	assert.Equal(t, model.FilterSuggestionCategoryBuildOutput, analysis.Suggestions[1].Category)
+	assert.Equal(t, int64(100), analysis.Suggestions[1].Bytes)
+
+	// .pyc files are matched by the next candidate once __pycache__ is excluded
+	assert.Equal(t, "- *.pyc", analysis.Suggestions[2].Rule)
+	assert.Equal(t, "- .DS_Store", analysis.Suggestions[3].Rule)
+	assert.Equal(t, model.FilterSuggestionCategorySystem, analysis.Suggestions[3].Category)
+}
+
+func TestNormalizeFilterRule(t *testing.T) {
+	assert.Equal(t, "- node_modules/**", normalizeFilterRule("  -node_modules/**  "))
+	assert.Equal(t, "+ *.jpg", normalizeFilterRule("+   *.jpg"))
+	assert.Equal(t, "*.jpg", normalizeFilterRule("*.jpg"))
+}
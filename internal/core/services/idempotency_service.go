@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/idempotencykey"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyKeyTTL is how long the result of a mutation is returned for a repeated idempotency key.
+	IdempotencyKeyTTL = 24 * time.Hour
+	// MaxIdempotencyKeyLength is the maximum length of an idempotency key.
+	MaxIdempotencyKeyLength = 255
+
+	// ErrIdempotencyKeyInvalid is returned for an idempotency key that is longer than MaxIdempotencyKeyLength.
+	ErrIdempotencyKeyInvalid = errs.ConstError("idempotency key is too long")
+	// ErrIdempotencyKeyReused is returned if an idempotency key is reused with other arguments.
+	ErrIdempotencyKeyReused = errs.ConstError("idempotency key was used with other arguments")
+	// ErrIdempotencyKeyInProgress is returned if the mutation of an idempotency key is still running.
+	ErrIdempotencyKeyInProgress = errs.ConstError("mutation of idempotency key is still running")
+)
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a context carrying the idempotency key of a request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key carried by the context, or "" if it has none.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key
+}
+
+// IdempotencyService makes create and run mutations idempotent: a mutation repeated with the same
+// idempotency key returns the ID of the entity of the first run instead of running again.
+type IdempotencyService struct {
+	client *ent.Client
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// NewIdempotencyService creates a new IdempotencyService instance.
+func NewIdempotencyService(client *ent.Client) *IdempotencyService {
+	return &IdempotencyService{
+		client: client,
+		logger: logger.Named("service.idempotency"),
+		now:    time.Now,
+	}
+}
+
+// Do runs fn once per key and operation, and returns the ID of the entity it created or started.
+// If the key was used for the operation within IdempotencyKeyTTL, fn is not run and the ID of
+// the first run is returned with replayed set. The key is released if fn fails, so it can be retried.
+// request are the arguments of the mutation: reusing a key with other arguments returns ErrIdempotencyKeyReused.
+func (s *IdempotencyService) Do(ctx context.Context, key, operation string, request any, fn func(ctx context.Context) (uuid.UUID, error)) (id uuid.UUID, replayed bool, err error) {
+	if len(key) > MaxIdempotencyKeyLength {
+		return uuid.Nil, false, ErrIdempotencyKeyInvalid
+	}
+	hash, err := requestHash(request)
+	if err != nil {
+		return uuid.Nil, false, errors.Join(errs.ErrSystem, err)
+	}
+
+	// Expired keys are purged here rather than periodically, they are only ever read here
+	if _, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.CreatedAtLT(s.now().Add(-IdempotencyKeyTTL))).
+		Exec(ctx); err != nil {
+		return uuid.Nil, false, errors.Join(errs.ErrSystem, err)
+	}
+
+	// The unique index on key and operation makes sure that only one of concurrent submissions runs
+	record, err := s.client.IdempotencyKey.Create().
+		SetKey(key).
+		SetOperation(operation).
+		SetRequestHash(hash).
+		SetCreatedAt(s.now()).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return s.replay(ctx, key, operation, hash)
+	}
+	if err != nil {
+		return uuid.Nil, false, errors.Join(errs.ErrSystem, err)
+	}
+
+	id, err = fn(ctx)
+	if err != nil {
+		if delErr := s.client.IdempotencyKey.DeleteOne(record).Exec(context.WithoutCancel(ctx)); delErr != nil {
+			s.logger.Error("Failed to release idempotency key", zap.String("operation", operation), zap.Error(delErr))
+		}
+		return uuid.Nil, false, err
+	}
+	if err := record.Update().SetResultID(id).Exec(context.WithoutCancel(ctx)); err != nil {
+		return uuid.Nil, false, errors.Join(errs.ErrSystem, err)
+	}
+	return id, false, nil
+}
+
+// replay returns the result recorded for a key and operation that is already in use.
+func (s *IdempotencyService) replay(ctx context.Context, key, operation, hash string) (uuid.UUID, bool, error) {
+	record, err := s.client.IdempotencyKey.Query().
+		Where(idempotencykey.Key(key), idempotencykey.Operation(operation)).
+		Only(ctx)
+	if err != nil {
+		return uuid.Nil, false, errors.Join(errs.ErrSystem, err)
+	}
+	if record.RequestHash != hash {
+		return uuid.Nil, false, ErrIdempotencyKeyReused
+	}
+	if record.ResultID == nil {
+		return uuid.Nil, false, ErrIdempotencyKeyInProgress
+	}
+	s.logger.Debug("Replaying idempotent mutation", zap.String("operation", operation), zap.Stringer("result_id", *record.ResultID))
+	return *record.ResultID, true, nil
+}
+
+// requestHash returns the hex encoded SHA-256 of the JSON encoding of request.
+func requestHash(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+)
+
+func TestIdempotencyService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewIdempotencyService(client)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	runs := 0
+	create := func(context.Context) (uuid.UUID, error) {
+		runs++
+		return uuid.New(), nil
+	}
+
+	t.Run("Replay", func(t *testing.T) {
+		id, replayed, err := service.Do(ctx, "key-1", "task.create", map[string]any{"name": "a"}, create)
+		require.NoError(t, err)
+		assert.False(t, replayed)
+
+		again, replayed, err := service.Do(ctx, "key-1", "task.create", map[string]any{"name": "a"}, create)
+		require.NoError(t, err)
+		assert.True(t, replayed)
+		assert.Equal(t, id, again)
+		assert.Equal(t, 1, runs)
+
+		// Keys are scoped to the operation
+		_, replayed, err = service.Do(ctx, "key-1", "task.run", map[string]any{"name": "a"}, create)
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, 2, runs)
+	})
+
+	t.Run("Reused", func(t *testing.T) {
+		_, _, err := service.Do(ctx, "key-1", "task.create", map[string]any{"name": "b"}, create)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+
+	t.Run("FailureReleasesKey", func(t *testing.T) {
+		_, _, err := service.Do(ctx, "key-2", "task.create", nil, func(context.Context) (uuid.UUID, error) {
+			return uuid.Nil, errors.New("remote unreachable")
+		})
+		require.Error(t, err)
+
+		_, replayed, err := service.Do(ctx, "key-2", "task.create", nil, create)
+		require.NoError(t, err)
+		assert.False(t, replayed)
+	})
+
+	t.Run("InProgress", func(t *testing.T) {
+		_, _, err := service.Do(ctx, "key-3", "task.run", nil, func(ctx context.Context) (uuid.UUID, error) {
+			_, _, err := service.Do(ctx, "key-3", "task.run", nil, create)
+			assert.ErrorIs(t, err, ErrIdempotencyKeyInProgress)
+			return uuid.New(), nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		id, _, err := service.Do(ctx, "key-4", "task.create", nil, create)
+		require.NoError(t, err)
+
+		now = now.Add(IdempotencyKeyTTL + time.Minute)
+		again, replayed, err := service.Do(ctx, "key-4", "task.create", nil, create)
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		assert.NotEqual(t, id, again)
+	})
+
+	t.Run("TooLong", func(t *testing.T) {
+		_, _, err := service.Do(ctx, strings.Repeat("k", MaxIdempotencyKeyLength+1), "task.create", nil, create)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInvalid)
+	})
+}
+
+func TestIdempotencyKeyFromContext(t *testing.T) {
+	assert.Empty(t, IdempotencyKeyFromContext(context.Background()))
+	assert.Equal(t, "key", IdempotencyKeyFromContext(WithIdempotencyKey(context.Background(), "key")))
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+// IntegrityReport is the result of a config integrity check of all connections.
+type IntegrityReport struct {
+	CheckedAt   time.Time
+	Connections int
+	// Failures are the connections whose config can't be decrypted, ordered by name.
+	Failures []ConfigIntegrityFailure
+}
+
+// IntegrityService checks that the encrypted config of every connection can be decrypted, so a wrong
+// encryption key or a corrupted config is reported before the jobs of the connection fail.
+type IntegrityService struct {
+	connections *ConnectionService
+	logger      *zap.Logger
+	cron        *cron.Cron
+	mu          sync.RWMutex
+	report      *IntegrityReport
+}
+
+// NewIntegrityService creates a new IntegrityService instance.
+func NewIntegrityService(connections *ConnectionService) *IntegrityService {
+	return &IntegrityService{
+		connections: connections,
+		logger:      logger.Named("service.integrity"),
+	}
+}
+
+// Start checks the configs of all connections now and then with the given cron schedule.
+func (s *IntegrityService) Start(schedule string) error {
+	s.logger.Info("Starting config integrity checks", zap.String("schedule", schedule))
+
+	s.cron = cron.New()
+	if _, err := s.cron.AddFunc(schedule, s.check); err != nil {
+		return err
+	}
+	s.cron.Start()
+	go s.check()
+	return nil
+}
+
+// Stop stops checking.
+func (s *IntegrityService) Stop() {
+	if s.cron != nil {
+		s.logger.Info("Stopping config integrity checks")
+		s.cron.Stop()
+		s.cron = nil
+	}
+}
+
+// check runs CheckAll, logging its error.
+func (s *IntegrityService) check() {
+	if _, err := s.CheckAll(context.Background()); err != nil {
+		s.logger.Error("Config integrity check failed", zap.Error(err))
+	}
+}
+
+// CheckAll tries to decrypt the config of every connection and logs an error for each connection that fails.
+// A CONFIG_UNREADABLE task event is recorded for the tasks of a connection the first time it fails, so the
+// failure shows up in the history of the tasks instead of only when their next job fails.
+// The report is kept as the latest one, see Report.
+func (s *IntegrityService) CheckAll(ctx context.Context) (*IntegrityReport, error) {
+	count, failures, err := s.connections.VerifyConfigs(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	previous := s.Report()
+	for _, f := range failures {
+		s.logger.Error("Connection config cannot be decrypted, check the encryption key",
+			zap.String("connection", f.Connection.Name),
+			zap.Error(f.Err))
+		if previous != nil && previous.failed(f.Connection) {
+			continue
+		}
+		if _, err := s.connections.RecordConfigUnreadable(ctx, f.Connection.ID, f.Err.Error()); err != nil {
+			s.logger.Error("Failed to record config failure for the tasks of the connection",
+				zap.String("connection", f.Connection.Name),
+				zap.Error(err))
+		}
+	}
+
+	report := &IntegrityReport{CheckedAt: time.Now(), Connections: count, Failures: failures}
+	s.mu.Lock()
+	s.report = report
+	s.mu.Unlock()
+	s.logger.Info("Config integrity check completed", zap.Int("connections", count), zap.Int("failures", len(failures)))
+	return report, nil
+}
+
+// failed reports whether the config of the connection failed to decrypt in this report, unchanged since.
+func (r *IntegrityReport) failed(conn *ent.Connection) bool {
+	return slices.ContainsFunc(r.Failures, func(f ConfigIntegrityFailure) bool {
+		return f.Connection.ID == conn.ID && f.Connection.UpdatedAt.Equal(conn.UpdatedAt)
+	})
+}
+
+// Report returns the latest report, nil if no check completed yet.
+func (s *IntegrityService) Report() *IntegrityReport {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.report
+}
+
+// Failure returns the decryption error of a connection in the latest report, nil if it was decrypted
+// or no check completed yet. A connection updated since the check, e.g. with a fixed config, is not
+// reported since updatedAt differs.
+func (s *IntegrityService) Failure(connectionID uuid.UUID, updatedAt time.Time) error {
+	report := s.Report()
+	if report == nil {
+		return nil
+	}
+	for _, f := range report.Failures {
+		if f.Connection.ID == connectionID && f.Connection.UpdatedAt.Equal(updatedAt) {
+			return f.Err
+		}
+	}
+	return nil
+}
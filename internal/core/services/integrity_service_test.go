@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
+)
+
+func TestIntegrityService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	service := NewIntegrityService(connService)
+	assert.Nil(t, service.Report(), "No check completed yet")
+
+	valid, err := connService.CreateConnection(ctx, "valid", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	corrupted, err := connService.CreateConnection(ctx, "corrupted", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	corruptedTask, err := NewTaskService(client).CreateTask(ctx, "corrupted-task", "/tmp/source", corrupted.ID, "/remote", "UPLOAD", "", false, nil)
+	require.NoError(t, err)
+	corrupted, err = client.Connection.UpdateOne(corrupted).SetEncryptedConfig([]byte("not encrypted")).Save(ctx)
+	require.NoError(t, err)
+
+	// Configs encrypted with another key can't be decrypted either
+	otherEncryptor, err := crypto.NewEncryptor("another-secret-key-32-bytes-long")
+	require.NoError(t, err)
+	wrongKey, err := NewConnectionService(client, otherEncryptor).CreateConnection(ctx, "wrong-key", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	report, err := service.CheckAll(ctx)
+	require.NoError(t, err)
+	assert.Same(t, report, service.Report())
+	assert.Equal(t, 3, report.Connections)
+	require.Len(t, report.Failures, 2)
+	assert.Equal(t, "corrupted", report.Failures[0].Connection.Name)
+	assert.Equal(t, "wrong-key", report.Failures[1].Connection.Name)
+
+	assert.Error(t, service.Failure(corrupted.ID, corrupted.UpdatedAt))
+	assert.Error(t, service.Failure(wrongKey.ID, wrongKey.UpdatedAt))
+	assert.NoError(t, service.Failure(valid.ID, valid.UpdatedAt))
+	assert.NoError(t, service.Failure(corrupted.ID, corrupted.UpdatedAt.Add(time.Second)),
+		"A connection updated since the check is not reported")
+
+	events, err := client.TaskEvent.Query().Where(taskevent.TaskID(corruptedTask.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, model.TaskEventTypeConfigUnreadable, events[0].Type)
+	assert.NotEmpty(t, events[0].Message)
+
+	// A connection that still fails is only recorded once
+	_, err = service.CheckAll(ctx)
+	require.NoError(t, err)
+	count, err := client.TaskEvent.Query().Where(taskevent.TaskID(corruptedTask.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
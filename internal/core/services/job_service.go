@@ -3,17 +3,28 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/jobevent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/predicate"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/retryqueue"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/core/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -21,6 +32,20 @@ import (
 type JobService struct {
 	client *ent.Client
 	logger *zap.Logger
+	tracer trace.Tracer
+	// failureThreshold is the number of failed runs in a row that escalates a task, 0 disables escalation
+	failureThreshold int
+	// disableThreshold is the number of runs in a row failing with a non-transient error that disables a task, 0 never disables
+	disableThreshold int
+	// logSink receives the recorded job logs and status changes, nil if job logs aren't shipped
+	logSink JobLogSink
+}
+
+// JobLogSink receives the job logs and job status changes recorded by the JobService, e.g. to ship them
+// to a central log store. Both methods are called after the change is saved and must not block.
+type JobLogSink interface {
+	JobLogs(jobID uuid.UUID, logs []*ent.JobLog)
+	JobStatus(job *ent.Job)
 }
 
 // NewJobService creates a new JobService instance.
@@ -28,30 +53,167 @@ func NewJobService(client *ent.Client) *JobService {
 	return &JobService{
 		client: client,
 		logger: logger.Named("service.job"),
+		tracer: tracing.Tracer("service.job"),
+	}
+}
+
+// SetFailureEscalationThreshold makes FinalizeJob escalate a task whose runs failed threshold times in a row:
+// a CONSECUTIVE_FAILURES task event is recorded and an error is logged, instead of the usual job log only.
+// The escalation is raised once per failure streak. A threshold of 0 (the default) disables escalation.
+func (s *JobService) SetFailureEscalationThreshold(threshold int) {
+	s.failureThreshold = threshold
+}
+
+// SetAutoDisableThreshold makes FinalizeJob disable a task whose runs failed threshold times in a row with
+// a non-transient error (see ports.JobResult.Fatal): the task is no longer run by its schedule or watcher,
+// and the reason is recorded on the task and as a TASK_DISABLED task event. A threshold of 0 (the default)
+// never disables tasks.
+func (s *JobService) SetAutoDisableThreshold(threshold int) {
+	s.disableThreshold = threshold
+}
+
+// SetJobLogSink makes the JobService pass the job logs and status changes it records to sink.
+func (s *JobService) SetJobLogSink(sink JobLogSink) {
+	s.logSink = sink
+}
+
+// shipStatus passes the status of j to the job log sink, if any.
+func (s *JobService) shipStatus(j *ent.Job) {
+	if s.logSink != nil && j != nil {
+		s.logSink.JobStatus(j)
+	}
+}
+
+// shipLogs passes the logs of a job to the job log sink, if any.
+func (s *JobService) shipLogs(jobID uuid.UUID, logs []*ent.JobLog) {
+	if s.logSink != nil && len(logs) > 0 {
+		s.logSink.JobLogs(jobID, logs)
+	}
+}
+
+// startJobSpan starts the span of a JobService method operating on the given job.
+func (s *JobService) startJobSpan(ctx context.Context, name string, jobID uuid.UUID) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, "JobService."+name, trace.WithAttributes(attribute.String("job.id", jobID.String())))
+}
+
+// setTaskConfig records the config version of the task's connection and the hash of the task's configuration
+// on a job being created, so it is known which config the job ran with.
+func (s *JobService) setTaskConfig(ctx context.Context, create *ent.JobCreate, taskID uuid.UUID) error {
+	t, err := s.client.Task.Query().
+		Where(task.ID(taskID)).
+		WithConnection().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// The missing task is reported by saving the job
+			return nil
+		}
+		return errors.Join(errs.ErrSystem, err)
 	}
+	basePath := ""
+	if conn := t.Edges.Connection; conn != nil {
+		create.SetConnectionConfigVersion(conn.ConfigVersion)
+		basePath = conn.BasePath
+	}
+	create.SetTaskConfigHash(TaskConfigHash(t, basePath))
+	return nil
 }
 
 // CreateJob creates a new job for a task.
+// The job records the ID of the trace ctx belongs to, so the run can be found in the tracing backend,
+// and the config version of the task's connection and the hash of the task's configuration.
+// If ctx carries a pending job of the task created ahead of the run, see provenance.WithJob, that job is returned instead.
 func (s *JobService) CreateJob(ctx context.Context, taskID uuid.UUID, trigger model.JobTrigger) (*ent.Job, error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.CreateJob", trace.WithAttributes(attribute.String("task.id", taskID.String())))
+	defer span.End()
+
+	// A pending job of the task created ahead of the run is taken over
+	if jobID := provenance.Job(ctx); jobID != nil {
+		j, err := s.client.Job.Query().
+			Where(job.ID(*jobID), job.TaskID(taskID), job.StatusEQ(model.JobStatusPending)).
+			Only(ctx)
+		if err == nil {
+			return j, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrSystem, err)
+		}
+	}
+
 	s.logger.Info("Creating new job", zap.String("task_id", taskID.String()), zap.Stringer("trigger", trigger))
-	j, err := s.client.Job.Create().
+	create := s.client.Job.Create().
 		SetTaskID(taskID).
 		SetTrigger(trigger).
 		SetStatus(model.JobStatusPending).
-		SetStartTime(time.Now()).
-		Save(ctx)
+		SetStartTime(time.Now())
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		create.SetTraceID(traceID)
+	}
+	if detail := provenance.TriggerDetail(ctx); detail != nil {
+		create.SetTriggerDetail(detail)
+	}
+	if err := s.setTaskConfig(ctx, create, taskID); err != nil {
+		return nil, err
+	}
+	j, err := create.Save(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	s.shipStatus(j)
+	return j, nil
+}
+
+// CreateChildJob creates a shard job linked to a parent job of the same task.
+func (s *JobService) CreateChildJob(ctx context.Context, parentID, taskID uuid.UUID, trigger model.JobTrigger) (*ent.Job, error) {
+	ctx, span := s.startJobSpan(ctx, "CreateChildJob", parentID)
+	defer span.End()
+
+	s.logger.Debug("Creating child job", zap.String("parent_id", parentID.String()), zap.String("task_id", taskID.String()))
+	create := s.client.Job.Create().
+		SetTaskID(taskID).
+		SetParentID(parentID).
+		SetTrigger(trigger).
+		SetStatus(model.JobStatusPending).
+		SetStartTime(time.Now())
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		create.SetTraceID(traceID)
+	}
+	if detail := provenance.TriggerDetail(ctx); detail != nil {
+		create.SetTriggerDetail(detail)
+	}
+	if err := s.setTaskConfig(ctx, create, taskID); err != nil {
+		return nil, err
+	}
+	j, err := create.Save(ctx)
 	if err != nil {
 		return nil, errors.Join(errs.ErrSystem, err)
 	}
+	s.shipStatus(j)
 	return j, nil
 }
 
+// ListChildJobs retrieves the shard and mirror jobs of a parent job ordered by start time.
+func (s *JobService) ListChildJobs(ctx context.Context, parentID uuid.UUID) ([]*ent.Job, error) {
+	jobs, err := s.client.Job.Query().
+		Where(job.ParentIDEQ(parentID)).
+		Order(ent.Asc(job.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return jobs, nil
+}
+
 // UpdateJobStatus updates the status of a job.
 func (s *JobService) UpdateJobStatus(ctx context.Context, jobID uuid.UUID, status string, errStr string) (*ent.Job, error) {
+	ctx, span := s.startJobSpan(ctx, "UpdateJobStatus", jobID)
+	defer span.End()
+
 	update := s.client.Job.UpdateOneID(jobID).
 		SetStatus(model.JobStatus(status))
 
-	if status == string(model.JobStatusSuccess) || status == string(model.JobStatusFailed) || status == string(model.JobStatusCancelled) {
+	if status == string(model.JobStatusSuccess) || status == string(model.JobStatusSuccessWithWarnings) || status == string(model.JobStatusFailed) ||
+		status == string(model.JobStatusFailedTimeout) || status == string(model.JobStatusCancelled) {
 		update.SetEndTime(time.Now())
 	}
 
@@ -66,11 +228,15 @@ func (s *JobService) UpdateJobStatus(ctx context.Context, jobID uuid.UUID, statu
 		}
 		return nil, errors.Join(errs.ErrSystem, err)
 	}
+	s.shipStatus(j)
 	return j, nil
 }
 
 // UpdateJobStats updates the statistics of a job.
 func (s *JobService) UpdateJobStats(ctx context.Context, jobID uuid.UUID, files, bytes, filesDeleted, errorCount int64) (*ent.Job, error) {
+	ctx, span := s.startJobSpan(ctx, "UpdateJobStats", jobID)
+	defer span.End()
+
 	j, err := s.client.Job.UpdateOneID(jobID).
 		SetFilesTransferred(int(files)).
 		SetBytesTransferred(bytes).
@@ -103,6 +269,7 @@ func (s *JobService) AddJobLog(ctx context.Context, jobID uuid.UUID, level, what
 	if err != nil {
 		return nil, errors.Join(errs.ErrSystem, err)
 	}
+	s.shipLogs(jobID, []*ent.JobLog{l})
 	return l, nil
 }
 
@@ -111,6 +278,10 @@ func (s *JobService) AddJobLogsBatch(ctx context.Context, jobID uuid.UUID, logs
 	if len(logs) == 0 {
 		return nil
 	}
+	ctx, span := s.startJobSpan(ctx, "AddJobLogsBatch", jobID)
+	defer span.End()
+	span.SetAttributes(attribute.Int("job.logs", len(logs)))
+
 	builders := make([]*ent.JobLogCreate, len(logs))
 	for i, l := range logs {
 		builder := s.client.JobLog.Create().
@@ -118,6 +289,7 @@ func (s *JobService) AddJobLogsBatch(ctx context.Context, jobID uuid.UUID, logs
 			SetLevel(l.Level).
 			SetWhat(l.What).
 			SetNillablePath(&l.Path).
+			SetNillablePreviousPath(l.PreviousPath).
 			SetTime(l.Time)
 
 		if l.Size > 0 {
@@ -130,40 +302,165 @@ func (s *JobService) AddJobLogsBatch(ctx context.Context, jobID uuid.UUID, logs
 	if err != nil {
 		return errors.Join(errs.ErrSystem, err)
 	}
+	s.shipLogs(jobID, logs)
+	return nil
+}
+
+// AddRetryQueueItems adds files whose transfer failed within a job to the retry queue of its task.
+func (s *JobService) AddRetryQueueItems(ctx context.Context, jobID uuid.UUID, items []*ent.RetryQueue) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.RetryQueueCreate, len(items))
+	for i, item := range items {
+		builders[i] = s.client.RetryQueue.Create().
+			SetJobID(jobID).
+			SetTaskID(item.TaskID).
+			SetPath(item.Path).
+			SetDirection(item.Direction).
+			SetErrorClass(item.ErrorClass).
+			SetError(item.Error)
+	}
+	if _, err := s.client.RetryQueue.CreateBulk(builders...).Save(ctx); err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
 	return nil
 }
 
-// ResetStuckJobs marks all jobs that are still in 'running' state as 'cancelled'.
+// retryQueueOfJob matches the retry queue items of a job and of its shard jobs.
+func retryQueueOfJob(jobID uuid.UUID) predicate.RetryQueue {
+	return retryqueue.Or(retryqueue.JobID(jobID), retryqueue.HasJobWith(job.ParentID(jobID)))
+}
+
+// ListRetryQueue returns the failed files of a job and its shard jobs, oldest first.
+func (s *JobService) ListRetryQueue(ctx context.Context, jobID uuid.UUID) ([]*ent.RetryQueue, error) {
+	items, err := s.client.RetryQueue.Query().
+		Where(retryQueueOfJob(jobID)).
+		Order(ent.Asc(retryqueue.FieldCreatedAt), ent.Asc(retryqueue.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return items, nil
+}
+
+// RequestRetry marks the failed files of a job and its shard jobs that were not retried yet,
+// so they are retried by the next RETRY run of the task, see ClaimRetryQueue.
+// It returns the number of files marked.
+func (s *JobService) RequestRetry(ctx context.Context, jobID uuid.UUID) (int, error) {
+	n, err := s.client.RetryQueue.Update().
+		Where(retryQueueOfJob(jobID), retryqueue.RetryJobIDIsNil()).
+		SetRequestedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, errors.Join(errs.ErrSystem, err)
+	}
+	return n, nil
+}
+
+// ClaimRetryQueue assigns the files of a task that were requested for retry but not retried yet
+// to the job retrying them, and returns them.
+func (s *JobService) ClaimRetryQueue(ctx context.Context, taskID, jobID uuid.UUID) ([]*ent.RetryQueue, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	items, err := tx.RetryQueue.Query().
+		Where(
+			retryqueue.TaskID(taskID),
+			retryqueue.RequestedAtNotNil(),
+			retryqueue.RetryJobIDIsNil(),
+		).
+		Order(ent.Asc(retryqueue.FieldCreatedAt), ent.Asc(retryqueue.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+		item.RetryJobID = &jobID
+	}
+	if err := tx.RetryQueue.Update().Where(retryqueue.IDIn(ids...)).SetRetryJobID(jobID).Exec(ctx); err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return items, nil
+}
+
+// AddJobEvent records an event of a job, e.g. the result of a task hook.
+func (s *JobService) AddJobEvent(ctx context.Context, jobID uuid.UUID, event *ent.JobEvent) (*ent.JobEvent, error) {
+	created, err := s.client.JobEvent.Create().
+		SetJobID(jobID).
+		SetType(event.Type).
+		SetCommand(event.Command).
+		SetArgs(event.Args).
+		SetNillableExitCode(event.ExitCode).
+		SetDurationMs(event.DurationMs).
+		SetOutput(event.Output).
+		SetOutputTruncated(event.OutputTruncated).
+		SetError(event.Error).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return created, nil
+}
+
+// ListJobEvents returns the events of a job, oldest first.
+func (s *JobService) ListJobEvents(ctx context.Context, jobID uuid.UUID) ([]*ent.JobEvent, error) {
+	events, err := s.client.JobEvent.Query().
+		Where(jobevent.JobID(jobID)).
+		Order(ent.Asc(jobevent.FieldCreatedAt), ent.Asc(jobevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return events, nil
+}
+
+// ResetStuckJobs marks all jobs that are still running or waiting for confirmation as 'cancelled'.
 // This is typically called on application startup to handle crash recovery.
 // It also calculates and updates the files_transferred and bytes_transferred
 // statistics from the job logs before marking the job as cancelled.
-func (s *JobService) ResetStuckJobs(ctx context.Context) error {
+// The jobs that were reset are returned, so interrupted runs can be resumed.
+func (s *JobService) ResetStuckJobs(ctx context.Context) ([]*ent.Job, error) {
 	s.logger.Info("Checking for stuck running jobs...")
 
-	// Find all jobs that are still in 'running' state
+	// Find all jobs that are still running or waiting for confirmation
 	stuckJobs, err := s.client.Job.Query().
-		Where(job.StatusEQ(model.JobStatusRunning)).
+		Where(job.StatusIn(model.JobStatusRunning, model.JobStatusWaitingConfirmation)).
 		All(ctx)
 
 	if err != nil {
-		return errors.Join(errs.ErrSystem, err)
+		return nil, errors.Join(errs.ErrSystem, err)
 	}
 
 	if len(stuckJobs) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	s.logger.Info("Found stuck jobs", zap.Int("count", len(stuckJobs)))
 
 	// Process each stuck job
+	resetJobs := make([]*ent.Job, 0, len(stuckJobs))
 	for _, j := range stuckJobs {
 		// Calculate statistics from job logs
-		// Count files transferred (UPLOAD, DOWNLOAD, MOVE with INFO level)
+		// Count files transferred (UPLOAD, DOWNLOAD, MOVE, RENAME with INFO level)
 		logs, err := s.client.JobLog.Query().
 			Where(
 				joblog.JobIDEQ(j.ID),
 				joblog.LevelEQ(model.LogLevelInfo),
-				joblog.WhatIn(model.LogActionUpload, model.LogActionDownload, model.LogActionMove),
+				joblog.WhatIn(model.LogActionUpload, model.LogActionDownload, model.LogActionMove, model.LogActionRename),
 			).
 			All(ctx)
 
@@ -182,7 +479,7 @@ func (s *JobService) ResetStuckJobs(ctx context.Context) error {
 		}
 
 		// Update the job with statistics and mark as cancelled
-		_, err = s.client.Job.UpdateOneID(j.ID).
+		resetJob, err := s.client.Job.UpdateOneID(j.ID).
 			SetFilesTransferred(filesTransferred).
 			SetBytesTransferred(bytesTransferred).
 			SetStatus(model.JobStatusCancelled).
@@ -196,6 +493,7 @@ func (s *JobService) ResetStuckJobs(ctx context.Context) error {
 				zap.Error(err))
 			continue
 		}
+		resetJobs = append(resetJobs, resetJob)
 
 		s.logger.Info("Reset stuck job with statistics",
 			zap.String("job_id", j.ID.String()),
@@ -204,7 +502,7 @@ func (s *JobService) ResetStuckJobs(ctx context.Context) error {
 	}
 
 	s.logger.Info("Reset stuck jobs completed", zap.Int("count", len(stuckJobs)))
-	return nil
+	return resetJobs, nil
 }
 
 // GetJob retrieves a job by ID.
@@ -219,10 +517,37 @@ func (s *JobService) GetJob(ctx context.Context, jobID uuid.UUID) (*ent.Job, err
 	return j, nil
 }
 
+// AnnotateJob sets the user note and/or acknowledged flag of a job.
+// Nil arguments are left unchanged; an empty note clears it.
+func (s *JobService) AnnotateJob(ctx context.Context, jobID uuid.UUID, note *string, acknowledged *bool) (*ent.Job, error) {
+	update := s.client.Job.UpdateOneID(jobID).
+		SetAnnotatedAt(time.Now())
+
+	if note != nil {
+		if *note == "" {
+			update.ClearNote()
+		} else {
+			update.SetNote(*note)
+		}
+	}
+	if acknowledged != nil {
+		update.SetAcknowledged(*acknowledged)
+	}
+
+	j, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return j, nil
+}
+
 // GetLastJobByTaskID retrieves the most recent job for a task.
 func (s *JobService) GetLastJobByTaskID(ctx context.Context, taskID uuid.UUID) (*ent.Job, error) {
 	j, err := s.client.Job.Query().
-		Where(job.HasTaskWith(task.ID(taskID))).
+		Where(job.HasTaskWith(task.ID(taskID)), job.ParentIDIsNil()).
 		Order(ent.Desc(job.FieldStartTime)).
 		First(ctx)
 	if err != nil {
@@ -234,8 +559,94 @@ func (s *JobService) GetLastJobByTaskID(ctx context.Context, taskID uuid.UUID) (
 	return j, nil
 }
 
-func (s *JobService) buildJobQuery(taskID *uuid.UUID, connectionID *uuid.UUID) *ent.JobQuery {
-	query := s.client.Job.Query()
+// GetLastSuccessfulConfigHash returns the configuration hash recorded by the latest successful job of a task,
+// see TaskConfigHash. It returns nil if no successful job recorded a hash.
+func (s *JobService) GetLastSuccessfulConfigHash(ctx context.Context, taskID uuid.UUID) (*string, error) {
+	j, err := s.client.Job.Query().
+		Where(
+			job.TaskID(taskID),
+			job.ParentIDIsNil(),
+			job.StatusIn(model.JobStatusSuccess, model.JobStatusSuccessWithWarnings),
+		).
+		Order(ent.Desc(job.FieldStartTime)).
+		Select(job.FieldTaskConfigHash).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return j.TaskConfigHash, nil
+}
+
+// TypicalDeletes returns the median number of files deleted by the last n successful runs of a task,
+// together with the number of runs it is based on. Retries are left out since they only redo the failed
+// items of a run.
+func (s *JobService) TypicalDeletes(ctx context.Context, taskID uuid.UUID, n int) (int64, int, error) {
+	jobs, err := s.client.Job.Query().
+		Where(
+			job.TaskID(taskID),
+			job.ParentIDIsNil(),
+			job.StatusIn(model.JobStatusSuccess, model.JobStatusSuccessWithWarnings),
+			job.TriggerNEQ(model.JobTriggerRetry),
+		).
+		Order(ent.Desc(job.FieldStartTime)).
+		Limit(n).
+		Select(job.FieldFilesDeleted).
+		All(ctx)
+	if err != nil {
+		return 0, 0, errors.Join(errs.ErrSystem, err)
+	}
+	if len(jobs) == 0 {
+		return 0, 0, nil
+	}
+
+	deletes := make([]int64, len(jobs))
+	for i, j := range jobs {
+		deletes[i] = int64(j.FilesDeleted)
+	}
+	slices.Sort(deletes)
+	return deletes[len(deletes)/2], len(deletes), nil
+}
+
+// RecordDeleteAnomaly records a DELETE_ANOMALY task event with the given message for a run
+// that deletes far more files than its task usually does, see TypicalDeletes.
+func (s *JobService) RecordDeleteAnomaly(ctx context.Context, taskID uuid.UUID, message string) error {
+	err := s.client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeDeleteAnomaly).
+		SetMessage(message).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.Join(errs.ErrNotFound, err)
+		}
+		return errors.Join(errs.ErrSystem, err)
+	}
+	return nil
+}
+
+// ListRunHistory returns the last n jobs of a task, oldest first. Only the fields needed to chart
+// the runs are loaded: ID, status, start and end time, and transferred bytes.
+func (s *JobService) ListRunHistory(ctx context.Context, taskID uuid.UUID, n int) ([]*ent.Job, error) {
+	jobs, err := s.client.Job.Query().
+		Where(job.TaskID(taskID), job.ParentIDIsNil()).
+		Order(ent.Desc(job.FieldStartTime)).
+		Limit(n).
+		Select(job.FieldStatus, job.FieldStartTime, job.FieldEndTime, job.FieldBytesTransferred).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	slices.Reverse(jobs)
+	return jobs, nil
+}
+
+func (s *JobService) buildJobQuery(taskID *uuid.UUID, connectionID *uuid.UUID, status string) *ent.JobQuery {
+	// Shard jobs are listed through their parent, jobs of deleted tasks are listed after a restore
+	query := s.client.Job.Query().
+		Where(job.ParentIDIsNil(), job.HasTaskWith(task.DeletedAtIsNil()))
 
 	if taskID != nil {
 		query.Where(job.HasTaskWith(task.ID(*taskID)))
@@ -245,14 +656,20 @@ func (s *JobService) buildJobQuery(taskID *uuid.UUID, connectionID *uuid.UUID) *
 		query.Where(job.HasTaskWith(task.ConnectionIDEQ(*connectionID)))
 	}
 
+	if status != "" {
+		query.Where(job.StatusEQ(model.JobStatus(status)))
+	}
+
 	return query
 }
 
-// ListJobs retrieves jobs with optional filtering (taskID, connectionID) and pagination.
-func (s *JobService) ListJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, limit, offset int) ([]*ent.Job, error) {
-	query := s.buildJobQuery(taskID, connectionID)
+// ListJobs retrieves jobs with optional filtering (taskID, connectionID, status) and pagination.
+// An empty status matches all jobs.
+func (s *JobService) ListJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, status string, limit, offset int) ([]*ent.Job, error) {
+	query := s.buildJobQuery(taskID, connectionID, status)
+	// The ID breaks ties between jobs started at the same time, so that pages do not overlap
 	jobs, err := query.
-		Order(ent.Desc(job.FieldStartTime)).
+		Order(ent.Desc(job.FieldStartTime), ent.Desc(job.FieldID)).
 		Limit(limit).
 		Offset(offset).
 		All(ctx)
@@ -264,8 +681,8 @@ func (s *JobService) ListJobs(ctx context.Context, taskID *uuid.UUID, connection
 }
 
 // CountJobs returns the total count of jobs with optional filtering.
-func (s *JobService) CountJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID) (int, error) {
-	query := s.buildJobQuery(taskID, connectionID)
+func (s *JobService) CountJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, status string) (int, error) {
+	query := s.buildJobQuery(taskID, connectionID, status)
 	count, err := query.Count(ctx)
 	if err != nil {
 		return 0, errors.Join(errs.ErrSystem, err)
@@ -273,6 +690,79 @@ func (s *JobService) CountJobs(ctx context.Context, taskID *uuid.UUID, connectio
 	return count, nil
 }
 
+// ListActiveJobs retrieves the jobs that are pending, running or waiting for confirmation, oldest first,
+// optionally filtered by task and connection. Shard jobs are left out, they end with their parent.
+// Mirror jobs are only included when filtering by connection, as the jobs uploading to the connection of their mirror.
+func (s *JobService) ListActiveJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID) ([]*ent.Job, error) {
+	query := s.client.Job.Query().
+		Where(job.StatusIn(model.JobStatusPending, model.JobStatusRunning, model.JobStatusWaitingConfirmation))
+	if taskID != nil {
+		query.Where(job.TaskID(*taskID))
+	}
+	if connectionID != nil {
+		query.Where(job.Or(
+			job.And(job.ParentIDIsNil(), job.HasTaskWith(task.ConnectionIDEQ(*connectionID))),
+			job.And(job.ParentIDNotNil(), func(sel *sql.Selector) {
+				sel.Where(sqljson.ValueEQ(job.FieldTriggerDetail, connectionID.String(), sqljson.Path("mirrorConnectionId")))
+			}),
+		))
+	} else {
+		query.Where(job.ParentIDIsNil())
+	}
+
+	jobs, err := query.Order(ent.Asc(job.FieldStartTime)).All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return jobs, nil
+}
+
+// JobDay summarizes the jobs started on one day.
+type JobDay struct {
+	// Day is midnight of the day in the local time zone of the server.
+	Day time.Time
+	// JobIDs are the IDs of the jobs of the day, newest first.
+	JobIDs []uuid.UUID
+	// StatusCounts is the number of jobs of the day per status.
+	StatusCounts map[model.JobStatus]int
+	// FilesTransferred, BytesTransferred and ErrorCount are the sums of the jobs of the day.
+	FilesTransferred int64
+	BytesTransferred int64
+	ErrorCount       int64
+}
+
+// ListJobDays groups the jobs of the last days days, including today, by the day they started on,
+// with the same filtering as ListJobs. Days without jobs are left out, the rest are returned oldest first.
+// Only the fields that are summarized are loaded, so the jobs of long periods can be grouped.
+func (s *JobService) ListJobDays(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, days int) ([]*JobDay, error) {
+	since := startOfDay(time.Now()).AddDate(0, 0, -(days - 1))
+	jobs, err := s.buildJobQuery(taskID, connectionID, "").
+		Where(job.StartTimeGTE(since)).
+		Order(ent.Desc(job.FieldStartTime), ent.Desc(job.FieldID)).
+		Select(job.FieldStatus, job.FieldStartTime, job.FieldFilesTransferred, job.FieldBytesTransferred, job.FieldErrorCount).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	var result []*JobDay
+	for _, j := range jobs {
+		day := startOfDay(j.StartTime.Local())
+		// Jobs are ordered newest first, so a new day starts whenever the day changes
+		if len(result) == 0 || !result[len(result)-1].Day.Equal(day) {
+			result = append(result, &JobDay{Day: day, StatusCounts: make(map[model.JobStatus]int)})
+		}
+		d := result[len(result)-1]
+		d.JobIDs = append(d.JobIDs, j.ID)
+		d.StatusCounts[j.Status]++
+		d.FilesTransferred += int64(j.FilesTransferred)
+		d.BytesTransferred += j.BytesTransferred
+		d.ErrorCount += int64(j.ErrorCount)
+	}
+	slices.Reverse(result)
+	return result, nil
+}
+
 // GetJobWithLogs retrieves a job by ID, including its logs.
 func (s *JobService) GetJobWithLogs(ctx context.Context, jobID uuid.UUID) (*ent.Job, error) {
 	j, err := s.client.Job.Query().
@@ -366,6 +856,38 @@ func (s *JobService) ListJobLogsByJobPaginated(ctx context.Context, jobID uuid.U
 	return logs, totalCount, nil
 }
 
+// ForEachJobLog calls fn for every log of a job in time order, loading batchSize logs at a time.
+// Batches are paged by (time, id), so logs added while iterating neither shift nor repeat pages.
+// Iteration stops at the first error returned by fn.
+func (s *JobService) ForEachJobLog(ctx context.Context, jobID uuid.UUID, batchSize int, fn func(*ent.JobLog) error) error {
+	var last *ent.JobLog
+	for {
+		query := s.client.JobLog.Query().
+			Where(joblog.JobID(jobID)).
+			Order(ent.Asc(joblog.FieldTime), ent.Asc(joblog.FieldID)).
+			Limit(batchSize)
+		if last != nil {
+			query.Where(joblog.Or(
+				joblog.TimeGT(last.Time),
+				joblog.And(joblog.TimeEQ(last.Time), joblog.IDGT(last.ID)),
+			))
+		}
+		logs, err := query.All(ctx)
+		if err != nil {
+			return errors.Join(errs.ErrSystem, err)
+		}
+		for _, l := range logs {
+			if err := fn(l); err != nil {
+				return err
+			}
+		}
+		if len(logs) < batchSize {
+			return nil
+		}
+		last = logs[len(logs)-1]
+	}
+}
+
 // DeleteOldLogsForConnection deletes old logs for a connection, keeping only the newest keepCount logs.
 // Returns the number of logs deleted.
 func (s *JobService) DeleteOldLogsForConnection(ctx context.Context, connectionID uuid.UUID, keepCount int) (int, error) {
@@ -409,6 +931,60 @@ func (s *JobService) DeleteOldLogsForConnection(ctx context.Context, connectionI
 	return deleted, nil
 }
 
+// JobLogDeleteFilter selects the job logs deleted by DeleteJobLogs. Unset fields match all logs.
+type JobLogDeleteFilter struct {
+	TaskID *uuid.UUID
+	JobID  *uuid.UUID
+	Level  string
+	// Before matches logs written before this time
+	Before *time.Time
+}
+
+// DeleteJobLogs deletes the job logs matching filter in batches of batchSize logs, so large ranges
+// don't hold a single long write lock on the database. progress, if not nil, is called after each batch
+// with the number of logs deleted so far and the number of logs matching the filter when deletion started.
+// Deletion stops between batches if ctx is cancelled. It returns the number of deleted logs.
+func (s *JobService) DeleteJobLogs(ctx context.Context, filter JobLogDeleteFilter, batchSize int, progress func(deleted, total int)) (int, error) {
+	query := s.buildJobLogQuery(nil, filter.TaskID, filter.JobID, filter.Level)
+	if filter.Before != nil {
+		query.Where(joblog.TimeLT(*filter.Before))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return 0, errors.Join(errs.ErrSystem, err)
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	s.logger.Info("Deleting job logs", zap.Int("matched", total), zap.Int("batch_size", batchSize))
+
+	deleted := 0
+	for deleted < total {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+		ids, err := query.Clone().Order(ent.Asc(joblog.FieldID)).Limit(batchSize).IDs(ctx)
+		if err != nil {
+			return deleted, errors.Join(errs.ErrSystem, err)
+		}
+		if len(ids) == 0 {
+			break // Logs were deleted concurrently, e.g. by the retention worker
+		}
+		n, err := s.client.JobLog.Delete().Where(joblog.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return deleted, errors.Join(errs.ErrSystem, err)
+		}
+		deleted += n
+		if progress != nil {
+			progress(deleted, total)
+		}
+	}
+
+	s.logger.Info("Deleted job logs", zap.Int("deleted_count", deleted))
+	return deleted, nil
+}
+
 // DeleteJob deletes a job by ID.
 // This will cascade delete all associated job logs.
 func (s *JobService) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
@@ -426,4 +1002,364 @@ func (s *JobService) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
 	return nil
 }
 
+// DeleteTaskJobsBefore deletes the finished jobs of a task that ended before the given time, together with
+// their logs, events and child jobs. The latest job of the task is always kept, so the task keeps its last result.
+// It returns the number of deleted jobs, not counting child jobs.
+func (s *JobService) DeleteTaskJobsBefore(ctx context.Context, taskID uuid.UUID, before time.Time) (int, error) {
+	latest, err := s.client.Job.Query().
+		Where(job.TaskIDEQ(taskID), job.ParentIDIsNil()).
+		Order(ent.Desc(job.FieldStartTime)).
+		FirstID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, errors.Join(errs.ErrSystem, err)
+	}
+
+	deleted, err := s.client.Job.Delete().
+		Where(
+			job.TaskIDEQ(taskID),
+			job.ParentIDIsNil(),
+			job.IDNEQ(latest),
+			job.StatusNotIn(model.JobStatusPending, model.JobStatusRunning, model.JobStatusWaitingConfirmation),
+			job.EndTimeLT(before),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, errors.Join(errs.ErrSystem, err)
+	}
+	return deleted, nil
+}
+
+// JobRollupDiscrepancy describes a stored rollup statistic of a parent job
+// that does not match the value recomputed from its child jobs.
+type JobRollupDiscrepancy struct {
+	JobID    uuid.UUID
+	Field    string
+	Stored   int64
+	Computed int64
+}
+
+// JobRollupReport is the result of ReindexJobRollups.
+type JobRollupReport struct {
+	// CheckedJobs is the number of finished sharded parent jobs that were checked.
+	CheckedJobs int
+	// Discrepancies lists every mismatching field found.
+	Discrepancies []JobRollupDiscrepancy
+	// FixedJobs is the number of parent jobs whose statistics were rewritten.
+	FixedJobs int
+}
+
+// ReindexJobRollups recomputes the statistics of finished sharded parent jobs
+// from their child jobs and reports fields whose stored value differs.
+// When fix is true the parent jobs are updated with the recomputed values.
+// Pending, running and waiting parents are skipped since their rollup is written on finalization.
+func (s *JobService) ReindexJobRollups(ctx context.Context, fix bool) (*JobRollupReport, error) {
+	parents, err := s.client.Job.Query().
+		Where(
+			job.HasChildren(),
+			job.StatusNotIn(model.JobStatusPending, model.JobStatusRunning, model.JobStatusWaitingConfirmation),
+		).
+		WithChildren().
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	report := &JobRollupReport{}
+	for _, p := range parents {
+		// Mirror jobs have stats of their own, only shards roll up into the parent
+		shards := slices.DeleteFunc(p.Edges.Children, func(c *ent.Job) bool {
+			return c.TriggerDetail != nil && c.TriggerDetail.MirrorConnectionID != nil
+		})
+		if len(shards) == 0 {
+			continue
+		}
+		report.CheckedJobs++
+
+		var sum ent.Job
+		for _, c := range shards {
+			sum.FilesTransferred += c.FilesTransferred
+			sum.BytesTransferred += c.BytesTransferred
+			sum.UploadedFiles += c.UploadedFiles
+			sum.UploadedBytes += c.UploadedBytes
+			sum.DownloadedFiles += c.DownloadedFiles
+			sum.DownloadedBytes += c.DownloadedBytes
+			sum.FilesDeleted += c.FilesDeleted
+			sum.ErrorCount += c.ErrorCount
+			sum.FilesChecked += c.FilesChecked
+			sum.ListingsDone += c.ListingsDone
+		}
+
+		fields := []struct {
+			name             string
+			stored, computed int64
+		}{
+			{job.FieldFilesTransferred, int64(p.FilesTransferred), int64(sum.FilesTransferred)},
+			{job.FieldBytesTransferred, p.BytesTransferred, sum.BytesTransferred},
+			{job.FieldUploadedFiles, int64(p.UploadedFiles), int64(sum.UploadedFiles)},
+			{job.FieldUploadedBytes, p.UploadedBytes, sum.UploadedBytes},
+			{job.FieldDownloadedFiles, int64(p.DownloadedFiles), int64(sum.DownloadedFiles)},
+			{job.FieldDownloadedBytes, p.DownloadedBytes, sum.DownloadedBytes},
+			{job.FieldFilesDeleted, int64(p.FilesDeleted), int64(sum.FilesDeleted)},
+			{job.FieldErrorCount, int64(p.ErrorCount), int64(sum.ErrorCount)},
+			{job.FieldFilesChecked, int64(p.FilesChecked), int64(sum.FilesChecked)},
+			{job.FieldListingsDone, int64(p.ListingsDone), int64(sum.ListingsDone)},
+		}
+		mismatch := false
+		for _, f := range fields {
+			if f.stored != f.computed {
+				mismatch = true
+				report.Discrepancies = append(report.Discrepancies, JobRollupDiscrepancy{
+					JobID:    p.ID,
+					Field:    f.name,
+					Stored:   f.stored,
+					Computed: f.computed,
+				})
+			}
+		}
+		if !mismatch || !fix {
+			continue
+		}
+
+		err := s.client.Job.UpdateOneID(p.ID).
+			SetFilesTransferred(sum.FilesTransferred).
+			SetBytesTransferred(sum.BytesTransferred).
+			SetUploadedFiles(sum.UploadedFiles).
+			SetUploadedBytes(sum.UploadedBytes).
+			SetDownloadedFiles(sum.DownloadedFiles).
+			SetDownloadedBytes(sum.DownloadedBytes).
+			SetFilesDeleted(sum.FilesDeleted).
+			SetErrorCount(sum.ErrorCount).
+			SetFilesChecked(sum.FilesChecked).
+			SetListingsDone(sum.ListingsDone).
+			Exec(ctx)
+		if err != nil {
+			return nil, errors.Join(errs.ErrSystem, err)
+		}
+		report.FixedJobs++
+	}
+
+	s.logger.Info("Reindexed job rollups",
+		zap.Int("checked", report.CheckedJobs),
+		zap.Int("discrepancies", len(report.Discrepancies)),
+		zap.Int("fixed", report.FixedJobs))
+	return report, nil
+}
+
+// FinalizeJob applies the final result of a job in a single transaction:
+// statistics, terminal status, end time and extra logs are written together,
+// or the job is deleted when result.DeleteJob is set.
+// The consecutive failure count of the task is updated with the status of top-level jobs,
+// see SetFailureEscalationThreshold. Returns nil job when the job was deleted.
+func (s *JobService) FinalizeJob(ctx context.Context, jobID uuid.UUID, result ports.JobResult) (*ent.Job, error) {
+	ctx, span := s.startJobSpan(ctx, "FinalizeJob", jobID)
+	defer span.End()
+	span.SetAttributes(attribute.Stringer("job.status", result.Status), attribute.Int("job.logs", len(result.Logs)))
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	// The job is loaded first since finalizing may delete it
+	var (
+		j        *ent.Job
+		failures int
+		disabled string
+	)
+	target, err := tx.Client().Job.Get(ctx, jobID)
+	if err == nil {
+		j, err = finalizeJobTx(ctx, tx.Client(), jobID, result)
+	}
+	// Shard jobs are counted through their parent
+	if err == nil && target.ParentID == nil {
+		failures, err = s.recordFailuresTx(ctx, tx.Client(), target.TaskID, result)
+	}
+	if err == nil && target.ParentID == nil {
+		disabled, err = recordFatalOutcomeTx(ctx, tx.Client(), target.TaskID, result, s.disableThreshold)
+	}
+	if err == nil {
+		err = recordTransferTx(ctx, tx.Client(), target, result.BytesTransferred)
+	}
+	if err == nil && result.Status == model.JobStatusQuotaDeferred {
+		err = recordQuotaDeferredTx(ctx, tx.Client(), target.TaskID, result.Error)
+	}
+	if err != nil {
+		tracing.RecordError(span, err)
+		if rerr := tx.Rollback(); rerr != nil {
+			s.logger.Error("Failed to rollback job finalization", zap.String("job_id", jobID.String()), zap.Error(rerr))
+		}
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	s.logger.Info("Finalized job",
+		zap.String("job_id", jobID.String()),
+		zap.Stringer("status", result.Status),
+		zap.Bool("deleted", result.DeleteJob))
+	if s.escalates(failures, result.Status) {
+		s.logger.Error("Task failed repeatedly",
+			zap.String("task_id", target.TaskID.String()),
+			zap.String("job_id", jobID.String()),
+			zap.Int("consecutive_failures", failures),
+			zap.String("error", result.Error))
+	}
+	if disabled != "" {
+		s.logger.Error("Task disabled after repeated non-transient failures",
+			zap.String("task_id", target.TaskID.String()),
+			zap.String("job_id", jobID.String()),
+			zap.String("reason", disabled))
+	}
+	if result.Status == model.JobStatusQuotaDeferred {
+		s.logger.Warn("Job deferred by the monthly transfer cap",
+			zap.String("task_id", target.TaskID.String()),
+			zap.String("job_id", jobID.String()),
+			zap.String("reason", result.Error))
+	}
+	if j != nil {
+		s.shipLogs(jobID, result.Logs)
+		s.shipStatus(j)
+	}
+	return j, nil
+}
+
+// escalates reports whether a run with the given status, leaving its task with failures consecutive
+// failures, reaches the failure escalation threshold.
+func (s *JobService) escalates(failures int, status model.JobStatus) bool {
+	return s.failureThreshold > 0 && failures == s.failureThreshold &&
+		(status == model.JobStatusFailed || status == model.JobStatusFailedTimeout)
+}
+
+// recordFailuresTx updates the consecutive failure count of the task with the job result and records
+// a CONSECUTIVE_FAILURES task event when the count reaches the escalation threshold. Returns the updated count.
+func (s *JobService) recordFailuresTx(ctx context.Context, client *ent.Client, taskID uuid.UUID, result ports.JobResult) (int, error) {
+	failures, err := recordJobOutcomeTx(ctx, client, taskID, result.Status)
+	if err != nil || !s.escalates(failures, result.Status) {
+		return failures, err
+	}
+
+	message := fmt.Sprintf("task failed %d times in a row", failures)
+	if result.Error != "" {
+		message += ", last error: " + result.Error
+	}
+	return failures, client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeConsecutiveFailures).
+		SetMessage(message).
+		Exec(ctx)
+}
+
+// finalizeJobTx performs the writes of FinalizeJob using a transactional client.
+func finalizeJobTx(ctx context.Context, client *ent.Client, jobID uuid.UUID, result ports.JobResult) (*ent.Job, error) {
+	if result.DeleteJob {
+		return nil, client.Job.DeleteOneID(jobID).Exec(ctx)
+	}
+
+	if len(result.Logs) > 0 {
+		builders := make([]*ent.JobLogCreate, len(result.Logs))
+		for i, l := range result.Logs {
+			logTime := l.Time
+			if logTime.IsZero() {
+				logTime = time.Now()
+			}
+			builder := client.JobLog.Create().
+				SetJobID(jobID).
+				SetLevel(l.Level).
+				SetWhat(l.What).
+				SetNillablePath(&l.Path).
+				SetNillablePreviousPath(l.PreviousPath).
+				SetTime(logTime)
+
+			if l.Size > 0 {
+				builder.SetSize(l.Size)
+			}
+
+			builders[i] = builder
+		}
+		if _, err := client.JobLog.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mergeRenameLogsTx(ctx, client, jobID, result.Renames); err != nil {
+		return nil, err
+	}
+
+	update := client.Job.UpdateOneID(jobID).
+		SetStatus(result.Status).
+		SetFilesTransferred(int(result.FilesTransferred)).
+		SetBytesTransferred(result.BytesTransferred).
+		SetFilesDeleted(int(result.FilesDeleted)).
+		SetErrorCount(int(result.ErrorCount)).
+		SetFilesChecked(int(result.FilesChecked)).
+		SetListingsDone(int(result.ListingsDone)).
+		SetUploadedFiles(int(result.UploadedFiles)).
+		SetUploadedBytes(result.UploadedBytes).
+		SetDownloadedFiles(int(result.DownloadedFiles)).
+		SetDownloadedBytes(result.DownloadedBytes).
+		SetEndTime(time.Now())
+
+	if result.Error != "" {
+		update.SetErrors(result.Error)
+	}
+	if result.Concurrency != nil {
+		update.SetConcurrency(result.Concurrency)
+	}
+	if result.ConfigSnapshot != nil {
+		update.SetConfigSnapshot(result.ConfigSnapshot)
+	}
+
+	return update.Save(ctx)
+}
+
+// mergeRenameLogsTx replaces the DELETE log of the previous path and the transfer log of the new path
+// of each rename with a single RENAME log. Renames without both logs are skipped.
+func mergeRenameLogsTx(ctx context.Context, client *ent.Client, jobID uuid.UUID, renames []ports.LogRename) error {
+	for _, r := range renames {
+		transfer, err := client.JobLog.Query().
+			Where(
+				joblog.JobIDEQ(jobID),
+				joblog.PathEQ(r.Path),
+				joblog.WhatIn(model.LogActionUpload, model.LogActionDownload),
+			).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		deleted, err := client.JobLog.Query().
+			Where(
+				joblog.JobIDEQ(jobID),
+				joblog.PathEQ(r.PreviousPath),
+				joblog.WhatEQ(model.LogActionDelete),
+			).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := client.JobLog.DeleteOne(deleted).Exec(ctx); err != nil {
+			return err
+		}
+		if err := client.JobLog.UpdateOne(transfer).
+			SetWhat(model.LogActionRename).
+			SetPreviousPath(r.PreviousPath).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var _ ports.JobService = (*JobService)(nil)
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,8 +16,14 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/db"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/joblog"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func init() {
@@ -62,6 +70,63 @@ func TestJobService(t *testing.T) {
 
 			assert.Equal(t, model.JobStatusPending, j.Status)
 			assert.Equal(t, model.JobTriggerManual, j.Trigger)
+			assert.Nil(t, j.TraceID, "no trace outside of a span")
+		})
+
+		t.Run("WithinTrace", func(t *testing.T) {
+			traceCtx := trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+				SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+				TraceFlags: trace.FlagsSampled,
+			}))
+			j, err := service.CreateJob(traceCtx, taskID, model.JobTriggerManual)
+			require.NoError(t, err)
+			require.NotNil(t, j.TraceID)
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", *j.TraceID)
+
+			child, err := service.CreateChildJob(traceCtx, j.ID, taskID, model.JobTriggerManual)
+			require.NoError(t, err)
+			assert.Equal(t, j.TraceID, child.TraceID)
+		})
+
+		t.Run("WithTriggerDetail", func(t *testing.T) {
+			schedule := "0 3 * * *"
+			detailCtx := provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{Schedule: &schedule})
+			j, err := service.CreateJob(detailCtx, taskID, model.JobTriggerSchedule)
+			require.NoError(t, err)
+
+			fetched, err := service.GetJob(ctx, j.ID)
+			require.NoError(t, err)
+			require.NotNil(t, fetched.TriggerDetail)
+			assert.Equal(t, schedule, *fetched.TriggerDetail.Schedule)
+
+			child, err := service.CreateChildJob(detailCtx, j.ID, taskID, model.JobTriggerSchedule)
+			require.NoError(t, err)
+			assert.Equal(t, j.TriggerDetail, child.TriggerDetail)
+
+			plain, err := service.CreateJob(ctx, taskID, model.JobTriggerManual)
+			require.NoError(t, err)
+			assert.Nil(t, plain.TriggerDetail)
+		})
+
+		t.Run("TakesOverPendingJob", func(t *testing.T) {
+			pending, err := service.CreateJob(ctx, taskID, model.JobTriggerManual)
+			require.NoError(t, err)
+
+			j, err := service.CreateJob(provenance.WithJob(ctx, &pending.ID), taskID, model.JobTriggerManual)
+			require.NoError(t, err)
+			assert.Equal(t, pending.ID, j.ID)
+
+			// Jobs of other tasks or already started ones aren't taken over
+			other, err := service.CreateJob(provenance.WithJob(ctx, &pending.ID), createTask(t), model.JobTriggerManual)
+			require.NoError(t, err)
+			assert.NotEqual(t, pending.ID, other.ID)
+
+			_, err = service.UpdateJobStatus(ctx, pending.ID, string(model.JobStatusRunning), "")
+			require.NoError(t, err)
+			started, err := service.CreateJob(provenance.WithJob(ctx, &pending.ID), taskID, model.JobTriggerManual)
+			require.NoError(t, err)
+			assert.NotEqual(t, pending.ID, started.ID)
 		})
 
 		t.Run("InvalidTask", func(t *testing.T) {
@@ -183,7 +248,7 @@ func TestJobService(t *testing.T) {
 		})
 
 		t.Run("ListJobs", func(t *testing.T) {
-			list, err := service.ListJobs(ctx, &taskID, nil, 10, 0)
+			list, err := service.ListJobs(ctx, &taskID, nil, "", 10, 0)
 			assert.NoError(t, err)
 			assert.Len(t, list, 2)
 			// Should be ordered by StartTime Desc
@@ -198,8 +263,13 @@ func TestJobService(t *testing.T) {
 		j, _ := service.CreateJob(ctx, taskID, model.JobTriggerManual)
 		_, _ = service.UpdateJobStatus(ctx, j.ID, string(model.JobStatusRunning), "")
 
-		err := service.ResetStuckJobs(ctx)
+		reset, err := service.ResetStuckJobs(ctx)
 		assert.NoError(t, err)
+		resetIDs := make([]uuid.UUID, len(reset))
+		for i, r := range reset {
+			resetIDs[i] = r.ID
+		}
+		assert.Contains(t, resetIDs, j.ID, "the reset jobs are returned")
 
 		// Verify it is now failed
 		updated, _ := service.GetJob(ctx, j.ID)
@@ -264,13 +334,13 @@ func TestJobService(t *testing.T) {
 
 		t.Run("NoFilters", func(t *testing.T) {
 			// There might be jobs from other tests, so we just check count > 0 or specific logic
-			count, err := service.CountJobs(ctx, nil, nil)
+			count, err := service.CountJobs(ctx, nil, nil, "")
 			assert.NoError(t, err)
 			assert.GreaterOrEqual(t, count, 2)
 		})
 
 		t.Run("FilterByTaskID", func(t *testing.T) {
-			count, err := service.CountJobs(ctx, &newTaskID, nil)
+			count, err := service.CountJobs(ctx, &newTaskID, nil, "")
 			assert.NoError(t, err)
 			assert.Equal(t, 2, count)
 		})
@@ -286,7 +356,7 @@ func TestJobService(t *testing.T) {
 			_, err = service.CreateJob(ctx, uniqueTask.ID, model.JobTriggerManual)
 			require.NoError(t, err)
 
-			count, err := service.CountJobs(ctx, nil, &uniqueConn.ID)
+			count, err := service.CountJobs(ctx, nil, &uniqueConn.ID, "")
 			assert.NoError(t, err)
 			assert.Equal(t, 1, count)
 		})
@@ -347,7 +417,7 @@ func TestJobService(t *testing.T) {
 	t.Run("ResetStuckJobs_NoOp", func(t *testing.T) {
 		// Ensure no running jobs exist (or clean state)
 		// We can't guarantee global state easily but we can check it doesn't error
-		err := service.ResetStuckJobs(ctx)
+		_, err := service.ResetStuckJobs(ctx)
 		assert.NoError(t, err)
 	})
 
@@ -375,7 +445,7 @@ func TestJobService(t *testing.T) {
 		require.NoError(t, err)
 
 		// List jobs by connectionID
-		jobs, err := service.ListJobs(ctx, nil, &uniqueConn.ID, 10, 0)
+		jobs, err := service.ListJobs(ctx, nil, &uniqueConn.ID, "", 10, 0)
 		assert.NoError(t, err)
 		assert.GreaterOrEqual(t, len(jobs), 2)
 
@@ -494,6 +564,434 @@ func TestJobService_UpdateJobStatus_Transitions(t *testing.T) {
 	assert.Empty(t, updated.Errors)
 }
 
+func TestJobService_AnnotateJob(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-annotate", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Annotate Test Task", "/l", testConn.ID, "/r", string(model.SyncDirectionBidirectional), "", false, nil)
+	require.NoError(t, err)
+	j, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	assert.False(t, j.Acknowledged)
+	assert.Nil(t, j.AnnotatedAt)
+
+	note := "remote was down, ignore"
+	ack := true
+	updated, err := service.AnnotateJob(ctx, j.ID, &note, &ack)
+	require.NoError(t, err)
+	assert.Equal(t, note, updated.Note)
+	assert.True(t, updated.Acknowledged)
+	require.NotNil(t, updated.AnnotatedAt)
+
+	// Nil arguments keep the current values
+	updated, err = service.AnnotateJob(ctx, j.ID, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, note, updated.Note)
+	assert.True(t, updated.Acknowledged)
+
+	// An empty note clears it
+	empty := ""
+	updated, err = service.AnnotateJob(ctx, j.ID, &empty, nil)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Note)
+	assert.True(t, updated.Acknowledged)
+
+	_, err = service.AnnotateJob(ctx, uuid.New(), &note, nil)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestJobService_RetryQueue(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-retry", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Retry Test Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	parent, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	child, err := service.CreateChildJob(ctx, parent.ID, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	require.NoError(t, service.AddRetryQueueItems(ctx, parent.ID, nil))
+	require.NoError(t, service.AddRetryQueueItems(ctx, parent.ID, []*ent.RetryQueue{
+		{TaskID: task.ID, Path: "a.txt", Direction: model.SyncDirectionUpload, ErrorClass: model.TransferErrorClassNetwork, Error: "connection reset by peer"},
+	}))
+	require.NoError(t, service.AddRetryQueueItems(ctx, child.ID, []*ent.RetryQueue{
+		{TaskID: task.ID, Path: "photos/b.jpg", Direction: model.SyncDirectionUpload, ErrorClass: model.TransferErrorClassNoSpace, Error: "quota exceeded"},
+	}))
+
+	t.Run("ListIncludesShardJobs", func(t *testing.T) {
+		items, err := service.ListRetryQueue(ctx, parent.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "a.txt", items[0].Path)
+		assert.Equal(t, "photos/b.jpg", items[1].Path)
+		assert.Nil(t, items[0].RequestedAt)
+
+		items, err = service.ListRetryQueue(ctx, child.ID)
+		require.NoError(t, err)
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("ClaimWithoutRequest", func(t *testing.T) {
+		items, err := service.ClaimRetryQueue(ctx, task.ID, uuid.New())
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	retry, err := service.CreateJob(ctx, task.ID, model.JobTriggerRetry)
+	require.NoError(t, err)
+
+	t.Run("RequestAndClaim", func(t *testing.T) {
+		n, err := service.RequestRetry(ctx, parent.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		items, err := service.ClaimRetryQueue(ctx, task.ID, retry.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		for _, item := range items {
+			require.NotNil(t, item.RetryJobID)
+			assert.Equal(t, retry.ID, *item.RetryJobID)
+		}
+
+		// Claimed files are neither claimed nor requested again
+		items, err = service.ClaimRetryQueue(ctx, task.ID, uuid.New())
+		require.NoError(t, err)
+		assert.Empty(t, items)
+		n, err = service.RequestRetry(ctx, parent.ID)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("DeletedWithJob", func(t *testing.T) {
+		require.NoError(t, service.DeleteJob(ctx, parent.ID))
+		n, err := client.RetryQueue.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func TestJobService_JobEvents(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-events", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Events Test Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	job, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	exitCode := 0
+	_, err = service.AddJobEvent(ctx, job.ID, &ent.JobEvent{
+		Type:       model.JobEventTypePreHook,
+		Command:    "/usr/local/bin/mount-backup",
+		Args:       []string{"--disk", "usb"},
+		ExitCode:   &exitCode,
+		DurationMs: 120,
+		Output:     "mounted\n",
+	})
+	require.NoError(t, err)
+	_, err = service.AddJobEvent(ctx, job.ID, &ent.JobEvent{
+		Type:            model.JobEventTypePostHook,
+		Command:         "/usr/local/bin/notify",
+		DurationMs:      300000,
+		Output:          "waiting",
+		OutputTruncated: true,
+		Error:           "hook timed out",
+	})
+	require.NoError(t, err)
+
+	events, err := service.ListJobEvents(ctx, job.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, model.JobEventTypePreHook, events[0].Type)
+	assert.Equal(t, []string{"--disk", "usb"}, events[0].Args)
+	require.NotNil(t, events[0].ExitCode)
+	assert.Equal(t, 0, *events[0].ExitCode)
+	assert.Equal(t, "mounted\n", events[0].Output)
+	assert.Empty(t, events[0].Error)
+	assert.Equal(t, model.JobEventTypePostHook, events[1].Type)
+	assert.Nil(t, events[1].ExitCode)
+	assert.True(t, events[1].OutputTruncated)
+	assert.Equal(t, "hook timed out", events[1].Error)
+
+	// Events are deleted with their job
+	require.NoError(t, service.DeleteJob(ctx, job.ID))
+	events, err = service.ListJobEvents(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestJobService_ListJobDays(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-days", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	task1, err := taskService.CreateTask(ctx, "Days Task 1", "/l1", testConn.ID, "/r1", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	task2, err := taskService.CreateTask(ctx, "Days Task 2", "/l2", testConn.ID, "/r2", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	today := startOfDay(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+	createJob := func(taskID uuid.UUID, start time.Time, status model.JobStatus, files int, bytes int64, errorCount int) *ent.Job {
+		j, err := client.Job.Create().
+			SetTaskID(taskID).
+			SetTrigger(model.JobTriggerSchedule).
+			SetStartTime(start).
+			SetStatus(status).
+			SetFilesTransferred(files).
+			SetBytesTransferred(bytes).
+			SetErrorCount(errorCount).
+			Save(ctx)
+		require.NoError(t, err)
+		return j
+	}
+	oldest := createJob(task1.ID, yesterday.Add(2*time.Hour), model.JobStatusSuccess, 1, 100, 0)
+	newest := createJob(task1.ID, yesterday.Add(20*time.Hour), model.JobStatusFailedTimeout, 2, 200, 3)
+	other := createJob(task2.ID, yesterday.Add(10*time.Hour), model.JobStatusSuccessWithWarnings, 4, 400, 1)
+	todays := createJob(task1.ID, today.Add(time.Minute), model.JobStatusRunning, 0, 0, 0)
+	// Outside of the requested days
+	createJob(task1.ID, today.AddDate(0, 0, -7).Add(time.Hour), model.JobStatusSuccess, 8, 800, 0)
+
+	days, err := service.ListJobDays(ctx, nil, nil, 7)
+	require.NoError(t, err)
+	require.Len(t, days, 2)
+	assert.True(t, days[0].Day.Equal(yesterday))
+	assert.Equal(t, []uuid.UUID{newest.ID, other.ID, oldest.ID}, days[0].JobIDs)
+	assert.Equal(t, map[model.JobStatus]int{
+		model.JobStatusSuccess:             1,
+		model.JobStatusSuccessWithWarnings: 1,
+		model.JobStatusFailedTimeout:       1,
+	}, days[0].StatusCounts)
+	assert.Equal(t, int64(7), days[0].FilesTransferred)
+	assert.Equal(t, int64(700), days[0].BytesTransferred)
+	assert.Equal(t, int64(4), days[0].ErrorCount)
+	assert.True(t, days[1].Day.Equal(today))
+	assert.Equal(t, []uuid.UUID{todays.ID}, days[1].JobIDs)
+
+	days, err = service.ListJobDays(ctx, &task2.ID, nil, 7)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, []uuid.UUID{other.ID}, days[0].JobIDs)
+
+	days, err = service.ListJobDays(ctx, nil, &testConn.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, []uuid.UUID{todays.ID}, days[0].JobIDs)
+}
+
+func TestJobService_MonthlyTransfer(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-transfer", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "Transfer Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	finalize := func(bytes int64) {
+		j, err := service.CreateJob(ctx, testTask.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		_, err = service.FinalizeJob(ctx, j.ID, ports.JobResult{Status: model.JobStatusSuccess, BytesTransferred: bytes})
+		require.NoError(t, err)
+	}
+	finalize(100)
+	finalize(250)
+
+	// Shard jobs are counted through their parent
+	parent, err := service.CreateJob(ctx, testTask.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	child, err := service.CreateChildJob(ctx, parent.ID, testTask.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	_, err = service.FinalizeJob(ctx, child.ID, ports.JobResult{Status: model.JobStatusSuccess, BytesTransferred: 1000})
+	require.NoError(t, err)
+	_, err = service.FinalizeJob(ctx, parent.ID, ports.JobResult{Status: model.JobStatusSuccess, BytesTransferred: 1000})
+	require.NoError(t, err)
+
+	// Mirror jobs are counted for the connection of the mirror
+	mirrorConn, err := connService.CreateConnection(ctx, "test-transfer-mirror", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	mirrorCtx := provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{MirrorConnectionID: &mirrorConn.ID})
+	mirror, err := service.CreateChildJob(mirrorCtx, parent.ID, testTask.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	_, err = service.FinalizeJob(ctx, mirror.ID, ports.JobResult{Status: model.JobStatusSuccess, BytesTransferred: 500})
+	require.NoError(t, err)
+
+	used, err := service.MonthlyTransfer(ctx, testConn.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1350), used)
+	used, err = service.MonthlyTransfer(ctx, mirrorConn.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), used)
+
+	// Deleting jobs doesn't reset the usage
+	require.NoError(t, service.DeleteJob(ctx, parent.ID))
+	used, err = service.MonthlyTransfer(ctx, testConn.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1350), used)
+
+	lastMonth := startOfMonth(time.Now()).AddDate(0, -1, 0)
+	used, err = service.MonthlyTransfer(ctx, testConn.ID, lastMonth)
+	require.NoError(t, err)
+	assert.Zero(t, used)
+	_, err = client.ConnectionTransfer.Create().SetConnectionID(testConn.ID).SetMonth(lastMonth).SetBytes(42).Save(ctx)
+	require.NoError(t, err)
+
+	transfers, err := service.ListMonthlyTransfers(ctx, testConn.ID, 12)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	assert.True(t, transfers[0].Month.Equal(lastMonth))
+	assert.Equal(t, int64(42), transfers[0].Bytes)
+	assert.Equal(t, int64(1350), transfers[1].Bytes)
+
+	transfers, err = service.ListMonthlyTransfers(ctx, testConn.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, int64(1350), transfers[0].Bytes)
+}
+
+func TestJobService_TypicalDeletes(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-deletes", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "Deletes Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	typical, runs, err := service.TypicalDeletes(ctx, testTask.ID, 20)
+	require.NoError(t, err)
+	assert.Zero(t, typical)
+	assert.Zero(t, runs)
+
+	finalize := func(trigger model.JobTrigger, status model.JobStatus, deletes int64) {
+		j, err := service.CreateJob(ctx, testTask.ID, trigger)
+		require.NoError(t, err)
+		_, err = service.FinalizeJob(ctx, j.ID, ports.JobResult{Status: status, FilesDeleted: deletes})
+		require.NoError(t, err)
+	}
+	finalize(model.JobTriggerSchedule, model.JobStatusSuccess, 3)
+	finalize(model.JobTriggerSchedule, model.JobStatusSuccessWithWarnings, 1)
+	finalize(model.JobTriggerManual, model.JobStatusSuccess, 500)
+	// Failed runs and retries are left out
+	finalize(model.JobTriggerSchedule, model.JobStatusFailed, 900)
+	finalize(model.JobTriggerRetry, model.JobStatusSuccess, 800)
+
+	typical, runs, err = service.TypicalDeletes(ctx, testTask.ID, 20)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), typical, "An outlier doesn't move the median")
+	assert.Equal(t, 3, runs)
+
+	// Only the last n runs are taken into account
+	typical, runs, err = service.TypicalDeletes(ctx, testTask.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), typical)
+	assert.Equal(t, 2, runs)
+
+	require.NoError(t, service.RecordDeleteAnomaly(ctx, testTask.ID, "sync deletes 900 files"))
+	events, err := client.TaskEvent.Query().Where(taskevent.TaskIDEQ(testTask.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, model.TaskEventTypeDeleteAnomaly, events[0].Type)
+	assert.ErrorIs(t, service.RecordDeleteAnomaly(ctx, uuid.New(), "unknown task"), errs.ErrNotFound)
+}
+
+func TestJobService_FinalizeJob_QuotaDeferred(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-deferred", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "Deferred Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	j, err := service.CreateJob(ctx, testTask.ID, model.JobTriggerSchedule)
+	require.NoError(t, err)
+	j, err = service.FinalizeJob(ctx, j.ID, ports.JobResult{Status: model.JobStatusQuotaDeferred, Error: "cap reached"})
+	require.NoError(t, err)
+	assert.Equal(t, model.JobStatusQuotaDeferred, j.Status)
+	assert.NotNil(t, j.EndTime)
+
+	events, err := client.TaskEvent.Query().Where(taskevent.TaskIDEQ(testTask.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, model.TaskEventTypeQuotaDeferred, events[0].Type)
+	assert.Equal(t, "cap reached", events[0].Message)
+}
+
 // Test for ListJobLogsByJobPaginated
 func TestJobService_ListJobLogsByJobPaginated(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
@@ -824,6 +1322,86 @@ func TestJobService_DeleteOldLogsForConnection(t *testing.T) {
 	})
 }
 
+func TestJobService_DeleteJobLogs(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-delete-logs", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	noisy, err := taskService.CreateTask(ctx, "Noisy Task", "/l1", testConn.ID, "/r1", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	quiet, err := taskService.CreateTask(ctx, "Quiet Task", "/l2", testConn.ID, "/r2", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	noisyJob, err := service.CreateJob(ctx, noisy.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	quietJob, err := service.CreateJob(ctx, quiet.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	old := time.Now().Add(-48 * time.Hour)
+	var logs []*ent.JobLog
+	for i := 0; i < 7; i++ {
+		logs = append(logs, &ent.JobLog{Level: model.LogLevelInfo, What: model.LogActionUpload, Path: fmt.Sprintf("old%d", i), Time: old})
+	}
+	logs = append(logs,
+		&ent.JobLog{Level: model.LogLevelError, What: model.LogActionError, Path: "recent-error", Time: time.Now()},
+		&ent.JobLog{Level: model.LogLevelInfo, What: model.LogActionUpload, Path: "recent", Time: time.Now()},
+	)
+	require.NoError(t, service.AddJobLogsBatch(ctx, noisyJob.ID, logs))
+	_, err = service.AddJobLog(ctx, quietJob.ID, string(model.LogLevelInfo), string(model.LogActionUpload), "quiet", 0)
+	require.NoError(t, err)
+
+	t.Run("Before_Batched", func(t *testing.T) {
+		before := time.Now().Add(-24 * time.Hour)
+		var progress [][2]int
+		deleted, err := service.DeleteJobLogs(ctx, JobLogDeleteFilter{TaskID: &noisy.ID, Before: &before}, 3, func(deleted, total int) {
+			progress = append(progress, [2]int{deleted, total})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 7, deleted)
+		assert.Equal(t, [][2]int{{3, 7}, {6, 7}, {7, 7}}, progress)
+
+		count, err := service.CountJobLogs(ctx, nil, &noisy.ID, nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Level", func(t *testing.T) {
+		deleted, err := service.DeleteJobLogs(ctx, JobLogDeleteFilter{JobID: &noisyJob.ID, Level: string(model.LogLevelError)}, 100, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		remaining, err := service.ListJobLogs(ctx, nil, &noisy.ID, nil, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, remaining, 1)
+		assert.Equal(t, "recent", remaining[0].Path)
+	})
+
+	t.Run("OtherTasksUntouched", func(t *testing.T) {
+		count, err := service.CountJobLogs(ctx, nil, &quiet.ID, nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		deleted, err := service.DeleteJobLogs(cancelled, JobLogDeleteFilter{TaskID: &quiet.ID}, 100, nil)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, deleted)
+	})
+}
+
 // Additional test for CountJobLogs with multiple filters
 func TestJobService_CountJobLogs_ComplexFilters(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
@@ -983,3 +1561,459 @@ func TestJobService_DeleteJob(t *testing.T) {
 		assert.Len(t, logs2, 1, "Job2's logs should still exist")
 	})
 }
+
+func TestJobService_FinalizeJob(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-finalize-job", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	task, err := taskService.CreateTask(ctx, "Finalize Job Task", "/l", testConn.ID, "/r", string(model.SyncDirectionBidirectional), "", false, nil)
+	require.NoError(t, err)
+
+	t.Run("Success_WritesStatsAndStatus", func(t *testing.T) {
+		j, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+
+		finalized, err := service.FinalizeJob(ctx, j.ID, ports.JobResult{
+			Status:           model.JobStatusSuccess,
+			FilesTransferred: 3,
+			BytesTransferred: 300,
+			FilesDeleted:     1,
+			UploadedFiles:    2,
+			UploadedBytes:    200,
+			DownloadedFiles:  1,
+			DownloadedBytes:  100,
+			Concurrency:      &model.JobConcurrency{Interval: 1, Limit: 4, Peak: 3, Samples: []float64{1, 3, 2.5}},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, finalized)
+		assert.Equal(t, model.JobStatusSuccess, finalized.Status)
+		assert.Equal(t, 3, finalized.FilesTransferred)
+		assert.Equal(t, int64(300), finalized.BytesTransferred)
+		assert.Equal(t, 1, finalized.FilesDeleted)
+		assert.Equal(t, 2, finalized.UploadedFiles)
+		assert.Equal(t, int64(200), finalized.UploadedBytes)
+		assert.Equal(t, 1, finalized.DownloadedFiles)
+		assert.Equal(t, int64(100), finalized.DownloadedBytes)
+		assert.False(t, finalized.EndTime.IsZero())
+
+		stored, err := service.GetJob(ctx, j.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &model.JobConcurrency{Interval: 1, Limit: 4, Peak: 3, Samples: []float64{1, 3, 2.5}}, stored.Concurrency)
+	})
+
+	t.Run("Failed_WritesErrorLog", func(t *testing.T) {
+		j, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+
+		finalized, err := service.FinalizeJob(ctx, j.ID, ports.JobResult{
+			Status: model.JobStatusFailed,
+			Error:  "sync failed",
+			Logs: []*ent.JobLog{{
+				Level: model.LogLevelError,
+				What:  model.LogActionError,
+				Path:  "sync failed",
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.JobStatusFailed, finalized.Status)
+		assert.Equal(t, "sync failed", finalized.Errors)
+
+		logs, err := service.ListJobLogs(ctx, nil, nil, &j.ID, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, model.LogLevelError, logs[0].Level)
+	})
+
+	t.Run("DeleteJob", func(t *testing.T) {
+		j, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+
+		finalized, err := service.FinalizeJob(ctx, j.ID, ports.JobResult{
+			Status:    model.JobStatusSuccess,
+			DeleteJob: true,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, finalized)
+
+		_, err = service.GetJob(ctx, j.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("NotFound_RollsBackLogs", func(t *testing.T) {
+		missingID := uuid.New()
+		_, err := service.FinalizeJob(ctx, missingID, ports.JobResult{
+			Status: model.JobStatusFailed,
+			Logs: []*ent.JobLog{{
+				Level: model.LogLevelError,
+				What:  model.LogActionError,
+				Path:  "orphan",
+			}},
+		})
+		require.Error(t, err)
+
+		count, err := client.JobLog.Query().Count(ctx)
+		require.NoError(t, err)
+		// Only the log from Failed_WritesErrorLog should exist
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("MergesRenameLogs", func(t *testing.T) {
+		j, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		require.NoError(t, service.AddJobLogsBatch(ctx, j.ID, []*ent.JobLog{
+			{Level: model.LogLevelInfo, What: model.LogActionUpload, Path: "new/a.txt", Size: 10, Time: time.Now()},
+			{Level: model.LogLevelInfo, What: model.LogActionDelete, Path: "a.txt", Time: time.Now()},
+			{Level: model.LogLevelInfo, What: model.LogActionUpload, Path: "b.txt", Size: 20, Time: time.Now()},
+		}))
+
+		_, err = service.FinalizeJob(ctx, j.ID, ports.JobResult{
+			Status: model.JobStatusSuccess,
+			Renames: []ports.LogRename{
+				{PreviousPath: "a.txt", Path: "new/a.txt"},
+				// Without a DELETE log of the previous path the upload is kept
+				{PreviousPath: "c.txt", Path: "b.txt"},
+			},
+		})
+		require.NoError(t, err)
+
+		logs, err := client.JobLog.Query().Where(joblog.JobIDEQ(j.ID)).Order(ent.Asc(joblog.FieldID)).All(ctx)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, model.LogActionRename, logs[0].What)
+		assert.Equal(t, "new/a.txt", logs[0].Path)
+		require.NotNil(t, logs[0].PreviousPath)
+		assert.Equal(t, "a.txt", *logs[0].PreviousPath)
+		assert.Equal(t, int64(10), logs[0].Size)
+		assert.Equal(t, model.LogActionUpload, logs[1].What)
+		assert.Nil(t, logs[1].PreviousPath)
+	})
+
+	t.Run("ConsecutiveFailures", func(t *testing.T) {
+		service.SetFailureEscalationThreshold(2)
+		defer service.SetFailureEscalationThreshold(0)
+
+		failing, err := taskService.CreateTask(ctx, "Failing Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		finalize := func(status model.JobStatus) int {
+			j, err := service.CreateJob(ctx, failing.ID, model.JobTriggerSchedule)
+			require.NoError(t, err)
+			_, err = service.FinalizeJob(ctx, j.ID, ports.JobResult{Status: status, Error: "remote unreachable"})
+			require.NoError(t, err)
+			got, err := client.Task.Get(ctx, failing.ID)
+			require.NoError(t, err)
+			return got.ConsecutiveFailures
+		}
+		escalations := func() []*ent.TaskEvent {
+			events, err := client.TaskEvent.Query().
+				Where(taskevent.TaskIDEQ(failing.ID), taskevent.TypeEQ(model.TaskEventTypeConsecutiveFailures)).
+				All(ctx)
+			require.NoError(t, err)
+			return events
+		}
+
+		assert.Equal(t, 1, finalize(model.JobStatusFailed))
+		assert.Empty(t, escalations())
+		assert.Equal(t, 2, finalize(model.JobStatusFailedTimeout))
+		events := escalations()
+		require.Len(t, events, 1)
+		assert.Equal(t, "task failed 2 times in a row, last error: remote unreachable", events[0].Message)
+
+		// The escalation is raised once per streak, cancelled runs do not count
+		assert.Equal(t, 3, finalize(model.JobStatusFailed))
+		assert.Equal(t, 3, finalize(model.JobStatusCancelled))
+		assert.Len(t, escalations(), 1)
+
+		assert.Equal(t, 0, finalize(model.JobStatusSuccessWithWarnings))
+		assert.Equal(t, 1, finalize(model.JobStatusFailed))
+		assert.Equal(t, 2, finalize(model.JobStatusFailed))
+		assert.Len(t, escalations(), 2)
+
+		got, err := client.Task.Get(ctx, failing.ID)
+		require.NoError(t, err)
+		assert.True(t, failing.UpdatedAt.Equal(got.UpdatedAt), "updated_at is not touched")
+	})
+
+	t.Run("AutoDisable", func(t *testing.T) {
+		service.SetAutoDisableThreshold(2)
+		defer service.SetAutoDisableThreshold(0)
+
+		broken, err := taskService.CreateTask(ctx, "Broken Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		finalize := func(result ports.JobResult) *ent.Task {
+			j, err := service.CreateJob(ctx, broken.ID, model.JobTriggerSchedule)
+			require.NoError(t, err)
+			_, err = service.FinalizeJob(ctx, j.ID, result)
+			require.NoError(t, err)
+			got, err := client.Task.Get(ctx, broken.ID)
+			require.NoError(t, err)
+			return got
+		}
+		fatal := ports.JobResult{Status: model.JobStatusFailed, Error: "401 Unauthorized", Fatal: true}
+		disables := func() []*ent.TaskEvent {
+			events, err := client.TaskEvent.Query().
+				Where(taskevent.TaskIDEQ(broken.ID), taskevent.TypeEQ(model.TaskEventTypeTaskDisabled)).
+				All(ctx)
+			require.NoError(t, err)
+			return events
+		}
+
+		// Transient failures start the count over
+		assert.Equal(t, 1, finalize(fatal).ConsecutiveFatalFailures)
+		assert.Equal(t, 0, finalize(ports.JobResult{Status: model.JobStatusFailed, Error: "connection reset"}).ConsecutiveFatalFailures)
+		assert.Equal(t, 1, finalize(fatal).ConsecutiveFatalFailures)
+		assert.Equal(t, 1, finalize(ports.JobResult{Status: model.JobStatusCancelled}).ConsecutiveFatalFailures)
+
+		got := finalize(fatal)
+		assert.True(t, got.Disabled)
+		require.NotNil(t, got.DisabledReason)
+		assert.Equal(t, "disabled after 2 runs in a row failed with a non-transient error, last error: 401 Unauthorized", *got.DisabledReason)
+		events := disables()
+		require.Len(t, events, 1)
+		assert.Equal(t, *got.DisabledReason, events[0].Message)
+
+		// Further failures of the disabled task, e.g. manual runs, don't disable it again
+		assert.Equal(t, 3, finalize(fatal).ConsecutiveFatalFailures)
+		assert.Len(t, disables(), 1)
+
+		// Enabling the task clears the reason and starts the count over
+		got, err = taskService.SetTaskEnabled(ctx, broken.ID, true)
+		require.NoError(t, err)
+		assert.False(t, got.Disabled)
+		assert.Nil(t, got.DisabledReason)
+		assert.Equal(t, 0, got.ConsecutiveFatalFailures)
+		assert.Equal(t, 1, finalize(fatal).ConsecutiveFatalFailures)
+		assert.False(t, finalize(ports.JobResult{Status: model.JobStatusSuccess}).Disabled)
+	})
+}
+
+func TestJobService_ChildJobs(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-child-jobs", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	task, err := taskService.CreateTask(ctx, "Child Job Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	parent, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	child1, err := service.CreateChildJob(ctx, parent.ID, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	require.NotNil(t, child1.ParentID)
+	assert.Equal(t, parent.ID, *child1.ParentID)
+	assert.Equal(t, model.JobStatusPending, child1.Status)
+
+	child2, err := service.CreateChildJob(ctx, parent.ID, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	t.Run("ListChildJobs", func(t *testing.T) {
+		children, err := service.ListChildJobs(ctx, parent.ID)
+		require.NoError(t, err)
+		require.Len(t, children, 2)
+		assert.Equal(t, child1.ID, children[0].ID)
+		assert.Equal(t, child2.ID, children[1].ID)
+
+		children, err = service.ListChildJobs(ctx, child1.ID)
+		require.NoError(t, err)
+		assert.Empty(t, children)
+	})
+
+	t.Run("ChildJobsExcludedFromListings", func(t *testing.T) {
+		jobs, err := service.ListJobs(ctx, &task.ID, nil, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, parent.ID, jobs[0].ID)
+
+		count, err := service.CountJobs(ctx, &task.ID, nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		last, err := service.GetLastJobByTaskID(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, parent.ID, last.ID)
+	})
+
+	t.Run("DeleteParentCascades", func(t *testing.T) {
+		require.NoError(t, service.DeleteJob(ctx, parent.ID))
+
+		_, err := service.GetJob(ctx, child1.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		_, err = service.GetJob(ctx, child2.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestJobService_ReindexJobRollups(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-reindex", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	task, err := taskService.CreateTask(ctx, "Reindex Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	parent, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	for _, files := range []int64{2, 3} {
+		child, err := service.CreateChildJob(ctx, parent.ID, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		_, err = service.FinalizeJob(ctx, child.ID, ports.JobResult{
+			Status:           model.JobStatusSuccess,
+			FilesTransferred: files,
+			BytesTransferred: files * 100,
+			UploadedFiles:    files,
+			UploadedBytes:    files * 100,
+		})
+		require.NoError(t, err)
+	}
+
+	// Mirror jobs don't roll up into their parent, neither into a sharded one nor into an unsharded one
+	mirrorCtx := provenance.WithTriggerDetail(ctx, &model.JobTriggerDetail{MirrorConnectionID: &testConn.ID})
+	mirrored, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	_, err = service.FinalizeJob(ctx, mirrored.ID, ports.JobResult{Status: model.JobStatusSuccess, FilesTransferred: 1})
+	require.NoError(t, err)
+	for _, p := range []*ent.Job{parent, mirrored} {
+		mirror, err := service.CreateChildJob(mirrorCtx, p.ID, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		_, err = service.FinalizeJob(ctx, mirror.ID, ports.JobResult{Status: model.JobStatusSuccess, FilesTransferred: 7, UploadedFiles: 7})
+		require.NoError(t, err)
+	}
+
+	// A running parent is skipped even though its stats are not rolled up yet
+	running, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	_, err = service.UpdateJobStatus(ctx, running.ID, string(model.JobStatusRunning), "")
+	require.NoError(t, err)
+	_, err = service.CreateChildJob(ctx, running.ID, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	// The parent was finalized with a stale error count and missing direction stats
+	_, err = service.FinalizeJob(ctx, parent.ID, ports.JobResult{
+		Status:           model.JobStatusSuccess,
+		FilesTransferred: 5,
+		BytesTransferred: 500,
+		ErrorCount:       1,
+	})
+	require.NoError(t, err)
+
+	t.Run("ReportOnly", func(t *testing.T) {
+		report, err := service.ReindexJobRollups(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.CheckedJobs)
+		assert.Equal(t, 0, report.FixedJobs)
+		assert.ElementsMatch(t, []JobRollupDiscrepancy{
+			{JobID: parent.ID, Field: job.FieldUploadedFiles, Stored: 0, Computed: 5},
+			{JobID: parent.ID, Field: job.FieldUploadedBytes, Stored: 0, Computed: 500},
+			{JobID: parent.ID, Field: job.FieldErrorCount, Stored: 1, Computed: 0},
+		}, report.Discrepancies)
+
+		j, err := service.GetJob(ctx, parent.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, j.ErrorCount, "report-only run must not modify the job")
+	})
+
+	t.Run("Fix", func(t *testing.T) {
+		report, err := service.ReindexJobRollups(ctx, true)
+		require.NoError(t, err)
+		assert.Len(t, report.Discrepancies, 3)
+		assert.Equal(t, 1, report.FixedJobs)
+
+		j, err := service.GetJob(ctx, parent.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, j.ErrorCount)
+		assert.Equal(t, 5, j.UploadedFiles)
+		assert.Equal(t, int64(500), j.UploadedBytes)
+		assert.Equal(t, model.JobStatusSuccess, j.Status)
+
+		report, err = service.ReindexJobRollups(ctx, true)
+		require.NoError(t, err)
+		assert.Empty(t, report.Discrepancies)
+		assert.Equal(t, 0, report.FixedJobs)
+	})
+}
+
+func TestJobService_ForEachJobLog(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewJobService(client)
+	taskService := NewTaskService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	testConn, err := connService.CreateConnection(ctx, "test-each-logs", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Each Logs Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	j, err := service.CreateJob(ctx, task.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	// Logs sharing a timestamp must neither repeat nor get lost across batches
+	now := time.Now()
+	var logs []*ent.JobLog
+	for i := 0; i < 7; i++ {
+		logs = append(logs, &ent.JobLog{
+			Level: model.LogLevelInfo,
+			What:  model.LogActionUpload,
+			Path:  fmt.Sprintf("/file%d", i),
+			Time:  now.Add(time.Duration(i/3) * time.Second),
+		})
+	}
+	require.NoError(t, service.AddJobLogsBatch(ctx, j.ID, logs))
+
+	var paths []string
+	err = service.ForEachJobLog(ctx, j.ID, 2, func(l *ent.JobLog) error {
+		paths = append(paths, l.Path)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/file0", "/file1", "/file2", "/file3", "/file4", "/file5", "/file6"}, paths)
+
+	// Errors of the callback stop the iteration
+	stop := errors.New("stop")
+	count := 0
+	err = service.ForEachJobLog(ctx, j.ID, 2, func(*ent.JobLog) error {
+		count++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, count)
+}
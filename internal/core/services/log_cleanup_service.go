@@ -2,23 +2,33 @@ package services
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"go.uber.org/zap"
 )
 
-// LogCleanupService provides operations for cleaning up old job logs.
+// retentionBatchSize is the number of job logs deleted at a time when applying the log retention of a task.
+const retentionBatchSize = 1000
+
+// LogCleanupService provides operations for cleaning up old job logs and purging deleted tasks.
 type LogCleanupService struct {
-	client   *ent.Client
-	logger   *zap.Logger
-	maxLogs  int
-	cron     *cron.Cron
-	entryID  cron.EntryID
-	jobSvc   *JobService
-	connList func(ctx context.Context) ([]*ent.Connection, error)
+	client        *ent.Client
+	logger        *zap.Logger
+	maxLogs       int
+	taskRetention time.Duration
+	logRetention  time.Duration
+	jobRetention  time.Duration
+	cron          *cron.Cron
+	entryID       cron.EntryID
+	jobSvc        *JobService
+	taskSvc       *TaskService
+	connList      func(ctx context.Context) ([]*ent.Connection, error)
 }
 
 // NewLogCleanupService creates a new LogCleanupService instance.
@@ -29,24 +39,53 @@ func NewLogCleanupService(client *ent.Client, maxLogsPerConnection int) *LogClea
 		logger:  logger.Named("service.log_cleanup"),
 		maxLogs: maxLogsPerConnection,
 		jobSvc:  jobSvc,
+		taskSvc: NewTaskService(client),
 		connList: func(ctx context.Context) ([]*ent.Connection, error) {
 			return client.Connection.Query().All(ctx)
 		},
 	}
 }
 
+// SetDeletedTaskRetention sets how long deleted tasks are kept before they are purged.
+// Deleted tasks are never purged if retention is 0 (the default).
+func (s *LogCleanupService) SetDeletedTaskRetention(retention time.Duration) {
+	s.taskRetention = retention
+}
+
+// SetJobRetention sets how long the job logs and the job records of tasks are kept, unless a task overrides them
+// with its logRetentionDays or jobRetentionDays options. A retention of 0 (the default) keeps them forever.
+func (s *LogCleanupService) SetJobRetention(logRetention, jobRetention time.Duration) {
+	s.logRetention = logRetention
+	s.jobRetention = jobRetention
+}
+
 // Start starts the log cleanup cron job with the given schedule.
+// Logs are only cleaned up if maxLogsPerConnection is positive, deleted tasks only if a retention is set.
+// The job retention of tasks is applied on every run, since tasks may set their own.
 func (s *LogCleanupService) Start(schedule string) error {
 	s.logger.Info("Starting log cleanup service",
 		zap.String("schedule", schedule),
-		zap.Int("max_logs_per_connection", s.maxLogs))
+		zap.Int("max_logs_per_connection", s.maxLogs),
+		zap.Duration("deleted_task_retention", s.taskRetention),
+		zap.Duration("log_retention", s.logRetention),
+		zap.Duration("job_retention", s.jobRetention))
 
 	s.cron = cron.New()
 
 	entryID, err := s.cron.AddFunc(schedule, func() {
 		ctx := context.Background()
-		if err := s.CleanupLogs(ctx); err != nil {
-			s.logger.Error("Log cleanup failed", zap.Error(err))
+		if s.maxLogs > 0 {
+			if err := s.CleanupLogs(ctx); err != nil {
+				s.logger.Error("Log cleanup failed", zap.Error(err))
+			}
+		}
+		if s.taskRetention > 0 {
+			if err := s.PurgeDeletedTasks(ctx); err != nil {
+				s.logger.Error("Purging deleted tasks failed", zap.Error(err))
+			}
+		}
+		if err := s.ApplyJobRetention(ctx); err != nil {
+			s.logger.Error("Applying job retention failed", zap.Error(err))
 		}
 	})
 
@@ -113,3 +152,67 @@ func (s *LogCleanupService) CleanupLogsForConnection(ctx context.Context, connec
 
 	return nil
 }
+
+// PurgeDeletedTasks permanently deletes the tasks deleted longer ago than the retention, with their job history.
+func (s *LogCleanupService) PurgeDeletedTasks(ctx context.Context) error {
+	purged, err := s.taskSvc.PurgeDeletedTasks(ctx, time.Now().Add(-s.taskRetention))
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		s.logger.Info("Purged deleted tasks",
+			zap.Int("purged", purged),
+			zap.Duration("retention", s.taskRetention))
+	}
+
+	return nil
+}
+
+// ApplyJobRetention deletes the job logs and the job records of each task that are older than the task's retention,
+// see SetJobRetention. Job records are deleted with their logs, so records can be kept for statistics long after
+// their logs, which take most of the space, were purged.
+func (s *LogCleanupService) ApplyJobRetention(ctx context.Context) error {
+	tasks, err := s.client.Task.Query().All(ctx)
+	if err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+
+	now := time.Now()
+	var logsDeleted, jobsDeleted int
+	for _, t := range tasks {
+		logRetention, jobRetention := s.logRetention, s.jobRetention
+		if t.Options != nil && t.Options.LogRetentionDays != nil {
+			logRetention = time.Duration(*t.Options.LogRetentionDays) * 24 * time.Hour
+		}
+		if t.Options != nil && t.Options.JobRetentionDays != nil {
+			jobRetention = time.Duration(*t.Options.JobRetentionDays) * 24 * time.Hour
+		}
+
+		if jobRetention > 0 {
+			n, err := s.jobSvc.DeleteTaskJobsBefore(ctx, t.ID, now.Add(-jobRetention))
+			if err != nil {
+				s.logger.Error("Failed to delete old jobs of task", zap.Stringer("task_id", t.ID), zap.Error(err))
+				continue
+			}
+			jobsDeleted += n
+		}
+		if logRetention > 0 {
+			before := now.Add(-logRetention)
+			n, err := s.jobSvc.DeleteJobLogs(ctx, JobLogDeleteFilter{TaskID: &t.ID, Before: &before}, retentionBatchSize, nil)
+			if err != nil {
+				s.logger.Error("Failed to delete old job logs of task", zap.Stringer("task_id", t.ID), zap.Error(err))
+				continue
+			}
+			logsDeleted += n
+		}
+	}
+
+	if logsDeleted > 0 || jobsDeleted > 0 {
+		s.logger.Info("Applied job retention",
+			zap.Int("tasks", len(tasks)),
+			zap.Int("jobs_deleted", jobsDeleted),
+			zap.Int("logs_deleted", logsDeleted))
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/db"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
 )
 
 // Helper to create a test connection service
@@ -101,6 +102,97 @@ func TestLogCleanupService(t *testing.T) {
 		assert.Equal(t, 3, count)
 	})
 
+	t.Run("PurgeDeletedTasks", func(t *testing.T) {
+		deletedTask, err := taskService.CreateTask(ctx, "Purged Task "+uuid.NewString(), "/l", testConn.ID, "/r", string(model.SyncDirectionBidirectional), "", false, nil)
+		require.NoError(t, err)
+		_, err = taskService.DeleteTask(ctx, deletedTask.ID)
+		require.NoError(t, err)
+
+		svc := NewLogCleanupService(client, 1000)
+		svc.SetDeletedTaskRetention(time.Hour)
+		require.NoError(t, svc.PurgeDeletedTasks(ctx))
+		_, err = taskService.RestoreTask(ctx, deletedTask.ID)
+		require.NoError(t, err, "tasks deleted within the retention are kept")
+
+		_, err = taskService.DeleteTask(ctx, deletedTask.ID)
+		require.NoError(t, err)
+		svc.SetDeletedTaskRetention(time.Nanosecond)
+		time.Sleep(time.Millisecond)
+		require.NoError(t, svc.PurgeDeletedTasks(ctx))
+		_, err = taskService.RestoreTask(ctx, deletedTask.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("ApplyJobRetention", func(t *testing.T) {
+		retentionTask, err := taskService.CreateTask(ctx, "Retention Task "+uuid.NewString(), "/l", testConn.ID, "/r", string(model.SyncDirectionBidirectional), "", false, nil)
+		require.NoError(t, err)
+
+		// Three finished jobs, ended 10, 5 and 1 days ago, each with one log of the same age
+		var jobIDs []uuid.UUID
+		for i, age := range []int{10, 5, 1} {
+			j, err := jobService.CreateJob(ctx, retentionTask.ID, model.JobTriggerManual)
+			require.NoError(t, err)
+			ended := time.Now().Add(-time.Duration(age) * 24 * time.Hour)
+			require.NoError(t, client.Job.UpdateOneID(j.ID).
+				SetStatus(model.JobStatusSuccess).
+				SetStartTime(ended.Add(-time.Minute)).
+				SetEndTime(ended).
+				Exec(ctx))
+			l, err := jobService.AddJobLog(ctx, j.ID, string(model.LogLevelInfo), string(model.LogActionUpload), "/retention"+string(rune('0'+i)), 1)
+			require.NoError(t, err)
+			require.NoError(t, client.JobLog.UpdateOneID(l.ID).SetTime(ended).Exec(ctx))
+			jobIDs = append(jobIDs, j.ID)
+		}
+		countLogs := func() int {
+			n, err := jobService.CountJobLogs(ctx, nil, &retentionTask.ID, nil, "")
+			require.NoError(t, err)
+			return n
+		}
+		countJobs := func() int {
+			n, err := jobService.CountJobs(ctx, &retentionTask.ID, nil, "")
+			require.NoError(t, err)
+			return n
+		}
+
+		svc := NewLogCleanupService(client, 1000)
+		require.NoError(t, svc.ApplyJobRetention(ctx))
+		assert.Equal(t, 3, countLogs(), "nothing is deleted without a retention")
+		assert.Equal(t, 3, countJobs())
+
+		// Logs older than 3 days are deleted, while their jobs are kept
+		svc.SetJobRetention(3*24*time.Hour, 0)
+		require.NoError(t, svc.ApplyJobRetention(ctx))
+		assert.Equal(t, 1, countLogs())
+		assert.Equal(t, 3, countJobs())
+
+		// The task keeps its jobs forever
+		keep := 0
+		_, err = taskService.UpdateTask(ctx, retentionTask.ID, retentionTask.Name, "/l", testConn.ID, "/r", string(model.SyncDirectionBidirectional), "", false,
+			&model.TaskSyncOptions{JobRetentionDays: &keep})
+		require.NoError(t, err)
+		svc.SetJobRetention(0, 24*time.Hour)
+		require.NoError(t, svc.ApplyJobRetention(ctx))
+		assert.Equal(t, 3, countJobs())
+
+		// The task overrides the retention of jobs; the latest job is always kept
+		days := 7
+		_, err = taskService.UpdateTask(ctx, retentionTask.ID, retentionTask.Name, "/l", testConn.ID, "/r", string(model.SyncDirectionBidirectional), "", false,
+			&model.TaskSyncOptions{JobRetentionDays: &days})
+		require.NoError(t, err)
+		require.NoError(t, svc.ApplyJobRetention(ctx))
+		assert.Equal(t, 2, countJobs())
+		_, err = jobService.GetJob(ctx, jobIDs[0])
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+
+		for _, id := range jobIDs[1:] {
+			require.NoError(t, client.Job.UpdateOneID(id).SetEndTime(time.Now().Add(-30*24*time.Hour)).Exec(ctx))
+		}
+		require.NoError(t, svc.ApplyJobRetention(ctx))
+		assert.Equal(t, 1, countJobs())
+		_, err = jobService.GetJob(ctx, jobIDs[2])
+		assert.NoError(t, err, "the latest job is kept")
+	})
+
 	t.Run("StartAndStop", func(t *testing.T) {
 		svc := NewLogCleanupService(client, 1000)
 
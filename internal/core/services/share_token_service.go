@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/sharetoken"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// shareTokenBytes is the number of random bytes of a share token.
+	shareTokenBytes = 32
+
+	// ErrShareTokenInvalid is returned for a share token that is unknown, revoked or expired.
+	ErrShareTokenInvalid = errs.ConstError("share token is invalid or expired")
+)
+
+// ShareTokenSpec describes a share token to be created by CreateShareToken.
+type ShareTokenSpec struct {
+	Name  string
+	Scope model.ShareScope
+	// TaskID is the task that can be read and run, for the TASK scope
+	TaskID *uuid.UUID
+	// ConnectionID and Path are the remote file that can be downloaded, for the DOWNLOAD scope
+	ConnectionID *uuid.UUID
+	Path         string
+	ExpiresAt    time.Time
+}
+
+// ShareTokenService manages share tokens, which grant time-limited access to a single task or
+// remote file to anyone holding the token. Only the SHA-256 of a token is stored.
+type ShareTokenService struct {
+	client *ent.Client
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// NewShareTokenService creates a new ShareTokenService instance.
+func NewShareTokenService(client *ent.Client) *ShareTokenService {
+	return &ShareTokenService{
+		client: client,
+		logger: logger.Named("service.share_token"),
+		now:    time.Now,
+	}
+}
+
+// CreateShareToken creates a share token and returns it along with the token itself,
+// which can't be retrieved later.
+func (s *ShareTokenService) CreateShareToken(ctx context.Context, spec ShareTokenSpec) (*ent.ShareToken, string, error) {
+	buf := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", errors.Join(errs.ErrSystem, err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	st, err := s.client.ShareToken.Create().
+		SetName(spec.Name).
+		SetTokenHash(shareTokenHash(token)).
+		SetScope(spec.Scope).
+		SetNillableTaskID(spec.TaskID).
+		SetNillableConnectionID(spec.ConnectionID).
+		SetPath(spec.Path).
+		SetExpiresAt(spec.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, "", errors.Join(errs.ErrInvalidInput, err)
+		}
+		return nil, "", errors.Join(errs.ErrSystem, err)
+	}
+
+	s.logger.Info("Share token created",
+		zap.String("id", st.ID.String()),
+		zap.String("name", st.Name),
+		zap.String("scope", string(st.Scope)),
+		zap.Time("expires_at", st.ExpiresAt),
+	)
+	return st, token, nil
+}
+
+// ListShareTokensPaginated lists the share tokens, most recently created first, with pagination.
+// Expired tokens are included.
+func (s *ShareTokenService) ListShareTokensPaginated(ctx context.Context, limit, offset int) ([]*ent.ShareToken, int, error) {
+	query := s.client.ShareToken.Query()
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Join(errs.ErrSystem, err)
+	}
+
+	tokens, err := query.
+		Order(ent.Desc(sharetoken.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.Join(errs.ErrSystem, err)
+	}
+	return tokens, totalCount, nil
+}
+
+// RevokeShareToken deletes a share token, so its links stop working immediately.
+func (s *ShareTokenService) RevokeShareToken(ctx context.Context, id uuid.UUID) error {
+	if err := s.client.ShareToken.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errors.Join(errs.ErrNotFound, err)
+		}
+		return errors.Join(errs.ErrSystem, err)
+	}
+	s.logger.Info("Share token revoked", zap.String("id", id.String()))
+	return nil
+}
+
+// ResolveShareToken returns the share token for token and records its use.
+// ErrShareTokenInvalid is returned if the token is unknown or expired.
+func (s *ShareTokenService) ResolveShareToken(ctx context.Context, token string) (*ent.ShareToken, error) {
+	if token == "" {
+		return nil, ErrShareTokenInvalid
+	}
+	st, err := s.client.ShareToken.Query().
+		Where(sharetoken.TokenHash(shareTokenHash(token))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrShareTokenInvalid
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	if !s.now().Before(st.ExpiresAt) {
+		return nil, ErrShareTokenInvalid
+	}
+
+	st, err = st.Update().
+		AddUseCount(1).
+		SetLastUsedAt(s.now()).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return st, nil
+}
+
+// shareTokenHash returns the hex encoded SHA-256 of a share token.
+func shareTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
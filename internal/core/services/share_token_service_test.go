@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/crypto"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+func TestShareTokenService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	taskService := NewTaskService(client)
+	service := NewShareTokenService(client)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	conn, err := connService.CreateConnection(ctx, "share-conn", "local", nil)
+	require.NoError(t, err)
+	task, err := taskService.CreateTask(ctx, "Shared Task", "/l", conn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	st, token, err := service.CreateShareToken(ctx, ShareTokenSpec{
+		Name:      "colleague",
+		Scope:     model.ShareScopeTask,
+		TaskID:    &task.ID,
+		ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.NotEqual(t, token, st.TokenHash, "only the hash of the token is stored")
+
+	t.Run("Resolve", func(t *testing.T) {
+		resolved, err := service.ResolveShareToken(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, st.ID, resolved.ID)
+		assert.Equal(t, 1, resolved.UseCount)
+		require.NotNil(t, resolved.LastUsedAt)
+
+		_, err = service.ResolveShareToken(ctx, token+"x")
+		assert.ErrorIs(t, err, ErrShareTokenInvalid)
+		_, err = service.ResolveShareToken(ctx, "")
+		assert.ErrorIs(t, err, ErrShareTokenInvalid)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		service.now = func() time.Time { return now.Add(time.Hour) }
+		defer func() { service.now = func() time.Time { return now } }()
+
+		_, err := service.ResolveShareToken(ctx, token)
+		assert.ErrorIs(t, err, ErrShareTokenInvalid)
+
+		// Expired tokens are still listed until revoked
+		tokens, total, err := service.ListShareTokensPaginated(ctx, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, tokens, 1)
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		require.NoError(t, service.RevokeShareToken(ctx, st.ID))
+		_, err := service.ResolveShareToken(ctx, token)
+		assert.ErrorIs(t, err, ErrShareTokenInvalid)
+		assert.ErrorIs(t, service.RevokeShareToken(ctx, st.ID), errs.ErrNotFound)
+	})
+
+	t.Run("DeletedWithConnection", func(t *testing.T) {
+		_, _, err := service.CreateShareToken(ctx, ShareTokenSpec{
+			Name:         "download",
+			Scope:        model.ShareScopeDownload,
+			ConnectionID: &conn.ID,
+			Path:         "report.txt",
+			ExpiresAt:    now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, connService.DeleteConnectionByID(ctx, conn.ID))
+		_, total, err := service.ListShareTokensPaginated(ctx, 10, 0)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+// TaskChange is a field of a task changed by an update.
+type TaskChange struct {
+	// Field is the name of the field as in UpdateTaskInput, options.<name> for sync options.
+	Field string
+	// Old and New are the values before and after the update, nil if unset.
+	// Strings, numbers and booleans are rendered as is, lists and objects as JSON.
+	Old *string
+	New *string
+}
+
+// String returns the change as "field: old → new".
+func (c TaskChange) String() string {
+	value := func(v *string) string {
+		if v == nil {
+			return "(unset)"
+		}
+		return *v
+	}
+	return c.Field + ": " + value(c.Old) + " → " + value(c.New)
+}
+
+// DiffTasks returns the changes between a task before and after an update, in the order of the fields of
+// UpdateTaskInput, with the changed sync options sorted by name.
+func DiffTasks(before, after *ent.Task) []TaskChange {
+	var changes []TaskChange
+	add := func(field string, old, new *string) {
+		if !equalValues(old, new) {
+			changes = append(changes, TaskChange{Field: field, Old: old, New: new})
+		}
+	}
+	add("name", &before.Name, &after.Name)
+	add("sourcePath", &before.SourcePath, &after.SourcePath)
+	add("connectionId", uuidValue(before.ConnectionID), uuidValue(after.ConnectionID))
+	add("remotePath", &before.RemotePath, &after.RemotePath)
+	add("direction", stringValue(string(before.Direction)), stringValue(string(after.Direction)))
+	add("schedule", stringValue(before.Schedule), stringValue(after.Schedule))
+	add("realtime", boolValue(before.Realtime), boolValue(after.Realtime))
+	add("engine", stringValue(before.Engine), stringValue(after.Engine))
+	add("tags", listValue(before.Tags), listValue(after.Tags))
+	add("enabled", boolValue(!before.Disabled), boolValue(!after.Disabled))
+
+	oldOptions, newOptions := optionValues(before.Options), optionValues(after.Options)
+	names := slices.Sorted(maps.Keys(oldOptions))
+	for name := range newOptions {
+		if _, ok := oldOptions[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		add("options."+name, oldOptions[name], newOptions[name])
+	}
+	return changes
+}
+
+// RecordTaskChanges records a TASK_UPDATED task event listing the changes of an update of a task.
+// Updates without changes aren't recorded.
+func (s *TaskService) RecordTaskChanges(ctx context.Context, taskID uuid.UUID, changes []TaskChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	lines := make([]string, len(changes))
+	for i, c := range changes {
+		lines[i] = c.String()
+	}
+	err := s.client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeTaskUpdated).
+		SetMessage(strings.Join(lines, "\n")).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.Join(errs.ErrNotFound, err)
+		}
+		return errors.Join(errs.ErrSystem, err)
+	}
+	return nil
+}
+
+// optionValues returns the rendered values of the set sync options by their JSON names.
+func optionValues(options *model.TaskSyncOptions) map[string]*string {
+	values := make(map[string]*string)
+	if options == nil {
+		return values
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		return values
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return values
+	}
+	for name, raw := range fields {
+		value := string(raw)
+		var str string
+		if json.Unmarshal(raw, &str) == nil {
+			value = str
+		}
+		values[name] = &value
+	}
+	return values
+}
+
+// equalValues reports whether two rendered values are the same, nil only being equal to nil.
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// stringValue returns s as a rendered value, nil if it is empty.
+func stringValue(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// listValue returns l rendered as JSON, nil if it is empty.
+func listValue(l []string) *string {
+	if len(l) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+func boolValue(b bool) *string {
+	s := strconv.FormatBool(b)
+	return &s
+}
+
+func uuidValue(id uuid.UUID) *string {
+	s := id.String()
+	return &s
+}
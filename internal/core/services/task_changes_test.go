@@ -0,0 +1,57 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestDiffTasks(t *testing.T) {
+	transfers, noDelete := 4, true
+	before := &ent.Task{
+		Name:         "photos",
+		SourcePath:   "/photos",
+		ConnectionID: uuid.New(),
+		RemotePath:   "/backup",
+		Direction:    model.SyncDirectionUpload,
+		Schedule:     "0 * * * *",
+		Options: &model.TaskSyncOptions{
+			Transfers: &transfers,
+			Filters:   []string{"- *.tmp"},
+		},
+	}
+	after := *before
+	after.Name = "pictures"
+	after.Schedule = ""
+	after.Realtime = true
+	moreTransfers := 8
+	after.Options = &model.TaskSyncOptions{
+		Transfers: &moreTransfers,
+		Filters:   []string{"- *.tmp"},
+		NoDelete:  &noDelete,
+	}
+
+	changes := DiffTasks(before, &after)
+	require.Len(t, changes, 5)
+	value := func(s string) *string { return &s }
+	assert.Equal(t, TaskChange{Field: "name", Old: value("photos"), New: value("pictures")}, changes[0])
+	assert.Equal(t, TaskChange{Field: "schedule", Old: value("0 * * * *")}, changes[1])
+	assert.Equal(t, TaskChange{Field: "realtime", Old: value("false"), New: value("true")}, changes[2])
+	assert.Equal(t, TaskChange{Field: "options.noDelete", New: value("true")}, changes[3])
+	assert.Equal(t, TaskChange{Field: "options.transfers", Old: value("4"), New: value("8")}, changes[4])
+
+	assert.Equal(t, "schedule: 0 * * * * → (unset)", changes[1].String())
+	assert.Empty(t, DiffTasks(before, before), "unchanged tasks have no changes")
+
+	// Lists and objects are rendered as JSON
+	after = *before
+	after.Options = nil
+	changes = DiffTasks(before, &after)
+	require.Len(t, changes, 2)
+	assert.Equal(t, TaskChange{Field: "options.filters", Old: value(`["- *.tmp"]`)}, changes[0])
+	assert.Equal(t, "options.transfers", changes[1].Field)
+}
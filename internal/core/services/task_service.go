@@ -2,14 +2,24 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/taskevent"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
 )
@@ -24,6 +34,34 @@ func NewTaskService(client *ent.Client) *TaskService {
 	return &TaskService{client: client}
 }
 
+// TaskConfigHash returns a stable hash of the configuration a run of the task applies: its paths, including
+// the base path of its connection, direction, engine and sync options. The name, the schedule and the job retention
+// of the task, which don't change what a run does, are left out. Jobs record the hash they were created with, so
+// tasks with configuration changes not applied yet can be found.
+func TaskConfigHash(t *ent.Task, connectionBasePath string) string {
+	options := t.Options
+	if options != nil && (options.LogRetentionDays != nil || options.JobRetentionDays != nil) {
+		o := *options
+		o.LogRetentionDays, o.JobRetentionDays = nil, nil
+		options = nil
+		if !reflect.ValueOf(o).IsZero() {
+			options = &o
+		}
+	}
+	// Struct fields are serialized in order, keeping the hash stable
+	config, _ := json.Marshal(struct {
+		SourcePath   string                 `json:"sourcePath"`
+		ConnectionID uuid.UUID              `json:"connectionId"`
+		BasePath     string                 `json:"basePath"`
+		RemotePath   string                 `json:"remotePath"`
+		Direction    model.SyncDirection    `json:"direction"`
+		Engine       string                 `json:"engine"`
+		Options      *model.TaskSyncOptions `json:"options"`
+	}{t.SourcePath, t.ConnectionID, connectionBasePath, t.RemotePath, t.Direction, t.Engine, options})
+	sum := sha256.Sum256(config)
+	return hex.EncodeToString(sum[:])
+}
+
 // withLatestJobPredicate 返回一个 JobQuery 的过滤器,用于只查询每个 task 的最新 job
 // 通过子查询来实现:对于每个 task,只选择 start_time 最大的 job
 func withLatestJobPredicate(q *ent.JobQuery) {
@@ -32,9 +70,13 @@ func withLatestJobPredicate(q *ent.JobQuery) {
 		// 子查询: SELECT id FROM jobs j2 WHERE j2.task_jobs = jobs.task_jobs ORDER BY start_time DESC LIMIT 1
 		subquery := sql.Select("id").
 			From(sql.Table(job.Table).As("j2")).
-			Where(sql.ColumnsEQ(
-				sql.Table("j2").C(job.TaskColumn),
-				s.C(job.TaskColumn),
+			Where(sql.And(
+				sql.ColumnsEQ(
+					sql.Table("j2").C(job.TaskColumn),
+					s.C(job.TaskColumn),
+				),
+				// 忽略分片子作业
+				sql.IsNull(sql.Table("j2").C(job.FieldParentID)),
 			)).
 			OrderBy(sql.Desc(sql.Table("j2").C(job.FieldStartTime))).
 			Limit(1)
@@ -44,6 +86,53 @@ func withLatestJobPredicate(q *ent.JobQuery) {
 	})
 }
 
+// taskOrder returns the order of a task list sorted by sortBy in the direction, see model.TaskSortField.
+// Tasks with equal values are sorted by name.
+func taskOrder(sortBy model.TaskSortField, direction model.SortDirection) []task.OrderOption {
+	opts := sortTermOptions(direction)
+	var order []task.OrderOption
+	switch sortBy {
+	case model.TaskSortFieldName:
+		order = []task.OrderOption{task.ByName(opts...)}
+	case model.TaskSortFieldType:
+		order = []task.OrderOption{task.ByEngine(opts...)}
+	case model.TaskSortFieldLastJobAt:
+		order = []task.OrderOption{func(s *sql.Selector) {
+			jobs := sql.Table(job.Table)
+			orderBySubquery(s, sql.Select(sql.Max(jobs.C(job.FieldStartTime))).
+				From(jobs).
+				Where(sql.And(
+					sql.ColumnsEQ(jobs.C(job.TaskColumn), s.C(task.FieldID)),
+					sql.IsNull(jobs.C(job.FieldParentID)),
+				)), direction)
+		}}
+	case model.TaskSortFieldHealth:
+		order = []task.OrderOption{task.ByDisabled(opts...), task.ByConsecutiveFailures(opts...)}
+	default:
+		order = []task.OrderOption{task.ByCreatedAt(opts...)}
+	}
+	return append(order, task.ByName(), task.ByID())
+}
+
+// sortTermOptions returns the order term options sorting a list field in the direction, with NULLs last.
+func sortTermOptions(direction model.SortDirection) []sql.OrderTermOption {
+	if direction == model.SortDirectionDesc {
+		return []sql.OrderTermOption{sql.OrderDesc(), sql.OrderNullsLast()}
+	}
+	return []sql.OrderTermOption{sql.OrderNullsLast()}
+}
+
+// orderBySubquery sorts the selector by the value of a scalar subquery in the direction, with NULLs last.
+func orderBySubquery(s *sql.Selector, subquery *sql.Selector, direction model.SortDirection) {
+	s.OrderExprFunc(func(b *sql.Builder) {
+		b.Wrap(func(b *sql.Builder) { b.Join(subquery) })
+		if direction == model.SortDirectionDesc {
+			b.WriteString(" DESC")
+		}
+		b.WriteString(" NULLS LAST")
+	})
+}
+
 // CreateTask creates a new sync task with the given parameters.
 func (s *TaskService) CreateTask(ctx context.Context, name, sourcePath string, connectionID uuid.UUID, remotePath, direction, schedule string, realtime bool, options *model.TaskSyncOptions) (*ent.Task, error) {
 	t, err := s.client.Task.Create().
@@ -65,9 +154,76 @@ func (s *TaskService) CreateTask(ctx context.Context, name, sourcePath string, c
 	return t, nil
 }
 
-// ListAllTasks retrieves all tasks with their latest job and connection.
+// CreateEphemeralTask creates a hidden task for a single ad-hoc run, using the default sync engine if engine is empty.
+// Ephemeral tasks are never scheduled or watched and are excluded from task lists, but their jobs are kept.
+func (s *TaskService) CreateEphemeralTask(ctx context.Context, spec TaskSpec, engine string) (*ent.Task, error) {
+	create := s.client.Task.Create().
+		SetName(spec.Name).
+		SetSourcePath(spec.SourcePath).
+		SetConnectionID(spec.ConnectionID).
+		SetRemotePath(spec.RemotePath).
+		SetDirection(model.SyncDirection(spec.Direction)).
+		SetOptions(spec.Options).
+		SetEphemeral(true)
+	if engine != "" {
+		create.SetEngine(engine)
+	}
+	t, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.Join(errs.ErrAlreadyExists, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// TaskSpec describes a task to be created by CreateTasks.
+type TaskSpec struct {
+	Name         string
+	SourcePath   string
+	ConnectionID uuid.UUID
+	RemotePath   string
+	Direction    string
+	Schedule     string
+	Realtime     bool
+	Options      *model.TaskSyncOptions
+}
+
+// CreateTasks creates multiple tasks in a single bulk insert.
+// Either all tasks are created or none are.
+func (s *TaskService) CreateTasks(ctx context.Context, specs []TaskSpec) ([]*ent.Task, error) {
+	if len(specs) == 0 {
+		return []*ent.Task{}, nil
+	}
+
+	builders := make([]*ent.TaskCreate, len(specs))
+	for i, spec := range specs {
+		builders[i] = s.client.Task.Create().
+			SetName(spec.Name).
+			SetSourcePath(spec.SourcePath).
+			SetConnectionID(spec.ConnectionID).
+			SetRemotePath(spec.RemotePath).
+			SetDirection(model.SyncDirection(spec.Direction)).
+			SetSchedule(spec.Schedule).
+			SetRealtime(spec.Realtime).
+			SetOptions(spec.Options)
+	}
+
+	tasks, err := s.client.Task.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.Join(errs.ErrAlreadyExists, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return tasks, nil
+}
+
+// ListAllTasks retrieves all tasks with their latest job and connection. Deleted and ephemeral tasks are excluded.
 func (s *TaskService) ListAllTasks(ctx context.Context) ([]*ent.Task, error) {
 	tasks, err := s.client.Task.Query().
+		Where(task.DeletedAtIsNil(), task.Ephemeral(false)).
 		WithJobs(withLatestJobPredicate).
 		WithConnection().
 		All(ctx)
@@ -77,9 +233,10 @@ func (s *TaskService) ListAllTasks(ctx context.Context) ([]*ent.Task, error) {
 	return tasks, nil
 }
 
-// ListTasksByConnection retrieves tasks by connection ID with their latest job.
+// ListTasksByConnection retrieves tasks by connection ID with their latest job. Deleted and ephemeral tasks are excluded.
 func (s *TaskService) ListTasksByConnection(ctx context.Context, connectionID uuid.UUID) ([]*ent.Task, error) {
-	query := s.client.Task.Query()
+	query := s.client.Task.Query().
+		Where(task.DeletedAtIsNil(), task.Ephemeral(false))
 	if connectionID != uuid.Nil {
 		query = query.Where(task.ConnectionIDEQ(connectionID))
 	}
@@ -93,9 +250,24 @@ func (s *TaskService) ListTasksByConnection(ctx context.Context, connectionID uu
 	return tasks, nil
 }
 
-// GetTask retrieves a task by ID.
+// ListTaskIDsByTag returns the IDs of the tasks tagged with tag. Deleted and ephemeral tasks are excluded.
+func (s *TaskService) ListTaskIDsByTag(ctx context.Context, tag string) ([]uuid.UUID, error) {
+	ids, err := s.client.Task.Query().
+		Where(task.DeletedAtIsNil(), task.Ephemeral(false), func(sel *sql.Selector) {
+			sel.Where(sqljson.ValueContains(task.FieldTags, tag))
+		}).
+		IDs(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return ids, nil
+}
+
+// GetTask retrieves a task by ID. Deleted tasks are not found.
 func (s *TaskService) GetTask(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
-	t, err := s.client.Task.Get(ctx, id)
+	t, err := s.client.Task.Query().
+		Where(task.IDEQ(id), task.DeletedAtIsNil()).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.Join(errs.ErrNotFound, err)
@@ -105,10 +277,29 @@ func (s *TaskService) GetTask(ctx context.Context, id uuid.UUID) (*ent.Task, err
 	return t, nil
 }
 
+// GetTasks retrieves the tasks with the given IDs in the same order, with nil for IDs of missing or deleted tasks.
+func (s *TaskService) GetTasks(ctx context.Context, ids []uuid.UUID) ([]*ent.Task, error) {
+	found, err := s.client.Task.Query().
+		Where(task.IDIn(ids...), task.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	byID := make(map[uuid.UUID]*ent.Task, len(found))
+	for _, t := range found {
+		byID[t.ID] = t
+	}
+	tasks := make([]*ent.Task, len(ids))
+	for i, id := range ids {
+		tasks[i] = byID[id]
+	}
+	return tasks, nil
+}
+
 // GetTaskWithConnection retrieves a task by ID with its connection.
 func (s *TaskService) GetTaskWithConnection(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
 	t, err := s.client.Task.Query().
-		Where(task.IDEQ(id)).
+		Where(task.IDEQ(id), task.DeletedAtIsNil()).
 		WithConnection().
 		Only(ctx)
 	if err != nil {
@@ -123,7 +314,7 @@ func (s *TaskService) GetTaskWithConnection(ctx context.Context, id uuid.UUID) (
 // GetTaskWithJobs retrieves a task by ID with its latest job.
 func (s *TaskService) GetTaskWithJobs(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
 	t, err := s.client.Task.Query().
-		Where(task.IDEQ(id)).
+		Where(task.IDEQ(id), task.DeletedAtIsNil()).
 		WithJobs(withLatestJobPredicate).
 		Only(ctx)
 	if err != nil {
@@ -138,6 +329,7 @@ func (s *TaskService) GetTaskWithJobs(ctx context.Context, id uuid.UUID) (*ent.T
 // UpdateTask updates an existing task with the given parameters.
 func (s *TaskService) UpdateTask(ctx context.Context, id uuid.UUID, name, sourcePath string, connectionID uuid.UUID, remotePath, direction, schedule string, realtime bool, options *model.TaskSyncOptions) (*ent.Task, error) {
 	t, err := s.client.Task.UpdateOneID(id).
+		Where(task.DeletedAtIsNil()).
 		SetName(name).
 		SetSourcePath(sourcePath).
 		SetConnectionID(connectionID).
@@ -159,10 +351,151 @@ func (s *TaskService) UpdateTask(ctx context.Context, id uuid.UUID, name, source
 	return t, nil
 }
 
-// DeleteTask deletes a task by ID.
-func (s *TaskService) DeleteTask(ctx context.Context, id uuid.UUID) error {
-	err := s.client.Task.DeleteOneID(id).Exec(ctx)
+// SetTaskConnection moves the task to another connection, keeping the rest of its configuration.
+func (s *TaskService) SetTaskConnection(ctx context.Context, id, connectionID uuid.UUID) (*ent.Task, error) {
+	t, err := s.client.Task.UpdateOneID(id).
+		Where(task.DeletedAtIsNil()).
+		SetConnectionID(connectionID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// SetTaskEngine sets the sync engine that runs the task.
+// The engine name is not checked here, since engines are registered in the runner.
+func (s *TaskService) SetTaskEngine(ctx context.Context, id uuid.UUID, engine string) (*ent.Task, error) {
+	t, err := s.client.Task.UpdateOneID(id).
+		Where(task.DeletedAtIsNil()).
+		SetEngine(engine).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		if ent.IsValidationError(err) {
+			return nil, errors.Join(errs.ErrInvalidInput, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// SetTaskTags replaces the tags of the task. Tags are trimmed, and empty and duplicate tags are dropped.
+func (s *TaskService) SetTaskTags(ctx context.Context, id uuid.UUID, tags []string) (*ent.Task, error) {
+	t, err := s.client.Task.UpdateOneID(id).
+		Where(task.DeletedAtIsNil()).
+		SetTags(normalizeTags(tags)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// SetTaskEnabled enables or disables the task. Either way the reason of an automatic disable is cleared
+// and the count of runs failing with a non-transient error starts over.
+func (s *TaskService) SetTaskEnabled(ctx context.Context, id uuid.UUID, enabled bool) (*ent.Task, error) {
+	t, err := s.client.Task.UpdateOneID(id).
+		Where(task.DeletedAtIsNil()).
+		SetDisabled(!enabled).
+		ClearDisabledReason().
+		SetConsecutiveFatalFailures(0).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// normalizeTags returns the trimmed, non-empty tags in order, without duplicates.
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+// DeleteTask soft deletes a task by ID and returns the deleted task. The task and its job history
+// are kept until PurgeDeletedTasks removes them, and can be restored with RestoreTask until then.
+// The task's updated_at is left unchanged since its configuration did not change.
+func (s *TaskService) DeleteTask(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
+	t, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t, err = s.client.Task.UpdateOne(t).
+		Where(task.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		SetUpdatedAt(t.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// RestoreTask restores a soft deleted task by ID.
+// Returns ErrNotFound if there is no deleted task with the ID, e.g. because it has been purged.
+func (s *TaskService) RestoreTask(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
+	t, err := s.client.Task.Query().
+		Where(task.IDEQ(id), task.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	t, err = s.client.Task.UpdateOne(t).
+		Where(task.DeletedAtNotNil()).
+		ClearDeletedAt().
+		SetUpdatedAt(t.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.Join(errs.ErrNotFound, err)
+		}
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return t, nil
+}
+
+// PurgeDeletedTasks permanently deletes the tasks soft deleted before the given time,
+// together with their jobs, logs and events. Returns the number of purged tasks.
+func (s *TaskService) PurgeDeletedTasks(ctx context.Context, before time.Time) (int, error) {
+	n, err := s.client.Task.Delete().
+		Where(task.DeletedAtLT(before)).
+		Exec(ctx)
 	if err != nil {
+		return 0, errors.Join(errs.ErrSystem, err)
+	}
+	return n, nil
+}
+
+// DiscardTask permanently deletes a task together with its jobs, bypassing the soft delete,
+// to roll back the creation of a task that couldn't be completed.
+func (s *TaskService) DiscardTask(ctx context.Context, id uuid.UUID) error {
+	if err := s.client.Task.DeleteOneID(id).Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return errors.Join(errs.ErrNotFound, err)
 		}
@@ -171,10 +504,34 @@ func (s *TaskService) DeleteTask(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// ListTasksPaginated lists tasks with pagination.
-func (s *TaskService) ListTasksPaginated(ctx context.Context, limit, offset int) ([]*ent.Task, int, error) {
+// ListDeletedTasksPaginated lists the soft deleted tasks, most recently deleted first, with pagination.
+// Ephemeral tasks are excluded.
+func (s *TaskService) ListDeletedTasksPaginated(ctx context.Context, limit, offset int) ([]*ent.Task, int, error) {
 	query := s.client.Task.Query().
-		Order(ent.Desc(task.FieldCreatedAt))
+		Where(task.DeletedAtNotNil(), task.Ephemeral(false)).
+		Order(ent.Desc(task.FieldDeletedAt))
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Join(errs.ErrSystem, err)
+	}
+
+	tasks, err := query.
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.Join(errs.ErrSystem, err)
+	}
+
+	return tasks, totalCount, nil
+}
+
+// ListTasksPaginated lists tasks with pagination, sorted by sortBy in the direction. Deleted and ephemeral tasks are excluded.
+func (s *TaskService) ListTasksPaginated(ctx context.Context, limit, offset int, sortBy model.TaskSortField, direction model.SortDirection) ([]*ent.Task, int, error) {
+	query := s.client.Task.Query().
+		Where(task.DeletedAtIsNil(), task.Ephemeral(false)).
+		Order(taskOrder(sortBy, direction)...)
 
 	// Get total count
 	totalCount, err := query.Clone().Count(ctx)
@@ -194,10 +551,36 @@ func (s *TaskService) ListTasksPaginated(ctx context.Context, limit, offset int)
 	return tasks, totalCount, nil
 }
 
-// ListTasksByConnectionPaginated lists tasks by connection ID with pagination.
+// ListTasksMirroringTo lists the tasks that upload to the connection as one of their mirrors, see
+// TaskSyncOptions.mirrors. Deleted and ephemeral tasks are excluded.
+func (s *TaskService) ListTasksMirroringTo(ctx context.Context, connectionID uuid.UUID) ([]*ent.Task, error) {
+	tasks, err := tasksMirroringTo(ctx, s.client, connectionID)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return tasks, nil
+}
+
+// tasksMirroringTo returns the tasks with a mirror on the connection. Mirrors are part of the options JSON of
+// a task, so they are matched here rather than in the query.
+func tasksMirroringTo(ctx context.Context, client *ent.Client, connectionID uuid.UUID) ([]*ent.Task, error) {
+	tasks, err := client.Task.Query().
+		Where(task.DeletedAtIsNil(), task.Ephemeral(false), task.OptionsNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(tasks, func(t *ent.Task) bool {
+		return t.Options == nil || !slices.ContainsFunc(t.Options.Mirrors, func(m *model.TaskMirror) bool {
+			return m != nil && m.ConnectionID == connectionID
+		})
+	}), nil
+}
+
+// ListTasksByConnectionPaginated lists tasks by connection ID with pagination. Deleted and ephemeral tasks are excluded.
 func (s *TaskService) ListTasksByConnectionPaginated(ctx context.Context, connectionID uuid.UUID, limit, offset int) ([]*ent.Task, int, error) {
 	query := s.client.Task.Query().
-		Where(task.ConnectionID(connectionID)).
+		Where(task.ConnectionID(connectionID), task.DeletedAtIsNil(), task.Ephemeral(false)).
 		Order(ent.Desc(task.FieldCreatedAt))
 
 	// Get total count
@@ -221,7 +604,7 @@ func (s *TaskService) ListTasksByConnectionPaginated(ctx context.Context, connec
 // ListJobsByTaskPaginated lists jobs for a task with pagination.
 func (s *TaskService) ListJobsByTaskPaginated(ctx context.Context, taskID uuid.UUID, limit, offset int) ([]*ent.Job, int, error) {
 	query := s.client.Job.Query().
-		Where(job.HasTaskWith(task.ID(taskID))).
+		Where(job.HasTaskWith(task.ID(taskID)), job.ParentIDIsNil()).
 		Order(ent.Desc(job.FieldStartTime))
 
 	// Get total count
@@ -242,4 +625,158 @@ func (s *TaskService) ListJobsByTaskPaginated(ctx context.Context, taskID uuid.U
 	return jobs, totalCount, nil
 }
 
+// RecordSkippedRun increments the skipped run counter of a task and records
+// a SCHEDULE_SKIPPED task event with the given message.
+// The task's updated_at is left unchanged since its configuration did not change.
+func (s *TaskService) RecordSkippedRun(ctx context.Context, taskID uuid.UUID, message string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+
+	if err := recordSkippedRunTx(ctx, tx.Client(), taskID, message); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+		if ent.IsNotFound(err) {
+			return errors.Join(errs.ErrNotFound, err)
+		}
+		return errors.Join(errs.ErrSystem, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+	return nil
+}
+
+// RecordWatchLimitReached records a WATCH_LIMIT task event with the given message
+// for a realtime task that is only partially watched since the inotify watch limit was reached.
+func (s *TaskService) RecordWatchLimitReached(ctx context.Context, taskID uuid.UUID, message string) error {
+	err := s.client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeWatchLimit).
+		SetMessage(message).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.Join(errs.ErrNotFound, err)
+		}
+		return errors.Join(errs.ErrSystem, err)
+	}
+	return nil
+}
+
+// recordSkippedRunTx performs the writes of RecordSkippedRun using a transactional client.
+func recordSkippedRunTx(ctx context.Context, client *ent.Client, taskID uuid.UUID, message string) error {
+	t, err := client.Task.Get(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if err := client.Task.UpdateOne(t).
+		AddSkippedRuns(1).
+		SetUpdatedAt(t.UpdatedAt).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeScheduleSkipped).
+		SetMessage(message).
+		Exec(ctx)
+}
+
+// recordJobOutcomeTx updates the consecutive failure count of a task with the final status of one of its runs
+// using a transactional client: failures increment it, successes reset it and other statuses (e.g. cancelled)
+// leave it unchanged. Returns the updated count.
+// The task's updated_at is left unchanged since its configuration did not change.
+func recordJobOutcomeTx(ctx context.Context, client *ent.Client, taskID uuid.UUID, status model.JobStatus) (int, error) {
+	t, err := client.Task.Get(ctx, taskID)
+	if err != nil {
+		return 0, err
+	}
+
+	update := client.Task.UpdateOne(t).SetUpdatedAt(t.UpdatedAt)
+	switch status {
+	case model.JobStatusFailed, model.JobStatusFailedTimeout:
+		update.AddConsecutiveFailures(1)
+	case model.JobStatusSuccess, model.JobStatusSuccessWithWarnings:
+		if t.ConsecutiveFailures == 0 {
+			return 0, nil
+		}
+		update.SetConsecutiveFailures(0)
+	default:
+		return t.ConsecutiveFailures, nil
+	}
+
+	t, err = update.Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return t.ConsecutiveFailures, nil
+}
+
+// recordFatalOutcomeTx updates the count of runs of the task that failed in a row with a non-transient error
+// with the job result. When the count reaches threshold, the task is disabled and a TASK_DISABLED task event
+// is recorded. Returns the reason the task was disabled with, empty if it wasn't disabled by this result.
+func recordFatalOutcomeTx(ctx context.Context, client *ent.Client, taskID uuid.UUID, result ports.JobResult, threshold int) (string, error) {
+	t, err := client.Task.Get(ctx, taskID)
+	if err != nil {
+		return "", err
+	}
+
+	update := client.Task.UpdateOne(t).SetUpdatedAt(t.UpdatedAt)
+	switch {
+	case result.Fatal && result.Status == model.JobStatusFailed:
+		update.AddConsecutiveFatalFailures(1)
+	case result.Status == model.JobStatusFailed, result.Status == model.JobStatusFailedTimeout,
+		result.Status == model.JobStatusSuccess, result.Status == model.JobStatusSuccessWithWarnings:
+		if t.ConsecutiveFatalFailures == 0 {
+			return "", nil
+		}
+		update.SetConsecutiveFatalFailures(0)
+	default:
+		return "", nil
+	}
+
+	t, err = update.Save(ctx)
+	if err != nil || threshold <= 0 || t.Disabled || t.ConsecutiveFatalFailures < threshold {
+		return "", err
+	}
+	reason := fmt.Sprintf("disabled after %d runs in a row failed with a non-transient error", t.ConsecutiveFatalFailures)
+	if result.Error != "" {
+		reason += ", last error: " + result.Error
+	}
+	if err := client.Task.UpdateOne(t).SetDisabled(true).SetDisabledReason(reason).Exec(ctx); err != nil {
+		return "", err
+	}
+	return reason, client.TaskEvent.Create().
+		SetTaskID(taskID).
+		SetType(model.TaskEventTypeTaskDisabled).
+		SetMessage(reason).
+		Exec(ctx)
+}
+
+// ListTaskEventsPaginated lists the events of a task, newest first, with pagination.
+func (s *TaskService) ListTaskEventsPaginated(ctx context.Context, taskID uuid.UUID, limit, offset int) ([]*ent.TaskEvent, int, error) {
+	query := s.client.TaskEvent.Query().
+		Where(taskevent.TaskIDEQ(taskID)).
+		Order(ent.Desc(taskevent.FieldTime))
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Join(errs.ErrSystem, err)
+	}
+
+	events, err := query.
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.Join(errs.ErrSystem, err)
+	}
+
+	return events, totalCount, nil
+}
+
 var _ ports.TaskService = (*TaskService)(nil)
@@ -17,6 +17,7 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/ent/job"
 	"github.com/xzzpig/rclone-sync/internal/core/ent/task"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
 )
 
 func TestTaskService(t *testing.T) {
@@ -184,16 +185,28 @@ func TestTaskService(t *testing.T) {
 		require.NoError(t, err)
 
 		t.Run("Success", func(t *testing.T) {
-			err := service.DeleteTask(ctx, tToDelete.ID)
-			assert.NoError(t, err)
+			deleted, err := service.DeleteTask(ctx, tToDelete.ID)
+			require.NoError(t, err)
+			require.NotNil(t, deleted.DeletedAt)
+			assert.Equal(t, tToDelete.UpdatedAt.Unix(), deleted.UpdatedAt.Unix())
 
-			// Verify it's gone
+			// Verify it's hidden
 			_, err = service.GetTask(ctx, tToDelete.ID)
 			assert.ErrorIs(t, err, errs.ErrNotFound)
+			tasks, err := service.ListAllTasks(ctx)
+			require.NoError(t, err)
+			for _, tk := range tasks {
+				assert.NotEqual(t, tToDelete.ID, tk.ID)
+			}
+		})
+
+		t.Run("AlreadyDeleted", func(t *testing.T) {
+			_, err := service.DeleteTask(ctx, tToDelete.ID)
+			assert.ErrorIs(t, err, errs.ErrNotFound)
 		})
 
 		t.Run("NotFound", func(t *testing.T) {
-			err := service.DeleteTask(ctx, uuid.New())
+			_, err := service.DeleteTask(ctx, uuid.New())
 			assert.Error(t, err)
 			assert.ErrorIs(t, err, errs.ErrNotFound)
 		})
@@ -212,27 +225,29 @@ func TestTaskService(t *testing.T) {
 			job2, err := jobService.CreateJob(ctx, taskWithJobs.ID, model.JobTriggerSchedule)
 			require.NoError(t, err)
 
-			// Verify jobs exist before deletion
-			jobsBeforeDelete, err := client.Job.Query().
-				Where(job.HasTaskWith(task.ID(taskWithJobs.ID))).
-				All(ctx)
+			// Soft deleting the task keeps its job history
+			_, err = service.DeleteTask(ctx, taskWithJobs.ID)
 			require.NoError(t, err)
-			assert.Len(t, jobsBeforeDelete, 2)
-
-			// Delete the task - this should also delete all associated jobs due to cascade delete
-			err = service.DeleteTask(ctx, taskWithJobs.ID)
+			_, err = jobService.GetJob(ctx, job1.ID)
 			assert.NoError(t, err)
 
-			// Verify task is gone
-			_, err = service.GetTask(ctx, taskWithJobs.ID)
-			assert.ErrorIs(t, err, errs.ErrNotFound)
+			// Jobs of deleted tasks are not listed
+			jobs, err := jobService.ListJobs(ctx, nil, nil, "", 100, 0)
+			require.NoError(t, err)
+			for _, j := range jobs {
+				assert.NotEqual(t, job1.ID, j.ID)
+			}
+
+			// Purging the task deletes all associated jobs due to cascade delete
+			purged, err := service.PurgeDeletedTasks(ctx, time.Now().Add(time.Second))
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, purged, 1)
 
-			// Verify jobs are also deleted due to cascade delete
-			jobsAfterDelete, err := client.Job.Query().
+			jobsAfterPurge, err := client.Job.Query().
 				Where(job.HasTaskWith(task.ID(taskWithJobs.ID))).
 				All(ctx)
 			require.NoError(t, err)
-			assert.Len(t, jobsAfterDelete, 0)
+			assert.Len(t, jobsAfterPurge, 0)
 
 			// Verify individual jobs are also deleted
 			_, err = jobService.GetJob(ctx, job1.ID)
@@ -506,7 +521,7 @@ func TestTaskService_ListTasksPaginated(t *testing.T) {
 	require.NoError(t, err)
 
 	// Initially empty
-	tasks, total, err := service.ListTasksPaginated(ctx, 10, 0)
+	tasks, total, err := service.ListTasksPaginated(ctx, 10, 0, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 	require.NoError(t, err)
 	assert.Empty(t, tasks)
 	assert.Equal(t, 0, total)
@@ -519,42 +534,42 @@ func TestTaskService_ListTasksPaginated(t *testing.T) {
 	}
 
 	t.Run("FirstPage", func(t *testing.T) {
-		tasks, total, err := service.ListTasksPaginated(ctx, 2, 0)
+		tasks, total, err := service.ListTasksPaginated(ctx, 2, 0, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, tasks, 2)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("SecondPage", func(t *testing.T) {
-		tasks, total, err := service.ListTasksPaginated(ctx, 2, 2)
+		tasks, total, err := service.ListTasksPaginated(ctx, 2, 2, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, tasks, 2)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("LastPage", func(t *testing.T) {
-		tasks, total, err := service.ListTasksPaginated(ctx, 2, 4)
+		tasks, total, err := service.ListTasksPaginated(ctx, 2, 4, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, tasks, 1)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("OffsetBeyondTotal", func(t *testing.T) {
-		tasks, total, err := service.ListTasksPaginated(ctx, 10, 100)
+		tasks, total, err := service.ListTasksPaginated(ctx, 10, 100, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Empty(t, tasks)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("LargeLimit", func(t *testing.T) {
-		tasks, total, err := service.ListTasksPaginated(ctx, 100, 0)
+		tasks, total, err := service.ListTasksPaginated(ctx, 100, 0, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		assert.Len(t, tasks, 5)
 		assert.Equal(t, 5, total)
 	})
 
 	t.Run("OrderedByCreatedAtDesc", func(t *testing.T) {
-		tasks, _, err := service.ListTasksPaginated(ctx, 5, 0)
+		tasks, _, err := service.ListTasksPaginated(ctx, 5, 0, model.TaskSortFieldCreatedAt, model.SortDirectionDesc)
 		require.NoError(t, err)
 		require.Len(t, tasks, 5)
 
@@ -566,6 +581,63 @@ func TestTaskService_ListTasksPaginated(t *testing.T) {
 	})
 }
 
+func TestTaskService_ListTasksPaginated_Sorted(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	jobService := NewJobService(client)
+	ctx := context.Background()
+
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	testConn, err := NewConnectionService(client, encryptor).CreateConnection(ctx, "sorted-tasks-conn", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	create := func(name string) *ent.Task {
+		task, err := service.CreateTask(ctx, name, "/src", testConn.ID, "/dst", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond) // Ensure different creation times
+		return task
+	}
+	beta, alpha, gamma := create("beta"), create("alpha"), create("gamma")
+	require.NoError(t, client.Task.UpdateOne(beta).SetEngine("backup").SetConsecutiveFailures(2).Exec(ctx))
+	require.NoError(t, client.Task.UpdateOne(gamma).SetDisabled(true).Exec(ctx))
+
+	// alpha ran last, gamma before it and beta never
+	for _, task := range []*ent.Task{gamma, alpha} {
+		_, err := jobService.CreateJob(ctx, task.ID, model.JobTriggerManual)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+	}
+
+	names := func(sortBy model.TaskSortField, direction model.SortDirection) []string {
+		tasks, total, err := service.ListTasksPaginated(ctx, 10, 0, sortBy, direction)
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		var names []string
+		for _, task := range tasks {
+			names = append(names, task.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, names(model.TaskSortFieldName, model.SortDirectionAsc))
+	assert.Equal(t, []string{"gamma", "beta", "alpha"}, names(model.TaskSortFieldName, model.SortDirectionDesc))
+	assert.Equal(t, []string{"gamma", "alpha", "beta"}, names(model.TaskSortFieldCreatedAt, model.SortDirectionDesc))
+	assert.Equal(t, []string{"beta", "alpha", "gamma"}, names(model.TaskSortFieldType, model.SortDirectionAsc), "by engine, then by name")
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, names(model.TaskSortFieldHealth, model.SortDirectionAsc))
+	assert.Equal(t, []string{"gamma", "beta", "alpha"}, names(model.TaskSortFieldHealth, model.SortDirectionDesc))
+	assert.Equal(t, []string{"gamma", "alpha", "beta"}, names(model.TaskSortFieldLastJobAt, model.SortDirectionAsc), "tasks without jobs are last")
+	assert.Equal(t, []string{"alpha", "gamma", "beta"}, names(model.TaskSortFieldLastJobAt, model.SortDirectionDesc), "tasks without jobs are last")
+
+	tasks, _, err := service.ListTasksPaginated(ctx, 1, 1, model.TaskSortFieldName, model.SortDirectionAsc)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "beta", tasks[0].Name, "pages are sorted")
+}
+
 // Tests for ListTasksByConnectionPaginated
 func TestTaskService_ListTasksByConnectionPaginated(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
@@ -771,3 +843,397 @@ func TestTaskService_ListJobsByTaskPaginated(t *testing.T) {
 		}
 	})
 }
+
+func TestTaskService_CreateTasks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	conn, err := connService.CreateConnection(ctx, "bulk-conn", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	t.Run("Empty", func(t *testing.T) {
+		tasks, err := service.CreateTasks(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		tasks, err := service.CreateTasks(ctx, []TaskSpec{
+			{Name: "a", SourcePath: "/local/a", ConnectionID: conn.ID, RemotePath: "/remote/a", Direction: string(model.SyncDirectionUpload)},
+			{Name: "b", SourcePath: "/local/b", ConnectionID: conn.ID, RemotePath: "/remote/b", Direction: string(model.SyncDirectionBidirectional)},
+		})
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+		assert.Equal(t, "a", tasks[0].Name)
+		assert.Equal(t, model.SyncDirectionUpload, tasks[0].Direction)
+		assert.Equal(t, "/remote/b", tasks[1].RemotePath)
+	})
+
+	t.Run("AllOrNothing", func(t *testing.T) {
+		before, err := client.Task.Query().Count(ctx)
+		require.NoError(t, err)
+
+		// The second spec violates the NotEmpty validator, so nothing should be created
+		_, err = service.CreateTasks(ctx, []TaskSpec{
+			{Name: "c", SourcePath: "/local/c", ConnectionID: conn.ID, RemotePath: "/remote/c", Direction: string(model.SyncDirectionUpload)},
+			{Name: "", SourcePath: "/local/d", ConnectionID: conn.ID, RemotePath: "/remote/d", Direction: string(model.SyncDirectionUpload)},
+		})
+		require.Error(t, err)
+
+		after, err := client.Task.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
+}
+
+func TestTaskService_RecordSkippedRun(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "test-skipped-runs", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	created, err := service.CreateTask(ctx, "Skipped Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "* * * * *", false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, created.SkippedRuns)
+
+	t.Run("IncrementsCounterAndRecordsEvent", func(t *testing.T) {
+		require.NoError(t, service.RecordSkippedRun(ctx, created.ID, "first"))
+		require.NoError(t, service.RecordSkippedRun(ctx, created.ID, "second"))
+
+		got, err := service.GetTask(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.SkippedRuns)
+		assert.True(t, created.UpdatedAt.Equal(got.UpdatedAt), "updated_at must not change")
+
+		events, total, err := service.ListTaskEventsPaginated(ctx, created.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, events, 2)
+		assert.Equal(t, model.TaskEventTypeScheduleSkipped, events[0].Type)
+		assert.Equal(t, "second", events[0].Message)
+		assert.Equal(t, "first", events[1].Message)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		err := service.RecordSkippedRun(ctx, uuid.New(), "missing")
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("PurgeTaskCascades", func(t *testing.T) {
+		_, err := service.DeleteTask(ctx, created.ID)
+		require.NoError(t, err)
+		count, err := client.TaskEvent.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count, "events are kept while the task can be restored")
+
+		_, err = service.PurgeDeletedTasks(ctx, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		count, err = client.TaskEvent.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
+
+func TestTaskService_RecordWatchLimitReached(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "test-watch-limit", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+	created, err := service.CreateTask(ctx, "Watched Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", true, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, service.RecordWatchLimitReached(ctx, created.ID, "limit reached"))
+	events, total, err := service.ListTaskEventsPaginated(ctx, created.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, events, 1)
+	assert.Equal(t, model.TaskEventTypeWatchLimit, events[0].Type)
+	assert.Equal(t, "limit reached", events[0].Message)
+
+	err = service.RecordWatchLimitReached(ctx, uuid.New(), "missing")
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestTaskService_SetTaskEngine(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "test-engine", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	created, err := service.CreateTask(ctx, "Engine Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ports.DefaultSyncEngine, created.Engine)
+
+	updated, err := service.SetTaskEngine(ctx, created.ID, "mock")
+	require.NoError(t, err)
+	assert.Equal(t, "mock", updated.Engine)
+
+	_, err = service.SetTaskEngine(ctx, created.ID, "")
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+
+	_, err = service.SetTaskEngine(ctx, uuid.New(), "mock")
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestTaskService_SetTaskTags(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "test-tags", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	tagged, err := service.CreateTask(ctx, "Tagged Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, tagged.Tags)
+	other, err := service.CreateTask(ctx, "Other Task", "/l2", testConn.ID, "/r2", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	deleted, err := service.CreateTask(ctx, "Deleted Task", "/l3", testConn.ID, "/r3", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+
+	updated, err := service.SetTaskTags(ctx, tagged.ID, []string{" nightly ", "photos", "", "nightly"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nightly", "photos"}, updated.Tags)
+	_, err = service.SetTaskTags(ctx, other.ID, []string{"photos-archive"})
+	require.NoError(t, err)
+	_, err = service.SetTaskTags(ctx, deleted.ID, []string{"nightly"})
+	require.NoError(t, err)
+	_, err = service.DeleteTask(ctx, deleted.ID)
+	require.NoError(t, err)
+
+	// Tags match exactly, deleted tasks are excluded
+	ids, err := service.ListTaskIDsByTag(ctx, "photos")
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tagged.ID}, ids)
+	ids, err = service.ListTaskIDsByTag(ctx, "nightly")
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tagged.ID}, ids)
+	ids, err = service.ListTaskIDsByTag(ctx, "missing")
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	_, err = service.SetTaskTags(ctx, uuid.New(), []string{"nightly"})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestTaskService_CreateEphemeralTask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "test-ephemeral", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	saved, err := service.CreateTask(ctx, "Saved Task", "/l", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	ephemeral, err := service.CreateEphemeralTask(ctx, TaskSpec{
+		Name: "Ad-hoc", SourcePath: "/l", ConnectionID: testConn.ID, RemotePath: "/r", Direction: string(model.SyncDirectionUpload),
+	}, "")
+	require.NoError(t, err)
+	assert.True(t, ephemeral.Ephemeral)
+	assert.Equal(t, ports.DefaultSyncEngine, ephemeral.Engine)
+
+	// Ephemeral tasks can be loaded by ID, but are hidden from lists and counts
+	got, err := service.GetTask(ctx, ephemeral.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ephemeral.ID, got.ID)
+
+	all, err := service.ListAllTasks(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, saved.ID, all[0].ID)
+
+	byConn, total, err := service.ListTasksByConnectionPaginated(ctx, testConn.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Len(t, byConn, 1)
+
+	count, err := connService.CountAssociatedTasks(ctx, testConn.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = service.DeleteTask(ctx, saved.ID)
+	require.NoError(t, err)
+	hasTasks, err := connService.HasAssociatedTasks(ctx, testConn.ID)
+	require.NoError(t, err)
+	assert.False(t, hasTasks, "ephemeral tasks don't keep the connection in use")
+}
+
+func TestTaskService_SoftDelete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	service := NewTaskService(client)
+	encryptor, err := crypto.NewEncryptor("test-secret-key-32-bytes-long!!")
+	require.NoError(t, err)
+	connService := NewConnectionService(client, encryptor)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "test-soft-delete", "local", map[string]string{
+		"type": "local",
+	})
+	require.NoError(t, err)
+
+	kept, err := service.CreateTask(ctx, "Kept Task", "/kept", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	deleted, err := service.CreateTask(ctx, "Deleted Task", "/deleted", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	_, err = service.DeleteTask(ctx, deleted.ID)
+	require.NoError(t, err)
+
+	t.Run("ExcludedFromQueries", func(t *testing.T) {
+		tasks, total, err := service.ListTasksByConnectionPaginated(ctx, testConn.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, kept.ID, tasks[0].ID)
+
+		count, err := connService.CountAssociatedTasks(ctx, testConn.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		_, err = service.UpdateTask(ctx, deleted.ID, "Renamed", "/deleted", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("ListDeleted", func(t *testing.T) {
+		tasks, total, err := service.ListDeletedTasksPaginated(ctx, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, deleted.ID, tasks[0].ID)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		restored, err := service.RestoreTask(ctx, deleted.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		_, err = service.GetTask(ctx, deleted.ID)
+		assert.NoError(t, err)
+
+		// Only deleted tasks can be restored
+		_, err = service.RestoreTask(ctx, deleted.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		_, err = service.RestoreTask(ctx, uuid.New())
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("PurgeAfterRetention", func(t *testing.T) {
+		_, err := service.DeleteTask(ctx, deleted.ID)
+		require.NoError(t, err)
+
+		// Tasks deleted after the cutoff are kept
+		purged, err := service.PurgeDeletedTasks(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+
+		purged, err = service.PurgeDeletedTasks(ctx, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		_, err = service.RestoreTask(ctx, deleted.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		_, err = service.GetTask(ctx, kept.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Discard", func(t *testing.T) {
+		discarded, err := service.CreateTask(ctx, "Discarded Task", "/discarded", testConn.ID, "/r", string(model.SyncDirectionUpload), "", false, nil)
+		require.NoError(t, err)
+		j, err := client.Job.Create().SetTaskID(discarded.ID).SetTrigger(model.JobTriggerManual).Save(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, service.DiscardTask(ctx, discarded.ID))
+		_, err = service.RestoreTask(ctx, discarded.ID)
+		assert.ErrorIs(t, err, errs.ErrNotFound, "discarded tasks can't be restored")
+		exists, err := client.Job.Query().Where(job.ID(j.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		assert.ErrorIs(t, service.DiscardTask(ctx, discarded.ID), errs.ErrNotFound)
+	})
+}
+
+func TestTaskConfigHash(t *testing.T) {
+	base := &ent.Task{
+		Name:         "Task",
+		SourcePath:   "/local",
+		ConnectionID: uuid.New(),
+		RemotePath:   "/remote",
+		Direction:    model.SyncDirectionUpload,
+		Schedule:     "0 * * * *",
+	}
+	hash := TaskConfigHash(base, "")
+	assert.Len(t, hash, 64)
+
+	cosmetic := *base
+	cosmetic.Name, cosmetic.Schedule, cosmetic.Realtime = "Renamed", "", true
+	assert.Equal(t, hash, TaskConfigHash(&cosmetic, ""), "name and triggers don't change the configuration")
+
+	changed := *base
+	changed.RemotePath = "/other"
+	assert.NotEqual(t, hash, TaskConfigHash(&changed, ""))
+	changed = *base
+	changed.Options = &model.TaskSyncOptions{}
+	assert.NotEqual(t, hash, TaskConfigHash(&changed, ""))
+	assert.NotEqual(t, hash, TaskConfigHash(base, "/base"), "the connection base path is part of the configuration")
+
+	days := 7
+	retention := *base
+	retention.Options = &model.TaskSyncOptions{LogRetentionDays: &days, JobRetentionDays: &days}
+	assert.Equal(t, hash, TaskConfigHash(&retention, ""), "the job retention doesn't change the configuration")
+	retention.Options.MaxDurationMinutes = &days
+	withOptions := *base
+	withOptions.Options = &model.TaskSyncOptions{MaxDurationMinutes: &days}
+	assert.Equal(t, TaskConfigHash(&withOptions, ""), TaskConfigHash(&retention, ""))
+}
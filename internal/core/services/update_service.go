@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+	"golang.org/x/mod/semver"
+)
+
+// DefaultUpdateCheckInterval is the time between update checks if none is configured.
+const DefaultUpdateCheckInterval = 24 * time.Hour
+
+// updateCheckTimeout bounds a single request to the GitHub API.
+const updateCheckTimeout = 30 * time.Second
+
+// UpdateStatus is the result of the latest update check.
+type UpdateStatus struct {
+	// LatestVersion is the tag of the latest release.
+	LatestVersion string
+	// ReleaseURL is the web page of the latest release.
+	ReleaseURL string
+	// PublishedAt is the time the latest release was published at.
+	PublishedAt time.Time
+	// CheckedAt is the time of the check.
+	CheckedAt time.Time
+	// UpdateAvailable reports whether the latest release is newer than the running version.
+	UpdateAvailable bool
+}
+
+// UpdateService periodically compares the running version against the latest GitHub release of a repository.
+type UpdateService struct {
+	logger     *zap.Logger
+	current    string
+	repository string
+	interval   time.Duration
+	apiURL     string
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu     sync.RWMutex
+	status *UpdateStatus
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewUpdateService creates a new UpdateService instance that checks the releases of repository ("owner/name")
+// for a version newer than current every interval (DefaultUpdateCheckInterval if not positive).
+func NewUpdateService(current, repository string, interval time.Duration) *UpdateService {
+	if interval <= 0 {
+		interval = DefaultUpdateCheckInterval
+	}
+	return &UpdateService{
+		logger:     logger.Named("service.update"),
+		current:    current,
+		repository: repository,
+		interval:   interval,
+		apiURL:     "https://api.github.com",
+		httpClient: &http.Client{Timeout: updateCheckTimeout},
+		now:        time.Now,
+	}
+}
+
+// Start checks for updates now and then every interval until Stop is called.
+func (s *UpdateService) Start() {
+	s.logger.Info("Starting update checks",
+		zap.String("repository", s.repository),
+		zap.String("current_version", s.current),
+		zap.Duration("interval", s.interval))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	go s.run(ctx)
+}
+
+// Stop stops checking for updates, canceling a running check.
+func (s *UpdateService) Stop() {
+	if s.cancel != nil {
+		s.logger.Info("Stopping update checks")
+		s.cancel()
+		<-s.done
+		s.cancel = nil
+	}
+}
+
+// run checks for updates every interval until ctx is canceled.
+func (s *UpdateService) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Check(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Warn("Update check failed", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// githubRelease is the part of a release of the GitHub API the update check uses.
+type githubRelease struct {
+	TagName     string    `json:"tag_name"`
+	HTMLURL     string    `json:"html_url"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// Check fetches the latest release of the repository and compares it against the running version.
+// The result is kept until the next check, see Status.
+func (s *UpdateService) Check(ctx context.Context) (*UpdateStatus, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases/latest", s.apiURL, s.repository)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Join(errs.ErrSystem, fmt.Errorf("unexpected status of latest release: %s", resp.Status))
+	}
+
+	var release githubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+
+	status := &UpdateStatus{
+		LatestVersion:   release.TagName,
+		ReleaseURL:      release.HTMLURL,
+		PublishedAt:     release.PublishedAt,
+		CheckedAt:       s.now(),
+		UpdateAvailable: newerVersion(release.TagName, s.current),
+	}
+	s.mu.Lock()
+	s.status = status
+	s.mu.Unlock()
+
+	if status.UpdateAvailable {
+		s.logger.Info("A newer release is available",
+			zap.String("current_version", s.current),
+			zap.String("latest_version", status.LatestVersion),
+			zap.String("release_url", status.ReleaseURL))
+	}
+	return status, nil
+}
+
+// Status returns the result of the latest successful check, or nil if there was none yet.
+func (s *UpdateService) Status() *UpdateStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
+
+// newerVersion reports whether the release version latest is newer than current.
+// Versions that aren't semantic versions, such as "dev" of development builds, are never outdated.
+func newerVersion(latest, current string) bool {
+	latest, current = canonicalVersion(latest), canonicalVersion(current)
+	if !semver.IsValid(latest) || !semver.IsValid(current) {
+		return false
+	}
+	return semver.Compare(latest, current) > 0
+}
+
+// canonicalVersion adds the "v" prefix semver expects to versions tagged without it.
+func canonicalVersion(version string) string {
+	if version != "" && version[0] != 'v' {
+		return "v" + version
+	}
+	return version
+}
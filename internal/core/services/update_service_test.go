@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewerVersion(t *testing.T) {
+	assert.True(t, newerVersion("v1.3.0", "v1.2.9"))
+	assert.True(t, newerVersion("1.3.0", "v1.2.9"), "Tags without the v prefix are compared too")
+	assert.True(t, newerVersion("v1.3.0", "v1.3.0-rc.1"))
+	assert.False(t, newerVersion("v1.2.9", "v1.3.0"))
+	assert.False(t, newerVersion("v1.3.0", "v1.3.0"))
+	assert.False(t, newerVersion("v1.3.0", "dev"), "Development builds are never outdated")
+	assert.False(t, newerVersion("nightly", "v1.3.0"))
+}
+
+func TestUpdateService_Check(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/releases/latest", r.URL.Path)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"tag_name":"v1.3.0","html_url":"https://github.com/owner/repo/releases/tag/v1.3.0","published_at":"2026-10-01T12:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	newService := func(current string) *UpdateService {
+		service := NewUpdateService(current, "owner/repo", 0)
+		service.apiURL = server.URL
+		service.now = func() time.Time { return now }
+		return service
+	}
+	ctx := context.Background()
+
+	t.Run("UpdateAvailable", func(t *testing.T) {
+		service := newService("v1.2.0")
+		assert.Nil(t, service.Status())
+
+		result, err := service.Check(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.UpdateAvailable)
+		assert.Equal(t, "v1.3.0", result.LatestVersion)
+		assert.Equal(t, "https://github.com/owner/repo/releases/tag/v1.3.0", result.ReleaseURL)
+		assert.Equal(t, time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), result.PublishedAt)
+		assert.Equal(t, now, result.CheckedAt)
+		assert.Equal(t, result, service.Status())
+	})
+
+	t.Run("UpToDate", func(t *testing.T) {
+		result, err := newService("v1.3.0").Check(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.UpdateAvailable)
+	})
+
+	t.Run("FailedCheckKeepsStatus", func(t *testing.T) {
+		service := newService("v1.2.0")
+		_, err := service.Check(ctx)
+		require.NoError(t, err)
+
+		status = http.StatusForbidden
+		defer func() { status = http.StatusOK }()
+		_, err = service.Check(ctx)
+		require.Error(t, err)
+		require.NotNil(t, service.Status())
+		assert.True(t, service.Status().UpdateAvailable)
+	})
+}
@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/connectionusage"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"go.uber.org/zap"
+)
+
+// DefaultForecastDays is the number of days of usage samples a forecast is based on if none is configured.
+const DefaultForecastDays = 30
+
+// UsageQuota is the quota of the remote of a connection. Total and Free are nil if the remote doesn't report them.
+type UsageQuota struct {
+	Used  int64
+	Total *int64
+	Free  *int64
+}
+
+// UsageQuotaFunc returns the quota of the remote of a connection, see rclone.GetRemoteQuota.
+type UsageQuotaFunc func(ctx context.Context, connectionName string) (*UsageQuota, error)
+
+// UsageForecast is the forecast of when the remote of a connection runs full at its current growth rate.
+type UsageForecast struct {
+	// DailyGrowth is the growth of the used bytes per day, negative if the usage shrinks.
+	DailyGrowth int64
+	// Free is the number of free bytes of the latest sample, nil if the remote reports neither free nor total bytes.
+	Free *int64
+	// DaysUntilFull is the number of days until the remote is full, nil if the usage doesn't grow or Free is unknown.
+	DaysUntilFull *int
+	// FullAt is the day the remote is expected to be full, nil if DaysUntilFull is.
+	FullAt *time.Time
+	// SampleCount is the number of daily samples the forecast is based on.
+	SampleCount int
+	// Since is the day of the first sample the forecast is based on.
+	Since time.Time
+	// Warning reports whether DaysUntilFull is below the warning threshold.
+	Warning bool
+}
+
+// UsageService records daily samples of the quota of connections and forecasts when their remotes run full.
+type UsageService struct {
+	client       *ent.Client
+	logger       *zap.Logger
+	forecastDays int
+	warningDays  int
+	cron         *cron.Cron
+	now          func() time.Time
+}
+
+// NewUsageService creates a new UsageService instance.
+// Forecasts are based on the samples of the last forecastDays days (DefaultForecastDays if not positive)
+// and warn if a remote runs full within warningDays days; 0 disables warnings.
+func NewUsageService(client *ent.Client, forecastDays, warningDays int) *UsageService {
+	if forecastDays <= 0 {
+		forecastDays = DefaultForecastDays
+	}
+	return &UsageService{
+		client:       client,
+		logger:       logger.Named("service.usage"),
+		forecastDays: forecastDays,
+		warningDays:  max(warningDays, 0),
+		now:          time.Now,
+	}
+}
+
+// Start samples the quota of all connections with the given cron schedule.
+func (s *UsageService) Start(schedule string, quota UsageQuotaFunc) error {
+	s.logger.Info("Starting usage sampling",
+		zap.String("schedule", schedule),
+		zap.Int("forecast_days", s.forecastDays),
+		zap.Int("warning_days", s.warningDays))
+
+	s.cron = cron.New()
+	if _, err := s.cron.AddFunc(schedule, func() {
+		if err := s.SampleAll(context.Background(), quota); err != nil {
+			s.logger.Error("Usage sampling failed", zap.Error(err))
+		}
+	}); err != nil {
+		return err
+	}
+	s.cron.Start()
+	return nil
+}
+
+// Stop stops sampling.
+func (s *UsageService) Stop() {
+	if s.cron != nil {
+		s.logger.Info("Stopping usage sampling")
+		s.cron.Stop()
+		s.cron = nil
+	}
+}
+
+// SampleAll records the quota of every connection whose remote reports one, and logs a warning
+// for each connection forecast to run full within the warning threshold.
+// Connections whose quota can't be read are skipped.
+func (s *UsageService) SampleAll(ctx context.Context, quota UsageQuotaFunc) error {
+	connections, err := s.client.Connection.Query().All(ctx)
+	if err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+
+	sampled := 0
+	for _, conn := range connections {
+		q, err := quota(ctx, conn.Name)
+		if err != nil {
+			s.logger.Debug("Skipping usage sample of connection", zap.String("connection", conn.Name), zap.Error(err))
+			continue
+		}
+		if err := s.RecordUsage(ctx, conn.ID, q); err != nil {
+			s.logger.Error("Failed to record usage sample", zap.String("connection", conn.Name), zap.Error(err))
+			continue
+		}
+		sampled++
+
+		forecast, err := s.Forecast(ctx, conn.ID)
+		if err != nil {
+			s.logger.Error("Failed to forecast usage", zap.String("connection", conn.Name), zap.Error(err))
+			continue
+		}
+		if forecast != nil && forecast.Warning {
+			s.logger.Warn("Connection is forecast to run out of space",
+				zap.String("connection", conn.Name),
+				zap.Int("days_until_full", *forecast.DaysUntilFull),
+				zap.Int64("free_bytes", *forecast.Free),
+				zap.Int64("daily_growth_bytes", forecast.DailyGrowth))
+		}
+	}
+
+	s.logger.Info("Usage sampling completed", zap.Int("connections", len(connections)), zap.Int("sampled", sampled))
+	return nil
+}
+
+// RecordUsage stores the quota as the sample of the current day of the connection,
+// replacing an earlier sample of the same day.
+func (s *UsageService) RecordUsage(ctx context.Context, connectionID uuid.UUID, quota *UsageQuota) error {
+	day := startOfDay(s.now())
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	existing, err := tx.ConnectionUsage.Query().
+		Where(connectionusage.ConnectionID(connectionID), connectionusage.Day(day)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = tx.ConnectionUsage.Create().
+			SetConnectionID(connectionID).
+			SetDay(day).
+			SetUsed(quota.Used).
+			SetNillableTotal(quota.Total).
+			SetNillableFree(quota.Free).
+			Exec(ctx)
+	case err == nil:
+		update := existing.Update().SetUsed(quota.Used)
+		if quota.Total != nil {
+			update.SetTotal(*quota.Total)
+		} else {
+			update.ClearTotal()
+		}
+		if quota.Free != nil {
+			update.SetFree(*quota.Free)
+		} else {
+			update.ClearFree()
+		}
+		err = update.Exec(ctx)
+	}
+	if err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Join(errs.ErrSystem, err)
+	}
+	return nil
+}
+
+// Forecast forecasts when the remote of the connection runs full, from the growth of its samples
+// of the last forecast days. It returns nil if there are fewer than two samples.
+func (s *UsageService) Forecast(ctx context.Context, connectionID uuid.UUID) (*UsageForecast, error) {
+	since := startOfDay(s.now()).AddDate(0, 0, -s.forecastDays)
+	samples, err := s.client.ConnectionUsage.Query().
+		Where(connectionusage.ConnectionID(connectionID), connectionusage.DayGTE(since)).
+		Order(ent.Asc(connectionusage.FieldDay)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(errs.ErrSystem, err)
+	}
+	return forecastUsage(samples, s.warningDays), nil
+}
+
+// forecastUsage fits a line through the used bytes of the samples, ordered by day, with least squares.
+// Its slope is the daily growth, which the free bytes of the latest sample are divided by.
+func forecastUsage(samples []*ent.ConnectionUsage, warningDays int) *UsageForecast {
+	if len(samples) < 2 {
+		return nil
+	}
+
+	first, latest := samples[0], samples[len(samples)-1]
+	var meanX, meanY float64
+	xs := make([]float64, len(samples))
+	for i, sample := range samples {
+		xs[i] = sample.Day.Sub(first.Day).Hours() / 24
+		meanX += xs[i]
+		meanY += float64(sample.Used)
+	}
+	meanX /= float64(len(samples))
+	meanY /= float64(len(samples))
+	var cov, varX float64
+	for i, sample := range samples {
+		cov += (xs[i] - meanX) * (float64(sample.Used) - meanY)
+		varX += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if varX == 0 {
+		return nil
+	}
+	growth := cov / varX
+
+	forecast := &UsageForecast{
+		DailyGrowth: int64(math.Round(growth)),
+		Free:        latest.Free,
+		SampleCount: len(samples),
+		Since:       first.Day,
+	}
+	if forecast.Free == nil && latest.Total != nil {
+		free := max(*latest.Total-latest.Used, 0)
+		forecast.Free = &free
+	}
+	if forecast.Free != nil && growth > 0 {
+		days := int(float64(*forecast.Free) / growth)
+		fullAt := latest.Day.AddDate(0, 0, days)
+		forecast.DaysUntilFull = &days
+		forecast.FullAt = &fullAt
+		forecast.Warning = warningDays > 0 && days < warningDays
+	}
+	return forecast
+}
+
+// startOfDay returns midnight of the day of t in its location.
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/core/db"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ent/enttest"
+)
+
+func TestForecastUsage(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	sample := func(days int, used int64, total, free *int64) *ent.ConnectionUsage {
+		return &ent.ConnectionUsage{Day: day.AddDate(0, 0, days), Used: used, Total: total, Free: free}
+	}
+	ptr := func(v int64) *int64 { return &v }
+
+	t.Run("NotEnoughSamples", func(t *testing.T) {
+		assert.Nil(t, forecastUsage(nil, 0))
+		assert.Nil(t, forecastUsage([]*ent.ConnectionUsage{sample(0, 100, nil, ptr(1000))}, 0))
+	})
+
+	t.Run("Growing", func(t *testing.T) {
+		// 100 bytes per day with a missed sample, 1000 bytes free on the latest day
+		forecast := forecastUsage([]*ent.ConnectionUsage{
+			sample(0, 1000, nil, nil),
+			sample(1, 1100, nil, nil),
+			sample(2, 1200, nil, nil),
+			sample(4, 1400, nil, ptr(1000)),
+		}, 14)
+		require.NotNil(t, forecast)
+		assert.Equal(t, int64(100), forecast.DailyGrowth)
+		assert.Equal(t, 4, forecast.SampleCount)
+		assert.Equal(t, day, forecast.Since)
+		require.NotNil(t, forecast.DaysUntilFull)
+		assert.Equal(t, 10, *forecast.DaysUntilFull)
+		assert.Equal(t, day.AddDate(0, 0, 14), *forecast.FullAt)
+		assert.True(t, forecast.Warning)
+	})
+
+	t.Run("FreeFromTotal", func(t *testing.T) {
+		forecast := forecastUsage([]*ent.ConnectionUsage{
+			sample(0, 1000, ptr(5000), nil),
+			sample(1, 1500, ptr(5000), nil),
+		}, 3)
+		require.NotNil(t, forecast)
+		assert.Equal(t, int64(3500), *forecast.Free)
+		assert.Equal(t, 7, *forecast.DaysUntilFull)
+		assert.False(t, forecast.Warning)
+	})
+
+	t.Run("NotGrowing", func(t *testing.T) {
+		forecast := forecastUsage([]*ent.ConnectionUsage{
+			sample(0, 2000, nil, ptr(1000)),
+			sample(1, 1500, nil, ptr(1500)),
+		}, 14)
+		require.NotNil(t, forecast)
+		assert.Equal(t, int64(-500), forecast.DailyGrowth)
+		assert.Nil(t, forecast.DaysUntilFull)
+		assert.Nil(t, forecast.FullAt)
+		assert.False(t, forecast.Warning)
+	})
+
+	t.Run("FreeUnknown", func(t *testing.T) {
+		forecast := forecastUsage([]*ent.ConnectionUsage{
+			sample(0, 1000, nil, nil),
+			sample(1, 1500, nil, nil),
+		}, 14)
+		require.NotNil(t, forecast)
+		assert.Equal(t, int64(500), forecast.DailyGrowth)
+		assert.Nil(t, forecast.Free)
+		assert.Nil(t, forecast.DaysUntilFull)
+	})
+}
+
+func TestUsageService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", db.InMemoryDSN())
+	defer client.Close()
+
+	connService := createTestConnService(t, client)
+	ctx := context.Background()
+	full, err := connService.CreateConnection(ctx, "filling", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	_, err = connService.CreateConnection(ctx, "no-quota", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	service := NewUsageService(client, 0, 14)
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local)
+	service.now = func() time.Time { return now }
+
+	used := int64(1000)
+	free := int64(2000)
+	quota := func(_ context.Context, connectionName string) (*UsageQuota, error) {
+		if connectionName != "filling" {
+			return nil, errors.New("remote does not support quota information")
+		}
+		return &UsageQuota{Used: used, Free: &free}, nil
+	}
+
+	// A single day of samples is not enough for a forecast
+	require.NoError(t, service.SampleAll(ctx, quota))
+	forecast, err := service.Forecast(ctx, full.ID)
+	require.NoError(t, err)
+	assert.Nil(t, forecast)
+
+	// A later sample of the same day replaces the earlier one
+	used, free = 1200, 1800
+	now = now.Add(6 * time.Hour)
+	require.NoError(t, service.SampleAll(ctx, quota))
+	samples, err := client.ConnectionUsage.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	assert.Equal(t, int64(1200), samples[0].Used)
+	assert.Equal(t, full.ID, samples[0].ConnectionID)
+
+	used, free = 1400, 1600
+	now = now.AddDate(0, 0, 1)
+	require.NoError(t, service.SampleAll(ctx, quota))
+	forecast, err = service.Forecast(ctx, full.ID)
+	require.NoError(t, err)
+	require.NotNil(t, forecast)
+	assert.Equal(t, int64(200), forecast.DailyGrowth)
+	assert.Equal(t, 8, *forecast.DaysUntilFull)
+	assert.True(t, forecast.Warning)
+
+	// Samples older than the forecast days are not part of the forecast
+	now = now.AddDate(0, 0, DefaultForecastDays)
+	forecast, err = service.Forecast(ctx, full.ID)
+	require.NoError(t, err)
+	assert.Nil(t, forecast)
+}
@@ -0,0 +1,137 @@
+// Package tracing provides OpenTelemetry tracing for the application.
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+)
+
+// instrumentationPrefix prefixes the names of the tracers returned by Tracer.
+const instrumentationPrefix = "github.com/xzzpig/rclone-sync/"
+
+// DefaultServiceName is the service name reported with the spans if none is configured.
+const DefaultServiceName = "rclone-sync"
+
+// ErrInvalidEndpoint is returned for an endpoint that is not an http:// or https:// URL.
+const ErrInvalidEndpoint = errs.ConstError("tracing endpoint must be an http:// or https:// URL")
+
+// provider is the SDK tracer provider installed by Init, nil if tracing is disabled.
+var (
+	provider   *sdktrace.TracerProvider
+	providerMu sync.Mutex
+)
+
+// Options configures exporting traces.
+type Options struct {
+	// Endpoint is the OTLP/HTTP endpoint URL traces are exported to (e.g. "http://localhost:4318"),
+	// tracing is disabled if empty. An http:// endpoint is used without TLS.
+	Endpoint string
+	// ServiceName is the service name reported with the spans, DefaultServiceName if empty.
+	ServiceName string
+	// SampleRatio is the fraction of new traces that are recorded, between 0 and 1.
+	// Traces continued from an incoming request follow the sampling decision of the caller.
+	SampleRatio float64
+}
+
+// Init installs the W3C trace context propagator and, if opts.Endpoint is set, a tracer provider
+// that exports spans in batches to the OTLP endpoint. Without an endpoint spans are not recorded,
+// but trace contexts of incoming requests are still propagated.
+func Init(ctx context.Context, opts Options) error {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if opts.Endpoint == "" {
+		return nil
+	}
+
+	// The exporter only logs an invalid endpoint, so it is checked here to fail at startup
+	if u, err := url.Parse(opts.Endpoint); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return ErrInvalidEndpoint
+	}
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.Endpoint))
+	if err != nil {
+		return err
+	}
+	serviceName := opts.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+
+	log := logger.Named("core.tracing")
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Warn("OpenTelemetry error", zap.Error(err))
+	}))
+	otel.SetTracerProvider(tp)
+
+	providerMu.Lock()
+	provider = tp
+	providerMu.Unlock()
+
+	log.Info("Tracing enabled", zap.String("endpoint", opts.Endpoint), zap.Float64("sample_ratio", opts.SampleRatio))
+	return nil
+}
+
+// Shutdown exports the remaining spans and stops the tracer provider installed by Init.
+// It does nothing if tracing is disabled.
+func Shutdown(ctx context.Context) error {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	if provider == nil {
+		return nil
+	}
+
+	err := provider.Shutdown(ctx)
+	provider = nil
+	return err
+}
+
+// Tracer returns the tracer of a component, named like its logger (e.g. "core.runner").
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(instrumentationPrefix + name)
+}
+
+// TraceID returns the hex encoded ID of the trace the context belongs to, or an empty string if it has none.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+// Detach returns a context that carries only the span of ctx, without its values, deadline and cancellation.
+// It is used for work that outlives the request it was started by but belongs to the same trace.
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+}
+
+// RecordError records err as the error status of the span. It does nothing if err is nil.
+func RecordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// End records err (if any) as the status of the span and ends it.
+func End(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceID(t *testing.T) {
+	assert.Empty(t, TraceID(context.Background()))
+
+	traceID := trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	}))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", TraceID(ctx))
+}
+
+func TestDetach(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, parent := tracer.Start(ctx, "request")
+	detached := Detach(ctx)
+	cancel()
+	parent.End()
+
+	assert.NoError(t, detached.Err(), "detached context is not cancelled with its parent")
+	_, child := tracer.Start(detached, "run")
+	child.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
+func TestEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, ok := tracer.Start(context.Background(), "ok")
+	End(ok, nil)
+	_, failed := tracer.Start(context.Background(), "failed")
+	End(failed, errors.New("database is locked"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "database is locked", spans[1].Status().Description)
+}
+
+func TestInit(t *testing.T) {
+	original := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	t.Run("Disabled", func(t *testing.T) {
+		require.NoError(t, Init(context.Background(), Options{}))
+		assert.Nil(t, provider)
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("Endpoint", func(t *testing.T) {
+		// Nothing is exported without spans, so the endpoint need not be reachable
+		require.NoError(t, Init(context.Background(), Options{Endpoint: "http://127.0.0.1:4318", SampleRatio: 1}))
+		assert.NotNil(t, provider)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.NoError(t, Shutdown(ctx))
+		assert.Nil(t, provider)
+	})
+
+	t.Run("InvalidEndpoint", func(t *testing.T) {
+		assert.ErrorIs(t, Init(context.Background(), Options{Endpoint: "localhost:4318"}), ErrInvalidEndpoint)
+		assert.Nil(t, provider)
+	})
+}
@@ -0,0 +1,52 @@
+// Package version reports the version of the running build.
+//
+// Release builds set the variables with the linker:
+//
+//	go build -ldflags "-X github.com/xzzpig/rclone-sync/internal/core/version.Version=v1.2.3 ..."
+//
+// Builds without them fall back to the VCS information Go embeds into the binary.
+package version
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+// Version, Commit and BuildDate are set by the linker in release builds.
+var (
+	// Version is the release version, e.g. v1.2.3.
+	Version = "dev"
+	// Commit is the git commit the binary was built from.
+	Commit = ""
+	// BuildDate is the RFC 3339 time the binary was built at.
+	BuildDate = ""
+)
+
+// Info describes the running build.
+type Info struct {
+	Version   string
+	Commit    string
+	BuildDate string
+}
+
+// Get returns the version of the running build.
+var Get = sync.OnceValue(func() Info {
+	info := Info{Version: Version, Commit: Commit, BuildDate: BuildDate}
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	if info.Version == "dev" && build.Main.Version != "" && build.Main.Version != "(devel)" {
+		info.Version = build.Main.Version
+	}
+	for _, setting := range build.Settings {
+		switch {
+		case setting.Key == "vcs.revision" && info.Commit == "":
+			info.Commit = setting.Value
+		case setting.Key == "vcs.time" && info.BuildDate == "":
+			// The commit time is the closest to a build date builds without ldflags have
+			info.BuildDate = setting.Value
+		}
+	}
+	return info
+})
@@ -0,0 +1,74 @@
+package watcher
+
+import (
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+// taskIgnorePatterns returns the watcher ignore patterns of a task,
+// falling back to defaults when the task does not set its own.
+func taskIgnorePatterns(task *ent.Task, defaults []string) []string {
+	if task.Options != nil && len(task.Options.WatchIgnorePatterns) > 0 {
+		return task.Options.WatchIgnorePatterns
+	}
+	return defaults
+}
+
+// taskExcludeDirs returns the cleaned, slash-separated paths of the
+// directories a task excludes from watching, relative to its source path.
+func taskExcludeDirs(task *ent.Task) []string {
+	if task.Options == nil {
+		return nil
+	}
+	var dirs []string
+	for _, dir := range task.Options.WatchExcludeDirs {
+		dir = path.Clean(filepath.ToSlash(dir))
+		if dir != "." && !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// matchExclude reports whether rel, a path relative to the watched source path,
+// is one of the excluded directories or inside one.
+func matchExclude(dirs []string, rel string) bool {
+	rel = filepath.ToSlash(rel)
+	for _, dir := range dirs {
+		if rel == dir || strings.HasPrefix(rel, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIgnore reports whether rel, a path relative to the watched source path,
+// matches one of the glob patterns. Patterns without a "/" match any single
+// path element (so "*.part" also ignores files inside "foo.part/"), patterns
+// with a "/" match the whole relative path. Malformed patterns never match.
+func matchIgnore(patterns []string, rel string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+
+	rel = filepath.ToSlash(rel)
+	elems := strings.Split(rel, "/")
+	for _, pattern := range patterns {
+		if strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(strings.TrimPrefix(pattern, "/"), rel); ok {
+				return true
+			}
+			continue
+		}
+		for _, elem := range elems {
+			if ok, _ := path.Match(pattern, elem); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package watcher
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestMatchIgnore(t *testing.T) {
+	patterns := []string{"*.part", "*.swp", "*~", "cache/*", "/build/out.log", "[bad"}
+
+	tests := []struct {
+		rel  string
+		want bool
+	}{
+		{"movie.mkv.part", true},
+		{"docs/.notes.txt.swp", true},
+		{"docs/report.txt~", true},
+		{"download.part/chunk-1", true},
+		{"cache/blob", true},
+		{"cache/nested/blob", false},
+		{"sub/cache/blob", false},
+		{"build/out.log", true},
+		{"movie.mkv", false},
+		{"docs/report.txt", false},
+		{"[bad", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rel, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchIgnore(patterns, filepath.FromSlash(tt.rel)))
+		})
+	}
+
+	assert.False(t, matchIgnore(nil, "movie.mkv.part"))
+}
+
+func TestTaskIgnorePatterns(t *testing.T) {
+	defaults := []string{"*.part"}
+
+	assert.Equal(t, defaults, taskIgnorePatterns(&ent.Task{}, defaults))
+	assert.Equal(t, defaults, taskIgnorePatterns(&ent.Task{Options: &model.TaskSyncOptions{}}, defaults))
+	assert.Equal(t, []string{"*.bak"}, taskIgnorePatterns(&ent.Task{
+		Options: &model.TaskSyncOptions{WatchIgnorePatterns: []string{"*.bak"}},
+	}, defaults))
+}
+
+func TestWatcher_IgnoredEventsSkipDebounce(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	tempDir := t.TempDir()
+
+	defaultTask := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	overrideTask := &ent.Task{
+		ID:         uuid.New(),
+		Realtime:   true,
+		SourcePath: tempDir,
+		Options:    &model.TaskSyncOptions{WatchIgnorePatterns: []string{"*.bak"}},
+	}
+	mockFW.On("Add", tempDir).Return(nil)
+
+	w := newWatcher(new(MockTaskService), new(MockRunner), mockFW)
+	w.defaultIgnore = []string{"*.part"}
+	require.NoError(t, w.AddTask(defaultTask))
+	require.NoError(t, w.AddTask(overrideTask))
+
+	// Ignored by every task: no debounce timer is started
+	w.handleEvent(fsnotify.Event{Name: filepath.Join(tempDir, "dl.part", "chunk.bak"), Op: fsnotify.Write})
+	assert.Empty(t, w.debounce)
+
+	// The override replaces the defaults, so only the default task ignores *.part
+	w.handleEvent(fsnotify.Event{Name: filepath.Join(tempDir, "movie.part"), Op: fsnotify.Create})
+	assert.Contains(t, w.debounce, overrideTask.ID.String())
+	assert.NotContains(t, w.debounce, defaultTask.ID.String())
+
+	for _, timer := range w.debounce {
+		timer.Stop()
+	}
+}
+
+func TestWatcher_AddTaskUpdatesIgnorePatterns(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	tempDir := t.TempDir()
+
+	task := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	mockFW.On("Add", tempDir).Return(nil).Once()
+
+	w := newWatcher(new(MockTaskService), new(MockRunner), mockFW)
+	require.NoError(t, w.AddTask(task))
+	assert.Empty(t, w.ignoreMap[task.ID.String()])
+
+	// Re-adding with the same source path only swaps the patterns, the tree is not re-watched
+	task.Options = &model.TaskSyncOptions{WatchIgnorePatterns: []string{"*.bak"}}
+	require.NoError(t, w.AddTask(task))
+	assert.Equal(t, []string{"*.bak"}, w.ignoreMap[task.ID.String()])
+
+	mockFW.AssertExpectations(t)
+}
+
+func TestTaskExcludeDirs(t *testing.T) {
+	assert.Empty(t, taskExcludeDirs(&ent.Task{}))
+	assert.Equal(t, []string{"cache", "build/tmp"}, taskExcludeDirs(&ent.Task{
+		Options: &model.TaskSyncOptions{WatchExcludeDirs: []string{"cache/", "./build/tmp", ".", "cache"}},
+	}))
+}
+
+func TestMatchExclude(t *testing.T) {
+	dirs := []string{"cache", "build/tmp"}
+
+	assert.True(t, matchExclude(dirs, "cache"))
+	assert.True(t, matchExclude(dirs, filepath.FromSlash("cache/blob")))
+	assert.True(t, matchExclude(dirs, filepath.FromSlash("build/tmp/out.o")))
+	assert.False(t, matchExclude(dirs, "cache.txt"))
+	assert.False(t, matchExclude(dirs, filepath.FromSlash("build/out.o")))
+	assert.False(t, matchExclude(nil, "cache"))
+}
+
+func TestWatcher_ExcludedEventsSkipDebounce(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	tempDir := t.TempDir()
+
+	excludingTask := &ent.Task{
+		ID:         uuid.New(),
+		Realtime:   true,
+		SourcePath: tempDir,
+		Options:    &model.TaskSyncOptions{WatchExcludeDirs: []string{"cache"}},
+	}
+	otherTask := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	mockFW.On("Add", tempDir).Return(nil)
+
+	w := newWatcher(new(MockTaskService), new(MockRunner), mockFW)
+	require.NoError(t, w.AddTask(excludingTask))
+	require.NoError(t, w.AddTask(otherTask))
+
+	// The tree is still watched for the other task, whose events must not trigger the excluding task
+	w.handleEvent(fsnotify.Event{Name: filepath.Join(tempDir, "cache", "blob"), Op: fsnotify.Write})
+	assert.Contains(t, w.debounce, otherTask.ID.String())
+	assert.NotContains(t, w.debounce, excludingTask.ID.String())
+
+	for _, timer := range w.debounce {
+		timer.Stop()
+	}
+}
+
+func TestWatcher_AddTaskRewatchesOnExcludeChange(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	tempDir := t.TempDir()
+
+	task := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	mockFW.On("Add", tempDir).Return(nil).Twice()
+	mockFW.On("Remove", tempDir).Return(nil).Once()
+
+	w := newWatcher(new(MockTaskService), new(MockRunner), mockFW)
+	require.NoError(t, w.AddTask(task))
+
+	// Changing the excluded directories re-watches the tree
+	task.Options = &model.TaskSyncOptions{WatchExcludeDirs: []string{"cache"}}
+	require.NoError(t, w.AddTask(task))
+	assert.Equal(t, []string{"cache"}, w.excludeMap[task.ID.String()])
+
+	mockFW.AssertExpectations(t)
+}
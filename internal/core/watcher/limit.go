@@ -0,0 +1,45 @@
+package watcher
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// inotifyWatchLimitPath is the file the per-user inotify watch limit is read from.
+var inotifyWatchLimitPath = "/proc/sys/fs/inotify/max_user_watches"
+
+// MinSuggestedWatchLimit is the lowest limit suggested when the watch limit is reached.
+// Lower limits are often reached by realtime tasks watching large trees.
+const MinSuggestedWatchLimit = 524288
+
+// WatchLimitError is returned when a directory can't be watched since the
+// inotify watch limit was reached. The directories watched before stay watched.
+type WatchLimitError struct {
+	Path  string // Directory that could not be watched
+	Limit int    // Current watch limit, 0 if unknown
+	Used  int    // Watches in use by this watcher
+}
+
+func (e *WatchLimitError) Error() string {
+	return fmt.Sprintf("inotify watch limit reached watching %s (limit %d, %d watches in use)", e.Path, e.Limit, e.Used)
+}
+
+// Sysctl returns the suggested command raising the watch limit.
+func (e *WatchLimitError) Sysctl() string {
+	return fmt.Sprintf("sysctl -w fs.inotify.max_user_watches=%d", max(2*e.Limit, MinSuggestedWatchLimit))
+}
+
+// WatchLimit returns the per-user inotify watch limit, 0 if it can't be read (e.g. on platforms without inotify).
+func WatchLimit() int {
+	data, err := os.ReadFile(inotifyWatchLimitPath)
+	if err != nil {
+		return 0
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0
+	}
+	return limit
+}
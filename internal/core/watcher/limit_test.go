@@ -0,0 +1,140 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestWatchLimitError_Sysctl(t *testing.T) {
+	assert.Equal(t, "sysctl -w fs.inotify.max_user_watches=524288", (&WatchLimitError{Limit: 8192}).Sysctl())
+	assert.Equal(t, "sysctl -w fs.inotify.max_user_watches=524288", (&WatchLimitError{}).Sysctl())
+	assert.Equal(t, "sysctl -w fs.inotify.max_user_watches=1048576", (&WatchLimitError{Limit: 524288}).Sysctl())
+}
+
+func TestWatchLimit(t *testing.T) {
+	original := inotifyWatchLimitPath
+	t.Cleanup(func() { inotifyWatchLimitPath = original })
+
+	inotifyWatchLimitPath = filepath.Join(t.TempDir(), "max_user_watches")
+	assert.Equal(t, 0, WatchLimit())
+
+	require.NoError(t, os.WriteFile(inotifyWatchLimitPath, []byte("8192\n"), 0644))
+	assert.Equal(t, 8192, WatchLimit())
+}
+
+func TestRecursiveWatcher_WatchLimit(t *testing.T) {
+	setupTest(t)
+	rw, err := NewRecursiveWatcher()
+	require.NoError(t, err)
+	defer rw.Close()
+
+	tmpDir := t.TempDir()
+	full := filepath.Join(tmpDir, "b")
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "a"), 0755))
+	require.NoError(t, os.Mkdir(full, 0755))
+
+	add := rw.addWatch
+	rw.addWatch = func(path string) error {
+		if path == full {
+			return syscall.ENOSPC
+		}
+		return add(path)
+	}
+
+	// The directories walked before the limit was reached stay watched
+	err = rw.Add(tmpDir)
+	var limitErr *WatchLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, full, limitErr.Path)
+	assert.Equal(t, 2, limitErr.Used)
+
+	rw.mu.Lock()
+	assert.Equal(t, map[string]int{tmpDir: 1, filepath.Join(tmpDir, "a"): 1}, rw.watchedDirs)
+	assert.Len(t, rw.roots, 1)
+	rw.mu.Unlock()
+
+	require.NoError(t, rw.Remove(tmpDir))
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	assert.Empty(t, rw.watchedDirs)
+	assert.Empty(t, rw.roots)
+}
+
+func TestWatcher_WatchLimitWarning(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	mockTaskSvc := new(MockTaskService)
+	tempDir := t.TempDir()
+	otherDir := t.TempDir()
+
+	task := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	otherTask := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: otherDir}
+	limitErr := &WatchLimitError{Path: filepath.Join(tempDir, "deep"), Limit: 8192, Used: 8192}
+	mockFW.On("Add", tempDir).Return(limitErr).Once()
+	mockFW.On("Add", otherDir).Return(nil).Once()
+	mockFW.On("Remove", tempDir).Return(nil).Once()
+	mockTaskSvc.On("RecordWatchLimitReached", mock.Anything, task.ID, mock.MatchedBy(func(message string) bool {
+		return assert.Contains(t, message, "sysctl -w fs.inotify.max_user_watches=524288")
+	})).Return(nil).Once()
+
+	w := newWatcher(mockTaskSvc, new(MockRunner), mockFW)
+
+	// A partially watched task is still watched, with a warning
+	require.NoError(t, w.AddTask(task))
+	require.NoError(t, w.AddTask(otherTask))
+	assert.Contains(t, w.watchMap, task.ID.String())
+	warning := w.WatchWarning(task.ID)
+	require.NotNil(t, warning)
+	assert.Equal(t, limitErr.Path, warning.Path)
+	assert.Equal(t, 8192, *warning.Limit)
+	assert.Equal(t, 8192, warning.Used)
+	assert.Nil(t, w.WatchWarning(otherTask.ID))
+
+	// Directories created later only warn the tasks containing them, and only once
+	w.handleWatchLimit(&WatchLimitError{Path: filepath.Join(tempDir, "new"), Limit: 8192, Used: 8192})
+	assert.Nil(t, w.WatchWarning(otherTask.ID))
+
+	require.NoError(t, w.RemoveTask(task))
+	assert.Nil(t, w.WatchWarning(task.ID))
+
+	mockFW.AssertExpectations(t)
+	mockTaskSvc.AssertExpectations(t)
+}
+
+func TestWatcher_WatchLimitOfCreatedDirectory(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	mockTaskSvc := new(MockTaskService)
+	tempDir := t.TempDir()
+
+	task := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	excludingTask := &ent.Task{
+		ID:         uuid.New(),
+		Realtime:   true,
+		SourcePath: tempDir,
+		Options:    &model.TaskSyncOptions{WatchExcludeDirs: []string{"cache"}},
+	}
+	mockFW.On("Add", tempDir).Return(nil)
+	mockTaskSvc.On("RecordWatchLimitReached", mock.Anything, task.ID, mock.Anything).Return(nil).Once()
+
+	w := newWatcher(mockTaskSvc, new(MockRunner), mockFW)
+	require.NoError(t, w.AddTask(task))
+	require.NoError(t, w.AddTask(excludingTask))
+
+	// Directories in excluded trees don't concern the excluding task
+	w.handleWatchLimit(&WatchLimitError{Path: filepath.Join(tempDir, "cache", "new"), Used: 10})
+	require.NotNil(t, w.WatchWarning(task.ID))
+	assert.Nil(t, w.WatchWarning(task.ID).Limit)
+	assert.Nil(t, w.WatchWarning(excludingTask.ID))
+
+	mockTaskSvc.AssertExpectations(t)
+}
@@ -2,11 +2,14 @@
 package watcher
 
 import (
+	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+	"syscall"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
@@ -18,6 +21,7 @@ import (
 // and reference counting for shared paths.
 type RecursiveWatcher struct {
 	fsWatcher *fsnotify.Watcher
+	addWatch  func(string) error // Adds a single directory to fsWatcher
 	logger    *zap.Logger
 	mu        sync.Mutex
 
@@ -25,6 +29,10 @@ type RecursiveWatcher struct {
 	// path -> count
 	watchedDirs map[string]int
 
+	// roots are the added directory trees, one entry per Add call,
+	// used to watch the directories created in them later
+	roots []watchRoot
+
 	// Events and Errors channels forward events from fsnotify
 	events chan fsnotify.Event
 	errors chan error
@@ -48,6 +56,8 @@ func NewRecursiveWatcher() (*RecursiveWatcher, error) {
 		done:        make(chan struct{}),
 	}
 
+	rw.addWatch = fsWatcher.Add
+
 	go rw.loop()
 
 	return rw, nil
@@ -69,8 +79,42 @@ func (rw *RecursiveWatcher) Close() error {
 	return rw.fsWatcher.Close()
 }
 
-// Add recursively watches a directory and its subdirectories
-func (rw *RecursiveWatcher) Add(root string) error {
+// watchRoot is a watched directory tree without its excluded subtrees.
+type watchRoot struct {
+	path    string
+	exclude []string // Absolute paths of the excluded directories
+}
+
+func newWatchRoot(root string, exclude []string) watchRoot {
+	r := watchRoot{path: filepath.Clean(root)}
+	for _, dir := range exclude {
+		r.exclude = append(r.exclude, filepath.Join(r.path, filepath.FromSlash(dir)))
+	}
+	return r
+}
+
+// covers reports whether path is inside the tree and not excluded.
+func (r watchRoot) covers(path string) bool {
+	if !isWithin(r.path, path) {
+		return false
+	}
+	return !slices.ContainsFunc(r.exclude, func(dir string) bool { return isWithin(dir, path) })
+}
+
+func (r watchRoot) equal(o watchRoot) bool {
+	return r.path == o.path && slices.Equal(r.exclude, o.exclude)
+}
+
+// isWithin reports whether path is dir or inside it.
+func isWithin(dir, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(os.PathSeparator))
+}
+
+// Add recursively watches a directory and its subdirectories, except the
+// excluded subdirectories (paths relative to root) and everything below them.
+// When the inotify watch limit is reached a *WatchLimitError is returned, the
+// directories watched until then stay watched and must still be removed.
+func (rw *RecursiveWatcher) Add(root string, exclude ...string) error {
 	rw.mu.Lock()
 	defer rw.mu.Unlock()
 
@@ -84,30 +128,41 @@ func (rw *RecursiveWatcher) Add(root string) error {
 	}
 
 	// Walk and add all subdirectories
-	return rw.addRecursiveLocked(root)
+	r := newWatchRoot(root, exclude)
+	err = rw.addRecursiveLocked(r, r.path)
+	var limitErr *WatchLimitError
+	if err != nil && !errors.As(err, &limitErr) {
+		return err
+	}
+	rw.roots = append(rw.roots, r)
+	return err
 }
 
 // Remove stops watching a directory (decrementing reference count)
 // It recursively removes watches for subdirectories based on internal state.
-func (rw *RecursiveWatcher) Remove(root string) error {
+// exclude must be the same as when the directory was added.
+func (rw *RecursiveWatcher) Remove(root string, exclude ...string) error {
 	rw.mu.Lock()
 	defer rw.mu.Unlock()
 
 	// This handles cases where the directory was deleted before we could walk it.
-	root = filepath.Clean(root)
+	r := newWatchRoot(root, exclude)
 	for path := range rw.watchedDirs {
-		if path == root || strings.HasPrefix(path, root+string(os.PathSeparator)) {
+		if r.covers(path) {
 			rw.removeDirLocked(path)
 		}
 	}
+	if i := slices.IndexFunc(rw.roots, r.equal); i >= 0 {
+		rw.roots = slices.Delete(rw.roots, i, i+1)
+	}
 
 	return nil
 }
 
-// addRecursiveLocked recursively adds a directory and its subdirectories.
+// addRecursiveLocked recursively adds dir and its subdirectories that are covered by r.
 // Caller must hold rw.mu.
-func (rw *RecursiveWatcher) addRecursiveLocked(root string) error {
-	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+func (rw *RecursiveWatcher) addRecursiveLocked(r watchRoot, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			// Skip errors for individual files/dirs but log them
 			rw.logger.Warn("Error walking path", zap.String("path", path), zap.Error(err))
@@ -115,6 +170,10 @@ func (rw *RecursiveWatcher) addRecursiveLocked(root string) error {
 		}
 
 		if d.IsDir() {
+			// Excluded trees are skipped entirely, so they use no watches
+			if !r.covers(path) {
+				return filepath.SkipDir
+			}
 			if err := rw.addDirLocked(path); err != nil {
 				return err
 			}
@@ -136,9 +195,12 @@ func (rw *RecursiveWatcher) addDirLocked(path string) error {
 	rw.watchedDirs[path] = count + 1
 
 	if count == 0 {
-		if err := rw.fsWatcher.Add(path); err != nil {
+		if err := rw.addWatch(path); err != nil {
 			// If we fail to add, rollback count
 			delete(rw.watchedDirs, path)
+			if errors.Is(err, syscall.ENOSPC) {
+				return &WatchLimitError{Path: path, Limit: WatchLimit(), Used: len(rw.watchedDirs)}
+			}
 			return err
 		}
 		rw.logger.Debug("Added watch", zap.String("path", path))
@@ -185,9 +247,22 @@ func (rw *RecursiveWatcher) loop() {
 				// If it's a directory, add it recursively
 				info, err := os.Stat(event.Name)
 				if err == nil && info.IsDir() {
+					var limitErr *WatchLimitError
 					rw.mu.Lock()
-					_ = rw.addRecursiveLocked(event.Name)
+					for _, r := range rw.roots {
+						if !r.covers(event.Name) {
+							continue
+						}
+						var e *WatchLimitError
+						if err := rw.addRecursiveLocked(r, event.Name); errors.As(err, &e) {
+							limitErr = e
+						}
+					}
 					rw.mu.Unlock()
+					// Reported without holding the lock, the receiver may be adding directories
+					if limitErr != nil {
+						rw.errors <- limitErr
+					}
 				}
 			}
 			// Note: fsnotify automatically removes watches for deleted directories
@@ -1,6 +1,7 @@
 package watcher
 
 import (
+	"maps"
 	"os"
 	"path/filepath"
 	"testing"
@@ -45,7 +46,7 @@ func TestRecursiveWatcher_RecursiveAdd(t *testing.T) {
 	// Expect StartTask to be called when we touch a file deep inside
 	mockRunner.On("StartTask", task, model.JobTriggerRealtime).Return(nil)
 
-	w, err := NewWatcher(mockTaskSvc, mockRunner)
+	w, err := NewWatcher(mockTaskSvc, mockRunner, nil)
 	assert.NoError(t, err)
 
 	w.Start()
@@ -85,7 +86,7 @@ func TestRecursiveWatcher_DynamicAdd(t *testing.T) {
 	mockTaskSvc.On("ListAllTasks", mock.Anything).Return([]*ent.Task{}, nil) // Add this line
 	mockRunner.On("StartTask", task, model.JobTriggerRealtime).Return(nil)
 
-	w, err := NewWatcher(mockTaskSvc, mockRunner)
+	w, err := NewWatcher(mockTaskSvc, mockRunner, nil)
 	assert.NoError(t, err)
 
 	w.Start()
@@ -175,3 +176,67 @@ func TestRecursiveWatcher_RefCount(t *testing.T) {
 	rw.mu.Unlock()
 	assert.False(t, ok, "Entry should be removed from map after count reaches 0")
 }
+
+func TestRecursiveWatcher_Exclude(t *testing.T) {
+	setupTest(t)
+	rw, err := NewRecursiveWatcher()
+	assert.NoError(t, err)
+	defer rw.Close()
+
+	tmpDir := t.TempDir()
+	cacheDir := filepath.Join(tmpDir, "cache")
+	assert.NoError(t, os.MkdirAll(filepath.Join(cacheDir, "nested"), 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "docs"), 0755))
+
+	watched := func() map[string]int {
+		rw.mu.Lock()
+		defer rw.mu.Unlock()
+		return maps.Clone(rw.watchedDirs)
+	}
+
+	// Excluded trees are not watched at all
+	assert.NoError(t, rw.Add(tmpDir, "cache"))
+	assert.Equal(t, map[string]int{tmpDir: 1, filepath.Join(tmpDir, "docs"): 1}, watched())
+
+	// Another root sharing the tree without the exclusion watches it
+	assert.NoError(t, rw.Add(tmpDir))
+	assert.Equal(t, 1, watched()[cacheDir])
+	assert.Equal(t, 1, watched()[filepath.Join(cacheDir, "nested")])
+
+	// Removing the excluding root leaves the watches of the other root intact
+	assert.NoError(t, rw.Remove(tmpDir, "cache"))
+	assert.Equal(t, map[string]int{
+		tmpDir:                            1,
+		filepath.Join(tmpDir, "docs"):     1,
+		cacheDir:                          1,
+		filepath.Join(cacheDir, "nested"): 1,
+	}, watched())
+	assert.NoError(t, rw.Remove(tmpDir))
+	assert.Empty(t, watched())
+}
+
+func TestRecursiveWatcher_ExcludeDynamicAdd(t *testing.T) {
+	setupTest(t)
+	rw, err := NewRecursiveWatcher()
+	assert.NoError(t, err)
+	defer rw.Close()
+
+	tmpDir := t.TempDir()
+	assert.NoError(t, rw.Add(tmpDir, "cache"))
+
+	// Directories created later are only watched outside the excluded trees
+	assert.NoError(t, os.Mkdir(filepath.Join(tmpDir, "cache"), 0755))
+	assert.NoError(t, os.Mkdir(filepath.Join(tmpDir, "docs"), 0755))
+	for range 2 {
+		select {
+		case <-rw.Events():
+		case <-time.After(1 * time.Second):
+			t.Fatal("timeout waiting for event")
+		}
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	assert.Contains(t, rw.watchedDirs, filepath.Join(tmpDir, "docs"))
+	assert.NotContains(t, rw.watchedDirs, filepath.Join(tmpDir, "cache"))
+}
@@ -2,7 +2,9 @@ package watcher
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -13,18 +15,23 @@ import (
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"go.uber.org/zap"
 )
 
 // FileWatcher defines the interface for watching files.
 type FileWatcher interface {
-	Add(string) error
-	Remove(string) error
+	Add(root string, exclude ...string) error
+	Remove(root string, exclude ...string) error
 	Close() error
 	Events() chan fsnotify.Event
 	Errors() chan error
 }
 
+// maxBatchPaths is the number of event paths recorded in the trigger detail of a realtime run.
+const maxBatchPaths = 20
+
 // Watcher monitors file system changes for realtime sync tasks.
 type Watcher struct {
 	recWatcher FileWatcher
@@ -32,18 +39,28 @@ type Watcher struct {
 	runner     ports.Runner
 	logger     *zap.Logger
 	mu         sync.Mutex
-	watchMap   map[string]string // Maps task ID to source path
+	watchMap   map[string]string              // Maps task ID to source path
+	ignoreMap  map[string][]string            // Maps task ID to its ignore patterns
+	excludeMap map[string][]string            // Maps task ID to its unwatched directories
+	warnings   map[string]*model.WatchWarning // Maps task ID to its watch limit warning
 	debounce   map[string]*time.Timer
+	batches    map[string]*model.JobTriggerDetail // Events of each task collected until its debounce timer fires
 	running    bool
+	// defaultIgnore are the ignore patterns of tasks that do not set their own
+	defaultIgnore []string
 }
 
 // NewWatcher creates a new Watcher instance.
-func NewWatcher(taskSvc ports.TaskService, runner ports.Runner) (*Watcher, error) {
+// Events on paths matching ignorePatterns never trigger a sync, unless a task
+// overrides them with its own watchIgnorePatterns option.
+func NewWatcher(taskSvc ports.TaskService, runner ports.Runner, ignorePatterns []string) (*Watcher, error) {
 	recWatcher, err := NewRecursiveWatcher()
 	if err != nil {
 		return nil, err
 	}
-	return newWatcher(taskSvc, runner, recWatcher), nil
+	w := newWatcher(taskSvc, runner, recWatcher)
+	w.defaultIgnore = ignorePatterns
+	return w, nil
 }
 
 func newWatcher(taskSvc ports.TaskService, runner ports.Runner, fw FileWatcher) *Watcher {
@@ -53,7 +70,11 @@ func newWatcher(taskSvc ports.TaskService, runner ports.Runner, fw FileWatcher)
 		runner:     runner,
 		logger:     logger.Named("core.watcher"),
 		watchMap:   make(map[string]string),
+		ignoreMap:  make(map[string][]string),
+		excludeMap: make(map[string][]string),
+		warnings:   make(map[string]*model.WatchWarning),
 		debounce:   make(map[string]*time.Timer),
+		batches:    make(map[string]*model.JobTriggerDetail),
 	}
 }
 
@@ -141,25 +162,104 @@ func (w *Watcher) RemoveTask(task *ent.Task) error {
 func (w *Watcher) addWatch(task *ent.Task) error {
 	taskIDStr := task.ID.String()
 
+	// Same tree already watched, only the ignore patterns may have changed
+	exclude := taskExcludeDirs(task)
+	if path, ok := w.watchMap[taskIDStr]; ok && path == task.SourcePath && slices.Equal(w.excludeMap[taskIDStr], exclude) {
+		w.ignoreMap[taskIDStr] = taskIgnorePatterns(task, w.defaultIgnore)
+		return nil
+	}
+
 	// If already watching, remove first (handle updates)
 	if _, ok := w.watchMap[taskIDStr]; ok {
 		w.removeWatch(taskIDStr)
 	}
 
-	err := w.recWatcher.Add(task.SourcePath)
-	if err != nil {
+	// A tree only partially watched due to the watch limit is kept, so changes
+	// in the directories watched before the limit was reached still trigger syncs
+	err := w.recWatcher.Add(task.SourcePath, exclude...)
+	var limitErr *WatchLimitError
+	if err != nil && !errors.As(err, &limitErr) {
 		return err
 	}
 
 	w.watchMap[taskIDStr] = task.SourcePath
+	w.excludeMap[taskIDStr] = exclude
+	w.ignoreMap[taskIDStr] = taskIgnorePatterns(task, w.defaultIgnore)
 	w.logger.Info("Added path to watcher", zap.String("task", task.Name), zap.String("path", task.SourcePath))
+	if limitErr != nil {
+		w.warnWatchLimit(taskIDStr, limitErr)
+	}
 	return nil
 }
 
+// WatchWarning returns the watch limit warning of a task, nil if it is fully watched.
+func (w *Watcher) WatchWarning(taskID uuid.UUID) *model.WatchWarning {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.warnings[taskID.String()]
+}
+
+// handleWatchLimit warns the tasks containing a directory created in a
+// watched tree that could not be watched due to the watch limit.
+func (w *Watcher) handleWatchLimit(limitErr *WatchLimitError) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for taskID, sourcePath := range w.watchMap {
+		rel, err := filepath.Rel(sourcePath, limitErr.Path)
+		if err != nil || strings.HasPrefix(rel, "..") || matchExclude(w.excludeMap[taskID], rel) {
+			continue
+		}
+		w.warnWatchLimit(taskID, limitErr)
+	}
+}
+
+// warnWatchLimit records the watch limit warning of a task and a WATCH_LIMIT
+// task event, unless the task was already warned since it was last watched.
+// Caller must hold w.mu.
+func (w *Watcher) warnWatchLimit(taskID string, limitErr *WatchLimitError) {
+	if _, ok := w.warnings[taskID]; ok {
+		return
+	}
+	warning := &model.WatchWarning{
+		Path:       limitErr.Path,
+		Used:       limitErr.Used,
+		Sysctl:     limitErr.Sysctl(),
+		DetectedAt: time.Now(),
+	}
+	if limitErr.Limit > 0 {
+		warning.Limit = &limitErr.Limit
+	}
+	w.warnings[taskID] = warning
+	w.logger.Warn("Inotify watch limit reached, changes in unwatched directories do not trigger a sync",
+		zap.String("task_id", taskID),
+		zap.String("path", limitErr.Path),
+		zap.Int("limit", limitErr.Limit),
+		zap.Int("used", limitErr.Used),
+		zap.String("suggestion", warning.Sysctl))
+
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return
+	}
+	ctx := context.Background()
+	message := i18n.CtxWithData(ctx, i18n.StatusWatchLimitReached, map[string]interface{}{
+		"Path":   limitErr.Path,
+		"Limit":  limitErr.Limit,
+		"Used":   limitErr.Used,
+		"Sysctl": warning.Sysctl,
+	})
+	if err := w.taskSvc.RecordWatchLimitReached(ctx, id, message); err != nil {
+		w.logger.Error("Failed to record watch limit warning", zap.String("task_id", taskID), zap.Error(err))
+	}
+}
+
 func (w *Watcher) removeWatch(taskID string) {
 	if path, ok := w.watchMap[taskID]; ok {
-		_ = w.recWatcher.Remove(path)
+		_ = w.recWatcher.Remove(path, w.excludeMap[taskID]...)
 		delete(w.watchMap, taskID)
+		delete(w.ignoreMap, taskID)
+		delete(w.excludeMap, taskID)
+		delete(w.warnings, taskID)
 		w.logger.Info("Removed path from watcher", zap.String("task_id", taskID), zap.String("path", path))
 	}
 }
@@ -186,6 +286,11 @@ func (w *Watcher) watchLoop() {
 			if !ok {
 				return
 			}
+			var limitErr *WatchLimitError
+			if errors.As(err, &limitErr) {
+				w.handleWatchLimit(limitErr)
+				continue
+			}
 			w.logger.Error("Watcher error", zap.Error(err))
 		}
 	}
@@ -206,23 +311,55 @@ func (w *Watcher) handleEvent(event fsnotify.Event) {
 		if err != nil {
 			continue
 		}
-		if !strings.HasPrefix(rel, "..") {
-			w.triggerSync(taskID)
+		if strings.HasPrefix(rel, "..") {
+			continue
+		}
+		// Ignored files (editor temp files, partial downloads) must not even reset the debounce timer.
+		// Excluded directories may still be watched for another task sharing the tree.
+		if matchIgnore(w.ignoreMap[taskID], rel) || matchExclude(w.excludeMap[taskID], rel) {
+			continue
 		}
+		w.triggerSync(taskID, filepath.ToSlash(rel))
 	}
 }
 
-func (w *Watcher) triggerSync(taskID string) {
+func (w *Watcher) triggerSync(taskID, rel string) {
+	// Record the event in the batch the debounced run is triggered by
+	batch, ok := w.batches[taskID]
+	if !ok {
+		batch = &model.JobTriggerDetail{EventCount: new(int)}
+		w.batches[taskID] = batch
+	}
+	*batch.EventCount++
+	if len(batch.EventPaths) < maxBatchPaths && !slices.Contains(batch.EventPaths, rel) {
+		batch.EventPaths = append(batch.EventPaths, rel)
+	}
+
 	// Debounce logic
 	if timer, ok := w.debounce[taskID]; ok {
 		timer.Stop()
 	}
 
-	// Wait 2 seconds after last event before syncing
-	w.debounce[taskID] = time.AfterFunc(2*time.Second, func() {
-		w.logger.Info("Triggering realtime sync", zap.String("task_id", taskID))
+	// Wait 2 seconds after last event before syncing.
+	// Stop doesn't keep a timer that already fired from waiting for w.mu, so a timer that was
+	// replaced in the meantime returns without triggering a run.
+	var timer *time.Timer
+	timer = time.AfterFunc(2*time.Second, func() {
+		w.mu.Lock()
+		if w.debounce[taskID] != timer {
+			w.mu.Unlock()
+			return
+		}
+		delete(w.debounce, taskID)
+		batch := w.batches[taskID]
+		delete(w.batches, taskID)
+		w.mu.Unlock()
+		if batch == nil {
+			return
+		}
+		w.logger.Info("Triggering realtime sync", zap.String("task_id", taskID), zap.Intp("events", batch.EventCount))
 
-		ctx := context.Background()
+		ctx := provenance.WithTriggerDetail(context.Background(), batch)
 		id, err := uuid.Parse(taskID)
 		if err != nil {
 			w.logger.Error("Failed to parse task ID", zap.String("task_id", taskID), zap.Error(err))
@@ -235,8 +372,9 @@ func (w *Watcher) triggerSync(taskID string) {
 			return
 		}
 
-		_ = w.runner.StartTask(task, model.JobTriggerRealtime)
+		_ = w.runner.StartTask(ctx, task, model.JobTriggerRealtime)
 	})
+	w.debounce[taskID] = timer
 }
 
 var _ ports.Watcher = (*Watcher)(nil)
@@ -2,7 +2,9 @@ package watcher
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -10,19 +12,25 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // MockRunner is a mock for the Runner interface
 type MockRunner struct {
 	mock.Mock
+	// detail is the trigger detail of the last StartTask call
+	detail atomic.Pointer[model.JobTriggerDetail]
 }
 
 func (m *MockRunner) Start() { m.Called() }
 func (m *MockRunner) Stop()  { m.Called() }
-func (m *MockRunner) StartTask(task *ent.Task, trigger model.JobTrigger) error {
+func (m *MockRunner) StartTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
+	m.detail.Store(provenance.TriggerDetail(ctx))
 	args := m.Called(task, trigger)
 	return args.Error(0)
 }
@@ -30,10 +38,31 @@ func (m *MockRunner) StopTask(taskID uuid.UUID) error {
 	args := m.Called(taskID)
 	return args.Error(0)
 }
+func (m *MockRunner) StopTasks(taskIDs []uuid.UUID) []uuid.UUID {
+	args := m.Called(taskIDs)
+	stopped, _ := args.Get(0).([]uuid.UUID)
+	return stopped
+}
 func (m *MockRunner) IsRunning(taskID uuid.UUID) bool {
 	args := m.Called(taskID)
 	return args.Bool(0)
 }
+func (m *MockRunner) SetMaintenance(enabled bool, cancelRunning bool) {
+	m.Called(enabled, cancelRunning)
+}
+func (m *MockRunner) IsMaintenance() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+func (m *MockRunner) RunningCount() int {
+	args := m.Called()
+	return args.Int(0)
+}
+
+func (m *MockRunner) Engines() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
 
 // MockTaskService is a mock for the TaskService interface
 type MockTaskService struct {
@@ -64,9 +93,20 @@ func (m *MockTaskService) ListAllTasks(ctx context.Context) ([]*ent.Task, error)
 	return args.Get(0).([]*ent.Task), args.Error(1)
 }
 
+func (m *MockTaskService) RecordSkippedRun(ctx context.Context, taskID uuid.UUID, message string) error {
+	args := m.Called(ctx, taskID, message)
+	return args.Error(0)
+}
+
+func (m *MockTaskService) RecordWatchLimitReached(ctx context.Context, taskID uuid.UUID, message string) error {
+	args := m.Called(ctx, taskID, message)
+	return args.Error(0)
+}
+
 func setupTest(t *testing.T) {
 	t.Helper()
 	logger.InitLogger(logger.EnvironmentDevelopment, logger.LogLevelDebug, nil)
+	require.NoError(t, i18n.Init())
 }
 
 func TestWatcher_Debounce(t *testing.T) {
@@ -89,7 +129,7 @@ func TestWatcher_Debounce(t *testing.T) {
 	// We expect StartTask to be called only once.
 	mockRunner.On("StartTask", task, model.JobTriggerRealtime).Return(nil).Once()
 
-	w, err := NewWatcher(mockTaskSvc, mockRunner)
+	w, err := NewWatcher(mockTaskSvc, mockRunner, nil)
 	assert.NoError(t, err)
 
 	// Add the task to the watcher
@@ -107,6 +147,71 @@ func TestWatcher_Debounce(t *testing.T) {
 
 	mockTaskSvc.AssertExpectations(t)
 	mockRunner.AssertExpectations(t)
+
+	// The run records the batch of events that triggered it
+	detail := mockRunner.detail.Load()
+	require.NotNil(t, detail)
+	assert.Equal(t, 5, *detail.EventCount)
+	assert.Equal(t, []string{"file.txt"}, detail.EventPaths)
+	assert.Empty(t, w.batches)
+}
+
+// TestWatcher_StaleDebounceTimer tests that a debounce timer which fired while another event replaced it
+// doesn't trigger a run of its own.
+func TestWatcher_StaleDebounceTimer(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	mockTaskSvc := new(MockTaskService)
+	mockRunner := new(MockRunner)
+	tempDir := t.TempDir()
+
+	task := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	mockFW.On("Add", tempDir).Return(nil)
+	mockTaskSvc.On("GetTaskWithConnection", mock.Anything, task.ID).Return(task, nil)
+	mockRunner.On("StartTask", task, model.JobTriggerRealtime).Return(nil).Once()
+
+	w := newWatcher(mockTaskSvc, mockRunner, mockFW)
+	require.NoError(t, w.AddTask(task))
+	w.handleEvent(fsnotify.Event{Name: filepath.Join(tempDir, "a.txt"), Op: fsnotify.Write})
+
+	// The first timer fires and waits for the lock while the next event replaces it
+	w.mu.Lock()
+	time.Sleep(2500 * time.Millisecond)
+	w.triggerSync(task.ID.String(), "b.txt")
+	w.mu.Unlock()
+
+	time.Sleep(2500 * time.Millisecond)
+	mockRunner.AssertExpectations(t)
+	detail := mockRunner.detail.Load()
+	require.NotNil(t, detail)
+	assert.Equal(t, 2, *detail.EventCount)
+	assert.Equal(t, []string{"a.txt", "b.txt"}, detail.EventPaths)
+}
+
+func TestWatcher_EventBatchPathsLimited(t *testing.T) {
+	setupTest(t)
+	mockFW := NewMockFileWatcher()
+	tempDir := t.TempDir()
+
+	task := &ent.Task{ID: uuid.New(), Realtime: true, SourcePath: tempDir}
+	mockFW.On("Add", tempDir).Return(nil)
+
+	w := newWatcher(new(MockTaskService), new(MockRunner), mockFW)
+	require.NoError(t, w.AddTask(task))
+
+	for i := range maxBatchPaths + 5 {
+		w.handleEvent(fsnotify.Event{Name: filepath.Join(tempDir, "dir", fmt.Sprintf("file-%d.txt", i)), Op: fsnotify.Create})
+	}
+
+	batch := w.batches[task.ID.String()]
+	require.NotNil(t, batch)
+	assert.Equal(t, maxBatchPaths+5, *batch.EventCount)
+	assert.Len(t, batch.EventPaths, maxBatchPaths)
+	assert.Equal(t, "dir/file-0.txt", batch.EventPaths[0])
+
+	for _, timer := range w.debounce {
+		timer.Stop()
+	}
 }
 
 func TestWatcher_PathFiltering(t *testing.T) {
@@ -124,7 +229,7 @@ func TestWatcher_PathFiltering(t *testing.T) {
 		SourcePath: tempDir,
 	}
 
-	w, err := NewWatcher(mockTaskSvc, mockRunner)
+	w, err := NewWatcher(mockTaskSvc, mockRunner, nil)
 	assert.NoError(t, err)
 
 	err = w.AddTask(task)
@@ -147,7 +252,7 @@ func TestWatcher_StartStopBehavior(t *testing.T) {
 	// Expect ListAllTasks to be called only once across all Start() calls.
 	mockTaskSvc.On("ListAllTasks", mock.Anything).Return([]*ent.Task{}, nil).Once()
 
-	w, err := NewWatcher(mockTaskSvc, mockRunner)
+	w, err := NewWatcher(mockTaskSvc, mockRunner, nil)
 	assert.NoError(t, err)
 
 	// 1. Test Start idempotency
@@ -179,12 +284,12 @@ func NewMockFileWatcher() *MockFileWatcher {
 	}
 }
 
-func (m *MockFileWatcher) Add(name string) error {
+func (m *MockFileWatcher) Add(name string, exclude ...string) error {
 	args := m.Called(name)
 	return args.Error(0)
 }
 
-func (m *MockFileWatcher) Remove(name string) error {
+func (m *MockFileWatcher) Remove(name string, exclude ...string) error {
 	args := m.Called(name)
 	return args.Error(0)
 }
@@ -18,6 +18,10 @@ var localeFS embed.FS
 
 var bundle *i18n.Bundle
 
+// defaultLocale is the locale used when no locale is available from the request
+// context, e.g. for background jobs and requests without Accept-Language.
+var defaultLocale = "en"
+
 // Init initializes the i18n bundle.
 // Should be called when the application starts.
 func Init() error {
@@ -40,6 +44,22 @@ func NewLocalizer(lang string) *i18n.Localizer {
 	return i18n.NewLocalizer(bundle, lang)
 }
 
+// SetDefaultLocale sets the fallback locale used for background rendering
+// (job failure reasons, logs) and requests without Accept-Language.
+// An empty string resets it to English.
+func SetDefaultLocale(locale string) {
+	if locale == "" {
+		defaultLocale = "en"
+		return
+	}
+	defaultLocale = ParseLocale(locale)
+}
+
+// DefaultLocale returns the configured fallback locale
+func DefaultLocale() string {
+	return defaultLocale
+}
+
 // ParseLocale normalizes a language string to a supported locale
 func ParseLocale(s string) string {
 	if strings.HasPrefix(s, "zh") {
@@ -111,21 +131,21 @@ func WithLocale(ctx context.Context, locale string) context.Context {
 }
 
 // LocalizerFromContext retrieves a Localizer from context.Context
-// If not found, returns an English Localizer
+// If not found, returns a Localizer for the default locale
 func LocalizerFromContext(ctx context.Context) *i18n.Localizer {
 	if localizer, ok := ctx.Value(ContextKeyLocalizer).(*i18n.Localizer); ok {
 		return localizer
 	}
-	return NewLocalizer("en")
+	return NewLocalizer(defaultLocale)
 }
 
 // LocaleFromContext retrieves a locale string from context.Context
-// If not found, returns "en"
+// If not found, returns the default locale
 func LocaleFromContext(ctx context.Context) string {
 	if locale, ok := ctx.Value(ContextKeyLocale).(string); ok {
 		return locale
 	}
-	return "en"
+	return defaultLocale
 }
 
 // Ctx is a convenient translation function for business logic
@@ -243,6 +263,21 @@ func ErrUnauthorizedI18n(msgID string) *Error {
 	return NewI18nError(msgID).WithStatus(401)
 }
 
+// ErrorMessage renders err for display using the localizer from context.
+// A top-level I18nError is translated (with its cause appended), other errors
+// are returned as-is so that wrapping context is not lost.
+func ErrorMessage(ctx context.Context, err error) string {
+	i18nErr, ok := err.(*Error) //nolint:errorlint // only the outermost error is translated
+	if !ok {
+		return err.Error()
+	}
+	msg := i18nErr.TranslateCtx(ctx)
+	if i18nErr.Cause != nil {
+		return fmt.Sprintf("%s: %v", msg, i18nErr.Cause)
+	}
+	return msg
+}
+
 // IsI18nError checks if the error is an I18nError
 func IsI18nError(err error) (*Error, bool) {
 	var i18nErr *Error
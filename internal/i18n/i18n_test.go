@@ -2,6 +2,8 @@ package i18n
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -140,6 +142,51 @@ func TestContextFunctions(t *testing.T) {
 	})
 }
 
+func TestDefaultLocale(t *testing.T) {
+	err := Init()
+	require.NoError(t, err)
+	t.Cleanup(func() { SetDefaultLocale("") })
+
+	assert.Equal(t, "en", DefaultLocale())
+
+	SetDefaultLocale("zh-Hans")
+	assert.Equal(t, "zh-CN", DefaultLocale())
+	assert.Equal(t, "zh-CN", LocaleFromContext(context.Background()))
+	assert.Equal(t, "发生错误", Ctx(context.Background(), ErrGeneric))
+
+	// Explicit context locale still wins
+	ctx := WithLocalizer(context.Background(), NewLocalizer("en"))
+	assert.Equal(t, "An error occurred", Ctx(ctx, ErrGeneric))
+
+	SetDefaultLocale("")
+	assert.Equal(t, "en", DefaultLocale())
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := Init()
+	require.NoError(t, err)
+
+	zhCtx := WithLocalizer(context.Background(), NewLocalizer("zh-CN"))
+
+	t.Run("plain error is returned as-is", func(t *testing.T) {
+		assert.Equal(t, "boom", ErrorMessage(zhCtx, errors.New("boom")))
+	})
+
+	t.Run("i18n error is translated", func(t *testing.T) {
+		assert.Equal(t, "资源不存在", ErrorMessage(zhCtx, NewI18nError(ErrNotFound)))
+	})
+
+	t.Run("cause is appended", func(t *testing.T) {
+		e := NewI18nError(ErrGeneric).WithCause(errors.New("disk full"))
+		assert.Equal(t, "An error occurred: disk full", ErrorMessage(context.Background(), e))
+	})
+
+	t.Run("wrapped i18n error keeps its wrapping", func(t *testing.T) {
+		e := fmt.Errorf("outer: %w", NewI18nError(ErrNotFound))
+		assert.Equal(t, "outer: error_not_found", ErrorMessage(zhCtx, e))
+	})
+}
+
 func TestI18nError(t *testing.T) {
 	err := Init()
 	require.NoError(t, err)
@@ -19,6 +19,7 @@ const (
 	ErrInvalidRequestBody          = "error_invalid_request_body"
 	ErrPathNotExist                = "error_path_not_exist"
 	ErrPathNotDirectory            = "error_path_not_directory"
+	ErrPathNotAbsolute             = "error_path_not_absolute"
 	ErrRemoteNotFound              = "error_remote_not_found"
 	ErrJobNotActive                = "error_job_not_active"
 	ErrJobNotFound                 = "error_job_not_found"
@@ -29,19 +30,98 @@ const (
 	ErrFailedToGetQuota            = "error_failed_to_get_quota"
 	ErrImportParseFailed           = "error_import_parse_failed"
 	ErrImportEmptyList             = "error_import_empty_list"
+	ErrImportPasswordRequired      = "error_import_password_required"
+	ErrImportPasswordIncorrect     = "error_import_password_incorrect"
+	ErrConfigPasswordInvalid       = "error_config_password_invalid"
 	ErrConnectionHasDependentTasks = "error_connection_has_dependent_tasks"
 	ErrFilterRuleInvalid           = "error_filter_rule_invalid"
 	ErrTransfersOutOfRange         = "error_transfers_out_of_range"
+	ErrMaintenanceMode             = "error_maintenance_mode"
+	ErrConnectionUpdateRunning     = "error_connection_update_running"
+	ErrUploadDisabled              = "error_upload_disabled"
+	ErrUploadRequiresAuth          = "error_upload_requires_auth"
+	ErrUploadTooLarge              = "error_upload_too_large"
+	ErrJobCancelled                = "error_job_cancelled"
+	ErrRelocateNotInMaintenance    = "error_relocate_not_in_maintenance"
+	ErrRelocateFailed              = "error_relocate_failed"
+	ErrShardsOutOfRange            = "error_shards_out_of_range"
+	ErrMaxDurationNegative         = "error_max_duration_negative"
+	ErrIgnorePatternInvalid        = "error_ignore_pattern_invalid"
+	ErrWatchExcludeDirInvalid      = "error_watch_exclude_dir_invalid"
+	ErrConnectionNameInvalid       = "error_connection_name_invalid"
+	ErrRemotePathNotExist          = "error_remote_path_not_exist"
+	ErrEngineNotFound              = "error_engine_not_found"
+	ErrBackupDirectionInvalid      = "error_backup_direction_invalid"
+	ErrBackupRetentionNegative     = "error_backup_retention_negative"
+	ErrSnapshotNotFound            = "error_snapshot_not_found"
+	ErrRestoreTaskRunning          = "error_restore_task_running"
+	ErrRestoreFailed               = "error_restore_failed"
+	ErrDemoConnectionConflict      = "error_demo_connection_conflict"
+	ErrServerLogDisabled           = "error_server_log_disabled"
+	ErrInvalidSince                = "error_invalid_since"
+	ErrNoFailedFiles               = "error_no_failed_files"
+	ErrRetryTaskRunning            = "error_retry_task_running"
+	ErrConnectionVersionConflict   = "error_connection_version_conflict"
+	ErrHooksDisabled               = "error_hooks_disabled"
+	ErrHookNotAllowed              = "error_hook_not_allowed"
+	ErrPresetNotFound              = "error_preset_not_found"
+	ErrPresetTypeMismatch          = "error_preset_type_mismatch"
+	ErrDaysOutOfRange              = "error_days_out_of_range"
+	ErrIdempotencyKeyInvalid       = "error_idempotency_key_invalid"
+	ErrIdempotencyKeyReused        = "error_idempotency_key_reused"
+	ErrIdempotencyKeyInProgress    = "error_idempotency_key_in_progress"
+	ErrPacingNegative              = "error_pacing_negative"
+	ErrPacingNotSupported          = "error_pacing_not_supported"
+	ErrDisplayNameTooLong          = "error_display_name_too_long"
+	ErrColorInvalid                = "error_color_invalid"
+	ErrIconInvalid                 = "error_icon_invalid"
+	ErrConfirmDeletesNegative      = "error_confirm_deletes_negative"
+	ErrJobNotWaiting               = "error_job_not_waiting"
+	ErrJobDeletesAborted           = "error_job_deletes_aborted"
+	ErrArchiveDisabled             = "error_archive_disabled"
+	ErrArchiveFormatInvalid        = "error_archive_format_invalid"
+	ErrArchiveTooLarge             = "error_archive_too_large"
+	ErrArchiveFailed               = "error_archive_failed"
+	ErrArchiveNotFound             = "error_archive_not_found"
+	ErrArchiveNotReady             = "error_archive_not_ready"
+	ErrOptimizeTasksRunning        = "error_optimize_tasks_running"
+	ErrOptimizeRunning             = "error_optimize_running"
+	ErrOptimizeFailed              = "error_optimize_failed"
+	ErrTaskPathsUnsupported        = "error_task_paths_unsupported"
+	ErrTaskPathSubpathInvalid      = "error_task_path_subpath_invalid"
+	ErrTaskPathSubpathOverlap      = "error_task_path_subpath_overlap"
+	ErrTaskMirrorsUnsupported      = "error_task_mirrors_unsupported"
+	ErrTaskMirrorDuplicate         = "error_task_mirror_duplicate"
+	ErrTooManyIDs                  = "error_too_many_ids"
+	ErrShareExpiryInvalid          = "error_share_expiry_invalid"
+	ErrShareTokenInvalid           = "error_share_token_invalid"
+	ErrShareScopeDenied            = "error_share_scope_denied"
+	ErrTransferCapNegative         = "error_transfer_cap_negative"
+	ErrLogDeleteFilterEmpty        = "error_log_delete_filter_empty"
+	ErrMigrateSameConnection       = "error_migrate_same_connection"
+	ErrMigrateTaskNotOnConnection  = "error_migrate_task_not_on_connection"
+	ErrMigrateTaskRunning          = "error_migrate_task_running"
+	ErrGraphQLDisabled             = "error_graphql_disabled"
+	ErrSubscriptionsDisabled       = "error_subscriptions_disabled"
+	ErrRESTAPIDisabled             = "error_rest_api_disabled"
+	ErrRetentionDaysNegative       = "error_retention_days_negative"
+	ErrMediaBidirectional          = "error_media_bidirectional"
+	ErrNotMediaConnection          = "error_not_media_connection"
+	ErrFilterRuleNotShardable      = "error_filter_rule_not_shardable"
+	ErrRelocateDemoData            = "error_relocate_demo_data"
 )
 
 // Status message keys
 const (
-	StatusSyncing      = "status_syncing"
-	StatusSyncingFiles = "status_syncing_files"
-	StatusCompleted    = "status_completed"
-	StatusFailed       = "status_failed"
-	StatusIdle         = "status_idle"
-	StatusCancelled    = "status_cancelled"
+	StatusSyncing           = "status_syncing"
+	StatusSyncingFiles      = "status_syncing_files"
+	StatusCompleted         = "status_completed"
+	StatusFailed            = "status_failed"
+	StatusIdle              = "status_idle"
+	StatusCancelled         = "status_cancelled"
+	StatusScheduleSkipped   = "status_schedule_skipped"
+	StatusWatchLimitReached = "status_watch_limit_reached"
+	StatusQuotaDeferred     = "status_quota_deferred"
 )
 
 // Success message keys
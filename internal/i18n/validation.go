@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+// FieldError describes why a single input field is invalid
+type FieldError struct {
+	// Field is the dotted path of the field within the input, e.g. "options.filters.2"
+	Field string
+	// MsgID is the key for the translated message, also used as the error code
+	MsgID string
+	// Data is the data for the translation template (optional)
+	Data map[string]interface{}
+}
+
+// Translate translates the field error message using the given localizer
+func (e FieldError) Translate(localizer *i18n.Localizer) string {
+	if e.Data != nil {
+		return TWithData(localizer, e.MsgID, e.Data)
+	}
+	return T(localizer, e.MsgID)
+}
+
+// ValidationError collects the errors of all invalid fields of an input,
+// so that clients can report every offending field at once instead of a single opaque message.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// NewValidationError creates an empty ValidationError
+func NewValidationError() *ValidationError {
+	return &ValidationError{}
+}
+
+// Add records an invalid field
+func (e *ValidationError) Add(field, msgID string, data map[string]interface{}) {
+	e.Fields = append(e.Fields, FieldError{Field: field, MsgID: msgID, Data: data})
+}
+
+// Err returns the ValidationError if any field is invalid, nil otherwise
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error implements the error interface
+// Returns the field paths and message IDs (for logging and other scenarios)
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		parts[i] = f.Field + ": " + f.MsgID
+	}
+	return ErrValidationFailed + ": " + strings.Join(parts, "; ")
+}
+
+// Unwrap allows matching the error with errs.ErrValidation
+func (e *ValidationError) Unwrap() error {
+	return errs.ErrValidation
+}
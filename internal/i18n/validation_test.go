@@ -0,0 +1,32 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+func TestValidationError(t *testing.T) {
+	require.NoError(t, Init())
+
+	v := NewValidationError()
+	assert.NoError(t, v.Err(), "no invalid fields")
+
+	v.Add("name", ErrMissingParameter, nil)
+	v.Add("options.transfers", ErrTransfersOutOfRange, map[string]interface{}{"Value": 0})
+
+	err := v.Err()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, errs.ErrValidation))
+	assert.Equal(t, "error_validation_failed: name: error_missing_parameter; options.transfers: error_transfers_out_of_range", err.Error())
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Len(t, validationErr.Fields, 2)
+	assert.Equal(t, "Missing required parameter", validationErr.Fields[0].Translate(NewLocalizer("en")))
+	assert.Equal(t, "并行传输数量必须在 1-64 之间，当前值为 0", validationErr.Fields[1].Translate(NewLocalizer("zh-CN")))
+}
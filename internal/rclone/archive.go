@@ -0,0 +1,146 @@
+package rclone
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/rclone/rclone/fs"
+)
+
+// ArchiveFormat is the format of an archive of a remote directory.
+type ArchiveFormat string
+
+const (
+	// ArchiveFormatZip is a deflate compressed zip archive.
+	ArchiveFormatZip ArchiveFormat = "zip"
+	// ArchiveFormatTarGz is a gzip compressed tar archive.
+	ArchiveFormatTarGz ArchiveFormat = "tar.gz"
+)
+
+// ParseArchiveFormat parses the name of an archive format, defaulting to zip if it is empty.
+func ParseArchiveFormat(name string) (ArchiveFormat, bool) {
+	switch ArchiveFormat(strings.ToLower(name)) {
+	case "", ArchiveFormatZip:
+		return ArchiveFormatZip, true
+	case ArchiveFormatTarGz, "tgz":
+		return ArchiveFormatTarGz, true
+	}
+	return "", false
+}
+
+// Extension returns the file name extension of the format, including the dot.
+func (f ArchiveFormat) Extension() string {
+	return "." + string(f)
+}
+
+// ContentType returns the MIME type of the format.
+func (f ArchiveFormat) ContentType() string {
+	if f == ArchiveFormatTarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// ListArchiveObjects lists the objects below f in path order, with their total size.
+// It returns fs.ErrorDirNotFound if the root of f doesn't exist.
+func ListArchiveObjects(ctx context.Context, f fs.Fs) ([]fs.Object, int64, error) {
+	objects, err := listObjects(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(objects) == 0 {
+		// listObjects lists a missing directory as empty
+		if _, err := f.List(ctx, ""); errors.Is(err, fs.ErrorDirNotFound) {
+			return nil, 0, err
+		}
+	}
+
+	list := make([]fs.Object, 0, len(objects))
+	var total int64
+	for _, o := range objects {
+		list = append(list, o)
+		total += max(o.Size(), 0)
+	}
+	slices.SortFunc(list, func(a, b fs.Object) int { return strings.Compare(a.Remote(), b.Remote()) })
+	return list, total, nil
+}
+
+// WriteArchive writes the objects as an archive of format to w, named by their path below the listed Fs.
+// Objects of unknown size, such as Google Docs, are skipped in tar archives, which need the size up front.
+func WriteArchive(ctx context.Context, w io.Writer, format ArchiveFormat, objects []fs.Object) error {
+	switch format {
+	case ArchiveFormatZip:
+		return writeZip(ctx, w, objects)
+	case ArchiveFormatTarGz:
+		return writeTarGz(ctx, w, objects)
+	}
+	return fmt.Errorf("unsupported archive format: %q", format) //nolint:err113
+}
+
+func writeZip(ctx context.Context, w io.Writer, objects []fs.Object) error {
+	zw := zip.NewWriter(w)
+	for _, o := range objects {
+		header := &zip.FileHeader{
+			Name:     o.Remote(),
+			Method:   zip.Deflate,
+			Modified: o.ModTime(ctx),
+		}
+		header.SetMode(0o644)
+		entry, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if err := copyObject(ctx, entry, o); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeTarGz(ctx context.Context, w io.Writer, objects []fs.Object) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, o := range objects {
+		if o.Size() < 0 {
+			fs.Debugf(o, "Skipping object of unknown size in tar archive")
+			continue
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     o.Remote(),
+			Size:     o.Size(),
+			Mode:     0o644,
+			ModTime:  o.ModTime(ctx),
+			Format:   tar.FormatPAX,
+		}); err != nil {
+			return err
+		}
+		if err := copyObject(ctx, tw, o); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// copyObject copies the content of o to w.
+func copyObject(ctx context.Context, w io.Writer, o fs.Object) error {
+	in, err := o.Open(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", o.Remote(), err)
+	}
+	defer in.Close()
+	if _, err := io.Copy(w, in); err != nil {
+		return fmt.Errorf("failed to read %s: %w", o.Remote(), err)
+	}
+	return nil
+}
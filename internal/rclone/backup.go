@@ -0,0 +1,646 @@
+package rclone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/fs/object"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/rclone/rclone/fs/walk"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"go.uber.org/zap"
+)
+
+// Directories of a backup repository below the task's remote path.
+const (
+	backupDataDir     = "data"
+	backupSnapshotDir = "snapshots"
+)
+
+// backupSnapshotIDFormat formats the UTC time of a snapshot as its ID, which sorts chronologically.
+const backupSnapshotIDFormat = "20060102T150405.000Z"
+
+// backupManifest is the content of snapshots/<id>.json, listing every file of a snapshot.
+type backupManifest struct {
+	ID     string       `json:"id"`
+	Time   time.Time    `json:"time"`
+	TaskID uuid.UUID    `json:"taskId"`
+	Files  []backupFile `json:"files"`
+}
+
+// backupFile is a file of a snapshot, whose content is stored in the blob named by its hash.
+type backupFile struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	SHA256  string    `json:"sha256"`
+}
+
+// BackupRetention decides which snapshots of a backup task are kept after a run.
+// Every rule keeps the newest snapshot of each of its most recent periods, and a snapshot
+// is kept if any rule keeps it. Without rules all snapshots are kept.
+type BackupRetention struct {
+	Last    int // Number of most recent snapshots to keep
+	Daily   int // Number of most recent days to keep one snapshot for
+	Weekly  int // Number of most recent ISO weeks to keep one snapshot for
+	Monthly int // Number of most recent months to keep one snapshot for
+}
+
+// IsZero reports whether no retention rule is set.
+func (r BackupRetention) IsZero() bool {
+	return r.Last <= 0 && r.Daily <= 0 && r.Weekly <= 0 && r.Monthly <= 0
+}
+
+// keep returns the IDs of the snapshots kept by the retention rules.
+// snapshots must be sorted newest first.
+func (r BackupRetention) keep(snapshots []*backupManifest) map[string]bool {
+	kept := make(map[string]bool)
+	if r.IsZero() {
+		for _, s := range snapshots {
+			kept[s.ID] = true
+		}
+		return kept
+	}
+
+	keepPeriods := func(n int, period func(t time.Time) string) {
+		seen := make(map[string]bool)
+		for _, s := range snapshots {
+			if len(seen) >= n {
+				return
+			}
+			key := period(s.Time.Local())
+			if !seen[key] {
+				seen[key] = true
+				kept[s.ID] = true
+			}
+		}
+	}
+	keepPeriods(r.Last, func(t time.Time) string { return t.Format(time.RFC3339Nano) })
+	keepPeriods(r.Daily, func(t time.Time) string { return t.Format(time.DateOnly) })
+	keepPeriods(r.Weekly, func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	})
+	keepPeriods(r.Monthly, func(t time.Time) string { return t.Format("2006-01") })
+	return kept
+}
+
+// getBackupRetentionFromOptions extracts the retention rules from task options.
+func getBackupRetentionFromOptions(options *model.TaskSyncOptions) BackupRetention {
+	var r BackupRetention
+	if options == nil {
+		return r
+	}
+	if options.BackupKeepLast != nil {
+		r.Last = *options.BackupKeepLast
+	}
+	if options.BackupKeepDaily != nil {
+		r.Daily = *options.BackupKeepDaily
+	}
+	if options.BackupKeepWeekly != nil {
+		r.Weekly = *options.BackupKeepWeekly
+	}
+	if options.BackupKeepMonthly != nil {
+		r.Monthly = *options.BackupKeepMonthly
+	}
+	return r
+}
+
+// BackupEngine runs backup tasks: instead of mirroring the source path, every run stores a
+// point-in-time snapshot of it in a repository at the task's remote path.
+//
+// The repository is a plain directory on the remote:
+//
+//	data/<first two hex digits>/<sha256>  file contents, stored once per distinct content
+//	snapshots/<id>.json                   manifest listing path, size, modTime and hash of every file
+//
+// Unchanged content is never uploaded twice, so a snapshot only costs the files changed since
+// the previous one. After each run the task's retention rules forget old snapshots, and content
+// no longer referenced by any snapshot is deleted. Empty directories are not part of a snapshot.
+//
+// Jobs, logs and progress of backup runs are handled by the wrapped SyncEngine like those of sync runs.
+type BackupEngine struct {
+	sync   *SyncEngine
+	logger *zap.Logger
+}
+
+// NewBackupEngine creates a BackupEngine that records its jobs through syncEngine.
+func NewBackupEngine(syncEngine *SyncEngine) *BackupEngine {
+	return &BackupEngine{
+		sync:   syncEngine,
+		logger: logger.Named("sync.backup"),
+	}
+}
+
+// RunTask creates a new snapshot of the task's source path and applies the task's retention rules.
+// Only the UPLOAD direction is supported, as the snapshots are taken of the local side.
+func (b *BackupEngine) RunTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
+	e := b.sync
+	if task.Edges.Connection == nil {
+		return errs.ConstError("task connection edge not loaded")
+	}
+
+	jobEntity, err := e.jobService.CreateJob(ctx, task.ID, trigger)
+	if err != nil {
+		return errors.Join(errs.ErrSystem, errs.ConstError("failed to create job"), err)
+	}
+	defer func() {
+		e.statsMu.Lock()
+		delete(e.lastEvents, jobEntity.ID)
+		delete(e.lastTransferEvents, jobEntity.ID)
+		e.statsMu.Unlock()
+	}()
+
+	// Jobs of connections that reached their monthly transfer cap are recorded, but don't back up
+	if deferred, err := e.deferOverCap(ctx, jobEntity, task); deferred || err != nil {
+		return err
+	}
+
+	b.logger.Info("Starting backup task", zap.String("task", task.Name), zap.Stringer("job_id", jobEntity.ID))
+
+	if _, err := e.jobService.UpdateJobStatus(ctx, jobEntity.ID, string(model.JobStatusRunning), ""); err != nil {
+		return errors.Join(errs.ErrSystem, errs.ConstError("failed to update job status"), err)
+	}
+	defer e.faults.crashDuring(jobEntity.ID)()
+
+	if task.Direction != model.SyncDirectionUpload {
+		err := i18n.NewI18nError(i18n.ErrBackupDirectionInvalid)
+		e.failJob(ctx, jobEntity.ID, err)
+		return err
+	}
+	if err := e.runPreHook(ctx, jobEntity, task); err != nil {
+		e.failJob(ctx, jobEntity.ID, err)
+		return err
+	}
+
+	syncOpts := getSyncOptionsFromTask(task.Options)
+	jobCtx := withFaults(ctx, e.faults)
+	if syncOpts.MaxDuration > 0 {
+		var jobCancel context.CancelFunc
+		jobCtx, jobCancel = context.WithTimeout(jobCtx, syncOpts.MaxDuration)
+		defer jobCancel()
+	}
+	statsCtx, statsCancel := context.WithCancel(jobCtx)
+	defer statsCancel()
+	statsCtx = accounting.WithStatsGroup(statsCtx, jobEntity.ID.String())
+	accounting.Stats(statsCtx).SetMaxCompletedTransfers(-1)
+
+	var wg sync.WaitGroup
+	var dirStats directionStats
+	concurrency := &concurrencySampler{}
+	wg.Go(func() {
+		dirStats, concurrency = e.pollStats(statsCtx, jobEntity.ID, task, jobEntity.StartTime, nil)
+	})
+
+	fSrc, err := GetFs(statsCtx, "", task.SourcePath)
+	if err != nil {
+		statsCancel()
+		wg.Wait()
+		e.failJob(ctx, jobEntity.ID, err)
+		return err
+	}
+	fRepo, err := GetFs(statsCtx, task.Edges.Connection.Name, TaskRemotePath(task))
+	if err != nil {
+		statsCancel()
+		wg.Wait()
+		e.failJob(ctx, jobEntity.ID, err)
+		return err
+	}
+
+	transfers := determineTransfers(syncOpts.Transfers, e.defaultTransfers)
+	statsCtx, rcloneCfg := fs.AddConfig(statsCtx)
+	rcloneCfg.Transfers = transfers
+
+	backupErr := b.backup(statsCtx, jobEntity.ID, task, fSrc, fRepo, syncOpts.Filters, transfers)
+
+	statsCancel()
+	wg.Wait()
+
+	result := ports.JobResult{
+		UploadedFiles:   dirStats.UploadedFiles,
+		UploadedBytes:   dirStats.UploadedBytes,
+		DownloadedFiles: dirStats.DownloadedFiles,
+		DownloadedBytes: dirStats.DownloadedBytes,
+		Concurrency:     concurrency.series(transfers),
+		ConfigSnapshot:  configSnapshot(statsCtx, task, syncOpts, transfers),
+	}
+	if s := accounting.Stats(statsCtx); s != nil {
+		result.FilesTransferred, result.BytesTransferred = s.GetTransfers(), s.GetBytes()
+		result.FilesDeleted, result.ErrorCount = s.GetDeletes(), s.GetErrors()
+		result.FilesChecked, result.ListingsDone = s.GetChecks(), s.Listed(0)
+	}
+	e.runPostHook(ctx, jobEntity, task, backupErr, &result)
+
+	return e.finishJob(ctx, jobCtx, jobEntity, task, result, backupErr, syncOpts.MaxDuration)
+}
+
+// backup stores a new snapshot of fSrc in the repository fRepo, then forgets the snapshots not kept
+// by the task's retention rules and deletes the content no longer referenced.
+//
+// Files that can't be read or uploaded are counted as errors and left out of the snapshot.
+func (b *BackupEngine) backup(ctx context.Context, jobID uuid.UUID, task *ent.Task, fSrc, fRepo fs.Fs, filters []string, transfers int) error {
+	snapshots, err := readSnapshots(ctx, fRepo)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshots: %w", err)
+	}
+	blobs, err := listBlobs(ctx, fRepo)
+	if err != nil {
+		return fmt.Errorf("failed to list repository data: %w", err)
+	}
+
+	// Files unchanged since the previous snapshot reuse its hash instead of being read again
+	previous := make(map[string]backupFile)
+	if len(snapshots) > 0 {
+		for _, f := range snapshots[0].Files {
+			previous[f.Path] = f
+		}
+	}
+
+	srcCtx, err := applyFilterRules(ctx, filters)
+	if err != nil {
+		return i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
+	}
+	var (
+		mu      sync.Mutex
+		objects []fs.Object
+	)
+	err = walk.ListR(srcCtx, fSrc, "", false, -1, walk.ListObjects, func(entries fs.DirEntries) error {
+		mu.Lock()
+		defer mu.Unlock()
+		entries.ForObject(func(o fs.Object) {
+			objects = append(objects, o)
+		})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list source: %w", err)
+	}
+
+	// 1. Hash the source files
+	files := make([]*backupFile, len(objects))
+	forEachParallel(ctx, transfers, len(objects), func(i int) {
+		o := objects[i]
+		f := &backupFile{Path: o.Remote(), Size: o.Size(), ModTime: o.ModTime(ctx)}
+		if prev, ok := previous[f.Path]; ok && prev.Size == f.Size && prev.ModTime.Equal(f.ModTime) {
+			f.SHA256 = prev.SHA256
+		} else {
+			sum, err := o.Hash(ctx, hash.SHA256)
+			if err == nil && sum == "" {
+				err = fmt.Errorf("%s: %w", fSrc.Name(), hash.ErrUnsupported)
+			}
+			if err != nil {
+				b.recordFileError(ctx, jobID, f.Path, f.Size, err)
+				return
+			}
+			f.SHA256 = sum
+		}
+		files[i] = f
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// 2. Upload the content missing in the repository, once per distinct hash
+	uploads := make(map[string]fs.Object)
+	for i, f := range files {
+		if f == nil {
+			continue
+		}
+		if _, ok := blobs[f.SHA256]; !ok {
+			if _, ok := uploads[f.SHA256]; !ok {
+				uploads[f.SHA256] = objects[i]
+			}
+		}
+	}
+	pending := make([]string, 0, len(uploads))
+	for sum := range uploads {
+		pending = append(pending, sum)
+	}
+	failed := make(map[string]bool)
+	forEachParallel(ctx, transfers, len(pending), func(i int) {
+		sum := pending[i]
+		// Copy records failures in the job stats, which logs them
+		dst, err := operations.Copy(ctx, fRepo, nil, backupBlobPath(sum), uploads[sum])
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failed[sum] = true
+			return
+		}
+		blobs[sum] = dst
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// 3. Write the snapshot manifest
+	now := time.Now().UTC()
+	manifest := &backupManifest{
+		ID:     now.Format(backupSnapshotIDFormat),
+		Time:   now,
+		TaskID: task.ID,
+		Files:  make([]backupFile, 0, len(files)),
+	}
+	for _, f := range files {
+		if f != nil && !failed[f.SHA256] {
+			manifest.Files = append(manifest.Files, *f)
+		}
+	}
+	slices.SortFunc(manifest.Files, func(a, b backupFile) int { return strings.Compare(a.Path, b.Path) })
+	if err := writeSnapshot(ctx, fRepo, manifest); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	b.logger.Info("Snapshot created",
+		zap.String("task", task.Name),
+		zap.String("snapshot", manifest.ID),
+		zap.Int("files", len(manifest.Files)),
+		zap.Int("uploaded", len(pending)-len(failed)),
+	)
+
+	// 4. Forget the snapshots not kept by the retention rules
+	snapshots = append([]*backupManifest{manifest}, snapshots...)
+	kept := getBackupRetentionFromOptions(task.Options).keep(snapshots)
+	referenced := make(map[string]bool)
+	for _, s := range snapshots {
+		if kept[s.ID] {
+			for _, f := range s.Files {
+				referenced[f.SHA256] = true
+			}
+			continue
+		}
+		o, err := fRepo.NewObject(ctx, backupSnapshotPath(s.ID))
+		if err == nil {
+			err = operations.DeleteFile(ctx, o)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to forget snapshot %s: %w", s.ID, err)
+		}
+		b.logger.Info("Snapshot forgotten", zap.String("task", task.Name), zap.String("snapshot", s.ID))
+	}
+
+	// 5. Prune the content referenced by no snapshot, including leftovers of interrupted runs
+	for sum, o := range blobs {
+		if referenced[sum] {
+			continue
+		}
+		if err := operations.DeleteFile(ctx, o); err != nil {
+			return fmt.Errorf("failed to prune repository data: %w", err)
+		}
+	}
+	return nil
+}
+
+// recordFileError counts an error of a single file in the job stats and adds it to the job log.
+func (b *BackupEngine) recordFileError(ctx context.Context, jobID uuid.UUID, path string, size int64, err error) {
+	err = accounting.Stats(ctx).Error(err)
+	if _, logErr := b.sync.jobService.AddJobLog(ctx, jobID, string(model.LogLevelError), string(model.LogActionError), path+": "+err.Error(), size); logErr != nil {
+		b.logger.Error("Failed to add job log", zap.Error(logErr))
+	}
+}
+
+// ListSnapshots returns the snapshots in the repository of a backup task, newest first.
+// The task's connection edge must be loaded.
+func (b *BackupEngine) ListSnapshots(ctx context.Context, task *ent.Task) ([]*model.BackupSnapshot, error) {
+	if task.Edges.Connection == nil {
+		return nil, errs.ConstError("task connection edge not loaded")
+	}
+	fRepo, err := GetFs(ctx, task.Edges.Connection.Name, TaskRemotePath(task))
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := readSnapshots(ctx, fRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*model.BackupSnapshot, 0, len(manifests))
+	for _, m := range manifests {
+		s := &model.BackupSnapshot{ID: m.ID, Time: m.Time, FileCount: len(m.Files)}
+		for _, f := range m.Files {
+			s.TotalBytes += f.Size
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, nil
+}
+
+// RestoreSnapshot writes the files of a snapshot of a backup task to the local directory targetPath.
+//
+// Existing files are overwritten unless their size and modification time already match the snapshot,
+// files not in the snapshot are left alone. The caller must ensure the task is not running, since
+// a run may forget the snapshot while it is restored. The task's connection edge must be loaded.
+func (b *BackupEngine) RestoreSnapshot(ctx context.Context, task *ent.Task, snapshotID, targetPath string) (*model.BackupRestoreResult, error) {
+	if task.Edges.Connection == nil {
+		return nil, errs.ConstError("task connection edge not loaded")
+	}
+	fRepo, err := GetFs(ctx, task.Edges.Connection.Name, TaskRemotePath(task))
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := readSnapshot(ctx, fRepo, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	fTarget, err := GetFs(ctx, "", targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.BackupRestoreResult{SnapshotID: manifest.ID, TargetPath: targetPath}
+	var (
+		mu        sync.Mutex
+		fileErrs  []error
+		transfers = determineTransfers(getSyncOptionsFromTask(task.Options).Transfers, b.sync.defaultTransfers)
+	)
+	forEachParallel(ctx, transfers, len(manifest.Files), func(i int) {
+		f := manifest.Files[i]
+		restored, err := restoreFile(ctx, fRepo, fTarget, f)
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case err != nil:
+			fileErrs = append(fileErrs, fmt.Errorf("%s: %w", f.Path, err))
+		case restored:
+			result.RestoredFiles++
+			result.RestoredBytes += f.Size
+		default:
+			result.SkippedFiles++
+		}
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(fileErrs) > 0 {
+		return nil, fmt.Errorf("failed to restore %d of %d files: %w", len(fileErrs), len(manifest.Files), errors.Join(fileErrs...))
+	}
+
+	b.logger.Info("Snapshot restored",
+		zap.String("task", task.Name),
+		zap.String("snapshot", manifest.ID),
+		zap.String("target", targetPath),
+		zap.Int("restored", result.RestoredFiles),
+		zap.Int("skipped", result.SkippedFiles),
+	)
+	return result, nil
+}
+
+// restoreFile writes a file of a snapshot below fTarget and reports whether it had to be written.
+func restoreFile(ctx context.Context, fRepo, fTarget fs.Fs, f backupFile) (bool, error) {
+	existing, err := fTarget.NewObject(ctx, f.Path)
+	switch {
+	case err == nil:
+		if existing.Size() == f.Size && existing.ModTime(ctx).Equal(f.ModTime) {
+			return false, nil
+		}
+	case errors.Is(err, fs.ErrorObjectNotFound):
+		existing = nil
+	default:
+		return false, err
+	}
+
+	blob, err := fRepo.NewObject(ctx, backupBlobPath(f.SHA256))
+	if err != nil {
+		return false, err
+	}
+	restored, err := operations.Copy(ctx, fTarget, existing, f.Path, blob)
+	if err != nil {
+		return false, err
+	}
+	return true, restored.SetModTime(ctx, f.ModTime)
+}
+
+// backupBlobPath returns the repository path of the content with the given hash.
+func backupBlobPath(sum string) string {
+	return path.Join(backupDataDir, sum[:2], sum)
+}
+
+// backupSnapshotPath returns the repository path of the manifest of a snapshot.
+func backupSnapshotPath(id string) string {
+	return path.Join(backupSnapshotDir, id+".json")
+}
+
+// listBlobs returns the content stored in the repository by hash.
+func listBlobs(ctx context.Context, fRepo fs.Fs) (map[string]fs.Object, error) {
+	var mu sync.Mutex
+	blobs := make(map[string]fs.Object)
+	err := walk.ListR(ctx, fRepo, backupDataDir, true, -1, walk.ListObjects, func(entries fs.DirEntries) error {
+		mu.Lock()
+		defer mu.Unlock()
+		entries.ForObject(func(o fs.Object) {
+			blobs[path.Base(o.Remote())] = o
+		})
+		return nil
+	})
+	if errors.Is(err, fs.ErrorDirNotFound) {
+		return blobs, nil
+	}
+	return blobs, err
+}
+
+// readSnapshots reads every snapshot manifest of the repository, newest first.
+// A manifest that can't be read fails the whole listing, so pruning never deletes content it references.
+func readSnapshots(ctx context.Context, fRepo fs.Fs) ([]*backupManifest, error) {
+	entries, err := fRepo.List(ctx, backupSnapshotDir)
+	if errors.Is(err, fs.ErrorDirNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*backupManifest
+	for _, entry := range entries {
+		o, ok := entry.(fs.Object)
+		if !ok || path.Ext(o.Remote()) != ".json" {
+			continue
+		}
+		m, err := decodeSnapshot(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, m)
+	}
+	slices.SortFunc(snapshots, func(a, b *backupManifest) int { return b.Time.Compare(a.Time) })
+	return snapshots, nil
+}
+
+// readSnapshot reads the manifest of a single snapshot.
+func readSnapshot(ctx context.Context, fRepo fs.Fs, id string) (*backupManifest, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return nil, i18n.NewI18nErrorWithData(i18n.ErrSnapshotNotFound, map[string]interface{}{"Snapshot": id}).WithStatus(404)
+	}
+	o, err := fRepo.NewObject(ctx, backupSnapshotPath(id))
+	if errors.Is(err, fs.ErrorObjectNotFound) || errors.Is(err, fs.ErrorDirNotFound) {
+		return nil, i18n.NewI18nErrorWithData(i18n.ErrSnapshotNotFound, map[string]interface{}{"Snapshot": id}).WithStatus(404)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeSnapshot(ctx, o)
+}
+
+// decodeSnapshot reads and parses a snapshot manifest.
+func decodeSnapshot(ctx context.Context, o fs.Object) (*backupManifest, error) {
+	rc, err := o.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	var m backupManifest
+	if err := json.NewDecoder(rc).Decode(&m); err != nil {
+		return nil, fmt.Errorf("invalid snapshot manifest %s: %w", o.Remote(), err)
+	}
+	return &m, nil
+}
+
+// writeSnapshot stores a snapshot manifest in the repository.
+// It is written directly instead of through operations, so it doesn't show up as a transfer of the job.
+func writeSnapshot(ctx context.Context, fRepo fs.Fs, m *backupManifest) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	info := object.NewStaticObjectInfo(backupSnapshotPath(m.ID), m.Time, int64(len(data)), true, nil, fRepo)
+	_, err = fRepo.Put(ctx, bytes.NewReader(data), info)
+	return err
+}
+
+// forEachParallel calls fn for every index below count, running at most parallel calls at once.
+// It stops starting new calls once ctx is done.
+func forEachParallel(ctx context.Context, parallel, count int, fn func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(parallel, 1))
+	for i := range count {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			fn(i)
+		})
+	}
+	wg.Wait()
+}
+
+var _ ports.SyncEngine = (*BackupEngine)(nil)
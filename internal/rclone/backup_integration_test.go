@@ -0,0 +1,171 @@
+package rclone_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+
+	_ "github.com/rclone/rclone/backend/local"
+)
+
+// countFiles returns the number of regular files below dir.
+func countFiles(t *testing.T, dir string) int {
+	t.Helper()
+	count := 0
+	err := filepath.WalkDir(dir, func(_ string, d os.DirEntry, err error) error {
+		if err == nil && d.Type().IsRegular() {
+			count++
+		}
+		return err
+	})
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+	return count
+}
+
+func TestBackupEngine_RunTask_Integration(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	repoDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a.txt"), []byte("hello"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "b.txt"), []byte("hello"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "sub", "c.txt"), []byte("world"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	keepLast := 2
+	testTask, err := taskService.CreateTask(ctx, "TestBackup", sourceDir, testConn.ID, repoDir,
+		string(model.SyncDirectionUpload), "", false, &model.TaskSyncOptions{BackupKeepLast: &keepLast})
+	require.NoError(t, err)
+	_, err = taskService.SetTaskEngine(ctx, testTask.ID, ports.BackupSyncEngine)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	backupEngine := rclone.NewBackupEngine(rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0))
+	dataDir := filepath.Join(repoDir, "data")
+	snapshotDir := filepath.Join(repoDir, "snapshots")
+
+	// First run stores identical content once
+	require.NoError(t, backupEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	assert.Equal(t, 2, countFiles(t, dataDir), "a.txt and b.txt share their content")
+	assert.Equal(t, 1, countFiles(t, snapshotDir))
+
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, model.JobStatusSuccess, jobs[0].Status)
+	assert.Equal(t, 2, jobs[0].FilesTransferred)
+
+	// Second run only uploads the changed content
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "sub", "c.txt"), []byte("world!"), 0644))
+	require.NoError(t, backupEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	assert.Equal(t, 3, countFiles(t, dataDir))
+	assert.Equal(t, 2, countFiles(t, snapshotDir))
+
+	// Third run forgets the first snapshot and prunes the content only it referenced
+	require.NoError(t, backupEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	assert.Equal(t, 2, countFiles(t, dataDir))
+	assert.Equal(t, 2, countFiles(t, snapshotDir))
+
+	snapshots, err := backupEngine.ListSnapshots(ctx, testTask)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.True(t, snapshots[0].Time.After(snapshots[1].Time), "snapshots are listed newest first")
+	assert.Equal(t, 3, snapshots[1].FileCount)
+	assert.Equal(t, int64(16), snapshots[1].TotalBytes)
+
+	// Restore writes the snapshot, and skips files that are already up to date
+	targetDir := t.TempDir()
+	result, err := backupEngine.RestoreSnapshot(ctx, testTask, snapshots[1].ID, targetDir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.RestoredFiles)
+	assert.Equal(t, int64(16), result.RestoredBytes)
+	content, err := os.ReadFile(filepath.Join(targetDir, "sub", "c.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "world!", string(content))
+	srcInfo, err := os.Stat(filepath.Join(sourceDir, "a.txt"))
+	require.NoError(t, err)
+	restoredInfo, err := os.Stat(filepath.Join(targetDir, "a.txt"))
+	require.NoError(t, err)
+	assert.True(t, srcInfo.ModTime().Equal(restoredInfo.ModTime()), "modification time is restored")
+
+	result, err = backupEngine.RestoreSnapshot(ctx, testTask, snapshots[1].ID, targetDir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.RestoredFiles)
+	assert.Equal(t, 3, result.SkippedFiles)
+
+	_, err = backupEngine.RestoreSnapshot(ctx, testTask, "unknown", targetDir)
+	assert.Error(t, err)
+}
+
+func TestBackupEngine_RunTask_DeferredOverTransferCap(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	repoDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "first.txt"), []byte("first content"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "TestBackupTransferCap", sourceDir, testConn.ID, repoDir,
+		string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	_, err = taskService.SetTaskEngine(ctx, testTask.ID, ports.BackupSyncEngine)
+	require.NoError(t, err)
+	backupEngine := rclone.NewBackupEngine(rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0))
+
+	// The first backup reaches the cap, which only defers the backups after it
+	_, err = connService.SetConnectionTransferCap(ctx, testConn.ID, 1)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+	require.NoError(t, backupEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	snapshotDir := filepath.Join(repoDir, "snapshots")
+	assert.Equal(t, 1, countFiles(t, snapshotDir))
+
+	require.NoError(t, backupEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	assert.Equal(t, 1, countFiles(t, snapshotDir), "deferred jobs don't back up")
+
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, model.JobStatusQuotaDeferred, jobs[0].Status)
+	assert.NotEmpty(t, jobs[0].Errors)
+	assert.Equal(t, model.JobStatusSuccess, jobs[1].Status)
+}
+
+func TestBackupEngine_RunTask_RejectsDownload(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "TestBackupDownload", t.TempDir(), testConn.ID, t.TempDir(),
+		string(model.SyncDirectionDownload), "", false, nil)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	backupEngine := rclone.NewBackupEngine(rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0))
+	require.Error(t, backupEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, model.JobStatusFailed, jobs[0].Status)
+}
@@ -0,0 +1,64 @@
+package rclone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+func TestBackupRetentionKeep(t *testing.T) {
+	// Snapshots newest first: two per day on 2026-10-03, 2026-10-02 and 2026-10-01, one on 2026-09-20
+	at := func(month time.Month, day, hour int) *backupManifest {
+		tm := time.Date(2026, month, day, hour, 0, 0, 0, time.Local)
+		return &backupManifest{ID: tm.Format(time.RFC3339), Time: tm}
+	}
+	snapshots := []*backupManifest{
+		at(10, 3, 18), at(10, 3, 6),
+		at(10, 2, 18), at(10, 2, 6),
+		at(10, 1, 18), at(10, 1, 6),
+		at(9, 20, 12),
+	}
+	ids := func(indexes ...int) map[string]bool {
+		kept := make(map[string]bool)
+		for _, i := range indexes {
+			kept[snapshots[i].ID] = true
+		}
+		return kept
+	}
+
+	tests := []struct {
+		name      string
+		retention BackupRetention
+		expected  map[string]bool
+	}{
+		{"no rules keep everything", BackupRetention{}, ids(0, 1, 2, 3, 4, 5, 6)},
+		{"last", BackupRetention{Last: 3}, ids(0, 1, 2)},
+		{"daily keeps the newest of each day", BackupRetention{Daily: 2}, ids(0, 2)},
+		{"monthly", BackupRetention{Monthly: 2}, ids(0, 6)},
+		{"weekly", BackupRetention{Weekly: 1}, ids(0)},
+		{"rules are combined", BackupRetention{Last: 1, Daily: 3, Monthly: 2}, ids(0, 2, 4, 6)},
+		{"more periods than snapshots", BackupRetention{Daily: 30}, ids(0, 2, 4, 6)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.retention.keep(snapshots))
+		})
+	}
+}
+
+func TestGetBackupRetentionFromOptions(t *testing.T) {
+	assert.True(t, getBackupRetentionFromOptions(nil).IsZero())
+	assert.True(t, getBackupRetentionFromOptions(&model.TaskSyncOptions{}).IsZero())
+
+	last, daily, weekly, monthly := 5, 7, 4, 12
+	r := getBackupRetentionFromOptions(&model.TaskSyncOptions{
+		BackupKeepLast:    &last,
+		BackupKeepDaily:   &daily,
+		BackupKeepWeekly:  &weekly,
+		BackupKeepMonthly: &monthly,
+	})
+	assert.Equal(t, BackupRetention{Last: 5, Daily: 7, Weekly: 4, Monthly: 12}, r)
+	assert.False(t, r.IsZero())
+}
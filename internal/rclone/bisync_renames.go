@@ -0,0 +1,122 @@
+package rclone
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+)
+
+// bisyncListingLine matches a line of a bisync listing file: flags, size, hash, id, modification time and quoted path.
+// It mirrors the unexported listing format of rclone's bisync package.
+var bisyncListingLine = regexp.MustCompile(`^(\S) +(-?\d+) (\S+) (\S+) (\d{4}-\d\d-\d\dT\d\d:\d\d:\d\d\.\d{9}[+-]\d{4}) (".+")$`)
+
+// bisyncListingEntry identifies the content of a file in a bisync listing.
+// Two entries are considered the same file if size, hash and modification time match.
+type bisyncListingEntry struct {
+	size    int64
+	hash    string
+	modTime string
+}
+
+// readBisyncListing reads the files of a bisync listing, keyed by path. Directory entries are skipped.
+func readBisyncListing(name string) (map[string]bisyncListingEntry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries := make(map[string]bisyncListingEntry)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		match := bisyncListingLine.FindStringSubmatch(scanner.Text())
+		// Header lines and directories ("d" flag) are not files
+		if match == nil || match[1] != "-" {
+			continue
+		}
+		size, err := strconv.ParseInt(match[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		path, err := strconv.Unquote(match[6])
+		if err != nil {
+			continue
+		}
+		entries[path] = bisyncListingEntry{size: size, hash: match[3], modTime: match[5]}
+	}
+	return entries, scanner.Err()
+}
+
+// detectListingRenames returns the renames between two listings of the same side, keyed by previous path.
+// A file is renamed if it is gone from the previous listing and a file with the same size, hash and
+// modification time appeared in the current one. Ambiguous matches are ignored.
+func detectListingRenames(previous, current map[string]bisyncListingEntry) map[string]string {
+	gone := make(map[bisyncListingEntry][]string)
+	for path, entry := range previous {
+		if _, ok := current[path]; !ok {
+			gone[entry] = append(gone[entry], path)
+		}
+	}
+	if len(gone) == 0 {
+		return nil
+	}
+	appeared := make(map[bisyncListingEntry][]string)
+	for path, entry := range current {
+		if _, ok := previous[path]; !ok {
+			appeared[entry] = append(appeared[entry], path)
+		}
+	}
+
+	renames := make(map[string]string)
+	for entry, oldPaths := range gone {
+		newPaths := appeared[entry]
+		if len(oldPaths) == 1 && len(newPaths) == 1 {
+			renames[oldPaths[0]] = newPaths[0]
+		}
+	}
+	return renames
+}
+
+// bisyncRenames detects the files renamed or moved on either side during the last bisync run,
+// by comparing the listings of both sides before (".lst-old") and after the run.
+// bisync itself applies such renames as a copy of the new path and a deletion of the old path.
+// Renames that are detected differently on the two sides are ignored.
+func bisyncRenames(basePath string) ([]ports.LogRename, error) {
+	renames := make(map[string]string)
+	conflicts := make(map[string]bool)
+	for _, side := range []string{".path1.lst", ".path2.lst"} {
+		previous, err := readBisyncListing(basePath + side + "-old")
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		current, err := readBisyncListing(basePath + side)
+		if err != nil {
+			return nil, err
+		}
+		for oldPath, newPath := range detectListingRenames(previous, current) {
+			if p, ok := renames[oldPath]; ok && p != newPath {
+				conflicts[oldPath] = true
+			}
+			renames[oldPath] = newPath
+		}
+	}
+
+	result := make([]ports.LogRename, 0, len(renames))
+	for oldPath, newPath := range renames {
+		if !conflicts[oldPath] {
+			result = append(result, ports.LogRename{PreviousPath: oldPath, Path: newPath})
+		}
+	}
+	slices.SortFunc(result, func(a, b ports.LogRename) int { return strings.Compare(a.Path, b.Path) })
+	return result, nil
+}
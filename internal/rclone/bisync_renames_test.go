@@ -0,0 +1,90 @@
+package rclone
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+)
+
+// writeBisyncListing writes a listing file in the bisync format with the given file lines.
+func writeBisyncListing(t *testing.T, name string, lines ...string) {
+	t.Helper()
+	content := "# bisync listing v1 from 2026-10-17T08:00:00.000000000+0000\n"
+	for _, line := range lines {
+		content += line + "\n"
+	}
+	require.NoError(t, os.WriteFile(name, []byte(content), 0600))
+}
+
+func TestReadBisyncListing(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.path1.lst")
+	writeBisyncListing(t, name,
+		`-       16 md5:0123456789abcdef - 2026-10-17T08:00:00.000000000+0000 "docs/report final.txt"`,
+		`d        0 - - 2026-10-17T08:00:00.000000000+0000 "docs"`,
+		`-        0 - - 2026-10-17T08:00:01.000000000+0000 "empty \"quoted\".txt"`,
+	)
+
+	entries, err := readBisyncListing(name)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bisyncListingEntry{
+		"docs/report final.txt": {size: 16, hash: "md5:0123456789abcdef", modTime: "2026-10-17T08:00:00.000000000+0000"},
+		`empty "quoted".txt`:    {size: 0, hash: "-", modTime: "2026-10-17T08:00:01.000000000+0000"},
+	}, entries)
+}
+
+func TestDetectListingRenames(t *testing.T) {
+	a := bisyncListingEntry{size: 10, hash: "-", modTime: "2026-10-17T08:00:00.000000000+0000"}
+	b := bisyncListingEntry{size: 20, hash: "-", modTime: "2026-10-17T08:00:00.000000000+0000"}
+	c := bisyncListingEntry{size: 30, hash: "-", modTime: "2026-10-17T08:00:00.000000000+0000"}
+
+	previous := map[string]bisyncListingEntry{"a.txt": a, "b1.txt": b, "b2.txt": b, "c.txt": c, "same.txt": a}
+	current := map[string]bisyncListingEntry{
+		"moved/a.txt": a,
+		// Ambiguous: two files with the same content are gone
+		"b.txt": b,
+		// The content changed, so it is a different file
+		"c2.txt":   {size: 31, hash: "-", modTime: c.modTime},
+		"same.txt": a,
+	}
+	assert.Equal(t, map[string]string{"a.txt": "moved/a.txt"}, detectListingRenames(previous, current))
+	assert.Empty(t, detectListingRenames(current, current))
+}
+
+func TestBisyncRenames(t *testing.T) {
+	const line = `-       10 - - 2026-10-17T08:00:00.000000000+0000 `
+	basePath := filepath.Join(t.TempDir(), "local..remote")
+
+	t.Run("NoPreviousListings", func(t *testing.T) {
+		writeBisyncListing(t, basePath+".path1.lst", line+`"a.txt"`)
+		writeBisyncListing(t, basePath+".path2.lst", line+`"a.txt"`)
+		renames, err := bisyncRenames(basePath)
+		require.NoError(t, err)
+		assert.Empty(t, renames)
+	})
+
+	t.Run("RenameOnOneSide", func(t *testing.T) {
+		// Path1 renamed a.txt, which bisync then copied and deleted on path2
+		writeBisyncListing(t, basePath+".path1.lst-old", line+`"a.txt"`)
+		writeBisyncListing(t, basePath+".path2.lst-old", line+`"a.txt"`)
+		writeBisyncListing(t, basePath+".path1.lst", line+`"b.txt"`)
+		writeBisyncListing(t, basePath+".path2.lst", line+`"b.txt"`)
+
+		renames, err := bisyncRenames(basePath)
+		require.NoError(t, err)
+		assert.Equal(t, []ports.LogRename{{PreviousPath: "a.txt", Path: "b.txt"}}, renames)
+	})
+
+	t.Run("ConflictingSides", func(t *testing.T) {
+		writeBisyncListing(t, basePath+".path1.lst", line+`"b.txt"`)
+		writeBisyncListing(t, basePath+".path2.lst", line+`"c.txt"`)
+
+		renames, err := bisyncRenames(basePath)
+		require.NoError(t, err)
+		assert.Empty(t, renames)
+	})
+}
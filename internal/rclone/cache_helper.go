@@ -5,6 +5,10 @@ package rclone
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/cache"
@@ -30,6 +34,16 @@ import (
 //	GetFs(ctx, "myremote", "path/to/folder")    // remote path, uses cache
 //	GetFs(ctx, "myremote", "")                  // remote root, uses cache
 func GetFs(ctx context.Context, remote string, path string) (fs.Fs, error) {
+	f, err := getFs(ctx, remote, path)
+	if fi := faultsFromContext(ctx); fi != nil && err == nil {
+		// Jobs run while testing with fault injection, see SyncEngine.SetFaultInjection
+		f = fi.wrapFs(ctx, f)
+	}
+	return f, err
+}
+
+// getFs returns the Fs of GetFs, using rclone's Fs cache for remote paths.
+func getFs(ctx context.Context, remote string, path string) (fs.Fs, error) {
 	if remote == "" {
 		// Direct local path - no caching
 		return fs.NewFs(ctx, path)
@@ -39,10 +53,98 @@ func GetFs(ctx context.Context, remote string, path string) (fs.Fs, error) {
 	fsPath := remote + ":" + path
 
 	newCtx := context.WithoutCancel(ctx)
-	return cache.GetFn(newCtx, fsPath, func(ctx context.Context, fsPath string) (fs.Fs, error) {
+	created := false
+	f, err := cache.GetFn(newCtx, fsPath, func(ctx context.Context, fsPath string) (fs.Fs, error) {
 		logger.Named("rclone.cache").Info("Creating new Fs", zap.String("fsPath", fsPath))
+		created = true
 		return fs.NewFs(ctx, fsPath)
 	})
+	if err == nil || errors.Is(err, fs.ErrorIsFile) {
+		fsCacheIdx.touch(remote, path, created)
+	}
+	return f, err
+}
+
+// FsCacheEntry describes an Fs held in rclone's Fs cache.
+type FsCacheEntry struct {
+	// Remote is the remote (connection) name
+	Remote string
+	// Path is the path within the remote the Fs is rooted at
+	Path string
+	// CreatedAt is when the Fs was created
+	CreatedAt time.Time
+	// LastUsedAt is when the Fs was last returned by GetFs
+	LastUsedAt time.Time
+}
+
+// fsCacheIndex tracks the Fs instances GetFs put into rclone's cache, which cannot be listed itself.
+// rclone expires unused entries on its own, so entries unused for longer than the expire duration are dropped.
+type fsCacheIndex struct {
+	mu      sync.Mutex
+	entries map[string]*FsCacheEntry
+}
+
+var fsCacheIdx = &fsCacheIndex{entries: make(map[string]*FsCacheEntry)}
+
+func (idx *fsCacheIndex) touch(remote, path string, created bool) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	now := time.Now()
+	key := remote + ":" + path
+	entry, ok := idx.entries[key]
+	if !ok || created {
+		entry = &FsCacheEntry{Remote: remote, Path: path, CreatedAt: now}
+		idx.entries[key] = entry
+	}
+	entry.LastUsedAt = now
+}
+
+func (idx *fsCacheIndex) remove(remote string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for key, entry := range idx.entries {
+		if remote == "" || entry.Remote == remote {
+			delete(idx.entries, key)
+		}
+	}
+}
+
+func (idx *fsCacheIndex) list() []FsCacheEntry {
+	expire := time.Duration(fs.GetConfig(context.Background()).FsCacheExpireDuration)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	now := time.Now()
+	entries := make([]FsCacheEntry, 0, len(idx.entries))
+	for key, entry := range idx.entries {
+		if expire > 0 && now.Sub(entry.LastUsedAt) > expire {
+			delete(idx.entries, key)
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+	slices.SortFunc(entries, func(a, b FsCacheEntry) int {
+		if c := strings.Compare(a.Remote, b.Remote); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Path, b.Path)
+	})
+	return entries
+}
+
+// ListFsCache returns the remote Fs instances currently cached by GetFs, sorted by remote name and path.
+func ListFsCache() []FsCacheEntry {
+	return fsCacheIdx.list()
+}
+
+// ClearAllFsCache clears the whole Fs cache, forcing every remote to be recreated with its current config.
+//
+// Returns the number of cache entries that were deleted.
+func ClearAllFsCache() int {
+	n := cache.Entries()
+	cache.Clear()
+	fsCacheIdx.remove("")
+	return n
 }
 
 // ClearFsCache clears the Fs cache for the given remote name.
@@ -56,6 +158,7 @@ func ClearFsCache(remoteName string) int {
 	if remoteName == "" {
 		return 0
 	}
+	fsCacheIdx.remove(remoteName)
 	return cache.ClearConfig(remoteName)
 }
 
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/rclone/rclone/fs/cache"
 	"github.com/stretchr/testify/assert"
@@ -136,3 +137,65 @@ func TestIsConnectionLoaded(t *testing.T) {
 	// through cache.Get or GetFs, which needs a real remote configuration.
 	// This is better tested in integration tests like TestListRemoteDir_CacheBehavior.
 }
+
+// TestListFsCache tests that GetFs records cached remotes and the clear functions drop them
+func TestListFsCache(t *testing.T) {
+	ctx := context.Background()
+	const remote = ":local"
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	t.Cleanup(func() { ClearFsCache(remote) })
+
+	find := func(path string) *FsCacheEntry {
+		for _, e := range ListFsCache() {
+			if e.Remote == remote && e.Path == path {
+				return &e
+			}
+		}
+		return nil
+	}
+
+	_, err := GetFs(ctx, remote, dir1)
+	require.NoError(t, err)
+	_, err = GetFs(ctx, remote, dir2)
+	require.NoError(t, err)
+
+	first := find(dir1)
+	require.NotNil(t, first)
+	assert.NotNil(t, find(dir2))
+	assert.False(t, first.CreatedAt.After(first.LastUsedAt))
+
+	t.Run("reuse keeps creation time", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		_, err := GetFs(ctx, remote, dir1)
+		require.NoError(t, err)
+
+		entry := find(dir1)
+		require.NotNil(t, entry)
+		assert.Equal(t, first.CreatedAt, entry.CreatedAt)
+		assert.True(t, entry.LastUsedAt.After(first.LastUsedAt))
+	})
+
+	t.Run("local paths are not listed", func(t *testing.T) {
+		_, err := GetFs(ctx, "", dir1)
+		require.NoError(t, err)
+		for _, e := range ListFsCache() {
+			assert.NotEmpty(t, e.Remote)
+		}
+	})
+
+	t.Run("clear by remote", func(t *testing.T) {
+		assert.Equal(t, 2, ClearFsCache(remote))
+		assert.Nil(t, find(dir1))
+		assert.Nil(t, find(dir2))
+		assert.False(t, IsConnectionLoaded(remote, dir1))
+	})
+
+	t.Run("clear all", func(t *testing.T) {
+		_, err := GetFs(ctx, remote, dir1)
+		require.NoError(t, err)
+		require.NotNil(t, find(dir1))
+
+		assert.GreaterOrEqual(t, ClearAllFsCache(), 1)
+		assert.Empty(t, ListFsCache())
+	})
+}
@@ -0,0 +1,62 @@
+package rclone
+
+import (
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+// uploadChunking describes how the backend of a task's remote splits large uploads into chunks.
+// rclone accounting only knows the bytes read of a transfer, so the chunk in progress is derived from them.
+type uploadChunking struct {
+	// chunkSize is the size of an upload chunk, zero if the backend doesn't upload in chunks
+	chunkSize int64
+	// cutoff is the size up to which files are uploaded in a single request
+	cutoff int64
+}
+
+// remoteUploadChunking returns the upload chunking of the remote of task, from the chunk_size and
+// upload_cutoff options of its backend as configured for the connection.
+func remoteUploadChunking(task *ent.Task) uploadChunking {
+	conn := task.Edges.Connection
+	if conn == nil {
+		return uploadChunking{}
+	}
+	regInfo, err := fs.Find(string(conn.Type))
+	if err != nil {
+		return uploadChunking{}
+	}
+	m := fs.ConfigMap(regInfo.Prefix, regInfo.Options, conn.Name, nil)
+	chunkSize := sizeOption(m, "chunk_size")
+	if chunkSize <= 0 {
+		return uploadChunking{}
+	}
+	// Backends without an upload cutoff upload files of up to one chunk in a single request
+	cutoff := sizeOption(m, "upload_cutoff")
+	return uploadChunking{chunkSize: chunkSize, cutoff: max(cutoff, chunkSize)}
+}
+
+// sizeOption returns the value of the size option name of m, zero if it isn't set or not a size.
+func sizeOption(m *configmap.Map, name string) int64 {
+	value, ok := m.Get(name)
+	if !ok {
+		return 0
+	}
+	var size fs.SizeSuffix
+	if err := size.Set(value); err != nil {
+		return 0
+	}
+	return int64(size)
+}
+
+// progress returns the chunk in progress, counted from 1, and the number of chunks of an upload of size bytes
+// of which transferred bytes were read, nil if the file is uploaded in a single request.
+func (c uploadChunking) progress(size, transferred int64) (chunk, chunks *int) {
+	if c.chunkSize <= 0 || size <= c.cutoff {
+		return nil, nil
+	}
+	total := int((size + c.chunkSize - 1) / c.chunkSize)
+	current := min(int(transferred/c.chunkSize)+1, total)
+	return &current, &total
+}
@@ -0,0 +1,114 @@
+package rclone
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestRemoteUploadChunking(t *testing.T) {
+	taskFor := func(connType model.ConnectionType) *ent.Task {
+		return &ent.Task{Edges: ent.TaskEdges{Connection: &ent.Connection{Name: "chunking-" + string(connType), Type: connType}}}
+	}
+
+	s3 := remoteUploadChunking(taskFor(model.ConnectionTypeS3))
+	assert.Equal(t, int64(5*fs.Mebi), s3.chunkSize)
+	assert.Equal(t, int64(200*fs.Mebi), s3.cutoff)
+
+	onedrive := remoteUploadChunking(taskFor(model.ConnectionTypeOnedrive))
+	assert.Equal(t, int64(10*fs.Mebi), onedrive.chunkSize)
+	assert.Equal(t, onedrive.chunkSize, onedrive.cutoff, "backends without an upload cutoff chunk files larger than a chunk")
+
+	assert.Zero(t, remoteUploadChunking(taskFor(model.ConnectionTypeLocal)), "local doesn't upload in chunks")
+	assert.Zero(t, remoteUploadChunking(&ent.Task{}), "tasks without a loaded connection have no chunking")
+}
+
+func TestUploadChunkingProgress(t *testing.T) {
+	c := uploadChunking{chunkSize: 10, cutoff: 20}
+
+	chunk, chunks := c.progress(20, 5)
+	assert.Nil(t, chunk, "files up to the cutoff are uploaded in a single request")
+	assert.Nil(t, chunks)
+
+	for _, tt := range []struct {
+		transferred int64
+		chunk       int
+	}{
+		{0, 1},
+		{9, 1},
+		{10, 2},
+		{25, 3},
+		{45, 5},
+	} {
+		chunk, chunks := c.progress(45, tt.transferred)
+		require.NotNil(t, chunk)
+		require.NotNil(t, chunks)
+		assert.Equal(t, tt.chunk, *chunk, "chunk after %d bytes", tt.transferred)
+		assert.Equal(t, 5, *chunks)
+	}
+
+	chunk, chunks = uploadChunking{}.progress(1<<30, 1<<20)
+	assert.Nil(t, chunk, "backends without chunked uploads report no chunks")
+	assert.Nil(t, chunks)
+}
+
+// TestProcessStatsChunks tests that processStats reports the chunk in progress of uploads, but not of downloads
+func TestProcessStatsChunks(t *testing.T) {
+	jobID := uuid.New()
+	bus := subscription.NewTransferProgressBus()
+	bus.Subscribe(nil)
+	engine := NewSyncEngine(new(MockJobService), nil, bus, t.TempDir(), false, 0)
+	engine.logger = zap.NewNop()
+
+	ctx := accounting.WithStatsGroup(context.Background(), jobID.String())
+	stats := accounting.Stats(ctx)
+	src := t.TempDir()
+	localFs, err := fs.NewFs(ctx, src)
+	require.NoError(t, err)
+	remoteFs, err := fs.NewFs(ctx, ":memory:bucket")
+	require.NoError(t, err)
+
+	// 25 of 45 bytes read with 10 byte chunks is the third of five chunks
+	for _, tr := range []*accounting.Transfer{
+		stats.NewTransferRemoteSize("upload.img", 45, localFs, remoteFs),
+		stats.NewTransferRemoteSize("download.img", 45, remoteFs, localFs),
+	} {
+		in := tr.Account(ctx, io.NopCloser(bytes.NewReader(make([]byte, 45))))
+		_, err := io.CopyN(io.Discard, in, 25)
+		require.NoError(t, err)
+	}
+
+	task := &ent.Task{ID: uuid.New(), SourcePath: src, Edges: ent.TaskEdges{Connection: &ent.Connection{ID: uuid.New()}}}
+	logBuf := engine.newJobLogBuffer(jobID)
+	var dirStats directionStats
+	engine.processStats(ctx, jobID, task, time.Now(), logBuf, &dirStats, uploadChunking{chunkSize: 10, cutoff: 10}, nil)
+
+	event := engine.lastTransferEvents[jobID]
+	require.NotNil(t, event)
+	require.Len(t, event.Transfers, 2)
+	for _, item := range event.Transfers {
+		assert.Equal(t, int64(25), item.Bytes)
+		if item.Name == "upload.img" {
+			require.NotNil(t, item.Chunk)
+			require.NotNil(t, item.Chunks)
+			assert.Equal(t, 3, *item.Chunk)
+			assert.Equal(t, 5, *item.Chunks)
+		} else {
+			assert.Nil(t, item.Chunk, "downloads report no chunks")
+			assert.Nil(t, item.Chunks)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package rclone
+
+import (
+	"math"
+	"time"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// statsPollInterval is the time between two polls of the stats of a job.
+const statsPollInterval = time.Second
+
+// maxConcurrencySamples bounds the samples of the concurrency series of a job. Once reached,
+// adjacent samples are merged, so the series stays compact however long the job runs.
+const maxConcurrencySamples = 120
+
+// concurrencySampler records the number of transfers in progress at each stats poll of a job
+// as averages over intervals of polls that double whenever maxConcurrencySamples is reached.
+type concurrencySampler struct {
+	samples []float64
+	// polls is the number of polls averaged by each sample
+	polls int
+	// sum and count accumulate the polls of the sample in progress
+	sum   int
+	count int
+	peak  int
+}
+
+// add records the number of transfers in progress at a poll.
+func (c *concurrencySampler) add(active int) {
+	if c.polls == 0 {
+		c.polls = 1
+	}
+	c.sum += active
+	c.count++
+	c.peak = max(c.peak, active)
+	if c.count < c.polls {
+		return
+	}
+
+	c.samples = append(c.samples, float64(c.sum)/float64(c.count))
+	c.sum, c.count = 0, 0
+	if len(c.samples) < maxConcurrencySamples {
+		return
+	}
+	// Merge pairs of samples, which then span twice as many polls
+	for i := 0; i < len(c.samples)/2; i++ {
+		c.samples[i] = (c.samples[2*i] + c.samples[2*i+1]) / 2
+	}
+	c.samples = c.samples[:len(c.samples)/2]
+	c.polls *= 2
+}
+
+// series returns the recorded samples for a job with the given transfers limit,
+// nil if no transfer was ever in progress.
+func (c *concurrencySampler) series(limit int) *model.JobConcurrency {
+	if c.peak == 0 {
+		return nil
+	}
+	samples := append([]float64(nil), c.samples...)
+	// The last, partial sample averages the polls it has
+	if c.count > 0 {
+		samples = append(samples, float64(c.sum)/float64(c.count))
+	}
+	for i, s := range samples {
+		samples[i] = math.Round(s*100) / 100
+	}
+	return &model.JobConcurrency{
+		Interval: int(time.Duration(max(c.polls, 1)) * statsPollInterval / time.Second),
+		Limit:    limit,
+		Peak:     c.peak,
+		Samples:  samples,
+	}
+}
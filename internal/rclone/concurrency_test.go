@@ -0,0 +1,80 @@
+package rclone
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/rclone/rclone/fstest/mockobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestConcurrencySampler(t *testing.T) {
+	var c concurrencySampler
+	assert.Nil(t, c.series(4), "jobs without transfers have no series")
+
+	for _, active := range []int{2, 4, 3} {
+		c.add(active)
+	}
+	series := c.series(4)
+	require.NotNil(t, series)
+	assert.Equal(t, 1, series.Interval)
+	assert.Equal(t, 4, series.Limit)
+	assert.Equal(t, 4, series.Peak)
+	assert.Equal(t, []float64{2, 4, 3}, series.Samples)
+
+	t.Run("Downsampling", func(t *testing.T) {
+		var c concurrencySampler
+		// 5 minutes alternating between 1 and 2 transfers in progress
+		polls := 300
+		for i := 0; i < polls; i++ {
+			c.add(1 + i%2)
+		}
+		series := c.series(8)
+		require.NotNil(t, series)
+		assert.Equal(t, 4, series.Interval, "samples are merged twice to stay within the limit")
+		assert.LessOrEqual(t, len(series.Samples), maxConcurrencySamples)
+		assert.Len(t, series.Samples, polls/4)
+		for _, s := range series.Samples {
+			assert.InDelta(t, 1.5, s, 0.001)
+		}
+		assert.Equal(t, 2, series.Peak)
+	})
+
+	t.Run("PartialSample", func(t *testing.T) {
+		var c concurrencySampler
+		for i := 0; i < maxConcurrencySamples+1; i++ {
+			c.add(3)
+		}
+		series := c.series(3)
+		require.NotNil(t, series)
+		assert.Equal(t, 2, series.Interval)
+		assert.Len(t, series.Samples, maxConcurrencySamples/2+1, "the last poll is a sample of its own")
+		assert.Equal(t, 3.0, series.Samples[len(series.Samples)-1])
+	})
+}
+
+// TestProcessStatsConcurrency tests that processStats counts the file transfers in progress, but not checks
+func TestProcessStatsConcurrency(t *testing.T) {
+	jobID := uuid.New()
+	engine := NewSyncEngine(new(MockJobService), nil, nil, t.TempDir(), false, 0)
+	engine.logger = zap.NewNop()
+
+	ctx := accounting.WithStatsGroup(context.Background(), jobID.String())
+	stats := accounting.Stats(ctx)
+	stats.NewTransfer(mockobject.Object("a.txt"), nil)
+	stats.NewTransfer(mockobject.Object("b.txt"), nil)
+	stats.NewTransfer(mockobject.Object("done.txt"), nil).Done(ctx, nil)
+	stats.NewCheckingTransfer(mockobject.Object("checking.txt"), "checking")
+
+	logBuf := engine.newJobLogBuffer(jobID)
+	var dirStats directionStats
+	active := engine.processStats(ctx, jobID, &ent.Task{ID: uuid.New()}, time.Now(), logBuf, &dirStats, uploadChunking{}, nil)
+	assert.Equal(t, 2, active)
+}
@@ -0,0 +1,151 @@
+package rclone
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/unknwon/goconfig"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+)
+
+// The rclone.conf encryption format, as read and written by "rclone config encryption":
+// the marker line followed by the base64 encoded nonce and NaCl secretbox of the plain config.
+// rclone's own config.Decrypt isn't used as it keeps the key in a global and may prompt on stdin.
+const (
+	encryptedConfMarker = "RCLONE_ENCRYPT_V0:"
+	encryptedConfHeader = "# Encrypted rclone configuration File"
+	nonceSize           = 24
+)
+
+const (
+	// ErrConfigPasswordRequired is returned by DecryptRcloneConf for an encrypted rclone.conf without a password.
+	ErrConfigPasswordRequired = errs.ConstError("rclone.conf is encrypted, a config password is required")
+	// ErrConfigPasswordIncorrect is returned by DecryptRcloneConf if the password doesn't decrypt the rclone.conf.
+	ErrConfigPasswordIncorrect = errs.ConstError("incorrect rclone.conf config password")
+	// ErrConfigPasswordInvalid is returned for a password rclone doesn't accept, such as an empty or blank one.
+	ErrConfigPasswordInvalid = errs.ConstError("invalid rclone.conf config password")
+)
+
+// DecryptRcloneConf returns the plain content of an rclone.conf encrypted with password.
+// Plain content is returned as it is, whatever the password.
+func DecryptRcloneConf(content, password string) (string, error) {
+	payload, ok := encryptedConfPayload(content)
+	if !ok {
+		return content, nil
+	}
+	if password == "" {
+		return "", ErrConfigPasswordRequired
+	}
+	key, err := configKey(password)
+	if err != nil {
+		return "", err
+	}
+
+	box, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode encrypted rclone.conf: %w", err)
+	}
+	if len(box) < nonceSize+secretbox.Overhead {
+		return "", errs.ConstError("encrypted rclone.conf is too short")
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], box[:nonceSize])
+	plain, ok := secretbox.Open(nil, box[nonceSize:], &nonce, key)
+	if !ok {
+		return "", ErrConfigPasswordIncorrect
+	}
+	return string(plain), nil
+}
+
+// EncryptRcloneConf encrypts the rclone.conf content with password, so that rclone reads it
+// after asking for the config password.
+func EncryptRcloneConf(content, password string) (string, error) {
+	key, err := configKey(password)
+	if err != nil {
+		return "", err
+	}
+	var nonce [nonceSize]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return "", err
+	}
+	box := secretbox.Seal(nonce[:], []byte(content), &nonce, key)
+
+	var b strings.Builder
+	b.WriteString(encryptedConfHeader + "\n\n" + encryptedConfMarker + "\n")
+	b.WriteString(base64.StdEncoding.EncodeToString(box))
+	b.WriteString("\n")
+	return b.String(), nil
+}
+
+// FormatRcloneConf writes the connections as rclone.conf content, one section per connection
+// with the type first. It is the reverse of ParseRcloneConf.
+func FormatRcloneConf(connections []ParsedConnection) (string, error) {
+	cfg, err := goconfig.LoadFromData(nil)
+	if err != nil {
+		return "", err
+	}
+	for _, conn := range connections {
+		cfg.SetValue(conn.Name, "type", conn.Type)
+		keys := make([]string, 0, len(conn.Config))
+		for key := range conn.Config {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			if key != "type" {
+				cfg.SetValue(conn.Name, key, conn.Config[key])
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := goconfig.SaveConfigData(cfg, &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// encryptedConfPayload returns the base64 payload of an encrypted rclone.conf.
+// Like rclone, the marker must be the first line that is neither empty nor a comment.
+func encryptedConfPayload(content string) (string, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line != encryptedConfMarker {
+			return "", false
+		}
+		var payload strings.Builder
+		for scanner.Scan() {
+			payload.WriteString(strings.TrimSpace(scanner.Text()))
+		}
+		return payload.String(), true
+	}
+	return "", false
+}
+
+// configKey derives the secretbox key from the config password the way rclone does.
+func configKey(password string) (*[32]byte, error) {
+	if !utf8.ValidString(password) || strings.TrimSpace(password) == "" {
+		return nil, ErrConfigPasswordInvalid
+	}
+	password = norm.NFKC.String(password)
+	if password == "" {
+		return nil, ErrConfigPasswordInvalid
+	}
+	key := sha256.Sum256([]byte("[" + password + "][rclone-config]"))
+	return &key, nil
+}
@@ -0,0 +1,87 @@
+package rclone
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rclone/rclone/fs/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const plainConf = `[remote]
+type = local
+`
+
+func TestDecryptRcloneConf(t *testing.T) {
+	t.Run("encrypted by rclone", func(t *testing.T) {
+		require.NoError(t, config.SetConfigPassword("s3cret"))
+		defer config.ClearConfigPassword()
+		var buf bytes.Buffer
+		require.NoError(t, config.Encrypt(strings.NewReader(plainConf), &buf))
+
+		content, err := DecryptRcloneConf(buf.String(), "s3cret")
+		require.NoError(t, err)
+		assert.Equal(t, plainConf, content)
+
+		_, err = DecryptRcloneConf(buf.String(), "wrong")
+		assert.ErrorIs(t, err, ErrConfigPasswordIncorrect)
+
+		_, err = DecryptRcloneConf(buf.String(), "")
+		assert.ErrorIs(t, err, ErrConfigPasswordRequired)
+
+		_, err = DecryptRcloneConf(buf.String(), "   ")
+		assert.ErrorIs(t, err, ErrConfigPasswordInvalid)
+	})
+
+	t.Run("plain content", func(t *testing.T) {
+		content, err := DecryptRcloneConf(plainConf, "ignored")
+		require.NoError(t, err)
+		assert.Equal(t, plainConf, content)
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		_, err := DecryptRcloneConf(encryptedConfMarker+"\nAAAA\n", "s3cret")
+		assert.Error(t, err)
+		_, err = DecryptRcloneConf(encryptedConfMarker+"\n!!!\n", "s3cret")
+		assert.Error(t, err)
+	})
+}
+
+func TestEncryptRcloneConf(t *testing.T) {
+	content, err := EncryptRcloneConf(plainConf, "s3cret")
+	require.NoError(t, err)
+	assert.NotContains(t, content, "type = local")
+
+	// rclone reads the encrypted config with the password
+	require.NoError(t, config.SetConfigPassword("s3cret"))
+	defer config.ClearConfigPassword()
+	r, err := config.Decrypt(strings.NewReader(content))
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, plainConf, string(decrypted))
+
+	_, err = EncryptRcloneConf(plainConf, "")
+	assert.ErrorIs(t, err, ErrConfigPasswordInvalid)
+}
+
+func TestFormatRcloneConf(t *testing.T) {
+	connections := []ParsedConnection{
+		{Name: "local", Type: "local", Config: map[string]string{"type": "local", "links": "true"}},
+		{Name: "s3", Type: "s3", Config: map[string]string{"provider": "AWS", "access_key_id": "key"}},
+	}
+	content, err := FormatRcloneConf(connections)
+	require.NoError(t, err)
+	assert.Less(t, strings.Index(content, "type = s3"), strings.Index(content, "access_key_id = key"))
+
+	parsed, err := ParseRcloneConf(content)
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	assert.Equal(t, "local", parsed[0].Name)
+	assert.Equal(t, map[string]string{"type": "local", "links": "true"}, parsed[0].Config)
+	assert.Equal(t, "s3", parsed[1].Name)
+	assert.Equal(t, map[string]string{"type": "s3", "provider": "AWS", "access_key_id": "key"}, parsed[1].Config)
+}
@@ -0,0 +1,67 @@
+package rclone
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rclone/rclone/cmd/bisync/bilib"
+	"github.com/rclone/rclone/fs"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+// configSnapshot returns the effective configuration of a run of task with opts, after defaults and fallbacks
+// like disabling trackRenames are applied. The bandwidth limit is taken from the rclone config of ctx.
+// The resync flag is left to the caller, as it's only known to bidirectional runs.
+func configSnapshot(ctx context.Context, task *ent.Task, opts SyncOptions, transfers int) *model.JobConfigSnapshot {
+	bidirectional := task.Direction == model.SyncDirectionBidirectional
+	snapshot := &model.JobConfigSnapshot{
+		Direction:          task.Direction,
+		Transfers:          transfers,
+		Filters:            append(windowsNameRules(opts.WindowsNames), opts.Filters...),
+		NoDelete:           opts.NoDelete && !bidirectional,
+		Shards:             1,
+		TrackRenames:       opts.TrackRenames && !bidirectional,
+		PreserveMetadata:   opts.PreserveMetadata,
+		SkipZeroByteFiles:  opts.SkipZeroByteFiles,
+		CreateEmptySrcDirs: opts.createEmptySrcDirs(bidirectional),
+	}
+	if snapshot.Filters == nil {
+		snapshot.Filters = []string{}
+	}
+	if opts.Shards > 1 && !bidirectional {
+		snapshot.Shards = opts.Shards
+	}
+	if opts.MaxDuration > 0 {
+		minutes := int(opts.MaxDuration.Minutes())
+		snapshot.MaxDurationMinutes = &minutes
+	}
+	if opts.WindowsNames != "" {
+		windowsNames := opts.WindowsNames
+		snapshot.WindowsNames = &windowsNames
+	}
+	if deletesGuarded(task.Direction, opts) {
+		confirmDeletesOver := opts.ConfirmDeletesOver
+		snapshot.ConfirmDeletesOver = &confirmDeletesOver
+	}
+	bwLimit := fs.GetConfig(ctx).BwLimit
+	if slices.ContainsFunc(bwLimit, func(slot fs.BwTimeSlot) bool { return slot.Bandwidth.IsSet() }) {
+		limit := bwLimit.String()
+		snapshot.BwLimit = &limit
+	}
+	if bidirectional {
+		conflictResolution := model.ConflictResolutionNewer
+		if task.Options != nil && task.Options.ConflictResolution != nil && task.Options.ConflictResolution.IsValid() {
+			conflictResolution = *task.Options.ConflictResolution
+		}
+		snapshot.ConflictResolution = &conflictResolution
+	}
+	return snapshot
+}
+
+// bisyncListings returns the base path of the bisync listings of f1 and f2 in workDir and whether
+// they are missing, in which case bisync has to resync.
+func bisyncListings(ctx context.Context, workDir string, f1, f2 fs.Fs) (basePath string, resync bool) {
+	basePath = bilib.BasePath(ctx, workDir, f1, f2)
+	return basePath, !bilib.FileExists(basePath+".path1.lst") || !bilib.FileExists(basePath+".path2.lst")
+}
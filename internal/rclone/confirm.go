@@ -0,0 +1,291 @@
+package rclone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/lib/transform"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"go.uber.org/zap"
+)
+
+const (
+	// ErrJobNotWaiting is returned by ConfirmJob and AbortJob for a job that isn't waiting for confirmation.
+	ErrJobNotWaiting = errs.ConstError("job is not waiting for confirmation")
+
+	// errDeletesAborted is the run error of a job whose deletions were aborted with AbortJob.
+	errDeletesAborted = errs.ConstError("deletions were aborted")
+)
+
+// countDeletes counts the objects of fDst that a one-way sync from fSrc deletes, which are those missing in fSrc.
+// Renames tracked with trackRenames are counted too, although they are moved instead.
+// Filter rules and name transforms are taken from ctx.
+func countDeletes(ctx context.Context, fSrc, fDst fs.Fs) (int64, error) {
+	dst, err := listObjects(ctx, fDst)
+	if err != nil {
+		return 0, err
+	}
+	src, err := listObjects(ctx, fSrc)
+	if err != nil {
+		return 0, err
+	}
+
+	names := make(map[string]bool, len(src))
+	for remote := range src {
+		names[transform.Path(ctx, remote, false)] = true
+	}
+	var n int64
+	for remote := range dst {
+		if !names[remote] {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DeleteAnomalyOptions configures flagging one-way runs that delete far more files than their task usually does,
+// e.g. after the source was unmounted or emptied by mistake.
+type DeleteAnomalyOptions struct {
+	// Factor flags runs deleting more than Factor times the typical deletions of the task, 0 disables flagging.
+	Factor int
+	// Min is the number of deletions a run must exceed to be flagged, so tasks that usually delete nothing
+	// aren't flagged for a few deletions.
+	Min int
+}
+
+const (
+	// deleteHistoryRuns is the number of successful runs the typical deletions of a task are based on.
+	deleteHistoryRuns = 20
+	// deleteHistoryMinRuns is the number of successful runs a task needs before its runs are flagged.
+	deleteHistoryMinRuns = 5
+)
+
+// SetDeleteAnomalyOptions configures flagging runs that delete far more files than their task usually does:
+// a DELETE_ANOMALY task event and a warning job log are recorded, and runs of tasks with confirmDeletesOver
+// wait for confirmation even if they are within the limit. Flagging is disabled until it is called.
+func (e *SyncEngine) SetDeleteAnomalyOptions(opts DeleteAnomalyOptions) {
+	e.deleteAnomaly = opts
+}
+
+// anomalous reports whether deleting deletes files is anomalous for a task that usually deletes typical files.
+func (o DeleteAnomalyOptions) anomalous(deletes, typical int64) bool {
+	return o.Factor > 0 && deletes > int64(o.Min) && deletes > int64(o.Factor)*max(typical, 1)
+}
+
+// typicalDeletes returns the typical deletions of a one-way task, see JobService.TypicalDeletes.
+// It returns false if runs of the task aren't checked for anomalous deletions: flagging is disabled, the task
+// has too few successful runs, or counting the deletions isn't cheap and the run doesn't count them anyway.
+func (e *SyncEngine) typicalDeletes(ctx context.Context, task *ent.Task, pairs []syncPair, opts SyncOptions) (int64, bool) {
+	if e.deleteAnomaly.Factor <= 0 || task.Direction == model.SyncDirectionBidirectional || opts.NoDelete {
+		return 0, false
+	}
+	if !deletesGuarded(task.Direction, opts) {
+		for _, pair := range pairs {
+			fSrc, fDst := pair.sides(task.Direction)
+			if !sizingCheap(fSrc) || !sizingCheap(fDst) {
+				return 0, false
+			}
+		}
+	}
+	typical, runs, err := e.jobService.TypicalDeletes(ctx, task.ID, deleteHistoryRuns)
+	if err != nil {
+		e.logger.Warn("Failed to get typical deletions", zap.String("task_id", task.ID.String()), zap.Error(err))
+		return 0, false
+	}
+	return typical, runs >= deleteHistoryMinRuns
+}
+
+// guardDeletes checks the deletions of a one-way job across its pairs of directories before it syncs.
+// Deletions far above the typical deletions of the task are flagged, see SetDeleteAnomalyOptions.
+// A job of a task guarded by confirmDeletesOver that would delete more than its limit, or that is flagged,
+// is paused until ConfirmJob or AbortJob is called for it.
+// It returns errDeletesAborted if the deletions were aborted, and the error of ctx if it is done while waiting.
+// Failing to count the deletions fails a guarded job, since it can't be verified that they are within the limit.
+func (e *SyncEngine) guardDeletes(ctx context.Context, jobEntity *ent.Job, task *ent.Task, pairs []syncPair, opts SyncOptions) error {
+	guarded := deletesGuarded(task.Direction, opts)
+	typical, checked := e.typicalDeletes(ctx, task, pairs, opts)
+	if !guarded && !checked {
+		return nil
+	}
+
+	deletes, err := countPairDeletes(ctx, task, pairs, opts)
+	if err != nil {
+		if !guarded {
+			e.logger.Warn("Failed to count deletions", zap.Stringer("job_id", jobEntity.ID), zap.Error(err))
+			return nil
+		}
+		return err
+	}
+	anomalous := checked && e.deleteAnomaly.anomalous(deletes, typical)
+	if anomalous {
+		e.flagDeleteAnomaly(ctx, jobEntity, task, deletes, typical)
+	}
+
+	var msg string
+	switch {
+	case guarded && deletes > int64(opts.ConfirmDeletesOver):
+		msg = fmt.Sprintf("sync would delete %d files, more than the limit of %d: waiting for confirmation", deletes, opts.ConfirmDeletesOver)
+	case guarded && anomalous:
+		msg = fmt.Sprintf("sync would delete %d files, far more than the %d files it usually deletes: waiting for confirmation", deletes, typical)
+	default:
+		return nil
+	}
+	return e.waitForConfirmation(ctx, jobEntity, task, deletes, msg)
+}
+
+// countPairDeletes counts the deletions of a one-way sync across the pairs of directories of a task, see countDeletes.
+func countPairDeletes(ctx context.Context, task *ent.Task, pairs []syncPair, opts SyncOptions) (int64, error) {
+	var deletes int64
+	for _, pair := range pairs {
+		pairOpts := opts
+		pairOpts.Filters = pair.Filters
+		countCtx, err := applySyncFilters(ctx, pairOpts)
+		if err != nil {
+			return 0, i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
+		}
+		countCtx = withWindowsNames(countCtx, pairOpts)
+		fSrc, fDst := pair.sides(task.Direction)
+		n, err := countDeletes(countCtx, fSrc, fDst)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count deletions: %w", err)
+		}
+		deletes += n
+	}
+	return deletes, nil
+}
+
+// flagDeleteAnomaly records a DELETE_ANOMALY task event and a warning job log for a job that deletes
+// far more files than its task usually does. Failures are only logged.
+func (e *SyncEngine) flagDeleteAnomaly(ctx context.Context, jobEntity *ent.Job, task *ent.Task, deletes, typical int64) {
+	e.logger.Warn("Job deletes far more files than usual",
+		zap.Stringer("job_id", jobEntity.ID),
+		zap.String("task", task.Name),
+		zap.Int64("deletes", deletes),
+		zap.Int64("typical", typical))
+	msg := fmt.Sprintf("sync deletes %d files, the task usually deletes %d", deletes, typical)
+	if err := e.jobService.RecordDeleteAnomaly(ctx, task.ID, msg); err != nil {
+		e.logger.Error("Failed to record task event", zap.Error(err))
+	}
+	if _, err := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelWarning), string(model.LogActionUnknown), msg, 0); err != nil {
+		e.logger.Error("Failed to add job log", zap.Error(err))
+	}
+}
+
+// waitForConfirmation pauses a job in WAITING_CONFIRMATION, logging msg, until ConfirmJob or AbortJob is called for it.
+// It returns errDeletesAborted if the deletions were aborted, and the error of ctx if it is done while waiting.
+func (e *SyncEngine) waitForConfirmation(ctx context.Context, jobEntity *ent.Job, task *ent.Task, deletes int64, msg string) error {
+	decision := make(chan bool, 1)
+	e.confirmMu.Lock()
+	e.confirmations[jobEntity.ID] = decision
+	e.confirmMu.Unlock()
+	stopWaiting := func() {
+		e.confirmMu.Lock()
+		delete(e.confirmations, jobEntity.ID)
+		e.confirmMu.Unlock()
+	}
+
+	e.logger.Warn("Job waits for confirmation of deletions",
+		zap.Stringer("job_id", jobEntity.ID),
+		zap.Int64("deletes", deletes))
+	if _, err := e.jobService.UpdateJobStatus(ctx, jobEntity.ID, string(model.JobStatusWaitingConfirmation), ""); err != nil {
+		stopWaiting()
+		return err
+	}
+	if _, err := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelWarning), string(model.LogActionUnknown), msg, 0); err != nil {
+		e.logger.Error("Failed to add job log", zap.Error(err))
+	}
+	e.broadcastStatus(jobEntity, task, model.JobStatusWaitingConfirmation)
+
+	var confirmed bool
+	select {
+	case <-ctx.Done():
+	case confirmed = <-decision:
+	}
+	stopWaiting()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !confirmed {
+		e.logger.Info("Deletions aborted", zap.Stringer("job_id", jobEntity.ID))
+		return errDeletesAborted
+	}
+	e.logger.Info("Deletions confirmed", zap.Stringer("job_id", jobEntity.ID))
+	e.broadcastStatus(jobEntity, task, model.JobStatusRunning)
+	return nil
+}
+
+// broadcastStatus broadcasts a status change of a running job, keeping the totals of its sizing pass.
+func (e *SyncEngine) broadcastStatus(jobEntity *ent.Job, task *ent.Task, status model.JobStatus) {
+	files, bytes := e.applyWorkingSet(jobEntity.ID, 0, 0)
+	e.broadcastJobUpdate(&model.JobProgressEvent{
+		JobID:        jobEntity.ID,
+		TaskID:       task.ID,
+		ConnectionID: task.Edges.Connection.ID,
+		Status:       status,
+		FilesTotal:   int(files),
+		BytesTotal:   bytes,
+		StartTime:    jobEntity.StartTime,
+	})
+}
+
+// runningStatus returns the status of a running job for its progress events.
+func (e *SyncEngine) runningStatus(jobID uuid.UUID) model.JobStatus {
+	e.confirmMu.Lock()
+	defer e.confirmMu.Unlock()
+	if _, ok := e.confirmations[jobID]; ok {
+		return model.JobStatusWaitingConfirmation
+	}
+	return model.JobStatusRunning
+}
+
+// ConfirmJob confirms the deletions of a job waiting for confirmation, which then continues to sync.
+// It returns ErrJobNotWaiting if the job isn't waiting for confirmation.
+func (e *SyncEngine) ConfirmJob(ctx context.Context, jobID uuid.UUID) error {
+	decision, err := e.takeConfirmation(jobID)
+	if err != nil {
+		return err
+	}
+	if _, err := e.jobService.UpdateJobStatus(ctx, jobID, string(model.JobStatusRunning), ""); err != nil {
+		e.logger.Error("Failed to update job status", zap.Stringer("job_id", jobID), zap.Error(err))
+	}
+	decision <- true
+	return nil
+}
+
+// AbortJob aborts a job waiting for confirmation, which is then cancelled without syncing.
+// It returns ErrJobNotWaiting if the job isn't waiting for confirmation.
+func (e *SyncEngine) AbortJob(ctx context.Context, jobID uuid.UUID) error {
+	decision, err := e.takeConfirmation(jobID)
+	if err != nil {
+		return err
+	}
+	if _, err := e.jobService.UpdateJobStatus(ctx, jobID, string(model.JobStatusCancelled), i18n.Ctx(ctx, i18n.ErrJobDeletesAborted)); err != nil {
+		e.logger.Error("Failed to update job status", zap.Stringer("job_id", jobID), zap.Error(err))
+	}
+	decision <- false
+	return nil
+}
+
+// takeConfirmation removes the pending confirmation of a job, so it is decided once.
+// The job keeps reporting WAITING_CONFIRMATION until it picks up the decision.
+func (e *SyncEngine) takeConfirmation(jobID uuid.UUID) (chan<- bool, error) {
+	e.confirmMu.Lock()
+	defer e.confirmMu.Unlock()
+	decision, ok := e.confirmations[jobID]
+	if !ok || decision == nil {
+		return nil, ErrJobNotWaiting
+	}
+	// A nil entry keeps the job waiting until it receives the decision
+	e.confirmations[jobID] = nil
+	return decision, nil
+}
+
+// deletesGuarded reports whether runs of a one-way task are guarded by confirmDeletesOver.
+func deletesGuarded(direction model.SyncDirection, opts SyncOptions) bool {
+	return direction != model.SyncDirectionBidirectional && !opts.NoDelete && opts.ConfirmDeletesOver > 0
+}
@@ -0,0 +1,163 @@
+package rclone
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// DuplicateOptions configures FindDuplicates.
+type DuplicateOptions struct {
+	// Mode is how duplicates are matched. HASH falls back to SIZE_NAME if the remote supports no hash.
+	Mode model.DuplicateMatchMode
+	// Keep is the cleanup rule deciding which file of a group is kept. Nil only produces a report.
+	Keep *model.DuplicateKeepRule
+	// DryRun reports which files the cleanup rule would delete without deleting them.
+	DryRun bool
+}
+
+// FindDuplicates scans f recursively for duplicate files and optionally deletes all but one file per group.
+//
+// In HASH mode only files sharing their size with another file are hashed, so unique files are never read.
+// Files without a usable hash are left out of the hash groups.
+func FindDuplicates(ctx context.Context, f fs.Fs, opts DuplicateOptions) (*model.DuplicateReport, error) {
+	var (
+		mu      sync.Mutex
+		objects []fs.Object
+	)
+	err := operations.ListFn(ctx, f, func(o fs.Object) {
+		mu.Lock()
+		objects = append(objects, o)
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.DuplicateReport{
+		Mode:         opts.Mode,
+		ScannedFiles: len(objects),
+		Groups:       []*model.DuplicateGroup{},
+	}
+
+	var groups map[string][]fs.Object
+	if opts.Mode == model.DuplicateMatchModeHash {
+		ht := f.Hashes().GetOne()
+		if ht == hash.None {
+			report.Mode = model.DuplicateMatchModeSizeName
+		} else {
+			name := ht.String()
+			report.HashType = &name
+			groups, err = groupByHash(ctx, objects, ht)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if report.Mode == model.DuplicateMatchModeSizeName {
+		groups = groupBy(objects, func(o fs.Object) string {
+			return fmt.Sprintf("%d/%s", o.Size(), path.Base(o.Remote()))
+		})
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key, objs := range groups {
+		if len(objs) > 1 {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		objs := groups[key]
+		if opts.Keep != nil {
+			sortByKeepRule(ctx, objs, *opts.Keep)
+		}
+
+		group := &model.DuplicateGroup{Key: key, Size: objs[0].Size()}
+		for i, o := range objs {
+			file := &model.DuplicateFile{
+				Path:    o.Remote(),
+				Size:    o.Size(),
+				ModTime: o.ModTime(ctx),
+				Keep:    opts.Keep == nil || i == 0,
+			}
+			if !file.Keep && !opts.DryRun {
+				if err := operations.DeleteFile(ctx, o); err != nil {
+					msg := err.Error()
+					file.Error = &msg
+				} else {
+					file.Deleted = true
+					report.DeletedFiles++
+				}
+			}
+			group.Files = append(group.Files, file)
+		}
+
+		report.DuplicateFiles += len(objs) - 1
+		report.ReclaimableBytes += int64(len(objs)-1) * group.Size
+		report.Groups = append(report.Groups, group)
+	}
+
+	return report, nil
+}
+
+// groupByHash groups objects by their hash of type ht, only hashing objects whose size is not unique.
+func groupByHash(ctx context.Context, objects []fs.Object, ht hash.Type) (map[string][]fs.Object, error) {
+	groups := make(map[string][]fs.Object)
+	for _, sameSize := range groupBy(objects, func(o fs.Object) string { return fmt.Sprint(o.Size()) }) {
+		if len(sameSize) < 2 {
+			continue
+		}
+		for _, o := range sameSize {
+			sum, err := o.Hash(ctx, ht)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				fs.Errorf(o, "Failed to hash file for duplicate check: %v", err)
+				continue
+			}
+			if sum == "" {
+				continue
+			}
+			groups[sum] = append(groups[sum], o)
+		}
+	}
+	return groups, nil
+}
+
+func groupBy(objects []fs.Object, key func(fs.Object) string) map[string][]fs.Object {
+	groups := make(map[string][]fs.Object)
+	for _, o := range objects {
+		k := key(o)
+		groups[k] = append(groups[k], o)
+	}
+	return groups
+}
+
+// sortByKeepRule sorts objs so the file to keep comes first. Ties are broken by path for stable results.
+func sortByKeepRule(ctx context.Context, objs []fs.Object, rule model.DuplicateKeepRule) {
+	slices.SortStableFunc(objs, func(a, b fs.Object) int {
+		var c int
+		switch rule {
+		case model.DuplicateKeepRuleNewest:
+			c = b.ModTime(ctx).Compare(a.ModTime(ctx))
+		case model.DuplicateKeepRuleShortestPath:
+			c = cmp.Compare(len(a.Remote()), len(b.Remote()))
+		}
+		if c != 0 {
+			return c
+		}
+		return strings.Compare(a.Remote(), b.Remote())
+	})
+}
@@ -0,0 +1,116 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// setupDuplicateTree creates a tree with one group of identical photos, a same-named but different file and a unique file.
+func setupDuplicateTree(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	write := func(name, content string, age time.Duration) {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+		mt := time.Now().Add(-age)
+		require.NoError(t, os.Chtimes(p, mt, mt))
+	}
+	write("photo.jpg", "same-content", 3*time.Hour)
+	write("backup/2024/photo.jpg", "same-content", time.Hour)
+	write("copy/photo (1).jpg", "same-content", 2*time.Hour)
+	write("other/photo.jpg", "diff-content", time.Hour)
+	write("unique.txt", "unique", time.Hour)
+	return dir
+}
+
+func TestFindDuplicates_Hash(t *testing.T) {
+	ctx := context.Background()
+	dir := setupDuplicateTree(t)
+	f, err := fs.NewFs(ctx, dir)
+	require.NoError(t, err)
+
+	report, err := FindDuplicates(ctx, f, DuplicateOptions{Mode: model.DuplicateMatchModeHash, DryRun: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.DuplicateMatchModeHash, report.Mode)
+	require.NotNil(t, report.HashType)
+	assert.Equal(t, 5, report.ScannedFiles)
+	require.Len(t, report.Groups, 1)
+	assert.Len(t, report.Groups[0].Files, 3)
+	assert.Equal(t, 2, report.DuplicateFiles)
+	assert.Equal(t, int64(2*len("same-content")), report.ReclaimableBytes)
+	for _, file := range report.Groups[0].Files {
+		assert.True(t, file.Keep, "without a keep rule all files are kept")
+	}
+}
+
+func TestFindDuplicates_SizeName(t *testing.T) {
+	ctx := context.Background()
+	dir := setupDuplicateTree(t)
+	f, err := fs.NewFs(ctx, dir)
+	require.NoError(t, err)
+
+	report, err := FindDuplicates(ctx, f, DuplicateOptions{Mode: model.DuplicateMatchModeSizeName, DryRun: true})
+	require.NoError(t, err)
+
+	assert.Nil(t, report.HashType)
+	require.Len(t, report.Groups, 1)
+	// Same size and name, regardless of content
+	var paths []string
+	for _, file := range report.Groups[0].Files {
+		paths = append(paths, file.Path)
+	}
+	assert.ElementsMatch(t, []string{"photo.jpg", "backup/2024/photo.jpg", "other/photo.jpg"}, paths)
+}
+
+func TestFindDuplicates_Cleanup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keep newest dry run", func(t *testing.T) {
+		dir := setupDuplicateTree(t)
+		f, err := fs.NewFs(ctx, dir)
+		require.NoError(t, err)
+
+		keep := model.DuplicateKeepRuleNewest
+		report, err := FindDuplicates(ctx, f, DuplicateOptions{Mode: model.DuplicateMatchModeHash, Keep: &keep, DryRun: true})
+		require.NoError(t, err)
+
+		files := report.Groups[0].Files
+		assert.Equal(t, "backup/2024/photo.jpg", files[0].Path)
+		assert.True(t, files[0].Keep)
+		assert.False(t, files[1].Keep)
+		assert.False(t, files[2].Keep)
+		assert.Zero(t, report.DeletedFiles)
+		assert.FileExists(t, filepath.Join(dir, "photo.jpg"))
+	})
+
+	t.Run("keep shortest path", func(t *testing.T) {
+		dir := setupDuplicateTree(t)
+		f, err := fs.NewFs(ctx, dir)
+		require.NoError(t, err)
+
+		keep := model.DuplicateKeepRuleShortestPath
+		report, err := FindDuplicates(ctx, f, DuplicateOptions{Mode: model.DuplicateMatchModeHash, Keep: &keep})
+		require.NoError(t, err)
+
+		files := report.Groups[0].Files
+		assert.Equal(t, "photo.jpg", files[0].Path)
+		assert.Equal(t, 2, report.DeletedFiles)
+		assert.True(t, files[1].Deleted)
+		assert.True(t, files[2].Deleted)
+		assert.FileExists(t, filepath.Join(dir, "photo.jpg"))
+		assert.NoFileExists(t, filepath.Join(dir, "backup/2024/photo.jpg"))
+		assert.NoFileExists(t, filepath.Join(dir, "copy/photo (1).jpg"))
+		assert.FileExists(t, filepath.Join(dir, "other/photo.jpg"))
+	})
+}
@@ -0,0 +1,295 @@
+package rclone
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/hash"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+)
+
+// FaultInjectionEnv is the environment variable that enables fault injection outside production,
+// e.g. "fail_transfers=20,stats_delay=5s,crash_after=30s,crash_jobs=50", see ParseFaultOptions.
+const FaultInjectionEnv = "RCLONESYNC_FAULT_INJECTION"
+
+// faultCrashExitCode is the exit code of the process crashed by fault injection.
+const faultCrashExitCode = 70
+
+// ErrInjectedFault is the error of transfers failed by fault injection.
+const ErrInjectedFault = errs.ConstError("injected fault: transfer failed")
+
+// FaultOptions configures the faults injected into jobs to exercise retries, notifications and stuck job recovery.
+type FaultOptions struct {
+	// FailTransfers is the percentage (0-100) of file transfers that fail.
+	FailTransfers int
+	// StatsDelay delays every collection of the transfer stats of a job, so its progress lags or stalls.
+	StatsDelay time.Duration
+	// CrashAfter makes the process exit abruptly once a job has run this long, leaving the job running in the database.
+	CrashAfter time.Duration
+	// CrashJobs is the percentage (0-100) of jobs that crash the process with CrashAfter.
+	CrashJobs int
+}
+
+// ParseFaultOptions parses comma-separated key=value pairs: fail_transfers (percent), stats_delay (duration),
+// crash_after (duration) and crash_jobs (percent, default 100).
+func ParseFaultOptions(spec string) (FaultOptions, error) {
+	opts := FaultOptions{CrashJobs: 100}
+	for pair := range strings.SplitSeq(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(pair, "=")
+		var err error
+		switch strings.TrimSpace(key) {
+		case "fail_transfers":
+			opts.FailTransfers, err = parsePercent(value)
+		case "stats_delay":
+			opts.StatsDelay, err = time.ParseDuration(strings.TrimSpace(value))
+		case "crash_after":
+			opts.CrashAfter, err = time.ParseDuration(strings.TrimSpace(value))
+		case "crash_jobs":
+			opts.CrashJobs, err = parsePercent(value)
+		default:
+			return FaultOptions{}, fmt.Errorf("unknown fault %q", key) //nolint:err113
+		}
+		if err != nil {
+			return FaultOptions{}, fmt.Errorf("invalid fault %q: %w", pair, err)
+		}
+	}
+	return opts, nil
+}
+
+// parsePercent parses a percentage from 0 to 100.
+func parsePercent(value string) (int, error) {
+	percent, err := strconv.Atoi(strings.TrimSpace(value))
+	if err == nil && (percent < 0 || percent > 100) {
+		err = errs.ConstError("percentage must be between 0 and 100")
+	}
+	return percent, err
+}
+
+// SetFaultInjection makes the engine inject the faults into subsequently started jobs.
+// It must only be used for testing, never in production.
+func (e *SyncEngine) SetFaultInjection(opts FaultOptions) {
+	e.faults = &faultInjector{opts: opts, logger: logger.Named("sync.faults")}
+	e.faults.logger.Warn("Fault injection enabled",
+		zap.Int("fail_transfers", opts.FailTransfers),
+		zap.Duration("stats_delay", opts.StatsDelay),
+		zap.Duration("crash_after", opts.CrashAfter),
+		zap.Int("crash_jobs", opts.CrashJobs))
+}
+
+// exitProcess exits the process crashed by fault injection, replaced in tests.
+var exitProcess = os.Exit
+
+// faultInjector injects the faults of FaultOptions. A nil injector injects no faults.
+type faultInjector struct {
+	opts   FaultOptions
+	logger *zap.Logger
+}
+
+// faultInjectorKey is the context key of the injector whose faults GetFs injects.
+type faultInjectorKey struct{}
+
+// withFaults returns a context making GetFs inject the transfer failures of fi.
+func withFaults(ctx context.Context, fi *faultInjector) context.Context {
+	if fi == nil || fi.opts.FailTransfers <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, faultInjectorKey{}, fi)
+}
+
+// faultsFromContext returns the injector set by withFaults, nil if none.
+func faultsFromContext(ctx context.Context) *faultInjector {
+	fi, _ := ctx.Value(faultInjectorKey{}).(*faultInjector)
+	return fi
+}
+
+// chance reports true with the given percentage.
+func chance(percent int) bool {
+	return percent >= 100 || (percent > 0 && rand.IntN(100) < percent) //nolint:gosec // Not security relevant
+}
+
+// failTransfer reports whether a transfer fails.
+func (fi *faultInjector) failTransfer() bool {
+	return fi != nil && chance(fi.opts.FailTransfers)
+}
+
+// delayStats waits StatsDelay before the stats of a job are collected, or until ctx is done.
+func (fi *faultInjector) delayStats(ctx context.Context) {
+	if fi == nil || fi.opts.StatsDelay <= 0 {
+		return
+	}
+	select {
+	case <-time.After(fi.opts.StatsDelay):
+	case <-ctx.Done():
+	}
+}
+
+// crashDuring makes the process exit after CrashAfter if the job is still running then.
+// The returned function is called when the job ends.
+func (fi *faultInjector) crashDuring(jobID uuid.UUID) (stop func()) {
+	if fi == nil || fi.opts.CrashAfter <= 0 || !chance(fi.opts.CrashJobs) {
+		return func() {}
+	}
+	timer := time.AfterFunc(fi.opts.CrashAfter, func() {
+		fi.logger.Error("Fault injection: crashing the process mid-job", zap.String("job_id", jobID.String()))
+		_ = fi.logger.Sync()
+		exitProcess(faultCrashExitCode)
+	})
+	return func() { timer.Stop() }
+}
+
+// wrapFs returns f with failing transfers.
+func (fi *faultInjector) wrapFs(ctx context.Context, f fs.Fs) fs.Fs {
+	ff := &faultFs{wrapped: f, faults: fi}
+	wrapped := f.Features()
+	ff.features = (&fs.Features{
+		CaseInsensitive:         wrapped.CaseInsensitive,
+		DuplicateFiles:          wrapped.DuplicateFiles,
+		CanHaveEmptyDirectories: wrapped.CanHaveEmptyDirectories,
+		BucketBased:             wrapped.BucketBased,
+		BucketBasedRootOK:       wrapped.BucketBasedRootOK,
+		ServerSideAcrossConfigs: wrapped.ServerSideAcrossConfigs,
+		IsLocal:                 wrapped.IsLocal,
+		SlowModTime:             wrapped.SlowModTime,
+		SlowHash:                wrapped.SlowHash,
+		PartialUploads:          wrapped.PartialUploads,
+		NoMultiThreading:        true,
+	}).Fill(ctx, ff).Mask(ctx, f)
+	return ff
+}
+
+// faultFs wraps an Fs and fails the share of uploads, updates and server-side copies and moves
+// configured by FaultOptions.FailTransfers.
+type faultFs struct {
+	wrapped  fs.Fs
+	faults   *faultInjector
+	features *fs.Features
+}
+
+// Name of the remote (as passed into NewFs)
+func (f *faultFs) Name() string { return f.wrapped.Name() }
+
+// Root of the remote (as passed into NewFs)
+func (f *faultFs) Root() string { return f.wrapped.Root() }
+
+// String returns a description of the FS
+func (f *faultFs) String() string { return f.wrapped.String() }
+
+// Precision of the ModTimes in this Fs
+func (f *faultFs) Precision() time.Duration { return f.wrapped.Precision() }
+
+// Hashes returns the supported hash types of the filesystem
+func (f *faultFs) Hashes() hash.Set { return f.wrapped.Hashes() }
+
+// Features returns the optional features of this Fs
+func (f *faultFs) Features() *fs.Features { return f.features }
+
+// List the objects and directories in dir into entries
+func (f *faultFs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	entries, err := f.wrapped.List(ctx, dir)
+	for i, entry := range entries {
+		if o, ok := entry.(fs.Object); ok {
+			entries[i] = &faultObject{Object: o, fs: f}
+		}
+	}
+	return entries, err
+}
+
+// NewObject finds the Object at remote
+func (f *faultFs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	o, err := f.wrapped.NewObject(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	return &faultObject{Object: o, fs: f}, nil
+}
+
+// Put in to the remote path with the modTime given of the given size
+func (f *faultFs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	if f.faults.failTransfer() {
+		return nil, ErrInjectedFault
+	}
+	o, err := f.wrapped.Put(ctx, in, src, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &faultObject{Object: o, fs: f}, nil
+}
+
+// Copy src to this remote using server-side copy operations
+func (f *faultFs) Copy(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+	if f.faults.failTransfer() {
+		return nil, ErrInjectedFault
+	}
+	o, err := f.wrapped.Features().Copy(ctx, unwrapFaultObject(src), remote)
+	if err != nil {
+		return nil, err
+	}
+	return &faultObject{Object: o, fs: f}, nil
+}
+
+// Move src to this remote using server-side move operations
+func (f *faultFs) Move(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+	if f.faults.failTransfer() {
+		return nil, ErrInjectedFault
+	}
+	o, err := f.wrapped.Features().Move(ctx, unwrapFaultObject(src), remote)
+	if err != nil {
+		return nil, err
+	}
+	return &faultObject{Object: o, fs: f}, nil
+}
+
+// Mkdir makes the directory (container, bucket)
+func (f *faultFs) Mkdir(ctx context.Context, dir string) error {
+	return f.wrapped.Mkdir(ctx, dir)
+}
+
+// Rmdir removes the directory (container, bucket) if empty
+func (f *faultFs) Rmdir(ctx context.Context, dir string) error {
+	return f.wrapped.Rmdir(ctx, dir)
+}
+
+// faultObject wraps an Object of a faultFs, failing updates like the faultFs fails uploads.
+type faultObject struct {
+	fs.Object
+	fs *faultFs
+}
+
+// Fs returns the parent Fs
+func (o *faultObject) Fs() fs.Info { return o.fs }
+
+// Update in to the object with the modTime given of the given size
+func (o *faultObject) Update(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) error {
+	if o.fs.faults.failTransfer() {
+		return ErrInjectedFault
+	}
+	return o.Object.Update(ctx, in, src, options...)
+}
+
+// unwrapFaultObject returns the wrapped Object of a faultObject, for server-side operations of the wrapped Fs.
+func unwrapFaultObject(o fs.Object) fs.Object {
+	if fo, ok := o.(*faultObject); ok {
+		return fo.Object
+	}
+	return o
+}
+
+var _ fs.Fs = (*faultFs)(nil)
+var _ fs.Copier = (*faultFs)(nil)
+var _ fs.Mover = (*faultFs)(nil)
+var _ fs.Object = (*faultObject)(nil)
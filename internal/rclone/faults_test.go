@@ -0,0 +1,118 @@
+package rclone
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/rclone/rclone/backend/local"
+	"github.com/rclone/rclone/fs/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/core/logger"
+)
+
+func TestParseFaultOptions(t *testing.T) {
+	opts, err := ParseFaultOptions("fail_transfers=20, stats_delay=5s,crash_after=1m,crash_jobs=50")
+	require.NoError(t, err)
+	assert.Equal(t, FaultOptions{FailTransfers: 20, StatsDelay: 5 * time.Second, CrashAfter: time.Minute, CrashJobs: 50}, opts)
+
+	opts, err = ParseFaultOptions("crash_after=30s")
+	require.NoError(t, err)
+	assert.Equal(t, FaultOptions{CrashAfter: 30 * time.Second, CrashJobs: 100}, opts, "jobs crash by default")
+
+	for _, spec := range []string{"fail_transfers=101", "fail_transfers=-1", "stats_delay=soon", "crash_after", "explode=1"} {
+		_, err := ParseFaultOptions(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestFaultInjector_NilInjectsNothing(t *testing.T) {
+	var fi *faultInjector
+	assert.False(t, fi.failTransfer())
+	fi.delayStats(context.Background())
+	fi.crashDuring(uuid.New())()
+
+	ctx := context.Background()
+	assert.Equal(t, ctx, withFaults(ctx, fi))
+	assert.Equal(t, ctx, withFaults(ctx, &faultInjector{opts: FaultOptions{StatsDelay: time.Second}}), "only transfer failures are injected by GetFs")
+}
+
+func TestFaultInjector_FailTransfers(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "existing.txt"), []byte("old"), 0644))
+
+	fi := &faultInjector{opts: FaultOptions{FailTransfers: 100}}
+	f, err := GetFs(withFaults(ctx, fi), "", dir)
+	require.NoError(t, err)
+	_, ok := f.(*faultFs)
+	require.True(t, ok, "GetFs wraps the Fs of jobs with fault injection")
+	assert.Equal(t, dir, f.Root())
+
+	content := []byte("new")
+	src := object.NewStaticObjectInfo("new.txt", time.Now(), int64(len(content)), true, nil, nil)
+	_, err = f.Put(ctx, bytes.NewReader(content), src)
+	assert.ErrorIs(t, err, ErrInjectedFault)
+
+	o, err := f.NewObject(ctx, "existing.txt")
+	require.NoError(t, err)
+	assert.Equal(t, f, o.Fs())
+	err = o.Update(ctx, bytes.NewReader(content), object.NewStaticObjectInfo("existing.txt", time.Now(), 3, true, nil, nil))
+	assert.ErrorIs(t, err, ErrInjectedFault)
+
+	entries, err := f.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.IsType(t, &faultObject{}, entries[0])
+
+	// Without failures, transfers go through
+	fi.opts.FailTransfers = 0
+	_, err = f.Put(ctx, bytes.NewReader(content), src)
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, "new.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, content, data)
+
+	plain, err := GetFs(ctx, "", dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, f, plain)
+	_, ok = plain.(*faultFs)
+	assert.False(t, ok, "other Fs are not wrapped")
+}
+
+func TestFaultInjector_CrashDuring(t *testing.T) {
+	exited := make(chan int, 1)
+	exitProcess = func(code int) { exited <- code }
+	t.Cleanup(func() { exitProcess = os.Exit })
+	fi := &faultInjector{opts: FaultOptions{CrashAfter: 10 * time.Millisecond, CrashJobs: 100}, logger: logger.Named("sync.faults")}
+
+	fi.crashDuring(uuid.New())
+	select {
+	case code := <-exited:
+		assert.Equal(t, faultCrashExitCode, code)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the process did not crash")
+	}
+
+	// Jobs ending in time don't crash
+	fi.crashDuring(uuid.New())()
+	select {
+	case <-exited:
+		t.Fatal("the process crashed after the job ended")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	fi.opts.CrashJobs = 0
+	fi.crashDuring(uuid.New())
+	select {
+	case <-exited:
+		t.Fatal("the process crashed although no jobs crash")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -41,6 +41,24 @@ func createFilterFromRules(rules []string) (*filter.Filter, error) {
 	return fi, nil
 }
 
+// ValidateFilterRule validates a single rclone filter rule.
+// Unlike ValidateFilterRules, the returned error is the plain rclone parse error,
+// so callers can attach it to the position of the rule themselves.
+func ValidateFilterRule(rule string) error {
+	fi, err := filter.NewFilter(nil)
+	if err != nil {
+		return err
+	}
+	return fi.AddRule(rule)
+}
+
+// ValidateShardFilterRule reports a filter rule that can't be rebased onto the top-level directories
+// synced by the shards of a sharded sync.
+func ValidateShardFilterRule(rule string) error {
+	_, err := shardFilterRules([]string{rule}, "")
+	return err
+}
+
 // ValidateFilterRules validates a list of rclone filter rules.
 // Each rule should be in the format "- pattern" (exclude) or "+ pattern" (include).
 // Returns nil if all rules are valid, otherwise returns an error with the first invalid rule.
@@ -136,6 +136,12 @@ func TestValidateFilterRules(t *testing.T) {
 	})
 }
 
+func TestValidateFilterRule(t *testing.T) {
+	assert.NoError(t, rclone.ValidateFilterRule("- *.tmp"))
+	assert.NoError(t, rclone.ValidateFilterRule("+ **"))
+	assert.Error(t, rclone.ValidateFilterRule("*.tmp"), "missing +/- prefix")
+}
+
 func TestValidateFilterRules_EdgeCases(t *testing.T) {
 	t.Run("NilRules", func(t *testing.T) {
 		err := rclone.ValidateFilterRules(nil)
@@ -0,0 +1,99 @@
+package rclone
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/hooks"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+)
+
+// SetHookOptions configures running the pre and post hooks of tasks.
+// Hooks are disabled until it is called with allowlisted commands.
+func (e *SyncEngine) SetHookOptions(opts hooks.Options) {
+	e.hooks = hooks.NewExecutor(opts)
+}
+
+// CheckHookCommand returns an error if command may not be run as a task hook.
+func (e *SyncEngine) CheckHookCommand(command string) error {
+	return e.hooks.Check(command)
+}
+
+// hasHooks reports whether the task has a pre or post hook.
+func hasHooks(task *ent.Task) bool {
+	return task.Options != nil && (task.Options.PreHook != nil || task.Options.PostHook != nil)
+}
+
+// runPreHook runs the pre hook of the task, if any, before the job syncs.
+// It returns an error if the hook failed, in which case the job must not sync.
+func (e *SyncEngine) runPreHook(ctx context.Context, jobEntity *ent.Job, task *ent.Task) error {
+	if task.Options == nil || task.Options.PreHook == nil {
+		return nil
+	}
+	if err := e.runHook(ctx, jobEntity, task, model.JobEventTypePreHook, task.Options.PreHook, nil); err != nil {
+		return fmt.Errorf("pre hook failed: %w", err)
+	}
+	return nil
+}
+
+// runPostHook runs the post hook of the task, if any, after the job synced with runErr.
+// Post hooks don't run for cancelled jobs. A failed post hook doesn't fail the job,
+// it is counted as an error of result and logged as a warning.
+func (e *SyncEngine) runPostHook(ctx context.Context, jobEntity *ent.Job, task *ent.Task, runErr error, result *ports.JobResult) {
+	if task.Options == nil || task.Options.PostHook == nil || ctx.Err() != nil {
+		return
+	}
+	status := "success"
+	if runErr != nil {
+		status = "failed"
+	}
+	err := e.runHook(ctx, jobEntity, task, model.JobEventTypePostHook, task.Options.PostHook, map[string]string{"RCLONE_SYNC_STATUS": status})
+	if err == nil {
+		return
+	}
+	result.ErrorCount++
+	msg := "post hook failed: " + err.Error()
+	if _, err := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelWarning), string(model.LogActionUnknown), msg, 0); err != nil {
+		e.logger.Error("Failed to add job log", zap.Error(err))
+	}
+}
+
+// runHook runs a hook of the task and records its result as an event of the job.
+// The hook gets the task and job it runs for in its environment, in addition to env.
+func (e *SyncEngine) runHook(ctx context.Context, jobEntity *ent.Job, task *ent.Task, eventType model.JobEventType, hook *model.TaskHook, env map[string]string) error {
+	vars := map[string]string{
+		"RCLONE_SYNC_TASK_ID":     task.ID.String(),
+		"RCLONE_SYNC_TASK_NAME":   task.Name,
+		"RCLONE_SYNC_JOB_ID":      jobEntity.ID.String(),
+		"RCLONE_SYNC_TRIGGER":     string(jobEntity.Trigger),
+		"RCLONE_SYNC_SOURCE_PATH": task.SourcePath,
+		"RCLONE_SYNC_REMOTE":      task.Edges.Connection.Name + ":" + TaskRemotePath(task),
+	}
+	maps.Copy(vars, env)
+
+	e.logger.Info("Running task hook", zap.Stringer("job_id", jobEntity.ID), zap.Stringer("type", eventType), zap.String("command", hook.Command))
+	result := e.hooks.Run(ctx, hook.Command, hook.Args, vars)
+
+	event := &ent.JobEvent{
+		Type:            eventType,
+		Command:         hook.Command,
+		Args:            hook.Args,
+		ExitCode:        result.ExitCode,
+		DurationMs:      result.Duration.Milliseconds(),
+		Output:          result.Output,
+		OutputTruncated: result.OutputTruncated,
+	}
+	if result.Err != nil {
+		event.Error = result.Err.Error()
+		e.logger.Warn("Task hook failed", zap.Stringer("job_id", jobEntity.ID), zap.Stringer("type", eventType), zap.Error(result.Err))
+	}
+	if _, err := e.jobService.AddJobEvent(ctx, jobEntity.ID, event); err != nil {
+		e.logger.Error("Failed to add job event", zap.Error(err))
+	}
+	return result.Err
+}
@@ -0,0 +1,110 @@
+//go:build unix
+
+package rclone_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/hooks"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+// writeHookScript writes an executable shell script to a temp directory and returns its path.
+func writeHookScript(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "hook.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o700))
+	return path
+}
+
+func TestSyncEngine_RunTask_Hooks(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	succeeding := writeHookScript(t, `echo "$1 $RCLONE_SYNC_TASK_NAME $RCLONE_SYNC_STATUS"`)
+	failing := writeHookScript(t, `echo "not mounted" >&2; exit 2`)
+
+	// runWithHooks runs an upload task of one file with the given hooks and returns its job and destination directory
+	runWithHooks := func(t *testing.T, allowed []string, preHook, postHook *model.TaskHook) (*ent.Job, string, error) {
+		sourceDir := t.TempDir()
+		destDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "file.txt"), []byte("content"), 0644))
+
+		testTask, err := taskService.CreateTask(ctx, t.Name(), sourceDir, testConn.ID, destDir,
+			string(model.SyncDirectionUpload), "", false, &model.TaskSyncOptions{PreHook: preHook, PostHook: postHook})
+		require.NoError(t, err)
+		testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+		require.NoError(t, err)
+
+		syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+		syncEngine.SetHookOptions(hooks.Options{AllowedCommands: allowed})
+		runErr := syncEngine.RunTask(ctx, testTask, model.JobTriggerManual)
+
+		job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+		require.NoError(t, err)
+		return job, destDir, runErr
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		job, destDir, err := runWithHooks(t, []string{succeeding},
+			&model.TaskHook{Command: succeeding, Args: []string{"pre"}},
+			&model.TaskHook{Command: succeeding, Args: []string{"post"}})
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(destDir, "file.txt"))
+		assert.Equal(t, model.JobStatusSuccess, job.Status)
+
+		events, err := jobService.ListJobEvents(ctx, job.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, model.JobEventTypePreHook, events[0].Type)
+		assert.Equal(t, "pre "+t.Name()+" \n", events[0].Output, "Pre hooks don't get a status")
+		require.NotNil(t, events[0].ExitCode)
+		assert.Equal(t, 0, *events[0].ExitCode)
+		assert.Equal(t, model.JobEventTypePostHook, events[1].Type)
+		assert.Equal(t, []string{"post"}, events[1].Args)
+		assert.Equal(t, "post "+t.Name()+" success\n", events[1].Output)
+	})
+
+	t.Run("PreHookFailsJob", func(t *testing.T) {
+		job, destDir, err := runWithHooks(t, []string{succeeding, failing},
+			&model.TaskHook{Command: failing},
+			&model.TaskHook{Command: succeeding})
+		require.Error(t, err)
+		assert.NoFileExists(t, filepath.Join(destDir, "file.txt"), "Nothing is synced after a failed pre hook")
+		assert.Equal(t, model.JobStatusFailed, job.Status)
+
+		events, err := jobService.ListJobEvents(ctx, job.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1, "The post hook doesn't run")
+		require.NotNil(t, events[0].ExitCode)
+		assert.Equal(t, 2, *events[0].ExitCode)
+		assert.Equal(t, "not mounted\n", events[0].Output)
+		assert.NotEmpty(t, events[0].Error)
+	})
+
+	t.Run("PostHookFailureIsWarning", func(t *testing.T) {
+		job, destDir, err := runWithHooks(t, []string{failing}, nil, &model.TaskHook{Command: failing})
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(destDir, "file.txt"))
+		assert.Equal(t, model.JobStatusSuccessWithWarnings, job.Status)
+		assert.Equal(t, 1, job.ErrorCount)
+	})
+
+	t.Run("NotAllowlisted", func(t *testing.T) {
+		job, destDir, err := runWithHooks(t, nil, &model.TaskHook{Command: succeeding}, nil)
+		require.ErrorIs(t, err, hooks.ErrHooksDisabled)
+		assert.NoFileExists(t, filepath.Join(destDir, "file.txt"))
+		assert.Equal(t, model.JobStatusFailed, job.Status)
+	})
+}
@@ -0,0 +1,185 @@
+package rclone
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultLogBatchSize is the built-in default for the number of logs written per batch.
+	DefaultLogBatchSize = 500
+	// DefaultLogFlushInterval is the built-in default for the maximum time logs stay buffered.
+	DefaultLogFlushInterval = 5 * time.Second
+	// DefaultLogBufferLimit is the built-in default for the maximum number of buffered logs per job.
+	DefaultLogBufferLimit = 10000
+)
+
+// LogBufferOptions configures how job logs are buffered before being persisted.
+type LogBufferOptions struct {
+	// BatchSize is the number of buffered logs that triggers a flush.
+	// It is also the maximum number of logs written in a single AddJobLogsBatch call.
+	BatchSize int
+
+	// FlushInterval is the maximum time logs stay in the buffer before being flushed.
+	FlushInterval time.Duration
+
+	// BufferLimit is the maximum number of logs kept in memory per job.
+	// When exceeded (e.g. the database is unavailable), the oldest logs are dropped.
+	BufferLimit int
+}
+
+// withDefaults returns a copy of the options with non-positive values replaced by the built-in defaults.
+func (o LogBufferOptions) withDefaults() LogBufferOptions {
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultLogBatchSize
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = DefaultLogFlushInterval
+	}
+	if o.BufferLimit <= 0 {
+		o.BufferLimit = DefaultLogBufferLimit
+	}
+	if o.BufferLimit < o.BatchSize {
+		o.BufferLimit = o.BatchSize
+	}
+	return o
+}
+
+// LogFlushMetrics contains cumulative statistics about job log flushing.
+type LogFlushMetrics struct {
+	Flushes           int64         // Number of successful batch writes
+	FailedFlushes     int64         // Number of failed batch writes
+	FlushedLogs       int64         // Total number of logs persisted
+	DroppedLogs       int64         // Total number of logs dropped due to buffer overflow
+	LastFlushSize     int           // Number of logs in the last successful batch
+	LastFlushDuration time.Duration // Duration of the last successful batch write
+	LastFlushAt       time.Time     // Time of the last successful batch write
+}
+
+// logFlushStats is the concurrency-safe holder of LogFlushMetrics shared by all jobs.
+type logFlushStats struct {
+	mu      sync.Mutex
+	metrics LogFlushMetrics
+}
+
+func (s *logFlushStats) recordFlush(size int, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metrics.Flushes++
+	s.metrics.FlushedLogs += int64(size)
+	s.metrics.LastFlushSize = size
+	s.metrics.LastFlushDuration = duration
+	s.metrics.LastFlushAt = time.Now()
+}
+
+func (s *logFlushStats) recordFailure() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metrics.FailedFlushes++
+}
+
+func (s *logFlushStats) recordDropped(count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metrics.DroppedLogs += int64(count)
+}
+
+func (s *logFlushStats) snapshot() LogFlushMetrics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.metrics
+}
+
+// jobLogBuffer accumulates logs of a single job and writes them in batches.
+// It is owned by the job's stats poller goroutine and is not safe for concurrent use.
+type jobLogBuffer struct {
+	jobID     uuid.UUID
+	opts      LogBufferOptions
+	engine    *SyncEngine
+	logs      []*ent.JobLog
+	failed    []*ent.RetryQueue
+	lastFlush time.Time
+}
+
+func (e *SyncEngine) newJobLogBuffer(jobID uuid.UUID) *jobLogBuffer {
+	return &jobLogBuffer{
+		jobID:     jobID,
+		opts:      e.logBufferOpts,
+		engine:    e,
+		lastFlush: time.Now(),
+	}
+}
+
+// add appends logs to the buffer, dropping the oldest entries when the buffer limit is exceeded.
+func (b *jobLogBuffer) add(logs []*ent.JobLog) {
+	b.logs = append(b.logs, logs...)
+
+	if overflow := len(b.logs) - b.opts.BufferLimit; overflow > 0 {
+		b.logs = b.logs[overflow:]
+		b.engine.logFlushStats.recordDropped(overflow)
+		b.engine.logger.Warn("Job log buffer overflow, dropping oldest logs",
+			zap.Stringer("job_id", b.jobID),
+			zap.Int("dropped", overflow),
+			zap.Int("limit", b.opts.BufferLimit),
+		)
+	}
+}
+
+// addFailed appends files whose transfer failed, which are added to the retry queue on the next flush.
+func (b *jobLogBuffer) addFailed(items []*ent.RetryQueue) {
+	b.failed = append(b.failed, items...)
+}
+
+// shouldFlush reports whether the buffer reached the batch size or the flush interval elapsed.
+func (b *jobLogBuffer) shouldFlush() bool {
+	if len(b.logs) == 0 {
+		return false
+	}
+	return len(b.logs) >= b.opts.BatchSize || time.Since(b.lastFlush) >= b.opts.FlushInterval
+}
+
+// flush writes all buffered logs in chunks of at most BatchSize, and the failed files to the retry queue.
+// Logs and files that fail to be written are kept in the buffer and retried on the next flush.
+func (b *jobLogBuffer) flush() {
+	if len(b.failed) > 0 {
+		dbCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := b.engine.jobService.AddRetryQueueItems(dbCtx, b.jobID, b.failed)
+		cancel()
+		if err != nil {
+			b.engine.logger.Error("Failed to save retry queue", zap.Stringer("job_id", b.jobID), zap.Int("count", len(b.failed)), zap.Error(err))
+		} else {
+			b.failed = nil
+		}
+	}
+
+	for len(b.logs) > 0 {
+		n := min(len(b.logs), b.opts.BatchSize)
+		batch := b.logs[:n]
+
+		// Use a separate context for DB operations to ensure they complete even if the job context is cancelling
+		dbCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		start := time.Now()
+		err := b.engine.jobService.AddJobLogsBatch(dbCtx, b.jobID, batch)
+		cancel()
+		if err != nil {
+			b.engine.logFlushStats.recordFailure()
+			b.engine.logger.Error("Failed to save job logs", zap.Stringer("job_id", b.jobID), zap.Int("count", n), zap.Error(err))
+			return
+		}
+
+		duration := time.Since(start)
+		b.engine.logFlushStats.recordFlush(n, duration)
+		b.engine.logger.Debug("Flushed job logs",
+			zap.Stringer("job_id", b.jobID),
+			zap.Int("count", n),
+			zap.Duration("duration", duration),
+		)
+		b.logs = b.logs[n:]
+	}
+	b.lastFlush = time.Now()
+}
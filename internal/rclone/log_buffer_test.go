@@ -0,0 +1,131 @@
+package rclone
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func makeTestLogs(n int) []*ent.JobLog {
+	logs := make([]*ent.JobLog, n)
+	for i := range logs {
+		logs[i] = &ent.JobLog{Path: uuid.NewString()}
+	}
+	return logs
+}
+
+func TestLogBufferOptions_WithDefaults(t *testing.T) {
+	opts := LogBufferOptions{}.withDefaults()
+	assert.Equal(t, DefaultLogBatchSize, opts.BatchSize)
+	assert.Equal(t, DefaultLogFlushInterval, opts.FlushInterval)
+	assert.Equal(t, DefaultLogBufferLimit, opts.BufferLimit)
+
+	// BufferLimit is never smaller than BatchSize
+	opts = LogBufferOptions{BatchSize: 100, BufferLimit: 10}.withDefaults()
+	assert.Equal(t, 100, opts.BufferLimit)
+}
+
+func TestJobLogBuffer_FlushInBatches(t *testing.T) {
+	mockJobService := new(MockJobService)
+	jobID := uuid.New()
+
+	engine := NewSyncEngine(mockJobService, nil, nil, t.TempDir(), false, 0)
+	engine.SetLogBufferOptions(LogBufferOptions{BatchSize: 3, FlushInterval: time.Hour})
+
+	var batchSizes []int
+	mockJobService.On("AddJobLogsBatch", mock.Anything, jobID, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		batchSizes = append(batchSizes, len(args.Get(2).([]*ent.JobLog)))
+	})
+
+	buf := engine.newJobLogBuffer(jobID)
+	buf.add(makeTestLogs(2))
+	assert.False(t, buf.shouldFlush(), "Should not flush below batch size before interval")
+
+	buf.add(makeTestLogs(5))
+	assert.True(t, buf.shouldFlush())
+	buf.flush()
+
+	assert.Equal(t, []int{3, 3, 1}, batchSizes)
+	assert.Empty(t, buf.logs)
+
+	metrics := engine.GetLogFlushMetrics()
+	assert.Equal(t, int64(3), metrics.Flushes)
+	assert.Equal(t, int64(7), metrics.FlushedLogs)
+	assert.Equal(t, 1, metrics.LastFlushSize)
+	assert.Equal(t, int64(0), metrics.FailedFlushes)
+}
+
+func TestJobLogBuffer_FlushInterval(t *testing.T) {
+	engine := NewSyncEngine(new(MockJobService), nil, nil, t.TempDir(), false, 0)
+	engine.SetLogBufferOptions(LogBufferOptions{BatchSize: 100, FlushInterval: time.Millisecond})
+
+	buf := engine.newJobLogBuffer(uuid.New())
+	assert.False(t, buf.shouldFlush(), "Empty buffer should never flush")
+
+	buf.add(makeTestLogs(1))
+	time.Sleep(5 * time.Millisecond)
+	assert.True(t, buf.shouldFlush(), "Should flush once the interval elapsed")
+}
+
+func TestJobLogBuffer_OverflowDropsOldest(t *testing.T) {
+	engine := NewSyncEngine(new(MockJobService), nil, nil, t.TempDir(), false, 0)
+	engine.SetLogBufferOptions(LogBufferOptions{BatchSize: 2, BufferLimit: 4})
+
+	buf := engine.newJobLogBuffer(uuid.New())
+	logs := makeTestLogs(6)
+	buf.add(logs)
+
+	assert.Len(t, buf.logs, 4)
+	assert.Equal(t, logs[2], buf.logs[0], "Oldest logs should be dropped first")
+	assert.Equal(t, int64(2), engine.GetLogFlushMetrics().DroppedLogs)
+}
+
+func TestJobLogBuffer_FailedFlushRetains(t *testing.T) {
+	mockJobService := new(MockJobService)
+	jobID := uuid.New()
+
+	engine := NewSyncEngine(mockJobService, nil, nil, t.TempDir(), false, 0)
+	engine.SetLogBufferOptions(LogBufferOptions{BatchSize: 10})
+
+	mockJobService.On("AddJobLogsBatch", mock.Anything, jobID, mock.Anything).Return(errors.New("db unavailable")).Once()
+	mockJobService.On("AddJobLogsBatch", mock.Anything, jobID, mock.Anything).Return(nil).Once()
+
+	buf := engine.newJobLogBuffer(jobID)
+	buf.add(makeTestLogs(3))
+
+	buf.flush()
+	assert.Len(t, buf.logs, 3, "Logs should be kept for retry after a failed flush")
+	assert.Equal(t, int64(1), engine.GetLogFlushMetrics().FailedFlushes)
+
+	buf.flush()
+	assert.Empty(t, buf.logs)
+	assert.Equal(t, int64(3), engine.GetLogFlushMetrics().FlushedLogs)
+	mockJobService.AssertExpectations(t)
+}
+
+func TestJobLogBuffer_FlushFailedFiles(t *testing.T) {
+	mockJobService := new(MockJobService)
+	jobID := uuid.New()
+	engine := NewSyncEngine(mockJobService, nil, nil, t.TempDir(), false, 0)
+
+	failed := []*ent.RetryQueue{{Path: "a.txt", Direction: model.SyncDirectionUpload, ErrorClass: model.TransferErrorClassNetwork}}
+	mockJobService.On("AddRetryQueueItems", mock.Anything, jobID, failed).Return(errors.New("database is locked")).Once()
+	mockJobService.On("AddRetryQueueItems", mock.Anything, jobID, failed).Return(nil).Once()
+
+	buf := engine.newJobLogBuffer(jobID)
+	buf.addFailed(failed)
+
+	// Files that fail to be written are kept for the next flush
+	buf.flush()
+	assert.Len(t, buf.failed, 1)
+	buf.flush()
+	assert.Empty(t, buf.failed)
+	mockJobService.AssertExpectations(t)
+}
@@ -0,0 +1,67 @@
+package rclone
+
+import (
+	"context"
+	"path"
+	"slices"
+	"sort"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// mediaBackends are the providers that only hold media in a layout of virtual directories,
+// e.g. Google Photos with its album/, shared-album/ and media/by-year/ trees.
+var mediaBackends = []string{"gphotos"}
+
+// Directories of a media backend listing the albums, each album is a directory below them.
+const (
+	mediaAlbumDir       = "album"
+	mediaSharedAlbumDir = "shared-album"
+)
+
+// MediaAlbum is an album of a media backend.
+type MediaAlbum struct {
+	// Title is the title of the album.
+	Title string
+	// Path is the remote path of the album, e.g. album/Holidays.
+	Path string
+	// Shared is whether the album is shared with the user.
+	Shared bool
+}
+
+// IsMediaBackend reports whether the provider only holds media.
+// Media backends can't delete or modify media, so they only support one-way syncs,
+// and most of them only download re-encoded copies instead of the originals.
+func IsMediaBackend(providerType string) bool {
+	return slices.Contains(mediaBackends, providerType)
+}
+
+// ListMediaAlbums lists the albums, followed by the shared albums, of the named remote sorted by title.
+// The path of an album can be used as the remote path of a task syncing the album.
+func ListMediaAlbums(ctx context.Context, remoteName string) ([]MediaAlbum, error) {
+	f, err := GetFs(ctx, remoteName, "")
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrPathNotExist).WithCause(err)
+	}
+
+	var albums []MediaAlbum
+	for _, dir := range []string{mediaAlbumDir, mediaSharedAlbumDir} {
+		entries, err := f.List(ctx, dir)
+		if err != nil {
+			return nil, i18n.NewI18nError(i18n.ErrFailedToListRemotes).WithCause(err)
+		}
+		sort.Sort(entries)
+		for _, entry := range entries {
+			if _, ok := entry.(fs.Directory); !ok {
+				continue
+			}
+			albums = append(albums, MediaAlbum{
+				Title:  path.Base(entry.Remote()),
+				Path:   entry.Remote(),
+				Shared: dir == mediaSharedAlbumDir,
+			})
+		}
+	}
+	return albums, nil
+}
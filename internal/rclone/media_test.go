@@ -0,0 +1,47 @@
+package rclone_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+func TestIsMediaBackend(t *testing.T) {
+	assert.True(t, rclone.IsMediaBackend("gphotos"))
+	assert.False(t, rclone.IsMediaBackend("drive"))
+	assert.False(t, rclone.IsMediaBackend("local"))
+}
+
+func TestListMediaAlbums(t *testing.T) {
+	_, connSvc := setupTestConfig(t)
+	ctx := context.Background()
+
+	// Lay out the virtual directories of a media backend
+	tempDir := t.TempDir()
+	for _, dir := range []string{"album/Holidays", "album/Pets", "shared-album/Family", "media/by-year/2026"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, dir), 0755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "album", "cover.jpg"), []byte("content"), 0644))
+
+	_, err := connSvc.CreateConnection(ctx, "test-media-albums", "alias", map[string]string{"remote": tempDir})
+	require.NoError(t, err)
+
+	albums, err := rclone.ListMediaAlbums(ctx, "test-media-albums")
+	require.NoError(t, err)
+	assert.Equal(t, []rclone.MediaAlbum{
+		{Title: "Holidays", Path: "album/Holidays"},
+		{Title: "Pets", Path: "album/Pets"},
+		{Title: "Family", Path: "shared-album/Family", Shared: true},
+	}, albums)
+
+	// A remote without albums can't be listed
+	_, err = connSvc.CreateConnection(ctx, "test-media-albums-empty", "alias", map[string]string{"remote": t.TempDir()})
+	require.NoError(t, err)
+	_, err = rclone.ListMediaAlbums(ctx, "test-media-albums-empty")
+	assert.Error(t, err)
+}
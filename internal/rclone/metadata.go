@@ -0,0 +1,188 @@
+package rclone
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"go.uber.org/zap"
+)
+
+// maxMetadataLogs is the maximum number of files logged by a single run, the rest are summarized in one log.
+const maxMetadataLogs = 1000
+
+// metadataSupport describes which metadata a backend stores when metadata is preserved.
+type metadataSupport struct {
+	// write reports whether the backend writes metadata at all.
+	write bool
+	// user reports whether the backend stores general purpose metadata, which includes the system
+	// metadata of other backends, like the permissions of local files on s3.
+	user bool
+	// system is the system metadata of the backend, with read-only keys that can't be set.
+	system map[string]fs.MetadataHelp
+}
+
+// metadataSupportOf returns the metadata f stores.
+func metadataSupportOf(f fs.Fs) metadataSupport {
+	features := f.Features()
+	support := metadataSupport{write: features.WriteMetadata, user: features.UserMetadata}
+	if info := fs.FindFromFs(f); info != nil && info.MetadataInfo != nil {
+		support.system = info.MetadataInfo.System
+	}
+	return support
+}
+
+// stores reports whether the metadata key is kept. The modification time is always kept,
+// since it is synced whether or not metadata is preserved.
+func (s metadataSupport) stores(key string) bool {
+	if key == "mtime" {
+		return true
+	}
+	if !s.write {
+		return false
+	}
+	if help, ok := s.system[key]; ok {
+		return !help.ReadOnly
+	}
+	return s.user
+}
+
+// droppedMetadata returns the sorted keys of metadata that dst doesn't store.
+func droppedMetadata(metadata fs.Metadata, dst metadataSupport) []string {
+	var dropped []string
+	for key := range metadata {
+		if !dst.stores(key) {
+			dropped = append(dropped, key)
+		}
+	}
+	slices.Sort(dropped)
+	return dropped
+}
+
+// metadataLog returns the job log of a file that doesn't keep the metadata keys dropped on its destination.
+func metadataLog(remote string, size int64, dropped []string) *ent.JobLog {
+	return &ent.JobLog{
+		Level: model.LogLevelWarning,
+		What:  model.LogActionMetadata,
+		Path:  remote + ": " + strings.Join(dropped, ", "),
+		Size:  max(size, 0),
+	}
+}
+
+// metadataLogs returns the logs of the files a one-way sync from fSrc to fDst transfers that don't keep all
+// of their metadata, see pendingObjects. Their paths are logged below dir. Filter rules and name transforms
+// are taken from ctx.
+func metadataLogs(ctx context.Context, fSrc, fDst fs.Fs, dir string) ([]*ent.JobLog, error) {
+	pending, err := pendingObjects(ctx, fSrc, fDst)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(pending, func(a, b fs.Object) int { return strings.Compare(a.Remote(), b.Remote()) })
+
+	dst := metadataSupportOf(fDst)
+	var logs []*ent.JobLog
+	for _, o := range pending {
+		metadata, err := fs.GetMetadata(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		if dropped := droppedMetadata(metadata, dst); len(dropped) > 0 {
+			logs = append(logs, metadataLog(path.Join(dir, o.Remote()), o.Size(), dropped))
+		}
+	}
+	return logs, nil
+}
+
+// droppedMetadataSummary describes the metadata of fSrc that fDst doesn't store for any file, without listing
+// them: the system metadata of fSrc and, if fDst doesn't store general purpose metadata, that of the files.
+// It returns an empty string if nothing is dropped.
+func droppedMetadataSummary(fSrc, fDst fs.Fs) string {
+	if !fSrc.Features().ReadMetadata {
+		return fmt.Sprintf("metadata not preserved: source %s does not read metadata", fSrc.Name())
+	}
+	src, dst := metadataSupportOf(fSrc), metadataSupportOf(fDst)
+	var dropped []string
+	for key := range src.system {
+		if !dst.stores(key) {
+			dropped = append(dropped, key)
+		}
+	}
+	slices.Sort(dropped)
+	if fSrc.Features().UserMetadata && (!dst.write || !dst.user) {
+		dropped = append(dropped, "user metadata")
+	}
+	if len(dropped) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("metadata not preserved on %s: %s", fDst.Name(), strings.Join(dropped, ", "))
+}
+
+// reportDroppedMetadata logs the files of the pairs that don't keep all of their metadata because the destination
+// doesn't store it as WARNING job logs, so migrations can tell which permissions or owners have to be restored.
+// Files are only checked if the source is local and listing the destination is cheap, since reading the metadata
+// costs a request per file on remotes; otherwise, and for bidirectional tasks, the metadata dropped by the backends
+// is logged once per direction. The report is best effort: failures are only logged, and at most maxMetadataLogs
+// files are logged.
+func (e *SyncEngine) reportDroppedMetadata(ctx context.Context, jobEntity *ent.Job, task *ent.Task, pairs []syncPair, opts SyncOptions) {
+	var logs []*ent.JobLog
+	summaries := make(map[string]bool)
+	summarize := func(fSrc, fDst fs.Fs) {
+		if msg := droppedMetadataSummary(fSrc, fDst); msg != "" && !summaries[msg] {
+			summaries[msg] = true
+			logs = append(logs, &ent.JobLog{Level: model.LogLevelWarning, What: model.LogActionUnknown, Path: msg})
+		}
+	}
+	for _, pair := range pairs {
+		fSrc, fDst := pair.sides(task.Direction)
+		if task.Direction == model.SyncDirectionBidirectional {
+			summarize(fSrc, fDst)
+			summarize(fDst, fSrc)
+			continue
+		}
+		if !fSrc.Features().IsLocal || !sizingCheap(fDst) || !fSrc.Features().ReadMetadata {
+			summarize(fSrc, fDst)
+			continue
+		}
+
+		pairOpts := opts
+		pairOpts.Filters = pair.Filters
+		listCtx, err := applySyncFilters(ctx, pairOpts)
+		if err != nil {
+			return // Reported by the sync itself
+		}
+		listCtx = withWindowsNames(listCtx, pairOpts)
+
+		pairLogs, err := metadataLogs(listCtx, fSrc, fDst, pair.RemoteSubpath)
+		if err != nil {
+			e.logger.Warn("Checking dropped metadata failed", zap.Stringer("job_id", jobEntity.ID), zap.String("path", pair.name()), zap.Error(err))
+			return
+		}
+		logs = append(logs, pairLogs...)
+	}
+	if len(logs) == 0 {
+		return
+	}
+
+	e.logger.Info("Found files with metadata the destination does not store", zap.Stringer("job_id", jobEntity.ID), zap.Int("count", len(logs)))
+	now := time.Now()
+	if len(logs) > maxMetadataLogs {
+		more := len(logs) - maxMetadataLogs
+		logs = append(logs[:maxMetadataLogs], &ent.JobLog{
+			Level: model.LogLevelWarning,
+			What:  model.LogActionUnknown,
+			Path:  fmt.Sprintf("%d more files do not keep all of their metadata", more),
+		})
+	}
+	for _, l := range logs {
+		l.Time = now
+	}
+	if err := e.jobService.AddJobLogsBatch(ctx, jobEntity.ID, logs); err != nil {
+		e.logger.Error("Failed to add job logs", zap.Error(err))
+	}
+}
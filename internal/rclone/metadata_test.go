@@ -0,0 +1,87 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/rclone/rclone/backend/local"
+	_ "github.com/rclone/rclone/backend/memory"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+func TestDroppedMetadata(t *testing.T) {
+	metadata := fs.Metadata{"mtime": "2024-01-01T00:00:00Z", "mode": "100644", "uid": "1000", "btime": "2024-01-01T00:00:00Z", "owner": "alice"}
+
+	// Nothing but the modification time is kept if the destination doesn't write metadata
+	assert.Equal(t, []string{"btime", "mode", "owner", "uid"}, droppedMetadata(metadata, metadataSupport{}))
+
+	// Read-only system metadata can't be set, general purpose metadata needs support for it
+	dst := metadataSupport{write: true, system: map[string]fs.MetadataHelp{"mode": {}, "uid": {}, "btime": {ReadOnly: true}}}
+	assert.Equal(t, []string{"btime", "owner"}, droppedMetadata(metadata, dst))
+	dst.user = true
+	assert.Equal(t, []string{"btime"}, droppedMetadata(metadata, dst))
+
+	// General purpose metadata stores the system metadata of other backends
+	assert.Empty(t, droppedMetadata(metadata, metadataSupport{write: true, user: true}))
+}
+
+func TestMetadataLogs(t *testing.T) {
+	ctx := context.Background()
+	srcDir := t.TempDir()
+	for _, name := range []string{"same.txt", "new.txt", "sub/script.sh"} {
+		path := filepath.Join(srcDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte("content"), 0644))
+	}
+	require.NoError(t, os.Chmod(filepath.Join(srcDir, "sub/script.sh"), 0755))
+
+	fSrc, err := fs.NewFs(ctx, srcDir)
+	require.NoError(t, err)
+	fDst, err := fs.NewFs(ctx, ":memory:metadata-logs")
+	require.NoError(t, err)
+	require.NoError(t, operations.CopyFile(ctx, fDst, fSrc, "same.txt", "same.txt"))
+
+	// Only the files that are transferred are logged, below the remote subpath of their pair
+	logs, err := metadataLogs(ctx, fSrc, fDst, "docs")
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	for i, name := range []string{"docs/new.txt", "docs/sub/script.sh"} {
+		assert.Equal(t, model.LogLevelWarning, logs[i].Level)
+		assert.Equal(t, model.LogActionMetadata, logs[i].What)
+		assert.Contains(t, logs[i].Path, name+": ")
+		assert.Contains(t, logs[i].Path, "mode")
+		assert.NotContains(t, logs[i].Path, "mtime")
+		assert.Equal(t, int64(len("content")), logs[i].Size)
+	}
+
+	// Local directories keep the system metadata of each other
+	fLocal, err := fs.NewFs(ctx, t.TempDir())
+	require.NoError(t, err)
+	logs, err = metadataLogs(ctx, fSrc, fLocal, "")
+	require.NoError(t, err)
+	for _, l := range logs {
+		assert.NotContains(t, l.Path, "mode")
+	}
+}
+
+func TestDroppedMetadataSummary(t *testing.T) {
+	ctx := context.Background()
+	fLocal, err := fs.NewFs(ctx, t.TempDir())
+	require.NoError(t, err)
+	fMemory, err := fs.NewFs(ctx, ":memory:metadata-summary")
+	require.NoError(t, err)
+
+	summary := droppedMetadataSummary(fLocal, fMemory)
+	assert.Contains(t, summary, "metadata not preserved on")
+	assert.Contains(t, summary, "mode, ")
+	assert.NotContains(t, summary, "mtime")
+
+	assert.Contains(t, droppedMetadataSummary(fMemory, fLocal), "does not read metadata")
+	assert.NotContains(t, droppedMetadataSummary(fLocal, fLocal), "mode")
+}
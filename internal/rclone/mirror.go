@@ -0,0 +1,237 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/provenance"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+)
+
+// errNoConnectionResolver is returned for mirrors when the engine can't look up their connections.
+const errNoConnectionResolver = errs.ConstError("connection resolver not set")
+
+// SyncMirror is an additional destination an upload task copies its content to, see TaskSyncOptions.mirrors.
+type SyncMirror struct {
+	// ConnectionID is the ID of the connection of the mirror.
+	ConnectionID uuid.UUID
+	// RemotePath is the path on the connection, relative to its base path.
+	RemotePath string
+}
+
+// ConnectionResolver looks up connections by ID, e.g. the connections of mirrors.
+type ConnectionResolver interface {
+	GetConnectionByID(ctx context.Context, id uuid.UUID) (*ent.Connection, error)
+}
+
+// SetConnectionResolver configures looking up the connections of mirrors.
+// Mirrors fail until it is called.
+func (e *SyncEngine) SetConnectionResolver(connections ConnectionResolver) {
+	e.connections = connections
+}
+
+// hasMirrors reports whether the task has mirrors.
+func hasMirrors(task *ent.Task) bool {
+	return task.Options != nil && len(task.Options.Mirrors) > 0
+}
+
+// runMirrors uploads the task's content to each of its mirrors after the primary sync of parent succeeded.
+// Each mirror runs in turn as a child job of parent with its own stats. A failed mirror doesn't fail parent,
+// it is logged as an error of parent instead. It returns the number of failed mirrors, and the error of ctx
+// if the run was cancelled or timed out in the meantime.
+func (e *SyncEngine) runMirrors(ctx context.Context, parent *ent.Job, task *ent.Task, trigger model.JobTrigger, opts SyncOptions) (int, error) {
+	failed := 0
+	for _, mirror := range opts.Mirrors {
+		if err := ctx.Err(); err != nil {
+			return failed, err
+		}
+		conn, err := e.runMirror(ctx, parent, task, trigger, mirror, opts)
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return failed, ctx.Err()
+		}
+
+		failed++
+		target := mirror.ConnectionID.String()
+		if conn != nil {
+			target = conn.Name
+		}
+		msg := fmt.Sprintf("mirror to %s:%s failed: %v", target, mirror.RemotePath, err)
+		e.logger.Warn("Mirror failed", zap.Stringer("job_id", parent.ID), zap.String("connection", target), zap.Error(err))
+		if _, err := e.jobService.AddJobLog(ctx, parent.ID, string(model.LogLevelError), string(model.LogActionError), msg, 0); err != nil {
+			e.logger.Error("Failed to add job log", zap.Error(err))
+		}
+	}
+	return failed, nil
+}
+
+// runMirror uploads the task's content to mirror as a child job of parent and finalizes it.
+// It returns the connection of the mirror, nil if it couldn't be looked up.
+func (e *SyncEngine) runMirror(ctx context.Context, parent *ent.Job, task *ent.Task, trigger model.JobTrigger, mirror SyncMirror, opts SyncOptions) (*ent.Connection, error) {
+	// Mark the child as a mirror job so it isn't mistaken for a shard of parent
+	detail := model.JobTriggerDetail{}
+	if d := provenance.TriggerDetail(ctx); d != nil {
+		detail = *d
+	}
+	detail.MirrorConnectionID = &mirror.ConnectionID
+	child, err := e.jobService.CreateChildJob(provenance.WithTriggerDetail(ctx, &detail), parent.ID, task.ID, trigger)
+	if err != nil {
+		return nil, err
+	}
+	e.statsMu.Lock()
+	e.childParents[child.ID] = parent.ID
+	e.statsMu.Unlock()
+	defer func() {
+		e.statsMu.Lock()
+		delete(e.lastEvents, child.ID)
+		delete(e.lastTransferEvents, child.ID)
+		delete(e.childParents, child.ID)
+		e.statsMu.Unlock()
+	}()
+
+	conn, err := e.mirrorConnection(ctx, mirror)
+	if err != nil {
+		e.failJob(ctx, child.ID, err)
+		return nil, err
+	}
+	mirrorTask := mirrorTask(task, conn, mirror)
+	if deferred, err := e.deferOverCap(ctx, child, mirrorTask); deferred {
+		if err == nil {
+			err = fmt.Errorf("monthly transfer cap of %s reached", conn.Name) //nolint:err113
+		}
+		return conn, err
+	}
+	if _, err := e.jobService.UpdateJobStatus(ctx, child.ID, string(model.JobStatusRunning), ""); err != nil {
+		return conn, err
+	}
+
+	log := e.logger.With(zap.Stringer("job_id", child.ID), zap.Stringer("parent_id", parent.ID), zap.String("mirror", conn.Name))
+	log.Debug("Starting mirror")
+
+	statsCtx, statsCancel := context.WithCancel(ctx)
+	defer statsCancel()
+	statsCtx = accounting.WithStatsGroup(statsCtx, child.ID.String())
+	accounting.Stats(statsCtx).SetMaxCompletedTransfers(-1)
+
+	var wg sync.WaitGroup
+	var dirStats directionStats
+	concurrency := &concurrencySampler{}
+	wg.Go(func() {
+		dirStats, concurrency = e.pollStats(statsCtx, child.ID, mirrorTask, child.StartTime, nil)
+	})
+
+	transfers := determineTransfers(opts.Transfers, e.defaultTransfers)
+	syncErr := e.runMirrorSync(statsCtx, child, mirrorTask, conn.Name, opts, transfers)
+
+	statsCancel()
+	wg.Wait()
+
+	result := ports.JobResult{
+		UploadedFiles:   dirStats.UploadedFiles,
+		UploadedBytes:   dirStats.UploadedBytes,
+		DownloadedFiles: dirStats.DownloadedFiles,
+		DownloadedBytes: dirStats.DownloadedBytes,
+		Concurrency:     concurrency.series(transfers),
+	}
+	if s := accounting.Stats(statsCtx); s != nil {
+		result.FilesTransferred, result.BytesTransferred, result.FilesDeleted, result.ErrorCount = s.GetTransfers(), s.GetBytes(), s.GetDeletes(), s.GetErrors()
+		result.FilesChecked, result.ListingsDone = s.GetChecks(), s.Listed(0)
+	}
+	result.Status = completedStatus(result.ErrorCount)
+	if syncErr != nil {
+		result.Status = model.JobStatusFailed
+		result.Error = i18n.ErrorMessage(ctx, syncErr)
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			result.Status = model.JobStatusFailedTimeout
+		case errors.Is(ctx.Err(), context.Canceled):
+			result.Status = model.JobStatusCancelled
+		}
+		log.Warn("Mirror finished with error", zap.Error(syncErr))
+	} else {
+		result.DeleteJob = shouldDeleteEmptyJob(e.autoDeleteEmptyJobs, result.Status, int(result.FilesTransferred), result.BytesTransferred, int(result.FilesDeleted), int(result.ErrorCount))
+	}
+
+	// Use a fresh context for DB operations since the job context may be cancelled
+	dbCtx, dbCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer dbCancel()
+	if _, err := e.jobService.FinalizeJob(dbCtx, child.ID, result); err != nil {
+		log.Error("Failed to finalize mirror job", zap.Error(err))
+	}
+
+	endTime := time.Now()
+	e.broadcastJobUpdate(&model.JobProgressEvent{
+		JobID:            child.ID,
+		TaskID:           task.ID,
+		ConnectionID:     conn.ID,
+		Status:           result.Status,
+		FilesTransferred: int(result.FilesTransferred),
+		BytesTransferred: result.BytesTransferred,
+		UploadedFiles:    int(result.UploadedFiles),
+		UploadedBytes:    result.UploadedBytes,
+		FilesDeleted:     int(result.FilesDeleted),
+		FilesChecked:     int(result.FilesChecked),
+		ListingsDone:     int(result.ListingsDone),
+		ErrorCount:       int(result.ErrorCount),
+		StartTime:        child.StartTime,
+		EndTime:          &endTime,
+	})
+
+	return conn, syncErr
+}
+
+// runMirrorSync uploads the source and the additional paths of the task to the mirror the task was rewritten to,
+// see mirrorTask.
+func (e *SyncEngine) runMirrorSync(ctx context.Context, child *ent.Job, task *ent.Task, connectionName string, opts SyncOptions, transfers int) error {
+	fLocal, err := GetFs(ctx, "", task.SourcePath)
+	if err != nil {
+		return err
+	}
+	fRemote, err := GetFs(ctx, connectionName, TaskRemotePath(task))
+	if err != nil {
+		return err
+	}
+	pairs, err := syncPairs(ctx, task, connectionName, fLocal, fRemote, opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, rcloneCfg := fs.AddConfig(ctx)
+	rcloneCfg.Transfers = transfers
+	rcloneCfg.Metadata = opts.PreserveMetadata
+	if len(pairs) > 1 {
+		return e.runPairs(ctx, child, task, pairs, opts)
+	}
+	return e.runOneWay(ctx, fLocal, fRemote, opts)
+}
+
+// mirrorConnection looks up the connection of mirror.
+func (e *SyncEngine) mirrorConnection(ctx context.Context, mirror SyncMirror) (*ent.Connection, error) {
+	if e.connections == nil {
+		return nil, errNoConnectionResolver
+	}
+	return e.connections.GetConnectionByID(ctx, mirror.ConnectionID)
+}
+
+// mirrorTask returns a copy of task uploading to mirror on conn instead of the task's own remote path,
+// so the base path of conn applies like it does for the task.
+func mirrorTask(task *ent.Task, conn *ent.Connection, mirror SyncMirror) *ent.Task {
+	t := *task
+	t.RemotePath = mirror.RemotePath
+	t.Edges.Connection = conn
+	return &t
+}
@@ -0,0 +1,49 @@
+package rclone
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+)
+
+// Backend options of the pacer that spaces the API calls of providers such as drive and dropbox.
+// rclone's global tpslimit can't be set per remote, so the pacing of a connection is mapped to these.
+// Every backend instance has its own pacer, so each remote path of the connection is paced separately.
+const (
+	pacerMinSleepOption = "pacer_min_sleep"
+	pacerBurstOption    = "pacer_burst"
+)
+
+// PacingSupport reports whether the provider paces its API calls with a configurable rate and burst.
+func PacingSupport(providerType string) (rate, burst bool) {
+	reg, err := fs.Find(providerType)
+	if err != nil {
+		return false, false
+	}
+	hasOption := func(name string) bool {
+		return slices.ContainsFunc(reg.Options, func(o fs.Option) bool { return o.Name == name })
+	}
+	return hasOption(pacerMinSleepOption), hasOption(pacerBurstOption)
+}
+
+// PacingOptions returns the backend options that pace the API calls to each remote path of a connection
+// of the provider to pathTpsLimit transactions per second, with bursts of up to pathTpsBurst transactions.
+// Unset values and values the provider doesn't support are left out.
+func PacingOptions(providerType string, pathTpsLimit *float64, pathTpsBurst *int) map[string]string {
+	rate, burst := PacingSupport(providerType)
+	options := make(map[string]string, 2)
+	if rate && pathTpsLimit != nil && *pathTpsLimit > 0 {
+		options[pacerMinSleepOption] = time.Duration(float64(time.Second) / *pathTpsLimit).String()
+	}
+	if burst && pathTpsBurst != nil && *pathTpsBurst > 0 {
+		options[pacerBurstOption] = strconv.Itoa(*pathTpsBurst)
+	}
+	return options
+}
+
+// isPacingOption reports whether key is one of the options set by PacingOptions.
+func isPacingOption(key string) bool {
+	return key == pacerMinSleepOption || key == pacerBurstOption
+}
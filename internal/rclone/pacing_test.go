@@ -0,0 +1,40 @@
+package rclone_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+func TestPacingSupport(t *testing.T) {
+	rate, burst := rclone.PacingSupport("drive")
+	assert.True(t, rate)
+	assert.True(t, burst)
+
+	rate, burst = rclone.PacingSupport("dropbox")
+	assert.True(t, rate)
+	assert.False(t, burst)
+
+	rate, burst = rclone.PacingSupport("local")
+	assert.False(t, rate)
+	assert.False(t, burst)
+
+	rate, burst = rclone.PacingSupport("unknown")
+	assert.False(t, rate)
+	assert.False(t, burst)
+}
+
+func TestPacingOptions(t *testing.T) {
+	limit, burst := 10.0, 50
+
+	assert.Equal(t, map[string]string{"pacer_min_sleep": "100ms", "pacer_burst": "50"},
+		rclone.PacingOptions("drive", &limit, &burst))
+	assert.Equal(t, map[string]string{"pacer_min_sleep": "100ms"},
+		rclone.PacingOptions("dropbox", &limit, &burst), "Options the provider doesn't have are left out")
+	assert.Empty(t, rclone.PacingOptions("local", &limit, &burst))
+	assert.Empty(t, rclone.PacingOptions("drive", nil, nil))
+
+	slow := 0.5
+	assert.Equal(t, map[string]string{"pacer_min_sleep": "2s"}, rclone.PacingOptions("drive", &slow, nil))
+}
@@ -0,0 +1,121 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"go.uber.org/zap"
+)
+
+// SyncPath is an additional pair of directories synced by a one-way task, see TaskSyncOptions.paths.
+type SyncPath struct {
+	// SourcePath is the absolute path of the local directory.
+	SourcePath string
+	// RemoteSubpath is the directory below the task's remote path the local directory is synced with.
+	RemoteSubpath string
+}
+
+// syncPair is a pair of directories synced within a job: the task's own source and remote path,
+// or one of its additional paths.
+type syncPair struct {
+	SyncPath
+	Local   fs.Fs
+	Remote  fs.Fs
+	Filters []string
+}
+
+// sides returns the source and destination of the pair in the direction of the task.
+func (p syncPair) sides(direction model.SyncDirection) (fs.Fs, fs.Fs) {
+	if direction == model.SyncDirectionDownload {
+		return p.Remote, p.Local
+	}
+	return p.Local, p.Remote
+}
+
+// name describes the pair in job logs.
+func (p syncPair) name() string {
+	return p.SourcePath + " → " + path.Join("/", p.RemoteSubpath)
+}
+
+// syncPairs returns the pairs of directories synced by a job of task: the task's own pair of fLocal and fRemote
+// followed by its additional paths. The own pair excludes the remote subpaths of the additional paths,
+// so it doesn't delete what they sync.
+func syncPairs(ctx context.Context, task *ent.Task, connectionName string, fLocal, fRemote fs.Fs, opts SyncOptions) ([]syncPair, error) {
+	own := syncPair{SyncPath: SyncPath{SourcePath: task.SourcePath}, Local: fLocal, Remote: fRemote, Filters: opts.Filters}
+	if len(opts.Paths) == 0 {
+		return []syncPair{own}, nil
+	}
+
+	var excludes []string
+	pairs := []syncPair{{}}
+	for _, p := range opts.Paths {
+		local, err := GetFs(ctx, "", p.SourcePath)
+		if err != nil {
+			return nil, err
+		}
+		remote, err := GetFs(ctx, connectionName, path.Join(TaskRemotePath(task), p.RemoteSubpath))
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, syncPair{SyncPath: p, Local: local, Remote: remote, Filters: opts.Filters})
+		excludes = append(excludes, "- /"+escapeFilterGlob(p.RemoteSubpath)+"/**")
+	}
+	own.Filters = append(excludes, opts.Filters...)
+	pairs[0] = own
+	return pairs, nil
+}
+
+// runPairs runs a one-way sync of each pair in turn, all counted in the stats group of the job.
+// A failed pair is logged to the job and the remaining pairs are still synced, unless opts.StopOnPathError is set.
+// The returned error joins the errors of all failed pairs.
+func (e *SyncEngine) runPairs(ctx context.Context, jobEntity *ent.Job, task *ent.Task, pairs []syncPair, opts SyncOptions) error {
+	var pairErrs []error
+	for i, pair := range pairs {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(pairErrs, err)...)
+		}
+		pairOpts := opts
+		pairOpts.Filters = pair.Filters
+		fFrom, fTo := pair.sides(task.Direction)
+		err := e.runOneWay(ctx, fFrom, fTo, pairOpts)
+		if err == nil {
+			continue
+		}
+
+		e.logger.Warn("Syncing path failed", zap.Stringer("job_id", jobEntity.ID), zap.String("path", pair.name()), zap.Error(err))
+		msg := fmt.Sprintf("sync of %s failed: %v", pair.name(), err)
+		if _, logErr := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelError), string(model.LogActionError), msg, 0); logErr != nil {
+			e.logger.Error("Failed to add job log", zap.Error(logErr))
+		}
+		pairErrs = append(pairErrs, err)
+		if opts.StopOnPathError && i < len(pairs)-1 {
+			e.logger.Info("Skipping remaining paths after failure", zap.Stringer("job_id", jobEntity.ID), zap.Int("skipped", len(pairs)-i-1))
+			break
+		}
+	}
+	return errors.Join(pairErrs...)
+}
+
+// localPath returns the local directory of the task that the local side of a transfer, fsString, belongs to,
+// along with the remote subpath it is synced with. fsString may also be a sub-directory of the task's own
+// source path, as synced by shard jobs. Additional paths are never sharded, so they match exactly.
+// ok is false if fsString is not a local directory of the task.
+func localPath(task *ent.Task, fsString string) (sourcePath, remoteSubpath string, ok bool) {
+	if task.Options != nil {
+		for _, p := range task.Options.Paths {
+			if p != nil && strings.TrimSuffix(fsString, "/") == strings.TrimSuffix(p.SourcePath, "/") {
+				return p.SourcePath, p.RemoteSubpath, true
+			}
+		}
+	}
+	if isWithinPath(fsString, task.SourcePath) {
+		return task.SourcePath, "", true
+	}
+	return "", "", false
+}
@@ -0,0 +1,117 @@
+package rclone
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ConnectionPreset is a curated configuration of a provider for a specific service,
+// e.g. the s3 provider for Cloudflare R2.
+type ConnectionPreset struct {
+	// Name identifies the preset.
+	Name string
+	// Description is a human readable name of the service.
+	Description string
+	// Type is the provider the preset configures.
+	Type string
+	// Defaults are config values set unless the config overrides them.
+	Defaults map[string]string
+	// Fixed are config values the config can't override, e.g. the s3 provider of the service.
+	Fixed map[string]string
+	// EndpointTemplate builds the endpoint from the region if the config has none,
+	// with {region} replaced by the region.
+	EndpointTemplate string
+	// Required are the config fields that must be set after applying the preset.
+	Required []string
+}
+
+// connectionPresets are the curated presets, in the order they are listed.
+var connectionPresets = []ConnectionPreset{
+	{
+		Name:        "minio",
+		Description: "MinIO",
+		Type:        "s3",
+		Defaults:    map[string]string{"region": "us-east-1"},
+		Fixed:       map[string]string{"provider": "Minio", "env_auth": "false", "force_path_style": "true"},
+		Required:    []string{"endpoint", "access_key_id", "secret_access_key"},
+	},
+	{
+		Name:             "backblaze-b2-s3",
+		Description:      "Backblaze B2 (S3-compatible API)",
+		Type:             "s3",
+		Fixed:            map[string]string{"provider": "Other", "env_auth": "false"},
+		EndpointTemplate: "https://s3.{region}.backblazeb2.com",
+		Required:         []string{"region", "endpoint", "access_key_id", "secret_access_key"},
+	},
+	{
+		Name:             "wasabi",
+		Description:      "Wasabi",
+		Type:             "s3",
+		Defaults:         map[string]string{"region": "us-east-1"},
+		Fixed:            map[string]string{"provider": "Wasabi", "env_auth": "false"},
+		EndpointTemplate: "https://s3.{region}.wasabisys.com",
+		Required:         []string{"region", "endpoint", "access_key_id", "secret_access_key"},
+	},
+	{
+		Name:        "cloudflare-r2",
+		Description: "Cloudflare R2",
+		Type:        "s3",
+		Defaults:    map[string]string{"acl": "private", "no_check_bucket": "true"},
+		Fixed:       map[string]string{"provider": "Cloudflare", "env_auth": "false", "region": "auto"},
+		Required:    []string{"endpoint", "access_key_id", "secret_access_key"},
+	},
+	{
+		Name:        "google-photos",
+		Description: "Google Photos",
+		Type:        "gphotos",
+		Defaults:    map[string]string{"include_archived": "true"},
+		Required:    []string{"token"},
+	},
+}
+
+// ListConnectionPresets lists the curated connection presets.
+func ListConnectionPresets() []ConnectionPreset {
+	return slices.Clone(connectionPresets)
+}
+
+// GetConnectionPreset gets the connection preset with the given name.
+func GetConnectionPreset(name string) (*ConnectionPreset, bool) {
+	for i := range connectionPresets {
+		if connectionPresets[i].Name == name {
+			preset := connectionPresets[i]
+			return &preset, true
+		}
+	}
+	return nil, false
+}
+
+// Apply returns the config with the values of the preset filled in.
+// Config values override the defaults, but not the fixed values, of the preset.
+func (p *ConnectionPreset) Apply(config map[string]string) map[string]string {
+	result := maps.Clone(p.Defaults)
+	if result == nil {
+		result = make(map[string]string, len(config)+len(p.Fixed))
+	}
+	for key, value := range config {
+		if value != "" {
+			result[key] = value
+		}
+	}
+	maps.Copy(result, p.Fixed)
+	if p.EndpointTemplate != "" && result["endpoint"] == "" && result["region"] != "" {
+		result["endpoint"] = strings.ReplaceAll(p.EndpointTemplate, "{region}", result["region"])
+	}
+	return result
+}
+
+// MissingFields returns the required fields of the preset that are empty in config, in the order of Required.
+func (p *ConnectionPreset) MissingFields(config map[string]string) []string {
+	var missing []string
+	for _, field := range p.Required {
+		if strings.TrimSpace(config[field]) == "" {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,82 @@
+package rclone_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+func TestListConnectionPresets(t *testing.T) {
+	presets := rclone.ListConnectionPresets()
+	names := make([]string, 0, len(presets))
+	for _, p := range presets {
+		names = append(names, p.Name)
+
+		// Every preset must configure an existing provider with options it knows
+		provider, err := rclone.GetProviderOptions(p.Type)
+		require.NoError(t, err, "Preset %s", p.Name)
+		options := make(map[string]bool, len(provider.Options))
+		for _, opt := range provider.Options {
+			options[opt.Name] = true
+		}
+		for _, fields := range []map[string]string{p.Defaults, p.Fixed} {
+			for key := range fields {
+				assert.True(t, options[key], "Preset %s sets unknown option %s", p.Name, key)
+			}
+		}
+		for _, key := range p.Required {
+			assert.True(t, options[key], "Preset %s requires unknown option %s", p.Name, key)
+		}
+	}
+	assert.Equal(t, []string{"minio", "backblaze-b2-s3", "wasabi", "cloudflare-r2", "google-photos"}, names)
+}
+
+func TestGetConnectionPreset(t *testing.T) {
+	preset, ok := rclone.GetConnectionPreset("wasabi")
+	require.True(t, ok)
+	assert.Equal(t, "s3", preset.Type)
+
+	_, ok = rclone.GetConnectionPreset("unknown")
+	assert.False(t, ok)
+}
+
+func TestConnectionPreset_Apply(t *testing.T) {
+	t.Run("EndpointFromRegion", func(t *testing.T) {
+		preset, _ := rclone.GetConnectionPreset("wasabi")
+		config := preset.Apply(map[string]string{"region": "eu-central-1", "access_key_id": "key"})
+		assert.Equal(t, "Wasabi", config["provider"])
+		assert.Equal(t, "https://s3.eu-central-1.wasabisys.com", config["endpoint"])
+		assert.Equal(t, []string{"secret_access_key"}, preset.MissingFields(config))
+	})
+
+	t.Run("DefaultRegion", func(t *testing.T) {
+		preset, _ := rclone.GetConnectionPreset("wasabi")
+		config := preset.Apply(nil)
+		assert.Equal(t, "us-east-1", config["region"])
+		assert.Equal(t, "https://s3.us-east-1.wasabisys.com", config["endpoint"])
+	})
+
+	t.Run("ConfigOverridesDefaultsButNotFixed", func(t *testing.T) {
+		preset, _ := rclone.GetConnectionPreset("cloudflare-r2")
+		config := preset.Apply(map[string]string{
+			"provider":        "AWS",
+			"region":          "us-east-1",
+			"no_check_bucket": "false",
+			"endpoint":        "https://account.r2.cloudflarestorage.com",
+		})
+		assert.Equal(t, "Cloudflare", config["provider"])
+		assert.Equal(t, "auto", config["region"])
+		assert.Equal(t, "false", config["no_check_bucket"])
+		assert.Equal(t, "private", config["acl"])
+		assert.Equal(t, []string{"access_key_id", "secret_access_key"}, preset.MissingFields(config))
+	})
+
+	t.Run("RegionRequiredForEndpoint", func(t *testing.T) {
+		preset, _ := rclone.GetConnectionPreset("backblaze-b2-s3")
+		config := preset.Apply(map[string]string{"access_key_id": "key", "secret_access_key": "secret"})
+		assert.Empty(t, config["endpoint"])
+		assert.Equal(t, []string{"region", "endpoint"}, preset.MissingFields(config))
+	})
+}
@@ -0,0 +1,97 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+// probeObjectPrefix is the name prefix of the temporary object written by ProbeRemotePath.
+const probeObjectPrefix = ".rclone-sync-probe-"
+
+// probeContent is the content of the probe object.
+const probeContent = "rclone-sync write probe\n"
+
+// ProbeRemotePath checks which operations the connection supports under remotePath.
+// It lists the path, writes a small probe object, reads it back and deletes it again.
+// A failing check does not abort the probe: later checks that depend on it are reported as skipped,
+// so read-only remotes yield LIST=OK and WRITE=FAILED instead of an error.
+// An error is only returned when the Fs cannot be created at all.
+func ProbeRemotePath(ctx context.Context, providerName string, params map[string]string, remotePath string) ([]*model.ConnectionCapabilityResult, error) {
+	f, err := newFsFromParams(ctx, providerName, params, remotePath)
+	if err != nil {
+		return nil, err
+	}
+	return probeFs(ctx, f), nil
+}
+
+// probeFs runs the capability checks against the root of f.
+func probeFs(ctx context.Context, f fs.Fs) []*model.ConnectionCapabilityResult {
+	results := make([]*model.ConnectionCapabilityResult, 0, 4)
+	record := func(capability model.ConnectionCapability, err error) bool {
+		results = append(results, capabilityResult(capability, err))
+		return err == nil
+	}
+	skip := func(capabilities ...model.ConnectionCapability) {
+		for _, c := range capabilities {
+			results = append(results, &model.ConnectionCapabilityResult{Capability: c, Status: model.CapabilityStatusSkipped})
+		}
+	}
+
+	// A missing directory is not a failure: the write probe creates it and it is removed again afterwards.
+	_, listErr := f.List(ctx, "")
+	dirExisted := listErr == nil
+	if errors.Is(listErr, fs.ErrorDirNotFound) {
+		listErr = nil
+	}
+	record(model.ConnectionCapabilityList, listErr)
+
+	name := probeObjectPrefix + uuid.NewString()
+	obj, err := operations.Rcat(ctx, f, name, io.NopCloser(strings.NewReader(probeContent)), time.Now(), nil)
+	if !record(model.ConnectionCapabilityWrite, err) {
+		skip(model.ConnectionCapabilityRead, model.ConnectionCapabilityDelete)
+		return results
+	}
+
+	record(model.ConnectionCapabilityRead, readProbe(ctx, obj))
+	record(model.ConnectionCapabilityDelete, obj.Remove(ctx))
+
+	if !dirExisted {
+		// Best effort: remove the directory created by the write probe
+		_ = f.Rmdir(ctx, "")
+	}
+	return results
+}
+
+// readProbe reads obj back and verifies its content.
+func readProbe(ctx context.Context, obj fs.Object) error {
+	rc, err := obj.Open(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	if string(data) != probeContent {
+		return errors.New("probe content mismatch")
+	}
+	return nil
+}
+
+func capabilityResult(capability model.ConnectionCapability, err error) *model.ConnectionCapabilityResult {
+	if err != nil {
+		msg := err.Error()
+		return &model.ConnectionCapabilityResult{Capability: capability, Status: model.CapabilityStatusFailed, Error: &msg}
+	}
+	return &model.ConnectionCapabilityResult{Capability: capability, Status: model.CapabilityStatusOk}
+}
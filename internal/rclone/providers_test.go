@@ -3,8 +3,10 @@ package rclone_test
 import (
 	"testing"
 
+	"github.com/rclone/rclone/fs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 )
 
@@ -69,3 +71,17 @@ func TestGetProviderOptions_CommonProviders(t *testing.T) {
 		})
 	}
 }
+
+// TestConnectionTypes tests that the ConnectionType enum matches the compiled rclone backends.
+func TestConnectionTypes(t *testing.T) {
+	var configTypes []model.ConnectionType
+	for _, item := range fs.Registry {
+		// Registered by the testutil package, not compiled into the app
+		if item.Prefix == "slowfs" {
+			continue
+		}
+		configTypes = append(configTypes, model.ConnectionType(item.Prefix))
+	}
+	assert.ElementsMatch(t, model.AllConnectionType, configTypes,
+		"every compiled backend must have a ConnectionType and vice versa, update enum ConnectionType in connection.graphql")
+}
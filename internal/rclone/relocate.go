@@ -0,0 +1,189 @@
+package rclone
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"go.uber.org/zap"
+)
+
+// bisyncStateDirName is the directory below the data directory holding bisync listings.
+const bisyncStateDirName = "bisync_state"
+
+// DataDirRelocation describes the outcome of relocating the data directory.
+type DataDirRelocation struct {
+	DataDir    string // New data directory
+	MovedFiles int    // Number of state files moved
+	MovedBytes int64  // Total size of the moved state files
+}
+
+// RelocateDataDir moves the bisync state below the current data directory to newDataDir.
+//
+// The bisync state is the only data the engine keeps there; callers must check the data directory
+// doesn't hold other data bound to its location, such as the demo data. Archives built in the
+// background are temporary and stay in the old directory until they expire.
+// The new data directory can't be inside the current one or contain it.
+//
+// Every file is copied and verified by checksum before the engine switches to the new
+// location; only then are the old files removed. On any error the engine keeps using the
+// old location and partially copied files are removed again. The caller must ensure no
+// job is running, e.g. by enabling maintenance mode.
+func (e *SyncEngine) RelocateDataDir(newDataDir string) (*DataDirRelocation, error) {
+	newDataDir, err := filepath.Abs(newDataDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidInput, err)
+	}
+	oldWorkDir := e.stateDir()
+	newWorkDir := filepath.Join(newDataDir, bisyncStateDirName)
+
+	oldDataDir, err := filepath.Abs(filepath.Dir(oldWorkDir))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidInput, err)
+	}
+	if oldDataDir == newDataDir {
+		return nil, fmt.Errorf("%w: the data directory is already %s", errs.ErrInvalidInput, newDataDir)
+	}
+	// Copying into a nested directory would walk its own copies, and removing the old state could remove the new one
+	if isWithinDir(newDataDir, oldDataDir) || isWithinDir(oldDataDir, newDataDir) {
+		return nil, fmt.Errorf("%w: %s and the data directory %s must not contain each other", errs.ErrInvalidInput, newDataDir, oldDataDir)
+	}
+	if entries, err := os.ReadDir(newWorkDir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("%w: %s already contains bisync state", errs.ErrInvalidInput, newWorkDir)
+	}
+
+	result := &DataDirRelocation{DataDir: newDataDir}
+	var copied []string
+	err = filepath.WalkDir(oldWorkDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(oldWorkDir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(newWorkDir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o750)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		size, err := copyVerified(path, target)
+		if err != nil {
+			return err
+		}
+		copied = append(copied, target)
+		result.MovedFiles++
+		result.MovedBytes += size
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		for _, target := range copied {
+			_ = os.Remove(target)
+		}
+		return nil, fmt.Errorf("failed to copy bisync state: %w", err)
+	}
+	if err := os.MkdirAll(newWorkDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create bisync state directory: %w", err)
+	}
+
+	e.workDirMu.Lock()
+	e.workDir = newWorkDir
+	e.workDirMu.Unlock()
+
+	if err := os.RemoveAll(oldWorkDir); err != nil {
+		// The state is safely in the new location, leftovers only waste space
+		e.logger.Warn("Failed to remove old bisync state directory", zap.String("path", oldWorkDir), zap.Error(err))
+	}
+
+	e.logger.Info("Relocated data directory",
+		zap.String("old_state_dir", oldWorkDir),
+		zap.String("new_state_dir", newWorkDir),
+		zap.Int("files", result.MovedFiles),
+		zap.Int64("bytes", result.MovedBytes),
+	)
+	return result, nil
+}
+
+// DataDir returns the data directory currently holding the bisync state.
+func (e *SyncEngine) DataDir() string {
+	return filepath.Dir(e.stateDir())
+}
+
+// stateDir returns the current bisync state directory.
+func (e *SyncEngine) stateDir() string {
+	e.workDirMu.RLock()
+	defer e.workDirMu.RUnlock()
+	return e.workDir
+}
+
+// isWithinDir reports whether the absolute path p is dir or a path below dir.
+func isWithinDir(p, dir string) bool {
+	rel, err := filepath.Rel(dir, p)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// copyVerified copies src to dst, syncs it to disk and verifies the copy by checksum.
+// It returns the number of bytes copied.
+func copyVerified(src, dst string) (int64, error) {
+	in, err := os.Open(src) //nolint:gosec // src is below the engine's own state directory
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return 0, err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm()) //nolint:gosec // dst is below the new data directory
+	if err != nil {
+		return 0, err
+	}
+
+	srcHash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(out, srcHash), in)
+	if err == nil {
+		err = out.Sync()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst)
+		return 0, err
+	}
+
+	dstSum, err := fileChecksum(dst)
+	if err != nil {
+		_ = os.Remove(dst)
+		return 0, err
+	}
+	if !bytes.Equal(srcHash.Sum(nil), dstSum) {
+		_ = os.Remove(dst)
+		return 0, fmt.Errorf("checksum mismatch after copying %s", src) //nolint:err113
+	}
+	return n, nil
+}
+
+// fileChecksum returns the SHA-256 checksum of a file.
+func fileChecksum(path string) ([]byte, error) {
+	f, err := os.Open(path) //nolint:gosec // path was just written by copyVerified
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
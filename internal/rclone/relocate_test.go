@@ -0,0 +1,84 @@
+package rclone_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+func TestSyncEngine_RelocateDataDir(t *testing.T) {
+	t.Run("moves and verifies bisync state", func(t *testing.T) {
+		oldDataDir := t.TempDir()
+		oldWorkDir := filepath.Join(oldDataDir, "bisync_state")
+		require.NoError(t, os.MkdirAll(filepath.Join(oldWorkDir, "sub"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(oldWorkDir, "a..b.path1.lst"), []byte("listing 1"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(oldWorkDir, "sub", "nested.lst"), []byte("nested"), 0600))
+
+		engine := rclone.NewSyncEngine(nil, nil, nil, oldDataDir, false, 0)
+		newDataDir := filepath.Join(t.TempDir(), "moved")
+
+		result, err := engine.RelocateDataDir(newDataDir)
+		require.NoError(t, err)
+		assert.Equal(t, newDataDir, result.DataDir)
+		assert.Equal(t, 2, result.MovedFiles)
+		assert.Equal(t, int64(len("listing 1")+len("nested")), result.MovedBytes)
+		assert.Equal(t, newDataDir, engine.DataDir())
+
+		content, err := os.ReadFile(filepath.Join(newDataDir, "bisync_state", "sub", "nested.lst"))
+		require.NoError(t, err)
+		assert.Equal(t, "nested", string(content))
+		assert.NoDirExists(t, oldWorkDir)
+	})
+
+	t.Run("without existing state only switches directory", func(t *testing.T) {
+		engine := rclone.NewSyncEngine(nil, nil, nil, t.TempDir(), false, 0)
+		newDataDir := t.TempDir()
+
+		result, err := engine.RelocateDataDir(newDataDir)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.MovedFiles)
+		assert.DirExists(t, filepath.Join(newDataDir, "bisync_state"))
+		assert.Equal(t, newDataDir, engine.DataDir())
+	})
+
+	t.Run("refuses target with existing state", func(t *testing.T) {
+		oldDataDir := t.TempDir()
+		engine := rclone.NewSyncEngine(nil, nil, nil, oldDataDir, false, 0)
+		newDataDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(newDataDir, "bisync_state"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(newDataDir, "bisync_state", "other.lst"), []byte("x"), 0600))
+
+		_, err := engine.RelocateDataDir(newDataDir)
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+		assert.Equal(t, oldDataDir, engine.DataDir())
+	})
+
+	t.Run("refuses nested directories", func(t *testing.T) {
+		parent := t.TempDir()
+		oldDataDir := filepath.Join(parent, "data")
+		require.NoError(t, os.MkdirAll(filepath.Join(oldDataDir, "bisync_state"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(oldDataDir, "bisync_state", "a.lst"), []byte("x"), 0600))
+		engine := rclone.NewSyncEngine(nil, nil, nil, oldDataDir, false, 0)
+
+		for _, newDataDir := range []string{filepath.Join(oldDataDir, "bisync_state", "moved"), filepath.Join(oldDataDir, "moved"), parent} {
+			_, err := engine.RelocateDataDir(newDataDir)
+			assert.ErrorIs(t, err, errs.ErrInvalidInput, newDataDir)
+			assert.Equal(t, oldDataDir, engine.DataDir())
+			assert.FileExists(t, filepath.Join(oldDataDir, "bisync_state", "a.lst"))
+		}
+	})
+
+	t.Run("refuses current directory", func(t *testing.T) {
+		dataDir := t.TempDir()
+		engine := rclone.NewSyncEngine(nil, nil, nil, dataDir, false, 0)
+
+		_, err := engine.RelocateDataDir(dataDir)
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	})
+}
@@ -2,12 +2,16 @@ package rclone
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/config/configmap"
 	"github.com/rclone/rclone/fs/filter"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
@@ -21,9 +25,27 @@ type DirEntry struct {
 // TestRemote verifies if the remote configuration is valid by attempting to create the Fs
 // and doing a lightweight check.
 func TestRemote(ctx context.Context, providerName string, params map[string]string) error {
+	// The root is empty for the root of the bucket/drive.
+	f, err := newFsFromParams(ctx, providerName, params, "")
+	if err != nil {
+		return err
+	}
+
+	// Double check connectivity by listing the root.
+	// Some backends initialize without error but fail on the first API call.
+	_, err = f.List(ctx, "")
+	if err != nil {
+		return i18n.NewI18nError(i18n.ErrConnectionTestFailed).WithCause(err)
+	}
+
+	return nil
+}
+
+// newFsFromParams creates an unsaved Fs instance for the given provider, config params and root.
+func newFsFromParams(ctx context.Context, providerName string, params map[string]string, root string) (fs.Fs, error) {
 	regItem, err := fs.Find(providerName)
 	if err != nil {
-		return i18n.NewI18nError(i18n.ErrProviderNotFound).WithCause(err)
+		return nil, i18n.NewI18nError(i18n.ErrProviderNotFound).WithCause(err)
 	}
 
 	// Create a ConfigMap from the params
@@ -32,20 +54,11 @@ func TestRemote(ctx context.Context, providerName string, params map[string]stri
 	// regItem.NewFs doesn't persist config. It creates an Fs instance from arguments.
 	// This is exactly what we want for testing without saving.
 	// The `name` here is a temporary name for the instance, can be empty.
-	// The `root` is empty for the root of the bucket/drive.
-	f, err := regItem.NewFs(ctx, "", "", m)
+	f, err := regItem.NewFs(ctx, "", root, m)
 	if err != nil {
-		return i18n.NewI18nError(i18n.ErrConnectionTestFailed).WithCause(err)
+		return nil, i18n.NewI18nError(i18n.ErrConnectionTestFailed).WithCause(err)
 	}
-
-	// Double check connectivity by listing the root.
-	// Some backends initialize without error but fail on the first API call.
-	_, err = f.List(ctx, "")
-	if err != nil {
-		return i18n.NewI18nError(i18n.ErrConnectionTestFailed).WithCause(err)
-	}
-
-	return nil
+	return f, nil
 }
 
 // CalculateListPath calculates the Fs root path and the relative list path for directory listing.
@@ -107,6 +120,61 @@ func ExtractEntryName(path string) string {
 	return path
 }
 
+// ResolveRemotePath prepends the connection's base path to a task's remote path.
+// An empty basePath leaves remotePath unchanged.
+//
+// Examples:
+//   - basePath="/backups-v2", remotePath="photos" → "/backups-v2/photos"
+//   - basePath="backups", remotePath="/photos" → "backups/photos"
+//   - basePath="backups", remotePath="" → "backups"
+//   - basePath="", remotePath="photos" → "photos"
+func ResolveRemotePath(basePath, remotePath string) string {
+	if basePath == "" {
+		return remotePath
+	}
+	return path.Join(basePath, remotePath)
+}
+
+// TaskRemotePath returns the remote path a task actually syncs with,
+// taking the base path of its connection into account when the connection edge is loaded.
+func TaskRemotePath(task *ent.Task) string {
+	if task.Edges.Connection == nil {
+		return task.RemotePath
+	}
+	return ResolveRemotePath(task.Edges.Connection.BasePath, task.RemotePath)
+}
+
+// RemoteDirExists reports whether remotePath exists as a directory on the named remote.
+// A path that points to a file is reported as an ErrPathNotDirectory error.
+func RemoteDirExists(ctx context.Context, remoteName, remotePath string) (bool, error) {
+	f, err := GetFs(ctx, remoteName, remotePath)
+	if errors.Is(err, fs.ErrorIsFile) {
+		return false, i18n.NewI18nError(i18n.ErrPathNotDirectory)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Most backends create the Fs without touching the remote, so list the root to find out whether it exists
+	_, err = f.List(ctx, "")
+	if errors.Is(err, fs.ErrorDirNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MakeRemoteDir creates remotePath, including missing parents, on the named remote.
+func MakeRemoteDir(ctx context.Context, remoteName, remotePath string) error {
+	f, err := GetFs(ctx, remoteName, remotePath)
+	if err != nil {
+		return err
+	}
+	return operations.Mkdir(ctx, f, "")
+}
+
 // ListRemoteDirOptions contains options for listing remote directory entries.
 type ListRemoteDirOptions struct {
 	// RemoteName is the name of the configured remote (required)
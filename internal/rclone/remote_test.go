@@ -8,6 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 )
 
@@ -48,6 +51,75 @@ func TestTestRemote_InvalidParams(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestProbeRemotePath(t *testing.T) {
+	setupTestConfig(t)
+
+	ctx := context.Background()
+
+	statuses := func(results []*model.ConnectionCapabilityResult) map[model.ConnectionCapability]model.CapabilityStatus {
+		m := make(map[model.ConnectionCapability]model.CapabilityStatus)
+		for _, r := range results {
+			m[r.Capability] = r.Status
+		}
+		return m
+	}
+
+	t.Run("writable directory", func(t *testing.T) {
+		dir := t.TempDir()
+
+		results, err := rclone.ProbeRemotePath(ctx, "local", map[string]string{}, dir)
+		require.NoError(t, err)
+		assert.Equal(t, map[model.ConnectionCapability]model.CapabilityStatus{
+			model.ConnectionCapabilityList:   model.CapabilityStatusOk,
+			model.ConnectionCapabilityWrite:  model.CapabilityStatusOk,
+			model.ConnectionCapabilityRead:   model.CapabilityStatusOk,
+			model.ConnectionCapabilityDelete: model.CapabilityStatusOk,
+		}, statuses(results))
+
+		// The probe object must be cleaned up
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("missing directory is removed again", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "missing")
+
+		results, err := rclone.ProbeRemotePath(ctx, "local", map[string]string{}, dir)
+		require.NoError(t, err)
+		assert.Equal(t, model.CapabilityStatusOk, statuses(results)[model.ConnectionCapabilityWrite])
+		assert.NoDirExists(t, dir)
+	})
+
+	t.Run("read-only directory", func(t *testing.T) {
+		if os.Geteuid() == 0 {
+			t.Skip("permissions are not enforced for root")
+		}
+		dir := t.TempDir()
+		require.NoError(t, os.Chmod(dir, 0o555))
+		t.Cleanup(func() { _ = os.Chmod(dir, 0o755) })
+
+		results, err := rclone.ProbeRemotePath(ctx, "local", map[string]string{}, dir)
+		require.NoError(t, err)
+		assert.Equal(t, map[model.ConnectionCapability]model.CapabilityStatus{
+			model.ConnectionCapabilityList:   model.CapabilityStatusOk,
+			model.ConnectionCapabilityWrite:  model.CapabilityStatusFailed,
+			model.ConnectionCapabilityRead:   model.CapabilityStatusSkipped,
+			model.ConnectionCapabilityDelete: model.CapabilityStatusSkipped,
+		}, statuses(results))
+		for _, r := range results {
+			if r.Status == model.CapabilityStatusFailed {
+				assert.NotNil(t, r.Error)
+			}
+		}
+	})
+
+	t.Run("invalid provider", func(t *testing.T) {
+		_, err := rclone.ProbeRemotePath(ctx, "non-existent-provider", map[string]string{}, "x")
+		assert.Error(t, err)
+	})
+}
+
 func TestListRemoteDir(t *testing.T) {
 	setupTestConfig(t)
 
@@ -741,3 +813,65 @@ func TestExtractEntryName(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveRemotePath(t *testing.T) {
+	tests := []struct {
+		name       string
+		basePath   string
+		remotePath string
+		expected   string
+	}{
+		{name: "no base path", basePath: "", remotePath: "photos", expected: "photos"},
+		{name: "absolute base path", basePath: "/backups-v2", remotePath: "photos", expected: "/backups-v2/photos"},
+		{name: "absolute remote path", basePath: "backups", remotePath: "/photos", expected: "backups/photos"},
+		{name: "empty remote path", basePath: "backups", remotePath: "", expected: "backups"},
+		{name: "nested paths", basePath: "a/b", remotePath: "c/d/", expected: "a/b/c/d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rclone.ResolveRemotePath(tt.basePath, tt.remotePath))
+		})
+	}
+}
+
+func TestTaskRemotePath(t *testing.T) {
+	task := &ent.Task{RemotePath: "photos"}
+	assert.Equal(t, "photos", rclone.TaskRemotePath(task), "connection edge not loaded")
+
+	task.Edges.Connection = &ent.Connection{Name: "remote", BasePath: "/backups"}
+	assert.Equal(t, "/backups/photos", rclone.TaskRemotePath(task))
+}
+
+func TestRemoteDirExists(t *testing.T) {
+	setupTestConfig(t)
+	ctx := context.Background()
+	remoteName := "local-exists"
+	require.NoError(t, createRemote(remoteName, map[string]string{"type": "local"}))
+	defer deleteRemote(remoteName)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.txt")
+	require.NoError(t, os.WriteFile(file, []byte("x"), 0o644))
+
+	exists, err := rclone.RemoteDirExists(ctx, remoteName, dir)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	missing := filepath.Join(dir, "missing", "nested")
+	exists, err = rclone.RemoteDirExists(ctx, remoteName, missing)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = rclone.RemoteDirExists(ctx, remoteName, file)
+	i18nErr, ok := i18n.IsI18nError(err)
+	require.True(t, ok)
+	assert.Equal(t, i18n.ErrPathNotDirectory, i18nErr.MsgID)
+
+	require.NoError(t, rclone.MakeRemoteDir(ctx, remoteName, missing))
+	assert.DirExists(t, missing)
+	rclone.ClearFsCache(remoteName)
+	exists, err = rclone.RemoteDirExists(ctx, remoteName, missing)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
@@ -0,0 +1,123 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rclone/rclone/cmd/bisync/bilib"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"go.uber.org/zap"
+)
+
+// RenameRemoteState migrates rclone state kept for a connection after it was renamed from oldName to newName.
+//
+// bisync names its listing files after both sync paths, including the remote name, so without
+// migration a rename would make every bidirectional task of the connection run a full resync.
+// The state files of the given tasks are renamed to the session names of the new remote name,
+// and cached Fs instances of both names are dropped.
+//
+// It returns the number of migrated state files.
+func (e *SyncEngine) RenameRemoteState(ctx context.Context, oldName, newName string, tasks []*ent.Task) (int, error) {
+	ClearFsCache(oldName)
+	ClearFsCache(newName)
+
+	if oldName == newName {
+		return 0, nil
+	}
+
+	workDir := e.stateDir()
+	entries, err := os.ReadDir(workDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	migrated := 0
+	for _, task := range tasks {
+		n, err := e.moveTaskState(ctx, workDir, entries, task, oldName, newName, false)
+		migrated += n
+		if err != nil {
+			return migrated, err
+		}
+	}
+
+	e.logger.Info("Migrated bisync state for renamed connection",
+		zap.String("old_name", oldName),
+		zap.String("new_name", newName),
+		zap.Int("files", migrated),
+	)
+	return migrated, nil
+}
+
+// MigrateTaskState migrates the bisync state of a task moved from the connection oldName to newName,
+// the connection it is now loaded with, like RenameRemoteState does for a renamed connection.
+// With reset, the state of the old connection is removed instead, so the next run resyncs.
+// State is only found if the remote path of the task resolves the same on both connections, otherwise
+// the next run resyncs anyway. It returns the number of migrated or removed state files.
+func (e *SyncEngine) MigrateTaskState(ctx context.Context, task *ent.Task, oldName, newName string, reset bool) (int, error) {
+	if oldName == newName {
+		return 0, nil
+	}
+	workDir := e.stateDir()
+	entries, err := os.ReadDir(workDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return e.moveTaskState(ctx, workDir, entries, task, oldName, newName, reset)
+}
+
+// moveTaskState renames the state files of a bidirectional task in workDir from the session names of the remote
+// name oldName to those of newName, or removes them with reset. Tasks of other directions have no state.
+// It returns the number of renamed or removed state files.
+func (e *SyncEngine) moveTaskState(ctx context.Context, workDir string, entries []os.DirEntry, task *ent.Task, oldName, newName string, reset bool) (int, error) {
+	if task.Direction != model.SyncDirectionBidirectional {
+		return 0, nil
+	}
+
+	// Session names depend on how the backend normalizes its root, so derive them from real Fs instances.
+	// The old remote name may no longer exist, but its root is normalized the same way as the new one.
+	fLocal, err := GetFs(ctx, "", task.SourcePath)
+	if err != nil {
+		e.logger.Warn("Failed to resolve local path for bisync state migration",
+			zap.Stringer("task_id", task.ID), zap.Error(err))
+		return 0, nil
+	}
+	fRemote, err := GetFs(ctx, newName, TaskRemotePath(task))
+	if err != nil {
+		e.logger.Warn("Failed to resolve remote path for bisync state migration",
+			zap.Stringer("task_id", task.ID), zap.Error(err))
+		return 0, nil
+	}
+	newSession := bilib.SessionName(fLocal, fRemote)
+	path1 := bilib.StripHexString(bilib.CanonicalPath(bilib.FsPath(fLocal)))
+	path2 := strings.TrimPrefix(newSession, path1+"..")
+	rootPart := strings.TrimPrefix(path2, bilib.CanonicalPath(newName))
+	oldSession := path1 + ".." + bilib.CanonicalPath(oldName) + rootPart
+
+	moved := 0
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, oldSession+".") {
+			continue
+		}
+		if reset {
+			err = os.Remove(filepath.Join(workDir, name))
+		} else {
+			target := newSession + strings.TrimPrefix(name, oldSession)
+			err = os.Rename(filepath.Join(workDir, name), filepath.Join(workDir, target))
+		}
+		if err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
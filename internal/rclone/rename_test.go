@@ -0,0 +1,138 @@
+package rclone_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/cmd/bisync/bilib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+func TestSyncEngine_RenameRemoteState(t *testing.T) {
+	setupTestConfig(t)
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	workDir := filepath.Join(dataDir, "bisync_state")
+	require.NoError(t, os.MkdirAll(workDir, 0755))
+	engine := rclone.NewSyncEngine(nil, nil, nil, dataDir, false, 0)
+
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+	require.NoError(t, createRemote("rename-old", map[string]string{"type": "local"}))
+	require.NoError(t, createRemote("rename-old-other", map[string]string{"type": "local"}))
+	t.Cleanup(func() {
+		deleteRemote("rename-old")
+		deleteRemote("rename-old-other")
+		deleteRemote("rename-new")
+	})
+
+	// basePath computes the bisync state base path exactly like runBidirectional does
+	basePath := func(remote string) string {
+		fLocal, err := rclone.GetFs(ctx, "", localDir)
+		require.NoError(t, err)
+		fRemote, err := rclone.GetFs(ctx, remote, remoteDir)
+		require.NoError(t, err)
+		return bilib.BasePath(ctx, workDir, fLocal, fRemote)
+	}
+
+	oldBase := basePath("rename-old")
+	otherBase := basePath("rename-old-other")
+	for _, suffix := range []string{".path1.lst", ".path2.lst", ".path1.lst-old"} {
+		require.NoError(t, os.WriteFile(oldBase+suffix, []byte("state"), 0644))
+		require.NoError(t, os.WriteFile(otherBase+suffix, []byte("other"), 0644))
+	}
+
+	tasks := []*ent.Task{
+		{ID: uuid.New(), SourcePath: localDir, RemotePath: remoteDir, Direction: model.SyncDirectionBidirectional},
+		{ID: uuid.New(), SourcePath: localDir, RemotePath: remoteDir, Direction: model.SyncDirectionUpload},
+	}
+
+	// Rename the remote as the connection service would
+	require.NoError(t, createRemote("rename-new", map[string]string{"type": "local"}))
+	deleteRemote("rename-old")
+
+	migrated, err := engine.RenameRemoteState(ctx, "rename-old", "rename-new", tasks)
+	require.NoError(t, err)
+	assert.Equal(t, 3, migrated)
+
+	newBase := basePath("rename-new")
+	for _, suffix := range []string{".path1.lst", ".path2.lst", ".path1.lst-old"} {
+		assert.FileExists(t, newBase+suffix)
+		assert.NoFileExists(t, oldBase+suffix)
+		// State of a connection whose name shares the prefix is left alone
+		assert.FileExists(t, otherBase+suffix)
+	}
+}
+
+func TestSyncEngine_RenameRemoteState_NoWorkDir(t *testing.T) {
+	engine := rclone.NewSyncEngine(nil, nil, nil, t.TempDir(), false, 0)
+
+	migrated, err := engine.RenameRemoteState(context.Background(), "a", "b", nil)
+	require.NoError(t, err)
+	assert.Zero(t, migrated)
+}
+
+func TestSyncEngine_MigrateTaskState(t *testing.T) {
+	setupTestConfig(t)
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	workDir := filepath.Join(dataDir, "bisync_state")
+	require.NoError(t, os.MkdirAll(workDir, 0755))
+	engine := rclone.NewSyncEngine(nil, nil, nil, dataDir, false, 0)
+
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+	for _, name := range []string{"migrate-from", "migrate-to"} {
+		require.NoError(t, createRemote(name, map[string]string{"type": "local"}))
+	}
+	t.Cleanup(func() {
+		deleteRemote("migrate-from")
+		deleteRemote("migrate-to")
+	})
+
+	basePath := func(remote string) string {
+		fLocal, err := rclone.GetFs(ctx, "", localDir)
+		require.NoError(t, err)
+		fRemote, err := rclone.GetFs(ctx, remote, remoteDir)
+		require.NoError(t, err)
+		return bilib.BasePath(ctx, workDir, fLocal, fRemote)
+	}
+	fromBase, toBase := basePath("migrate-from"), basePath("migrate-to")
+	writeState := func() {
+		for _, suffix := range []string{".path1.lst", ".path2.lst"} {
+			require.NoError(t, os.WriteFile(fromBase+suffix, []byte("state"), 0644))
+		}
+	}
+	task := &ent.Task{ID: uuid.New(), SourcePath: localDir, RemotePath: remoteDir, Direction: model.SyncDirectionBidirectional}
+
+	// The state follows the task to the new connection
+	writeState()
+	migrated, err := engine.MigrateTaskState(ctx, task, "migrate-from", "migrate-to", false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated)
+	for _, suffix := range []string{".path1.lst", ".path2.lst"} {
+		assert.FileExists(t, toBase+suffix)
+		assert.NoFileExists(t, fromBase+suffix)
+		require.NoError(t, os.Remove(toBase+suffix))
+	}
+
+	// With reset the state is removed, so the next run resyncs
+	writeState()
+	migrated, err = engine.MigrateTaskState(ctx, task, "migrate-from", "migrate-to", true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated)
+	for _, suffix := range []string{".path1.lst", ".path2.lst"} {
+		assert.NoFileExists(t, toBase+suffix)
+		assert.NoFileExists(t, fromBase+suffix)
+	}
+}
@@ -0,0 +1,164 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/rclone/rclone/fs/fserrors"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+// classifyTransferError returns the class of the error a file transfer failed with.
+// Classes are checked from the most to the least specific, so e.g. a rate limit is not reported as a network error.
+func classifyTransferError(err error) model.TransferErrorClass {
+	msg := strings.ToLower(err.Error())
+	var netErr net.Error
+	switch {
+	case errors.Is(err, fs.ErrorObjectNotFound), errors.Is(err, fs.ErrorDirNotFound), errors.Is(err, os.ErrNotExist):
+		return model.TransferErrorClassNotFound
+	case errors.Is(err, os.ErrPermission), strings.Contains(msg, "permission denied"), strings.Contains(msg, "403 forbidden"):
+		return model.TransferErrorClassPermissionDenied
+	case fserrors.IsErrNoSpace(err), strings.Contains(msg, "quota"), strings.Contains(msg, "insufficient storage"):
+		return model.TransferErrorClassNoSpace
+	case fserrors.IsRetryAfterError(err), strings.Contains(msg, "rate limit"), strings.Contains(msg, "too many requests"):
+		return model.TransferErrorClassRateLimited
+	case strings.Contains(msg, "corrupted on transfer"):
+		return model.TransferErrorClassCorrupted
+	case errors.As(err, &netErr), fserrors.IsRetryError(err):
+		return model.TransferErrorClassNetwork
+	default:
+		return model.TransferErrorClassOther
+	}
+}
+
+// isFatalRunError reports whether a run failed with a non-transient error, which fails every run until the
+// configuration of the task or its connection is fixed: a missing path or remote, denied access or failed authentication.
+func isFatalRunError(err error) bool {
+	switch classifyTransferError(err) {
+	case model.TransferErrorClassNotFound, model.TransferErrorClassPermissionDenied:
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return errors.Is(err, fs.ErrorNotFoundInConfigFile) ||
+		strings.Contains(msg, "unauthorized") || strings.Contains(msg, "invalid_grant") ||
+		strings.Contains(msg, "unable to authenticate") || strings.Contains(msg, "authentication failed")
+}
+
+// failedTransfer returns the retry queue item of a file transfer that failed within a job of task.
+// Shard jobs and additional paths transfer files relative to other directories than the task root,
+// so the path is made relative to the task's remote path using the local side of the transfer.
+func failedTransfer(task *ent.Task, snapshot accounting.TransferSnapshot) *ent.RetryQueue {
+	direction, localFs := model.SyncDirectionUpload, snapshot.SrcFs
+	sourcePath, remoteSubpath, ok := localPath(task, snapshot.SrcFs)
+	if !ok {
+		direction, localFs = model.SyncDirectionDownload, snapshot.DstFs
+		sourcePath, remoteSubpath, ok = localPath(task, snapshot.DstFs)
+	}
+
+	name := snapshot.Name
+	if ok {
+		dir := strings.TrimPrefix(strings.TrimPrefix(localFs, strings.TrimSuffix(sourcePath, "/")), "/")
+		name = path.Join(remoteSubpath, filepath.ToSlash(dir), name)
+	}
+
+	return &ent.RetryQueue{
+		TaskID:     task.ID,
+		Path:       name,
+		Direction:  direction,
+		ErrorClass: classifyTransferError(snapshot.Error),
+		Error:      snapshot.Error.Error(),
+	}
+}
+
+// retryFilterRules returns filter rules that include exactly the given paths, relative to the task root.
+func retryFilterRules(paths []string) []string {
+	rules := make([]string, 0, len(paths)+1)
+	for _, p := range paths {
+		rules = append(rules, "+ /"+escapeFilterGlob(p))
+	}
+	return append(rules, "- **")
+}
+
+// retryOptions returns the options of a run retrying failed files: the files are copied without sharding,
+// sizing or deleting anything, regardless of the task's options. Filters are set per direction by runRetry,
+// additional paths are kept so their files are copied between the right directories, the handling of
+// Windows names so they are copied under the same names and the metadata preservation so they keep the same metadata.
+func retryOptions(opts SyncOptions) SyncOptions {
+	createEmptySrcDirs := false
+	return SyncOptions{
+		Transfers:          opts.Transfers,
+		MaxDuration:        opts.MaxDuration,
+		Paths:              opts.Paths,
+		WindowsNames:       opts.WindowsNames,
+		PreserveMetadata:   opts.PreserveMetadata,
+		NoDelete:           true,
+		SkipSizing:         true,
+		CreateEmptySrcDirs: &createEmptySrcDirs,
+	}
+}
+
+// runRetry copies the files of the retry queue items in the direction each of them failed in.
+// Files of bidirectional tasks are copied one-way as well, since filtering bisync to a few
+// files would make it consider all other files deleted. Item paths are relative to the task's
+// remote path, so the files of additional paths are copied by the pair of their remote subpath.
+func (e *SyncEngine) runRetry(ctx context.Context, pairs []syncPair, opts SyncOptions, items []*ent.RetryQueue) error {
+	var errs []error
+	for _, pair := range pairs {
+		var pairItems []*ent.RetryQueue
+		for _, item := range items {
+			if pair.RemoteSubpath == "" {
+				if !slices.ContainsFunc(pairs[1:], func(p syncPair) bool { return isWithinPath(item.Path, p.RemoteSubpath) }) {
+					pairItems = append(pairItems, item)
+				}
+				continue
+			}
+			if isWithinPath(item.Path, pair.RemoteSubpath) {
+				relative := *item
+				relative.Path = strings.TrimPrefix(strings.TrimPrefix(item.Path, pair.RemoteSubpath), "/")
+				pairItems = append(pairItems, &relative)
+			}
+		}
+		if len(pairItems) > 0 {
+			errs = append(errs, e.retryPair(ctx, pair.Local, pair.Remote, opts, pairItems))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// retryPair copies the files of the retry queue items between fLocal and fRemote.
+func (e *SyncEngine) retryPair(ctx context.Context, fLocal, fRemote fs.Fs, opts SyncOptions, items []*ent.RetryQueue) error {
+	type queued struct {
+		direction model.SyncDirection
+		path      string
+	}
+	seen := map[queued]bool{}
+	paths := map[model.SyncDirection][]string{}
+	for _, item := range items {
+		key := queued{item.Direction, item.Path}
+		if !seen[key] {
+			seen[key] = true
+			paths[item.Direction] = append(paths[item.Direction], item.Path)
+		}
+	}
+
+	var errs []error
+	if len(paths[model.SyncDirectionUpload]) > 0 {
+		opts.Filters = retryFilterRules(paths[model.SyncDirectionUpload])
+		errs = append(errs, e.runOneWay(ctx, fLocal, fRemote, opts))
+	}
+	if len(paths[model.SyncDirectionDownload]) > 0 {
+		opts.Filters = retryFilterRules(paths[model.SyncDirectionDownload])
+		errs = append(errs, e.runOneWay(ctx, fRemote, fLocal, opts))
+	}
+	return errors.Join(errs...)
+}
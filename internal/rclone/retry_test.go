@@ -0,0 +1,87 @@
+package rclone
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+func TestClassifyTransferError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want model.TransferErrorClass
+	}{
+		{fs.ErrorObjectNotFound, model.TransferErrorClassNotFound},
+		{fmt.Errorf("open: %w", os.ErrNotExist), model.TransferErrorClassNotFound},
+		{&os.PathError{Op: "open", Path: "/data/a.txt", Err: syscall.EACCES}, model.TransferErrorClassPermissionDenied},
+		{errors.New("googleapi: Error 403: The user's Drive storage quota has been exceeded"), model.TransferErrorClassNoSpace},
+		{errors.New("HTTP error 429 (429 Too Many Requests)"), model.TransferErrorClassRateLimited},
+		{errors.New("corrupted on transfer: md5 hashes differ"), model.TransferErrorClassCorrupted},
+		{fmt.Errorf("write: %w", syscall.ECONNRESET), model.TransferErrorClassNetwork},
+		{errors.New("something unexpected"), model.TransferErrorClassOther},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, classifyTransferError(tt.err), tt.err.Error())
+	}
+}
+
+func TestIsFatalRunError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{fmt.Errorf("sync: %w", fs.ErrorDirNotFound), true},
+		{&os.PathError{Op: "open", Path: "/data", Err: syscall.EACCES}, true},
+		{fs.ErrorNotFoundInConfigFile, true},
+		{errors.New("couldn't list files: 401 Unauthorized"), true},
+		{errors.New(`oauth2: "invalid_grant" "Token has been expired or revoked."`), true},
+		{errors.New("ssh: handshake failed: ssh: unable to authenticate"), true},
+		{errors.New("HTTP error 429 (429 Too Many Requests)"), false},
+		{fmt.Errorf("write: %w", syscall.ECONNRESET), false},
+		{errors.New("something unexpected"), false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isFatalRunError(tt.err), tt.err.Error())
+	}
+}
+
+func TestFailedTransfer(t *testing.T) {
+	task := &ent.Task{ID: uuid.New(), SourcePath: "/data/"}
+	failure := errors.New("connection reset by peer")
+
+	item := failedTransfer(task, accounting.TransferSnapshot{Name: "a.txt", SrcFs: "/data", DstFs: "remote:backup", Error: failure})
+	assert.Equal(t, task.ID, item.TaskID)
+	assert.Equal(t, "a.txt", item.Path)
+	assert.Equal(t, model.SyncDirectionUpload, item.Direction)
+	assert.Equal(t, model.TransferErrorClassOther, item.ErrorClass)
+	assert.Equal(t, "connection reset by peer", item.Error)
+
+	// Shard jobs transfer files relative to a sub-directory of the task
+	item = failedTransfer(task, accounting.TransferSnapshot{Name: "2024/b.jpg", SrcFs: "/data/photos", DstFs: "remote:backup/photos", Error: failure})
+	assert.Equal(t, "photos/2024/b.jpg", item.Path)
+	assert.Equal(t, model.SyncDirectionUpload, item.Direction)
+
+	item = failedTransfer(task, accounting.TransferSnapshot{Name: "c.txt", SrcFs: "remote:backup/docs", DstFs: "/data/docs", Error: failure})
+	assert.Equal(t, "docs/c.txt", item.Path)
+	assert.Equal(t, model.SyncDirectionDownload, item.Direction)
+
+	// Files of additional paths are relative to their remote subpath
+	task.Options = &model.TaskSyncOptions{Paths: []*model.TaskPath{{SourcePath: "/photos", RemoteSubpath: "media/photos"}}}
+	item = failedTransfer(task, accounting.TransferSnapshot{Name: "d.jpg", SrcFs: "/photos", DstFs: "remote:backup/media/photos", Error: failure})
+	assert.Equal(t, "media/photos/d.jpg", item.Path)
+	assert.Equal(t, model.SyncDirectionUpload, item.Direction)
+}
+
+func TestRetryFilterRules(t *testing.T) {
+	assert.Equal(t, []string{"+ /a.txt", "+ /photos/\\[1\\].jpg", "- **"}, retryFilterRules([]string{"a.txt", "photos/[1].jpg"}))
+}
@@ -0,0 +1,399 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/rclone/rclone/fs/filter"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
+	"go.uber.org/zap"
+)
+
+// MaxShards is the maximum number of shards a task can run in parallel.
+const MaxShards = 16
+
+// shardProgress is a progress snapshot of a single shard job, or the aggregate of several.
+type shardProgress struct {
+	directionStats
+	FilesTransferred int64
+	BytesTransferred int64
+	FilesTotal       int64
+	BytesTotal       int64
+	FilesDeleted     int64
+	ErrorCount       int64
+	FilesChecked     int64
+	ListingsDone     int64
+	Transfers        []*model.TransferItem
+}
+
+func (p *shardProgress) addCounters(o shardProgress) {
+	p.directionStats.add(o.directionStats)
+	p.FilesTransferred += o.FilesTransferred
+	p.BytesTransferred += o.BytesTransferred
+	p.FilesTotal += o.FilesTotal
+	p.BytesTotal += o.BytesTotal
+	p.FilesDeleted += o.FilesDeleted
+	p.ErrorCount += o.ErrorCount
+	p.FilesChecked += o.FilesChecked
+	p.ListingsDone += o.ListingsDone
+}
+
+// shardTracker aggregates the progress of the child jobs of a sharded parent job.
+// Child pollers report their progress here instead of broadcasting it themselves,
+// so subscribers only see the parent job.
+type shardTracker struct {
+	mu       sync.Mutex
+	finished shardProgress
+	running  map[uuid.UUID]shardProgress
+}
+
+func newShardTracker() *shardTracker {
+	return &shardTracker{running: make(map[uuid.UUID]shardProgress)}
+}
+
+// update records the latest progress of a running child job.
+func (t *shardTracker) update(jobID uuid.UUID, p shardProgress) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running[jobID] = p
+}
+
+// finish moves a child job's last progress into the finished totals and returns it.
+func (t *shardTracker) finish(jobID uuid.UUID) shardProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p := t.running[jobID]
+	delete(t.running, jobID)
+	t.finished.addCounters(p)
+	return p
+}
+
+// snapshot returns the aggregated progress of all finished and running child jobs.
+func (t *shardTracker) snapshot() shardProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	total := t.finished
+	total.Transfers = nil
+	for _, p := range t.running {
+		total.addCounters(p)
+		total.Transfers = append(total.Transfers, p.Transfers...)
+	}
+	return total
+}
+
+// syncShard describes a part of the task tree synced by a single child job.
+type syncShard struct {
+	// Dir is the top-level directory of the shard, or empty for the root shard.
+	Dir string
+	// Filters are the filter rules applied to the shard.
+	Filters []string
+}
+
+// pollShardProgress broadcasts the aggregated progress of a sharded job until ctx is done.
+func (e *SyncEngine) pollShardProgress(ctx context.Context, jobID uuid.UUID, task *ent.Task, startTime time.Time, tracker *shardTracker) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.broadcastShardProgress(jobID, task, startTime, tracker)
+		}
+	}
+}
+
+func (e *SyncEngine) broadcastShardProgress(jobID uuid.UUID, task *ent.Task, startTime time.Time, tracker *shardTracker) {
+	if task.Edges.Connection == nil {
+		return
+	}
+
+	p := tracker.snapshot()
+	e.broadcastJobUpdate(&model.JobProgressEvent{
+		JobID:            jobID,
+		TaskID:           task.ID,
+		ConnectionID:     task.Edges.Connection.ID,
+		Status:           e.runningStatus(jobID),
+		FilesTransferred: int(p.FilesTransferred),
+		BytesTransferred: p.BytesTransferred,
+		UploadedFiles:    int(p.UploadedFiles),
+		UploadedBytes:    p.UploadedBytes,
+		DownloadedFiles:  int(p.DownloadedFiles),
+		DownloadedBytes:  p.DownloadedBytes,
+		FilesTotal:       int(p.FilesTotal),
+		BytesTotal:       p.BytesTotal,
+		FilesDeleted:     int(p.FilesDeleted),
+		FilesChecked:     int(p.FilesChecked),
+		ListingsDone:     int(p.ListingsDone),
+		ErrorCount:       int(p.ErrorCount),
+		StartTime:        startTime,
+	})
+	e.broadcastTransferProgress(jobID, task, p.Transfers)
+}
+
+// planShards splits the sync source into one shard per top-level directory plus a root shard.
+// The root shard syncs top-level files and entries that only exist in the destination,
+// so deletions still propagate for directories removed from the source.
+// Top-level directories excluded by the task filters or skipped for windowsNames are left to the root shard,
+// which applies the same filters. The other shards get the filters rebased onto their directory.
+func planShards(ctx context.Context, fFrom fs.Fs, filters []string, windowsNames model.WindowsNameHandling) ([]syncShard, error) {
+	entries, err := fFrom.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	filterCtx, err := applyFilterRules(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	includeDir := func(string) (bool, error) { return true, nil }
+	if len(filters) > 0 {
+		includeDir = filter.GetConfig(filterCtx).IncludeDirectory(filterCtx, fFrom)
+	}
+
+	var shards []syncShard
+	var rootFilters []string
+	for _, entry := range entries {
+		dir, ok := entry.(fs.Directory)
+		if !ok {
+			continue
+		}
+		name := dir.Remote()
+		include, err := includeDir(name)
+		if err != nil {
+			return nil, err
+		}
+		if !include || skipsWindowsName(windowsNames, name) {
+			continue
+		}
+		shardFilters, err := shardFilterRules(filters, name)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, syncShard{Dir: name, Filters: shardFilters})
+		rootFilters = append(rootFilters, "- /"+escapeFilterGlob(name)+"/**")
+	}
+
+	root := syncShard{Filters: append(rootFilters, filters...)}
+	return append([]syncShard{root}, shards...), nil
+}
+
+// errFilterNotShardable reports a filter rule that can't be rebased onto the directory of a shard.
+var errFilterNotShardable = errors.New("filter rule can't be applied to a single top-level directory")
+
+// shardFilterRules rebases the filter rules of a task onto the shard of the top-level directory dir,
+// whose sync matches the rules against paths relative to dir instead of the task's root.
+// Anchored rules for dir lose their first segment and anchored rules for other top-level entries are dropped,
+// since they can't match within the shard. Unanchored rules spanning several directories keep matching at any
+// depth, but also get a rebased copy for the matches starting at the task's root.
+func shardFilterRules(rules []string, dir string) ([]string, error) {
+	out := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		if !strings.HasPrefix(rule, "+ ") && !strings.HasPrefix(rule, "- ") {
+			out = append(out, rule)
+			continue
+		}
+		sign, pattern := rule[:2], rule[2:]
+		anchored := strings.HasPrefix(pattern, "/")
+		if !anchored && !strings.Contains(strings.TrimSuffix(pattern, "/"), "/") {
+			out = append(out, rule)
+			continue
+		}
+
+		rebased, err := rebaseFilterPattern(strings.TrimPrefix(pattern, "/"), dir)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, rule)
+		}
+		for _, p := range rebased {
+			out = append(out, sign+p)
+		}
+		if !anchored {
+			out = append(out, rule)
+		}
+	}
+	return out, nil
+}
+
+// rebaseFilterPattern returns the anchored patterns matching the paths below dir that the anchored pattern
+// "/"+pattern matches below the task's root, or errFilterNotShardable if the first segment of pattern
+// can't be matched against dir on its own.
+func rebaseFilterPattern(pattern, dir string) ([]string, error) {
+	first, rest, found := strings.Cut(pattern, "/")
+	if first == "**" {
+		if !found {
+			return []string{"/**"}, nil
+		}
+		// "**/" either matches dir alone or dir and some of its subdirectories
+		return []string{"/" + rest, "/**/" + rest}, nil
+	}
+	if strings.Contains(first, "**") {
+		return nil, errFilterNotShardable
+	}
+	re, err := filter.GlobPathToRegexp("/"+first, false)
+	if err != nil {
+		// The segment ends within a {a,b} group or a {{regexp}} spanning several directories
+		return nil, errFilterNotShardable
+	}
+	if !found || rest == "" || !re.MatchString(dir) {
+		return nil, nil
+	}
+	return []string{"/" + rest}, nil
+}
+
+// escapeFilterGlob escapes glob metacharacters so a name matches literally in an rclone filter rule.
+func escapeFilterGlob(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch r {
+		case '*', '?', '[', ']', '{', '}', '\\':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// runSharded executes a one-way sync as parallel child jobs, one per shard.
+// At most opts.Shards child jobs run at the same time. All shards run to completion
+// even if some of them fail; the returned error joins the errors of all failed shards.
+func (e *SyncEngine) runSharded(ctx context.Context, parent *ent.Job, task *ent.Task, trigger model.JobTrigger, connectionName string, fFrom fs.Fs, opts SyncOptions, tracker *shardTracker) error {
+	shards, err := planShards(ctx, fFrom, opts.Filters, opts.WindowsNames)
+	if err != nil {
+		return i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
+	}
+	e.logger.Info("Running sharded sync",
+		zap.Stringer("job_id", parent.ID),
+		zap.Int("shards", len(shards)),
+		zap.Int("parallel", opts.Shards),
+	)
+
+	var (
+		wg        sync.WaitGroup
+		errMu     sync.Mutex
+		shardErrs []error
+	)
+	sem := make(chan struct{}, opts.Shards)
+	for _, shard := range shards {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return errors.Join(append(shardErrs, ctx.Err())...)
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			if err := e.runShard(ctx, parent, task, trigger, connectionName, shard, opts, tracker); err != nil {
+				errMu.Lock()
+				shardErrs = append(shardErrs, err)
+				errMu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(shardErrs...)
+}
+
+// runShard runs a single shard as a child job of parent and finalizes it.
+func (e *SyncEngine) runShard(ctx context.Context, parent *ent.Job, task *ent.Task, trigger model.JobTrigger, connectionName string, shard syncShard, opts SyncOptions, tracker *shardTracker) error {
+	child, err := e.jobService.CreateChildJob(ctx, parent.ID, task.ID, trigger)
+	if err != nil {
+		return err
+	}
+	if _, err := e.jobService.UpdateJobStatus(ctx, child.ID, string(model.JobStatusRunning), ""); err != nil {
+		return err
+	}
+
+	log := e.logger.With(zap.Stringer("job_id", child.ID), zap.Stringer("parent_id", parent.ID), zap.String("shard", shard.Dir))
+	log.Debug("Starting shard")
+
+	statsCtx, statsCancel := context.WithCancel(ctx)
+	defer statsCancel()
+	statsCtx = accounting.WithStatsGroup(statsCtx, child.ID.String())
+	accounting.Stats(statsCtx).SetMaxCompletedTransfers(-1)
+
+	var wg sync.WaitGroup
+	concurrency := &concurrencySampler{}
+	wg.Go(func() {
+		_, concurrency = e.pollStats(statsCtx, child.ID, task, child.StartTime, tracker)
+	})
+
+	shardOpts := opts
+	shardOpts.Filters = shard.Filters
+	syncErr := e.runShardSync(statsCtx, task, connectionName, shard.Dir, shardOpts)
+
+	statsCancel()
+	wg.Wait()
+
+	p := tracker.finish(child.ID)
+	result := ports.JobResult{
+		Status:           completedStatus(p.ErrorCount),
+		FilesTransferred: p.FilesTransferred,
+		BytesTransferred: p.BytesTransferred,
+		FilesDeleted:     p.FilesDeleted,
+		ErrorCount:       p.ErrorCount,
+		FilesChecked:     p.FilesChecked,
+		ListingsDone:     p.ListingsDone,
+		UploadedFiles:    p.UploadedFiles,
+		UploadedBytes:    p.UploadedBytes,
+		DownloadedFiles:  p.DownloadedFiles,
+		DownloadedBytes:  p.DownloadedBytes,
+		Concurrency:      concurrency.series(determineTransfers(opts.Transfers, e.defaultTransfers)),
+	}
+	if syncErr != nil {
+		result.Status = model.JobStatusFailed
+		result.Error = i18n.ErrorMessage(ctx, syncErr)
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			result.Status = model.JobStatusFailedTimeout
+		case errors.Is(ctx.Err(), context.Canceled):
+			result.Status = model.JobStatusCancelled
+		}
+		log.Warn("Shard finished with error", zap.Error(syncErr))
+	}
+
+	// Use a fresh context for DB operations since the job context may be cancelled
+	dbCtx, dbCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer dbCancel()
+	if _, err := e.jobService.FinalizeJob(dbCtx, child.ID, result); err != nil {
+		log.Error("Failed to finalize shard job", zap.Error(err))
+	}
+
+	return syncErr
+}
+
+// runShardSync syncs the shard's sub-tree of the task in the task's direction.
+func (e *SyncEngine) runShardSync(ctx context.Context, task *ent.Task, connectionName, dir string, opts SyncOptions) error {
+	fLocal, err := GetFs(ctx, "", filepath.Join(task.SourcePath, dir))
+	if err != nil {
+		return err
+	}
+	fRemote, err := GetFs(ctx, connectionName, path.Join(TaskRemotePath(task), dir))
+	if err != nil {
+		return err
+	}
+
+	if task.Direction == model.SyncDirectionDownload {
+		return e.runOneWay(ctx, fRemote, fLocal, opts)
+	}
+	return e.runOneWay(ctx, fLocal, fRemote, opts)
+}
+
+// isWithinPath reports whether p is root or a path below root.
+func isWithinPath(p, root string) bool {
+	root = strings.TrimSuffix(root, "/")
+	return p == root || strings.HasPrefix(p, root+"/")
+}
@@ -0,0 +1,104 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+func TestPlanShards(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "photos"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "docs[old]"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "node_modules"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "readme.txt"), []byte("x"), 0644))
+
+	f, err := fs.NewFs(ctx, dir)
+	require.NoError(t, err)
+
+	filters := []string{"- node_modules/**"}
+	shards, err := planShards(ctx, f, filters, "")
+	require.NoError(t, err)
+	require.Len(t, shards, 3, "Root shard plus one shard per included top-level directory")
+
+	root := shards[0]
+	assert.Empty(t, root.Dir)
+	assert.Equal(t, []string{"- /docs\\[old\\]/**", "- /photos/**", "- node_modules/**"}, root.Filters)
+
+	assert.Equal(t, "docs[old]", shards[1].Dir)
+	assert.Equal(t, "photos", shards[2].Dir)
+	assert.Equal(t, filters, shards[2].Filters)
+}
+
+func TestShardFilterRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []string
+		want  []string
+	}{
+		{"unanchored rules are kept", []string{"- *.tmp", "+ **"}, []string{"- *.tmp", "+ **"}},
+		{"anchored rules are rebased", []string{"- /photos/raw/**", "+ /photos/**"}, []string{"- /raw/**", "+ /**"}},
+		{"anchored rules of other entries are dropped", []string{"- /docs/**", "- /photos", "- /photos/"}, []string{}},
+		{"anchored globs are matched against the directory", []string{"- /p*/cache/**", "- /{photos,docs}/tmp/**", "- /d*/**"}, []string{"- /cache/**", "- /tmp/**"}},
+		{"leading double star", []string{"- /**/cache/**", "+ /**"}, []string{"- /cache/**", "- /**/cache/**", "+ /**"}},
+		{"unanchored paths also match from the root", []string{"- photos/raw/**"}, []string{"- /raw/**", "- photos/raw/**"}},
+		{"clear rule", []string{"!"}, []string{"!"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := shardFilterRules(tt.rules, "photos")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, rule := range []string{"- /ph**/raw/**", "- /{photos/raw,docs}/**", "- /{{photos/raw}}/**"} {
+		_, err := shardFilterRules([]string{rule}, "photos")
+		assert.ErrorIs(t, err, errFilterNotShardable, rule)
+		assert.Error(t, ValidateShardFilterRule(rule), rule)
+	}
+	assert.NoError(t, ValidateShardFilterRule("- /photos/raw/**"))
+}
+
+func TestShardTracker(t *testing.T) {
+	tracker := newShardTracker()
+	job1, job2 := uuid.New(), uuid.New()
+
+	tracker.update(job1, shardProgress{FilesTransferred: 1, BytesTransferred: 10, Transfers: []*model.TransferItem{{Name: "a"}}})
+	tracker.update(job2, shardProgress{FilesTransferred: 2, BytesTransferred: 20, Transfers: []*model.TransferItem{{Name: "b"}}})
+
+	p := tracker.snapshot()
+	assert.Equal(t, int64(3), p.FilesTransferred)
+	assert.Equal(t, int64(30), p.BytesTransferred)
+	assert.Len(t, p.Transfers, 2)
+
+	// Finished shards keep counting towards totals but no longer report transfers
+	tracker.update(job1, shardProgress{FilesTransferred: 4, BytesTransferred: 40, FilesDeleted: 1})
+	final := tracker.finish(job1)
+	assert.Equal(t, int64(4), final.FilesTransferred)
+
+	p = tracker.snapshot()
+	assert.Equal(t, int64(6), p.FilesTransferred)
+	assert.Equal(t, int64(60), p.BytesTransferred)
+	assert.Equal(t, int64(1), p.FilesDeleted)
+	require.Len(t, p.Transfers, 1)
+	assert.Equal(t, "b", p.Transfers[0].Name)
+}
+
+func TestIsWithinPath(t *testing.T) {
+	assert.True(t, isWithinPath("/data", "/data"))
+	assert.True(t, isWithinPath("/data/photos", "/data"))
+	assert.True(t, isWithinPath("/data/photos", "/data/"))
+	assert.True(t, isWithinPath("/data", "/data/"))
+	assert.False(t, isWithinPath("/data2", "/data"))
+	assert.False(t, isWithinPath("remote:/data", "/data"))
+}
@@ -0,0 +1,154 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/walk"
+	"github.com/rclone/rclone/lib/transform"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"go.uber.org/zap"
+)
+
+// workingSet is the estimated amount of data a job transfers.
+type workingSet struct {
+	Files int64
+	Bytes int64
+}
+
+// sizingCheap reports whether listing f is cheap enough for a sizing pass:
+// local directories and remotes that list recursively in a single call.
+func sizingCheap(f fs.Fs) bool {
+	features := f.Features()
+	return features.IsLocal || features.ListR != nil
+}
+
+// estimateWorkingSet sizes the files of fSrc that a one-way sync to fDst transfers, see pendingObjects.
+func estimateWorkingSet(ctx context.Context, fSrc, fDst fs.Fs) (workingSet, error) {
+	pending, err := pendingObjects(ctx, fSrc, fDst)
+	if err != nil {
+		return workingSet{}, err
+	}
+	var ws workingSet
+	for _, o := range pending {
+		ws.Files++
+		ws.Bytes += max(o.Size(), 0)
+	}
+	return ws, nil
+}
+
+// pendingObjects returns the objects of fSrc that a one-way sync to fDst transfers, which are those
+// missing in fDst or differing in size. Modification times are only compared if both sides are local,
+// since reading them costs a request per file on some remotes; files that only differ in their
+// modification time are counted by rclone once they are queued. Filter rules and name transforms are taken from ctx.
+func pendingObjects(ctx context.Context, fSrc, fDst fs.Fs) ([]fs.Object, error) {
+	dst, err := listObjects(ctx, fDst)
+	if err != nil {
+		return nil, err
+	}
+	src, err := listObjects(ctx, fSrc)
+	if err != nil {
+		return nil, err
+	}
+
+	compareModTime := fSrc.Features().IsLocal && fDst.Features().IsLocal
+	window := fs.GetModifyWindow(ctx, fSrc, fDst)
+	var pending []fs.Object
+	for remote, s := range src {
+		if d, ok := dst[transform.Path(ctx, remote, false)]; ok && d.Size() == s.Size() {
+			if !compareModTime || window == fs.ModTimeNotSupported {
+				continue
+			}
+			if dt := s.ModTime(ctx).Sub(d.ModTime(ctx)); dt.Abs() <= window {
+				continue
+			}
+		}
+		pending = append(pending, s)
+	}
+	return pending, nil
+}
+
+// listObjects lists the objects below f by path. A missing directory is listed as empty.
+func listObjects(ctx context.Context, f fs.Fs) (map[string]fs.Object, error) {
+	var mu sync.Mutex
+	objects := make(map[string]fs.Object)
+	err := walk.ListR(ctx, f, "", false, -1, walk.ListObjects, func(entries fs.DirEntries) error {
+		mu.Lock()
+		defer mu.Unlock()
+		entries.ForObject(func(o fs.Object) {
+			objects[o.Remote()] = o
+		})
+		return nil
+	})
+	if errors.Is(err, fs.ErrorDirNotFound) {
+		return objects, nil
+	}
+	return objects, err
+}
+
+// sizeWorkingSet runs the sizing pass of a one-way job over all its pairs of directories and publishes
+// the estimated totals right away, so progress doesn't jump around while rclone discovers the files to transfer.
+// Sizing is best effort: it is skipped if listing any side is not cheap and failures are only logged.
+func (e *SyncEngine) sizeWorkingSet(ctx context.Context, jobEntity *ent.Job, task *ent.Task, pairs []syncPair, opts SyncOptions) {
+	start := time.Now()
+	var ws workingSet
+	for _, pair := range pairs {
+		fSrc, fDst := pair.sides(task.Direction)
+		if !sizingCheap(fSrc) || !sizingCheap(fDst) {
+			e.logger.Debug("Skipping sizing pass, listing is not cheap", zap.Stringer("job_id", jobEntity.ID))
+			return
+		}
+		pairOpts := opts
+		pairOpts.Filters = pair.Filters
+		sizingCtx, err := applySyncFilters(ctx, pairOpts)
+		if err != nil {
+			return // Reported by the sync itself
+		}
+		sizingCtx = withWindowsNames(sizingCtx, pairOpts)
+
+		pairWs, err := estimateWorkingSet(sizingCtx, fSrc, fDst)
+		if err != nil {
+			e.logger.Warn("Sizing pass failed", zap.Stringer("job_id", jobEntity.ID), zap.Error(err))
+			return
+		}
+		ws.Files += pairWs.Files
+		ws.Bytes += pairWs.Bytes
+	}
+	e.logger.Debug("Sizing pass completed",
+		zap.Stringer("job_id", jobEntity.ID),
+		zap.Int64("files", ws.Files),
+		zap.Int64("bytes", ws.Bytes),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	e.statsMu.Lock()
+	e.workingSets[jobEntity.ID] = ws
+	e.statsMu.Unlock()
+
+	e.broadcastJobUpdate(&model.JobProgressEvent{
+		JobID:        jobEntity.ID,
+		TaskID:       task.ID,
+		ConnectionID: task.Edges.Connection.ID,
+		Status:       model.JobStatusRunning,
+		FilesTotal:   int(ws.Files),
+		BytesTotal:   ws.Bytes,
+		StartTime:    jobEntity.StartTime,
+	})
+}
+
+// applyWorkingSet raises the totals reported by rclone to the estimated working set of the job.
+// rclone's totals only grow while files are queued, and take over once they exceed the estimate.
+func (e *SyncEngine) applyWorkingSet(jobID uuid.UUID, totalTransfers, totalBytes int64) (int64, int64) {
+	e.statsMu.RLock()
+	ws, ok := e.workingSets[jobID]
+	e.statsMu.RUnlock()
+	if !ok {
+		return totalTransfers, totalBytes
+	}
+	return max(totalTransfers, ws.Files), max(totalBytes, ws.Bytes)
+}
@@ -0,0 +1,81 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateWorkingSet(t *testing.T) {
+	ctx := context.Background()
+	srcDir := t.TempDir()
+	dstDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	write := func(dir, name, content string, mtime time.Time) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+	write(srcDir, "same.txt", "hello", modTime)
+	write(dstDir, "same.txt", "hello", modTime)
+	write(srcDir, "resized.txt", "longer", modTime)
+	write(dstDir, "resized.txt", "short", modTime)
+	write(srcDir, "touched.txt", "12345", modTime.Add(time.Minute))
+	write(dstDir, "touched.txt", "12345", modTime)
+	write(srcDir, "sub/new.txt", "new", modTime)
+	write(srcDir, "skip.tmp", "ignored", modTime)
+	write(dstDir, "extra.txt", "only in destination", modTime)
+
+	fSrc, err := fs.NewFs(ctx, srcDir)
+	require.NoError(t, err)
+	fDst, err := fs.NewFs(ctx, dstDir)
+	require.NoError(t, err)
+	assert.True(t, sizingCheap(fSrc))
+
+	ws, err := estimateWorkingSet(ctx, fSrc, fDst)
+	require.NoError(t, err)
+	assert.Equal(t, workingSet{Files: 4, Bytes: 6 + 5 + 3 + 7}, ws)
+
+	// Filter rules of the task apply to the sizing pass
+	filterCtx, err := applyFilterRules(ctx, []string{"- *.tmp"})
+	require.NoError(t, err)
+	ws, err = estimateWorkingSet(filterCtx, fSrc, fDst)
+	require.NoError(t, err)
+	assert.Equal(t, workingSet{Files: 3, Bytes: 6 + 5 + 3}, ws)
+
+	// A destination that doesn't exist yet receives everything
+	fMissing, err := fs.NewFs(ctx, filepath.Join(dstDir, "missing"))
+	require.NoError(t, err)
+	ws, err = estimateWorkingSet(filterCtx, fSrc, fMissing)
+	require.NoError(t, err)
+	assert.Equal(t, workingSet{Files: 4, Bytes: 5 + 6 + 5 + 3}, ws)
+}
+
+func TestApplyWorkingSet(t *testing.T) {
+	e := NewSyncEngine(nil, nil, nil, t.TempDir(), false, 0)
+	jobID := uuid.New()
+
+	// Without an estimate the rclone totals are used as they are
+	files, bytes := e.applyWorkingSet(jobID, 2, 100)
+	assert.Equal(t, int64(2), files)
+	assert.Equal(t, int64(100), bytes)
+
+	e.workingSets[jobID] = workingSet{Files: 10, Bytes: 1000}
+	files, bytes = e.applyWorkingSet(jobID, 2, 100)
+	assert.Equal(t, int64(10), files)
+	assert.Equal(t, int64(1000), bytes)
+
+	// rclone's totals take over once they exceed the estimate
+	files, bytes = e.applyWorkingSet(jobID, 12, 1200)
+	assert.Equal(t, int64(12), files)
+	assert.Equal(t, int64(1200), bytes)
+}
@@ -7,8 +7,8 @@ import (
 	"encoding/json"
 	"sync"
 
-	"github.com/rclone/rclone/fs/cache"
 	"github.com/rclone/rclone/fs/config"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
 )
 
@@ -70,7 +70,7 @@ func (s *DBStorage) DeleteSection(section string) {
 	_ = s.svc.DeleteConnectionByName(ctx, section)
 
 	// Clear rclone cache for this remote
-	cache.ClearConfig(section)
+	ClearFsCache(section)
 }
 
 // GetKeyList returns all configuration keys for a connection.
@@ -92,11 +92,21 @@ func (s *DBStorage) GetKeyList(section string) []string {
 }
 
 // GetValue retrieves a configuration value for a connection.
+// The pacer options are taken from the connection's API pacing if it is set, overriding the config,
+// so that every Fs of the connection, and with it every job, is paced the same.
 func (s *DBStorage) GetValue(section, key string) (string, bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := context.Background()
+	if isPacingOption(key) {
+		if conn, err := s.svc.GetConnectionByName(ctx, section); err == nil {
+			if value, ok := PacingOptions(string(conn.Type), conn.PathTpsLimit, conn.PathTpsBurst)[key]; ok {
+				return value, true
+			}
+		}
+	}
+
 	cfg, err := s.svc.GetConnectionConfig(ctx, section)
 	if err != nil {
 		return "", false
@@ -120,9 +130,9 @@ func (s *DBStorage) SetValue(section, key, value string) {
 	if err != nil {
 		// Connection doesn't exist, create a new one
 		cfg := map[string]string{key: value}
-		connType := ""
+		var connType model.ConnectionType
 		if key == "type" {
-			connType = value
+			connType = model.ConnectionType(value)
 		}
 		_, _ = s.svc.CreateConnection(ctx, section, connType, cfg)
 		return
@@ -140,14 +150,14 @@ func (s *DBStorage) SetValue(section, key, value string) {
 	// Determine connection type
 	connType := conn.Type
 	if key == "type" {
-		connType = value
+		connType = model.ConnectionType(value)
 	}
 
 	// Update the connection
 	_ = s.svc.UpdateConnection(ctx, conn.ID, nil, &connType, cfg)
 
 	// Clear cache so rclone reloads the config
-	cache.ClearConfig(section)
+	ClearFsCache(section)
 }
 
 // DeleteKey removes a configuration key from a connection.
@@ -181,7 +191,7 @@ func (s *DBStorage) DeleteKey(section, key string) bool {
 	_ = s.svc.UpdateConnection(ctx, conn.ID, nil, nil, cfg)
 
 	// Clear cache
-	cache.ClearConfig(section)
+	ClearFsCache(section)
 
 	return true
 }
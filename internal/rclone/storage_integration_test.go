@@ -270,7 +270,7 @@ func TestSyncEngine_WithDBStorage_Integration(t *testing.T) {
 	assert.Equal(t, "sync test content", string(content))
 
 	// Check job was created
-	jobs, err := jobSvc.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobSvc.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1)
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs[0].Status))
@@ -108,13 +108,13 @@ func TestDBStorage_SetValue(t *testing.T) {
 
 	t.Run("set value creates new connection if not exists", func(t *testing.T) {
 		// Set value on non-existing section (creates new connection)
-		storage.SetValue("new-remote", "type", "gdrive")
+		storage.SetValue("new-remote", "type", "drive")
 
 		// Verify connection was created
 		assert.True(t, storage.HasSection("new-remote"))
 		value, ok := storage.GetValue("new-remote", "type")
 		assert.True(t, ok)
-		assert.Equal(t, "gdrive", value)
+		assert.Equal(t, "drive", value)
 	})
 
 	t.Run("token refresh scenario - simulates rclone token update", func(t *testing.T) {
@@ -173,7 +173,7 @@ func TestDBStorage_GetSectionList(t *testing.T) {
 		// Create multiple connections
 		_, err := connSvc.CreateConnection(ctx, "remote-a", "s3", map[string]string{"type": "s3"})
 		require.NoError(t, err)
-		_, err = connSvc.CreateConnection(ctx, "remote-b", "gdrive", map[string]string{"type": "gdrive"})
+		_, err = connSvc.CreateConnection(ctx, "remote-b", "drive", map[string]string{"type": "drive"})
 		require.NoError(t, err)
 		_, err = connSvc.CreateConnection(ctx, "remote-c", "onedrive", map[string]string{"type": "onedrive"})
 		require.NoError(t, err)
@@ -346,3 +346,32 @@ func TestDBStorage_Install(t *testing.T) {
 		storage.Install()
 	})
 }
+
+// TestDBStorage_GetValue_Pacing tests that the API pacing of a connection overrides the pacer options of its config
+func TestDBStorage_GetValue_Pacing(t *testing.T) {
+	storage, connSvc := setupStorageTest(t)
+	ctx := context.Background()
+
+	conn, err := connSvc.CreateConnection(ctx, "paced-remote", "drive", map[string]string{
+		"type":            "drive",
+		"pacer_min_sleep": "10ms",
+	})
+	require.NoError(t, err)
+
+	value, ok := storage.GetValue("paced-remote", "pacer_min_sleep")
+	assert.True(t, ok)
+	assert.Equal(t, "10ms", value, "Without pacing the config value is used")
+	_, ok = storage.GetValue("paced-remote", "pacer_burst")
+	assert.False(t, ok)
+
+	limit, burst := 4.0, 20
+	_, err = connSvc.SetConnectionPacing(ctx, conn.ID, &limit, &burst)
+	require.NoError(t, err)
+
+	value, ok = storage.GetValue("paced-remote", "pacer_min_sleep")
+	assert.True(t, ok)
+	assert.Equal(t, "250ms", value)
+	value, ok = storage.GetValue("paced-remote", "pacer_burst")
+	assert.True(t, ok)
+	assert.Equal(t, "20", value)
+}
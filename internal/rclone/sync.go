@@ -12,19 +12,24 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rclone/rclone/cmd/bisync"
-	"github.com/rclone/rclone/cmd/bisync/bilib"
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/accounting"
 	"github.com/rclone/rclone/fs/filter"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/fs/operations"
 	rclonesync "github.com/rclone/rclone/fs/sync"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/errs"
+	"github.com/xzzpig/rclone-sync/internal/core/hooks"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
 	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/core/tracing"
 	"github.com/xzzpig/rclone-sync/internal/i18n"
 	"github.com/xzzpig/rclone-sync/internal/utils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -44,6 +49,78 @@ type SyncOptions struct {
 	// Transfers is the number of parallel file transfers (1-64).
 	// If 0, the global default from config is used.
 	Transfers int
+
+	// Shards is the number of top-level directory shards synced in parallel as child jobs (1-16).
+	// Values <= 1 disable sharding. Only applies to one-way sync (Upload/Download).
+	Shards int
+
+	// MaxDuration is the maximum run time of the job. The job is cancelled and marked
+	// FAILED_TIMEOUT once it is exceeded. Zero means no limit.
+	MaxDuration time.Duration
+
+	// TrackRenames moves renamed files on the destination server-side instead of transferring them again.
+	// Only applies to one-way sync without NoDelete, and only if the destination supports server-side
+	// move or copy and both sides share a hash; otherwise RunTask disables it with a warning.
+	TrackRenames bool
+
+	// WindowsNames enables the handling of names that Windows doesn't accept, see windowsNameRules and
+	// windowsNameTransforms. Encoding only applies to one-way sync; RunTask skips the names of bidirectional
+	// tasks instead. Empty disables the handling.
+	WindowsNames model.WindowsNameHandling
+
+	// PreserveMetadata copies the metadata of files, like permissions and owners, along with their contents
+	// where both backends support it, see reportDroppedMetadata. Modification times are synced regardless.
+	PreserveMetadata bool
+
+	// SkipSizing disables the sizing pass that publishes the job totals before transferring.
+	// Only applies to one-way sync without sharding.
+	SkipSizing bool
+
+	// CreateEmptySrcDirs creates empty source directories on the destination.
+	// Nil keeps the default of the direction: enabled for one-way sync, disabled for bidirectional sync.
+	CreateEmptySrcDirs *bool
+
+	// SkipZeroByteFiles excludes zero-byte files from the sync, so they are neither transferred nor deleted.
+	SkipZeroByteFiles bool
+
+	// ConfirmDeletesOver pauses a run that would delete more files than this in WAITING_CONFIRMATION
+	// until ConfirmJob or AbortJob is called for its job. Only applies to one-way sync without NoDelete.
+	// Zero disables the guard.
+	ConfirmDeletesOver int
+
+	// Paths are additional pairs of directories synced by the same job after the task's own pair.
+	// Only applies to one-way sync without sharding.
+	Paths []SyncPath
+
+	// StopOnPathError skips the remaining pairs of directories once one of them failed.
+	StopOnPathError bool
+
+	// Mirrors are additional destinations the content is uploaded to after the primary sync succeeded,
+	// each as a child job of the run. Only applies to upload tasks.
+	Mirrors []SyncMirror
+}
+
+// createEmptySrcDirs reports whether empty source directories are created on the destination.
+func (o SyncOptions) createEmptySrcDirs(bidirectional bool) bool {
+	if o.CreateEmptySrcDirs == nil {
+		return !bidirectional
+	}
+	return *o.CreateEmptySrcDirs
+}
+
+// directionStats counts completed transfers of a job per direction.
+type directionStats struct {
+	UploadedFiles   int64
+	UploadedBytes   int64
+	DownloadedFiles int64
+	DownloadedBytes int64
+}
+
+func (d *directionStats) add(o directionStats) {
+	d.UploadedFiles += o.UploadedFiles
+	d.UploadedBytes += o.UploadedBytes
+	d.DownloadedFiles += o.DownloadedFiles
+	d.DownloadedBytes += o.DownloadedBytes
 }
 
 // SyncEngine handles file synchronization operations using rclone.
@@ -52,12 +129,25 @@ type SyncEngine struct {
 	jobProgressBus      *subscription.JobProgressBus
 	transferProgressBus *subscription.TransferProgressBus
 	logger              *zap.Logger
+	tracer              trace.Tracer
+	workDirMu           sync.RWMutex
 	workDir             string
 	autoDeleteEmptyJobs bool
 	defaultTransfers    int // Global default for parallel transfers (from config)
 	statsMu             sync.RWMutex
 	lastEvents          map[uuid.UUID]*model.JobProgressEvent
 	lastTransferEvents  map[uuid.UUID]*model.TransferProgressEvent
+	childParents        map[uuid.UUID]uuid.UUID  // Parent jobs of running mirror jobs, set on their progress events
+	workingSets         map[uuid.UUID]workingSet // Estimated totals of running jobs, see sizeWorkingSet
+	logBufferOpts       LogBufferOptions
+	logFlushStats       logFlushStats
+	hooks               *hooks.Executor // Runs task hooks, see SetHookOptions
+	confirmMu           sync.Mutex
+	confirmations       map[uuid.UUID]chan bool // Decisions of jobs waiting for confirmation, see guardDeletes
+	faults              *faultInjector          // Faults injected into jobs when testing, see SetFaultInjection
+	deleteAnomaly       DeleteAnomalyOptions    // Flagging of runs deleting far more files than usual, see SetDeleteAnomalyOptions
+	connections         ConnectionResolver      // Looks up the connections of mirrors, see SetConnectionResolver
+	warmup              warmup                  // Progress of the Fs cache warm-up, see WarmUp
 }
 
 // DefaultTransfers is the built-in default for parallel transfers when not configured.
@@ -67,7 +157,7 @@ const DefaultTransfers = 4
 // defaultTransfers specifies the global default for parallel transfers (from config).
 // If defaultTransfers is 0 or negative, DefaultTransfers (4) will be used.
 func NewSyncEngine(jobService ports.JobService, jobProgressBus *subscription.JobProgressBus, transferProgressBus *subscription.TransferProgressBus, dataDir string, autoDeleteEmptyJobs bool, defaultTransfers int) *SyncEngine {
-	workDir := filepath.Join(dataDir, "bisync_state")
+	workDir := filepath.Join(dataDir, bisyncStateDirName)
 	if defaultTransfers <= 0 {
 		defaultTransfers = DefaultTransfers
 	}
@@ -76,14 +166,30 @@ func NewSyncEngine(jobService ports.JobService, jobProgressBus *subscription.Job
 		jobProgressBus:      jobProgressBus,
 		transferProgressBus: transferProgressBus,
 		logger:              logger.Named("sync.engine"),
+		tracer:              tracing.Tracer("sync.engine"),
 		workDir:             workDir,
 		autoDeleteEmptyJobs: autoDeleteEmptyJobs,
 		defaultTransfers:    defaultTransfers,
 		lastEvents:          make(map[uuid.UUID]*model.JobProgressEvent),
 		lastTransferEvents:  make(map[uuid.UUID]*model.TransferProgressEvent),
+		childParents:        make(map[uuid.UUID]uuid.UUID),
+		workingSets:         make(map[uuid.UUID]workingSet),
+		confirmations:       make(map[uuid.UUID]chan bool),
+		logBufferOpts:       LogBufferOptions{}.withDefaults(),
 	}
 }
 
+// SetLogBufferOptions configures job log batching for subsequently started jobs.
+// Non-positive values fall back to the built-in defaults.
+func (e *SyncEngine) SetLogBufferOptions(opts LogBufferOptions) {
+	e.logBufferOpts = opts.withDefaults()
+}
+
+// GetLogFlushMetrics returns cumulative job log flush metrics across all jobs.
+func (e *SyncEngine) GetLogFlushMetrics() LogFlushMetrics {
+	return e.logFlushStats.snapshot()
+}
+
 // GetJobProgress returns the current progress of a running job.
 // Returns the latest cached JobProgressEvent if the job is running, nil otherwise.
 func (e *SyncEngine) GetJobProgress(jobID uuid.UUID) *model.JobProgressEvent {
@@ -130,7 +236,19 @@ func getConflictResolutionFromOptions(options *model.TaskSyncOptions) (bisync.Pr
 
 // RunTask executes a sync task using the appropriate method based on task.Direction.
 // Supports bidirectional sync using bisync, and one-way sync (upload/download) using rclone sync.
+// Runs triggered by RETRY only copy the files of the task requested for retry, see runRetry.
 func (e *SyncEngine) RunTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
+	ctx, span := e.tracer.Start(ctx, "SyncEngine.RunTask", trace.WithAttributes(
+		attribute.String("task.id", task.ID.String()),
+		attribute.Stringer("task.direction", task.Direction),
+	))
+	err := e.runTask(ctx, task, trigger)
+	tracing.End(span, err)
+	return err
+}
+
+// runTask performs RunTask within its span.
+func (e *SyncEngine) runTask(ctx context.Context, task *ent.Task, trigger model.JobTrigger) error {
 	// Get connection name from task's connection edge (needed throughout function)
 	if task.Edges.Connection == nil {
 		return errs.ConstError("task connection edge not loaded")
@@ -142,15 +260,22 @@ func (e *SyncEngine) RunTask(ctx context.Context, task *ent.Task, trigger model.
 	if err != nil {
 		return errors.Join(errs.ErrSystem, errs.ConstError("failed to create job"), err)
 	}
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("job.id", jobEntity.ID.String()))
 
 	// Ensure cleanup of cached events when done
 	defer func() {
 		e.statsMu.Lock()
 		delete(e.lastEvents, jobEntity.ID)
 		delete(e.lastTransferEvents, jobEntity.ID)
+		delete(e.workingSets, jobEntity.ID)
 		e.statsMu.Unlock()
 	}()
 
+	// Jobs of connections that reached their monthly transfer cap are recorded, but don't sync
+	if deferred, err := e.deferOverCap(ctx, jobEntity, task); deferred || err != nil {
+		return err
+	}
+
 	e.logger.Info("Starting sync task", zap.String("task", task.Name), zap.String("job_id", jobEntity.ID.String()))
 
 	// 2. Update Job status to running
@@ -158,10 +283,33 @@ func (e *SyncEngine) RunTask(ctx context.Context, task *ent.Task, trigger model.
 	if err != nil {
 		return errors.Join(errs.ErrSystem, errs.ConstError("failed to update job status"), err)
 	}
+	defer e.faults.crashDuring(jobEntity.ID)()
+	if err := e.runPreHook(ctx, jobEntity, task); err != nil {
+		e.failJob(ctx, jobEntity.ID, err)
+		return err
+	}
+
+	syncOpts := getSyncOptionsFromTask(task.Options)
+	// Retry runs only copy the files requested for retry
+	var retryItems []*ent.RetryQueue
+	if trigger == model.JobTriggerRetry {
+		if retryItems, err = e.jobService.ClaimRetryQueue(ctx, task.ID, jobEntity.ID); err != nil {
+			e.failJob(ctx, jobEntity.ID, err)
+			return err
+		}
+		syncOpts = retryOptions(syncOpts)
+	}
+	// The job context carries the task's max duration; ctx itself is only cancelled by the user or shutdown
+	jobCtx := withFaults(ctx, e.faults)
+	if syncOpts.MaxDuration > 0 {
+		var jobCancel context.CancelFunc
+		jobCtx, jobCancel = context.WithTimeout(jobCtx, syncOpts.MaxDuration)
+		defer jobCancel()
+	}
 
 	// 3. Prepare Rclone context with stats group
 	// We use the job ID as the stats group key to isolate stats for this job
-	statsCtx, statsCancel := context.WithCancel(ctx)
+	statsCtx, statsCancel := context.WithCancel(jobCtx)
 	defer statsCancel()
 
 	// Initialize stats for this context
@@ -169,10 +317,21 @@ func (e *SyncEngine) RunTask(ctx context.Context, task *ent.Task, trigger model.
 	accounting.Stats(statsCtx).SetMaxCompletedTransfers(-1) // Unlimited buffer, we manage it manually
 
 	// 4. Start stats poller
-	// This runs in the background and collects transfer events
+	// This runs in the background and collects transfer events.
+	// Sharded jobs broadcast the aggregated progress of their child jobs instead.
+	var shards *shardTracker
+	if syncOpts.Shards > 1 && task.Direction != model.SyncDirectionBidirectional {
+		shards = newShardTracker()
+	}
 	var wg sync.WaitGroup
+	var dirStats directionStats
+	concurrency := &concurrencySampler{}
 	wg.Go(func() {
-		e.pollStats(statsCtx, jobEntity.ID, task, jobEntity.StartTime)
+		if shards != nil {
+			e.pollShardProgress(statsCtx, jobEntity.ID, task, jobEntity.StartTime, shards)
+			return
+		}
+		dirStats, concurrency = e.pollStats(statsCtx, jobEntity.ID, task, jobEntity.StartTime, nil)
 	})
 
 	// 5. Create Fs objects
@@ -185,156 +344,320 @@ func (e *SyncEngine) RunTask(ctx context.Context, task *ent.Task, trigger model.
 	}
 
 	// For remote destinations, use cached Fs to avoid repeated connection setup
-	fDst, err := GetFs(statsCtx, connectionName, task.RemotePath)
+	fDst, err := GetFs(statsCtx, connectionName, TaskRemotePath(task))
 	if err != nil {
 		e.failJob(ctx, jobEntity.ID, err)
 		return err
 	}
 
-	// 6. Extract sync options from task
-	syncOpts := getSyncOptionsFromTask(task.Options)
+	// Additional paths of one-way tasks are synced by the same job, after the task's own pair
+	pairOpts := syncOpts
+	if shards != nil || task.Direction == model.SyncDirectionBidirectional {
+		pairOpts.Paths = nil
+	}
+	pairs, err := syncPairs(statsCtx, task, connectionName, fSrc, fDst, pairOpts)
+	if err != nil {
+		e.failJob(ctx, jobEntity.ID, err)
+		return err
+	}
+
+	// 6. Log sync options extracted from task
 	e.logger.Debug("Sync options extracted",
 		zap.Strings("filters", syncOpts.Filters),
 		zap.Bool("noDelete", syncOpts.NoDelete),
 		zap.Int("transfers", syncOpts.Transfers),
+		zap.Int("shards", syncOpts.Shards),
+		zap.Int("paths", len(pairs)),
+		zap.Duration("max_duration", syncOpts.MaxDuration),
 	)
 
 	// 7. Apply common rclone config (transfers) to context
 	transfers := determineTransfers(syncOpts.Transfers, e.defaultTransfers)
 	statsCtx, rcloneCfg := fs.AddConfig(statsCtx)
 	rcloneCfg.Transfers = transfers
+	rcloneCfg.Metadata = syncOpts.PreserveMetadata
 	e.logger.Debug("Transfers configured", zap.Int("transfers", transfers))
 
-	// 8. Run sync based on task direction
+	if syncOpts.TrackRenames && task.Direction != model.SyncDirectionBidirectional {
+		fFrom, fTo := fSrc, fDst
+		if task.Direction == model.SyncDirectionDownload {
+			fFrom, fTo = fDst, fSrc
+		}
+		if reason := trackRenamesUnsupported(fFrom, fTo, syncOpts); reason != "" {
+			syncOpts.TrackRenames = false
+			e.logger.Warn("Ignoring trackRenames", zap.String("task", task.Name), zap.String("reason", reason))
+			msg := "track renames disabled: " + reason
+			if _, err := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelWarning), string(model.LogActionUnknown), msg, 0); err != nil {
+				e.logger.Error("Failed to add job log", zap.Error(err))
+			}
+		}
+	}
+
+	if syncOpts.WindowsNames == model.WindowsNameHandlingEncode && task.Direction == model.SyncDirectionBidirectional {
+		syncOpts.WindowsNames = model.WindowsNameHandlingSkip
+		e.logger.Warn("Skipping Windows names instead of encoding them", zap.String("task", task.Name))
+		msg := "windows names are skipped instead of encoded: bidirectional sync does not support encoding names"
+		if _, err := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelWarning), string(model.LogActionUnknown), msg, 0); err != nil {
+			e.logger.Error("Failed to add job log", zap.Error(err))
+		}
+	}
+	if syncOpts.WindowsNames != "" && trigger != model.JobTriggerRetry {
+		e.reportWindowsNames(statsCtx, jobEntity, task, pairs, syncOpts)
+	}
+	if syncOpts.PreserveMetadata && trigger != model.JobTriggerRetry {
+		e.reportDroppedMetadata(statsCtx, jobEntity, task, pairs, syncOpts)
+	}
+	snapshot := configSnapshot(statsCtx, task, syncOpts, transfers)
+	if task.Direction == model.SyncDirectionBidirectional && trigger != model.JobTriggerRetry {
+		_, resync := bisyncListings(statsCtx, e.stateDir(), fSrc, fDst)
+		snapshot.Resync = &resync
+	}
+
+	// 8. Publish the totals of one-way jobs before transferring
+	if !syncOpts.SkipSizing && shards == nil && task.Direction != model.SyncDirectionBidirectional {
+		e.sizeWorkingSet(statsCtx, jobEntity, task, pairs, syncOpts)
+	}
+
+	// 9. Flag runs deleting far more files than usual, and wait for confirmation if the task guards its deletions
 	var syncErr error
-	switch task.Direction {
-	case model.SyncDirectionBidirectional:
-		syncErr = e.runBidirectional(statsCtx, task, fSrc, fDst, syncOpts)
-	case model.SyncDirectionUpload:
-		syncErr = e.runOneWay(statsCtx, fSrc, fDst, syncOpts)
-	case model.SyncDirectionDownload:
-		syncErr = e.runOneWay(statsCtx, fDst, fSrc, syncOpts)
+	if trigger != model.JobTriggerRetry {
+		syncErr = e.guardDeletes(statsCtx, jobEntity, task, pairs, syncOpts)
+	}
+
+	// 10. Run sync based on task direction
+	var renames []ports.LogRename
+	syncCtx, syncSpan := e.tracer.Start(statsCtx, "SyncEngine.transfer", trace.WithAttributes(attribute.Int("sync.transfers", transfers)))
+	switch {
+	case syncErr != nil:
+		// The run was aborted or cancelled while waiting for confirmation
+	case trigger == model.JobTriggerRetry:
+		syncErr = e.runRetry(syncCtx, pairs, syncOpts, retryItems)
+	case task.Direction == model.SyncDirectionBidirectional:
+		renames, syncErr = e.runBidirectional(syncCtx, task, fSrc, fDst, syncOpts)
+	case task.Direction == model.SyncDirectionUpload:
+		if shards != nil {
+			syncErr = e.runSharded(syncCtx, jobEntity, task, trigger, connectionName, fSrc, syncOpts, shards)
+			break
+		}
+		if len(pairs) > 1 {
+			syncErr = e.runPairs(syncCtx, jobEntity, task, pairs, syncOpts)
+			break
+		}
+		syncErr = e.runOneWay(syncCtx, fSrc, fDst, syncOpts)
+	case task.Direction == model.SyncDirectionDownload:
+		if shards != nil {
+			syncErr = e.runSharded(syncCtx, jobEntity, task, trigger, connectionName, fDst, syncOpts, shards)
+			break
+		}
+		if len(pairs) > 1 {
+			syncErr = e.runPairs(syncCtx, jobEntity, task, pairs, syncOpts)
+			break
+		}
+		syncErr = e.runOneWay(syncCtx, fDst, fSrc, syncOpts)
 	default:
 		syncErr = i18n.NewI18nError(i18n.ErrInvalidInput).WithCause(fmt.Errorf("unsupported sync direction: %s", task.Direction)) //nolint:err113
 	}
+	tracing.End(syncSpan, syncErr)
 
-	// 9. Wait for poller to finish (it stops when statsCtx is cancelled or done)
+	// 11. Wait for poller to finish (it stops when statsCtx is cancelled or done)
 	// We cancel statsCtx after sync returns to stop the poller loop
 	statsCancel()
 	wg.Wait()
 
-	// 10. Finalize Job
-	if syncErr != nil {
-		// Check if the error is due to context cancellation
-		if errors.Is(ctx.Err(), context.Canceled) {
-			e.logger.Info("Sync task cancelled", zap.Stringer("job_id", jobEntity.ID))
+	// 12. Finalize Job
+	// Collect final stats (available for all outcomes)
+	var files, bytes, filesDeleted, errorCount, checks, listed int64
+	if shards != nil {
+		p := shards.snapshot()
+		files, bytes, filesDeleted, errorCount = p.FilesTransferred, p.BytesTransferred, p.FilesDeleted, p.ErrorCount
+		checks, listed = p.FilesChecked, p.ListingsDone
+		dirStats = p.directionStats
+	} else if s := accounting.Stats(statsCtx); s != nil {
+		files, bytes, filesDeleted, errorCount = s.GetTransfers(), s.GetBytes(), s.GetDeletes(), s.GetErrors()
+		checks, listed = s.GetChecks(), s.Listed(0)
+	}
+	result := ports.JobResult{
+		FilesTransferred: files,
+		BytesTransferred: bytes,
+		FilesDeleted:     filesDeleted,
+		ErrorCount:       errorCount,
+		FilesChecked:     checks,
+		ListingsDone:     listed,
+		UploadedFiles:    dirStats.UploadedFiles,
+		UploadedBytes:    dirStats.UploadedBytes,
+		DownloadedFiles:  dirStats.DownloadedFiles,
+		DownloadedBytes:  dirStats.DownloadedBytes,
+		Concurrency:      concurrency.series(transfers),
+		ConfigSnapshot:   snapshot,
+		Renames:          renames,
+	}
+
+	// Upload to the mirrors once the primary sync succeeded; retry runs don't carry mirrors, see retryOptions
+	if syncErr == nil && task.Direction == model.SyncDirectionUpload && len(syncOpts.Mirrors) > 0 {
+		var failed int
+		failed, syncErr = e.runMirrors(jobCtx, jobEntity, task, trigger, syncOpts)
+		result.ErrorCount += int64(failed)
+	}
+	if !errors.Is(syncErr, errDeletesAborted) {
+		e.runPostHook(ctx, jobEntity, task, syncErr, &result)
+	}
+
+	return e.finishJob(ctx, jobCtx, jobEntity, task, result, syncErr, syncOpts.MaxDuration)
+}
+
+// finishJob finalizes the job of a run that ended with runErr and broadcasts its final progress.
+// ctx is the run context, which is only cancelled by the user or shutdown, while jobCtx also carries
+// the task's max duration. result holds the stats of the run, its status is set here.
+// It returns the error of the run, which is replaced by a timeout error if the run exceeded maxDuration.
+func (e *SyncEngine) finishJob(ctx, jobCtx context.Context, jobEntity *ent.Job, task *ent.Task, result ports.JobResult, runErr error, maxDuration time.Duration) error {
+	finalEvent := func() *model.JobProgressEvent {
+		endTime := time.Now()
+		return &model.JobProgressEvent{
+			JobID:            jobEntity.ID,
+			TaskID:           task.ID,
+			ConnectionID:     task.Edges.Connection.ID,
+			Status:           result.Status,
+			FilesTransferred: int(result.FilesTransferred),
+			BytesTransferred: result.BytesTransferred,
+			UploadedFiles:    int(result.UploadedFiles),
+			UploadedBytes:    result.UploadedBytes,
+			DownloadedFiles:  int(result.DownloadedFiles),
+			DownloadedBytes:  result.DownloadedBytes,
+			FilesDeleted:     int(result.FilesDeleted),
+			FilesChecked:     int(result.FilesChecked),
+			ListingsDone:     int(result.ListingsDone),
+			ErrorCount:       int(result.ErrorCount),
+			StartTime:        jobEntity.StartTime,
+			EndTime:          &endTime,
+		}
+	}
+
+	if runErr != nil {
+		// Check if the error is due to context cancellation, or to deletions that were aborted
+		if aborted := errors.Is(runErr, errDeletesAborted); aborted || errors.Is(ctx.Err(), context.Canceled) {
+			e.logger.Info("Sync task cancelled", zap.Stringer("job_id", jobEntity.ID), zap.Bool("deletes_aborted", aborted))
 			// Use a fresh context for DB operations since the original context is cancelled
-			dbCtx, dbCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			dbCtx, dbCancel := context.WithTimeout(tracing.Detach(ctx), 5*time.Second)
 			defer dbCancel()
 
-			// Get stats for cancelled job
-			s := accounting.Stats(statsCtx)
-			var files, bytes, filesDeleted, errorCount int64
-			if s != nil {
-				files, bytes, filesDeleted, errorCount = s.GetTransfers(), s.GetBytes(), s.GetDeletes(), s.GetErrors()
-				// Update job stats (like ResetStuckJobs does)
-				if _, updateErr := e.jobService.UpdateJobStats(dbCtx, jobEntity.ID, files, bytes, filesDeleted, errorCount); updateErr != nil {
-					e.logger.Error("Failed to update job stats for cancelled job", zap.Error(updateErr))
-				}
+			result.Status = model.JobStatusCancelled
+			result.Error = i18n.Ctx(dbCtx, i18n.ErrJobCancelled)
+			if aborted {
+				result.Error = i18n.Ctx(dbCtx, i18n.ErrJobDeletesAborted)
 			}
-
-			if _, updateErr := e.jobService.UpdateJobStatus(dbCtx, jobEntity.ID, string(model.JobStatusCancelled), "Task cancelled by user or shutdown"); updateErr != nil {
-				e.logger.Error("Failed to update job status to cancelled", zap.Error(updateErr))
+			if _, finalizeErr := e.jobService.FinalizeJob(dbCtx, jobEntity.ID, result); finalizeErr != nil {
+				e.logger.Error("Failed to finalize cancelled job", zap.Error(finalizeErr))
 			}
 
 			// Broadcast cancellation
-			e.broadcastJobUpdate(&model.JobProgressEvent{
-				JobID:            jobEntity.ID,
-				TaskID:           task.ID,
-				ConnectionID:     task.Edges.Connection.ID,
-				Status:           model.JobStatusCancelled,
-				FilesTransferred: int(files),
-				BytesTransferred: bytes,
-				FilesDeleted:     int(filesDeleted),
-				ErrorCount:       int(errorCount),
-				StartTime:        jobEntity.StartTime,
-				EndTime:          func() *time.Time { t := time.Now(); return &t }(),
-			})
-			return syncErr
+			e.broadcastJobUpdate(finalEvent())
+			return runErr
 		}
 
-		if _, updateErr := e.jobService.AddJobLog(ctx, jobEntity.ID, string(model.LogLevelError), string(model.LogActionError), syncErr.Error(), 0); updateErr != nil {
-			e.logger.Error("Failed to add job log for sync failure", zap.Error(updateErr))
+		status := model.JobStatusFailed
+		if ctx.Err() == nil && errors.Is(jobCtx.Err(), context.DeadlineExceeded) {
+			// The run error is only a consequence of the cancelled job context
+			status = model.JobStatusFailedTimeout
+			runErr = fmt.Errorf("%w: job exceeded maximum duration of %s", errs.ErrTimeout, maxDuration)
+			e.logger.Warn("Sync task timed out", zap.Stringer("job_id", jobEntity.ID), zap.Duration("max_duration", maxDuration))
+		} else {
+			e.logger.Error("Sync operation failed", zap.Error(runErr))
 		}
-
-		e.logger.Error("Sync operation failed", zap.Error(syncErr))
-		if _, updateErr := e.jobService.UpdateJobStatus(ctx, jobEntity.ID, string(model.JobStatusFailed), syncErr.Error()); updateErr != nil {
-			e.logger.Error("Failed to update job status to failed", zap.Error(updateErr))
+		result.Status = status
+		result.Error = i18n.ErrorMessage(ctx, runErr)
+		result.Fatal = status == model.JobStatusFailed && isFatalRunError(runErr)
+		result.Logs = []*ent.JobLog{{
+			Level: model.LogLevelError,
+			What:  model.LogActionError,
+			Path:  result.Error,
+			Time:  time.Now(),
+		}}
+		if _, finalizeErr := e.jobService.FinalizeJob(ctx, jobEntity.ID, result); finalizeErr != nil {
+			e.logger.Error("Failed to finalize failed job", zap.Error(finalizeErr))
 		}
+
 		// Broadcast failure
-		s := accounting.Stats(statsCtx)
-		var files, bytes int64
-		if s != nil {
-			files, bytes = s.GetTransfers(), s.GetBytes()
-		}
-		e.broadcastJobUpdate(&model.JobProgressEvent{
-			JobID:            jobEntity.ID,
-			TaskID:           task.ID,
-			ConnectionID:     task.Edges.Connection.ID,
-			Status:           model.JobStatusFailed,
-			FilesTransferred: int(files),
-			BytesTransferred: bytes,
-			StartTime:        jobEntity.StartTime,
-			EndTime:          func() *time.Time { t := time.Now(); return &t }(),
-		})
-		return syncErr
+		e.broadcastJobUpdate(finalEvent())
+		return runErr
 	}
 
-	// Update final stats
-	s := accounting.Stats(statsCtx)
-	var files, bytes, filesDeleted, errorCount int64
-	if s != nil {
-		files, bytes, filesDeleted, errorCount = s.GetTransfers(), s.GetBytes(), s.GetDeletes(), s.GetErrors()
-		if _, updateErr := e.jobService.UpdateJobStats(ctx, jobEntity.ID, files, bytes, filesDeleted, errorCount); updateErr != nil {
-			e.logger.Error("Failed to update final job stats", zap.Error(updateErr))
-		}
-	}
+	// Errors rclone recovered from (e.g. single files that failed) don't fail the sync, but must not pass as a clean success
+	result.Status = completedStatus(result.ErrorCount)
 
-	if _, updateErr := e.jobService.UpdateJobStatus(ctx, jobEntity.ID, string(model.JobStatusSuccess), ""); updateErr != nil {
-		e.logger.Error("Failed to update job status to success", zap.Error(updateErr))
+	// Auto-delete empty jobs if configured; deletion happens in the same transaction as finalization
+	// Jobs of tasks with hooks are kept for the recorded hook results, jobs of tasks with mirrors for their child jobs
+	result.DeleteJob = !hasHooks(task) && !hasMirrors(task) && shouldDeleteEmptyJob(e.autoDeleteEmptyJobs, result.Status, int(result.FilesTransferred), result.BytesTransferred, int(result.FilesDeleted), int(result.ErrorCount))
+	if result.DeleteJob {
+		e.logger.Debug("Auto-deleting empty job", zap.Stringer("job_id", jobEntity.ID))
+	}
+	if _, finalizeErr := e.jobService.FinalizeJob(ctx, jobEntity.ID, result); finalizeErr != nil {
+		e.logger.Error("Failed to finalize successful job", zap.Error(finalizeErr))
 	}
 
 	// Broadcast success
-	e.broadcastJobUpdate(&model.JobProgressEvent{
-		JobID:            jobEntity.ID,
-		TaskID:           task.ID,
-		ConnectionID:     task.Edges.Connection.ID,
-		Status:           model.JobStatusSuccess,
-		FilesTransferred: int(files),
-		BytesTransferred: bytes,
-		StartTime:        jobEntity.StartTime,
-		EndTime:          func() *time.Time { t := time.Now(); return &t }(),
-	})
+	e.broadcastJobUpdate(finalEvent())
 
-	e.logger.Info("Sync task completed successfully", zap.Stringer("job_id", jobEntity.ID))
-
-	// Auto-delete empty jobs if configured
-	if shouldDeleteEmptyJob(e.autoDeleteEmptyJobs, model.JobStatusSuccess, int(files), bytes, int(filesDeleted), int(errorCount)) {
-		e.logger.Debug("Auto-deleting empty job", zap.Stringer("job_id", jobEntity.ID))
-		if err := e.jobService.DeleteJob(ctx, jobEntity.ID); err != nil {
-			// Log warning but don't fail the task - the job has already succeeded
-			e.logger.Warn("Failed to auto-delete empty job", zap.Stringer("job_id", jobEntity.ID), zap.Error(err))
-		} else {
-			e.logger.Debug("Successfully auto-deleted empty job", zap.Stringer("job_id", jobEntity.ID))
-		}
-	}
+	e.logger.Info("Sync task completed successfully", zap.Stringer("job_id", jobEntity.ID), zap.Stringer("status", result.Status))
 
 	return nil
 }
 
+// failJob finalizes a job that failed during setup, before any file was transferred.
 func (e *SyncEngine) failJob(ctx context.Context, jobID uuid.UUID, err error) {
 	e.logger.Error("Job failed during setup", zap.Error(err))
-	_, _ = e.jobService.UpdateJobStatus(ctx, jobID, string(model.JobStatusFailed), err.Error())
+	_, _ = e.jobService.FinalizeJob(ctx, jobID, ports.JobResult{
+		Status: model.JobStatusFailed,
+		Error:  i18n.ErrorMessage(ctx, err),
+		Fatal:  isFatalRunError(err),
+	})
+}
+
+// deferOverCap finalizes the job with the QUOTA_DEFERRED status if the connection of task transferred its
+// monthly transfer cap in the current calendar month. It reports whether the job was deferred.
+func (e *SyncEngine) deferOverCap(ctx context.Context, jobEntity *ent.Job, task *ent.Task) (bool, error) {
+	conn := task.Edges.Connection
+	if conn.MonthlyTransferCap == nil {
+		return false, nil
+	}
+	used, err := e.jobService.MonthlyTransfer(ctx, conn.ID, time.Now())
+	if err != nil {
+		e.failJob(ctx, jobEntity.ID, err)
+		return true, err
+	}
+	if used < *conn.MonthlyTransferCap {
+		return false, nil
+	}
+
+	reason := i18n.CtxWithData(ctx, i18n.StatusQuotaDeferred, map[string]interface{}{
+		"Connection": conn.Name,
+		"Used":       fs.SizeSuffix(used).ByteUnit(),
+		"Cap":        fs.SizeSuffix(*conn.MonthlyTransferCap).ByteUnit(),
+	})
+	e.logger.Info("Sync task deferred by the monthly transfer cap", zap.Stringer("job_id", jobEntity.ID),
+		zap.Int64("used", used), zap.Int64("cap", *conn.MonthlyTransferCap))
+	if _, err := e.jobService.FinalizeJob(ctx, jobEntity.ID, ports.JobResult{Status: model.JobStatusQuotaDeferred, Error: reason}); err != nil {
+		return true, errors.Join(errs.ErrSystem, errs.ConstError("failed to defer job"), err)
+	}
+
+	endTime := time.Now()
+	e.broadcastJobUpdate(&model.JobProgressEvent{
+		JobID:        jobEntity.ID,
+		TaskID:       task.ID,
+		ConnectionID: conn.ID,
+		Status:       model.JobStatusQuotaDeferred,
+		StartTime:    jobEntity.StartTime,
+		EndTime:      &endTime,
+	})
+	return true, nil
+}
+
+// completedStatus returns the status of a job whose sync completed without error,
+// which is SUCCESS_WITH_WARNINGS if errors were counted along the way.
+func completedStatus(errorCount int64) model.JobStatus {
+	if errorCount > 0 {
+		return model.JobStatusSuccessWithWarnings
+	}
+	return model.JobStatusSuccess
 }
 
 // shouldDeleteEmptyJob determines if a job should be deleted based on its configuration and result.
@@ -387,6 +710,66 @@ func getSyncOptionsFromTask(options *model.TaskSyncOptions) SyncOptions {
 		opts.Transfers = *options.Transfers
 	}
 
+	// Extract shards, capped at MaxShards
+	if options.Shards != nil {
+		opts.Shards = min(*options.Shards, MaxShards)
+	}
+
+	// Extract max duration
+	if options.MaxDurationMinutes != nil && *options.MaxDurationMinutes > 0 {
+		opts.MaxDuration = time.Duration(*options.MaxDurationMinutes) * time.Minute
+	}
+
+	// Extract trackRenames
+	if options.TrackRenames != nil {
+		opts.TrackRenames = *options.TrackRenames
+	}
+
+	// Extract windowsNames
+	if options.WindowsNames != nil {
+		opts.WindowsNames = *options.WindowsNames
+	}
+
+	// Extract preserveMetadata
+	if options.PreserveMetadata != nil {
+		opts.PreserveMetadata = *options.PreserveMetadata
+	}
+
+	// Extract skipSizing
+	if options.SkipSizing != nil {
+		opts.SkipSizing = *options.SkipSizing
+	}
+
+	// Extract createEmptySrcDirs, nil keeps the default of the direction
+	opts.CreateEmptySrcDirs = options.CreateEmptySrcDirs
+
+	// Extract skipZeroByteFiles
+	if options.SkipZeroByteFiles != nil {
+		opts.SkipZeroByteFiles = *options.SkipZeroByteFiles
+	}
+
+	// Extract confirmDeletesOver
+	if options.ConfirmDeletesOver != nil && *options.ConfirmDeletesOver > 0 {
+		opts.ConfirmDeletesOver = *options.ConfirmDeletesOver
+	}
+
+	// Extract additional paths
+	for _, p := range options.Paths {
+		if p != nil {
+			opts.Paths = append(opts.Paths, SyncPath{SourcePath: p.SourcePath, RemoteSubpath: p.RemoteSubpath})
+		}
+	}
+	if options.StopOnPathError != nil {
+		opts.StopOnPathError = *options.StopOnPathError
+	}
+
+	// Extract mirrors
+	for _, m := range options.Mirrors {
+		if m != nil {
+			opts.Mirrors = append(opts.Mirrors, SyncMirror{ConnectionID: m.ConnectionID, RemotePath: m.RemotePath})
+		}
+	}
+
 	return opts
 }
 
@@ -419,30 +802,46 @@ func applyFilterRules(ctx context.Context, rules []string) (context.Context, err
 	return filter.ReplaceConfig(ctx, fi), nil
 }
 
+// applySyncFilters injects the filter rules of opts into the context, preceded by the rules excluding
+// the names skipped for opts.WindowsNames and additionally excluding zero-byte files if opts.SkipZeroByteFiles is set.
+func applySyncFilters(ctx context.Context, opts SyncOptions) (context.Context, error) {
+	rules := opts.Filters
+	if windowsRules := windowsNameRules(opts.WindowsNames); len(windowsRules) > 0 {
+		rules = append(windowsRules, opts.Filters...)
+	}
+	if !opts.SkipZeroByteFiles {
+		return applyFilterRules(ctx, rules)
+	}
+
+	fi, err := createFilterFromRules(rules)
+	if err != nil {
+		return ctx, err
+	}
+	if fi == nil {
+		if fi, err = filter.NewFilter(nil); err != nil {
+			return ctx, err
+		}
+	}
+	fi.Opt.MinSize = 1
+	return filter.ReplaceConfig(ctx, fi), nil
+}
+
 // runBidirectional executes a bidirectional sync using bisync.
 // It applies SyncOptions including filters.
 // Note: noDelete is ignored for bidirectional sync as deletion propagation is inherent to bisync.
 // Note: transfers setting is applied in RunTask before calling this method.
-func (e *SyncEngine) runBidirectional(ctx context.Context, task *ent.Task, f1, f2 fs.Fs, opts SyncOptions) error {
+// It returns the files renamed on either side, which bisync applies as a copy and a deletion.
+func (e *SyncEngine) runBidirectional(ctx context.Context, task *ent.Task, f1, f2 fs.Fs, opts SyncOptions) ([]ports.LogRename, error) {
 	// Apply filter rules if specified
-	var err error
-	if len(opts.Filters) > 0 {
-		ctx, err = applyFilterRules(ctx, opts.Filters)
-		if err != nil {
-			return i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
-		}
+	ctx, err := applySyncFilters(ctx, opts)
+	if err != nil {
+		return nil, i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
 	}
 
-	// Determine Resync necessity
-	// Calculate base path and listing file names to check if they exist
-	basePath := bilib.BasePath(ctx, e.workDir, f1, f2)
-	listing1 := basePath + ".path1.lst"
-	listing2 := basePath + ".path2.lst"
-
-	resync := false
-	if !bilib.FileExists(listing1) || !bilib.FileExists(listing2) {
+	// Determine Resync necessity from the existence of the listing files
+	basePath, resync := bisyncListings(ctx, e.stateDir(), f1, f2)
+	if resync {
 		e.logger.Info("Listing files not found, forcing Resync")
-		resync = true
 	}
 
 	// Get conflict resolution settings from task options
@@ -454,18 +853,33 @@ func (e *SyncEngine) runBidirectional(ctx context.Context, task *ent.Task, f1, f
 
 	// Prepare Bisync options
 	opt := &bisync.Options{
-		Resync:          resync,
-		Recover:         true,
-		Workdir:         e.workDir,
-		NoCleanup:       true, // Keep workdir for state
-		Force:           true, // TODO: Expose as task option
-		CheckAccess:     false,
-		ConflictResolve: conflictResolve,
-		ConflictLoser:   conflictLoser,
+		Resync:             resync,
+		Recover:            true,
+		Workdir:            e.stateDir(),
+		NoCleanup:          true, // Keep workdir for state
+		Force:              true, // TODO: Expose as task option
+		CheckAccess:        false,
+		ConflictResolve:    conflictResolve,
+		ConflictLoser:      conflictLoser,
+		CreateEmptySrcDirs: opts.createEmptySrcDirs(true),
 	}
 
 	// Run Bisync
-	return bisync.Bisync(ctx, f1, f2, opt)
+	if err := bisync.Bisync(ctx, f1, f2, opt); err != nil {
+		return nil, err
+	}
+	if resync {
+		// There are no previous listings to detect renames with
+		return nil, nil
+	}
+
+	renames, err := bisyncRenames(basePath)
+	if err != nil {
+		// The sync itself succeeded, the logs just keep the separate copies and deletions
+		e.logger.Warn("Failed to detect renames from bisync listings", zap.String("task", task.Name), zap.Error(err))
+		return nil, nil
+	}
+	return renames, nil
 }
 
 // runOneWay executes a one-way sync using rclone sync.
@@ -478,21 +892,40 @@ func (e *SyncEngine) runBidirectional(ctx context.Context, task *ent.Task, f1, f
 //   - opts: sync options including filters and noDelete flag
 func (e *SyncEngine) runOneWay(ctx context.Context, fSrc, fDst fs.Fs, opts SyncOptions) error {
 	// Apply filter rules if specified
-	var err error
-	if len(opts.Filters) > 0 {
-		ctx, err = applyFilterRules(ctx, opts.Filters)
-		if err != nil {
-			return i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
-		}
+	ctx, err := applySyncFilters(ctx, opts)
+	if err != nil {
+		return i18n.NewI18nError(i18n.ErrSyncFailed).WithCause(err)
+	}
+
+	if opts.TrackRenames {
+		var ci *fs.ConfigInfo
+		ctx, ci = fs.AddConfig(ctx)
+		ci.TrackRenames = true
 	}
+	ctx = withWindowsNames(ctx, opts)
 
 	// Use CopyDir instead of Sync when noDelete is true
 	// CopyDir copies from src to dst without deleting existing files
+	createEmptySrcDirs := opts.createEmptySrcDirs(false)
 	if opts.NoDelete {
-		return rclonesync.CopyDir(ctx, fDst, fSrc, true) // dst = fDst, src = fSrc
+		return rclonesync.CopyDir(ctx, fDst, fSrc, createEmptySrcDirs) // dst = fDst, src = fSrc
 	}
 	// Sync makes dst identical to src, including deletions
-	return rclonesync.Sync(ctx, fDst, fSrc, true) // dst = fDst, src = fSrc
+	return rclonesync.Sync(ctx, fDst, fSrc, createEmptySrcDirs) // dst = fDst, src = fSrc
+}
+
+// trackRenamesUnsupported returns why renames from fSrc to fDst can't be tracked, or an empty string if they can.
+// These are the conditions under which rclone silently ignores --track-renames.
+func trackRenamesUnsupported(fSrc, fDst fs.Fs, opts SyncOptions) string {
+	switch {
+	case opts.NoDelete:
+		return "renames are only tracked when noDelete is disabled"
+	case !operations.CanServerSideMove(fDst):
+		return fmt.Sprintf("destination %s does not support server-side move or copy", fDst.Name())
+	case fSrc.Hashes().Overlap(fDst.Hashes()).GetOne() == hash.None:
+		return fmt.Sprintf("source %s and destination %s have no common hash", fSrc.Name(), fDst.Name())
+	}
+	return ""
 }
 
 // pollStats monitors the rclone stats and persists logs to the database.
@@ -512,40 +945,64 @@ func (e *SyncEngine) runOneWay(ctx context.Context, fSrc, fDst fs.Fs, opts SyncO
 //     strategy, this could lead to race conditions or deadlocks.
 //
 // Future: If rclone adds a proper event bus or callback system for transfers, this should be replaced immediately.
-func (e *SyncEngine) pollStats(ctx context.Context, jobID uuid.UUID, task *ent.Task, startTime time.Time) {
-	ticker := time.NewTicker(1 * time.Second)
+//
+// When shard is non-nil, jobID is a shard job and its progress is reported to the tracker instead of being broadcast.
+// It returns the per-direction transfer counts of the job and the number of transfers in progress
+// at each poll once ctx is done.
+func (e *SyncEngine) pollStats(ctx context.Context, jobID uuid.UUID, task *ent.Task, startTime time.Time, shard *shardTracker) (directionStats, *concurrencySampler) {
+	ticker := time.NewTicker(statsPollInterval)
 	defer ticker.Stop()
 
+	logBuf := e.newJobLogBuffer(jobID)
+	var dirStats directionStats
+	concurrency := &concurrencySampler{}
+	chunking := remoteUploadChunking(task)
+
 	for {
 		select {
 		case <-ctx.Done():
-			// Final stats update
-			e.processStats(ctx, jobID, task, startTime)
-			return
+			// Final stats update, then persist everything still buffered
+			e.processStats(ctx, jobID, task, startTime, logBuf, &dirStats, chunking, shard)
+			logBuf.flush()
+			return dirStats, concurrency
 		case <-ticker.C:
-			e.processStats(ctx, jobID, task, startTime)
+			e.faults.delayStats(ctx)
+			concurrency.add(e.processStats(ctx, jobID, task, startTime, logBuf, &dirStats, chunking, shard))
+			if logBuf.shouldFlush() {
+				logBuf.flush()
+			}
 		}
 	}
 }
 
 // processStats is the core logic for polling rclone stats, creating logs, and updating progress.
-func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *ent.Task, startTime time.Time) {
+// Completed transfer logs are appended to logBuf, which is flushed by the caller.
+// Check and listing operations are logged at DEBUG level only when the task enables verboseLogging.
+// Completed transfers are also counted per direction in dirStats.
+// Uploads report the chunk in progress for backends that upload in chunks, see uploadChunking.
+// It returns the number of file transfers in progress.
+func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *ent.Task, startTime time.Time, logBuf *jobLogBuffer, dirStats *directionStats, chunking uploadChunking, shard *shardTracker) int {
 	s := accounting.Stats(ctx)
 	if s == nil {
-		return
+		return 0
 	}
 
 	statsInnerMu, transfers, err := getStatsInternals(s)
 	if err != nil {
 		e.logger.Debug("Failed to get stats internals", zap.Error(err))
-		return
+		return 0
 	}
 
 	statsInnerMu.Lock()
 
 	var transfersToRemove []*accounting.Transfer
 	var logsToSave []*ent.JobLog
+	var failed []*ent.RetryQueue
 	var activeTransfers []*model.TransferItem
+	inProgress := 0
+	verbose := task.Options != nil && task.Options.VerboseLogging != nil && *task.Options.VerboseLogging
+	// Shard transfers are aggregated by the parent job, see pollShardProgress
+	collectTransfers := shard != nil || e.hasTransferSubscribers(jobID, task)
 
 	e.logger.Debug("Processing stats", zap.Any("transfers", *transfers))
 
@@ -564,6 +1021,10 @@ func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *en
 					Size:  snapshot.Size,
 					Time:  snapshot.CompletedAt,
 				})
+				// Failed transfers can be retried on their own, see job.retryFailedFiles
+				if snapshot.What == "transferring" {
+					failed = append(failed, failedTransfer(task, snapshot))
+				}
 				continue
 			}
 
@@ -587,14 +1048,40 @@ func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *en
 					Size:  snapshot.Size,
 					Time:  snapshot.CompletedAt,
 				})
-			case "checking", "hashing", "listing", "listing file - Path1", "listing file - Path2":
-				// Skip pure check operations (e.g., MD5 verification, listing)
-				continue
+			case "checking", "hashing", "renaming":
+				// Pure check operations (e.g., MD5 verification, hashing rename candidates) are only logged when verbose
+				if verbose {
+					logsToSave = append(logsToSave, &ent.JobLog{
+						Level: model.LogLevelDebug,
+						What:  model.LogActionCheck,
+						Path:  snapshot.Name,
+						Size:  snapshot.Size,
+						Time:  snapshot.CompletedAt,
+					})
+				}
+			case "listing", "listing file - Path1", "listing file - Path2":
+				if verbose {
+					logsToSave = append(logsToSave, &ent.JobLog{
+						Level: model.LogLevelDebug,
+						What:  model.LogActionList,
+						Path:  snapshot.Name,
+						Size:  snapshot.Size,
+						Time:  snapshot.CompletedAt,
+					})
+				}
 			case "transferring":
 				what := model.LogActionUpload
-				if snapshot.SrcFs != task.SourcePath {
+				// Shard jobs sync sub-directories of the task's source path, additional paths other local directories
+				if _, _, ok := localPath(task, snapshot.SrcFs); !ok {
 					what = model.LogActionDownload
 				}
+				if what == model.LogActionUpload {
+					dirStats.UploadedFiles++
+					dirStats.UploadedBytes += snapshot.Size
+				} else {
+					dirStats.DownloadedFiles++
+					dirStats.DownloadedBytes += snapshot.Size
+				}
 				// Log successful transfers (including 0-byte files)
 				logsToSave = append(logsToSave, &ent.JobLog{
 					Level: model.LogLevelInfo,
@@ -603,12 +1090,19 @@ func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *en
 					Size:  snapshot.Size,
 					Time:  snapshot.CompletedAt,
 				})
+				if !collectTransfers {
+					continue
+				}
 				// Include completed transfers in broadcast (bytes == size signals completion to frontend)
-				activeTransfers = append(activeTransfers, &model.TransferItem{
+				item := &model.TransferItem{
 					Name:  snapshot.Name,
 					Size:  snapshot.Size,
 					Bytes: snapshot.Size, // bytes == size indicates completion
-				})
+				}
+				if what == model.LogActionUpload {
+					item.Chunk, item.Chunks = chunking.progress(snapshot.Size, snapshot.Size)
+				}
+				activeTransfers = append(activeTransfers, item)
 			default:
 				// Unknown operation type
 				logsToSave = append(logsToSave, &ent.JobLog{
@@ -621,25 +1115,33 @@ func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *en
 		} else {
 			// Collect active transfers for progress broadcast
 			snapshot := tr.Snapshot()
-			activeTransfers = append(activeTransfers, &model.TransferItem{
+			if snapshot.What == "transferring" {
+				inProgress++
+			}
+			if !collectTransfers {
+				continue
+			}
+			item := &model.TransferItem{
 				Name:  snapshot.Name,
 				Size:  snapshot.Size,
 				Bytes: snapshot.Bytes,
-			})
+			}
+			// Chunks are only reported for uploads to the remote
+			if _, _, ok := localPath(task, snapshot.SrcFs); ok && snapshot.What == "transferring" {
+				item.Chunk, item.Chunks = chunking.progress(snapshot.Size, snapshot.Bytes)
+			}
+			activeTransfers = append(activeTransfers, item)
 		}
 	}
 
 	statsInnerMu.Unlock()
 
-	// Persist logs
+	// Buffer logs for batched persistence
 	if len(logsToSave) > 0 {
-		// Use a separate context for DB operations to ensure they complete even if the job context is cancelling
-		dbCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		err := e.jobService.AddJobLogsBatch(dbCtx, jobID, logsToSave)
-		if err != nil {
-			e.logger.Error("Failed to save job logs", zap.Error(err))
-		}
-		cancel()
+		logBuf.add(logsToSave)
+	}
+	if len(failed) > 0 {
+		logBuf.addFailed(failed)
 	}
 
 	// Remove processed transfers from stats
@@ -647,9 +1149,29 @@ func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *en
 		s.RemoveTransfer(tr)
 	}
 
-	// Get total stats for progress display
+	// Get total stats for progress display, starting from the estimate of the sizing pass
 	totalTransfers, totalBytes := getTotalStats(s)
+	totalTransfers, totalBytes = e.applyWorkingSet(jobID, totalTransfers, totalBytes)
 	filesDeleted, errorCount := s.GetDeletes(), s.GetErrors()
+	// Listed(0) reads the counter without adding to it
+	checks, listed := s.GetChecks(), s.Listed(0)
+
+	// Shard progress is aggregated and broadcast by the parent job
+	if shard != nil {
+		shard.update(jobID, shardProgress{
+			directionStats:   *dirStats,
+			FilesTransferred: s.GetTransfers(),
+			BytesTransferred: s.GetBytes(),
+			FilesTotal:       totalTransfers,
+			BytesTotal:       totalBytes,
+			FilesDeleted:     filesDeleted,
+			ErrorCount:       errorCount,
+			FilesChecked:     checks,
+			ListingsDone:     listed,
+			Transfers:        activeTransfers,
+		})
+		return inProgress
+	}
 
 	// Broadcast progress update
 	if task.Edges.Connection != nil {
@@ -657,19 +1179,49 @@ func (e *SyncEngine) processStats(ctx context.Context, jobID uuid.UUID, task *en
 			JobID:            jobID,
 			TaskID:           task.ID,
 			ConnectionID:     task.Edges.Connection.ID,
-			Status:           model.JobStatusRunning,
+			Status:           e.runningStatus(jobID),
 			FilesTransferred: int(s.GetTransfers()),
 			BytesTransferred: s.GetBytes(),
+			UploadedFiles:    int(dirStats.UploadedFiles),
+			UploadedBytes:    dirStats.UploadedBytes,
+			DownloadedFiles:  int(dirStats.DownloadedFiles),
+			DownloadedBytes:  dirStats.DownloadedBytes,
 			FilesTotal:       int(totalTransfers),
 			BytesTotal:       totalBytes,
 			FilesDeleted:     int(filesDeleted),
+			FilesChecked:     int(checks),
+			ListingsDone:     int(listed),
 			ErrorCount:       int(errorCount),
 			StartTime:        startTime,
 		})
 
 		// Broadcast transfer progress update (using snapshots collected while holding the lock)
-		e.broadcastTransferProgress(jobID, task, activeTransfers)
+		if collectTransfers {
+			e.broadcastTransferProgress(jobID, task, activeTransfers)
+		}
 	}
+	return inProgress
+}
+
+// hasTransferSubscribers reports whether the transfer progress of the job is subscribed to.
+// Without subscribers the cached progress of the job is dropped, so a new subscriber gets
+// the next progress even if it didn't change since it was last published.
+func (e *SyncEngine) hasTransferSubscribers(jobID uuid.UUID, task *ent.Task) bool {
+	if e.transferProgressBus == nil || task.Edges.Connection == nil {
+		return false
+	}
+	if e.transferProgressBus.HasSubscribers(&model.TransferProgressEvent{
+		JobID:        jobID,
+		TaskID:       task.ID,
+		ConnectionID: task.Edges.Connection.ID,
+	}) {
+		return true
+	}
+
+	e.statsMu.Lock()
+	delete(e.lastTransferEvents, jobID)
+	e.statsMu.Unlock()
+	return false
 }
 
 // broadcastTransferProgress broadcasts the current transfer progress for active file transfers.
@@ -730,12 +1282,17 @@ func (e *SyncEngine) broadcastJobUpdate(event *model.JobProgressEvent) {
 	e.statsMu.Lock()
 	defer e.statsMu.Unlock()
 
+	if parentID, ok := e.childParents[event.JobID]; ok && event.ParentID == nil {
+		event.ParentID = &parentID
+	}
 	last, ok := e.lastEvents[event.JobID]
 	if ok && last.Status == event.Status &&
 		last.FilesTransferred == event.FilesTransferred &&
 		last.BytesTransferred == event.BytesTransferred &&
 		last.FilesTotal == event.FilesTotal &&
 		last.BytesTotal == event.BytesTotal &&
+		last.FilesChecked == event.FilesChecked &&
+		last.ListingsDone == event.ListingsDone &&
 		last.TaskID == event.TaskID &&
 		last.ConnectionID == event.ConnectionID &&
 		last.StartTime.Equal(event.StartTime) &&
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/rclone"
 )
 
@@ -77,10 +78,15 @@ func TestSyncEngine_RunTask_Upload(t *testing.T) {
 	assert.True(t, os.IsNotExist(err), "Extra file in destination should be deleted")
 
 	// Verify Job Status
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1)
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs[0].Status))
+
+	// Verify per-direction stats
+	assert.Equal(t, 1, jobs[0].UploadedFiles)
+	assert.Equal(t, int64(len("upload content")), jobs[0].UploadedBytes)
+	assert.Equal(t, 0, jobs[0].DownloadedFiles)
 }
 
 func TestSyncEngine_RunTask_Download(t *testing.T) {
@@ -140,10 +146,15 @@ func TestSyncEngine_RunTask_Download(t *testing.T) {
 	assert.True(t, os.IsNotExist(err), "Extra file in source should be deleted")
 
 	// Verify Job Status
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1)
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs[0].Status))
+
+	// Verify per-direction stats
+	assert.Equal(t, 0, jobs[0].UploadedFiles)
+	assert.Equal(t, 1, jobs[0].DownloadedFiles)
+	assert.Equal(t, int64(len("download content")), jobs[0].DownloadedBytes)
 }
 
 func TestSyncEngine_RunTask_Bidirectional(t *testing.T) {
@@ -204,8 +215,382 @@ func TestSyncEngine_RunTask_Bidirectional(t *testing.T) {
 	assert.NoError(t, err, "Destination file should be synced to source")
 
 	// Verify Job Status
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1)
 	assert.Equal(t, string(model.JobStatusSuccess), string(jobs[0].Status))
+
+	// Verify per-direction stats
+	assert.Equal(t, 1, jobs[0].UploadedFiles)
+	assert.Equal(t, 1, jobs[0].DownloadedFiles)
+	assert.Equal(t, jobs[0].BytesTransferred, jobs[0].UploadedBytes+jobs[0].DownloadedBytes)
+}
+
+func TestSyncEngine_RunTask_BidirectionalRename(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "report.txt"), []byte("quarterly report"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "other.txt"), []byte("unchanged"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "TestBidirectionalRename", sourceDir, testConn.ID, destDir,
+		string(model.SyncDirectionBidirectional), "", false, nil)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+
+	// The first run is a resync without previous listings
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	require.FileExists(t, filepath.Join(destDir, "report.txt"))
+
+	// Rename and move the file locally, bisync copies the new path and deletes the old one on the remote
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "archive"), 0755))
+	require.NoError(t, os.Rename(filepath.Join(sourceDir, "report.txt"), filepath.Join(sourceDir, "archive", "report-q3.txt")))
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	assert.FileExists(t, filepath.Join(destDir, "archive", "report-q3.txt"))
+	assert.NoFileExists(t, filepath.Join(destDir, "report.txt"))
+
+	job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.JobStatusSuccess, job.Status)
+	logs, err := jobService.ListJobLogs(ctx, nil, nil, &job.ID, "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 1, "the deletion and the upload are merged into a single log")
+	assert.Equal(t, model.LogActionRename, logs[0].What)
+	assert.Equal(t, "archive/report-q3.txt", logs[0].Path)
+	require.NotNil(t, logs[0].PreviousPath)
+	assert.Equal(t, "report.txt", *logs[0].PreviousPath)
+	assert.Equal(t, int64(len("quarterly report")), logs[0].Size)
+}
+
+func TestSyncEngine_RunTask_ShardedUpload(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	// 1. Setup test directories with several top-level directories
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+
+	for _, dir := range []string{"a", "b", "c"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, dir), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, dir, "file.txt"), []byte("content "+dir), 0644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "root.txt"), []byte("root"), 0644))
+
+	// Directory only present in destination should be removed by the root shard
+	require.NoError(t, os.MkdirAll(filepath.Join(destDir, "stale"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(destDir, "stale", "old.txt"), []byte("old"), 0644))
+
+	// 2. Create Connection and Task with sharding enabled
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	shards := 2
+	testTask, err := taskService.CreateTask(ctx,
+		"TestShardedUpload",
+		sourceDir,
+		testConn.ID,
+		destDir,
+		string(model.SyncDirectionUpload),
+		"",
+		false,
+		&model.TaskSyncOptions{Shards: &shards},
+	)
+	require.NoError(t, err)
+
+	// 3. Setup SyncEngine and run the task
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	err = syncEngine.RunTask(ctx, testTask, model.JobTriggerManual)
+	require.NoError(t, err)
+
+	// 4. Verify results
+	for _, dir := range []string{"a", "b", "c"} {
+		content, err := os.ReadFile(filepath.Join(destDir, dir, "file.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "content "+dir, string(content))
+	}
+	_, err = os.Stat(filepath.Join(destDir, "root.txt"))
+	assert.NoError(t, err, "Top-level file should be synced by the root shard")
+	_, err = os.Stat(filepath.Join(destDir, "stale", "old.txt"))
+	assert.True(t, os.IsNotExist(err), "Stale destination directory should be deleted")
+
+	// 5. Verify the parent job aggregates one child job per shard
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1, "Child jobs should not be listed as top-level jobs")
+	parent := jobs[0]
+	assert.Equal(t, model.JobStatusSuccess, parent.Status)
+	assert.Equal(t, 4, parent.FilesTransferred)
+	assert.Equal(t, 4, parent.UploadedFiles)
+	assert.Equal(t, 0, parent.DownloadedFiles)
+
+	children, err := jobService.ListChildJobs(ctx, parent.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 4, "Root shard plus one shard per top-level directory")
+	var childFiles int
+	for _, child := range children {
+		assert.Equal(t, model.JobStatusSuccess, child.Status)
+		childFiles += child.FilesTransferred
+	}
+	assert.Equal(t, parent.FilesTransferred, childFiles)
+
+	// Transfer logs are persisted on the child jobs and classified by direction
+	logs, err := jobService.ListJobLogs(ctx, nil, &testTask.ID, nil, "", 100, 0)
+	require.NoError(t, err)
+	var uploads int
+	for _, l := range logs {
+		if l.What == model.LogActionUpload {
+			uploads++
+		}
+	}
+	assert.Equal(t, 4, uploads)
+}
+
+func TestSyncEngine_RunTask_ShardedUploadAnchoredFilters(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	for _, file := range []string{"a/keep.txt", "a/skip/file.txt", "b/file.txt", "b/sub/file.txt"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, filepath.Dir(file)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, file), []byte(file), 0644))
+	}
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+
+	// Anchored rules match paths relative to the task's root, not the shard's directory
+	shards := 2
+	testTask, err := taskService.CreateTask(ctx,
+		"TestShardedUploadAnchoredFilters",
+		sourceDir,
+		testConn.ID,
+		destDir,
+		string(model.SyncDirectionUpload),
+		"",
+		false,
+		&model.TaskSyncOptions{Shards: &shards, Filters: []string{"- /a/skip/**", "+ /b/sub/**", "- /b/**"}},
+	)
+	require.NoError(t, err)
+
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	for file, synced := range map[string]bool{
+		"a/keep.txt":      true,
+		"a/skip/file.txt": false,
+		"b/file.txt":      false,
+		"b/sub/file.txt":  true,
+	} {
+		_, err := os.Stat(filepath.Join(destDir, file))
+		if synced {
+			assert.NoError(t, err, file)
+		} else {
+			assert.True(t, os.IsNotExist(err), "%s should be filtered", file)
+		}
+	}
+}
+
+func TestSyncEngine_RunTask_Retry(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	// 1. Setup a bidirectional task whose previous job failed to transfer two files
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "docs"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "docs", "failed.txt"), []byte("failed upload"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "other.txt"), []byte("not failed"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(destDir, "remote.txt"), []byte("failed download"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(destDir, "remote-only.txt"), []byte("kept"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx,
+		"TestRetry",
+		sourceDir,
+		testConn.ID,
+		destDir,
+		string(model.SyncDirectionBidirectional),
+		"",
+		false,
+		nil,
+	)
+	require.NoError(t, err)
+
+	failedJob, err := jobService.CreateJob(ctx, testTask.ID, model.JobTriggerManual)
+	require.NoError(t, err)
+	require.NoError(t, jobService.AddRetryQueueItems(ctx, failedJob.ID, []*ent.RetryQueue{
+		{TaskID: testTask.ID, Path: "docs/failed.txt", Direction: model.SyncDirectionUpload, ErrorClass: model.TransferErrorClassNetwork, Error: "connection reset by peer"},
+		{TaskID: testTask.ID, Path: "remote.txt", Direction: model.SyncDirectionDownload, ErrorClass: model.TransferErrorClassNetwork, Error: "connection reset by peer"},
+	}))
+	n, err := jobService.RequestRetry(ctx, failedJob.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// 2. Run the retry
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerRetry))
+
+	// 3. Only the failed files are copied, in the direction they failed in
+	content, err := os.ReadFile(filepath.Join(destDir, "docs", "failed.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "failed upload", string(content))
+	content, err = os.ReadFile(filepath.Join(sourceDir, "remote.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "failed download", string(content))
+	_, err = os.Stat(filepath.Join(destDir, "other.txt"))
+	assert.True(t, os.IsNotExist(err), "Files that did not fail should not be transferred")
+	_, err = os.Stat(filepath.Join(sourceDir, "remote-only.txt"))
+	assert.True(t, os.IsNotExist(err), "Files that did not fail should not be transferred")
+	_, err = os.Stat(filepath.Join(destDir, "remote-only.txt"))
+	assert.NoError(t, err, "Retries never delete files")
+
+	// 4. The retry job claimed the files
+	retryJob, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.JobTriggerRetry, retryJob.Trigger)
+	assert.Equal(t, model.JobStatusSuccess, retryJob.Status)
+	assert.Equal(t, 1, retryJob.UploadedFiles)
+	assert.Equal(t, 1, retryJob.DownloadedFiles)
+
+	items, err := jobService.ListRetryQueue(ctx, failedJob.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	for _, item := range items {
+		require.NotNil(t, item.RetryJobID)
+		assert.Equal(t, retryJob.ID, *item.RetryJobID)
+	}
+}
+
+func TestSyncEngine_RunTask_DeferredOverTransferCap(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "first.txt"), []byte("first content"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "TestTransferCap", sourceDir, testConn.ID, destDir,
+		string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+
+	// The first run reaches the cap, which only defers the runs after it
+	_, err = connService.SetConnectionTransferCap(ctx, testConn.ID, int64(len("first content")))
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	_, err = os.Stat(filepath.Join(destDir, "first.txt"))
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "second.txt"), []byte("second content"), 0644))
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	_, err = os.Stat(filepath.Join(destDir, "second.txt"))
+	assert.True(t, os.IsNotExist(err), "deferred jobs don't sync")
+
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, model.JobStatusQuotaDeferred, jobs[0].Status)
+	assert.NotEmpty(t, jobs[0].Errors)
+	assert.Equal(t, model.JobStatusSuccess, jobs[1].Status)
+
+	// Raising the cap lets jobs run again
+	_, err = connService.SetConnectionTransferCap(ctx, testConn.ID, 1<<20)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	_, err = os.Stat(filepath.Join(destDir, "second.txt"))
+	assert.NoError(t, err)
+}
+
+// TestSyncEngine_RunTask_ConfigSnapshot tests that jobs record the effective configuration of their run,
+// including whether a bidirectional run had to resync.
+func TestSyncEngine_RunTask_ConfigSnapshot(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "file.txt"), []byte("content"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+
+	t.Run("upload", func(t *testing.T) {
+		transfers := 3
+		trackRenames := true
+		testTask, err := taskService.CreateTask(ctx, "TestSnapshotUpload", sourceDir, testConn.ID, destDir,
+			string(model.SyncDirectionUpload), "", false,
+			&model.TaskSyncOptions{Transfers: &transfers, Filters: []string{"- *.tmp"}, TrackRenames: &trackRenames})
+		require.NoError(t, err)
+		testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+		jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		snapshot := jobs[0].ConfigSnapshot
+		require.NotNil(t, snapshot)
+		assert.Equal(t, model.SyncDirectionUpload, snapshot.Direction)
+		assert.Equal(t, 3, snapshot.Transfers)
+		assert.Equal(t, []string{"- *.tmp"}, snapshot.Filters)
+		assert.True(t, snapshot.TrackRenames)
+		assert.True(t, snapshot.CreateEmptySrcDirs)
+		assert.Equal(t, 1, snapshot.Shards)
+		assert.Nil(t, snapshot.BwLimit)
+		assert.Nil(t, snapshot.ConflictResolution)
+		assert.Nil(t, snapshot.Resync)
+	})
+
+	t.Run("bidirectional", func(t *testing.T) {
+		conflict := model.ConflictResolutionLocal
+		testTask, err := taskService.CreateTask(ctx, "TestSnapshotBidirectional", sourceDir, testConn.ID, t.TempDir(),
+			string(model.SyncDirectionBidirectional), "", false,
+			&model.TaskSyncOptions{ConflictResolution: &conflict})
+		require.NoError(t, err)
+		testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+		require.NoError(t, err)
+
+		// The first run has no listings to compare with, the second one does
+		for _, wantResync := range []bool{true, false} {
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 1, 0)
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+			snapshot := jobs[0].ConfigSnapshot
+			require.NotNil(t, snapshot)
+			assert.Equal(t, rclone.DefaultTransfers, snapshot.Transfers)
+			assert.Empty(t, snapshot.Filters)
+			assert.False(t, snapshot.CreateEmptySrcDirs)
+			require.NotNil(t, snapshot.ConflictResolution)
+			assert.Equal(t, model.ConflictResolutionLocal, *snapshot.ConflictResolution)
+			require.NotNil(t, snapshot.Resync)
+			assert.Equal(t, wantResync, *snapshot.Resync)
+		}
+	})
 }
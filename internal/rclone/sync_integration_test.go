@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -96,7 +97,7 @@ func TestSyncEngine_RunTask_Integration(t *testing.T) {
 	assert.NoError(t, err, "File should exist in destination")
 
 	// Check database for job and logs
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1, "Should be one job in the database")
 
@@ -119,6 +120,40 @@ func TestSyncEngine_RunTask_Integration(t *testing.T) {
 	assert.True(t, foundLog, "Should find a log entry for test.txt")
 }
 
+// TestSyncEngine_RunTask_CheckCounters tests that a job records the files it compared and the entries it listed,
+// including the files that were already up to date.
+func TestSyncEngine_RunTask_CheckCounters(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, name), []byte(name), 0644))
+	}
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "CheckCounters", sourceDir, testConn.ID, destDir,
+		string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	// The second run only has a new file to transfer, the others are checked
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "d.txt"), []byte("d"), 0644))
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, job.FilesTransferred)
+	assert.Equal(t, 3, job.FilesChecked)
+	assert.GreaterOrEqual(t, job.ListingsDone, 7, "at least 4 source and 3 destination entries")
+}
+
 // TestSyncEngine_RunTask_AutoDeleteEmptyJob tests the auto-delete empty job logic.
 // This test covers the auto-delete logic in sync.go lines 285-294.
 func TestSyncEngine_RunTask_AutoDeleteEmptyJob(t *testing.T) {
@@ -199,7 +234,7 @@ func TestSyncEngine_RunTask_AutoDeleteEmptyJob(t *testing.T) {
 			require.NoError(t, err)
 
 			// 6. Verify results
-			jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+			jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 			require.NoError(t, err)
 
 			if tt.expectJobDeleted {
@@ -252,7 +287,7 @@ func TestSyncEngine_RunTask_Failure(t *testing.T) {
 	assert.Error(t, err, "RunTask should return an error for non-existent source")
 
 	// 6. Verify results
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1, "Should be one job in the database")
 
@@ -308,7 +343,7 @@ func TestSyncEngine_RunTask_Cancel(t *testing.T) {
 	assert.Contains(t, err.Error(), "context canceled", "Error should mention context cancellation")
 
 	// 7. Verify that no job was created because the context was cancelled before any work
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	assert.Empty(t, jobs, "No job should be created if the context is already cancelled")
 }
@@ -335,10 +370,10 @@ func TestSyncEngine_RunTask_CancelDuringSync(t *testing.T) {
 	err := os.WriteFile(testFilePath, []byte("hello world"), 0644)
 	require.NoError(t, err)
 
-	// 3. Create slowfs connection
-	slowConn, err := connService.CreateConnection(ctx, "slowlocal", "slowfs", map[string]string{
-		"type":   "slowfs",
-		"remote": "/",
+	// 3. Create slowfs connection, slowfs is registered by the tests only and is reached through an alias of an on-the-fly remote
+	slowConn, err := connService.CreateConnection(ctx, "slowlocal", model.ConnectionTypeAlias, map[string]string{
+		"type":   "alias",
+		"remote": ":slowfs,remote=/:",
 	})
 	require.NoError(t, err)
 
@@ -385,7 +420,7 @@ func TestSyncEngine_RunTask_CancelDuringSync(t *testing.T) {
 	time.Sleep(200 * time.Millisecond)
 
 	// 11. Verify job is running
-	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err := jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	require.Len(t, jobs, 1, "Should have exactly one job")
 	jobID := jobs[0].ID
@@ -410,7 +445,7 @@ func TestSyncEngine_RunTask_CancelDuringSync(t *testing.T) {
 	time.Sleep(500 * time.Millisecond)
 
 	// 15. Verify job status was updated to cancelled
-	jobs, err = jobService.ListJobs(ctx, &testTask.ID, nil, 10, 0)
+	jobs, err = jobService.ListJobs(ctx, &testTask.ID, nil, "", 10, 0)
 	require.NoError(t, err)
 	require.Len(t, jobs, 1, "Should still have one job")
 
@@ -531,6 +566,156 @@ func TestSyncEngine_RunTask_NoDelete(t *testing.T) {
 	}
 }
 
+// TestSyncEngine_RunTask_TrackRenames tests that renamed source files are moved on the destination
+// instead of being transferred again, and that an unusable trackRenames option is reported in the job log.
+func TestSyncEngine_RunTask_TrackRenames(t *testing.T) {
+	tests := []struct {
+		name          string
+		noDelete      bool
+		expectMoved   bool
+		expectWarning bool
+	}{
+		{
+			name:        "renamed file is moved on destination",
+			expectMoved: true,
+		},
+		{
+			name:          "noDelete disables trackRenames with a warning",
+			noDelete:      true,
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connService, taskService, jobService, _ := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			sourceDir := t.TempDir()
+			destDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "old.bin"), []byte("large file content"), 0644))
+
+			testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+			require.NoError(t, err)
+
+			trackRenames := true
+			options := &model.TaskSyncOptions{
+				NoDelete:     &tt.noDelete,
+				TrackRenames: &trackRenames,
+			}
+			testTask, err := taskService.CreateTask(ctx, tt.name, sourceDir, testConn.ID, destDir,
+				string(model.SyncDirectionUpload), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+
+			syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			before, err := os.Stat(filepath.Join(destDir, "old.bin"))
+			require.NoError(t, err)
+
+			// Rename the file in the source and sync again
+			require.NoError(t, os.Rename(filepath.Join(sourceDir, "old.bin"), filepath.Join(sourceDir, "new.bin")))
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			after, err := os.Stat(filepath.Join(destDir, "new.bin"))
+			require.NoError(t, err)
+			// A server-side move on the local backend keeps the file, a transfer creates a new one
+			assert.Equal(t, tt.expectMoved, os.SameFile(before, after))
+
+			job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+			require.NoError(t, err)
+			warnings, err := jobService.CountJobLogs(ctx, nil, nil, &job.ID, string(model.LogLevelWarning))
+			require.NoError(t, err)
+			if tt.expectWarning {
+				assert.Equal(t, 1, warnings)
+			} else {
+				assert.Zero(t, warnings)
+			}
+		})
+	}
+}
+
+// TestSyncEngine_RunTask_EmptyDirsAndZeroByteFiles tests the createEmptySrcDirs and skipZeroByteFiles options.
+func TestSyncEngine_RunTask_EmptyDirsAndZeroByteFiles(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		name               string
+		direction          model.SyncDirection
+		createEmptySrcDirs *bool
+		skipZeroByteFiles  bool
+		expectEmptyDir     bool
+		expectZeroByteFile bool
+	}{
+		{
+			name:               "one-way creates empty directories by default",
+			direction:          model.SyncDirectionUpload,
+			expectEmptyDir:     true,
+			expectZeroByteFile: true,
+		},
+		{
+			name:               "one-way without empty directories",
+			direction:          model.SyncDirectionUpload,
+			createEmptySrcDirs: &disabled,
+			skipZeroByteFiles:  true,
+		},
+		{
+			name:               "bidirectional skips empty directories by default",
+			direction:          model.SyncDirectionBidirectional,
+			expectZeroByteFile: true,
+		},
+		{
+			name:               "bidirectional with empty directories",
+			direction:          model.SyncDirectionBidirectional,
+			createEmptySrcDirs: &enabled,
+			skipZeroByteFiles:  true,
+			expectEmptyDir:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connService, taskService, jobService, _ := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			sourceDir := t.TempDir()
+			destDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "data.txt"), []byte("content"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "empty.tmp"), nil, 0644))
+			require.NoError(t, os.Mkdir(filepath.Join(sourceDir, "empty"), 0755))
+
+			testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+			require.NoError(t, err)
+			options := &model.TaskSyncOptions{
+				CreateEmptySrcDirs: tt.createEmptySrcDirs,
+				SkipZeroByteFiles:  &tt.skipZeroByteFiles,
+			}
+			testTask, err := taskService.CreateTask(ctx, tt.name, sourceDir, testConn.ID, destDir,
+				string(tt.direction), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+
+			syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			assert.FileExists(t, filepath.Join(destDir, "data.txt"))
+			if tt.expectEmptyDir {
+				assert.DirExists(t, filepath.Join(destDir, "empty"))
+			} else {
+				assert.NoDirExists(t, filepath.Join(destDir, "empty"))
+			}
+			if tt.expectZeroByteFile {
+				assert.FileExists(t, filepath.Join(destDir, "empty.tmp"))
+			} else {
+				assert.NoFileExists(t, filepath.Join(destDir, "empty.tmp"))
+				assert.FileExists(t, filepath.Join(sourceDir, "empty.tmp"), "skipped files are not deleted")
+			}
+		})
+	}
+}
+
 // TestSyncEngine_RunTask_ProgressEvents tests that JobProgressEvent and TransferProgressEvent
 // are properly published during sync operations.
 func TestSyncEngine_RunTask_ProgressEvents(t *testing.T) {
@@ -695,3 +880,594 @@ func TestSyncEngine_RunTask_ProgressEvents(t *testing.T) {
 		assert.True(t, foundCompleted, "Should find at least one completed transfer (bytes == size)")
 	}
 }
+
+// TestSyncEngine_RunTask_SizingPass tests that one-way jobs publish their totals before transferring.
+func TestSyncEngine_RunTask_SizingPass(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a.txt"), []byte("hello"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "b.txt"), []byte("hello world"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "TestSizingPass", sourceDir, testConn.ID, destDir,
+		string(model.SyncDirectionUpload), "", false, nil)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	jobProgressBus := subscription.NewJobProgressBus()
+	jobSub := jobProgressBus.Subscribe(nil)
+	defer jobProgressBus.Unsubscribe(jobSub.ID)
+
+	syncEngine := rclone.NewSyncEngine(jobService, jobProgressBus, nil, t.TempDir(), false, 0)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	// The first event carrying totals already has the complete working set
+	for {
+		select {
+		case event := <-jobSub.Events:
+			if event.FilesTotal == 0 {
+				continue
+			}
+			assert.Equal(t, 2, event.FilesTotal)
+			assert.Equal(t, int64(16), event.BytesTotal)
+			return
+		case <-time.After(time.Second):
+			t.Fatal("no progress event with totals received")
+		}
+	}
+}
+
+// TestSyncEngine_RunTask_ConfirmDeletes tests that a run deleting more files than confirmDeletesOver
+// waits in WAITING_CONFIRMATION until its deletions are confirmed or aborted.
+func TestSyncEngine_RunTask_ConfirmDeletes(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int
+		confirm       bool
+		expectWaiting bool
+		expectDeleted bool
+		expectStatus  model.JobStatus
+	}{
+		{
+			name:          "deletions within the limit don't wait",
+			limit:         2,
+			expectDeleted: true,
+			expectStatus:  model.JobStatusSuccess,
+		},
+		{
+			name:          "confirmed deletions continue the sync",
+			limit:         1,
+			confirm:       true,
+			expectWaiting: true,
+			expectDeleted: true,
+			expectStatus:  model.JobStatusSuccess,
+		},
+		{
+			name:          "aborted deletions cancel the job",
+			limit:         1,
+			expectWaiting: true,
+			expectStatus:  model.JobStatusCancelled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connService, taskService, jobService, _ := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			sourceDir := t.TempDir()
+			destDir := t.TempDir()
+			for _, name := range []string{"keep.txt", "a.txt", "b.txt"} {
+				require.NoError(t, os.WriteFile(filepath.Join(sourceDir, name), []byte(name), 0644))
+			}
+
+			testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+			require.NoError(t, err)
+			options := &model.TaskSyncOptions{ConfirmDeletesOver: &tt.limit}
+			testTask, err := taskService.CreateTask(ctx, tt.name, sourceDir, testConn.ID, destDir,
+				string(model.SyncDirectionUpload), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+
+			jobProgressBus := subscription.NewJobProgressBus()
+			jobSub := jobProgressBus.Subscribe(nil)
+			defer jobProgressBus.Unsubscribe(jobSub.ID)
+
+			syncEngine := rclone.NewSyncEngine(jobService, jobProgressBus, nil, t.TempDir(), false, 0)
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			// Delete two files from the source, which the next run deletes from the destination
+			require.NoError(t, os.Remove(filepath.Join(sourceDir, "a.txt")))
+			require.NoError(t, os.Remove(filepath.Join(sourceDir, "b.txt")))
+
+			done := make(chan error, 1)
+			go func() { done <- syncEngine.RunTask(ctx, testTask, model.JobTriggerManual) }()
+
+			if tt.expectWaiting {
+				var waiting *model.JobProgressEvent
+				for waiting == nil {
+					select {
+					case event := <-jobSub.Events:
+						if event.Status == model.JobStatusWaitingConfirmation {
+							waiting = event
+						}
+					case <-time.After(5 * time.Second):
+						t.Fatal("job did not wait for confirmation")
+					}
+				}
+				job, err := jobService.GetJob(ctx, waiting.JobID)
+				require.NoError(t, err)
+				assert.Equal(t, model.JobStatusWaitingConfirmation, job.Status)
+
+				if tt.confirm {
+					require.NoError(t, syncEngine.ConfirmJob(ctx, waiting.JobID))
+				} else {
+					require.NoError(t, syncEngine.AbortJob(ctx, waiting.JobID))
+				}
+				assert.ErrorIs(t, syncEngine.ConfirmJob(ctx, waiting.JobID), rclone.ErrJobNotWaiting, "A job is decided once")
+			}
+
+			select {
+			case err = <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("run did not finish")
+			}
+			if tt.expectStatus == model.JobStatusCancelled {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, job.Status)
+
+			_, err = os.Stat(filepath.Join(destDir, "a.txt"))
+			assert.Equal(t, tt.expectDeleted, os.IsNotExist(err))
+			_, err = os.Stat(filepath.Join(destDir, "keep.txt"))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestSyncEngine_RunTask_DeleteAnomaly tests that a run deleting far more files than its task usually does
+// is flagged with a DELETE_ANOMALY task event, and waits for confirmation if the task guards its deletions.
+func TestSyncEngine_RunTask_DeleteAnomaly(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int
+		expectWaiting bool
+	}{
+		{
+			name: "unguarded tasks are flagged only",
+		},
+		{
+			name:          "guarded tasks wait within the limit",
+			limit:         100,
+			expectWaiting: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connService, taskService, jobService, _ := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			sourceDir := t.TempDir()
+			destDir := t.TempDir()
+			for _, name := range []string{"keep.txt", "a.txt", "b.txt", "c.txt"} {
+				require.NoError(t, os.WriteFile(filepath.Join(sourceDir, name), []byte(name), 0644))
+			}
+
+			testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+			require.NoError(t, err)
+			options := &model.TaskSyncOptions{ConfirmDeletesOver: &tt.limit}
+			testTask, err := taskService.CreateTask(ctx, tt.name, sourceDir, testConn.ID, destDir,
+				string(model.SyncDirectionUpload), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+
+			jobProgressBus := subscription.NewJobProgressBus()
+			jobSub := jobProgressBus.Subscribe(nil)
+			defer jobProgressBus.Unsubscribe(jobSub.ID)
+
+			syncEngine := rclone.NewSyncEngine(jobService, jobProgressBus, nil, t.TempDir(), false, 0)
+			syncEngine.SetDeleteAnomalyOptions(rclone.DeleteAnomalyOptions{Factor: 2, Min: 1})
+			// Runs that delete nothing make up the history of the task
+			for range 5 {
+				require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+			}
+			events, _, err := taskService.ListTaskEventsPaginated(ctx, testTask.ID, 10, 0)
+			require.NoError(t, err)
+			assert.Empty(t, events)
+
+			for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+				require.NoError(t, os.Remove(filepath.Join(sourceDir, name)))
+			}
+			done := make(chan error, 1)
+			go func() { done <- syncEngine.RunTask(ctx, testTask, model.JobTriggerManual) }()
+
+			if tt.expectWaiting {
+				var waiting *model.JobProgressEvent
+				for waiting == nil {
+					select {
+					case event := <-jobSub.Events:
+						if event.Status == model.JobStatusWaitingConfirmation {
+							waiting = event
+						}
+					case <-time.After(5 * time.Second):
+						t.Fatal("job did not wait for confirmation")
+					}
+				}
+				require.NoError(t, syncEngine.AbortJob(ctx, waiting.JobID))
+			}
+
+			var runErr error
+			select {
+			case runErr = <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("run did not finish")
+			}
+
+			events, _, err = taskService.ListTaskEventsPaginated(ctx, testTask.ID, 10, 0)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, model.TaskEventTypeDeleteAnomaly, events[0].Type)
+			assert.Equal(t, "sync deletes 3 files, the task usually deletes 0", events[0].Message)
+
+			_, statErr := os.Stat(filepath.Join(destDir, "a.txt"))
+			assert.Equal(t, !tt.expectWaiting, os.IsNotExist(statErr), "Aborted deletions are not synced")
+			if tt.expectWaiting {
+				assert.Error(t, runErr)
+			} else {
+				assert.NoError(t, runErr)
+			}
+		})
+	}
+}
+
+// TestSyncEngine_RunTask_Paths tests that the additional paths of a task are synced by the same job
+// with combined stats, and that a failed path stops the remaining ones only with stopOnPathError.
+func TestSyncEngine_RunTask_Paths(t *testing.T) {
+	tests := []struct {
+		name           string
+		stopOnError    bool
+		expectSynced   []string
+		expectMissing  []string
+		expectFiles    int
+		expectedStatus model.JobStatus
+	}{
+		{
+			name:           "failed path continues",
+			expectSynced:   []string{"own.txt", "photos/a.jpg", "docs/letters/b.txt"},
+			expectFiles:    3,
+			expectedStatus: model.JobStatusFailed,
+		},
+		{
+			name:           "failed path stops",
+			stopOnError:    true,
+			expectSynced:   []string{"own.txt", "photos/a.jpg"},
+			expectMissing:  []string{"docs/letters/b.txt"},
+			expectFiles:    2,
+			expectedStatus: model.JobStatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connService, taskService, jobService, _ := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			sourceDir := t.TempDir()
+			photosDir := t.TempDir()
+			docsDir := t.TempDir()
+			destDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "own.txt"), []byte("own"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(photosDir, "a.jpg"), []byte("photo"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(docsDir, "b.txt"), []byte("letter"), 0644))
+			// Files of the remote subpaths are kept by the sync of the task's own pair
+			require.NoError(t, os.MkdirAll(filepath.Join(destDir, "photos"), 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(destDir, "photos", "a.jpg"), []byte("old"), 0644))
+
+			testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+			require.NoError(t, err)
+			options := &model.TaskSyncOptions{
+				Paths: []*model.TaskPath{
+					{SourcePath: photosDir, RemoteSubpath: "photos"},
+					{SourcePath: filepath.Join(sourceDir, "missing"), RemoteSubpath: "missing"},
+					{SourcePath: docsDir, RemoteSubpath: "docs/letters"},
+				},
+				StopOnPathError: &tt.stopOnError,
+			}
+			testTask, err := taskService.CreateTask(ctx, tt.name, sourceDir, testConn.ID, destDir,
+				string(model.SyncDirectionUpload), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+
+			syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+			assert.Error(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			for _, name := range tt.expectSynced {
+				_, err := os.Stat(filepath.Join(destDir, name))
+				assert.NoError(t, err, name)
+			}
+			for _, name := range tt.expectMissing {
+				_, err := os.Stat(filepath.Join(destDir, name))
+				assert.True(t, os.IsNotExist(err), name)
+			}
+			content, err := os.ReadFile(filepath.Join(destDir, "photos", "a.jpg"))
+			require.NoError(t, err)
+			assert.Equal(t, "photo", string(content))
+
+			job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, job.Status)
+			assert.Equal(t, tt.expectFiles, job.FilesTransferred)
+		})
+	}
+}
+
+// TestSyncEngine_RunTask_Mirrors tests that upload tasks copy their content to their mirrors
+// as child jobs after the primary sync, and that a failed mirror only makes the run succeed with warnings.
+func TestSyncEngine_RunTask_Mirrors(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	mirrorDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a.txt"), []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "b.txt"), []byte("b"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	mirrorConn, err := connService.CreateConnection(ctx, "mirror", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	options := &model.TaskSyncOptions{
+		Mirrors: []*model.TaskMirror{
+			{ConnectionID: mirrorConn.ID, RemotePath: mirrorDir},
+			{ConnectionID: uuid.New(), RemotePath: "deleted"},
+		},
+	}
+	testTask, err := taskService.CreateTask(ctx, "Mirrors", sourceDir, testConn.ID, destDir,
+		string(model.SyncDirectionUpload), "", false, options)
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	jobProgressBus := subscription.NewJobProgressBus()
+	jobSub := jobProgressBus.Subscribe(nil)
+	defer jobProgressBus.Unsubscribe(jobSub.ID)
+
+	syncEngine := rclone.NewSyncEngine(jobService, jobProgressBus, nil, t.TempDir(), true, 0)
+	syncEngine.SetConnectionResolver(connService)
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+	for _, dir := range []string{destDir, mirrorDir} {
+		for _, name := range []string{"a.txt", "b.txt"} {
+			assert.FileExists(t, filepath.Join(dir, name))
+		}
+	}
+
+	job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.JobStatusSuccessWithWarnings, job.Status, "the mirror of the deleted connection failed")
+	assert.Equal(t, 2, job.FilesTransferred, "mirrors are counted by their own jobs")
+	assert.Equal(t, 1, job.ErrorCount)
+	errorLogs, err := jobService.ListJobLogs(ctx, nil, nil, &job.ID, string(model.LogLevelError), 10, 0)
+	require.NoError(t, err)
+	require.Len(t, errorLogs, 1)
+	assert.Contains(t, errorLogs[0].Path, "deleted")
+
+	children, err := jobService.ListChildJobs(ctx, job.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+	assert.Equal(t, model.JobStatusSuccess, children[0].Status)
+	assert.Equal(t, 2, children[0].FilesTransferred)
+	require.NotNil(t, children[0].TriggerDetail)
+	assert.Equal(t, &mirrorConn.ID, children[0].TriggerDetail.MirrorConnectionID)
+	assert.Equal(t, model.JobStatusFailed, children[1].Status)
+
+	// Progress events of mirrors carry the task and the ID of their parent
+	parentIDs := make(map[uuid.UUID]*uuid.UUID)
+	for len(jobSub.Events) > 0 {
+		event := <-jobSub.Events
+		assert.Equal(t, testTask.ID, event.TaskID)
+		parentIDs[event.JobID] = event.ParentID
+	}
+	require.Contains(t, parentIDs, job.ID)
+	assert.Nil(t, parentIDs[job.ID])
+	require.Contains(t, parentIDs, children[0].ID)
+	assert.Equal(t, &job.ID, parentIDs[children[0].ID])
+
+	// Mirrors that are up to date are auto-deleted, their parent is kept
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	job, err = jobService.GetLastJobByTaskID(ctx, testTask.ID)
+	require.NoError(t, err)
+	children, err = jobService.ListChildJobs(ctx, job.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	assert.Equal(t, model.JobStatusFailed, children[0].Status)
+}
+
+// TestSyncEngine_RunTask_MirrorOverTransferCap tests that mirrors are charged to the transfer cap of their own
+// connection and deferred once it is reached, while the primary sync still runs.
+func TestSyncEngine_RunTask_MirrorOverTransferCap(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	destDir := t.TempDir()
+	mirrorDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "first.txt"), []byte("first content"), 0644))
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	mirrorConn, err := connService.CreateConnection(ctx, "mirror", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	_, err = connService.SetConnectionTransferCap(ctx, mirrorConn.ID, int64(len("first content")))
+	require.NoError(t, err)
+	testTask, err := taskService.CreateTask(ctx, "MirrorTransferCap", sourceDir, testConn.ID, destDir,
+		string(model.SyncDirectionUpload), "", false,
+		&model.TaskSyncOptions{Mirrors: []*model.TaskMirror{{ConnectionID: mirrorConn.ID, RemotePath: mirrorDir}}})
+	require.NoError(t, err)
+	testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+	require.NoError(t, err)
+
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+	syncEngine.SetConnectionResolver(connService)
+
+	// The first run pushes the mirror connection to its cap
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	assert.FileExists(t, filepath.Join(mirrorDir, "first.txt"))
+	used, err := jobService.MonthlyTransfer(ctx, mirrorConn.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("first content")), used)
+	used, err = jobService.MonthlyTransfer(ctx, testConn.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("first content")), used, "mirror bytes aren't charged to the primary connection")
+
+	// The next run still syncs the primary connection, but defers the mirror
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "second.txt"), []byte("second content"), 0644))
+	require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+	assert.FileExists(t, filepath.Join(destDir, "second.txt"))
+	assert.NoFileExists(t, filepath.Join(mirrorDir, "second.txt"))
+
+	job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+	require.NoError(t, err)
+	children, err := jobService.ListChildJobs(ctx, job.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	assert.Equal(t, model.JobStatusQuotaDeferred, children[0].Status)
+}
+
+// TestSyncEngine_RunTask_WindowsNames tests that the windowsNames option encodes or skips names
+// Windows doesn't accept and logs each of them as a warning.
+func TestSyncEngine_RunTask_WindowsNames(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          model.WindowsNameHandling
+		expectSynced  []string
+		expectMissing []string
+		expectWhat    model.LogAction
+	}{
+		{
+			name:          "encode",
+			mode:          model.WindowsNameHandlingEncode,
+			expectSynced:  []string{"ok.txt", "aux_.txt", "a：b.txt"},
+			expectMissing: []string{"aux.txt", "a:b.txt"},
+			expectWhat:    model.LogActionRename,
+		},
+		{
+			name:          "skip",
+			mode:          model.WindowsNameHandlingSkip,
+			expectSynced:  []string{"ok.txt"},
+			expectMissing: []string{"aux.txt", "aux_.txt", "a:b.txt"},
+			expectWhat:    model.LogActionSkip,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connService, taskService, jobService, _ := setupIntegrationTest(t)
+			ctx := context.Background()
+
+			sourceDir := t.TempDir()
+			destDir := t.TempDir()
+			for _, name := range []string{"ok.txt", "aux.txt", "a:b.txt"} {
+				require.NoError(t, os.WriteFile(filepath.Join(sourceDir, name), []byte(name), 0644))
+			}
+
+			testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+			require.NoError(t, err)
+			options := &model.TaskSyncOptions{WindowsNames: &tt.mode}
+			testTask, err := taskService.CreateTask(ctx, tt.name, sourceDir, testConn.ID, destDir,
+				string(model.SyncDirectionUpload), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+
+			syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			for _, name := range tt.expectSynced {
+				_, err := os.Stat(filepath.Join(destDir, name))
+				assert.NoError(t, err, name)
+			}
+			for _, name := range tt.expectMissing {
+				_, err := os.Stat(filepath.Join(destDir, name))
+				assert.True(t, os.IsNotExist(err), name)
+			}
+
+			job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+			require.NoError(t, err)
+			warnings, err := jobService.ListJobLogs(ctx, nil, nil, &job.ID, string(model.LogLevelWarning), 10, 0)
+			require.NoError(t, err)
+			require.Len(t, warnings, 2)
+			for _, l := range warnings {
+				assert.Equal(t, tt.expectWhat, l.What)
+			}
+
+			// Encoded names are recognized as synced by the next run
+			require.NoError(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+			job, err = jobService.GetLastJobByTaskID(ctx, testTask.ID)
+			require.NoError(t, err)
+			assert.Zero(t, job.FilesTransferred)
+			for _, name := range tt.expectSynced {
+				_, err := os.Stat(filepath.Join(destDir, name))
+				assert.NoError(t, err, name)
+			}
+		})
+	}
+}
+
+func TestSyncEngine_RunTask_FaultInjection(t *testing.T) {
+	connService, taskService, jobService, _ := setupIntegrationTest(t)
+	ctx := context.Background()
+
+	testConn, err := connService.CreateConnection(ctx, "local", "local", map[string]string{"type": "local"})
+	require.NoError(t, err)
+	syncEngine := rclone.NewSyncEngine(jobService, nil, nil, t.TempDir(), false, 0)
+	syncEngine.SetFaultInjection(rclone.FaultOptions{FailTransfers: 100})
+
+	// Faults are also injected into tasks with a max duration
+	maxDuration := 60
+	for name, options := range map[string]*model.TaskSyncOptions{
+		"default":      nil,
+		"max duration": {MaxDurationMinutes: &maxDuration},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sourceDir := t.TempDir()
+			destDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a.txt"), []byte("a"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "b.txt"), []byte("b"), 0644))
+
+			testTask, err := taskService.CreateTask(ctx, "FaultInjection "+name, sourceDir, testConn.ID, destDir,
+				string(model.SyncDirectionUpload), "", false, options)
+			require.NoError(t, err)
+			testTask, err = taskService.GetTaskWithConnection(ctx, testTask.ID)
+			require.NoError(t, err)
+			assert.Error(t, syncEngine.RunTask(ctx, testTask, model.JobTriggerManual))
+
+			entries, err := os.ReadDir(destDir)
+			require.NoError(t, err)
+			assert.Empty(t, entries, "all transfers failed")
+
+			job, err := jobService.GetLastJobByTaskID(ctx, testTask.ID)
+			require.NoError(t, err)
+			assert.Equal(t, model.JobStatusFailed, job.Status)
+			assert.Equal(t, 2, job.ErrorCount)
+			items, err := jobService.ListRetryQueue(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Len(t, items, 2, "failed transfers can be retried")
+		})
+	}
+}
@@ -7,14 +7,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/accounting"
+	"github.com/rclone/rclone/fs/filter"
+	"github.com/rclone/rclone/fstest/mockobject"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/subscription"
 	"github.com/xzzpig/rclone-sync/internal/core/ent"
 	"github.com/xzzpig/rclone-sync/internal/core/logger"
+	"github.com/xzzpig/rclone-sync/internal/core/ports"
+	"github.com/xzzpig/rclone-sync/internal/i18n"
 )
 
 // MockJobService is a mock for services.JobService
@@ -28,6 +35,9 @@ func TestMain(m *testing.M) {
 	{ // logger init block
 		logger.InitLogger(logger.EnvironmentDevelopment, logger.LogLevelDebug, nil)
 	}
+	if err := i18n.Init(); err != nil {
+		panic(err)
+	}
 	m.Run()
 }
 
@@ -39,6 +49,14 @@ func (m *MockJobService) CreateJob(ctx context.Context, taskID uuid.UUID, trigge
 	return args.Get(0).(*ent.Job), args.Error(1)
 }
 
+func (m *MockJobService) CreateChildJob(ctx context.Context, parentID, taskID uuid.UUID, trigger model.JobTrigger) (*ent.Job, error) {
+	args := m.Called(ctx, parentID, taskID, trigger)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Job), args.Error(1)
+}
+
 func (m *MockJobService) UpdateJobStatus(ctx context.Context, jobID uuid.UUID, status string, errStr string) (*ent.Job, error) {
 	args := m.Called(ctx, jobID, status, errStr)
 	if args.Get(0) == nil {
@@ -84,8 +102,8 @@ func (m *MockJobService) GetLastJobByTaskID(ctx context.Context, taskID uuid.UUI
 	return args.Get(0).(*ent.Job), args.Error(1)
 }
 
-func (m *MockJobService) ListJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, limit, offset int) ([]*ent.Job, error) {
-	args := m.Called(ctx, taskID, connectionID, limit, offset)
+func (m *MockJobService) ListJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, status string, limit, offset int) ([]*ent.Job, error) {
+	args := m.Called(ctx, taskID, connectionID, status, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -100,8 +118,8 @@ func (m *MockJobService) GetJobWithLogs(ctx context.Context, jobID uuid.UUID) (*
 	return args.Get(0).(*ent.Job), args.Error(1)
 }
 
-func (m *MockJobService) CountJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID) (int, error) {
-	args := m.Called(ctx, taskID, connectionID)
+func (m *MockJobService) CountJobs(ctx context.Context, taskID *uuid.UUID, connectionID *uuid.UUID, status string) (int, error) {
+	args := m.Called(ctx, taskID, connectionID, status)
 	return args.Int(0), args.Error(1)
 }
 
@@ -123,6 +141,50 @@ func (m *MockJobService) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockJobService) FinalizeJob(ctx context.Context, jobID uuid.UUID, result ports.JobResult) (*ent.Job, error) {
+	args := m.Called(ctx, jobID, result)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Job), args.Error(1)
+}
+
+func (m *MockJobService) AddRetryQueueItems(ctx context.Context, jobID uuid.UUID, items []*ent.RetryQueue) error {
+	args := m.Called(ctx, jobID, items)
+	return args.Error(0)
+}
+
+func (m *MockJobService) ClaimRetryQueue(ctx context.Context, taskID, jobID uuid.UUID) ([]*ent.RetryQueue, error) {
+	args := m.Called(ctx, taskID, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.RetryQueue), args.Error(1)
+}
+
+func (m *MockJobService) AddJobEvent(ctx context.Context, jobID uuid.UUID, event *ent.JobEvent) (*ent.JobEvent, error) {
+	args := m.Called(ctx, jobID, event)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.JobEvent), args.Error(1)
+}
+
+func (m *MockJobService) MonthlyTransfer(ctx context.Context, connectionID uuid.UUID, t time.Time) (int64, error) {
+	args := m.Called(ctx, connectionID, t)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockJobService) TypicalDeletes(ctx context.Context, taskID uuid.UUID, n int) (int64, int, error) {
+	args := m.Called(ctx, taskID, n)
+	return args.Get(0).(int64), args.Get(1).(int), args.Error(2)
+}
+
+func (m *MockJobService) RecordDeleteAnomaly(ctx context.Context, taskID uuid.UUID, message string) error {
+	args := m.Called(ctx, taskID, message)
+	return args.Error(0)
+}
+
 // TestPollStatsLogic tests the logic of pollStats using a mocked JobService
 func TestPollStatsLogic(t *testing.T) {
 	// 1. Setup Mock
@@ -144,7 +206,7 @@ func TestPollStatsLogic(t *testing.T) {
 	// 4. Run loop
 	var wg sync.WaitGroup
 	wg.Go(func() {
-		engine.pollStats(ctx, jobID, &ent.Task{ID: uuid.New()}, time.Now())
+		engine.pollStats(ctx, jobID, &ent.Task{ID: uuid.New()}, time.Now(), nil)
 	})
 
 	// Allow some time for the loop to run
@@ -155,6 +217,53 @@ func TestPollStatsLogic(t *testing.T) {
 	wg.Wait()
 }
 
+// TestProcessStatsVerboseLogging tests that check and listing operations are only logged for verbose tasks
+func TestProcessStatsVerboseLogging(t *testing.T) {
+	verbose := true
+	tests := []struct {
+		name     string
+		options  *model.TaskSyncOptions
+		expected []*ent.JobLog
+	}{
+		{
+			name:    "default skips checks",
+			options: nil,
+		},
+		{
+			name:    "verbose logs checks as debug",
+			options: &model.TaskSyncOptions{VerboseLogging: &verbose},
+			expected: []*ent.JobLog{
+				{Level: model.LogLevelDebug, What: model.LogActionCheck, Path: "checked.txt"},
+				{Level: model.LogLevelDebug, What: model.LogActionList, Path: "dir"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobID := uuid.New()
+			engine := NewSyncEngine(new(MockJobService), nil, nil, t.TempDir(), false, 0)
+			engine.logger = zap.NewNop()
+
+			ctx := accounting.WithStatsGroup(context.Background(), jobID.String())
+			stats := accounting.Stats(ctx)
+			stats.NewCheckingTransfer(mockobject.Object("checked.txt"), "checking").Done(ctx, nil)
+			stats.NewCheckingTransfer(mockobject.Object("dir"), "listing").Done(ctx, nil)
+
+			logBuf := engine.newJobLogBuffer(jobID)
+			var dirStats directionStats
+			engine.processStats(ctx, jobID, &ent.Task{ID: uuid.New(), Options: tt.options}, time.Now(), logBuf, &dirStats, uploadChunking{}, nil)
+
+			require.Len(t, logBuf.logs, len(tt.expected))
+			for i, want := range tt.expected {
+				assert.Equal(t, want.Level, logBuf.logs[i].Level)
+				assert.Equal(t, want.What, logBuf.logs[i].What)
+				assert.Equal(t, want.Path, logBuf.logs[i].Path)
+			}
+		})
+	}
+}
+
 // TestGetJobProgress tests the GetJobProgress method of SyncEngine
 func TestGetJobProgress(t *testing.T) {
 	// Setup
@@ -257,12 +366,16 @@ func TestFailJob(t *testing.T) {
 	jobID := uuid.New()
 	testErr := assert.AnError
 
-	// Expect UpdateJobStatus to be called
-	mockJobService.On("UpdateJobStatus", mock.Anything, jobID, string(model.JobStatusFailed), testErr.Error()).
+	// Expect the job to be finalized as failed
+	mockJobService.On("FinalizeJob", mock.Anything, jobID, ports.JobResult{Status: model.JobStatusFailed, Error: testErr.Error()}).
+		Return((*ent.Job)(nil), nil).Once()
+	// Missing paths fail every run, so they are reported as fatal
+	mockJobService.On("FinalizeJob", mock.Anything, jobID, ports.JobResult{Status: model.JobStatusFailed, Error: fs.ErrorDirNotFound.Error(), Fatal: true}).
 		Return((*ent.Job)(nil), nil).Once()
 
 	ctx := context.Background()
 	engine.failJob(ctx, jobID, testErr)
+	engine.failJob(ctx, jobID, fs.ErrorDirNotFound)
 
 	mockJobService.AssertExpectations(t)
 }
@@ -393,6 +506,40 @@ func TestApplyFilterRules(t *testing.T) {
 	}
 }
 
+// TestApplySyncFilters tests that skipZeroByteFiles adds a minimum size to the filter rules.
+func TestApplySyncFilters(t *testing.T) {
+	ctx := context.Background()
+
+	newCtx, err := applySyncFilters(ctx, SyncOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, ctx, newCtx)
+
+	newCtx, err = applySyncFilters(ctx, SyncOptions{SkipZeroByteFiles: true})
+	require.NoError(t, err)
+	fi := filter.GetConfig(newCtx)
+	assert.Equal(t, fs.SizeSuffix(1), fi.Opt.MinSize)
+	assert.False(t, fi.InActive())
+
+	newCtx, err = applySyncFilters(ctx, SyncOptions{Filters: []string{"- *.tmp"}, SkipZeroByteFiles: true})
+	require.NoError(t, err)
+	fi = filter.GetConfig(newCtx)
+	assert.Equal(t, fs.SizeSuffix(1), fi.Opt.MinSize)
+	assert.False(t, fi.IncludeRemote("a.tmp"))
+
+	_, err = applySyncFilters(ctx, SyncOptions{Filters: []string{"*.tmp"}, SkipZeroByteFiles: true})
+	assert.Error(t, err)
+}
+
+// TestSyncOptionsCreateEmptySrcDirs tests the direction dependent default of createEmptySrcDirs.
+func TestSyncOptionsCreateEmptySrcDirs(t *testing.T) {
+	assert.True(t, SyncOptions{}.createEmptySrcDirs(false))
+	assert.False(t, SyncOptions{}.createEmptySrcDirs(true))
+
+	enabled, disabled := true, false
+	assert.True(t, SyncOptions{CreateEmptySrcDirs: &enabled}.createEmptySrcDirs(true))
+	assert.False(t, SyncOptions{CreateEmptySrcDirs: &disabled}.createEmptySrcDirs(false))
+}
+
 // TestGetSyncOptionsFromTask tests the getSyncOptionsFromTask helper function.
 func TestGetSyncOptionsFromTask(t *testing.T) {
 	tests := []struct {
@@ -428,6 +575,71 @@ func TestGetSyncOptionsFromTask(t *testing.T) {
 				NoDelete: true,
 			},
 		},
+		{
+			name: "max duration in minutes",
+			options: &model.TaskSyncOptions{
+				MaxDurationMinutes: func() *int { v := 90; return &v }(),
+			},
+			expected: SyncOptions{
+				MaxDuration: 90 * time.Minute,
+			},
+		},
+		{
+			name: "zero max duration - no limit",
+			options: &model.TaskSyncOptions{
+				MaxDurationMinutes: func() *int { v := 0; return &v }(),
+			},
+			expected: SyncOptions{},
+		},
+		{
+			name: "trackRenames only",
+			options: &model.TaskSyncOptions{
+				TrackRenames: func() *bool { v := true; return &v }(),
+			},
+			expected: SyncOptions{
+				TrackRenames: true,
+			},
+		},
+		{
+			name: "preserveMetadata only",
+			options: &model.TaskSyncOptions{
+				PreserveMetadata: func() *bool { v := true; return &v }(),
+			},
+			expected: SyncOptions{
+				PreserveMetadata: true,
+			},
+		},
+		{
+			name: "createEmptySrcDirs and skipZeroByteFiles",
+			options: &model.TaskSyncOptions{
+				CreateEmptySrcDirs: func() *bool { v := false; return &v }(),
+				SkipZeroByteFiles:  func() *bool { v := true; return &v }(),
+			},
+			expected: SyncOptions{
+				CreateEmptySrcDirs: func() *bool { v := false; return &v }(),
+				SkipZeroByteFiles:  true,
+			},
+		},
+		{
+			name: "skipSizing only",
+			options: &model.TaskSyncOptions{
+				SkipSizing: func() *bool { v := true; return &v }(),
+			},
+			expected: SyncOptions{
+				SkipSizing: true,
+			},
+		},
+		{
+			name: "additional paths",
+			options: &model.TaskSyncOptions{
+				Paths:           []*model.TaskPath{{SourcePath: "/photos", RemoteSubpath: "photos"}},
+				StopOnPathError: func() *bool { v := true; return &v }(),
+			},
+			expected: SyncOptions{
+				Paths:           []SyncPath{{SourcePath: "/photos", RemoteSubpath: "photos"}},
+				StopOnPathError: true,
+			},
+		},
 		{
 			name: "transfers only",
 			options: &model.TaskSyncOptions{
@@ -657,3 +869,47 @@ func TestShouldDeleteEmptyJob(t *testing.T) {
 		})
 	}
 }
+
+// TestCompletedStatus tests that completed jobs with counted errors are marked SUCCESS_WITH_WARNINGS.
+func TestCompletedStatus(t *testing.T) {
+	assert.Equal(t, model.JobStatusSuccess, completedStatus(0))
+	assert.Equal(t, model.JobStatusSuccessWithWarnings, completedStatus(1))
+	assert.Equal(t, model.JobStatusSuccessWithWarnings, completedStatus(42))
+}
+
+// TestProcessStatsTransferSubscribers tests that processStats only collects and publishes the transfer progress
+// of jobs somebody subscribed to, and drops the cached progress of jobs nobody subscribed to.
+func TestProcessStatsTransferSubscribers(t *testing.T) {
+	jobID := uuid.New()
+	bus := subscription.NewTransferProgressBus()
+	engine := NewSyncEngine(new(MockJobService), nil, bus, t.TempDir(), false, 0)
+	engine.logger = zap.NewNop()
+
+	ctx := accounting.WithStatsGroup(context.Background(), jobID.String())
+	src := t.TempDir()
+	localFs, err := fs.NewFs(ctx, src)
+	require.NoError(t, err)
+	accounting.Stats(ctx).NewTransferRemoteSize("a.txt", 10, localFs, localFs)
+
+	task := &ent.Task{ID: uuid.New(), SourcePath: src, Edges: ent.TaskEdges{Connection: &ent.Connection{ID: uuid.New()}}}
+	process := func() {
+		var dirStats directionStats
+		engine.processStats(ctx, jobID, task, time.Now(), engine.newJobLogBuffer(jobID), &dirStats, uploadChunking{}, nil)
+	}
+
+	// Subscribers of other tasks don't count
+	otherTask := uuid.New()
+	other := bus.Subscribe(subscription.TransferProgressFilter(nil, &otherTask, nil))
+	engine.lastTransferEvents[jobID] = &model.TransferProgressEvent{JobID: jobID}
+	process()
+	assert.NotContains(t, engine.lastTransferEvents, jobID, "the cached progress is dropped without subscribers")
+	assert.Empty(t, other.Events)
+
+	sub := bus.Subscribe(subscription.TransferProgressFilter(nil, &task.ID, nil))
+	process()
+	require.Len(t, sub.Events, 1)
+	event := <-sub.Events
+	require.Len(t, event.Transfers, 1)
+	assert.Equal(t, "a.txt", event.Transfers[0].Name)
+	assert.Empty(t, other.Events)
+}
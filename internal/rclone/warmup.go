@@ -0,0 +1,148 @@
+package rclone
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"go.uber.org/zap"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+)
+
+// WarmupStatus is the progress of warming up the Fs cache, see SyncEngine.WarmUp.
+type WarmupStatus struct {
+	StartedAt  *time.Time // Nil if the warm-up didn't start
+	FinishedAt *time.Time // Nil while the warm-up runs
+	// Connections are the connections of the warmed up tasks, ordered by name.
+	Connections []ConnectionWarmup
+}
+
+// ConnectionWarmup is the warm-up progress of the remote paths of a connection.
+type ConnectionWarmup struct {
+	Connection *ent.Connection
+	State      model.WarmupState
+	Paths      int    // Distinct remote paths of the connection's tasks
+	ReadyPaths int    // Paths whose Fs is cached
+	Error      string // First error creating an Fs, empty if none failed
+	failed     int
+}
+
+// warmup tracks the Fs cache warm-up of an engine.
+type warmup struct {
+	mu          sync.Mutex
+	startedAt   *time.Time
+	finishedAt  *time.Time
+	connections map[string]*ConnectionWarmup
+}
+
+// WarmUp creates and caches the Fs of the remote path of each enabled task, so the first jobs after startup
+// don't wait for slow remotes one after another. At most parallel Fs are created at a time.
+// It blocks until all are created or ctx is cancelled; the progress is reported by WarmupStatus.
+func (e *SyncEngine) WarmUp(ctx context.Context, tasks []*ent.Task, parallel int) {
+	parallel = max(parallel, 1)
+	type target struct {
+		conn *ent.Connection
+		path string
+	}
+	var targets []target
+	seen := make(map[string]bool)
+	connections := make(map[string]*ConnectionWarmup)
+	for _, t := range tasks {
+		conn := t.Edges.Connection
+		if t.Disabled || conn == nil {
+			continue
+		}
+		if _, ok := connections[conn.Name]; !ok {
+			connections[conn.Name] = &ConnectionWarmup{Connection: conn, State: model.WarmupStatePending}
+		}
+		p := TaskRemotePath(t)
+		if key := conn.Name + ":" + p; !seen[key] {
+			seen[key] = true
+			targets = append(targets, target{conn, p})
+			connections[conn.Name].Paths++
+		}
+	}
+
+	now := time.Now()
+	e.warmup.mu.Lock()
+	e.warmup.startedAt, e.warmup.finishedAt = &now, nil
+	e.warmup.connections = connections
+	e.warmup.mu.Unlock()
+	e.logger.Info("Warming up the Fs cache", zap.Int("connections", len(connections)), zap.Int("paths", len(targets)), zap.Int("parallel", parallel))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallel)
+	for _, t := range targets {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		e.warmup.update(t.conn.Name, func(c *ConnectionWarmup) { c.State = model.WarmupStateWarming })
+		wg.Go(func() {
+			defer func() { <-sem }()
+			start := time.Now()
+			_, err := GetFs(ctx, t.conn.Name, t.path)
+			if errors.Is(err, fs.ErrorIsFile) {
+				err = nil
+			}
+			if err != nil {
+				e.logger.Warn("Failed to warm up Fs", zap.String("connection", t.conn.Name), zap.String("path", t.path), zap.Error(err))
+			} else {
+				e.logger.Debug("Warmed up Fs", zap.String("connection", t.conn.Name), zap.String("path", t.path), zap.Duration("duration", time.Since(start)))
+			}
+			e.warmup.update(t.conn.Name, func(c *ConnectionWarmup) {
+				if err != nil {
+					c.failed++
+					if c.Error == "" {
+						c.Error = err.Error()
+					}
+				} else {
+					c.ReadyPaths++
+				}
+				switch {
+				case c.ReadyPaths+c.failed < c.Paths:
+				case c.failed > 0:
+					c.State = model.WarmupStateFailed
+				default:
+					c.State = model.WarmupStateReady
+				}
+			})
+		})
+	}
+	wg.Wait()
+
+	finished := time.Now()
+	e.warmup.mu.Lock()
+	e.warmup.finishedAt = &finished
+	e.warmup.mu.Unlock()
+	e.logger.Info("Fs cache warm-up finished", zap.Duration("duration", finished.Sub(now)), zap.Bool("cancelled", ctx.Err() != nil))
+}
+
+// WarmupStatus returns the progress of the Fs cache warm-up.
+func (e *SyncEngine) WarmupStatus() WarmupStatus {
+	e.warmup.mu.Lock()
+	defer e.warmup.mu.Unlock()
+
+	status := WarmupStatus{StartedAt: e.warmup.startedAt, FinishedAt: e.warmup.finishedAt}
+	for _, c := range e.warmup.connections {
+		status.Connections = append(status.Connections, *c)
+	}
+	slices.SortFunc(status.Connections, func(a, b ConnectionWarmup) int { return cmp.Compare(a.Connection.Name, b.Connection.Name) })
+	return status
+}
+
+// update applies fn to the warm-up progress of the named connection.
+func (w *warmup) update(name string, fn func(c *ConnectionWarmup)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	fn(w.connections[name])
+}
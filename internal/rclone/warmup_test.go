@@ -0,0 +1,60 @@
+package rclone_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"github.com/xzzpig/rclone-sync/internal/rclone"
+)
+
+func TestSyncEngine_WarmUp(t *testing.T) {
+	setupTestConfig(t)
+	require.NoError(t, createRemote("warmup-ok", map[string]string{"type": "local"}))
+	t.Cleanup(func() {
+		deleteRemote("warmup-ok")
+		rclone.ClearFsCache("warmup-ok")
+	})
+
+	engine := rclone.NewSyncEngine(nil, nil, nil, t.TempDir(), false, 0)
+	assert.Nil(t, engine.WarmupStatus().StartedAt, "nothing is warmed up before WarmUp")
+
+	ok := &ent.Connection{ID: uuid.New(), Name: "warmup-ok"}
+	missing := &ent.Connection{ID: uuid.New(), Name: "warmup-missing"}
+	disabled := &ent.Connection{ID: uuid.New(), Name: "warmup-disabled"}
+	dirA, dirB := t.TempDir(), t.TempDir()
+	task := func(conn *ent.Connection, remotePath string, isDisabled bool) *ent.Task {
+		return &ent.Task{ID: uuid.New(), RemotePath: remotePath, Disabled: isDisabled, Edges: ent.TaskEdges{Connection: conn}}
+	}
+	engine.WarmUp(context.Background(), []*ent.Task{
+		task(ok, dirA, false),
+		task(ok, dirA, false), // The same path is warmed up once
+		task(ok, dirB, false),
+		task(missing, "backup", false),
+		task(disabled, "backup", true),
+	}, 2)
+
+	status := engine.WarmupStatus()
+	require.NotNil(t, status.StartedAt)
+	require.NotNil(t, status.FinishedAt)
+	require.Len(t, status.Connections, 2, "connections of disabled tasks aren't warmed up")
+
+	assert.Equal(t, "warmup-missing", status.Connections[0].Connection.Name)
+	assert.Equal(t, model.WarmupStateFailed, status.Connections[0].State)
+	assert.Equal(t, 1, status.Connections[0].Paths)
+	assert.Zero(t, status.Connections[0].ReadyPaths)
+	assert.NotEmpty(t, status.Connections[0].Error)
+
+	assert.Equal(t, "warmup-ok", status.Connections[1].Connection.Name)
+	assert.Equal(t, model.WarmupStateReady, status.Connections[1].State)
+	assert.Equal(t, 2, status.Connections[1].Paths)
+	assert.Equal(t, 2, status.Connections[1].ReadyPaths)
+	assert.Empty(t, status.Connections[1].Error)
+	assert.True(t, rclone.IsConnectionLoaded("warmup-ok", dirA))
+	assert.True(t, rclone.IsConnectionLoaded("warmup-ok", dirB))
+}
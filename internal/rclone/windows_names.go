@@ -0,0 +1,229 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/walk"
+	"github.com/rclone/rclone/lib/transform"
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+	"github.com/xzzpig/rclone-sync/internal/core/ent"
+	"go.uber.org/zap"
+)
+
+// maxWindowsPath is the longest path, relative to the synced directory, that is synced with windowsNames set.
+// Windows limits full paths to 260 characters unless long paths are enabled on the server, so longer paths
+// can never be created there and are skipped in both modes.
+const maxWindowsPath = 259
+
+// maxWindowsNameLogs is the maximum number of files logged by a single run, the rest are summarized in one log.
+const maxWindowsNameLogs = 1000
+
+// Regular expressions matching a single name Windows doesn't accept. They are used both in rclone filter rules,
+// where they end at the first "}}", and by windowsIncompatibleName, so they must not contain "}}".
+const (
+	// windowsReservedName matches device names, which are reserved with any extension, like aux.txt.
+	windowsReservedName = `(?i)(CON|PRN|AUX|NUL|COM[1-9¹²³]|LPT[1-9¹²³])(\.[^/]*)?`
+	// windowsInvalidChars matches names containing characters that are invalid in Windows file names.
+	windowsInvalidChars = `[^/]*[<>:"\\|?*\x00-\x1f][^/]*`
+	// windowsTrailingChar matches names ending with a space or period, which Windows strips.
+	windowsTrailingChar = `[^/]*[ .]`
+)
+
+var windowsNamePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^(?:` + windowsReservedName + `)$`),
+	regexp.MustCompile(`^(?:` + windowsInvalidChars + `)$`),
+	regexp.MustCompile(`^(?:` + windowsTrailingChar + `)$`),
+}
+
+// windowsEncoding is the rclone encoding of ENCODE, replacing the characters that Windows doesn't accept
+// with their fullwidth equivalents, like the encoding of rclone's own SMB backend does.
+const windowsEncoding = "LtGt,DoubleQuote,Colon,Question,Asterisk,Pipe,BackSlash,Ctl,RightSpace,RightPeriod,InvalidUtf8"
+
+// windowsNameTransforms are the rclone name transforms of ENCODE. After encoding characters,
+// an underscore is appended to the base of reserved names, so aux.txt is synced as aux_.txt.
+// The regex of a transform is separated from its replacement by "/" and thus can't contain "/" itself.
+var windowsNameTransforms = []string{
+	"all,encoder=" + windowsEncoding,
+	`all,regex=(?i)^(CON|PRN|AUX|NUL|COM[1-9¹²³]|LPT[1-9¹²³])(\..*)?$/${1}_${2}`,
+}
+
+// windowsIncompatibleName reports whether Windows rejects the file or directory name:
+// a reserved name, a name with invalid characters or a name ending with a space or period.
+func windowsIncompatibleName(name string) bool {
+	for _, re := range windowsNamePatterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// windowsPathTooLong reports whether remote is longer than maxWindowsPath.
+func windowsPathTooLong(remote string) bool {
+	return utf8.RuneCountInString(remote) > maxWindowsPath
+}
+
+// skipsWindowsName reports whether the file or directory at remote is excluded from syncing in mode,
+// not counting the directories it is in. It matches the filter rules of windowsNameRules.
+func skipsWindowsName(mode model.WindowsNameHandling, remote string) bool {
+	switch mode {
+	case model.WindowsNameHandlingSkip:
+		return windowsPathTooLong(remote) || windowsIncompatibleName(path.Base(remote))
+	case model.WindowsNameHandlingEncode:
+		return windowsPathTooLong(remote)
+	}
+	return false
+}
+
+// windowsNameRules returns the filter rules excluding the files and directories that are skipped in mode.
+// Each pattern is excluded both as a file and, with a trailing "/", as a directory along with its contents.
+func windowsNameRules(mode model.WindowsNameHandling) []string {
+	var patterns []string
+	switch mode {
+	case model.WindowsNameHandlingSkip:
+		patterns = []string{"{{" + windowsReservedName + "}}", "{{" + windowsInvalidChars + "}}", "{{" + windowsTrailingChar + "}}"}
+	case model.WindowsNameHandlingEncode:
+	default:
+		return nil
+	}
+	patterns = append(patterns, fmt.Sprintf("/{{.{%d}.*}}", maxWindowsPath+1))
+
+	rules := make([]string, 0, 2*len(patterns))
+	for _, p := range patterns {
+		rules = append(rules, "- "+p, "- "+p+"/")
+	}
+	return rules
+}
+
+// withWindowsNameTransforms returns ctx with the name transforms of ENCODE applied.
+func withWindowsNameTransforms(ctx context.Context) context.Context {
+	ctx, ci := fs.AddConfig(ctx)
+	ci.NameTransform = append(slices.Clone(ci.NameTransform), windowsNameTransforms...)
+	return ctx
+}
+
+// withWindowsNames returns ctx with the name transforms of opts.WindowsNames applied, if it encodes names.
+func withWindowsNames(ctx context.Context, opts SyncOptions) context.Context {
+	if opts.WindowsNames != model.WindowsNameHandlingEncode {
+		return ctx
+	}
+	return withWindowsNameTransforms(ctx)
+}
+
+// windowsNameLog returns the job log of the file or directory at remote if it is skipped or renamed in mode, or nil.
+// encodeCtx carries the name transforms of ENCODE.
+func windowsNameLog(encodeCtx context.Context, mode model.WindowsNameHandling, remote string, isDir bool) *ent.JobLog {
+	if skipsWindowsName(mode, remote) {
+		return &ent.JobLog{Level: model.LogLevelWarning, What: model.LogActionSkip, Path: remote}
+	}
+	if mode != model.WindowsNameHandlingEncode {
+		return nil
+	}
+	// Only the base name is logged as renamed, the names of the directories it is in are logged on their own
+	encoded := transform.Path(encodeCtx, remote, isDir)
+	if path.Base(encoded) == path.Base(remote) {
+		return nil
+	}
+	previous := remote
+	return &ent.JobLog{Level: model.LogLevelWarning, What: model.LogActionRename, Path: path.Join(path.Dir(remote), path.Base(encoded)), PreviousPath: &previous}
+}
+
+// windowsNameLogs lists fSrc and returns the logs of the files and directories that are skipped or renamed in mode.
+// Entries below a skipped directory are not logged, since the directory is logged already.
+// Filter rules are taken from ctx and must not include the rules of windowsNameRules.
+func windowsNameLogs(ctx context.Context, fSrc fs.Fs, mode model.WindowsNameHandling) ([]*ent.JobLog, error) {
+	var mu sync.Mutex
+	var entries []fs.DirEntry
+	err := walk.ListR(ctx, fSrc, "", false, -1, walk.ListAll, func(batch fs.DirEntries) error {
+		mu.Lock()
+		defer mu.Unlock()
+		entries = append(entries, batch...)
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrorDirNotFound) {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Remote(), b.Remote()) })
+
+	encodeCtx := withWindowsNameTransforms(ctx)
+	var logs []*ent.JobLog
+	var skippedDirs []string
+	for _, entry := range entries {
+		remote := entry.Remote()
+		if slices.ContainsFunc(skippedDirs, func(dir string) bool { return strings.HasPrefix(remote, dir+"/") }) {
+			continue
+		}
+		_, isDir := entry.(fs.Directory)
+		l := windowsNameLog(encodeCtx, mode, remote, isDir)
+		if l == nil {
+			continue
+		}
+		if isDir && l.What == model.LogActionSkip {
+			skippedDirs = append(skippedDirs, remote)
+		}
+		logs = append(logs, l)
+	}
+	return logs, nil
+}
+
+// reportWindowsNames logs the files and directories of the pairs that are skipped or renamed because of
+// opts.WindowsNames as WARNING job logs, so users can tell which files don't appear on the destination
+// as they are in the source. Bidirectional tasks are checked on their local side. The report is best effort:
+// failures are only logged, and at most maxWindowsNameLogs files are logged.
+func (e *SyncEngine) reportWindowsNames(ctx context.Context, jobEntity *ent.Job, task *ent.Task, pairs []syncPair, opts SyncOptions) {
+	var logs []*ent.JobLog
+	for _, pair := range pairs {
+		fSrc, _ := pair.sides(task.Direction)
+		pairOpts := opts
+		pairOpts.Filters = pair.Filters
+		pairOpts.WindowsNames = ""
+		listCtx, err := applySyncFilters(ctx, pairOpts)
+		if err != nil {
+			return // Reported by the sync itself
+		}
+
+		pairLogs, err := windowsNameLogs(listCtx, fSrc, opts.WindowsNames)
+		if err != nil {
+			e.logger.Warn("Checking Windows names failed", zap.Stringer("job_id", jobEntity.ID), zap.String("path", pair.name()), zap.Error(err))
+			return
+		}
+		for _, l := range pairLogs {
+			l.Path = path.Join(pair.RemoteSubpath, l.Path)
+			if l.PreviousPath != nil {
+				previous := path.Join(pair.RemoteSubpath, *l.PreviousPath)
+				l.PreviousPath = &previous
+			}
+		}
+		logs = append(logs, pairLogs...)
+	}
+	if len(logs) == 0 {
+		return
+	}
+
+	e.logger.Info("Found names incompatible with Windows", zap.Stringer("job_id", jobEntity.ID), zap.Int("count", len(logs)))
+	now := time.Now()
+	if len(logs) > maxWindowsNameLogs {
+		more := len(logs) - maxWindowsNameLogs
+		logs = append(logs[:maxWindowsNameLogs], &ent.JobLog{
+			Level: model.LogLevelWarning,
+			What:  model.LogActionUnknown,
+			Path:  fmt.Sprintf("%d more names incompatible with Windows were skipped or renamed", more),
+		})
+	}
+	for _, l := range logs {
+		l.Time = now
+	}
+	if err := e.jobService.AddJobLogsBatch(ctx, jobEntity.ID, logs); err != nil {
+		e.logger.Error("Failed to add job logs", zap.Error(err))
+	}
+}
@@ -0,0 +1,106 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rclone/rclone/lib/transform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xzzpig/rclone-sync/internal/api/graphql/model"
+)
+
+func TestWindowsIncompatibleName(t *testing.T) {
+	for _, name := range []string{"aux", "AUX.txt", "con.tar.gz", "Com1", "lpt9.log", "com¹", "a:b", "what?", "x<y>", `back\slash`, "tab\tname", "trailing ", "trailing."} {
+		assert.True(t, windowsIncompatibleName(name), name)
+	}
+	for _, name := range []string{"auxiliary.txt", "console", "com0", "lpt10", "my aux.txt", "file.txt", ".hidden", "日本語.txt"} {
+		assert.False(t, windowsIncompatibleName(name), name)
+	}
+}
+
+// TestWindowsNameRules checks that the filter rules exclude exactly what skipsWindowsName reports.
+func TestWindowsNameRules(t *testing.T) {
+	long := strings.Repeat("a", maxWindowsPath-4) + "/b.txt"
+	remotes := []string{"aux.txt", "docs/CON", "docs/a:b.txt", "docs/name.", "docs/ok.txt", "auxiliary.txt", "ok.txt", long, long[:maxWindowsPath-6] + ".txt"}
+
+	for _, mode := range []model.WindowsNameHandling{model.WindowsNameHandlingSkip, model.WindowsNameHandlingEncode} {
+		fi, err := createFilterFromRules(windowsNameRules(mode))
+		require.NoError(t, err)
+		includeDir := fi.IncludeDirectory(context.Background(), nil)
+
+		for _, remote := range remotes {
+			skipped := skipsWindowsName(mode, remote)
+			assert.Equal(t, !skipped, fi.Include(remote, 1, time.Now(), nil), "file %s in %s", remote, mode)
+			included, err := includeDir(remote)
+			require.NoError(t, err)
+			assert.Equal(t, !skipped, included, "directory %s in %s", remote, mode)
+		}
+	}
+
+	assert.Empty(t, windowsNameRules(""))
+}
+
+func TestWindowsNameTransforms(t *testing.T) {
+	ctx := withWindowsNameTransforms(context.Background())
+	require.NoError(t, transform.SetOptions(ctx, windowsNameTransforms...))
+
+	tests := map[string]string{
+		"aux.txt":      "aux_.txt",
+		"docs/CON":     "docs/CON_",
+		"a:b?.txt":     "a：b？.txt",
+		"name.":        "name．",
+		"aux/file.txt": "aux_/file.txt",
+		"file.txt":     "file.txt",
+	}
+	for remote, want := range tests {
+		assert.Equal(t, want, transform.Path(ctx, remote, false), remote)
+	}
+}
+
+func TestWindowsNameLogs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"ok.txt", "aux.txt", "a:b.txt", "con/inner.txt", "docs/nul"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644))
+	}
+	f, err := GetFs(context.Background(), "", dir)
+	require.NoError(t, err)
+
+	t.Run("skip", func(t *testing.T) {
+		logs, err := windowsNameLogs(context.Background(), f, model.WindowsNameHandlingSkip)
+		require.NoError(t, err)
+
+		var paths []string
+		for _, l := range logs {
+			assert.Equal(t, model.LogLevelWarning, l.Level)
+			assert.Equal(t, model.LogActionSkip, l.What)
+			paths = append(paths, l.Path)
+		}
+		// Files below skipped directories are not logged on their own
+		assert.Equal(t, []string{"a:b.txt", "aux.txt", "con", "docs/nul"}, paths)
+	})
+
+	t.Run("encode", func(t *testing.T) {
+		logs, err := windowsNameLogs(context.Background(), f, model.WindowsNameHandlingEncode)
+		require.NoError(t, err)
+
+		renames := make(map[string]string)
+		for _, l := range logs {
+			assert.Equal(t, model.LogActionRename, l.What)
+			require.NotNil(t, l.PreviousPath)
+			renames[*l.PreviousPath] = l.Path
+		}
+		assert.Equal(t, map[string]string{
+			"a:b.txt":  "a：b.txt",
+			"aux.txt":  "aux_.txt",
+			"con":      "con_",
+			"docs/nul": "docs/nul_",
+		}, renames)
+	})
+}
@@ -2,9 +2,12 @@
 package ui
 
 import (
+	"crypto/sha256"
 	"embed"
+	"encoding/hex"
 	"io/fs"
 	"net/http"
+	"sync"
 )
 
 //go:generate sh -c "cd ../../web && pnpm install && pnpm build"
@@ -19,3 +22,29 @@ func GetFileSystem() (http.FileSystem, error) {
 	}
 	return http.FS(fsys), nil
 }
+
+// Version returns a hash of the embedded frontend files, which changes with every change of the UI.
+// A UI that was loaded with another version is outdated and should reload.
+// It returns "" if no frontend is embedded.
+var Version = sync.OnceValue(func() string {
+	hash := sha256.New()
+	found := false
+	_ = fs.WalkDir(distFS, "dist", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := distFS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		// Hash the path as well, so that renaming a file changes the version
+		hash.Write([]byte(path))
+		hash.Write(data)
+		found = path == "dist/index.html" || found
+		return nil
+	})
+	if !found {
+		return ""
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:12]
+})
@@ -101,6 +101,7 @@ export const LogsListQuery = graphql(`
           level
           time
           path
+          previousPath
           what
           size
         }
@@ -3,6 +3,7 @@ import { cn } from '@/lib/utils';
 import type { StatusType } from '@/lib/types';
 
 import { Component, Match, Show, Switch } from 'solid-js';
+import IconAlertTriangle from '~icons/lucide/alert-triangle';
 import IconBan from '~icons/lucide/ban';
 import IconCheckCircle2 from '~icons/lucide/check-circle-2';
 import IconClock from '~icons/lucide/clock';
@@ -34,11 +35,21 @@ const StatusIcon: Component<StatusIconProps> = (props) => {
             <IconCheckCircle2 class={cn('h-5 w-5 text-green-500', props.class)} />
           </HelpTooltip>
         </Match>
-        <Match when={props.status === 'FAILED'}>
+        <Match when={props.status === 'SUCCESS_WITH_WARNINGS'}>
+          <HelpTooltip content={m.status_completedWithWarnings()}>
+            <IconAlertTriangle class={cn('h-5 w-5 text-amber-500', props.class)} />
+          </HelpTooltip>
+        </Match>
+        <Match when={props.status === 'FAILED' || props.status === 'FAILED_TIMEOUT'}>
           <HelpTooltip content={m.status_failed()}>
             <IconXCircle class={cn('h-5 w-5 text-red-500', props.class)} />
           </HelpTooltip>
         </Match>
+        <Match when={props.status === 'WAITING_CONFIRMATION'}>
+          <HelpTooltip content={m.status_waitingConfirmation()}>
+            <IconAlertTriangle class={cn('h-5 w-5 text-orange-500', props.class)} />
+          </HelpTooltip>
+        </Match>
         <Match when={props.status === 'PENDING'}>
           <HelpTooltip content={m.status_pending()}>
             <IconClock class={cn('h-5 w-5 text-yellow-500', props.class)} />
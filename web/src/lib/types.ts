@@ -222,10 +222,10 @@ export interface ImportExecuteRequest {
  * Log level filter options for UI (lowercase for URL-friendly values)
  * Includes 'all' for showing all log levels
  */
-export const LOG_LEVEL_FILTERS = ['all', 'info', 'warning', 'error'] as const;
+export const LOG_LEVEL_FILTERS = ['all', 'debug', 'info', 'warning', 'error'] as const;
 
 /**
  * Log level filter type derived from LOG_LEVEL_FILTERS constant
- * Type: 'all' | 'info' | 'warning' | 'error'
+ * Type: 'all' | 'debug' | 'info' | 'warning' | 'error'
  */
 export type LogLevelFilter = (typeof LOG_LEVEL_FILTERS)[number];
@@ -62,7 +62,7 @@ export const AddConnectionDialog = (props: { isOpen: boolean; onClose: () => voi
     const result = await client.mutation(ConnectionCreateMutation, {
       input: {
         name,
-        type: selectedProvider()!.name,
+        type: selectedProvider()!.prefix,
         config,
       },
     });
@@ -70,6 +70,7 @@ const Log: Component = () => {
   // Convert UI level filter to GraphQL LogLevel enum
   const toLogLevel = (level: string): LogLevel | undefined => {
     const levelMap: Record<string, LogLevel> = {
+      debug: 'DEBUG',
       info: 'INFO',
       warning: 'WARNING',
       error: 'ERROR',
@@ -141,6 +142,8 @@ const Log: Component = () => {
         return <IconAlertTriangle class="size-4 text-yellow-500" />;
       case 'INFO':
         return <IconInfo class="size-4 text-blue-500" />;
+      case 'DEBUG':
+        return <IconInfo class="size-4 text-muted-foreground" />;
       default:
         console.warn(`Unexpected log level: ${level}`);
         return <IconCheckCircle class="size-4 text-green-500" />;
@@ -155,12 +158,14 @@ const Log: Component = () => {
       ERROR: 'error',
       WARNING: 'warning',
       INFO: 'secondary',
+      DEBUG: 'outline',
     };
 
     const labels: Record<LogLevel, string> = {
       ERROR: m.common_error(),
       WARNING: m.common_warning(),
       INFO: m.common_info(),
+      DEBUG: m.common_debug(),
     };
 
     return <Badge variant={variants[level] ?? 'outline'}>{labels[level] ?? level}</Badge>;
@@ -172,6 +177,11 @@ const Log: Component = () => {
       DOWNLOAD: m.log_action_download(),
       DELETE: m.log_action_delete(),
       MOVE: m.log_action_move(),
+      RENAME: m.log_action_rename(),
+      SKIP: m.log_action_skip(),
+      METADATA: m.log_action_metadata(),
+      CHECK: m.log_action_check(),
+      LIST: m.log_action_list(),
       ERROR: m.log_action_error(),
       UNKNOWN: m.log_action_unknown(),
     };
@@ -266,6 +276,8 @@ const Log: Component = () => {
                   switch (value) {
                     case 'all':
                       return m.log_allLevels();
+                    case 'debug':
+                      return m.common_debug();
                     case 'info':
                       return m.common_info();
                     case 'warning':
@@ -286,6 +298,8 @@ const Log: Component = () => {
                   switch (value) {
                     case 'all':
                       return m.log_allLevels();
+                    case 'debug':
+                      return m.common_debug();
                     case 'info':
                       return m.common_info();
                     case 'warning':
@@ -357,6 +371,7 @@ const Log: Component = () => {
                           fallback={<span class="text-muted-foreground">-</span>}
                         >
                           <div class="whitespace-pre-wrap break-all font-mono text-xs text-muted-foreground">
+                            <Show when={log.previousPath}>{(previousPath) => `${previousPath()} → `}</Show>
                             {log.path}
                           </div>
                         </Show>
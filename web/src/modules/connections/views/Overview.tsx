@@ -39,7 +39,7 @@ const Overview: Component = () => {
     const s = status();
     if (s === 'RUNNING') return m.status_running();
     if (s === 'SUCCESS') return m.overview_healthy();
-    if (s === 'FAILED') return m.status_failed();
+    if (s === 'FAILED' || s === 'FAILED_TIMEOUT') return m.status_failed();
     return m.status_idle();
   };
 
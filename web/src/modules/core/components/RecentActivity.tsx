@@ -30,10 +30,15 @@ const RecentActivity: Component<RecentActivityProps> = (props) => {
     switch (status.toUpperCase()) {
       case 'SUCCESS':
         return m.status_completed();
+      case 'SUCCESS_WITH_WARNINGS':
+        return m.status_completedWithWarnings();
       case 'FAILED':
+      case 'FAILED_TIMEOUT':
         return m.status_failed();
       case 'RUNNING':
         return m.status_running();
+      case 'WAITING_CONFIRMATION':
+        return m.status_waitingConfirmation();
       case 'CANCELLED':
         return m.task_status_cancelled();
       case 'PENDING':
@@ -46,7 +46,7 @@ const WelcomeView: Component = () => {
       jobDate.setHours(0, 0, 0, 0);
       return (
         jobDate.getTime() === today.getTime() &&
-        ['SUCCESS', 'FINISHED', 'DONE'].includes(job.status)
+        ['SUCCESS', 'SUCCESS_WITH_WARNINGS', 'FINISHED', 'DONE'].includes(job.status)
       );
     }).length;
   });
@@ -59,7 +59,7 @@ const WelcomeView: Component = () => {
 
   const failedCount = createMemo(() => {
     const jobList = jobs();
-    return jobList.filter((job) => ['FAILED', 'ERROR'].includes(job.status)).length;
+    return jobList.filter((job) => ['FAILED', 'FAILED_TIMEOUT', 'ERROR'].includes(job.status)).length;
   });
 
   const isLoading = () => connectionsResult.fetching ?? tasksResult.fetching ?? jobsResult.fetching;
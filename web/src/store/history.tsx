@@ -117,7 +117,7 @@ export const HistoryProvider: ParentComponent = (props) => {
             filesDeleted: data.filesDeleted,
             errorCount: data.errorCount,
           };
-        } else if (['SUCCESS', 'FAILED', 'CANCELLED'].includes(data.status)) {
+        } else if (['SUCCESS', 'SUCCESS_WITH_WARNINGS', 'FAILED', 'FAILED_TIMEOUT', 'CANCELLED', 'QUOTA_DEFERRED'].includes(data.status)) {
           // Job completed, clear progress cache
           delete s.jobProgressCache[data.jobId];
         }
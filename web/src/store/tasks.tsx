@@ -123,9 +123,9 @@ export const TaskProvider: ParentComponent = (props) => {
       const getStatus = (task: TaskListItem) => task.latestJob?.status;
 
       // GraphQL returns uppercase enum values: PENDING, RUNNING, SUCCESS, FAILED, CANCELLED
-      const isRunning = (s?: string) => s && ['RUNNING', 'PENDING'].includes(s);
-      const isFailed = (s?: string) => s && ['FAILED'].includes(s);
-      const isSuccess = (s?: string) => s && ['SUCCESS'].includes(s);
+      const isRunning = (s?: string) => s && ['RUNNING', 'PENDING', 'WAITING_CONFIRMATION'].includes(s);
+      const isFailed = (s?: string) => s && ['FAILED', 'FAILED_TIMEOUT'].includes(s);
+      const isSuccess = (s?: string) => s && ['SUCCESS', 'SUCCESS_WITH_WARNINGS'].includes(s);
 
       if (relevantTasks.some((t) => isRunning(getStatus(t)))) return 'RUNNING';
       if (relevantTasks.some((t) => isFailed(getStatus(t)))) return 'FAILED';